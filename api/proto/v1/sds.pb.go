@@ -575,6 +575,203 @@ func (x *AddDiskToPoolResponse) GetMessage() string {
 	return ""
 }
 
+type GetPoolHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pool          string                 `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`    // Empty for all nodes
+	Since         int64                  `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"` // Unix timestamp, 0 for all samples kept
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPoolHistoryRequest) Reset() {
+	*x = GetPoolHistoryRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPoolHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPoolHistoryRequest) ProtoMessage() {}
+
+func (x *GetPoolHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPoolHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPoolHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPoolHistoryRequest) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *GetPoolHistoryRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *GetPoolHistoryRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+// PoolUsageSample is the capacity of a pool on a node at one point in time
+type PoolUsageSample struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Node           string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	TotalBytes     uint64                 `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	FreeBytes      uint64                 `protobuf:"varint,3,opt,name=free_bytes,json=freeBytes,proto3" json:"free_bytes,omitempty"`
+	AllocatedBytes uint64                 `protobuf:"varint,4,opt,name=allocated_bytes,json=allocatedBytes,proto3" json:"allocated_bytes,omitempty"` // Provisioned size of the volumes in the pool
+	At             int64                  `protobuf:"varint,5,opt,name=at,proto3" json:"at,omitempty"`                                               // Unix timestamp
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PoolUsageSample) Reset() {
+	*x = PoolUsageSample{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PoolUsageSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolUsageSample) ProtoMessage() {}
+
+func (x *PoolUsageSample) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolUsageSample.ProtoReflect.Descriptor instead.
+func (*PoolUsageSample) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PoolUsageSample) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *PoolUsageSample) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *PoolUsageSample) GetFreeBytes() uint64 {
+	if x != nil {
+		return x.FreeBytes
+	}
+	return 0
+}
+
+func (x *PoolUsageSample) GetAllocatedBytes() uint64 {
+	if x != nil {
+		return x.AllocatedBytes
+	}
+	return 0
+}
+
+func (x *PoolUsageSample) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+type GetPoolHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Samples       []*PoolUsageSample     `protobuf:"bytes,3,rep,name=samples,proto3" json:"samples,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPoolHistoryResponse) Reset() {
+	*x = GetPoolHistoryResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPoolHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPoolHistoryResponse) ProtoMessage() {}
+
+func (x *GetPoolHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPoolHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPoolHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetPoolHistoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetPoolHistoryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetPoolHistoryResponse) GetSamples() []*PoolUsageSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
 type PoolInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -591,7 +788,7 @@ type PoolInfo struct {
 
 func (x *PoolInfo) Reset() {
 	*x = PoolInfo{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[10]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -603,7 +800,7 @@ func (x *PoolInfo) String() string {
 func (*PoolInfo) ProtoMessage() {}
 
 func (x *PoolInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[10]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,7 +813,7 @@ func (x *PoolInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PoolInfo.ProtoReflect.Descriptor instead.
 func (*PoolInfo) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{10}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PoolInfo) GetName() string {
@@ -688,7 +885,7 @@ type CreateZFSPoolRequest struct {
 
 func (x *CreateZFSPoolRequest) Reset() {
 	*x = CreateZFSPoolRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[11]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -700,7 +897,7 @@ func (x *CreateZFSPoolRequest) String() string {
 func (*CreateZFSPoolRequest) ProtoMessage() {}
 
 func (x *CreateZFSPoolRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[11]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -713,7 +910,7 @@ func (x *CreateZFSPoolRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateZFSPoolRequest.ProtoReflect.Descriptor instead.
 func (*CreateZFSPoolRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{11}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CreateZFSPoolRequest) GetName() string {
@@ -754,7 +951,7 @@ type CreateZFSPoolResponse struct {
 
 func (x *CreateZFSPoolResponse) Reset() {
 	*x = CreateZFSPoolResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[12]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -766,7 +963,7 @@ func (x *CreateZFSPoolResponse) String() string {
 func (*CreateZFSPoolResponse) ProtoMessage() {}
 
 func (x *CreateZFSPoolResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[12]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -779,7 +976,7 @@ func (x *CreateZFSPoolResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateZFSPoolResponse.ProtoReflect.Descriptor instead.
 func (*CreateZFSPoolResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{12}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CreateZFSPoolResponse) GetSuccess() bool {
@@ -806,7 +1003,7 @@ type DeleteZFSPoolRequest struct {
 
 func (x *DeleteZFSPoolRequest) Reset() {
 	*x = DeleteZFSPoolRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[13]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -818,7 +1015,7 @@ func (x *DeleteZFSPoolRequest) String() string {
 func (*DeleteZFSPoolRequest) ProtoMessage() {}
 
 func (x *DeleteZFSPoolRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[13]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -831,7 +1028,7 @@ func (x *DeleteZFSPoolRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteZFSPoolRequest.ProtoReflect.Descriptor instead.
 func (*DeleteZFSPoolRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{13}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DeleteZFSPoolRequest) GetName() string {
@@ -858,7 +1055,7 @@ type DeleteZFSPoolResponse struct {
 
 func (x *DeleteZFSPoolResponse) Reset() {
 	*x = DeleteZFSPoolResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[14]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -870,7 +1067,7 @@ func (x *DeleteZFSPoolResponse) String() string {
 func (*DeleteZFSPoolResponse) ProtoMessage() {}
 
 func (x *DeleteZFSPoolResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[14]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -883,7 +1080,7 @@ func (x *DeleteZFSPoolResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteZFSPoolResponse.ProtoReflect.Descriptor instead.
 func (*DeleteZFSPoolResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{14}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DeleteZFSPoolResponse) GetSuccess() bool {
@@ -908,7 +1105,7 @@ type ListZFSPoolsRequest struct {
 
 func (x *ListZFSPoolsRequest) Reset() {
 	*x = ListZFSPoolsRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[15]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -920,7 +1117,7 @@ func (x *ListZFSPoolsRequest) String() string {
 func (*ListZFSPoolsRequest) ProtoMessage() {}
 
 func (x *ListZFSPoolsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[15]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -933,7 +1130,7 @@ func (x *ListZFSPoolsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListZFSPoolsRequest.ProtoReflect.Descriptor instead.
 func (*ListZFSPoolsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{15}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{18}
 }
 
 type ListZFSPoolsResponse struct {
@@ -947,7 +1144,7 @@ type ListZFSPoolsResponse struct {
 
 func (x *ListZFSPoolsResponse) Reset() {
 	*x = ListZFSPoolsResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[16]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -959,7 +1156,7 @@ func (x *ListZFSPoolsResponse) String() string {
 func (*ListZFSPoolsResponse) ProtoMessage() {}
 
 func (x *ListZFSPoolsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[16]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -972,7 +1169,7 @@ func (x *ListZFSPoolsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListZFSPoolsResponse.ProtoReflect.Descriptor instead.
 func (*ListZFSPoolsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{16}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListZFSPoolsResponse) GetSuccess() bool {
@@ -1006,7 +1203,7 @@ type CreateZFSDatasetRequest struct {
 
 func (x *CreateZFSDatasetRequest) Reset() {
 	*x = CreateZFSDatasetRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[17]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1018,7 +1215,7 @@ func (x *CreateZFSDatasetRequest) String() string {
 func (*CreateZFSDatasetRequest) ProtoMessage() {}
 
 func (x *CreateZFSDatasetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[17]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1031,7 +1228,7 @@ func (x *CreateZFSDatasetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateZFSDatasetRequest.ProtoReflect.Descriptor instead.
 func (*CreateZFSDatasetRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{17}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CreateZFSDatasetRequest) GetDatasetPath() string {
@@ -1058,7 +1255,7 @@ type CreateZFSDatasetResponse struct {
 
 func (x *CreateZFSDatasetResponse) Reset() {
 	*x = CreateZFSDatasetResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[18]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1070,7 +1267,7 @@ func (x *CreateZFSDatasetResponse) String() string {
 func (*CreateZFSDatasetResponse) ProtoMessage() {}
 
 func (x *CreateZFSDatasetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[18]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,7 +1280,7 @@ func (x *CreateZFSDatasetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateZFSDatasetResponse.ProtoReflect.Descriptor instead.
 func (*CreateZFSDatasetResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{18}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CreateZFSDatasetResponse) GetSuccess() bool {
@@ -1112,7 +1309,7 @@ type CreateZFSVolumeRequest struct {
 
 func (x *CreateZFSVolumeRequest) Reset() {
 	*x = CreateZFSVolumeRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[19]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1124,7 +1321,7 @@ func (x *CreateZFSVolumeRequest) String() string {
 func (*CreateZFSVolumeRequest) ProtoMessage() {}
 
 func (x *CreateZFSVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[19]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1137,7 +1334,7 @@ func (x *CreateZFSVolumeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateZFSVolumeRequest.ProtoReflect.Descriptor instead.
 func (*CreateZFSVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{19}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CreateZFSVolumeRequest) GetPoolName() string {
@@ -1178,7 +1375,7 @@ type CreateZFSVolumeResponse struct {
 
 func (x *CreateZFSVolumeResponse) Reset() {
 	*x = CreateZFSVolumeResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[20]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1190,7 +1387,7 @@ func (x *CreateZFSVolumeResponse) String() string {
 func (*CreateZFSVolumeResponse) ProtoMessage() {}
 
 func (x *CreateZFSVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[20]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1203,7 +1400,7 @@ func (x *CreateZFSVolumeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateZFSVolumeResponse.ProtoReflect.Descriptor instead.
 func (*CreateZFSVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{20}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *CreateZFSVolumeResponse) GetSuccess() bool {
@@ -1231,7 +1428,7 @@ type ResizeZFSVolumeRequest struct {
 
 func (x *ResizeZFSVolumeRequest) Reset() {
 	*x = ResizeZFSVolumeRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[21]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1243,7 +1440,7 @@ func (x *ResizeZFSVolumeRequest) String() string {
 func (*ResizeZFSVolumeRequest) ProtoMessage() {}
 
 func (x *ResizeZFSVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[21]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1256,7 +1453,7 @@ func (x *ResizeZFSVolumeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResizeZFSVolumeRequest.ProtoReflect.Descriptor instead.
 func (*ResizeZFSVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{21}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ResizeZFSVolumeRequest) GetVolumePath() string {
@@ -1290,7 +1487,7 @@ type ResizeZFSVolumeResponse struct {
 
 func (x *ResizeZFSVolumeResponse) Reset() {
 	*x = ResizeZFSVolumeResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[22]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1302,7 +1499,7 @@ func (x *ResizeZFSVolumeResponse) String() string {
 func (*ResizeZFSVolumeResponse) ProtoMessage() {}
 
 func (x *ResizeZFSVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[22]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1315,7 +1512,7 @@ func (x *ResizeZFSVolumeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResizeZFSVolumeResponse.ProtoReflect.Descriptor instead.
 func (*ResizeZFSVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{22}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ResizeZFSVolumeResponse) GetSuccess() bool {
@@ -1342,7 +1539,7 @@ type DeleteZFSDatasetRequest struct {
 
 func (x *DeleteZFSDatasetRequest) Reset() {
 	*x = DeleteZFSDatasetRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[23]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1354,7 +1551,7 @@ func (x *DeleteZFSDatasetRequest) String() string {
 func (*DeleteZFSDatasetRequest) ProtoMessage() {}
 
 func (x *DeleteZFSDatasetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[23]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1367,7 +1564,7 @@ func (x *DeleteZFSDatasetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteZFSDatasetRequest.ProtoReflect.Descriptor instead.
 func (*DeleteZFSDatasetRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{23}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DeleteZFSDatasetRequest) GetDatasetPath() string {
@@ -1394,7 +1591,7 @@ type DeleteZFSDatasetResponse struct {
 
 func (x *DeleteZFSDatasetResponse) Reset() {
 	*x = DeleteZFSDatasetResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[24]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1406,7 +1603,7 @@ func (x *DeleteZFSDatasetResponse) String() string {
 func (*DeleteZFSDatasetResponse) ProtoMessage() {}
 
 func (x *DeleteZFSDatasetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[24]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1419,7 +1616,7 @@ func (x *DeleteZFSDatasetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteZFSDatasetResponse.ProtoReflect.Descriptor instead.
 func (*DeleteZFSDatasetResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{24}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *DeleteZFSDatasetResponse) GetSuccess() bool {
@@ -1447,7 +1644,7 @@ type CreateZFSSnapshotRequest struct {
 
 func (x *CreateZFSSnapshotRequest) Reset() {
 	*x = CreateZFSSnapshotRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[25]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1459,7 +1656,7 @@ func (x *CreateZFSSnapshotRequest) String() string {
 func (*CreateZFSSnapshotRequest) ProtoMessage() {}
 
 func (x *CreateZFSSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[25]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1472,7 +1669,7 @@ func (x *CreateZFSSnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateZFSSnapshotRequest.ProtoReflect.Descriptor instead.
 func (*CreateZFSSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{25}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CreateZFSSnapshotRequest) GetDataset() string {
@@ -1506,7 +1703,7 @@ type CreateZFSSnapshotResponse struct {
 
 func (x *CreateZFSSnapshotResponse) Reset() {
 	*x = CreateZFSSnapshotResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[26]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1518,7 +1715,7 @@ func (x *CreateZFSSnapshotResponse) String() string {
 func (*CreateZFSSnapshotResponse) ProtoMessage() {}
 
 func (x *CreateZFSSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[26]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1531,7 +1728,7 @@ func (x *CreateZFSSnapshotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateZFSSnapshotResponse.ProtoReflect.Descriptor instead.
 func (*CreateZFSSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{26}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *CreateZFSSnapshotResponse) GetSuccess() bool {
@@ -1558,7 +1755,7 @@ type DeleteZFSSnapshotRequest struct {
 
 func (x *DeleteZFSSnapshotRequest) Reset() {
 	*x = DeleteZFSSnapshotRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[27]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1570,7 +1767,7 @@ func (x *DeleteZFSSnapshotRequest) String() string {
 func (*DeleteZFSSnapshotRequest) ProtoMessage() {}
 
 func (x *DeleteZFSSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[27]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1583,7 +1780,7 @@ func (x *DeleteZFSSnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteZFSSnapshotRequest.ProtoReflect.Descriptor instead.
 func (*DeleteZFSSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{27}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *DeleteZFSSnapshotRequest) GetSnapshot() string {
@@ -1610,7 +1807,7 @@ type DeleteZFSSnapshotResponse struct {
 
 func (x *DeleteZFSSnapshotResponse) Reset() {
 	*x = DeleteZFSSnapshotResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[28]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1622,7 +1819,7 @@ func (x *DeleteZFSSnapshotResponse) String() string {
 func (*DeleteZFSSnapshotResponse) ProtoMessage() {}
 
 func (x *DeleteZFSSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[28]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1635,7 +1832,7 @@ func (x *DeleteZFSSnapshotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteZFSSnapshotResponse.ProtoReflect.Descriptor instead.
 func (*DeleteZFSSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{28}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *DeleteZFSSnapshotResponse) GetSuccess() bool {
@@ -1662,7 +1859,7 @@ type ListZFSSnapshotsRequest struct {
 
 func (x *ListZFSSnapshotsRequest) Reset() {
 	*x = ListZFSSnapshotsRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[29]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1674,7 +1871,7 @@ func (x *ListZFSSnapshotsRequest) String() string {
 func (*ListZFSSnapshotsRequest) ProtoMessage() {}
 
 func (x *ListZFSSnapshotsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[29]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1687,7 +1884,7 @@ func (x *ListZFSSnapshotsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListZFSSnapshotsRequest.ProtoReflect.Descriptor instead.
 func (*ListZFSSnapshotsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{29}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ListZFSSnapshotsRequest) GetDataset() string {
@@ -1715,7 +1912,7 @@ type ListZFSSnapshotsResponse struct {
 
 func (x *ListZFSSnapshotsResponse) Reset() {
 	*x = ListZFSSnapshotsResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[30]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1727,7 +1924,7 @@ func (x *ListZFSSnapshotsResponse) String() string {
 func (*ListZFSSnapshotsResponse) ProtoMessage() {}
 
 func (x *ListZFSSnapshotsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[30]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1740,7 +1937,7 @@ func (x *ListZFSSnapshotsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListZFSSnapshotsResponse.ProtoReflect.Descriptor instead.
 func (*ListZFSSnapshotsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{30}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ListZFSSnapshotsResponse) GetSuccess() bool {
@@ -1775,7 +1972,7 @@ type RestoreZFSSnapshotRequest struct {
 
 func (x *RestoreZFSSnapshotRequest) Reset() {
 	*x = RestoreZFSSnapshotRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[31]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1787,7 +1984,7 @@ func (x *RestoreZFSSnapshotRequest) String() string {
 func (*RestoreZFSSnapshotRequest) ProtoMessage() {}
 
 func (x *RestoreZFSSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[31]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1800,7 +1997,7 @@ func (x *RestoreZFSSnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreZFSSnapshotRequest.ProtoReflect.Descriptor instead.
 func (*RestoreZFSSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{31}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *RestoreZFSSnapshotRequest) GetDataset() string {
@@ -1834,7 +2031,7 @@ type RestoreZFSSnapshotResponse struct {
 
 func (x *RestoreZFSSnapshotResponse) Reset() {
 	*x = RestoreZFSSnapshotResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[32]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1846,7 +2043,7 @@ func (x *RestoreZFSSnapshotResponse) String() string {
 func (*RestoreZFSSnapshotResponse) ProtoMessage() {}
 
 func (x *RestoreZFSSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[32]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1859,7 +2056,7 @@ func (x *RestoreZFSSnapshotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreZFSSnapshotResponse.ProtoReflect.Descriptor instead.
 func (*RestoreZFSSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{32}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *RestoreZFSSnapshotResponse) GetSuccess() bool {
@@ -1887,7 +2084,7 @@ type CloneZFSSnapshotRequest struct {
 
 func (x *CloneZFSSnapshotRequest) Reset() {
 	*x = CloneZFSSnapshotRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[33]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1899,7 +2096,7 @@ func (x *CloneZFSSnapshotRequest) String() string {
 func (*CloneZFSSnapshotRequest) ProtoMessage() {}
 
 func (x *CloneZFSSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[33]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1912,7 +2109,7 @@ func (x *CloneZFSSnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloneZFSSnapshotRequest.ProtoReflect.Descriptor instead.
 func (*CloneZFSSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{33}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *CloneZFSSnapshotRequest) GetSnapshot() string {
@@ -1946,7 +2143,7 @@ type CloneZFSSnapshotResponse struct {
 
 func (x *CloneZFSSnapshotResponse) Reset() {
 	*x = CloneZFSSnapshotResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[34]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1958,7 +2155,7 @@ func (x *CloneZFSSnapshotResponse) String() string {
 func (*CloneZFSSnapshotResponse) ProtoMessage() {}
 
 func (x *CloneZFSSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[34]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1971,7 +2168,7 @@ func (x *CloneZFSSnapshotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloneZFSSnapshotResponse.ProtoReflect.Descriptor instead.
 func (*CloneZFSSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{34}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *CloneZFSSnapshotResponse) GetSuccess() bool {
@@ -2002,7 +2199,7 @@ type CreateLvmSnapshotRequest struct {
 
 func (x *CreateLvmSnapshotRequest) Reset() {
 	*x = CreateLvmSnapshotRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[35]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2014,7 +2211,7 @@ func (x *CreateLvmSnapshotRequest) String() string {
 func (*CreateLvmSnapshotRequest) ProtoMessage() {}
 
 func (x *CreateLvmSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[35]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2027,7 +2224,7 @@ func (x *CreateLvmSnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLvmSnapshotRequest.ProtoReflect.Descriptor instead.
 func (*CreateLvmSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{35}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *CreateLvmSnapshotRequest) GetResource() string {
@@ -2075,7 +2272,7 @@ type CreateLvmSnapshotResponse struct {
 
 func (x *CreateLvmSnapshotResponse) Reset() {
 	*x = CreateLvmSnapshotResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[36]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2087,7 +2284,7 @@ func (x *CreateLvmSnapshotResponse) String() string {
 func (*CreateLvmSnapshotResponse) ProtoMessage() {}
 
 func (x *CreateLvmSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[36]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2100,7 +2297,7 @@ func (x *CreateLvmSnapshotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLvmSnapshotResponse.ProtoReflect.Descriptor instead.
 func (*CreateLvmSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{36}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *CreateLvmSnapshotResponse) GetSuccess() bool {
@@ -2128,7 +2325,7 @@ type DeleteLvmSnapshotRequest struct {
 
 func (x *DeleteLvmSnapshotRequest) Reset() {
 	*x = DeleteLvmSnapshotRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[37]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2140,7 +2337,7 @@ func (x *DeleteLvmSnapshotRequest) String() string {
 func (*DeleteLvmSnapshotRequest) ProtoMessage() {}
 
 func (x *DeleteLvmSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[37]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2153,7 +2350,7 @@ func (x *DeleteLvmSnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLvmSnapshotRequest.ProtoReflect.Descriptor instead.
 func (*DeleteLvmSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{37}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *DeleteLvmSnapshotRequest) GetLvName() string {
@@ -2187,7 +2384,7 @@ type DeleteLvmSnapshotResponse struct {
 
 func (x *DeleteLvmSnapshotResponse) Reset() {
 	*x = DeleteLvmSnapshotResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[38]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2199,7 +2396,7 @@ func (x *DeleteLvmSnapshotResponse) String() string {
 func (*DeleteLvmSnapshotResponse) ProtoMessage() {}
 
 func (x *DeleteLvmSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[38]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2212,7 +2409,7 @@ func (x *DeleteLvmSnapshotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLvmSnapshotResponse.ProtoReflect.Descriptor instead.
 func (*DeleteLvmSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{38}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *DeleteLvmSnapshotResponse) GetSuccess() bool {
@@ -2239,7 +2436,7 @@ type ListLvmSnapshotsRequest struct {
 
 func (x *ListLvmSnapshotsRequest) Reset() {
 	*x = ListLvmSnapshotsRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[39]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2251,7 +2448,7 @@ func (x *ListLvmSnapshotsRequest) String() string {
 func (*ListLvmSnapshotsRequest) ProtoMessage() {}
 
 func (x *ListLvmSnapshotsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[39]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2264,7 +2461,7 @@ func (x *ListLvmSnapshotsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLvmSnapshotsRequest.ProtoReflect.Descriptor instead.
 func (*ListLvmSnapshotsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{39}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ListLvmSnapshotsRequest) GetLvName() string {
@@ -2292,7 +2489,7 @@ type ListLvmSnapshotsResponse struct {
 
 func (x *ListLvmSnapshotsResponse) Reset() {
 	*x = ListLvmSnapshotsResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[40]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2304,7 +2501,7 @@ func (x *ListLvmSnapshotsResponse) String() string {
 func (*ListLvmSnapshotsResponse) ProtoMessage() {}
 
 func (x *ListLvmSnapshotsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[40]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2317,7 +2514,7 @@ func (x *ListLvmSnapshotsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLvmSnapshotsResponse.ProtoReflect.Descriptor instead.
 func (*ListLvmSnapshotsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{40}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ListLvmSnapshotsResponse) GetSuccess() bool {
@@ -2352,7 +2549,7 @@ type RestoreLvmSnapshotRequest struct {
 
 func (x *RestoreLvmSnapshotRequest) Reset() {
 	*x = RestoreLvmSnapshotRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[41]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2364,7 +2561,7 @@ func (x *RestoreLvmSnapshotRequest) String() string {
 func (*RestoreLvmSnapshotRequest) ProtoMessage() {}
 
 func (x *RestoreLvmSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[41]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2377,7 +2574,7 @@ func (x *RestoreLvmSnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreLvmSnapshotRequest.ProtoReflect.Descriptor instead.
 func (*RestoreLvmSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{41}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *RestoreLvmSnapshotRequest) GetLvName() string {
@@ -2411,7 +2608,7 @@ type RestoreLvmSnapshotResponse struct {
 
 func (x *RestoreLvmSnapshotResponse) Reset() {
 	*x = RestoreLvmSnapshotResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[42]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2423,7 +2620,7 @@ func (x *RestoreLvmSnapshotResponse) String() string {
 func (*RestoreLvmSnapshotResponse) ProtoMessage() {}
 
 func (x *RestoreLvmSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[42]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2436,7 +2633,7 @@ func (x *RestoreLvmSnapshotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreLvmSnapshotResponse.ProtoReflect.Descriptor instead.
 func (*RestoreLvmSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{42}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *RestoreLvmSnapshotResponse) GetSuccess() bool {
@@ -2464,7 +2661,7 @@ type RegisterNodeRequest struct {
 
 func (x *RegisterNodeRequest) Reset() {
 	*x = RegisterNodeRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[43]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2476,7 +2673,7 @@ func (x *RegisterNodeRequest) String() string {
 func (*RegisterNodeRequest) ProtoMessage() {}
 
 func (x *RegisterNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[43]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2489,7 +2686,7 @@ func (x *RegisterNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterNodeRequest.ProtoReflect.Descriptor instead.
 func (*RegisterNodeRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{43}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *RegisterNodeRequest) GetAddress() string {
@@ -2517,7 +2714,7 @@ type RegisterNodeResponse struct {
 
 func (x *RegisterNodeResponse) Reset() {
 	*x = RegisterNodeResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[44]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2529,7 +2726,7 @@ func (x *RegisterNodeResponse) String() string {
 func (*RegisterNodeResponse) ProtoMessage() {}
 
 func (x *RegisterNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[44]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2542,7 +2739,7 @@ func (x *RegisterNodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterNodeResponse.ProtoReflect.Descriptor instead.
 func (*RegisterNodeResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{44}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *RegisterNodeResponse) GetSuccess() bool {
@@ -2575,7 +2772,7 @@ type UnregisterNodeRequest struct {
 
 func (x *UnregisterNodeRequest) Reset() {
 	*x = UnregisterNodeRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[45]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2587,7 +2784,7 @@ func (x *UnregisterNodeRequest) String() string {
 func (*UnregisterNodeRequest) ProtoMessage() {}
 
 func (x *UnregisterNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[45]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2600,7 +2797,7 @@ func (x *UnregisterNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnregisterNodeRequest.ProtoReflect.Descriptor instead.
 func (*UnregisterNodeRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{45}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *UnregisterNodeRequest) GetAddress() string {
@@ -2620,7 +2817,7 @@ type UnregisterNodeResponse struct {
 
 func (x *UnregisterNodeResponse) Reset() {
 	*x = UnregisterNodeResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[46]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2632,7 +2829,7 @@ func (x *UnregisterNodeResponse) String() string {
 func (*UnregisterNodeResponse) ProtoMessage() {}
 
 func (x *UnregisterNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[46]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2645,7 +2842,7 @@ func (x *UnregisterNodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnregisterNodeResponse.ProtoReflect.Descriptor instead.
 func (*UnregisterNodeResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{46}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *UnregisterNodeResponse) GetSuccess() bool {
@@ -2671,7 +2868,7 @@ type GetNodeRequest struct {
 
 func (x *GetNodeRequest) Reset() {
 	*x = GetNodeRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[47]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2683,7 +2880,7 @@ func (x *GetNodeRequest) String() string {
 func (*GetNodeRequest) ProtoMessage() {}
 
 func (x *GetNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[47]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2696,7 +2893,7 @@ func (x *GetNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNodeRequest.ProtoReflect.Descriptor instead.
 func (*GetNodeRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{47}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GetNodeRequest) GetAddress() string {
@@ -2717,7 +2914,7 @@ type GetNodeResponse struct {
 
 func (x *GetNodeResponse) Reset() {
 	*x = GetNodeResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[48]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2729,7 +2926,7 @@ func (x *GetNodeResponse) String() string {
 func (*GetNodeResponse) ProtoMessage() {}
 
 func (x *GetNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[48]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2742,7 +2939,7 @@ func (x *GetNodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNodeResponse.ProtoReflect.Descriptor instead.
 func (*GetNodeResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{48}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GetNodeResponse) GetSuccess() bool {
@@ -2774,7 +2971,7 @@ type ListNodesRequest struct {
 
 func (x *ListNodesRequest) Reset() {
 	*x = ListNodesRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[49]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2786,7 +2983,7 @@ func (x *ListNodesRequest) String() string {
 func (*ListNodesRequest) ProtoMessage() {}
 
 func (x *ListNodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[49]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2799,7 +2996,7 @@ func (x *ListNodesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNodesRequest.ProtoReflect.Descriptor instead.
 func (*ListNodesRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{49}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{52}
 }
 
 type ListNodesResponse struct {
@@ -2813,7 +3010,7 @@ type ListNodesResponse struct {
 
 func (x *ListNodesResponse) Reset() {
 	*x = ListNodesResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[50]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2825,7 +3022,7 @@ func (x *ListNodesResponse) String() string {
 func (*ListNodesResponse) ProtoMessage() {}
 
 func (x *ListNodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[50]
+	mi := &file_api_proto_v1_sds_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2838,7 +3035,7 @@ func (x *ListNodesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNodesResponse.ProtoReflect.Descriptor instead.
 func (*ListNodesResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{50}
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *ListNodesResponse) GetSuccess() bool {
@@ -2862,33 +3059,30 @@ func (x *ListNodesResponse) GetNodes() []*NodeInfo {
 	return nil
 }
 
-type NodeInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Hostname      string                 `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
-	LastSeen      int64                  `protobuf:"varint,5,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
-	Version       string                 `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SetNodeAddressRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                            // Registered node name
+	Address        string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`                                      // New IP address, the node must answer on it
+	TimeoutSeconds int64                  `protobuf:"varint,3,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // How long to wait for connections, 0 for 2 minutes
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *NodeInfo) Reset() {
-	*x = NodeInfo{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[51]
+func (x *SetNodeAddressRequest) Reset() {
+	*x = SetNodeAddressRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NodeInfo) String() string {
+func (x *SetNodeAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeInfo) ProtoMessage() {}
+func (*SetNodeAddressRequest) ProtoMessage() {}
 
-func (x *NodeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[51]
+func (x *SetNodeAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2899,76 +3093,59 @@ func (x *NodeInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeInfo.ProtoReflect.Descriptor instead.
-func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use SetNodeAddressRequest.ProtoReflect.Descriptor instead.
+func (*SetNodeAddressRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *NodeInfo) GetName() string {
+func (x *SetNodeAddressRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *NodeInfo) GetAddress() string {
+func (x *SetNodeAddressRequest) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-func (x *NodeInfo) GetHostname() string {
-	if x != nil {
-		return x.Hostname
-	}
-	return ""
-}
-
-func (x *NodeInfo) GetState() string {
-	if x != nil {
-		return x.State
-	}
-	return ""
-}
-
-func (x *NodeInfo) GetLastSeen() int64 {
+func (x *SetNodeAddressRequest) GetTimeoutSeconds() int64 {
 	if x != nil {
-		return x.LastSeen
+		return x.TimeoutSeconds
 	}
 	return 0
 }
 
-func (x *NodeInfo) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-// Health check messages
-type HealthCheckRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SetNodeAddressResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PreviousAddress string                 `protobuf:"bytes,3,opt,name=previous_address,json=previousAddress,proto3" json:"previous_address,omitempty"`
+	Resources       []string               `protobuf:"bytes,4,rep,name=resources,proto3" json:"resources,omitempty"` // Resources whose configs were rewritten
+	Actions         []string               `protobuf:"bytes,5,rep,name=actions,proto3" json:"actions,omitempty"`
+	Unrecovered     []string               `protobuf:"bytes,6,rep,name=unrecovered,proto3" json:"unrecovered,omitempty"` // Connections still down at the timeout
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *HealthCheckRequest) Reset() {
-	*x = HealthCheckRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[52]
+func (x *SetNodeAddressResponse) Reset() {
+	*x = SetNodeAddressResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealthCheckRequest) String() string {
+func (x *SetNodeAddressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthCheckRequest) ProtoMessage() {}
+func (*SetNodeAddressResponse) ProtoMessage() {}
 
-func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[52]
+func (x *SetNodeAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2979,42 +3156,77 @@ func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
-func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use SetNodeAddressResponse.ProtoReflect.Descriptor instead.
+func (*SetNodeAddressResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *HealthCheckRequest) GetNode() string {
+func (x *SetNodeAddressResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Node
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetNodeAddressResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-type HealthCheckResponse struct {
+func (x *SetNodeAddressResponse) GetPreviousAddress() string {
+	if x != nil {
+		return x.PreviousAddress
+	}
+	return ""
+}
+
+func (x *SetNodeAddressResponse) GetResources() []string {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *SetNodeAddressResponse) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *SetNodeAddressResponse) GetUnrecovered() []string {
+	if x != nil {
+		return x.Unrecovered
+	}
+	return nil
+}
+
+type TrustNodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Health        *NodeHealthInfo        `protobuf:"bytes,3,opt,name=health,proto3" json:"health,omitempty"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`               // Node name, or the address of a node not registered yet
+	Fingerprint   string                 `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"` // Confirmed key fingerprint to pin, empty to only fetch the key
+	Replace       bool                   `protobuf:"varint,3,opt,name=replace,proto3" json:"replace,omitempty"`        // Replace a different key pinned before
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HealthCheckResponse) Reset() {
-	*x = HealthCheckResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[53]
+func (x *TrustNodeRequest) Reset() {
+	*x = TrustNodeRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealthCheckResponse) String() string {
+func (x *TrustNodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthCheckResponse) ProtoMessage() {}
+func (*TrustNodeRequest) ProtoMessage() {}
 
-func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[53]
+func (x *TrustNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3025,30 +3237,1629 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
-func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use TrustNodeRequest.ProtoReflect.Descriptor instead.
+func (*TrustNodeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *HealthCheckResponse) GetSuccess() bool {
+func (x *TrustNodeRequest) GetNode() string {
 	if x != nil {
-		return x.Success
+		return x.Node
 	}
-	return false
+	return ""
 }
 
-func (x *HealthCheckResponse) GetMessage() string {
+func (x *TrustNodeRequest) GetFingerprint() string {
 	if x != nil {
-		return x.Message
+		return x.Fingerprint
 	}
 	return ""
 }
 
-func (x *HealthCheckResponse) GetHealth() *NodeHealthInfo {
+func (x *TrustNodeRequest) GetReplace() bool {
 	if x != nil {
-		return x.Health
+		return x.Replace
 	}
-	return nil
+	return false
+}
+
+type TrustNodeResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Success             bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message             string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Node                string                 `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	Address             string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	KeyType             string                 `protobuf:"bytes,5,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
+	Fingerprint         string                 `protobuf:"bytes,6,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`                                            // SHA256 fingerprint as printed by ssh-keygen -l
+	PreviousFingerprint string                 `protobuf:"bytes,7,opt,name=previous_fingerprint,json=previousFingerprint,proto3" json:"previous_fingerprint,omitempty"` // Different key pinned before
+	Pinned              bool                   `protobuf:"varint,8,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TrustNodeResponse) Reset() {
+	*x = TrustNodeResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrustNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustNodeResponse) ProtoMessage() {}
+
+func (x *TrustNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustNodeResponse.ProtoReflect.Descriptor instead.
+func (*TrustNodeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TrustNodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TrustNodeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TrustNodeResponse) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *TrustNodeResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TrustNodeResponse) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+func (x *TrustNodeResponse) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *TrustNodeResponse) GetPreviousFingerprint() string {
+	if x != nil {
+		return x.PreviousFingerprint
+	}
+	return ""
+}
+
+func (x *TrustNodeResponse) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type HardenNodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	User          string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`    // SSH user the policy is for, default: the one the controller connects as
+	Apply         bool                   `protobuf:"varint,3,opt,name=apply,proto3" json:"apply,omitempty"` // Install the policy, otherwise only generate it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HardenNodeRequest) Reset() {
+	*x = HardenNodeRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HardenNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HardenNodeRequest) ProtoMessage() {}
+
+func (x *HardenNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HardenNodeRequest.ProtoReflect.Descriptor instead.
+func (*HardenNodeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *HardenNodeRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *HardenNodeRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *HardenNodeRequest) GetApply() bool {
+	if x != nil {
+		return x.Apply
+	}
+	return false
+}
+
+type HardenNodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	User          string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Policy        string                 `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"`   // Generated sudoers policy
+	Missing       []string               `protobuf:"bytes,5,rep,name=missing,proto3" json:"missing,omitempty"` // Privileged commands not installed on the node
+	Installed     bool                   `protobuf:"varint,6,opt,name=installed,proto3" json:"installed,omitempty"`
+	Unrestricted  bool                   `protobuf:"varint,7,opt,name=unrestricted,proto3" json:"unrestricted,omitempty"` // The user still has unrestricted sudo through another rule
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HardenNodeResponse) Reset() {
+	*x = HardenNodeResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HardenNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HardenNodeResponse) ProtoMessage() {}
+
+func (x *HardenNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HardenNodeResponse.ProtoReflect.Descriptor instead.
+func (*HardenNodeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *HardenNodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *HardenNodeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HardenNodeResponse) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *HardenNodeResponse) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *HardenNodeResponse) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *HardenNodeResponse) GetInstalled() bool {
+	if x != nil {
+		return x.Installed
+	}
+	return false
+}
+
+func (x *HardenNodeResponse) GetUnrestricted() bool {
+	if x != nil {
+		return x.Unrestricted
+	}
+	return false
+}
+
+type MaintenanceWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	From          int64                  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"` // Unix timestamp
+	To            int64                  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`     // Unix timestamp, the window expires then
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"` // The window has started
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MaintenanceWindow) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type SetNodeMaintenanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	From          int64                  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"` // Unix timestamp, 0 for now
+	To            int64                  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`     // Unix timestamp
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNodeMaintenanceRequest) Reset() {
+	*x = SetNodeMaintenanceRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNodeMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNodeMaintenanceRequest) ProtoMessage() {}
+
+func (x *SetNodeMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNodeMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*SetNodeMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SetNodeMaintenanceRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *SetNodeMaintenanceRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *SetNodeMaintenanceRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *SetNodeMaintenanceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetNodeMaintenanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Window        *MaintenanceWindow     `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNodeMaintenanceResponse) Reset() {
+	*x = SetNodeMaintenanceResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNodeMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNodeMaintenanceResponse) ProtoMessage() {}
+
+func (x *SetNodeMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNodeMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*SetNodeMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SetNodeMaintenanceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetNodeMaintenanceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetNodeMaintenanceResponse) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type ClearNodeMaintenanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearNodeMaintenanceRequest) Reset() {
+	*x = ClearNodeMaintenanceRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearNodeMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearNodeMaintenanceRequest) ProtoMessage() {}
+
+func (x *ClearNodeMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearNodeMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*ClearNodeMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ClearNodeMaintenanceRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type ClearNodeMaintenanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearNodeMaintenanceResponse) Reset() {
+	*x = ClearNodeMaintenanceResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearNodeMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearNodeMaintenanceResponse) ProtoMessage() {}
+
+func (x *ClearNodeMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearNodeMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*ClearNodeMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ClearNodeMaintenanceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ClearNodeMaintenanceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListMaintenanceWindowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMaintenanceWindowsRequest) Reset() {
+	*x = ListMaintenanceWindowsRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaintenanceWindowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceWindowsRequest) ProtoMessage() {}
+
+func (x *ListMaintenanceWindowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceWindowsRequest.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{65}
+}
+
+type ListMaintenanceWindowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Windows       []*MaintenanceWindow   `protobuf:"bytes,3,rep,name=windows,proto3" json:"windows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMaintenanceWindowsResponse) Reset() {
+	*x = ListMaintenanceWindowsResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaintenanceWindowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceWindowsResponse) ProtoMessage() {}
+
+func (x *ListMaintenanceWindowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceWindowsResponse.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListMaintenanceWindowsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListMaintenanceWindowsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListMaintenanceWindowsResponse) GetWindows() []*MaintenanceWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type NodeExecRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Nodes          []string               `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`                                          // Node names or addresses, or "all"
+	Command        string                 `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`                                      // Run with sh on every node
+	TimeoutSeconds int32                  `protobuf:"varint,3,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // 0 for the default command timeout
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NodeExecRequest) Reset() {
+	*x = NodeExecRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeExecRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeExecRequest) ProtoMessage() {}
+
+func (x *NodeExecRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeExecRequest.ProtoReflect.Descriptor instead.
+func (*NodeExecRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *NodeExecRequest) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *NodeExecRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *NodeExecRequest) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type NodeExecResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	ExitCode      int32                  `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"` // -1 if the node returned no result
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Output        string                 `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"` // Combined output, the tail if truncated
+	Truncated     bool                   `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeExecResult) Reset() {
+	*x = NodeExecResult{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeExecResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeExecResult) ProtoMessage() {}
+
+func (x *NodeExecResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeExecResult.ProtoReflect.Descriptor instead.
+func (*NodeExecResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *NodeExecResult) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *NodeExecResult) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *NodeExecResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *NodeExecResult) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *NodeExecResult) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *NodeExecResult) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type NodeExecResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // The command succeeded on every node
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Results       []*NodeExecResult      `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeExecResponse) Reset() {
+	*x = NodeExecResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeExecResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeExecResponse) ProtoMessage() {}
+
+func (x *NodeExecResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeExecResponse.ProtoReflect.Descriptor instead.
+func (*NodeExecResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *NodeExecResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *NodeExecResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NodeExecResponse) GetResults() []*NodeExecResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type StreamNodeLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`         // Node name or address
+	Unit          string                 `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`         // drbd-reactor (default), drbd for kernel messages, or gateway
+	Resource      string                 `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"` // Resource of the gateway, for unit gateway
+	Lines         int32                  `protobuf:"varint,4,opt,name=lines,proto3" json:"lines,omitempty"`      // Recent lines to start with, 0 for 100
+	Follow        bool                   `protobuf:"varint,5,opt,name=follow,proto3" json:"follow,omitempty"`    // Keep streaming new lines until cancelled
+	Since         string                 `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`       // journalctl --since, e.g. "1 hour ago"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamNodeLogsRequest) Reset() {
+	*x = StreamNodeLogsRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamNodeLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNodeLogsRequest) ProtoMessage() {}
+
+func (x *StreamNodeLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNodeLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamNodeLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *StreamNodeLogsRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *StreamNodeLogsRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *StreamNodeLogsRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *StreamNodeLogsRequest) GetLines() int32 {
+	if x != nil {
+		return x.Lines
+	}
+	return 0
+}
+
+func (x *StreamNodeLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *StreamNodeLogsRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+type NodeLogLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Line          string                 `protobuf:"bytes,2,opt,name=line,proto3" json:"line,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeLogLine) Reset() {
+	*x = NodeLogLine{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeLogLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeLogLine) ProtoMessage() {}
+
+func (x *NodeLogLine) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeLogLine.ProtoReflect.Descriptor instead.
+func (*NodeLogLine) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *NodeLogLine) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *NodeLogLine) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+type PushFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []string               `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"` // Node names or addresses, or "all"
+	Dest          string                 `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`   // Absolute path on the nodes
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Mode          uint32                 `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`  // Permission bits, 0 for 0644
+	Owner         string                 `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"` // user or user:group, empty to keep root
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushFileRequest) Reset() {
+	*x = PushFileRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushFileRequest) ProtoMessage() {}
+
+func (x *PushFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushFileRequest.ProtoReflect.Descriptor instead.
+func (*PushFileRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PushFileRequest) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *PushFileRequest) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *PushFileRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *PushFileRequest) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *PushFileRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+type PushFileResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Checksum      string                 `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"` // SHA-256 of the file as written on the node
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushFileResult) Reset() {
+	*x = PushFileResult{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushFileResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushFileResult) ProtoMessage() {}
+
+func (x *PushFileResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushFileResult.ProtoReflect.Descriptor instead.
+func (*PushFileResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *PushFileResult) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *PushFileResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PushFileResult) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *PushFileResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PushFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // The file was written and verified on every node
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Checksum      string                 `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"` // SHA-256 of the pushed content
+	Results       []*PushFileResult      `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushFileResponse) Reset() {
+	*x = PushFileResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushFileResponse) ProtoMessage() {}
+
+func (x *PushFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushFileResponse.ProtoReflect.Descriptor instead.
+func (*PushFileResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *PushFileResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PushFileResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PushFileResponse) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *PushFileResponse) GetResults() []*PushFileResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type NodeInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address           string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Hostname          string                 `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	State             string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	LastSeen          int64                  `protobuf:"varint,5,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	Version           string                 `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	Capacity          *NodeCapacity          `protobuf:"bytes,7,opt,name=capacity,proto3" json:"capacity,omitempty"`                                              // Unset until the node was polled
+	DrbdKernelVersion string                 `protobuf:"bytes,8,opt,name=drbd_kernel_version,json=drbdKernelVersion,proto3" json:"drbd_kernel_version,omitempty"` // Empty until detected
+	DrbdUtilsVersion  string                 `protobuf:"bytes,9,opt,name=drbd_utils_version,json=drbdUtilsVersion,proto3" json:"drbd_utils_version,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *NodeInfo) Reset() {
+	*x = NodeInfo{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeInfo) ProtoMessage() {}
+
+func (x *NodeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeInfo.ProtoReflect.Descriptor instead.
+func (*NodeInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *NodeInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetCapacity() *NodeCapacity {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetDrbdKernelVersion() string {
+	if x != nil {
+		return x.DrbdKernelVersion
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetDrbdUtilsVersion() string {
+	if x != nil {
+		return x.DrbdUtilsVersion
+	}
+	return ""
+}
+
+// NodeCapacity is collected by the controller's node poller
+type NodeCapacity struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Pools             []*NodePoolCapacity    `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	DrbdDevices       uint32                 `protobuf:"varint,2,opt,name=drbd_devices,json=drbdDevices,proto3" json:"drbd_devices,omitempty"`
+	Primaries         uint32                 `protobuf:"varint,3,opt,name=primaries,proto3" json:"primaries,omitempty"` // Resources in Primary role on the node
+	Load1             float64                `protobuf:"fixed64,4,opt,name=load1,proto3" json:"load1,omitempty"`
+	Load5             float64                `protobuf:"fixed64,5,opt,name=load5,proto3" json:"load5,omitempty"`
+	Load15            float64                `protobuf:"fixed64,6,opt,name=load15,proto3" json:"load15,omitempty"`
+	Cpus              uint32                 `protobuf:"varint,7,opt,name=cpus,proto3" json:"cpus,omitempty"`
+	MemTotalBytes     uint64                 `protobuf:"varint,8,opt,name=mem_total_bytes,json=memTotalBytes,proto3" json:"mem_total_bytes,omitempty"`
+	MemAvailableBytes uint64                 `protobuf:"varint,9,opt,name=mem_available_bytes,json=memAvailableBytes,proto3" json:"mem_available_bytes,omitempty"`
+	CollectedAt       int64                  `protobuf:"varint,10,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"` // Unix timestamp
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *NodeCapacity) Reset() {
+	*x = NodeCapacity{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeCapacity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeCapacity) ProtoMessage() {}
+
+func (x *NodeCapacity) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeCapacity.ProtoReflect.Descriptor instead.
+func (*NodeCapacity) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *NodeCapacity) GetPools() []*NodePoolCapacity {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *NodeCapacity) GetDrbdDevices() uint32 {
+	if x != nil {
+		return x.DrbdDevices
+	}
+	return 0
+}
+
+func (x *NodeCapacity) GetPrimaries() uint32 {
+	if x != nil {
+		return x.Primaries
+	}
+	return 0
+}
+
+func (x *NodeCapacity) GetLoad1() float64 {
+	if x != nil {
+		return x.Load1
+	}
+	return 0
+}
+
+func (x *NodeCapacity) GetLoad5() float64 {
+	if x != nil {
+		return x.Load5
+	}
+	return 0
+}
+
+func (x *NodeCapacity) GetLoad15() float64 {
+	if x != nil {
+		return x.Load15
+	}
+	return 0
+}
+
+func (x *NodeCapacity) GetCpus() uint32 {
+	if x != nil {
+		return x.Cpus
+	}
+	return 0
+}
+
+func (x *NodeCapacity) GetMemTotalBytes() uint64 {
+	if x != nil {
+		return x.MemTotalBytes
+	}
+	return 0
+}
+
+func (x *NodeCapacity) GetMemAvailableBytes() uint64 {
+	if x != nil {
+		return x.MemAvailableBytes
+	}
+	return 0
+}
+
+func (x *NodeCapacity) GetCollectedAt() int64 {
+	if x != nil {
+		return x.CollectedAt
+	}
+	return 0
+}
+
+// NodePoolCapacity is an LVM volume group or ZFS pool on a node
+type NodePoolCapacity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // lvm or zfs
+	TotalBytes    uint64                 `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	FreeBytes     uint64                 `protobuf:"varint,4,opt,name=free_bytes,json=freeBytes,proto3" json:"free_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodePoolCapacity) Reset() {
+	*x = NodePoolCapacity{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodePoolCapacity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodePoolCapacity) ProtoMessage() {}
+
+func (x *NodePoolCapacity) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodePoolCapacity.ProtoReflect.Descriptor instead.
+func (*NodePoolCapacity) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *NodePoolCapacity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NodePoolCapacity) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NodePoolCapacity) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *NodePoolCapacity) GetFreeBytes() uint64 {
+	if x != nil {
+		return x.FreeBytes
+	}
+	return 0
+}
+
+// Health check messages
+type HealthCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckRequest) Reset() {
+	*x = HealthCheckRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckRequest) ProtoMessage() {}
+
+func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *HealthCheckRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type HealthCheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Health        *NodeHealthInfo        `protobuf:"bytes,3,opt,name=health,proto3" json:"health,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckResponse) Reset() {
+	*x = HealthCheckResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckResponse) ProtoMessage() {}
+
+func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *HealthCheckResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *HealthCheckResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HealthCheckResponse) GetHealth() *NodeHealthInfo {
+	if x != nil {
+		return x.Health
+	}
+	return nil
 }
 
 type NodeHealthInfo struct {
@@ -3060,25 +4871,13777 @@ type NodeHealthInfo struct {
 	DrbdReactorRunning      bool                   `protobuf:"varint,5,opt,name=drbd_reactor_running,json=drbdReactorRunning,proto3" json:"drbd_reactor_running,omitempty"`
 	ResourceAgentsInstalled bool                   `protobuf:"varint,6,opt,name=resource_agents_installed,json=resourceAgentsInstalled,proto3" json:"resource_agents_installed,omitempty"`
 	AvailableAgents         []string               `protobuf:"bytes,7,rep,name=available_agents,json=availableAgents,proto3" json:"available_agents,omitempty"`
+	DrbdKernelVersion       string                 `protobuf:"bytes,8,opt,name=drbd_kernel_version,json=drbdKernelVersion,proto3" json:"drbd_kernel_version,omitempty"`
+	DrbdUtilsVersion        string                 `protobuf:"bytes,9,opt,name=drbd_utils_version,json=drbdUtilsVersion,proto3" json:"drbd_utils_version,omitempty"`
+	DrbdVersionWarnings     []string               `protobuf:"bytes,10,rep,name=drbd_version_warnings,json=drbdVersionWarnings,proto3" json:"drbd_version_warnings,omitempty"` // Unsupported DRBD version mixes
+	LvmVersion              string                 `protobuf:"bytes,11,opt,name=lvm_version,json=lvmVersion,proto3" json:"lvm_version,omitempty"`                              // Empty if not installed
+	ZfsVersion              string                 `protobuf:"bytes,12,opt,name=zfs_version,json=zfsVersion,proto3" json:"zfs_version,omitempty"`
+	Time                    *NodeTime              `protobuf:"bytes,13,opt,name=time,proto3" json:"time,omitempty"` // Unset if the clock could not be read
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
 
-func (x *NodeHealthInfo) Reset() {
-	*x = NodeHealthInfo{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[54]
+func (x *NodeHealthInfo) Reset() {
+	*x = NodeHealthInfo{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeHealthInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeHealthInfo) ProtoMessage() {}
+
+func (x *NodeHealthInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeHealthInfo.ProtoReflect.Descriptor instead.
+func (*NodeHealthInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *NodeHealthInfo) GetDrbdInstalled() bool {
+	if x != nil {
+		return x.DrbdInstalled
+	}
+	return false
+}
+
+func (x *NodeHealthInfo) GetDrbdVersion() string {
+	if x != nil {
+		return x.DrbdVersion
+	}
+	return ""
+}
+
+func (x *NodeHealthInfo) GetDrbdReactorInstalled() bool {
+	if x != nil {
+		return x.DrbdReactorInstalled
+	}
+	return false
+}
+
+func (x *NodeHealthInfo) GetDrbdReactorVersion() string {
+	if x != nil {
+		return x.DrbdReactorVersion
+	}
+	return ""
+}
+
+func (x *NodeHealthInfo) GetDrbdReactorRunning() bool {
+	if x != nil {
+		return x.DrbdReactorRunning
+	}
+	return false
+}
+
+func (x *NodeHealthInfo) GetResourceAgentsInstalled() bool {
+	if x != nil {
+		return x.ResourceAgentsInstalled
+	}
+	return false
+}
+
+func (x *NodeHealthInfo) GetAvailableAgents() []string {
+	if x != nil {
+		return x.AvailableAgents
+	}
+	return nil
+}
+
+func (x *NodeHealthInfo) GetDrbdKernelVersion() string {
+	if x != nil {
+		return x.DrbdKernelVersion
+	}
+	return ""
+}
+
+func (x *NodeHealthInfo) GetDrbdUtilsVersion() string {
+	if x != nil {
+		return x.DrbdUtilsVersion
+	}
+	return ""
+}
+
+func (x *NodeHealthInfo) GetDrbdVersionWarnings() []string {
+	if x != nil {
+		return x.DrbdVersionWarnings
+	}
+	return nil
+}
+
+func (x *NodeHealthInfo) GetLvmVersion() string {
+	if x != nil {
+		return x.LvmVersion
+	}
+	return ""
+}
+
+func (x *NodeHealthInfo) GetZfsVersion() string {
+	if x != nil {
+		return x.ZfsVersion
+	}
+	return ""
+}
+
+func (x *NodeHealthInfo) GetTime() *NodeTime {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// NodeTime is the clock of a node compared with the controller's
+type NodeTime struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Node           string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	SkewMs         float64                `protobuf:"fixed64,2,opt,name=skew_ms,json=skewMs,proto3" json:"skew_ms,omitempty"`                           // Least difference to the controller's clock, positive when ahead
+	RoundTripMs    float64                `protobuf:"fixed64,3,opt,name=round_trip_ms,json=roundTripMs,proto3" json:"round_trip_ms,omitempty"`          // Of the measurement, differences within it are not skew
+	Synchronized   bool                   `protobuf:"varint,4,opt,name=synchronized,proto3" json:"synchronized,omitempty"`                              // The NTP client keeps the clock in sync
+	Source         string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`                                           // chrony or timesyncd, empty if neither runs
+	Server         string                 `protobuf:"bytes,6,opt,name=server,proto3" json:"server,omitempty"`                                           // NTP server chrony follows
+	SourceOffsetMs float64                `protobuf:"fixed64,7,opt,name=source_offset_ms,json=sourceOffsetMs,proto3" json:"source_offset_ms,omitempty"` // Offset to the NTP source as chrony reports it
+	Warnings       []string               `protobuf:"bytes,8,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Error          string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	CheckedAt      int64                  `protobuf:"varint,10,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"` // Unix timestamp
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NodeTime) Reset() {
+	*x = NodeTime{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeTime) ProtoMessage() {}
+
+func (x *NodeTime) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeTime.ProtoReflect.Descriptor instead.
+func (*NodeTime) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *NodeTime) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *NodeTime) GetSkewMs() float64 {
+	if x != nil {
+		return x.SkewMs
+	}
+	return 0
+}
+
+func (x *NodeTime) GetRoundTripMs() float64 {
+	if x != nil {
+		return x.RoundTripMs
+	}
+	return 0
+}
+
+func (x *NodeTime) GetSynchronized() bool {
+	if x != nil {
+		return x.Synchronized
+	}
+	return false
+}
+
+func (x *NodeTime) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *NodeTime) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *NodeTime) GetSourceOffsetMs() float64 {
+	if x != nil {
+		return x.SourceOffsetMs
+	}
+	return 0
+}
+
+func (x *NodeTime) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *NodeTime) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *NodeTime) GetCheckedAt() int64 {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return 0
+}
+
+type CheckTimeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []string               `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"` // Node names or addresses, empty for all nodes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckTimeRequest) Reset() {
+	*x = CheckTimeRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckTimeRequest) ProtoMessage() {}
+
+func (x *CheckTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckTimeRequest.ProtoReflect.Descriptor instead.
+func (*CheckTimeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CheckTimeRequest) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type CheckTimeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Nodes         []*NodeTime            `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	MaxSkewMs     float64                `protobuf:"fixed64,4,opt,name=max_skew_ms,json=maxSkewMs,proto3" json:"max_skew_ms,omitempty"` // timesync.max_skew of the controller
+	SpreadMs      float64                `protobuf:"fixed64,5,opt,name=spread_ms,json=spreadMs,proto3" json:"spread_ms,omitempty"`      // Between the fastest and the slowest clock
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckTimeResponse) Reset() {
+	*x = CheckTimeResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckTimeResponse) ProtoMessage() {}
+
+func (x *CheckTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckTimeResponse.ProtoReflect.Descriptor instead.
+func (*CheckTimeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *CheckTimeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CheckTimeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CheckTimeResponse) GetNodes() []*NodeTime {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *CheckTimeResponse) GetMaxSkewMs() float64 {
+	if x != nil {
+		return x.MaxSkewMs
+	}
+	return 0
+}
+
+func (x *CheckTimeResponse) GetSpreadMs() float64 {
+	if x != nil {
+		return x.SpreadMs
+	}
+	return 0
+}
+
+// Resource messages
+type CreateResourceRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Port        uint32                 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Nodes       []string               `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Protocol    string                 `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	SizeGb      uint32                 `protobuf:"varint,5,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	Pool        string                 `protobuf:"bytes,6,opt,name=pool,proto3" json:"pool,omitempty"`
+	StorageType string                 `protobuf:"bytes,7,opt,name=storage_type,json=storageType,proto3" json:"storage_type,omitempty"` // "lvm", "lvm-thin", "zfs", "zfs-thin", "raw" or "file" (labs)
+	DrbdOptions map[string]string      `protobuf:"bytes,8,rep,name=drbd_options,json=drbdOptions,proto3" json:"drbd_options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Devices     map[string]string      `protobuf:"bytes,9,rep,name=devices,proto3" json:"devices,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // raw storage: node name -> block device
+	// node name -> protocol of the connections to that node, e.g. A for a
+	// remote peer of a protocol C resource
+	PeerProtocols map[string]string `protobuf:"bytes,10,rep,name=peer_protocols,json=peerProtocols,proto3" json:"peer_protocols,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// metadata peer slots, room for nodes added later; 0 sizes them for nodes
+	MaxPeers      uint32 `protobuf:"varint,11,opt,name=max_peers,json=maxPeers,proto3" json:"max_peers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateResourceRequest) Reset() {
+	*x = CreateResourceRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResourceRequest) ProtoMessage() {}
+
+func (x *CreateResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResourceRequest.ProtoReflect.Descriptor instead.
+func (*CreateResourceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CreateResourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateResourceRequest) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *CreateResourceRequest) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *CreateResourceRequest) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *CreateResourceRequest) GetSizeGb() uint32 {
+	if x != nil {
+		return x.SizeGb
+	}
+	return 0
+}
+
+func (x *CreateResourceRequest) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *CreateResourceRequest) GetStorageType() string {
+	if x != nil {
+		return x.StorageType
+	}
+	return ""
+}
+
+func (x *CreateResourceRequest) GetDrbdOptions() map[string]string {
+	if x != nil {
+		return x.DrbdOptions
+	}
+	return nil
+}
+
+func (x *CreateResourceRequest) GetDevices() map[string]string {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *CreateResourceRequest) GetPeerProtocols() map[string]string {
+	if x != nil {
+		return x.PeerProtocols
+	}
+	return nil
+}
+
+func (x *CreateResourceRequest) GetMaxPeers() uint32 {
+	if x != nil {
+		return x.MaxPeers
+	}
+	return 0
+}
+
+type CreateResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateResourceResponse) Reset() {
+	*x = CreateResourceResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResourceResponse) ProtoMessage() {}
+
+func (x *CreateResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResourceResponse.ProtoReflect.Descriptor instead.
+func (*CreateResourceResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CreateResourceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateResourceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteResourceRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NoSafetySnapshot bool                   `protobuf:"varint,2,opt,name=no_safety_snapshot,json=noSafetySnapshot,proto3" json:"no_safety_snapshot,omitempty"` // skip the automatic safety snapshot
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeleteResourceRequest) Reset() {
+	*x = DeleteResourceRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceRequest) ProtoMessage() {}
+
+func (x *DeleteResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteResourceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DeleteResourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteResourceRequest) GetNoSafetySnapshot() bool {
+	if x != nil {
+		return x.NoSafetySnapshot
+	}
+	return false
+}
+
+type DeleteResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResourceResponse) Reset() {
+	*x = DeleteResourceResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceResponse) ProtoMessage() {}
+
+func (x *DeleteResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResourceResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *DeleteResourceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteResourceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SetMaxPeersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MaxPeers       uint32                 `protobuf:"varint,2,opt,name=max_peers,json=maxPeers,proto3" json:"max_peers,omitempty"`                   // new metadata peer slots
+	TimeoutSeconds int64                  `protobuf:"varint,3,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // resync wait per node, 0 uses the default
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetMaxPeersRequest) Reset() {
+	*x = SetMaxPeersRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaxPeersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaxPeersRequest) ProtoMessage() {}
+
+func (x *SetMaxPeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaxPeersRequest.ProtoReflect.Descriptor instead.
+func (*SetMaxPeersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SetMaxPeersRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetMaxPeersRequest) GetMaxPeers() uint32 {
+	if x != nil {
+		return x.MaxPeers
+	}
+	return 0
+}
+
+func (x *SetMaxPeersRequest) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type SetMaxPeersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Converted     []string               `protobuf:"bytes,3,rep,name=converted,proto3" json:"converted,omitempty"` // nodes whose metadata was recreated
+	Pending       []string               `protobuf:"bytes,4,rep,name=pending,proto3" json:"pending,omitempty"`     // nodes left, the Primary until it fails over
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMaxPeersResponse) Reset() {
+	*x = SetMaxPeersResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaxPeersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaxPeersResponse) ProtoMessage() {}
+
+func (x *SetMaxPeersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaxPeersResponse.ProtoReflect.Descriptor instead.
+func (*SetMaxPeersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SetMaxPeersResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetMaxPeersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetMaxPeersResponse) GetConverted() []string {
+	if x != nil {
+		return x.Converted
+	}
+	return nil
+}
+
+func (x *SetMaxPeersResponse) GetPending() []string {
+	if x != nil {
+		return x.Pending
+	}
+	return nil
+}
+
+type MigratePoolRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pool           string                 `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`                                            // target volume group or ZFS pool
+	TimeoutSeconds int64                  `protobuf:"varint,3,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // resync wait per node, 0 uses the default
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MigratePoolRequest) Reset() {
+	*x = MigratePoolRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigratePoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigratePoolRequest) ProtoMessage() {}
+
+func (x *MigratePoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigratePoolRequest.ProtoReflect.Descriptor instead.
+func (*MigratePoolRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *MigratePoolRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MigratePoolRequest) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *MigratePoolRequest) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type MigratePoolResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Migrated      []string               `protobuf:"bytes,3,rep,name=migrated,proto3" json:"migrated,omitempty"` // nodes whose volumes were moved
+	Pending       []string               `protobuf:"bytes,4,rep,name=pending,proto3" json:"pending,omitempty"`   // nodes left, the Primary until it fails over
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigratePoolResponse) Reset() {
+	*x = MigratePoolResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigratePoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigratePoolResponse) ProtoMessage() {}
+
+func (x *MigratePoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigratePoolResponse.ProtoReflect.Descriptor instead.
+func (*MigratePoolResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *MigratePoolResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MigratePoolResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MigratePoolResponse) GetMigrated() []string {
+	if x != nil {
+		return x.Migrated
+	}
+	return nil
+}
+
+func (x *MigratePoolResponse) GetPending() []string {
+	if x != nil {
+		return x.Pending
+	}
+	return nil
+}
+
+type ConvertStorageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StorageType    string                 `protobuf:"bytes,2,opt,name=storage_type,json=storageType,proto3" json:"storage_type,omitempty"`           // lvm, lvm-thin, zfs or zfs-thin
+	Pool           string                 `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool,omitempty"`                                            // volume group or ZFS pool of the new volumes
+	TimeoutSeconds int64                  `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // full resync wait per node, 0 uses the default
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConvertStorageRequest) Reset() {
+	*x = ConvertStorageRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertStorageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertStorageRequest) ProtoMessage() {}
+
+func (x *ConvertStorageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertStorageRequest.ProtoReflect.Descriptor instead.
+func (*ConvertStorageRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ConvertStorageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConvertStorageRequest) GetStorageType() string {
+	if x != nil {
+		return x.StorageType
+	}
+	return ""
+}
+
+func (x *ConvertStorageRequest) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *ConvertStorageRequest) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type ConvertStorageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Converted     []string               `protobuf:"bytes,3,rep,name=converted,proto3" json:"converted,omitempty"` // nodes whose volumes were recreated
+	Pending       []string               `protobuf:"bytes,4,rep,name=pending,proto3" json:"pending,omitempty"`     // nodes left, the Primary until it fails over
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertStorageResponse) Reset() {
+	*x = ConvertStorageResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertStorageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertStorageResponse) ProtoMessage() {}
+
+func (x *ConvertStorageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertStorageResponse.ProtoReflect.Descriptor instead.
+func (*ConvertStorageResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ConvertStorageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConvertStorageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConvertStorageResponse) GetConverted() []string {
+	if x != nil {
+		return x.Converted
+	}
+	return nil
+}
+
+func (x *ConvertStorageResponse) GetPending() []string {
+	if x != nil {
+		return x.Pending
+	}
+	return nil
+}
+
+type ReplaceDiskRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Node           string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`                                            // node whose disk is replaced
+	Devices        []string               `protobuf:"bytes,3,rep,name=devices,proto3" json:"devices,omitempty"`                                      // new backing devices in volume order, empty creates volumes in pool
+	Pool           string                 `protobuf:"bytes,4,opt,name=pool,proto3" json:"pool,omitempty"`                                            // volume group or ZFS pool of created volumes, default: the pool of each volume
+	TimeoutSeconds int64                  `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // full resync wait, 0 uses the default
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReplaceDiskRequest) Reset() {
+	*x = ReplaceDiskRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceDiskRequest) ProtoMessage() {}
+
+func (x *ReplaceDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceDiskRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceDiskRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ReplaceDiskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReplaceDiskRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ReplaceDiskRequest) GetDevices() []string {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *ReplaceDiskRequest) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *ReplaceDiskRequest) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+// ReplacedDisk is the new backing device of a volume on the node
+type ReplacedDisk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VolumeId      uint32                 `protobuf:"varint,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	OldDevice     string                 `protobuf:"bytes,2,opt,name=old_device,json=oldDevice,proto3" json:"old_device,omitempty"`
+	NewDevice     string                 `protobuf:"bytes,3,opt,name=new_device,json=newDevice,proto3" json:"new_device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplacedDisk) Reset() {
+	*x = ReplacedDisk{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplacedDisk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplacedDisk) ProtoMessage() {}
+
+func (x *ReplacedDisk) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplacedDisk.ProtoReflect.Descriptor instead.
+func (*ReplacedDisk) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ReplacedDisk) GetVolumeId() uint32 {
+	if x != nil {
+		return x.VolumeId
+	}
+	return 0
+}
+
+func (x *ReplacedDisk) GetOldDevice() string {
+	if x != nil {
+		return x.OldDevice
+	}
+	return ""
+}
+
+func (x *ReplacedDisk) GetNewDevice() string {
+	if x != nil {
+		return x.NewDevice
+	}
+	return ""
+}
+
+type ReplaceDiskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Disks         []*ReplacedDisk        `protobuf:"bytes,3,rep,name=disks,proto3" json:"disks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceDiskResponse) Reset() {
+	*x = ReplaceDiskResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceDiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceDiskResponse) ProtoMessage() {}
+
+func (x *ReplaceDiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceDiskResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceDiskResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ReplaceDiskResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReplaceDiskResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReplaceDiskResponse) GetDisks() []*ReplacedDisk {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+type StopResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopResourceRequest) Reset() {
+	*x = StopResourceRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopResourceRequest) ProtoMessage() {}
+
+func (x *StopResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopResourceRequest.ProtoReflect.Descriptor instead.
+func (*StopResourceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *StopResourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type StopResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopResourceResponse) Reset() {
+	*x = StopResourceResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopResourceResponse) ProtoMessage() {}
+
+func (x *StopResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopResourceResponse.ProtoReflect.Descriptor instead.
+func (*StopResourceResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *StopResourceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StopResourceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PauseSyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Peer          string                 `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"` // only the connections to this node, empty for all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseSyncRequest) Reset() {
+	*x = PauseSyncRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSyncRequest) ProtoMessage() {}
+
+func (x *PauseSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSyncRequest.ProtoReflect.Descriptor instead.
+func (*PauseSyncRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *PauseSyncRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PauseSyncRequest) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+type PauseSyncResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseSyncResponse) Reset() {
+	*x = PauseSyncResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSyncResponse) ProtoMessage() {}
+
+func (x *PauseSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSyncResponse.ProtoReflect.Descriptor instead.
+func (*PauseSyncResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *PauseSyncResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PauseSyncResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ResumeSyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Peer          string                 `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"` // only the connections to this node, empty for all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSyncRequest) Reset() {
+	*x = ResumeSyncRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSyncRequest) ProtoMessage() {}
+
+func (x *ResumeSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSyncRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSyncRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ResumeSyncRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResumeSyncRequest) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+type ResumeSyncResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSyncResponse) Reset() {
+	*x = ResumeSyncResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSyncResponse) ProtoMessage() {}
+
+func (x *ResumeSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSyncResponse.ProtoReflect.Descriptor instead.
+func (*ResumeSyncResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ResumeSyncResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResumeSyncResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SetSyncRateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Peer            string                 `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`                                               // only the connections to this node, empty for all
+	Rate            string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`                                               // DRBD resync-rate, e.g. 20M
+	DurationSeconds int64                  `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // how long the override lasts, 0 for an hour
+	Clear           bool                   `protobuf:"varint,5,opt,name=clear,proto3" json:"clear,omitempty"`                                            // revert to the configured rate instead
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetSyncRateRequest) Reset() {
+	*x = SetSyncRateRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSyncRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSyncRateRequest) ProtoMessage() {}
+
+func (x *SetSyncRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSyncRateRequest.ProtoReflect.Descriptor instead.
+func (*SetSyncRateRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SetSyncRateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetSyncRateRequest) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *SetSyncRateRequest) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *SetSyncRateRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *SetSyncRateRequest) GetClear() bool {
+	if x != nil {
+		return x.Clear
+	}
+	return false
+}
+
+type SetSyncRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix timestamp the override is reverted at
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSyncRateResponse) Reset() {
+	*x = SetSyncRateResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSyncRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSyncRateResponse) ProtoMessage() {}
+
+func (x *SetSyncRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSyncRateResponse.ProtoReflect.Descriptor instead.
+func (*SetSyncRateResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *SetSyncRateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetSyncRateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetSyncRateResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type StartResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartResourceRequest) Reset() {
+	*x = StartResourceRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartResourceRequest) ProtoMessage() {}
+
+func (x *StartResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartResourceRequest.ProtoReflect.Descriptor instead.
+func (*StartResourceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *StartResourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type StartResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartResourceResponse) Reset() {
+	*x = StartResourceResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartResourceResponse) ProtoMessage() {}
+
+func (x *StartResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartResourceResponse.ProtoReflect.Descriptor instead.
+func (*StartResourceResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *StartResourceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StartResourceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ExecFenceTestRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Node             string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`                                                    // Secondary whose IO is probed, default: the first Secondary
+	Peer             string                 `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer,omitempty"`                                                    // peer to disconnect, default: a Secondary, then the Primary
+	IoTimeoutSeconds int64                  `protobuf:"varint,4,opt,name=io_timeout_seconds,json=ioTimeoutSeconds,proto3" json:"io_timeout_seconds,omitempty"` // how long a read may block before IO counts as suspended
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExecFenceTestRequest) Reset() {
+	*x = ExecFenceTestRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecFenceTestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecFenceTestRequest) ProtoMessage() {}
+
+func (x *ExecFenceTestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecFenceTestRequest.ProtoReflect.Descriptor instead.
+func (*ExecFenceTestRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ExecFenceTestRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExecFenceTestRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ExecFenceTestRequest) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *ExecFenceTestRequest) GetIoTimeoutSeconds() int64 {
+	if x != nil {
+		return x.IoTimeoutSeconds
+	}
+	return 0
+}
+
+type FenceTestCheck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // disconnect, quorum, io, reconnect or io-resumed
+	Passed        bool                   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Detail        string                 `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FenceTestCheck) Reset() {
+	*x = FenceTestCheck{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FenceTestCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FenceTestCheck) ProtoMessage() {}
+
+func (x *FenceTestCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FenceTestCheck.ProtoReflect.Descriptor instead.
+func (*FenceTestCheck) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *FenceTestCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FenceTestCheck) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *FenceTestCheck) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type ExecFenceTestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Node          string                 `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	Peer          string                 `protobuf:"bytes,4,opt,name=peer,proto3" json:"peer,omitempty"`
+	Quorum        string                 `protobuf:"bytes,5,opt,name=quorum,proto3" json:"quorum,omitempty"` // effective quorum policy on the node
+	OnNoQuorum    string                 `protobuf:"bytes,6,opt,name=on_no_quorum,json=onNoQuorum,proto3" json:"on_no_quorum,omitempty"`
+	ExpectQuorum  bool                   `protobuf:"varint,7,opt,name=expect_quorum,json=expectQuorum,proto3" json:"expect_quorum,omitempty"` // the node keeps quorum without the peer
+	Passed        bool                   `protobuf:"varint,8,opt,name=passed,proto3" json:"passed,omitempty"`                                 // every check passed
+	Checks        []*FenceTestCheck      `protobuf:"bytes,9,rep,name=checks,proto3" json:"checks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecFenceTestResponse) Reset() {
+	*x = ExecFenceTestResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecFenceTestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecFenceTestResponse) ProtoMessage() {}
+
+func (x *ExecFenceTestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecFenceTestResponse.ProtoReflect.Descriptor instead.
+func (*ExecFenceTestResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ExecFenceTestResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ExecFenceTestResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExecFenceTestResponse) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ExecFenceTestResponse) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *ExecFenceTestResponse) GetQuorum() string {
+	if x != nil {
+		return x.Quorum
+	}
+	return ""
+}
+
+func (x *ExecFenceTestResponse) GetOnNoQuorum() string {
+	if x != nil {
+		return x.OnNoQuorum
+	}
+	return ""
+}
+
+func (x *ExecFenceTestResponse) GetExpectQuorum() bool {
+	if x != nil {
+		return x.ExpectQuorum
+	}
+	return false
+}
+
+func (x *ExecFenceTestResponse) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *ExecFenceTestResponse) GetChecks() []*FenceTestCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+type ActivateResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	MountPoint    string                 `protobuf:"bytes,3,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"` // mount point of volume 0, none if empty
+	FsType        string                 `protobuf:"bytes,4,opt,name=fs_type,json=fsType,proto3" json:"fs_type,omitempty"`             // default: ext4
+	Services      []string               `protobuf:"bytes,5,rep,name=services,proto3" json:"services,omitempty"`                       // systemd units started in order
+	Force         bool                   `protobuf:"varint,6,opt,name=force,proto3" json:"force,omitempty"`                            // activate a node that fails the verification
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateResourceRequest) Reset() {
+	*x = ActivateResourceRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateResourceRequest) ProtoMessage() {}
+
+func (x *ActivateResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateResourceRequest.ProtoReflect.Descriptor instead.
+func (*ActivateResourceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ActivateResourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ActivateResourceRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ActivateResourceRequest) GetMountPoint() string {
+	if x != nil {
+		return x.MountPoint
+	}
+	return ""
+}
+
+func (x *ActivateResourceRequest) GetFsType() string {
+	if x != nil {
+		return x.FsType
+	}
+	return ""
+}
+
+func (x *ActivateResourceRequest) GetServices() []string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *ActivateResourceRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ActivationStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // verify, promote, mount, start <unit>, ...
+	Passed        bool                   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Detail        string                 `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivationStep) Reset() {
+	*x = ActivationStep{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivationStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivationStep) ProtoMessage() {}
+
+func (x *ActivationStep) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivationStep.ProtoReflect.Descriptor instead.
+func (*ActivationStep) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ActivationStep) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ActivationStep) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *ActivationStep) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type ActivateResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Node          string                 `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	Steps         []*ActivationStep      `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateResourceResponse) Reset() {
+	*x = ActivateResourceResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateResourceResponse) ProtoMessage() {}
+
+func (x *ActivateResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateResourceResponse.ProtoReflect.Descriptor instead.
+func (*ActivateResourceResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ActivateResourceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ActivateResourceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ActivateResourceResponse) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ActivateResourceResponse) GetSteps() []*ActivationStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type DeactivateResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateResourceRequest) Reset() {
+	*x = DeactivateResourceRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateResourceRequest) ProtoMessage() {}
+
+func (x *DeactivateResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateResourceRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateResourceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *DeactivateResourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeactivateResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Node          string                 `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	Steps         []*ActivationStep      `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateResourceResponse) Reset() {
+	*x = DeactivateResourceResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateResourceResponse) ProtoMessage() {}
+
+func (x *DeactivateResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateResourceResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateResourceResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *DeactivateResourceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeactivateResourceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeactivateResourceResponse) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *DeactivateResourceResponse) GetSteps() []*ActivationStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type FencePeerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`                                  // node whose fence-peer handler runs
+	Peer          string                 `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer,omitempty"`                                  // peer to fence, DRBD's name of it
+	PeerAddress   string                 `protobuf:"bytes,4,opt,name=peer_address,json=peerAddress,proto3" json:"peer_address,omitempty"` // address of the peer, if its name is unknown
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FencePeerRequest) Reset() {
+	*x = FencePeerRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FencePeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FencePeerRequest) ProtoMessage() {}
+
+func (x *FencePeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FencePeerRequest.ProtoReflect.Descriptor instead.
+func (*FencePeerRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *FencePeerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FencePeerRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *FencePeerRequest) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *FencePeerRequest) GetPeerAddress() string {
+	if x != nil {
+		return x.PeerAddress
+	}
+	return ""
+}
+
+type FencePeerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ExitCode      uint32                 `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"` // exit code of the handler, 1 on failure
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FencePeerResponse) Reset() {
+	*x = FencePeerResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FencePeerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FencePeerResponse) ProtoMessage() {}
+
+func (x *FencePeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FencePeerResponse.ProtoReflect.Descriptor instead.
+func (*FencePeerResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *FencePeerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *FencePeerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FencePeerResponse) GetExitCode() uint32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type UnfencePeerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"` // node whose unfence-peer handler runs
+	Peer          string                 `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer,omitempty"` // peer to unfence
+	All           bool                   `protobuf:"varint,4,opt,name=all,proto3" json:"all,omitempty"`  // lift the constraints of all peers, without peer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfencePeerRequest) Reset() {
+	*x = UnfencePeerRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfencePeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfencePeerRequest) ProtoMessage() {}
+
+func (x *UnfencePeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfencePeerRequest.ProtoReflect.Descriptor instead.
+func (*UnfencePeerRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *UnfencePeerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UnfencePeerRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *UnfencePeerRequest) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *UnfencePeerRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type UnfencePeerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ExitCode      uint32                 `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfencePeerResponse) Reset() {
+	*x = UnfencePeerResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfencePeerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfencePeerResponse) ProtoMessage() {}
+
+func (x *UnfencePeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfencePeerResponse.ProtoReflect.Descriptor instead.
+func (*UnfencePeerResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *UnfencePeerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnfencePeerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UnfencePeerResponse) GetExitCode() uint32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type InstallFenceHandlersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallFenceHandlersRequest) Reset() {
+	*x = InstallFenceHandlersRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallFenceHandlersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallFenceHandlersRequest) ProtoMessage() {}
+
+func (x *InstallFenceHandlersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallFenceHandlersRequest.ProtoReflect.Descriptor instead.
+func (*InstallFenceHandlersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{119}
+}
+
+type InstallFenceHandlersResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Success          bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message          string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Path             string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"` // handler on the nodes
+	FailedNodes      []string               `protobuf:"bytes,4,rep,name=failed_nodes,json=failedNodes,proto3" json:"failed_nodes,omitempty"`
+	DrbdOptions      map[string]string      `protobuf:"bytes,5,rep,name=drbd_options,json=drbdOptions,proto3" json:"drbd_options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // options that enable the handlers on a resource
+	EventHandlerPath string                 `protobuf:"bytes,6,opt,name=event_handler_path,json=eventHandlerPath,proto3" json:"event_handler_path,omitempty"`                                                          // handler reporting split-brain, out-of-sync and pri-lost-after-sb
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InstallFenceHandlersResponse) Reset() {
+	*x = InstallFenceHandlersResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallFenceHandlersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallFenceHandlersResponse) ProtoMessage() {}
+
+func (x *InstallFenceHandlersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallFenceHandlersResponse.ProtoReflect.Descriptor instead.
+func (*InstallFenceHandlersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *InstallFenceHandlersResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *InstallFenceHandlersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *InstallFenceHandlersResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *InstallFenceHandlersResponse) GetFailedNodes() []string {
+	if x != nil {
+		return x.FailedNodes
+	}
+	return nil
+}
+
+func (x *InstallFenceHandlersResponse) GetDrbdOptions() map[string]string {
+	if x != nil {
+		return x.DrbdOptions
+	}
+	return nil
+}
+
+func (x *InstallFenceHandlersResponse) GetEventHandlerPath() string {
+	if x != nil {
+		return x.EventHandlerPath
+	}
+	return ""
+}
+
+type ReportDrbdEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`       // node whose handler runs
+	Handler       string                 `protobuf:"bytes,3,opt,name=handler,proto3" json:"handler,omitempty"` // DRBD handler, e.g. split-brain
+	Peer          string                 `protobuf:"bytes,4,opt,name=peer,proto3" json:"peer,omitempty"`
+	PeerAddress   string                 `protobuf:"bytes,5,opt,name=peer_address,json=peerAddress,proto3" json:"peer_address,omitempty"`
+	Volume        string                 `protobuf:"bytes,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportDrbdEventRequest) Reset() {
+	*x = ReportDrbdEventRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportDrbdEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportDrbdEventRequest) ProtoMessage() {}
+
+func (x *ReportDrbdEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportDrbdEventRequest.ProtoReflect.Descriptor instead.
+func (*ReportDrbdEventRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ReportDrbdEventRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReportDrbdEventRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ReportDrbdEventRequest) GetHandler() string {
+	if x != nil {
+		return x.Handler
+	}
+	return ""
+}
+
+func (x *ReportDrbdEventRequest) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *ReportDrbdEventRequest) GetPeerAddress() string {
+	if x != nil {
+		return x.PeerAddress
+	}
+	return ""
+}
+
+func (x *ReportDrbdEventRequest) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+type ReportDrbdEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportDrbdEventResponse) Reset() {
+	*x = ReportDrbdEventResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportDrbdEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportDrbdEventResponse) ProtoMessage() {}
+
+func (x *ReportDrbdEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportDrbdEventResponse.ProtoReflect.Descriptor instead.
+func (*ReportDrbdEventResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ReportDrbdEventResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReportDrbdEventResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type FenceConstraint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`                         // fenced node
+	FencedBy      string                 `protobuf:"bytes,3,opt,name=fenced_by,json=fencedBy,proto3" json:"fenced_by,omitempty"` // node whose handler fenced it
+	Outdated      bool                   `protobuf:"varint,4,opt,name=outdated,proto3" json:"outdated,omitempty"`                // its disk was outdated, not only kept from promotion
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FenceConstraint) Reset() {
+	*x = FenceConstraint{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FenceConstraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FenceConstraint) ProtoMessage() {}
+
+func (x *FenceConstraint) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FenceConstraint.ProtoReflect.Descriptor instead.
+func (*FenceConstraint) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *FenceConstraint) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *FenceConstraint) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *FenceConstraint) GetFencedBy() string {
+	if x != nil {
+		return x.FencedBy
+	}
+	return ""
+}
+
+func (x *FenceConstraint) GetOutdated() bool {
+	if x != nil {
+		return x.Outdated
+	}
+	return false
+}
+
+func (x *FenceConstraint) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListFenceConstraintsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFenceConstraintsRequest) Reset() {
+	*x = ListFenceConstraintsRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFenceConstraintsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFenceConstraintsRequest) ProtoMessage() {}
+
+func (x *ListFenceConstraintsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFenceConstraintsRequest.ProtoReflect.Descriptor instead.
+func (*ListFenceConstraintsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{124}
+}
+
+type ListFenceConstraintsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Constraints   []*FenceConstraint     `protobuf:"bytes,3,rep,name=constraints,proto3" json:"constraints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFenceConstraintsResponse) Reset() {
+	*x = ListFenceConstraintsResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFenceConstraintsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFenceConstraintsResponse) ProtoMessage() {}
+
+func (x *ListFenceConstraintsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFenceConstraintsResponse.ProtoReflect.Descriptor instead.
+func (*ListFenceConstraintsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ListFenceConstraintsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListFenceConstraintsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListFenceConstraintsResponse) GetConstraints() []*FenceConstraint {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+type GetResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceRequest) Reset() {
+	*x = GetResourceRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceRequest) ProtoMessage() {}
+
+func (x *GetResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GetResourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Resource      *ResourceInfo          `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceResponse) Reset() {
+	*x = GetResourceResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceResponse) ProtoMessage() {}
+
+func (x *GetResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceResponse.ProtoReflect.Descriptor instead.
+func (*GetResourceResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetResourceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetResourceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetResourceResponse) GetResource() *ResourceInfo {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+type ListResourcesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResourcesRequest) Reset() {
+	*x = ListResourcesRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcesRequest) ProtoMessage() {}
+
+func (x *ListResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{128}
+}
+
+type ListResourcesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Resources     []*ResourceInfo        `protobuf:"bytes,3,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResourcesResponse) Reset() {
+	*x = ListResourcesResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcesResponse) ProtoMessage() {}
+
+func (x *ListResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ListResourcesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListResourcesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListResourcesResponse) GetResources() []*ResourceInfo {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type AddVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Volume        string                 `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	Pool          string                 `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool,omitempty"`
+	SizeGb        uint32                 `protobuf:"varint,4,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddVolumeRequest) Reset() {
+	*x = AddVolumeRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVolumeRequest) ProtoMessage() {}
+
+func (x *AddVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVolumeRequest.ProtoReflect.Descriptor instead.
+func (*AddVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *AddVolumeRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *AddVolumeRequest) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *AddVolumeRequest) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *AddVolumeRequest) GetSizeGb() uint32 {
+	if x != nil {
+		return x.SizeGb
+	}
+	return 0
+}
+
+type AddVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Volume        *VolumeInfo            `protobuf:"bytes,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddVolumeResponse) Reset() {
+	*x = AddVolumeResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVolumeResponse) ProtoMessage() {}
+
+func (x *AddVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVolumeResponse.ProtoReflect.Descriptor instead.
+func (*AddVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *AddVolumeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddVolumeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddVolumeResponse) GetVolume() *VolumeInfo {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type RemoveVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	VolumeId      uint32                 `protobuf:"varint,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveVolumeRequest) Reset() {
+	*x = RemoveVolumeRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveVolumeRequest) ProtoMessage() {}
+
+func (x *RemoveVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveVolumeRequest.ProtoReflect.Descriptor instead.
+func (*RemoveVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *RemoveVolumeRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *RemoveVolumeRequest) GetVolumeId() uint32 {
+	if x != nil {
+		return x.VolumeId
+	}
+	return 0
+}
+
+type RemoveVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveVolumeResponse) Reset() {
+	*x = RemoveVolumeResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveVolumeResponse) ProtoMessage() {}
+
+func (x *RemoveVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveVolumeResponse.ProtoReflect.Descriptor instead.
+func (*RemoveVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *RemoveVolumeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RemoveVolumeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ResizeVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	VolumeId      uint32                 `protobuf:"varint,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	SizeGb        uint32                 `protobuf:"varint,3,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResizeVolumeRequest) Reset() {
+	*x = ResizeVolumeRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResizeVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeVolumeRequest) ProtoMessage() {}
+
+func (x *ResizeVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeVolumeRequest.ProtoReflect.Descriptor instead.
+func (*ResizeVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ResizeVolumeRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *ResizeVolumeRequest) GetVolumeId() uint32 {
+	if x != nil {
+		return x.VolumeId
+	}
+	return 0
+}
+
+func (x *ResizeVolumeRequest) GetSizeGb() uint32 {
+	if x != nil {
+		return x.SizeGb
+	}
+	return 0
+}
+
+type ResizeVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResizeVolumeResponse) Reset() {
+	*x = ResizeVolumeResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResizeVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeVolumeResponse) ProtoMessage() {}
+
+func (x *ResizeVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeVolumeResponse.ProtoReflect.Descriptor instead.
+func (*ResizeVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ResizeVolumeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResizeVolumeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	VolumeId      uint32                 `protobuf:"varint,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVolumeRequest) Reset() {
+	*x = GetVolumeRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeRequest) ProtoMessage() {}
+
+func (x *GetVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeRequest.ProtoReflect.Descriptor instead.
+func (*GetVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *GetVolumeRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *GetVolumeRequest) GetVolumeId() uint32 {
+	if x != nil {
+		return x.VolumeId
+	}
+	return 0
+}
+
+type GetVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Volume        *VolumeInfo            `protobuf:"bytes,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVolumeResponse) Reset() {
+	*x = GetVolumeResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeResponse) ProtoMessage() {}
+
+func (x *GetVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeResponse.ProtoReflect.Descriptor instead.
+func (*GetVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GetVolumeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetVolumeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetVolumeResponse) GetVolume() *VolumeInfo {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type ListVolumesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"` // optional, all resources if empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolumesRequest) Reset() {
+	*x = ListVolumesRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolumesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolumesRequest) ProtoMessage() {}
+
+func (x *ListVolumesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolumesRequest.ProtoReflect.Descriptor instead.
+func (*ListVolumesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ListVolumesRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+type ListVolumesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Volumes       []*VolumeInfo          `protobuf:"bytes,3,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolumesResponse) Reset() {
+	*x = ListVolumesResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolumesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolumesResponse) ProtoMessage() {}
+
+func (x *ListVolumesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolumesResponse.ProtoReflect.Descriptor instead.
+func (*ListVolumesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ListVolumesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListVolumesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListVolumesResponse) GetVolumes() []*VolumeInfo {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+type ResourceStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceStatusRequest) Reset() {
+	*x = ResourceStatusRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceStatusRequest) ProtoMessage() {}
+
+func (x *ResourceStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceStatusRequest.ProtoReflect.Descriptor instead.
+func (*ResourceStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ResourceStatusRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ResourceStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Status        *ResourceStatus        `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceStatusResponse) Reset() {
+	*x = ResourceStatusResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceStatusResponse) ProtoMessage() {}
+
+func (x *ResourceStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceStatusResponse.ProtoReflect.Descriptor instead.
+func (*ResourceStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ResourceStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResourceStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ResourceStatusResponse) GetStatus() *ResourceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ExportResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportResourceRequest) Reset() {
+	*x = ExportResourceRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResourceRequest) ProtoMessage() {}
+
+func (x *ExportResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResourceRequest.ProtoReflect.Descriptor instead.
+func (*ExportResourceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *ExportResourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ExportResourceResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Success            bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message            string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	DrbdConfigPath     string                 `protobuf:"bytes,3,opt,name=drbd_config_path,json=drbdConfigPath,proto3" json:"drbd_config_path,omitempty"`
+	DrbdConfig         string                 `protobuf:"bytes,4,opt,name=drbd_config,json=drbdConfig,proto3" json:"drbd_config,omitempty"`
+	PromoterConfigPath string                 `protobuf:"bytes,5,opt,name=promoter_config_path,json=promoterConfigPath,proto3" json:"promoter_config_path,omitempty"` // Empty unless HA-managed
+	PromoterConfig     string                 `protobuf:"bytes,6,opt,name=promoter_config,json=promoterConfig,proto3" json:"promoter_config,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ExportResourceResponse) Reset() {
+	*x = ExportResourceResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResourceResponse) ProtoMessage() {}
+
+func (x *ExportResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResourceResponse.ProtoReflect.Descriptor instead.
+func (*ExportResourceResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *ExportResourceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ExportResourceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExportResourceResponse) GetDrbdConfigPath() string {
+	if x != nil {
+		return x.DrbdConfigPath
+	}
+	return ""
+}
+
+func (x *ExportResourceResponse) GetDrbdConfig() string {
+	if x != nil {
+		return x.DrbdConfig
+	}
+	return ""
+}
+
+func (x *ExportResourceResponse) GetPromoterConfigPath() string {
+	if x != nil {
+		return x.PromoterConfigPath
+	}
+	return ""
+}
+
+func (x *ExportResourceResponse) GetPromoterConfig() string {
+	if x != nil {
+		return x.PromoterConfig
+	}
+	return ""
+}
+
+type DiffResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffResourceRequest) Reset() {
+	*x = DiffResourceRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffResourceRequest) ProtoMessage() {}
+
+func (x *DiffResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffResourceRequest.ProtoReflect.Descriptor instead.
+func (*DiffResourceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *DiffResourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ConfigDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"` // identical, differs, missing or unreachable
+	Diff          string                 `protobuf:"bytes,4,opt,name=diff,proto3" json:"diff,omitempty"`   // Changed lines, "-" canonical and "+" deployed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigDiff) Reset() {
+	*x = ConfigDiff{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigDiff) ProtoMessage() {}
+
+func (x *ConfigDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigDiff.ProtoReflect.Descriptor instead.
+func (*ConfigDiff) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *ConfigDiff) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ConfigDiff) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ConfigDiff) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ConfigDiff) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+type DiffResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Diffs         []*ConfigDiff          `protobuf:"bytes,3,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffResourceResponse) Reset() {
+	*x = DiffResourceResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffResourceResponse) ProtoMessage() {}
+
+func (x *DiffResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffResourceResponse.ProtoReflect.Descriptor instead.
+func (*DiffResourceResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *DiffResourceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DiffResourceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DiffResourceResponse) GetDiffs() []*ConfigDiff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+type GetNodeResourceConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeResourceConfigRequest) Reset() {
+	*x = GetNodeResourceConfigRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeResourceConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeResourceConfigRequest) ProtoMessage() {}
+
+func (x *GetNodeResourceConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeResourceConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetNodeResourceConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GetNodeResourceConfigRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *GetNodeResourceConfigRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+// A section of a DRBD config, e.g. resource r0 { ... }
+type DrbdConfigSection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Args          []string               `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	Options       map[string]string      `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // "keyword value;", repeated keywords joined with "; "
+	Sections      []*DrbdConfigSection   `protobuf:"bytes,4,rep,name=sections,proto3" json:"sections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrbdConfigSection) Reset() {
+	*x = DrbdConfigSection{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrbdConfigSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrbdConfigSection) ProtoMessage() {}
+
+func (x *DrbdConfigSection) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrbdConfigSection.ProtoReflect.Descriptor instead.
+func (*DrbdConfigSection) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *DrbdConfigSection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrbdConfigSection) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *DrbdConfigSection) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *DrbdConfigSection) GetSections() []*DrbdConfigSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+type GetNodeResourceConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Node          string                 `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	Running       bool                   `protobuf:"varint,4,opt,name=running,proto3" json:"running,omitempty"`                     // The resource is up on the node
+	Dump          string                 `protobuf:"bytes,5,opt,name=dump,proto3" json:"dump,omitempty"`                            // drbdadm dump: the config file as drbdadm parses it
+	DumpError     string                 `protobuf:"bytes,6,opt,name=dump_error,json=dumpError,proto3" json:"dump_error,omitempty"` // Why drbdadm could not parse the config file
+	Show          string                 `protobuf:"bytes,7,opt,name=show,proto3" json:"show,omitempty"`                            // drbdsetup show: the config loaded into the kernel
+	Configured    *DrbdConfigSection     `protobuf:"bytes,8,opt,name=configured,proto3" json:"configured,omitempty"`                // Parsed dump
+	Effective     *DrbdConfigSection     `protobuf:"bytes,9,opt,name=effective,proto3" json:"effective,omitempty"`                  // Parsed show, unset unless running
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeResourceConfigResponse) Reset() {
+	*x = GetNodeResourceConfigResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeResourceConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeResourceConfigResponse) ProtoMessage() {}
+
+func (x *GetNodeResourceConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeResourceConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeResourceConfigResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *GetNodeResourceConfigResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetNodeResourceConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetNodeResourceConfigResponse) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *GetNodeResourceConfigResponse) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *GetNodeResourceConfigResponse) GetDump() string {
+	if x != nil {
+		return x.Dump
+	}
+	return ""
+}
+
+func (x *GetNodeResourceConfigResponse) GetDumpError() string {
+	if x != nil {
+		return x.DumpError
+	}
+	return ""
+}
+
+func (x *GetNodeResourceConfigResponse) GetShow() string {
+	if x != nil {
+		return x.Show
+	}
+	return ""
+}
+
+func (x *GetNodeResourceConfigResponse) GetConfigured() *DrbdConfigSection {
+	if x != nil {
+		return x.Configured
+	}
+	return nil
+}
+
+func (x *GetNodeResourceConfigResponse) GetEffective() *DrbdConfigSection {
+	if x != nil {
+		return x.Effective
+	}
+	return nil
+}
+
+// RenderConfigRequest gives the inputs of a config generator. Nothing is
+// looked up, reserved or written.
+type RenderConfigRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Kind          string                     `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // drbd (default), promoter, nfs, iscsi or nvmeof
+	Resource      string                     `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	Nodes         []string                   `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"` // Node names, name=address to give the address
+	Port          uint32                     `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`  // DRBD port, 7000 if unset; the minor is port - 7000
+	Protocol      string                     `protobuf:"bytes,5,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	PeerProtocols map[string]string          `protobuf:"bytes,6,rep,name=peer_protocols,json=peerProtocols,proto3" json:"peer_protocols,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Pool          string                     `protobuf:"bytes,7,opt,name=pool,proto3" json:"pool,omitempty"`
+	StorageType   string                     `protobuf:"bytes,8,opt,name=storage_type,json=storageType,proto3" json:"storage_type,omitempty"`                                                                           // lvm or zfs
+	DrbdOptions   map[string]string          `protobuf:"bytes,9,rep,name=drbd_options,json=drbdOptions,proto3" json:"drbd_options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // section/key options over the cluster defaults
+	Volumes       uint32                     `protobuf:"varint,10,opt,name=volumes,proto3" json:"volumes,omitempty"`                                                                                                    // Volumes of the resource, 1 for DRBD and 2 for gateways if unset
+	Services      []string                   `protobuf:"bytes,11,rep,name=services,proto3" json:"services,omitempty"`                                                                                                   // Promoter: systemd services
+	MountPoint    string                     `protobuf:"bytes,12,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`                                                                             // Promoter
+	FsType        string                     `protobuf:"bytes,13,opt,name=fs_type,json=fsType,proto3" json:"fs_type,omitempty"`                                                                                         // Promoter
+	Vip           string                     `protobuf:"bytes,14,opt,name=vip,proto3" json:"vip,omitempty"`                                                                                                             // Promoter
+	DependsOn     []string                   `protobuf:"bytes,15,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`                                                                                // Promoter: resources started first
+	Nfs           *CreateNFSGatewayRequest   `protobuf:"bytes,16,opt,name=nfs,proto3" json:"nfs,omitempty"`                                                                                                             // NFS gateway settings, the resource is taken from above
+	Iscsi         *CreateISCSIGatewayRequest `protobuf:"bytes,17,opt,name=iscsi,proto3" json:"iscsi,omitempty"`
+	Nvmeof        *CreateNVMeGatewayRequest  `protobuf:"bytes,18,opt,name=nvmeof,proto3" json:"nvmeof,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderConfigRequest) Reset() {
+	*x = RenderConfigRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderConfigRequest) ProtoMessage() {}
+
+func (x *RenderConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderConfigRequest.ProtoReflect.Descriptor instead.
+func (*RenderConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *RenderConfigRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RenderConfigRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *RenderConfigRequest) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *RenderConfigRequest) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *RenderConfigRequest) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *RenderConfigRequest) GetPeerProtocols() map[string]string {
+	if x != nil {
+		return x.PeerProtocols
+	}
+	return nil
+}
+
+func (x *RenderConfigRequest) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *RenderConfigRequest) GetStorageType() string {
+	if x != nil {
+		return x.StorageType
+	}
+	return ""
+}
+
+func (x *RenderConfigRequest) GetDrbdOptions() map[string]string {
+	if x != nil {
+		return x.DrbdOptions
+	}
+	return nil
+}
+
+func (x *RenderConfigRequest) GetVolumes() uint32 {
+	if x != nil {
+		return x.Volumes
+	}
+	return 0
+}
+
+func (x *RenderConfigRequest) GetServices() []string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *RenderConfigRequest) GetMountPoint() string {
+	if x != nil {
+		return x.MountPoint
+	}
+	return ""
+}
+
+func (x *RenderConfigRequest) GetFsType() string {
+	if x != nil {
+		return x.FsType
+	}
+	return ""
+}
+
+func (x *RenderConfigRequest) GetVip() string {
+	if x != nil {
+		return x.Vip
+	}
+	return ""
+}
+
+func (x *RenderConfigRequest) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+func (x *RenderConfigRequest) GetNfs() *CreateNFSGatewayRequest {
+	if x != nil {
+		return x.Nfs
+	}
+	return nil
+}
+
+func (x *RenderConfigRequest) GetIscsi() *CreateISCSIGatewayRequest {
+	if x != nil {
+		return x.Iscsi
+	}
+	return nil
+}
+
+func (x *RenderConfigRequest) GetNvmeof() *CreateNVMeGatewayRequest {
+	if x != nil {
+		return x.Nvmeof
+	}
+	return nil
+}
+
+type RenderConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Files         []*PlannedFile         `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"` // The generated files by path, without node and state
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderConfigResponse) Reset() {
+	*x = RenderConfigResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderConfigResponse) ProtoMessage() {}
+
+func (x *RenderConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderConfigResponse.ProtoReflect.Descriptor instead.
+func (*RenderConfigResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *RenderConfigResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RenderConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RenderConfigResponse) GetFiles() []*PlannedFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type SetPrimaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrimaryRequest) Reset() {
+	*x = SetPrimaryRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryRequest) ProtoMessage() {}
+
+func (x *SetPrimaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryRequest.ProtoReflect.Descriptor instead.
+func (*SetPrimaryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *SetPrimaryRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *SetPrimaryRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *SetPrimaryRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type SetPrimaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrimaryResponse) Reset() {
+	*x = SetPrimaryResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryResponse) ProtoMessage() {}
+
+func (x *SetPrimaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryResponse.ProtoReflect.Descriptor instead.
+func (*SetPrimaryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *SetPrimaryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetPrimaryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SetSecondaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSecondaryRequest) Reset() {
+	*x = SetSecondaryRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSecondaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSecondaryRequest) ProtoMessage() {}
+
+func (x *SetSecondaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSecondaryRequest.ProtoReflect.Descriptor instead.
+func (*SetSecondaryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *SetSecondaryRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *SetSecondaryRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type SetSecondaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSecondaryResponse) Reset() {
+	*x = SetSecondaryResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSecondaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSecondaryResponse) ProtoMessage() {}
+
+func (x *SetSecondaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSecondaryResponse.ProtoReflect.Descriptor instead.
+func (*SetSecondaryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *SetSecondaryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetSecondaryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CreateFilesystemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	VolumeId      uint32                 `protobuf:"varint,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	Fstype        string                 `protobuf:"bytes,3,opt,name=fstype,proto3" json:"fstype,omitempty"`
+	Node          string                 `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFilesystemRequest) Reset() {
+	*x = CreateFilesystemRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFilesystemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFilesystemRequest) ProtoMessage() {}
+
+func (x *CreateFilesystemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFilesystemRequest.ProtoReflect.Descriptor instead.
+func (*CreateFilesystemRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *CreateFilesystemRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *CreateFilesystemRequest) GetVolumeId() uint32 {
+	if x != nil {
+		return x.VolumeId
+	}
+	return 0
+}
+
+func (x *CreateFilesystemRequest) GetFstype() string {
+	if x != nil {
+		return x.Fstype
+	}
+	return ""
+}
+
+func (x *CreateFilesystemRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type CreateFilesystemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFilesystemResponse) Reset() {
+	*x = CreateFilesystemResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFilesystemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFilesystemResponse) ProtoMessage() {}
+
+func (x *CreateFilesystemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFilesystemResponse.ProtoReflect.Descriptor instead.
+func (*CreateFilesystemResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *CreateFilesystemResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateFilesystemResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type MountResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	VolumeId      uint32                 `protobuf:"varint,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Node          string                 `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
+	Fstype        string                 `protobuf:"bytes,5,opt,name=fstype,proto3" json:"fstype,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MountResourceRequest) Reset() {
+	*x = MountResourceRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MountResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MountResourceRequest) ProtoMessage() {}
+
+func (x *MountResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MountResourceRequest.ProtoReflect.Descriptor instead.
+func (*MountResourceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *MountResourceRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *MountResourceRequest) GetVolumeId() uint32 {
+	if x != nil {
+		return x.VolumeId
+	}
+	return 0
+}
+
+func (x *MountResourceRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *MountResourceRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *MountResourceRequest) GetFstype() string {
+	if x != nil {
+		return x.Fstype
+	}
+	return ""
+}
+
+type MountResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MountResourceResponse) Reset() {
+	*x = MountResourceResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MountResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MountResourceResponse) ProtoMessage() {}
+
+func (x *MountResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MountResourceResponse.ProtoReflect.Descriptor instead.
+func (*MountResourceResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *MountResourceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MountResourceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UnmountResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	VolumeId      uint32                 `protobuf:"varint,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	Node          string                 `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmountResourceRequest) Reset() {
+	*x = UnmountResourceRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmountResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmountResourceRequest) ProtoMessage() {}
+
+func (x *UnmountResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmountResourceRequest.ProtoReflect.Descriptor instead.
+func (*UnmountResourceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *UnmountResourceRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *UnmountResourceRequest) GetVolumeId() uint32 {
+	if x != nil {
+		return x.VolumeId
+	}
+	return 0
+}
+
+func (x *UnmountResourceRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type UnmountResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmountResourceResponse) Reset() {
+	*x = UnmountResourceResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmountResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmountResourceResponse) ProtoMessage() {}
+
+func (x *UnmountResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmountResourceResponse.ProtoReflect.Descriptor instead.
+func (*UnmountResourceResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *UnmountResourceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnmountResourceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type MakeHaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Services      []string               `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`                       // systemd services to start/stop
+	MountPoint    string                 `protobuf:"bytes,3,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"` // optional mount point
+	Fstype        string                 `protobuf:"bytes,4,opt,name=fstype,proto3" json:"fstype,omitempty"`                           // filesystem type (if mount_point specified)
+	Vip           string                 `protobuf:"bytes,5,opt,name=vip,proto3" json:"vip,omitempty"`                                 // optional virtual IP (CIDR, e.g., "192.168.1.100/24"), "auto" allocates from vip_pool
+	DependsOn     []string               `protobuf:"bytes,6,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`    // HA resources that must be active on the same node first
+	Policy        *HaPolicy              `protobuf:"bytes,7,opt,name=policy,proto3" json:"policy,omitempty"`                           // drbd-reactor failure handling, unset uses the defaults
+	VipPool       string                 `protobuf:"bytes,8,opt,name=vip_pool,json=vipPool,proto3" json:"vip_pool,omitempty"`          // IPAM pool for vip "auto"
+	DryRun        bool                   `protobuf:"varint,9,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`            // only show the files that would be written and their diff
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MakeHaRequest) Reset() {
+	*x = MakeHaRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MakeHaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeHaRequest) ProtoMessage() {}
+
+func (x *MakeHaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeHaRequest.ProtoReflect.Descriptor instead.
+func (*MakeHaRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *MakeHaRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *MakeHaRequest) GetServices() []string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *MakeHaRequest) GetMountPoint() string {
+	if x != nil {
+		return x.MountPoint
+	}
+	return ""
+}
+
+func (x *MakeHaRequest) GetFstype() string {
+	if x != nil {
+		return x.Fstype
+	}
+	return ""
+}
+
+func (x *MakeHaRequest) GetVip() string {
+	if x != nil {
+		return x.Vip
+	}
+	return ""
+}
+
+func (x *MakeHaRequest) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+func (x *MakeHaRequest) GetPolicy() *HaPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *MakeHaRequest) GetVipPool() string {
+	if x != nil {
+		return x.VipPool
+	}
+	return ""
+}
+
+func (x *MakeHaRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// HaPolicy is the drbd-reactor failure handling of an HA resource
+type HaPolicy struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OnDemoteFailure string                 `protobuf:"bytes,1,opt,name=on_demote_failure,json=onDemoteFailure,proto3" json:"on_demote_failure,omitempty"` // systemd action when demoting fails: none, reboot (default), reboot-force,
+	// reboot-immediate, poweroff, poweroff-force, poweroff-immediate, exit, exit-force
+	StopServicesOnExit bool `protobuf:"varint,2,opt,name=stop_services_on_exit,json=stopServicesOnExit,proto3" json:"stop_services_on_exit,omitempty"` // stop the services when drbd-reactor exits
+	SecondaryForce     bool `protobuf:"varint,3,opt,name=secondary_force,json=secondaryForce,proto3" json:"secondary_force,omitempty"`                 // demote with --force after stopping the services (default on)
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *HaPolicy) Reset() {
+	*x = HaPolicy{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HaPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HaPolicy) ProtoMessage() {}
+
+func (x *HaPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HaPolicy.ProtoReflect.Descriptor instead.
+func (*HaPolicy) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *HaPolicy) GetOnDemoteFailure() string {
+	if x != nil {
+		return x.OnDemoteFailure
+	}
+	return ""
+}
+
+func (x *HaPolicy) GetStopServicesOnExit() bool {
+	if x != nil {
+		return x.StopServicesOnExit
+	}
+	return false
+}
+
+func (x *HaPolicy) GetSecondaryForce() bool {
+	if x != nil {
+		return x.SecondaryForce
+	}
+	return false
+}
+
+type MakeHaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ConfigPath    string                 `protobuf:"bytes,3,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"` // path to generated promoter config
+	Vip           string                 `protobuf:"bytes,4,opt,name=vip,proto3" json:"vip,omitempty"`                                 // VIP in use, allocated for "auto"
+	Files         []*PlannedFile         `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`                             // files a dry run would write
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MakeHaResponse) Reset() {
+	*x = MakeHaResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MakeHaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeHaResponse) ProtoMessage() {}
+
+func (x *MakeHaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeHaResponse.ProtoReflect.Descriptor instead.
+func (*MakeHaResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *MakeHaResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MakeHaResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MakeHaResponse) GetConfigPath() string {
+	if x != nil {
+		return x.ConfigPath
+	}
+	return ""
+}
+
+func (x *MakeHaResponse) GetVip() string {
+	if x != nil {
+		return x.Vip
+	}
+	return ""
+}
+
+func (x *MakeHaResponse) GetFiles() []*PlannedFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+// PlannedFile is a file a dry run would write to a node
+type PlannedFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"` // the file as it would be written
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`     // identical, differs, missing or unreachable
+	Diff          string                 `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`       // "-" deployed and "+" planned lines
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlannedFile) Reset() {
+	*x = PlannedFile{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedFile) ProtoMessage() {}
+
+func (x *PlannedFile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedFile.ProtoReflect.Descriptor instead.
+func (*PlannedFile) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *PlannedFile) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *PlannedFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PlannedFile) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PlannedFile) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PlannedFile) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+type UpdateHaRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Resource       string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	AddServices    []string               `protobuf:"bytes,2,rep,name=add_services,json=addServices,proto3" json:"add_services,omitempty"`          // systemd services to add to the start list
+	RemoveServices []string               `protobuf:"bytes,3,rep,name=remove_services,json=removeServices,proto3" json:"remove_services,omitempty"` // systemd services to remove from the start list
+	Vip            string                 `protobuf:"bytes,4,opt,name=vip,proto3" json:"vip,omitempty"`                                             // new VIP, "auto" allocates from vip_pool, "none" removes it, empty keeps it
+	VipPool        string                 `protobuf:"bytes,5,opt,name=vip_pool,json=vipPool,proto3" json:"vip_pool,omitempty"`                      // IPAM pool for vip "auto"
+	DryRun         bool                   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                        // only show the config diff and the planned actions
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateHaRequest) Reset() {
+	*x = UpdateHaRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHaRequest) ProtoMessage() {}
+
+func (x *UpdateHaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHaRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHaRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *UpdateHaRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *UpdateHaRequest) GetAddServices() []string {
+	if x != nil {
+		return x.AddServices
+	}
+	return nil
+}
+
+func (x *UpdateHaRequest) GetRemoveServices() []string {
+	if x != nil {
+		return x.RemoveServices
+	}
+	return nil
+}
+
+func (x *UpdateHaRequest) GetVip() string {
+	if x != nil {
+		return x.Vip
+	}
+	return ""
+}
+
+func (x *UpdateHaRequest) GetVipPool() string {
+	if x != nil {
+		return x.VipPool
+	}
+	return ""
+}
+
+func (x *UpdateHaRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type UpdateHaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Diff          string                 `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`       // promoter config diff, "-" current and "+" new lines
+	Actions       []string               `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"` // actions taken, or planned on a dry run
+	Vip           string                 `protobuf:"bytes,5,opt,name=vip,proto3" json:"vip,omitempty"`         // VIP in use after the update
+	Files         []*PlannedFile         `protobuf:"bytes,6,rep,name=files,proto3" json:"files,omitempty"`     // files a dry run would write
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHaResponse) Reset() {
+	*x = UpdateHaResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHaResponse) ProtoMessage() {}
+
+func (x *UpdateHaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHaResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHaResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *UpdateHaResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateHaResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateHaResponse) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *UpdateHaResponse) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *UpdateHaResponse) GetVip() string {
+	if x != nil {
+		return x.Vip
+	}
+	return ""
+}
+
+func (x *UpdateHaResponse) GetFiles() []*PlannedFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type FailoverHaRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Resource       string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Target         string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`                                        // node name or address to fail over to
+	TimeoutSeconds int64                  `protobuf:"varint,3,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // how long to wait for the target to become Primary, 0 uses the default
+	Force          bool                   `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`                                         // skip the check that the target can take over
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FailoverHaRequest) Reset() {
+	*x = FailoverHaRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailoverHaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailoverHaRequest) ProtoMessage() {}
+
+func (x *FailoverHaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailoverHaRequest.ProtoReflect.Descriptor instead.
+func (*FailoverHaRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *FailoverHaRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *FailoverHaRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *FailoverHaRequest) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *FailoverHaRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type FailoverHaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"` // previous Primary
+	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`     // new Primary
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailoverHaResponse) Reset() {
+	*x = FailoverHaResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailoverHaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailoverHaResponse) ProtoMessage() {}
+
+func (x *FailoverHaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailoverHaResponse.ProtoReflect.Descriptor instead.
+func (*FailoverHaResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *FailoverHaResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *FailoverHaResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FailoverHaResponse) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *FailoverHaResponse) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type EvictHaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"` // skip the check that another node can take over
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvictHaRequest) Reset() {
+	*x = EvictHaRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvictHaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvictHaRequest) ProtoMessage() {}
+
+func (x *EvictHaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvictHaRequest.ProtoReflect.Descriptor instead.
+func (*EvictHaRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *EvictHaRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *EvictHaRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type EvictHaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvictHaResponse) Reset() {
+	*x = EvictHaResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvictHaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvictHaResponse) ProtoMessage() {}
+
+func (x *EvictHaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvictHaResponse.ProtoReflect.Descriptor instead.
+func (*EvictHaResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *EvictHaResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EvictHaResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ResourceInfo struct {
+	state           protoimpl.MessageState        `protogen:"open.v1"`
+	Name            string                        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Port            uint32                        `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Protocol        string                        `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Nodes           []string                      `protobuf:"bytes,4,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Role            string                        `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	Volumes         []*VolumeInfo                 `protobuf:"bytes,6,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	NodeStates      map[string]*NodeResourceState `protobuf:"bytes,7,rep,name=node_states,json=nodeStates,proto3" json:"node_states,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PeerProtocols   map[string]string             `protobuf:"bytes,8,rep,name=peer_protocols,json=peerProtocols,proto3" json:"peer_protocols,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // node name -> protocol, where it differs
+	MaxPeers        uint32                        `protobuf:"varint,9,opt,name=max_peers,json=maxPeers,proto3" json:"max_peers,omitempty"`                                                                                         // metadata peer slots
+	MaxPeersPending []string                      `protobuf:"bytes,10,rep,name=max_peers_pending,json=maxPeersPending,proto3" json:"max_peers_pending,omitempty"`                                                                  // nodes whose metadata still has the previous slots
+	DesiredState    string                        `protobuf:"bytes,11,opt,name=desired_state,json=desiredState,proto3" json:"desired_state,omitempty"`                                                                             // started, or stopped while down on purpose
+	Owner           string                        `protobuf:"bytes,12,opt,name=owner,proto3" json:"owner,omitempty"`                                                                                                               // principal the resource belongs to, empty when shared
+	Health          string                        `protobuf:"bytes,13,opt,name=health,proto3" json:"health,omitempty"`                                                                                                             // Healthy, Degraded or Critical as of the last node poll, empty until polled
+	HealthReasons   []string                      `protobuf:"bytes,14,rep,name=health_reasons,json=healthReasons,proto3" json:"health_reasons,omitempty"`                                                                          // unhealthy replicas, e.g. "node2: Outdated"
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ResourceInfo) Reset() {
+	*x = ResourceInfo{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceInfo) ProtoMessage() {}
+
+func (x *ResourceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceInfo.ProtoReflect.Descriptor instead.
+func (*ResourceInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *ResourceInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceInfo) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ResourceInfo) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *ResourceInfo) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *ResourceInfo) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ResourceInfo) GetVolumes() []*VolumeInfo {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *ResourceInfo) GetNodeStates() map[string]*NodeResourceState {
+	if x != nil {
+		return x.NodeStates
+	}
+	return nil
+}
+
+func (x *ResourceInfo) GetPeerProtocols() map[string]string {
+	if x != nil {
+		return x.PeerProtocols
+	}
+	return nil
+}
+
+func (x *ResourceInfo) GetMaxPeers() uint32 {
+	if x != nil {
+		return x.MaxPeers
+	}
+	return 0
+}
+
+func (x *ResourceInfo) GetMaxPeersPending() []string {
+	if x != nil {
+		return x.MaxPeersPending
+	}
+	return nil
+}
+
+func (x *ResourceInfo) GetDesiredState() string {
+	if x != nil {
+		return x.DesiredState
+	}
+	return ""
+}
+
+func (x *ResourceInfo) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ResourceInfo) GetHealth() string {
+	if x != nil {
+		return x.Health
+	}
+	return ""
+}
+
+func (x *ResourceInfo) GetHealthReasons() []string {
+	if x != nil {
+		return x.HealthReasons
+	}
+	return nil
+}
+
+type ResourceStatus struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Name          string                        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role          string                        `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Nodes         []string                      `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	NodeStates    map[string]*NodeResourceState `protobuf:"bytes,4,rep,name=node_states,json=nodeStates,proto3" json:"node_states,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Volumes       []*VolumeInfo                 `protobuf:"bytes,5,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	IoStats       []*VolumeIOStats              `protobuf:"bytes,6,rep,name=io_stats,json=ioStats,proto3" json:"io_stats,omitempty"` // On the nodes the resource is Primary on
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceStatus) Reset() {
+	*x = ResourceStatus{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceStatus) ProtoMessage() {}
+
+func (x *ResourceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceStatus.ProtoReflect.Descriptor instead.
+func (*ResourceStatus) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *ResourceStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceStatus) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ResourceStatus) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *ResourceStatus) GetNodeStates() map[string]*NodeResourceState {
+	if x != nil {
+		return x.NodeStates
+	}
+	return nil
+}
+
+func (x *ResourceStatus) GetVolumes() []*VolumeInfo {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *ResourceStatus) GetIoStats() []*VolumeIOStats {
+	if x != nil {
+		return x.IoStats
+	}
+	return nil
+}
+
+// VolumeIOStats is the I/O rate of a volume over the last node poll interval
+type VolumeIOStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Node             string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	VolumeId         uint32                 `protobuf:"varint,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	ReadBytesPerSec  float64                `protobuf:"fixed64,3,opt,name=read_bytes_per_sec,json=readBytesPerSec,proto3" json:"read_bytes_per_sec,omitempty"`
+	WriteBytesPerSec float64                `protobuf:"fixed64,4,opt,name=write_bytes_per_sec,json=writeBytesPerSec,proto3" json:"write_bytes_per_sec,omitempty"`
+	ReadIops         float64                `protobuf:"fixed64,5,opt,name=read_iops,json=readIops,proto3" json:"read_iops,omitempty"`
+	WriteIops        float64                `protobuf:"fixed64,6,opt,name=write_iops,json=writeIops,proto3" json:"write_iops,omitempty"`
+	ReadLatencyMs    float64                `protobuf:"fixed64,7,opt,name=read_latency_ms,json=readLatencyMs,proto3" json:"read_latency_ms,omitempty"`
+	WriteLatencyMs   float64                `protobuf:"fixed64,8,opt,name=write_latency_ms,json=writeLatencyMs,proto3" json:"write_latency_ms,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VolumeIOStats) Reset() {
+	*x = VolumeIOStats{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeIOStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeIOStats) ProtoMessage() {}
+
+func (x *VolumeIOStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeIOStats.ProtoReflect.Descriptor instead.
+func (*VolumeIOStats) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *VolumeIOStats) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *VolumeIOStats) GetVolumeId() uint32 {
+	if x != nil {
+		return x.VolumeId
+	}
+	return 0
+}
+
+func (x *VolumeIOStats) GetReadBytesPerSec() float64 {
+	if x != nil {
+		return x.ReadBytesPerSec
+	}
+	return 0
+}
+
+func (x *VolumeIOStats) GetWriteBytesPerSec() float64 {
+	if x != nil {
+		return x.WriteBytesPerSec
+	}
+	return 0
+}
+
+func (x *VolumeIOStats) GetReadIops() float64 {
+	if x != nil {
+		return x.ReadIops
+	}
+	return 0
+}
+
+func (x *VolumeIOStats) GetWriteIops() float64 {
+	if x != nil {
+		return x.WriteIops
+	}
+	return 0
+}
+
+func (x *VolumeIOStats) GetReadLatencyMs() float64 {
+	if x != nil {
+		return x.ReadLatencyMs
+	}
+	return 0
+}
+
+func (x *VolumeIOStats) GetWriteLatencyMs() float64 {
+	if x != nil {
+		return x.WriteLatencyMs
+	}
+	return 0
+}
+
+type NodeResourceState struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Role             string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	DiskState        string                 `protobuf:"bytes,2,opt,name=disk_state,json=diskState,proto3" json:"disk_state,omitempty"`
+	ReplicationState string                 `protobuf:"bytes,3,opt,name=replication_state,json=replicationState,proto3" json:"replication_state,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NodeResourceState) Reset() {
+	*x = NodeResourceState{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeResourceState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeResourceState) ProtoMessage() {}
+
+func (x *NodeResourceState) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeResourceState.ProtoReflect.Descriptor instead.
+func (*NodeResourceState) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *NodeResourceState) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *NodeResourceState) GetDiskState() string {
+	if x != nil {
+		return x.DiskState
+	}
+	return ""
+}
+
+func (x *NodeResourceState) GetReplicationState() string {
+	if x != nil {
+		return x.ReplicationState
+	}
+	return ""
+}
+
+// VolumeInfo describes a DRBD volume. A volume is identified by its resource
+// and volume_id (the DRBD volume number); id is its stable database ID.
+type VolumeInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VolumeId       uint32                 `protobuf:"varint,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	Device         string                 `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	SizeGb         uint64                 `protobuf:"varint,3,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	Id             int64                  `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	Resource       string                 `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	Name           string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"` // backing LV or zvol name
+	Pool           string                 `protobuf:"bytes,7,opt,name=pool,proto3" json:"pool,omitempty"`
+	StorageType    string                 `protobuf:"bytes,8,opt,name=storage_type,json=storageType,proto3" json:"storage_type,omitempty"` // lvm, lvm-thin, zfs or zfs-thin
+	Backing        []*VolumeBacking       `protobuf:"bytes,9,rep,name=backing,proto3" json:"backing,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SizeBytes      uint64                 `protobuf:"varint,11,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`                // provisioned size found on a node, 0 if unknown
+	AllocatedBytes uint64                 `protobuf:"varint,12,opt,name=allocated_bytes,json=allocatedBytes,proto3" json:"allocated_bytes,omitempty"` // allocated space, less than size_bytes for thin volumes
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VolumeInfo) Reset() {
+	*x = VolumeInfo{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeInfo) ProtoMessage() {}
+
+func (x *VolumeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeInfo.ProtoReflect.Descriptor instead.
+func (*VolumeInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *VolumeInfo) GetVolumeId() uint32 {
+	if x != nil {
+		return x.VolumeId
+	}
+	return 0
+}
+
+func (x *VolumeInfo) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetSizeGb() uint64 {
+	if x != nil {
+		return x.SizeGb
+	}
+	return 0
+}
+
+func (x *VolumeInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VolumeInfo) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetStorageType() string {
+	if x != nil {
+		return x.StorageType
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetBacking() []*VolumeBacking {
+	if x != nil {
+		return x.Backing
+	}
+	return nil
+}
+
+func (x *VolumeInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *VolumeInfo) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *VolumeInfo) GetAllocatedBytes() uint64 {
+	if x != nil {
+		return x.AllocatedBytes
+	}
+	return 0
+}
+
+// VolumeBacking is the backing block device of a volume on one node
+type VolumeBacking struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Device        string                 `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeBacking) Reset() {
+	*x = VolumeBacking{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeBacking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeBacking) ProtoMessage() {}
+
+func (x *VolumeBacking) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeBacking.ProtoReflect.Descriptor instead.
+func (*VolumeBacking) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *VolumeBacking) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *VolumeBacking) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+// Snapshot messages
+// volume is a resource name (or an LVM vg/lv path); an empty node selects
+// all nodes of the resource
+type CreateSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        string                 `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	SnapshotName  string                 `protobuf:"bytes,2,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"`
+	Node          string                 `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	Size          string                 `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`                             // COW size of thick LVM snapshots (e.g., "1G"), sized from the origin and its change rate if empty
+	SkipHooks     bool                   `protobuf:"varint,5,opt,name=skip_hooks,json=skipHooks,proto3" json:"skip_hooks,omitempty"` // Do not run the snapshot hooks of the resource
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSnapshotRequest) Reset() {
+	*x = CreateSnapshotRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSnapshotRequest) ProtoMessage() {}
+
+func (x *CreateSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *CreateSnapshotRequest) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *CreateSnapshotRequest) GetSnapshotName() string {
+	if x != nil {
+		return x.SnapshotName
+	}
+	return ""
+}
+
+func (x *CreateSnapshotRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *CreateSnapshotRequest) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *CreateSnapshotRequest) GetSkipHooks() bool {
+	if x != nil {
+		return x.SkipHooks
+	}
+	return false
+}
+
+type CreateSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSnapshotResponse) Reset() {
+	*x = CreateSnapshotResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSnapshotResponse) ProtoMessage() {}
+
+func (x *CreateSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *CreateSnapshotResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateSnapshotResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        string                 `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	SnapshotName  string                 `protobuf:"bytes,2,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"`
+	Node          string                 `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSnapshotRequest) Reset() {
+	*x = DeleteSnapshotRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSnapshotRequest) ProtoMessage() {}
+
+func (x *DeleteSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *DeleteSnapshotRequest) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *DeleteSnapshotRequest) GetSnapshotName() string {
+	if x != nil {
+		return x.SnapshotName
+	}
+	return ""
+}
+
+func (x *DeleteSnapshotRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type DeleteSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSnapshotResponse) Reset() {
+	*x = DeleteSnapshotResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSnapshotResponse) ProtoMessage() {}
+
+func (x *DeleteSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *DeleteSnapshotResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteSnapshotResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RestoreSnapshotRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Volume           string                 `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	SnapshotName     string                 `protobuf:"bytes,2,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"`
+	Node             string                 `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	NoSafetySnapshot bool                   `protobuf:"varint,4,opt,name=no_safety_snapshot,json=noSafetySnapshot,proto3" json:"no_safety_snapshot,omitempty"` // skip the automatic safety snapshot
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RestoreSnapshotRequest) Reset() {
+	*x = RestoreSnapshotRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSnapshotRequest) ProtoMessage() {}
+
+func (x *RestoreSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*RestoreSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *RestoreSnapshotRequest) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *RestoreSnapshotRequest) GetSnapshotName() string {
+	if x != nil {
+		return x.SnapshotName
+	}
+	return ""
+}
+
+func (x *RestoreSnapshotRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *RestoreSnapshotRequest) GetNoSafetySnapshot() bool {
+	if x != nil {
+		return x.NoSafetySnapshot
+	}
+	return false
+}
+
+type RestoreSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreSnapshotResponse) Reset() {
+	*x = RestoreSnapshotResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSnapshotResponse) ProtoMessage() {}
+
+func (x *RestoreSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*RestoreSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *RestoreSnapshotResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RestoreSnapshotResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListSnapshotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        string                 `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSnapshotsRequest) Reset() {
+	*x = ListSnapshotsRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *ListSnapshotsRequest) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *ListSnapshotsRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type ListSnapshotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Snapshots     []*SnapshotInfo        `protobuf:"bytes,3,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSnapshotsResponse) Reset() {
+	*x = ListSnapshotsResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *ListSnapshotsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListSnapshotsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListSnapshotsResponse) GetSnapshots() []*SnapshotInfo {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type SnapshotInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Volume        string                 `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	SizeGb        uint64                 `protobuf:"varint,3,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Node          string                 `protobuf:"bytes,5,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotInfo) Reset() {
+	*x = SnapshotInfo{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotInfo) ProtoMessage() {}
+
+func (x *SnapshotInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotInfo.ProtoReflect.Descriptor instead.
+func (*SnapshotInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *SnapshotInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SnapshotInfo) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *SnapshotInfo) GetSizeGb() uint64 {
+	if x != nil {
+		return x.SizeGb
+	}
+	return 0
+}
+
+func (x *SnapshotInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SnapshotInfo) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type CreateSnapshotGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Label shared by the snapshots, e.g. nightly
+	Resources     []string               `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty"`
+	Node          string                 `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`                             // Empty for all nodes of each resource
+	Size          string                 `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`                             // COW size of thick LVM snapshots, sized per volume if empty
+	SkipHooks     bool                   `protobuf:"varint,5,opt,name=skip_hooks,json=skipHooks,proto3" json:"skip_hooks,omitempty"` // Do not run the snapshot hooks of the resources
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSnapshotGroupRequest) Reset() {
+	*x = CreateSnapshotGroupRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSnapshotGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSnapshotGroupRequest) ProtoMessage() {}
+
+func (x *CreateSnapshotGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSnapshotGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *CreateSnapshotGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSnapshotGroupRequest) GetResources() []string {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *CreateSnapshotGroupRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *CreateSnapshotGroupRequest) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *CreateSnapshotGroupRequest) GetSkipHooks() bool {
+	if x != nil {
+		return x.SkipHooks
+	}
+	return false
+}
+
+type DeleteSnapshotGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSnapshotGroupRequest) Reset() {
+	*x = DeleteSnapshotGroupRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSnapshotGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSnapshotGroupRequest) ProtoMessage() {}
+
+func (x *DeleteSnapshotGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSnapshotGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *DeleteSnapshotGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RestoreSnapshotGroupRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NoSafetySnapshot bool                   `protobuf:"varint,2,opt,name=no_safety_snapshot,json=noSafetySnapshot,proto3" json:"no_safety_snapshot,omitempty"` // skip the automatic safety snapshots
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RestoreSnapshotGroupRequest) Reset() {
+	*x = RestoreSnapshotGroupRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreSnapshotGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSnapshotGroupRequest) ProtoMessage() {}
+
+func (x *RestoreSnapshotGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSnapshotGroupRequest.ProtoReflect.Descriptor instead.
+func (*RestoreSnapshotGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *RestoreSnapshotGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RestoreSnapshotGroupRequest) GetNoSafetySnapshot() bool {
+	if x != nil {
+		return x.NoSafetySnapshot
+	}
+	return false
+}
+
+// SnapshotGroupResult is the outcome of a snapshot group operation on one resource
+type SnapshotGroupResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Snapshot      string                 `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"` // <resource>_<label>
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotGroupResult) Reset() {
+	*x = SnapshotGroupResult{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotGroupResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotGroupResult) ProtoMessage() {}
+
+func (x *SnapshotGroupResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotGroupResult.ProtoReflect.Descriptor instead.
+func (*SnapshotGroupResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *SnapshotGroupResult) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *SnapshotGroupResult) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+func (x *SnapshotGroupResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SnapshotGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Succeeded on every resource
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Results       []*SnapshotGroupResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotGroupResponse) Reset() {
+	*x = SnapshotGroupResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotGroupResponse) ProtoMessage() {}
+
+func (x *SnapshotGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotGroupResponse.ProtoReflect.Descriptor instead.
+func (*SnapshotGroupResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *SnapshotGroupResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SnapshotGroupResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SnapshotGroupResponse) GetResults() []*SnapshotGroupResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SnapshotGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Resources     []string               `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty"`                   // Resources that have the snapshot
+	Node          string                 `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`                             // Empty for all nodes of each resource
+	JobId         int64                  `protobuf:"varint,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`             // Job that took the snapshots
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotGroup) Reset() {
+	*x = SnapshotGroup{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotGroup) ProtoMessage() {}
+
+func (x *SnapshotGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotGroup.ProtoReflect.Descriptor instead.
+func (*SnapshotGroup) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *SnapshotGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SnapshotGroup) GetResources() []string {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *SnapshotGroup) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *SnapshotGroup) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *SnapshotGroup) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListSnapshotGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSnapshotGroupsRequest) Reset() {
+	*x = ListSnapshotGroupsRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSnapshotGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotGroupsRequest) ProtoMessage() {}
+
+func (x *ListSnapshotGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListSnapshotGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{193}
+}
+
+type ListSnapshotGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Groups        []*SnapshotGroup       `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSnapshotGroupsResponse) Reset() {
+	*x = ListSnapshotGroupsResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSnapshotGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotGroupsResponse) ProtoMessage() {}
+
+func (x *ListSnapshotGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListSnapshotGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *ListSnapshotGroupsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListSnapshotGroupsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListSnapshotGroupsResponse) GetGroups() []*SnapshotGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GetSnapshotUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        string                 `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSnapshotUsageRequest) Reset() {
+	*x = GetSnapshotUsageRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSnapshotUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSnapshotUsageRequest) ProtoMessage() {}
+
+func (x *GetSnapshotUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSnapshotUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetSnapshotUsageRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *GetSnapshotUsageRequest) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *GetSnapshotUsageRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type GetSnapshotUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Usage         []*SnapshotUsageInfo   `protobuf:"bytes,3,rep,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSnapshotUsageResponse) Reset() {
+	*x = GetSnapshotUsageResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSnapshotUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSnapshotUsageResponse) ProtoMessage() {}
+
+func (x *GetSnapshotUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[196]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSnapshotUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetSnapshotUsageResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *GetSnapshotUsageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetSnapshotUsageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetSnapshotUsageResponse) GetUsage() []*SnapshotUsageInfo {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// SnapshotHook quiesces the application of a resource on its Primary around
+// snapshots: pre runs before the snapshot, post releases it after
+type SnapshotHook struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pre            string                 `protobuf:"bytes,2,opt,name=pre,proto3" json:"pre,omitempty"`
+	Post           string                 `protobuf:"bytes,3,opt,name=post,proto3" json:"post,omitempty"`
+	TimeoutSeconds uint32                 `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // Of each command, 0 for 60
+	OnFailure      string                 `protobuf:"bytes,5,opt,name=on_failure,json=onFailure,proto3" json:"on_failure,omitempty"`                 // abort (default) or continue
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SnapshotHook) Reset() {
+	*x = SnapshotHook{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[197]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotHook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotHook) ProtoMessage() {}
+
+func (x *SnapshotHook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[197]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotHook.ProtoReflect.Descriptor instead.
+func (*SnapshotHook) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *SnapshotHook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SnapshotHook) GetPre() string {
+	if x != nil {
+		return x.Pre
+	}
+	return ""
+}
+
+func (x *SnapshotHook) GetPost() string {
+	if x != nil {
+		return x.Post
+	}
+	return ""
+}
+
+func (x *SnapshotHook) GetTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *SnapshotHook) GetOnFailure() string {
+	if x != nil {
+		return x.OnFailure
+	}
+	return ""
+}
+
+type SetSnapshotHookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Hook          *SnapshotHook          `protobuf:"bytes,3,opt,name=hook,proto3" json:"hook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSnapshotHookRequest) Reset() {
+	*x = SetSnapshotHookRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSnapshotHookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSnapshotHookRequest) ProtoMessage() {}
+
+func (x *SetSnapshotHookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSnapshotHookRequest.ProtoReflect.Descriptor instead.
+func (*SetSnapshotHookRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *SetSnapshotHookRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *SetSnapshotHookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetSnapshotHookRequest) GetHook() *SnapshotHook {
+	if x != nil {
+		return x.Hook
+	}
+	return nil
+}
+
+type SetSnapshotHookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSnapshotHookResponse) Reset() {
+	*x = SetSnapshotHookResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSnapshotHookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSnapshotHookResponse) ProtoMessage() {}
+
+func (x *SetSnapshotHookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSnapshotHookResponse.ProtoReflect.Descriptor instead.
+func (*SetSnapshotHookResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *SetSnapshotHookResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetSnapshotHookResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteSnapshotHookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSnapshotHookRequest) Reset() {
+	*x = DeleteSnapshotHookRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[200]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSnapshotHookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSnapshotHookRequest) ProtoMessage() {}
+
+func (x *DeleteSnapshotHookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[200]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSnapshotHookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotHookRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *DeleteSnapshotHookRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *DeleteSnapshotHookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteSnapshotHookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSnapshotHookResponse) Reset() {
+	*x = DeleteSnapshotHookResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[201]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSnapshotHookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSnapshotHookResponse) ProtoMessage() {}
+
+func (x *DeleteSnapshotHookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[201]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSnapshotHookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotHookResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *DeleteSnapshotHookResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteSnapshotHookResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListSnapshotHooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSnapshotHooksRequest) Reset() {
+	*x = ListSnapshotHooksRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[202]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSnapshotHooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotHooksRequest) ProtoMessage() {}
+
+func (x *ListSnapshotHooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[202]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotHooksRequest.ProtoReflect.Descriptor instead.
+func (*ListSnapshotHooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *ListSnapshotHooksRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+type ListSnapshotHooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Hooks         []*SnapshotHook        `protobuf:"bytes,3,rep,name=hooks,proto3" json:"hooks,omitempty"` // In the order they run
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSnapshotHooksResponse) Reset() {
+	*x = ListSnapshotHooksResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[203]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSnapshotHooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotHooksResponse) ProtoMessage() {}
+
+func (x *ListSnapshotHooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[203]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotHooksResponse.ProtoReflect.Descriptor instead.
+func (*ListSnapshotHooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *ListSnapshotHooksResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListSnapshotHooksResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListSnapshotHooksResponse) GetHooks() []*SnapshotHook {
+	if x != nil {
+		return x.Hooks
+	}
+	return nil
+}
+
+// ReplicationPolicy sends the snapshots of a resource incrementally to a
+// backup node on a schedule
+type ReplicationPolicy struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Resource          string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	TargetNode        string                 `protobuf:"bytes,2,opt,name=target_node,json=targetNode,proto3" json:"target_node,omitempty"` // May be outside the replica set
+	TargetPool        string                 `protobuf:"bytes,3,opt,name=target_pool,json=targetPool,proto3" json:"target_pool,omitempty"` // ZFS pool or dataset the volumes are received into
+	IntervalSeconds   int64                  `protobuf:"varint,4,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	Keep              uint32                 `protobuf:"varint,5,opt,name=keep,proto3" json:"keep,omitempty"`                                                      // Replicated snapshots kept on the target, 0 for 7
+	StaleAfterSeconds int64                  `protobuf:"varint,6,opt,name=stale_after_seconds,json=staleAfterSeconds,proto3" json:"stale_after_seconds,omitempty"` // 0 for three intervals
+	SourceNode        string                 `protobuf:"bytes,7,opt,name=source_node,json=sourceNode,proto3" json:"source_node,omitempty"`                         // Holds the base of the next increment
+	LastSnapshot      string                 `protobuf:"bytes,8,opt,name=last_snapshot,json=lastSnapshot,proto3" json:"last_snapshot,omitempty"`
+	LastAttempt       int64                  `protobuf:"varint,9,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt,omitempty"`  // Unix timestamp, 0 before the first run
+	LastSuccess       int64                  `protobuf:"varint,10,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"` // Unix timestamp, 0 before the first success
+	LastError         string                 `protobuf:"bytes,11,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`        // Of the last run, empty if it succeeded
+	Stale             bool                   `protobuf:"varint,12,opt,name=stale,proto3" json:"stale,omitempty"`                                // No success within stale_after
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReplicationPolicy) Reset() {
+	*x = ReplicationPolicy{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[204]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplicationPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicationPolicy) ProtoMessage() {}
+
+func (x *ReplicationPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[204]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicationPolicy.ProtoReflect.Descriptor instead.
+func (*ReplicationPolicy) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *ReplicationPolicy) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *ReplicationPolicy) GetTargetNode() string {
+	if x != nil {
+		return x.TargetNode
+	}
+	return ""
+}
+
+func (x *ReplicationPolicy) GetTargetPool() string {
+	if x != nil {
+		return x.TargetPool
+	}
+	return ""
+}
+
+func (x *ReplicationPolicy) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *ReplicationPolicy) GetKeep() uint32 {
+	if x != nil {
+		return x.Keep
+	}
+	return 0
+}
+
+func (x *ReplicationPolicy) GetStaleAfterSeconds() int64 {
+	if x != nil {
+		return x.StaleAfterSeconds
+	}
+	return 0
+}
+
+func (x *ReplicationPolicy) GetSourceNode() string {
+	if x != nil {
+		return x.SourceNode
+	}
+	return ""
+}
+
+func (x *ReplicationPolicy) GetLastSnapshot() string {
+	if x != nil {
+		return x.LastSnapshot
+	}
+	return ""
+}
+
+func (x *ReplicationPolicy) GetLastAttempt() int64 {
+	if x != nil {
+		return x.LastAttempt
+	}
+	return 0
+}
+
+func (x *ReplicationPolicy) GetLastSuccess() int64 {
+	if x != nil {
+		return x.LastSuccess
+	}
+	return 0
+}
+
+func (x *ReplicationPolicy) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ReplicationPolicy) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+type SetReplicationPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Policy        *ReplicationPolicy     `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReplicationPolicyRequest) Reset() {
+	*x = SetReplicationPolicyRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[205]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReplicationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReplicationPolicyRequest) ProtoMessage() {}
+
+func (x *SetReplicationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[205]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReplicationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetReplicationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{205}
+}
+
+func (x *SetReplicationPolicyRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *SetReplicationPolicyRequest) GetPolicy() *ReplicationPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SetReplicationPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Warnings      []string               `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReplicationPolicyResponse) Reset() {
+	*x = SetReplicationPolicyResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[206]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReplicationPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReplicationPolicyResponse) ProtoMessage() {}
+
+func (x *SetReplicationPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[206]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReplicationPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetReplicationPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{206}
+}
+
+func (x *SetReplicationPolicyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetReplicationPolicyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetReplicationPolicyResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type DeleteReplicationPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReplicationPolicyRequest) Reset() {
+	*x = DeleteReplicationPolicyRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[207]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReplicationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReplicationPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteReplicationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[207]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReplicationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReplicationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{207}
+}
+
+func (x *DeleteReplicationPolicyRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+type DeleteReplicationPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReplicationPolicyResponse) Reset() {
+	*x = DeleteReplicationPolicyResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[208]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReplicationPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReplicationPolicyResponse) ProtoMessage() {}
+
+func (x *DeleteReplicationPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[208]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReplicationPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReplicationPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *DeleteReplicationPolicyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteReplicationPolicyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListReplicationPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"` // optional, all resources if empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReplicationPoliciesRequest) Reset() {
+	*x = ListReplicationPoliciesRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[209]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReplicationPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReplicationPoliciesRequest) ProtoMessage() {}
+
+func (x *ListReplicationPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[209]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReplicationPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListReplicationPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{209}
+}
+
+func (x *ListReplicationPoliciesRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+type ListReplicationPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Policies      []*ReplicationPolicy   `protobuf:"bytes,3,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReplicationPoliciesResponse) Reset() {
+	*x = ListReplicationPoliciesResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[210]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReplicationPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReplicationPoliciesResponse) ProtoMessage() {}
+
+func (x *ListReplicationPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[210]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReplicationPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListReplicationPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{210}
+}
+
+func (x *ListReplicationPoliciesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListReplicationPoliciesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListReplicationPoliciesResponse) GetPolicies() []*ReplicationPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type RunReplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Full          bool                   `protobuf:"varint,2,opt,name=full,proto3" json:"full,omitempty"` // Replace the copy on the target with a full one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunReplicationRequest) Reset() {
+	*x = RunReplicationRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[211]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunReplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunReplicationRequest) ProtoMessage() {}
+
+func (x *RunReplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[211]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunReplicationRequest.ProtoReflect.Descriptor instead.
+func (*RunReplicationRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{211}
+}
+
+func (x *RunReplicationRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *RunReplicationRequest) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+type RunReplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Policy        *ReplicationPolicy     `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunReplicationResponse) Reset() {
+	*x = RunReplicationResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[212]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunReplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunReplicationResponse) ProtoMessage() {}
+
+func (x *RunReplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[212]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunReplicationResponse.ProtoReflect.Descriptor instead.
+func (*RunReplicationResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{212}
+}
+
+func (x *RunReplicationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RunReplicationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RunReplicationResponse) GetPolicy() *ReplicationPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// SnapshotUsageInfo is the space accounting of one snapshot on one node
+type SnapshotUsageInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Volume          string                 `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	Node            string                 `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	Backend         string                 `protobuf:"bytes,4,opt,name=backend,proto3" json:"backend,omitempty"`                                         // lvm or zfs
+	UsedBytes       uint64                 `protobuf:"varint,5,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`                   // space unique to the snapshot (ZFS used, LVM allocated COW)
+	ReferencedBytes uint64                 `protobuf:"varint,6,opt,name=referenced_bytes,json=referencedBytes,proto3" json:"referenced_bytes,omitempty"` // data referenced by the snapshot (ZFS refer, LVM origin size)
+	SizeBytes       uint64                 `protobuf:"varint,7,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`                   // COW size of thick LVM snapshots, 0 otherwise
+	CowUsedPercent  float64                `protobuf:"fixed64,8,opt,name=cow_used_percent,json=cowUsedPercent,proto3" json:"cow_used_percent,omitempty"` // allocated percentage of the COW volume of thick LVM snapshots
+	State           string                 `protobuf:"bytes,9,opt,name=state,proto3" json:"state,omitempty"`                                             // ok, warning, critical or invalid
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SnapshotUsageInfo) Reset() {
+	*x = SnapshotUsageInfo{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[213]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotUsageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotUsageInfo) ProtoMessage() {}
+
+func (x *SnapshotUsageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[213]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotUsageInfo.ProtoReflect.Descriptor instead.
+func (*SnapshotUsageInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{213}
+}
+
+func (x *SnapshotUsageInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SnapshotUsageInfo) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *SnapshotUsageInfo) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *SnapshotUsageInfo) GetBackend() string {
+	if x != nil {
+		return x.Backend
+	}
+	return ""
+}
+
+func (x *SnapshotUsageInfo) GetUsedBytes() uint64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *SnapshotUsageInfo) GetReferencedBytes() uint64 {
+	if x != nil {
+		return x.ReferencedBytes
+	}
+	return 0
+}
+
+func (x *SnapshotUsageInfo) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *SnapshotUsageInfo) GetCowUsedPercent() float64 {
+	if x != nil {
+		return x.CowUsedPercent
+	}
+	return 0
+}
+
+func (x *SnapshotUsageInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// Gateway messages
+type CreateNFSGatewayRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Resource           string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`                                                                         // DRBD resource name
+	ServiceIp          string                 `protobuf:"bytes,2,opt,name=service_ip,json=serviceIp,proto3" json:"service_ip,omitempty"`                                                      // Service IP (e.g., "192.168.1.200/24")
+	ExportPath         string                 `protobuf:"bytes,3,opt,name=export_path,json=exportPath,proto3" json:"export_path,omitempty"`                                                   // Base export path (e.g., "/data")
+	AllowedIps         []string               `protobuf:"bytes,4,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`                                                   // Allowed client IPs (e.g., ["192.168.1.0/24"])
+	FsType             string                 `protobuf:"bytes,5,opt,name=fs_type,json=fsType,proto3" json:"fs_type,omitempty"`                                                               // Filesystem type (ext4, xfs)
+	Options            map[string]string      `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Additional options
+	ServiceIpPool      string                 `protobuf:"bytes,7,opt,name=service_ip_pool,json=serviceIpPool,proto3" json:"service_ip_pool,omitempty"`                                        // IPAM pool for service_ip "auto"
+	ExportBasePath     string                 `protobuf:"bytes,8,opt,name=export_base_path,json=exportBasePath,proto3" json:"export_base_path,omitempty"`                                     // Directory the exports are mounted under, the controller's default if empty
+	ClusterPrivatePath string                 `protobuf:"bytes,9,opt,name=cluster_private_path,json=clusterPrivatePath,proto3" json:"cluster_private_path,omitempty"`                         // Directory the cluster-private volume is mounted under, the controller's default if empty
+	Interface          string                 `protobuf:"bytes,10,opt,name=interface,proto3" json:"interface,omitempty"`                                                                      // Interface of the tenant network the VIP is bound to, or the parent of its VLAN
+	Vlan               uint32                 `protobuf:"varint,11,opt,name=vlan,proto3" json:"vlan,omitempty"`                                                                               // VLAN of the tenant network on interface, 0 for none
+	Router             string                 `protobuf:"bytes,12,opt,name=router,proto3" json:"router,omitempty"`                                                                            // Router of the tenant network for clients on other subnets
+	DryRun             bool                   `protobuf:"varint,13,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                                                             // Only show the files that would be written and their diff
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateNFSGatewayRequest) Reset() {
+	*x = CreateNFSGatewayRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[214]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNFSGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNFSGatewayRequest) ProtoMessage() {}
+
+func (x *CreateNFSGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[214]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNFSGatewayRequest.ProtoReflect.Descriptor instead.
+func (*CreateNFSGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{214}
+}
+
+func (x *CreateNFSGatewayRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *CreateNFSGatewayRequest) GetServiceIp() string {
+	if x != nil {
+		return x.ServiceIp
+	}
+	return ""
+}
+
+func (x *CreateNFSGatewayRequest) GetExportPath() string {
+	if x != nil {
+		return x.ExportPath
+	}
+	return ""
+}
+
+func (x *CreateNFSGatewayRequest) GetAllowedIps() []string {
+	if x != nil {
+		return x.AllowedIps
+	}
+	return nil
+}
+
+func (x *CreateNFSGatewayRequest) GetFsType() string {
+	if x != nil {
+		return x.FsType
+	}
+	return ""
+}
+
+func (x *CreateNFSGatewayRequest) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateNFSGatewayRequest) GetServiceIpPool() string {
+	if x != nil {
+		return x.ServiceIpPool
+	}
+	return ""
+}
+
+func (x *CreateNFSGatewayRequest) GetExportBasePath() string {
+	if x != nil {
+		return x.ExportBasePath
+	}
+	return ""
+}
+
+func (x *CreateNFSGatewayRequest) GetClusterPrivatePath() string {
+	if x != nil {
+		return x.ClusterPrivatePath
+	}
+	return ""
+}
+
+func (x *CreateNFSGatewayRequest) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *CreateNFSGatewayRequest) GetVlan() uint32 {
+	if x != nil {
+		return x.Vlan
+	}
+	return 0
+}
+
+func (x *CreateNFSGatewayRequest) GetRouter() string {
+	if x != nil {
+		return x.Router
+	}
+	return ""
+}
+
+func (x *CreateNFSGatewayRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type CreateNFSGatewayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ConfigPath    string                 `protobuf:"bytes,3,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"` // Path to generated config file
+	ServiceIp     string                 `protobuf:"bytes,4,opt,name=service_ip,json=serviceIp,proto3" json:"service_ip,omitempty"`    // Service IP in use, allocated for "auto"
+	Files         []*PlannedFile         `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`                             // Files a dry run would write, per node
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNFSGatewayResponse) Reset() {
+	*x = CreateNFSGatewayResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[215]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNFSGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNFSGatewayResponse) ProtoMessage() {}
+
+func (x *CreateNFSGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[215]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNFSGatewayResponse.ProtoReflect.Descriptor instead.
+func (*CreateNFSGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{215}
+}
+
+func (x *CreateNFSGatewayResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateNFSGatewayResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateNFSGatewayResponse) GetConfigPath() string {
+	if x != nil {
+		return x.ConfigPath
+	}
+	return ""
+}
+
+func (x *CreateNFSGatewayResponse) GetServiceIp() string {
+	if x != nil {
+		return x.ServiceIp
+	}
+	return ""
+}
+
+func (x *CreateNFSGatewayResponse) GetFiles() []*PlannedFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type CreateISCSIGatewayRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Resource           string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`                                                                         // DRBD resource name
+	ServiceIp          string                 `protobuf:"bytes,2,opt,name=service_ip,json=serviceIp,proto3" json:"service_ip,omitempty"`                                                      // Service IP (e.g., "192.168.1.100/24")
+	Iqn                string                 `protobuf:"bytes,3,opt,name=iqn,proto3" json:"iqn,omitempty"`                                                                                   // iSCSI Qualified Name
+	AllowedInitiators  []string               `protobuf:"bytes,4,rep,name=allowed_initiators,json=allowedInitiators,proto3" json:"allowed_initiators,omitempty"`                              // Allowed initiator IQNs
+	Username           string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`                                                                         // CHAP username (optional)
+	Password           string                 `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`                                                                         // CHAP password (optional)
+	Implementation     string                 `protobuf:"bytes,7,opt,name=implementation,proto3" json:"implementation,omitempty"`                                                             // iSCSI implementation (lio, tgt, iet)
+	Options            map[string]string      `protobuf:"bytes,8,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Additional options
+	ServiceIpPool      string                 `protobuf:"bytes,9,opt,name=service_ip_pool,json=serviceIpPool,proto3" json:"service_ip_pool,omitempty"`                                        // IPAM pool for service_ip "auto"
+	ClusterPrivatePath string                 `protobuf:"bytes,10,opt,name=cluster_private_path,json=clusterPrivatePath,proto3" json:"cluster_private_path,omitempty"`                        // Directory the cluster-private volume is mounted under, the controller's default if empty
+	Interface          string                 `protobuf:"bytes,11,opt,name=interface,proto3" json:"interface,omitempty"`                                                                      // Interface of the tenant network the VIP is bound to, or the parent of its VLAN
+	Vlan               uint32                 `protobuf:"varint,12,opt,name=vlan,proto3" json:"vlan,omitempty"`                                                                               // VLAN of the tenant network on interface, 0 for none
+	Router             string                 `protobuf:"bytes,13,opt,name=router,proto3" json:"router,omitempty"`                                                                            // Router of the tenant network for clients on other subnets
+	DryRun             bool                   `protobuf:"varint,14,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                                                             // Only show the files that would be written and their diff
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateISCSIGatewayRequest) Reset() {
+	*x = CreateISCSIGatewayRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[216]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateISCSIGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateISCSIGatewayRequest) ProtoMessage() {}
+
+func (x *CreateISCSIGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[216]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateISCSIGatewayRequest.ProtoReflect.Descriptor instead.
+func (*CreateISCSIGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{216}
+}
+
+func (x *CreateISCSIGatewayRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *CreateISCSIGatewayRequest) GetServiceIp() string {
+	if x != nil {
+		return x.ServiceIp
+	}
+	return ""
+}
+
+func (x *CreateISCSIGatewayRequest) GetIqn() string {
+	if x != nil {
+		return x.Iqn
+	}
+	return ""
+}
+
+func (x *CreateISCSIGatewayRequest) GetAllowedInitiators() []string {
+	if x != nil {
+		return x.AllowedInitiators
+	}
+	return nil
+}
+
+func (x *CreateISCSIGatewayRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateISCSIGatewayRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateISCSIGatewayRequest) GetImplementation() string {
+	if x != nil {
+		return x.Implementation
+	}
+	return ""
+}
+
+func (x *CreateISCSIGatewayRequest) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateISCSIGatewayRequest) GetServiceIpPool() string {
+	if x != nil {
+		return x.ServiceIpPool
+	}
+	return ""
+}
+
+func (x *CreateISCSIGatewayRequest) GetClusterPrivatePath() string {
+	if x != nil {
+		return x.ClusterPrivatePath
+	}
+	return ""
+}
+
+func (x *CreateISCSIGatewayRequest) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *CreateISCSIGatewayRequest) GetVlan() uint32 {
+	if x != nil {
+		return x.Vlan
+	}
+	return 0
+}
+
+func (x *CreateISCSIGatewayRequest) GetRouter() string {
+	if x != nil {
+		return x.Router
+	}
+	return ""
+}
+
+func (x *CreateISCSIGatewayRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type CreateISCSIGatewayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ConfigPath    string                 `protobuf:"bytes,3,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"` // Path to generated config file
+	ServiceIp     string                 `protobuf:"bytes,4,opt,name=service_ip,json=serviceIp,proto3" json:"service_ip,omitempty"`    // Service IP in use, allocated for "auto"
+	Files         []*PlannedFile         `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`                             // Files a dry run would write, per node
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateISCSIGatewayResponse) Reset() {
+	*x = CreateISCSIGatewayResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[217]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateISCSIGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateISCSIGatewayResponse) ProtoMessage() {}
+
+func (x *CreateISCSIGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[217]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateISCSIGatewayResponse.ProtoReflect.Descriptor instead.
+func (*CreateISCSIGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{217}
+}
+
+func (x *CreateISCSIGatewayResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateISCSIGatewayResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateISCSIGatewayResponse) GetConfigPath() string {
+	if x != nil {
+		return x.ConfigPath
+	}
+	return ""
+}
+
+func (x *CreateISCSIGatewayResponse) GetServiceIp() string {
+	if x != nil {
+		return x.ServiceIp
+	}
+	return ""
+}
+
+func (x *CreateISCSIGatewayResponse) GetFiles() []*PlannedFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type CreateNVMeGatewayRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Resource           string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`                                                                         // DRBD resource name
+	ServiceIp          string                 `protobuf:"bytes,2,opt,name=service_ip,json=serviceIp,proto3" json:"service_ip,omitempty"`                                                      // Service IP (e.g., "192.168.1.150/24")
+	Nqn                string                 `protobuf:"bytes,3,opt,name=nqn,proto3" json:"nqn,omitempty"`                                                                                   // NVMe Qualified Name
+	TransportType      string                 `protobuf:"bytes,4,opt,name=transport_type,json=transportType,proto3" json:"transport_type,omitempty"`                                          // Transport type (tcp, rdma)
+	Options            map[string]string      `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Additional options
+	ServiceIpPool      string                 `protobuf:"bytes,6,opt,name=service_ip_pool,json=serviceIpPool,proto3" json:"service_ip_pool,omitempty"`                                        // IPAM pool for service_ip "auto"
+	ClusterPrivatePath string                 `protobuf:"bytes,7,opt,name=cluster_private_path,json=clusterPrivatePath,proto3" json:"cluster_private_path,omitempty"`                         // Directory the cluster-private volume is mounted under, the controller's default if empty
+	Interface          string                 `protobuf:"bytes,8,opt,name=interface,proto3" json:"interface,omitempty"`                                                                       // Interface of the tenant network the VIP is bound to, or the parent of its VLAN
+	Vlan               uint32                 `protobuf:"varint,9,opt,name=vlan,proto3" json:"vlan,omitempty"`                                                                                // VLAN of the tenant network on interface, 0 for none
+	Router             string                 `protobuf:"bytes,10,opt,name=router,proto3" json:"router,omitempty"`                                                                            // Router of the tenant network for clients on other subnets
+	DryRun             bool                   `protobuf:"varint,11,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                                                             // Only show the files that would be written and their diff
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateNVMeGatewayRequest) Reset() {
+	*x = CreateNVMeGatewayRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[218]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNVMeGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNVMeGatewayRequest) ProtoMessage() {}
+
+func (x *CreateNVMeGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[218]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNVMeGatewayRequest.ProtoReflect.Descriptor instead.
+func (*CreateNVMeGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{218}
+}
+
+func (x *CreateNVMeGatewayRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *CreateNVMeGatewayRequest) GetServiceIp() string {
+	if x != nil {
+		return x.ServiceIp
+	}
+	return ""
+}
+
+func (x *CreateNVMeGatewayRequest) GetNqn() string {
+	if x != nil {
+		return x.Nqn
+	}
+	return ""
+}
+
+func (x *CreateNVMeGatewayRequest) GetTransportType() string {
+	if x != nil {
+		return x.TransportType
+	}
+	return ""
+}
+
+func (x *CreateNVMeGatewayRequest) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateNVMeGatewayRequest) GetServiceIpPool() string {
+	if x != nil {
+		return x.ServiceIpPool
+	}
+	return ""
+}
+
+func (x *CreateNVMeGatewayRequest) GetClusterPrivatePath() string {
+	if x != nil {
+		return x.ClusterPrivatePath
+	}
+	return ""
+}
+
+func (x *CreateNVMeGatewayRequest) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *CreateNVMeGatewayRequest) GetVlan() uint32 {
+	if x != nil {
+		return x.Vlan
+	}
+	return 0
+}
+
+func (x *CreateNVMeGatewayRequest) GetRouter() string {
+	if x != nil {
+		return x.Router
+	}
+	return ""
+}
+
+func (x *CreateNVMeGatewayRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type CreateNVMeGatewayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ConfigPath    string                 `protobuf:"bytes,3,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"` // Path to generated config file
+	ServiceIp     string                 `protobuf:"bytes,4,opt,name=service_ip,json=serviceIp,proto3" json:"service_ip,omitempty"`    // Service IP in use, allocated for "auto"
+	Files         []*PlannedFile         `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`                             // Files a dry run would write, per node
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNVMeGatewayResponse) Reset() {
+	*x = CreateNVMeGatewayResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[219]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNVMeGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNVMeGatewayResponse) ProtoMessage() {}
+
+func (x *CreateNVMeGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[219]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNVMeGatewayResponse.ProtoReflect.Descriptor instead.
+func (*CreateNVMeGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{219}
+}
+
+func (x *CreateNVMeGatewayResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateNVMeGatewayResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateNVMeGatewayResponse) GetConfigPath() string {
+	if x != nil {
+		return x.ConfigPath
+	}
+	return ""
+}
+
+func (x *CreateNVMeGatewayResponse) GetServiceIp() string {
+	if x != nil {
+		return x.ServiceIp
+	}
+	return ""
+}
+
+func (x *CreateNVMeGatewayResponse) GetFiles() []*PlannedFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type DeleteGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGatewayRequest) Reset() {
+	*x = DeleteGatewayRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[220]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGatewayRequest) ProtoMessage() {}
+
+func (x *DeleteGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[220]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGatewayRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{220}
+}
+
+func (x *DeleteGatewayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteGatewayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGatewayResponse) Reset() {
+	*x = DeleteGatewayResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[221]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGatewayResponse) ProtoMessage() {}
+
+func (x *DeleteGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[221]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGatewayResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{221}
+}
+
+func (x *DeleteGatewayResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteGatewayResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGatewayRequest) Reset() {
+	*x = GetGatewayRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[222]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGatewayRequest) ProtoMessage() {}
+
+func (x *GetGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[222]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGatewayRequest.ProtoReflect.Descriptor instead.
+func (*GetGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{222}
+}
+
+func (x *GetGatewayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetGatewayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Gateway       *GatewayInfo           `protobuf:"bytes,3,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGatewayResponse) Reset() {
+	*x = GetGatewayResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[223]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGatewayResponse) ProtoMessage() {}
+
+func (x *GetGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[223]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGatewayResponse.ProtoReflect.Descriptor instead.
+func (*GetGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{223}
+}
+
+func (x *GetGatewayResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetGatewayResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetGatewayResponse) GetGateway() *GatewayInfo {
+	if x != nil {
+		return x.Gateway
+	}
+	return nil
+}
+
+type ListGatewaysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGatewaysRequest) Reset() {
+	*x = ListGatewaysRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[224]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGatewaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGatewaysRequest) ProtoMessage() {}
+
+func (x *ListGatewaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[224]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGatewaysRequest.ProtoReflect.Descriptor instead.
+func (*ListGatewaysRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{224}
+}
+
+type ListGatewaysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Gateways      []*GatewayInfo         `protobuf:"bytes,3,rep,name=gateways,proto3" json:"gateways,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGatewaysResponse) Reset() {
+	*x = ListGatewaysResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[225]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGatewaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGatewaysResponse) ProtoMessage() {}
+
+func (x *ListGatewaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[225]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGatewaysResponse.ProtoReflect.Descriptor instead.
+func (*ListGatewaysResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{225}
+}
+
+func (x *ListGatewaysResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListGatewaysResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListGatewaysResponse) GetGateways() []*GatewayInfo {
+	if x != nil {
+		return x.Gateways
+	}
+	return nil
+}
+
+type StartGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGatewayRequest) Reset() {
+	*x = StartGatewayRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[226]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGatewayRequest) ProtoMessage() {}
+
+func (x *StartGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[226]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGatewayRequest.ProtoReflect.Descriptor instead.
+func (*StartGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{226}
+}
+
+func (x *StartGatewayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StartGatewayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGatewayResponse) Reset() {
+	*x = StartGatewayResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[227]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGatewayResponse) ProtoMessage() {}
+
+func (x *StartGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[227]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGatewayResponse.ProtoReflect.Descriptor instead.
+func (*StartGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{227}
+}
+
+func (x *StartGatewayResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StartGatewayResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type StopGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopGatewayRequest) Reset() {
+	*x = StopGatewayRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[228]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopGatewayRequest) ProtoMessage() {}
+
+func (x *StopGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[228]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopGatewayRequest.ProtoReflect.Descriptor instead.
+func (*StopGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{228}
+}
+
+func (x *StopGatewayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StopGatewayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopGatewayResponse) Reset() {
+	*x = StopGatewayResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[229]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopGatewayResponse) ProtoMessage() {}
+
+func (x *StopGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[229]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopGatewayResponse.ProtoReflect.Descriptor instead.
+func (*StopGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{229}
+}
+
+func (x *StopGatewayResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StopGatewayResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// gateway is a gateway or resource name, "" for all gateways
+type ListGatewayClientsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gateway       string                 `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGatewayClientsRequest) Reset() {
+	*x = ListGatewayClientsRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[230]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGatewayClientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGatewayClientsRequest) ProtoMessage() {}
+
+func (x *ListGatewayClientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[230]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGatewayClientsRequest.ProtoReflect.Descriptor instead.
+func (*ListGatewayClientsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{230}
+}
+
+func (x *ListGatewayClientsRequest) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+type GatewayClient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // NFSv4 client name, initiator IQN or host NQN
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayClient) Reset() {
+	*x = GatewayClient{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[231]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayClient) ProtoMessage() {}
+
+func (x *GatewayClient) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[231]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayClient.ProtoReflect.Descriptor instead.
+func (*GatewayClient) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{231}
+}
+
+func (x *GatewayClient) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GatewayClient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GatewayClient) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type GatewayClientList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gateway       string                 `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Resource      string                 `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Node          string                 `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"` // Node the gateway is active on
+	Clients       []*GatewayClient       `protobuf:"bytes,5,rep,name=clients,proto3" json:"clients,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayClientList) Reset() {
+	*x = GatewayClientList{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[232]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayClientList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayClientList) ProtoMessage() {}
+
+func (x *GatewayClientList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[232]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayClientList.ProtoReflect.Descriptor instead.
+func (*GatewayClientList) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{232}
+}
+
+func (x *GatewayClientList) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *GatewayClientList) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GatewayClientList) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *GatewayClientList) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *GatewayClientList) GetClients() []*GatewayClient {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+func (x *GatewayClientList) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListGatewayClientsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Gateways      []*GatewayClientList   `protobuf:"bytes,3,rep,name=gateways,proto3" json:"gateways,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGatewayClientsResponse) Reset() {
+	*x = ListGatewayClientsResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[233]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGatewayClientsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGatewayClientsResponse) ProtoMessage() {}
+
+func (x *ListGatewayClientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[233]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGatewayClientsResponse.ProtoReflect.Descriptor instead.
+func (*ListGatewayClientsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{233}
+}
+
+func (x *ListGatewayClientsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListGatewayClientsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListGatewayClientsResponse) GetGateways() []*GatewayClientList {
+	if x != nil {
+		return x.Gateways
+	}
+	return nil
+}
+
+type GatewayInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Node          string                 `protobuf:"bytes,5,opt,name=node,proto3" json:"node,omitempty"`
+	Resource      string                 `protobuf:"bytes,6,opt,name=resource,proto3" json:"resource,omitempty"`
+	VolumeId      uint32                 `protobuf:"varint,7,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	Path          string                 `protobuf:"bytes,8,opt,name=path,proto3" json:"path,omitempty"`
+	Options       map[string]string      `protobuf:"bytes,9,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Owner         string                 `protobuf:"bytes,10,opt,name=owner,proto3" json:"owner,omitempty"` // principal the gateway belongs to, empty when shared
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayInfo) Reset() {
+	*x = GatewayInfo{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[234]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayInfo) ProtoMessage() {}
+
+func (x *GatewayInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[234]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayInfo.ProtoReflect.Descriptor instead.
+func (*GatewayInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{234}
+}
+
+func (x *GatewayInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GatewayInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GatewayInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GatewayInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *GatewayInfo) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *GatewayInfo) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *GatewayInfo) GetVolumeId() uint32 {
+	if x != nil {
+		return x.VolumeId
+	}
+	return 0
+}
+
+func (x *GatewayInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GatewayInfo) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *GatewayInfo) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+// Client (initiator) messages
+// gateway is the gateway name or the name of its resource
+type NVMeConnectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gateway       string                 `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`                      // client node to connect from
+	HostNqn       string                 `protobuf:"bytes,3,opt,name=host_nqn,json=hostNqn,proto3" json:"host_nqn,omitempty"` // optional, defaults to the client's /etc/nvme/hostnqn
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NVMeConnectRequest) Reset() {
+	*x = NVMeConnectRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[235]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NVMeConnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NVMeConnectRequest) ProtoMessage() {}
+
+func (x *NVMeConnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[235]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NVMeConnectRequest.ProtoReflect.Descriptor instead.
+func (*NVMeConnectRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{235}
+}
+
+func (x *NVMeConnectRequest) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *NVMeConnectRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *NVMeConnectRequest) GetHostNqn() string {
+	if x != nil {
+		return x.HostNqn
+	}
+	return ""
+}
+
+type NVMeConnectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Initiator     *InitiatorInfo         `protobuf:"bytes,3,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NVMeConnectResponse) Reset() {
+	*x = NVMeConnectResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[236]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NVMeConnectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NVMeConnectResponse) ProtoMessage() {}
+
+func (x *NVMeConnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[236]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NVMeConnectResponse.ProtoReflect.Descriptor instead.
+func (*NVMeConnectResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{236}
+}
+
+func (x *NVMeConnectResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *NVMeConnectResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NVMeConnectResponse) GetInitiator() *InitiatorInfo {
+	if x != nil {
+		return x.Initiator
+	}
+	return nil
+}
+
+type NVMeDisconnectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gateway       string                 `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NVMeDisconnectRequest) Reset() {
+	*x = NVMeDisconnectRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[237]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NVMeDisconnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NVMeDisconnectRequest) ProtoMessage() {}
+
+func (x *NVMeDisconnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[237]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NVMeDisconnectRequest.ProtoReflect.Descriptor instead.
+func (*NVMeDisconnectRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{237}
+}
+
+func (x *NVMeDisconnectRequest) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *NVMeDisconnectRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type NVMeDisconnectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Initiator     *InitiatorInfo         `protobuf:"bytes,3,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NVMeDisconnectResponse) Reset() {
+	*x = NVMeDisconnectResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[238]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NVMeDisconnectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NVMeDisconnectResponse) ProtoMessage() {}
+
+func (x *NVMeDisconnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[238]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NVMeDisconnectResponse.ProtoReflect.Descriptor instead.
+func (*NVMeDisconnectResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{238}
+}
+
+func (x *NVMeDisconnectResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *NVMeDisconnectResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NVMeDisconnectResponse) GetInitiator() *InitiatorInfo {
+	if x != nil {
+		return x.Initiator
+	}
+	return nil
+}
+
+type InitiatorInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gateway       string                 `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Target        string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`   // NQN or IQN
+	Portals       []string               `protobuf:"bytes,4,rep,name=portals,proto3" json:"portals,omitempty"` // address:port
+	Devices       []string               `protobuf:"bytes,5,rep,name=devices,proto3" json:"devices,omitempty"` // block devices seen by the client
+	Warnings      []string               `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Unit          string                 `protobuf:"bytes,7,opt,name=unit,proto3" json:"unit,omitempty"` // systemd unit installed on the client
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitiatorInfo) Reset() {
+	*x = InitiatorInfo{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[239]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiatorInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiatorInfo) ProtoMessage() {}
+
+func (x *InitiatorInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[239]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiatorInfo.ProtoReflect.Descriptor instead.
+func (*InitiatorInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{239}
+}
+
+func (x *InitiatorInfo) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *InitiatorInfo) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *InitiatorInfo) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *InitiatorInfo) GetPortals() []string {
+	if x != nil {
+		return x.Portals
+	}
+	return nil
+}
+
+func (x *InitiatorInfo) GetDevices() []string {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *InitiatorInfo) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *InitiatorInfo) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type GetISCSIClientConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gateway       string                 `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetISCSIClientConfigRequest) Reset() {
+	*x = GetISCSIClientConfigRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[240]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetISCSIClientConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetISCSIClientConfigRequest) ProtoMessage() {}
+
+func (x *GetISCSIClientConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[240]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetISCSIClientConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetISCSIClientConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{240}
+}
+
+func (x *GetISCSIClientConfigRequest) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+type GetISCSIClientConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Gateway       string                 `protobuf:"bytes,3,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Iqn           string                 `protobuf:"bytes,4,opt,name=iqn,proto3" json:"iqn,omitempty"`
+	Portals       []string               `protobuf:"bytes,5,rep,name=portals,proto3" json:"portals,omitempty"`                                  // address:port
+	MultipathConf string                 `protobuf:"bytes,6,opt,name=multipath_conf,json=multipathConf,proto3" json:"multipath_conf,omitempty"` // /etc/multipath.conf snippet
+	LoginScript   string                 `protobuf:"bytes,7,opt,name=login_script,json=loginScript,proto3" json:"login_script,omitempty"`       // iscsiadm discovery and login script
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetISCSIClientConfigResponse) Reset() {
+	*x = GetISCSIClientConfigResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[241]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetISCSIClientConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetISCSIClientConfigResponse) ProtoMessage() {}
+
+func (x *GetISCSIClientConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[241]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetISCSIClientConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetISCSIClientConfigResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{241}
+}
+
+func (x *GetISCSIClientConfigResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetISCSIClientConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetISCSIClientConfigResponse) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *GetISCSIClientConfigResponse) GetIqn() string {
+	if x != nil {
+		return x.Iqn
+	}
+	return ""
+}
+
+func (x *GetISCSIClientConfigResponse) GetPortals() []string {
+	if x != nil {
+		return x.Portals
+	}
+	return nil
+}
+
+func (x *GetISCSIClientConfigResponse) GetMultipathConf() string {
+	if x != nil {
+		return x.MultipathConf
+	}
+	return ""
+}
+
+func (x *GetISCSIClientConfigResponse) GetLoginScript() string {
+	if x != nil {
+		return x.LoginScript
+	}
+	return ""
+}
+
+type ValidateISCSIInitiatorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gateway       string                 `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateISCSIInitiatorRequest) Reset() {
+	*x = ValidateISCSIInitiatorRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[242]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateISCSIInitiatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateISCSIInitiatorRequest) ProtoMessage() {}
+
+func (x *ValidateISCSIInitiatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[242]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateISCSIInitiatorRequest.ProtoReflect.Descriptor instead.
+func (*ValidateISCSIInitiatorRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{242}
+}
+
+func (x *ValidateISCSIInitiatorRequest) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *ValidateISCSIInitiatorRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type ValidateISCSIInitiatorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Initiator     *InitiatorInfo         `protobuf:"bytes,3,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateISCSIInitiatorResponse) Reset() {
+	*x = ValidateISCSIInitiatorResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[243]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateISCSIInitiatorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateISCSIInitiatorResponse) ProtoMessage() {}
+
+func (x *ValidateISCSIInitiatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[243]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateISCSIInitiatorResponse.ProtoReflect.Descriptor instead.
+func (*ValidateISCSIInitiatorResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{243}
+}
+
+func (x *ValidateISCSIInitiatorResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ValidateISCSIInitiatorResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ValidateISCSIInitiatorResponse) GetInitiator() *InitiatorInfo {
+	if x != nil {
+		return x.Initiator
+	}
+	return nil
+}
+
+type NFSMountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gateway       string                 `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`       // mount point on the client
+	Soft          bool                   `protobuf:"varint,4,opt,name=soft,proto3" json:"soft,omitempty"`      // soft mount instead of hard
+	Options       string                 `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"` // extra mount options
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NFSMountRequest) Reset() {
+	*x = NFSMountRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[244]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NFSMountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NFSMountRequest) ProtoMessage() {}
+
+func (x *NFSMountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[244]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NFSMountRequest.ProtoReflect.Descriptor instead.
+func (*NFSMountRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{244}
+}
+
+func (x *NFSMountRequest) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *NFSMountRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *NFSMountRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *NFSMountRequest) GetSoft() bool {
+	if x != nil {
+		return x.Soft
+	}
+	return false
+}
+
+func (x *NFSMountRequest) GetOptions() string {
+	if x != nil {
+		return x.Options
+	}
+	return ""
+}
+
+type NFSMountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Initiator     *InitiatorInfo         `protobuf:"bytes,3,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NFSMountResponse) Reset() {
+	*x = NFSMountResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[245]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NFSMountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NFSMountResponse) ProtoMessage() {}
+
+func (x *NFSMountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[245]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NFSMountResponse.ProtoReflect.Descriptor instead.
+func (*NFSMountResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{245}
+}
+
+func (x *NFSMountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *NFSMountResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NFSMountResponse) GetInitiator() *InitiatorInfo {
+	if x != nil {
+		return x.Initiator
+	}
+	return nil
+}
+
+type DeleteHaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHaRequest) Reset() {
+	*x = DeleteHaRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[246]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHaRequest) ProtoMessage() {}
+
+func (x *DeleteHaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[246]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHaRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHaRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{246}
+}
+
+func (x *DeleteHaRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+type DeleteHaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHaResponse) Reset() {
+	*x = DeleteHaResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[247]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHaResponse) ProtoMessage() {}
+
+func (x *DeleteHaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[247]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHaResponse.ProtoReflect.Descriptor instead.
+func (*DeleteHaResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{247}
+}
+
+func (x *DeleteHaResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteHaResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetHaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHaRequest) Reset() {
+	*x = GetHaRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[248]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHaRequest) ProtoMessage() {}
+
+func (x *GetHaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[248]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHaRequest.ProtoReflect.Descriptor instead.
+func (*GetHaRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{248}
+}
+
+func (x *GetHaRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+type GetHaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Config        *HaConfigInfo          `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHaResponse) Reset() {
+	*x = GetHaResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[249]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHaResponse) ProtoMessage() {}
+
+func (x *GetHaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[249]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHaResponse.ProtoReflect.Descriptor instead.
+func (*GetHaResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{249}
+}
+
+func (x *GetHaResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetHaResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetHaResponse) GetConfig() *HaConfigInfo {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ListHaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHaRequest) Reset() {
+	*x = ListHaRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[250]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHaRequest) ProtoMessage() {}
+
+func (x *ListHaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[250]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHaRequest.ProtoReflect.Descriptor instead.
+func (*ListHaRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{250}
+}
+
+type GetHaStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHaStatusRequest) Reset() {
+	*x = GetHaStatusRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[251]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHaStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHaStatusRequest) ProtoMessage() {}
+
+func (x *GetHaStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[251]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHaStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetHaStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{251}
+}
+
+func (x *GetHaStatusRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+// HaMember is the state of an HA resource on one of its nodes
+type HaMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // Unknown if the node cannot be reached
+	DiskState     string                 `protobuf:"bytes,4,opt,name=disk_state,json=diskState,proto3" json:"disk_state,omitempty"`
+	Reactor       string                 `protobuf:"bytes,5,opt,name=reactor,proto3" json:"reactor,omitempty"` // state of the drbd-reactor service, e.g. active
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HaMember) Reset() {
+	*x = HaMember{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[252]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HaMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HaMember) ProtoMessage() {}
+
+func (x *HaMember) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[252]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HaMember.ProtoReflect.Descriptor instead.
+func (*HaMember) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{252}
+}
+
+func (x *HaMember) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *HaMember) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *HaMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *HaMember) GetDiskState() string {
+	if x != nil {
+		return x.DiskState
+	}
+	return ""
+}
+
+func (x *HaMember) GetReactor() string {
+	if x != nil {
+		return x.Reactor
+	}
+	return ""
+}
+
+type GetHaStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Config        *HaConfigInfo          `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	ConfigPath    string                 `protobuf:"bytes,4,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"` // drbd-reactor config on the nodes
+	ActiveNode    string                 `protobuf:"bytes,5,opt,name=active_node,json=activeNode,proto3" json:"active_node,omitempty"` // empty if the resource is Primary nowhere
+	Members       []*HaMember            `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHaStatusResponse) Reset() {
+	*x = GetHaStatusResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[253]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHaStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHaStatusResponse) ProtoMessage() {}
+
+func (x *GetHaStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[253]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHaStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetHaStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{253}
+}
+
+func (x *GetHaStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetHaStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetHaStatusResponse) GetConfig() *HaConfigInfo {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *GetHaStatusResponse) GetConfigPath() string {
+	if x != nil {
+		return x.ConfigPath
+	}
+	return ""
+}
+
+func (x *GetHaStatusResponse) GetActiveNode() string {
+	if x != nil {
+		return x.ActiveNode
+	}
+	return ""
+}
+
+func (x *GetHaStatusResponse) GetMembers() []*HaMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ListHaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Configs       []*HaConfigInfo        `protobuf:"bytes,3,rep,name=configs,proto3" json:"configs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHaResponse) Reset() {
+	*x = ListHaResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[254]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHaResponse) ProtoMessage() {}
+
+func (x *ListHaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[254]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHaResponse.ProtoReflect.Descriptor instead.
+func (*ListHaResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{254}
+}
+
+func (x *ListHaResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListHaResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListHaResponse) GetConfigs() []*HaConfigInfo {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type ImportPacemakerHaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`           // Node to read the CIB from with cibadmin
+	Cib           string                 `protobuf:"bytes,2,opt,name=cib,proto3" json:"cib,omitempty"`             // CIB XML, instead of reading it from node
+	Resources     []string               `protobuf:"bytes,3,rep,name=resources,proto3" json:"resources,omitempty"` // DRBD resources to import, empty for all
+	Apply         bool                   `protobuf:"varint,4,opt,name=apply,proto3" json:"apply,omitempty"`        // Install the configs, otherwise only show them
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPacemakerHaRequest) Reset() {
+	*x = ImportPacemakerHaRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[255]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPacemakerHaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPacemakerHaRequest) ProtoMessage() {}
+
+func (x *ImportPacemakerHaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[255]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPacemakerHaRequest.ProtoReflect.Descriptor instead.
+func (*ImportPacemakerHaRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{255}
+}
+
+func (x *ImportPacemakerHaRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ImportPacemakerHaRequest) GetCib() string {
+	if x != nil {
+		return x.Cib
+	}
+	return ""
+}
+
+func (x *ImportPacemakerHaRequest) GetResources() []string {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ImportPacemakerHaRequest) GetApply() bool {
+	if x != nil {
+		return x.Apply
+	}
+	return false
+}
+
+// PacemakerHaImport is the HA setup of a DRBD resource taken over from Pacemaker
+type PacemakerHaImport struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Resource       string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Clone          string                 `protobuf:"bytes,2,opt,name=clone,proto3" json:"clone,omitempty"`     // Promotable DRBD clone
+	Members        []string               `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"` // Primitives run on the Primary
+	MountPoint     string                 `protobuf:"bytes,4,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
+	Fstype         string                 `protobuf:"bytes,5,opt,name=fstype,proto3" json:"fstype,omitempty"`
+	Vip            string                 `protobuf:"bytes,6,opt,name=vip,proto3" json:"vip,omitempty"`
+	Services       []string               `protobuf:"bytes,7,rep,name=services,proto3" json:"services,omitempty"`
+	PreferredNodes []string               `protobuf:"bytes,8,rep,name=preferred_nodes,json=preferredNodes,proto3" json:"preferred_nodes,omitempty"`
+	Unmanaged      bool                   `protobuf:"varint,9,opt,name=unmanaged,proto3" json:"unmanaged,omitempty"` // Pacemaker leaves the resources alone
+	Warnings       []string               `protobuf:"bytes,10,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Blockers       []string               `protobuf:"bytes,11,rep,name=blockers,proto3" json:"blockers,omitempty"` // Why the setup cannot be applied
+	Config         string                 `protobuf:"bytes,12,opt,name=config,proto3" json:"config,omitempty"`     // drbd-reactor promoter config
+	Applied        bool                   `protobuf:"varint,13,opt,name=applied,proto3" json:"applied,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PacemakerHaImport) Reset() {
+	*x = PacemakerHaImport{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[256]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PacemakerHaImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacemakerHaImport) ProtoMessage() {}
+
+func (x *PacemakerHaImport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[256]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacemakerHaImport.ProtoReflect.Descriptor instead.
+func (*PacemakerHaImport) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{256}
+}
+
+func (x *PacemakerHaImport) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *PacemakerHaImport) GetClone() string {
+	if x != nil {
+		return x.Clone
+	}
+	return ""
+}
+
+func (x *PacemakerHaImport) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *PacemakerHaImport) GetMountPoint() string {
+	if x != nil {
+		return x.MountPoint
+	}
+	return ""
+}
+
+func (x *PacemakerHaImport) GetFstype() string {
+	if x != nil {
+		return x.Fstype
+	}
+	return ""
+}
+
+func (x *PacemakerHaImport) GetVip() string {
+	if x != nil {
+		return x.Vip
+	}
+	return ""
+}
+
+func (x *PacemakerHaImport) GetServices() []string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *PacemakerHaImport) GetPreferredNodes() []string {
+	if x != nil {
+		return x.PreferredNodes
+	}
+	return nil
+}
+
+func (x *PacemakerHaImport) GetUnmanaged() bool {
+	if x != nil {
+		return x.Unmanaged
+	}
+	return false
+}
+
+func (x *PacemakerHaImport) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *PacemakerHaImport) GetBlockers() []string {
+	if x != nil {
+		return x.Blockers
+	}
+	return nil
+}
+
+func (x *PacemakerHaImport) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+func (x *PacemakerHaImport) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+type ImportPacemakerHaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Imports       []*PacemakerHaImport   `protobuf:"bytes,3,rep,name=imports,proto3" json:"imports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPacemakerHaResponse) Reset() {
+	*x = ImportPacemakerHaResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[257]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPacemakerHaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPacemakerHaResponse) ProtoMessage() {}
+
+func (x *ImportPacemakerHaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[257]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPacemakerHaResponse.ProtoReflect.Descriptor instead.
+func (*ImportPacemakerHaResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{257}
+}
+
+func (x *ImportPacemakerHaResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ImportPacemakerHaResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportPacemakerHaResponse) GetImports() []*PacemakerHaImport {
+	if x != nil {
+		return x.Imports
+	}
+	return nil
+}
+
+type HaConfigInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Vip           string                 `protobuf:"bytes,2,opt,name=vip,proto3" json:"vip,omitempty"`
+	MountPoint    string                 `protobuf:"bytes,3,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
+	FsType        string                 `protobuf:"bytes,4,opt,name=fs_type,json=fsType,proto3" json:"fs_type,omitempty"`
+	Services      []string               `protobuf:"bytes,5,rep,name=services,proto3" json:"services,omitempty"`
+	DependsOn     []string               `protobuf:"bytes,6,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	Policy        *HaPolicy              `protobuf:"bytes,7,opt,name=policy,proto3" json:"policy,omitempty"`
+	ActiveNode    string                 `protobuf:"bytes,8,opt,name=active_node,json=activeNode,proto3" json:"active_node,omitempty"` // Primary as of the last node poll, in ListHa only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HaConfigInfo) Reset() {
+	*x = HaConfigInfo{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[258]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HaConfigInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HaConfigInfo) ProtoMessage() {}
+
+func (x *HaConfigInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[258]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HaConfigInfo.ProtoReflect.Descriptor instead.
+func (*HaConfigInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{258}
+}
+
+func (x *HaConfigInfo) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *HaConfigInfo) GetVip() string {
+	if x != nil {
+		return x.Vip
+	}
+	return ""
+}
+
+func (x *HaConfigInfo) GetMountPoint() string {
+	if x != nil {
+		return x.MountPoint
+	}
+	return ""
+}
+
+func (x *HaConfigInfo) GetFsType() string {
+	if x != nil {
+		return x.FsType
+	}
+	return ""
+}
+
+func (x *HaConfigInfo) GetServices() []string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *HaConfigInfo) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+func (x *HaConfigInfo) GetPolicy() *HaPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *HaConfigInfo) GetActiveNode() string {
+	if x != nil {
+		return x.ActiveNode
+	}
+	return ""
+}
+
+// VIP messages
+type VIPInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Prefix        int32                  `protobuf:"varint,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Owner         string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`                           // ha/<resource> or gateway/<name>
+	Pool          string                 `protobuf:"bytes,4,opt,name=pool,proto3" json:"pool,omitempty"`                             // IPAM pool, empty if given explicitly
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VIPInfo) Reset() {
+	*x = VIPInfo{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[259]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VIPInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VIPInfo) ProtoMessage() {}
+
+func (x *VIPInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[259]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VIPInfo.ProtoReflect.Descriptor instead.
+func (*VIPInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{259}
+}
+
+func (x *VIPInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *VIPInfo) GetPrefix() int32 {
+	if x != nil {
+		return x.Prefix
+	}
+	return 0
+}
+
+func (x *VIPInfo) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *VIPInfo) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *VIPInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type VIPPoolInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Range         string                 `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Used          int32                  `protobuf:"varint,4,opt,name=used,proto3" json:"used,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VIPPoolInfo) Reset() {
+	*x = VIPPoolInfo{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[260]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VIPPoolInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VIPPoolInfo) ProtoMessage() {}
+
+func (x *VIPPoolInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[260]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VIPPoolInfo.ProtoReflect.Descriptor instead.
+func (*VIPPoolInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{260}
+}
+
+func (x *VIPPoolInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VIPPoolInfo) GetRange() string {
+	if x != nil {
+		return x.Range
+	}
+	return ""
+}
+
+func (x *VIPPoolInfo) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *VIPPoolInfo) GetUsed() int32 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+type ListVIPsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVIPsRequest) Reset() {
+	*x = ListVIPsRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[261]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVIPsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVIPsRequest) ProtoMessage() {}
+
+func (x *ListVIPsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[261]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVIPsRequest.ProtoReflect.Descriptor instead.
+func (*ListVIPsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{261}
+}
+
+type ListVIPsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Vips          []*VIPInfo             `protobuf:"bytes,3,rep,name=vips,proto3" json:"vips,omitempty"`
+	Pools         []*VIPPoolInfo         `protobuf:"bytes,4,rep,name=pools,proto3" json:"pools,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVIPsResponse) Reset() {
+	*x = ListVIPsResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[262]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVIPsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVIPsResponse) ProtoMessage() {}
+
+func (x *ListVIPsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[262]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVIPsResponse.ProtoReflect.Descriptor instead.
+func (*ListVIPsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{262}
+}
+
+func (x *ListVIPsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListVIPsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListVIPsResponse) GetVips() []*VIPInfo {
+	if x != nil {
+		return x.Vips
+	}
+	return nil
+}
+
+func (x *ListVIPsResponse) GetPools() []*VIPPoolInfo {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+// Disaster recovery messages
+type DrSwitchoverRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Resource           string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	TargetNode         string                 `protobuf:"bytes,2,opt,name=target_node,json=targetNode,proto3" json:"target_node,omitempty"`                            // DR node; optional when the resource has a single secondary
+	SyncTimeoutSeconds uint32                 `protobuf:"varint,3,opt,name=sync_timeout_seconds,json=syncTimeoutSeconds,proto3" json:"sync_timeout_seconds,omitempty"` // 0 = default (600)
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DrSwitchoverRequest) Reset() {
+	*x = DrSwitchoverRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[263]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrSwitchoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrSwitchoverRequest) ProtoMessage() {}
+
+func (x *DrSwitchoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[263]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrSwitchoverRequest.ProtoReflect.Descriptor instead.
+func (*DrSwitchoverRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{263}
+}
+
+func (x *DrSwitchoverRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *DrSwitchoverRequest) GetTargetNode() string {
+	if x != nil {
+		return x.TargetNode
+	}
+	return ""
+}
+
+func (x *DrSwitchoverRequest) GetSyncTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.SyncTimeoutSeconds
+	}
+	return 0
+}
+
+type DrSwitchoverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	FromNode      string                 `protobuf:"bytes,3,opt,name=from_node,json=fromNode,proto3" json:"from_node,omitempty"`
+	ToNode        string                 `protobuf:"bytes,4,opt,name=to_node,json=toNode,proto3" json:"to_node,omitempty"`
+	Steps         []string               `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrSwitchoverResponse) Reset() {
+	*x = DrSwitchoverResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[264]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrSwitchoverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrSwitchoverResponse) ProtoMessage() {}
+
+func (x *DrSwitchoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[264]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrSwitchoverResponse.ProtoReflect.Descriptor instead.
+func (*DrSwitchoverResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{264}
+}
+
+func (x *DrSwitchoverResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DrSwitchoverResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DrSwitchoverResponse) GetFromNode() string {
+	if x != nil {
+		return x.FromNode
+	}
+	return ""
+}
+
+func (x *DrSwitchoverResponse) GetToNode() string {
+	if x != nil {
+		return x.ToNode
+	}
+	return ""
+}
+
+func (x *DrSwitchoverResponse) GetSteps() []string {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type DrFailbackRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Resource           string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	SyncTimeoutSeconds uint32                 `protobuf:"varint,2,opt,name=sync_timeout_seconds,json=syncTimeoutSeconds,proto3" json:"sync_timeout_seconds,omitempty"` // 0 = default (600)
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DrFailbackRequest) Reset() {
+	*x = DrFailbackRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[265]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrFailbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrFailbackRequest) ProtoMessage() {}
+
+func (x *DrFailbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[265]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrFailbackRequest.ProtoReflect.Descriptor instead.
+func (*DrFailbackRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{265}
+}
+
+func (x *DrFailbackRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *DrFailbackRequest) GetSyncTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.SyncTimeoutSeconds
+	}
+	return 0
+}
+
+type DrFailbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	FromNode      string                 `protobuf:"bytes,3,opt,name=from_node,json=fromNode,proto3" json:"from_node,omitempty"`
+	ToNode        string                 `protobuf:"bytes,4,opt,name=to_node,json=toNode,proto3" json:"to_node,omitempty"`
+	Steps         []string               `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrFailbackResponse) Reset() {
+	*x = DrFailbackResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[266]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrFailbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrFailbackResponse) ProtoMessage() {}
+
+func (x *DrFailbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[266]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrFailbackResponse.ProtoReflect.Descriptor instead.
+func (*DrFailbackResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{266}
+}
+
+func (x *DrFailbackResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DrFailbackResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DrFailbackResponse) GetFromNode() string {
+	if x != nil {
+		return x.FromNode
+	}
+	return ""
+}
+
+func (x *DrFailbackResponse) GetToNode() string {
+	if x != nil {
+		return x.ToNode
+	}
+	return ""
+}
+
+func (x *DrFailbackResponse) GetSteps() []string {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// Placement rule messages
+type AddPlacementRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceA     string                 `protobuf:"bytes,1,opt,name=resource_a,json=resourceA,proto3" json:"resource_a,omitempty"`
+	ResourceB     string                 `protobuf:"bytes,2,opt,name=resource_b,json=resourceB,proto3" json:"resource_b,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // "colocate" or "anti-affinity"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPlacementRuleRequest) Reset() {
+	*x = AddPlacementRuleRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[267]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPlacementRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPlacementRuleRequest) ProtoMessage() {}
+
+func (x *AddPlacementRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[267]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPlacementRuleRequest.ProtoReflect.Descriptor instead.
+func (*AddPlacementRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{267}
+}
+
+func (x *AddPlacementRuleRequest) GetResourceA() string {
+	if x != nil {
+		return x.ResourceA
+	}
+	return ""
+}
+
+func (x *AddPlacementRuleRequest) GetResourceB() string {
+	if x != nil {
+		return x.ResourceB
+	}
+	return ""
+}
+
+func (x *AddPlacementRuleRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type AddPlacementRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPlacementRuleResponse) Reset() {
+	*x = AddPlacementRuleResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[268]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPlacementRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPlacementRuleResponse) ProtoMessage() {}
+
+func (x *AddPlacementRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[268]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPlacementRuleResponse.ProtoReflect.Descriptor instead.
+func (*AddPlacementRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{268}
+}
+
+func (x *AddPlacementRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddPlacementRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeletePlacementRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceA     string                 `protobuf:"bytes,1,opt,name=resource_a,json=resourceA,proto3" json:"resource_a,omitempty"`
+	ResourceB     string                 `protobuf:"bytes,2,opt,name=resource_b,json=resourceB,proto3" json:"resource_b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlacementRuleRequest) Reset() {
+	*x = DeletePlacementRuleRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[269]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlacementRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlacementRuleRequest) ProtoMessage() {}
+
+func (x *DeletePlacementRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[269]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlacementRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlacementRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{269}
+}
+
+func (x *DeletePlacementRuleRequest) GetResourceA() string {
+	if x != nil {
+		return x.ResourceA
+	}
+	return ""
+}
+
+func (x *DeletePlacementRuleRequest) GetResourceB() string {
+	if x != nil {
+		return x.ResourceB
+	}
+	return ""
+}
+
+type DeletePlacementRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlacementRuleResponse) Reset() {
+	*x = DeletePlacementRuleResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[270]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlacementRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlacementRuleResponse) ProtoMessage() {}
+
+func (x *DeletePlacementRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[270]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlacementRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlacementRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{270}
+}
+
+func (x *DeletePlacementRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeletePlacementRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListPlacementRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"` // optional filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlacementRulesRequest) Reset() {
+	*x = ListPlacementRulesRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[271]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlacementRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlacementRulesRequest) ProtoMessage() {}
+
+func (x *ListPlacementRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[271]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlacementRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListPlacementRulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{271}
+}
+
+func (x *ListPlacementRulesRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+type ListPlacementRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rules         []*PlacementRuleInfo   `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlacementRulesResponse) Reset() {
+	*x = ListPlacementRulesResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[272]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlacementRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlacementRulesResponse) ProtoMessage() {}
+
+func (x *ListPlacementRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[272]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlacementRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListPlacementRulesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{272}
+}
+
+func (x *ListPlacementRulesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListPlacementRulesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListPlacementRulesResponse) GetRules() []*PlacementRuleInfo {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type PlacementRuleInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceA     string                 `protobuf:"bytes,1,opt,name=resource_a,json=resourceA,proto3" json:"resource_a,omitempty"`
+	ResourceB     string                 `protobuf:"bytes,2,opt,name=resource_b,json=resourceB,proto3" json:"resource_b,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlacementRuleInfo) Reset() {
+	*x = PlacementRuleInfo{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[273]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacementRuleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementRuleInfo) ProtoMessage() {}
+
+func (x *PlacementRuleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[273]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementRuleInfo.ProtoReflect.Descriptor instead.
+func (*PlacementRuleInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{273}
+}
+
+func (x *PlacementRuleInfo) GetResourceA() string {
+	if x != nil {
+		return x.ResourceA
+	}
+	return ""
+}
+
+func (x *PlacementRuleInfo) GetResourceB() string {
+	if x != nil {
+		return x.ResourceB
+	}
+	return ""
+}
+
+func (x *PlacementRuleInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PlacementRuleInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// Events log messages
+type ListEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"` // optional filter
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`      // 0 = all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[274]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[274]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{274}
+}
+
+func (x *ListEventsRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Events        []*EventInfo           `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[275]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsResponse) ProtoMessage() {}
+
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[275]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{275}
+}
+
+func (x *ListEventsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListEventsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListEventsResponse) GetEvents() []*EventInfo {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type EventInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Resource      string                 `protobuf:"bytes,4,opt,name=resource,proto3" json:"resource,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Details       map[string]string      `protobuf:"bytes,6,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventInfo) Reset() {
+	*x = EventInfo{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[276]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventInfo) ProtoMessage() {}
+
+func (x *EventInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[276]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventInfo.ProtoReflect.Descriptor instead.
+func (*EventInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{276}
+}
+
+func (x *EventInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EventInfo) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *EventInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EventInfo) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *EventInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EventInfo) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+// Cluster report messages
+type GetClusterReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          uint32                 `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`    // Period up to now, 0 for a week
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // html or md, empty for html
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterReportRequest) Reset() {
+	*x = GetClusterReportRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[277]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterReportRequest) ProtoMessage() {}
+
+func (x *GetClusterReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[277]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterReportRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{277}
+}
+
+func (x *GetClusterReportRequest) GetDays() uint32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetClusterReportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type GetClusterReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"` // The rendered report
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterReportResponse) Reset() {
+	*x = GetClusterReportResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[278]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterReportResponse) ProtoMessage() {}
+
+func (x *GetClusterReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[278]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterReportResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterReportResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{278}
+}
+
+func (x *GetClusterReportResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetClusterReportResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetClusterReportResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GetClusterReportResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type GetAlertRulesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PoolFullPercent float64                `protobuf:"fixed64,1,opt,name=pool_full_percent,json=poolFullPercent,proto3" json:"pool_full_percent,omitempty"` // Pool usage that alerts, 0 for 90
+	ForSeconds      uint32                 `protobuf:"varint,2,opt,name=for_seconds,json=forSeconds,proto3" json:"for_seconds,omitempty"`                   // How long a condition holds before it alerts, 0 for 600
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetAlertRulesRequest) Reset() {
+	*x = GetAlertRulesRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[279]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlertRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlertRulesRequest) ProtoMessage() {}
+
+func (x *GetAlertRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[279]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlertRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetAlertRulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{279}
+}
+
+func (x *GetAlertRulesRequest) GetPoolFullPercent() float64 {
+	if x != nil {
+		return x.PoolFullPercent
+	}
+	return 0
+}
+
+func (x *GetAlertRulesRequest) GetForSeconds() uint32 {
+	if x != nil {
+		return x.ForSeconds
+	}
+	return 0
+}
+
+type GetAlertRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rules         string                 `protobuf:"bytes,3,opt,name=rules,proto3" json:"rules,omitempty"` // Prometheus rule file (YAML)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAlertRulesResponse) Reset() {
+	*x = GetAlertRulesResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[280]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlertRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlertRulesResponse) ProtoMessage() {}
+
+func (x *GetAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[280]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{280}
+}
+
+func (x *GetAlertRulesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetAlertRulesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetAlertRulesResponse) GetRules() string {
+	if x != nil {
+		return x.Rules
+	}
+	return ""
+}
+
+// Admin messages
+type FreezeStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Frozen        bool                   `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Since         int64                  `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeStatus) Reset() {
+	*x = FreezeStatus{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[281]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeStatus) ProtoMessage() {}
+
+func (x *FreezeStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[281]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeStatus.ProtoReflect.Descriptor instead.
+func (*FreezeStatus) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{281}
+}
+
+func (x *FreezeStatus) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+func (x *FreezeStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FreezeStatus) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+type ListClustersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClustersRequest) Reset() {
+	*x = ListClustersRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[282]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClustersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClustersRequest) ProtoMessage() {}
+
+func (x *ListClustersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[282]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClustersRequest.ProtoReflect.Descriptor instead.
+func (*ListClustersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{282}
+}
+
+type ClusterInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uuid          string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	DatabasePath  string                 `protobuf:"bytes,3,opt,name=database_path,json=databasePath,proto3" json:"database_path,omitempty"`
+	Nodes         uint32                 `protobuf:"varint,4,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	Resources     uint32                 `protobuf:"varint,5,opt,name=resources,proto3" json:"resources,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"` // Calls without x-sds-cluster go to this cluster
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterInfo) Reset() {
+	*x = ClusterInfo{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[283]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterInfo) ProtoMessage() {}
+
+func (x *ClusterInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[283]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterInfo.ProtoReflect.Descriptor instead.
+func (*ClusterInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{283}
+}
+
+func (x *ClusterInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClusterInfo) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ClusterInfo) GetDatabasePath() string {
+	if x != nil {
+		return x.DatabasePath
+	}
+	return ""
+}
+
+func (x *ClusterInfo) GetNodes() uint32 {
+	if x != nil {
+		return x.Nodes
+	}
+	return 0
+}
+
+func (x *ClusterInfo) GetResources() uint32 {
+	if x != nil {
+		return x.Resources
+	}
+	return 0
+}
+
+func (x *ClusterInfo) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type ListClustersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Clusters      []*ClusterInfo         `protobuf:"bytes,3,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClustersResponse) Reset() {
+	*x = ListClustersResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[284]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClustersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClustersResponse) ProtoMessage() {}
+
+func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[284]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClustersResponse.ProtoReflect.Descriptor instead.
+func (*ListClustersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{284}
+}
+
+func (x *ListClustersResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListClustersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListClustersResponse) GetClusters() []*ClusterInfo {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+type BuildInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Commit        string                 `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	BuildDate     string                 `protobuf:"bytes,3,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
+	GoVersion     string                 `protobuf:"bytes,4,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	Platform      string                 `protobuf:"bytes,5,opt,name=platform,proto3" json:"platform,omitempty"` // os/arch
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildInfo) Reset() {
+	*x = BuildInfo{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[285]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildInfo) ProtoMessage() {}
+
+func (x *BuildInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[285]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildInfo.ProtoReflect.Descriptor instead.
+func (*BuildInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{285}
+}
+
+func (x *BuildInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetBuildDate() string {
+	if x != nil {
+		return x.BuildDate
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+// NodeComponents are the versions of the storage stack of a node, empty for
+// components that are not installed
+type NodeComponents struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address            string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	State              string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	DrbdKernelVersion  string                 `protobuf:"bytes,4,opt,name=drbd_kernel_version,json=drbdKernelVersion,proto3" json:"drbd_kernel_version,omitempty"`
+	DrbdUtilsVersion   string                 `protobuf:"bytes,5,opt,name=drbd_utils_version,json=drbdUtilsVersion,proto3" json:"drbd_utils_version,omitempty"`
+	DrbdReactorVersion string                 `protobuf:"bytes,6,opt,name=drbd_reactor_version,json=drbdReactorVersion,proto3" json:"drbd_reactor_version,omitempty"`
+	LvmVersion         string                 `protobuf:"bytes,7,opt,name=lvm_version,json=lvmVersion,proto3" json:"lvm_version,omitempty"`
+	ZfsVersion         string                 `protobuf:"bytes,8,opt,name=zfs_version,json=zfsVersion,proto3" json:"zfs_version,omitempty"`
+	CheckedAt          int64                  `protobuf:"varint,9,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"` // Unix time of the collection, 0 if never
+	Stale              bool                   `protobuf:"varint,10,opt,name=stale,proto3" json:"stale,omitempty"`                         // Unreachable now, the versions are the last known
+	Warnings           []string               `protobuf:"bytes,11,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *NodeComponents) Reset() {
+	*x = NodeComponents{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[286]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeComponents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeComponents) ProtoMessage() {}
+
+func (x *NodeComponents) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[286]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeComponents.ProtoReflect.Descriptor instead.
+func (*NodeComponents) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{286}
+}
+
+func (x *NodeComponents) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NodeComponents) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *NodeComponents) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *NodeComponents) GetDrbdKernelVersion() string {
+	if x != nil {
+		return x.DrbdKernelVersion
+	}
+	return ""
+}
+
+func (x *NodeComponents) GetDrbdUtilsVersion() string {
+	if x != nil {
+		return x.DrbdUtilsVersion
+	}
+	return ""
+}
+
+func (x *NodeComponents) GetDrbdReactorVersion() string {
+	if x != nil {
+		return x.DrbdReactorVersion
+	}
+	return ""
+}
+
+func (x *NodeComponents) GetLvmVersion() string {
+	if x != nil {
+		return x.LvmVersion
+	}
+	return ""
+}
+
+func (x *NodeComponents) GetZfsVersion() string {
+	if x != nil {
+		return x.ZfsVersion
+	}
+	return ""
+}
+
+func (x *NodeComponents) GetCheckedAt() int64 {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return 0
+}
+
+func (x *NodeComponents) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *NodeComponents) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type GetClusterInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterInfoRequest) Reset() {
+	*x = GetClusterInfoRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[287]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterInfoRequest) ProtoMessage() {}
+
+func (x *GetClusterInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[287]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterInfoRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{287}
+}
+
+type GetClusterInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Cluster       *ClusterInfo           `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Controller    *BuildInfo             `protobuf:"bytes,4,opt,name=controller,proto3" json:"controller,omitempty"`
+	Nodes         []*NodeComponents      `protobuf:"bytes,5,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterInfoResponse) Reset() {
+	*x = GetClusterInfoResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[288]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterInfoResponse) ProtoMessage() {}
+
+func (x *GetClusterInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[288]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterInfoResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{288}
+}
+
+func (x *GetClusterInfoResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetClusterInfoResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetClusterInfoResponse) GetCluster() *ClusterInfo {
+	if x != nil {
+		return x.Cluster
+	}
+	return nil
+}
+
+func (x *GetClusterInfoResponse) GetController() *BuildInfo {
+	if x != nil {
+		return x.Controller
+	}
+	return nil
+}
+
+func (x *GetClusterInfoResponse) GetNodes() []*NodeComponents {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type GetOverviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        int32                  `protobuf:"varint,1,opt,name=events,proto3" json:"events,omitempty"` // Recent events to include, 0 for 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOverviewRequest) Reset() {
+	*x = GetOverviewRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[289]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOverviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOverviewRequest) ProtoMessage() {}
+
+func (x *GetOverviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[289]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOverviewRequest.ProtoReflect.Descriptor instead.
+func (*GetOverviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{289}
+}
+
+func (x *GetOverviewRequest) GetEvents() int32 {
+	if x != nil {
+		return x.Events
+	}
+	return 0
+}
+
+type GetOverviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Nodes         []*NodeInfo            `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Pools         []*PoolInfo            `protobuf:"bytes,4,rep,name=pools,proto3" json:"pools,omitempty"`         // From the last node poll
+	Resources     []*ResourceInfo        `protobuf:"bytes,5,rep,name=resources,proto3" json:"resources,omitempty"` // With the DRBD state of the last node poll
+	Ha            []*HaConfigInfo        `protobuf:"bytes,6,rep,name=ha,proto3" json:"ha,omitempty"`
+	Gateways      []*GatewayInfo         `protobuf:"bytes,7,rep,name=gateways,proto3" json:"gateways,omitempty"` // node is where the resource is Primary
+	Events        []*EventInfo           `protobuf:"bytes,8,rep,name=events,proto3" json:"events,omitempty"`     // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOverviewResponse) Reset() {
+	*x = GetOverviewResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[290]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NodeHealthInfo) String() string {
+func (x *GetOverviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeHealthInfo) ProtoMessage() {}
+func (*GetOverviewResponse) ProtoMessage() {}
 
-func (x *NodeHealthInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[54]
+func (x *GetOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[290]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3089,90 +18652,89 @@ func (x *NodeHealthInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeHealthInfo.ProtoReflect.Descriptor instead.
-func (*NodeHealthInfo) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use GetOverviewResponse.ProtoReflect.Descriptor instead.
+func (*GetOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{290}
 }
 
-func (x *NodeHealthInfo) GetDrbdInstalled() bool {
+func (x *GetOverviewResponse) GetSuccess() bool {
 	if x != nil {
-		return x.DrbdInstalled
+		return x.Success
 	}
 	return false
 }
 
-func (x *NodeHealthInfo) GetDrbdVersion() string {
+func (x *GetOverviewResponse) GetMessage() string {
 	if x != nil {
-		return x.DrbdVersion
+		return x.Message
 	}
 	return ""
 }
 
-func (x *NodeHealthInfo) GetDrbdReactorInstalled() bool {
+func (x *GetOverviewResponse) GetNodes() []*NodeInfo {
 	if x != nil {
-		return x.DrbdReactorInstalled
+		return x.Nodes
 	}
-	return false
+	return nil
 }
 
-func (x *NodeHealthInfo) GetDrbdReactorVersion() string {
+func (x *GetOverviewResponse) GetPools() []*PoolInfo {
 	if x != nil {
-		return x.DrbdReactorVersion
+		return x.Pools
 	}
-	return ""
+	return nil
 }
 
-func (x *NodeHealthInfo) GetDrbdReactorRunning() bool {
+func (x *GetOverviewResponse) GetResources() []*ResourceInfo {
 	if x != nil {
-		return x.DrbdReactorRunning
+		return x.Resources
 	}
-	return false
+	return nil
 }
 
-func (x *NodeHealthInfo) GetResourceAgentsInstalled() bool {
+func (x *GetOverviewResponse) GetHa() []*HaConfigInfo {
 	if x != nil {
-		return x.ResourceAgentsInstalled
+		return x.Ha
 	}
-	return false
+	return nil
 }
 
-func (x *NodeHealthInfo) GetAvailableAgents() []string {
+func (x *GetOverviewResponse) GetGateways() []*GatewayInfo {
 	if x != nil {
-		return x.AvailableAgents
+		return x.Gateways
 	}
 	return nil
 }
 
-// Resource messages
-type CreateResourceRequest struct {
+func (x *GetOverviewResponse) GetEvents() []*EventInfo {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type FreezeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Port          uint32                 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	Nodes         []string               `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	Protocol      string                 `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	SizeGb        uint32                 `protobuf:"varint,5,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
-	Pool          string                 `protobuf:"bytes,6,opt,name=pool,proto3" json:"pool,omitempty"`
-	StorageType   string                 `protobuf:"bytes,7,opt,name=storage_type,json=storageType,proto3" json:"storage_type,omitempty"` // "lvm" or "zfs"
-	DrbdOptions   map[string]string      `protobuf:"bytes,8,rep,name=drbd_options,json=drbdOptions,proto3" json:"drbd_options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateResourceRequest) Reset() {
-	*x = CreateResourceRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[55]
+func (x *FreezeRequest) Reset() {
+	*x = FreezeRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[291]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateResourceRequest) String() string {
+func (x *FreezeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateResourceRequest) ProtoMessage() {}
+func (*FreezeRequest) ProtoMessage() {}
 
-func (x *CreateResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[55]
+func (x *FreezeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[291]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3183,68 +18745,115 @@ func (x *CreateResourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateResourceRequest.ProtoReflect.Descriptor instead.
-func (*CreateResourceRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use FreezeRequest.ProtoReflect.Descriptor instead.
+func (*FreezeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{291}
 }
 
-func (x *CreateResourceRequest) GetName() string {
+func (x *FreezeRequest) GetReason() string {
 	if x != nil {
-		return x.Name
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *CreateResourceRequest) GetPort() uint32 {
-	if x != nil {
-		return x.Port
-	}
-	return 0
+type FreezeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Status        *FreezeStatus          `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateResourceRequest) GetNodes() []string {
-	if x != nil {
-		return x.Nodes
-	}
-	return nil
+func (x *FreezeResponse) Reset() {
+	*x = FreezeResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[292]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateResourceRequest) GetProtocol() string {
+func (x *FreezeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeResponse) ProtoMessage() {}
+
+func (x *FreezeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[292]
 	if x != nil {
-		return x.Protocol
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateResourceRequest) GetSizeGb() uint32 {
+// Deprecated: Use FreezeResponse.ProtoReflect.Descriptor instead.
+func (*FreezeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{292}
+}
+
+func (x *FreezeResponse) GetSuccess() bool {
 	if x != nil {
-		return x.SizeGb
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *CreateResourceRequest) GetPool() string {
+func (x *FreezeResponse) GetMessage() string {
 	if x != nil {
-		return x.Pool
+		return x.Message
 	}
 	return ""
 }
 
-func (x *CreateResourceRequest) GetStorageType() string {
+func (x *FreezeResponse) GetStatus() *FreezeStatus {
 	if x != nil {
-		return x.StorageType
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateResourceRequest) GetDrbdOptions() map[string]string {
+type UnfreezeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfreezeRequest) Reset() {
+	*x = UnfreezeRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[293]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfreezeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfreezeRequest) ProtoMessage() {}
+
+func (x *UnfreezeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[293]
 	if x != nil {
-		return x.DrbdOptions
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type CreateResourceResponse struct {
+// Deprecated: Use UnfreezeRequest.ProtoReflect.Descriptor instead.
+func (*UnfreezeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{293}
+}
+
+type UnfreezeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -3252,21 +18861,21 @@ type CreateResourceResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateResourceResponse) Reset() {
-	*x = CreateResourceResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[56]
+func (x *UnfreezeResponse) Reset() {
+	*x = UnfreezeResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[294]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateResourceResponse) String() string {
+func (x *UnfreezeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateResourceResponse) ProtoMessage() {}
+func (*UnfreezeResponse) ProtoMessage() {}
 
-func (x *CreateResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[56]
+func (x *UnfreezeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[294]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3277,47 +18886,46 @@ func (x *CreateResourceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateResourceResponse.ProtoReflect.Descriptor instead.
-func (*CreateResourceResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use UnfreezeResponse.ProtoReflect.Descriptor instead.
+func (*UnfreezeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{294}
 }
 
-func (x *CreateResourceResponse) GetSuccess() bool {
+func (x *UnfreezeResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *CreateResourceResponse) GetMessage() string {
+func (x *UnfreezeResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type DeleteResourceRequest struct {
+type GetFreezeStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteResourceRequest) Reset() {
-	*x = DeleteResourceRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[57]
+func (x *GetFreezeStatusRequest) Reset() {
+	*x = GetFreezeStatusRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[295]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteResourceRequest) String() string {
+func (x *GetFreezeStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteResourceRequest) ProtoMessage() {}
+func (*GetFreezeStatusRequest) ProtoMessage() {}
 
-func (x *DeleteResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[57]
+func (x *GetFreezeStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[295]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3328,41 +18936,35 @@ func (x *DeleteResourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteResourceRequest.ProtoReflect.Descriptor instead.
-func (*DeleteResourceRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{57}
-}
-
-func (x *DeleteResourceRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use GetFreezeStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetFreezeStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{295}
 }
 
-type DeleteResourceResponse struct {
+type GetFreezeStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Status        *FreezeStatus          `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteResourceResponse) Reset() {
-	*x = DeleteResourceResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[58]
+func (x *GetFreezeStatusResponse) Reset() {
+	*x = GetFreezeStatusResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[296]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteResourceResponse) String() string {
+func (x *GetFreezeStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteResourceResponse) ProtoMessage() {}
+func (*GetFreezeStatusResponse) ProtoMessage() {}
 
-func (x *DeleteResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[58]
+func (x *GetFreezeStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[296]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3373,47 +18975,59 @@ func (x *DeleteResourceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteResourceResponse.ProtoReflect.Descriptor instead.
-func (*DeleteResourceResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use GetFreezeStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetFreezeStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{296}
 }
 
-func (x *DeleteResourceResponse) GetSuccess() bool {
+func (x *GetFreezeStatusResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *DeleteResourceResponse) GetMessage() string {
+func (x *GetFreezeStatusResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type GetResourceRequest struct {
+func (x *GetFreezeStatusResponse) GetStatus() *FreezeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type Orphan struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // lv, zvol, drbd-config, ha-backup or reactor-config
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	InUse         bool                   `protobuf:"varint,4,opt,name=in_use,json=inUse,proto3" json:"in_use,omitempty"` // Reported but never removed
+	Removed       bool                   `protobuf:"varint,5,opt,name=removed,proto3" json:"removed,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetResourceRequest) Reset() {
-	*x = GetResourceRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[59]
+func (x *Orphan) Reset() {
+	*x = Orphan{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[297]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetResourceRequest) String() string {
+func (x *Orphan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResourceRequest) ProtoMessage() {}
+func (*Orphan) ProtoMessage() {}
 
-func (x *GetResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[59]
+func (x *Orphan) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[297]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3424,99 +19038,75 @@ func (x *GetResourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResourceRequest.ProtoReflect.Descriptor instead.
-func (*GetResourceRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use Orphan.ProtoReflect.Descriptor instead.
+func (*Orphan) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{297}
 }
 
-func (x *GetResourceRequest) GetName() string {
+func (x *Orphan) GetKind() string {
 	if x != nil {
-		return x.Name
+		return x.Kind
 	}
 	return ""
 }
 
-type GetResourceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Resource      *ResourceInfo          `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetResourceResponse) Reset() {
-	*x = GetResourceResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[60]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetResourceResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetResourceResponse) ProtoMessage() {}
-
-func (x *GetResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[60]
+func (x *Orphan) GetNode() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Node
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetResourceResponse.ProtoReflect.Descriptor instead.
-func (*GetResourceResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{60}
+func (x *Orphan) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
 }
 
-func (x *GetResourceResponse) GetSuccess() bool {
+func (x *Orphan) GetInUse() bool {
 	if x != nil {
-		return x.Success
+		return x.InUse
 	}
 	return false
 }
 
-func (x *GetResourceResponse) GetMessage() string {
+func (x *Orphan) GetRemoved() bool {
 	if x != nil {
-		return x.Message
+		return x.Removed
 	}
-	return ""
+	return false
 }
 
-func (x *GetResourceResponse) GetResource() *ResourceInfo {
+func (x *Orphan) GetError() string {
 	if x != nil {
-		return x.Resource
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-type ListResourcesRequest struct {
+type CollectGarbageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Only list the orphans
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListResourcesRequest) Reset() {
-	*x = ListResourcesRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[61]
+func (x *CollectGarbageRequest) Reset() {
+	*x = CollectGarbageRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[298]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListResourcesRequest) String() string {
+func (x *CollectGarbageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResourcesRequest) ProtoMessage() {}
-
-func (x *ListResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[61]
+func (*CollectGarbageRequest) ProtoMessage() {}
+
+func (x *CollectGarbageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[298]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3527,35 +19117,42 @@ func (x *ListResourcesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResourcesRequest.ProtoReflect.Descriptor instead.
-func (*ListResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use CollectGarbageRequest.ProtoReflect.Descriptor instead.
+func (*CollectGarbageRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{298}
 }
 
-type ListResourcesResponse struct {
+func (x *CollectGarbageRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type CollectGarbageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Resources     []*ResourceInfo        `protobuf:"bytes,3,rep,name=resources,proto3" json:"resources,omitempty"`
+	Orphans       []*Orphan              `protobuf:"bytes,3,rep,name=orphans,proto3" json:"orphans,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListResourcesResponse) Reset() {
-	*x = ListResourcesResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[62]
+func (x *CollectGarbageResponse) Reset() {
+	*x = CollectGarbageResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[299]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListResourcesResponse) String() string {
+func (x *CollectGarbageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResourcesResponse) ProtoMessage() {}
+func (*CollectGarbageResponse) ProtoMessage() {}
 
-func (x *ListResourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[62]
+func (x *CollectGarbageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[299]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3566,57 +19163,62 @@ func (x *ListResourcesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResourcesResponse.ProtoReflect.Descriptor instead.
-func (*ListResourcesResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use CollectGarbageResponse.ProtoReflect.Descriptor instead.
+func (*CollectGarbageResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{299}
 }
 
-func (x *ListResourcesResponse) GetSuccess() bool {
+func (x *CollectGarbageResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ListResourcesResponse) GetMessage() string {
+func (x *CollectGarbageResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ListResourcesResponse) GetResources() []*ResourceInfo {
+func (x *CollectGarbageResponse) GetOrphans() []*Orphan {
 	if x != nil {
-		return x.Resources
+		return x.Orphans
 	}
 	return nil
 }
 
-type AddVolumeRequest struct {
+// Rediscovered is a database record missing for an artifact on the nodes
+type Rediscovered struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	Volume        string                 `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
-	Pool          string                 `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool,omitempty"`
-	SizeGb        uint32                 `protobuf:"varint,4,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // resource, volume or ha-config
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Nodes         []string               `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`   // Nodes the artifact was found on
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"` // Path of the artifact
+	Detail        string                 `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`
+	Skipped       string                 `protobuf:"bytes,6,opt,name=skipped,proto3" json:"skipped,omitempty"` // Why the record cannot be rebuilt
+	Restored      bool                   `protobuf:"varint,7,opt,name=restored,proto3" json:"restored,omitempty"`
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddVolumeRequest) Reset() {
-	*x = AddVolumeRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[63]
+func (x *Rediscovered) Reset() {
+	*x = Rediscovered{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[300]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddVolumeRequest) String() string {
+func (x *Rediscovered) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddVolumeRequest) ProtoMessage() {}
+func (*Rediscovered) ProtoMessage() {}
 
-func (x *AddVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[63]
+func (x *Rediscovered) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[300]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3627,114 +19229,89 @@ func (x *AddVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddVolumeRequest.ProtoReflect.Descriptor instead.
-func (*AddVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use Rediscovered.ProtoReflect.Descriptor instead.
+func (*Rediscovered) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{300}
 }
 
-func (x *AddVolumeRequest) GetResource() string {
+func (x *Rediscovered) GetKind() string {
 	if x != nil {
-		return x.Resource
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *AddVolumeRequest) GetVolume() string {
+func (x *Rediscovered) GetName() string {
 	if x != nil {
-		return x.Volume
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AddVolumeRequest) GetPool() string {
+func (x *Rediscovered) GetNodes() []string {
 	if x != nil {
-		return x.Pool
+		return x.Nodes
 	}
-	return ""
+	return nil
 }
 
-func (x *AddVolumeRequest) GetSizeGb() uint32 {
+func (x *Rediscovered) GetSource() string {
 	if x != nil {
-		return x.SizeGb
+		return x.Source
 	}
-	return 0
-}
-
-type AddVolumeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *AddVolumeResponse) Reset() {
-	*x = AddVolumeResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[64]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *AddVolumeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*AddVolumeResponse) ProtoMessage() {}
-
-func (x *AddVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[64]
+func (x *Rediscovered) GetDetail() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Detail
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AddVolumeResponse.ProtoReflect.Descriptor instead.
-func (*AddVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{64}
+func (x *Rediscovered) GetSkipped() string {
+	if x != nil {
+		return x.Skipped
+	}
+	return ""
 }
 
-func (x *AddVolumeResponse) GetSuccess() bool {
+func (x *Rediscovered) GetRestored() bool {
 	if x != nil {
-		return x.Success
+		return x.Restored
 	}
 	return false
 }
 
-func (x *AddVolumeResponse) GetMessage() string {
+func (x *Rediscovered) GetError() string {
 	if x != nil {
-		return x.Message
+		return x.Error
 	}
 	return ""
 }
 
-type RemoveVolumeRequest struct {
+type RediscoverRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	VolumeId      uint32                 `protobuf:"varint,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Only list the missing records
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveVolumeRequest) Reset() {
-	*x = RemoveVolumeRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[65]
+func (x *RediscoverRequest) Reset() {
+	*x = RediscoverRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[301]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveVolumeRequest) String() string {
+func (x *RediscoverRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveVolumeRequest) ProtoMessage() {}
+func (*RediscoverRequest) ProtoMessage() {}
 
-func (x *RemoveVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[65]
+func (x *RediscoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[301]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3745,48 +19322,42 @@ func (x *RemoveVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveVolumeRequest.ProtoReflect.Descriptor instead.
-func (*RemoveVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{65}
-}
-
-func (x *RemoveVolumeRequest) GetResource() string {
-	if x != nil {
-		return x.Resource
-	}
-	return ""
+// Deprecated: Use RediscoverRequest.ProtoReflect.Descriptor instead.
+func (*RediscoverRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{301}
 }
 
-func (x *RemoveVolumeRequest) GetVolumeId() uint32 {
+func (x *RediscoverRequest) GetDryRun() bool {
 	if x != nil {
-		return x.VolumeId
+		return x.DryRun
 	}
-	return 0
+	return false
 }
 
-type RemoveVolumeResponse struct {
+type RediscoverResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Records       []*Rediscovered        `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveVolumeResponse) Reset() {
-	*x = RemoveVolumeResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[66]
+func (x *RediscoverResponse) Reset() {
+	*x = RediscoverResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[302]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveVolumeResponse) String() string {
+func (x *RediscoverResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveVolumeResponse) ProtoMessage() {}
+func (*RediscoverResponse) ProtoMessage() {}
 
-func (x *RemoveVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[66]
+func (x *RediscoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[302]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3797,49 +19368,60 @@ func (x *RemoveVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveVolumeResponse.ProtoReflect.Descriptor instead.
-func (*RemoveVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use RediscoverResponse.ProtoReflect.Descriptor instead.
+func (*RediscoverResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{302}
 }
 
-func (x *RemoveVolumeResponse) GetSuccess() bool {
+func (x *RediscoverResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *RemoveVolumeResponse) GetMessage() string {
+func (x *RediscoverResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type ResizeVolumeRequest struct {
+func (x *RediscoverResponse) GetRecords() []*Rediscovered {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// Reconcile messages
+type Drift struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	VolumeId      uint32                 `protobuf:"varint,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
-	SizeGb        uint32                 `protobuf:"varint,3,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // resource, ha, gateway, node or drbd-global
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Node          string                 `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"` // Degraded or Drifted
+	Problem       string                 `protobuf:"bytes,5,opt,name=problem,proto3" json:"problem,omitempty"`
+	DetectedAt    int64                  `protobuf:"varint,6,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"` // Unix timestamp
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResizeVolumeRequest) Reset() {
-	*x = ResizeVolumeRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[67]
+func (x *Drift) Reset() {
+	*x = Drift{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[303]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResizeVolumeRequest) String() string {
+func (x *Drift) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResizeVolumeRequest) ProtoMessage() {}
+func (*Drift) ProtoMessage() {}
 
-func (x *ResizeVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[67]
+func (x *Drift) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[303]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3850,106 +19432,75 @@ func (x *ResizeVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResizeVolumeRequest.ProtoReflect.Descriptor instead.
-func (*ResizeVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use Drift.ProtoReflect.Descriptor instead.
+func (*Drift) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{303}
 }
 
-func (x *ResizeVolumeRequest) GetResource() string {
+func (x *Drift) GetKind() string {
 	if x != nil {
-		return x.Resource
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *ResizeVolumeRequest) GetVolumeId() uint32 {
+func (x *Drift) GetName() string {
 	if x != nil {
-		return x.VolumeId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ResizeVolumeRequest) GetSizeGb() uint32 {
+func (x *Drift) GetNode() string {
 	if x != nil {
-		return x.SizeGb
+		return x.Node
 	}
-	return 0
-}
-
-type ResizeVolumeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ResizeVolumeResponse) Reset() {
-	*x = ResizeVolumeResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[68]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ResizeVolumeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*ResizeVolumeResponse) ProtoMessage() {}
-
-func (x *ResizeVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[68]
+func (x *Drift) GetState() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.State
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ResizeVolumeResponse.ProtoReflect.Descriptor instead.
-func (*ResizeVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{68}
+	return ""
 }
 
-func (x *ResizeVolumeResponse) GetSuccess() bool {
+func (x *Drift) GetProblem() string {
 	if x != nil {
-		return x.Success
+		return x.Problem
 	}
-	return false
+	return ""
 }
 
-func (x *ResizeVolumeResponse) GetMessage() string {
+func (x *Drift) GetDetectedAt() int64 {
 	if x != nil {
-		return x.Message
+		return x.DetectedAt
 	}
-	return ""
+	return 0
 }
 
-type ResourceStatusRequest struct {
+type GetDriftReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Refresh       bool                   `protobuf:"varint,1,opt,name=refresh,proto3" json:"refresh,omitempty"` // Run a reconcile pass instead of returning the last one
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResourceStatusRequest) Reset() {
-	*x = ResourceStatusRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[69]
+func (x *GetDriftReportRequest) Reset() {
+	*x = GetDriftReportRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[304]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResourceStatusRequest) String() string {
+func (x *GetDriftReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResourceStatusRequest) ProtoMessage() {}
+func (*GetDriftReportRequest) ProtoMessage() {}
 
-func (x *ResourceStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[69]
+func (x *GetDriftReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[304]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3960,42 +19511,43 @@ func (x *ResourceStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResourceStatusRequest.ProtoReflect.Descriptor instead.
-func (*ResourceStatusRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use GetDriftReportRequest.ProtoReflect.Descriptor instead.
+func (*GetDriftReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{304}
 }
 
-func (x *ResourceStatusRequest) GetName() string {
+func (x *GetDriftReportRequest) GetRefresh() bool {
 	if x != nil {
-		return x.Name
+		return x.Refresh
 	}
-	return ""
+	return false
 }
 
-type ResourceStatusResponse struct {
+type GetDriftReportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Status        *ResourceStatus        `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	CheckedAt     int64                  `protobuf:"varint,3,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"` // Unix timestamp, 0 if no pass ran yet
+	Drifts        []*Drift               `protobuf:"bytes,4,rep,name=drifts,proto3" json:"drifts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResourceStatusResponse) Reset() {
-	*x = ResourceStatusResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[70]
+func (x *GetDriftReportResponse) Reset() {
+	*x = GetDriftReportResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[305]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResourceStatusResponse) String() string {
+func (x *GetDriftReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResourceStatusResponse) ProtoMessage() {}
+func (*GetDriftReportResponse) ProtoMessage() {}
 
-func (x *ResourceStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[70]
+func (x *GetDriftReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[305]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4006,56 +19558,62 @@ func (x *ResourceStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResourceStatusResponse.ProtoReflect.Descriptor instead.
-func (*ResourceStatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use GetDriftReportResponse.ProtoReflect.Descriptor instead.
+func (*GetDriftReportResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{305}
 }
 
-func (x *ResourceStatusResponse) GetSuccess() bool {
+func (x *GetDriftReportResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ResourceStatusResponse) GetMessage() string {
+func (x *GetDriftReportResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ResourceStatusResponse) GetStatus() *ResourceStatus {
+func (x *GetDriftReportResponse) GetCheckedAt() int64 {
 	if x != nil {
-		return x.Status
+		return x.CheckedAt
+	}
+	return 0
+}
+
+func (x *GetDriftReportResponse) GetDrifts() []*Drift {
+	if x != nil {
+		return x.Drifts
 	}
 	return nil
 }
 
-type SetPrimaryRequest struct {
+type RepairRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
-	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // resource, ha, gateway or drbd-global
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetPrimaryRequest) Reset() {
-	*x = SetPrimaryRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[71]
+func (x *RepairRequest) Reset() {
+	*x = RepairRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[306]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetPrimaryRequest) String() string {
+func (x *RepairRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPrimaryRequest) ProtoMessage() {}
+func (*RepairRequest) ProtoMessage() {}
 
-func (x *SetPrimaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[71]
+func (x *RepairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[306]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4066,55 +19624,49 @@ func (x *SetPrimaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPrimaryRequest.ProtoReflect.Descriptor instead.
-func (*SetPrimaryRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use RepairRequest.ProtoReflect.Descriptor instead.
+func (*RepairRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{306}
 }
 
-func (x *SetPrimaryRequest) GetResource() string {
+func (x *RepairRequest) GetKind() string {
 	if x != nil {
-		return x.Resource
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *SetPrimaryRequest) GetNode() string {
+func (x *RepairRequest) GetName() string {
 	if x != nil {
-		return x.Node
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SetPrimaryRequest) GetForce() bool {
-	if x != nil {
-		return x.Force
-	}
-	return false
-}
-
-type SetPrimaryResponse struct {
+type RepairResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Actions       []string               `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetPrimaryResponse) Reset() {
-	*x = SetPrimaryResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[72]
+func (x *RepairResponse) Reset() {
+	*x = RepairResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[307]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetPrimaryResponse) String() string {
+func (x *RepairResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPrimaryResponse) ProtoMessage() {}
+func (*RepairResponse) ProtoMessage() {}
 
-func (x *SetPrimaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[72]
+func (x *RepairResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[307]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4125,48 +19677,56 @@ func (x *SetPrimaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPrimaryResponse.ProtoReflect.Descriptor instead.
-func (*SetPrimaryResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use RepairResponse.ProtoReflect.Descriptor instead.
+func (*RepairResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{307}
 }
 
-func (x *SetPrimaryResponse) GetSuccess() bool {
+func (x *RepairResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *SetPrimaryResponse) GetMessage() string {
+func (x *RepairResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type SetSecondaryRequest struct {
+func (x *RepairResponse) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+// Rebalance messages
+type RebalanceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`       // Only plan the moves
+	MaxMoves      int32                  `protobuf:"varint,2,opt,name=max_moves,json=maxMoves,proto3" json:"max_moves,omitempty"` // 0 uses the configured limit
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetSecondaryRequest) Reset() {
-	*x = SetSecondaryRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[73]
+func (x *RebalanceRequest) Reset() {
+	*x = RebalanceRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[308]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetSecondaryRequest) String() string {
+func (x *RebalanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetSecondaryRequest) ProtoMessage() {}
+func (*RebalanceRequest) ProtoMessage() {}
 
-func (x *SetSecondaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[73]
+func (x *RebalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[308]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4177,48 +19737,48 @@ func (x *SetSecondaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetSecondaryRequest.ProtoReflect.Descriptor instead.
-func (*SetSecondaryRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use RebalanceRequest.ProtoReflect.Descriptor instead.
+func (*RebalanceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{308}
 }
 
-func (x *SetSecondaryRequest) GetResource() string {
+func (x *RebalanceRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Resource
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-func (x *SetSecondaryRequest) GetNode() string {
+func (x *RebalanceRequest) GetMaxMoves() int32 {
 	if x != nil {
-		return x.Node
+		return x.MaxMoves
 	}
-	return ""
+	return 0
 }
 
-type SetSecondaryResponse struct {
+type NodePrimaries struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Primaries     int32                  `protobuf:"varint,2,opt,name=primaries,proto3" json:"primaries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetSecondaryResponse) Reset() {
-	*x = SetSecondaryResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[74]
+func (x *NodePrimaries) Reset() {
+	*x = NodePrimaries{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[309]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetSecondaryResponse) String() string {
+func (x *NodePrimaries) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetSecondaryResponse) ProtoMessage() {}
+func (*NodePrimaries) ProtoMessage() {}
 
-func (x *SetSecondaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[74]
+func (x *NodePrimaries) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[309]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4229,50 +19789,51 @@ func (x *SetSecondaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetSecondaryResponse.ProtoReflect.Descriptor instead.
-func (*SetSecondaryResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use NodePrimaries.ProtoReflect.Descriptor instead.
+func (*NodePrimaries) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{309}
 }
 
-func (x *SetSecondaryResponse) GetSuccess() bool {
+func (x *NodePrimaries) GetNode() string {
 	if x != nil {
-		return x.Success
+		return x.Node
 	}
-	return false
+	return ""
 }
 
-func (x *SetSecondaryResponse) GetMessage() string {
+func (x *NodePrimaries) GetPrimaries() int32 {
 	if x != nil {
-		return x.Message
+		return x.Primaries
 	}
-	return ""
+	return 0
 }
 
-type CreateFilesystemRequest struct {
+type RebalanceMove struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	VolumeId      uint32                 `protobuf:"varint,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
-	Fstype        string                 `protobuf:"bytes,3,opt,name=fstype,proto3" json:"fstype,omitempty"`
-	Node          string                 `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
+	FromNode      string                 `protobuf:"bytes,2,opt,name=from_node,json=fromNode,proto3" json:"from_node,omitempty"`
+	ToNode        string                 `protobuf:"bytes,3,opt,name=to_node,json=toNode,proto3" json:"to_node,omitempty"`
+	Done          bool                   `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateFilesystemRequest) Reset() {
-	*x = CreateFilesystemRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[75]
+func (x *RebalanceMove) Reset() {
+	*x = RebalanceMove{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[310]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateFilesystemRequest) String() string {
+func (x *RebalanceMove) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateFilesystemRequest) ProtoMessage() {}
+func (*RebalanceMove) ProtoMessage() {}
 
-func (x *CreateFilesystemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[75]
+func (x *RebalanceMove) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[310]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4283,62 +19844,72 @@ func (x *CreateFilesystemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateFilesystemRequest.ProtoReflect.Descriptor instead.
-func (*CreateFilesystemRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use RebalanceMove.ProtoReflect.Descriptor instead.
+func (*RebalanceMove) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{310}
 }
 
-func (x *CreateFilesystemRequest) GetResource() string {
+func (x *RebalanceMove) GetResource() string {
 	if x != nil {
 		return x.Resource
 	}
 	return ""
 }
 
-func (x *CreateFilesystemRequest) GetVolumeId() uint32 {
+func (x *RebalanceMove) GetFromNode() string {
 	if x != nil {
-		return x.VolumeId
+		return x.FromNode
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateFilesystemRequest) GetFstype() string {
+func (x *RebalanceMove) GetToNode() string {
 	if x != nil {
-		return x.Fstype
+		return x.ToNode
 	}
 	return ""
 }
 
-func (x *CreateFilesystemRequest) GetNode() string {
+func (x *RebalanceMove) GetDone() bool {
 	if x != nil {
-		return x.Node
+		return x.Done
+	}
+	return false
+}
+
+func (x *RebalanceMove) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-type CreateFilesystemResponse struct {
+type RebalanceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Nodes         []*NodePrimaries       `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"` // Primaries per node before the moves
+	Moves         []*RebalanceMove       `protobuf:"bytes,4,rep,name=moves,proto3" json:"moves,omitempty"`
+	Skipped       []string               `protobuf:"bytes,5,rep,name=skipped,proto3" json:"skipped,omitempty"` // Resources left out and why
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateFilesystemResponse) Reset() {
-	*x = CreateFilesystemResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[76]
+func (x *RebalanceResponse) Reset() {
+	*x = RebalanceResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[311]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateFilesystemResponse) String() string {
+func (x *RebalanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateFilesystemResponse) ProtoMessage() {}
+func (*RebalanceResponse) ProtoMessage() {}
 
-func (x *CreateFilesystemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[76]
+func (x *RebalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[311]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4349,51 +19920,75 @@ func (x *CreateFilesystemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateFilesystemResponse.ProtoReflect.Descriptor instead.
-func (*CreateFilesystemResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use RebalanceResponse.ProtoReflect.Descriptor instead.
+func (*RebalanceResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{311}
 }
 
-func (x *CreateFilesystemResponse) GetSuccess() bool {
+func (x *RebalanceResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *CreateFilesystemResponse) GetMessage() string {
+func (x *RebalanceResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type MountResourceRequest struct {
+func (x *RebalanceResponse) GetNodes() []*NodePrimaries {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *RebalanceResponse) GetMoves() []*RebalanceMove {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+func (x *RebalanceResponse) GetSkipped() []string {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+// DRBD global config messages
+type DrbdGlobalConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	VolumeId      uint32                 `protobuf:"varint,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
-	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	Node          string                 `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
-	Fstype        string                 `protobuf:"bytes,5,opt,name=fstype,proto3" json:"fstype,omitempty"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	UsageCount    string                 `protobuf:"bytes,2,opt,name=usage_count,json=usageCount,proto3" json:"usage_count,omitempty"`                                                     // yes, no or ask
+	Disk          map[string]string      `protobuf:"bytes,3,rep,name=disk,proto3" json:"disk,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`         // common disk options
+	Net           map[string]string      `protobuf:"bytes,4,rep,name=net,proto3" json:"net,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`           // common net options, e.g. timeouts
+	Handlers      map[string]string      `protobuf:"bytes,5,rep,name=handlers,proto3" json:"handlers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // handler name -> command
+	Comment       string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MountResourceRequest) Reset() {
-	*x = MountResourceRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[77]
+func (x *DrbdGlobalConfig) Reset() {
+	*x = DrbdGlobalConfig{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[312]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MountResourceRequest) String() string {
+func (x *DrbdGlobalConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MountResourceRequest) ProtoMessage() {}
+func (*DrbdGlobalConfig) ProtoMessage() {}
 
-func (x *MountResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[77]
+func (x *DrbdGlobalConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[312]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4404,69 +19999,82 @@ func (x *MountResourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MountResourceRequest.ProtoReflect.Descriptor instead.
-func (*MountResourceRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use DrbdGlobalConfig.ProtoReflect.Descriptor instead.
+func (*DrbdGlobalConfig) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{312}
 }
 
-func (x *MountResourceRequest) GetResource() string {
+func (x *DrbdGlobalConfig) GetVersion() int32 {
 	if x != nil {
-		return x.Resource
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DrbdGlobalConfig) GetUsageCount() string {
+	if x != nil {
+		return x.UsageCount
 	}
 	return ""
 }
 
-func (x *MountResourceRequest) GetVolumeId() uint32 {
+func (x *DrbdGlobalConfig) GetDisk() map[string]string {
 	if x != nil {
-		return x.VolumeId
+		return x.Disk
 	}
-	return 0
+	return nil
 }
 
-func (x *MountResourceRequest) GetPath() string {
+func (x *DrbdGlobalConfig) GetNet() map[string]string {
 	if x != nil {
-		return x.Path
+		return x.Net
 	}
-	return ""
+	return nil
 }
 
-func (x *MountResourceRequest) GetNode() string {
+func (x *DrbdGlobalConfig) GetHandlers() map[string]string {
 	if x != nil {
-		return x.Node
+		return x.Handlers
 	}
-	return ""
+	return nil
 }
 
-func (x *MountResourceRequest) GetFstype() string {
+func (x *DrbdGlobalConfig) GetComment() string {
 	if x != nil {
-		return x.Fstype
+		return x.Comment
 	}
 	return ""
 }
 
-type MountResourceResponse struct {
+func (x *DrbdGlobalConfig) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type GetDrbdGlobalConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // 0 for the latest
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MountResourceResponse) Reset() {
-	*x = MountResourceResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[78]
+func (x *GetDrbdGlobalConfigRequest) Reset() {
+	*x = GetDrbdGlobalConfigRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[313]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MountResourceResponse) String() string {
+func (x *GetDrbdGlobalConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MountResourceResponse) ProtoMessage() {}
+func (*GetDrbdGlobalConfigRequest) ProtoMessage() {}
 
-func (x *MountResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[78]
+func (x *GetDrbdGlobalConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[313]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4477,49 +20085,43 @@ func (x *MountResourceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MountResourceResponse.ProtoReflect.Descriptor instead.
-func (*MountResourceResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{78}
-}
-
-func (x *MountResourceResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use GetDrbdGlobalConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetDrbdGlobalConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{313}
 }
 
-func (x *MountResourceResponse) GetMessage() string {
+func (x *GetDrbdGlobalConfigRequest) GetVersion() int32 {
 	if x != nil {
-		return x.Message
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-type UnmountResourceRequest struct {
+type GetDrbdGlobalConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	VolumeId      uint32                 `protobuf:"varint,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
-	Node          string                 `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Config        *DrbdGlobalConfig      `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"` // Rendered global_common.conf
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnmountResourceRequest) Reset() {
-	*x = UnmountResourceRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[79]
+func (x *GetDrbdGlobalConfigResponse) Reset() {
+	*x = GetDrbdGlobalConfigResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[314]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnmountResourceRequest) String() string {
+func (x *GetDrbdGlobalConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnmountResourceRequest) ProtoMessage() {}
+func (*GetDrbdGlobalConfigResponse) ProtoMessage() {}
 
-func (x *UnmountResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[79]
+func (x *GetDrbdGlobalConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[314]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4530,55 +20132,62 @@ func (x *UnmountResourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnmountResourceRequest.ProtoReflect.Descriptor instead.
-func (*UnmountResourceRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use GetDrbdGlobalConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetDrbdGlobalConfigResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{314}
 }
 
-func (x *UnmountResourceRequest) GetResource() string {
+func (x *GetDrbdGlobalConfigResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Resource
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetDrbdGlobalConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *UnmountResourceRequest) GetVolumeId() uint32 {
+func (x *GetDrbdGlobalConfigResponse) GetConfig() *DrbdGlobalConfig {
 	if x != nil {
-		return x.VolumeId
+		return x.Config
 	}
-	return 0
+	return nil
 }
 
-func (x *UnmountResourceRequest) GetNode() string {
+func (x *GetDrbdGlobalConfigResponse) GetContent() string {
 	if x != nil {
-		return x.Node
+		return x.Content
 	}
 	return ""
 }
 
-type UnmountResourceResponse struct {
+type SetDrbdGlobalConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Config        *DrbdGlobalConfig      `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"` // Replaces the whole definition, version is ignored
+	Apply         bool                   `protobuf:"varint,2,opt,name=apply,proto3" json:"apply,omitempty"`  // Run drbdadm adjust all on the nodes
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnmountResourceResponse) Reset() {
-	*x = UnmountResourceResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[80]
+func (x *SetDrbdGlobalConfigRequest) Reset() {
+	*x = SetDrbdGlobalConfigRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[315]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnmountResourceResponse) String() string {
+func (x *SetDrbdGlobalConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnmountResourceResponse) ProtoMessage() {}
+func (*SetDrbdGlobalConfigRequest) ProtoMessage() {}
 
-func (x *UnmountResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[80]
+func (x *SetDrbdGlobalConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[315]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4589,51 +20198,50 @@ func (x *UnmountResourceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnmountResourceResponse.ProtoReflect.Descriptor instead.
-func (*UnmountResourceResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use SetDrbdGlobalConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetDrbdGlobalConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{315}
 }
 
-func (x *UnmountResourceResponse) GetSuccess() bool {
+func (x *SetDrbdGlobalConfigRequest) GetConfig() *DrbdGlobalConfig {
 	if x != nil {
-		return x.Success
+		return x.Config
 	}
-	return false
+	return nil
 }
 
-func (x *UnmountResourceResponse) GetMessage() string {
+func (x *SetDrbdGlobalConfigRequest) GetApply() bool {
 	if x != nil {
-		return x.Message
+		return x.Apply
 	}
-	return ""
+	return false
 }
 
-type MakeHaRequest struct {
+type SetDrbdGlobalConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	Services      []string               `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`                       // systemd services to start/stop
-	MountPoint    string                 `protobuf:"bytes,3,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"` // optional mount point
-	Fstype        string                 `protobuf:"bytes,4,opt,name=fstype,proto3" json:"fstype,omitempty"`                           // filesystem type (if mount_point specified)
-	Vip           string                 `protobuf:"bytes,5,opt,name=vip,proto3" json:"vip,omitempty"`                                 // optional virtual IP (CIDR, e.g., "192.168.1.100/24")
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	FailedNodes   []string               `protobuf:"bytes,4,rep,name=failed_nodes,json=failedNodes,proto3" json:"failed_nodes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MakeHaRequest) Reset() {
-	*x = MakeHaRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[81]
+func (x *SetDrbdGlobalConfigResponse) Reset() {
+	*x = SetDrbdGlobalConfigResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[316]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MakeHaRequest) String() string {
+func (x *SetDrbdGlobalConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MakeHaRequest) ProtoMessage() {}
+func (*SetDrbdGlobalConfigResponse) ProtoMessage() {}
 
-func (x *MakeHaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[81]
+func (x *SetDrbdGlobalConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[316]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4644,70 +20252,99 @@ func (x *MakeHaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MakeHaRequest.ProtoReflect.Descriptor instead.
-func (*MakeHaRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use SetDrbdGlobalConfigResponse.ProtoReflect.Descriptor instead.
+func (*SetDrbdGlobalConfigResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{316}
 }
 
-func (x *MakeHaRequest) GetResource() string {
+func (x *SetDrbdGlobalConfigResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Resource
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *MakeHaRequest) GetServices() []string {
+func (x *SetDrbdGlobalConfigResponse) GetMessage() string {
 	if x != nil {
-		return x.Services
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *MakeHaRequest) GetMountPoint() string {
+func (x *SetDrbdGlobalConfigResponse) GetVersion() int32 {
 	if x != nil {
-		return x.MountPoint
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *MakeHaRequest) GetFstype() string {
+func (x *SetDrbdGlobalConfigResponse) GetFailedNodes() []string {
 	if x != nil {
-		return x.Fstype
+		return x.FailedNodes
 	}
-	return ""
+	return nil
 }
 
-func (x *MakeHaRequest) GetVip() string {
+type ListDrbdGlobalConfigsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDrbdGlobalConfigsRequest) Reset() {
+	*x = ListDrbdGlobalConfigsRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[317]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDrbdGlobalConfigsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDrbdGlobalConfigsRequest) ProtoMessage() {}
+
+func (x *ListDrbdGlobalConfigsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[317]
 	if x != nil {
-		return x.Vip
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type MakeHaResponse struct {
+// Deprecated: Use ListDrbdGlobalConfigsRequest.ProtoReflect.Descriptor instead.
+func (*ListDrbdGlobalConfigsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{317}
+}
+
+type ListDrbdGlobalConfigsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	ConfigPath    string                 `protobuf:"bytes,3,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"` // path to generated promoter config
+	Configs       []*DrbdGlobalConfig    `protobuf:"bytes,3,rep,name=configs,proto3" json:"configs,omitempty"` // Newest first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MakeHaResponse) Reset() {
-	*x = MakeHaResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[82]
+func (x *ListDrbdGlobalConfigsResponse) Reset() {
+	*x = ListDrbdGlobalConfigsResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[318]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MakeHaResponse) String() string {
+func (x *ListDrbdGlobalConfigsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MakeHaResponse) ProtoMessage() {}
+func (*ListDrbdGlobalConfigsResponse) ProtoMessage() {}
 
-func (x *MakeHaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[82]
+func (x *ListDrbdGlobalConfigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[318]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4718,54 +20355,55 @@ func (x *MakeHaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MakeHaResponse.ProtoReflect.Descriptor instead.
-func (*MakeHaResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use ListDrbdGlobalConfigsResponse.ProtoReflect.Descriptor instead.
+func (*ListDrbdGlobalConfigsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{318}
 }
 
-func (x *MakeHaResponse) GetSuccess() bool {
+func (x *ListDrbdGlobalConfigsResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *MakeHaResponse) GetMessage() string {
+func (x *ListDrbdGlobalConfigsResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *MakeHaResponse) GetConfigPath() string {
+func (x *ListDrbdGlobalConfigsResponse) GetConfigs() []*DrbdGlobalConfig {
 	if x != nil {
-		return x.ConfigPath
+		return x.Configs
 	}
-	return ""
+	return nil
 }
 
-type EvictHaRequest struct {
+type RollbackDrbdGlobalConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Apply         bool                   `protobuf:"varint,2,opt,name=apply,proto3" json:"apply,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EvictHaRequest) Reset() {
-	*x = EvictHaRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[83]
+func (x *RollbackDrbdGlobalConfigRequest) Reset() {
+	*x = RollbackDrbdGlobalConfigRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[319]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EvictHaRequest) String() string {
+func (x *RollbackDrbdGlobalConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EvictHaRequest) ProtoMessage() {}
+func (*RollbackDrbdGlobalConfigRequest) ProtoMessage() {}
 
-func (x *EvictHaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[83]
+func (x *RollbackDrbdGlobalConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[319]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4776,41 +20414,50 @@ func (x *EvictHaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EvictHaRequest.ProtoReflect.Descriptor instead.
-func (*EvictHaRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use RollbackDrbdGlobalConfigRequest.ProtoReflect.Descriptor instead.
+func (*RollbackDrbdGlobalConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{319}
 }
 
-func (x *EvictHaRequest) GetResource() string {
+func (x *RollbackDrbdGlobalConfigRequest) GetVersion() int32 {
 	if x != nil {
-		return x.Resource
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-type EvictHaResponse struct {
+func (x *RollbackDrbdGlobalConfigRequest) GetApply() bool {
+	if x != nil {
+		return x.Apply
+	}
+	return false
+}
+
+type RollbackDrbdGlobalConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // The new version
+	FailedNodes   []string               `protobuf:"bytes,4,rep,name=failed_nodes,json=failedNodes,proto3" json:"failed_nodes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EvictHaResponse) Reset() {
-	*x = EvictHaResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[84]
+func (x *RollbackDrbdGlobalConfigResponse) Reset() {
+	*x = RollbackDrbdGlobalConfigResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[320]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EvictHaResponse) String() string {
+func (x *RollbackDrbdGlobalConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EvictHaResponse) ProtoMessage() {}
+func (*RollbackDrbdGlobalConfigResponse) ProtoMessage() {}
 
-func (x *EvictHaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[84]
+func (x *RollbackDrbdGlobalConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[320]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4821,53 +20468,70 @@ func (x *EvictHaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EvictHaResponse.ProtoReflect.Descriptor instead.
-func (*EvictHaResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use RollbackDrbdGlobalConfigResponse.ProtoReflect.Descriptor instead.
+func (*RollbackDrbdGlobalConfigResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{320}
 }
 
-func (x *EvictHaResponse) GetSuccess() bool {
+func (x *RollbackDrbdGlobalConfigResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *EvictHaResponse) GetMessage() string {
+func (x *RollbackDrbdGlobalConfigResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type ResourceInfo struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	Name          string                        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Port          uint32                        `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	Protocol      string                        `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Nodes         []string                      `protobuf:"bytes,4,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	Role          string                        `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
-	Volumes       []*VolumeInfo                 `protobuf:"bytes,6,rep,name=volumes,proto3" json:"volumes,omitempty"`
-	NodeStates    map[string]*NodeResourceState `protobuf:"bytes,7,rep,name=node_states,json=nodeStates,proto3" json:"node_states,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+func (x *RollbackDrbdGlobalConfigResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RollbackDrbdGlobalConfigResponse) GetFailedNodes() []string {
+	if x != nil {
+		return x.FailedNodes
+	}
+	return nil
+}
+
+// Job messages
+type JobStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // e.g. lvcreate, drbdadm create-md or DistributeConfig
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Command       string                 `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`                       // Redacted and truncated
+	StartedAt     int64                  `protobuf:"varint,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"` // Unix timestamp in milliseconds
+	DurationMs    int64                  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Success       bool                   `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`
+	Slow          bool                   `protobuf:"varint,7,opt,name=slow,proto3" json:"slow,omitempty"` // Took longer than jobs.slow_command
+	ExitCode      int32                  `protobuf:"varint,8,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Output        string                 `protobuf:"bytes,9,opt,name=output,proto3" json:"output,omitempty"` // Redacted, truncated to its end
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResourceInfo) Reset() {
-	*x = ResourceInfo{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[85]
+func (x *JobStep) Reset() {
+	*x = JobStep{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[321]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResourceInfo) String() string {
+func (x *JobStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResourceInfo) ProtoMessage() {}
+func (*JobStep) ProtoMessage() {}
 
-func (x *ResourceInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[85]
+func (x *JobStep) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[321]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4878,86 +20542,110 @@ func (x *ResourceInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResourceInfo.ProtoReflect.Descriptor instead.
-func (*ResourceInfo) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use JobStep.ProtoReflect.Descriptor instead.
+func (*JobStep) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{321}
 }
 
-func (x *ResourceInfo) GetName() string {
+func (x *JobStep) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ResourceInfo) GetPort() uint32 {
+func (x *JobStep) GetNode() string {
 	if x != nil {
-		return x.Port
+		return x.Node
 	}
-	return 0
+	return ""
 }
 
-func (x *ResourceInfo) GetProtocol() string {
+func (x *JobStep) GetCommand() string {
 	if x != nil {
-		return x.Protocol
+		return x.Command
 	}
 	return ""
 }
 
-func (x *ResourceInfo) GetNodes() []string {
+func (x *JobStep) GetStartedAt() int64 {
 	if x != nil {
-		return x.Nodes
+		return x.StartedAt
 	}
-	return nil
+	return 0
 }
 
-func (x *ResourceInfo) GetRole() string {
+func (x *JobStep) GetDurationMs() int64 {
 	if x != nil {
-		return x.Role
+		return x.DurationMs
 	}
-	return ""
+	return 0
 }
 
-func (x *ResourceInfo) GetVolumes() []*VolumeInfo {
+func (x *JobStep) GetSuccess() bool {
 	if x != nil {
-		return x.Volumes
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *ResourceInfo) GetNodeStates() map[string]*NodeResourceState {
+func (x *JobStep) GetSlow() bool {
 	if x != nil {
-		return x.NodeStates
+		return x.Slow
 	}
-	return nil
+	return false
 }
 
-type ResourceStatus struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	Name          string                        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Role          string                        `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
-	Nodes         []string                      `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	NodeStates    map[string]*NodeResourceState `protobuf:"bytes,4,rep,name=node_states,json=nodeStates,proto3" json:"node_states,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Volumes       []*VolumeInfo                 `protobuf:"bytes,5,rep,name=volumes,proto3" json:"volumes,omitempty"`
+func (x *JobStep) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *JobStep) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type JobInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Operation     string                 `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	Target        string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"` // running, succeeded, failed, cancelled or interrupted
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`    // Unix timestamp in milliseconds
+	FinishedAt    int64                  `protobuf:"varint,7,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"` // 0 while running
+	StepCount     int32                  `protobuf:"varint,8,opt,name=step_count,json=stepCount,proto3" json:"step_count,omitempty"`
+	SlowSteps     int32                  `protobuf:"varint,9,opt,name=slow_steps,json=slowSteps,proto3" json:"slow_steps,omitempty"`
+	Steps         []*JobStep             `protobuf:"bytes,10,rep,name=steps,proto3" json:"steps,omitempty"`                              // Only set by GetJob
+	Step          string                 `protobuf:"bytes,11,opt,name=step,proto3" json:"step,omitempty"`                                // Orchestration step journaled last
+	Resumable     bool                   `protobuf:"varint,12,opt,name=resumable,proto3" json:"resumable,omitempty"`                     // Interrupted with its request journaled
+	Rollback      string                 `protobuf:"bytes,13,opt,name=rollback,proto3" json:"rollback,omitempty"`                        // Operation that rolls an interrupted job back, if any
+	ResolvedBy    int64                  `protobuf:"varint,14,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"` // Job that resumed or rolled back an interrupted job
+	Resolution    string                 `protobuf:"bytes,15,opt,name=resolution,proto3" json:"resolution,omitempty"`                    // resumed or rolled back
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResourceStatus) Reset() {
-	*x = ResourceStatus{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[86]
+func (x *JobInfo) Reset() {
+	*x = JobInfo{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[322]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResourceStatus) String() string {
+func (x *JobInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResourceStatus) ProtoMessage() {}
+func (*JobInfo) ProtoMessage() {}
 
-func (x *ResourceStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[86]
+func (x *JobInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[322]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4968,130 +20656,140 @@ func (x *ResourceStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResourceStatus.ProtoReflect.Descriptor instead.
-func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use JobInfo.ProtoReflect.Descriptor instead.
+func (*JobInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{322}
 }
 
-func (x *ResourceStatus) GetName() string {
+func (x *JobInfo) GetId() int64 {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *ResourceStatus) GetRole() string {
+func (x *JobInfo) GetOperation() string {
 	if x != nil {
-		return x.Role
+		return x.Operation
 	}
 	return ""
 }
 
-func (x *ResourceStatus) GetNodes() []string {
+func (x *JobInfo) GetTarget() string {
 	if x != nil {
-		return x.Nodes
+		return x.Target
 	}
-	return nil
+	return ""
 }
 
-func (x *ResourceStatus) GetNodeStates() map[string]*NodeResourceState {
+func (x *JobInfo) GetState() string {
 	if x != nil {
-		return x.NodeStates
+		return x.State
 	}
-	return nil
+	return ""
 }
 
-func (x *ResourceStatus) GetVolumes() []*VolumeInfo {
+func (x *JobInfo) GetMessage() string {
 	if x != nil {
-		return x.Volumes
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type NodeResourceState struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Role             string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	DiskState        string                 `protobuf:"bytes,2,opt,name=disk_state,json=diskState,proto3" json:"disk_state,omitempty"`
-	ReplicationState string                 `protobuf:"bytes,3,opt,name=replication_state,json=replicationState,proto3" json:"replication_state,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *JobInfo) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
 }
 
-func (x *NodeResourceState) Reset() {
-	*x = NodeResourceState{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[87]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *JobInfo) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
 }
 
-func (x *NodeResourceState) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *JobInfo) GetStepCount() int32 {
+	if x != nil {
+		return x.StepCount
+	}
+	return 0
 }
 
-func (*NodeResourceState) ProtoMessage() {}
+func (x *JobInfo) GetSlowSteps() int32 {
+	if x != nil {
+		return x.SlowSteps
+	}
+	return 0
+}
 
-func (x *NodeResourceState) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[87]
+func (x *JobInfo) GetSteps() []*JobStep {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *JobInfo) GetStep() string {
+	if x != nil {
+		return x.Step
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use NodeResourceState.ProtoReflect.Descriptor instead.
-func (*NodeResourceState) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{87}
+func (x *JobInfo) GetResumable() bool {
+	if x != nil {
+		return x.Resumable
+	}
+	return false
 }
 
-func (x *NodeResourceState) GetRole() string {
+func (x *JobInfo) GetRollback() string {
 	if x != nil {
-		return x.Role
+		return x.Rollback
 	}
 	return ""
 }
 
-func (x *NodeResourceState) GetDiskState() string {
+func (x *JobInfo) GetResolvedBy() int64 {
 	if x != nil {
-		return x.DiskState
+		return x.ResolvedBy
 	}
-	return ""
+	return 0
 }
 
-func (x *NodeResourceState) GetReplicationState() string {
+func (x *JobInfo) GetResolution() string {
 	if x != nil {
-		return x.ReplicationState
+		return x.Resolution
 	}
 	return ""
 }
 
-type VolumeInfo struct {
+type ListJobsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VolumeId      uint32                 `protobuf:"varint,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
-	Device        string                 `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
-	SizeGb        uint64                 `protobuf:"varint,3,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"` // Only jobs of this resource or object
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`  // 0 for the default of 50
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`   // Only jobs in this state, e.g. interrupted
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VolumeInfo) Reset() {
-	*x = VolumeInfo{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[88]
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[323]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VolumeInfo) String() string {
+func (x *ListJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolumeInfo) ProtoMessage() {}
+func (*ListJobsRequest) ProtoMessage() {}
 
-func (x *VolumeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[88]
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[323]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5102,57 +20800,56 @@ func (x *VolumeInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolumeInfo.ProtoReflect.Descriptor instead.
-func (*VolumeInfo) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{323}
 }
 
-func (x *VolumeInfo) GetVolumeId() uint32 {
+func (x *ListJobsRequest) GetTarget() string {
 	if x != nil {
-		return x.VolumeId
+		return x.Target
 	}
-	return 0
+	return ""
 }
 
-func (x *VolumeInfo) GetDevice() string {
+func (x *ListJobsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Device
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *VolumeInfo) GetSizeGb() uint64 {
+func (x *ListJobsRequest) GetState() string {
 	if x != nil {
-		return x.SizeGb
+		return x.State
 	}
-	return 0
+	return ""
 }
 
-// Snapshot messages
-type CreateSnapshotRequest struct {
+type ListJobsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        string                 `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
-	SnapshotName  string                 `protobuf:"bytes,2,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"`
-	Node          string                 `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Jobs          []*JobInfo             `protobuf:"bytes,3,rep,name=jobs,proto3" json:"jobs,omitempty"` // Newest first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSnapshotRequest) Reset() {
-	*x = CreateSnapshotRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[89]
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[324]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSnapshotRequest) String() string {
+func (x *ListJobsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSnapshotRequest) ProtoMessage() {}
+func (*ListJobsResponse) ProtoMessage() {}
 
-func (x *CreateSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[89]
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[324]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5163,55 +20860,54 @@ func (x *CreateSnapshotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSnapshotRequest.ProtoReflect.Descriptor instead.
-func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{324}
 }
 
-func (x *CreateSnapshotRequest) GetVolume() string {
+func (x *ListJobsResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Volume
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *CreateSnapshotRequest) GetSnapshotName() string {
+func (x *ListJobsResponse) GetMessage() string {
 	if x != nil {
-		return x.SnapshotName
+		return x.Message
 	}
 	return ""
 }
 
-func (x *CreateSnapshotRequest) GetNode() string {
+func (x *ListJobsResponse) GetJobs() []*JobInfo {
 	if x != nil {
-		return x.Node
+		return x.Jobs
 	}
-	return ""
+	return nil
 }
 
-type CreateSnapshotResponse struct {
+type GetJobRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSnapshotResponse) Reset() {
-	*x = CreateSnapshotResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[90]
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[325]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSnapshotResponse) String() string {
+func (x *GetJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSnapshotResponse) ProtoMessage() {}
+func (*GetJobRequest) ProtoMessage() {}
 
-func (x *CreateSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[90]
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[325]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5222,49 +20918,42 @@ func (x *CreateSnapshotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSnapshotResponse.ProtoReflect.Descriptor instead.
-func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{90}
-}
-
-func (x *CreateSnapshotResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{325}
 }
 
-func (x *CreateSnapshotResponse) GetMessage() string {
+func (x *GetJobRequest) GetId() int64 {
 	if x != nil {
-		return x.Message
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type DeleteSnapshotRequest struct {
+type GetJobResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        string                 `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
-	SnapshotName  string                 `protobuf:"bytes,2,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"`
-	Node          string                 `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Job           *JobInfo               `protobuf:"bytes,3,opt,name=job,proto3" json:"job,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSnapshotRequest) Reset() {
-	*x = DeleteSnapshotRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[91]
+func (x *GetJobResponse) Reset() {
+	*x = GetJobResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[326]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSnapshotRequest) String() string {
+func (x *GetJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSnapshotRequest) ProtoMessage() {}
+func (*GetJobResponse) ProtoMessage() {}
 
-func (x *DeleteSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[91]
+func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[326]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5275,55 +20964,54 @@ func (x *DeleteSnapshotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSnapshotRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
+func (*GetJobResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{326}
 }
 
-func (x *DeleteSnapshotRequest) GetVolume() string {
+func (x *GetJobResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Volume
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *DeleteSnapshotRequest) GetSnapshotName() string {
+func (x *GetJobResponse) GetMessage() string {
 	if x != nil {
-		return x.SnapshotName
+		return x.Message
 	}
 	return ""
 }
 
-func (x *DeleteSnapshotRequest) GetNode() string {
+func (x *GetJobResponse) GetJob() *JobInfo {
 	if x != nil {
-		return x.Node
+		return x.Job
 	}
-	return ""
+	return nil
 }
 
-type DeleteSnapshotResponse struct {
+type ResumeJobRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // An interrupted job
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSnapshotResponse) Reset() {
-	*x = DeleteSnapshotResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[92]
+func (x *ResumeJobRequest) Reset() {
+	*x = ResumeJobRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[327]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSnapshotResponse) String() string {
+func (x *ResumeJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSnapshotResponse) ProtoMessage() {}
+func (*ResumeJobRequest) ProtoMessage() {}
 
-func (x *DeleteSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[92]
+func (x *ResumeJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[327]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5334,49 +21022,42 @@ func (x *DeleteSnapshotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSnapshotResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{92}
-}
-
-func (x *DeleteSnapshotResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use ResumeJobRequest.ProtoReflect.Descriptor instead.
+func (*ResumeJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{327}
 }
 
-func (x *DeleteSnapshotResponse) GetMessage() string {
+func (x *ResumeJobRequest) GetId() int64 {
 	if x != nil {
-		return x.Message
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type RestoreSnapshotRequest struct {
+type ResumeJobResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        string                 `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
-	SnapshotName  string                 `protobuf:"bytes,2,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"`
-	Node          string                 `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	JobId         int64                  `protobuf:"varint,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // The job that ran the operation again
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestoreSnapshotRequest) Reset() {
-	*x = RestoreSnapshotRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[93]
+func (x *ResumeJobResponse) Reset() {
+	*x = ResumeJobResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[328]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestoreSnapshotRequest) String() string {
+func (x *ResumeJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreSnapshotRequest) ProtoMessage() {}
+func (*ResumeJobResponse) ProtoMessage() {}
 
-func (x *RestoreSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[93]
+func (x *ResumeJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[328]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5387,55 +21068,54 @@ func (x *RestoreSnapshotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreSnapshotRequest.ProtoReflect.Descriptor instead.
-func (*RestoreSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use ResumeJobResponse.ProtoReflect.Descriptor instead.
+func (*ResumeJobResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{328}
 }
 
-func (x *RestoreSnapshotRequest) GetVolume() string {
+func (x *ResumeJobResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Volume
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *RestoreSnapshotRequest) GetSnapshotName() string {
+func (x *ResumeJobResponse) GetMessage() string {
 	if x != nil {
-		return x.SnapshotName
+		return x.Message
 	}
 	return ""
 }
 
-func (x *RestoreSnapshotRequest) GetNode() string {
+func (x *ResumeJobResponse) GetJobId() int64 {
 	if x != nil {
-		return x.Node
+		return x.JobId
 	}
-	return ""
+	return 0
 }
 
-type RestoreSnapshotResponse struct {
+type RollbackJobRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // An interrupted job
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestoreSnapshotResponse) Reset() {
-	*x = RestoreSnapshotResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[94]
+func (x *RollbackJobRequest) Reset() {
+	*x = RollbackJobRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[329]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestoreSnapshotResponse) String() string {
+func (x *RollbackJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreSnapshotResponse) ProtoMessage() {}
+func (*RollbackJobRequest) ProtoMessage() {}
 
-func (x *RestoreSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[94]
+func (x *RollbackJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[329]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5446,48 +21126,42 @@ func (x *RestoreSnapshotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreSnapshotResponse.ProtoReflect.Descriptor instead.
-func (*RestoreSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{94}
-}
-
-func (x *RestoreSnapshotResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use RollbackJobRequest.ProtoReflect.Descriptor instead.
+func (*RollbackJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{329}
 }
 
-func (x *RestoreSnapshotResponse) GetMessage() string {
+func (x *RollbackJobRequest) GetId() int64 {
 	if x != nil {
-		return x.Message
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type ListSnapshotsRequest struct {
+type RollbackJobResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        string                 `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
-	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	JobId         int64                  `protobuf:"varint,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // The job that ran the rollback operation
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSnapshotsRequest) Reset() {
-	*x = ListSnapshotsRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[95]
+func (x *RollbackJobResponse) Reset() {
+	*x = RollbackJobResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[330]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSnapshotsRequest) String() string {
+func (x *RollbackJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSnapshotsRequest) ProtoMessage() {}
+func (*RollbackJobResponse) ProtoMessage() {}
 
-func (x *ListSnapshotsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[95]
+func (x *RollbackJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[330]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5498,49 +21172,54 @@ func (x *ListSnapshotsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSnapshotsRequest.ProtoReflect.Descriptor instead.
-func (*ListSnapshotsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use RollbackJobResponse.ProtoReflect.Descriptor instead.
+func (*RollbackJobResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{330}
 }
 
-func (x *ListSnapshotsRequest) GetVolume() string {
+func (x *RollbackJobResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Volume
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *ListSnapshotsRequest) GetNode() string {
+func (x *RollbackJobResponse) GetMessage() string {
 	if x != nil {
-		return x.Node
+		return x.Message
 	}
 	return ""
 }
 
-type ListSnapshotsResponse struct {
+func (x *RollbackJobResponse) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type WatchJobProgressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Snapshots     []*SnapshotInfo        `protobuf:"bytes,3,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	ProgressId    string                 `protobuf:"bytes,1,opt,name=progress_id,json=progressId,proto3" json:"progress_id,omitempty"` // Sent by the call in x-sds-progress-id, may be watched before the call
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSnapshotsResponse) Reset() {
-	*x = ListSnapshotsResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[96]
+func (x *WatchJobProgressRequest) Reset() {
+	*x = WatchJobProgressRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[331]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSnapshotsResponse) String() string {
+func (x *WatchJobProgressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSnapshotsResponse) ProtoMessage() {}
+func (*WatchJobProgressRequest) ProtoMessage() {}
 
-func (x *ListSnapshotsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[96]
+func (x *WatchJobProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[331]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5551,57 +21230,49 @@ func (x *ListSnapshotsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSnapshotsResponse.ProtoReflect.Descriptor instead.
-func (*ListSnapshotsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{96}
-}
-
-func (x *ListSnapshotsResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *ListSnapshotsResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use WatchJobProgressRequest.ProtoReflect.Descriptor instead.
+func (*WatchJobProgressRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{331}
 }
 
-func (x *ListSnapshotsResponse) GetSnapshots() []*SnapshotInfo {
+func (x *WatchJobProgressRequest) GetProgressId() string {
 	if x != nil {
-		return x.Snapshots
+		return x.ProgressId
 	}
-	return nil
+	return ""
 }
 
-type SnapshotInfo struct {
+// JobProgress is an event of a running job
+type JobProgress struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Volume        string                 `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
-	SizeGb        uint64                 `protobuf:"varint,3,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // step, command or done
+	JobId         int64                  `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Step          string                 `protobuf:"bytes,3,opt,name=step,proto3" json:"step,omitempty"`                                // Orchestration step, or the command that ran, e.g. "drbdadm up"
+	Label         string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`                              // Description of an orchestration step
+	Node          string                 `protobuf:"bytes,5,opt,name=node,proto3" json:"node,omitempty"`                                // Of a command
+	Success       bool                   `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`                         // Of a command
+	DurationMs    int64                  `protobuf:"varint,7,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"` // Of a command
+	State         string                 `protobuf:"bytes,8,opt,name=state,proto3" json:"state,omitempty"`                              // Of the finished job
+	Message       string                 `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`                          // Output of a failed command, the message of the finished job
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SnapshotInfo) Reset() {
-	*x = SnapshotInfo{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[97]
+func (x *JobProgress) Reset() {
+	*x = JobProgress{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[332]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SnapshotInfo) String() string {
+func (x *JobProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SnapshotInfo) ProtoMessage() {}
+func (*JobProgress) ProtoMessage() {}
 
-func (x *SnapshotInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[97]
+func (x *JobProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[332]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5612,67 +21283,106 @@ func (x *SnapshotInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SnapshotInfo.ProtoReflect.Descriptor instead.
-func (*SnapshotInfo) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use JobProgress.ProtoReflect.Descriptor instead.
+func (*JobProgress) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{332}
 }
 
-func (x *SnapshotInfo) GetName() string {
+func (x *JobProgress) GetKind() string {
 	if x != nil {
-		return x.Name
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *SnapshotInfo) GetVolume() string {
+func (x *JobProgress) GetJobId() int64 {
 	if x != nil {
-		return x.Volume
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *JobProgress) GetStep() string {
+	if x != nil {
+		return x.Step
 	}
 	return ""
 }
 
-func (x *SnapshotInfo) GetSizeGb() uint64 {
+func (x *JobProgress) GetLabel() string {
 	if x != nil {
-		return x.SizeGb
+		return x.Label
+	}
+	return ""
+}
+
+func (x *JobProgress) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *JobProgress) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *JobProgress) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
 	}
 	return 0
 }
 
-func (x *SnapshotInfo) GetCreatedAt() string {
+func (x *JobProgress) GetState() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.State
 	}
 	return ""
 }
 
-// Gateway messages
-type CreateNFSGatewayRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`                                                                         // DRBD resource name
-	ServiceIp     string                 `protobuf:"bytes,2,opt,name=service_ip,json=serviceIp,proto3" json:"service_ip,omitempty"`                                                      // Service IP (e.g., "192.168.1.200/24")
-	ExportPath    string                 `protobuf:"bytes,3,opt,name=export_path,json=exportPath,proto3" json:"export_path,omitempty"`                                                   // Base export path (e.g., "/data")
-	AllowedIps    []string               `protobuf:"bytes,4,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`                                                   // Allowed client IPs (e.g., ["192.168.1.0/24"])
-	FsType        string                 `protobuf:"bytes,5,opt,name=fs_type,json=fsType,proto3" json:"fs_type,omitempty"`                                                               // Filesystem type (ext4, xfs)
-	Options       map[string]string      `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Additional options
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *JobProgress) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-func (x *CreateNFSGatewayRequest) Reset() {
-	*x = CreateNFSGatewayRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[98]
+// NetProbe is the latest measurement of the link between two nodes
+type NetProbe struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Source         string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target         string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Method         string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`                                         // iperf3 or tcp
+	LatencyMs      float64                `protobuf:"fixed64,4,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`                // Average round trip time, 0 if not measured
+	ThroughputMbps float64                `protobuf:"fixed64,5,opt,name=throughput_mbps,json=throughputMbps,proto3" json:"throughput_mbps,omitempty"` // From source to target, 0 if not measured
+	RequiredMbps   float64                `protobuf:"fixed64,6,opt,name=required_mbps,json=requiredMbps,proto3" json:"required_mbps,omitempty"`       // Highest sync rate of the resources on the link
+	Protocol       string                 `protobuf:"bytes,7,opt,name=protocol,proto3" json:"protocol,omitempty"`                                     // C if any resource on the link uses protocol C
+	Warnings       []string               `protobuf:"bytes,8,rep,name=warnings,proto3" json:"warnings,omitempty"`                                     // The link is below the requirements
+	Error          string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	ProbedAt       int64                  `protobuf:"varint,10,opt,name=probed_at,json=probedAt,proto3" json:"probed_at,omitempty"` // Unix timestamp
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NetProbe) Reset() {
+	*x = NetProbe{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[333]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNFSGatewayRequest) String() string {
+func (x *NetProbe) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNFSGatewayRequest) ProtoMessage() {}
+func (*NetProbe) ProtoMessage() {}
 
-func (x *CreateNFSGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[98]
+func (x *NetProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[333]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5683,77 +21393,103 @@ func (x *CreateNFSGatewayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNFSGatewayRequest.ProtoReflect.Descriptor instead.
-func (*CreateNFSGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use NetProbe.ProtoReflect.Descriptor instead.
+func (*NetProbe) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{333}
 }
 
-func (x *CreateNFSGatewayRequest) GetResource() string {
+func (x *NetProbe) GetSource() string {
 	if x != nil {
-		return x.Resource
+		return x.Source
 	}
 	return ""
 }
 
-func (x *CreateNFSGatewayRequest) GetServiceIp() string {
+func (x *NetProbe) GetTarget() string {
 	if x != nil {
-		return x.ServiceIp
+		return x.Target
 	}
 	return ""
 }
 
-func (x *CreateNFSGatewayRequest) GetExportPath() string {
+func (x *NetProbe) GetMethod() string {
 	if x != nil {
-		return x.ExportPath
+		return x.Method
 	}
 	return ""
 }
 
-func (x *CreateNFSGatewayRequest) GetAllowedIps() []string {
+func (x *NetProbe) GetLatencyMs() float64 {
 	if x != nil {
-		return x.AllowedIps
+		return x.LatencyMs
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateNFSGatewayRequest) GetFsType() string {
+func (x *NetProbe) GetThroughputMbps() float64 {
 	if x != nil {
-		return x.FsType
+		return x.ThroughputMbps
+	}
+	return 0
+}
+
+func (x *NetProbe) GetRequiredMbps() float64 {
+	if x != nil {
+		return x.RequiredMbps
+	}
+	return 0
+}
+
+func (x *NetProbe) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
 	}
 	return ""
 }
 
-func (x *CreateNFSGatewayRequest) GetOptions() map[string]string {
+func (x *NetProbe) GetWarnings() []string {
 	if x != nil {
-		return x.Options
+		return x.Warnings
 	}
 	return nil
 }
 
-type CreateNFSGatewayResponse struct {
+func (x *NetProbe) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *NetProbe) GetProbedAt() int64 {
+	if x != nil {
+		return x.ProbedAt
+	}
+	return 0
+}
+
+type ProbeNetworkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	ConfigPath    string                 `protobuf:"bytes,3,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"` // Path to generated config file
+	Nodes         []string               `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"` // Node names or addresses, empty for all nodes
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateNFSGatewayResponse) Reset() {
-	*x = CreateNFSGatewayResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[99]
+func (x *ProbeNetworkRequest) Reset() {
+	*x = ProbeNetworkRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[334]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNFSGatewayResponse) String() string {
+func (x *ProbeNetworkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNFSGatewayResponse) ProtoMessage() {}
+func (*ProbeNetworkRequest) ProtoMessage() {}
 
-func (x *CreateNFSGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[99]
+func (x *ProbeNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[334]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5764,61 +21500,42 @@ func (x *CreateNFSGatewayResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNFSGatewayResponse.ProtoReflect.Descriptor instead.
-func (*CreateNFSGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{99}
-}
-
-func (x *CreateNFSGatewayResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *CreateNFSGatewayResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use ProbeNetworkRequest.ProtoReflect.Descriptor instead.
+func (*ProbeNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{334}
 }
 
-func (x *CreateNFSGatewayResponse) GetConfigPath() string {
+func (x *ProbeNetworkRequest) GetNodes() []string {
 	if x != nil {
-		return x.ConfigPath
+		return x.Nodes
 	}
-	return ""
+	return nil
 }
 
-type CreateISCSIGatewayRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Resource          string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`                                                                         // DRBD resource name
-	ServiceIp         string                 `protobuf:"bytes,2,opt,name=service_ip,json=serviceIp,proto3" json:"service_ip,omitempty"`                                                      // Service IP (e.g., "192.168.1.100/24")
-	Iqn               string                 `protobuf:"bytes,3,opt,name=iqn,proto3" json:"iqn,omitempty"`                                                                                   // iSCSI Qualified Name
-	AllowedInitiators []string               `protobuf:"bytes,4,rep,name=allowed_initiators,json=allowedInitiators,proto3" json:"allowed_initiators,omitempty"`                              // Allowed initiator IQNs
-	Username          string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`                                                                         // CHAP username (optional)
-	Password          string                 `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`                                                                         // CHAP password (optional)
-	Implementation    string                 `protobuf:"bytes,7,opt,name=implementation,proto3" json:"implementation,omitempty"`                                                             // iSCSI implementation (lio, tgt, iet)
-	Options           map[string]string      `protobuf:"bytes,8,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Additional options
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type ProbeNetworkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Probes        []*NetProbe            `protobuf:"bytes,3,rep,name=probes,proto3" json:"probes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateISCSIGatewayRequest) Reset() {
-	*x = CreateISCSIGatewayRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[100]
+func (x *ProbeNetworkResponse) Reset() {
+	*x = ProbeNetworkResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[335]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateISCSIGatewayRequest) String() string {
+func (x *ProbeNetworkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateISCSIGatewayRequest) ProtoMessage() {}
+func (*ProbeNetworkResponse) ProtoMessage() {}
 
-func (x *CreateISCSIGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[100]
+func (x *ProbeNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[335]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5829,91 +21546,92 @@ func (x *CreateISCSIGatewayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateISCSIGatewayRequest.ProtoReflect.Descriptor instead.
-func (*CreateISCSIGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use ProbeNetworkResponse.ProtoReflect.Descriptor instead.
+func (*ProbeNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{335}
 }
 
-func (x *CreateISCSIGatewayRequest) GetResource() string {
+func (x *ProbeNetworkResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Resource
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *CreateISCSIGatewayRequest) GetServiceIp() string {
+func (x *ProbeNetworkResponse) GetMessage() string {
 	if x != nil {
-		return x.ServiceIp
+		return x.Message
 	}
 	return ""
 }
 
-func (x *CreateISCSIGatewayRequest) GetIqn() string {
+func (x *ProbeNetworkResponse) GetProbes() []*NetProbe {
 	if x != nil {
-		return x.Iqn
+		return x.Probes
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateISCSIGatewayRequest) GetAllowedInitiators() []string {
-	if x != nil {
-		return x.AllowedInitiators
-	}
-	return nil
+type ListNetProbesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateISCSIGatewayRequest) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+func (x *ListNetProbesRequest) Reset() {
+	*x = ListNetProbesRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[336]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateISCSIGatewayRequest) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
+func (x *ListNetProbesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateISCSIGatewayRequest) GetImplementation() string {
+func (*ListNetProbesRequest) ProtoMessage() {}
+
+func (x *ListNetProbesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[336]
 	if x != nil {
-		return x.Implementation
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateISCSIGatewayRequest) GetOptions() map[string]string {
-	if x != nil {
-		return x.Options
-	}
-	return nil
+// Deprecated: Use ListNetProbesRequest.ProtoReflect.Descriptor instead.
+func (*ListNetProbesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{336}
 }
 
-type CreateISCSIGatewayResponse struct {
+type ListNetProbesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	ConfigPath    string                 `protobuf:"bytes,3,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"` // Path to generated config file
+	Probes        []*NetProbe            `protobuf:"bytes,3,rep,name=probes,proto3" json:"probes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateISCSIGatewayResponse) Reset() {
-	*x = CreateISCSIGatewayResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[101]
+func (x *ListNetProbesResponse) Reset() {
+	*x = ListNetProbesResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[337]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateISCSIGatewayResponse) String() string {
+func (x *ListNetProbesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateISCSIGatewayResponse) ProtoMessage() {}
+func (*ListNetProbesResponse) ProtoMessage() {}
 
-func (x *CreateISCSIGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[101]
+func (x *ListNetProbesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[337]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5924,58 +21642,59 @@ func (x *CreateISCSIGatewayResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateISCSIGatewayResponse.ProtoReflect.Descriptor instead.
-func (*CreateISCSIGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use ListNetProbesResponse.ProtoReflect.Descriptor instead.
+func (*ListNetProbesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{337}
 }
 
-func (x *CreateISCSIGatewayResponse) GetSuccess() bool {
+func (x *ListNetProbesResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *CreateISCSIGatewayResponse) GetMessage() string {
+func (x *ListNetProbesResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *CreateISCSIGatewayResponse) GetConfigPath() string {
+func (x *ListNetProbesResponse) GetProbes() []*NetProbe {
 	if x != nil {
-		return x.ConfigPath
+		return x.Probes
 	}
-	return ""
+	return nil
 }
 
-type CreateNVMeGatewayRequest struct {
+type DiscoveredDisk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`                                                                         // DRBD resource name
-	ServiceIp     string                 `protobuf:"bytes,2,opt,name=service_ip,json=serviceIp,proto3" json:"service_ip,omitempty"`                                                      // Service IP (e.g., "192.168.1.150/24")
-	Nqn           string                 `protobuf:"bytes,3,opt,name=nqn,proto3" json:"nqn,omitempty"`                                                                                   // NVMe Qualified Name
-	TransportType string                 `protobuf:"bytes,4,opt,name=transport_type,json=transportType,proto3" json:"transport_type,omitempty"`                                          // Transport type (tcp, rdma)
-	Options       map[string]string      `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Additional options
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	SizeBytes     uint64                 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Model         string                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	Rotational    bool                   `protobuf:"varint,4,opt,name=rotational,proto3" json:"rotational,omitempty"`
+	Available     bool                   `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"` // No partitions, signature, mount or holder
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`        // Why the disk is not available
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateNVMeGatewayRequest) Reset() {
-	*x = CreateNVMeGatewayRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[102]
+func (x *DiscoveredDisk) Reset() {
+	*x = DiscoveredDisk{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[338]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNVMeGatewayRequest) String() string {
+func (x *DiscoveredDisk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNVMeGatewayRequest) ProtoMessage() {}
+func (*DiscoveredDisk) ProtoMessage() {}
 
-func (x *CreateNVMeGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[102]
+func (x *DiscoveredDisk) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[338]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5986,70 +21705,75 @@ func (x *CreateNVMeGatewayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNVMeGatewayRequest.ProtoReflect.Descriptor instead.
-func (*CreateNVMeGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use DiscoveredDisk.ProtoReflect.Descriptor instead.
+func (*DiscoveredDisk) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{338}
 }
 
-func (x *CreateNVMeGatewayRequest) GetResource() string {
+func (x *DiscoveredDisk) GetPath() string {
 	if x != nil {
-		return x.Resource
+		return x.Path
 	}
 	return ""
 }
 
-func (x *CreateNVMeGatewayRequest) GetServiceIp() string {
+func (x *DiscoveredDisk) GetSizeBytes() uint64 {
 	if x != nil {
-		return x.ServiceIp
+		return x.SizeBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateNVMeGatewayRequest) GetNqn() string {
+func (x *DiscoveredDisk) GetModel() string {
 	if x != nil {
-		return x.Nqn
+		return x.Model
 	}
 	return ""
 }
 
-func (x *CreateNVMeGatewayRequest) GetTransportType() string {
+func (x *DiscoveredDisk) GetRotational() bool {
 	if x != nil {
-		return x.TransportType
+		return x.Rotational
 	}
-	return ""
+	return false
+}
+
+func (x *DiscoveredDisk) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
 }
 
-func (x *CreateNVMeGatewayRequest) GetOptions() map[string]string {
+func (x *DiscoveredDisk) GetReason() string {
 	if x != nil {
-		return x.Options
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type CreateNVMeGatewayResponse struct {
+type DiscoverDisksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	ConfigPath    string                 `protobuf:"bytes,3,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"` // Path to generated config file
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateNVMeGatewayResponse) Reset() {
-	*x = CreateNVMeGatewayResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[103]
+func (x *DiscoverDisksRequest) Reset() {
+	*x = DiscoverDisksRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[339]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNVMeGatewayResponse) String() string {
+func (x *DiscoverDisksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNVMeGatewayResponse) ProtoMessage() {}
+func (*DiscoverDisksRequest) ProtoMessage() {}
 
-func (x *CreateNVMeGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[103]
+func (x *DiscoverDisksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[339]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6060,54 +21784,42 @@ func (x *CreateNVMeGatewayResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNVMeGatewayResponse.ProtoReflect.Descriptor instead.
-func (*CreateNVMeGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{103}
-}
-
-func (x *CreateNVMeGatewayResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *CreateNVMeGatewayResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use DiscoverDisksRequest.ProtoReflect.Descriptor instead.
+func (*DiscoverDisksRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{339}
 }
 
-func (x *CreateNVMeGatewayResponse) GetConfigPath() string {
+func (x *DiscoverDisksRequest) GetNode() string {
 	if x != nil {
-		return x.ConfigPath
+		return x.Node
 	}
 	return ""
 }
 
-type DeleteGatewayRequest struct {
+type DiscoverDisksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Disks         []*DiscoveredDisk      `protobuf:"bytes,3,rep,name=disks,proto3" json:"disks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGatewayRequest) Reset() {
-	*x = DeleteGatewayRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[104]
+func (x *DiscoverDisksResponse) Reset() {
+	*x = DiscoverDisksResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[340]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGatewayRequest) String() string {
+func (x *DiscoverDisksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGatewayRequest) ProtoMessage() {}
+func (*DiscoverDisksResponse) ProtoMessage() {}
 
-func (x *DeleteGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[104]
+func (x *DiscoverDisksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[340]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6118,41 +21830,56 @@ func (x *DeleteGatewayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGatewayRequest.ProtoReflect.Descriptor instead.
-func (*DeleteGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{104}
+// Deprecated: Use DiscoverDisksResponse.ProtoReflect.Descriptor instead.
+func (*DiscoverDisksResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{340}
 }
 
-func (x *DeleteGatewayRequest) GetId() string {
+func (x *DiscoverDisksResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Id
+		return x.Success
+	}
+	return false
+}
+
+func (x *DiscoverDisksResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-type DeleteGatewayResponse struct {
+func (x *DiscoverDisksResponse) GetDisks() []*DiscoveredDisk {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+type SetupStep struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // nodes, connectivity, pools, resource or ha
+	Done          bool                   `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	Detail        string                 `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGatewayResponse) Reset() {
-	*x = DeleteGatewayResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[105]
+func (x *SetupStep) Reset() {
+	*x = SetupStep{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[341]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGatewayResponse) String() string {
+func (x *SetupStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGatewayResponse) ProtoMessage() {}
+func (*SetupStep) ProtoMessage() {}
 
-func (x *DeleteGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[105]
+func (x *SetupStep) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[341]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6163,47 +21890,53 @@ func (x *DeleteGatewayResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGatewayResponse.ProtoReflect.Descriptor instead.
-func (*DeleteGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use SetupStep.ProtoReflect.Descriptor instead.
+func (*SetupStep) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{341}
 }
 
-func (x *DeleteGatewayResponse) GetSuccess() bool {
+func (x *SetupStep) GetName() string {
 	if x != nil {
-		return x.Success
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetupStep) GetDone() bool {
+	if x != nil {
+		return x.Done
 	}
 	return false
 }
 
-func (x *DeleteGatewayResponse) GetMessage() string {
+func (x *SetupStep) GetDetail() string {
 	if x != nil {
-		return x.Message
+		return x.Detail
 	}
 	return ""
 }
 
-type GetGatewayRequest struct {
+type GetSetupStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGatewayRequest) Reset() {
-	*x = GetGatewayRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[106]
+func (x *GetSetupStatusRequest) Reset() {
+	*x = GetSetupStatusRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[342]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGatewayRequest) String() string {
+func (x *GetSetupStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGatewayRequest) ProtoMessage() {}
+func (*GetSetupStatusRequest) ProtoMessage() {}
 
-func (x *GetGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[106]
+func (x *GetSetupStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[342]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6214,42 +21947,38 @@ func (x *GetGatewayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGatewayRequest.ProtoReflect.Descriptor instead.
-func (*GetGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{106}
-}
-
-func (x *GetGatewayRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use GetSetupStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSetupStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{342}
 }
 
-type GetGatewayResponse struct {
+type GetSetupStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Gateway       *GatewayInfo           `protobuf:"bytes,3,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Steps         []*SetupStep           `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps,omitempty"`
+	Next          string                 `protobuf:"bytes,4,opt,name=next,proto3" json:"next,omitempty"`                                   // First step not done
+	Completed     bool                   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`                        // All steps are done, or the setup was finished or skipped
+	CompletedAt   int64                  `protobuf:"varint,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"` // Unix timestamp, 0 if not finished or skipped
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGatewayResponse) Reset() {
-	*x = GetGatewayResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[107]
+func (x *GetSetupStatusResponse) Reset() {
+	*x = GetSetupStatusResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[343]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGatewayResponse) String() string {
+func (x *GetSetupStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGatewayResponse) ProtoMessage() {}
+func (*GetSetupStatusResponse) ProtoMessage() {}
 
-func (x *GetGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[107]
+func (x *GetSetupStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[343]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6260,92 +21989,77 @@ func (x *GetGatewayResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGatewayResponse.ProtoReflect.Descriptor instead.
-func (*GetGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use GetSetupStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSetupStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{343}
 }
 
-func (x *GetGatewayResponse) GetSuccess() bool {
+func (x *GetSetupStatusResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetGatewayResponse) GetMessage() string {
+func (x *GetSetupStatusResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetGatewayResponse) GetGateway() *GatewayInfo {
+func (x *GetSetupStatusResponse) GetSteps() []*SetupStep {
 	if x != nil {
-		return x.Gateway
+		return x.Steps
 	}
 	return nil
 }
 
-type ListGatewaysRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListGatewaysRequest) Reset() {
-	*x = ListGatewaysRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[108]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListGatewaysRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetSetupStatusResponse) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
 }
 
-func (*ListGatewaysRequest) ProtoMessage() {}
-
-func (x *ListGatewaysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[108]
+func (x *GetSetupStatusResponse) GetCompleted() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Completed
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ListGatewaysRequest.ProtoReflect.Descriptor instead.
-func (*ListGatewaysRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{108}
+func (x *GetSetupStatusResponse) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
 }
 
-type ListGatewaysResponse struct {
+type SetupCheck struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Gateways      []*GatewayInfo         `protobuf:"bytes,3,rep,name=gateways,proto3" json:"gateways,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // ssh, sudo, hostname, drbd, drbd-reactor or storage
+	Passed        bool                   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Detail        string                 `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGatewaysResponse) Reset() {
-	*x = ListGatewaysResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[109]
+func (x *SetupCheck) Reset() {
+	*x = SetupCheck{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[344]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGatewaysResponse) String() string {
+func (x *SetupCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGatewaysResponse) ProtoMessage() {}
+func (*SetupCheck) ProtoMessage() {}
 
-func (x *ListGatewaysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[109]
+func (x *SetupCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[344]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6356,54 +22070,55 @@ func (x *ListGatewaysResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGatewaysResponse.ProtoReflect.Descriptor instead.
-func (*ListGatewaysResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use SetupCheck.ProtoReflect.Descriptor instead.
+func (*SetupCheck) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{344}
 }
 
-func (x *ListGatewaysResponse) GetSuccess() bool {
+func (x *SetupCheck) GetName() string {
 	if x != nil {
-		return x.Success
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *ListGatewaysResponse) GetMessage() string {
+func (x *SetupCheck) GetPassed() bool {
 	if x != nil {
-		return x.Message
+		return x.Passed
 	}
-	return ""
+	return false
 }
 
-func (x *ListGatewaysResponse) GetGateways() []*GatewayInfo {
+func (x *SetupCheck) GetDetail() string {
 	if x != nil {
-		return x.Gateways
+		return x.Detail
 	}
-	return nil
+	return ""
 }
 
-type StartGatewayRequest struct {
+type TestNodeConnectionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Node name to register the host as, empty to skip the hostname check
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartGatewayRequest) Reset() {
-	*x = StartGatewayRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[110]
+func (x *TestNodeConnectionRequest) Reset() {
+	*x = TestNodeConnectionRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[345]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartGatewayRequest) String() string {
+func (x *TestNodeConnectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartGatewayRequest) ProtoMessage() {}
+func (*TestNodeConnectionRequest) ProtoMessage() {}
 
-func (x *StartGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[110]
+func (x *TestNodeConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[345]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6414,41 +22129,50 @@ func (x *StartGatewayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartGatewayRequest.ProtoReflect.Descriptor instead.
-func (*StartGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{110}
+// Deprecated: Use TestNodeConnectionRequest.ProtoReflect.Descriptor instead.
+func (*TestNodeConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{345}
 }
 
-func (x *StartGatewayRequest) GetId() string {
+func (x *TestNodeConnectionRequest) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-type StartGatewayResponse struct {
+func (x *TestNodeConnectionRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type TestNodeConnectionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Checks        []*SetupCheck          `protobuf:"bytes,3,rep,name=checks,proto3" json:"checks,omitempty"`
+	Ready         bool                   `protobuf:"varint,4,opt,name=ready,proto3" json:"ready,omitempty"` // Every check passed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartGatewayResponse) Reset() {
-	*x = StartGatewayResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[111]
+func (x *TestNodeConnectionResponse) Reset() {
+	*x = TestNodeConnectionResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[346]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartGatewayResponse) String() string {
+func (x *TestNodeConnectionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartGatewayResponse) ProtoMessage() {}
+func (*TestNodeConnectionResponse) ProtoMessage() {}
 
-func (x *StartGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[111]
+func (x *TestNodeConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[346]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6459,47 +22183,99 @@ func (x *StartGatewayResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartGatewayResponse.ProtoReflect.Descriptor instead.
-func (*StartGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{111}
+// Deprecated: Use TestNodeConnectionResponse.ProtoReflect.Descriptor instead.
+func (*TestNodeConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{346}
 }
 
-func (x *StartGatewayResponse) GetSuccess() bool {
+func (x *TestNodeConnectionResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *StartGatewayResponse) GetMessage() string {
+func (x *TestNodeConnectionResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type StopGatewayRequest struct {
+func (x *TestNodeConnectionResponse) GetChecks() []*SetupCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *TestNodeConnectionResponse) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+type CompleteSetupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteSetupRequest) Reset() {
+	*x = CompleteSetupRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[347]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteSetupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteSetupRequest) ProtoMessage() {}
+
+func (x *CompleteSetupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[347]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteSetupRequest.ProtoReflect.Descriptor instead.
+func (*CompleteSetupRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{347}
+}
+
+type CompleteSetupResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	CompletedAt   int64                  `protobuf:"varint,3,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopGatewayRequest) Reset() {
-	*x = StopGatewayRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[112]
+func (x *CompleteSetupResponse) Reset() {
+	*x = CompleteSetupResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[348]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopGatewayRequest) String() string {
+func (x *CompleteSetupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopGatewayRequest) ProtoMessage() {}
+func (*CompleteSetupResponse) ProtoMessage() {}
 
-func (x *StopGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[112]
+func (x *CompleteSetupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[348]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6510,41 +22286,56 @@ func (x *StopGatewayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopGatewayRequest.ProtoReflect.Descriptor instead.
-func (*StopGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{112}
+// Deprecated: Use CompleteSetupResponse.ProtoReflect.Descriptor instead.
+func (*CompleteSetupResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{348}
 }
 
-func (x *StopGatewayRequest) GetId() string {
+func (x *CompleteSetupResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Id
+		return x.Success
+	}
+	return false
+}
+
+func (x *CompleteSetupResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-type StopGatewayResponse struct {
+func (x *CompleteSetupResponse) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+type SupportBundleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Nodes         []string               `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`                        // Node names or addresses, empty for all nodes
+	LogLines      int32                  `protobuf:"varint,2,opt,name=log_lines,json=logLines,proto3" json:"log_lines,omitempty"` // Recent lines per log, 0 for 2000
+	Jobs          int32                  `protobuf:"varint,3,opt,name=jobs,proto3" json:"jobs,omitempty"`                         // Recent jobs with their command transcripts, 0 for 50
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopGatewayResponse) Reset() {
-	*x = StopGatewayResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[113]
+func (x *SupportBundleRequest) Reset() {
+	*x = SupportBundleRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[349]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopGatewayResponse) String() string {
+func (x *SupportBundleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopGatewayResponse) ProtoMessage() {}
+func (*SupportBundleRequest) ProtoMessage() {}
 
-func (x *StopGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[113]
+func (x *SupportBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[349]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6555,55 +22346,54 @@ func (x *StopGatewayResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopGatewayResponse.ProtoReflect.Descriptor instead.
-func (*StopGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{113}
+// Deprecated: Use SupportBundleRequest.ProtoReflect.Descriptor instead.
+func (*SupportBundleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{349}
 }
 
-func (x *StopGatewayResponse) GetSuccess() bool {
+func (x *SupportBundleRequest) GetNodes() []string {
 	if x != nil {
-		return x.Success
+		return x.Nodes
 	}
-	return false
+	return nil
 }
 
-func (x *StopGatewayResponse) GetMessage() string {
+func (x *SupportBundleRequest) GetLogLines() int32 {
 	if x != nil {
-		return x.Message
+		return x.LogLines
 	}
-	return ""
+	return 0
 }
 
-type GatewayInfo struct {
+func (x *SupportBundleRequest) GetJobs() int32 {
+	if x != nil {
+		return x.Jobs
+	}
+	return 0
+}
+
+type SupportBundleChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
-	Node          string                 `protobuf:"bytes,5,opt,name=node,proto3" json:"node,omitempty"`
-	Resource      string                 `protobuf:"bytes,6,opt,name=resource,proto3" json:"resource,omitempty"`
-	VolumeId      uint32                 `protobuf:"varint,7,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
-	Path          string                 `protobuf:"bytes,8,opt,name=path,proto3" json:"path,omitempty"`
-	Options       map[string]string      `protobuf:"bytes,9,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // Next part of the tarball
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GatewayInfo) Reset() {
-	*x = GatewayInfo{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[114]
+func (x *SupportBundleChunk) Reset() {
+	*x = SupportBundleChunk{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[350]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GatewayInfo) String() string {
+func (x *SupportBundleChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GatewayInfo) ProtoMessage() {}
+func (*SupportBundleChunk) ProtoMessage() {}
 
-func (x *GatewayInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[114]
+func (x *SupportBundleChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[350]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6614,96 +22404,135 @@ func (x *GatewayInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GatewayInfo.ProtoReflect.Descriptor instead.
-func (*GatewayInfo) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{114}
+// Deprecated: Use SupportBundleChunk.ProtoReflect.Descriptor instead.
+func (*SupportBundleChunk) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{350}
 }
 
-func (x *GatewayInfo) GetId() string {
+func (x *SupportBundleChunk) GetData() []byte {
 	if x != nil {
-		return x.Id
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *GatewayInfo) GetName() string {
+// EventHook posts events to a webhook or pipes them to a command on the
+// controller host
+type EventHook struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Events         []string               `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`                                                                             // Event types, e.g. ha.failover, ha.* or *
+	Url            string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`                                                                                   // Webhook, or
+	Command        string                 `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`                                                                           // Shell command, the payload on stdin
+	Headers        map[string]string      `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Of webhook requests, values are redacted when listed
+	Template       string                 `protobuf:"bytes,6,opt,name=template,proto3" json:"template,omitempty"`                                                                         // Go template of the payload, the event as JSON if empty
+	TimeoutSeconds uint32                 `protobuf:"varint,7,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`                                      // 0 for 10
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EventHook) Reset() {
+	*x = EventHook{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[351]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventHook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventHook) ProtoMessage() {}
+
+func (x *EventHook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[351]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GatewayInfo) GetType() string {
+// Deprecated: Use EventHook.ProtoReflect.Descriptor instead.
+func (*EventHook) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{351}
+}
+
+func (x *EventHook) GetName() string {
 	if x != nil {
-		return x.Type
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GatewayInfo) GetState() string {
+func (x *EventHook) GetEvents() []string {
 	if x != nil {
-		return x.State
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-func (x *GatewayInfo) GetNode() string {
+func (x *EventHook) GetUrl() string {
 	if x != nil {
-		return x.Node
+		return x.Url
 	}
 	return ""
 }
 
-func (x *GatewayInfo) GetResource() string {
+func (x *EventHook) GetCommand() string {
 	if x != nil {
-		return x.Resource
+		return x.Command
 	}
 	return ""
 }
 
-func (x *GatewayInfo) GetVolumeId() uint32 {
+func (x *EventHook) GetHeaders() map[string]string {
 	if x != nil {
-		return x.VolumeId
+		return x.Headers
 	}
-	return 0
+	return nil
 }
 
-func (x *GatewayInfo) GetPath() string {
+func (x *EventHook) GetTemplate() string {
 	if x != nil {
-		return x.Path
+		return x.Template
 	}
 	return ""
 }
 
-func (x *GatewayInfo) GetOptions() map[string]string {
+func (x *EventHook) GetTimeoutSeconds() uint32 {
 	if x != nil {
-		return x.Options
+		return x.TimeoutSeconds
 	}
-	return nil
+	return 0
 }
 
-type DeleteHaRequest struct {
+type SetEventHookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Hook          *EventHook             `protobuf:"bytes,2,opt,name=hook,proto3" json:"hook,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteHaRequest) Reset() {
-	*x = DeleteHaRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[115]
+func (x *SetEventHookRequest) Reset() {
+	*x = SetEventHookRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[352]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteHaRequest) String() string {
+func (x *SetEventHookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteHaRequest) ProtoMessage() {}
+func (*SetEventHookRequest) ProtoMessage() {}
 
-func (x *DeleteHaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[115]
+func (x *SetEventHookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[352]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6714,19 +22543,26 @@ func (x *DeleteHaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteHaRequest.ProtoReflect.Descriptor instead.
-func (*DeleteHaRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{115}
+// Deprecated: Use SetEventHookRequest.ProtoReflect.Descriptor instead.
+func (*SetEventHookRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{352}
 }
 
-func (x *DeleteHaRequest) GetResource() string {
+func (x *SetEventHookRequest) GetName() string {
 	if x != nil {
-		return x.Resource
+		return x.Name
 	}
 	return ""
 }
 
-type DeleteHaResponse struct {
+func (x *SetEventHookRequest) GetHook() *EventHook {
+	if x != nil {
+		return x.Hook
+	}
+	return nil
+}
+
+type SetEventHookResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -6734,21 +22570,21 @@ type DeleteHaResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteHaResponse) Reset() {
-	*x = DeleteHaResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[116]
+func (x *SetEventHookResponse) Reset() {
+	*x = SetEventHookResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[353]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteHaResponse) String() string {
+func (x *SetEventHookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteHaResponse) ProtoMessage() {}
+func (*SetEventHookResponse) ProtoMessage() {}
 
-func (x *DeleteHaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[116]
+func (x *SetEventHookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[353]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6759,47 +22595,47 @@ func (x *DeleteHaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteHaResponse.ProtoReflect.Descriptor instead.
-func (*DeleteHaResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use SetEventHookResponse.ProtoReflect.Descriptor instead.
+func (*SetEventHookResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{353}
 }
 
-func (x *DeleteHaResponse) GetSuccess() bool {
+func (x *SetEventHookResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *DeleteHaResponse) GetMessage() string {
+func (x *SetEventHookResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type GetHaRequest struct {
+type DeleteEventHookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHaRequest) Reset() {
-	*x = GetHaRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[117]
+func (x *DeleteEventHookRequest) Reset() {
+	*x = DeleteEventHookRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[354]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHaRequest) String() string {
+func (x *DeleteEventHookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHaRequest) ProtoMessage() {}
+func (*DeleteEventHookRequest) ProtoMessage() {}
 
-func (x *GetHaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[117]
+func (x *DeleteEventHookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[354]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6810,42 +22646,41 @@ func (x *GetHaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHaRequest.ProtoReflect.Descriptor instead.
-func (*GetHaRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{117}
+// Deprecated: Use DeleteEventHookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventHookRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{354}
 }
 
-func (x *GetHaRequest) GetResource() string {
+func (x *DeleteEventHookRequest) GetName() string {
 	if x != nil {
-		return x.Resource
+		return x.Name
 	}
 	return ""
 }
 
-type GetHaResponse struct {
+type DeleteEventHookResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Config        *HaConfigInfo          `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHaResponse) Reset() {
-	*x = GetHaResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[118]
+func (x *DeleteEventHookResponse) Reset() {
+	*x = DeleteEventHookResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[355]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHaResponse) String() string {
+func (x *DeleteEventHookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHaResponse) ProtoMessage() {}
+func (*DeleteEventHookResponse) ProtoMessage() {}
 
-func (x *GetHaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[118]
+func (x *DeleteEventHookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[355]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6856,53 +22691,46 @@ func (x *GetHaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHaResponse.ProtoReflect.Descriptor instead.
-func (*GetHaResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{118}
+// Deprecated: Use DeleteEventHookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEventHookResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{355}
 }
 
-func (x *GetHaResponse) GetSuccess() bool {
+func (x *DeleteEventHookResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetHaResponse) GetMessage() string {
+func (x *DeleteEventHookResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetHaResponse) GetConfig() *HaConfigInfo {
-	if x != nil {
-		return x.Config
-	}
-	return nil
-}
-
-type ListHaRequest struct {
+type ListEventHooksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListHaRequest) Reset() {
-	*x = ListHaRequest{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[119]
+func (x *ListEventHooksRequest) Reset() {
+	*x = ListEventHooksRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[356]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListHaRequest) String() string {
+func (x *ListEventHooksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHaRequest) ProtoMessage() {}
+func (*ListEventHooksRequest) ProtoMessage() {}
 
-func (x *ListHaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[119]
+func (x *ListEventHooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[356]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6913,35 +22741,35 @@ func (x *ListHaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHaRequest.ProtoReflect.Descriptor instead.
-func (*ListHaRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{119}
+// Deprecated: Use ListEventHooksRequest.ProtoReflect.Descriptor instead.
+func (*ListEventHooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{356}
 }
 
-type ListHaResponse struct {
+type ListEventHooksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Configs       []*HaConfigInfo        `protobuf:"bytes,3,rep,name=configs,proto3" json:"configs,omitempty"`
+	Hooks         []*EventHook           `protobuf:"bytes,3,rep,name=hooks,proto3" json:"hooks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListHaResponse) Reset() {
-	*x = ListHaResponse{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[120]
+func (x *ListEventHooksResponse) Reset() {
+	*x = ListEventHooksResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[357]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListHaResponse) String() string {
+func (x *ListEventHooksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHaResponse) ProtoMessage() {}
+func (*ListEventHooksResponse) ProtoMessage() {}
 
-func (x *ListHaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[120]
+func (x *ListEventHooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[357]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6952,58 +22780,54 @@ func (x *ListHaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHaResponse.ProtoReflect.Descriptor instead.
-func (*ListHaResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{120}
+// Deprecated: Use ListEventHooksResponse.ProtoReflect.Descriptor instead.
+func (*ListEventHooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{357}
 }
 
-func (x *ListHaResponse) GetSuccess() bool {
+func (x *ListEventHooksResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ListHaResponse) GetMessage() string {
+func (x *ListEventHooksResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ListHaResponse) GetConfigs() []*HaConfigInfo {
+func (x *ListEventHooksResponse) GetHooks() []*EventHook {
 	if x != nil {
-		return x.Configs
+		return x.Hooks
 	}
 	return nil
 }
 
-type HaConfigInfo struct {
+type TestEventHookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	Vip           string                 `protobuf:"bytes,2,opt,name=vip,proto3" json:"vip,omitempty"`
-	MountPoint    string                 `protobuf:"bytes,3,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
-	FsType        string                 `protobuf:"bytes,4,opt,name=fs_type,json=fsType,proto3" json:"fs_type,omitempty"`
-	Services      []string               `protobuf:"bytes,5,rep,name=services,proto3" json:"services,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HaConfigInfo) Reset() {
-	*x = HaConfigInfo{}
-	mi := &file_api_proto_v1_sds_proto_msgTypes[121]
+func (x *TestEventHookRequest) Reset() {
+	*x = TestEventHookRequest{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[358]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HaConfigInfo) String() string {
+func (x *TestEventHookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HaConfigInfo) ProtoMessage() {}
+func (*TestEventHookRequest) ProtoMessage() {}
 
-func (x *HaConfigInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_sds_proto_msgTypes[121]
+func (x *TestEventHookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[358]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7014,44 +22838,68 @@ func (x *HaConfigInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HaConfigInfo.ProtoReflect.Descriptor instead.
-func (*HaConfigInfo) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{121}
+// Deprecated: Use TestEventHookRequest.ProtoReflect.Descriptor instead.
+func (*TestEventHookRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{358}
 }
 
-func (x *HaConfigInfo) GetResource() string {
+func (x *TestEventHookRequest) GetName() string {
 	if x != nil {
-		return x.Resource
+		return x.Name
 	}
 	return ""
 }
 
-func (x *HaConfigInfo) GetVip() string {
+type TestEventHookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestEventHookResponse) Reset() {
+	*x = TestEventHookResponse{}
+	mi := &file_api_proto_v1_sds_proto_msgTypes[359]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestEventHookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestEventHookResponse) ProtoMessage() {}
+
+func (x *TestEventHookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_sds_proto_msgTypes[359]
 	if x != nil {
-		return x.Vip
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *HaConfigInfo) GetMountPoint() string {
-	if x != nil {
-		return x.MountPoint
-	}
-	return ""
+// Deprecated: Use TestEventHookResponse.ProtoReflect.Descriptor instead.
+func (*TestEventHookResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_sds_proto_rawDescGZIP(), []int{359}
 }
 
-func (x *HaConfigInfo) GetFsType() string {
+func (x *TestEventHookResponse) GetSuccess() bool {
 	if x != nil {
-		return x.FsType
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *HaConfigInfo) GetServices() []string {
+func (x *TestEventHookResponse) GetMessage() string {
 	if x != nil {
-		return x.Services
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
 var File_api_proto_v1_sds_proto protoreflect.FileDescriptor
@@ -7092,7 +22940,23 @@ const file_api_proto_v1_sds_proto_rawDesc = "" +
 	"\x04node\x18\x03 \x01(\tR\x04node\"K\n" +
 	"\x15AddDiskToPoolResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xca\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"U\n" +
+	"\x15GetPoolHistoryRequest\x12\x12\n" +
+	"\x04pool\x18\x01 \x01(\tR\x04pool\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\x03R\x05since\"\x9e\x01\n" +
+	"\x0fPoolUsageSample\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x1f\n" +
+	"\vtotal_bytes\x18\x02 \x01(\x04R\n" +
+	"totalBytes\x12\x1d\n" +
+	"\n" +
+	"free_bytes\x18\x03 \x01(\x04R\tfreeBytes\x12'\n" +
+	"\x0fallocated_bytes\x18\x04 \x01(\x04R\x0eallocatedBytes\x12\x0e\n" +
+	"\x02at\x18\x05 \x01(\x03R\x02at\"{\n" +
+	"\x16GetPoolHistoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\asamples\x18\x03 \x03(\v2\x13.v1.PoolUsageSampleR\asamples\"\xca\x01\n" +
 	"\bPoolInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
@@ -7237,20 +23101,145 @@ const file_api_proto_v1_sds_proto_rawDesc = "" +
 	"\x11ListNodesResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\"\n" +
-	"\x05nodes\x18\x03 \x03(\v2\f.v1.NodeInfoR\x05nodes\"\xa1\x01\n" +
+	"\x05nodes\x18\x03 \x03(\v2\f.v1.NodeInfoR\x05nodes\"n\n" +
+	"\x15SetNodeAddressRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12'\n" +
+	"\x0ftimeout_seconds\x18\x03 \x01(\x03R\x0etimeoutSeconds\"\xd1\x01\n" +
+	"\x16SetNodeAddressResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\x10previous_address\x18\x03 \x01(\tR\x0fpreviousAddress\x12\x1c\n" +
+	"\tresources\x18\x04 \x03(\tR\tresources\x12\x18\n" +
+	"\aactions\x18\x05 \x03(\tR\aactions\x12 \n" +
+	"\vunrecovered\x18\x06 \x03(\tR\vunrecovered\"b\n" +
+	"\x10TrustNodeRequest\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12 \n" +
+	"\vfingerprint\x18\x02 \x01(\tR\vfingerprint\x12\x18\n" +
+	"\areplace\x18\x03 \x01(\bR\areplace\"\xfd\x01\n" +
+	"\x11TrustNodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04node\x18\x03 \x01(\tR\x04node\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x19\n" +
+	"\bkey_type\x18\x05 \x01(\tR\akeyType\x12 \n" +
+	"\vfingerprint\x18\x06 \x01(\tR\vfingerprint\x121\n" +
+	"\x14previous_fingerprint\x18\a \x01(\tR\x13previousFingerprint\x12\x16\n" +
+	"\x06pinned\x18\b \x01(\bR\x06pinned\"Q\n" +
+	"\x11HardenNodeRequest\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x12\n" +
+	"\x04user\x18\x02 \x01(\tR\x04user\x12\x14\n" +
+	"\x05apply\x18\x03 \x01(\bR\x05apply\"\xd0\x01\n" +
+	"\x12HardenNodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04user\x18\x03 \x01(\tR\x04user\x12\x16\n" +
+	"\x06policy\x18\x04 \x01(\tR\x06policy\x12\x18\n" +
+	"\amissing\x18\x05 \x03(\tR\amissing\x12\x1c\n" +
+	"\tinstalled\x18\x06 \x01(\bR\tinstalled\x12\"\n" +
+	"\funrestricted\x18\a \x01(\bR\funrestricted\"{\n" +
+	"\x11MaintenanceWindow\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x03R\x02to\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\"k\n" +
+	"\x19SetNodeMaintenanceRequest\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x03R\x02to\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x7f\n" +
+	"\x1aSetNodeMaintenanceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\x06window\x18\x03 \x01(\v2\x15.v1.MaintenanceWindowR\x06window\"1\n" +
+	"\x1bClearNodeMaintenanceRequest\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\"R\n" +
+	"\x1cClearNodeMaintenanceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x1f\n" +
+	"\x1dListMaintenanceWindowsRequest\"\x85\x01\n" +
+	"\x1eListMaintenanceWindowsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
+	"\awindows\x18\x03 \x03(\v2\x15.v1.MaintenanceWindowR\awindows\"j\n" +
+	"\x0fNodeExecRequest\x12\x14\n" +
+	"\x05nodes\x18\x01 \x03(\tR\x05nodes\x12\x18\n" +
+	"\acommand\x18\x02 \x01(\tR\acommand\x12'\n" +
+	"\x0ftimeout_seconds\x18\x03 \x01(\x05R\x0etimeoutSeconds\"\xb2\x01\n" +
+	"\x0eNodeExecResult\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x1b\n" +
+	"\texit_code\x18\x02 \x01(\x05R\bexitCode\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06output\x18\x04 \x01(\tR\x06output\x12\x1c\n" +
+	"\ttruncated\x18\x05 \x01(\bR\ttruncated\x12\x1f\n" +
+	"\vduration_ms\x18\x06 \x01(\x03R\n" +
+	"durationMs\"t\n" +
+	"\x10NodeExecResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\aresults\x18\x03 \x03(\v2\x12.v1.NodeExecResultR\aresults\"\x9f\x01\n" +
+	"\x15StreamNodeLogsRequest\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x12\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\x12\x1a\n" +
+	"\bresource\x18\x03 \x01(\tR\bresource\x12\x14\n" +
+	"\x05lines\x18\x04 \x01(\x05R\x05lines\x12\x16\n" +
+	"\x06follow\x18\x05 \x01(\bR\x06follow\x12\x14\n" +
+	"\x05since\x18\x06 \x01(\tR\x05since\"5\n" +
+	"\vNodeLogLine\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\tR\x04line\"\x7f\n" +
+	"\x0fPushFileRequest\x12\x14\n" +
+	"\x05nodes\x18\x01 \x03(\tR\x05nodes\x12\x12\n" +
+	"\x04dest\x18\x02 \x01(\tR\x04dest\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\rR\x04mode\x12\x14\n" +
+	"\x05owner\x18\x05 \x01(\tR\x05owner\"p\n" +
+	"\x0ePushFileResult\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x1a\n" +
+	"\bchecksum\x18\x03 \x01(\tR\bchecksum\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x90\x01\n" +
+	"\x10PushFileResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\bchecksum\x18\x03 \x01(\tR\bchecksum\x12,\n" +
+	"\aresults\x18\x04 \x03(\v2\x12.v1.PushFileResultR\aresults\"\xad\x02\n" +
 	"\bNodeInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x1a\n" +
 	"\bhostname\x18\x03 \x01(\tR\bhostname\x12\x14\n" +
 	"\x05state\x18\x04 \x01(\tR\x05state\x12\x1b\n" +
 	"\tlast_seen\x18\x05 \x01(\x03R\blastSeen\x12\x18\n" +
-	"\aversion\x18\x06 \x01(\tR\aversion\"(\n" +
+	"\aversion\x18\x06 \x01(\tR\aversion\x12,\n" +
+	"\bcapacity\x18\a \x01(\v2\x10.v1.NodeCapacityR\bcapacity\x12.\n" +
+	"\x13drbd_kernel_version\x18\b \x01(\tR\x11drbdKernelVersion\x12,\n" +
+	"\x12drbd_utils_version\x18\t \x01(\tR\x10drbdUtilsVersion\"\xce\x02\n" +
+	"\fNodeCapacity\x12*\n" +
+	"\x05pools\x18\x01 \x03(\v2\x14.v1.NodePoolCapacityR\x05pools\x12!\n" +
+	"\fdrbd_devices\x18\x02 \x01(\rR\vdrbdDevices\x12\x1c\n" +
+	"\tprimaries\x18\x03 \x01(\rR\tprimaries\x12\x14\n" +
+	"\x05load1\x18\x04 \x01(\x01R\x05load1\x12\x14\n" +
+	"\x05load5\x18\x05 \x01(\x01R\x05load5\x12\x16\n" +
+	"\x06load15\x18\x06 \x01(\x01R\x06load15\x12\x12\n" +
+	"\x04cpus\x18\a \x01(\rR\x04cpus\x12&\n" +
+	"\x0fmem_total_bytes\x18\b \x01(\x04R\rmemTotalBytes\x12.\n" +
+	"\x13mem_available_bytes\x18\t \x01(\x04R\x11memAvailableBytes\x12!\n" +
+	"\fcollected_at\x18\n" +
+	" \x01(\x03R\vcollectedAt\"z\n" +
+	"\x10NodePoolCapacity\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1f\n" +
+	"\vtotal_bytes\x18\x03 \x01(\x04R\n" +
+	"totalBytes\x12\x1d\n" +
+	"\n" +
+	"free_bytes\x18\x04 \x01(\x04R\tfreeBytes\"(\n" +
 	"\x12HealthCheckRequest\x12\x12\n" +
 	"\x04node\x18\x01 \x01(\tR\x04node\"u\n" +
 	"\x13HealthCheckResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
-	"\x06health\x18\x03 \x01(\v2\x12.v1.NodeHealthInfoR\x06health\"\xdb\x02\n" +
+	"\x06health\x18\x03 \x01(\v2\x12.v1.NodeHealthInfoR\x06health\"\xd1\x04\n" +
 	"\x0eNodeHealthInfo\x12%\n" +
 	"\x0edrbd_installed\x18\x01 \x01(\bR\rdrbdInstalled\x12!\n" +
 	"\fdrbd_version\x18\x02 \x01(\tR\vdrbdVersion\x124\n" +
@@ -7258,7 +23247,37 @@ const file_api_proto_v1_sds_proto_rawDesc = "" +
 	"\x14drbd_reactor_version\x18\x04 \x01(\tR\x12drbdReactorVersion\x120\n" +
 	"\x14drbd_reactor_running\x18\x05 \x01(\bR\x12drbdReactorRunning\x12:\n" +
 	"\x19resource_agents_installed\x18\x06 \x01(\bR\x17resourceAgentsInstalled\x12)\n" +
-	"\x10available_agents\x18\a \x03(\tR\x0favailableAgents\"\xd0\x02\n" +
+	"\x10available_agents\x18\a \x03(\tR\x0favailableAgents\x12.\n" +
+	"\x13drbd_kernel_version\x18\b \x01(\tR\x11drbdKernelVersion\x12,\n" +
+	"\x12drbd_utils_version\x18\t \x01(\tR\x10drbdUtilsVersion\x122\n" +
+	"\x15drbd_version_warnings\x18\n" +
+	" \x03(\tR\x13drbdVersionWarnings\x12\x1f\n" +
+	"\vlvm_version\x18\v \x01(\tR\n" +
+	"lvmVersion\x12\x1f\n" +
+	"\vzfs_version\x18\f \x01(\tR\n" +
+	"zfsVersion\x12 \n" +
+	"\x04time\x18\r \x01(\v2\f.v1.NodeTimeR\x04time\"\xaa\x02\n" +
+	"\bNodeTime\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x17\n" +
+	"\askew_ms\x18\x02 \x01(\x01R\x06skewMs\x12\"\n" +
+	"\rround_trip_ms\x18\x03 \x01(\x01R\vroundTripMs\x12\"\n" +
+	"\fsynchronized\x18\x04 \x01(\bR\fsynchronized\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12\x16\n" +
+	"\x06server\x18\x06 \x01(\tR\x06server\x12(\n" +
+	"\x10source_offset_ms\x18\a \x01(\x01R\x0esourceOffsetMs\x12\x1a\n" +
+	"\bwarnings\x18\b \x03(\tR\bwarnings\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"checked_at\x18\n" +
+	" \x01(\x03R\tcheckedAt\"(\n" +
+	"\x10CheckTimeRequest\x12\x14\n" +
+	"\x05nodes\x18\x01 \x03(\tR\x05nodes\"\xa8\x01\n" +
+	"\x11CheckTimeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\"\n" +
+	"\x05nodes\x18\x03 \x03(\v2\f.v1.NodeTimeR\x05nodes\x12\x1e\n" +
+	"\vmax_skew_ms\x18\x04 \x01(\x01R\tmaxSkewMs\x12\x1b\n" +
+	"\tspread_ms\x18\x05 \x01(\x01R\bspreadMs\"\x82\x05\n" +
 	"\x15CreateResourceRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\rR\x04port\x12\x14\n" +
@@ -7267,18 +23286,201 @@ const file_api_proto_v1_sds_proto_rawDesc = "" +
 	"\asize_gb\x18\x05 \x01(\rR\x06sizeGb\x12\x12\n" +
 	"\x04pool\x18\x06 \x01(\tR\x04pool\x12!\n" +
 	"\fstorage_type\x18\a \x01(\tR\vstorageType\x12M\n" +
-	"\fdrbd_options\x18\b \x03(\v2*.v1.CreateResourceRequest.DrbdOptionsEntryR\vdrbdOptions\x1a>\n" +
+	"\fdrbd_options\x18\b \x03(\v2*.v1.CreateResourceRequest.DrbdOptionsEntryR\vdrbdOptions\x12@\n" +
+	"\adevices\x18\t \x03(\v2&.v1.CreateResourceRequest.DevicesEntryR\adevices\x12S\n" +
+	"\x0epeer_protocols\x18\n" +
+	" \x03(\v2,.v1.CreateResourceRequest.PeerProtocolsEntryR\rpeerProtocols\x12\x1b\n" +
+	"\tmax_peers\x18\v \x01(\rR\bmaxPeers\x1a>\n" +
 	"\x10DrbdOptionsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a:\n" +
+	"\fDevicesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a@\n" +
+	"\x12PeerProtocolsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"L\n" +
 	"\x16CreateResourceResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"+\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"Y\n" +
 	"\x15DeleteResourceRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"L\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12,\n" +
+	"\x12no_safety_snapshot\x18\x02 \x01(\bR\x10noSafetySnapshot\"L\n" +
 	"\x16DeleteResourceResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"(\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"n\n" +
+	"\x12SetMaxPeersRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tmax_peers\x18\x02 \x01(\rR\bmaxPeers\x12'\n" +
+	"\x0ftimeout_seconds\x18\x03 \x01(\x03R\x0etimeoutSeconds\"\x81\x01\n" +
+	"\x13SetMaxPeersResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\tconverted\x18\x03 \x03(\tR\tconverted\x12\x18\n" +
+	"\apending\x18\x04 \x03(\tR\apending\"e\n" +
+	"\x12MigratePoolRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04pool\x18\x02 \x01(\tR\x04pool\x12'\n" +
+	"\x0ftimeout_seconds\x18\x03 \x01(\x03R\x0etimeoutSeconds\"\x7f\n" +
+	"\x13MigratePoolResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\bmigrated\x18\x03 \x03(\tR\bmigrated\x12\x18\n" +
+	"\apending\x18\x04 \x03(\tR\apending\"\x8b\x01\n" +
+	"\x15ConvertStorageRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fstorage_type\x18\x02 \x01(\tR\vstorageType\x12\x12\n" +
+	"\x04pool\x18\x03 \x01(\tR\x04pool\x12'\n" +
+	"\x0ftimeout_seconds\x18\x04 \x01(\x03R\x0etimeoutSeconds\"\x84\x01\n" +
+	"\x16ConvertStorageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\tconverted\x18\x03 \x03(\tR\tconverted\x12\x18\n" +
+	"\apending\x18\x04 \x03(\tR\apending\"\x93\x01\n" +
+	"\x12ReplaceDiskRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\x12\x18\n" +
+	"\adevices\x18\x03 \x03(\tR\adevices\x12\x12\n" +
+	"\x04pool\x18\x04 \x01(\tR\x04pool\x12'\n" +
+	"\x0ftimeout_seconds\x18\x05 \x01(\x03R\x0etimeoutSeconds\"i\n" +
+	"\fReplacedDisk\x12\x1b\n" +
+	"\tvolume_id\x18\x01 \x01(\rR\bvolumeId\x12\x1d\n" +
+	"\n" +
+	"old_device\x18\x02 \x01(\tR\toldDevice\x12\x1d\n" +
+	"\n" +
+	"new_device\x18\x03 \x01(\tR\tnewDevice\"q\n" +
+	"\x13ReplaceDiskResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x05disks\x18\x03 \x03(\v2\x10.v1.ReplacedDiskR\x05disks\")\n" +
+	"\x13StopResourceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"J\n" +
+	"\x14StopResourceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\":\n" +
+	"\x10PauseSyncRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04peer\x18\x02 \x01(\tR\x04peer\"G\n" +
+	"\x11PauseSyncResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\";\n" +
+	"\x11ResumeSyncRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04peer\x18\x02 \x01(\tR\x04peer\"H\n" +
+	"\x12ResumeSyncResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x91\x01\n" +
+	"\x12SetSyncRateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04peer\x18\x02 \x01(\tR\x04peer\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\x12)\n" +
+	"\x10duration_seconds\x18\x04 \x01(\x03R\x0fdurationSeconds\x12\x14\n" +
+	"\x05clear\x18\x05 \x01(\bR\x05clear\"h\n" +
+	"\x13SetSyncRateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\"*\n" +
+	"\x14StartResourceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"K\n" +
+	"\x15StartResourceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x80\x01\n" +
+	"\x14ExecFenceTestRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\x12\x12\n" +
+	"\x04peer\x18\x03 \x01(\tR\x04peer\x12,\n" +
+	"\x12io_timeout_seconds\x18\x04 \x01(\x03R\x10ioTimeoutSeconds\"T\n" +
+	"\x0eFenceTestCheck\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\bR\x06passed\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\"\x96\x02\n" +
+	"\x15ExecFenceTestResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04node\x18\x03 \x01(\tR\x04node\x12\x12\n" +
+	"\x04peer\x18\x04 \x01(\tR\x04peer\x12\x16\n" +
+	"\x06quorum\x18\x05 \x01(\tR\x06quorum\x12 \n" +
+	"\fon_no_quorum\x18\x06 \x01(\tR\n" +
+	"onNoQuorum\x12#\n" +
+	"\rexpect_quorum\x18\a \x01(\bR\fexpectQuorum\x12\x16\n" +
+	"\x06passed\x18\b \x01(\bR\x06passed\x12*\n" +
+	"\x06checks\x18\t \x03(\v2\x12.v1.FenceTestCheckR\x06checks\"\xad\x01\n" +
+	"\x17ActivateResourceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\x12\x1f\n" +
+	"\vmount_point\x18\x03 \x01(\tR\n" +
+	"mountPoint\x12\x17\n" +
+	"\afs_type\x18\x04 \x01(\tR\x06fsType\x12\x1a\n" +
+	"\bservices\x18\x05 \x03(\tR\bservices\x12\x14\n" +
+	"\x05force\x18\x06 \x01(\bR\x05force\"T\n" +
+	"\x0eActivationStep\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\bR\x06passed\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\"\x8c\x01\n" +
+	"\x18ActivateResourceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04node\x18\x03 \x01(\tR\x04node\x12(\n" +
+	"\x05steps\x18\x04 \x03(\v2\x12.v1.ActivationStepR\x05steps\"/\n" +
+	"\x19DeactivateResourceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x8e\x01\n" +
+	"\x1aDeactivateResourceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04node\x18\x03 \x01(\tR\x04node\x12(\n" +
+	"\x05steps\x18\x04 \x03(\v2\x12.v1.ActivationStepR\x05steps\"q\n" +
+	"\x10FencePeerRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\x12\x12\n" +
+	"\x04peer\x18\x03 \x01(\tR\x04peer\x12!\n" +
+	"\fpeer_address\x18\x04 \x01(\tR\vpeerAddress\"d\n" +
+	"\x11FencePeerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1b\n" +
+	"\texit_code\x18\x03 \x01(\rR\bexitCode\"b\n" +
+	"\x12UnfencePeerRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\x12\x12\n" +
+	"\x04peer\x18\x03 \x01(\tR\x04peer\x12\x10\n" +
+	"\x03all\x18\x04 \x01(\bR\x03all\"f\n" +
+	"\x13UnfencePeerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1b\n" +
+	"\texit_code\x18\x03 \x01(\rR\bexitCode\"\x1d\n" +
+	"\x1bInstallFenceHandlersRequest\"\xcd\x02\n" +
+	"\x1cInstallFenceHandlersResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12!\n" +
+	"\ffailed_nodes\x18\x04 \x03(\tR\vfailedNodes\x12T\n" +
+	"\fdrbd_options\x18\x05 \x03(\v21.v1.InstallFenceHandlersResponse.DrbdOptionsEntryR\vdrbdOptions\x12,\n" +
+	"\x12event_handler_path\x18\x06 \x01(\tR\x10eventHandlerPath\x1a>\n" +
+	"\x10DrbdOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa9\x01\n" +
+	"\x16ReportDrbdEventRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\x12\x18\n" +
+	"\ahandler\x18\x03 \x01(\tR\ahandler\x12\x12\n" +
+	"\x04peer\x18\x04 \x01(\tR\x04peer\x12!\n" +
+	"\fpeer_address\x18\x05 \x01(\tR\vpeerAddress\x12\x16\n" +
+	"\x06volume\x18\x06 \x01(\tR\x06volume\"M\n" +
+	"\x17ReportDrbdEventResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x99\x01\n" +
+	"\x0fFenceConstraint\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\x12\x1b\n" +
+	"\tfenced_by\x18\x03 \x01(\tR\bfencedBy\x12\x1a\n" +
+	"\boutdated\x18\x04 \x01(\bR\boutdated\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\"\x1d\n" +
+	"\x1bListFenceConstraintsRequest\"\x89\x01\n" +
+	"\x1cListFenceConstraintsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\vconstraints\x18\x03 \x03(\v2\x13.v1.FenceConstraintR\vconstraints\"(\n" +
 	"\x12GetResourceRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"w\n" +
 	"\x13GetResourceResponse\x12\x18\n" +
@@ -7294,10 +23496,11 @@ const file_api_proto_v1_sds_proto_rawDesc = "" +
 	"\bresource\x18\x01 \x01(\tR\bresource\x12\x16\n" +
 	"\x06volume\x18\x02 \x01(\tR\x06volume\x12\x12\n" +
 	"\x04pool\x18\x03 \x01(\tR\x04pool\x12\x17\n" +
-	"\asize_gb\x18\x04 \x01(\rR\x06sizeGb\"G\n" +
+	"\asize_gb\x18\x04 \x01(\rR\x06sizeGb\"o\n" +
 	"\x11AddVolumeResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"N\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x06volume\x18\x03 \x01(\v2\x0e.v1.VolumeInfoR\x06volume\"N\n" +
 	"\x13RemoveVolumeRequest\x12\x1a\n" +
 	"\bresource\x18\x01 \x01(\tR\bresource\x12\x1b\n" +
 	"\tvolume_id\x18\x02 \x01(\rR\bvolumeId\"J\n" +
@@ -7310,13 +23513,104 @@ const file_api_proto_v1_sds_proto_rawDesc = "" +
 	"\asize_gb\x18\x03 \x01(\rR\x06sizeGb\"J\n" +
 	"\x14ResizeVolumeResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"+\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"K\n" +
+	"\x10GetVolumeRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x1b\n" +
+	"\tvolume_id\x18\x02 \x01(\rR\bvolumeId\"o\n" +
+	"\x11GetVolumeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x06volume\x18\x03 \x01(\v2\x0e.v1.VolumeInfoR\x06volume\"0\n" +
+	"\x12ListVolumesRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\"s\n" +
+	"\x13ListVolumesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\avolumes\x18\x03 \x03(\v2\x0e.v1.VolumeInfoR\avolumes\"+\n" +
 	"\x15ResourceStatusRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"x\n" +
 	"\x16ResourceStatusResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
-	"\x06status\x18\x03 \x01(\v2\x12.v1.ResourceStatusR\x06status\"Y\n" +
+	"\x06status\x18\x03 \x01(\v2\x12.v1.ResourceStatusR\x06status\"+\n" +
+	"\x15ExportResourceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xf2\x01\n" +
+	"\x16ExportResourceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\x10drbd_config_path\x18\x03 \x01(\tR\x0edrbdConfigPath\x12\x1f\n" +
+	"\vdrbd_config\x18\x04 \x01(\tR\n" +
+	"drbdConfig\x120\n" +
+	"\x14promoter_config_path\x18\x05 \x01(\tR\x12promoterConfigPath\x12'\n" +
+	"\x0fpromoter_config\x18\x06 \x01(\tR\x0epromoterConfig\")\n" +
+	"\x13DiffResourceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"^\n" +
+	"\n" +
+	"ConfigDiff\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x12\n" +
+	"\x04diff\x18\x04 \x01(\tR\x04diff\"p\n" +
+	"\x14DiffResourceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\x05diffs\x18\x03 \x03(\v2\x0e.v1.ConfigDiffR\x05diffs\"N\n" +
+	"\x1cGetNodeResourceConfigRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\"\xe8\x01\n" +
+	"\x11DrbdConfigSection\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04args\x18\x02 \x03(\tR\x04args\x12<\n" +
+	"\aoptions\x18\x03 \x03(\v2\".v1.DrbdConfigSection.OptionsEntryR\aoptions\x121\n" +
+	"\bsections\x18\x04 \x03(\v2\x15.v1.DrbdConfigSectionR\bsections\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb4\x02\n" +
+	"\x1dGetNodeResourceConfigResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04node\x18\x03 \x01(\tR\x04node\x12\x18\n" +
+	"\arunning\x18\x04 \x01(\bR\arunning\x12\x12\n" +
+	"\x04dump\x18\x05 \x01(\tR\x04dump\x12\x1d\n" +
+	"\n" +
+	"dump_error\x18\x06 \x01(\tR\tdumpError\x12\x12\n" +
+	"\x04show\x18\a \x01(\tR\x04show\x125\n" +
+	"\n" +
+	"configured\x18\b \x01(\v2\x15.v1.DrbdConfigSectionR\n" +
+	"configured\x123\n" +
+	"\teffective\x18\t \x01(\v2\x15.v1.DrbdConfigSectionR\teffective\"\x9f\x06\n" +
+	"\x13RenderConfigRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bresource\x18\x02 \x01(\tR\bresource\x12\x14\n" +
+	"\x05nodes\x18\x03 \x03(\tR\x05nodes\x12\x12\n" +
+	"\x04port\x18\x04 \x01(\rR\x04port\x12\x1a\n" +
+	"\bprotocol\x18\x05 \x01(\tR\bprotocol\x12Q\n" +
+	"\x0epeer_protocols\x18\x06 \x03(\v2*.v1.RenderConfigRequest.PeerProtocolsEntryR\rpeerProtocols\x12\x12\n" +
+	"\x04pool\x18\a \x01(\tR\x04pool\x12!\n" +
+	"\fstorage_type\x18\b \x01(\tR\vstorageType\x12K\n" +
+	"\fdrbd_options\x18\t \x03(\v2(.v1.RenderConfigRequest.DrbdOptionsEntryR\vdrbdOptions\x12\x18\n" +
+	"\avolumes\x18\n" +
+	" \x01(\rR\avolumes\x12\x1a\n" +
+	"\bservices\x18\v \x03(\tR\bservices\x12\x1f\n" +
+	"\vmount_point\x18\f \x01(\tR\n" +
+	"mountPoint\x12\x17\n" +
+	"\afs_type\x18\r \x01(\tR\x06fsType\x12\x10\n" +
+	"\x03vip\x18\x0e \x01(\tR\x03vip\x12\x1d\n" +
+	"\n" +
+	"depends_on\x18\x0f \x03(\tR\tdependsOn\x12-\n" +
+	"\x03nfs\x18\x10 \x01(\v2\x1b.v1.CreateNFSGatewayRequestR\x03nfs\x123\n" +
+	"\x05iscsi\x18\x11 \x01(\v2\x1d.v1.CreateISCSIGatewayRequestR\x05iscsi\x124\n" +
+	"\x06nvmeof\x18\x12 \x01(\v2\x1c.v1.CreateNVMeGatewayRequestR\x06nvmeof\x1a@\n" +
+	"\x12PeerProtocolsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10DrbdOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"q\n" +
+	"\x14RenderConfigResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
+	"\x05files\x18\x03 \x03(\v2\x0f.v1.PlannedFileR\x05files\"Y\n" +
 	"\x11SetPrimaryRequest\x12\x1a\n" +
 	"\bresource\x18\x01 \x01(\tR\bresource\x12\x12\n" +
 	"\x04node\x18\x02 \x01(\tR\x04node\x12\x14\n" +
@@ -7353,24 +23647,66 @@ const file_api_proto_v1_sds_proto_rawDesc = "" +
 	"\x04node\x18\x03 \x01(\tR\x04node\"M\n" +
 	"\x17UnmountResourceResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x92\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x8b\x02\n" +
 	"\rMakeHaRequest\x12\x1a\n" +
 	"\bresource\x18\x01 \x01(\tR\bresource\x12\x1a\n" +
 	"\bservices\x18\x02 \x03(\tR\bservices\x12\x1f\n" +
 	"\vmount_point\x18\x03 \x01(\tR\n" +
 	"mountPoint\x12\x16\n" +
 	"\x06fstype\x18\x04 \x01(\tR\x06fstype\x12\x10\n" +
-	"\x03vip\x18\x05 \x01(\tR\x03vip\"e\n" +
+	"\x03vip\x18\x05 \x01(\tR\x03vip\x12\x1d\n" +
+	"\n" +
+	"depends_on\x18\x06 \x03(\tR\tdependsOn\x12$\n" +
+	"\x06policy\x18\a \x01(\v2\f.v1.HaPolicyR\x06policy\x12\x19\n" +
+	"\bvip_pool\x18\b \x01(\tR\avipPool\x12\x17\n" +
+	"\adry_run\x18\t \x01(\bR\x06dryRun\"\x92\x01\n" +
+	"\bHaPolicy\x12*\n" +
+	"\x11on_demote_failure\x18\x01 \x01(\tR\x0fonDemoteFailure\x121\n" +
+	"\x15stop_services_on_exit\x18\x02 \x01(\bR\x12stopServicesOnExit\x12'\n" +
+	"\x0fsecondary_force\x18\x03 \x01(\bR\x0esecondaryForce\"\x9e\x01\n" +
 	"\x0eMakeHaResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
 	"\vconfig_path\x18\x03 \x01(\tR\n" +
-	"configPath\",\n" +
+	"configPath\x12\x10\n" +
+	"\x03vip\x18\x04 \x01(\tR\x03vip\x12%\n" +
+	"\x05files\x18\x05 \x03(\v2\x0f.v1.PlannedFileR\x05files\"y\n" +
+	"\vPlannedFile\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x12\n" +
+	"\x04diff\x18\x05 \x01(\tR\x04diff\"\xbf\x01\n" +
+	"\x0fUpdateHaRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12!\n" +
+	"\fadd_services\x18\x02 \x03(\tR\vaddServices\x12'\n" +
+	"\x0fremove_services\x18\x03 \x03(\tR\x0eremoveServices\x12\x10\n" +
+	"\x03vip\x18\x04 \x01(\tR\x03vip\x12\x19\n" +
+	"\bvip_pool\x18\x05 \x01(\tR\avipPool\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\"\xad\x01\n" +
+	"\x10UpdateHaResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04diff\x18\x03 \x01(\tR\x04diff\x12\x18\n" +
+	"\aactions\x18\x04 \x03(\tR\aactions\x12\x10\n" +
+	"\x03vip\x18\x05 \x01(\tR\x03vip\x12%\n" +
+	"\x05files\x18\x06 \x03(\v2\x0f.v1.PlannedFileR\x05files\"\x86\x01\n" +
+	"\x11FailoverHaRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12'\n" +
+	"\x0ftimeout_seconds\x18\x03 \x01(\x03R\x0etimeoutSeconds\x12\x14\n" +
+	"\x05force\x18\x04 \x01(\bR\x05force\"l\n" +
+	"\x12FailoverHaResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\"B\n" +
 	"\x0eEvictHaRequest\x12\x1a\n" +
-	"\bresource\x18\x01 \x01(\tR\bresource\"E\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"E\n" +
 	"\x0fEvictHaResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xbf\x02\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x90\x05\n" +
 	"\fResourceInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\rR\x04port\x12\x1a\n" +
@@ -7379,34 +23715,74 @@ const file_api_proto_v1_sds_proto_rawDesc = "" +
 	"\x04role\x18\x05 \x01(\tR\x04role\x12(\n" +
 	"\avolumes\x18\x06 \x03(\v2\x0e.v1.VolumeInfoR\avolumes\x12A\n" +
 	"\vnode_states\x18\a \x03(\v2 .v1.ResourceInfo.NodeStatesEntryR\n" +
-	"nodeStates\x1aT\n" +
+	"nodeStates\x12J\n" +
+	"\x0epeer_protocols\x18\b \x03(\v2#.v1.ResourceInfo.PeerProtocolsEntryR\rpeerProtocols\x12\x1b\n" +
+	"\tmax_peers\x18\t \x01(\rR\bmaxPeers\x12*\n" +
+	"\x11max_peers_pending\x18\n" +
+	" \x03(\tR\x0fmaxPeersPending\x12#\n" +
+	"\rdesired_state\x18\v \x01(\tR\fdesiredState\x12\x14\n" +
+	"\x05owner\x18\f \x01(\tR\x05owner\x12\x16\n" +
+	"\x06health\x18\r \x01(\tR\x06health\x12%\n" +
+	"\x0ehealth_reasons\x18\x0e \x03(\tR\rhealthReasons\x1aT\n" +
 	"\x0fNodeStatesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12+\n" +
-	"\x05value\x18\x02 \x01(\v2\x15.v1.NodeResourceStateR\x05value:\x028\x01\"\x93\x02\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.v1.NodeResourceStateR\x05value:\x028\x01\x1a@\n" +
+	"\x12PeerProtocolsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc1\x02\n" +
 	"\x0eResourceStatus\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04role\x18\x02 \x01(\tR\x04role\x12\x14\n" +
 	"\x05nodes\x18\x03 \x03(\tR\x05nodes\x12C\n" +
 	"\vnode_states\x18\x04 \x03(\v2\".v1.ResourceStatus.NodeStatesEntryR\n" +
 	"nodeStates\x12(\n" +
-	"\avolumes\x18\x05 \x03(\v2\x0e.v1.VolumeInfoR\avolumes\x1aT\n" +
+	"\avolumes\x18\x05 \x03(\v2\x0e.v1.VolumeInfoR\avolumes\x12,\n" +
+	"\bio_stats\x18\x06 \x03(\v2\x11.v1.VolumeIOStatsR\aioStats\x1aT\n" +
 	"\x0fNodeStatesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12+\n" +
-	"\x05value\x18\x02 \x01(\v2\x15.v1.NodeResourceStateR\x05value:\x028\x01\"s\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.v1.NodeResourceStateR\x05value:\x028\x01\"\xaa\x02\n" +
+	"\rVolumeIOStats\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x1b\n" +
+	"\tvolume_id\x18\x02 \x01(\rR\bvolumeId\x12+\n" +
+	"\x12read_bytes_per_sec\x18\x03 \x01(\x01R\x0freadBytesPerSec\x12-\n" +
+	"\x13write_bytes_per_sec\x18\x04 \x01(\x01R\x10writeBytesPerSec\x12\x1b\n" +
+	"\tread_iops\x18\x05 \x01(\x01R\breadIops\x12\x1d\n" +
+	"\n" +
+	"write_iops\x18\x06 \x01(\x01R\twriteIops\x12&\n" +
+	"\x0fread_latency_ms\x18\a \x01(\x01R\rreadLatencyMs\x12(\n" +
+	"\x10write_latency_ms\x18\b \x01(\x01R\x0ewriteLatencyMs\"s\n" +
 	"\x11NodeResourceState\x12\x12\n" +
 	"\x04role\x18\x01 \x01(\tR\x04role\x12\x1d\n" +
 	"\n" +
 	"disk_state\x18\x02 \x01(\tR\tdiskState\x12+\n" +
-	"\x11replication_state\x18\x03 \x01(\tR\x10replicationState\"Z\n" +
+	"\x11replication_state\x18\x03 \x01(\tR\x10replicationState\"\xe5\x02\n" +
 	"\n" +
 	"VolumeInfo\x12\x1b\n" +
 	"\tvolume_id\x18\x01 \x01(\rR\bvolumeId\x12\x16\n" +
 	"\x06device\x18\x02 \x01(\tR\x06device\x12\x17\n" +
-	"\asize_gb\x18\x03 \x01(\x04R\x06sizeGb\"h\n" +
+	"\asize_gb\x18\x03 \x01(\x04R\x06sizeGb\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\x03R\x02id\x12\x1a\n" +
+	"\bresource\x18\x05 \x01(\tR\bresource\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12\x12\n" +
+	"\x04pool\x18\a \x01(\tR\x04pool\x12!\n" +
+	"\fstorage_type\x18\b \x01(\tR\vstorageType\x12+\n" +
+	"\abacking\x18\t \x03(\v2\x11.v1.VolumeBackingR\abacking\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\v \x01(\x04R\tsizeBytes\x12'\n" +
+	"\x0fallocated_bytes\x18\f \x01(\x04R\x0eallocatedBytes\";\n" +
+	"\rVolumeBacking\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x16\n" +
+	"\x06device\x18\x02 \x01(\tR\x06device\"\x9b\x01\n" +
 	"\x15CreateSnapshotRequest\x12\x16\n" +
 	"\x06volume\x18\x01 \x01(\tR\x06volume\x12#\n" +
 	"\rsnapshot_name\x18\x02 \x01(\tR\fsnapshotName\x12\x12\n" +
-	"\x04node\x18\x03 \x01(\tR\x04node\"L\n" +
+	"\x04node\x18\x03 \x01(\tR\x04node\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\tR\x04size\x12\x1d\n" +
+	"\n" +
+	"skip_hooks\x18\x05 \x01(\bR\tskipHooks\"L\n" +
 	"\x16CreateSnapshotResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"h\n" +
@@ -7416,11 +23792,12 @@ const file_api_proto_v1_sds_proto_rawDesc = "" +
 	"\x04node\x18\x03 \x01(\tR\x04node\"L\n" +
 	"\x16DeleteSnapshotResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"i\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x97\x01\n" +
 	"\x16RestoreSnapshotRequest\x12\x16\n" +
 	"\x06volume\x18\x01 \x01(\tR\x06volume\x12#\n" +
 	"\rsnapshot_name\x18\x02 \x01(\tR\fsnapshotName\x12\x12\n" +
-	"\x04node\x18\x03 \x01(\tR\x04node\"M\n" +
+	"\x04node\x18\x03 \x01(\tR\x04node\x12,\n" +
+	"\x12no_safety_snapshot\x18\x04 \x01(\bR\x10noSafetySnapshot\"M\n" +
 	"\x17RestoreSnapshotResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"B\n" +
@@ -7430,13 +23807,134 @@ const file_api_proto_v1_sds_proto_rawDesc = "" +
 	"\x15ListSnapshotsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
-	"\tsnapshots\x18\x03 \x03(\v2\x10.v1.SnapshotInfoR\tsnapshots\"r\n" +
+	"\tsnapshots\x18\x03 \x03(\v2\x10.v1.SnapshotInfoR\tsnapshots\"\x86\x01\n" +
 	"\fSnapshotInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06volume\x18\x02 \x01(\tR\x06volume\x12\x17\n" +
 	"\asize_gb\x18\x03 \x01(\x04R\x06sizeGb\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\tR\tcreatedAt\"\xaf\x02\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x12\n" +
+	"\x04node\x18\x05 \x01(\tR\x04node\"\x95\x01\n" +
+	"\x1aCreateSnapshotGroupRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tresources\x18\x02 \x03(\tR\tresources\x12\x12\n" +
+	"\x04node\x18\x03 \x01(\tR\x04node\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\tR\x04size\x12\x1d\n" +
+	"\n" +
+	"skip_hooks\x18\x05 \x01(\bR\tskipHooks\"0\n" +
+	"\x1aDeleteSnapshotGroupRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"_\n" +
+	"\x1bRestoreSnapshotGroupRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12,\n" +
+	"\x12no_safety_snapshot\x18\x02 \x01(\bR\x10noSafetySnapshot\"c\n" +
+	"\x13SnapshotGroupResult\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x1a\n" +
+	"\bsnapshot\x18\x02 \x01(\tR\bsnapshot\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"~\n" +
+	"\x15SnapshotGroupResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
+	"\aresults\x18\x03 \x03(\v2\x17.v1.SnapshotGroupResultR\aresults\"\x8b\x01\n" +
+	"\rSnapshotGroup\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tresources\x18\x02 \x03(\tR\tresources\x12\x12\n" +
+	"\x04node\x18\x03 \x01(\tR\x04node\x12\x15\n" +
+	"\x06job_id\x18\x04 \x01(\x03R\x05jobId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\"\x1b\n" +
+	"\x19ListSnapshotGroupsRequest\"{\n" +
+	"\x1aListSnapshotGroupsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\x06groups\x18\x03 \x03(\v2\x11.v1.SnapshotGroupR\x06groups\"E\n" +
+	"\x17GetSnapshotUsageRequest\x12\x16\n" +
+	"\x06volume\x18\x01 \x01(\tR\x06volume\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\"{\n" +
+	"\x18GetSnapshotUsageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12+\n" +
+	"\x05usage\x18\x03 \x03(\v2\x15.v1.SnapshotUsageInfoR\x05usage\"\x90\x01\n" +
+	"\fSnapshotHook\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03pre\x18\x02 \x01(\tR\x03pre\x12\x12\n" +
+	"\x04post\x18\x03 \x01(\tR\x04post\x12'\n" +
+	"\x0ftimeout_seconds\x18\x04 \x01(\rR\x0etimeoutSeconds\x12\x1d\n" +
+	"\n" +
+	"on_failure\x18\x05 \x01(\tR\tonFailure\"n\n" +
+	"\x16SetSnapshotHookRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12$\n" +
+	"\x04hook\x18\x03 \x01(\v2\x10.v1.SnapshotHookR\x04hook\"M\n" +
+	"\x17SetSnapshotHookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"K\n" +
+	"\x19DeleteSnapshotHookRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"P\n" +
+	"\x1aDeleteSnapshotHookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"6\n" +
+	"\x18ListSnapshotHooksRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\"w\n" +
+	"\x19ListSnapshotHooksResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x05hooks\x18\x03 \x03(\v2\x10.v1.SnapshotHookR\x05hooks\"\xa1\x03\n" +
+	"\x11ReplicationPolicy\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x1f\n" +
+	"\vtarget_node\x18\x02 \x01(\tR\n" +
+	"targetNode\x12\x1f\n" +
+	"\vtarget_pool\x18\x03 \x01(\tR\n" +
+	"targetPool\x12)\n" +
+	"\x10interval_seconds\x18\x04 \x01(\x03R\x0fintervalSeconds\x12\x12\n" +
+	"\x04keep\x18\x05 \x01(\rR\x04keep\x12.\n" +
+	"\x13stale_after_seconds\x18\x06 \x01(\x03R\x11staleAfterSeconds\x12\x1f\n" +
+	"\vsource_node\x18\a \x01(\tR\n" +
+	"sourceNode\x12#\n" +
+	"\rlast_snapshot\x18\b \x01(\tR\flastSnapshot\x12!\n" +
+	"\flast_attempt\x18\t \x01(\x03R\vlastAttempt\x12!\n" +
+	"\flast_success\x18\n" +
+	" \x01(\x03R\vlastSuccess\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\v \x01(\tR\tlastError\x12\x14\n" +
+	"\x05stale\x18\f \x01(\bR\x05stale\"h\n" +
+	"\x1bSetReplicationPolicyRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12-\n" +
+	"\x06policy\x18\x02 \x01(\v2\x15.v1.ReplicationPolicyR\x06policy\"n\n" +
+	"\x1cSetReplicationPolicyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarnings\"<\n" +
+	"\x1eDeleteReplicationPolicyRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\"U\n" +
+	"\x1fDeleteReplicationPolicyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"<\n" +
+	"\x1eListReplicationPoliciesRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\"\x88\x01\n" +
+	"\x1fListReplicationPoliciesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
+	"\bpolicies\x18\x03 \x03(\v2\x15.v1.ReplicationPolicyR\bpolicies\"G\n" +
+	"\x15RunReplicationRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x12\n" +
+	"\x04full\x18\x02 \x01(\bR\x04full\"{\n" +
+	"\x16RunReplicationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\x06policy\x18\x03 \x01(\v2\x15.v1.ReplicationPolicyR\x06policy\"\x96\x02\n" +
+	"\x11SnapshotUsageInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06volume\x18\x02 \x01(\tR\x06volume\x12\x12\n" +
+	"\x04node\x18\x03 \x01(\tR\x04node\x12\x18\n" +
+	"\abackend\x18\x04 \x01(\tR\abackend\x12\x1d\n" +
+	"\n" +
+	"used_bytes\x18\x05 \x01(\x04R\tusedBytes\x12)\n" +
+	"\x10referenced_bytes\x18\x06 \x01(\x04R\x0freferencedBytes\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\a \x01(\x04R\tsizeBytes\x12(\n" +
+	"\x10cow_used_percent\x18\b \x01(\x01R\x0ecowUsedPercent\x12\x14\n" +
+	"\x05state\x18\t \x01(\tR\x05state\"\x96\x04\n" +
 	"\x17CreateNFSGatewayRequest\x12\x1a\n" +
 	"\bresource\x18\x01 \x01(\tR\bresource\x12\x1d\n" +
 	"\n" +
@@ -7446,15 +23944,26 @@ const file_api_proto_v1_sds_proto_rawDesc = "" +
 	"\vallowed_ips\x18\x04 \x03(\tR\n" +
 	"allowedIps\x12\x17\n" +
 	"\afs_type\x18\x05 \x01(\tR\x06fsType\x12B\n" +
-	"\aoptions\x18\x06 \x03(\v2(.v1.CreateNFSGatewayRequest.OptionsEntryR\aoptions\x1a:\n" +
+	"\aoptions\x18\x06 \x03(\v2(.v1.CreateNFSGatewayRequest.OptionsEntryR\aoptions\x12&\n" +
+	"\x0fservice_ip_pool\x18\a \x01(\tR\rserviceIpPool\x12(\n" +
+	"\x10export_base_path\x18\b \x01(\tR\x0eexportBasePath\x120\n" +
+	"\x14cluster_private_path\x18\t \x01(\tR\x12clusterPrivatePath\x12\x1c\n" +
+	"\tinterface\x18\n" +
+	" \x01(\tR\tinterface\x12\x12\n" +
+	"\x04vlan\x18\v \x01(\rR\x04vlan\x12\x16\n" +
+	"\x06router\x18\f \x01(\tR\x06router\x12\x17\n" +
+	"\adry_run\x18\r \x01(\bR\x06dryRun\x1a:\n" +
 	"\fOptionsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"o\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb5\x01\n" +
 	"\x18CreateNFSGatewayResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
 	"\vconfig_path\x18\x03 \x01(\tR\n" +
-	"configPath\"\xf9\x02\n" +
+	"configPath\x12\x1d\n" +
+	"\n" +
+	"service_ip\x18\x04 \x01(\tR\tserviceIp\x12%\n" +
+	"\x05files\x18\x05 \x03(\v2\x0f.v1.PlannedFileR\x05files\"\xb6\x04\n" +
 	"\x19CreateISCSIGatewayRequest\x12\x1a\n" +
 	"\bresource\x18\x01 \x01(\tR\bresource\x12\x1d\n" +
 	"\n" +
@@ -7464,30 +23973,50 @@ const file_api_proto_v1_sds_proto_rawDesc = "" +
 	"\busername\x18\x05 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x06 \x01(\tR\bpassword\x12&\n" +
 	"\x0eimplementation\x18\a \x01(\tR\x0eimplementation\x12D\n" +
-	"\aoptions\x18\b \x03(\v2*.v1.CreateISCSIGatewayRequest.OptionsEntryR\aoptions\x1a:\n" +
+	"\aoptions\x18\b \x03(\v2*.v1.CreateISCSIGatewayRequest.OptionsEntryR\aoptions\x12&\n" +
+	"\x0fservice_ip_pool\x18\t \x01(\tR\rserviceIpPool\x120\n" +
+	"\x14cluster_private_path\x18\n" +
+	" \x01(\tR\x12clusterPrivatePath\x12\x1c\n" +
+	"\tinterface\x18\v \x01(\tR\tinterface\x12\x12\n" +
+	"\x04vlan\x18\f \x01(\rR\x04vlan\x12\x16\n" +
+	"\x06router\x18\r \x01(\tR\x06router\x12\x17\n" +
+	"\adry_run\x18\x0e \x01(\bR\x06dryRun\x1a:\n" +
 	"\fOptionsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"q\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb7\x01\n" +
 	"\x1aCreateISCSIGatewayResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
 	"\vconfig_path\x18\x03 \x01(\tR\n" +
-	"configPath\"\x8f\x02\n" +
+	"configPath\x12\x1d\n" +
+	"\n" +
+	"service_ip\x18\x04 \x01(\tR\tserviceIp\x12%\n" +
+	"\x05files\x18\x05 \x03(\v2\x0f.v1.PlannedFileR\x05files\"\xcc\x03\n" +
 	"\x18CreateNVMeGatewayRequest\x12\x1a\n" +
 	"\bresource\x18\x01 \x01(\tR\bresource\x12\x1d\n" +
 	"\n" +
 	"service_ip\x18\x02 \x01(\tR\tserviceIp\x12\x10\n" +
 	"\x03nqn\x18\x03 \x01(\tR\x03nqn\x12%\n" +
 	"\x0etransport_type\x18\x04 \x01(\tR\rtransportType\x12C\n" +
-	"\aoptions\x18\x05 \x03(\v2).v1.CreateNVMeGatewayRequest.OptionsEntryR\aoptions\x1a:\n" +
+	"\aoptions\x18\x05 \x03(\v2).v1.CreateNVMeGatewayRequest.OptionsEntryR\aoptions\x12&\n" +
+	"\x0fservice_ip_pool\x18\x06 \x01(\tR\rserviceIpPool\x120\n" +
+	"\x14cluster_private_path\x18\a \x01(\tR\x12clusterPrivatePath\x12\x1c\n" +
+	"\tinterface\x18\b \x01(\tR\tinterface\x12\x12\n" +
+	"\x04vlan\x18\t \x01(\rR\x04vlan\x12\x16\n" +
+	"\x06router\x18\n" +
+	" \x01(\tR\x06router\x12\x17\n" +
+	"\adry_run\x18\v \x01(\bR\x06dryRun\x1a:\n" +
 	"\fOptionsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"p\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb6\x01\n" +
 	"\x19CreateNVMeGatewayResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
 	"\vconfig_path\x18\x03 \x01(\tR\n" +
-	"configPath\"&\n" +
+	"configPath\x12\x1d\n" +
+	"\n" +
+	"service_ip\x18\x04 \x01(\tR\tserviceIp\x12%\n" +
+	"\x05files\x18\x05 \x03(\v2\x0f.v1.PlannedFileR\x05files\"&\n" +
 	"\x14DeleteGatewayRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"K\n" +
 	"\x15DeleteGatewayResponse\x12\x18\n" +
@@ -7513,7 +24042,24 @@ const file_api_proto_v1_sds_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"I\n" +
 	"\x13StopGatewayResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xb0\x02\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"5\n" +
+	"\x19ListGatewayClientsRequest\x12\x18\n" +
+	"\agateway\x18\x01 \x01(\tR\agateway\"S\n" +
+	"\rGatewayClient\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\"\xb4\x01\n" +
+	"\x11GatewayClientList\x12\x18\n" +
+	"\agateway\x18\x01 \x01(\tR\agateway\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
+	"\bresource\x18\x03 \x01(\tR\bresource\x12\x12\n" +
+	"\x04node\x18\x04 \x01(\tR\x04node\x12+\n" +
+	"\aclients\x18\x05 \x03(\v2\x11.v1.GatewayClientR\aclients\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\x83\x01\n" +
+	"\x1aListGatewayClientsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
+	"\bgateways\x18\x03 \x03(\v2\x15.v1.GatewayClientListR\bgateways\"\xc6\x02\n" +
 	"\vGatewayInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -7523,10 +24069,62 @@ const file_api_proto_v1_sds_proto_rawDesc = "" +
 	"\bresource\x18\x06 \x01(\tR\bresource\x12\x1b\n" +
 	"\tvolume_id\x18\a \x01(\rR\bvolumeId\x12\x12\n" +
 	"\x04path\x18\b \x01(\tR\x04path\x126\n" +
-	"\aoptions\x18\t \x03(\v2\x1c.v1.GatewayInfo.OptionsEntryR\aoptions\x1a:\n" +
+	"\aoptions\x18\t \x03(\v2\x1c.v1.GatewayInfo.OptionsEntryR\aoptions\x12\x14\n" +
+	"\x05owner\x18\n" +
+	" \x01(\tR\x05owner\x1a:\n" +
 	"\fOptionsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"-\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"]\n" +
+	"\x12NVMeConnectRequest\x12\x18\n" +
+	"\agateway\x18\x01 \x01(\tR\agateway\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\x12\x19\n" +
+	"\bhost_nqn\x18\x03 \x01(\tR\ahostNqn\"z\n" +
+	"\x13NVMeConnectResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
+	"\tinitiator\x18\x03 \x01(\v2\x11.v1.InitiatorInfoR\tinitiator\"E\n" +
+	"\x15NVMeDisconnectRequest\x12\x18\n" +
+	"\agateway\x18\x01 \x01(\tR\agateway\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\"}\n" +
+	"\x16NVMeDisconnectResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
+	"\tinitiator\x18\x03 \x01(\v2\x11.v1.InitiatorInfoR\tinitiator\"\xb9\x01\n" +
+	"\rInitiatorInfo\x12\x18\n" +
+	"\agateway\x18\x01 \x01(\tR\agateway\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x12\x18\n" +
+	"\aportals\x18\x04 \x03(\tR\aportals\x12\x18\n" +
+	"\adevices\x18\x05 \x03(\tR\adevices\x12\x1a\n" +
+	"\bwarnings\x18\x06 \x03(\tR\bwarnings\x12\x12\n" +
+	"\x04unit\x18\a \x01(\tR\x04unit\"7\n" +
+	"\x1bGetISCSIClientConfigRequest\x12\x18\n" +
+	"\agateway\x18\x01 \x01(\tR\agateway\"\xe2\x01\n" +
+	"\x1cGetISCSIClientConfigResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\agateway\x18\x03 \x01(\tR\agateway\x12\x10\n" +
+	"\x03iqn\x18\x04 \x01(\tR\x03iqn\x12\x18\n" +
+	"\aportals\x18\x05 \x03(\tR\aportals\x12%\n" +
+	"\x0emultipath_conf\x18\x06 \x01(\tR\rmultipathConf\x12!\n" +
+	"\flogin_script\x18\a \x01(\tR\vloginScript\"M\n" +
+	"\x1dValidateISCSIInitiatorRequest\x12\x18\n" +
+	"\agateway\x18\x01 \x01(\tR\agateway\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\"\x85\x01\n" +
+	"\x1eValidateISCSIInitiatorResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
+	"\tinitiator\x18\x03 \x01(\v2\x11.v1.InitiatorInfoR\tinitiator\"\x81\x01\n" +
+	"\x0fNFSMountRequest\x12\x18\n" +
+	"\agateway\x18\x01 \x01(\tR\agateway\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x12\n" +
+	"\x04soft\x18\x04 \x01(\bR\x04soft\x12\x18\n" +
+	"\aoptions\x18\x05 \x01(\tR\aoptions\"w\n" +
+	"\x10NFSMountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
+	"\tinitiator\x18\x03 \x01(\v2\x11.v1.InitiatorInfoR\tinitiator\"-\n" +
 	"\x0fDeleteHaRequest\x12\x1a\n" +
 	"\bresource\x18\x01 \x01(\tR\bresource\"F\n" +
 	"\x10DeleteHaResponse\x12\x18\n" +
@@ -7538,18 +24136,545 @@ const file_api_proto_v1_sds_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
 	"\x06config\x18\x03 \x01(\v2\x10.v1.HaConfigInfoR\x06config\"\x0f\n" +
-	"\rListHaRequest\"p\n" +
+	"\rListHaRequest\"0\n" +
+	"\x12GetHaStatusRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\"\x85\x01\n" +
+	"\bHaMember\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"disk_state\x18\x04 \x01(\tR\tdiskState\x12\x18\n" +
+	"\areactor\x18\x05 \x01(\tR\areactor\"\xdd\x01\n" +
+	"\x13GetHaStatusResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\x06config\x18\x03 \x01(\v2\x10.v1.HaConfigInfoR\x06config\x12\x1f\n" +
+	"\vconfig_path\x18\x04 \x01(\tR\n" +
+	"configPath\x12\x1f\n" +
+	"\vactive_node\x18\x05 \x01(\tR\n" +
+	"activeNode\x12&\n" +
+	"\amembers\x18\x06 \x03(\v2\f.v1.HaMemberR\amembers\"p\n" +
 	"\x0eListHaResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
-	"\aconfigs\x18\x03 \x03(\v2\x10.v1.HaConfigInfoR\aconfigs\"\x92\x01\n" +
+	"\aconfigs\x18\x03 \x03(\v2\x10.v1.HaConfigInfoR\aconfigs\"t\n" +
+	"\x18ImportPacemakerHaRequest\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x10\n" +
+	"\x03cib\x18\x02 \x01(\tR\x03cib\x12\x1c\n" +
+	"\tresources\x18\x03 \x03(\tR\tresources\x12\x14\n" +
+	"\x05apply\x18\x04 \x01(\bR\x05apply\"\xf7\x02\n" +
+	"\x11PacemakerHaImport\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x14\n" +
+	"\x05clone\x18\x02 \x01(\tR\x05clone\x12\x18\n" +
+	"\amembers\x18\x03 \x03(\tR\amembers\x12\x1f\n" +
+	"\vmount_point\x18\x04 \x01(\tR\n" +
+	"mountPoint\x12\x16\n" +
+	"\x06fstype\x18\x05 \x01(\tR\x06fstype\x12\x10\n" +
+	"\x03vip\x18\x06 \x01(\tR\x03vip\x12\x1a\n" +
+	"\bservices\x18\a \x03(\tR\bservices\x12'\n" +
+	"\x0fpreferred_nodes\x18\b \x03(\tR\x0epreferredNodes\x12\x1c\n" +
+	"\tunmanaged\x18\t \x01(\bR\tunmanaged\x12\x1a\n" +
+	"\bwarnings\x18\n" +
+	" \x03(\tR\bwarnings\x12\x1a\n" +
+	"\bblockers\x18\v \x03(\tR\bblockers\x12\x16\n" +
+	"\x06config\x18\f \x01(\tR\x06config\x12\x18\n" +
+	"\aapplied\x18\r \x01(\bR\aapplied\"\x80\x01\n" +
+	"\x19ImportPacemakerHaResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
+	"\aimports\x18\x03 \x03(\v2\x15.v1.PacemakerHaImportR\aimports\"\xf8\x01\n" +
 	"\fHaConfigInfo\x12\x1a\n" +
 	"\bresource\x18\x01 \x01(\tR\bresource\x12\x10\n" +
 	"\x03vip\x18\x02 \x01(\tR\x03vip\x12\x1f\n" +
 	"\vmount_point\x18\x03 \x01(\tR\n" +
 	"mountPoint\x12\x17\n" +
 	"\afs_type\x18\x04 \x01(\tR\x06fsType\x12\x1a\n" +
-	"\bservices\x18\x05 \x03(\tR\bservices2\xa4/\n" +
+	"\bservices\x18\x05 \x03(\tR\bservices\x12\x1d\n" +
+	"\n" +
+	"depends_on\x18\x06 \x03(\tR\tdependsOn\x12$\n" +
+	"\x06policy\x18\a \x01(\v2\f.v1.HaPolicyR\x06policy\x12\x1f\n" +
+	"\vactive_node\x18\b \x01(\tR\n" +
+	"activeNode\"\x84\x01\n" +
+	"\aVIPInfo\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\x05R\x06prefix\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04pool\x18\x04 \x01(\tR\x04pool\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\"a\n" +
+	"\vVIPPoolInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05range\x18\x02 \x01(\tR\x05range\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04used\x18\x04 \x01(\x05R\x04used\"\x11\n" +
+	"\x0fListVIPsRequest\"\x8e\x01\n" +
+	"\x10ListVIPsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
+	"\x04vips\x18\x03 \x03(\v2\v.v1.VIPInfoR\x04vips\x12%\n" +
+	"\x05pools\x18\x04 \x03(\v2\x0f.v1.VIPPoolInfoR\x05pools\"\x84\x01\n" +
+	"\x13DrSwitchoverRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x1f\n" +
+	"\vtarget_node\x18\x02 \x01(\tR\n" +
+	"targetNode\x120\n" +
+	"\x14sync_timeout_seconds\x18\x03 \x01(\rR\x12syncTimeoutSeconds\"\x96\x01\n" +
+	"\x14DrSwitchoverResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1b\n" +
+	"\tfrom_node\x18\x03 \x01(\tR\bfromNode\x12\x17\n" +
+	"\ato_node\x18\x04 \x01(\tR\x06toNode\x12\x14\n" +
+	"\x05steps\x18\x05 \x03(\tR\x05steps\"a\n" +
+	"\x11DrFailbackRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x120\n" +
+	"\x14sync_timeout_seconds\x18\x02 \x01(\rR\x12syncTimeoutSeconds\"\x94\x01\n" +
+	"\x12DrFailbackResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1b\n" +
+	"\tfrom_node\x18\x03 \x01(\tR\bfromNode\x12\x17\n" +
+	"\ato_node\x18\x04 \x01(\tR\x06toNode\x12\x14\n" +
+	"\x05steps\x18\x05 \x03(\tR\x05steps\"k\n" +
+	"\x17AddPlacementRuleRequest\x12\x1d\n" +
+	"\n" +
+	"resource_a\x18\x01 \x01(\tR\tresourceA\x12\x1d\n" +
+	"\n" +
+	"resource_b\x18\x02 \x01(\tR\tresourceB\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\"N\n" +
+	"\x18AddPlacementRuleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"Z\n" +
+	"\x1aDeletePlacementRuleRequest\x12\x1d\n" +
+	"\n" +
+	"resource_a\x18\x01 \x01(\tR\tresourceA\x12\x1d\n" +
+	"\n" +
+	"resource_b\x18\x02 \x01(\tR\tresourceB\"Q\n" +
+	"\x1bDeletePlacementRuleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"7\n" +
+	"\x19ListPlacementRulesRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\"}\n" +
+	"\x1aListPlacementRulesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12+\n" +
+	"\x05rules\x18\x03 \x03(\v2\x15.v1.PlacementRuleInfoR\x05rules\"\x84\x01\n" +
+	"\x11PlacementRuleInfo\x12\x1d\n" +
+	"\n" +
+	"resource_a\x18\x01 \x01(\tR\tresourceA\x12\x1d\n" +
+	"\n" +
+	"resource_b\x18\x02 \x01(\tR\tresourceB\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\"E\n" +
+	"\x11ListEventsRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"o\n" +
+	"\x12ListEventsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
+	"\x06events\x18\x03 \x03(\v2\r.v1.EventInfoR\x06events\"\xf5\x01\n" +
+	"\tEventInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
+	"\bresource\x18\x04 \x01(\tR\bresource\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x124\n" +
+	"\adetails\x18\x06 \x03(\v2\x1a.v1.EventInfo.DetailsEntryR\adetails\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"E\n" +
+	"\x17GetClusterReportRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\rR\x04days\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"\x80\x01\n" +
+	"\x18GetClusterReportResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\"c\n" +
+	"\x14GetAlertRulesRequest\x12*\n" +
+	"\x11pool_full_percent\x18\x01 \x01(\x01R\x0fpoolFullPercent\x12\x1f\n" +
+	"\vfor_seconds\x18\x02 \x01(\rR\n" +
+	"forSeconds\"a\n" +
+	"\x15GetAlertRulesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05rules\x18\x03 \x01(\tR\x05rules\"T\n" +
+	"\fFreezeStatus\x12\x16\n" +
+	"\x06frozen\x18\x01 \x01(\bR\x06frozen\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\x03R\x05since\"\x15\n" +
+	"\x13ListClustersRequest\"\xad\x01\n" +
+	"\vClusterInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12#\n" +
+	"\rdatabase_path\x18\x03 \x01(\tR\fdatabasePath\x12\x14\n" +
+	"\x05nodes\x18\x04 \x01(\rR\x05nodes\x12\x1c\n" +
+	"\tresources\x18\x05 \x01(\rR\tresources\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x06 \x01(\bR\tisDefault\"w\n" +
+	"\x14ListClustersResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12+\n" +
+	"\bclusters\x18\x03 \x03(\v2\x0f.v1.ClusterInfoR\bclusters\"\x97\x01\n" +
+	"\tBuildInfo\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x16\n" +
+	"\x06commit\x18\x02 \x01(\tR\x06commit\x12\x1d\n" +
+	"\n" +
+	"build_date\x18\x03 \x01(\tR\tbuildDate\x12\x1d\n" +
+	"\n" +
+	"go_version\x18\x04 \x01(\tR\tgoVersion\x12\x1a\n" +
+	"\bplatform\x18\x05 \x01(\tR\bplatform\"\xf7\x02\n" +
+	"\x0eNodeComponents\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12.\n" +
+	"\x13drbd_kernel_version\x18\x04 \x01(\tR\x11drbdKernelVersion\x12,\n" +
+	"\x12drbd_utils_version\x18\x05 \x01(\tR\x10drbdUtilsVersion\x120\n" +
+	"\x14drbd_reactor_version\x18\x06 \x01(\tR\x12drbdReactorVersion\x12\x1f\n" +
+	"\vlvm_version\x18\a \x01(\tR\n" +
+	"lvmVersion\x12\x1f\n" +
+	"\vzfs_version\x18\b \x01(\tR\n" +
+	"zfsVersion\x12\x1d\n" +
+	"\n" +
+	"checked_at\x18\t \x01(\x03R\tcheckedAt\x12\x14\n" +
+	"\x05stale\x18\n" +
+	" \x01(\bR\x05stale\x12\x1a\n" +
+	"\bwarnings\x18\v \x03(\tR\bwarnings\"\x17\n" +
+	"\x15GetClusterInfoRequest\"\xd0\x01\n" +
+	"\x16GetClusterInfoResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\acluster\x18\x03 \x01(\v2\x0f.v1.ClusterInfoR\acluster\x12-\n" +
+	"\n" +
+	"controller\x18\x04 \x01(\v2\r.v1.BuildInfoR\n" +
+	"controller\x12(\n" +
+	"\x05nodes\x18\x05 \x03(\v2\x12.v1.NodeComponentsR\x05nodes\",\n" +
+	"\x12GetOverviewRequest\x12\x16\n" +
+	"\x06events\x18\x01 \x01(\x05R\x06events\"\xb7\x02\n" +
+	"\x13GetOverviewResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\"\n" +
+	"\x05nodes\x18\x03 \x03(\v2\f.v1.NodeInfoR\x05nodes\x12\"\n" +
+	"\x05pools\x18\x04 \x03(\v2\f.v1.PoolInfoR\x05pools\x12.\n" +
+	"\tresources\x18\x05 \x03(\v2\x10.v1.ResourceInfoR\tresources\x12 \n" +
+	"\x02ha\x18\x06 \x03(\v2\x10.v1.HaConfigInfoR\x02ha\x12+\n" +
+	"\bgateways\x18\a \x03(\v2\x0f.v1.GatewayInfoR\bgateways\x12%\n" +
+	"\x06events\x18\b \x03(\v2\r.v1.EventInfoR\x06events\"'\n" +
+	"\rFreezeRequest\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\"n\n" +
+	"\x0eFreezeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\x06status\x18\x03 \x01(\v2\x10.v1.FreezeStatusR\x06status\"\x11\n" +
+	"\x0fUnfreezeRequest\"F\n" +
+	"\x10UnfreezeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x18\n" +
+	"\x16GetFreezeStatusRequest\"w\n" +
+	"\x17GetFreezeStatusResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\x06status\x18\x03 \x01(\v2\x10.v1.FreezeStatusR\x06status\"\x8b\x01\n" +
+	"\x06Orphan\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x15\n" +
+	"\x06in_use\x18\x04 \x01(\bR\x05inUse\x12\x18\n" +
+	"\aremoved\x18\x05 \x01(\bR\aremoved\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"0\n" +
+	"\x15CollectGarbageRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\"r\n" +
+	"\x16CollectGarbageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\aorphans\x18\x03 \x03(\v2\n" +
+	".v1.OrphanR\aorphans\"\xc8\x01\n" +
+	"\fRediscovered\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05nodes\x18\x03 \x03(\tR\x05nodes\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x16\n" +
+	"\x06detail\x18\x05 \x01(\tR\x06detail\x12\x18\n" +
+	"\askipped\x18\x06 \x01(\tR\askipped\x12\x1a\n" +
+	"\brestored\x18\a \x01(\bR\brestored\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\",\n" +
+	"\x11RediscoverRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\"t\n" +
+	"\x12RediscoverResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\arecords\x18\x03 \x03(\v2\x10.v1.RediscoveredR\arecords\"\x94\x01\n" +
+	"\x05Drift\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04node\x18\x03 \x01(\tR\x04node\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x18\n" +
+	"\aproblem\x18\x05 \x01(\tR\aproblem\x12\x1f\n" +
+	"\vdetected_at\x18\x06 \x01(\x03R\n" +
+	"detectedAt\"1\n" +
+	"\x15GetDriftReportRequest\x12\x18\n" +
+	"\arefresh\x18\x01 \x01(\bR\arefresh\"\x8e\x01\n" +
+	"\x16GetDriftReportResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"checked_at\x18\x03 \x01(\x03R\tcheckedAt\x12!\n" +
+	"\x06drifts\x18\x04 \x03(\v2\t.v1.DriftR\x06drifts\"7\n" +
+	"\rRepairRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"^\n" +
+	"\x0eRepairResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\aactions\x18\x03 \x03(\tR\aactions\"H\n" +
+	"\x10RebalanceRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x1b\n" +
+	"\tmax_moves\x18\x02 \x01(\x05R\bmaxMoves\"A\n" +
+	"\rNodePrimaries\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x1c\n" +
+	"\tprimaries\x18\x02 \x01(\x05R\tprimaries\"\x8b\x01\n" +
+	"\rRebalanceMove\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x1b\n" +
+	"\tfrom_node\x18\x02 \x01(\tR\bfromNode\x12\x17\n" +
+	"\ato_node\x18\x03 \x01(\tR\x06toNode\x12\x12\n" +
+	"\x04done\x18\x04 \x01(\bR\x04done\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\xb3\x01\n" +
+	"\x11RebalanceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
+	"\x05nodes\x18\x03 \x03(\v2\x11.v1.NodePrimariesR\x05nodes\x12'\n" +
+	"\x05moves\x18\x04 \x03(\v2\x11.v1.RebalanceMoveR\x05moves\x12\x18\n" +
+	"\askipped\x18\x05 \x03(\tR\askipped\"\xd9\x03\n" +
+	"\x10DrbdGlobalConfig\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1f\n" +
+	"\vusage_count\x18\x02 \x01(\tR\n" +
+	"usageCount\x122\n" +
+	"\x04disk\x18\x03 \x03(\v2\x1e.v1.DrbdGlobalConfig.DiskEntryR\x04disk\x12/\n" +
+	"\x03net\x18\x04 \x03(\v2\x1d.v1.DrbdGlobalConfig.NetEntryR\x03net\x12>\n" +
+	"\bhandlers\x18\x05 \x03(\v2\".v1.DrbdGlobalConfig.HandlersEntryR\bhandlers\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x1a7\n" +
+	"\tDiskEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a6\n" +
+	"\bNetEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
+	"\rHandlersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"6\n" +
+	"\x1aGetDrbdGlobalConfigRequest\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\"\x99\x01\n" +
+	"\x1bGetDrbdGlobalConfigResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x06config\x18\x03 \x01(\v2\x14.v1.DrbdGlobalConfigR\x06config\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\"`\n" +
+	"\x1aSetDrbdGlobalConfigRequest\x12,\n" +
+	"\x06config\x18\x01 \x01(\v2\x14.v1.DrbdGlobalConfigR\x06config\x12\x14\n" +
+	"\x05apply\x18\x02 \x01(\bR\x05apply\"\x8e\x01\n" +
+	"\x1bSetDrbdGlobalConfigResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12!\n" +
+	"\ffailed_nodes\x18\x04 \x03(\tR\vfailedNodes\"\x1e\n" +
+	"\x1cListDrbdGlobalConfigsRequest\"\x83\x01\n" +
+	"\x1dListDrbdGlobalConfigsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\aconfigs\x18\x03 \x03(\v2\x14.v1.DrbdGlobalConfigR\aconfigs\"Q\n" +
+	"\x1fRollbackDrbdGlobalConfigRequest\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x14\n" +
+	"\x05apply\x18\x02 \x01(\bR\x05apply\"\x93\x01\n" +
+	" RollbackDrbdGlobalConfigResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12!\n" +
+	"\ffailed_nodes\x18\x04 \x03(\tR\vfailedNodes\"\xee\x01\n" +
+	"\aJobStep\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\x12\x18\n" +
+	"\acommand\x18\x03 \x01(\tR\acommand\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\x03R\tstartedAt\x12\x1f\n" +
+	"\vduration_ms\x18\x05 \x01(\x03R\n" +
+	"durationMs\x12\x18\n" +
+	"\asuccess\x18\x06 \x01(\bR\asuccess\x12\x12\n" +
+	"\x04slow\x18\a \x01(\bR\x04slow\x12\x1b\n" +
+	"\texit_code\x18\b \x01(\x05R\bexitCode\x12\x16\n" +
+	"\x06output\x18\t \x01(\tR\x06output\"\xaf\x03\n" +
+	"\aJobInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
+	"\toperation\x18\x02 \x01(\tR\toperation\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\x03R\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\a \x01(\x03R\n" +
+	"finishedAt\x12\x1d\n" +
+	"\n" +
+	"step_count\x18\b \x01(\x05R\tstepCount\x12\x1d\n" +
+	"\n" +
+	"slow_steps\x18\t \x01(\x05R\tslowSteps\x12!\n" +
+	"\x05steps\x18\n" +
+	" \x03(\v2\v.v1.JobStepR\x05steps\x12\x12\n" +
+	"\x04step\x18\v \x01(\tR\x04step\x12\x1c\n" +
+	"\tresumable\x18\f \x01(\bR\tresumable\x12\x1a\n" +
+	"\brollback\x18\r \x01(\tR\brollback\x12\x1f\n" +
+	"\vresolved_by\x18\x0e \x01(\x03R\n" +
+	"resolvedBy\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x0f \x01(\tR\n" +
+	"resolution\"U\n" +
+	"\x0fListJobsRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\"g\n" +
+	"\x10ListJobsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
+	"\x04jobs\x18\x03 \x03(\v2\v.v1.JobInfoR\x04jobs\"\x1f\n" +
+	"\rGetJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"c\n" +
+	"\x0eGetJobResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\x03job\x18\x03 \x01(\v2\v.v1.JobInfoR\x03job\"\"\n" +
+	"\x10ResumeJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"^\n" +
+	"\x11ResumeJobResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x15\n" +
+	"\x06job_id\x18\x03 \x01(\x03R\x05jobId\"$\n" +
+	"\x12RollbackJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"`\n" +
+	"\x13RollbackJobResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x15\n" +
+	"\x06job_id\x18\x03 \x01(\x03R\x05jobId\":\n" +
+	"\x17WatchJobProgressRequest\x12\x1f\n" +
+	"\vprogress_id\x18\x01 \x01(\tR\n" +
+	"progressId\"\xe1\x01\n" +
+	"\vJobProgress\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\x03R\x05jobId\x12\x12\n" +
+	"\x04step\x18\x03 \x01(\tR\x04step\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12\x12\n" +
+	"\x04node\x18\x05 \x01(\tR\x04node\x12\x18\n" +
+	"\asuccess\x18\x06 \x01(\bR\asuccess\x12\x1f\n" +
+	"\vduration_ms\x18\a \x01(\x03R\n" +
+	"durationMs\x12\x14\n" +
+	"\x05state\x18\b \x01(\tR\x05state\x12\x18\n" +
+	"\amessage\x18\t \x01(\tR\amessage\"\xaa\x02\n" +
+	"\bNetProbe\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x04 \x01(\x01R\tlatencyMs\x12'\n" +
+	"\x0fthroughput_mbps\x18\x05 \x01(\x01R\x0ethroughputMbps\x12#\n" +
+	"\rrequired_mbps\x18\x06 \x01(\x01R\frequiredMbps\x12\x1a\n" +
+	"\bprotocol\x18\a \x01(\tR\bprotocol\x12\x1a\n" +
+	"\bwarnings\x18\b \x03(\tR\bwarnings\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12\x1b\n" +
+	"\tprobed_at\x18\n" +
+	" \x01(\x03R\bprobedAt\"+\n" +
+	"\x13ProbeNetworkRequest\x12\x14\n" +
+	"\x05nodes\x18\x01 \x03(\tR\x05nodes\"p\n" +
+	"\x14ProbeNetworkResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\x06probes\x18\x03 \x03(\v2\f.v1.NetProbeR\x06probes\"\x16\n" +
+	"\x14ListNetProbesRequest\"q\n" +
+	"\x15ListNetProbesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\x06probes\x18\x03 \x03(\v2\f.v1.NetProbeR\x06probes\"\xaf\x01\n" +
+	"\x0eDiscoveredDisk\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x02 \x01(\x04R\tsizeBytes\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12\x1e\n" +
+	"\n" +
+	"rotational\x18\x04 \x01(\bR\n" +
+	"rotational\x12\x1c\n" +
+	"\tavailable\x18\x05 \x01(\bR\tavailable\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"*\n" +
+	"\x14DiscoverDisksRequest\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\"u\n" +
+	"\x15DiscoverDisksResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\x05disks\x18\x03 \x03(\v2\x12.v1.DiscoveredDiskR\x05disks\"K\n" +
+	"\tSetupStep\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04done\x18\x02 \x01(\bR\x04done\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\"\x17\n" +
+	"\x15GetSetupStatusRequest\"\xc6\x01\n" +
+	"\x16GetSetupStatusResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12#\n" +
+	"\x05steps\x18\x03 \x03(\v2\r.v1.SetupStepR\x05steps\x12\x12\n" +
+	"\x04next\x18\x04 \x01(\tR\x04next\x12\x1c\n" +
+	"\tcompleted\x18\x05 \x01(\bR\tcompleted\x12!\n" +
+	"\fcompleted_at\x18\x06 \x01(\x03R\vcompletedAt\"P\n" +
+	"\n" +
+	"SetupCheck\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\bR\x06passed\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\"I\n" +
+	"\x19TestNodeConnectionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\"\x8e\x01\n" +
+	"\x1aTestNodeConnectionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x06checks\x18\x03 \x03(\v2\x0e.v1.SetupCheckR\x06checks\x12\x14\n" +
+	"\x05ready\x18\x04 \x01(\bR\x05ready\"\x16\n" +
+	"\x14CompleteSetupRequest\"n\n" +
+	"\x15CompleteSetupResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12!\n" +
+	"\fcompleted_at\x18\x03 \x01(\x03R\vcompletedAt\"]\n" +
+	"\x14SupportBundleRequest\x12\x14\n" +
+	"\x05nodes\x18\x01 \x03(\tR\x05nodes\x12\x1b\n" +
+	"\tlog_lines\x18\x02 \x01(\x05R\blogLines\x12\x12\n" +
+	"\x04jobs\x18\x03 \x01(\x05R\x04jobs\"(\n" +
+	"\x12SupportBundleChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\x9a\x02\n" +
+	"\tEventHook\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06events\x18\x02 \x03(\tR\x06events\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x18\n" +
+	"\acommand\x18\x04 \x01(\tR\acommand\x124\n" +
+	"\aheaders\x18\x05 \x03(\v2\x1a.v1.EventHook.HeadersEntryR\aheaders\x12\x1a\n" +
+	"\btemplate\x18\x06 \x01(\tR\btemplate\x12'\n" +
+	"\x0ftimeout_seconds\x18\a \x01(\rR\x0etimeoutSeconds\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"L\n" +
+	"\x13SetEventHookRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\x04hook\x18\x02 \x01(\v2\r.v1.EventHookR\x04hook\"J\n" +
+	"\x14SetEventHookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\",\n" +
+	"\x16DeleteEventHookRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"M\n" +
+	"\x17DeleteEventHookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x17\n" +
+	"\x15ListEventHooksRequest\"q\n" +
+	"\x16ListEventHooksResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12#\n" +
+	"\x05hooks\x18\x03 \x03(\v2\r.v1.EventHookR\x05hooks\"*\n" +
+	"\x14TestEventHookRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"K\n" +
+	"\x15TestEventHookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\x9c\x81\x01\n" +
 	"\rSDSController\x12Q\n" +
 	"\n" +
 	"CreatePool\x12\x15.v1.CreatePoolRequest\x1a\x16.v1.CreatePoolResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/pools\x12U\n" +
@@ -7557,20 +24682,57 @@ const file_api_proto_v1_sds_proto_rawDesc = "" +
 	"DeletePool\x12\x15.v1.DeletePoolRequest\x1a\x16.v1.DeletePoolResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/pools/{name}\x12L\n" +
 	"\aGetPool\x12\x12.v1.GetPoolRequest\x1a\x13.v1.GetPoolResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/pools/{name}\x12K\n" +
 	"\tListPools\x12\x14.v1.ListPoolsRequest\x1a\x15.v1.ListPoolsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/pools\x12g\n" +
-	"\rAddDiskToPool\x12\x18.v1.AddDiskToPoolRequest\x1a\x19.v1.AddDiskToPoolResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/pools/{pool}/disks\x12W\n" +
+	"\rAddDiskToPool\x12\x18.v1.AddDiskToPoolRequest\x1a\x19.v1.AddDiskToPoolResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/pools/{pool}/disks\x12i\n" +
+	"\x0eGetPoolHistory\x12\x19.v1.GetPoolHistoryRequest\x1a\x1a.v1.GetPoolHistoryResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/pools/{pool}/history\x12W\n" +
 	"\fRegisterNode\x12\x17.v1.RegisterNodeRequest\x1a\x18.v1.RegisterNodeResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/nodes\x12d\n" +
 	"\x0eUnregisterNode\x12\x19.v1.UnregisterNodeRequest\x1a\x1a.v1.UnregisterNodeResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/nodes/{address}\x12O\n" +
 	"\aGetNode\x12\x12.v1.GetNodeRequest\x1a\x13.v1.GetNodeResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/nodes/{address}\x12K\n" +
-	"\tListNodes\x12\x14.v1.ListNodesRequest\x1a\x15.v1.ListNodesResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/nodes\x12_\n" +
-	"\vHealthCheck\x12\x16.v1.HealthCheckRequest\x1a\x17.v1.HealthCheckResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/nodes/{node}/health\x12a\n" +
+	"\tListNodes\x12\x14.v1.ListNodesRequest\x1a\x15.v1.ListNodesResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/nodes\x12l\n" +
+	"\x0eSetNodeAddress\x12\x19.v1.SetNodeAddressRequest\x1a\x1a.v1.SetNodeAddressResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/nodes/{name}/address\x12[\n" +
+	"\tTrustNode\x12\x14.v1.TrustNodeRequest\x1a\x15.v1.TrustNodeResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/nodes/{node}/trust\x12d\n" +
+	"\rDiscoverDisks\x12\x18.v1.DiscoverDisksRequest\x1a\x19.v1.DiscoverDisksResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/nodes/{node}/disks\x12_\n" +
+	"\n" +
+	"HardenNode\x12\x15.v1.HardenNodeRequest\x1a\x16.v1.HardenNodeResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/nodes/{node}/harden\x12|\n" +
+	"\x12SetNodeMaintenance\x12\x1d.v1.SetNodeMaintenanceRequest\x1a\x1e.v1.SetNodeMaintenanceResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/v1/nodes/{node}/maintenance\x12\x7f\n" +
+	"\x14ClearNodeMaintenance\x12\x1f.v1.ClearNodeMaintenanceRequest\x1a .v1.ClearNodeMaintenanceResponse\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/v1/nodes/{node}/maintenance\x12x\n" +
+	"\x16ListMaintenanceWindows\x12!.v1.ListMaintenanceWindowsRequest\x1a\".v1.ListMaintenanceWindowsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/maintenance\x12_\n" +
+	"\vHealthCheck\x12\x16.v1.HealthCheckRequest\x1a\x17.v1.HealthCheckResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/nodes/{node}/health\x12Y\n" +
+	"\tCheckTime\x12\x14.v1.CheckTimeRequest\x1a\x15.v1.CheckTimeResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/nodes/check-time\x12P\n" +
+	"\bNodeExec\x12\x13.v1.NodeExecRequest\x1a\x14.v1.NodeExecResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/nodes/exec\x12Q\n" +
+	"\bPushFile\x12\x13.v1.PushFileRequest\x1a\x14.v1.PushFileResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/nodes/files\x12]\n" +
+	"\x0eStreamNodeLogs\x12\x19.v1.StreamNodeLogsRequest\x1a\x0f.v1.NodeLogLine\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/nodes/{node}/logs0\x01\x12a\n" +
 	"\x0eCreateResource\x12\x19.v1.CreateResourceRequest\x1a\x1a.v1.CreateResourceResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/resources\x12e\n" +
-	"\x0eDeleteResource\x12\x19.v1.DeleteResourceRequest\x1a\x1a.v1.DeleteResourceResponse\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v1/resources/{name}\x12\\\n" +
+	"\x0eDeleteResource\x12\x19.v1.DeleteResourceRequest\x1a\x1a.v1.DeleteResourceResponse\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v1/resources/{name}\x12i\n" +
+	"\vSetMaxPeers\x12\x16.v1.SetMaxPeersRequest\x1a\x17.v1.SetMaxPeersResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/resources/{name}/max-peers\x12l\n" +
+	"\vMigratePool\x12\x16.v1.MigratePoolRequest\x1a\x17.v1.MigratePoolResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/resources/{name}/migrate-pool\x12x\n" +
+	"\x0eConvertStorage\x12\x19.v1.ConvertStorageRequest\x1a\x1a.v1.ConvertStorageResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/resources/{name}/convert-storage\x12l\n" +
+	"\vReplaceDisk\x12\x16.v1.ReplaceDiskRequest\x1a\x17.v1.ReplaceDiskResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/resources/{name}/replace-disk\x12g\n" +
+	"\fStopResource\x12\x17.v1.StopResourceRequest\x1a\x18.v1.StopResourceResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/resources/{name}/stop\x12k\n" +
+	"\rStartResource\x12\x18.v1.StartResourceRequest\x1a\x19.v1.StartResourceResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/resources/{name}/start\x12d\n" +
+	"\tPauseSync\x12\x14.v1.PauseSyncRequest\x1a\x15.v1.PauseSyncResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/resources/{name}/pause-sync\x12h\n" +
+	"\n" +
+	"ResumeSync\x12\x15.v1.ResumeSyncRequest\x1a\x16.v1.ResumeSyncResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/resources/{name}/resume-sync\x12i\n" +
+	"\vSetSyncRate\x12\x16.v1.SetSyncRateRequest\x1a\x17.v1.SetSyncRateResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/resources/{name}/sync-rate\x12p\n" +
+	"\rExecFenceTest\x12\x18.v1.ExecFenceTestRequest\x1a\x19.v1.ExecFenceTestResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/resources/{name}/fence-test\x12w\n" +
+	"\x10ActivateResource\x12\x1b.v1.ActivateResourceRequest\x1a\x1c.v1.ActivateResourceResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/resources/{name}/activate\x12\x7f\n" +
+	"\x12DeactivateResource\x12\x1d.v1.DeactivateResourceRequest\x1a\x1e.v1.DeactivateResourceResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/resources/{name}/deactivate\x12d\n" +
+	"\tFencePeer\x12\x14.v1.FencePeerRequest\x1a\x15.v1.FencePeerResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/resources/{name}/fence-peer\x12l\n" +
+	"\vUnfencePeer\x12\x16.v1.UnfencePeerRequest\x1a\x17.v1.UnfencePeerResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/resources/{name}/unfence-peer\x12w\n" +
+	"\x0fReportDrbdEvent\x12\x1a.v1.ReportDrbdEventRequest\x1a\x1b.v1.ReportDrbdEventResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/resources/{name}/drbd-events\x12y\n" +
+	"\x14InstallFenceHandlers\x12\x1f.v1.InstallFenceHandlersRequest\x1a .v1.InstallFenceHandlersResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/fencing/install\x12z\n" +
+	"\x14ListFenceConstraints\x12\x1f.v1.ListFenceConstraintsRequest\x1a .v1.ListFenceConstraintsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/fencing/constraints\x12\\\n" +
 	"\vGetResource\x12\x16.v1.GetResourceRequest\x1a\x17.v1.GetResourceResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/resources/{name}\x12[\n" +
 	"\rListResources\x12\x18.v1.ListResourcesRequest\x1a\x19.v1.ListResourcesResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/resources\x12e\n" +
 	"\tAddVolume\x12\x14.v1.AddVolumeRequest\x1a\x15.v1.AddVolumeResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/resources/{resource}/volumes\x12w\n" +
 	"\fRemoveVolume\x12\x17.v1.RemoveVolumeRequest\x1a\x18.v1.RemoveVolumeResponse\"4\x82\xd3\xe4\x93\x02.*,/v1/resources/{resource}/volumes/{volume_id}\x12z\n" +
-	"\fResizeVolume\x12\x17.v1.ResizeVolumeRequest\x1a\x18.v1.ResizeVolumeResponse\"7\x82\xd3\xe4\x93\x021:\x01*2,/v1/resources/{resource}/volumes/{volume_id}\x12l\n" +
-	"\x0eResourceStatus\x12\x19.v1.ResourceStatusRequest\x1a\x1a.v1.ResourceStatusResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/resources/{name}/status\x12h\n" +
+	"\fResizeVolume\x12\x17.v1.ResizeVolumeRequest\x1a\x18.v1.ResizeVolumeResponse\"7\x82\xd3\xe4\x93\x021:\x01*2,/v1/resources/{resource}/volumes/{volume_id}\x12n\n" +
+	"\tGetVolume\x12\x14.v1.GetVolumeRequest\x1a\x15.v1.GetVolumeResponse\"4\x82\xd3\xe4\x93\x02.\x12,/v1/resources/{resource}/volumes/{volume_id}\x12S\n" +
+	"\vListVolumes\x12\x16.v1.ListVolumesRequest\x1a\x17.v1.ListVolumesResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/volumes\x12l\n" +
+	"\x0eResourceStatus\x12\x19.v1.ResourceStatusRequest\x1a\x1a.v1.ResourceStatusResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/resources/{name}/status\x12l\n" +
+	"\x0eExportResource\x12\x19.v1.ExportResourceRequest\x1a\x1a.v1.ExportResourceResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/resources/{name}/export\x12d\n" +
+	"\fDiffResource\x12\x17.v1.DiffResourceRequest\x1a\x18.v1.DiffResourceResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/resources/{name}/diff\x12\x92\x01\n" +
+	"\x15GetNodeResourceConfig\x12 .v1.GetNodeResourceConfigRequest\x1a!.v1.GetNodeResourceConfigResponse\"4\x82\xd3\xe4\x93\x02.\x12,/v1/resources/{resource}/nodes/{node}/config\x12^\n" +
+	"\fRenderConfig\x12\x17.v1.RenderConfigRequest\x1a\x18.v1.RenderConfigResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/debug/render\x12h\n" +
 	"\n" +
 	"SetPrimary\x12\x15.v1.SetPrimaryRequest\x1a\x16.v1.SetPrimaryResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/resources/{resource}/primary\x12p\n" +
 	"\fSetSecondary\x12\x17.v1.SetSecondaryRequest\x1a\x18.v1.SetSecondaryResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/resources/{resource}/secondary\x12\x91\x01\n" +
@@ -7578,14 +24740,74 @@ const file_api_proto_v1_sds_proto_rawDesc = "" +
 	"\rMountResource\x12\x18.v1.MountResourceRequest\x1a\x19.v1.MountResourceResponse\"=\x82\xd3\xe4\x93\x027:\x01*\"2/v1/resources/{resource}/volumes/{volume_id}/mount\x12\x8b\x01\n" +
 	"\x0fUnmountResource\x12\x1a.v1.UnmountResourceRequest\x1a\x1b.v1.UnmountResourceResponse\"?\x82\xd3\xe4\x93\x029:\x01*\"4/v1/resources/{resource}/volumes/{volume_id}/unmount\x12W\n" +
 	"\x06MakeHa\x12\x11.v1.MakeHaRequest\x1a\x12.v1.MakeHaResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/resources/{resource}/ha\x12`\n" +
-	"\aEvictHa\x12\x12.v1.EvictHaRequest\x1a\x13.v1.EvictHaResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/resources/{resource}/ha/evict\x12Z\n" +
+	"\aEvictHa\x12\x12.v1.EvictHaRequest\x1a\x13.v1.EvictHaResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/resources/{resource}/ha/evict\x12]\n" +
+	"\bUpdateHa\x12\x13.v1.UpdateHaRequest\x1a\x14.v1.UpdateHaResponse\"&\x82\xd3\xe4\x93\x02 :\x01*2\x1b/v1/resources/{resource}/ha\x12l\n" +
+	"\n" +
+	"FailoverHa\x12\x15.v1.FailoverHaRequest\x1a\x16.v1.FailoverHaResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/resources/{resource}/ha/failover\x12Z\n" +
 	"\bDeleteHa\x12\x13.v1.DeleteHaRequest\x1a\x14.v1.DeleteHaResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/v1/resources/{resource}/ha\x12Q\n" +
 	"\x05GetHa\x12\x10.v1.GetHaRequest\x1a\x11.v1.GetHaResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/resources/{resource}/ha\x12?\n" +
-	"\x06ListHa\x12\x11.v1.ListHaRequest\x1a\x12.v1.ListHaResponse\"\x0e\x82\xd3\xe4\x93\x02\b\x12\x06/v1/ha\x12r\n" +
+	"\x06ListHa\x12\x11.v1.ListHaRequest\x1a\x12.v1.ListHaResponse\"\x0e\x82\xd3\xe4\x93\x02\b\x12\x06/v1/ha\x12j\n" +
+	"\vGetHaStatus\x12\x16.v1.GetHaStatusRequest\x1a\x17.v1.GetHaStatusResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/resources/{resource}/ha/status\x12t\n" +
+	"\x11ImportPacemakerHa\x12\x1c.v1.ImportPacemakerHaRequest\x1a\x1d.v1.ImportPacemakerHaResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/ha/import-pacemaker\x12G\n" +
+	"\bListVIPs\x12\x13.v1.ListVIPsRequest\x1a\x14.v1.ListVIPsResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/v1/vips\x12t\n" +
+	"\fDrSwitchover\x12\x17.v1.DrSwitchoverRequest\x1a\x18.v1.DrSwitchoverResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/resources/{resource}/dr/switchover\x12l\n" +
+	"\n" +
+	"DrFailback\x12\x15.v1.DrFailbackRequest\x1a\x16.v1.DrFailbackResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/resources/{resource}/dr/failback\x12m\n" +
+	"\x10AddPlacementRule\x12\x1b.v1.AddPlacementRuleRequest\x1a\x1c.v1.AddPlacementRuleResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/placement-rules\x12\x8d\x01\n" +
+	"\x13DeletePlacementRule\x12\x1e.v1.DeletePlacementRuleRequest\x1a\x1f.v1.DeletePlacementRuleResponse\"5\x82\xd3\xe4\x93\x02/*-/v1/placement-rules/{resource_a}/{resource_b}\x12p\n" +
+	"\x12ListPlacementRules\x12\x1d.v1.ListPlacementRulesRequest\x1a\x1e.v1.ListPlacementRulesResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/placement-rules\x12O\n" +
+	"\n" +
+	"ListEvents\x12\x15.v1.ListEventsRequest\x1a\x16.v1.ListEventsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/events\x12d\n" +
+	"\fSetEventHook\x12\x17.v1.SetEventHookRequest\x1a\x18.v1.SetEventHookResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/v1/event-hooks/{name}\x12j\n" +
+	"\x0fDeleteEventHook\x12\x1a.v1.DeleteEventHookRequest\x1a\x1b.v1.DeleteEventHookResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/v1/event-hooks/{name}\x12`\n" +
+	"\x0eListEventHooks\x12\x19.v1.ListEventHooksRequest\x1a\x1a.v1.ListEventHooksResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/event-hooks\x12l\n" +
+	"\rTestEventHook\x12\x18.v1.TestEventHookRequest\x1a\x19.v1.TestEventHookResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/event-hooks/{name}/test\x12a\n" +
+	"\x10GetClusterReport\x12\x1b.v1.GetClusterReportRequest\x1a\x1c.v1.GetClusterReportResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/report\x12d\n" +
+	"\rGetAlertRules\x12\x18.v1.GetAlertRulesRequest\x1a\x19.v1.GetAlertRulesResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/report/alert-rules\x12W\n" +
+	"\fListClusters\x12\x17.v1.ListClustersRequest\x1a\x18.v1.ListClustersResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/clusters\x12a\n" +
+	"\x0eGetClusterInfo\x12\x19.v1.GetClusterInfoRequest\x1a\x1a.v1.GetClusterInfoResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/cluster/info\x12T\n" +
+	"\vGetOverview\x12\x16.v1.GetOverviewRequest\x1a\x17.v1.GetOverviewResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/overview\x12L\n" +
+	"\x06Freeze\x12\x11.v1.FreezeRequest\x1a\x12.v1.FreezeResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/admin/freeze\x12T\n" +
+	"\bUnfreeze\x12\x13.v1.UnfreezeRequest\x1a\x14.v1.UnfreezeResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/admin/unfreeze\x12d\n" +
+	"\x0fGetFreezeStatus\x12\x1a.v1.GetFreezeStatusRequest\x1a\x1b.v1.GetFreezeStatusResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/admin/freeze\x12`\n" +
+	"\x0eCollectGarbage\x12\x19.v1.CollectGarbageRequest\x1a\x1a.v1.CollectGarbageResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/admin/gc\x12\\\n" +
+	"\n" +
+	"Rediscover\x12\x15.v1.RediscoverRequest\x1a\x16.v1.RediscoverResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/admin/rediscover\x12e\n" +
+	"\rSupportBundle\x12\x18.v1.SupportBundleRequest\x1a\x16.v1.SupportBundleChunk\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/admin/support-bundle0\x01\x12d\n" +
+	"\x0eGetDriftReport\x12\x19.v1.GetDriftReportRequest\x1a\x1a.v1.GetDriftReportResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/reconcile/drift\x12^\n" +
+	"\x06Repair\x12\x11.v1.RepairRequest\x1a\x12.v1.RepairResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/reconcile/repair/{kind}/{name}\x12R\n" +
+	"\tRebalance\x12\x14.v1.RebalanceRequest\x1a\x15.v1.RebalanceResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/rebalance\x12G\n" +
+	"\bListJobs\x12\x13.v1.ListJobsRequest\x1a\x14.v1.ListJobsResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/v1/jobs\x12F\n" +
+	"\x06GetJob\x12\x11.v1.GetJobRequest\x1a\x12.v1.GetJobResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/jobs/{id}\x12Y\n" +
+	"\tResumeJob\x12\x14.v1.ResumeJobRequest\x1a\x15.v1.ResumeJobResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/jobs/{id}/resume\x12a\n" +
+	"\vRollbackJob\x12\x16.v1.RollbackJobRequest\x1a\x17.v1.RollbackJobResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/jobs/{id}/rollback\x12k\n" +
+	"\x10WatchJobProgress\x12\x1b.v1.WatchJobProgressRequest\x1a\x0f.v1.JobProgress\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/jobs/progress/{progress_id}0\x01\x12o\n" +
+	"\x13GetDrbdGlobalConfig\x12\x1e.v1.GetDrbdGlobalConfigRequest\x1a\x1f.v1.GetDrbdGlobalConfigResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/drbd/global\x12r\n" +
+	"\x13SetDrbdGlobalConfig\x12\x1e.v1.SetDrbdGlobalConfigRequest\x1a\x1f.v1.SetDrbdGlobalConfigResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\x1a\x0f/v1/drbd/global\x12~\n" +
+	"\x15ListDrbdGlobalConfigs\x12 .v1.ListDrbdGlobalConfigsRequest\x1a!.v1.ListDrbdGlobalConfigsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/drbd/global/versions\x12\x8a\x01\n" +
+	"\x18RollbackDrbdGlobalConfig\x12#.v1.RollbackDrbdGlobalConfigRequest\x1a$.v1.RollbackDrbdGlobalConfigResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/drbd/global/rollback\x12[\n" +
+	"\fProbeNetwork\x12\x17.v1.ProbeNetworkRequest\x1a\x18.v1.ProbeNetworkResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/net/probe\x12\\\n" +
+	"\rListNetProbes\x12\x18.v1.ListNetProbesRequest\x1a\x19.v1.ListNetProbesResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/net/probes\x12r\n" +
 	"\x0eCreateSnapshot\x12\x19.v1.CreateSnapshotRequest\x1a\x1a.v1.CreateSnapshotResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/volumes/{volume}/snapshots\x12\x7f\n" +
 	"\x0eDeleteSnapshot\x12\x19.v1.DeleteSnapshotRequest\x1a\x1a.v1.DeleteSnapshotResponse\"6\x82\xd3\xe4\x93\x020*./v1/volumes/{volume}/snapshots/{snapshot_name}\x12\x8d\x01\n" +
 	"\x0fRestoreSnapshot\x12\x1a.v1.RestoreSnapshotRequest\x1a\x1b.v1.RestoreSnapshotResponse\"A\x82\xd3\xe4\x93\x02;:\x01*\"6/v1/volumes/{volume}/snapshots/{snapshot_name}/restore\x12l\n" +
-	"\rListSnapshots\x12\x18.v1.ListSnapshotsRequest\x1a\x19.v1.ListSnapshotsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/volumes/{volume}/snapshots\x12j\n" +
+	"\rListSnapshots\x12\x18.v1.ListSnapshotsRequest\x1a\x19.v1.ListSnapshotsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/volumes/{volume}/snapshots\x12{\n" +
+	"\x10GetSnapshotUsage\x12\x1b.v1.GetSnapshotUsageRequest\x1a\x1c.v1.GetSnapshotUsageResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/volumes/{volume}/snapshots/usage\x12\x85\x01\n" +
+	"\x0fSetSnapshotHook\x12\x1a.v1.SetSnapshotHookRequest\x1a\x1b.v1.SetSnapshotHookResponse\"9\x82\xd3\xe4\x93\x023:\x01*\x1a./v1/resources/{resource}/snapshot-hooks/{name}\x12\x8b\x01\n" +
+	"\x12DeleteSnapshotHook\x12\x1d.v1.DeleteSnapshotHookRequest\x1a\x1e.v1.DeleteSnapshotHookResponse\"6\x82\xd3\xe4\x93\x020*./v1/resources/{resource}/snapshot-hooks/{name}\x12\x81\x01\n" +
+	"\x11ListSnapshotHooks\x12\x1c.v1.ListSnapshotHooksRequest\x1a\x1d.v1.ListSnapshotHooksResponse\"/\x82\xd3\xe4\x93\x02)\x12'/v1/resources/{resource}/snapshot-hooks\x12p\n" +
+	"\x13CreateSnapshotGroup\x12\x1e.v1.CreateSnapshotGroupRequest\x1a\x19.v1.SnapshotGroupResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/snapshot-groups\x12t\n" +
+	"\x13DeleteSnapshotGroup\x12\x1e.v1.DeleteSnapshotGroupRequest\x1a\x19.v1.SnapshotGroupResponse\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/v1/snapshot-groups/{name}\x12\x81\x01\n" +
+	"\x14RestoreSnapshotGroup\x12\x1f.v1.RestoreSnapshotGroupRequest\x1a\x19.v1.SnapshotGroupResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/snapshot-groups/{name}/restore\x12p\n" +
+	"\x12ListSnapshotGroups\x12\x1d.v1.ListSnapshotGroupsRequest\x1a\x1e.v1.ListSnapshotGroupsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/snapshot-groups\x12\x8a\x01\n" +
+	"\x14SetReplicationPolicy\x12\x1f.v1.SetReplicationPolicyRequest\x1a .v1.SetReplicationPolicyResponse\"/\x82\xd3\xe4\x93\x02):\x01*\x1a$/v1/resources/{resource}/replication\x12\x90\x01\n" +
+	"\x17DeleteReplicationPolicy\x12\".v1.DeleteReplicationPolicyRequest\x1a#.v1.DeleteReplicationPolicyResponse\",\x82\xd3\xe4\x93\x02&*$/v1/resources/{resource}/replication\x12{\n" +
+	"\x17ListReplicationPolicies\x12\".v1.ListReplicationPoliciesRequest\x1a#.v1.ListReplicationPoliciesResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/replication\x12|\n" +
+	"\x0eRunReplication\x12\x19.v1.RunReplicationRequest\x1a\x1a.v1.RunReplicationResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/resources/{resource}/replication/run\x12j\n" +
 	"\x10CreateNFSGateway\x12\x1b.v1.CreateNFSGatewayRequest\x1a\x1c.v1.CreateNFSGatewayResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/gateways/nfs\x12r\n" +
 	"\x12CreateISCSIGateway\x12\x1d.v1.CreateISCSIGatewayRequest\x1a\x1e.v1.CreateISCSIGatewayResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/gateways/iscsi\x12n\n" +
 	"\x11CreateNVMeGateway\x12\x1c.v1.CreateNVMeGatewayRequest\x1a\x1d.v1.CreateNVMeGatewayResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/gateways/nvme\x12_\n" +
@@ -7594,23 +24816,32 @@ const file_api_proto_v1_sds_proto_rawDesc = "" +
 	"GetGateway\x12\x15.v1.GetGatewayRequest\x1a\x16.v1.GetGatewayResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/gateways/{id}\x12W\n" +
 	"\fListGateways\x12\x17.v1.ListGatewaysRequest\x1a\x18.v1.ListGatewaysResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/gateways\x12e\n" +
 	"\fStartGateway\x12\x17.v1.StartGatewayRequest\x1a\x18.v1.StartGatewayResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/gateways/{id}/start\x12a\n" +
-	"\vStopGateway\x12\x16.v1.StopGatewayRequest\x1a\x17.v1.StopGatewayResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/gateways/{id}/stop\x12^\n" +
+	"\vStopGateway\x12\x16.v1.StopGatewayRequest\x1a\x17.v1.StopGatewayResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/gateways/{id}/stop\x12p\n" +
+	"\x12ListGatewayClients\x12\x1d.v1.ListGatewayClientsRequest\x1a\x1e.v1.ListGatewayClientsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/gateway-clients\x12n\n" +
+	"\vNVMeConnect\x12\x16.v1.NVMeConnectRequest\x1a\x17.v1.NVMeConnectResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/gateways/{gateway}/nvme/connect\x12z\n" +
+	"\x0eNVMeDisconnect\x12\x19.v1.NVMeDisconnectRequest\x1a\x1a.v1.NVMeDisconnectResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/gateways/{gateway}/nvme/disconnect\x12\x8d\x01\n" +
+	"\x14GetISCSIClientConfig\x12\x1f.v1.GetISCSIClientConfigRequest\x1a .v1.GetISCSIClientConfigResponse\"2\x82\xd3\xe4\x93\x02,\x12*/v1/gateways/{gateway}/iscsi/client-config\x12\x91\x01\n" +
+	"\x16ValidateISCSIInitiator\x12!.v1.ValidateISCSIInitiatorRequest\x1a\".v1.ValidateISCSIInitiatorResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/gateways/{gateway}/iscsi/validate\x12b\n" +
+	"\bNFSMount\x12\x13.v1.NFSMountRequest\x1a\x14.v1.NFSMountResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/gateways/{gateway}/nfs/mount\x12^\n" +
 	"\rCreateZFSPool\x12\x18.v1.CreateZFSPoolRequest\x1a\x19.v1.CreateZFSPoolResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/zfs/pools\x12b\n" +
 	"\rDeleteZFSPool\x12\x18.v1.DeleteZFSPoolRequest\x1a\x19.v1.DeleteZFSPoolResponse\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v1/zfs/pools/{name}\x12A\n" +
 	"\fListZFSpools\x12\x17.v1.ListZFSPoolsRequest\x1a\x18.v1.ListZFSPoolsResponse\x12j\n" +
 	"\x10CreateZFSDataset\x12\x1b.v1.CreateZFSDatasetRequest\x1a\x1c.v1.CreateZFSDatasetResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/zfs/datasets\x12f\n" +
 	"\x0fCreateZFSVolume\x12\x1a.v1.CreateZFSVolumeRequest\x1a\x1b.v1.CreateZFSVolumeResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/zfs/volumes\x12t\n" +
 	"\x0fResizeZFSVolume\x12\x1a.v1.ResizeZFSVolumeRequest\x1a\x1b.v1.ResizeZFSVolumeResponse\"(\x82\xd3\xe4\x93\x02\":\x01*2\x1d/v1/zfs/volumes/{volume_path}\x12v\n" +
-	"\x10DeleteZFSDataset\x12\x1b.v1.DeleteZFSDatasetRequest\x1a\x1c.v1.DeleteZFSDatasetResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/v1/zfs/datasets/{dataset_path}\x12\x81\x01\n" +
-	"\x11CreateZFSSnapshot\x12\x1c.v1.CreateZFSSnapshotRequest\x1a\x1d.v1.CreateZFSSnapshotResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/zfs/datasets/{dataset}/snapshots\x12v\n" +
-	"\x11DeleteZFSSnapshot\x12\x1c.v1.DeleteZFSSnapshotRequest\x1a\x1d.v1.DeleteZFSSnapshotResponse\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/v1/zfs/snapshots/{snapshot}\x12{\n" +
-	"\x10ListZFSSnapshots\x12\x1b.v1.ListZFSSnapshotsRequest\x1a\x1c.v1.ListZFSSnapshotsResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/zfs/datasets/{dataset}/snapshots\x12\x9c\x01\n" +
-	"\x12RestoreZFSSnapshot\x12\x1d.v1.RestoreZFSSnapshotRequest\x1a\x1e.v1.RestoreZFSSnapshotResponse\"G\x82\xd3\xe4\x93\x02A:\x01*\"</v1/zfs/datasets/{dataset}/snapshots/{snapshot_name}/restore\x12|\n" +
-	"\x10CloneZFSSnapshot\x12\x1b.v1.CloneZFSSnapshotRequest\x1a\x1c.v1.CloneZFSSnapshotResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/zfs/snapshots/{snapshot}/clone\x12\x80\x01\n" +
-	"\x11CreateLvmSnapshot\x12\x1c.v1.CreateLvmSnapshotRequest\x1a\x1d.v1.CreateLvmSnapshotResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/lvm/volumes/{lv_name}/snapshots\x12\x8d\x01\n" +
-	"\x11DeleteLvmSnapshot\x12\x1c.v1.DeleteLvmSnapshotRequest\x1a\x1d.v1.DeleteLvmSnapshotResponse\";\x82\xd3\xe4\x93\x025*3/v1/lvm/volumes/{lv_name}/snapshots/{snapshot_name}\x12z\n" +
-	"\x10ListLvmSnapshots\x12\x1b.v1.ListLvmSnapshotsRequest\x1a\x1c.v1.ListLvmSnapshotsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/lvm/volumes/{lv_name}/snapshots\x12\x9b\x01\n" +
-	"\x12RestoreLvmSnapshot\x12\x1d.v1.RestoreLvmSnapshotRequest\x1a\x1e.v1.RestoreLvmSnapshotResponse\"F\x82\xd3\xe4\x93\x02@:\x01*\";/v1/lvm/volumes/{lv_name}/snapshots/{snapshot_name}/restoreB\aZ\x05./;v1b\x06proto3"
+	"\x10DeleteZFSDataset\x12\x1b.v1.DeleteZFSDatasetRequest\x1a\x1c.v1.DeleteZFSDatasetResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/v1/zfs/datasets/{dataset_path}\x12\x84\x01\n" +
+	"\x11CreateZFSSnapshot\x12\x1c.v1.CreateZFSSnapshotRequest\x1a\x1d.v1.CreateZFSSnapshotResponse\"2\x82\xd3\xe4\x93\x02):\x01*\"$/v1/zfs/datasets/{dataset}/snapshots\x88\x02\x01\x12y\n" +
+	"\x11DeleteZFSSnapshot\x12\x1c.v1.DeleteZFSSnapshotRequest\x1a\x1d.v1.DeleteZFSSnapshotResponse\"'\x82\xd3\xe4\x93\x02\x1e*\x1c/v1/zfs/snapshots/{snapshot}\x88\x02\x01\x12~\n" +
+	"\x10ListZFSSnapshots\x12\x1b.v1.ListZFSSnapshotsRequest\x1a\x1c.v1.ListZFSSnapshotsResponse\"/\x82\xd3\xe4\x93\x02&\x12$/v1/zfs/datasets/{dataset}/snapshots\x88\x02\x01\x12\x9f\x01\n" +
+	"\x12RestoreZFSSnapshot\x12\x1d.v1.RestoreZFSSnapshotRequest\x1a\x1e.v1.RestoreZFSSnapshotResponse\"J\x82\xd3\xe4\x93\x02A:\x01*\"</v1/zfs/datasets/{dataset}/snapshots/{snapshot_name}/restore\x88\x02\x01\x12|\n" +
+	"\x10CloneZFSSnapshot\x12\x1b.v1.CloneZFSSnapshotRequest\x1a\x1c.v1.CloneZFSSnapshotResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/zfs/snapshots/{snapshot}/clone\x12Z\n" +
+	"\x0eGetSetupStatus\x12\x19.v1.GetSetupStatusRequest\x1a\x1a.v1.GetSetupStatusResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/setup\x12s\n" +
+	"\x12TestNodeConnection\x12\x1d.v1.TestNodeConnectionRequest\x1a\x1e.v1.TestNodeConnectionResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/setup/test-node\x12c\n" +
+	"\rCompleteSetup\x12\x18.v1.CompleteSetupRequest\x1a\x19.v1.CompleteSetupResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/setup/complete\x12\x83\x01\n" +
+	"\x11CreateLvmSnapshot\x12\x1c.v1.CreateLvmSnapshotRequest\x1a\x1d.v1.CreateLvmSnapshotResponse\"1\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/lvm/volumes/{lv_name}/snapshots\x88\x02\x01\x12\x90\x01\n" +
+	"\x11DeleteLvmSnapshot\x12\x1c.v1.DeleteLvmSnapshotRequest\x1a\x1d.v1.DeleteLvmSnapshotResponse\">\x82\xd3\xe4\x93\x025*3/v1/lvm/volumes/{lv_name}/snapshots/{snapshot_name}\x88\x02\x01\x12}\n" +
+	"\x10ListLvmSnapshots\x12\x1b.v1.ListLvmSnapshotsRequest\x1a\x1c.v1.ListLvmSnapshotsResponse\".\x82\xd3\xe4\x93\x02%\x12#/v1/lvm/volumes/{lv_name}/snapshots\x88\x02\x01\x12\x9e\x01\n" +
+	"\x12RestoreLvmSnapshot\x12\x1d.v1.RestoreLvmSnapshotRequest\x1a\x1e.v1.RestoreLvmSnapshotResponse\"I\x82\xd3\xe4\x93\x02@:\x01*\";/v1/lvm/volumes/{lv_name}/snapshots/{snapshot_name}/restore\x88\x02\x01B\aZ\x05./;v1b\x06proto3"
 
 var (
 	file_api_proto_v1_sds_proto_rawDescOnce sync.Once
@@ -7624,284 +24855,823 @@ func file_api_proto_v1_sds_proto_rawDescGZIP() []byte {
 	return file_api_proto_v1_sds_proto_rawDescData
 }
 
-var file_api_proto_v1_sds_proto_msgTypes = make([]protoimpl.MessageInfo, 129)
+var file_api_proto_v1_sds_proto_msgTypes = make([]protoimpl.MessageInfo, 379)
 var file_api_proto_v1_sds_proto_goTypes = []any{
-	(*CreatePoolRequest)(nil),          // 0: v1.CreatePoolRequest
-	(*CreatePoolResponse)(nil),         // 1: v1.CreatePoolResponse
-	(*DeletePoolRequest)(nil),          // 2: v1.DeletePoolRequest
-	(*DeletePoolResponse)(nil),         // 3: v1.DeletePoolResponse
-	(*GetPoolRequest)(nil),             // 4: v1.GetPoolRequest
-	(*GetPoolResponse)(nil),            // 5: v1.GetPoolResponse
-	(*ListPoolsRequest)(nil),           // 6: v1.ListPoolsRequest
-	(*ListPoolsResponse)(nil),          // 7: v1.ListPoolsResponse
-	(*AddDiskToPoolRequest)(nil),       // 8: v1.AddDiskToPoolRequest
-	(*AddDiskToPoolResponse)(nil),      // 9: v1.AddDiskToPoolResponse
-	(*PoolInfo)(nil),                   // 10: v1.PoolInfo
-	(*CreateZFSPoolRequest)(nil),       // 11: v1.CreateZFSPoolRequest
-	(*CreateZFSPoolResponse)(nil),      // 12: v1.CreateZFSPoolResponse
-	(*DeleteZFSPoolRequest)(nil),       // 13: v1.DeleteZFSPoolRequest
-	(*DeleteZFSPoolResponse)(nil),      // 14: v1.DeleteZFSPoolResponse
-	(*ListZFSPoolsRequest)(nil),        // 15: v1.ListZFSPoolsRequest
-	(*ListZFSPoolsResponse)(nil),       // 16: v1.ListZFSPoolsResponse
-	(*CreateZFSDatasetRequest)(nil),    // 17: v1.CreateZFSDatasetRequest
-	(*CreateZFSDatasetResponse)(nil),   // 18: v1.CreateZFSDatasetResponse
-	(*CreateZFSVolumeRequest)(nil),     // 19: v1.CreateZFSVolumeRequest
-	(*CreateZFSVolumeResponse)(nil),    // 20: v1.CreateZFSVolumeResponse
-	(*ResizeZFSVolumeRequest)(nil),     // 21: v1.ResizeZFSVolumeRequest
-	(*ResizeZFSVolumeResponse)(nil),    // 22: v1.ResizeZFSVolumeResponse
-	(*DeleteZFSDatasetRequest)(nil),    // 23: v1.DeleteZFSDatasetRequest
-	(*DeleteZFSDatasetResponse)(nil),   // 24: v1.DeleteZFSDatasetResponse
-	(*CreateZFSSnapshotRequest)(nil),   // 25: v1.CreateZFSSnapshotRequest
-	(*CreateZFSSnapshotResponse)(nil),  // 26: v1.CreateZFSSnapshotResponse
-	(*DeleteZFSSnapshotRequest)(nil),   // 27: v1.DeleteZFSSnapshotRequest
-	(*DeleteZFSSnapshotResponse)(nil),  // 28: v1.DeleteZFSSnapshotResponse
-	(*ListZFSSnapshotsRequest)(nil),    // 29: v1.ListZFSSnapshotsRequest
-	(*ListZFSSnapshotsResponse)(nil),   // 30: v1.ListZFSSnapshotsResponse
-	(*RestoreZFSSnapshotRequest)(nil),  // 31: v1.RestoreZFSSnapshotRequest
-	(*RestoreZFSSnapshotResponse)(nil), // 32: v1.RestoreZFSSnapshotResponse
-	(*CloneZFSSnapshotRequest)(nil),    // 33: v1.CloneZFSSnapshotRequest
-	(*CloneZFSSnapshotResponse)(nil),   // 34: v1.CloneZFSSnapshotResponse
-	(*CreateLvmSnapshotRequest)(nil),   // 35: v1.CreateLvmSnapshotRequest
-	(*CreateLvmSnapshotResponse)(nil),  // 36: v1.CreateLvmSnapshotResponse
-	(*DeleteLvmSnapshotRequest)(nil),   // 37: v1.DeleteLvmSnapshotRequest
-	(*DeleteLvmSnapshotResponse)(nil),  // 38: v1.DeleteLvmSnapshotResponse
-	(*ListLvmSnapshotsRequest)(nil),    // 39: v1.ListLvmSnapshotsRequest
-	(*ListLvmSnapshotsResponse)(nil),   // 40: v1.ListLvmSnapshotsResponse
-	(*RestoreLvmSnapshotRequest)(nil),  // 41: v1.RestoreLvmSnapshotRequest
-	(*RestoreLvmSnapshotResponse)(nil), // 42: v1.RestoreLvmSnapshotResponse
-	(*RegisterNodeRequest)(nil),        // 43: v1.RegisterNodeRequest
-	(*RegisterNodeResponse)(nil),       // 44: v1.RegisterNodeResponse
-	(*UnregisterNodeRequest)(nil),      // 45: v1.UnregisterNodeRequest
-	(*UnregisterNodeResponse)(nil),     // 46: v1.UnregisterNodeResponse
-	(*GetNodeRequest)(nil),             // 47: v1.GetNodeRequest
-	(*GetNodeResponse)(nil),            // 48: v1.GetNodeResponse
-	(*ListNodesRequest)(nil),           // 49: v1.ListNodesRequest
-	(*ListNodesResponse)(nil),          // 50: v1.ListNodesResponse
-	(*NodeInfo)(nil),                   // 51: v1.NodeInfo
-	(*HealthCheckRequest)(nil),         // 52: v1.HealthCheckRequest
-	(*HealthCheckResponse)(nil),        // 53: v1.HealthCheckResponse
-	(*NodeHealthInfo)(nil),             // 54: v1.NodeHealthInfo
-	(*CreateResourceRequest)(nil),      // 55: v1.CreateResourceRequest
-	(*CreateResourceResponse)(nil),     // 56: v1.CreateResourceResponse
-	(*DeleteResourceRequest)(nil),      // 57: v1.DeleteResourceRequest
-	(*DeleteResourceResponse)(nil),     // 58: v1.DeleteResourceResponse
-	(*GetResourceRequest)(nil),         // 59: v1.GetResourceRequest
-	(*GetResourceResponse)(nil),        // 60: v1.GetResourceResponse
-	(*ListResourcesRequest)(nil),       // 61: v1.ListResourcesRequest
-	(*ListResourcesResponse)(nil),      // 62: v1.ListResourcesResponse
-	(*AddVolumeRequest)(nil),           // 63: v1.AddVolumeRequest
-	(*AddVolumeResponse)(nil),          // 64: v1.AddVolumeResponse
-	(*RemoveVolumeRequest)(nil),        // 65: v1.RemoveVolumeRequest
-	(*RemoveVolumeResponse)(nil),       // 66: v1.RemoveVolumeResponse
-	(*ResizeVolumeRequest)(nil),        // 67: v1.ResizeVolumeRequest
-	(*ResizeVolumeResponse)(nil),       // 68: v1.ResizeVolumeResponse
-	(*ResourceStatusRequest)(nil),      // 69: v1.ResourceStatusRequest
-	(*ResourceStatusResponse)(nil),     // 70: v1.ResourceStatusResponse
-	(*SetPrimaryRequest)(nil),          // 71: v1.SetPrimaryRequest
-	(*SetPrimaryResponse)(nil),         // 72: v1.SetPrimaryResponse
-	(*SetSecondaryRequest)(nil),        // 73: v1.SetSecondaryRequest
-	(*SetSecondaryResponse)(nil),       // 74: v1.SetSecondaryResponse
-	(*CreateFilesystemRequest)(nil),    // 75: v1.CreateFilesystemRequest
-	(*CreateFilesystemResponse)(nil),   // 76: v1.CreateFilesystemResponse
-	(*MountResourceRequest)(nil),       // 77: v1.MountResourceRequest
-	(*MountResourceResponse)(nil),      // 78: v1.MountResourceResponse
-	(*UnmountResourceRequest)(nil),     // 79: v1.UnmountResourceRequest
-	(*UnmountResourceResponse)(nil),    // 80: v1.UnmountResourceResponse
-	(*MakeHaRequest)(nil),              // 81: v1.MakeHaRequest
-	(*MakeHaResponse)(nil),             // 82: v1.MakeHaResponse
-	(*EvictHaRequest)(nil),             // 83: v1.EvictHaRequest
-	(*EvictHaResponse)(nil),            // 84: v1.EvictHaResponse
-	(*ResourceInfo)(nil),               // 85: v1.ResourceInfo
-	(*ResourceStatus)(nil),             // 86: v1.ResourceStatus
-	(*NodeResourceState)(nil),          // 87: v1.NodeResourceState
-	(*VolumeInfo)(nil),                 // 88: v1.VolumeInfo
-	(*CreateSnapshotRequest)(nil),      // 89: v1.CreateSnapshotRequest
-	(*CreateSnapshotResponse)(nil),     // 90: v1.CreateSnapshotResponse
-	(*DeleteSnapshotRequest)(nil),      // 91: v1.DeleteSnapshotRequest
-	(*DeleteSnapshotResponse)(nil),     // 92: v1.DeleteSnapshotResponse
-	(*RestoreSnapshotRequest)(nil),     // 93: v1.RestoreSnapshotRequest
-	(*RestoreSnapshotResponse)(nil),    // 94: v1.RestoreSnapshotResponse
-	(*ListSnapshotsRequest)(nil),       // 95: v1.ListSnapshotsRequest
-	(*ListSnapshotsResponse)(nil),      // 96: v1.ListSnapshotsResponse
-	(*SnapshotInfo)(nil),               // 97: v1.SnapshotInfo
-	(*CreateNFSGatewayRequest)(nil),    // 98: v1.CreateNFSGatewayRequest
-	(*CreateNFSGatewayResponse)(nil),   // 99: v1.CreateNFSGatewayResponse
-	(*CreateISCSIGatewayRequest)(nil),  // 100: v1.CreateISCSIGatewayRequest
-	(*CreateISCSIGatewayResponse)(nil), // 101: v1.CreateISCSIGatewayResponse
-	(*CreateNVMeGatewayRequest)(nil),   // 102: v1.CreateNVMeGatewayRequest
-	(*CreateNVMeGatewayResponse)(nil),  // 103: v1.CreateNVMeGatewayResponse
-	(*DeleteGatewayRequest)(nil),       // 104: v1.DeleteGatewayRequest
-	(*DeleteGatewayResponse)(nil),      // 105: v1.DeleteGatewayResponse
-	(*GetGatewayRequest)(nil),          // 106: v1.GetGatewayRequest
-	(*GetGatewayResponse)(nil),         // 107: v1.GetGatewayResponse
-	(*ListGatewaysRequest)(nil),        // 108: v1.ListGatewaysRequest
-	(*ListGatewaysResponse)(nil),       // 109: v1.ListGatewaysResponse
-	(*StartGatewayRequest)(nil),        // 110: v1.StartGatewayRequest
-	(*StartGatewayResponse)(nil),       // 111: v1.StartGatewayResponse
-	(*StopGatewayRequest)(nil),         // 112: v1.StopGatewayRequest
-	(*StopGatewayResponse)(nil),        // 113: v1.StopGatewayResponse
-	(*GatewayInfo)(nil),                // 114: v1.GatewayInfo
-	(*DeleteHaRequest)(nil),            // 115: v1.DeleteHaRequest
-	(*DeleteHaResponse)(nil),           // 116: v1.DeleteHaResponse
-	(*GetHaRequest)(nil),               // 117: v1.GetHaRequest
-	(*GetHaResponse)(nil),              // 118: v1.GetHaResponse
-	(*ListHaRequest)(nil),              // 119: v1.ListHaRequest
-	(*ListHaResponse)(nil),             // 120: v1.ListHaResponse
-	(*HaConfigInfo)(nil),               // 121: v1.HaConfigInfo
-	nil,                                // 122: v1.CreateResourceRequest.DrbdOptionsEntry
-	nil,                                // 123: v1.ResourceInfo.NodeStatesEntry
-	nil,                                // 124: v1.ResourceStatus.NodeStatesEntry
-	nil,                                // 125: v1.CreateNFSGatewayRequest.OptionsEntry
-	nil,                                // 126: v1.CreateISCSIGatewayRequest.OptionsEntry
-	nil,                                // 127: v1.CreateNVMeGatewayRequest.OptionsEntry
-	nil,                                // 128: v1.GatewayInfo.OptionsEntry
+	(*CreatePoolRequest)(nil),                // 0: v1.CreatePoolRequest
+	(*CreatePoolResponse)(nil),               // 1: v1.CreatePoolResponse
+	(*DeletePoolRequest)(nil),                // 2: v1.DeletePoolRequest
+	(*DeletePoolResponse)(nil),               // 3: v1.DeletePoolResponse
+	(*GetPoolRequest)(nil),                   // 4: v1.GetPoolRequest
+	(*GetPoolResponse)(nil),                  // 5: v1.GetPoolResponse
+	(*ListPoolsRequest)(nil),                 // 6: v1.ListPoolsRequest
+	(*ListPoolsResponse)(nil),                // 7: v1.ListPoolsResponse
+	(*AddDiskToPoolRequest)(nil),             // 8: v1.AddDiskToPoolRequest
+	(*AddDiskToPoolResponse)(nil),            // 9: v1.AddDiskToPoolResponse
+	(*GetPoolHistoryRequest)(nil),            // 10: v1.GetPoolHistoryRequest
+	(*PoolUsageSample)(nil),                  // 11: v1.PoolUsageSample
+	(*GetPoolHistoryResponse)(nil),           // 12: v1.GetPoolHistoryResponse
+	(*PoolInfo)(nil),                         // 13: v1.PoolInfo
+	(*CreateZFSPoolRequest)(nil),             // 14: v1.CreateZFSPoolRequest
+	(*CreateZFSPoolResponse)(nil),            // 15: v1.CreateZFSPoolResponse
+	(*DeleteZFSPoolRequest)(nil),             // 16: v1.DeleteZFSPoolRequest
+	(*DeleteZFSPoolResponse)(nil),            // 17: v1.DeleteZFSPoolResponse
+	(*ListZFSPoolsRequest)(nil),              // 18: v1.ListZFSPoolsRequest
+	(*ListZFSPoolsResponse)(nil),             // 19: v1.ListZFSPoolsResponse
+	(*CreateZFSDatasetRequest)(nil),          // 20: v1.CreateZFSDatasetRequest
+	(*CreateZFSDatasetResponse)(nil),         // 21: v1.CreateZFSDatasetResponse
+	(*CreateZFSVolumeRequest)(nil),           // 22: v1.CreateZFSVolumeRequest
+	(*CreateZFSVolumeResponse)(nil),          // 23: v1.CreateZFSVolumeResponse
+	(*ResizeZFSVolumeRequest)(nil),           // 24: v1.ResizeZFSVolumeRequest
+	(*ResizeZFSVolumeResponse)(nil),          // 25: v1.ResizeZFSVolumeResponse
+	(*DeleteZFSDatasetRequest)(nil),          // 26: v1.DeleteZFSDatasetRequest
+	(*DeleteZFSDatasetResponse)(nil),         // 27: v1.DeleteZFSDatasetResponse
+	(*CreateZFSSnapshotRequest)(nil),         // 28: v1.CreateZFSSnapshotRequest
+	(*CreateZFSSnapshotResponse)(nil),        // 29: v1.CreateZFSSnapshotResponse
+	(*DeleteZFSSnapshotRequest)(nil),         // 30: v1.DeleteZFSSnapshotRequest
+	(*DeleteZFSSnapshotResponse)(nil),        // 31: v1.DeleteZFSSnapshotResponse
+	(*ListZFSSnapshotsRequest)(nil),          // 32: v1.ListZFSSnapshotsRequest
+	(*ListZFSSnapshotsResponse)(nil),         // 33: v1.ListZFSSnapshotsResponse
+	(*RestoreZFSSnapshotRequest)(nil),        // 34: v1.RestoreZFSSnapshotRequest
+	(*RestoreZFSSnapshotResponse)(nil),       // 35: v1.RestoreZFSSnapshotResponse
+	(*CloneZFSSnapshotRequest)(nil),          // 36: v1.CloneZFSSnapshotRequest
+	(*CloneZFSSnapshotResponse)(nil),         // 37: v1.CloneZFSSnapshotResponse
+	(*CreateLvmSnapshotRequest)(nil),         // 38: v1.CreateLvmSnapshotRequest
+	(*CreateLvmSnapshotResponse)(nil),        // 39: v1.CreateLvmSnapshotResponse
+	(*DeleteLvmSnapshotRequest)(nil),         // 40: v1.DeleteLvmSnapshotRequest
+	(*DeleteLvmSnapshotResponse)(nil),        // 41: v1.DeleteLvmSnapshotResponse
+	(*ListLvmSnapshotsRequest)(nil),          // 42: v1.ListLvmSnapshotsRequest
+	(*ListLvmSnapshotsResponse)(nil),         // 43: v1.ListLvmSnapshotsResponse
+	(*RestoreLvmSnapshotRequest)(nil),        // 44: v1.RestoreLvmSnapshotRequest
+	(*RestoreLvmSnapshotResponse)(nil),       // 45: v1.RestoreLvmSnapshotResponse
+	(*RegisterNodeRequest)(nil),              // 46: v1.RegisterNodeRequest
+	(*RegisterNodeResponse)(nil),             // 47: v1.RegisterNodeResponse
+	(*UnregisterNodeRequest)(nil),            // 48: v1.UnregisterNodeRequest
+	(*UnregisterNodeResponse)(nil),           // 49: v1.UnregisterNodeResponse
+	(*GetNodeRequest)(nil),                   // 50: v1.GetNodeRequest
+	(*GetNodeResponse)(nil),                  // 51: v1.GetNodeResponse
+	(*ListNodesRequest)(nil),                 // 52: v1.ListNodesRequest
+	(*ListNodesResponse)(nil),                // 53: v1.ListNodesResponse
+	(*SetNodeAddressRequest)(nil),            // 54: v1.SetNodeAddressRequest
+	(*SetNodeAddressResponse)(nil),           // 55: v1.SetNodeAddressResponse
+	(*TrustNodeRequest)(nil),                 // 56: v1.TrustNodeRequest
+	(*TrustNodeResponse)(nil),                // 57: v1.TrustNodeResponse
+	(*HardenNodeRequest)(nil),                // 58: v1.HardenNodeRequest
+	(*HardenNodeResponse)(nil),               // 59: v1.HardenNodeResponse
+	(*MaintenanceWindow)(nil),                // 60: v1.MaintenanceWindow
+	(*SetNodeMaintenanceRequest)(nil),        // 61: v1.SetNodeMaintenanceRequest
+	(*SetNodeMaintenanceResponse)(nil),       // 62: v1.SetNodeMaintenanceResponse
+	(*ClearNodeMaintenanceRequest)(nil),      // 63: v1.ClearNodeMaintenanceRequest
+	(*ClearNodeMaintenanceResponse)(nil),     // 64: v1.ClearNodeMaintenanceResponse
+	(*ListMaintenanceWindowsRequest)(nil),    // 65: v1.ListMaintenanceWindowsRequest
+	(*ListMaintenanceWindowsResponse)(nil),   // 66: v1.ListMaintenanceWindowsResponse
+	(*NodeExecRequest)(nil),                  // 67: v1.NodeExecRequest
+	(*NodeExecResult)(nil),                   // 68: v1.NodeExecResult
+	(*NodeExecResponse)(nil),                 // 69: v1.NodeExecResponse
+	(*StreamNodeLogsRequest)(nil),            // 70: v1.StreamNodeLogsRequest
+	(*NodeLogLine)(nil),                      // 71: v1.NodeLogLine
+	(*PushFileRequest)(nil),                  // 72: v1.PushFileRequest
+	(*PushFileResult)(nil),                   // 73: v1.PushFileResult
+	(*PushFileResponse)(nil),                 // 74: v1.PushFileResponse
+	(*NodeInfo)(nil),                         // 75: v1.NodeInfo
+	(*NodeCapacity)(nil),                     // 76: v1.NodeCapacity
+	(*NodePoolCapacity)(nil),                 // 77: v1.NodePoolCapacity
+	(*HealthCheckRequest)(nil),               // 78: v1.HealthCheckRequest
+	(*HealthCheckResponse)(nil),              // 79: v1.HealthCheckResponse
+	(*NodeHealthInfo)(nil),                   // 80: v1.NodeHealthInfo
+	(*NodeTime)(nil),                         // 81: v1.NodeTime
+	(*CheckTimeRequest)(nil),                 // 82: v1.CheckTimeRequest
+	(*CheckTimeResponse)(nil),                // 83: v1.CheckTimeResponse
+	(*CreateResourceRequest)(nil),            // 84: v1.CreateResourceRequest
+	(*CreateResourceResponse)(nil),           // 85: v1.CreateResourceResponse
+	(*DeleteResourceRequest)(nil),            // 86: v1.DeleteResourceRequest
+	(*DeleteResourceResponse)(nil),           // 87: v1.DeleteResourceResponse
+	(*SetMaxPeersRequest)(nil),               // 88: v1.SetMaxPeersRequest
+	(*SetMaxPeersResponse)(nil),              // 89: v1.SetMaxPeersResponse
+	(*MigratePoolRequest)(nil),               // 90: v1.MigratePoolRequest
+	(*MigratePoolResponse)(nil),              // 91: v1.MigratePoolResponse
+	(*ConvertStorageRequest)(nil),            // 92: v1.ConvertStorageRequest
+	(*ConvertStorageResponse)(nil),           // 93: v1.ConvertStorageResponse
+	(*ReplaceDiskRequest)(nil),               // 94: v1.ReplaceDiskRequest
+	(*ReplacedDisk)(nil),                     // 95: v1.ReplacedDisk
+	(*ReplaceDiskResponse)(nil),              // 96: v1.ReplaceDiskResponse
+	(*StopResourceRequest)(nil),              // 97: v1.StopResourceRequest
+	(*StopResourceResponse)(nil),             // 98: v1.StopResourceResponse
+	(*PauseSyncRequest)(nil),                 // 99: v1.PauseSyncRequest
+	(*PauseSyncResponse)(nil),                // 100: v1.PauseSyncResponse
+	(*ResumeSyncRequest)(nil),                // 101: v1.ResumeSyncRequest
+	(*ResumeSyncResponse)(nil),               // 102: v1.ResumeSyncResponse
+	(*SetSyncRateRequest)(nil),               // 103: v1.SetSyncRateRequest
+	(*SetSyncRateResponse)(nil),              // 104: v1.SetSyncRateResponse
+	(*StartResourceRequest)(nil),             // 105: v1.StartResourceRequest
+	(*StartResourceResponse)(nil),            // 106: v1.StartResourceResponse
+	(*ExecFenceTestRequest)(nil),             // 107: v1.ExecFenceTestRequest
+	(*FenceTestCheck)(nil),                   // 108: v1.FenceTestCheck
+	(*ExecFenceTestResponse)(nil),            // 109: v1.ExecFenceTestResponse
+	(*ActivateResourceRequest)(nil),          // 110: v1.ActivateResourceRequest
+	(*ActivationStep)(nil),                   // 111: v1.ActivationStep
+	(*ActivateResourceResponse)(nil),         // 112: v1.ActivateResourceResponse
+	(*DeactivateResourceRequest)(nil),        // 113: v1.DeactivateResourceRequest
+	(*DeactivateResourceResponse)(nil),       // 114: v1.DeactivateResourceResponse
+	(*FencePeerRequest)(nil),                 // 115: v1.FencePeerRequest
+	(*FencePeerResponse)(nil),                // 116: v1.FencePeerResponse
+	(*UnfencePeerRequest)(nil),               // 117: v1.UnfencePeerRequest
+	(*UnfencePeerResponse)(nil),              // 118: v1.UnfencePeerResponse
+	(*InstallFenceHandlersRequest)(nil),      // 119: v1.InstallFenceHandlersRequest
+	(*InstallFenceHandlersResponse)(nil),     // 120: v1.InstallFenceHandlersResponse
+	(*ReportDrbdEventRequest)(nil),           // 121: v1.ReportDrbdEventRequest
+	(*ReportDrbdEventResponse)(nil),          // 122: v1.ReportDrbdEventResponse
+	(*FenceConstraint)(nil),                  // 123: v1.FenceConstraint
+	(*ListFenceConstraintsRequest)(nil),      // 124: v1.ListFenceConstraintsRequest
+	(*ListFenceConstraintsResponse)(nil),     // 125: v1.ListFenceConstraintsResponse
+	(*GetResourceRequest)(nil),               // 126: v1.GetResourceRequest
+	(*GetResourceResponse)(nil),              // 127: v1.GetResourceResponse
+	(*ListResourcesRequest)(nil),             // 128: v1.ListResourcesRequest
+	(*ListResourcesResponse)(nil),            // 129: v1.ListResourcesResponse
+	(*AddVolumeRequest)(nil),                 // 130: v1.AddVolumeRequest
+	(*AddVolumeResponse)(nil),                // 131: v1.AddVolumeResponse
+	(*RemoveVolumeRequest)(nil),              // 132: v1.RemoveVolumeRequest
+	(*RemoveVolumeResponse)(nil),             // 133: v1.RemoveVolumeResponse
+	(*ResizeVolumeRequest)(nil),              // 134: v1.ResizeVolumeRequest
+	(*ResizeVolumeResponse)(nil),             // 135: v1.ResizeVolumeResponse
+	(*GetVolumeRequest)(nil),                 // 136: v1.GetVolumeRequest
+	(*GetVolumeResponse)(nil),                // 137: v1.GetVolumeResponse
+	(*ListVolumesRequest)(nil),               // 138: v1.ListVolumesRequest
+	(*ListVolumesResponse)(nil),              // 139: v1.ListVolumesResponse
+	(*ResourceStatusRequest)(nil),            // 140: v1.ResourceStatusRequest
+	(*ResourceStatusResponse)(nil),           // 141: v1.ResourceStatusResponse
+	(*ExportResourceRequest)(nil),            // 142: v1.ExportResourceRequest
+	(*ExportResourceResponse)(nil),           // 143: v1.ExportResourceResponse
+	(*DiffResourceRequest)(nil),              // 144: v1.DiffResourceRequest
+	(*ConfigDiff)(nil),                       // 145: v1.ConfigDiff
+	(*DiffResourceResponse)(nil),             // 146: v1.DiffResourceResponse
+	(*GetNodeResourceConfigRequest)(nil),     // 147: v1.GetNodeResourceConfigRequest
+	(*DrbdConfigSection)(nil),                // 148: v1.DrbdConfigSection
+	(*GetNodeResourceConfigResponse)(nil),    // 149: v1.GetNodeResourceConfigResponse
+	(*RenderConfigRequest)(nil),              // 150: v1.RenderConfigRequest
+	(*RenderConfigResponse)(nil),             // 151: v1.RenderConfigResponse
+	(*SetPrimaryRequest)(nil),                // 152: v1.SetPrimaryRequest
+	(*SetPrimaryResponse)(nil),               // 153: v1.SetPrimaryResponse
+	(*SetSecondaryRequest)(nil),              // 154: v1.SetSecondaryRequest
+	(*SetSecondaryResponse)(nil),             // 155: v1.SetSecondaryResponse
+	(*CreateFilesystemRequest)(nil),          // 156: v1.CreateFilesystemRequest
+	(*CreateFilesystemResponse)(nil),         // 157: v1.CreateFilesystemResponse
+	(*MountResourceRequest)(nil),             // 158: v1.MountResourceRequest
+	(*MountResourceResponse)(nil),            // 159: v1.MountResourceResponse
+	(*UnmountResourceRequest)(nil),           // 160: v1.UnmountResourceRequest
+	(*UnmountResourceResponse)(nil),          // 161: v1.UnmountResourceResponse
+	(*MakeHaRequest)(nil),                    // 162: v1.MakeHaRequest
+	(*HaPolicy)(nil),                         // 163: v1.HaPolicy
+	(*MakeHaResponse)(nil),                   // 164: v1.MakeHaResponse
+	(*PlannedFile)(nil),                      // 165: v1.PlannedFile
+	(*UpdateHaRequest)(nil),                  // 166: v1.UpdateHaRequest
+	(*UpdateHaResponse)(nil),                 // 167: v1.UpdateHaResponse
+	(*FailoverHaRequest)(nil),                // 168: v1.FailoverHaRequest
+	(*FailoverHaResponse)(nil),               // 169: v1.FailoverHaResponse
+	(*EvictHaRequest)(nil),                   // 170: v1.EvictHaRequest
+	(*EvictHaResponse)(nil),                  // 171: v1.EvictHaResponse
+	(*ResourceInfo)(nil),                     // 172: v1.ResourceInfo
+	(*ResourceStatus)(nil),                   // 173: v1.ResourceStatus
+	(*VolumeIOStats)(nil),                    // 174: v1.VolumeIOStats
+	(*NodeResourceState)(nil),                // 175: v1.NodeResourceState
+	(*VolumeInfo)(nil),                       // 176: v1.VolumeInfo
+	(*VolumeBacking)(nil),                    // 177: v1.VolumeBacking
+	(*CreateSnapshotRequest)(nil),            // 178: v1.CreateSnapshotRequest
+	(*CreateSnapshotResponse)(nil),           // 179: v1.CreateSnapshotResponse
+	(*DeleteSnapshotRequest)(nil),            // 180: v1.DeleteSnapshotRequest
+	(*DeleteSnapshotResponse)(nil),           // 181: v1.DeleteSnapshotResponse
+	(*RestoreSnapshotRequest)(nil),           // 182: v1.RestoreSnapshotRequest
+	(*RestoreSnapshotResponse)(nil),          // 183: v1.RestoreSnapshotResponse
+	(*ListSnapshotsRequest)(nil),             // 184: v1.ListSnapshotsRequest
+	(*ListSnapshotsResponse)(nil),            // 185: v1.ListSnapshotsResponse
+	(*SnapshotInfo)(nil),                     // 186: v1.SnapshotInfo
+	(*CreateSnapshotGroupRequest)(nil),       // 187: v1.CreateSnapshotGroupRequest
+	(*DeleteSnapshotGroupRequest)(nil),       // 188: v1.DeleteSnapshotGroupRequest
+	(*RestoreSnapshotGroupRequest)(nil),      // 189: v1.RestoreSnapshotGroupRequest
+	(*SnapshotGroupResult)(nil),              // 190: v1.SnapshotGroupResult
+	(*SnapshotGroupResponse)(nil),            // 191: v1.SnapshotGroupResponse
+	(*SnapshotGroup)(nil),                    // 192: v1.SnapshotGroup
+	(*ListSnapshotGroupsRequest)(nil),        // 193: v1.ListSnapshotGroupsRequest
+	(*ListSnapshotGroupsResponse)(nil),       // 194: v1.ListSnapshotGroupsResponse
+	(*GetSnapshotUsageRequest)(nil),          // 195: v1.GetSnapshotUsageRequest
+	(*GetSnapshotUsageResponse)(nil),         // 196: v1.GetSnapshotUsageResponse
+	(*SnapshotHook)(nil),                     // 197: v1.SnapshotHook
+	(*SetSnapshotHookRequest)(nil),           // 198: v1.SetSnapshotHookRequest
+	(*SetSnapshotHookResponse)(nil),          // 199: v1.SetSnapshotHookResponse
+	(*DeleteSnapshotHookRequest)(nil),        // 200: v1.DeleteSnapshotHookRequest
+	(*DeleteSnapshotHookResponse)(nil),       // 201: v1.DeleteSnapshotHookResponse
+	(*ListSnapshotHooksRequest)(nil),         // 202: v1.ListSnapshotHooksRequest
+	(*ListSnapshotHooksResponse)(nil),        // 203: v1.ListSnapshotHooksResponse
+	(*ReplicationPolicy)(nil),                // 204: v1.ReplicationPolicy
+	(*SetReplicationPolicyRequest)(nil),      // 205: v1.SetReplicationPolicyRequest
+	(*SetReplicationPolicyResponse)(nil),     // 206: v1.SetReplicationPolicyResponse
+	(*DeleteReplicationPolicyRequest)(nil),   // 207: v1.DeleteReplicationPolicyRequest
+	(*DeleteReplicationPolicyResponse)(nil),  // 208: v1.DeleteReplicationPolicyResponse
+	(*ListReplicationPoliciesRequest)(nil),   // 209: v1.ListReplicationPoliciesRequest
+	(*ListReplicationPoliciesResponse)(nil),  // 210: v1.ListReplicationPoliciesResponse
+	(*RunReplicationRequest)(nil),            // 211: v1.RunReplicationRequest
+	(*RunReplicationResponse)(nil),           // 212: v1.RunReplicationResponse
+	(*SnapshotUsageInfo)(nil),                // 213: v1.SnapshotUsageInfo
+	(*CreateNFSGatewayRequest)(nil),          // 214: v1.CreateNFSGatewayRequest
+	(*CreateNFSGatewayResponse)(nil),         // 215: v1.CreateNFSGatewayResponse
+	(*CreateISCSIGatewayRequest)(nil),        // 216: v1.CreateISCSIGatewayRequest
+	(*CreateISCSIGatewayResponse)(nil),       // 217: v1.CreateISCSIGatewayResponse
+	(*CreateNVMeGatewayRequest)(nil),         // 218: v1.CreateNVMeGatewayRequest
+	(*CreateNVMeGatewayResponse)(nil),        // 219: v1.CreateNVMeGatewayResponse
+	(*DeleteGatewayRequest)(nil),             // 220: v1.DeleteGatewayRequest
+	(*DeleteGatewayResponse)(nil),            // 221: v1.DeleteGatewayResponse
+	(*GetGatewayRequest)(nil),                // 222: v1.GetGatewayRequest
+	(*GetGatewayResponse)(nil),               // 223: v1.GetGatewayResponse
+	(*ListGatewaysRequest)(nil),              // 224: v1.ListGatewaysRequest
+	(*ListGatewaysResponse)(nil),             // 225: v1.ListGatewaysResponse
+	(*StartGatewayRequest)(nil),              // 226: v1.StartGatewayRequest
+	(*StartGatewayResponse)(nil),             // 227: v1.StartGatewayResponse
+	(*StopGatewayRequest)(nil),               // 228: v1.StopGatewayRequest
+	(*StopGatewayResponse)(nil),              // 229: v1.StopGatewayResponse
+	(*ListGatewayClientsRequest)(nil),        // 230: v1.ListGatewayClientsRequest
+	(*GatewayClient)(nil),                    // 231: v1.GatewayClient
+	(*GatewayClientList)(nil),                // 232: v1.GatewayClientList
+	(*ListGatewayClientsResponse)(nil),       // 233: v1.ListGatewayClientsResponse
+	(*GatewayInfo)(nil),                      // 234: v1.GatewayInfo
+	(*NVMeConnectRequest)(nil),               // 235: v1.NVMeConnectRequest
+	(*NVMeConnectResponse)(nil),              // 236: v1.NVMeConnectResponse
+	(*NVMeDisconnectRequest)(nil),            // 237: v1.NVMeDisconnectRequest
+	(*NVMeDisconnectResponse)(nil),           // 238: v1.NVMeDisconnectResponse
+	(*InitiatorInfo)(nil),                    // 239: v1.InitiatorInfo
+	(*GetISCSIClientConfigRequest)(nil),      // 240: v1.GetISCSIClientConfigRequest
+	(*GetISCSIClientConfigResponse)(nil),     // 241: v1.GetISCSIClientConfigResponse
+	(*ValidateISCSIInitiatorRequest)(nil),    // 242: v1.ValidateISCSIInitiatorRequest
+	(*ValidateISCSIInitiatorResponse)(nil),   // 243: v1.ValidateISCSIInitiatorResponse
+	(*NFSMountRequest)(nil),                  // 244: v1.NFSMountRequest
+	(*NFSMountResponse)(nil),                 // 245: v1.NFSMountResponse
+	(*DeleteHaRequest)(nil),                  // 246: v1.DeleteHaRequest
+	(*DeleteHaResponse)(nil),                 // 247: v1.DeleteHaResponse
+	(*GetHaRequest)(nil),                     // 248: v1.GetHaRequest
+	(*GetHaResponse)(nil),                    // 249: v1.GetHaResponse
+	(*ListHaRequest)(nil),                    // 250: v1.ListHaRequest
+	(*GetHaStatusRequest)(nil),               // 251: v1.GetHaStatusRequest
+	(*HaMember)(nil),                         // 252: v1.HaMember
+	(*GetHaStatusResponse)(nil),              // 253: v1.GetHaStatusResponse
+	(*ListHaResponse)(nil),                   // 254: v1.ListHaResponse
+	(*ImportPacemakerHaRequest)(nil),         // 255: v1.ImportPacemakerHaRequest
+	(*PacemakerHaImport)(nil),                // 256: v1.PacemakerHaImport
+	(*ImportPacemakerHaResponse)(nil),        // 257: v1.ImportPacemakerHaResponse
+	(*HaConfigInfo)(nil),                     // 258: v1.HaConfigInfo
+	(*VIPInfo)(nil),                          // 259: v1.VIPInfo
+	(*VIPPoolInfo)(nil),                      // 260: v1.VIPPoolInfo
+	(*ListVIPsRequest)(nil),                  // 261: v1.ListVIPsRequest
+	(*ListVIPsResponse)(nil),                 // 262: v1.ListVIPsResponse
+	(*DrSwitchoverRequest)(nil),              // 263: v1.DrSwitchoverRequest
+	(*DrSwitchoverResponse)(nil),             // 264: v1.DrSwitchoverResponse
+	(*DrFailbackRequest)(nil),                // 265: v1.DrFailbackRequest
+	(*DrFailbackResponse)(nil),               // 266: v1.DrFailbackResponse
+	(*AddPlacementRuleRequest)(nil),          // 267: v1.AddPlacementRuleRequest
+	(*AddPlacementRuleResponse)(nil),         // 268: v1.AddPlacementRuleResponse
+	(*DeletePlacementRuleRequest)(nil),       // 269: v1.DeletePlacementRuleRequest
+	(*DeletePlacementRuleResponse)(nil),      // 270: v1.DeletePlacementRuleResponse
+	(*ListPlacementRulesRequest)(nil),        // 271: v1.ListPlacementRulesRequest
+	(*ListPlacementRulesResponse)(nil),       // 272: v1.ListPlacementRulesResponse
+	(*PlacementRuleInfo)(nil),                // 273: v1.PlacementRuleInfo
+	(*ListEventsRequest)(nil),                // 274: v1.ListEventsRequest
+	(*ListEventsResponse)(nil),               // 275: v1.ListEventsResponse
+	(*EventInfo)(nil),                        // 276: v1.EventInfo
+	(*GetClusterReportRequest)(nil),          // 277: v1.GetClusterReportRequest
+	(*GetClusterReportResponse)(nil),         // 278: v1.GetClusterReportResponse
+	(*GetAlertRulesRequest)(nil),             // 279: v1.GetAlertRulesRequest
+	(*GetAlertRulesResponse)(nil),            // 280: v1.GetAlertRulesResponse
+	(*FreezeStatus)(nil),                     // 281: v1.FreezeStatus
+	(*ListClustersRequest)(nil),              // 282: v1.ListClustersRequest
+	(*ClusterInfo)(nil),                      // 283: v1.ClusterInfo
+	(*ListClustersResponse)(nil),             // 284: v1.ListClustersResponse
+	(*BuildInfo)(nil),                        // 285: v1.BuildInfo
+	(*NodeComponents)(nil),                   // 286: v1.NodeComponents
+	(*GetClusterInfoRequest)(nil),            // 287: v1.GetClusterInfoRequest
+	(*GetClusterInfoResponse)(nil),           // 288: v1.GetClusterInfoResponse
+	(*GetOverviewRequest)(nil),               // 289: v1.GetOverviewRequest
+	(*GetOverviewResponse)(nil),              // 290: v1.GetOverviewResponse
+	(*FreezeRequest)(nil),                    // 291: v1.FreezeRequest
+	(*FreezeResponse)(nil),                   // 292: v1.FreezeResponse
+	(*UnfreezeRequest)(nil),                  // 293: v1.UnfreezeRequest
+	(*UnfreezeResponse)(nil),                 // 294: v1.UnfreezeResponse
+	(*GetFreezeStatusRequest)(nil),           // 295: v1.GetFreezeStatusRequest
+	(*GetFreezeStatusResponse)(nil),          // 296: v1.GetFreezeStatusResponse
+	(*Orphan)(nil),                           // 297: v1.Orphan
+	(*CollectGarbageRequest)(nil),            // 298: v1.CollectGarbageRequest
+	(*CollectGarbageResponse)(nil),           // 299: v1.CollectGarbageResponse
+	(*Rediscovered)(nil),                     // 300: v1.Rediscovered
+	(*RediscoverRequest)(nil),                // 301: v1.RediscoverRequest
+	(*RediscoverResponse)(nil),               // 302: v1.RediscoverResponse
+	(*Drift)(nil),                            // 303: v1.Drift
+	(*GetDriftReportRequest)(nil),            // 304: v1.GetDriftReportRequest
+	(*GetDriftReportResponse)(nil),           // 305: v1.GetDriftReportResponse
+	(*RepairRequest)(nil),                    // 306: v1.RepairRequest
+	(*RepairResponse)(nil),                   // 307: v1.RepairResponse
+	(*RebalanceRequest)(nil),                 // 308: v1.RebalanceRequest
+	(*NodePrimaries)(nil),                    // 309: v1.NodePrimaries
+	(*RebalanceMove)(nil),                    // 310: v1.RebalanceMove
+	(*RebalanceResponse)(nil),                // 311: v1.RebalanceResponse
+	(*DrbdGlobalConfig)(nil),                 // 312: v1.DrbdGlobalConfig
+	(*GetDrbdGlobalConfigRequest)(nil),       // 313: v1.GetDrbdGlobalConfigRequest
+	(*GetDrbdGlobalConfigResponse)(nil),      // 314: v1.GetDrbdGlobalConfigResponse
+	(*SetDrbdGlobalConfigRequest)(nil),       // 315: v1.SetDrbdGlobalConfigRequest
+	(*SetDrbdGlobalConfigResponse)(nil),      // 316: v1.SetDrbdGlobalConfigResponse
+	(*ListDrbdGlobalConfigsRequest)(nil),     // 317: v1.ListDrbdGlobalConfigsRequest
+	(*ListDrbdGlobalConfigsResponse)(nil),    // 318: v1.ListDrbdGlobalConfigsResponse
+	(*RollbackDrbdGlobalConfigRequest)(nil),  // 319: v1.RollbackDrbdGlobalConfigRequest
+	(*RollbackDrbdGlobalConfigResponse)(nil), // 320: v1.RollbackDrbdGlobalConfigResponse
+	(*JobStep)(nil),                          // 321: v1.JobStep
+	(*JobInfo)(nil),                          // 322: v1.JobInfo
+	(*ListJobsRequest)(nil),                  // 323: v1.ListJobsRequest
+	(*ListJobsResponse)(nil),                 // 324: v1.ListJobsResponse
+	(*GetJobRequest)(nil),                    // 325: v1.GetJobRequest
+	(*GetJobResponse)(nil),                   // 326: v1.GetJobResponse
+	(*ResumeJobRequest)(nil),                 // 327: v1.ResumeJobRequest
+	(*ResumeJobResponse)(nil),                // 328: v1.ResumeJobResponse
+	(*RollbackJobRequest)(nil),               // 329: v1.RollbackJobRequest
+	(*RollbackJobResponse)(nil),              // 330: v1.RollbackJobResponse
+	(*WatchJobProgressRequest)(nil),          // 331: v1.WatchJobProgressRequest
+	(*JobProgress)(nil),                      // 332: v1.JobProgress
+	(*NetProbe)(nil),                         // 333: v1.NetProbe
+	(*ProbeNetworkRequest)(nil),              // 334: v1.ProbeNetworkRequest
+	(*ProbeNetworkResponse)(nil),             // 335: v1.ProbeNetworkResponse
+	(*ListNetProbesRequest)(nil),             // 336: v1.ListNetProbesRequest
+	(*ListNetProbesResponse)(nil),            // 337: v1.ListNetProbesResponse
+	(*DiscoveredDisk)(nil),                   // 338: v1.DiscoveredDisk
+	(*DiscoverDisksRequest)(nil),             // 339: v1.DiscoverDisksRequest
+	(*DiscoverDisksResponse)(nil),            // 340: v1.DiscoverDisksResponse
+	(*SetupStep)(nil),                        // 341: v1.SetupStep
+	(*GetSetupStatusRequest)(nil),            // 342: v1.GetSetupStatusRequest
+	(*GetSetupStatusResponse)(nil),           // 343: v1.GetSetupStatusResponse
+	(*SetupCheck)(nil),                       // 344: v1.SetupCheck
+	(*TestNodeConnectionRequest)(nil),        // 345: v1.TestNodeConnectionRequest
+	(*TestNodeConnectionResponse)(nil),       // 346: v1.TestNodeConnectionResponse
+	(*CompleteSetupRequest)(nil),             // 347: v1.CompleteSetupRequest
+	(*CompleteSetupResponse)(nil),            // 348: v1.CompleteSetupResponse
+	(*SupportBundleRequest)(nil),             // 349: v1.SupportBundleRequest
+	(*SupportBundleChunk)(nil),               // 350: v1.SupportBundleChunk
+	(*EventHook)(nil),                        // 351: v1.EventHook
+	(*SetEventHookRequest)(nil),              // 352: v1.SetEventHookRequest
+	(*SetEventHookResponse)(nil),             // 353: v1.SetEventHookResponse
+	(*DeleteEventHookRequest)(nil),           // 354: v1.DeleteEventHookRequest
+	(*DeleteEventHookResponse)(nil),          // 355: v1.DeleteEventHookResponse
+	(*ListEventHooksRequest)(nil),            // 356: v1.ListEventHooksRequest
+	(*ListEventHooksResponse)(nil),           // 357: v1.ListEventHooksResponse
+	(*TestEventHookRequest)(nil),             // 358: v1.TestEventHookRequest
+	(*TestEventHookResponse)(nil),            // 359: v1.TestEventHookResponse
+	nil,                                      // 360: v1.CreateResourceRequest.DrbdOptionsEntry
+	nil,                                      // 361: v1.CreateResourceRequest.DevicesEntry
+	nil,                                      // 362: v1.CreateResourceRequest.PeerProtocolsEntry
+	nil,                                      // 363: v1.InstallFenceHandlersResponse.DrbdOptionsEntry
+	nil,                                      // 364: v1.DrbdConfigSection.OptionsEntry
+	nil,                                      // 365: v1.RenderConfigRequest.PeerProtocolsEntry
+	nil,                                      // 366: v1.RenderConfigRequest.DrbdOptionsEntry
+	nil,                                      // 367: v1.ResourceInfo.NodeStatesEntry
+	nil,                                      // 368: v1.ResourceInfo.PeerProtocolsEntry
+	nil,                                      // 369: v1.ResourceStatus.NodeStatesEntry
+	nil,                                      // 370: v1.CreateNFSGatewayRequest.OptionsEntry
+	nil,                                      // 371: v1.CreateISCSIGatewayRequest.OptionsEntry
+	nil,                                      // 372: v1.CreateNVMeGatewayRequest.OptionsEntry
+	nil,                                      // 373: v1.GatewayInfo.OptionsEntry
+	nil,                                      // 374: v1.EventInfo.DetailsEntry
+	nil,                                      // 375: v1.DrbdGlobalConfig.DiskEntry
+	nil,                                      // 376: v1.DrbdGlobalConfig.NetEntry
+	nil,                                      // 377: v1.DrbdGlobalConfig.HandlersEntry
+	nil,                                      // 378: v1.EventHook.HeadersEntry
 }
 var file_api_proto_v1_sds_proto_depIdxs = []int32{
-	10,  // 0: v1.GetPoolResponse.pool:type_name -> v1.PoolInfo
-	10,  // 1: v1.ListPoolsResponse.pools:type_name -> v1.PoolInfo
-	10,  // 2: v1.ListZFSPoolsResponse.pools:type_name -> v1.PoolInfo
-	97,  // 3: v1.ListZFSSnapshotsResponse.snapshots:type_name -> v1.SnapshotInfo
-	97,  // 4: v1.ListLvmSnapshotsResponse.snapshots:type_name -> v1.SnapshotInfo
-	51,  // 5: v1.RegisterNodeResponse.node:type_name -> v1.NodeInfo
-	51,  // 6: v1.GetNodeResponse.node:type_name -> v1.NodeInfo
-	51,  // 7: v1.ListNodesResponse.nodes:type_name -> v1.NodeInfo
-	54,  // 8: v1.HealthCheckResponse.health:type_name -> v1.NodeHealthInfo
-	122, // 9: v1.CreateResourceRequest.drbd_options:type_name -> v1.CreateResourceRequest.DrbdOptionsEntry
-	85,  // 10: v1.GetResourceResponse.resource:type_name -> v1.ResourceInfo
-	85,  // 11: v1.ListResourcesResponse.resources:type_name -> v1.ResourceInfo
-	86,  // 12: v1.ResourceStatusResponse.status:type_name -> v1.ResourceStatus
-	88,  // 13: v1.ResourceInfo.volumes:type_name -> v1.VolumeInfo
-	123, // 14: v1.ResourceInfo.node_states:type_name -> v1.ResourceInfo.NodeStatesEntry
-	124, // 15: v1.ResourceStatus.node_states:type_name -> v1.ResourceStatus.NodeStatesEntry
-	88,  // 16: v1.ResourceStatus.volumes:type_name -> v1.VolumeInfo
-	97,  // 17: v1.ListSnapshotsResponse.snapshots:type_name -> v1.SnapshotInfo
-	125, // 18: v1.CreateNFSGatewayRequest.options:type_name -> v1.CreateNFSGatewayRequest.OptionsEntry
-	126, // 19: v1.CreateISCSIGatewayRequest.options:type_name -> v1.CreateISCSIGatewayRequest.OptionsEntry
-	127, // 20: v1.CreateNVMeGatewayRequest.options:type_name -> v1.CreateNVMeGatewayRequest.OptionsEntry
-	114, // 21: v1.GetGatewayResponse.gateway:type_name -> v1.GatewayInfo
-	114, // 22: v1.ListGatewaysResponse.gateways:type_name -> v1.GatewayInfo
-	128, // 23: v1.GatewayInfo.options:type_name -> v1.GatewayInfo.OptionsEntry
-	121, // 24: v1.GetHaResponse.config:type_name -> v1.HaConfigInfo
-	121, // 25: v1.ListHaResponse.configs:type_name -> v1.HaConfigInfo
-	87,  // 26: v1.ResourceInfo.NodeStatesEntry.value:type_name -> v1.NodeResourceState
-	87,  // 27: v1.ResourceStatus.NodeStatesEntry.value:type_name -> v1.NodeResourceState
-	0,   // 28: v1.SDSController.CreatePool:input_type -> v1.CreatePoolRequest
-	2,   // 29: v1.SDSController.DeletePool:input_type -> v1.DeletePoolRequest
-	4,   // 30: v1.SDSController.GetPool:input_type -> v1.GetPoolRequest
-	6,   // 31: v1.SDSController.ListPools:input_type -> v1.ListPoolsRequest
-	8,   // 32: v1.SDSController.AddDiskToPool:input_type -> v1.AddDiskToPoolRequest
-	43,  // 33: v1.SDSController.RegisterNode:input_type -> v1.RegisterNodeRequest
-	45,  // 34: v1.SDSController.UnregisterNode:input_type -> v1.UnregisterNodeRequest
-	47,  // 35: v1.SDSController.GetNode:input_type -> v1.GetNodeRequest
-	49,  // 36: v1.SDSController.ListNodes:input_type -> v1.ListNodesRequest
-	52,  // 37: v1.SDSController.HealthCheck:input_type -> v1.HealthCheckRequest
-	55,  // 38: v1.SDSController.CreateResource:input_type -> v1.CreateResourceRequest
-	57,  // 39: v1.SDSController.DeleteResource:input_type -> v1.DeleteResourceRequest
-	59,  // 40: v1.SDSController.GetResource:input_type -> v1.GetResourceRequest
-	61,  // 41: v1.SDSController.ListResources:input_type -> v1.ListResourcesRequest
-	63,  // 42: v1.SDSController.AddVolume:input_type -> v1.AddVolumeRequest
-	65,  // 43: v1.SDSController.RemoveVolume:input_type -> v1.RemoveVolumeRequest
-	67,  // 44: v1.SDSController.ResizeVolume:input_type -> v1.ResizeVolumeRequest
-	69,  // 45: v1.SDSController.ResourceStatus:input_type -> v1.ResourceStatusRequest
-	71,  // 46: v1.SDSController.SetPrimary:input_type -> v1.SetPrimaryRequest
-	73,  // 47: v1.SDSController.SetSecondary:input_type -> v1.SetSecondaryRequest
-	75,  // 48: v1.SDSController.CreateFilesystem:input_type -> v1.CreateFilesystemRequest
-	77,  // 49: v1.SDSController.MountResource:input_type -> v1.MountResourceRequest
-	79,  // 50: v1.SDSController.UnmountResource:input_type -> v1.UnmountResourceRequest
-	81,  // 51: v1.SDSController.MakeHa:input_type -> v1.MakeHaRequest
-	83,  // 52: v1.SDSController.EvictHa:input_type -> v1.EvictHaRequest
-	115, // 53: v1.SDSController.DeleteHa:input_type -> v1.DeleteHaRequest
-	117, // 54: v1.SDSController.GetHa:input_type -> v1.GetHaRequest
-	119, // 55: v1.SDSController.ListHa:input_type -> v1.ListHaRequest
-	89,  // 56: v1.SDSController.CreateSnapshot:input_type -> v1.CreateSnapshotRequest
-	91,  // 57: v1.SDSController.DeleteSnapshot:input_type -> v1.DeleteSnapshotRequest
-	93,  // 58: v1.SDSController.RestoreSnapshot:input_type -> v1.RestoreSnapshotRequest
-	95,  // 59: v1.SDSController.ListSnapshots:input_type -> v1.ListSnapshotsRequest
-	98,  // 60: v1.SDSController.CreateNFSGateway:input_type -> v1.CreateNFSGatewayRequest
-	100, // 61: v1.SDSController.CreateISCSIGateway:input_type -> v1.CreateISCSIGatewayRequest
-	102, // 62: v1.SDSController.CreateNVMeGateway:input_type -> v1.CreateNVMeGatewayRequest
-	104, // 63: v1.SDSController.DeleteGateway:input_type -> v1.DeleteGatewayRequest
-	106, // 64: v1.SDSController.GetGateway:input_type -> v1.GetGatewayRequest
-	108, // 65: v1.SDSController.ListGateways:input_type -> v1.ListGatewaysRequest
-	110, // 66: v1.SDSController.StartGateway:input_type -> v1.StartGatewayRequest
-	112, // 67: v1.SDSController.StopGateway:input_type -> v1.StopGatewayRequest
-	11,  // 68: v1.SDSController.CreateZFSPool:input_type -> v1.CreateZFSPoolRequest
-	13,  // 69: v1.SDSController.DeleteZFSPool:input_type -> v1.DeleteZFSPoolRequest
-	15,  // 70: v1.SDSController.ListZFSpools:input_type -> v1.ListZFSPoolsRequest
-	17,  // 71: v1.SDSController.CreateZFSDataset:input_type -> v1.CreateZFSDatasetRequest
-	19,  // 72: v1.SDSController.CreateZFSVolume:input_type -> v1.CreateZFSVolumeRequest
-	21,  // 73: v1.SDSController.ResizeZFSVolume:input_type -> v1.ResizeZFSVolumeRequest
-	23,  // 74: v1.SDSController.DeleteZFSDataset:input_type -> v1.DeleteZFSDatasetRequest
-	25,  // 75: v1.SDSController.CreateZFSSnapshot:input_type -> v1.CreateZFSSnapshotRequest
-	27,  // 76: v1.SDSController.DeleteZFSSnapshot:input_type -> v1.DeleteZFSSnapshotRequest
-	29,  // 77: v1.SDSController.ListZFSSnapshots:input_type -> v1.ListZFSSnapshotsRequest
-	31,  // 78: v1.SDSController.RestoreZFSSnapshot:input_type -> v1.RestoreZFSSnapshotRequest
-	33,  // 79: v1.SDSController.CloneZFSSnapshot:input_type -> v1.CloneZFSSnapshotRequest
-	35,  // 80: v1.SDSController.CreateLvmSnapshot:input_type -> v1.CreateLvmSnapshotRequest
-	37,  // 81: v1.SDSController.DeleteLvmSnapshot:input_type -> v1.DeleteLvmSnapshotRequest
-	39,  // 82: v1.SDSController.ListLvmSnapshots:input_type -> v1.ListLvmSnapshotsRequest
-	41,  // 83: v1.SDSController.RestoreLvmSnapshot:input_type -> v1.RestoreLvmSnapshotRequest
-	1,   // 84: v1.SDSController.CreatePool:output_type -> v1.CreatePoolResponse
-	3,   // 85: v1.SDSController.DeletePool:output_type -> v1.DeletePoolResponse
-	5,   // 86: v1.SDSController.GetPool:output_type -> v1.GetPoolResponse
-	7,   // 87: v1.SDSController.ListPools:output_type -> v1.ListPoolsResponse
-	9,   // 88: v1.SDSController.AddDiskToPool:output_type -> v1.AddDiskToPoolResponse
-	44,  // 89: v1.SDSController.RegisterNode:output_type -> v1.RegisterNodeResponse
-	46,  // 90: v1.SDSController.UnregisterNode:output_type -> v1.UnregisterNodeResponse
-	48,  // 91: v1.SDSController.GetNode:output_type -> v1.GetNodeResponse
-	50,  // 92: v1.SDSController.ListNodes:output_type -> v1.ListNodesResponse
-	53,  // 93: v1.SDSController.HealthCheck:output_type -> v1.HealthCheckResponse
-	56,  // 94: v1.SDSController.CreateResource:output_type -> v1.CreateResourceResponse
-	58,  // 95: v1.SDSController.DeleteResource:output_type -> v1.DeleteResourceResponse
-	60,  // 96: v1.SDSController.GetResource:output_type -> v1.GetResourceResponse
-	62,  // 97: v1.SDSController.ListResources:output_type -> v1.ListResourcesResponse
-	64,  // 98: v1.SDSController.AddVolume:output_type -> v1.AddVolumeResponse
-	66,  // 99: v1.SDSController.RemoveVolume:output_type -> v1.RemoveVolumeResponse
-	68,  // 100: v1.SDSController.ResizeVolume:output_type -> v1.ResizeVolumeResponse
-	70,  // 101: v1.SDSController.ResourceStatus:output_type -> v1.ResourceStatusResponse
-	72,  // 102: v1.SDSController.SetPrimary:output_type -> v1.SetPrimaryResponse
-	74,  // 103: v1.SDSController.SetSecondary:output_type -> v1.SetSecondaryResponse
-	76,  // 104: v1.SDSController.CreateFilesystem:output_type -> v1.CreateFilesystemResponse
-	78,  // 105: v1.SDSController.MountResource:output_type -> v1.MountResourceResponse
-	80,  // 106: v1.SDSController.UnmountResource:output_type -> v1.UnmountResourceResponse
-	82,  // 107: v1.SDSController.MakeHa:output_type -> v1.MakeHaResponse
-	84,  // 108: v1.SDSController.EvictHa:output_type -> v1.EvictHaResponse
-	116, // 109: v1.SDSController.DeleteHa:output_type -> v1.DeleteHaResponse
-	118, // 110: v1.SDSController.GetHa:output_type -> v1.GetHaResponse
-	120, // 111: v1.SDSController.ListHa:output_type -> v1.ListHaResponse
-	90,  // 112: v1.SDSController.CreateSnapshot:output_type -> v1.CreateSnapshotResponse
-	92,  // 113: v1.SDSController.DeleteSnapshot:output_type -> v1.DeleteSnapshotResponse
-	94,  // 114: v1.SDSController.RestoreSnapshot:output_type -> v1.RestoreSnapshotResponse
-	96,  // 115: v1.SDSController.ListSnapshots:output_type -> v1.ListSnapshotsResponse
-	99,  // 116: v1.SDSController.CreateNFSGateway:output_type -> v1.CreateNFSGatewayResponse
-	101, // 117: v1.SDSController.CreateISCSIGateway:output_type -> v1.CreateISCSIGatewayResponse
-	103, // 118: v1.SDSController.CreateNVMeGateway:output_type -> v1.CreateNVMeGatewayResponse
-	105, // 119: v1.SDSController.DeleteGateway:output_type -> v1.DeleteGatewayResponse
-	107, // 120: v1.SDSController.GetGateway:output_type -> v1.GetGatewayResponse
-	109, // 121: v1.SDSController.ListGateways:output_type -> v1.ListGatewaysResponse
-	111, // 122: v1.SDSController.StartGateway:output_type -> v1.StartGatewayResponse
-	113, // 123: v1.SDSController.StopGateway:output_type -> v1.StopGatewayResponse
-	12,  // 124: v1.SDSController.CreateZFSPool:output_type -> v1.CreateZFSPoolResponse
-	14,  // 125: v1.SDSController.DeleteZFSPool:output_type -> v1.DeleteZFSPoolResponse
-	16,  // 126: v1.SDSController.ListZFSpools:output_type -> v1.ListZFSPoolsResponse
-	18,  // 127: v1.SDSController.CreateZFSDataset:output_type -> v1.CreateZFSDatasetResponse
-	20,  // 128: v1.SDSController.CreateZFSVolume:output_type -> v1.CreateZFSVolumeResponse
-	22,  // 129: v1.SDSController.ResizeZFSVolume:output_type -> v1.ResizeZFSVolumeResponse
-	24,  // 130: v1.SDSController.DeleteZFSDataset:output_type -> v1.DeleteZFSDatasetResponse
-	26,  // 131: v1.SDSController.CreateZFSSnapshot:output_type -> v1.CreateZFSSnapshotResponse
-	28,  // 132: v1.SDSController.DeleteZFSSnapshot:output_type -> v1.DeleteZFSSnapshotResponse
-	30,  // 133: v1.SDSController.ListZFSSnapshots:output_type -> v1.ListZFSSnapshotsResponse
-	32,  // 134: v1.SDSController.RestoreZFSSnapshot:output_type -> v1.RestoreZFSSnapshotResponse
-	34,  // 135: v1.SDSController.CloneZFSSnapshot:output_type -> v1.CloneZFSSnapshotResponse
-	36,  // 136: v1.SDSController.CreateLvmSnapshot:output_type -> v1.CreateLvmSnapshotResponse
-	38,  // 137: v1.SDSController.DeleteLvmSnapshot:output_type -> v1.DeleteLvmSnapshotResponse
-	40,  // 138: v1.SDSController.ListLvmSnapshots:output_type -> v1.ListLvmSnapshotsResponse
-	42,  // 139: v1.SDSController.RestoreLvmSnapshot:output_type -> v1.RestoreLvmSnapshotResponse
-	84,  // [84:140] is the sub-list for method output_type
-	28,  // [28:84] is the sub-list for method input_type
-	28,  // [28:28] is the sub-list for extension type_name
-	28,  // [28:28] is the sub-list for extension extendee
-	0,   // [0:28] is the sub-list for field type_name
+	13,  // 0: v1.GetPoolResponse.pool:type_name -> v1.PoolInfo
+	13,  // 1: v1.ListPoolsResponse.pools:type_name -> v1.PoolInfo
+	11,  // 2: v1.GetPoolHistoryResponse.samples:type_name -> v1.PoolUsageSample
+	13,  // 3: v1.ListZFSPoolsResponse.pools:type_name -> v1.PoolInfo
+	186, // 4: v1.ListZFSSnapshotsResponse.snapshots:type_name -> v1.SnapshotInfo
+	186, // 5: v1.ListLvmSnapshotsResponse.snapshots:type_name -> v1.SnapshotInfo
+	75,  // 6: v1.RegisterNodeResponse.node:type_name -> v1.NodeInfo
+	75,  // 7: v1.GetNodeResponse.node:type_name -> v1.NodeInfo
+	75,  // 8: v1.ListNodesResponse.nodes:type_name -> v1.NodeInfo
+	60,  // 9: v1.SetNodeMaintenanceResponse.window:type_name -> v1.MaintenanceWindow
+	60,  // 10: v1.ListMaintenanceWindowsResponse.windows:type_name -> v1.MaintenanceWindow
+	68,  // 11: v1.NodeExecResponse.results:type_name -> v1.NodeExecResult
+	73,  // 12: v1.PushFileResponse.results:type_name -> v1.PushFileResult
+	76,  // 13: v1.NodeInfo.capacity:type_name -> v1.NodeCapacity
+	77,  // 14: v1.NodeCapacity.pools:type_name -> v1.NodePoolCapacity
+	80,  // 15: v1.HealthCheckResponse.health:type_name -> v1.NodeHealthInfo
+	81,  // 16: v1.NodeHealthInfo.time:type_name -> v1.NodeTime
+	81,  // 17: v1.CheckTimeResponse.nodes:type_name -> v1.NodeTime
+	360, // 18: v1.CreateResourceRequest.drbd_options:type_name -> v1.CreateResourceRequest.DrbdOptionsEntry
+	361, // 19: v1.CreateResourceRequest.devices:type_name -> v1.CreateResourceRequest.DevicesEntry
+	362, // 20: v1.CreateResourceRequest.peer_protocols:type_name -> v1.CreateResourceRequest.PeerProtocolsEntry
+	95,  // 21: v1.ReplaceDiskResponse.disks:type_name -> v1.ReplacedDisk
+	108, // 22: v1.ExecFenceTestResponse.checks:type_name -> v1.FenceTestCheck
+	111, // 23: v1.ActivateResourceResponse.steps:type_name -> v1.ActivationStep
+	111, // 24: v1.DeactivateResourceResponse.steps:type_name -> v1.ActivationStep
+	363, // 25: v1.InstallFenceHandlersResponse.drbd_options:type_name -> v1.InstallFenceHandlersResponse.DrbdOptionsEntry
+	123, // 26: v1.ListFenceConstraintsResponse.constraints:type_name -> v1.FenceConstraint
+	172, // 27: v1.GetResourceResponse.resource:type_name -> v1.ResourceInfo
+	172, // 28: v1.ListResourcesResponse.resources:type_name -> v1.ResourceInfo
+	176, // 29: v1.AddVolumeResponse.volume:type_name -> v1.VolumeInfo
+	176, // 30: v1.GetVolumeResponse.volume:type_name -> v1.VolumeInfo
+	176, // 31: v1.ListVolumesResponse.volumes:type_name -> v1.VolumeInfo
+	173, // 32: v1.ResourceStatusResponse.status:type_name -> v1.ResourceStatus
+	145, // 33: v1.DiffResourceResponse.diffs:type_name -> v1.ConfigDiff
+	364, // 34: v1.DrbdConfigSection.options:type_name -> v1.DrbdConfigSection.OptionsEntry
+	148, // 35: v1.DrbdConfigSection.sections:type_name -> v1.DrbdConfigSection
+	148, // 36: v1.GetNodeResourceConfigResponse.configured:type_name -> v1.DrbdConfigSection
+	148, // 37: v1.GetNodeResourceConfigResponse.effective:type_name -> v1.DrbdConfigSection
+	365, // 38: v1.RenderConfigRequest.peer_protocols:type_name -> v1.RenderConfigRequest.PeerProtocolsEntry
+	366, // 39: v1.RenderConfigRequest.drbd_options:type_name -> v1.RenderConfigRequest.DrbdOptionsEntry
+	214, // 40: v1.RenderConfigRequest.nfs:type_name -> v1.CreateNFSGatewayRequest
+	216, // 41: v1.RenderConfigRequest.iscsi:type_name -> v1.CreateISCSIGatewayRequest
+	218, // 42: v1.RenderConfigRequest.nvmeof:type_name -> v1.CreateNVMeGatewayRequest
+	165, // 43: v1.RenderConfigResponse.files:type_name -> v1.PlannedFile
+	163, // 44: v1.MakeHaRequest.policy:type_name -> v1.HaPolicy
+	165, // 45: v1.MakeHaResponse.files:type_name -> v1.PlannedFile
+	165, // 46: v1.UpdateHaResponse.files:type_name -> v1.PlannedFile
+	176, // 47: v1.ResourceInfo.volumes:type_name -> v1.VolumeInfo
+	367, // 48: v1.ResourceInfo.node_states:type_name -> v1.ResourceInfo.NodeStatesEntry
+	368, // 49: v1.ResourceInfo.peer_protocols:type_name -> v1.ResourceInfo.PeerProtocolsEntry
+	369, // 50: v1.ResourceStatus.node_states:type_name -> v1.ResourceStatus.NodeStatesEntry
+	176, // 51: v1.ResourceStatus.volumes:type_name -> v1.VolumeInfo
+	174, // 52: v1.ResourceStatus.io_stats:type_name -> v1.VolumeIOStats
+	177, // 53: v1.VolumeInfo.backing:type_name -> v1.VolumeBacking
+	186, // 54: v1.ListSnapshotsResponse.snapshots:type_name -> v1.SnapshotInfo
+	190, // 55: v1.SnapshotGroupResponse.results:type_name -> v1.SnapshotGroupResult
+	192, // 56: v1.ListSnapshotGroupsResponse.groups:type_name -> v1.SnapshotGroup
+	213, // 57: v1.GetSnapshotUsageResponse.usage:type_name -> v1.SnapshotUsageInfo
+	197, // 58: v1.SetSnapshotHookRequest.hook:type_name -> v1.SnapshotHook
+	197, // 59: v1.ListSnapshotHooksResponse.hooks:type_name -> v1.SnapshotHook
+	204, // 60: v1.SetReplicationPolicyRequest.policy:type_name -> v1.ReplicationPolicy
+	204, // 61: v1.ListReplicationPoliciesResponse.policies:type_name -> v1.ReplicationPolicy
+	204, // 62: v1.RunReplicationResponse.policy:type_name -> v1.ReplicationPolicy
+	370, // 63: v1.CreateNFSGatewayRequest.options:type_name -> v1.CreateNFSGatewayRequest.OptionsEntry
+	165, // 64: v1.CreateNFSGatewayResponse.files:type_name -> v1.PlannedFile
+	371, // 65: v1.CreateISCSIGatewayRequest.options:type_name -> v1.CreateISCSIGatewayRequest.OptionsEntry
+	165, // 66: v1.CreateISCSIGatewayResponse.files:type_name -> v1.PlannedFile
+	372, // 67: v1.CreateNVMeGatewayRequest.options:type_name -> v1.CreateNVMeGatewayRequest.OptionsEntry
+	165, // 68: v1.CreateNVMeGatewayResponse.files:type_name -> v1.PlannedFile
+	234, // 69: v1.GetGatewayResponse.gateway:type_name -> v1.GatewayInfo
+	234, // 70: v1.ListGatewaysResponse.gateways:type_name -> v1.GatewayInfo
+	231, // 71: v1.GatewayClientList.clients:type_name -> v1.GatewayClient
+	232, // 72: v1.ListGatewayClientsResponse.gateways:type_name -> v1.GatewayClientList
+	373, // 73: v1.GatewayInfo.options:type_name -> v1.GatewayInfo.OptionsEntry
+	239, // 74: v1.NVMeConnectResponse.initiator:type_name -> v1.InitiatorInfo
+	239, // 75: v1.NVMeDisconnectResponse.initiator:type_name -> v1.InitiatorInfo
+	239, // 76: v1.ValidateISCSIInitiatorResponse.initiator:type_name -> v1.InitiatorInfo
+	239, // 77: v1.NFSMountResponse.initiator:type_name -> v1.InitiatorInfo
+	258, // 78: v1.GetHaResponse.config:type_name -> v1.HaConfigInfo
+	258, // 79: v1.GetHaStatusResponse.config:type_name -> v1.HaConfigInfo
+	252, // 80: v1.GetHaStatusResponse.members:type_name -> v1.HaMember
+	258, // 81: v1.ListHaResponse.configs:type_name -> v1.HaConfigInfo
+	256, // 82: v1.ImportPacemakerHaResponse.imports:type_name -> v1.PacemakerHaImport
+	163, // 83: v1.HaConfigInfo.policy:type_name -> v1.HaPolicy
+	259, // 84: v1.ListVIPsResponse.vips:type_name -> v1.VIPInfo
+	260, // 85: v1.ListVIPsResponse.pools:type_name -> v1.VIPPoolInfo
+	273, // 86: v1.ListPlacementRulesResponse.rules:type_name -> v1.PlacementRuleInfo
+	276, // 87: v1.ListEventsResponse.events:type_name -> v1.EventInfo
+	374, // 88: v1.EventInfo.details:type_name -> v1.EventInfo.DetailsEntry
+	283, // 89: v1.ListClustersResponse.clusters:type_name -> v1.ClusterInfo
+	283, // 90: v1.GetClusterInfoResponse.cluster:type_name -> v1.ClusterInfo
+	285, // 91: v1.GetClusterInfoResponse.controller:type_name -> v1.BuildInfo
+	286, // 92: v1.GetClusterInfoResponse.nodes:type_name -> v1.NodeComponents
+	75,  // 93: v1.GetOverviewResponse.nodes:type_name -> v1.NodeInfo
+	13,  // 94: v1.GetOverviewResponse.pools:type_name -> v1.PoolInfo
+	172, // 95: v1.GetOverviewResponse.resources:type_name -> v1.ResourceInfo
+	258, // 96: v1.GetOverviewResponse.ha:type_name -> v1.HaConfigInfo
+	234, // 97: v1.GetOverviewResponse.gateways:type_name -> v1.GatewayInfo
+	276, // 98: v1.GetOverviewResponse.events:type_name -> v1.EventInfo
+	281, // 99: v1.FreezeResponse.status:type_name -> v1.FreezeStatus
+	281, // 100: v1.GetFreezeStatusResponse.status:type_name -> v1.FreezeStatus
+	297, // 101: v1.CollectGarbageResponse.orphans:type_name -> v1.Orphan
+	300, // 102: v1.RediscoverResponse.records:type_name -> v1.Rediscovered
+	303, // 103: v1.GetDriftReportResponse.drifts:type_name -> v1.Drift
+	309, // 104: v1.RebalanceResponse.nodes:type_name -> v1.NodePrimaries
+	310, // 105: v1.RebalanceResponse.moves:type_name -> v1.RebalanceMove
+	375, // 106: v1.DrbdGlobalConfig.disk:type_name -> v1.DrbdGlobalConfig.DiskEntry
+	376, // 107: v1.DrbdGlobalConfig.net:type_name -> v1.DrbdGlobalConfig.NetEntry
+	377, // 108: v1.DrbdGlobalConfig.handlers:type_name -> v1.DrbdGlobalConfig.HandlersEntry
+	312, // 109: v1.GetDrbdGlobalConfigResponse.config:type_name -> v1.DrbdGlobalConfig
+	312, // 110: v1.SetDrbdGlobalConfigRequest.config:type_name -> v1.DrbdGlobalConfig
+	312, // 111: v1.ListDrbdGlobalConfigsResponse.configs:type_name -> v1.DrbdGlobalConfig
+	321, // 112: v1.JobInfo.steps:type_name -> v1.JobStep
+	322, // 113: v1.ListJobsResponse.jobs:type_name -> v1.JobInfo
+	322, // 114: v1.GetJobResponse.job:type_name -> v1.JobInfo
+	333, // 115: v1.ProbeNetworkResponse.probes:type_name -> v1.NetProbe
+	333, // 116: v1.ListNetProbesResponse.probes:type_name -> v1.NetProbe
+	338, // 117: v1.DiscoverDisksResponse.disks:type_name -> v1.DiscoveredDisk
+	341, // 118: v1.GetSetupStatusResponse.steps:type_name -> v1.SetupStep
+	344, // 119: v1.TestNodeConnectionResponse.checks:type_name -> v1.SetupCheck
+	378, // 120: v1.EventHook.headers:type_name -> v1.EventHook.HeadersEntry
+	351, // 121: v1.SetEventHookRequest.hook:type_name -> v1.EventHook
+	351, // 122: v1.ListEventHooksResponse.hooks:type_name -> v1.EventHook
+	175, // 123: v1.ResourceInfo.NodeStatesEntry.value:type_name -> v1.NodeResourceState
+	175, // 124: v1.ResourceStatus.NodeStatesEntry.value:type_name -> v1.NodeResourceState
+	0,   // 125: v1.SDSController.CreatePool:input_type -> v1.CreatePoolRequest
+	2,   // 126: v1.SDSController.DeletePool:input_type -> v1.DeletePoolRequest
+	4,   // 127: v1.SDSController.GetPool:input_type -> v1.GetPoolRequest
+	6,   // 128: v1.SDSController.ListPools:input_type -> v1.ListPoolsRequest
+	8,   // 129: v1.SDSController.AddDiskToPool:input_type -> v1.AddDiskToPoolRequest
+	10,  // 130: v1.SDSController.GetPoolHistory:input_type -> v1.GetPoolHistoryRequest
+	46,  // 131: v1.SDSController.RegisterNode:input_type -> v1.RegisterNodeRequest
+	48,  // 132: v1.SDSController.UnregisterNode:input_type -> v1.UnregisterNodeRequest
+	50,  // 133: v1.SDSController.GetNode:input_type -> v1.GetNodeRequest
+	52,  // 134: v1.SDSController.ListNodes:input_type -> v1.ListNodesRequest
+	54,  // 135: v1.SDSController.SetNodeAddress:input_type -> v1.SetNodeAddressRequest
+	56,  // 136: v1.SDSController.TrustNode:input_type -> v1.TrustNodeRequest
+	339, // 137: v1.SDSController.DiscoverDisks:input_type -> v1.DiscoverDisksRequest
+	58,  // 138: v1.SDSController.HardenNode:input_type -> v1.HardenNodeRequest
+	61,  // 139: v1.SDSController.SetNodeMaintenance:input_type -> v1.SetNodeMaintenanceRequest
+	63,  // 140: v1.SDSController.ClearNodeMaintenance:input_type -> v1.ClearNodeMaintenanceRequest
+	65,  // 141: v1.SDSController.ListMaintenanceWindows:input_type -> v1.ListMaintenanceWindowsRequest
+	78,  // 142: v1.SDSController.HealthCheck:input_type -> v1.HealthCheckRequest
+	82,  // 143: v1.SDSController.CheckTime:input_type -> v1.CheckTimeRequest
+	67,  // 144: v1.SDSController.NodeExec:input_type -> v1.NodeExecRequest
+	72,  // 145: v1.SDSController.PushFile:input_type -> v1.PushFileRequest
+	70,  // 146: v1.SDSController.StreamNodeLogs:input_type -> v1.StreamNodeLogsRequest
+	84,  // 147: v1.SDSController.CreateResource:input_type -> v1.CreateResourceRequest
+	86,  // 148: v1.SDSController.DeleteResource:input_type -> v1.DeleteResourceRequest
+	88,  // 149: v1.SDSController.SetMaxPeers:input_type -> v1.SetMaxPeersRequest
+	90,  // 150: v1.SDSController.MigratePool:input_type -> v1.MigratePoolRequest
+	92,  // 151: v1.SDSController.ConvertStorage:input_type -> v1.ConvertStorageRequest
+	94,  // 152: v1.SDSController.ReplaceDisk:input_type -> v1.ReplaceDiskRequest
+	97,  // 153: v1.SDSController.StopResource:input_type -> v1.StopResourceRequest
+	105, // 154: v1.SDSController.StartResource:input_type -> v1.StartResourceRequest
+	99,  // 155: v1.SDSController.PauseSync:input_type -> v1.PauseSyncRequest
+	101, // 156: v1.SDSController.ResumeSync:input_type -> v1.ResumeSyncRequest
+	103, // 157: v1.SDSController.SetSyncRate:input_type -> v1.SetSyncRateRequest
+	107, // 158: v1.SDSController.ExecFenceTest:input_type -> v1.ExecFenceTestRequest
+	110, // 159: v1.SDSController.ActivateResource:input_type -> v1.ActivateResourceRequest
+	113, // 160: v1.SDSController.DeactivateResource:input_type -> v1.DeactivateResourceRequest
+	115, // 161: v1.SDSController.FencePeer:input_type -> v1.FencePeerRequest
+	117, // 162: v1.SDSController.UnfencePeer:input_type -> v1.UnfencePeerRequest
+	121, // 163: v1.SDSController.ReportDrbdEvent:input_type -> v1.ReportDrbdEventRequest
+	119, // 164: v1.SDSController.InstallFenceHandlers:input_type -> v1.InstallFenceHandlersRequest
+	124, // 165: v1.SDSController.ListFenceConstraints:input_type -> v1.ListFenceConstraintsRequest
+	126, // 166: v1.SDSController.GetResource:input_type -> v1.GetResourceRequest
+	128, // 167: v1.SDSController.ListResources:input_type -> v1.ListResourcesRequest
+	130, // 168: v1.SDSController.AddVolume:input_type -> v1.AddVolumeRequest
+	132, // 169: v1.SDSController.RemoveVolume:input_type -> v1.RemoveVolumeRequest
+	134, // 170: v1.SDSController.ResizeVolume:input_type -> v1.ResizeVolumeRequest
+	136, // 171: v1.SDSController.GetVolume:input_type -> v1.GetVolumeRequest
+	138, // 172: v1.SDSController.ListVolumes:input_type -> v1.ListVolumesRequest
+	140, // 173: v1.SDSController.ResourceStatus:input_type -> v1.ResourceStatusRequest
+	142, // 174: v1.SDSController.ExportResource:input_type -> v1.ExportResourceRequest
+	144, // 175: v1.SDSController.DiffResource:input_type -> v1.DiffResourceRequest
+	147, // 176: v1.SDSController.GetNodeResourceConfig:input_type -> v1.GetNodeResourceConfigRequest
+	150, // 177: v1.SDSController.RenderConfig:input_type -> v1.RenderConfigRequest
+	152, // 178: v1.SDSController.SetPrimary:input_type -> v1.SetPrimaryRequest
+	154, // 179: v1.SDSController.SetSecondary:input_type -> v1.SetSecondaryRequest
+	156, // 180: v1.SDSController.CreateFilesystem:input_type -> v1.CreateFilesystemRequest
+	158, // 181: v1.SDSController.MountResource:input_type -> v1.MountResourceRequest
+	160, // 182: v1.SDSController.UnmountResource:input_type -> v1.UnmountResourceRequest
+	162, // 183: v1.SDSController.MakeHa:input_type -> v1.MakeHaRequest
+	170, // 184: v1.SDSController.EvictHa:input_type -> v1.EvictHaRequest
+	166, // 185: v1.SDSController.UpdateHa:input_type -> v1.UpdateHaRequest
+	168, // 186: v1.SDSController.FailoverHa:input_type -> v1.FailoverHaRequest
+	246, // 187: v1.SDSController.DeleteHa:input_type -> v1.DeleteHaRequest
+	248, // 188: v1.SDSController.GetHa:input_type -> v1.GetHaRequest
+	250, // 189: v1.SDSController.ListHa:input_type -> v1.ListHaRequest
+	251, // 190: v1.SDSController.GetHaStatus:input_type -> v1.GetHaStatusRequest
+	255, // 191: v1.SDSController.ImportPacemakerHa:input_type -> v1.ImportPacemakerHaRequest
+	261, // 192: v1.SDSController.ListVIPs:input_type -> v1.ListVIPsRequest
+	263, // 193: v1.SDSController.DrSwitchover:input_type -> v1.DrSwitchoverRequest
+	265, // 194: v1.SDSController.DrFailback:input_type -> v1.DrFailbackRequest
+	267, // 195: v1.SDSController.AddPlacementRule:input_type -> v1.AddPlacementRuleRequest
+	269, // 196: v1.SDSController.DeletePlacementRule:input_type -> v1.DeletePlacementRuleRequest
+	271, // 197: v1.SDSController.ListPlacementRules:input_type -> v1.ListPlacementRulesRequest
+	274, // 198: v1.SDSController.ListEvents:input_type -> v1.ListEventsRequest
+	352, // 199: v1.SDSController.SetEventHook:input_type -> v1.SetEventHookRequest
+	354, // 200: v1.SDSController.DeleteEventHook:input_type -> v1.DeleteEventHookRequest
+	356, // 201: v1.SDSController.ListEventHooks:input_type -> v1.ListEventHooksRequest
+	358, // 202: v1.SDSController.TestEventHook:input_type -> v1.TestEventHookRequest
+	277, // 203: v1.SDSController.GetClusterReport:input_type -> v1.GetClusterReportRequest
+	279, // 204: v1.SDSController.GetAlertRules:input_type -> v1.GetAlertRulesRequest
+	282, // 205: v1.SDSController.ListClusters:input_type -> v1.ListClustersRequest
+	287, // 206: v1.SDSController.GetClusterInfo:input_type -> v1.GetClusterInfoRequest
+	289, // 207: v1.SDSController.GetOverview:input_type -> v1.GetOverviewRequest
+	291, // 208: v1.SDSController.Freeze:input_type -> v1.FreezeRequest
+	293, // 209: v1.SDSController.Unfreeze:input_type -> v1.UnfreezeRequest
+	295, // 210: v1.SDSController.GetFreezeStatus:input_type -> v1.GetFreezeStatusRequest
+	298, // 211: v1.SDSController.CollectGarbage:input_type -> v1.CollectGarbageRequest
+	301, // 212: v1.SDSController.Rediscover:input_type -> v1.RediscoverRequest
+	349, // 213: v1.SDSController.SupportBundle:input_type -> v1.SupportBundleRequest
+	304, // 214: v1.SDSController.GetDriftReport:input_type -> v1.GetDriftReportRequest
+	306, // 215: v1.SDSController.Repair:input_type -> v1.RepairRequest
+	308, // 216: v1.SDSController.Rebalance:input_type -> v1.RebalanceRequest
+	323, // 217: v1.SDSController.ListJobs:input_type -> v1.ListJobsRequest
+	325, // 218: v1.SDSController.GetJob:input_type -> v1.GetJobRequest
+	327, // 219: v1.SDSController.ResumeJob:input_type -> v1.ResumeJobRequest
+	329, // 220: v1.SDSController.RollbackJob:input_type -> v1.RollbackJobRequest
+	331, // 221: v1.SDSController.WatchJobProgress:input_type -> v1.WatchJobProgressRequest
+	313, // 222: v1.SDSController.GetDrbdGlobalConfig:input_type -> v1.GetDrbdGlobalConfigRequest
+	315, // 223: v1.SDSController.SetDrbdGlobalConfig:input_type -> v1.SetDrbdGlobalConfigRequest
+	317, // 224: v1.SDSController.ListDrbdGlobalConfigs:input_type -> v1.ListDrbdGlobalConfigsRequest
+	319, // 225: v1.SDSController.RollbackDrbdGlobalConfig:input_type -> v1.RollbackDrbdGlobalConfigRequest
+	334, // 226: v1.SDSController.ProbeNetwork:input_type -> v1.ProbeNetworkRequest
+	336, // 227: v1.SDSController.ListNetProbes:input_type -> v1.ListNetProbesRequest
+	178, // 228: v1.SDSController.CreateSnapshot:input_type -> v1.CreateSnapshotRequest
+	180, // 229: v1.SDSController.DeleteSnapshot:input_type -> v1.DeleteSnapshotRequest
+	182, // 230: v1.SDSController.RestoreSnapshot:input_type -> v1.RestoreSnapshotRequest
+	184, // 231: v1.SDSController.ListSnapshots:input_type -> v1.ListSnapshotsRequest
+	195, // 232: v1.SDSController.GetSnapshotUsage:input_type -> v1.GetSnapshotUsageRequest
+	198, // 233: v1.SDSController.SetSnapshotHook:input_type -> v1.SetSnapshotHookRequest
+	200, // 234: v1.SDSController.DeleteSnapshotHook:input_type -> v1.DeleteSnapshotHookRequest
+	202, // 235: v1.SDSController.ListSnapshotHooks:input_type -> v1.ListSnapshotHooksRequest
+	187, // 236: v1.SDSController.CreateSnapshotGroup:input_type -> v1.CreateSnapshotGroupRequest
+	188, // 237: v1.SDSController.DeleteSnapshotGroup:input_type -> v1.DeleteSnapshotGroupRequest
+	189, // 238: v1.SDSController.RestoreSnapshotGroup:input_type -> v1.RestoreSnapshotGroupRequest
+	193, // 239: v1.SDSController.ListSnapshotGroups:input_type -> v1.ListSnapshotGroupsRequest
+	205, // 240: v1.SDSController.SetReplicationPolicy:input_type -> v1.SetReplicationPolicyRequest
+	207, // 241: v1.SDSController.DeleteReplicationPolicy:input_type -> v1.DeleteReplicationPolicyRequest
+	209, // 242: v1.SDSController.ListReplicationPolicies:input_type -> v1.ListReplicationPoliciesRequest
+	211, // 243: v1.SDSController.RunReplication:input_type -> v1.RunReplicationRequest
+	214, // 244: v1.SDSController.CreateNFSGateway:input_type -> v1.CreateNFSGatewayRequest
+	216, // 245: v1.SDSController.CreateISCSIGateway:input_type -> v1.CreateISCSIGatewayRequest
+	218, // 246: v1.SDSController.CreateNVMeGateway:input_type -> v1.CreateNVMeGatewayRequest
+	220, // 247: v1.SDSController.DeleteGateway:input_type -> v1.DeleteGatewayRequest
+	222, // 248: v1.SDSController.GetGateway:input_type -> v1.GetGatewayRequest
+	224, // 249: v1.SDSController.ListGateways:input_type -> v1.ListGatewaysRequest
+	226, // 250: v1.SDSController.StartGateway:input_type -> v1.StartGatewayRequest
+	228, // 251: v1.SDSController.StopGateway:input_type -> v1.StopGatewayRequest
+	230, // 252: v1.SDSController.ListGatewayClients:input_type -> v1.ListGatewayClientsRequest
+	235, // 253: v1.SDSController.NVMeConnect:input_type -> v1.NVMeConnectRequest
+	237, // 254: v1.SDSController.NVMeDisconnect:input_type -> v1.NVMeDisconnectRequest
+	240, // 255: v1.SDSController.GetISCSIClientConfig:input_type -> v1.GetISCSIClientConfigRequest
+	242, // 256: v1.SDSController.ValidateISCSIInitiator:input_type -> v1.ValidateISCSIInitiatorRequest
+	244, // 257: v1.SDSController.NFSMount:input_type -> v1.NFSMountRequest
+	14,  // 258: v1.SDSController.CreateZFSPool:input_type -> v1.CreateZFSPoolRequest
+	16,  // 259: v1.SDSController.DeleteZFSPool:input_type -> v1.DeleteZFSPoolRequest
+	18,  // 260: v1.SDSController.ListZFSpools:input_type -> v1.ListZFSPoolsRequest
+	20,  // 261: v1.SDSController.CreateZFSDataset:input_type -> v1.CreateZFSDatasetRequest
+	22,  // 262: v1.SDSController.CreateZFSVolume:input_type -> v1.CreateZFSVolumeRequest
+	24,  // 263: v1.SDSController.ResizeZFSVolume:input_type -> v1.ResizeZFSVolumeRequest
+	26,  // 264: v1.SDSController.DeleteZFSDataset:input_type -> v1.DeleteZFSDatasetRequest
+	28,  // 265: v1.SDSController.CreateZFSSnapshot:input_type -> v1.CreateZFSSnapshotRequest
+	30,  // 266: v1.SDSController.DeleteZFSSnapshot:input_type -> v1.DeleteZFSSnapshotRequest
+	32,  // 267: v1.SDSController.ListZFSSnapshots:input_type -> v1.ListZFSSnapshotsRequest
+	34,  // 268: v1.SDSController.RestoreZFSSnapshot:input_type -> v1.RestoreZFSSnapshotRequest
+	36,  // 269: v1.SDSController.CloneZFSSnapshot:input_type -> v1.CloneZFSSnapshotRequest
+	342, // 270: v1.SDSController.GetSetupStatus:input_type -> v1.GetSetupStatusRequest
+	345, // 271: v1.SDSController.TestNodeConnection:input_type -> v1.TestNodeConnectionRequest
+	347, // 272: v1.SDSController.CompleteSetup:input_type -> v1.CompleteSetupRequest
+	38,  // 273: v1.SDSController.CreateLvmSnapshot:input_type -> v1.CreateLvmSnapshotRequest
+	40,  // 274: v1.SDSController.DeleteLvmSnapshot:input_type -> v1.DeleteLvmSnapshotRequest
+	42,  // 275: v1.SDSController.ListLvmSnapshots:input_type -> v1.ListLvmSnapshotsRequest
+	44,  // 276: v1.SDSController.RestoreLvmSnapshot:input_type -> v1.RestoreLvmSnapshotRequest
+	1,   // 277: v1.SDSController.CreatePool:output_type -> v1.CreatePoolResponse
+	3,   // 278: v1.SDSController.DeletePool:output_type -> v1.DeletePoolResponse
+	5,   // 279: v1.SDSController.GetPool:output_type -> v1.GetPoolResponse
+	7,   // 280: v1.SDSController.ListPools:output_type -> v1.ListPoolsResponse
+	9,   // 281: v1.SDSController.AddDiskToPool:output_type -> v1.AddDiskToPoolResponse
+	12,  // 282: v1.SDSController.GetPoolHistory:output_type -> v1.GetPoolHistoryResponse
+	47,  // 283: v1.SDSController.RegisterNode:output_type -> v1.RegisterNodeResponse
+	49,  // 284: v1.SDSController.UnregisterNode:output_type -> v1.UnregisterNodeResponse
+	51,  // 285: v1.SDSController.GetNode:output_type -> v1.GetNodeResponse
+	53,  // 286: v1.SDSController.ListNodes:output_type -> v1.ListNodesResponse
+	55,  // 287: v1.SDSController.SetNodeAddress:output_type -> v1.SetNodeAddressResponse
+	57,  // 288: v1.SDSController.TrustNode:output_type -> v1.TrustNodeResponse
+	340, // 289: v1.SDSController.DiscoverDisks:output_type -> v1.DiscoverDisksResponse
+	59,  // 290: v1.SDSController.HardenNode:output_type -> v1.HardenNodeResponse
+	62,  // 291: v1.SDSController.SetNodeMaintenance:output_type -> v1.SetNodeMaintenanceResponse
+	64,  // 292: v1.SDSController.ClearNodeMaintenance:output_type -> v1.ClearNodeMaintenanceResponse
+	66,  // 293: v1.SDSController.ListMaintenanceWindows:output_type -> v1.ListMaintenanceWindowsResponse
+	79,  // 294: v1.SDSController.HealthCheck:output_type -> v1.HealthCheckResponse
+	83,  // 295: v1.SDSController.CheckTime:output_type -> v1.CheckTimeResponse
+	69,  // 296: v1.SDSController.NodeExec:output_type -> v1.NodeExecResponse
+	74,  // 297: v1.SDSController.PushFile:output_type -> v1.PushFileResponse
+	71,  // 298: v1.SDSController.StreamNodeLogs:output_type -> v1.NodeLogLine
+	85,  // 299: v1.SDSController.CreateResource:output_type -> v1.CreateResourceResponse
+	87,  // 300: v1.SDSController.DeleteResource:output_type -> v1.DeleteResourceResponse
+	89,  // 301: v1.SDSController.SetMaxPeers:output_type -> v1.SetMaxPeersResponse
+	91,  // 302: v1.SDSController.MigratePool:output_type -> v1.MigratePoolResponse
+	93,  // 303: v1.SDSController.ConvertStorage:output_type -> v1.ConvertStorageResponse
+	96,  // 304: v1.SDSController.ReplaceDisk:output_type -> v1.ReplaceDiskResponse
+	98,  // 305: v1.SDSController.StopResource:output_type -> v1.StopResourceResponse
+	106, // 306: v1.SDSController.StartResource:output_type -> v1.StartResourceResponse
+	100, // 307: v1.SDSController.PauseSync:output_type -> v1.PauseSyncResponse
+	102, // 308: v1.SDSController.ResumeSync:output_type -> v1.ResumeSyncResponse
+	104, // 309: v1.SDSController.SetSyncRate:output_type -> v1.SetSyncRateResponse
+	109, // 310: v1.SDSController.ExecFenceTest:output_type -> v1.ExecFenceTestResponse
+	112, // 311: v1.SDSController.ActivateResource:output_type -> v1.ActivateResourceResponse
+	114, // 312: v1.SDSController.DeactivateResource:output_type -> v1.DeactivateResourceResponse
+	116, // 313: v1.SDSController.FencePeer:output_type -> v1.FencePeerResponse
+	118, // 314: v1.SDSController.UnfencePeer:output_type -> v1.UnfencePeerResponse
+	122, // 315: v1.SDSController.ReportDrbdEvent:output_type -> v1.ReportDrbdEventResponse
+	120, // 316: v1.SDSController.InstallFenceHandlers:output_type -> v1.InstallFenceHandlersResponse
+	125, // 317: v1.SDSController.ListFenceConstraints:output_type -> v1.ListFenceConstraintsResponse
+	127, // 318: v1.SDSController.GetResource:output_type -> v1.GetResourceResponse
+	129, // 319: v1.SDSController.ListResources:output_type -> v1.ListResourcesResponse
+	131, // 320: v1.SDSController.AddVolume:output_type -> v1.AddVolumeResponse
+	133, // 321: v1.SDSController.RemoveVolume:output_type -> v1.RemoveVolumeResponse
+	135, // 322: v1.SDSController.ResizeVolume:output_type -> v1.ResizeVolumeResponse
+	137, // 323: v1.SDSController.GetVolume:output_type -> v1.GetVolumeResponse
+	139, // 324: v1.SDSController.ListVolumes:output_type -> v1.ListVolumesResponse
+	141, // 325: v1.SDSController.ResourceStatus:output_type -> v1.ResourceStatusResponse
+	143, // 326: v1.SDSController.ExportResource:output_type -> v1.ExportResourceResponse
+	146, // 327: v1.SDSController.DiffResource:output_type -> v1.DiffResourceResponse
+	149, // 328: v1.SDSController.GetNodeResourceConfig:output_type -> v1.GetNodeResourceConfigResponse
+	151, // 329: v1.SDSController.RenderConfig:output_type -> v1.RenderConfigResponse
+	153, // 330: v1.SDSController.SetPrimary:output_type -> v1.SetPrimaryResponse
+	155, // 331: v1.SDSController.SetSecondary:output_type -> v1.SetSecondaryResponse
+	157, // 332: v1.SDSController.CreateFilesystem:output_type -> v1.CreateFilesystemResponse
+	159, // 333: v1.SDSController.MountResource:output_type -> v1.MountResourceResponse
+	161, // 334: v1.SDSController.UnmountResource:output_type -> v1.UnmountResourceResponse
+	164, // 335: v1.SDSController.MakeHa:output_type -> v1.MakeHaResponse
+	171, // 336: v1.SDSController.EvictHa:output_type -> v1.EvictHaResponse
+	167, // 337: v1.SDSController.UpdateHa:output_type -> v1.UpdateHaResponse
+	169, // 338: v1.SDSController.FailoverHa:output_type -> v1.FailoverHaResponse
+	247, // 339: v1.SDSController.DeleteHa:output_type -> v1.DeleteHaResponse
+	249, // 340: v1.SDSController.GetHa:output_type -> v1.GetHaResponse
+	254, // 341: v1.SDSController.ListHa:output_type -> v1.ListHaResponse
+	253, // 342: v1.SDSController.GetHaStatus:output_type -> v1.GetHaStatusResponse
+	257, // 343: v1.SDSController.ImportPacemakerHa:output_type -> v1.ImportPacemakerHaResponse
+	262, // 344: v1.SDSController.ListVIPs:output_type -> v1.ListVIPsResponse
+	264, // 345: v1.SDSController.DrSwitchover:output_type -> v1.DrSwitchoverResponse
+	266, // 346: v1.SDSController.DrFailback:output_type -> v1.DrFailbackResponse
+	268, // 347: v1.SDSController.AddPlacementRule:output_type -> v1.AddPlacementRuleResponse
+	270, // 348: v1.SDSController.DeletePlacementRule:output_type -> v1.DeletePlacementRuleResponse
+	272, // 349: v1.SDSController.ListPlacementRules:output_type -> v1.ListPlacementRulesResponse
+	275, // 350: v1.SDSController.ListEvents:output_type -> v1.ListEventsResponse
+	353, // 351: v1.SDSController.SetEventHook:output_type -> v1.SetEventHookResponse
+	355, // 352: v1.SDSController.DeleteEventHook:output_type -> v1.DeleteEventHookResponse
+	357, // 353: v1.SDSController.ListEventHooks:output_type -> v1.ListEventHooksResponse
+	359, // 354: v1.SDSController.TestEventHook:output_type -> v1.TestEventHookResponse
+	278, // 355: v1.SDSController.GetClusterReport:output_type -> v1.GetClusterReportResponse
+	280, // 356: v1.SDSController.GetAlertRules:output_type -> v1.GetAlertRulesResponse
+	284, // 357: v1.SDSController.ListClusters:output_type -> v1.ListClustersResponse
+	288, // 358: v1.SDSController.GetClusterInfo:output_type -> v1.GetClusterInfoResponse
+	290, // 359: v1.SDSController.GetOverview:output_type -> v1.GetOverviewResponse
+	292, // 360: v1.SDSController.Freeze:output_type -> v1.FreezeResponse
+	294, // 361: v1.SDSController.Unfreeze:output_type -> v1.UnfreezeResponse
+	296, // 362: v1.SDSController.GetFreezeStatus:output_type -> v1.GetFreezeStatusResponse
+	299, // 363: v1.SDSController.CollectGarbage:output_type -> v1.CollectGarbageResponse
+	302, // 364: v1.SDSController.Rediscover:output_type -> v1.RediscoverResponse
+	350, // 365: v1.SDSController.SupportBundle:output_type -> v1.SupportBundleChunk
+	305, // 366: v1.SDSController.GetDriftReport:output_type -> v1.GetDriftReportResponse
+	307, // 367: v1.SDSController.Repair:output_type -> v1.RepairResponse
+	311, // 368: v1.SDSController.Rebalance:output_type -> v1.RebalanceResponse
+	324, // 369: v1.SDSController.ListJobs:output_type -> v1.ListJobsResponse
+	326, // 370: v1.SDSController.GetJob:output_type -> v1.GetJobResponse
+	328, // 371: v1.SDSController.ResumeJob:output_type -> v1.ResumeJobResponse
+	330, // 372: v1.SDSController.RollbackJob:output_type -> v1.RollbackJobResponse
+	332, // 373: v1.SDSController.WatchJobProgress:output_type -> v1.JobProgress
+	314, // 374: v1.SDSController.GetDrbdGlobalConfig:output_type -> v1.GetDrbdGlobalConfigResponse
+	316, // 375: v1.SDSController.SetDrbdGlobalConfig:output_type -> v1.SetDrbdGlobalConfigResponse
+	318, // 376: v1.SDSController.ListDrbdGlobalConfigs:output_type -> v1.ListDrbdGlobalConfigsResponse
+	320, // 377: v1.SDSController.RollbackDrbdGlobalConfig:output_type -> v1.RollbackDrbdGlobalConfigResponse
+	335, // 378: v1.SDSController.ProbeNetwork:output_type -> v1.ProbeNetworkResponse
+	337, // 379: v1.SDSController.ListNetProbes:output_type -> v1.ListNetProbesResponse
+	179, // 380: v1.SDSController.CreateSnapshot:output_type -> v1.CreateSnapshotResponse
+	181, // 381: v1.SDSController.DeleteSnapshot:output_type -> v1.DeleteSnapshotResponse
+	183, // 382: v1.SDSController.RestoreSnapshot:output_type -> v1.RestoreSnapshotResponse
+	185, // 383: v1.SDSController.ListSnapshots:output_type -> v1.ListSnapshotsResponse
+	196, // 384: v1.SDSController.GetSnapshotUsage:output_type -> v1.GetSnapshotUsageResponse
+	199, // 385: v1.SDSController.SetSnapshotHook:output_type -> v1.SetSnapshotHookResponse
+	201, // 386: v1.SDSController.DeleteSnapshotHook:output_type -> v1.DeleteSnapshotHookResponse
+	203, // 387: v1.SDSController.ListSnapshotHooks:output_type -> v1.ListSnapshotHooksResponse
+	191, // 388: v1.SDSController.CreateSnapshotGroup:output_type -> v1.SnapshotGroupResponse
+	191, // 389: v1.SDSController.DeleteSnapshotGroup:output_type -> v1.SnapshotGroupResponse
+	191, // 390: v1.SDSController.RestoreSnapshotGroup:output_type -> v1.SnapshotGroupResponse
+	194, // 391: v1.SDSController.ListSnapshotGroups:output_type -> v1.ListSnapshotGroupsResponse
+	206, // 392: v1.SDSController.SetReplicationPolicy:output_type -> v1.SetReplicationPolicyResponse
+	208, // 393: v1.SDSController.DeleteReplicationPolicy:output_type -> v1.DeleteReplicationPolicyResponse
+	210, // 394: v1.SDSController.ListReplicationPolicies:output_type -> v1.ListReplicationPoliciesResponse
+	212, // 395: v1.SDSController.RunReplication:output_type -> v1.RunReplicationResponse
+	215, // 396: v1.SDSController.CreateNFSGateway:output_type -> v1.CreateNFSGatewayResponse
+	217, // 397: v1.SDSController.CreateISCSIGateway:output_type -> v1.CreateISCSIGatewayResponse
+	219, // 398: v1.SDSController.CreateNVMeGateway:output_type -> v1.CreateNVMeGatewayResponse
+	221, // 399: v1.SDSController.DeleteGateway:output_type -> v1.DeleteGatewayResponse
+	223, // 400: v1.SDSController.GetGateway:output_type -> v1.GetGatewayResponse
+	225, // 401: v1.SDSController.ListGateways:output_type -> v1.ListGatewaysResponse
+	227, // 402: v1.SDSController.StartGateway:output_type -> v1.StartGatewayResponse
+	229, // 403: v1.SDSController.StopGateway:output_type -> v1.StopGatewayResponse
+	233, // 404: v1.SDSController.ListGatewayClients:output_type -> v1.ListGatewayClientsResponse
+	236, // 405: v1.SDSController.NVMeConnect:output_type -> v1.NVMeConnectResponse
+	238, // 406: v1.SDSController.NVMeDisconnect:output_type -> v1.NVMeDisconnectResponse
+	241, // 407: v1.SDSController.GetISCSIClientConfig:output_type -> v1.GetISCSIClientConfigResponse
+	243, // 408: v1.SDSController.ValidateISCSIInitiator:output_type -> v1.ValidateISCSIInitiatorResponse
+	245, // 409: v1.SDSController.NFSMount:output_type -> v1.NFSMountResponse
+	15,  // 410: v1.SDSController.CreateZFSPool:output_type -> v1.CreateZFSPoolResponse
+	17,  // 411: v1.SDSController.DeleteZFSPool:output_type -> v1.DeleteZFSPoolResponse
+	19,  // 412: v1.SDSController.ListZFSpools:output_type -> v1.ListZFSPoolsResponse
+	21,  // 413: v1.SDSController.CreateZFSDataset:output_type -> v1.CreateZFSDatasetResponse
+	23,  // 414: v1.SDSController.CreateZFSVolume:output_type -> v1.CreateZFSVolumeResponse
+	25,  // 415: v1.SDSController.ResizeZFSVolume:output_type -> v1.ResizeZFSVolumeResponse
+	27,  // 416: v1.SDSController.DeleteZFSDataset:output_type -> v1.DeleteZFSDatasetResponse
+	29,  // 417: v1.SDSController.CreateZFSSnapshot:output_type -> v1.CreateZFSSnapshotResponse
+	31,  // 418: v1.SDSController.DeleteZFSSnapshot:output_type -> v1.DeleteZFSSnapshotResponse
+	33,  // 419: v1.SDSController.ListZFSSnapshots:output_type -> v1.ListZFSSnapshotsResponse
+	35,  // 420: v1.SDSController.RestoreZFSSnapshot:output_type -> v1.RestoreZFSSnapshotResponse
+	37,  // 421: v1.SDSController.CloneZFSSnapshot:output_type -> v1.CloneZFSSnapshotResponse
+	343, // 422: v1.SDSController.GetSetupStatus:output_type -> v1.GetSetupStatusResponse
+	346, // 423: v1.SDSController.TestNodeConnection:output_type -> v1.TestNodeConnectionResponse
+	348, // 424: v1.SDSController.CompleteSetup:output_type -> v1.CompleteSetupResponse
+	39,  // 425: v1.SDSController.CreateLvmSnapshot:output_type -> v1.CreateLvmSnapshotResponse
+	41,  // 426: v1.SDSController.DeleteLvmSnapshot:output_type -> v1.DeleteLvmSnapshotResponse
+	43,  // 427: v1.SDSController.ListLvmSnapshots:output_type -> v1.ListLvmSnapshotsResponse
+	45,  // 428: v1.SDSController.RestoreLvmSnapshot:output_type -> v1.RestoreLvmSnapshotResponse
+	277, // [277:429] is the sub-list for method output_type
+	125, // [125:277] is the sub-list for method input_type
+	125, // [125:125] is the sub-list for extension type_name
+	125, // [125:125] is the sub-list for extension extendee
+	0,   // [0:125] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_v1_sds_proto_init() }
@@ -7915,7 +25685,7 @@ func file_api_proto_v1_sds_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_v1_sds_proto_rawDesc), len(file_api_proto_v1_sds_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   129,
+			NumMessages:   379,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,62 +19,158 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SDSController_CreatePool_FullMethodName         = "/v1.SDSController/CreatePool"
-	SDSController_DeletePool_FullMethodName         = "/v1.SDSController/DeletePool"
-	SDSController_GetPool_FullMethodName            = "/v1.SDSController/GetPool"
-	SDSController_ListPools_FullMethodName          = "/v1.SDSController/ListPools"
-	SDSController_AddDiskToPool_FullMethodName      = "/v1.SDSController/AddDiskToPool"
-	SDSController_RegisterNode_FullMethodName       = "/v1.SDSController/RegisterNode"
-	SDSController_UnregisterNode_FullMethodName     = "/v1.SDSController/UnregisterNode"
-	SDSController_GetNode_FullMethodName            = "/v1.SDSController/GetNode"
-	SDSController_ListNodes_FullMethodName          = "/v1.SDSController/ListNodes"
-	SDSController_HealthCheck_FullMethodName        = "/v1.SDSController/HealthCheck"
-	SDSController_CreateResource_FullMethodName     = "/v1.SDSController/CreateResource"
-	SDSController_DeleteResource_FullMethodName     = "/v1.SDSController/DeleteResource"
-	SDSController_GetResource_FullMethodName        = "/v1.SDSController/GetResource"
-	SDSController_ListResources_FullMethodName      = "/v1.SDSController/ListResources"
-	SDSController_AddVolume_FullMethodName          = "/v1.SDSController/AddVolume"
-	SDSController_RemoveVolume_FullMethodName       = "/v1.SDSController/RemoveVolume"
-	SDSController_ResizeVolume_FullMethodName       = "/v1.SDSController/ResizeVolume"
-	SDSController_ResourceStatus_FullMethodName     = "/v1.SDSController/ResourceStatus"
-	SDSController_SetPrimary_FullMethodName         = "/v1.SDSController/SetPrimary"
-	SDSController_SetSecondary_FullMethodName       = "/v1.SDSController/SetSecondary"
-	SDSController_CreateFilesystem_FullMethodName   = "/v1.SDSController/CreateFilesystem"
-	SDSController_MountResource_FullMethodName      = "/v1.SDSController/MountResource"
-	SDSController_UnmountResource_FullMethodName    = "/v1.SDSController/UnmountResource"
-	SDSController_MakeHa_FullMethodName             = "/v1.SDSController/MakeHa"
-	SDSController_EvictHa_FullMethodName            = "/v1.SDSController/EvictHa"
-	SDSController_DeleteHa_FullMethodName           = "/v1.SDSController/DeleteHa"
-	SDSController_GetHa_FullMethodName              = "/v1.SDSController/GetHa"
-	SDSController_ListHa_FullMethodName             = "/v1.SDSController/ListHa"
-	SDSController_CreateSnapshot_FullMethodName     = "/v1.SDSController/CreateSnapshot"
-	SDSController_DeleteSnapshot_FullMethodName     = "/v1.SDSController/DeleteSnapshot"
-	SDSController_RestoreSnapshot_FullMethodName    = "/v1.SDSController/RestoreSnapshot"
-	SDSController_ListSnapshots_FullMethodName      = "/v1.SDSController/ListSnapshots"
-	SDSController_CreateNFSGateway_FullMethodName   = "/v1.SDSController/CreateNFSGateway"
-	SDSController_CreateISCSIGateway_FullMethodName = "/v1.SDSController/CreateISCSIGateway"
-	SDSController_CreateNVMeGateway_FullMethodName  = "/v1.SDSController/CreateNVMeGateway"
-	SDSController_DeleteGateway_FullMethodName      = "/v1.SDSController/DeleteGateway"
-	SDSController_GetGateway_FullMethodName         = "/v1.SDSController/GetGateway"
-	SDSController_ListGateways_FullMethodName       = "/v1.SDSController/ListGateways"
-	SDSController_StartGateway_FullMethodName       = "/v1.SDSController/StartGateway"
-	SDSController_StopGateway_FullMethodName        = "/v1.SDSController/StopGateway"
-	SDSController_CreateZFSPool_FullMethodName      = "/v1.SDSController/CreateZFSPool"
-	SDSController_DeleteZFSPool_FullMethodName      = "/v1.SDSController/DeleteZFSPool"
-	SDSController_ListZFSpools_FullMethodName       = "/v1.SDSController/ListZFSpools"
-	SDSController_CreateZFSDataset_FullMethodName   = "/v1.SDSController/CreateZFSDataset"
-	SDSController_CreateZFSVolume_FullMethodName    = "/v1.SDSController/CreateZFSVolume"
-	SDSController_ResizeZFSVolume_FullMethodName    = "/v1.SDSController/ResizeZFSVolume"
-	SDSController_DeleteZFSDataset_FullMethodName   = "/v1.SDSController/DeleteZFSDataset"
-	SDSController_CreateZFSSnapshot_FullMethodName  = "/v1.SDSController/CreateZFSSnapshot"
-	SDSController_DeleteZFSSnapshot_FullMethodName  = "/v1.SDSController/DeleteZFSSnapshot"
-	SDSController_ListZFSSnapshots_FullMethodName   = "/v1.SDSController/ListZFSSnapshots"
-	SDSController_RestoreZFSSnapshot_FullMethodName = "/v1.SDSController/RestoreZFSSnapshot"
-	SDSController_CloneZFSSnapshot_FullMethodName   = "/v1.SDSController/CloneZFSSnapshot"
-	SDSController_CreateLvmSnapshot_FullMethodName  = "/v1.SDSController/CreateLvmSnapshot"
-	SDSController_DeleteLvmSnapshot_FullMethodName  = "/v1.SDSController/DeleteLvmSnapshot"
-	SDSController_ListLvmSnapshots_FullMethodName   = "/v1.SDSController/ListLvmSnapshots"
-	SDSController_RestoreLvmSnapshot_FullMethodName = "/v1.SDSController/RestoreLvmSnapshot"
+	SDSController_CreatePool_FullMethodName               = "/v1.SDSController/CreatePool"
+	SDSController_DeletePool_FullMethodName               = "/v1.SDSController/DeletePool"
+	SDSController_GetPool_FullMethodName                  = "/v1.SDSController/GetPool"
+	SDSController_ListPools_FullMethodName                = "/v1.SDSController/ListPools"
+	SDSController_AddDiskToPool_FullMethodName            = "/v1.SDSController/AddDiskToPool"
+	SDSController_GetPoolHistory_FullMethodName           = "/v1.SDSController/GetPoolHistory"
+	SDSController_RegisterNode_FullMethodName             = "/v1.SDSController/RegisterNode"
+	SDSController_UnregisterNode_FullMethodName           = "/v1.SDSController/UnregisterNode"
+	SDSController_GetNode_FullMethodName                  = "/v1.SDSController/GetNode"
+	SDSController_ListNodes_FullMethodName                = "/v1.SDSController/ListNodes"
+	SDSController_SetNodeAddress_FullMethodName           = "/v1.SDSController/SetNodeAddress"
+	SDSController_TrustNode_FullMethodName                = "/v1.SDSController/TrustNode"
+	SDSController_DiscoverDisks_FullMethodName            = "/v1.SDSController/DiscoverDisks"
+	SDSController_HardenNode_FullMethodName               = "/v1.SDSController/HardenNode"
+	SDSController_SetNodeMaintenance_FullMethodName       = "/v1.SDSController/SetNodeMaintenance"
+	SDSController_ClearNodeMaintenance_FullMethodName     = "/v1.SDSController/ClearNodeMaintenance"
+	SDSController_ListMaintenanceWindows_FullMethodName   = "/v1.SDSController/ListMaintenanceWindows"
+	SDSController_HealthCheck_FullMethodName              = "/v1.SDSController/HealthCheck"
+	SDSController_CheckTime_FullMethodName                = "/v1.SDSController/CheckTime"
+	SDSController_NodeExec_FullMethodName                 = "/v1.SDSController/NodeExec"
+	SDSController_PushFile_FullMethodName                 = "/v1.SDSController/PushFile"
+	SDSController_StreamNodeLogs_FullMethodName           = "/v1.SDSController/StreamNodeLogs"
+	SDSController_CreateResource_FullMethodName           = "/v1.SDSController/CreateResource"
+	SDSController_DeleteResource_FullMethodName           = "/v1.SDSController/DeleteResource"
+	SDSController_SetMaxPeers_FullMethodName              = "/v1.SDSController/SetMaxPeers"
+	SDSController_MigratePool_FullMethodName              = "/v1.SDSController/MigratePool"
+	SDSController_ConvertStorage_FullMethodName           = "/v1.SDSController/ConvertStorage"
+	SDSController_ReplaceDisk_FullMethodName              = "/v1.SDSController/ReplaceDisk"
+	SDSController_StopResource_FullMethodName             = "/v1.SDSController/StopResource"
+	SDSController_StartResource_FullMethodName            = "/v1.SDSController/StartResource"
+	SDSController_PauseSync_FullMethodName                = "/v1.SDSController/PauseSync"
+	SDSController_ResumeSync_FullMethodName               = "/v1.SDSController/ResumeSync"
+	SDSController_SetSyncRate_FullMethodName              = "/v1.SDSController/SetSyncRate"
+	SDSController_ExecFenceTest_FullMethodName            = "/v1.SDSController/ExecFenceTest"
+	SDSController_ActivateResource_FullMethodName         = "/v1.SDSController/ActivateResource"
+	SDSController_DeactivateResource_FullMethodName       = "/v1.SDSController/DeactivateResource"
+	SDSController_FencePeer_FullMethodName                = "/v1.SDSController/FencePeer"
+	SDSController_UnfencePeer_FullMethodName              = "/v1.SDSController/UnfencePeer"
+	SDSController_ReportDrbdEvent_FullMethodName          = "/v1.SDSController/ReportDrbdEvent"
+	SDSController_InstallFenceHandlers_FullMethodName     = "/v1.SDSController/InstallFenceHandlers"
+	SDSController_ListFenceConstraints_FullMethodName     = "/v1.SDSController/ListFenceConstraints"
+	SDSController_GetResource_FullMethodName              = "/v1.SDSController/GetResource"
+	SDSController_ListResources_FullMethodName            = "/v1.SDSController/ListResources"
+	SDSController_AddVolume_FullMethodName                = "/v1.SDSController/AddVolume"
+	SDSController_RemoveVolume_FullMethodName             = "/v1.SDSController/RemoveVolume"
+	SDSController_ResizeVolume_FullMethodName             = "/v1.SDSController/ResizeVolume"
+	SDSController_GetVolume_FullMethodName                = "/v1.SDSController/GetVolume"
+	SDSController_ListVolumes_FullMethodName              = "/v1.SDSController/ListVolumes"
+	SDSController_ResourceStatus_FullMethodName           = "/v1.SDSController/ResourceStatus"
+	SDSController_ExportResource_FullMethodName           = "/v1.SDSController/ExportResource"
+	SDSController_DiffResource_FullMethodName             = "/v1.SDSController/DiffResource"
+	SDSController_GetNodeResourceConfig_FullMethodName    = "/v1.SDSController/GetNodeResourceConfig"
+	SDSController_RenderConfig_FullMethodName             = "/v1.SDSController/RenderConfig"
+	SDSController_SetPrimary_FullMethodName               = "/v1.SDSController/SetPrimary"
+	SDSController_SetSecondary_FullMethodName             = "/v1.SDSController/SetSecondary"
+	SDSController_CreateFilesystem_FullMethodName         = "/v1.SDSController/CreateFilesystem"
+	SDSController_MountResource_FullMethodName            = "/v1.SDSController/MountResource"
+	SDSController_UnmountResource_FullMethodName          = "/v1.SDSController/UnmountResource"
+	SDSController_MakeHa_FullMethodName                   = "/v1.SDSController/MakeHa"
+	SDSController_EvictHa_FullMethodName                  = "/v1.SDSController/EvictHa"
+	SDSController_UpdateHa_FullMethodName                 = "/v1.SDSController/UpdateHa"
+	SDSController_FailoverHa_FullMethodName               = "/v1.SDSController/FailoverHa"
+	SDSController_DeleteHa_FullMethodName                 = "/v1.SDSController/DeleteHa"
+	SDSController_GetHa_FullMethodName                    = "/v1.SDSController/GetHa"
+	SDSController_ListHa_FullMethodName                   = "/v1.SDSController/ListHa"
+	SDSController_GetHaStatus_FullMethodName              = "/v1.SDSController/GetHaStatus"
+	SDSController_ImportPacemakerHa_FullMethodName        = "/v1.SDSController/ImportPacemakerHa"
+	SDSController_ListVIPs_FullMethodName                 = "/v1.SDSController/ListVIPs"
+	SDSController_DrSwitchover_FullMethodName             = "/v1.SDSController/DrSwitchover"
+	SDSController_DrFailback_FullMethodName               = "/v1.SDSController/DrFailback"
+	SDSController_AddPlacementRule_FullMethodName         = "/v1.SDSController/AddPlacementRule"
+	SDSController_DeletePlacementRule_FullMethodName      = "/v1.SDSController/DeletePlacementRule"
+	SDSController_ListPlacementRules_FullMethodName       = "/v1.SDSController/ListPlacementRules"
+	SDSController_ListEvents_FullMethodName               = "/v1.SDSController/ListEvents"
+	SDSController_SetEventHook_FullMethodName             = "/v1.SDSController/SetEventHook"
+	SDSController_DeleteEventHook_FullMethodName          = "/v1.SDSController/DeleteEventHook"
+	SDSController_ListEventHooks_FullMethodName           = "/v1.SDSController/ListEventHooks"
+	SDSController_TestEventHook_FullMethodName            = "/v1.SDSController/TestEventHook"
+	SDSController_GetClusterReport_FullMethodName         = "/v1.SDSController/GetClusterReport"
+	SDSController_GetAlertRules_FullMethodName            = "/v1.SDSController/GetAlertRules"
+	SDSController_ListClusters_FullMethodName             = "/v1.SDSController/ListClusters"
+	SDSController_GetClusterInfo_FullMethodName           = "/v1.SDSController/GetClusterInfo"
+	SDSController_GetOverview_FullMethodName              = "/v1.SDSController/GetOverview"
+	SDSController_Freeze_FullMethodName                   = "/v1.SDSController/Freeze"
+	SDSController_Unfreeze_FullMethodName                 = "/v1.SDSController/Unfreeze"
+	SDSController_GetFreezeStatus_FullMethodName          = "/v1.SDSController/GetFreezeStatus"
+	SDSController_CollectGarbage_FullMethodName           = "/v1.SDSController/CollectGarbage"
+	SDSController_Rediscover_FullMethodName               = "/v1.SDSController/Rediscover"
+	SDSController_SupportBundle_FullMethodName            = "/v1.SDSController/SupportBundle"
+	SDSController_GetDriftReport_FullMethodName           = "/v1.SDSController/GetDriftReport"
+	SDSController_Repair_FullMethodName                   = "/v1.SDSController/Repair"
+	SDSController_Rebalance_FullMethodName                = "/v1.SDSController/Rebalance"
+	SDSController_ListJobs_FullMethodName                 = "/v1.SDSController/ListJobs"
+	SDSController_GetJob_FullMethodName                   = "/v1.SDSController/GetJob"
+	SDSController_ResumeJob_FullMethodName                = "/v1.SDSController/ResumeJob"
+	SDSController_RollbackJob_FullMethodName              = "/v1.SDSController/RollbackJob"
+	SDSController_WatchJobProgress_FullMethodName         = "/v1.SDSController/WatchJobProgress"
+	SDSController_GetDrbdGlobalConfig_FullMethodName      = "/v1.SDSController/GetDrbdGlobalConfig"
+	SDSController_SetDrbdGlobalConfig_FullMethodName      = "/v1.SDSController/SetDrbdGlobalConfig"
+	SDSController_ListDrbdGlobalConfigs_FullMethodName    = "/v1.SDSController/ListDrbdGlobalConfigs"
+	SDSController_RollbackDrbdGlobalConfig_FullMethodName = "/v1.SDSController/RollbackDrbdGlobalConfig"
+	SDSController_ProbeNetwork_FullMethodName             = "/v1.SDSController/ProbeNetwork"
+	SDSController_ListNetProbes_FullMethodName            = "/v1.SDSController/ListNetProbes"
+	SDSController_CreateSnapshot_FullMethodName           = "/v1.SDSController/CreateSnapshot"
+	SDSController_DeleteSnapshot_FullMethodName           = "/v1.SDSController/DeleteSnapshot"
+	SDSController_RestoreSnapshot_FullMethodName          = "/v1.SDSController/RestoreSnapshot"
+	SDSController_ListSnapshots_FullMethodName            = "/v1.SDSController/ListSnapshots"
+	SDSController_GetSnapshotUsage_FullMethodName         = "/v1.SDSController/GetSnapshotUsage"
+	SDSController_SetSnapshotHook_FullMethodName          = "/v1.SDSController/SetSnapshotHook"
+	SDSController_DeleteSnapshotHook_FullMethodName       = "/v1.SDSController/DeleteSnapshotHook"
+	SDSController_ListSnapshotHooks_FullMethodName        = "/v1.SDSController/ListSnapshotHooks"
+	SDSController_CreateSnapshotGroup_FullMethodName      = "/v1.SDSController/CreateSnapshotGroup"
+	SDSController_DeleteSnapshotGroup_FullMethodName      = "/v1.SDSController/DeleteSnapshotGroup"
+	SDSController_RestoreSnapshotGroup_FullMethodName     = "/v1.SDSController/RestoreSnapshotGroup"
+	SDSController_ListSnapshotGroups_FullMethodName       = "/v1.SDSController/ListSnapshotGroups"
+	SDSController_SetReplicationPolicy_FullMethodName     = "/v1.SDSController/SetReplicationPolicy"
+	SDSController_DeleteReplicationPolicy_FullMethodName  = "/v1.SDSController/DeleteReplicationPolicy"
+	SDSController_ListReplicationPolicies_FullMethodName  = "/v1.SDSController/ListReplicationPolicies"
+	SDSController_RunReplication_FullMethodName           = "/v1.SDSController/RunReplication"
+	SDSController_CreateNFSGateway_FullMethodName         = "/v1.SDSController/CreateNFSGateway"
+	SDSController_CreateISCSIGateway_FullMethodName       = "/v1.SDSController/CreateISCSIGateway"
+	SDSController_CreateNVMeGateway_FullMethodName        = "/v1.SDSController/CreateNVMeGateway"
+	SDSController_DeleteGateway_FullMethodName            = "/v1.SDSController/DeleteGateway"
+	SDSController_GetGateway_FullMethodName               = "/v1.SDSController/GetGateway"
+	SDSController_ListGateways_FullMethodName             = "/v1.SDSController/ListGateways"
+	SDSController_StartGateway_FullMethodName             = "/v1.SDSController/StartGateway"
+	SDSController_StopGateway_FullMethodName              = "/v1.SDSController/StopGateway"
+	SDSController_ListGatewayClients_FullMethodName       = "/v1.SDSController/ListGatewayClients"
+	SDSController_NVMeConnect_FullMethodName              = "/v1.SDSController/NVMeConnect"
+	SDSController_NVMeDisconnect_FullMethodName           = "/v1.SDSController/NVMeDisconnect"
+	SDSController_GetISCSIClientConfig_FullMethodName     = "/v1.SDSController/GetISCSIClientConfig"
+	SDSController_ValidateISCSIInitiator_FullMethodName   = "/v1.SDSController/ValidateISCSIInitiator"
+	SDSController_NFSMount_FullMethodName                 = "/v1.SDSController/NFSMount"
+	SDSController_CreateZFSPool_FullMethodName            = "/v1.SDSController/CreateZFSPool"
+	SDSController_DeleteZFSPool_FullMethodName            = "/v1.SDSController/DeleteZFSPool"
+	SDSController_ListZFSpools_FullMethodName             = "/v1.SDSController/ListZFSpools"
+	SDSController_CreateZFSDataset_FullMethodName         = "/v1.SDSController/CreateZFSDataset"
+	SDSController_CreateZFSVolume_FullMethodName          = "/v1.SDSController/CreateZFSVolume"
+	SDSController_ResizeZFSVolume_FullMethodName          = "/v1.SDSController/ResizeZFSVolume"
+	SDSController_DeleteZFSDataset_FullMethodName         = "/v1.SDSController/DeleteZFSDataset"
+	SDSController_CreateZFSSnapshot_FullMethodName        = "/v1.SDSController/CreateZFSSnapshot"
+	SDSController_DeleteZFSSnapshot_FullMethodName        = "/v1.SDSController/DeleteZFSSnapshot"
+	SDSController_ListZFSSnapshots_FullMethodName         = "/v1.SDSController/ListZFSSnapshots"
+	SDSController_RestoreZFSSnapshot_FullMethodName       = "/v1.SDSController/RestoreZFSSnapshot"
+	SDSController_CloneZFSSnapshot_FullMethodName         = "/v1.SDSController/CloneZFSSnapshot"
+	SDSController_GetSetupStatus_FullMethodName           = "/v1.SDSController/GetSetupStatus"
+	SDSController_TestNodeConnection_FullMethodName       = "/v1.SDSController/TestNodeConnection"
+	SDSController_CompleteSetup_FullMethodName            = "/v1.SDSController/CompleteSetup"
+	SDSController_CreateLvmSnapshot_FullMethodName        = "/v1.SDSController/CreateLvmSnapshot"
+	SDSController_DeleteLvmSnapshot_FullMethodName        = "/v1.SDSController/DeleteLvmSnapshot"
+	SDSController_ListLvmSnapshots_FullMethodName         = "/v1.SDSController/ListLvmSnapshots"
+	SDSController_RestoreLvmSnapshot_FullMethodName       = "/v1.SDSController/RestoreLvmSnapshot"
 )
 
 // SDSControllerClient is the client API for SDSController service.
@@ -89,21 +185,64 @@ type SDSControllerClient interface {
 	GetPool(ctx context.Context, in *GetPoolRequest, opts ...grpc.CallOption) (*GetPoolResponse, error)
 	ListPools(ctx context.Context, in *ListPoolsRequest, opts ...grpc.CallOption) (*ListPoolsResponse, error)
 	AddDiskToPool(ctx context.Context, in *AddDiskToPoolRequest, opts ...grpc.CallOption) (*AddDiskToPoolResponse, error)
+	GetPoolHistory(ctx context.Context, in *GetPoolHistoryRequest, opts ...grpc.CallOption) (*GetPoolHistoryResponse, error)
 	// Node operations
 	RegisterNode(ctx context.Context, in *RegisterNodeRequest, opts ...grpc.CallOption) (*RegisterNodeResponse, error)
 	UnregisterNode(ctx context.Context, in *UnregisterNodeRequest, opts ...grpc.CallOption) (*UnregisterNodeResponse, error)
 	GetNode(ctx context.Context, in *GetNodeRequest, opts ...grpc.CallOption) (*GetNodeResponse, error)
 	ListNodes(ctx context.Context, in *ListNodesRequest, opts ...grpc.CallOption) (*ListNodesResponse, error)
+	SetNodeAddress(ctx context.Context, in *SetNodeAddressRequest, opts ...grpc.CallOption) (*SetNodeAddressResponse, error)
+	TrustNode(ctx context.Context, in *TrustNodeRequest, opts ...grpc.CallOption) (*TrustNodeResponse, error)
+	DiscoverDisks(ctx context.Context, in *DiscoverDisksRequest, opts ...grpc.CallOption) (*DiscoverDisksResponse, error)
+	HardenNode(ctx context.Context, in *HardenNodeRequest, opts ...grpc.CallOption) (*HardenNodeResponse, error)
+	SetNodeMaintenance(ctx context.Context, in *SetNodeMaintenanceRequest, opts ...grpc.CallOption) (*SetNodeMaintenanceResponse, error)
+	ClearNodeMaintenance(ctx context.Context, in *ClearNodeMaintenanceRequest, opts ...grpc.CallOption) (*ClearNodeMaintenanceResponse, error)
+	ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error)
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+	// Compares the clocks of the nodes with the controller's
+	CheckTime(ctx context.Context, in *CheckTimeRequest, opts ...grpc.CallOption) (*CheckTimeResponse, error)
+	// Admin only: runs an ad-hoc command, requires the admin token and admin.exec
+	NodeExec(ctx context.Context, in *NodeExecRequest, opts ...grpc.CallOption) (*NodeExecResponse, error)
+	// Admin only: writes a file to nodes and verifies its checksum
+	PushFile(ctx context.Context, in *PushFileRequest, opts ...grpc.CallOption) (*PushFileResponse, error)
+	// Streams the journal of drbd-reactor, the DRBD kernel messages or a
+	// gateway on a node
+	StreamNodeLogs(ctx context.Context, in *StreamNodeLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NodeLogLine], error)
 	// Resource operations
 	CreateResource(ctx context.Context, in *CreateResourceRequest, opts ...grpc.CallOption) (*CreateResourceResponse, error)
 	DeleteResource(ctx context.Context, in *DeleteResourceRequest, opts ...grpc.CallOption) (*DeleteResourceResponse, error)
+	SetMaxPeers(ctx context.Context, in *SetMaxPeersRequest, opts ...grpc.CallOption) (*SetMaxPeersResponse, error)
+	MigratePool(ctx context.Context, in *MigratePoolRequest, opts ...grpc.CallOption) (*MigratePoolResponse, error)
+	ConvertStorage(ctx context.Context, in *ConvertStorageRequest, opts ...grpc.CallOption) (*ConvertStorageResponse, error)
+	ReplaceDisk(ctx context.Context, in *ReplaceDiskRequest, opts ...grpc.CallOption) (*ReplaceDiskResponse, error)
+	// Takes a resource down everywhere and keeps it down until it is started
+	StopResource(ctx context.Context, in *StopResourceRequest, opts ...grpc.CallOption) (*StopResourceResponse, error)
+	StartResource(ctx context.Context, in *StartResourceRequest, opts ...grpc.CallOption) (*StartResourceResponse, error)
+	// Pause or resume the resync of a resource, or of its connections to a peer
+	PauseSync(ctx context.Context, in *PauseSyncRequest, opts ...grpc.CallOption) (*PauseSyncResponse, error)
+	ResumeSync(ctx context.Context, in *ResumeSyncRequest, opts ...grpc.CallOption) (*ResumeSyncResponse, error)
+	// Overrides the resync rate of a resource for a while, or reverts it
+	SetSyncRate(ctx context.Context, in *SetSyncRateRequest, opts ...grpc.CallOption) (*SetSyncRateResponse, error)
+	ExecFenceTest(ctx context.Context, in *ExecFenceTestRequest, opts ...grpc.CallOption) (*ExecFenceTestResponse, error)
+	ActivateResource(ctx context.Context, in *ActivateResourceRequest, opts ...grpc.CallOption) (*ActivateResourceResponse, error)
+	DeactivateResource(ctx context.Context, in *DeactivateResourceRequest, opts ...grpc.CallOption) (*DeactivateResourceResponse, error)
+	FencePeer(ctx context.Context, in *FencePeerRequest, opts ...grpc.CallOption) (*FencePeerResponse, error)
+	UnfencePeer(ctx context.Context, in *UnfencePeerRequest, opts ...grpc.CallOption) (*UnfencePeerResponse, error)
+	ReportDrbdEvent(ctx context.Context, in *ReportDrbdEventRequest, opts ...grpc.CallOption) (*ReportDrbdEventResponse, error)
+	InstallFenceHandlers(ctx context.Context, in *InstallFenceHandlersRequest, opts ...grpc.CallOption) (*InstallFenceHandlersResponse, error)
+	ListFenceConstraints(ctx context.Context, in *ListFenceConstraintsRequest, opts ...grpc.CallOption) (*ListFenceConstraintsResponse, error)
 	GetResource(ctx context.Context, in *GetResourceRequest, opts ...grpc.CallOption) (*GetResourceResponse, error)
 	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error)
 	AddVolume(ctx context.Context, in *AddVolumeRequest, opts ...grpc.CallOption) (*AddVolumeResponse, error)
 	RemoveVolume(ctx context.Context, in *RemoveVolumeRequest, opts ...grpc.CallOption) (*RemoveVolumeResponse, error)
 	ResizeVolume(ctx context.Context, in *ResizeVolumeRequest, opts ...grpc.CallOption) (*ResizeVolumeResponse, error)
+	GetVolume(ctx context.Context, in *GetVolumeRequest, opts ...grpc.CallOption) (*GetVolumeResponse, error)
+	ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error)
 	ResourceStatus(ctx context.Context, in *ResourceStatusRequest, opts ...grpc.CallOption) (*ResourceStatusResponse, error)
+	ExportResource(ctx context.Context, in *ExportResourceRequest, opts ...grpc.CallOption) (*ExportResourceResponse, error)
+	DiffResource(ctx context.Context, in *DiffResourceRequest, opts ...grpc.CallOption) (*DiffResourceResponse, error)
+	GetNodeResourceConfig(ctx context.Context, in *GetNodeResourceConfigRequest, opts ...grpc.CallOption) (*GetNodeResourceConfigResponse, error)
+	RenderConfig(ctx context.Context, in *RenderConfigRequest, opts ...grpc.CallOption) (*RenderConfigResponse, error)
 	SetPrimary(ctx context.Context, in *SetPrimaryRequest, opts ...grpc.CallOption) (*SetPrimaryResponse, error)
 	SetSecondary(ctx context.Context, in *SetSecondaryRequest, opts ...grpc.CallOption) (*SetSecondaryResponse, error)
 	CreateFilesystem(ctx context.Context, in *CreateFilesystemRequest, opts ...grpc.CallOption) (*CreateFilesystemResponse, error)
@@ -111,14 +250,88 @@ type SDSControllerClient interface {
 	UnmountResource(ctx context.Context, in *UnmountResourceRequest, opts ...grpc.CallOption) (*UnmountResourceResponse, error)
 	MakeHa(ctx context.Context, in *MakeHaRequest, opts ...grpc.CallOption) (*MakeHaResponse, error)
 	EvictHa(ctx context.Context, in *EvictHaRequest, opts ...grpc.CallOption) (*EvictHaResponse, error)
+	UpdateHa(ctx context.Context, in *UpdateHaRequest, opts ...grpc.CallOption) (*UpdateHaResponse, error)
+	FailoverHa(ctx context.Context, in *FailoverHaRequest, opts ...grpc.CallOption) (*FailoverHaResponse, error)
 	DeleteHa(ctx context.Context, in *DeleteHaRequest, opts ...grpc.CallOption) (*DeleteHaResponse, error)
 	GetHa(ctx context.Context, in *GetHaRequest, opts ...grpc.CallOption) (*GetHaResponse, error)
 	ListHa(ctx context.Context, in *ListHaRequest, opts ...grpc.CallOption) (*ListHaResponse, error)
-	// Snapshot operations
+	GetHaStatus(ctx context.Context, in *GetHaStatusRequest, opts ...grpc.CallOption) (*GetHaStatusResponse, error)
+	ImportPacemakerHa(ctx context.Context, in *ImportPacemakerHaRequest, opts ...grpc.CallOption) (*ImportPacemakerHaResponse, error)
+	ListVIPs(ctx context.Context, in *ListVIPsRequest, opts ...grpc.CallOption) (*ListVIPsResponse, error)
+	// Disaster recovery operations
+	DrSwitchover(ctx context.Context, in *DrSwitchoverRequest, opts ...grpc.CallOption) (*DrSwitchoverResponse, error)
+	DrFailback(ctx context.Context, in *DrFailbackRequest, opts ...grpc.CallOption) (*DrFailbackResponse, error)
+	// Placement rules
+	AddPlacementRule(ctx context.Context, in *AddPlacementRuleRequest, opts ...grpc.CallOption) (*AddPlacementRuleResponse, error)
+	DeletePlacementRule(ctx context.Context, in *DeletePlacementRuleRequest, opts ...grpc.CallOption) (*DeletePlacementRuleResponse, error)
+	ListPlacementRules(ctx context.Context, in *ListPlacementRulesRequest, opts ...grpc.CallOption) (*ListPlacementRulesResponse, error)
+	// Events log
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	// Hooks notifying external systems of events. Setting, deleting and
+	// testing a hook is admin only.
+	SetEventHook(ctx context.Context, in *SetEventHookRequest, opts ...grpc.CallOption) (*SetEventHookResponse, error)
+	DeleteEventHook(ctx context.Context, in *DeleteEventHookRequest, opts ...grpc.CallOption) (*DeleteEventHookResponse, error)
+	ListEventHooks(ctx context.Context, in *ListEventHooksRequest, opts ...grpc.CallOption) (*ListEventHooksResponse, error)
+	TestEventHook(ctx context.Context, in *TestEventHookRequest, opts ...grpc.CallOption) (*TestEventHookResponse, error)
+	// Cluster report (capacity, failovers, resyncs, snapshots, alerts over a period)
+	GetClusterReport(ctx context.Context, in *GetClusterReportRequest, opts ...grpc.CallOption) (*GetClusterReportResponse, error)
+	GetAlertRules(ctx context.Context, in *GetAlertRulesRequest, opts ...grpc.CallOption) (*GetAlertRulesResponse, error)
+	// Clusters served by the controller, select one with the x-sds-cluster header
+	ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error)
+	// Controller build and node component versions, for support and bug reports
+	GetClusterInfo(ctx context.Context, in *GetClusterInfoRequest, opts ...grpc.CallOption) (*GetClusterInfoResponse, error)
+	// Nodes, pools, resources, HA, gateways and recent events in one call for
+	// dashboards, from what the controller stored and polled last. Served
+	// with an ETag over REST.
+	GetOverview(ctx context.Context, in *GetOverviewRequest, opts ...grpc.CallOption) (*GetOverviewResponse, error)
+	// Admin operations
+	Freeze(ctx context.Context, in *FreezeRequest, opts ...grpc.CallOption) (*FreezeResponse, error)
+	Unfreeze(ctx context.Context, in *UnfreezeRequest, opts ...grpc.CallOption) (*UnfreezeResponse, error)
+	GetFreezeStatus(ctx context.Context, in *GetFreezeStatusRequest, opts ...grpc.CallOption) (*GetFreezeStatusResponse, error)
+	CollectGarbage(ctx context.Context, in *CollectGarbageRequest, opts ...grpc.CallOption) (*CollectGarbageResponse, error)
+	Rediscover(ctx context.Context, in *RediscoverRequest, opts ...grpc.CallOption) (*RediscoverResponse, error)
+	// Admin only: streams a gzipped tarball of logs, a redacted database dump,
+	// job transcripts and the DRBD and drbd-reactor state of the nodes
+	SupportBundle(ctx context.Context, in *SupportBundleRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SupportBundleChunk], error)
+	// Reconcile operations (database records vs. state on the nodes)
+	GetDriftReport(ctx context.Context, in *GetDriftReportRequest, opts ...grpc.CallOption) (*GetDriftReportResponse, error)
+	Repair(ctx context.Context, in *RepairRequest, opts ...grpc.CallOption) (*RepairResponse, error)
+	// Rebalance operations (Primary placement across nodes)
+	Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalanceResponse, error)
+	// Jobs (records of mutating operations with per-step timing)
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
+	ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*ResumeJobResponse, error)
+	RollbackJob(ctx context.Context, in *RollbackJobRequest, opts ...grpc.CallOption) (*RollbackJobResponse, error)
+	// Progress of the job of a call sent with the x-sds-progress-id header
+	WatchJobProgress(ctx context.Context, in *WatchJobProgressRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[JobProgress], error)
+	// DRBD global config (versioned /etc/drbd.d/global_common.conf on all nodes)
+	GetDrbdGlobalConfig(ctx context.Context, in *GetDrbdGlobalConfigRequest, opts ...grpc.CallOption) (*GetDrbdGlobalConfigResponse, error)
+	SetDrbdGlobalConfig(ctx context.Context, in *SetDrbdGlobalConfigRequest, opts ...grpc.CallOption) (*SetDrbdGlobalConfigResponse, error)
+	ListDrbdGlobalConfigs(ctx context.Context, in *ListDrbdGlobalConfigsRequest, opts ...grpc.CallOption) (*ListDrbdGlobalConfigsResponse, error)
+	RollbackDrbdGlobalConfig(ctx context.Context, in *RollbackDrbdGlobalConfigRequest, opts ...grpc.CallOption) (*RollbackDrbdGlobalConfigResponse, error)
+	// Replication network probe (latency and throughput between nodes)
+	ProbeNetwork(ctx context.Context, in *ProbeNetworkRequest, opts ...grpc.CallOption) (*ProbeNetworkResponse, error)
+	ListNetProbes(ctx context.Context, in *ListNetProbesRequest, opts ...grpc.CallOption) (*ListNetProbesResponse, error)
+	// Snapshot operations (LVM or ZFS, detected from the resource)
 	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error)
 	DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotResponse, error)
 	RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error)
 	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	GetSnapshotUsage(ctx context.Context, in *GetSnapshotUsageRequest, opts ...grpc.CallOption) (*GetSnapshotUsageResponse, error)
+	SetSnapshotHook(ctx context.Context, in *SetSnapshotHookRequest, opts ...grpc.CallOption) (*SetSnapshotHookResponse, error)
+	DeleteSnapshotHook(ctx context.Context, in *DeleteSnapshotHookRequest, opts ...grpc.CallOption) (*DeleteSnapshotHookResponse, error)
+	ListSnapshotHooks(ctx context.Context, in *ListSnapshotHooksRequest, opts ...grpc.CallOption) (*ListSnapshotHooksResponse, error)
+	// Snapshots of several resources taken, deleted and restored together
+	// under a label, one job per call
+	CreateSnapshotGroup(ctx context.Context, in *CreateSnapshotGroupRequest, opts ...grpc.CallOption) (*SnapshotGroupResponse, error)
+	DeleteSnapshotGroup(ctx context.Context, in *DeleteSnapshotGroupRequest, opts ...grpc.CallOption) (*SnapshotGroupResponse, error)
+	RestoreSnapshotGroup(ctx context.Context, in *RestoreSnapshotGroupRequest, opts ...grpc.CallOption) (*SnapshotGroupResponse, error)
+	ListSnapshotGroups(ctx context.Context, in *ListSnapshotGroupsRequest, opts ...grpc.CallOption) (*ListSnapshotGroupsResponse, error)
+	SetReplicationPolicy(ctx context.Context, in *SetReplicationPolicyRequest, opts ...grpc.CallOption) (*SetReplicationPolicyResponse, error)
+	DeleteReplicationPolicy(ctx context.Context, in *DeleteReplicationPolicyRequest, opts ...grpc.CallOption) (*DeleteReplicationPolicyResponse, error)
+	ListReplicationPolicies(ctx context.Context, in *ListReplicationPoliciesRequest, opts ...grpc.CallOption) (*ListReplicationPoliciesResponse, error)
+	RunReplication(ctx context.Context, in *RunReplicationRequest, opts ...grpc.CallOption) (*RunReplicationResponse, error)
 	// Gateway operations
 	CreateNFSGateway(ctx context.Context, in *CreateNFSGatewayRequest, opts ...grpc.CallOption) (*CreateNFSGatewayResponse, error)
 	CreateISCSIGateway(ctx context.Context, in *CreateISCSIGatewayRequest, opts ...grpc.CallOption) (*CreateISCSIGatewayResponse, error)
@@ -128,6 +341,13 @@ type SDSControllerClient interface {
 	ListGateways(ctx context.Context, in *ListGatewaysRequest, opts ...grpc.CallOption) (*ListGatewaysResponse, error)
 	StartGateway(ctx context.Context, in *StartGatewayRequest, opts ...grpc.CallOption) (*StartGatewayResponse, error)
 	StopGateway(ctx context.Context, in *StopGatewayRequest, opts ...grpc.CallOption) (*StopGatewayResponse, error)
+	ListGatewayClients(ctx context.Context, in *ListGatewayClientsRequest, opts ...grpc.CallOption) (*ListGatewayClientsResponse, error)
+	// Client (initiator) helpers, executed on a registered client node
+	NVMeConnect(ctx context.Context, in *NVMeConnectRequest, opts ...grpc.CallOption) (*NVMeConnectResponse, error)
+	NVMeDisconnect(ctx context.Context, in *NVMeDisconnectRequest, opts ...grpc.CallOption) (*NVMeDisconnectResponse, error)
+	GetISCSIClientConfig(ctx context.Context, in *GetISCSIClientConfigRequest, opts ...grpc.CallOption) (*GetISCSIClientConfigResponse, error)
+	ValidateISCSIInitiator(ctx context.Context, in *ValidateISCSIInitiatorRequest, opts ...grpc.CallOption) (*ValidateISCSIInitiatorResponse, error)
+	NFSMount(ctx context.Context, in *NFSMountRequest, opts ...grpc.CallOption) (*NFSMountResponse, error)
 	// ZFS operations
 	CreateZFSPool(ctx context.Context, in *CreateZFSPoolRequest, opts ...grpc.CallOption) (*CreateZFSPoolResponse, error)
 	DeleteZFSPool(ctx context.Context, in *DeleteZFSPoolRequest, opts ...grpc.CallOption) (*DeleteZFSPoolResponse, error)
@@ -136,16 +356,30 @@ type SDSControllerClient interface {
 	CreateZFSVolume(ctx context.Context, in *CreateZFSVolumeRequest, opts ...grpc.CallOption) (*CreateZFSVolumeResponse, error)
 	ResizeZFSVolume(ctx context.Context, in *ResizeZFSVolumeRequest, opts ...grpc.CallOption) (*ResizeZFSVolumeResponse, error)
 	DeleteZFSDataset(ctx context.Context, in *DeleteZFSDatasetRequest, opts ...grpc.CallOption) (*DeleteZFSDatasetResponse, error)
+	// Deprecated: Do not use.
 	// ZFS Snapshot operations
+	// Deprecated: use the generic snapshot operations, which detect the backend
 	CreateZFSSnapshot(ctx context.Context, in *CreateZFSSnapshotRequest, opts ...grpc.CallOption) (*CreateZFSSnapshotResponse, error)
+	// Deprecated: Do not use.
 	DeleteZFSSnapshot(ctx context.Context, in *DeleteZFSSnapshotRequest, opts ...grpc.CallOption) (*DeleteZFSSnapshotResponse, error)
+	// Deprecated: Do not use.
 	ListZFSSnapshots(ctx context.Context, in *ListZFSSnapshotsRequest, opts ...grpc.CallOption) (*ListZFSSnapshotsResponse, error)
+	// Deprecated: Do not use.
 	RestoreZFSSnapshot(ctx context.Context, in *RestoreZFSSnapshotRequest, opts ...grpc.CallOption) (*RestoreZFSSnapshotResponse, error)
 	CloneZFSSnapshot(ctx context.Context, in *CloneZFSSnapshotRequest, opts ...grpc.CallOption) (*CloneZFSSnapshotResponse, error)
+	// First boot setup
+	GetSetupStatus(ctx context.Context, in *GetSetupStatusRequest, opts ...grpc.CallOption) (*GetSetupStatusResponse, error)
+	TestNodeConnection(ctx context.Context, in *TestNodeConnectionRequest, opts ...grpc.CallOption) (*TestNodeConnectionResponse, error)
+	CompleteSetup(ctx context.Context, in *CompleteSetupRequest, opts ...grpc.CallOption) (*CompleteSetupResponse, error)
+	// Deprecated: Do not use.
 	// LVM Snapshot operations
+	// Deprecated: use the generic snapshot operations, which detect the backend
 	CreateLvmSnapshot(ctx context.Context, in *CreateLvmSnapshotRequest, opts ...grpc.CallOption) (*CreateLvmSnapshotResponse, error)
+	// Deprecated: Do not use.
 	DeleteLvmSnapshot(ctx context.Context, in *DeleteLvmSnapshotRequest, opts ...grpc.CallOption) (*DeleteLvmSnapshotResponse, error)
+	// Deprecated: Do not use.
 	ListLvmSnapshots(ctx context.Context, in *ListLvmSnapshotsRequest, opts ...grpc.CallOption) (*ListLvmSnapshotsResponse, error)
+	// Deprecated: Do not use.
 	RestoreLvmSnapshot(ctx context.Context, in *RestoreLvmSnapshotRequest, opts ...grpc.CallOption) (*RestoreLvmSnapshotResponse, error)
 }
 
@@ -207,6 +441,16 @@ func (c *sDSControllerClient) AddDiskToPool(ctx context.Context, in *AddDiskToPo
 	return out, nil
 }
 
+func (c *sDSControllerClient) GetPoolHistory(ctx context.Context, in *GetPoolHistoryRequest, opts ...grpc.CallOption) (*GetPoolHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPoolHistoryResponse)
+	err := c.cc.Invoke(ctx, SDSController_GetPoolHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sDSControllerClient) RegisterNode(ctx context.Context, in *RegisterNodeRequest, opts ...grpc.CallOption) (*RegisterNodeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RegisterNodeResponse)
@@ -247,6 +491,76 @@ func (c *sDSControllerClient) ListNodes(ctx context.Context, in *ListNodesReques
 	return out, nil
 }
 
+func (c *sDSControllerClient) SetNodeAddress(ctx context.Context, in *SetNodeAddressRequest, opts ...grpc.CallOption) (*SetNodeAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetNodeAddressResponse)
+	err := c.cc.Invoke(ctx, SDSController_SetNodeAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) TrustNode(ctx context.Context, in *TrustNodeRequest, opts ...grpc.CallOption) (*TrustNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrustNodeResponse)
+	err := c.cc.Invoke(ctx, SDSController_TrustNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) DiscoverDisks(ctx context.Context, in *DiscoverDisksRequest, opts ...grpc.CallOption) (*DiscoverDisksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscoverDisksResponse)
+	err := c.cc.Invoke(ctx, SDSController_DiscoverDisks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) HardenNode(ctx context.Context, in *HardenNodeRequest, opts ...grpc.CallOption) (*HardenNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HardenNodeResponse)
+	err := c.cc.Invoke(ctx, SDSController_HardenNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) SetNodeMaintenance(ctx context.Context, in *SetNodeMaintenanceRequest, opts ...grpc.CallOption) (*SetNodeMaintenanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetNodeMaintenanceResponse)
+	err := c.cc.Invoke(ctx, SDSController_SetNodeMaintenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) ClearNodeMaintenance(ctx context.Context, in *ClearNodeMaintenanceRequest, opts ...grpc.CallOption) (*ClearNodeMaintenanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearNodeMaintenanceResponse)
+	err := c.cc.Invoke(ctx, SDSController_ClearNodeMaintenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMaintenanceWindowsResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListMaintenanceWindows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sDSControllerClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HealthCheckResponse)
@@ -257,1451 +571,4445 @@ func (c *sDSControllerClient) HealthCheck(ctx context.Context, in *HealthCheckRe
 	return out, nil
 }
 
-func (c *sDSControllerClient) CreateResource(ctx context.Context, in *CreateResourceRequest, opts ...grpc.CallOption) (*CreateResourceResponse, error) {
+func (c *sDSControllerClient) CheckTime(ctx context.Context, in *CheckTimeRequest, opts ...grpc.CallOption) (*CheckTimeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateResourceResponse)
-	err := c.cc.Invoke(ctx, SDSController_CreateResource_FullMethodName, in, out, cOpts...)
+	out := new(CheckTimeResponse)
+	err := c.cc.Invoke(ctx, SDSController_CheckTime_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) DeleteResource(ctx context.Context, in *DeleteResourceRequest, opts ...grpc.CallOption) (*DeleteResourceResponse, error) {
+func (c *sDSControllerClient) NodeExec(ctx context.Context, in *NodeExecRequest, opts ...grpc.CallOption) (*NodeExecResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteResourceResponse)
-	err := c.cc.Invoke(ctx, SDSController_DeleteResource_FullMethodName, in, out, cOpts...)
+	out := new(NodeExecResponse)
+	err := c.cc.Invoke(ctx, SDSController_NodeExec_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) GetResource(ctx context.Context, in *GetResourceRequest, opts ...grpc.CallOption) (*GetResourceResponse, error) {
+func (c *sDSControllerClient) PushFile(ctx context.Context, in *PushFileRequest, opts ...grpc.CallOption) (*PushFileResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetResourceResponse)
-	err := c.cc.Invoke(ctx, SDSController_GetResource_FullMethodName, in, out, cOpts...)
+	out := new(PushFileResponse)
+	err := c.cc.Invoke(ctx, SDSController_PushFile_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error) {
+func (c *sDSControllerClient) StreamNodeLogs(ctx context.Context, in *StreamNodeLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NodeLogLine], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListResourcesResponse)
-	err := c.cc.Invoke(ctx, SDSController_ListResources_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SDSController_ServiceDesc.Streams[0], SDSController_StreamNodeLogs_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[StreamNodeLogsRequest, NodeLogLine]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *sDSControllerClient) AddVolume(ctx context.Context, in *AddVolumeRequest, opts ...grpc.CallOption) (*AddVolumeResponse, error) {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SDSController_StreamNodeLogsClient = grpc.ServerStreamingClient[NodeLogLine]
+
+func (c *sDSControllerClient) CreateResource(ctx context.Context, in *CreateResourceRequest, opts ...grpc.CallOption) (*CreateResourceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AddVolumeResponse)
-	err := c.cc.Invoke(ctx, SDSController_AddVolume_FullMethodName, in, out, cOpts...)
+	out := new(CreateResourceResponse)
+	err := c.cc.Invoke(ctx, SDSController_CreateResource_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) RemoveVolume(ctx context.Context, in *RemoveVolumeRequest, opts ...grpc.CallOption) (*RemoveVolumeResponse, error) {
+func (c *sDSControllerClient) DeleteResource(ctx context.Context, in *DeleteResourceRequest, opts ...grpc.CallOption) (*DeleteResourceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RemoveVolumeResponse)
-	err := c.cc.Invoke(ctx, SDSController_RemoveVolume_FullMethodName, in, out, cOpts...)
+	out := new(DeleteResourceResponse)
+	err := c.cc.Invoke(ctx, SDSController_DeleteResource_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) ResizeVolume(ctx context.Context, in *ResizeVolumeRequest, opts ...grpc.CallOption) (*ResizeVolumeResponse, error) {
+func (c *sDSControllerClient) SetMaxPeers(ctx context.Context, in *SetMaxPeersRequest, opts ...grpc.CallOption) (*SetMaxPeersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ResizeVolumeResponse)
-	err := c.cc.Invoke(ctx, SDSController_ResizeVolume_FullMethodName, in, out, cOpts...)
+	out := new(SetMaxPeersResponse)
+	err := c.cc.Invoke(ctx, SDSController_SetMaxPeers_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) ResourceStatus(ctx context.Context, in *ResourceStatusRequest, opts ...grpc.CallOption) (*ResourceStatusResponse, error) {
+func (c *sDSControllerClient) MigratePool(ctx context.Context, in *MigratePoolRequest, opts ...grpc.CallOption) (*MigratePoolResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ResourceStatusResponse)
-	err := c.cc.Invoke(ctx, SDSController_ResourceStatus_FullMethodName, in, out, cOpts...)
+	out := new(MigratePoolResponse)
+	err := c.cc.Invoke(ctx, SDSController_MigratePool_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) SetPrimary(ctx context.Context, in *SetPrimaryRequest, opts ...grpc.CallOption) (*SetPrimaryResponse, error) {
+func (c *sDSControllerClient) ConvertStorage(ctx context.Context, in *ConvertStorageRequest, opts ...grpc.CallOption) (*ConvertStorageResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SetPrimaryResponse)
-	err := c.cc.Invoke(ctx, SDSController_SetPrimary_FullMethodName, in, out, cOpts...)
+	out := new(ConvertStorageResponse)
+	err := c.cc.Invoke(ctx, SDSController_ConvertStorage_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) SetSecondary(ctx context.Context, in *SetSecondaryRequest, opts ...grpc.CallOption) (*SetSecondaryResponse, error) {
+func (c *sDSControllerClient) ReplaceDisk(ctx context.Context, in *ReplaceDiskRequest, opts ...grpc.CallOption) (*ReplaceDiskResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SetSecondaryResponse)
-	err := c.cc.Invoke(ctx, SDSController_SetSecondary_FullMethodName, in, out, cOpts...)
+	out := new(ReplaceDiskResponse)
+	err := c.cc.Invoke(ctx, SDSController_ReplaceDisk_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) CreateFilesystem(ctx context.Context, in *CreateFilesystemRequest, opts ...grpc.CallOption) (*CreateFilesystemResponse, error) {
+func (c *sDSControllerClient) StopResource(ctx context.Context, in *StopResourceRequest, opts ...grpc.CallOption) (*StopResourceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateFilesystemResponse)
-	err := c.cc.Invoke(ctx, SDSController_CreateFilesystem_FullMethodName, in, out, cOpts...)
+	out := new(StopResourceResponse)
+	err := c.cc.Invoke(ctx, SDSController_StopResource_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) MountResource(ctx context.Context, in *MountResourceRequest, opts ...grpc.CallOption) (*MountResourceResponse, error) {
+func (c *sDSControllerClient) StartResource(ctx context.Context, in *StartResourceRequest, opts ...grpc.CallOption) (*StartResourceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(MountResourceResponse)
-	err := c.cc.Invoke(ctx, SDSController_MountResource_FullMethodName, in, out, cOpts...)
+	out := new(StartResourceResponse)
+	err := c.cc.Invoke(ctx, SDSController_StartResource_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) UnmountResource(ctx context.Context, in *UnmountResourceRequest, opts ...grpc.CallOption) (*UnmountResourceResponse, error) {
+func (c *sDSControllerClient) PauseSync(ctx context.Context, in *PauseSyncRequest, opts ...grpc.CallOption) (*PauseSyncResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UnmountResourceResponse)
-	err := c.cc.Invoke(ctx, SDSController_UnmountResource_FullMethodName, in, out, cOpts...)
+	out := new(PauseSyncResponse)
+	err := c.cc.Invoke(ctx, SDSController_PauseSync_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) MakeHa(ctx context.Context, in *MakeHaRequest, opts ...grpc.CallOption) (*MakeHaResponse, error) {
+func (c *sDSControllerClient) ResumeSync(ctx context.Context, in *ResumeSyncRequest, opts ...grpc.CallOption) (*ResumeSyncResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(MakeHaResponse)
-	err := c.cc.Invoke(ctx, SDSController_MakeHa_FullMethodName, in, out, cOpts...)
+	out := new(ResumeSyncResponse)
+	err := c.cc.Invoke(ctx, SDSController_ResumeSync_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) EvictHa(ctx context.Context, in *EvictHaRequest, opts ...grpc.CallOption) (*EvictHaResponse, error) {
+func (c *sDSControllerClient) SetSyncRate(ctx context.Context, in *SetSyncRateRequest, opts ...grpc.CallOption) (*SetSyncRateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(EvictHaResponse)
-	err := c.cc.Invoke(ctx, SDSController_EvictHa_FullMethodName, in, out, cOpts...)
+	out := new(SetSyncRateResponse)
+	err := c.cc.Invoke(ctx, SDSController_SetSyncRate_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) DeleteHa(ctx context.Context, in *DeleteHaRequest, opts ...grpc.CallOption) (*DeleteHaResponse, error) {
+func (c *sDSControllerClient) ExecFenceTest(ctx context.Context, in *ExecFenceTestRequest, opts ...grpc.CallOption) (*ExecFenceTestResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteHaResponse)
-	err := c.cc.Invoke(ctx, SDSController_DeleteHa_FullMethodName, in, out, cOpts...)
+	out := new(ExecFenceTestResponse)
+	err := c.cc.Invoke(ctx, SDSController_ExecFenceTest_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) GetHa(ctx context.Context, in *GetHaRequest, opts ...grpc.CallOption) (*GetHaResponse, error) {
+func (c *sDSControllerClient) ActivateResource(ctx context.Context, in *ActivateResourceRequest, opts ...grpc.CallOption) (*ActivateResourceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetHaResponse)
-	err := c.cc.Invoke(ctx, SDSController_GetHa_FullMethodName, in, out, cOpts...)
+	out := new(ActivateResourceResponse)
+	err := c.cc.Invoke(ctx, SDSController_ActivateResource_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) ListHa(ctx context.Context, in *ListHaRequest, opts ...grpc.CallOption) (*ListHaResponse, error) {
+func (c *sDSControllerClient) DeactivateResource(ctx context.Context, in *DeactivateResourceRequest, opts ...grpc.CallOption) (*DeactivateResourceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListHaResponse)
-	err := c.cc.Invoke(ctx, SDSController_ListHa_FullMethodName, in, out, cOpts...)
+	out := new(DeactivateResourceResponse)
+	err := c.cc.Invoke(ctx, SDSController_DeactivateResource_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error) {
+func (c *sDSControllerClient) FencePeer(ctx context.Context, in *FencePeerRequest, opts ...grpc.CallOption) (*FencePeerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateSnapshotResponse)
-	err := c.cc.Invoke(ctx, SDSController_CreateSnapshot_FullMethodName, in, out, cOpts...)
+	out := new(FencePeerResponse)
+	err := c.cc.Invoke(ctx, SDSController_FencePeer_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotResponse, error) {
+func (c *sDSControllerClient) UnfencePeer(ctx context.Context, in *UnfencePeerRequest, opts ...grpc.CallOption) (*UnfencePeerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteSnapshotResponse)
-	err := c.cc.Invoke(ctx, SDSController_DeleteSnapshot_FullMethodName, in, out, cOpts...)
+	out := new(UnfencePeerResponse)
+	err := c.cc.Invoke(ctx, SDSController_UnfencePeer_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error) {
+func (c *sDSControllerClient) ReportDrbdEvent(ctx context.Context, in *ReportDrbdEventRequest, opts ...grpc.CallOption) (*ReportDrbdEventResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RestoreSnapshotResponse)
-	err := c.cc.Invoke(ctx, SDSController_RestoreSnapshot_FullMethodName, in, out, cOpts...)
+	out := new(ReportDrbdEventResponse)
+	err := c.cc.Invoke(ctx, SDSController_ReportDrbdEvent_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+func (c *sDSControllerClient) InstallFenceHandlers(ctx context.Context, in *InstallFenceHandlersRequest, opts ...grpc.CallOption) (*InstallFenceHandlersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListSnapshotsResponse)
-	err := c.cc.Invoke(ctx, SDSController_ListSnapshots_FullMethodName, in, out, cOpts...)
+	out := new(InstallFenceHandlersResponse)
+	err := c.cc.Invoke(ctx, SDSController_InstallFenceHandlers_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) CreateNFSGateway(ctx context.Context, in *CreateNFSGatewayRequest, opts ...grpc.CallOption) (*CreateNFSGatewayResponse, error) {
+func (c *sDSControllerClient) ListFenceConstraints(ctx context.Context, in *ListFenceConstraintsRequest, opts ...grpc.CallOption) (*ListFenceConstraintsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateNFSGatewayResponse)
-	err := c.cc.Invoke(ctx, SDSController_CreateNFSGateway_FullMethodName, in, out, cOpts...)
+	out := new(ListFenceConstraintsResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListFenceConstraints_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) CreateISCSIGateway(ctx context.Context, in *CreateISCSIGatewayRequest, opts ...grpc.CallOption) (*CreateISCSIGatewayResponse, error) {
+func (c *sDSControllerClient) GetResource(ctx context.Context, in *GetResourceRequest, opts ...grpc.CallOption) (*GetResourceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateISCSIGatewayResponse)
-	err := c.cc.Invoke(ctx, SDSController_CreateISCSIGateway_FullMethodName, in, out, cOpts...)
+	out := new(GetResourceResponse)
+	err := c.cc.Invoke(ctx, SDSController_GetResource_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) CreateNVMeGateway(ctx context.Context, in *CreateNVMeGatewayRequest, opts ...grpc.CallOption) (*CreateNVMeGatewayResponse, error) {
+func (c *sDSControllerClient) ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateNVMeGatewayResponse)
-	err := c.cc.Invoke(ctx, SDSController_CreateNVMeGateway_FullMethodName, in, out, cOpts...)
+	out := new(ListResourcesResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListResources_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) DeleteGateway(ctx context.Context, in *DeleteGatewayRequest, opts ...grpc.CallOption) (*DeleteGatewayResponse, error) {
+func (c *sDSControllerClient) AddVolume(ctx context.Context, in *AddVolumeRequest, opts ...grpc.CallOption) (*AddVolumeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteGatewayResponse)
-	err := c.cc.Invoke(ctx, SDSController_DeleteGateway_FullMethodName, in, out, cOpts...)
+	out := new(AddVolumeResponse)
+	err := c.cc.Invoke(ctx, SDSController_AddVolume_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) GetGateway(ctx context.Context, in *GetGatewayRequest, opts ...grpc.CallOption) (*GetGatewayResponse, error) {
+func (c *sDSControllerClient) RemoveVolume(ctx context.Context, in *RemoveVolumeRequest, opts ...grpc.CallOption) (*RemoveVolumeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetGatewayResponse)
-	err := c.cc.Invoke(ctx, SDSController_GetGateway_FullMethodName, in, out, cOpts...)
+	out := new(RemoveVolumeResponse)
+	err := c.cc.Invoke(ctx, SDSController_RemoveVolume_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) ListGateways(ctx context.Context, in *ListGatewaysRequest, opts ...grpc.CallOption) (*ListGatewaysResponse, error) {
+func (c *sDSControllerClient) ResizeVolume(ctx context.Context, in *ResizeVolumeRequest, opts ...grpc.CallOption) (*ResizeVolumeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListGatewaysResponse)
-	err := c.cc.Invoke(ctx, SDSController_ListGateways_FullMethodName, in, out, cOpts...)
+	out := new(ResizeVolumeResponse)
+	err := c.cc.Invoke(ctx, SDSController_ResizeVolume_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) StartGateway(ctx context.Context, in *StartGatewayRequest, opts ...grpc.CallOption) (*StartGatewayResponse, error) {
+func (c *sDSControllerClient) GetVolume(ctx context.Context, in *GetVolumeRequest, opts ...grpc.CallOption) (*GetVolumeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(StartGatewayResponse)
-	err := c.cc.Invoke(ctx, SDSController_StartGateway_FullMethodName, in, out, cOpts...)
+	out := new(GetVolumeResponse)
+	err := c.cc.Invoke(ctx, SDSController_GetVolume_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) StopGateway(ctx context.Context, in *StopGatewayRequest, opts ...grpc.CallOption) (*StopGatewayResponse, error) {
+func (c *sDSControllerClient) ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(StopGatewayResponse)
-	err := c.cc.Invoke(ctx, SDSController_StopGateway_FullMethodName, in, out, cOpts...)
+	out := new(ListVolumesResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListVolumes_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) CreateZFSPool(ctx context.Context, in *CreateZFSPoolRequest, opts ...grpc.CallOption) (*CreateZFSPoolResponse, error) {
+func (c *sDSControllerClient) ResourceStatus(ctx context.Context, in *ResourceStatusRequest, opts ...grpc.CallOption) (*ResourceStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateZFSPoolResponse)
-	err := c.cc.Invoke(ctx, SDSController_CreateZFSPool_FullMethodName, in, out, cOpts...)
+	out := new(ResourceStatusResponse)
+	err := c.cc.Invoke(ctx, SDSController_ResourceStatus_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) DeleteZFSPool(ctx context.Context, in *DeleteZFSPoolRequest, opts ...grpc.CallOption) (*DeleteZFSPoolResponse, error) {
+func (c *sDSControllerClient) ExportResource(ctx context.Context, in *ExportResourceRequest, opts ...grpc.CallOption) (*ExportResourceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteZFSPoolResponse)
-	err := c.cc.Invoke(ctx, SDSController_DeleteZFSPool_FullMethodName, in, out, cOpts...)
+	out := new(ExportResourceResponse)
+	err := c.cc.Invoke(ctx, SDSController_ExportResource_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) ListZFSpools(ctx context.Context, in *ListZFSPoolsRequest, opts ...grpc.CallOption) (*ListZFSPoolsResponse, error) {
+func (c *sDSControllerClient) DiffResource(ctx context.Context, in *DiffResourceRequest, opts ...grpc.CallOption) (*DiffResourceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListZFSPoolsResponse)
-	err := c.cc.Invoke(ctx, SDSController_ListZFSpools_FullMethodName, in, out, cOpts...)
+	out := new(DiffResourceResponse)
+	err := c.cc.Invoke(ctx, SDSController_DiffResource_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) CreateZFSDataset(ctx context.Context, in *CreateZFSDatasetRequest, opts ...grpc.CallOption) (*CreateZFSDatasetResponse, error) {
+func (c *sDSControllerClient) GetNodeResourceConfig(ctx context.Context, in *GetNodeResourceConfigRequest, opts ...grpc.CallOption) (*GetNodeResourceConfigResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateZFSDatasetResponse)
-	err := c.cc.Invoke(ctx, SDSController_CreateZFSDataset_FullMethodName, in, out, cOpts...)
+	out := new(GetNodeResourceConfigResponse)
+	err := c.cc.Invoke(ctx, SDSController_GetNodeResourceConfig_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) CreateZFSVolume(ctx context.Context, in *CreateZFSVolumeRequest, opts ...grpc.CallOption) (*CreateZFSVolumeResponse, error) {
+func (c *sDSControllerClient) RenderConfig(ctx context.Context, in *RenderConfigRequest, opts ...grpc.CallOption) (*RenderConfigResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateZFSVolumeResponse)
-	err := c.cc.Invoke(ctx, SDSController_CreateZFSVolume_FullMethodName, in, out, cOpts...)
+	out := new(RenderConfigResponse)
+	err := c.cc.Invoke(ctx, SDSController_RenderConfig_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) ResizeZFSVolume(ctx context.Context, in *ResizeZFSVolumeRequest, opts ...grpc.CallOption) (*ResizeZFSVolumeResponse, error) {
+func (c *sDSControllerClient) SetPrimary(ctx context.Context, in *SetPrimaryRequest, opts ...grpc.CallOption) (*SetPrimaryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ResizeZFSVolumeResponse)
-	err := c.cc.Invoke(ctx, SDSController_ResizeZFSVolume_FullMethodName, in, out, cOpts...)
+	out := new(SetPrimaryResponse)
+	err := c.cc.Invoke(ctx, SDSController_SetPrimary_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) DeleteZFSDataset(ctx context.Context, in *DeleteZFSDatasetRequest, opts ...grpc.CallOption) (*DeleteZFSDatasetResponse, error) {
+func (c *sDSControllerClient) SetSecondary(ctx context.Context, in *SetSecondaryRequest, opts ...grpc.CallOption) (*SetSecondaryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteZFSDatasetResponse)
-	err := c.cc.Invoke(ctx, SDSController_DeleteZFSDataset_FullMethodName, in, out, cOpts...)
+	out := new(SetSecondaryResponse)
+	err := c.cc.Invoke(ctx, SDSController_SetSecondary_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) CreateZFSSnapshot(ctx context.Context, in *CreateZFSSnapshotRequest, opts ...grpc.CallOption) (*CreateZFSSnapshotResponse, error) {
+func (c *sDSControllerClient) CreateFilesystem(ctx context.Context, in *CreateFilesystemRequest, opts ...grpc.CallOption) (*CreateFilesystemResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateZFSSnapshotResponse)
-	err := c.cc.Invoke(ctx, SDSController_CreateZFSSnapshot_FullMethodName, in, out, cOpts...)
+	out := new(CreateFilesystemResponse)
+	err := c.cc.Invoke(ctx, SDSController_CreateFilesystem_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) DeleteZFSSnapshot(ctx context.Context, in *DeleteZFSSnapshotRequest, opts ...grpc.CallOption) (*DeleteZFSSnapshotResponse, error) {
+func (c *sDSControllerClient) MountResource(ctx context.Context, in *MountResourceRequest, opts ...grpc.CallOption) (*MountResourceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteZFSSnapshotResponse)
-	err := c.cc.Invoke(ctx, SDSController_DeleteZFSSnapshot_FullMethodName, in, out, cOpts...)
+	out := new(MountResourceResponse)
+	err := c.cc.Invoke(ctx, SDSController_MountResource_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) ListZFSSnapshots(ctx context.Context, in *ListZFSSnapshotsRequest, opts ...grpc.CallOption) (*ListZFSSnapshotsResponse, error) {
+func (c *sDSControllerClient) UnmountResource(ctx context.Context, in *UnmountResourceRequest, opts ...grpc.CallOption) (*UnmountResourceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListZFSSnapshotsResponse)
-	err := c.cc.Invoke(ctx, SDSController_ListZFSSnapshots_FullMethodName, in, out, cOpts...)
+	out := new(UnmountResourceResponse)
+	err := c.cc.Invoke(ctx, SDSController_UnmountResource_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) RestoreZFSSnapshot(ctx context.Context, in *RestoreZFSSnapshotRequest, opts ...grpc.CallOption) (*RestoreZFSSnapshotResponse, error) {
+func (c *sDSControllerClient) MakeHa(ctx context.Context, in *MakeHaRequest, opts ...grpc.CallOption) (*MakeHaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RestoreZFSSnapshotResponse)
-	err := c.cc.Invoke(ctx, SDSController_RestoreZFSSnapshot_FullMethodName, in, out, cOpts...)
+	out := new(MakeHaResponse)
+	err := c.cc.Invoke(ctx, SDSController_MakeHa_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) CloneZFSSnapshot(ctx context.Context, in *CloneZFSSnapshotRequest, opts ...grpc.CallOption) (*CloneZFSSnapshotResponse, error) {
+func (c *sDSControllerClient) EvictHa(ctx context.Context, in *EvictHaRequest, opts ...grpc.CallOption) (*EvictHaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CloneZFSSnapshotResponse)
-	err := c.cc.Invoke(ctx, SDSController_CloneZFSSnapshot_FullMethodName, in, out, cOpts...)
+	out := new(EvictHaResponse)
+	err := c.cc.Invoke(ctx, SDSController_EvictHa_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) CreateLvmSnapshot(ctx context.Context, in *CreateLvmSnapshotRequest, opts ...grpc.CallOption) (*CreateLvmSnapshotResponse, error) {
+func (c *sDSControllerClient) UpdateHa(ctx context.Context, in *UpdateHaRequest, opts ...grpc.CallOption) (*UpdateHaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateLvmSnapshotResponse)
-	err := c.cc.Invoke(ctx, SDSController_CreateLvmSnapshot_FullMethodName, in, out, cOpts...)
+	out := new(UpdateHaResponse)
+	err := c.cc.Invoke(ctx, SDSController_UpdateHa_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) DeleteLvmSnapshot(ctx context.Context, in *DeleteLvmSnapshotRequest, opts ...grpc.CallOption) (*DeleteLvmSnapshotResponse, error) {
+func (c *sDSControllerClient) FailoverHa(ctx context.Context, in *FailoverHaRequest, opts ...grpc.CallOption) (*FailoverHaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteLvmSnapshotResponse)
-	err := c.cc.Invoke(ctx, SDSController_DeleteLvmSnapshot_FullMethodName, in, out, cOpts...)
+	out := new(FailoverHaResponse)
+	err := c.cc.Invoke(ctx, SDSController_FailoverHa_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) ListLvmSnapshots(ctx context.Context, in *ListLvmSnapshotsRequest, opts ...grpc.CallOption) (*ListLvmSnapshotsResponse, error) {
+func (c *sDSControllerClient) DeleteHa(ctx context.Context, in *DeleteHaRequest, opts ...grpc.CallOption) (*DeleteHaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListLvmSnapshotsResponse)
-	err := c.cc.Invoke(ctx, SDSController_ListLvmSnapshots_FullMethodName, in, out, cOpts...)
+	out := new(DeleteHaResponse)
+	err := c.cc.Invoke(ctx, SDSController_DeleteHa_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sDSControllerClient) RestoreLvmSnapshot(ctx context.Context, in *RestoreLvmSnapshotRequest, opts ...grpc.CallOption) (*RestoreLvmSnapshotResponse, error) {
+func (c *sDSControllerClient) GetHa(ctx context.Context, in *GetHaRequest, opts ...grpc.CallOption) (*GetHaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RestoreLvmSnapshotResponse)
-	err := c.cc.Invoke(ctx, SDSController_RestoreLvmSnapshot_FullMethodName, in, out, cOpts...)
+	out := new(GetHaResponse)
+	err := c.cc.Invoke(ctx, SDSController_GetHa_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// SDSControllerServer is the server API for SDSController service.
-// All implementations must embed UnimplementedSDSControllerServer
-// for forward compatibility.
-//
-// SDS Controller Service
-type SDSControllerServer interface {
-	// Pool operations
-	CreatePool(context.Context, *CreatePoolRequest) (*CreatePoolResponse, error)
-	DeletePool(context.Context, *DeletePoolRequest) (*DeletePoolResponse, error)
-	GetPool(context.Context, *GetPoolRequest) (*GetPoolResponse, error)
-	ListPools(context.Context, *ListPoolsRequest) (*ListPoolsResponse, error)
-	AddDiskToPool(context.Context, *AddDiskToPoolRequest) (*AddDiskToPoolResponse, error)
-	// Node operations
-	RegisterNode(context.Context, *RegisterNodeRequest) (*RegisterNodeResponse, error)
-	UnregisterNode(context.Context, *UnregisterNodeRequest) (*UnregisterNodeResponse, error)
-	GetNode(context.Context, *GetNodeRequest) (*GetNodeResponse, error)
-	ListNodes(context.Context, *ListNodesRequest) (*ListNodesResponse, error)
-	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
-	// Resource operations
-	CreateResource(context.Context, *CreateResourceRequest) (*CreateResourceResponse, error)
-	DeleteResource(context.Context, *DeleteResourceRequest) (*DeleteResourceResponse, error)
-	GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error)
-	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
-	AddVolume(context.Context, *AddVolumeRequest) (*AddVolumeResponse, error)
-	RemoveVolume(context.Context, *RemoveVolumeRequest) (*RemoveVolumeResponse, error)
-	ResizeVolume(context.Context, *ResizeVolumeRequest) (*ResizeVolumeResponse, error)
-	ResourceStatus(context.Context, *ResourceStatusRequest) (*ResourceStatusResponse, error)
-	SetPrimary(context.Context, *SetPrimaryRequest) (*SetPrimaryResponse, error)
-	SetSecondary(context.Context, *SetSecondaryRequest) (*SetSecondaryResponse, error)
-	CreateFilesystem(context.Context, *CreateFilesystemRequest) (*CreateFilesystemResponse, error)
-	MountResource(context.Context, *MountResourceRequest) (*MountResourceResponse, error)
-	UnmountResource(context.Context, *UnmountResourceRequest) (*UnmountResourceResponse, error)
-	MakeHa(context.Context, *MakeHaRequest) (*MakeHaResponse, error)
-	EvictHa(context.Context, *EvictHaRequest) (*EvictHaResponse, error)
-	DeleteHa(context.Context, *DeleteHaRequest) (*DeleteHaResponse, error)
-	GetHa(context.Context, *GetHaRequest) (*GetHaResponse, error)
-	ListHa(context.Context, *ListHaRequest) (*ListHaResponse, error)
-	// Snapshot operations
-	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error)
-	DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error)
-	RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error)
-	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
-	// Gateway operations
-	CreateNFSGateway(context.Context, *CreateNFSGatewayRequest) (*CreateNFSGatewayResponse, error)
-	CreateISCSIGateway(context.Context, *CreateISCSIGatewayRequest) (*CreateISCSIGatewayResponse, error)
-	CreateNVMeGateway(context.Context, *CreateNVMeGatewayRequest) (*CreateNVMeGatewayResponse, error)
-	DeleteGateway(context.Context, *DeleteGatewayRequest) (*DeleteGatewayResponse, error)
-	GetGateway(context.Context, *GetGatewayRequest) (*GetGatewayResponse, error)
-	ListGateways(context.Context, *ListGatewaysRequest) (*ListGatewaysResponse, error)
-	StartGateway(context.Context, *StartGatewayRequest) (*StartGatewayResponse, error)
-	StopGateway(context.Context, *StopGatewayRequest) (*StopGatewayResponse, error)
-	// ZFS operations
-	CreateZFSPool(context.Context, *CreateZFSPoolRequest) (*CreateZFSPoolResponse, error)
-	DeleteZFSPool(context.Context, *DeleteZFSPoolRequest) (*DeleteZFSPoolResponse, error)
-	ListZFSpools(context.Context, *ListZFSPoolsRequest) (*ListZFSPoolsResponse, error)
-	CreateZFSDataset(context.Context, *CreateZFSDatasetRequest) (*CreateZFSDatasetResponse, error)
-	CreateZFSVolume(context.Context, *CreateZFSVolumeRequest) (*CreateZFSVolumeResponse, error)
-	ResizeZFSVolume(context.Context, *ResizeZFSVolumeRequest) (*ResizeZFSVolumeResponse, error)
-	DeleteZFSDataset(context.Context, *DeleteZFSDatasetRequest) (*DeleteZFSDatasetResponse, error)
-	// ZFS Snapshot operations
-	CreateZFSSnapshot(context.Context, *CreateZFSSnapshotRequest) (*CreateZFSSnapshotResponse, error)
-	DeleteZFSSnapshot(context.Context, *DeleteZFSSnapshotRequest) (*DeleteZFSSnapshotResponse, error)
-	ListZFSSnapshots(context.Context, *ListZFSSnapshotsRequest) (*ListZFSSnapshotsResponse, error)
-	RestoreZFSSnapshot(context.Context, *RestoreZFSSnapshotRequest) (*RestoreZFSSnapshotResponse, error)
-	CloneZFSSnapshot(context.Context, *CloneZFSSnapshotRequest) (*CloneZFSSnapshotResponse, error)
-	// LVM Snapshot operations
-	CreateLvmSnapshot(context.Context, *CreateLvmSnapshotRequest) (*CreateLvmSnapshotResponse, error)
-	DeleteLvmSnapshot(context.Context, *DeleteLvmSnapshotRequest) (*DeleteLvmSnapshotResponse, error)
-	ListLvmSnapshots(context.Context, *ListLvmSnapshotsRequest) (*ListLvmSnapshotsResponse, error)
-	RestoreLvmSnapshot(context.Context, *RestoreLvmSnapshotRequest) (*RestoreLvmSnapshotResponse, error)
-	mustEmbedUnimplementedSDSControllerServer()
+func (c *sDSControllerClient) ListHa(ctx context.Context, in *ListHaRequest, opts ...grpc.CallOption) (*ListHaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHaResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListHa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedSDSControllerServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedSDSControllerServer struct{}
-
-func (UnimplementedSDSControllerServer) CreatePool(context.Context, *CreatePoolRequest) (*CreatePoolResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreatePool not implemented")
+func (c *sDSControllerClient) GetHaStatus(ctx context.Context, in *GetHaStatusRequest, opts ...grpc.CallOption) (*GetHaStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHaStatusResponse)
+	err := c.cc.Invoke(ctx, SDSController_GetHaStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) DeletePool(context.Context, *DeletePoolRequest) (*DeletePoolResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeletePool not implemented")
+
+func (c *sDSControllerClient) ImportPacemakerHa(ctx context.Context, in *ImportPacemakerHaRequest, opts ...grpc.CallOption) (*ImportPacemakerHaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportPacemakerHaResponse)
+	err := c.cc.Invoke(ctx, SDSController_ImportPacemakerHa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) GetPool(context.Context, *GetPoolRequest) (*GetPoolResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetPool not implemented")
+
+func (c *sDSControllerClient) ListVIPs(ctx context.Context, in *ListVIPsRequest, opts ...grpc.CallOption) (*ListVIPsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVIPsResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListVIPs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) ListPools(context.Context, *ListPoolsRequest) (*ListPoolsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListPools not implemented")
+
+func (c *sDSControllerClient) DrSwitchover(ctx context.Context, in *DrSwitchoverRequest, opts ...grpc.CallOption) (*DrSwitchoverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrSwitchoverResponse)
+	err := c.cc.Invoke(ctx, SDSController_DrSwitchover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) AddDiskToPool(context.Context, *AddDiskToPoolRequest) (*AddDiskToPoolResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method AddDiskToPool not implemented")
+
+func (c *sDSControllerClient) DrFailback(ctx context.Context, in *DrFailbackRequest, opts ...grpc.CallOption) (*DrFailbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrFailbackResponse)
+	err := c.cc.Invoke(ctx, SDSController_DrFailback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) RegisterNode(context.Context, *RegisterNodeRequest) (*RegisterNodeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RegisterNode not implemented")
+
+func (c *sDSControllerClient) AddPlacementRule(ctx context.Context, in *AddPlacementRuleRequest, opts ...grpc.CallOption) (*AddPlacementRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddPlacementRuleResponse)
+	err := c.cc.Invoke(ctx, SDSController_AddPlacementRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) UnregisterNode(context.Context, *UnregisterNodeRequest) (*UnregisterNodeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UnregisterNode not implemented")
+
+func (c *sDSControllerClient) DeletePlacementRule(ctx context.Context, in *DeletePlacementRuleRequest, opts ...grpc.CallOption) (*DeletePlacementRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePlacementRuleResponse)
+	err := c.cc.Invoke(ctx, SDSController_DeletePlacementRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) GetNode(context.Context, *GetNodeRequest) (*GetNodeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetNode not implemented")
+
+func (c *sDSControllerClient) ListPlacementRules(ctx context.Context, in *ListPlacementRulesRequest, opts ...grpc.CallOption) (*ListPlacementRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlacementRulesResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListPlacementRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) ListNodes(context.Context, *ListNodesRequest) (*ListNodesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListNodes not implemented")
+
+func (c *sDSControllerClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method HealthCheck not implemented")
+
+func (c *sDSControllerClient) SetEventHook(ctx context.Context, in *SetEventHookRequest, opts ...grpc.CallOption) (*SetEventHookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEventHookResponse)
+	err := c.cc.Invoke(ctx, SDSController_SetEventHook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) CreateResource(context.Context, *CreateResourceRequest) (*CreateResourceResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateResource not implemented")
+
+func (c *sDSControllerClient) DeleteEventHook(ctx context.Context, in *DeleteEventHookRequest, opts ...grpc.CallOption) (*DeleteEventHookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEventHookResponse)
+	err := c.cc.Invoke(ctx, SDSController_DeleteEventHook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) DeleteResource(context.Context, *DeleteResourceRequest) (*DeleteResourceResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteResource not implemented")
+
+func (c *sDSControllerClient) ListEventHooks(ctx context.Context, in *ListEventHooksRequest, opts ...grpc.CallOption) (*ListEventHooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventHooksResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListEventHooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetResource not implemented")
+
+func (c *sDSControllerClient) TestEventHook(ctx context.Context, in *TestEventHookRequest, opts ...grpc.CallOption) (*TestEventHookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestEventHookResponse)
+	err := c.cc.Invoke(ctx, SDSController_TestEventHook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListResources not implemented")
+
+func (c *sDSControllerClient) GetClusterReport(ctx context.Context, in *GetClusterReportRequest, opts ...grpc.CallOption) (*GetClusterReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClusterReportResponse)
+	err := c.cc.Invoke(ctx, SDSController_GetClusterReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) AddVolume(context.Context, *AddVolumeRequest) (*AddVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method AddVolume not implemented")
+
+func (c *sDSControllerClient) GetAlertRules(ctx context.Context, in *GetAlertRulesRequest, opts ...grpc.CallOption) (*GetAlertRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAlertRulesResponse)
+	err := c.cc.Invoke(ctx, SDSController_GetAlertRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) RemoveVolume(context.Context, *RemoveVolumeRequest) (*RemoveVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RemoveVolume not implemented")
+
+func (c *sDSControllerClient) ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClustersResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListClusters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) ResizeVolume(context.Context, *ResizeVolumeRequest) (*ResizeVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ResizeVolume not implemented")
+
+func (c *sDSControllerClient) GetClusterInfo(ctx context.Context, in *GetClusterInfoRequest, opts ...grpc.CallOption) (*GetClusterInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClusterInfoResponse)
+	err := c.cc.Invoke(ctx, SDSController_GetClusterInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) ResourceStatus(context.Context, *ResourceStatusRequest) (*ResourceStatusResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ResourceStatus not implemented")
+
+func (c *sDSControllerClient) GetOverview(ctx context.Context, in *GetOverviewRequest, opts ...grpc.CallOption) (*GetOverviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOverviewResponse)
+	err := c.cc.Invoke(ctx, SDSController_GetOverview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) SetPrimary(context.Context, *SetPrimaryRequest) (*SetPrimaryResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SetPrimary not implemented")
+
+func (c *sDSControllerClient) Freeze(ctx context.Context, in *FreezeRequest, opts ...grpc.CallOption) (*FreezeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FreezeResponse)
+	err := c.cc.Invoke(ctx, SDSController_Freeze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) SetSecondary(context.Context, *SetSecondaryRequest) (*SetSecondaryResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SetSecondary not implemented")
+
+func (c *sDSControllerClient) Unfreeze(ctx context.Context, in *UnfreezeRequest, opts ...grpc.CallOption) (*UnfreezeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfreezeResponse)
+	err := c.cc.Invoke(ctx, SDSController_Unfreeze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) CreateFilesystem(context.Context, *CreateFilesystemRequest) (*CreateFilesystemResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateFilesystem not implemented")
+
+func (c *sDSControllerClient) GetFreezeStatus(ctx context.Context, in *GetFreezeStatusRequest, opts ...grpc.CallOption) (*GetFreezeStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFreezeStatusResponse)
+	err := c.cc.Invoke(ctx, SDSController_GetFreezeStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) MountResource(context.Context, *MountResourceRequest) (*MountResourceResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method MountResource not implemented")
+
+func (c *sDSControllerClient) CollectGarbage(ctx context.Context, in *CollectGarbageRequest, opts ...grpc.CallOption) (*CollectGarbageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectGarbageResponse)
+	err := c.cc.Invoke(ctx, SDSController_CollectGarbage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) UnmountResource(context.Context, *UnmountResourceRequest) (*UnmountResourceResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UnmountResource not implemented")
-}
-func (UnimplementedSDSControllerServer) MakeHa(context.Context, *MakeHaRequest) (*MakeHaResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method MakeHa not implemented")
+
+func (c *sDSControllerClient) Rediscover(ctx context.Context, in *RediscoverRequest, opts ...grpc.CallOption) (*RediscoverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RediscoverResponse)
+	err := c.cc.Invoke(ctx, SDSController_Rediscover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) EvictHa(context.Context, *EvictHaRequest) (*EvictHaResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method EvictHa not implemented")
+
+func (c *sDSControllerClient) SupportBundle(ctx context.Context, in *SupportBundleRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SupportBundleChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SDSController_ServiceDesc.Streams[1], SDSController_SupportBundle_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SupportBundleRequest, SupportBundleChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedSDSControllerServer) DeleteHa(context.Context, *DeleteHaRequest) (*DeleteHaResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteHa not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SDSController_SupportBundleClient = grpc.ServerStreamingClient[SupportBundleChunk]
+
+func (c *sDSControllerClient) GetDriftReport(ctx context.Context, in *GetDriftReportRequest, opts ...grpc.CallOption) (*GetDriftReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDriftReportResponse)
+	err := c.cc.Invoke(ctx, SDSController_GetDriftReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) GetHa(context.Context, *GetHaRequest) (*GetHaResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetHa not implemented")
+
+func (c *sDSControllerClient) Repair(ctx context.Context, in *RepairRequest, opts ...grpc.CallOption) (*RepairResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RepairResponse)
+	err := c.cc.Invoke(ctx, SDSController_Repair_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) ListHa(context.Context, *ListHaRequest) (*ListHaResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListHa not implemented")
+
+func (c *sDSControllerClient) Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebalanceResponse)
+	err := c.cc.Invoke(ctx, SDSController_Rebalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateSnapshot not implemented")
+
+func (c *sDSControllerClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteSnapshot not implemented")
+
+func (c *sDSControllerClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJobResponse)
+	err := c.cc.Invoke(ctx, SDSController_GetJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RestoreSnapshot not implemented")
+
+func (c *sDSControllerClient) ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*ResumeJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeJobResponse)
+	err := c.cc.Invoke(ctx, SDSController_ResumeJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListSnapshots not implemented")
+
+func (c *sDSControllerClient) RollbackJob(ctx context.Context, in *RollbackJobRequest, opts ...grpc.CallOption) (*RollbackJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackJobResponse)
+	err := c.cc.Invoke(ctx, SDSController_RollbackJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) CreateNFSGateway(context.Context, *CreateNFSGatewayRequest) (*CreateNFSGatewayResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateNFSGateway not implemented")
+
+func (c *sDSControllerClient) WatchJobProgress(ctx context.Context, in *WatchJobProgressRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[JobProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SDSController_ServiceDesc.Streams[2], SDSController_WatchJobProgress_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchJobProgressRequest, JobProgress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedSDSControllerServer) CreateISCSIGateway(context.Context, *CreateISCSIGatewayRequest) (*CreateISCSIGatewayResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateISCSIGateway not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SDSController_WatchJobProgressClient = grpc.ServerStreamingClient[JobProgress]
+
+func (c *sDSControllerClient) GetDrbdGlobalConfig(ctx context.Context, in *GetDrbdGlobalConfigRequest, opts ...grpc.CallOption) (*GetDrbdGlobalConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDrbdGlobalConfigResponse)
+	err := c.cc.Invoke(ctx, SDSController_GetDrbdGlobalConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) CreateNVMeGateway(context.Context, *CreateNVMeGatewayRequest) (*CreateNVMeGatewayResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateNVMeGateway not implemented")
+
+func (c *sDSControllerClient) SetDrbdGlobalConfig(ctx context.Context, in *SetDrbdGlobalConfigRequest, opts ...grpc.CallOption) (*SetDrbdGlobalConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDrbdGlobalConfigResponse)
+	err := c.cc.Invoke(ctx, SDSController_SetDrbdGlobalConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) DeleteGateway(context.Context, *DeleteGatewayRequest) (*DeleteGatewayResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteGateway not implemented")
+
+func (c *sDSControllerClient) ListDrbdGlobalConfigs(ctx context.Context, in *ListDrbdGlobalConfigsRequest, opts ...grpc.CallOption) (*ListDrbdGlobalConfigsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDrbdGlobalConfigsResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListDrbdGlobalConfigs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) GetGateway(context.Context, *GetGatewayRequest) (*GetGatewayResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetGateway not implemented")
+
+func (c *sDSControllerClient) RollbackDrbdGlobalConfig(ctx context.Context, in *RollbackDrbdGlobalConfigRequest, opts ...grpc.CallOption) (*RollbackDrbdGlobalConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackDrbdGlobalConfigResponse)
+	err := c.cc.Invoke(ctx, SDSController_RollbackDrbdGlobalConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) ListGateways(context.Context, *ListGatewaysRequest) (*ListGatewaysResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListGateways not implemented")
+
+func (c *sDSControllerClient) ProbeNetwork(ctx context.Context, in *ProbeNetworkRequest, opts ...grpc.CallOption) (*ProbeNetworkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProbeNetworkResponse)
+	err := c.cc.Invoke(ctx, SDSController_ProbeNetwork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) StartGateway(context.Context, *StartGatewayRequest) (*StartGatewayResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method StartGateway not implemented")
+
+func (c *sDSControllerClient) ListNetProbes(ctx context.Context, in *ListNetProbesRequest, opts ...grpc.CallOption) (*ListNetProbesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNetProbesResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListNetProbes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) StopGateway(context.Context, *StopGatewayRequest) (*StopGatewayResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method StopGateway not implemented")
+
+func (c *sDSControllerClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSnapshotResponse)
+	err := c.cc.Invoke(ctx, SDSController_CreateSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) CreateZFSPool(context.Context, *CreateZFSPoolRequest) (*CreateZFSPoolResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateZFSPool not implemented")
+
+func (c *sDSControllerClient) DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSnapshotResponse)
+	err := c.cc.Invoke(ctx, SDSController_DeleteSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) DeleteZFSPool(context.Context, *DeleteZFSPoolRequest) (*DeleteZFSPoolResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteZFSPool not implemented")
+
+func (c *sDSControllerClient) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreSnapshotResponse)
+	err := c.cc.Invoke(ctx, SDSController_RestoreSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) ListZFSpools(context.Context, *ListZFSPoolsRequest) (*ListZFSPoolsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListZFSpools not implemented")
+
+func (c *sDSControllerClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) CreateZFSDataset(context.Context, *CreateZFSDatasetRequest) (*CreateZFSDatasetResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateZFSDataset not implemented")
+
+func (c *sDSControllerClient) GetSnapshotUsage(ctx context.Context, in *GetSnapshotUsageRequest, opts ...grpc.CallOption) (*GetSnapshotUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSnapshotUsageResponse)
+	err := c.cc.Invoke(ctx, SDSController_GetSnapshotUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) CreateZFSVolume(context.Context, *CreateZFSVolumeRequest) (*CreateZFSVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateZFSVolume not implemented")
+
+func (c *sDSControllerClient) SetSnapshotHook(ctx context.Context, in *SetSnapshotHookRequest, opts ...grpc.CallOption) (*SetSnapshotHookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSnapshotHookResponse)
+	err := c.cc.Invoke(ctx, SDSController_SetSnapshotHook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) ResizeZFSVolume(context.Context, *ResizeZFSVolumeRequest) (*ResizeZFSVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ResizeZFSVolume not implemented")
+
+func (c *sDSControllerClient) DeleteSnapshotHook(ctx context.Context, in *DeleteSnapshotHookRequest, opts ...grpc.CallOption) (*DeleteSnapshotHookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSnapshotHookResponse)
+	err := c.cc.Invoke(ctx, SDSController_DeleteSnapshotHook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) DeleteZFSDataset(context.Context, *DeleteZFSDatasetRequest) (*DeleteZFSDatasetResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteZFSDataset not implemented")
+
+func (c *sDSControllerClient) ListSnapshotHooks(ctx context.Context, in *ListSnapshotHooksRequest, opts ...grpc.CallOption) (*ListSnapshotHooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSnapshotHooksResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListSnapshotHooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) CreateZFSSnapshot(context.Context, *CreateZFSSnapshotRequest) (*CreateZFSSnapshotResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateZFSSnapshot not implemented")
+
+func (c *sDSControllerClient) CreateSnapshotGroup(ctx context.Context, in *CreateSnapshotGroupRequest, opts ...grpc.CallOption) (*SnapshotGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SnapshotGroupResponse)
+	err := c.cc.Invoke(ctx, SDSController_CreateSnapshotGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) DeleteZFSSnapshot(context.Context, *DeleteZFSSnapshotRequest) (*DeleteZFSSnapshotResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteZFSSnapshot not implemented")
+
+func (c *sDSControllerClient) DeleteSnapshotGroup(ctx context.Context, in *DeleteSnapshotGroupRequest, opts ...grpc.CallOption) (*SnapshotGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SnapshotGroupResponse)
+	err := c.cc.Invoke(ctx, SDSController_DeleteSnapshotGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) ListZFSSnapshots(context.Context, *ListZFSSnapshotsRequest) (*ListZFSSnapshotsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListZFSSnapshots not implemented")
+
+func (c *sDSControllerClient) RestoreSnapshotGroup(ctx context.Context, in *RestoreSnapshotGroupRequest, opts ...grpc.CallOption) (*SnapshotGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SnapshotGroupResponse)
+	err := c.cc.Invoke(ctx, SDSController_RestoreSnapshotGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) RestoreZFSSnapshot(context.Context, *RestoreZFSSnapshotRequest) (*RestoreZFSSnapshotResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RestoreZFSSnapshot not implemented")
+
+func (c *sDSControllerClient) ListSnapshotGroups(ctx context.Context, in *ListSnapshotGroupsRequest, opts ...grpc.CallOption) (*ListSnapshotGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSnapshotGroupsResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListSnapshotGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) CloneZFSSnapshot(context.Context, *CloneZFSSnapshotRequest) (*CloneZFSSnapshotResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CloneZFSSnapshot not implemented")
+
+func (c *sDSControllerClient) SetReplicationPolicy(ctx context.Context, in *SetReplicationPolicyRequest, opts ...grpc.CallOption) (*SetReplicationPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetReplicationPolicyResponse)
+	err := c.cc.Invoke(ctx, SDSController_SetReplicationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSDSControllerServer) CreateLvmSnapshot(context.Context, *CreateLvmSnapshotRequest) (*CreateLvmSnapshotResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateLvmSnapshot not implemented")
+
+func (c *sDSControllerClient) DeleteReplicationPolicy(ctx context.Context, in *DeleteReplicationPolicyRequest, opts ...grpc.CallOption) (*DeleteReplicationPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReplicationPolicyResponse)
+	err := c.cc.Invoke(ctx, SDSController_DeleteReplicationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) ListReplicationPolicies(ctx context.Context, in *ListReplicationPoliciesRequest, opts ...grpc.CallOption) (*ListReplicationPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReplicationPoliciesResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListReplicationPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) RunReplication(ctx context.Context, in *RunReplicationRequest, opts ...grpc.CallOption) (*RunReplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunReplicationResponse)
+	err := c.cc.Invoke(ctx, SDSController_RunReplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) CreateNFSGateway(ctx context.Context, in *CreateNFSGatewayRequest, opts ...grpc.CallOption) (*CreateNFSGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateNFSGatewayResponse)
+	err := c.cc.Invoke(ctx, SDSController_CreateNFSGateway_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) CreateISCSIGateway(ctx context.Context, in *CreateISCSIGatewayRequest, opts ...grpc.CallOption) (*CreateISCSIGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateISCSIGatewayResponse)
+	err := c.cc.Invoke(ctx, SDSController_CreateISCSIGateway_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) CreateNVMeGateway(ctx context.Context, in *CreateNVMeGatewayRequest, opts ...grpc.CallOption) (*CreateNVMeGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateNVMeGatewayResponse)
+	err := c.cc.Invoke(ctx, SDSController_CreateNVMeGateway_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) DeleteGateway(ctx context.Context, in *DeleteGatewayRequest, opts ...grpc.CallOption) (*DeleteGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGatewayResponse)
+	err := c.cc.Invoke(ctx, SDSController_DeleteGateway_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) GetGateway(ctx context.Context, in *GetGatewayRequest, opts ...grpc.CallOption) (*GetGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGatewayResponse)
+	err := c.cc.Invoke(ctx, SDSController_GetGateway_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) ListGateways(ctx context.Context, in *ListGatewaysRequest, opts ...grpc.CallOption) (*ListGatewaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGatewaysResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListGateways_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) StartGateway(ctx context.Context, in *StartGatewayRequest, opts ...grpc.CallOption) (*StartGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartGatewayResponse)
+	err := c.cc.Invoke(ctx, SDSController_StartGateway_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) StopGateway(ctx context.Context, in *StopGatewayRequest, opts ...grpc.CallOption) (*StopGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopGatewayResponse)
+	err := c.cc.Invoke(ctx, SDSController_StopGateway_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) ListGatewayClients(ctx context.Context, in *ListGatewayClientsRequest, opts ...grpc.CallOption) (*ListGatewayClientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGatewayClientsResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListGatewayClients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) NVMeConnect(ctx context.Context, in *NVMeConnectRequest, opts ...grpc.CallOption) (*NVMeConnectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NVMeConnectResponse)
+	err := c.cc.Invoke(ctx, SDSController_NVMeConnect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) NVMeDisconnect(ctx context.Context, in *NVMeDisconnectRequest, opts ...grpc.CallOption) (*NVMeDisconnectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NVMeDisconnectResponse)
+	err := c.cc.Invoke(ctx, SDSController_NVMeDisconnect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) GetISCSIClientConfig(ctx context.Context, in *GetISCSIClientConfigRequest, opts ...grpc.CallOption) (*GetISCSIClientConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetISCSIClientConfigResponse)
+	err := c.cc.Invoke(ctx, SDSController_GetISCSIClientConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) ValidateISCSIInitiator(ctx context.Context, in *ValidateISCSIInitiatorRequest, opts ...grpc.CallOption) (*ValidateISCSIInitiatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateISCSIInitiatorResponse)
+	err := c.cc.Invoke(ctx, SDSController_ValidateISCSIInitiator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) NFSMount(ctx context.Context, in *NFSMountRequest, opts ...grpc.CallOption) (*NFSMountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NFSMountResponse)
+	err := c.cc.Invoke(ctx, SDSController_NFSMount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) CreateZFSPool(ctx context.Context, in *CreateZFSPoolRequest, opts ...grpc.CallOption) (*CreateZFSPoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateZFSPoolResponse)
+	err := c.cc.Invoke(ctx, SDSController_CreateZFSPool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) DeleteZFSPool(ctx context.Context, in *DeleteZFSPoolRequest, opts ...grpc.CallOption) (*DeleteZFSPoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteZFSPoolResponse)
+	err := c.cc.Invoke(ctx, SDSController_DeleteZFSPool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) ListZFSpools(ctx context.Context, in *ListZFSPoolsRequest, opts ...grpc.CallOption) (*ListZFSPoolsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListZFSPoolsResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListZFSpools_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) CreateZFSDataset(ctx context.Context, in *CreateZFSDatasetRequest, opts ...grpc.CallOption) (*CreateZFSDatasetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateZFSDatasetResponse)
+	err := c.cc.Invoke(ctx, SDSController_CreateZFSDataset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) CreateZFSVolume(ctx context.Context, in *CreateZFSVolumeRequest, opts ...grpc.CallOption) (*CreateZFSVolumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateZFSVolumeResponse)
+	err := c.cc.Invoke(ctx, SDSController_CreateZFSVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) ResizeZFSVolume(ctx context.Context, in *ResizeZFSVolumeRequest, opts ...grpc.CallOption) (*ResizeZFSVolumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResizeZFSVolumeResponse)
+	err := c.cc.Invoke(ctx, SDSController_ResizeZFSVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) DeleteZFSDataset(ctx context.Context, in *DeleteZFSDatasetRequest, opts ...grpc.CallOption) (*DeleteZFSDatasetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteZFSDatasetResponse)
+	err := c.cc.Invoke(ctx, SDSController_DeleteZFSDataset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *sDSControllerClient) CreateZFSSnapshot(ctx context.Context, in *CreateZFSSnapshotRequest, opts ...grpc.CallOption) (*CreateZFSSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateZFSSnapshotResponse)
+	err := c.cc.Invoke(ctx, SDSController_CreateZFSSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *sDSControllerClient) DeleteZFSSnapshot(ctx context.Context, in *DeleteZFSSnapshotRequest, opts ...grpc.CallOption) (*DeleteZFSSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteZFSSnapshotResponse)
+	err := c.cc.Invoke(ctx, SDSController_DeleteZFSSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *sDSControllerClient) ListZFSSnapshots(ctx context.Context, in *ListZFSSnapshotsRequest, opts ...grpc.CallOption) (*ListZFSSnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListZFSSnapshotsResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListZFSSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *sDSControllerClient) RestoreZFSSnapshot(ctx context.Context, in *RestoreZFSSnapshotRequest, opts ...grpc.CallOption) (*RestoreZFSSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreZFSSnapshotResponse)
+	err := c.cc.Invoke(ctx, SDSController_RestoreZFSSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) CloneZFSSnapshot(ctx context.Context, in *CloneZFSSnapshotRequest, opts ...grpc.CallOption) (*CloneZFSSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneZFSSnapshotResponse)
+	err := c.cc.Invoke(ctx, SDSController_CloneZFSSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) GetSetupStatus(ctx context.Context, in *GetSetupStatusRequest, opts ...grpc.CallOption) (*GetSetupStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSetupStatusResponse)
+	err := c.cc.Invoke(ctx, SDSController_GetSetupStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) TestNodeConnection(ctx context.Context, in *TestNodeConnectionRequest, opts ...grpc.CallOption) (*TestNodeConnectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestNodeConnectionResponse)
+	err := c.cc.Invoke(ctx, SDSController_TestNodeConnection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDSControllerClient) CompleteSetup(ctx context.Context, in *CompleteSetupRequest, opts ...grpc.CallOption) (*CompleteSetupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteSetupResponse)
+	err := c.cc.Invoke(ctx, SDSController_CompleteSetup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *sDSControllerClient) CreateLvmSnapshot(ctx context.Context, in *CreateLvmSnapshotRequest, opts ...grpc.CallOption) (*CreateLvmSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLvmSnapshotResponse)
+	err := c.cc.Invoke(ctx, SDSController_CreateLvmSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *sDSControllerClient) DeleteLvmSnapshot(ctx context.Context, in *DeleteLvmSnapshotRequest, opts ...grpc.CallOption) (*DeleteLvmSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLvmSnapshotResponse)
+	err := c.cc.Invoke(ctx, SDSController_DeleteLvmSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *sDSControllerClient) ListLvmSnapshots(ctx context.Context, in *ListLvmSnapshotsRequest, opts ...grpc.CallOption) (*ListLvmSnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLvmSnapshotsResponse)
+	err := c.cc.Invoke(ctx, SDSController_ListLvmSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *sDSControllerClient) RestoreLvmSnapshot(ctx context.Context, in *RestoreLvmSnapshotRequest, opts ...grpc.CallOption) (*RestoreLvmSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreLvmSnapshotResponse)
+	err := c.cc.Invoke(ctx, SDSController_RestoreLvmSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SDSControllerServer is the server API for SDSController service.
+// All implementations must embed UnimplementedSDSControllerServer
+// for forward compatibility.
+//
+// SDS Controller Service
+type SDSControllerServer interface {
+	// Pool operations
+	CreatePool(context.Context, *CreatePoolRequest) (*CreatePoolResponse, error)
+	DeletePool(context.Context, *DeletePoolRequest) (*DeletePoolResponse, error)
+	GetPool(context.Context, *GetPoolRequest) (*GetPoolResponse, error)
+	ListPools(context.Context, *ListPoolsRequest) (*ListPoolsResponse, error)
+	AddDiskToPool(context.Context, *AddDiskToPoolRequest) (*AddDiskToPoolResponse, error)
+	GetPoolHistory(context.Context, *GetPoolHistoryRequest) (*GetPoolHistoryResponse, error)
+	// Node operations
+	RegisterNode(context.Context, *RegisterNodeRequest) (*RegisterNodeResponse, error)
+	UnregisterNode(context.Context, *UnregisterNodeRequest) (*UnregisterNodeResponse, error)
+	GetNode(context.Context, *GetNodeRequest) (*GetNodeResponse, error)
+	ListNodes(context.Context, *ListNodesRequest) (*ListNodesResponse, error)
+	SetNodeAddress(context.Context, *SetNodeAddressRequest) (*SetNodeAddressResponse, error)
+	TrustNode(context.Context, *TrustNodeRequest) (*TrustNodeResponse, error)
+	DiscoverDisks(context.Context, *DiscoverDisksRequest) (*DiscoverDisksResponse, error)
+	HardenNode(context.Context, *HardenNodeRequest) (*HardenNodeResponse, error)
+	SetNodeMaintenance(context.Context, *SetNodeMaintenanceRequest) (*SetNodeMaintenanceResponse, error)
+	ClearNodeMaintenance(context.Context, *ClearNodeMaintenanceRequest) (*ClearNodeMaintenanceResponse, error)
+	ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error)
+	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
+	// Compares the clocks of the nodes with the controller's
+	CheckTime(context.Context, *CheckTimeRequest) (*CheckTimeResponse, error)
+	// Admin only: runs an ad-hoc command, requires the admin token and admin.exec
+	NodeExec(context.Context, *NodeExecRequest) (*NodeExecResponse, error)
+	// Admin only: writes a file to nodes and verifies its checksum
+	PushFile(context.Context, *PushFileRequest) (*PushFileResponse, error)
+	// Streams the journal of drbd-reactor, the DRBD kernel messages or a
+	// gateway on a node
+	StreamNodeLogs(*StreamNodeLogsRequest, grpc.ServerStreamingServer[NodeLogLine]) error
+	// Resource operations
+	CreateResource(context.Context, *CreateResourceRequest) (*CreateResourceResponse, error)
+	DeleteResource(context.Context, *DeleteResourceRequest) (*DeleteResourceResponse, error)
+	SetMaxPeers(context.Context, *SetMaxPeersRequest) (*SetMaxPeersResponse, error)
+	MigratePool(context.Context, *MigratePoolRequest) (*MigratePoolResponse, error)
+	ConvertStorage(context.Context, *ConvertStorageRequest) (*ConvertStorageResponse, error)
+	ReplaceDisk(context.Context, *ReplaceDiskRequest) (*ReplaceDiskResponse, error)
+	// Takes a resource down everywhere and keeps it down until it is started
+	StopResource(context.Context, *StopResourceRequest) (*StopResourceResponse, error)
+	StartResource(context.Context, *StartResourceRequest) (*StartResourceResponse, error)
+	// Pause or resume the resync of a resource, or of its connections to a peer
+	PauseSync(context.Context, *PauseSyncRequest) (*PauseSyncResponse, error)
+	ResumeSync(context.Context, *ResumeSyncRequest) (*ResumeSyncResponse, error)
+	// Overrides the resync rate of a resource for a while, or reverts it
+	SetSyncRate(context.Context, *SetSyncRateRequest) (*SetSyncRateResponse, error)
+	ExecFenceTest(context.Context, *ExecFenceTestRequest) (*ExecFenceTestResponse, error)
+	ActivateResource(context.Context, *ActivateResourceRequest) (*ActivateResourceResponse, error)
+	DeactivateResource(context.Context, *DeactivateResourceRequest) (*DeactivateResourceResponse, error)
+	FencePeer(context.Context, *FencePeerRequest) (*FencePeerResponse, error)
+	UnfencePeer(context.Context, *UnfencePeerRequest) (*UnfencePeerResponse, error)
+	ReportDrbdEvent(context.Context, *ReportDrbdEventRequest) (*ReportDrbdEventResponse, error)
+	InstallFenceHandlers(context.Context, *InstallFenceHandlersRequest) (*InstallFenceHandlersResponse, error)
+	ListFenceConstraints(context.Context, *ListFenceConstraintsRequest) (*ListFenceConstraintsResponse, error)
+	GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error)
+	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
+	AddVolume(context.Context, *AddVolumeRequest) (*AddVolumeResponse, error)
+	RemoveVolume(context.Context, *RemoveVolumeRequest) (*RemoveVolumeResponse, error)
+	ResizeVolume(context.Context, *ResizeVolumeRequest) (*ResizeVolumeResponse, error)
+	GetVolume(context.Context, *GetVolumeRequest) (*GetVolumeResponse, error)
+	ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error)
+	ResourceStatus(context.Context, *ResourceStatusRequest) (*ResourceStatusResponse, error)
+	ExportResource(context.Context, *ExportResourceRequest) (*ExportResourceResponse, error)
+	DiffResource(context.Context, *DiffResourceRequest) (*DiffResourceResponse, error)
+	GetNodeResourceConfig(context.Context, *GetNodeResourceConfigRequest) (*GetNodeResourceConfigResponse, error)
+	RenderConfig(context.Context, *RenderConfigRequest) (*RenderConfigResponse, error)
+	SetPrimary(context.Context, *SetPrimaryRequest) (*SetPrimaryResponse, error)
+	SetSecondary(context.Context, *SetSecondaryRequest) (*SetSecondaryResponse, error)
+	CreateFilesystem(context.Context, *CreateFilesystemRequest) (*CreateFilesystemResponse, error)
+	MountResource(context.Context, *MountResourceRequest) (*MountResourceResponse, error)
+	UnmountResource(context.Context, *UnmountResourceRequest) (*UnmountResourceResponse, error)
+	MakeHa(context.Context, *MakeHaRequest) (*MakeHaResponse, error)
+	EvictHa(context.Context, *EvictHaRequest) (*EvictHaResponse, error)
+	UpdateHa(context.Context, *UpdateHaRequest) (*UpdateHaResponse, error)
+	FailoverHa(context.Context, *FailoverHaRequest) (*FailoverHaResponse, error)
+	DeleteHa(context.Context, *DeleteHaRequest) (*DeleteHaResponse, error)
+	GetHa(context.Context, *GetHaRequest) (*GetHaResponse, error)
+	ListHa(context.Context, *ListHaRequest) (*ListHaResponse, error)
+	GetHaStatus(context.Context, *GetHaStatusRequest) (*GetHaStatusResponse, error)
+	ImportPacemakerHa(context.Context, *ImportPacemakerHaRequest) (*ImportPacemakerHaResponse, error)
+	ListVIPs(context.Context, *ListVIPsRequest) (*ListVIPsResponse, error)
+	// Disaster recovery operations
+	DrSwitchover(context.Context, *DrSwitchoverRequest) (*DrSwitchoverResponse, error)
+	DrFailback(context.Context, *DrFailbackRequest) (*DrFailbackResponse, error)
+	// Placement rules
+	AddPlacementRule(context.Context, *AddPlacementRuleRequest) (*AddPlacementRuleResponse, error)
+	DeletePlacementRule(context.Context, *DeletePlacementRuleRequest) (*DeletePlacementRuleResponse, error)
+	ListPlacementRules(context.Context, *ListPlacementRulesRequest) (*ListPlacementRulesResponse, error)
+	// Events log
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	// Hooks notifying external systems of events. Setting, deleting and
+	// testing a hook is admin only.
+	SetEventHook(context.Context, *SetEventHookRequest) (*SetEventHookResponse, error)
+	DeleteEventHook(context.Context, *DeleteEventHookRequest) (*DeleteEventHookResponse, error)
+	ListEventHooks(context.Context, *ListEventHooksRequest) (*ListEventHooksResponse, error)
+	TestEventHook(context.Context, *TestEventHookRequest) (*TestEventHookResponse, error)
+	// Cluster report (capacity, failovers, resyncs, snapshots, alerts over a period)
+	GetClusterReport(context.Context, *GetClusterReportRequest) (*GetClusterReportResponse, error)
+	GetAlertRules(context.Context, *GetAlertRulesRequest) (*GetAlertRulesResponse, error)
+	// Clusters served by the controller, select one with the x-sds-cluster header
+	ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error)
+	// Controller build and node component versions, for support and bug reports
+	GetClusterInfo(context.Context, *GetClusterInfoRequest) (*GetClusterInfoResponse, error)
+	// Nodes, pools, resources, HA, gateways and recent events in one call for
+	// dashboards, from what the controller stored and polled last. Served
+	// with an ETag over REST.
+	GetOverview(context.Context, *GetOverviewRequest) (*GetOverviewResponse, error)
+	// Admin operations
+	Freeze(context.Context, *FreezeRequest) (*FreezeResponse, error)
+	Unfreeze(context.Context, *UnfreezeRequest) (*UnfreezeResponse, error)
+	GetFreezeStatus(context.Context, *GetFreezeStatusRequest) (*GetFreezeStatusResponse, error)
+	CollectGarbage(context.Context, *CollectGarbageRequest) (*CollectGarbageResponse, error)
+	Rediscover(context.Context, *RediscoverRequest) (*RediscoverResponse, error)
+	// Admin only: streams a gzipped tarball of logs, a redacted database dump,
+	// job transcripts and the DRBD and drbd-reactor state of the nodes
+	SupportBundle(*SupportBundleRequest, grpc.ServerStreamingServer[SupportBundleChunk]) error
+	// Reconcile operations (database records vs. state on the nodes)
+	GetDriftReport(context.Context, *GetDriftReportRequest) (*GetDriftReportResponse, error)
+	Repair(context.Context, *RepairRequest) (*RepairResponse, error)
+	// Rebalance operations (Primary placement across nodes)
+	Rebalance(context.Context, *RebalanceRequest) (*RebalanceResponse, error)
+	// Jobs (records of mutating operations with per-step timing)
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
+	ResumeJob(context.Context, *ResumeJobRequest) (*ResumeJobResponse, error)
+	RollbackJob(context.Context, *RollbackJobRequest) (*RollbackJobResponse, error)
+	// Progress of the job of a call sent with the x-sds-progress-id header
+	WatchJobProgress(*WatchJobProgressRequest, grpc.ServerStreamingServer[JobProgress]) error
+	// DRBD global config (versioned /etc/drbd.d/global_common.conf on all nodes)
+	GetDrbdGlobalConfig(context.Context, *GetDrbdGlobalConfigRequest) (*GetDrbdGlobalConfigResponse, error)
+	SetDrbdGlobalConfig(context.Context, *SetDrbdGlobalConfigRequest) (*SetDrbdGlobalConfigResponse, error)
+	ListDrbdGlobalConfigs(context.Context, *ListDrbdGlobalConfigsRequest) (*ListDrbdGlobalConfigsResponse, error)
+	RollbackDrbdGlobalConfig(context.Context, *RollbackDrbdGlobalConfigRequest) (*RollbackDrbdGlobalConfigResponse, error)
+	// Replication network probe (latency and throughput between nodes)
+	ProbeNetwork(context.Context, *ProbeNetworkRequest) (*ProbeNetworkResponse, error)
+	ListNetProbes(context.Context, *ListNetProbesRequest) (*ListNetProbesResponse, error)
+	// Snapshot operations (LVM or ZFS, detected from the resource)
+	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error)
+	DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error)
+	RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error)
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	GetSnapshotUsage(context.Context, *GetSnapshotUsageRequest) (*GetSnapshotUsageResponse, error)
+	SetSnapshotHook(context.Context, *SetSnapshotHookRequest) (*SetSnapshotHookResponse, error)
+	DeleteSnapshotHook(context.Context, *DeleteSnapshotHookRequest) (*DeleteSnapshotHookResponse, error)
+	ListSnapshotHooks(context.Context, *ListSnapshotHooksRequest) (*ListSnapshotHooksResponse, error)
+	// Snapshots of several resources taken, deleted and restored together
+	// under a label, one job per call
+	CreateSnapshotGroup(context.Context, *CreateSnapshotGroupRequest) (*SnapshotGroupResponse, error)
+	DeleteSnapshotGroup(context.Context, *DeleteSnapshotGroupRequest) (*SnapshotGroupResponse, error)
+	RestoreSnapshotGroup(context.Context, *RestoreSnapshotGroupRequest) (*SnapshotGroupResponse, error)
+	ListSnapshotGroups(context.Context, *ListSnapshotGroupsRequest) (*ListSnapshotGroupsResponse, error)
+	SetReplicationPolicy(context.Context, *SetReplicationPolicyRequest) (*SetReplicationPolicyResponse, error)
+	DeleteReplicationPolicy(context.Context, *DeleteReplicationPolicyRequest) (*DeleteReplicationPolicyResponse, error)
+	ListReplicationPolicies(context.Context, *ListReplicationPoliciesRequest) (*ListReplicationPoliciesResponse, error)
+	RunReplication(context.Context, *RunReplicationRequest) (*RunReplicationResponse, error)
+	// Gateway operations
+	CreateNFSGateway(context.Context, *CreateNFSGatewayRequest) (*CreateNFSGatewayResponse, error)
+	CreateISCSIGateway(context.Context, *CreateISCSIGatewayRequest) (*CreateISCSIGatewayResponse, error)
+	CreateNVMeGateway(context.Context, *CreateNVMeGatewayRequest) (*CreateNVMeGatewayResponse, error)
+	DeleteGateway(context.Context, *DeleteGatewayRequest) (*DeleteGatewayResponse, error)
+	GetGateway(context.Context, *GetGatewayRequest) (*GetGatewayResponse, error)
+	ListGateways(context.Context, *ListGatewaysRequest) (*ListGatewaysResponse, error)
+	StartGateway(context.Context, *StartGatewayRequest) (*StartGatewayResponse, error)
+	StopGateway(context.Context, *StopGatewayRequest) (*StopGatewayResponse, error)
+	ListGatewayClients(context.Context, *ListGatewayClientsRequest) (*ListGatewayClientsResponse, error)
+	// Client (initiator) helpers, executed on a registered client node
+	NVMeConnect(context.Context, *NVMeConnectRequest) (*NVMeConnectResponse, error)
+	NVMeDisconnect(context.Context, *NVMeDisconnectRequest) (*NVMeDisconnectResponse, error)
+	GetISCSIClientConfig(context.Context, *GetISCSIClientConfigRequest) (*GetISCSIClientConfigResponse, error)
+	ValidateISCSIInitiator(context.Context, *ValidateISCSIInitiatorRequest) (*ValidateISCSIInitiatorResponse, error)
+	NFSMount(context.Context, *NFSMountRequest) (*NFSMountResponse, error)
+	// ZFS operations
+	CreateZFSPool(context.Context, *CreateZFSPoolRequest) (*CreateZFSPoolResponse, error)
+	DeleteZFSPool(context.Context, *DeleteZFSPoolRequest) (*DeleteZFSPoolResponse, error)
+	ListZFSpools(context.Context, *ListZFSPoolsRequest) (*ListZFSPoolsResponse, error)
+	CreateZFSDataset(context.Context, *CreateZFSDatasetRequest) (*CreateZFSDatasetResponse, error)
+	CreateZFSVolume(context.Context, *CreateZFSVolumeRequest) (*CreateZFSVolumeResponse, error)
+	ResizeZFSVolume(context.Context, *ResizeZFSVolumeRequest) (*ResizeZFSVolumeResponse, error)
+	DeleteZFSDataset(context.Context, *DeleteZFSDatasetRequest) (*DeleteZFSDatasetResponse, error)
+	// Deprecated: Do not use.
+	// ZFS Snapshot operations
+	// Deprecated: use the generic snapshot operations, which detect the backend
+	CreateZFSSnapshot(context.Context, *CreateZFSSnapshotRequest) (*CreateZFSSnapshotResponse, error)
+	// Deprecated: Do not use.
+	DeleteZFSSnapshot(context.Context, *DeleteZFSSnapshotRequest) (*DeleteZFSSnapshotResponse, error)
+	// Deprecated: Do not use.
+	ListZFSSnapshots(context.Context, *ListZFSSnapshotsRequest) (*ListZFSSnapshotsResponse, error)
+	// Deprecated: Do not use.
+	RestoreZFSSnapshot(context.Context, *RestoreZFSSnapshotRequest) (*RestoreZFSSnapshotResponse, error)
+	CloneZFSSnapshot(context.Context, *CloneZFSSnapshotRequest) (*CloneZFSSnapshotResponse, error)
+	// First boot setup
+	GetSetupStatus(context.Context, *GetSetupStatusRequest) (*GetSetupStatusResponse, error)
+	TestNodeConnection(context.Context, *TestNodeConnectionRequest) (*TestNodeConnectionResponse, error)
+	CompleteSetup(context.Context, *CompleteSetupRequest) (*CompleteSetupResponse, error)
+	// Deprecated: Do not use.
+	// LVM Snapshot operations
+	// Deprecated: use the generic snapshot operations, which detect the backend
+	CreateLvmSnapshot(context.Context, *CreateLvmSnapshotRequest) (*CreateLvmSnapshotResponse, error)
+	// Deprecated: Do not use.
+	DeleteLvmSnapshot(context.Context, *DeleteLvmSnapshotRequest) (*DeleteLvmSnapshotResponse, error)
+	// Deprecated: Do not use.
+	ListLvmSnapshots(context.Context, *ListLvmSnapshotsRequest) (*ListLvmSnapshotsResponse, error)
+	// Deprecated: Do not use.
+	RestoreLvmSnapshot(context.Context, *RestoreLvmSnapshotRequest) (*RestoreLvmSnapshotResponse, error)
+	mustEmbedUnimplementedSDSControllerServer()
+}
+
+// UnimplementedSDSControllerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSDSControllerServer struct{}
+
+func (UnimplementedSDSControllerServer) CreatePool(context.Context, *CreatePoolRequest) (*CreatePoolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePool not implemented")
+}
+func (UnimplementedSDSControllerServer) DeletePool(context.Context, *DeletePoolRequest) (*DeletePoolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePool not implemented")
+}
+func (UnimplementedSDSControllerServer) GetPool(context.Context, *GetPoolRequest) (*GetPoolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPool not implemented")
+}
+func (UnimplementedSDSControllerServer) ListPools(context.Context, *ListPoolsRequest) (*ListPoolsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPools not implemented")
+}
+func (UnimplementedSDSControllerServer) AddDiskToPool(context.Context, *AddDiskToPoolRequest) (*AddDiskToPoolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddDiskToPool not implemented")
+}
+func (UnimplementedSDSControllerServer) GetPoolHistory(context.Context, *GetPoolHistoryRequest) (*GetPoolHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPoolHistory not implemented")
+}
+func (UnimplementedSDSControllerServer) RegisterNode(context.Context, *RegisterNodeRequest) (*RegisterNodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterNode not implemented")
+}
+func (UnimplementedSDSControllerServer) UnregisterNode(context.Context, *UnregisterNodeRequest) (*UnregisterNodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnregisterNode not implemented")
+}
+func (UnimplementedSDSControllerServer) GetNode(context.Context, *GetNodeRequest) (*GetNodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNode not implemented")
+}
+func (UnimplementedSDSControllerServer) ListNodes(context.Context, *ListNodesRequest) (*ListNodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNodes not implemented")
+}
+func (UnimplementedSDSControllerServer) SetNodeAddress(context.Context, *SetNodeAddressRequest) (*SetNodeAddressResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetNodeAddress not implemented")
+}
+func (UnimplementedSDSControllerServer) TrustNode(context.Context, *TrustNodeRequest) (*TrustNodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TrustNode not implemented")
+}
+func (UnimplementedSDSControllerServer) DiscoverDisks(context.Context, *DiscoverDisksRequest) (*DiscoverDisksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiscoverDisks not implemented")
+}
+func (UnimplementedSDSControllerServer) HardenNode(context.Context, *HardenNodeRequest) (*HardenNodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method HardenNode not implemented")
+}
+func (UnimplementedSDSControllerServer) SetNodeMaintenance(context.Context, *SetNodeMaintenanceRequest) (*SetNodeMaintenanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetNodeMaintenance not implemented")
+}
+func (UnimplementedSDSControllerServer) ClearNodeMaintenance(context.Context, *ClearNodeMaintenanceRequest) (*ClearNodeMaintenanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClearNodeMaintenance not implemented")
+}
+func (UnimplementedSDSControllerServer) ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMaintenanceWindows not implemented")
+}
+func (UnimplementedSDSControllerServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method HealthCheck not implemented")
+}
+func (UnimplementedSDSControllerServer) CheckTime(context.Context, *CheckTimeRequest) (*CheckTimeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckTime not implemented")
+}
+func (UnimplementedSDSControllerServer) NodeExec(context.Context, *NodeExecRequest) (*NodeExecResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method NodeExec not implemented")
+}
+func (UnimplementedSDSControllerServer) PushFile(context.Context, *PushFileRequest) (*PushFileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PushFile not implemented")
+}
+func (UnimplementedSDSControllerServer) StreamNodeLogs(*StreamNodeLogsRequest, grpc.ServerStreamingServer[NodeLogLine]) error {
+	return status.Error(codes.Unimplemented, "method StreamNodeLogs not implemented")
+}
+func (UnimplementedSDSControllerServer) CreateResource(context.Context, *CreateResourceRequest) (*CreateResourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateResource not implemented")
+}
+func (UnimplementedSDSControllerServer) DeleteResource(context.Context, *DeleteResourceRequest) (*DeleteResourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteResource not implemented")
+}
+func (UnimplementedSDSControllerServer) SetMaxPeers(context.Context, *SetMaxPeersRequest) (*SetMaxPeersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMaxPeers not implemented")
+}
+func (UnimplementedSDSControllerServer) MigratePool(context.Context, *MigratePoolRequest) (*MigratePoolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MigratePool not implemented")
+}
+func (UnimplementedSDSControllerServer) ConvertStorage(context.Context, *ConvertStorageRequest) (*ConvertStorageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConvertStorage not implemented")
+}
+func (UnimplementedSDSControllerServer) ReplaceDisk(context.Context, *ReplaceDiskRequest) (*ReplaceDiskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplaceDisk not implemented")
+}
+func (UnimplementedSDSControllerServer) StopResource(context.Context, *StopResourceRequest) (*StopResourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StopResource not implemented")
+}
+func (UnimplementedSDSControllerServer) StartResource(context.Context, *StartResourceRequest) (*StartResourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartResource not implemented")
+}
+func (UnimplementedSDSControllerServer) PauseSync(context.Context, *PauseSyncRequest) (*PauseSyncResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseSync not implemented")
+}
+func (UnimplementedSDSControllerServer) ResumeSync(context.Context, *ResumeSyncRequest) (*ResumeSyncResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeSync not implemented")
+}
+func (UnimplementedSDSControllerServer) SetSyncRate(context.Context, *SetSyncRateRequest) (*SetSyncRateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSyncRate not implemented")
+}
+func (UnimplementedSDSControllerServer) ExecFenceTest(context.Context, *ExecFenceTestRequest) (*ExecFenceTestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExecFenceTest not implemented")
+}
+func (UnimplementedSDSControllerServer) ActivateResource(context.Context, *ActivateResourceRequest) (*ActivateResourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ActivateResource not implemented")
+}
+func (UnimplementedSDSControllerServer) DeactivateResource(context.Context, *DeactivateResourceRequest) (*DeactivateResourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeactivateResource not implemented")
+}
+func (UnimplementedSDSControllerServer) FencePeer(context.Context, *FencePeerRequest) (*FencePeerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FencePeer not implemented")
+}
+func (UnimplementedSDSControllerServer) UnfencePeer(context.Context, *UnfencePeerRequest) (*UnfencePeerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnfencePeer not implemented")
+}
+func (UnimplementedSDSControllerServer) ReportDrbdEvent(context.Context, *ReportDrbdEventRequest) (*ReportDrbdEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportDrbdEvent not implemented")
+}
+func (UnimplementedSDSControllerServer) InstallFenceHandlers(context.Context, *InstallFenceHandlersRequest) (*InstallFenceHandlersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InstallFenceHandlers not implemented")
+}
+func (UnimplementedSDSControllerServer) ListFenceConstraints(context.Context, *ListFenceConstraintsRequest) (*ListFenceConstraintsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFenceConstraints not implemented")
+}
+func (UnimplementedSDSControllerServer) GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetResource not implemented")
+}
+func (UnimplementedSDSControllerServer) ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListResources not implemented")
+}
+func (UnimplementedSDSControllerServer) AddVolume(context.Context, *AddVolumeRequest) (*AddVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddVolume not implemented")
+}
+func (UnimplementedSDSControllerServer) RemoveVolume(context.Context, *RemoveVolumeRequest) (*RemoveVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveVolume not implemented")
+}
+func (UnimplementedSDSControllerServer) ResizeVolume(context.Context, *ResizeVolumeRequest) (*ResizeVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResizeVolume not implemented")
+}
+func (UnimplementedSDSControllerServer) GetVolume(context.Context, *GetVolumeRequest) (*GetVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVolume not implemented")
+}
+func (UnimplementedSDSControllerServer) ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVolumes not implemented")
+}
+func (UnimplementedSDSControllerServer) ResourceStatus(context.Context, *ResourceStatusRequest) (*ResourceStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResourceStatus not implemented")
+}
+func (UnimplementedSDSControllerServer) ExportResource(context.Context, *ExportResourceRequest) (*ExportResourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportResource not implemented")
+}
+func (UnimplementedSDSControllerServer) DiffResource(context.Context, *DiffResourceRequest) (*DiffResourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffResource not implemented")
+}
+func (UnimplementedSDSControllerServer) GetNodeResourceConfig(context.Context, *GetNodeResourceConfigRequest) (*GetNodeResourceConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNodeResourceConfig not implemented")
+}
+func (UnimplementedSDSControllerServer) RenderConfig(context.Context, *RenderConfigRequest) (*RenderConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenderConfig not implemented")
+}
+func (UnimplementedSDSControllerServer) SetPrimary(context.Context, *SetPrimaryRequest) (*SetPrimaryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPrimary not implemented")
+}
+func (UnimplementedSDSControllerServer) SetSecondary(context.Context, *SetSecondaryRequest) (*SetSecondaryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSecondary not implemented")
+}
+func (UnimplementedSDSControllerServer) CreateFilesystem(context.Context, *CreateFilesystemRequest) (*CreateFilesystemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateFilesystem not implemented")
+}
+func (UnimplementedSDSControllerServer) MountResource(context.Context, *MountResourceRequest) (*MountResourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MountResource not implemented")
+}
+func (UnimplementedSDSControllerServer) UnmountResource(context.Context, *UnmountResourceRequest) (*UnmountResourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnmountResource not implemented")
+}
+func (UnimplementedSDSControllerServer) MakeHa(context.Context, *MakeHaRequest) (*MakeHaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MakeHa not implemented")
+}
+func (UnimplementedSDSControllerServer) EvictHa(context.Context, *EvictHaRequest) (*EvictHaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EvictHa not implemented")
+}
+func (UnimplementedSDSControllerServer) UpdateHa(context.Context, *UpdateHaRequest) (*UpdateHaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateHa not implemented")
+}
+func (UnimplementedSDSControllerServer) FailoverHa(context.Context, *FailoverHaRequest) (*FailoverHaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FailoverHa not implemented")
+}
+func (UnimplementedSDSControllerServer) DeleteHa(context.Context, *DeleteHaRequest) (*DeleteHaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteHa not implemented")
+}
+func (UnimplementedSDSControllerServer) GetHa(context.Context, *GetHaRequest) (*GetHaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHa not implemented")
+}
+func (UnimplementedSDSControllerServer) ListHa(context.Context, *ListHaRequest) (*ListHaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListHa not implemented")
+}
+func (UnimplementedSDSControllerServer) GetHaStatus(context.Context, *GetHaStatusRequest) (*GetHaStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHaStatus not implemented")
+}
+func (UnimplementedSDSControllerServer) ImportPacemakerHa(context.Context, *ImportPacemakerHaRequest) (*ImportPacemakerHaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportPacemakerHa not implemented")
+}
+func (UnimplementedSDSControllerServer) ListVIPs(context.Context, *ListVIPsRequest) (*ListVIPsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVIPs not implemented")
+}
+func (UnimplementedSDSControllerServer) DrSwitchover(context.Context, *DrSwitchoverRequest) (*DrSwitchoverResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DrSwitchover not implemented")
+}
+func (UnimplementedSDSControllerServer) DrFailback(context.Context, *DrFailbackRequest) (*DrFailbackResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DrFailback not implemented")
+}
+func (UnimplementedSDSControllerServer) AddPlacementRule(context.Context, *AddPlacementRuleRequest) (*AddPlacementRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddPlacementRule not implemented")
+}
+func (UnimplementedSDSControllerServer) DeletePlacementRule(context.Context, *DeletePlacementRuleRequest) (*DeletePlacementRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePlacementRule not implemented")
+}
+func (UnimplementedSDSControllerServer) ListPlacementRules(context.Context, *ListPlacementRulesRequest) (*ListPlacementRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPlacementRules not implemented")
+}
+func (UnimplementedSDSControllerServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedSDSControllerServer) SetEventHook(context.Context, *SetEventHookRequest) (*SetEventHookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetEventHook not implemented")
+}
+func (UnimplementedSDSControllerServer) DeleteEventHook(context.Context, *DeleteEventHookRequest) (*DeleteEventHookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteEventHook not implemented")
+}
+func (UnimplementedSDSControllerServer) ListEventHooks(context.Context, *ListEventHooksRequest) (*ListEventHooksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEventHooks not implemented")
+}
+func (UnimplementedSDSControllerServer) TestEventHook(context.Context, *TestEventHookRequest) (*TestEventHookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TestEventHook not implemented")
+}
+func (UnimplementedSDSControllerServer) GetClusterReport(context.Context, *GetClusterReportRequest) (*GetClusterReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetClusterReport not implemented")
+}
+func (UnimplementedSDSControllerServer) GetAlertRules(context.Context, *GetAlertRulesRequest) (*GetAlertRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAlertRules not implemented")
+}
+func (UnimplementedSDSControllerServer) ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListClusters not implemented")
+}
+func (UnimplementedSDSControllerServer) GetClusterInfo(context.Context, *GetClusterInfoRequest) (*GetClusterInfoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetClusterInfo not implemented")
+}
+func (UnimplementedSDSControllerServer) GetOverview(context.Context, *GetOverviewRequest) (*GetOverviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOverview not implemented")
+}
+func (UnimplementedSDSControllerServer) Freeze(context.Context, *FreezeRequest) (*FreezeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Freeze not implemented")
+}
+func (UnimplementedSDSControllerServer) Unfreeze(context.Context, *UnfreezeRequest) (*UnfreezeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Unfreeze not implemented")
+}
+func (UnimplementedSDSControllerServer) GetFreezeStatus(context.Context, *GetFreezeStatusRequest) (*GetFreezeStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFreezeStatus not implemented")
+}
+func (UnimplementedSDSControllerServer) CollectGarbage(context.Context, *CollectGarbageRequest) (*CollectGarbageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CollectGarbage not implemented")
+}
+func (UnimplementedSDSControllerServer) Rediscover(context.Context, *RediscoverRequest) (*RediscoverResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Rediscover not implemented")
+}
+func (UnimplementedSDSControllerServer) SupportBundle(*SupportBundleRequest, grpc.ServerStreamingServer[SupportBundleChunk]) error {
+	return status.Error(codes.Unimplemented, "method SupportBundle not implemented")
+}
+func (UnimplementedSDSControllerServer) GetDriftReport(context.Context, *GetDriftReportRequest) (*GetDriftReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDriftReport not implemented")
+}
+func (UnimplementedSDSControllerServer) Repair(context.Context, *RepairRequest) (*RepairResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Repair not implemented")
+}
+func (UnimplementedSDSControllerServer) Rebalance(context.Context, *RebalanceRequest) (*RebalanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Rebalance not implemented")
+}
+func (UnimplementedSDSControllerServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedSDSControllerServer) GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedSDSControllerServer) ResumeJob(context.Context, *ResumeJobRequest) (*ResumeJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeJob not implemented")
+}
+func (UnimplementedSDSControllerServer) RollbackJob(context.Context, *RollbackJobRequest) (*RollbackJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RollbackJob not implemented")
+}
+func (UnimplementedSDSControllerServer) WatchJobProgress(*WatchJobProgressRequest, grpc.ServerStreamingServer[JobProgress]) error {
+	return status.Error(codes.Unimplemented, "method WatchJobProgress not implemented")
+}
+func (UnimplementedSDSControllerServer) GetDrbdGlobalConfig(context.Context, *GetDrbdGlobalConfigRequest) (*GetDrbdGlobalConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDrbdGlobalConfig not implemented")
+}
+func (UnimplementedSDSControllerServer) SetDrbdGlobalConfig(context.Context, *SetDrbdGlobalConfigRequest) (*SetDrbdGlobalConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDrbdGlobalConfig not implemented")
+}
+func (UnimplementedSDSControllerServer) ListDrbdGlobalConfigs(context.Context, *ListDrbdGlobalConfigsRequest) (*ListDrbdGlobalConfigsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDrbdGlobalConfigs not implemented")
+}
+func (UnimplementedSDSControllerServer) RollbackDrbdGlobalConfig(context.Context, *RollbackDrbdGlobalConfigRequest) (*RollbackDrbdGlobalConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RollbackDrbdGlobalConfig not implemented")
+}
+func (UnimplementedSDSControllerServer) ProbeNetwork(context.Context, *ProbeNetworkRequest) (*ProbeNetworkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ProbeNetwork not implemented")
+}
+func (UnimplementedSDSControllerServer) ListNetProbes(context.Context, *ListNetProbesRequest) (*ListNetProbesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNetProbes not implemented")
+}
+func (UnimplementedSDSControllerServer) CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (UnimplementedSDSControllerServer) DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSnapshot not implemented")
+}
+func (UnimplementedSDSControllerServer) RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
+func (UnimplementedSDSControllerServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedSDSControllerServer) GetSnapshotUsage(context.Context, *GetSnapshotUsageRequest) (*GetSnapshotUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSnapshotUsage not implemented")
+}
+func (UnimplementedSDSControllerServer) SetSnapshotHook(context.Context, *SetSnapshotHookRequest) (*SetSnapshotHookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSnapshotHook not implemented")
+}
+func (UnimplementedSDSControllerServer) DeleteSnapshotHook(context.Context, *DeleteSnapshotHookRequest) (*DeleteSnapshotHookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSnapshotHook not implemented")
+}
+func (UnimplementedSDSControllerServer) ListSnapshotHooks(context.Context, *ListSnapshotHooksRequest) (*ListSnapshotHooksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSnapshotHooks not implemented")
+}
+func (UnimplementedSDSControllerServer) CreateSnapshotGroup(context.Context, *CreateSnapshotGroupRequest) (*SnapshotGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSnapshotGroup not implemented")
+}
+func (UnimplementedSDSControllerServer) DeleteSnapshotGroup(context.Context, *DeleteSnapshotGroupRequest) (*SnapshotGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSnapshotGroup not implemented")
+}
+func (UnimplementedSDSControllerServer) RestoreSnapshotGroup(context.Context, *RestoreSnapshotGroupRequest) (*SnapshotGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreSnapshotGroup not implemented")
+}
+func (UnimplementedSDSControllerServer) ListSnapshotGroups(context.Context, *ListSnapshotGroupsRequest) (*ListSnapshotGroupsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSnapshotGroups not implemented")
+}
+func (UnimplementedSDSControllerServer) SetReplicationPolicy(context.Context, *SetReplicationPolicyRequest) (*SetReplicationPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetReplicationPolicy not implemented")
+}
+func (UnimplementedSDSControllerServer) DeleteReplicationPolicy(context.Context, *DeleteReplicationPolicyRequest) (*DeleteReplicationPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteReplicationPolicy not implemented")
+}
+func (UnimplementedSDSControllerServer) ListReplicationPolicies(context.Context, *ListReplicationPoliciesRequest) (*ListReplicationPoliciesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReplicationPolicies not implemented")
+}
+func (UnimplementedSDSControllerServer) RunReplication(context.Context, *RunReplicationRequest) (*RunReplicationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunReplication not implemented")
+}
+func (UnimplementedSDSControllerServer) CreateNFSGateway(context.Context, *CreateNFSGatewayRequest) (*CreateNFSGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateNFSGateway not implemented")
+}
+func (UnimplementedSDSControllerServer) CreateISCSIGateway(context.Context, *CreateISCSIGatewayRequest) (*CreateISCSIGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateISCSIGateway not implemented")
+}
+func (UnimplementedSDSControllerServer) CreateNVMeGateway(context.Context, *CreateNVMeGatewayRequest) (*CreateNVMeGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateNVMeGateway not implemented")
+}
+func (UnimplementedSDSControllerServer) DeleteGateway(context.Context, *DeleteGatewayRequest) (*DeleteGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGateway not implemented")
+}
+func (UnimplementedSDSControllerServer) GetGateway(context.Context, *GetGatewayRequest) (*GetGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGateway not implemented")
+}
+func (UnimplementedSDSControllerServer) ListGateways(context.Context, *ListGatewaysRequest) (*ListGatewaysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGateways not implemented")
+}
+func (UnimplementedSDSControllerServer) StartGateway(context.Context, *StartGatewayRequest) (*StartGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartGateway not implemented")
+}
+func (UnimplementedSDSControllerServer) StopGateway(context.Context, *StopGatewayRequest) (*StopGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StopGateway not implemented")
+}
+func (UnimplementedSDSControllerServer) ListGatewayClients(context.Context, *ListGatewayClientsRequest) (*ListGatewayClientsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGatewayClients not implemented")
+}
+func (UnimplementedSDSControllerServer) NVMeConnect(context.Context, *NVMeConnectRequest) (*NVMeConnectResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method NVMeConnect not implemented")
+}
+func (UnimplementedSDSControllerServer) NVMeDisconnect(context.Context, *NVMeDisconnectRequest) (*NVMeDisconnectResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method NVMeDisconnect not implemented")
+}
+func (UnimplementedSDSControllerServer) GetISCSIClientConfig(context.Context, *GetISCSIClientConfigRequest) (*GetISCSIClientConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetISCSIClientConfig not implemented")
+}
+func (UnimplementedSDSControllerServer) ValidateISCSIInitiator(context.Context, *ValidateISCSIInitiatorRequest) (*ValidateISCSIInitiatorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateISCSIInitiator not implemented")
+}
+func (UnimplementedSDSControllerServer) NFSMount(context.Context, *NFSMountRequest) (*NFSMountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method NFSMount not implemented")
+}
+func (UnimplementedSDSControllerServer) CreateZFSPool(context.Context, *CreateZFSPoolRequest) (*CreateZFSPoolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateZFSPool not implemented")
+}
+func (UnimplementedSDSControllerServer) DeleteZFSPool(context.Context, *DeleteZFSPoolRequest) (*DeleteZFSPoolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteZFSPool not implemented")
+}
+func (UnimplementedSDSControllerServer) ListZFSpools(context.Context, *ListZFSPoolsRequest) (*ListZFSPoolsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListZFSpools not implemented")
+}
+func (UnimplementedSDSControllerServer) CreateZFSDataset(context.Context, *CreateZFSDatasetRequest) (*CreateZFSDatasetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateZFSDataset not implemented")
+}
+func (UnimplementedSDSControllerServer) CreateZFSVolume(context.Context, *CreateZFSVolumeRequest) (*CreateZFSVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateZFSVolume not implemented")
+}
+func (UnimplementedSDSControllerServer) ResizeZFSVolume(context.Context, *ResizeZFSVolumeRequest) (*ResizeZFSVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResizeZFSVolume not implemented")
+}
+func (UnimplementedSDSControllerServer) DeleteZFSDataset(context.Context, *DeleteZFSDatasetRequest) (*DeleteZFSDatasetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteZFSDataset not implemented")
+}
+func (UnimplementedSDSControllerServer) CreateZFSSnapshot(context.Context, *CreateZFSSnapshotRequest) (*CreateZFSSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateZFSSnapshot not implemented")
+}
+func (UnimplementedSDSControllerServer) DeleteZFSSnapshot(context.Context, *DeleteZFSSnapshotRequest) (*DeleteZFSSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteZFSSnapshot not implemented")
+}
+func (UnimplementedSDSControllerServer) ListZFSSnapshots(context.Context, *ListZFSSnapshotsRequest) (*ListZFSSnapshotsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListZFSSnapshots not implemented")
+}
+func (UnimplementedSDSControllerServer) RestoreZFSSnapshot(context.Context, *RestoreZFSSnapshotRequest) (*RestoreZFSSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreZFSSnapshot not implemented")
+}
+func (UnimplementedSDSControllerServer) CloneZFSSnapshot(context.Context, *CloneZFSSnapshotRequest) (*CloneZFSSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloneZFSSnapshot not implemented")
+}
+func (UnimplementedSDSControllerServer) GetSetupStatus(context.Context, *GetSetupStatusRequest) (*GetSetupStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSetupStatus not implemented")
+}
+func (UnimplementedSDSControllerServer) TestNodeConnection(context.Context, *TestNodeConnectionRequest) (*TestNodeConnectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TestNodeConnection not implemented")
+}
+func (UnimplementedSDSControllerServer) CompleteSetup(context.Context, *CompleteSetupRequest) (*CompleteSetupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteSetup not implemented")
+}
+func (UnimplementedSDSControllerServer) CreateLvmSnapshot(context.Context, *CreateLvmSnapshotRequest) (*CreateLvmSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateLvmSnapshot not implemented")
+}
+func (UnimplementedSDSControllerServer) DeleteLvmSnapshot(context.Context, *DeleteLvmSnapshotRequest) (*DeleteLvmSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteLvmSnapshot not implemented")
+}
+func (UnimplementedSDSControllerServer) ListLvmSnapshots(context.Context, *ListLvmSnapshotsRequest) (*ListLvmSnapshotsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLvmSnapshots not implemented")
+}
+func (UnimplementedSDSControllerServer) RestoreLvmSnapshot(context.Context, *RestoreLvmSnapshotRequest) (*RestoreLvmSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreLvmSnapshot not implemented")
+}
+func (UnimplementedSDSControllerServer) mustEmbedUnimplementedSDSControllerServer() {}
+func (UnimplementedSDSControllerServer) testEmbeddedByValue()                       {}
+
+// UnsafeSDSControllerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SDSControllerServer will
+// result in compilation errors.
+type UnsafeSDSControllerServer interface {
+	mustEmbedUnimplementedSDSControllerServer()
+}
+
+func RegisterSDSControllerServer(s grpc.ServiceRegistrar, srv SDSControllerServer) {
+	// If the following call panics, it indicates UnimplementedSDSControllerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SDSController_ServiceDesc, srv)
+}
+
+func _SDSController_CreatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).CreatePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_CreatePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).CreatePool(ctx, req.(*CreatePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_DeletePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).DeletePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_DeletePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).DeletePool(ctx, req.(*DeletePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_GetPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).GetPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_GetPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).GetPool(ctx, req.(*GetPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ListPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ListPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ListPools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ListPools(ctx, req.(*ListPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_AddDiskToPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDiskToPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).AddDiskToPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_AddDiskToPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).AddDiskToPool(ctx, req.(*AddDiskToPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_GetPoolHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPoolHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).GetPoolHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_GetPoolHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).GetPoolHistory(ctx, req.(*GetPoolHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_RegisterNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).RegisterNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_RegisterNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).RegisterNode(ctx, req.(*RegisterNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_UnregisterNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).UnregisterNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_UnregisterNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).UnregisterNode(ctx, req.(*UnregisterNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_GetNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).GetNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_GetNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).GetNode(ctx, req.(*GetNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ListNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ListNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ListNodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ListNodes(ctx, req.(*ListNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_SetNodeAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNodeAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).SetNodeAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_SetNodeAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).SetNodeAddress(ctx, req.(*SetNodeAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_TrustNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrustNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).TrustNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_TrustNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).TrustNode(ctx, req.(*TrustNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_DiscoverDisks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscoverDisksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).DiscoverDisks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_DiscoverDisks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).DiscoverDisks(ctx, req.(*DiscoverDisksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_HardenNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HardenNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).HardenNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_HardenNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).HardenNode(ctx, req.(*HardenNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_SetNodeMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNodeMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).SetNodeMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_SetNodeMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).SetNodeMaintenance(ctx, req.(*SetNodeMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ClearNodeMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearNodeMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ClearNodeMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ClearNodeMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ClearNodeMaintenance(ctx, req.(*ClearNodeMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ListMaintenanceWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaintenanceWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ListMaintenanceWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ListMaintenanceWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ListMaintenanceWindows(ctx, req.(*ListMaintenanceWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_HealthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).HealthCheck(ctx, req.(*HealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_CheckTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).CheckTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_CheckTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).CheckTime(ctx, req.(*CheckTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_NodeExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).NodeExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_NodeExec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).NodeExec(ctx, req.(*NodeExecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_PushFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).PushFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_PushFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).PushFile(ctx, req.(*PushFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_StreamNodeLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamNodeLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SDSControllerServer).StreamNodeLogs(m, &grpc.GenericServerStream[StreamNodeLogsRequest, NodeLogLine]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SDSController_StreamNodeLogsServer = grpc.ServerStreamingServer[NodeLogLine]
+
+func _SDSController_CreateResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).CreateResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_CreateResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).CreateResource(ctx, req.(*CreateResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_DeleteResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).DeleteResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_DeleteResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).DeleteResource(ctx, req.(*DeleteResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_SetMaxPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaxPeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).SetMaxPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_SetMaxPeers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).SetMaxPeers(ctx, req.(*SetMaxPeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_MigratePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigratePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).MigratePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_MigratePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).MigratePool(ctx, req.(*MigratePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ConvertStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ConvertStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ConvertStorage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ConvertStorage(ctx, req.(*ConvertStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ReplaceDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ReplaceDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ReplaceDisk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ReplaceDisk(ctx, req.(*ReplaceDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_StopResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).StopResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_StopResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).StopResource(ctx, req.(*StopResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_StartResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).StartResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_StartResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).StartResource(ctx, req.(*StartResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_PauseSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).PauseSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_PauseSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).PauseSync(ctx, req.(*PauseSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ResumeSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ResumeSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ResumeSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ResumeSync(ctx, req.(*ResumeSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_SetSyncRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSyncRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).SetSyncRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_SetSyncRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).SetSyncRate(ctx, req.(*SetSyncRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ExecFenceTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecFenceTestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ExecFenceTest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ExecFenceTest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ExecFenceTest(ctx, req.(*ExecFenceTestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ActivateResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ActivateResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ActivateResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ActivateResource(ctx, req.(*ActivateResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_DeactivateResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).DeactivateResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_DeactivateResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).DeactivateResource(ctx, req.(*DeactivateResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_FencePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FencePeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).FencePeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_FencePeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).FencePeer(ctx, req.(*FencePeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_UnfencePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfencePeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).UnfencePeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_UnfencePeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).UnfencePeer(ctx, req.(*UnfencePeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ReportDrbdEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportDrbdEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ReportDrbdEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ReportDrbdEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ReportDrbdEvent(ctx, req.(*ReportDrbdEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_InstallFenceHandlers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstallFenceHandlersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).InstallFenceHandlers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_InstallFenceHandlers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).InstallFenceHandlers(ctx, req.(*InstallFenceHandlersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ListFenceConstraints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFenceConstraintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ListFenceConstraints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ListFenceConstraints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ListFenceConstraints(ctx, req.(*ListFenceConstraintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_GetResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).GetResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_GetResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).GetResource(ctx, req.(*GetResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ListResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ListResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ListResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ListResources(ctx, req.(*ListResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_AddVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).AddVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_AddVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).AddVolume(ctx, req.(*AddVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_RemoveVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).RemoveVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_RemoveVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).RemoveVolume(ctx, req.(*RemoveVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ResizeVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ResizeVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ResizeVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ResizeVolume(ctx, req.(*ResizeVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_GetVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).GetVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_GetVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).GetVolume(ctx, req.(*GetVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ListVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVolumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ListVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ListVolumes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ListVolumes(ctx, req.(*ListVolumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ResourceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ResourceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ResourceStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ResourceStatus(ctx, req.(*ResourceStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ExportResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ExportResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ExportResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ExportResource(ctx, req.(*ExportResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_DiffResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).DiffResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_DiffResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).DiffResource(ctx, req.(*DiffResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_GetNodeResourceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeResourceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).GetNodeResourceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_GetNodeResourceConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).GetNodeResourceConfig(ctx, req.(*GetNodeResourceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_RenderConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).RenderConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_RenderConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).RenderConfig(ctx, req.(*RenderConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_SetPrimary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrimaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).SetPrimary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_SetPrimary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).SetPrimary(ctx, req.(*SetPrimaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_SetSecondary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSecondaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).SetSecondary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_SetSecondary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).SetSecondary(ctx, req.(*SetSecondaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_CreateFilesystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFilesystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).CreateFilesystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_CreateFilesystem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).CreateFilesystem(ctx, req.(*CreateFilesystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_MountResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MountResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).MountResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_MountResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).MountResource(ctx, req.(*MountResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_UnmountResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmountResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).UnmountResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_UnmountResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).UnmountResource(ctx, req.(*UnmountResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_MakeHa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MakeHaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).MakeHa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_MakeHa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).MakeHa(ctx, req.(*MakeHaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_EvictHa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvictHaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).EvictHa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_EvictHa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).EvictHa(ctx, req.(*EvictHaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_UpdateHa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).UpdateHa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_UpdateHa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).UpdateHa(ctx, req.(*UpdateHaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_FailoverHa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailoverHaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).FailoverHa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_FailoverHa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).FailoverHa(ctx, req.(*FailoverHaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_DeleteHa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).DeleteHa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_DeleteHa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).DeleteHa(ctx, req.(*DeleteHaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_GetHa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).GetHa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_GetHa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).GetHa(ctx, req.(*GetHaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ListHa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ListHa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ListHa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ListHa(ctx, req.(*ListHaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_GetHaStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHaStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).GetHaStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_GetHaStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).GetHaStatus(ctx, req.(*GetHaStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ImportPacemakerHa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportPacemakerHaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ImportPacemakerHa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ImportPacemakerHa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ImportPacemakerHa(ctx, req.(*ImportPacemakerHaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ListVIPs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVIPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ListVIPs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ListVIPs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ListVIPs(ctx, req.(*ListVIPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_DrSwitchover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrSwitchoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).DrSwitchover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_DrSwitchover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).DrSwitchover(ctx, req.(*DrSwitchoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_DrFailback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrFailbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).DrFailback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_DrFailback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).DrFailback(ctx, req.(*DrFailbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_AddPlacementRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPlacementRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).AddPlacementRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_AddPlacementRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).AddPlacementRule(ctx, req.(*AddPlacementRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_DeletePlacementRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlacementRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).DeletePlacementRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_DeletePlacementRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).DeletePlacementRule(ctx, req.(*DeletePlacementRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSDSControllerServer) DeleteLvmSnapshot(context.Context, *DeleteLvmSnapshotRequest) (*DeleteLvmSnapshotResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteLvmSnapshot not implemented")
+
+func _SDSController_ListPlacementRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlacementRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ListPlacementRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ListPlacementRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ListPlacementRules(ctx, req.(*ListPlacementRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSDSControllerServer) ListLvmSnapshots(context.Context, *ListLvmSnapshotsRequest) (*ListLvmSnapshotsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListLvmSnapshots not implemented")
+
+func _SDSController_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ListEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSDSControllerServer) RestoreLvmSnapshot(context.Context, *RestoreLvmSnapshotRequest) (*RestoreLvmSnapshotResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RestoreLvmSnapshot not implemented")
+
+func _SDSController_SetEventHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEventHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).SetEventHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_SetEventHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).SetEventHook(ctx, req.(*SetEventHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSDSControllerServer) mustEmbedUnimplementedSDSControllerServer() {}
-func (UnimplementedSDSControllerServer) testEmbeddedByValue()                       {}
 
-// UnsafeSDSControllerServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SDSControllerServer will
-// result in compilation errors.
-type UnsafeSDSControllerServer interface {
-	mustEmbedUnimplementedSDSControllerServer()
+func _SDSController_DeleteEventHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).DeleteEventHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_DeleteEventHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).DeleteEventHook(ctx, req.(*DeleteEventHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterSDSControllerServer(s grpc.ServiceRegistrar, srv SDSControllerServer) {
-	// If the following call panics, it indicates UnimplementedSDSControllerServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _SDSController_ListEventHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventHooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ListEventHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ListEventHooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ListEventHooks(ctx, req.(*ListEventHooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_TestEventHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestEventHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).TestEventHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_TestEventHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).TestEventHook(ctx, req.(*TestEventHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_GetClusterReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).GetClusterReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_GetClusterReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).GetClusterReport(ctx, req.(*GetClusterReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_GetAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlertRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).GetAlertRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_GetAlertRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).GetAlertRules(ctx, req.(*GetAlertRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_ListClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClustersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ListClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ListClusters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ListClusters(ctx, req.(*ListClustersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_GetClusterInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).GetClusterInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_GetClusterInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).GetClusterInfo(ctx, req.(*GetClusterInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_GetOverview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOverviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).GetOverview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_GetOverview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).GetOverview(ctx, req.(*GetOverviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_Freeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).Freeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_Freeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).Freeze(ctx, req.(*FreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_Unfreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).Unfreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_Unfreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).Unfreeze(ctx, req.(*UnfreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_GetFreezeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFreezeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).GetFreezeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_GetFreezeStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).GetFreezeStatus(ctx, req.(*GetFreezeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_CollectGarbage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectGarbageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).CollectGarbage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_CollectGarbage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).CollectGarbage(ctx, req.(*CollectGarbageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_Rediscover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RediscoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).Rediscover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_Rediscover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).Rediscover(ctx, req.(*RediscoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_SupportBundle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SupportBundleRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SDSControllerServer).SupportBundle(m, &grpc.GenericServerStream[SupportBundleRequest, SupportBundleChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SDSController_SupportBundleServer = grpc.ServerStreamingServer[SupportBundleChunk]
+
+func _SDSController_GetDriftReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDriftReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).GetDriftReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_GetDriftReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).GetDriftReport(ctx, req.(*GetDriftReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_Repair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).Repair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_Repair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).Repair(ctx, req.(*RepairRequest))
 	}
-	s.RegisterService(&SDSController_ServiceDesc, srv)
+	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_CreatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePoolRequest)
+func _SDSController_Rebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).CreatePool(ctx, in)
+		return srv.(SDSControllerServer).Rebalance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_CreatePool_FullMethodName,
+		FullMethod: SDSController_Rebalance_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).CreatePool(ctx, req.(*CreatePoolRequest))
+		return srv.(SDSControllerServer).Rebalance(ctx, req.(*RebalanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_DeletePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePoolRequest)
+func _SDSController_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).DeletePool(ctx, in)
+		return srv.(SDSControllerServer).ListJobs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_DeletePool_FullMethodName,
+		FullMethod: SDSController_ListJobs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).DeletePool(ctx, req.(*DeletePoolRequest))
+		return srv.(SDSControllerServer).ListJobs(ctx, req.(*ListJobsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_GetPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPoolRequest)
+func _SDSController_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).GetPool(ctx, in)
+		return srv.(SDSControllerServer).GetJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_GetPool_FullMethodName,
+		FullMethod: SDSController_GetJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).GetPool(ctx, req.(*GetPoolRequest))
+		return srv.(SDSControllerServer).GetJob(ctx, req.(*GetJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_ListPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPoolsRequest)
+func _SDSController_ResumeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).ListPools(ctx, in)
+		return srv.(SDSControllerServer).ResumeJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_ListPools_FullMethodName,
+		FullMethod: SDSController_ResumeJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).ListPools(ctx, req.(*ListPoolsRequest))
+		return srv.(SDSControllerServer).ResumeJob(ctx, req.(*ResumeJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_AddDiskToPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddDiskToPoolRequest)
+func _SDSController_RollbackJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).AddDiskToPool(ctx, in)
+		return srv.(SDSControllerServer).RollbackJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_AddDiskToPool_FullMethodName,
+		FullMethod: SDSController_RollbackJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).AddDiskToPool(ctx, req.(*AddDiskToPoolRequest))
+		return srv.(SDSControllerServer).RollbackJob(ctx, req.(*RollbackJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_RegisterNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterNodeRequest)
+func _SDSController_WatchJobProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchJobProgressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SDSControllerServer).WatchJobProgress(m, &grpc.GenericServerStream[WatchJobProgressRequest, JobProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SDSController_WatchJobProgressServer = grpc.ServerStreamingServer[JobProgress]
+
+func _SDSController_GetDrbdGlobalConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDrbdGlobalConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).RegisterNode(ctx, in)
+		return srv.(SDSControllerServer).GetDrbdGlobalConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_RegisterNode_FullMethodName,
+		FullMethod: SDSController_GetDrbdGlobalConfig_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).RegisterNode(ctx, req.(*RegisterNodeRequest))
+		return srv.(SDSControllerServer).GetDrbdGlobalConfig(ctx, req.(*GetDrbdGlobalConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_UnregisterNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnregisterNodeRequest)
+func _SDSController_SetDrbdGlobalConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDrbdGlobalConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).UnregisterNode(ctx, in)
+		return srv.(SDSControllerServer).SetDrbdGlobalConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_UnregisterNode_FullMethodName,
+		FullMethod: SDSController_SetDrbdGlobalConfig_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).UnregisterNode(ctx, req.(*UnregisterNodeRequest))
+		return srv.(SDSControllerServer).SetDrbdGlobalConfig(ctx, req.(*SetDrbdGlobalConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_GetNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNodeRequest)
+func _SDSController_ListDrbdGlobalConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDrbdGlobalConfigsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).GetNode(ctx, in)
+		return srv.(SDSControllerServer).ListDrbdGlobalConfigs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_GetNode_FullMethodName,
+		FullMethod: SDSController_ListDrbdGlobalConfigs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).GetNode(ctx, req.(*GetNodeRequest))
+		return srv.(SDSControllerServer).ListDrbdGlobalConfigs(ctx, req.(*ListDrbdGlobalConfigsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_ListNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListNodesRequest)
+func _SDSController_RollbackDrbdGlobalConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackDrbdGlobalConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).ListNodes(ctx, in)
+		return srv.(SDSControllerServer).RollbackDrbdGlobalConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_ListNodes_FullMethodName,
+		FullMethod: SDSController_RollbackDrbdGlobalConfig_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).ListNodes(ctx, req.(*ListNodesRequest))
+		return srv.(SDSControllerServer).RollbackDrbdGlobalConfig(ctx, req.(*RollbackDrbdGlobalConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HealthCheckRequest)
+func _SDSController_ProbeNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbeNetworkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).HealthCheck(ctx, in)
+		return srv.(SDSControllerServer).ProbeNetwork(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_HealthCheck_FullMethodName,
+		FullMethod: SDSController_ProbeNetwork_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).HealthCheck(ctx, req.(*HealthCheckRequest))
+		return srv.(SDSControllerServer).ProbeNetwork(ctx, req.(*ProbeNetworkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_CreateResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateResourceRequest)
+func _SDSController_ListNetProbes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNetProbesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).CreateResource(ctx, in)
+		return srv.(SDSControllerServer).ListNetProbes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_CreateResource_FullMethodName,
+		FullMethod: SDSController_ListNetProbes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).CreateResource(ctx, req.(*CreateResourceRequest))
+		return srv.(SDSControllerServer).ListNetProbes(ctx, req.(*ListNetProbesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_DeleteResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteResourceRequest)
+func _SDSController_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).DeleteResource(ctx, in)
+		return srv.(SDSControllerServer).CreateSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_DeleteResource_FullMethodName,
+		FullMethod: SDSController_CreateSnapshot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).DeleteResource(ctx, req.(*DeleteResourceRequest))
+		return srv.(SDSControllerServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_GetResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetResourceRequest)
+func _SDSController_DeleteSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).GetResource(ctx, in)
+		return srv.(SDSControllerServer).DeleteSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_GetResource_FullMethodName,
+		FullMethod: SDSController_DeleteSnapshot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).GetResource(ctx, req.(*GetResourceRequest))
+		return srv.(SDSControllerServer).DeleteSnapshot(ctx, req.(*DeleteSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_ListResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListResourcesRequest)
+func _SDSController_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).ListResources(ctx, in)
+		return srv.(SDSControllerServer).RestoreSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_ListResources_FullMethodName,
+		FullMethod: SDSController_RestoreSnapshot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).ListResources(ctx, req.(*ListResourcesRequest))
+		return srv.(SDSControllerServer).RestoreSnapshot(ctx, req.(*RestoreSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_AddVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddVolumeRequest)
+func _SDSController_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).AddVolume(ctx, in)
+		return srv.(SDSControllerServer).ListSnapshots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_AddVolume_FullMethodName,
+		FullMethod: SDSController_ListSnapshots_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).AddVolume(ctx, req.(*AddVolumeRequest))
+		return srv.(SDSControllerServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_RemoveVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveVolumeRequest)
+func _SDSController_GetSnapshotUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSnapshotUsageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).RemoveVolume(ctx, in)
+		return srv.(SDSControllerServer).GetSnapshotUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_RemoveVolume_FullMethodName,
+		FullMethod: SDSController_GetSnapshotUsage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).RemoveVolume(ctx, req.(*RemoveVolumeRequest))
+		return srv.(SDSControllerServer).GetSnapshotUsage(ctx, req.(*GetSnapshotUsageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_ResizeVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResizeVolumeRequest)
+func _SDSController_SetSnapshotHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSnapshotHookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).ResizeVolume(ctx, in)
+		return srv.(SDSControllerServer).SetSnapshotHook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_ResizeVolume_FullMethodName,
+		FullMethod: SDSController_SetSnapshotHook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).ResizeVolume(ctx, req.(*ResizeVolumeRequest))
+		return srv.(SDSControllerServer).SetSnapshotHook(ctx, req.(*SetSnapshotHookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_ResourceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResourceStatusRequest)
+func _SDSController_DeleteSnapshotHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotHookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).ResourceStatus(ctx, in)
+		return srv.(SDSControllerServer).DeleteSnapshotHook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_ResourceStatus_FullMethodName,
+		FullMethod: SDSController_DeleteSnapshotHook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).ResourceStatus(ctx, req.(*ResourceStatusRequest))
+		return srv.(SDSControllerServer).DeleteSnapshotHook(ctx, req.(*DeleteSnapshotHookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_SetPrimary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetPrimaryRequest)
+func _SDSController_ListSnapshotHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotHooksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).SetPrimary(ctx, in)
+		return srv.(SDSControllerServer).ListSnapshotHooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_SetPrimary_FullMethodName,
+		FullMethod: SDSController_ListSnapshotHooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).SetPrimary(ctx, req.(*SetPrimaryRequest))
+		return srv.(SDSControllerServer).ListSnapshotHooks(ctx, req.(*ListSnapshotHooksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_SetSecondary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetSecondaryRequest)
+func _SDSController_CreateSnapshotGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotGroupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).SetSecondary(ctx, in)
+		return srv.(SDSControllerServer).CreateSnapshotGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_SetSecondary_FullMethodName,
+		FullMethod: SDSController_CreateSnapshotGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).SetSecondary(ctx, req.(*SetSecondaryRequest))
+		return srv.(SDSControllerServer).CreateSnapshotGroup(ctx, req.(*CreateSnapshotGroupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_CreateFilesystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateFilesystemRequest)
+func _SDSController_DeleteSnapshotGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotGroupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).CreateFilesystem(ctx, in)
+		return srv.(SDSControllerServer).DeleteSnapshotGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_CreateFilesystem_FullMethodName,
+		FullMethod: SDSController_DeleteSnapshotGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).CreateFilesystem(ctx, req.(*CreateFilesystemRequest))
+		return srv.(SDSControllerServer).DeleteSnapshotGroup(ctx, req.(*DeleteSnapshotGroupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_MountResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MountResourceRequest)
+func _SDSController_RestoreSnapshotGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSnapshotGroupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).MountResource(ctx, in)
+		return srv.(SDSControllerServer).RestoreSnapshotGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_MountResource_FullMethodName,
+		FullMethod: SDSController_RestoreSnapshotGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).MountResource(ctx, req.(*MountResourceRequest))
+		return srv.(SDSControllerServer).RestoreSnapshotGroup(ctx, req.(*RestoreSnapshotGroupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_UnmountResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnmountResourceRequest)
+func _SDSController_ListSnapshotGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotGroupsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).UnmountResource(ctx, in)
+		return srv.(SDSControllerServer).ListSnapshotGroups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_UnmountResource_FullMethodName,
+		FullMethod: SDSController_ListSnapshotGroups_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).UnmountResource(ctx, req.(*UnmountResourceRequest))
+		return srv.(SDSControllerServer).ListSnapshotGroups(ctx, req.(*ListSnapshotGroupsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_MakeHa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MakeHaRequest)
+func _SDSController_SetReplicationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReplicationPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).MakeHa(ctx, in)
+		return srv.(SDSControllerServer).SetReplicationPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_MakeHa_FullMethodName,
+		FullMethod: SDSController_SetReplicationPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).MakeHa(ctx, req.(*MakeHaRequest))
+		return srv.(SDSControllerServer).SetReplicationPolicy(ctx, req.(*SetReplicationPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_EvictHa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EvictHaRequest)
+func _SDSController_DeleteReplicationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReplicationPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).EvictHa(ctx, in)
+		return srv.(SDSControllerServer).DeleteReplicationPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_EvictHa_FullMethodName,
+		FullMethod: SDSController_DeleteReplicationPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).EvictHa(ctx, req.(*EvictHaRequest))
+		return srv.(SDSControllerServer).DeleteReplicationPolicy(ctx, req.(*DeleteReplicationPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_DeleteHa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteHaRequest)
+func _SDSController_ListReplicationPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReplicationPoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).DeleteHa(ctx, in)
+		return srv.(SDSControllerServer).ListReplicationPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_DeleteHa_FullMethodName,
+		FullMethod: SDSController_ListReplicationPolicies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).DeleteHa(ctx, req.(*DeleteHaRequest))
+		return srv.(SDSControllerServer).ListReplicationPolicies(ctx, req.(*ListReplicationPoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_GetHa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHaRequest)
+func _SDSController_RunReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunReplicationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).GetHa(ctx, in)
+		return srv.(SDSControllerServer).RunReplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_GetHa_FullMethodName,
+		FullMethod: SDSController_RunReplication_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).GetHa(ctx, req.(*GetHaRequest))
+		return srv.(SDSControllerServer).RunReplication(ctx, req.(*RunReplicationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_ListHa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHaRequest)
+func _SDSController_CreateNFSGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNFSGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).ListHa(ctx, in)
+		return srv.(SDSControllerServer).CreateNFSGateway(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_ListHa_FullMethodName,
+		FullMethod: SDSController_CreateNFSGateway_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).ListHa(ctx, req.(*ListHaRequest))
+		return srv.(SDSControllerServer).CreateNFSGateway(ctx, req.(*CreateNFSGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSnapshotRequest)
+func _SDSController_CreateISCSIGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateISCSIGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).CreateSnapshot(ctx, in)
+		return srv.(SDSControllerServer).CreateISCSIGateway(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_CreateSnapshot_FullMethodName,
+		FullMethod: SDSController_CreateISCSIGateway_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
+		return srv.(SDSControllerServer).CreateISCSIGateway(ctx, req.(*CreateISCSIGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_DeleteSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteSnapshotRequest)
+func _SDSController_CreateNVMeGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNVMeGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).DeleteSnapshot(ctx, in)
+		return srv.(SDSControllerServer).CreateNVMeGateway(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_DeleteSnapshot_FullMethodName,
+		FullMethod: SDSController_CreateNVMeGateway_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).DeleteSnapshot(ctx, req.(*DeleteSnapshotRequest))
+		return srv.(SDSControllerServer).CreateNVMeGateway(ctx, req.(*CreateNVMeGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestoreSnapshotRequest)
+func _SDSController_DeleteGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).RestoreSnapshot(ctx, in)
+		return srv.(SDSControllerServer).DeleteGateway(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_RestoreSnapshot_FullMethodName,
+		FullMethod: SDSController_DeleteGateway_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).RestoreSnapshot(ctx, req.(*RestoreSnapshotRequest))
+		return srv.(SDSControllerServer).DeleteGateway(ctx, req.(*DeleteGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListSnapshotsRequest)
+func _SDSController_GetGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).ListSnapshots(ctx, in)
+		return srv.(SDSControllerServer).GetGateway(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_ListSnapshots_FullMethodName,
+		FullMethod: SDSController_GetGateway_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+		return srv.(SDSControllerServer).GetGateway(ctx, req.(*GetGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_CreateNFSGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateNFSGatewayRequest)
+func _SDSController_ListGateways_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGatewaysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).CreateNFSGateway(ctx, in)
+		return srv.(SDSControllerServer).ListGateways(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_CreateNFSGateway_FullMethodName,
+		FullMethod: SDSController_ListGateways_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).CreateNFSGateway(ctx, req.(*CreateNFSGatewayRequest))
+		return srv.(SDSControllerServer).ListGateways(ctx, req.(*ListGatewaysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_CreateISCSIGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateISCSIGatewayRequest)
+func _SDSController_StartGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).CreateISCSIGateway(ctx, in)
+		return srv.(SDSControllerServer).StartGateway(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_CreateISCSIGateway_FullMethodName,
+		FullMethod: SDSController_StartGateway_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).CreateISCSIGateway(ctx, req.(*CreateISCSIGatewayRequest))
+		return srv.(SDSControllerServer).StartGateway(ctx, req.(*StartGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_CreateNVMeGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateNVMeGatewayRequest)
+func _SDSController_StopGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).CreateNVMeGateway(ctx, in)
+		return srv.(SDSControllerServer).StopGateway(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_CreateNVMeGateway_FullMethodName,
+		FullMethod: SDSController_StopGateway_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).CreateNVMeGateway(ctx, req.(*CreateNVMeGatewayRequest))
+		return srv.(SDSControllerServer).StopGateway(ctx, req.(*StopGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_DeleteGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteGatewayRequest)
+func _SDSController_ListGatewayClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGatewayClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).ListGatewayClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_ListGatewayClients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).ListGatewayClients(ctx, req.(*ListGatewayClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_NVMeConnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NVMeConnectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).DeleteGateway(ctx, in)
+		return srv.(SDSControllerServer).NVMeConnect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_DeleteGateway_FullMethodName,
+		FullMethod: SDSController_NVMeConnect_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).DeleteGateway(ctx, req.(*DeleteGatewayRequest))
+		return srv.(SDSControllerServer).NVMeConnect(ctx, req.(*NVMeConnectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_GetGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGatewayRequest)
+func _SDSController_NVMeDisconnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NVMeDisconnectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).GetGateway(ctx, in)
+		return srv.(SDSControllerServer).NVMeDisconnect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_GetGateway_FullMethodName,
+		FullMethod: SDSController_NVMeDisconnect_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).GetGateway(ctx, req.(*GetGatewayRequest))
+		return srv.(SDSControllerServer).NVMeDisconnect(ctx, req.(*NVMeDisconnectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_ListGateways_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListGatewaysRequest)
+func _SDSController_GetISCSIClientConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetISCSIClientConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).ListGateways(ctx, in)
+		return srv.(SDSControllerServer).GetISCSIClientConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_ListGateways_FullMethodName,
+		FullMethod: SDSController_GetISCSIClientConfig_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).ListGateways(ctx, req.(*ListGatewaysRequest))
+		return srv.(SDSControllerServer).GetISCSIClientConfig(ctx, req.(*GetISCSIClientConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_StartGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartGatewayRequest)
+func _SDSController_ValidateISCSIInitiator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateISCSIInitiatorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).StartGateway(ctx, in)
+		return srv.(SDSControllerServer).ValidateISCSIInitiator(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_StartGateway_FullMethodName,
+		FullMethod: SDSController_ValidateISCSIInitiator_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).StartGateway(ctx, req.(*StartGatewayRequest))
+		return srv.(SDSControllerServer).ValidateISCSIInitiator(ctx, req.(*ValidateISCSIInitiatorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SDSController_StopGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopGatewayRequest)
+func _SDSController_NFSMount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NFSMountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SDSControllerServer).StopGateway(ctx, in)
+		return srv.(SDSControllerServer).NFSMount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SDSController_StopGateway_FullMethodName,
+		FullMethod: SDSController_NFSMount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SDSControllerServer).StopGateway(ctx, req.(*StopGatewayRequest))
+		return srv.(SDSControllerServer).NFSMount(ctx, req.(*NFSMountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1922,6 +5230,60 @@ func _SDSController_CloneZFSSnapshot_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SDSController_GetSetupStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSetupStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).GetSetupStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_GetSetupStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).GetSetupStatus(ctx, req.(*GetSetupStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_TestNodeConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestNodeConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).TestNodeConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_TestNodeConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).TestNodeConnection(ctx, req.(*TestNodeConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDSController_CompleteSetup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteSetupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDSControllerServer).CompleteSetup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SDSController_CompleteSetup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDSControllerServer).CompleteSetup(ctx, req.(*CompleteSetupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SDSController_CreateLvmSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateLvmSnapshotRequest)
 	if err := dec(in); err != nil {
@@ -2021,6 +5383,10 @@ var SDSController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddDiskToPool",
 			Handler:    _SDSController_AddDiskToPool_Handler,
 		},
+		{
+			MethodName: "GetPoolHistory",
+			Handler:    _SDSController_GetPoolHistory_Handler,
+		},
 		{
 			MethodName: "RegisterNode",
 			Handler:    _SDSController_RegisterNode_Handler,
@@ -2037,10 +5403,50 @@ var SDSController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListNodes",
 			Handler:    _SDSController_ListNodes_Handler,
 		},
+		{
+			MethodName: "SetNodeAddress",
+			Handler:    _SDSController_SetNodeAddress_Handler,
+		},
+		{
+			MethodName: "TrustNode",
+			Handler:    _SDSController_TrustNode_Handler,
+		},
+		{
+			MethodName: "DiscoverDisks",
+			Handler:    _SDSController_DiscoverDisks_Handler,
+		},
+		{
+			MethodName: "HardenNode",
+			Handler:    _SDSController_HardenNode_Handler,
+		},
+		{
+			MethodName: "SetNodeMaintenance",
+			Handler:    _SDSController_SetNodeMaintenance_Handler,
+		},
+		{
+			MethodName: "ClearNodeMaintenance",
+			Handler:    _SDSController_ClearNodeMaintenance_Handler,
+		},
+		{
+			MethodName: "ListMaintenanceWindows",
+			Handler:    _SDSController_ListMaintenanceWindows_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _SDSController_HealthCheck_Handler,
 		},
+		{
+			MethodName: "CheckTime",
+			Handler:    _SDSController_CheckTime_Handler,
+		},
+		{
+			MethodName: "NodeExec",
+			Handler:    _SDSController_NodeExec_Handler,
+		},
+		{
+			MethodName: "PushFile",
+			Handler:    _SDSController_PushFile_Handler,
+		},
 		{
 			MethodName: "CreateResource",
 			Handler:    _SDSController_CreateResource_Handler,
@@ -2049,6 +5455,74 @@ var SDSController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteResource",
 			Handler:    _SDSController_DeleteResource_Handler,
 		},
+		{
+			MethodName: "SetMaxPeers",
+			Handler:    _SDSController_SetMaxPeers_Handler,
+		},
+		{
+			MethodName: "MigratePool",
+			Handler:    _SDSController_MigratePool_Handler,
+		},
+		{
+			MethodName: "ConvertStorage",
+			Handler:    _SDSController_ConvertStorage_Handler,
+		},
+		{
+			MethodName: "ReplaceDisk",
+			Handler:    _SDSController_ReplaceDisk_Handler,
+		},
+		{
+			MethodName: "StopResource",
+			Handler:    _SDSController_StopResource_Handler,
+		},
+		{
+			MethodName: "StartResource",
+			Handler:    _SDSController_StartResource_Handler,
+		},
+		{
+			MethodName: "PauseSync",
+			Handler:    _SDSController_PauseSync_Handler,
+		},
+		{
+			MethodName: "ResumeSync",
+			Handler:    _SDSController_ResumeSync_Handler,
+		},
+		{
+			MethodName: "SetSyncRate",
+			Handler:    _SDSController_SetSyncRate_Handler,
+		},
+		{
+			MethodName: "ExecFenceTest",
+			Handler:    _SDSController_ExecFenceTest_Handler,
+		},
+		{
+			MethodName: "ActivateResource",
+			Handler:    _SDSController_ActivateResource_Handler,
+		},
+		{
+			MethodName: "DeactivateResource",
+			Handler:    _SDSController_DeactivateResource_Handler,
+		},
+		{
+			MethodName: "FencePeer",
+			Handler:    _SDSController_FencePeer_Handler,
+		},
+		{
+			MethodName: "UnfencePeer",
+			Handler:    _SDSController_UnfencePeer_Handler,
+		},
+		{
+			MethodName: "ReportDrbdEvent",
+			Handler:    _SDSController_ReportDrbdEvent_Handler,
+		},
+		{
+			MethodName: "InstallFenceHandlers",
+			Handler:    _SDSController_InstallFenceHandlers_Handler,
+		},
+		{
+			MethodName: "ListFenceConstraints",
+			Handler:    _SDSController_ListFenceConstraints_Handler,
+		},
 		{
 			MethodName: "GetResource",
 			Handler:    _SDSController_GetResource_Handler,
@@ -2069,10 +5543,34 @@ var SDSController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResizeVolume",
 			Handler:    _SDSController_ResizeVolume_Handler,
 		},
+		{
+			MethodName: "GetVolume",
+			Handler:    _SDSController_GetVolume_Handler,
+		},
+		{
+			MethodName: "ListVolumes",
+			Handler:    _SDSController_ListVolumes_Handler,
+		},
 		{
 			MethodName: "ResourceStatus",
 			Handler:    _SDSController_ResourceStatus_Handler,
 		},
+		{
+			MethodName: "ExportResource",
+			Handler:    _SDSController_ExportResource_Handler,
+		},
+		{
+			MethodName: "DiffResource",
+			Handler:    _SDSController_DiffResource_Handler,
+		},
+		{
+			MethodName: "GetNodeResourceConfig",
+			Handler:    _SDSController_GetNodeResourceConfig_Handler,
+		},
+		{
+			MethodName: "RenderConfig",
+			Handler:    _SDSController_RenderConfig_Handler,
+		},
 		{
 			MethodName: "SetPrimary",
 			Handler:    _SDSController_SetPrimary_Handler,
@@ -2101,6 +5599,14 @@ var SDSController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EvictHa",
 			Handler:    _SDSController_EvictHa_Handler,
 		},
+		{
+			MethodName: "UpdateHa",
+			Handler:    _SDSController_UpdateHa_Handler,
+		},
+		{
+			MethodName: "FailoverHa",
+			Handler:    _SDSController_FailoverHa_Handler,
+		},
 		{
 			MethodName: "DeleteHa",
 			Handler:    _SDSController_DeleteHa_Handler,
@@ -2113,6 +5619,150 @@ var SDSController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListHa",
 			Handler:    _SDSController_ListHa_Handler,
 		},
+		{
+			MethodName: "GetHaStatus",
+			Handler:    _SDSController_GetHaStatus_Handler,
+		},
+		{
+			MethodName: "ImportPacemakerHa",
+			Handler:    _SDSController_ImportPacemakerHa_Handler,
+		},
+		{
+			MethodName: "ListVIPs",
+			Handler:    _SDSController_ListVIPs_Handler,
+		},
+		{
+			MethodName: "DrSwitchover",
+			Handler:    _SDSController_DrSwitchover_Handler,
+		},
+		{
+			MethodName: "DrFailback",
+			Handler:    _SDSController_DrFailback_Handler,
+		},
+		{
+			MethodName: "AddPlacementRule",
+			Handler:    _SDSController_AddPlacementRule_Handler,
+		},
+		{
+			MethodName: "DeletePlacementRule",
+			Handler:    _SDSController_DeletePlacementRule_Handler,
+		},
+		{
+			MethodName: "ListPlacementRules",
+			Handler:    _SDSController_ListPlacementRules_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _SDSController_ListEvents_Handler,
+		},
+		{
+			MethodName: "SetEventHook",
+			Handler:    _SDSController_SetEventHook_Handler,
+		},
+		{
+			MethodName: "DeleteEventHook",
+			Handler:    _SDSController_DeleteEventHook_Handler,
+		},
+		{
+			MethodName: "ListEventHooks",
+			Handler:    _SDSController_ListEventHooks_Handler,
+		},
+		{
+			MethodName: "TestEventHook",
+			Handler:    _SDSController_TestEventHook_Handler,
+		},
+		{
+			MethodName: "GetClusterReport",
+			Handler:    _SDSController_GetClusterReport_Handler,
+		},
+		{
+			MethodName: "GetAlertRules",
+			Handler:    _SDSController_GetAlertRules_Handler,
+		},
+		{
+			MethodName: "ListClusters",
+			Handler:    _SDSController_ListClusters_Handler,
+		},
+		{
+			MethodName: "GetClusterInfo",
+			Handler:    _SDSController_GetClusterInfo_Handler,
+		},
+		{
+			MethodName: "GetOverview",
+			Handler:    _SDSController_GetOverview_Handler,
+		},
+		{
+			MethodName: "Freeze",
+			Handler:    _SDSController_Freeze_Handler,
+		},
+		{
+			MethodName: "Unfreeze",
+			Handler:    _SDSController_Unfreeze_Handler,
+		},
+		{
+			MethodName: "GetFreezeStatus",
+			Handler:    _SDSController_GetFreezeStatus_Handler,
+		},
+		{
+			MethodName: "CollectGarbage",
+			Handler:    _SDSController_CollectGarbage_Handler,
+		},
+		{
+			MethodName: "Rediscover",
+			Handler:    _SDSController_Rediscover_Handler,
+		},
+		{
+			MethodName: "GetDriftReport",
+			Handler:    _SDSController_GetDriftReport_Handler,
+		},
+		{
+			MethodName: "Repair",
+			Handler:    _SDSController_Repair_Handler,
+		},
+		{
+			MethodName: "Rebalance",
+			Handler:    _SDSController_Rebalance_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _SDSController_ListJobs_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _SDSController_GetJob_Handler,
+		},
+		{
+			MethodName: "ResumeJob",
+			Handler:    _SDSController_ResumeJob_Handler,
+		},
+		{
+			MethodName: "RollbackJob",
+			Handler:    _SDSController_RollbackJob_Handler,
+		},
+		{
+			MethodName: "GetDrbdGlobalConfig",
+			Handler:    _SDSController_GetDrbdGlobalConfig_Handler,
+		},
+		{
+			MethodName: "SetDrbdGlobalConfig",
+			Handler:    _SDSController_SetDrbdGlobalConfig_Handler,
+		},
+		{
+			MethodName: "ListDrbdGlobalConfigs",
+			Handler:    _SDSController_ListDrbdGlobalConfigs_Handler,
+		},
+		{
+			MethodName: "RollbackDrbdGlobalConfig",
+			Handler:    _SDSController_RollbackDrbdGlobalConfig_Handler,
+		},
+		{
+			MethodName: "ProbeNetwork",
+			Handler:    _SDSController_ProbeNetwork_Handler,
+		},
+		{
+			MethodName: "ListNetProbes",
+			Handler:    _SDSController_ListNetProbes_Handler,
+		},
 		{
 			MethodName: "CreateSnapshot",
 			Handler:    _SDSController_CreateSnapshot_Handler,
@@ -2129,6 +5779,54 @@ var SDSController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSnapshots",
 			Handler:    _SDSController_ListSnapshots_Handler,
 		},
+		{
+			MethodName: "GetSnapshotUsage",
+			Handler:    _SDSController_GetSnapshotUsage_Handler,
+		},
+		{
+			MethodName: "SetSnapshotHook",
+			Handler:    _SDSController_SetSnapshotHook_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshotHook",
+			Handler:    _SDSController_DeleteSnapshotHook_Handler,
+		},
+		{
+			MethodName: "ListSnapshotHooks",
+			Handler:    _SDSController_ListSnapshotHooks_Handler,
+		},
+		{
+			MethodName: "CreateSnapshotGroup",
+			Handler:    _SDSController_CreateSnapshotGroup_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshotGroup",
+			Handler:    _SDSController_DeleteSnapshotGroup_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshotGroup",
+			Handler:    _SDSController_RestoreSnapshotGroup_Handler,
+		},
+		{
+			MethodName: "ListSnapshotGroups",
+			Handler:    _SDSController_ListSnapshotGroups_Handler,
+		},
+		{
+			MethodName: "SetReplicationPolicy",
+			Handler:    _SDSController_SetReplicationPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteReplicationPolicy",
+			Handler:    _SDSController_DeleteReplicationPolicy_Handler,
+		},
+		{
+			MethodName: "ListReplicationPolicies",
+			Handler:    _SDSController_ListReplicationPolicies_Handler,
+		},
+		{
+			MethodName: "RunReplication",
+			Handler:    _SDSController_RunReplication_Handler,
+		},
 		{
 			MethodName: "CreateNFSGateway",
 			Handler:    _SDSController_CreateNFSGateway_Handler,
@@ -2161,6 +5859,30 @@ var SDSController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopGateway",
 			Handler:    _SDSController_StopGateway_Handler,
 		},
+		{
+			MethodName: "ListGatewayClients",
+			Handler:    _SDSController_ListGatewayClients_Handler,
+		},
+		{
+			MethodName: "NVMeConnect",
+			Handler:    _SDSController_NVMeConnect_Handler,
+		},
+		{
+			MethodName: "NVMeDisconnect",
+			Handler:    _SDSController_NVMeDisconnect_Handler,
+		},
+		{
+			MethodName: "GetISCSIClientConfig",
+			Handler:    _SDSController_GetISCSIClientConfig_Handler,
+		},
+		{
+			MethodName: "ValidateISCSIInitiator",
+			Handler:    _SDSController_ValidateISCSIInitiator_Handler,
+		},
+		{
+			MethodName: "NFSMount",
+			Handler:    _SDSController_NFSMount_Handler,
+		},
 		{
 			MethodName: "CreateZFSPool",
 			Handler:    _SDSController_CreateZFSPool_Handler,
@@ -2209,6 +5931,18 @@ var SDSController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CloneZFSSnapshot",
 			Handler:    _SDSController_CloneZFSSnapshot_Handler,
 		},
+		{
+			MethodName: "GetSetupStatus",
+			Handler:    _SDSController_GetSetupStatus_Handler,
+		},
+		{
+			MethodName: "TestNodeConnection",
+			Handler:    _SDSController_TestNodeConnection_Handler,
+		},
+		{
+			MethodName: "CompleteSetup",
+			Handler:    _SDSController_CompleteSetup_Handler,
+		},
 		{
 			MethodName: "CreateLvmSnapshot",
 			Handler:    _SDSController_CreateLvmSnapshot_Handler,
@@ -2226,6 +5960,22 @@ var SDSController_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _SDSController_RestoreLvmSnapshot_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamNodeLogs",
+			Handler:       _SDSController_StreamNodeLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SupportBundle",
+			Handler:       _SDSController_SupportBundle_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchJobProgress",
+			Handler:       _SDSController_WatchJobProgress_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api/proto/v1/sds.proto",
 }
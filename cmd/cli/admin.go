@@ -0,0 +1,347 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	v1 "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func adminCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Controller administration",
+	}
+
+	cmd.AddCommand(adminFreeze())
+	cmd.AddCommand(adminUnfreeze())
+	cmd.AddCommand(adminStatus())
+	cmd.AddCommand(adminGC())
+	cmd.AddCommand(adminRediscover())
+	cmd.AddCommand(adminSupportBundle())
+
+	return cmd
+}
+
+func adminFreeze() *cobra.Command {
+	var reason string
+
+	cmd := &cobra.Command{
+		Use:   "freeze",
+		Short: "Put the controller in read-only mode",
+		Long: `Put the controller in read-only mode. Mutating operations are rejected until
+'sds admin unfreeze', while status, list and get operations keep working.
+Use it during maintenance windows or to investigate suspected state drift
+safely. The switch survives controller restarts.
+
+Example:
+  sds admin freeze --reason "storage maintenance, ticket 1234"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			status, err := sdsClient.Freeze(ctx, reason)
+			if err != nil {
+				return fmt.Errorf("failed to freeze: %w", err)
+			}
+
+			printFreezeStatus(status)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&reason, "reason", "", "Reason shown to rejected callers")
+
+	return cmd
+}
+
+func adminUnfreeze() *cobra.Command {
+	return &cobra.Command{
+		Use:   "unfreeze",
+		Short: "Leave read-only mode",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			if err := sdsClient.Unfreeze(ctx); err != nil {
+				return fmt.Errorf("failed to unfreeze: %w", err)
+			}
+
+			fmt.Println("Controller unfrozen, changes are allowed")
+			return nil
+		},
+	}
+}
+
+func adminStatus() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show whether the controller is in read-only mode",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			status, err := sdsClient.GetFreezeStatus(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get status: %w", err)
+			}
+
+			printFreezeStatus(status)
+			return nil
+		},
+	}
+}
+
+func adminGC() *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Find and remove orphaned artifacts on the nodes",
+		Long: `Find artifacts on the nodes that no database record refers to and remove them:
+
+  lv, zvol        backing volumes named by naming.volume
+  drbd-config     /etc/drbd.d/*.res files
+  ha-backup       /tmp/ha_backup_* directories left by sds ha create
+  reactor-config  /etc/drbd-reactor.d/sds-*.toml plugins and HA configs
+                  named by naming.ha_config
+
+Artifacts still used by DRBD or open are listed but never removed. Run with
+--dry-run first to review the list.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			orphans, err := sdsClient.CollectGarbage(ctx, dryRun)
+			if err != nil {
+				return fmt.Errorf("failed to collect garbage: %w", err)
+			}
+
+			if len(orphans) == 0 {
+				fmt.Println("No orphaned artifacts found")
+				return nil
+			}
+
+			failed := 0
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tKIND\tPATH\tSTATUS")
+			for _, o := range orphans {
+				status := "orphaned"
+				switch {
+				case o.InUse:
+					status = "in use, kept"
+				case o.Removed:
+					status = "removed"
+				case o.Error != "":
+					status = "failed: " + o.Error
+					failed++
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", o.Node, o.Kind, o.Path, status)
+			}
+			w.Flush()
+
+			if dryRun {
+				fmt.Println("\nDry run, nothing was removed")
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d artifact(s) could not be removed", failed)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only list the orphaned artifacts")
+
+	return cmd
+}
+
+func adminRediscover() *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "rediscover",
+		Short: "Rebuild missing database records from the nodes",
+		Long: `Scan the registered nodes for the artifacts sds created and rebuild the
+database records that are missing for them, e.g. after the controller
+database was lost or restored from an old backup:
+
+  resource   /etc/drbd.d/*.res files generated for this cluster
+  volume     the volumes of those files, with the LVs and zvols backing them
+  ha-config  /etc/drbd-reactor.d promoter configs named by naming.ha_config
+
+Existing records are never changed. DRBD options and peer protocols are not
+recorded again, the DRBD configs on the nodes keep them. LVs and zvols named
+by naming.volume that no DRBD config refers to are listed but cannot be
+rebuilt. If the node records were lost too, add the nodes first. Run with
+--dry-run first to review the list.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			records, err := sdsClient.Rediscover(ctx, dryRun)
+			if err != nil {
+				return fmt.Errorf("failed to rediscover: %w", err)
+			}
+
+			if len(records) == 0 {
+				fmt.Println("No missing records found")
+				return nil
+			}
+
+			failed := 0
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "KIND\tNAME\tNODES\tDETAIL\tSTATUS")
+			for _, r := range records {
+				status := "missing"
+				switch {
+				case r.Restored:
+					status = "restored"
+				case r.Skipped != "":
+					status = "skipped: " + r.Skipped
+				case r.Error != "":
+					status = "failed: " + r.Error
+					failed++
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.Kind, r.Name, strings.Join(r.Nodes, ","), r.Detail, status)
+			}
+			w.Flush()
+
+			if dryRun {
+				fmt.Println("\nDry run, nothing was recorded")
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d record(s) could not be rebuilt", failed)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only list the missing records")
+
+	return cmd
+}
+
+func adminSupportBundle() *cobra.Command {
+	var nodes []string
+	var output, adminToken string
+	var logLines, jobs int
+
+	cmd := &cobra.Command{
+		Use:   "support-bundle",
+		Short: "Collect logs and state into a tarball for bug reports (admin only)",
+		Long: `Collect what is needed to investigate a problem into a single gzipped
+tarball to attach to a bug report:
+
+  controller/controller.log   journal of the sds-controller unit
+  controller/database.json    dump of the database, credentials redacted
+  jobs/                       recent jobs with the commands they ran
+  nodes/<node>/               drbdsetup status and events, DRBD and
+                              drbd-reactor configs, drbd-reactor status and
+                              log, DRBD kernel messages
+
+Passwords, secrets and tokens are redacted, but review the bundle before
+sharing it. Whatever could not be collected is listed in errors.txt. The
+call must present the admin token (--admin-token or SDS_ADMIN_TOKEN).`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if adminToken == "" {
+				adminToken = os.Getenv("SDS_ADMIN_TOKEN")
+			}
+			if output == "" {
+				output = fmt.Sprintf("sds-support-%s.tar.gz", time.Now().Format("20060102-150405"))
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			f, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+			if err != nil {
+				return err
+			}
+			written, err := sdsClient.SupportBundle(client.WithAdminToken(ctx, adminToken), &v1.SupportBundleRequest{
+				Nodes:    nodes,
+				LogLines: int32(logLines),
+				Jobs:     int32(jobs),
+			}, f)
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				os.Remove(output)
+				return fmt.Errorf("failed to collect support bundle: %w", err)
+			}
+
+			fmt.Printf("✓ Support bundle written to %s (%s)\n", output, formatBytes(uint64(written)))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Tarball to write (default: sds-support-<time>.tar.gz)")
+	cmd.Flags().StringSliceVar(&nodes, "nodes", nil, "Node names or addresses (default: all nodes)")
+	cmd.Flags().IntVar(&logLines, "log-lines", 0, "Recent lines per log (default: 2000)")
+	cmd.Flags().IntVar(&jobs, "jobs", 0, "Recent jobs to include (default: 50)")
+	cmd.Flags().StringVar(&adminToken, "admin-token", "", "Admin token (default: $SDS_ADMIN_TOKEN)")
+
+	return cmd
+}
+
+// printFreezeStatus prints the read-only state of the controller
+func printFreezeStatus(status *v1.FreezeStatus) {
+	if !status.Frozen {
+		fmt.Println("Controller:  read-write")
+		return
+	}
+
+	since := time.Unix(status.Since, 0)
+	fmt.Println("Controller:  FROZEN (read-only)")
+	fmt.Printf("Since:       %s (%s ago)\n", since.Format("2006-01-02 15:04:05"), time.Since(since).Round(time.Second))
+	if status.Reason != "" {
+		fmt.Printf("Reason:      %s\n", status.Reason)
+	}
+}
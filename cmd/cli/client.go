@@ -0,0 +1,311 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	v1 "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func clientCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "client",
+		Short: "Connect client nodes to SDS gateways",
+	}
+
+	cmd.AddCommand(clientNVMe())
+	cmd.AddCommand(clientISCSI())
+	cmd.AddCommand(clientNFS())
+
+	return cmd
+}
+
+func clientNVMe() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nvme",
+		Short: "NVMe-oF initiator helpers",
+	}
+
+	cmd.AddCommand(clientNVMeConnect())
+	cmd.AddCommand(clientNVMeDisconnect())
+
+	return cmd
+}
+
+func clientNVMeConnect() *cobra.Command {
+	var node string
+	var hostNQN string
+
+	cmd := &cobra.Command{
+		Use:   "connect <gateway>",
+		Short: "Connect a client node to an NVMe-oF gateway",
+		Long: `Discover an SDS NVMe-oF gateway from a client node and connect to its
+subsystem. The gateway can be given by name or by its resource name. The
+client node must be registered so the controller can reach it over SSH.
+
+Example:
+  sds client nvme connect res01 --node client1`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			info, err := sdsClient.NVMeConnect(ctx, args[0], node, hostNQN)
+			printInitiatorInfo(info)
+			if err != nil {
+				return fmt.Errorf("failed to connect: %w", err)
+			}
+
+			fmt.Printf("Node '%s' connected to %s\n", node, info.Target)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&node, "node", "", "Client node to connect from (required)")
+	cmd.Flags().StringVar(&hostNQN, "host-nqn", "", "Host NQN of the client (default: /etc/nvme/hostnqn)")
+	cmd.MarkFlagRequired("node")
+
+	return cmd
+}
+
+func clientNVMeDisconnect() *cobra.Command {
+	var node string
+
+	cmd := &cobra.Command{
+		Use:   "disconnect <gateway>",
+		Short: "Disconnect a client node from an NVMe-oF gateway",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			info, err := sdsClient.NVMeDisconnect(ctx, args[0], node)
+			printInitiatorInfo(info)
+			if err != nil {
+				return fmt.Errorf("failed to disconnect: %w", err)
+			}
+
+			fmt.Printf("Node '%s' disconnected from %s\n", node, info.Target)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&node, "node", "", "Client node (required)")
+	cmd.MarkFlagRequired("node")
+
+	return cmd
+}
+
+func clientISCSI() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "iscsi",
+		Short: "iSCSI initiator helpers",
+	}
+
+	cmd.AddCommand(clientISCSIConfig())
+	cmd.AddCommand(clientISCSIValidate())
+
+	return cmd
+}
+
+func clientISCSIConfig() *cobra.Command {
+	var outputDir string
+
+	cmd := &cobra.Command{
+		Use:   "config <gateway>",
+		Short: "Generate multipath and iscsiadm configuration for an iSCSI gateway",
+		Long: `Generate an /etc/multipath.conf snippet and an iscsiadm login script for an
+SDS iSCSI gateway. The script discovers and logs in to the target on every
+portal of the gateway (extra portals are taken from the gateway option
+"portals") and makes the login persistent.
+
+Examples:
+  sds client iscsi config res01
+  sds client iscsi config res01 --output-dir /tmp/res01`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			cfg, err := sdsClient.GetISCSIClientConfig(ctx, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to generate client config: %w", err)
+			}
+
+			if outputDir == "" {
+				fmt.Printf("# ---- multipath.conf ----\n%s\n", cfg.MultipathConf)
+				fmt.Printf("# ---- iscsi-login.sh ----\n%s", cfg.LoginScript)
+				return nil
+			}
+
+			if err := os.MkdirAll(outputDir, 0755); err != nil {
+				return fmt.Errorf("failed to create %s: %w", outputDir, err)
+			}
+			multipathFile := filepath.Join(outputDir, fmt.Sprintf("multipath-%s.conf", cfg.Gateway))
+			if err := os.WriteFile(multipathFile, []byte(cfg.MultipathConf), 0644); err != nil {
+				return fmt.Errorf("failed to write %s: %w", multipathFile, err)
+			}
+			// The script contains the CHAP credentials
+			scriptFile := filepath.Join(outputDir, fmt.Sprintf("iscsi-login-%s.sh", cfg.Gateway))
+			if err := os.WriteFile(scriptFile, []byte(cfg.LoginScript), 0700); err != nil {
+				return fmt.Errorf("failed to write %s: %w", scriptFile, err)
+			}
+
+			fmt.Printf("Target:    %s\n", cfg.Iqn)
+			for _, portal := range cfg.Portals {
+				fmt.Printf("Portal:    %s\n", portal)
+			}
+			fmt.Printf("Multipath: %s\n", multipathFile)
+			fmt.Printf("Login:     %s\n", scriptFile)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&outputDir, "output-dir", "", "Write the files to this directory instead of stdout")
+
+	return cmd
+}
+
+func clientISCSIValidate() *cobra.Command {
+	var node string
+
+	cmd := &cobra.Command{
+		Use:   "validate <gateway>",
+		Short: "Check the connectivity of a client node to an iSCSI gateway",
+		Long: `Check from a registered client node that every portal of an SDS iSCSI gateway
+is reachable and offers its target, and report the session and multipath
+state of the client.
+
+Example:
+  sds client iscsi validate res01 --node client1`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			info, err := sdsClient.ValidateISCSIInitiator(ctx, args[0], node)
+			printInitiatorInfo(info)
+			if err != nil {
+				return fmt.Errorf("validation failed: %w", err)
+			}
+
+			fmt.Printf("Node '%s' can reach %s\n", node, info.Target)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&node, "node", "", "Client node to check from (required)")
+	cmd.MarkFlagRequired("node")
+
+	return cmd
+}
+
+func clientNFS() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nfs",
+		Short: "NFS client helpers",
+	}
+
+	cmd.AddCommand(clientNFSMount())
+
+	return cmd
+}
+
+func clientNFSMount() *cobra.Command {
+	var gatewayName string
+	var node string
+	var mountPath string
+	var soft bool
+	var options string
+
+	cmd := &cobra.Command{
+		Use:   "mount",
+		Short: "Mount an NFS gateway export on a client node",
+		Long: `Mount the export of an SDS NFS gateway on a client node through a systemd
+mount unit, so the mount comes back after a reboot. The export is mounted
+hard by default, which blocks I/O while the gateway fails over instead of
+returning errors; use --soft to get errors after the retries are exhausted.
+
+Examples:
+  sds client nfs mount --gateway res01 --path /mnt/res01 --node client1
+  sds client nfs mount --gateway res01 --path /mnt/res01 --node client1 --options noatime`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			info, err := sdsClient.NFSMount(ctx, gatewayName, node, mountPath, soft, options)
+			printInitiatorInfo(info)
+			if err != nil {
+				return fmt.Errorf("failed to mount: %w", err)
+			}
+
+			fmt.Printf("Mounted %s on %s:%s\n", info.Target, node, mountPath)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&gatewayName, "gateway", "", "NFS gateway or resource name (required)")
+	cmd.Flags().StringVar(&mountPath, "path", "", "Mount point on the client (required)")
+	cmd.Flags().StringVar(&node, "node", "", "Client node to mount on (required)")
+	cmd.Flags().BoolVar(&soft, "soft", false, "Soft mount, return I/O errors instead of blocking during failover")
+	cmd.Flags().StringVar(&options, "options", "", "Extra mount options, comma-separated")
+	cmd.MarkFlagRequired("gateway")
+	cmd.MarkFlagRequired("path")
+	cmd.MarkFlagRequired("node")
+
+	return cmd
+}
+
+// printInitiatorInfo prints what an initiator helper found on the client node
+func printInitiatorInfo(info *v1.InitiatorInfo) {
+	if info == nil {
+		return
+	}
+
+	for _, portal := range info.Portals {
+		fmt.Printf("  Portal:  %s\n", portal)
+	}
+	for _, device := range info.Devices {
+		fmt.Printf("  Device:  %s\n", device)
+	}
+	if info.Unit != "" {
+		fmt.Printf("  Unit:    %s\n", info.Unit)
+	}
+	for _, warning := range info.Warnings {
+		fmt.Printf("  Note:    %s\n", warning)
+	}
+}
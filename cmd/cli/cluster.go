@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/liliang-cn/sds/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func clusterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cluster",
+		Short: "Clusters served by the controller",
+	}
+
+	cmd.AddCommand(clusterList())
+
+	return cmd
+}
+
+func clusterList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the clusters served by the controller",
+		Long: `List the clusters served by the controller with their UUIDs.
+
+A controller can serve several clusters, each with its own nodes and
+database (cluster.extra in the controller config). Every other command
+addresses the default cluster unless --cluster selects another one.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			clusters, err := sdsClient.ListClusters(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list clusters: %w", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NAME\tUUID\tNODES\tRESOURCES\tDATABASE\tDEFAULT")
+			for _, cluster := range clusters {
+				isDefault := ""
+				if cluster.IsDefault {
+					isDefault = "*"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t%s\n",
+					cluster.Name, cluster.Uuid, cluster.Nodes, cluster.Resources, cluster.DatabasePath, isDefault)
+			}
+			w.Flush()
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func debugCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Inspect what the controller generates",
+	}
+
+	cmd.AddCommand(debugRender())
+
+	return cmd
+}
+
+func debugRender() *cobra.Command {
+	var (
+		resource, protocol, pool, storageType  string
+		nodes                                  []string
+		port, volumes                          uint32
+		peerProtocols, drbdOptions             map[string]string
+		services, dependsOn                    []string
+		mountPoint, fsType, vip                string
+		serviceIP, exportPath, iqn, nqn, iface string
+		allowedIPs, allowedInitiators          []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "render <drbd|promoter|nfs|iscsi|nvmeof>",
+		Short: "Print the config files the controller generates for the given inputs",
+		Long: `Render runs a config generator of the controller on the given inputs and prints
+the files it produces. The resource does not need to exist: nothing is looked
+up, reserved or written, only the cluster defaults of the controller apply.
+
+Nodes are names, or name=address to render them with an address, e.g. an IPv6
+one. The DRBD minor is the port minus 7000.
+
+Examples:
+  sds debug render drbd --resource r0 --nodes a=10.0.0.1,b=10.0.0.2,c=fd00::3 --volumes 2
+  sds debug render drbd --resource r0 --nodes a,b --storage-type zfs --drbd-options net/max-buffers=8000
+  sds debug render promoter --resource r0 --nodes a,b --mount /srv --vip 10.0.0.100/24 --services nginx.service
+  sds debug render nfs --resource r0 --service-ip 10.0.0.200/24 --export-path /data`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &v1.RenderConfigRequest{
+				Kind:          args[0],
+				Resource:      resource,
+				Nodes:         nodes,
+				Port:          port,
+				Protocol:      protocol,
+				PeerProtocols: peerProtocols,
+				Pool:          pool,
+				StorageType:   storageType,
+				DrbdOptions:   drbdOptions,
+				Volumes:       volumes,
+				Services:      services,
+				MountPoint:    mountPoint,
+				FsType:        fsType,
+				Vip:           vip,
+				DependsOn:     dependsOn,
+			}
+			switch args[0] {
+			case "nfs":
+				req.Nfs = &v1.CreateNFSGatewayRequest{ServiceIp: serviceIP, ExportPath: exportPath, AllowedIps: allowedIPs, FsType: fsType, Interface: iface}
+			case "iscsi":
+				req.Iscsi = &v1.CreateISCSIGatewayRequest{ServiceIp: serviceIP, Iqn: iqn, AllowedInitiators: allowedInitiators, Implementation: "lio", Interface: iface}
+			case "nvmeof":
+				req.Nvmeof = &v1.CreateNVMeGatewayRequest{ServiceIp: serviceIP, Nqn: nqn, TransportType: "tcp", Interface: iface}
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			files, err := sdsClient.RenderConfig(ctx, req)
+			if err != nil {
+				return fmt.Errorf("failed to render %s: %w", args[0], err)
+			}
+			for i, f := range files {
+				if i > 0 {
+					fmt.Println()
+				}
+				fmt.Printf("==> %s <==\n", f.Path)
+				fmt.Print(f.Content)
+				if !strings.HasSuffix(f.Content, "\n") {
+					fmt.Println()
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&resource, "resource", "", "Resource name (required)")
+	cmd.Flags().StringSliceVar(&nodes, "nodes", nil, "Nodes as name or name=address (comma-separated)")
+	cmd.Flags().Uint32Var(&port, "port", 0, "DRBD port (default 7000)")
+	cmd.Flags().StringVar(&protocol, "protocol", "C", "DRBD replication protocol")
+	cmd.Flags().StringToStringVar(&peerProtocols, "peer-protocol", nil, "Protocol of the connections to a node (e.g., dr1=A)")
+	cmd.Flags().StringVar(&pool, "pool", "", "Storage pool (default data-pool)")
+	cmd.Flags().StringVar(&storageType, "storage-type", "lvm", "Storage type (lvm, zfs)")
+	cmd.Flags().StringToStringVar(&drbdOptions, "drbd-options", nil, "DRBD options as key=value pairs, section/key for other sections than options")
+	cmd.Flags().Uint32Var(&volumes, "volumes", 0, "Volumes of the resource (default 1 for drbd, 2 for gateways)")
+	cmd.Flags().StringSliceVar(&services, "services", nil, "Promoter: systemd services (comma-separated)")
+	cmd.Flags().StringVar(&mountPoint, "mount", "", "Promoter: mount point")
+	cmd.Flags().StringVar(&fsType, "fstype", "", "Promoter and NFS: filesystem type")
+	cmd.Flags().StringVar(&vip, "vip", "", "Promoter: virtual IP (CIDR)")
+	cmd.Flags().StringSliceVar(&dependsOn, "depends-on", nil, "Promoter: resources to start first (comma-separated)")
+	cmd.Flags().StringVar(&serviceIP, "service-ip", "", "Gateways: service IP (CIDR)")
+	cmd.Flags().StringVar(&iface, "interface", "", "Gateways: interface of the tenant network")
+	cmd.Flags().StringVar(&exportPath, "export-path", "", "NFS: export path")
+	cmd.Flags().StringSliceVar(&allowedIPs, "allowed-ips", nil, "NFS: allowed client IPs")
+	cmd.Flags().StringVar(&iqn, "iqn", "", "iSCSI: target IQN")
+	cmd.Flags().StringSliceVar(&allowedInitiators, "allowed-initiators", nil, "iSCSI: allowed initiator IQNs")
+	cmd.Flags().StringVar(&nqn, "nqn", "", "NVMe-oF: subsystem NQN")
+	cmd.MarkFlagRequired("resource")
+
+	return cmd
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func drCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dr",
+		Short: "Disaster recovery runbooks (planned switchover and failback)",
+	}
+
+	cmd.AddCommand(drSwitchover())
+	cmd.AddCommand(drFailback())
+
+	return cmd
+}
+
+func drSwitchover() *cobra.Command {
+	var target string
+	var syncTimeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "switchover <resource>",
+		Short: "Migrate a resource to the DR node (demote, wait for sync, promote, verify)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resource := args[0]
+
+			// Leave room for the demote/promote steps on top of the sync wait
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			fmt.Printf("Switching over %s...\n", resource)
+			resp, err := sdsClient.DrSwitchover(ctx, resource, target, uint32(syncTimeout.Seconds()))
+			if resp != nil {
+				printDrSteps(resp.Steps)
+			}
+			if err != nil {
+				return fmt.Errorf("switchover failed: %w", err)
+			}
+
+			fmt.Printf("\nSwitchover completed\n")
+			fmt.Printf("  Resource: %s\n", resource)
+			fmt.Printf("  From:     %s\n", resp.FromNode)
+			fmt.Printf("  To:       %s\n", resp.ToNode)
+			fmt.Printf("\nUse 'sds dr failback %s' to return to %s\n", resource, resp.FromNode)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&target, "to", "", "DR node to switch over to (required if the resource has more than one secondary)")
+	cmd.Flags().DurationVar(&syncTimeout, "sync-timeout", 10*time.Minute, "How long to wait for the DR node to be in sync")
+
+	return cmd
+}
+
+func drFailback() *cobra.Command {
+	var syncTimeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "failback <resource>",
+		Short: "Return a resource to the node it was switched over from",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resource := args[0]
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			fmt.Printf("Failing back %s...\n", resource)
+			resp, err := sdsClient.DrFailback(ctx, resource, uint32(syncTimeout.Seconds()))
+			if resp != nil {
+				printDrSteps(resp.Steps)
+			}
+			if err != nil {
+				return fmt.Errorf("failback failed: %w", err)
+			}
+
+			fmt.Printf("\nFailback completed\n")
+			fmt.Printf("  Resource: %s\n", resource)
+			fmt.Printf("  From:     %s\n", resp.FromNode)
+			fmt.Printf("  To:       %s\n", resp.ToNode)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().DurationVar(&syncTimeout, "sync-timeout", 10*time.Minute, "How long to wait for the home node to be in sync")
+
+	return cmd
+}
+
+func printDrSteps(steps []string) {
+	for i, step := range steps {
+		fmt.Printf("  %d. %s\n", i+1, step)
+	}
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	v1 "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func drbdGlobalCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drbd-global",
+		Short: "Manage the DRBD global_common.conf of all nodes",
+		Long: `Manage /etc/drbd.d/global_common.conf from a single, versioned definition
+on the controller. Every change is saved as a new version and written to all
+registered nodes; nodes registered later receive the latest version.`,
+	}
+
+	cmd.AddCommand(drbdGlobalShow())
+	cmd.AddCommand(drbdGlobalSet())
+	cmd.AddCommand(drbdGlobalHistory())
+	cmd.AddCommand(drbdGlobalRollback())
+
+	return cmd
+}
+
+func drbdGlobalShow() *cobra.Command {
+	var version int
+	var raw bool
+
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Show a version of the global config",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			cfg, content, err := sdsClient.GetDrbdGlobalConfig(ctx, version)
+			if err != nil {
+				return fmt.Errorf("failed to get DRBD global config: %w", err)
+			}
+
+			if raw {
+				fmt.Print(content)
+				return nil
+			}
+
+			fmt.Printf("Version:     %d\n", cfg.Version)
+			fmt.Printf("Created:     %s\n", time.Unix(cfg.CreatedAt, 0).Format(time.RFC3339))
+			if cfg.Comment != "" {
+				fmt.Printf("Comment:     %s\n", cfg.Comment)
+			}
+			fmt.Printf("Usage count: %s\n", cfg.UsageCount)
+			printOptions := func(title string, options map[string]string) {
+				if len(options) == 0 {
+					return
+				}
+				fmt.Printf("\n%s:\n", title)
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+				for _, key := range sortedKeys(options) {
+					fmt.Fprintf(w, "  %s\t%s\n", key, options[key])
+				}
+				w.Flush()
+			}
+			printOptions("Handlers", cfg.Handlers)
+			printOptions("Disk options", cfg.Disk)
+			printOptions("Net options", cfg.Net)
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&version, "version", 0, "Version to show (default: latest)")
+	cmd.Flags().BoolVar(&raw, "raw", false, "Print the rendered global_common.conf")
+
+	return cmd
+}
+
+func drbdGlobalSet() *cobra.Command {
+	var usageCount, comment string
+	var disk, net, handlers map[string]string
+	var unset []string
+	var apply bool
+
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Change the global config and write it to all nodes",
+		Long: `Change the global config and write it to all nodes as a new version.
+
+The latest version is the starting point; the given options are added or
+replaced and --unset removes options as section.name. Without --apply the
+file is only written, running resources pick the common options up at their
+next adjust.
+
+Example:
+  sds drbd-global set --usage-count no --net timeout=90 --net ping-timeout=10
+  sds drbd-global set --handler fence-peer=/usr/lib/drbd/crm-fence-peer.9.sh --apply
+  sds drbd-global set --unset disk.on-io-error`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			// Start from the latest version, if any
+			cfg, _, err := sdsClient.GetDrbdGlobalConfig(ctx, 0)
+			if err != nil {
+				cfg = &v1.DrbdGlobalConfig{}
+			}
+			sections := map[string]map[string]string{
+				"disk":     mergeOptions(cfg.Disk, disk),
+				"net":      mergeOptions(cfg.Net, net),
+				"handlers": mergeOptions(cfg.Handlers, handlers),
+			}
+			for _, name := range unset {
+				section, key, ok := strings.Cut(name, ".")
+				if _, known := sections[section]; !ok || !known {
+					return fmt.Errorf("invalid --unset %q (must be disk.<option>, net.<option> or handlers.<name>)", name)
+				}
+				delete(sections[section], key)
+			}
+
+			update := &v1.DrbdGlobalConfig{
+				UsageCount: cfg.UsageCount,
+				Disk:       sections["disk"],
+				Net:        sections["net"],
+				Handlers:   sections["handlers"],
+				Comment:    comment,
+			}
+			if usageCount != "" {
+				update.UsageCount = usageCount
+			}
+
+			resp, err := sdsClient.SetDrbdGlobalConfig(ctx, update, apply)
+			if resp != nil {
+				printFailedNodes(resp.FailedNodes)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to set DRBD global config: %w", err)
+			}
+
+			fmt.Printf("DRBD global config version %d written to all nodes\n", resp.Version)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&usageCount, "usage-count", "", "usage-count (yes, no, ask)")
+	cmd.Flags().StringToStringVar(&disk, "disk", nil, "Common disk options as key=value pairs (e.g., on-io-error=detach)")
+	cmd.Flags().StringToStringVar(&net, "net", nil, "Common net options as key=value pairs (e.g., timeout=90)")
+	cmd.Flags().StringToStringVar(&handlers, "handler", nil, "Handlers as name=command pairs (e.g., split-brain=/usr/lib/drbd/notify-split-brain.sh)")
+	cmd.Flags().StringSliceVar(&unset, "unset", nil, "Options to remove as section.name (e.g., net.timeout)")
+	cmd.Flags().StringVar(&comment, "comment", "", "Description of the change")
+	cmd.Flags().BoolVar(&apply, "apply", false, "Run drbdadm adjust all on the nodes")
+
+	return cmd
+}
+
+func drbdGlobalHistory() *cobra.Command {
+	return &cobra.Command{
+		Use:   "history",
+		Short: "List the versions of the global config",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			configs, err := sdsClient.ListDrbdGlobalConfigs(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list DRBD global config versions: %w", err)
+			}
+
+			if len(configs) == 0 {
+				fmt.Println("No DRBD global config defined, global_common.conf is not managed")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "VERSION\tCREATED\tCOMMENT")
+			for _, cfg := range configs {
+				fmt.Fprintf(w, "%d\t%s\t%s\n", cfg.Version, time.Unix(cfg.CreatedAt, 0).Format("2006-01-02 15:04:05"), cfg.Comment)
+			}
+			w.Flush()
+			return nil
+		},
+	}
+}
+
+func drbdGlobalRollback() *cobra.Command {
+	var apply bool
+
+	cmd := &cobra.Command{
+		Use:   "rollback <version>",
+		Short: "Write an earlier version to all nodes as a new version",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			version, err := strconv.Atoi(args[0])
+			if err != nil || version < 1 {
+				return fmt.Errorf("invalid version %q", args[0])
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.RollbackDrbdGlobalConfig(ctx, version, apply)
+			if resp != nil {
+				printFailedNodes(resp.FailedNodes)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to roll back DRBD global config: %w", err)
+			}
+
+			fmt.Printf("Rolled back to version %d as version %d\n", version, resp.Version)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&apply, "apply", false, "Run drbdadm adjust all on the nodes")
+
+	return cmd
+}
+
+// mergeOptions returns a copy of base with the entries of update added or replaced
+func mergeOptions(base, update map[string]string) map[string]string {
+	merged := make(map[string]string, len(base)+len(update))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range update {
+		merged[k] = v
+	}
+	return merged
+}
+
+// sortedKeys returns the keys of options in order
+func sortedKeys(options map[string]string) []string {
+	keys := make([]string, 0, len(options))
+	for key := range options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// printFailedNodes lists the nodes a global config could not be written to
+func printFailedNodes(nodes []string) {
+	if len(nodes) == 0 {
+		return
+	}
+	fmt.Println("Failed on nodes:")
+	for _, node := range nodes {
+		fmt.Printf("  - %s\n", node)
+	}
+}
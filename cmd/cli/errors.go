@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/liliang-cn/sds/pkg/client"
+)
+
+// verbose shows the remote commands that failed on the nodes
+var verbose bool
+
+// printError prints a failed command with a hint how to fix it and, with
+// --verbose, the remote commands that failed on the nodes
+func printError(w io.Writer, err error) {
+	e := client.Explain(err)
+	fmt.Fprintf(w, "Error: %v\n", e)
+	if hint := e.Hint(); hint != "" {
+		fmt.Fprintf(w, "Hint: %s\n", hint)
+	}
+	if url := e.DocURL(); url != "" {
+		fmt.Fprintf(w, "See: %s\n", url)
+	}
+
+	if len(e.Failures) == 0 {
+		return
+	}
+	if !verbose {
+		fmt.Fprintf(w, "%d remote command(s) failed, run with --verbose to show them\n", len(e.Failures))
+		return
+	}
+	fmt.Fprintln(w, "Failed commands:")
+	for _, failure := range e.Failures {
+		fmt.Fprintf(w, "  %s\n", failure)
+	}
+}
@@ -0,0 +1,287 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	v1 "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func eventsCommand() *cobra.Command {
+	var resource string
+	var limit uint32
+
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "Show the cluster events log",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			events, err := sdsClient.ListEvents(ctx, resource, limit)
+			if err != nil {
+				return fmt.Errorf("failed to list events: %w", err)
+			}
+
+			if len(events) == 0 {
+				fmt.Println("No events recorded")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "TIME\tTYPE\tRESOURCE\tMESSAGE\tDETAILS")
+
+			for _, event := range events {
+				var details []string
+				for k, v := range event.Details {
+					if k == "steps" || v == "" {
+						continue
+					}
+					details = append(details, fmt.Sprintf("%s=%s", k, v))
+				}
+				sort.Strings(details)
+
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+					time.Unix(event.Timestamp, 0).Format("2006-01-02 15:04:05"),
+					event.Type,
+					event.Resource,
+					event.Message,
+					strings.Join(details, ","))
+			}
+
+			w.Flush()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&resource, "resource", "", "Only show events for this resource")
+	cmd.Flags().Uint32Var(&limit, "limit", 50, "Maximum number of events to show (0 = all)")
+
+	cmd.AddCommand(eventsHook())
+
+	return cmd
+}
+
+func eventsHook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hook",
+		Short: "Notify external systems of events",
+		Long: `Event hooks keep external systems such as a CMDB or a ticketing system up to
+date: for each event of a subscribed type, e.g. resource.created,
+ha.failover or snapshot.completed, the controller posts a payload to a
+webhook or runs a command on the controller host with the payload on stdin
+and SDS_EVENT_ID, SDS_EVENT_TYPE, SDS_EVENT_RESOURCE and SDS_EVENT_MESSAGE
+set. "ha.*" subscribes to all event types of a prefix, "*" to all.
+
+The payload is the event as JSON, or rendered from --template, a Go template
+of the event (.ID, .Type, .Resource, .Message, .Details, .CreatedAt) with
+the json, upper and lower functions, e.g.
+  --template '{"text": {{json .Message}}}'
+
+Hooks run in the background; failures are recorded as hook.failed events.
+Setting, deleting and testing hooks needs the admin token (--admin-token or
+SDS_ADMIN_TOKEN).`,
+	}
+
+	cmd.AddCommand(eventsHookSet())
+	cmd.AddCommand(eventsHookDelete())
+	cmd.AddCommand(eventsHookList())
+	cmd.AddCommand(eventsHookTest())
+
+	return cmd
+}
+
+func eventsHookSet() *cobra.Command {
+	var name, url, command, template, adminToken string
+	var events []string
+	var headers map[string]string
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Add an event hook or replace the hook of the same name",
+		Example: `  sds events hook set --name cmdb --events resource.created,resource.deleted \
+    --url https://cmdb.example.com/api/storage --header Authorization="Bearer $TOKEN"
+  sds events hook set --name tickets --events ha.failover,resource.health \
+    --command '/usr/local/bin/open-ticket'`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if adminToken == "" {
+				adminToken = os.Getenv("SDS_ADMIN_TOKEN")
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			hook := &v1.EventHook{
+				Name:           name,
+				Events:         events,
+				Url:            url,
+				Command:        command,
+				Headers:        headers,
+				Template:       template,
+				TimeoutSeconds: uint32(timeout / time.Second),
+			}
+			if err := sdsClient.SetEventHook(client.WithAdminToken(ctx, adminToken), hook); err != nil {
+				return fmt.Errorf("failed to set event hook: %w", err)
+			}
+			fmt.Printf("Event hook '%s' set\n", name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Hook name")
+	cmd.Flags().StringSliceVar(&events, "events", nil, "Event types, e.g. resource.created, ha.* or *")
+	cmd.Flags().StringVar(&url, "url", "", "Webhook URL to post the payload to")
+	cmd.Flags().StringVar(&command, "command", "", "Command run on the controller host with the payload on stdin")
+	cmd.Flags().StringToStringVar(&headers, "header", nil, "Webhook request headers as name=value pairs")
+	cmd.Flags().StringVar(&template, "template", "", "Go template of the payload (default: the event as JSON)")
+	cmd.Flags().DurationVar(&timeout, "timeout", 10*time.Second, "Timeout of the request or command")
+	cmd.Flags().StringVar(&adminToken, "admin-token", "", "Admin token (default: $SDS_ADMIN_TOKEN)")
+
+	cmd.MarkFlagRequired("name")
+	cmd.MarkFlagRequired("events")
+
+	return cmd
+}
+
+func eventsHookDelete() *cobra.Command {
+	var name, adminToken string
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Remove an event hook",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if adminToken == "" {
+				adminToken = os.Getenv("SDS_ADMIN_TOKEN")
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			if err := sdsClient.DeleteEventHook(client.WithAdminToken(ctx, adminToken), name); err != nil {
+				return fmt.Errorf("failed to delete event hook: %w", err)
+			}
+			fmt.Printf("Event hook '%s' deleted\n", name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Hook name")
+	cmd.Flags().StringVar(&adminToken, "admin-token", "", "Admin token (default: $SDS_ADMIN_TOKEN)")
+	cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func eventsHookList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the event hooks",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			hooks, err := sdsClient.ListEventHooks(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list event hooks: %w", err)
+			}
+			if len(hooks) == 0 {
+				fmt.Println("No event hooks")
+				return nil
+			}
+			for _, h := range hooks {
+				timeout := time.Duration(h.TimeoutSeconds) * time.Second
+				if timeout == 0 {
+					timeout = 10 * time.Second
+				}
+				fmt.Printf("%s: %s (timeout %s)\n", h.Name, strings.Join(h.Events, ", "), timeout)
+				if h.Url != "" {
+					fmt.Printf("  url:      %s\n", h.Url)
+				}
+				if h.Command != "" {
+					fmt.Printf("  command:  %s\n", h.Command)
+				}
+				var headers []string
+				for k, v := range h.Headers {
+					headers = append(headers, k+"="+v)
+				}
+				sort.Strings(headers)
+				if len(headers) > 0 {
+					fmt.Printf("  headers:  %s\n", strings.Join(headers, ", "))
+				}
+				if h.Template != "" {
+					fmt.Printf("  template: %s\n", h.Template)
+				}
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func eventsHookTest() *cobra.Command {
+	var name, adminToken string
+
+	cmd := &cobra.Command{
+		Use:   "test",
+		Short: "Send a hook.test event to an event hook",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if adminToken == "" {
+				adminToken = os.Getenv("SDS_ADMIN_TOKEN")
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			if err := sdsClient.TestEventHook(client.WithAdminToken(ctx, adminToken), name); err != nil {
+				return fmt.Errorf("event hook test failed: %w", err)
+			}
+			fmt.Printf("✓ Test event delivered to hook '%s'\n", name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Hook name")
+	cmd.Flags().StringVar(&adminToken, "admin-token", "", "Admin token (default: $SDS_ADMIN_TOKEN)")
+	cmd.MarkFlagRequired("name")
+
+	return cmd
+}
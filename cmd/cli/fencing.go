@@ -0,0 +1,162 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func fencingCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fencing",
+		Short: "Manage the DRBD fence-peer and event handlers",
+	}
+
+	cmd.AddCommand(fencingInstall())
+	cmd.AddCommand(fencingList())
+	cmd.AddCommand(fencingClear())
+
+	return cmd
+}
+
+func fencingInstall() *cobra.Command {
+	return &cobra.Command{
+		Use:   "install",
+		Short: "Install the DRBD handlers on all nodes",
+		Long: `Install the fence-peer, unfence-peer and event handler on all nodes. DRBD
+runs the fence handlers when a resource loses its connection to a peer; they
+call back into the controllers in fencing.controller_urls, which outdate the
+peer, or keep it from promotion while it is unreachable. Resources use them
+once they have the printed DRBD options, e.g. in --drbd-options or
+drbd_defaults.
+
+The event handler reports split-brain, out-of-sync and pri-lost-after-sb to
+the events log. Resources created while fencing.controller_urls is set use it
+by default; override a handler with --drbd-options handlers/<name>=<command>,
+or drop it with an empty command.
+
+Every run gives each node a new key in /var/lib/sds/handler.key, which its
+handlers authenticate with once auth.keys are set. A node's handlers may
+only act on the resources it replicates.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.InstallFenceHandlers(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to install fence handlers: %w", err)
+			}
+
+			fmt.Printf("%s: %s, %s\n", resp.Message, resp.Path, resp.EventHandlerPath)
+			for _, node := range resp.FailedNodes {
+				fmt.Printf("  failed: %s\n", node)
+			}
+			fmt.Println()
+			fmt.Println("Resources report split-brain, out-of-sync and pri-lost-after-sb to the")
+			fmt.Println("events log by default. Enable fencing on a resource with these DRBD options:")
+			keys := make([]string, 0, len(resp.DrbdOptions))
+			for key := range resp.DrbdOptions {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				fmt.Printf("  %s=%s\n", key, resp.DrbdOptions[key])
+			}
+			return nil
+		},
+	}
+}
+
+func fencingList() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the nodes fenced by the fence-peer handlers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			constraints, err := sdsClient.ListFenceConstraints(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list fence constraints: %w", err)
+			}
+			if len(constraints) == 0 {
+				fmt.Println("No nodes fenced")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "RESOURCE\tNODE\tFENCED BY\tOUTDATED\tSINCE")
+			for _, constraint := range constraints {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%v\t%s\n", constraint.Resource, constraint.Node, constraint.FencedBy,
+					constraint.Outdated, time.Unix(constraint.CreatedAt, 0).Format("2006-01-02 15:04:05"))
+			}
+			w.Flush()
+			return nil
+		},
+	}
+}
+
+func fencingClear() *cobra.Command {
+	var node, adminToken string
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "clear <resource> (--node <node> | --all)",
+		Short: "Lift the fence constraints of a resource",
+		Long: `Lift the fence constraint of a node of a resource, as the unfence-peer
+handler does once the peer is in sync again, or with --all those of all its
+nodes. Only clear them by hand when the fenced node is known to have all
+writes, e.g. after it was resynced. When the controller has API keys
+(auth.keys) this needs the admin token (--admin-token or SDS_ADMIN_TOKEN).`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if (node == "") == !all {
+				return fmt.Errorf("either --node or --all is required")
+			}
+			if adminToken == "" {
+				adminToken = os.Getenv("SDS_ADMIN_TOKEN")
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			message, err := sdsClient.UnfencePeer(client.WithAdminToken(ctx, adminToken), args[0], node, all)
+			if err != nil {
+				return fmt.Errorf("failed to clear fence constraints: %w", err)
+			}
+			fmt.Println(message)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&node, "node", "", "Lift the constraint of this node")
+	cmd.Flags().BoolVar(&all, "all", false, "Lift the constraints of all nodes")
+	cmd.Flags().StringVar(&adminToken, "admin-token", "", "Admin token (default: $SDS_ADMIN_TOKEN)")
+
+	return cmd
+}
@@ -20,6 +20,7 @@ func gatewayCommand() *cobra.Command {
 	cmd.AddCommand(gatewayNFS())
 	cmd.AddCommand(gatewayNVMe())
 	cmd.AddCommand(gatewayList())
+	cmd.AddCommand(gatewayStatus())
 	cmd.AddCommand(gatewayDelete())
 	cmd.AddCommand(gatewayStart())
 	cmd.AddCommand(gatewayStop())
@@ -40,7 +41,9 @@ func gatewayISCSI() *cobra.Command {
 }
 
 func iscsiCreate() *cobra.Command {
-	var resource, serviceIP, iqn, username, password, implementation string
+	var resource, serviceIP, serviceIPPool, iqn, username, password, implementation, clusterPrivatePath, iface, router string
+	var vlan uint32
+	var dryRun bool
 	var allowedInitiators []string
 
 	cmd := &cobra.Command{
@@ -70,18 +73,26 @@ func iscsiCreate() *cobra.Command {
 			req := &v1.CreateISCSIGatewayRequest{
 				Resource:           resource,
 				ServiceIp:          serviceIP,
+				ServiceIpPool:      serviceIPPool,
 				Iqn:                iqn,
 				AllowedInitiators:  allowedInitiators,
 				Username:           username,
 				Password:           password,
 				Implementation:     implementation,
+				ClusterPrivatePath: clusterPrivatePath,
+				Interface:          iface,
+				Vlan:               vlan,
+				Router:             router,
+				DryRun:             dryRun,
 			}
 
 			if req.Implementation == "" {
 				req.Implementation = "lio"
 			}
 
-			resp, err := sdsClient.CreateISCSIGateway(ctx, req)
+			progressCtx, stopProgress := watchProgress(ctx, sdsClient)
+			resp, err := sdsClient.CreateISCSIGateway(progressCtx, req)
+			stopProgress()
 			if err != nil {
 				return fmt.Errorf("failed to create iSCSI gateway: %w", err)
 			}
@@ -89,6 +100,15 @@ func iscsiCreate() *cobra.Command {
 			if !resp.Success {
 				return fmt.Errorf("failed to create iSCSI gateway: %s", resp.Message)
 			}
+			if dryRun {
+				fmt.Println(resp.Message)
+				printPlannedFiles(resp.Files)
+				return nil
+			}
+			if resp.ServiceIp != "" {
+				// Allocated from the pool for --service-ip auto
+				serviceIP = resp.ServiceIp
+			}
 
 			fmt.Printf("✓ iSCSI gateway created successfully\n")
 			fmt.Printf("  Resource:     %s\n", resource)
@@ -105,11 +125,17 @@ func iscsiCreate() *cobra.Command {
 
 	cmd.Flags().StringVar(&resource, "resource", "", "DRBD resource name")
 	cmd.Flags().StringVar(&iqn, "iqn", "", "iSCSI Qualified Name (IQN)")
-	cmd.Flags().StringVar(&serviceIP, "service-ip", "", "Service IP (e.g., 192.168.1.100/24)")
+	cmd.Flags().StringVar(&serviceIP, "service-ip", "", "Service IP (e.g., 192.168.1.100/24), or auto to allocate one from --service-ip-pool")
+	cmd.Flags().StringVar(&serviceIPPool, "service-ip-pool", "", "IPAM pool to allocate the service IP from with --service-ip auto")
 	cmd.Flags().StringSliceVar(&allowedInitiators, "allowed-initiators", []string{}, "Allowed initiator IQNs")
 	cmd.Flags().StringVar(&username, "username", "", "CHAP username")
 	cmd.Flags().StringVar(&password, "password", "", "CHAP password")
 	cmd.Flags().StringVar(&implementation, "implementation", "lio", "iSCSI implementation (lio, tgt, iet)")
+	cmd.Flags().StringVar(&clusterPrivatePath, "cluster-private-path", "", "Directory to mount the cluster-private volume under (default from the controller config)")
+	cmd.Flags().StringVar(&iface, "interface", "", "Interface of the tenant network to bind the service IP to (default: wherever it routes)")
+	cmd.Flags().Uint32Var(&vlan, "vlan", 0, "VLAN of the tenant network on --interface")
+	cmd.Flags().StringVar(&router, "router", "", "Router of the tenant network for clients outside the service IP subnet")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show the files that would be written to each node")
 
 	cmd.MarkFlagRequired("resource")
 	cmd.MarkFlagRequired("iqn")
@@ -179,7 +205,9 @@ func gatewayNFS() *cobra.Command {
 }
 
 func nfsCreate() *cobra.Command {
-	var resource, serviceIP, exportPath, fsType string
+	var resource, serviceIP, serviceIPPool, exportPath, fsType, exportBasePath, clusterPrivatePath, iface, router string
+	var vlan uint32
+	var dryRun bool
 	var allowedIPs []string
 
 	cmd := &cobra.Command{
@@ -207,18 +235,27 @@ func nfsCreate() *cobra.Command {
 
 			// Create NFS gateway
 			req := &v1.CreateNFSGatewayRequest{
-				Resource:   resource,
-				ServiceIp:  serviceIP,
-				ExportPath: exportPath,
-				AllowedIps: allowedIPs,
-				FsType:     fsType,
+				Resource:           resource,
+				ServiceIp:          serviceIP,
+				ServiceIpPool:      serviceIPPool,
+				ExportPath:         exportPath,
+				AllowedIps:         allowedIPs,
+				FsType:             fsType,
+				ExportBasePath:     exportBasePath,
+				ClusterPrivatePath: clusterPrivatePath,
+				Interface:          iface,
+				Vlan:               vlan,
+				Router:             router,
+				DryRun:             dryRun,
 			}
 
 			if req.FsType == "" {
 				req.FsType = "ext4"
 			}
 
-			resp, err := sdsClient.CreateNFSGateway(ctx, req)
+			progressCtx, stopProgress := watchProgress(ctx, sdsClient)
+			resp, err := sdsClient.CreateNFSGateway(progressCtx, req)
+			stopProgress()
 			if err != nil {
 				return fmt.Errorf("failed to create NFS gateway: %w", err)
 			}
@@ -226,6 +263,15 @@ func nfsCreate() *cobra.Command {
 			if !resp.Success {
 				return fmt.Errorf("failed to create NFS gateway: %s", resp.Message)
 			}
+			if dryRun {
+				fmt.Println(resp.Message)
+				printPlannedFiles(resp.Files)
+				return nil
+			}
+			if resp.ServiceIp != "" {
+				// Allocated from the pool for --service-ip auto
+				serviceIP = resp.ServiceIp
+			}
 
 			fmt.Printf("✓ NFS gateway created successfully\n")
 			fmt.Printf("  Resource:     %s\n", resource)
@@ -242,10 +288,17 @@ func nfsCreate() *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&resource, "resource", "", "DRBD resource name")
-	cmd.Flags().StringVar(&serviceIP, "service-ip", "", "Service IP (e.g., 192.168.1.200/24)")
+	cmd.Flags().StringVar(&serviceIP, "service-ip", "", "Service IP (e.g., 192.168.1.200/24), or auto to allocate one from --service-ip-pool")
+	cmd.Flags().StringVar(&serviceIPPool, "service-ip-pool", "", "IPAM pool to allocate the service IP from with --service-ip auto")
 	cmd.Flags().StringVar(&exportPath, "export-path", "", "Export path (e.g., /data)")
 	cmd.Flags().StringSliceVar(&allowedIPs, "allowed-ips", []string{}, "Allowed client IPs (e.g., 192.168.1.0/24)")
 	cmd.Flags().StringVar(&fsType, "fs-type", "ext4", "Filesystem type (ext4, xfs)")
+	cmd.Flags().StringVar(&exportBasePath, "export-base-path", "", "Directory to mount the exports under (default from the controller config)")
+	cmd.Flags().StringVar(&clusterPrivatePath, "cluster-private-path", "", "Directory to mount the cluster-private volume under (default from the controller config)")
+	cmd.Flags().StringVar(&iface, "interface", "", "Interface of the tenant network to bind the service IP to (default: wherever it routes)")
+	cmd.Flags().Uint32Var(&vlan, "vlan", 0, "VLAN of the tenant network on --interface")
+	cmd.Flags().StringVar(&router, "router", "", "Router of the tenant network for clients outside the service IP subnet")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show the files that would be written to each node")
 
 	cmd.MarkFlagRequired("resource")
 	cmd.MarkFlagRequired("service-ip")
@@ -315,7 +368,9 @@ func gatewayNVMe() *cobra.Command {
 }
 
 func nvmeCreate() *cobra.Command {
-	var resource, serviceIP, nqn, transportType string
+	var resource, serviceIP, serviceIPPool, nqn, transportType, clusterPrivatePath, iface, router string
+	var vlan uint32
+	var dryRun bool
 
 	cmd := &cobra.Command{
 		Use:   "create --resource <name> --nqn <nqn> --service-ip <ip/cidr>",
@@ -342,17 +397,25 @@ func nvmeCreate() *cobra.Command {
 
 			// Create NVMe-oF gateway
 			req := &v1.CreateNVMeGatewayRequest{
-				Resource:      resource,
-				ServiceIp:     serviceIP,
-				Nqn:           nqn,
-				TransportType: transportType,
+				Resource:           resource,
+				ServiceIp:          serviceIP,
+				ServiceIpPool:      serviceIPPool,
+				Nqn:                nqn,
+				TransportType:      transportType,
+				ClusterPrivatePath: clusterPrivatePath,
+				Interface:          iface,
+				Vlan:               vlan,
+				Router:             router,
+				DryRun:             dryRun,
 			}
 
 			if req.TransportType == "" {
 				req.TransportType = "tcp"
 			}
 
-			resp, err := sdsClient.CreateNVMeGateway(ctx, req)
+			progressCtx, stopProgress := watchProgress(ctx, sdsClient)
+			resp, err := sdsClient.CreateNVMeGateway(progressCtx, req)
+			stopProgress()
 			if err != nil {
 				return fmt.Errorf("failed to create NVMe-oF gateway: %w", err)
 			}
@@ -360,6 +423,15 @@ func nvmeCreate() *cobra.Command {
 			if !resp.Success {
 				return fmt.Errorf("failed to create NVMe-oF gateway: %s", resp.Message)
 			}
+			if dryRun {
+				fmt.Println(resp.Message)
+				printPlannedFiles(resp.Files)
+				return nil
+			}
+			if resp.ServiceIp != "" {
+				// Allocated from the pool for --service-ip auto
+				serviceIP = resp.ServiceIp
+			}
 
 			fmt.Printf("✓ NVMe-oF gateway created successfully\n")
 			fmt.Printf("  Resource:     %s\n", resource)
@@ -376,8 +448,14 @@ func nvmeCreate() *cobra.Command {
 
 	cmd.Flags().StringVar(&resource, "resource", "", "DRBD resource name")
 	cmd.Flags().StringVar(&nqn, "nqn", "", "NVMe Qualified Name (NQN)")
-	cmd.Flags().StringVar(&serviceIP, "service-ip", "", "Service IP (e.g., 192.168.1.150/24)")
+	cmd.Flags().StringVar(&serviceIP, "service-ip", "", "Service IP (e.g., 192.168.1.150/24), or auto to allocate one from --service-ip-pool")
+	cmd.Flags().StringVar(&serviceIPPool, "service-ip-pool", "", "IPAM pool to allocate the service IP from with --service-ip auto")
 	cmd.Flags().StringVar(&transportType, "transport", "tcp", "Transport type (tcp, rdma)")
+	cmd.Flags().StringVar(&clusterPrivatePath, "cluster-private-path", "", "Directory to mount the cluster-private volume under (default from the controller config)")
+	cmd.Flags().StringVar(&iface, "interface", "", "Interface of the tenant network to bind the service IP to (default: wherever it routes)")
+	cmd.Flags().Uint32Var(&vlan, "vlan", 0, "VLAN of the tenant network on --interface")
+	cmd.Flags().StringVar(&router, "router", "", "Router of the tenant network for clients outside the service IP subnet")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show the files that would be written to each node")
 
 	cmd.MarkFlagRequired("resource")
 	cmd.MarkFlagRequired("nqn")
@@ -474,6 +552,72 @@ func gatewayList() *cobra.Command {
 	return cmd
 }
 
+func gatewayStatus() *cobra.Command {
+	var resource string
+
+	cmd := &cobra.Command{
+		Use:   "status [--resource <name>]",
+		Short: "Show the clients connected to gateways",
+		Long: `Show the node each gateway is active on and the clients connected to it:
+NFS clients, iSCSI sessions and NVMe-oF controllers. Check who is affected
+before a failover or node maintenance.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			gateways, err := sdsClient.ListGatewayClients(ctx, resource)
+			if err != nil {
+				return fmt.Errorf("failed to list gateway clients: %w", err)
+			}
+
+			if len(gateways) == 0 {
+				fmt.Println("No gateways configured")
+				return nil
+			}
+
+			for i, gw := range gateways {
+				if i > 0 {
+					fmt.Println()
+				}
+				node := gw.Node
+				if node == "" {
+					node = "-"
+				}
+				fmt.Printf("%s (%s, resource %s) on %s: %d clients\n", gw.Gateway, gw.Type, gw.Resource, node, len(gw.Clients))
+				if gw.Error != "" {
+					fmt.Printf("  ✗ %s\n", gw.Error)
+					continue
+				}
+				if len(gw.Clients) == 0 {
+					continue
+				}
+
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+				fmt.Fprintln(w, "  ADDRESS\tNAME\tSTATE")
+				for _, c := range gw.Clients {
+					name := c.Name
+					if name == "" {
+						name = "-"
+					}
+					fmt.Fprintf(w, "  %s\t%s\t%s\n", c.Address, name, c.State)
+				}
+				w.Flush()
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&resource, "resource", "", "DRBD resource or gateway name (default: all gateways)")
+
+	return cmd
+}
+
 func gatewayDelete() *cobra.Command {
 	var resource string
 
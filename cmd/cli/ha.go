@@ -1,12 +1,13 @@
 package main
 
 import (
-	"context"
 	"fmt"
 	"os"
 	"strings"
+	"text/tabwriter"
 	"time"
 
+	v1 "github.com/liliang-cn/sds/api/proto/v1"
 	"github.com/liliang-cn/sds/pkg/client"
 	"github.com/spf13/cobra"
 )
@@ -18,9 +19,13 @@ func haCommand() *cobra.Command {
 	}
 
 	cmd.AddCommand(haCreate())
+	cmd.AddCommand(haUpdate())
+	cmd.AddCommand(haFailover())
+	cmd.AddCommand(haEvict())
 	cmd.AddCommand(haDelete())
 	cmd.AddCommand(haList())
 	cmd.AddCommand(haStatus())
+	cmd.AddCommand(haImportPacemaker())
 
 	return cmd
 }
@@ -30,15 +35,30 @@ func haCreate() *cobra.Command {
 	var mountPoint string
 	var fsType string
 	var vip string
+	var vipPool string
+	var dependsOn string
+	var onDemoteFailure string
+	var stopServicesOnExit bool
+	var noSecondaryForce bool
+	var dryRun bool
 
 	cmd := &cobra.Command{
 		Use:   "create <resource>",
 		Short: "Create HA configuration for a resource",
-		Args:  cobra.ExactArgs(1),
+		Long: `Create HA configuration for a resource.
+
+If the Primary cannot be demoted during a failover, drbd-reactor runs the
+--on-demote-failure action so another node can take over. The default reboot
+is the safe choice for shared-nothing setups; "none" leaves the node as it
+is and the resource stuck until an operator intervenes.
+
+--dry-run shows the mount unit and promoter config that would be written to
+each node, with a diff against the deployed files.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			resource := args[0]
 
-			ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -53,23 +73,46 @@ func haCreate() *cobra.Command {
 				serviceList = strings.Split(services, ",")
 			}
 
-			configPath, err := sdsClient.MakeHa(ctx, resource, serviceList, mountPoint, fsType, vip)
+			// Parse dependencies
+			var dependsOnList []string
+			if dependsOn != "" {
+				dependsOnList = strings.Split(dependsOn, ",")
+			}
+
+			policy := &v1.HaPolicy{
+				OnDemoteFailure:    onDemoteFailure,
+				StopServicesOnExit: stopServicesOnExit,
+				SecondaryForce:     !noSecondaryForce,
+			}
+
+			progressCtx, stopProgress := watchProgress(ctx, sdsClient)
+			resp, err := sdsClient.MakeHa(progressCtx, resource, serviceList, mountPoint, fsType, vip, vipPool, dependsOnList, policy, dryRun)
+			stopProgress()
 			if err != nil {
 				return fmt.Errorf("failed to create HA config: %w", err)
 			}
+			if dryRun {
+				fmt.Println(resp.Message)
+				printPlannedFiles(resp.Files)
+				return nil
+			}
 
 			fmt.Printf("HA configuration created successfully\n")
 			fmt.Printf("  Resource:  %s\n", resource)
-			fmt.Printf("  Config:    %s\n", configPath)
+			fmt.Printf("  Config:    %s\n", resp.ConfigPath)
 			if len(serviceList) > 0 {
 				fmt.Printf("  Services:  %v\n", serviceList)
 			}
 			if mountPoint != "" {
 				fmt.Printf("  Mount:     %s (%s)\n", mountPoint, fsType)
 			}
-			if vip != "" {
-				fmt.Printf("  VIP:       %s\n", vip)
+			if resp.Vip != "" {
+				fmt.Printf("  VIP:       %s\n", resp.Vip)
 			}
+			if len(dependsOnList) > 0 {
+				fmt.Printf("  Depends on: %v\n", dependsOnList)
+			}
+			fmt.Printf("  On demote failure: %s\n", onDemoteFailure)
 			fmt.Printf("\nConfiguration distributed to all nodes and drbd-reactor reloaded\n")
 
 			return nil
@@ -79,7 +122,177 @@ func haCreate() *cobra.Command {
 	cmd.Flags().StringVar(&services, "services", "", "Systemd services to start/stop (comma-separated)")
 	cmd.Flags().StringVar(&mountPoint, "mount", "", "Mount point for filesystem")
 	cmd.Flags().StringVar(&fsType, "fstype", "ext4", "Filesystem type (ext4, xfs, etc.)")
-	cmd.Flags().StringVar(&vip, "vip", "", "Virtual IP (CIDR, e.g., 192.168.1.100/24)")
+	cmd.Flags().StringVar(&vip, "vip", "", "Virtual IP (CIDR, e.g., 192.168.1.100/24), or auto to allocate one from --vip-pool")
+	cmd.Flags().StringVar(&vipPool, "vip-pool", "", "IPAM pool to allocate the VIP from with --vip auto")
+	cmd.Flags().StringVar(&dependsOn, "depends-on", "", "HA resources to start first on the same node (comma-separated)")
+	cmd.Flags().StringVar(&onDemoteFailure, "on-demote-failure", "reboot",
+		"Action when demoting fails (none, reboot, reboot-force, reboot-immediate, poweroff, poweroff-force, poweroff-immediate, exit, exit-force)")
+	cmd.Flags().BoolVar(&stopServicesOnExit, "stop-services-on-exit", false, "Stop the services when drbd-reactor exits")
+	cmd.Flags().BoolVar(&noSecondaryForce, "no-secondary-force", false, "Do not force the demotion after stopping the services")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show the files that would be written to each node")
+
+	return cmd
+}
+
+func haUpdate() *cobra.Command {
+	var addServices []string
+	var removeServices []string
+	var vip string
+	var vipPool string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "update <resource>",
+		Short: "Change the services or the VIP of an HA configuration in place",
+		Long: `Change the services or the VIP of an HA configuration without deleting and
+re-creating it.
+
+The promoter config is regenerated and compared with the one on the nodes.
+drbd-reactor is reloaded on the Secondaries first and on the Primary last;
+on the Primary only removed units are stopped and added units started, the
+other services keep running. --vip none removes the VIP. --dry-run also shows
+the promoter config per node, with a diff against the deployed one.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resource := args[0]
+
+			if len(addServices) == 0 && len(removeServices) == 0 && vip == "" {
+				return fmt.Errorf("nothing to update, use --add-service, --remove-service or --vip")
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.UpdateHa(ctx, resource, addServices, removeServices, vip, vipPool, dryRun)
+			if err != nil {
+				return fmt.Errorf("failed to update HA config: %w", err)
+			}
+
+			fmt.Println(resp.Message)
+			if resp.Diff != "" {
+				fmt.Println("\nPromoter config changes:")
+				for _, line := range strings.Split(strings.TrimRight(resp.Diff, "\n"), "\n") {
+					fmt.Printf("  %s\n", line)
+				}
+			}
+			if len(resp.Actions) > 0 {
+				if dryRun {
+					fmt.Println("\nPlanned actions:")
+				} else {
+					fmt.Println("\nActions:")
+				}
+				for _, action := range resp.Actions {
+					fmt.Printf("  - %s\n", action)
+				}
+			}
+			if resp.Vip != "" {
+				fmt.Printf("\nVIP: %s\n", resp.Vip)
+			}
+			printPlannedFiles(resp.Files)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&addServices, "add-service", nil, "Systemd service to add (repeatable)")
+	cmd.Flags().StringSliceVar(&removeServices, "remove-service", nil, "Systemd service to remove (repeatable)")
+	cmd.Flags().StringVar(&vip, "vip", "", "New virtual IP (CIDR), auto to allocate one from --vip-pool, or none to remove it")
+	cmd.Flags().StringVar(&vipPool, "vip-pool", "", "IPAM pool to allocate the VIP from with --vip auto")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show the config diff, the planned actions and the files per node")
+
+	return cmd
+}
+
+func haFailover() *cobra.Command {
+	var to string
+	var timeout time.Duration
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "failover <resource> --to <node>",
+		Short: "Move an HA resource gracefully to a chosen node",
+		Long: `Move an HA resource gracefully to a chosen node.
+
+The destination must be UpToDate. It is put first in the preferred nodes of
+the promoter config, then the current Primary is evicted and drbd-reactor
+starts the services on the destination. Afterwards the regular placement
+order is restored without failing back. HA resources co-located with the
+resource move along.
+
+The failover is refused if the destination is not UpToDate, would not have
+quorum, or misses the services or the mount unit, unless --force is given.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resource := args[0]
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.FailoverHa(ctx, resource, to, timeout, force)
+			if err != nil {
+				return fmt.Errorf("failed to fail over HA resource: %w", err)
+			}
+
+			fmt.Printf("HA resource %s failed over from %s to %s\n", resource, resp.From, resp.To)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&to, "to", "", "Node to fail over to (required)")
+	cmd.Flags().DurationVar(&timeout, "timeout", 2*time.Minute, "How long to wait for the node to become Primary")
+	cmd.Flags().BoolVar(&force, "force", false, "Fail over even if the node fails the health checks")
+	cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
+func haEvict() *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "evict <resource>",
+		Short: "Move an HA resource away from its current node",
+		Long: `Move an HA resource away from its current node and let drbd-reactor
+start it on another one.
+
+The eviction is refused unless at least one other node is UpToDate, would
+have quorum, and has the services and the mount unit installed. --force
+evicts anyway.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resource := args[0]
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			if err := sdsClient.EvictHa(ctx, resource, force); err != nil {
+				return fmt.Errorf("failed to evict HA resource: %w", err)
+			}
+
+			fmt.Printf("HA resource %s evicted\n", resource)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Evict even if no node passes the health checks")
 
 	return cmd
 }
@@ -92,7 +305,7 @@ func haDelete() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			resource := args[0]
 
-			ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -123,28 +336,43 @@ func haList() *cobra.Command {
 		Use:   "list",
 		Short: "List all HA configurations",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			configDir := "/etc/drbd-reactor.d"
-			configFiles, err := listHAConfigs(configDir)
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			configs, err := sdsClient.ListHa(ctx)
 			if err != nil {
 				return fmt.Errorf("failed to list HA configs: %w", err)
 			}
 
-			if len(configFiles) == 0 {
+			if len(configs) == 0 {
 				fmt.Println("No HA configurations found")
 				return nil
 			}
 
-			fmt.Printf("HA Configurations (%d):\n", len(configFiles))
-			for _, cfg := range configFiles {
-				fmt.Printf("  - %s\n", cfg.Resource)
+			fmt.Printf("HA Configurations (%d):\n", len(configs))
+			for _, cfg := range configs {
+				active := cfg.ActiveNode
+				if active == "" {
+					active = "none"
+				}
+				fmt.Printf("  - %s (active on %s)\n", cfg.Resource, active)
 				if cfg.MountPoint != "" {
-					fmt.Printf("      Mount: %s (%s)\n", cfg.MountPoint, cfg.FSType)
+					fmt.Printf("      Mount: %s (%s)\n", cfg.MountPoint, cfg.FsType)
 				}
 				if len(cfg.Services) > 0 {
 					fmt.Printf("      Services: %v\n", cfg.Services)
 				}
-				if cfg.VIP != "" {
-					fmt.Printf("      VIP: %s\n", cfg.VIP)
+				if cfg.Vip != "" {
+					fmt.Printf("      VIP: %s\n", cfg.Vip)
+				}
+				if len(cfg.DependsOn) > 0 {
+					fmt.Printf("      Depends on: %v\n", cfg.DependsOn)
 				}
 			}
 
@@ -159,33 +387,66 @@ func haStatus() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "status <resource>",
 		Short: "Show HA configuration status for a resource",
-		Args:  cobra.ExactArgs(1),
+		Long: `Show the HA configuration of a resource, the node it is active on and the
+DRBD role, disk state and drbd-reactor state on each of its nodes, as the
+controller queries them from the nodes.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			resource := args[0]
-			configPath := fmt.Sprintf("/etc/drbd-reactor.d/sds-ha-%s.toml", resource)
 
-			cfg, err := readHAConfig(configPath)
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			status, err := sdsClient.GetHaStatus(ctx, resource)
 			if err != nil {
-				return fmt.Errorf("failed to read HA config: %w", err)
+				return fmt.Errorf("failed to get HA status: %w", err)
 			}
 
+			cfg := status.Config
 			fmt.Printf("HA Configuration: %s\n", resource)
-			fmt.Printf("  Config:    %s\n", configPath)
+			fmt.Printf("  Config:    %s\n", status.ConfigPath)
 			if cfg.MountPoint != "" {
-				fmt.Printf("  Mount:     %s (%s)\n", cfg.MountPoint, cfg.FSType)
-				fmt.Printf("  Mount Unit: %s.mount\n", strings.TrimPrefix(strings.ReplaceAll(cfg.MountPoint, "/", "-"), "/"))
+				fmt.Printf("  Mount:     %s (%s)\n", cfg.MountPoint, cfg.FsType)
+				fmt.Printf("  Mount Unit: %s.mount\n", strings.TrimPrefix(strings.ReplaceAll(cfg.MountPoint, "/", "-"), "-"))
 			}
 			if len(cfg.Services) > 0 {
 				fmt.Printf("  Services:  %v\n", cfg.Services)
 			}
-			if cfg.VIP != "" {
-				fmt.Printf("  VIP:       %s\n", cfg.VIP)
+			if cfg.Vip != "" {
+				fmt.Printf("  VIP:       %s\n", cfg.Vip)
+			}
+			if len(cfg.DependsOn) > 0 {
+				fmt.Printf("  Depends on: %v\n", cfg.DependsOn)
+			}
+			if policy := cfg.Policy; policy != nil {
+				onDemoteFailure := policy.OnDemoteFailure
+				if onDemoteFailure == "" {
+					onDemoteFailure = "reboot"
+				}
+				fmt.Printf("  On demote failure: %s\n", onDemoteFailure)
+				fmt.Printf("  Stop services on exit: %v\n", policy.StopServicesOnExit)
+				fmt.Printf("  Secondary force: %v\n", policy.SecondaryForce)
 			}
-			fmt.Printf("  Nodes:     %v\n", cfg.Nodes)
 
-			// Show drbd-reactor status
-			fmt.Printf("\nChecking drbd-reactor status...\n")
-			fmt.Printf("Run: drbd-reactorctl status sds-ha-%s.toml\n", resource)
+			active := status.ActiveNode
+			if active == "" {
+				active = "none"
+			}
+			fmt.Printf("  Active on: %s\n", active)
+
+			fmt.Println()
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tADDRESS\tROLE\tDISK\tDRBD-REACTOR")
+			for _, member := range status.Members {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", member.Node, member.Address, member.Role, member.DiskState, member.Reactor)
+			}
+			w.Flush()
 
 			return nil
 		},
@@ -194,116 +455,117 @@ func haStatus() *cobra.Command {
 	return cmd
 }
 
-// HAConfig represents a parsed HA configuration
-type HAConfig struct {
-	Resource   string
-	MountPoint string
-	FSType     string
-	Services   []string
-	VIP        string
-	Nodes      []string
-}
-
-// listHAConfigs lists all HA configurations in the directory
-func listHAConfigs(configDir string) ([]*HAConfig, error) {
-	var configs []*HAConfig
+func haImportPacemaker() *cobra.Command {
+	var node, cibFile string
+	var apply, showConfig bool
 
-	files, err := os.ReadDir(configDir)
-	if err != nil {
-		return nil, err
-	}
+	cmd := &cobra.Command{
+		Use:   "import-pacemaker [resource...]",
+		Short: "Take over the HA setup of DRBD resources from Pacemaker",
+		Long: `Map the Pacemaker HA setup of DRBD resources onto drbd-reactor promoter configs.
 
-	for _, file := range files {
-		if !strings.HasPrefix(file.Name(), "sds-ha-") || !strings.HasSuffix(file.Name(), ".toml") {
-			continue
-		}
+The CIB is read with cibadmin from --node, or from --cib (cibadmin --query
+output). For each promotable ocf:linbit:drbd clone, the Filesystem, IPaddr2
+and systemd resources colocated with its Promoted role become the mount, VIP
+and services of the HA config. Location constraints become preferred nodes.
 
-		configPath := fmt.Sprintf("%s/%s", configDir, file.Name())
-		cfg, err := readHAConfig(configPath)
-		if err != nil {
-			continue
-		}
-		configs = append(configs, cfg)
-	}
+Without --apply nothing changes. To move a resource off Pacemaker:
 
-	return configs, nil
-}
+  1. pcs resource unmanage <drbd-clone> <group>
+  2. sds ha import-pacemaker <resource> --node <node> --apply
+  3. pcs resource delete <group>; pcs resource delete <drbd-clone>
 
-// readHAConfig reads and parses an HA configuration file
-func readHAConfig(configPath string) (*HAConfig, error) {
-	content, err := os.ReadFile(configPath)
-	if err != nil {
-		return nil, err
-	}
+Unmanaged resources keep running when they are deleted from Pacemaker, and
+drbd-reactor takes them over. Resources are moved one at a time this way.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var cib string
+			if cibFile != "" {
+				data, err := os.ReadFile(cibFile)
+				if err != nil {
+					return fmt.Errorf("failed to read CIB: %w", err)
+				}
+				cib = string(data)
+			} else if node == "" {
+				return fmt.Errorf("--node or --cib is required")
+			}
 
-	cfg := &HAConfig{
-		FSType: "ext4", // default
-	}
+			ctx, cancel := commandContext()
+			defer cancel()
 
-	lines := strings.Split(string(content), "\n")
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
 
-	// Extract resource name from filename
-	parts := strings.Split(configPath, "/")
-	lastPart := parts[len(parts)-1]
-	cfg.Resource = strings.TrimPrefix(lastPart, "sds-ha-")
-	cfg.Resource = strings.TrimSuffix(cfg.Resource, ".toml")
+			imports, err := sdsClient.ImportPacemakerHa(ctx, node, cib, args, apply)
+			for _, imp := range imports {
+				state := "ready"
+				switch {
+				case imp.Applied:
+					state = "imported"
+				case len(imp.Blockers) > 0:
+					state = "blocked"
+				}
+				fmt.Printf("%s (%s): %s\n", imp.Resource, imp.Clone, state)
+				fmt.Printf("  Members:   %s\n", strings.Join(imp.Members, ", "))
+				if imp.MountPoint != "" {
+					fmt.Printf("  Mount:     %s (%s)\n", imp.MountPoint, imp.Fstype)
+				}
+				if imp.Vip != "" {
+					fmt.Printf("  VIP:       %s\n", imp.Vip)
+				}
+				if len(imp.Services) > 0 {
+					fmt.Printf("  Services:  %s\n", strings.Join(imp.Services, ", "))
+				}
+				if len(imp.PreferredNodes) > 0 {
+					fmt.Printf("  Preferred: %s\n", strings.Join(imp.PreferredNodes, ", "))
+				}
+				for _, w := range imp.Warnings {
+					fmt.Printf("  WARNING:   %s\n", w)
+				}
+				for _, b := range imp.Blockers {
+					fmt.Printf("  BLOCKED:   %s\n", b)
+				}
+				if showConfig {
+					fmt.Printf("\n%s", imp.Config)
+				}
+				fmt.Println()
+			}
+			if err != nil {
+				return fmt.Errorf("failed to import Pacemaker HA setup: %w", err)
+			}
+			if len(imports) == 0 {
+				fmt.Println("No promotable DRBD clones in the CIB")
+			} else if !apply {
+				fmt.Println("Nothing applied, run again with --apply")
+			}
+			return nil
+		},
+	}
 
-	// Parse TOML content
-	inResourceBlock := false
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
+	cmd.Flags().StringVar(&node, "node", "", "Node to read the CIB from")
+	cmd.Flags().StringVar(&cibFile, "cib", "", "CIB file (cibadmin --query output) instead of reading it from --node")
+	cmd.Flags().BoolVar(&apply, "apply", false, "Install the promoter configs and record the HA configs")
+	cmd.Flags().BoolVar(&showConfig, "show-config", false, "Print the generated promoter configs")
 
-		// Check for resource block
-		if strings.HasPrefix(line, "[promoter.resources.") {
-			inResourceBlock = true
-			continue
-		}
-		if inResourceBlock && strings.HasPrefix(line, "]") {
-			inResourceBlock = false
-			continue
-		}
+	return cmd
+}
 
-		// Parse start array
-		if strings.HasPrefix(line, "start = [") {
-			// Multi-line start array
+// printPlannedFiles prints the files a dry run would write, per node: the
+// diff against the deployed file, or the whole file if it is missing
+func printPlannedFiles(files []*v1.PlannedFile) {
+	for _, file := range files {
+		fmt.Printf("\n%s:%s (%s)\n", file.Node, file.Path, file.State)
+		text := file.Diff
+		switch file.State {
+		case "identical":
 			continue
+		case "missing", "unreachable":
+			text = file.Content
 		}
-
-		// Parse mount unit (e.g., "var-lib-sds.mount")
-		if strings.Contains(line, ".mount") {
-			mountUnit := strings.TrimSpace(line)
-			mountUnit = strings.TrimPrefix(mountUnit, `"`)
-			mountUnit = strings.TrimSuffix(mountUnit, `"`)
-			mountUnit = strings.TrimSuffix(mountUnit, ",")
-			cfg.MountPoint = mountUnit
-			// Convert mount unit back to path
-			cfg.MountPoint = strings.ReplaceAll(mountUnit, "-", "/")
-		}
-
-		// Parse service
-		if strings.Contains(line, ".service") {
-			svc := strings.TrimSpace(line)
-			svc = strings.TrimPrefix(svc, `"`)
-			svc = strings.TrimSuffix(svc, `",`)
-			svc = strings.TrimSuffix(svc, `"`)
-			cfg.Services = append(cfg.Services, svc)
-		}
-
-		// Parse preferred-nodes
-		if strings.HasPrefix(line, "preferred-nodes = [") {
-			nodesStr := strings.TrimPrefix(line, "preferred-nodes = [")
-			nodesStr = strings.TrimSuffix(nodesStr, "]")
-			nodes := strings.Split(nodesStr, ",")
-			for _, node := range nodes {
-				node = strings.TrimSpace(node)
-				node = strings.TrimPrefix(node, `"`)
-				node = strings.TrimSuffix(node, `"`)
-				if node != "" {
-					cfg.Nodes = append(cfg.Nodes, node)
-				}
-			}
+		for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+			fmt.Printf("  %s\n", line)
 		}
 	}
-
-	return cfg, nil
 }
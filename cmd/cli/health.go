@@ -1,10 +1,8 @@
 package main
 
 import (
-	"context"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/liliang-cn/sds/pkg/client"
@@ -17,7 +15,7 @@ func healthCommand() *cobra.Command {
 		Use:   "health-check",
 		Short: "Check node health (drbd, drbd-reactor, resource-agents)",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -61,6 +59,13 @@ func healthCommand() *cobra.Command {
 				// Print DRBD status
 				if healthy.DrbdInstalled {
 					fmt.Printf("  [OK] DRBD: %s\n", healthy.DrbdVersion)
+					if healthy.DrbdKernelVersion != "" || healthy.DrbdUtilsVersion != "" {
+						fmt.Printf("  [INFO] DRBD kernel module: %s, drbd-utils: %s\n",
+							versionOrUnknown(healthy.DrbdKernelVersion), versionOrUnknown(healthy.DrbdUtilsVersion))
+					}
+					for _, warning := range healthy.DrbdVersionWarnings {
+						fmt.Printf("  [WARN] %s\n", warning)
+					}
 				} else {
 					fmt.Printf("  [MISSING] DRBD not installed\n")
 					allHealthy = false
@@ -89,6 +94,16 @@ func healthCommand() *cobra.Command {
 					fmt.Printf("  [MISSING] resource-agents-extra not installed\n")
 					allHealthy = false
 				}
+
+				// Print the clock against the controller's
+				if t := healthy.Time; t != nil {
+					if len(t.Warnings) == 0 {
+						fmt.Printf("  [OK] Clock: %s, synchronized by %s\n", formatSkew(t.SkewMs), t.Source)
+					}
+					for _, warning := range t.Warnings {
+						fmt.Printf("  [WARN] Clock: %s\n", warning)
+					}
+				}
 			}
 
 			fmt.Println()
@@ -105,3 +120,11 @@ func healthCommand() *cobra.Command {
 
 	return cmd
 }
+
+// versionOrUnknown returns a detected version, or "unknown" if it was not
+func versionOrUnknown(version string) string {
+	if version == "" {
+		return "unknown"
+	}
+	return version
+}
@@ -0,0 +1,366 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	v1 "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func jobCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "job",
+		Short: "Inspect the records of mutating operations",
+		Long: `Every mutating operation is recorded as a job together with the remote
+commands it ran on each node and how long they took.
+
+A job journals each orchestration step before it starts. Jobs the controller
+was running when it stopped are marked interrupted on the next start; resume
+or roll them back so the cluster is not left half-changed.`,
+	}
+
+	cmd.AddCommand(jobList())
+	cmd.AddCommand(jobDescribe())
+	cmd.AddCommand(jobCommands())
+	cmd.AddCommand(jobResume())
+	cmd.AddCommand(jobRollback())
+
+	return cmd
+}
+
+func jobList() *cobra.Command {
+	var target, state string
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List recent jobs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			jobs, err := sdsClient.ListJobs(ctx, target, state, limit)
+			if err != nil {
+				return fmt.Errorf("failed to list jobs: %w", err)
+			}
+
+			if len(jobs) == 0 {
+				fmt.Println("No jobs recorded")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "ID\tSTARTED\tOPERATION\tTARGET\tSTATE\tDURATION\tSTEPS\tSLOW")
+			for _, job := range jobs {
+				fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%d\t%d\n",
+					job.Id,
+					time.UnixMilli(job.StartedAt).Format("2006-01-02 15:04:05"),
+					job.Operation,
+					job.Target,
+					job.State,
+					jobDuration(job),
+					job.StepCount,
+					job.SlowSteps)
+			}
+			w.Flush()
+
+			for _, job := range jobs {
+				if job.State == "interrupted" && job.Resolution == "" {
+					fmt.Println("\nInterrupted jobs left the cluster half-changed, see 'sds job describe <id>'")
+					break
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&target, "target", "", "Only show jobs of this resource or object")
+	cmd.Flags().StringVar(&state, "state", "", "Only show jobs in this state (running, succeeded, failed, cancelled, interrupted)")
+	cmd.Flags().IntVar(&limit, "limit", 50, "Maximum number of jobs to show")
+
+	return cmd
+}
+
+func jobDescribe() *cobra.Command {
+	return &cobra.Command{
+		Use:   "describe <id>",
+		Short: "Show a job with the timing of its steps per node",
+		Long: `Show a job with the remote commands it ran, when they started relative to
+the job, on which node and how long they took. Slow commands are flagged
+and the time spent per step and node is summed up, slowest first.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil || id < 1 {
+				return fmt.Errorf("invalid job ID %q", args[0])
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			job, err := sdsClient.GetJob(ctx, id)
+			if err != nil {
+				return fmt.Errorf("failed to get job: %w", err)
+			}
+
+			fmt.Printf("Job:       %d\n", job.Id)
+			fmt.Printf("Operation: %s\n", job.Operation)
+			if job.Target != "" {
+				fmt.Printf("Target:    %s\n", job.Target)
+			}
+			fmt.Printf("State:     %s\n", job.State)
+			if job.Message != "" {
+				fmt.Printf("Message:   %s\n", job.Message)
+			}
+			fmt.Printf("Started:   %s\n", time.UnixMilli(job.StartedAt).Format(time.RFC3339))
+			fmt.Printf("Duration:  %s\n", jobDuration(job))
+			if job.Step != "" {
+				fmt.Printf("Last step: %s\n", job.Step)
+			}
+			if job.Resolution != "" {
+				fmt.Printf("Resolved:  %s by job %d\n", job.Resolution, job.ResolvedBy)
+			} else if job.State == "interrupted" {
+				fmt.Println("\nThe controller stopped during this job. Options:")
+				if job.Resumable {
+					fmt.Printf("  sds job resume %d     run %s again\n", job.Id, job.Operation)
+				}
+				if job.Rollback != "" {
+					fmt.Printf("  sds job rollback %d   undo it with %s\n", job.Id, job.Rollback)
+				}
+				if !job.Resumable && job.Rollback == "" {
+					fmt.Println("  none automatic, check the cluster with 'sds drift' and repair it by hand")
+				}
+			}
+
+			if len(job.Steps) == 0 {
+				fmt.Println("\nNo remote commands were run")
+				return nil
+			}
+
+			fmt.Println("\nSteps:")
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "  AT\tSTEP\tNODE\tDURATION\tRESULT")
+			for _, step := range job.Steps {
+				result := "ok"
+				if !step.Success {
+					result = "failed"
+				}
+				if step.Slow {
+					result += " (slow)"
+				}
+				fmt.Fprintf(w, "  +%s\t%s\t%s\t%s\t%s\n",
+					formatMillis(step.StartedAt-job.StartedAt),
+					step.Name,
+					step.Node,
+					formatMillis(step.DurationMs),
+					result)
+			}
+			w.Flush()
+
+			fmt.Println("\nTime per step and node:")
+			w = tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "  STEP\tNODE\tCOMMANDS\tTOTAL")
+			for _, total := range stepTotals(job.Steps) {
+				fmt.Fprintf(w, "  %s\t%s\t%d\t%s\n", total.name, total.node, total.count, formatMillis(total.ms))
+			}
+			w.Flush()
+			return nil
+		},
+	}
+}
+
+func jobCommands() *cobra.Command {
+	var failedOnly bool
+
+	cmd := &cobra.Command{
+		Use:   "commands <id>",
+		Short: "List the remote commands a job ran with their exit codes and output",
+		Long: `List every remote command a job ran, in the order they started, with the
+node, the exit code and the output, so that a failed step can be reproduced
+and debugged by hand. Secrets are redacted and long output is truncated to
+its end.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil || id < 1 {
+				return fmt.Errorf("invalid job ID %q", args[0])
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			job, err := sdsClient.GetJob(ctx, id)
+			if err != nil {
+				return fmt.Errorf("failed to get job: %w", err)
+			}
+
+			fmt.Printf("Job %d: %s %s (%s)\n", job.Id, job.Operation, job.Target, job.State)
+
+			shown := 0
+			for i, step := range job.Steps {
+				if failedOnly && step.Success {
+					continue
+				}
+				shown++
+				fmt.Printf("\n#%d +%s %s on %s, exit %d, %s\n",
+					i+1,
+					formatMillis(step.StartedAt-job.StartedAt),
+					step.Name,
+					step.Node,
+					step.ExitCode,
+					formatMillis(step.DurationMs))
+				fmt.Printf("  $ %s\n", step.Command)
+				for _, line := range strings.Split(strings.TrimRight(step.Output, "\n"), "\n") {
+					if line != "" {
+						fmt.Printf("    %s\n", line)
+					}
+				}
+			}
+
+			if shown == 0 {
+				if failedOnly {
+					fmt.Println("\nNo remote command failed")
+				} else {
+					fmt.Println("\nNo remote commands were run")
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&failedOnly, "failed", false, "Only show the commands that failed")
+
+	return cmd
+}
+
+func jobResume() *cobra.Command {
+	return &cobra.Command{
+		Use:   "resume <id>",
+		Short: "Run the operation of an interrupted job again",
+		Long: `Run the journaled request of an interrupted job again, as a new job.
+Operations check the state they find; if the new job fails on what the
+interrupted one left behind, roll the interrupted job back instead.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return resolveJob(args[0], "resume", "resumed", func(sdsClient *client.SDSClient, ctx context.Context, id int64) (int64, error) {
+				return sdsClient.ResumeJob(ctx, id)
+			})
+		},
+	}
+}
+
+func jobRollback() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rollback <id>",
+		Short: "Undo what an interrupted job left behind",
+		Long: `Undo an interrupted job by running the operation that removes what it
+created, e.g. DeleteResource for CreateResource, as a new job. Jobs that did
+not create an object cannot be rolled back automatically.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return resolveJob(args[0], "roll back", "rolled back", func(sdsClient *client.SDSClient, ctx context.Context, id int64) (int64, error) {
+				return sdsClient.RollbackJob(ctx, id)
+			})
+		},
+	}
+}
+
+// resolveJob resumes or rolls back the interrupted job with the given ID
+func resolveJob(arg, action, done string, run func(*client.SDSClient, context.Context, int64) (int64, error)) error {
+	id, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || id < 1 {
+		return fmt.Errorf("invalid job ID %q", arg)
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	sdsClient, err := client.NewSDSClient(controllerAddr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to controller: %w", err)
+	}
+	defer sdsClient.Close()
+
+	newID, err := run(sdsClient, ctx, id)
+	if err != nil {
+		if newID > 0 {
+			return fmt.Errorf("failed to %s job %d: %w (see 'sds job describe %d')", action, id, err, newID)
+		}
+		return fmt.Errorf("failed to %s job %d: %w", action, id, err)
+	}
+
+	fmt.Printf("✓ Job %d %s by job %d\n", id, done, newID)
+	return nil
+}
+
+// stepTotal is the time a job spent in one step on one node
+type stepTotal struct {
+	name  string
+	node  string
+	count int
+	ms    int64
+}
+
+// stepTotals sums up the steps of a job per step name and node, slowest first
+func stepTotals(steps []*v1.JobStep) []*stepTotal {
+	byKey := make(map[string]*stepTotal)
+	var totals []*stepTotal
+	for _, step := range steps {
+		key := step.Name + "\x00" + step.Node
+		t, ok := byKey[key]
+		if !ok {
+			t = &stepTotal{name: step.Name, node: step.Node}
+			byKey[key] = t
+			totals = append(totals, t)
+		}
+		t.count++
+		t.ms += step.DurationMs
+	}
+	sort.SliceStable(totals, func(i, j int) bool { return totals[i].ms > totals[j].ms })
+	return totals
+}
+
+// jobDuration returns how long a job ran, or has been running
+func jobDuration(job *v1.JobInfo) string {
+	end := job.FinishedAt
+	if end == 0 {
+		end = time.Now().UnixMilli()
+	}
+	return formatMillis(end - job.StartedAt)
+}
+
+// formatMillis formats a duration in milliseconds, e.g. 1.2s or 350ms
+func formatMillis(ms int64) string {
+	d := time.Duration(ms) * time.Millisecond
+	if d < time.Second {
+		return d.String()
+	}
+	return d.Round(100 * time.Millisecond).String()
+}
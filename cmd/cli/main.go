@@ -1,33 +1,76 @@
 package main
 
 import (
-	"fmt"
+	"context"
 	"os"
+	"os/signal"
+	"syscall"
 
+	"github.com/liliang-cn/sds/pkg/client"
 	"github.com/spf13/cobra"
 )
 
 var (
 	controllerAddr string
+	clusterName    string
+	apiKey         string
 )
 
 func main() {
 	rootCmd := &cobra.Command{
 		Use:   "sds",
 		Short: "HA-SDS CLI - Software Defined Storage Management",
+		// Errors are printed with hints by printError
+		SilenceErrors: true,
 	}
 
 	rootCmd.PersistentFlags().StringVarP(&controllerAddr, "controller", "c", "127.0.0.1:3374", "Controller address")
+	rootCmd.PersistentFlags().StringVar(&clusterName, "cluster", os.Getenv("SDS_CLUSTER"), "Cluster of a controller serving several clusters (default: $SDS_CLUSTER or the controller's default)")
+	rootCmd.PersistentFlags().StringVar(&apiKey, "api-key", "", "API key of a principal of the controller's auth.keys (default: $SDS_API_KEY)")
+	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Show the remote commands that failed on the nodes")
+	rootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		client.SetCluster(clusterName)
+		if apiKey == "" {
+			apiKey = os.Getenv("SDS_API_KEY")
+		}
+		client.SetAPIKey(apiKey)
+	}
 
 	rootCmd.AddCommand(poolCommand())
 	rootCmd.AddCommand(nodeCommand())
 	rootCmd.AddCommand(resourceCommand())
+	rootCmd.AddCommand(volumeCommand())
+	rootCmd.AddCommand(snapshotCommand())
 	rootCmd.AddCommand(haCommand())
+	rootCmd.AddCommand(vipCommand())
 	rootCmd.AddCommand(gatewayCommand())
+	rootCmd.AddCommand(clientCommand())
 	rootCmd.AddCommand(healthCommand())
+	rootCmd.AddCommand(drCommand())
+	rootCmd.AddCommand(eventsCommand())
+	rootCmd.AddCommand(reportCommand())
+	rootCmd.AddCommand(placementCommand())
+	rootCmd.AddCommand(adminCommand())
+	rootCmd.AddCommand(driftCommand())
+	rootCmd.AddCommand(repairCommand())
+	rootCmd.AddCommand(rebalanceCommand())
+	rootCmd.AddCommand(drbdGlobalCommand())
+	rootCmd.AddCommand(jobCommand())
+	rootCmd.AddCommand(netCommand())
+	rootCmd.AddCommand(clusterCommand())
+	rootCmd.AddCommand(fencingCommand())
+	rootCmd.AddCommand(debugCommand())
+	rootCmd.AddCommand(versionCommand())
 
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		printError(os.Stderr, err)
 		os.Exit(1)
 	}
 }
+
+// commandContext returns the context of a controller call. It has no
+// deadline: the controller enforces a timeout budget per orchestration step.
+// Ctrl+C cancels the call, which also stops its remote commands.
+func commandContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	v1 "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func netCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "net",
+		Short: "Check the replication network between nodes",
+	}
+
+	cmd.AddCommand(netProbe())
+	cmd.AddCommand(netList())
+
+	return cmd
+}
+
+func netProbe() *cobra.Command {
+	var nodes []string
+
+	cmd := &cobra.Command{
+		Use:   "probe",
+		Short: "Measure latency and throughput between nodes",
+		Long: `Measure the round trip time and the throughput of every link between the
+nodes, over the addresses DRBD replicates on. Links are probed one after the
+other with iperf3 where both nodes have it installed, with a plain TCP
+transfer otherwise; the port and length are set in [netprobe].
+
+Links are checked against the resources they carry: protocol C links with a
+round trip above netprobe.max_sync_latency, and links slower than the
+resync-rate or c-max-rate of a resource, are reported. The results are kept
+and shown again by 'sds net list'.
+
+Example:
+  sds net probe
+  sds net probe --nodes node1,node2`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			probes, err := sdsClient.ProbeNetwork(ctx, nodes)
+			if err != nil {
+				return fmt.Errorf("failed to probe network: %w", err)
+			}
+
+			printNetProbes(probes)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&nodes, "nodes", nil, "Nodes to probe (default: all)")
+
+	return cmd
+}
+
+func netList() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Show the latest measurement of every link",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			probes, err := sdsClient.ListNetProbes(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list net probes: %w", err)
+			}
+
+			if len(probes) == 0 {
+				fmt.Println("No links probed yet, run 'sds net probe'")
+				return nil
+			}
+
+			printNetProbes(probes)
+			return nil
+		},
+	}
+}
+
+// printNetProbes prints link measurements followed by their warnings and errors
+func printNetProbes(probes []*v1.NetProbe) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "LINK\tMETHOD\tRTT\tTHROUGHPUT\tREQUIRED\tPROTOCOL\tSTATUS\tPROBED")
+	for _, p := range probes {
+		status := "ok"
+		switch {
+		case p.Error != "":
+			status = "error"
+		case len(p.Warnings) > 0:
+			status = "below requirements"
+		}
+		protocol := p.Protocol
+		if protocol == "" {
+			protocol = "-"
+		}
+		fmt.Fprintf(w, "%s -> %s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			p.Source, p.Target,
+			p.Method,
+			formatMeasurement(p.LatencyMs, "%.2f ms"),
+			formatMeasurement(p.ThroughputMbps, "%.0f Mbit/s"),
+			formatMeasurement(p.RequiredMbps, "%.0f Mbit/s"),
+			protocol,
+			status,
+			time.Unix(p.ProbedAt, 0).Format("2006-01-02 15:04:05"))
+	}
+	w.Flush()
+
+	for _, p := range probes {
+		for _, warning := range p.Warnings {
+			fmt.Printf("[WARN] %s -> %s: %s\n", p.Source, p.Target, warning)
+		}
+		if p.Error != "" {
+			fmt.Printf("[ERROR] %s -> %s: %s\n", p.Source, p.Target, p.Error)
+		}
+	}
+}
+
+// formatMeasurement formats a measured value, "-" if it was not measured
+func formatMeasurement(value float64, format string) string {
+	if value <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf(format, value)
+}
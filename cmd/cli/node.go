@@ -1,10 +1,13 @@
 package main
 
 import (
+	"bufio"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	v1 "github.com/liliang-cn/sds/api/proto/v1"
 	"github.com/liliang-cn/sds/pkg/client"
@@ -21,6 +24,15 @@ func nodeCommand() *cobra.Command {
 	cmd.AddCommand(nodeGet())
 	cmd.AddCommand(nodeRegister())
 	cmd.AddCommand(nodeUnregister())
+	cmd.AddCommand(nodeSetAddress())
+	cmd.AddCommand(nodeTrust())
+	cmd.AddCommand(nodeHarden())
+	cmd.AddCommand(nodeExec())
+	cmd.AddCommand(nodePushFile())
+	cmd.AddCommand(nodeLogs())
+	cmd.AddCommand(nodeMaintenance())
+	cmd.AddCommand(nodeCheck())
+	cmd.AddCommand(nodeDisks())
 
 	return cmd
 }
@@ -52,7 +64,7 @@ func nodeList() *cobra.Command {
 
 			// Print nodes in table format
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
-			fmt.Fprintln(w, "NAME\tADDRESS\tSTATE\tVERSION")
+			fmt.Fprintln(w, "NAME\tADDRESS\tSTATE\tVERSION\tPOOLS FREE\tDRBD\tPRIMARY\tLOAD")
 
 			for _, node := range nodes {
 				// Strip port from address for display
@@ -60,11 +72,27 @@ func nodeList() *cobra.Command {
 				if idx := strings.LastIndex(node.Address, ":"); idx != -1 {
 					displayAddr = node.Address[:idx]
 				}
-				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+				free, drbd, primary, load := "-", "-", "-", "-"
+				if c := node.Capacity; c != nil {
+					var total, available uint64
+					for _, p := range c.Pools {
+						total += p.TotalBytes
+						available += p.FreeBytes
+					}
+					free = fmt.Sprintf("%s / %s", formatBytes(available), formatBytes(total))
+					drbd = fmt.Sprintf("%d", c.DrbdDevices)
+					primary = fmt.Sprintf("%d", c.Primaries)
+					load = fmt.Sprintf("%.2f", c.Load1)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
 					node.Name,
 					displayAddr,
 					node.State,
-					node.Version)
+					node.Version,
+					free,
+					drbd,
+					primary,
+					load)
 			}
 
 			w.Flush()
@@ -117,8 +145,29 @@ func nodeGet() *cobra.Command {
 			fmt.Printf("Hostname:  %s\n", foundNode.Hostname)
 			fmt.Printf("State:     %s\n", foundNode.State)
 			fmt.Printf("Version:   %s\n", foundNode.Version)
+			if foundNode.DrbdKernelVersion != "" || foundNode.DrbdUtilsVersion != "" {
+				fmt.Printf("DRBD:      kernel %s, utils %s\n",
+					versionOrUnknown(foundNode.DrbdKernelVersion), versionOrUnknown(foundNode.DrbdUtilsVersion))
+			}
 			fmt.Printf("Last Seen: %d\n", foundNode.LastSeen)
 
+			c := foundNode.Capacity
+			if c == nil {
+				fmt.Println("\nCapacity:  not collected yet")
+				return nil
+			}
+
+			fmt.Printf("\nCapacity (collected %s):\n", time.Unix(c.CollectedAt, 0).Format("2006-01-02 15:04:05"))
+			fmt.Printf("  DRBD devices:  %d (%d Primary)\n", c.DrbdDevices, c.Primaries)
+			fmt.Printf("  Load:          %.2f %.2f %.2f (%d CPUs)\n", c.Load1, c.Load5, c.Load15, c.Cpus)
+			fmt.Printf("  Memory:        %s available of %s\n", formatBytes(c.MemAvailableBytes), formatBytes(c.MemTotalBytes))
+			if len(c.Pools) > 0 {
+				fmt.Printf("  Pools:\n")
+				for _, p := range c.Pools {
+					fmt.Printf("    %-20s %-4s %s free of %s\n", p.Name, p.Type, formatBytes(p.FreeBytes), formatBytes(p.TotalBytes))
+				}
+			}
+
 			return nil
 		},
 	}
@@ -133,6 +182,13 @@ func nodeRegister() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "register --name <name> --address <ip>",
 		Short: "Register a storage node",
+		Long: `Register a storage node.
+
+The name must equal uname -n on the node: DRBD picks its "on" section of a
+resource by that name. Registration fails with a fix suggestion otherwise.
+
+Pin the SSH host key of the node first with 'sds node trust <ip>'; with
+ssh.strict_host_key the controller does not connect to unknown nodes.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if name == "" {
 				return fmt.Errorf("--name is required")
@@ -164,7 +220,7 @@ func nodeRegister() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVar(&name, "name", "", "Node name (e.g., orange1)")
+	cmd.Flags().StringVar(&name, "name", "", "Node name, must match uname -n on the node (e.g., orange1)")
 	cmd.Flags().StringVar(&address, "address", "", "Node IP address (e.g., 192.168.1.10)")
 
 	cmd.MarkFlagRequired("name")
@@ -211,3 +267,735 @@ This removes the node from the database but does not affect the node itself.`,
 
 	return cmd
 }
+
+func nodeSetAddress() *cobra.Command {
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "set-address <node> <new-ip>",
+		Short: "Move a node to a new IP address",
+		Long: `Move a registered node to a new IP address, e.g. after its NIC was
+renumbered.
+
+Change the IP on the node first; it must answer SSH on the new address. The
+controller then updates its records and /etc/hosts on the other nodes,
+regenerates the DRBD config of every resource on the node and writes it to
+all of their nodes, rewrites HA promoter configs, runs drbdadm adjust, and
+waits until the connections of the node are back.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.SetNodeAddress(ctx, args[0], args[1], timeout)
+			if resp != nil {
+				for _, action := range resp.Actions {
+					fmt.Printf("  %s\n", action)
+				}
+				for _, conn := range resp.Unrecovered {
+					fmt.Printf("  not connected: %s\n", conn)
+				}
+			}
+			if err != nil {
+				return fmt.Errorf("failed to set node address: %w", err)
+			}
+
+			fmt.Printf("✓ Node %s moved from %s to %s\n", args[0], resp.PreviousAddress, args[1])
+			return nil
+		},
+	}
+
+	cmd.Flags().DurationVar(&timeout, "timeout", 2*time.Minute, "How long to wait for the DRBD connections to recover")
+
+	return cmd
+}
+
+func nodeDisks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disks <node>",
+		Short: "List the disks of a node for creating pools",
+		Long: `List the disks of a node and whether a pool can be created on them. Disks
+with partitions, a filesystem, RAID, LVM or ZFS signature, a mount or a
+holder, and read-only disks are listed with the reason they are not
+available. Nothing is changed on the node.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			disks, err := sdsClient.DiscoverDisks(ctx, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to discover disks: %w", err)
+			}
+			if len(disks) == 0 {
+				fmt.Printf("No disks found on %s\n", args[0])
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "DISK\tSIZE\tTYPE\tMODEL\tAVAILABLE")
+			for _, disk := range disks {
+				kind := "ssd"
+				if disk.Rotational {
+					kind = "hdd"
+				}
+				available := "yes"
+				if !disk.Available {
+					available = "no, " + disk.Reason
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", disk.Path, formatBytes(disk.SizeBytes), kind, disk.Model, available)
+			}
+			return w.Flush()
+		},
+	}
+
+	return cmd
+}
+
+func nodeTrust() *cobra.Command {
+	var fingerprint string
+	var replace bool
+
+	cmd := &cobra.Command{
+		Use:   "trust <node>",
+		Short: "Pin the SSH host key of a node",
+		Long: `Fetch the SSH host key of a node and pin it in the controller's known hosts
+store (ssh.known_hosts). With ssh.strict_host_key the controller refuses to
+connect to nodes whose key is not pinned, so trust a node before registering
+it; give its address if it is not registered yet.
+
+The fingerprint is shown for confirmation; compare it with the output of
+'ssh-keygen -lf /etc/ssh/ssh_host_ed25519_key.pub' on the node. Pass
+--fingerprint to confirm it without a prompt. A different key pinned before,
+e.g. after the node was reinstalled, is only replaced with --replace.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			key, err := sdsClient.TrustNode(ctx, args[0], "", false)
+			if err != nil {
+				return fmt.Errorf("failed to fetch host key: %w", err)
+			}
+			if key.Pinned {
+				fmt.Printf("✓ Host key %s %s of %s is already pinned\n", key.KeyType, key.Fingerprint, key.Address)
+				return nil
+			}
+
+			fmt.Printf("Host key of %s: %s %s\n", key.Address, key.KeyType, key.Fingerprint)
+			if key.PreviousFingerprint != "" {
+				fmt.Printf("WARNING: %s was pinned before, the key has CHANGED\n", key.PreviousFingerprint)
+				if !replace {
+					return fmt.Errorf("host key of %s changed, pass --replace if the node was reinstalled", key.Address)
+				}
+			}
+
+			if fingerprint == "" {
+				fmt.Print("Trust this host key? [y/N] ")
+				answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+				if answer = strings.ToLower(strings.TrimSpace(answer)); answer != "y" && answer != "yes" {
+					return fmt.Errorf("host key not confirmed")
+				}
+				fingerprint = key.Fingerprint
+			}
+
+			pinned, err := sdsClient.TrustNode(ctx, args[0], fingerprint, replace)
+			if err != nil {
+				return fmt.Errorf("failed to pin host key: %w", err)
+			}
+
+			fmt.Printf("✓ Host key %s of %s pinned\n", pinned.Fingerprint, pinned.Address)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&fingerprint, "fingerprint", "", "Expected SHA256 fingerprint, pins the key without a prompt")
+	cmd.Flags().BoolVar(&replace, "replace", false, "Replace a different host key pinned before")
+
+	return cmd
+}
+
+func nodeHarden() *cobra.Command {
+	var user string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "harden <node>",
+		Short: "Limit the sudo rights of the SSH user to the controller's commands",
+		Long: `Generate a sudoers policy that lets the SSH user of a node run only the
+binaries the controller needs as root (drbdadm, lvcreate, zfs, ...),
+resolved to their paths on the node, check it with visudo and install it as
+/etc/sudoers.d/sds. Tools that can write any file or start any unit (tee,
+sed, mv, rm, chmod, chown, cat, dd, truncate, systemctl) are only allowed
+through /usr/local/sbin/sds-file, a helper that limits them to the configs,
+units and volumes of the controller; journalctl may not start a pager.
+
+Run it while the user still has full sudo: the helper and the policy are
+outside the paths of the controller, so regenerating them later and pushing
+files elsewhere with sds node push-file need full sudo again. Snapshot hooks
+run arbitrary commands as root and are not allowed by the policy.
+
+Broader sudo rules of the user, e.g. "ALL=(ALL) NOPASSWD: ALL", are
+reported but not removed. Remove them once every node is hardened and set
+ssh.sudo = "restricted" in the controller config, which makes the controller
+run every privileged command through sudo -n. Use --dry-run to only print
+the policy.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.HardenNode(ctx, args[0], user, !dryRun)
+			if err != nil {
+				return fmt.Errorf("failed to harden node: %w", err)
+			}
+
+			if dryRun {
+				fmt.Print(resp.Policy)
+			} else {
+				fmt.Printf("✓ Sudoers policy for %s installed on %s\n", resp.User, args[0])
+			}
+			if len(resp.Missing) > 0 {
+				fmt.Printf("Not installed on the node, left out: %s\n", strings.Join(resp.Missing, ", "))
+			}
+			if resp.Unrestricted {
+				fmt.Printf("WARNING: %s still has unrestricted sudo through another rule, remove it to restrict the user\n", resp.User)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&user, "user", "", "SSH user the policy is for (default: the user the controller connects as)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only print the policy")
+
+	return cmd
+}
+
+func nodeExec() *cobra.Command {
+	var nodes []string
+	var timeout time.Duration
+	var adminToken string
+
+	cmd := &cobra.Command{
+		Use:   "exec --nodes <a,b|all> -- <command>",
+		Short: "Run a command on nodes through the controller (admin only)",
+		Long: `Run an ad-hoc command on registered nodes through the controller instead of
+an SSH loop. The run is recorded in the events log and as a job, and the
+output and exit code are reported per node.
+
+The controller must allow it with admin.exec = true, and the call must
+present the admin token (--admin-token or SDS_ADMIN_TOKEN). A single
+argument after -- is passed to the shell as is, several arguments are
+quoted.
+
+Example:
+  sds node exec --nodes node1,node2 -- drbdadm status
+  sds node exec --nodes all -- 'journalctl -u drbd-reactor -n 20 | grep -i error'`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(nodes) == 0 {
+				return fmt.Errorf("--nodes is required")
+			}
+			if adminToken == "" {
+				adminToken = os.Getenv("SDS_ADMIN_TOKEN")
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.NodeExec(client.WithAdminToken(ctx, adminToken), nodes, shellJoin(args), timeout)
+			if resp != nil {
+				for _, r := range resp.Results {
+					status := fmt.Sprintf("exit %d", r.ExitCode)
+					if r.Truncated {
+						status += ", output truncated"
+					}
+					fmt.Printf("=== %s (%s, %s) ===\n", r.Node, status, time.Duration(r.DurationMs)*time.Millisecond)
+					fmt.Print(r.Output)
+					if r.Output != "" && !strings.HasSuffix(r.Output, "\n") {
+						fmt.Println()
+					}
+				}
+			}
+			if err != nil {
+				return fmt.Errorf("node exec: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&nodes, "nodes", nil, "Node names or addresses, or all")
+	cmd.Flags().DurationVar(&timeout, "timeout", 0, "Command timeout (default: controller default)")
+	cmd.Flags().StringVar(&adminToken, "admin-token", "", "Admin token (default: $SDS_ADMIN_TOKEN)")
+
+	return cmd
+}
+
+func nodePushFile() *cobra.Command {
+	var nodes []string
+	var src, dest, mode, owner, adminToken string
+
+	cmd := &cobra.Command{
+		Use:   "push-file --nodes <a,b|all> --src <file> --dest <path>",
+		Short: "Write a file to nodes and verify its checksum (admin only)",
+		Long: `Write a local file to registered nodes, e.g. a systemd unit or an
+application config used by an HA service. The mode and owner are set on
+every node and the SHA-256 checksum of the written file is compared with the
+local one. The push is recorded in the events log and as a job.
+
+The call must present the admin token (--admin-token or SDS_ADMIN_TOKEN).
+
+Example:
+  sds node push-file --nodes all --src app.service --dest /etc/systemd/system/app.service
+  sds node push-file --nodes node1,node2 --src app.conf --dest /etc/app/app.conf --mode 0640 --owner root:app`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(nodes) == 0 {
+				return fmt.Errorf("--nodes is required")
+			}
+			if src == "" || dest == "" {
+				return fmt.Errorf("--src and --dest are required")
+			}
+			fileMode, err := strconv.ParseUint(mode, 8, 32)
+			if err != nil || fileMode > 07777 {
+				return fmt.Errorf("invalid --mode %q (must be octal, e.g. 0644)", mode)
+			}
+			content, err := os.ReadFile(src)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", src, err)
+			}
+			if adminToken == "" {
+				adminToken = os.Getenv("SDS_ADMIN_TOKEN")
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.PushFile(client.WithAdminToken(ctx, adminToken), nodes, content, dest, uint32(fileMode), owner)
+			if resp != nil && len(resp.Results) > 0 {
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+				fmt.Fprintln(w, "NODE\tRESULT\tCHECKSUM")
+				for _, r := range resp.Results {
+					result := "ok"
+					if !r.Success {
+						result = "failed: " + r.Error
+					}
+					fmt.Fprintf(w, "%s\t%s\t%s\n", r.Node, result, r.Checksum)
+				}
+				w.Flush()
+			}
+			if err != nil {
+				return fmt.Errorf("push-file: %w", err)
+			}
+
+			fmt.Printf("✓ %s written to %d node(s), sha256 %s\n", dest, len(resp.Results), resp.Checksum)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&nodes, "nodes", nil, "Node names or addresses, or all")
+	cmd.Flags().StringVar(&src, "src", "", "Local file to push")
+	cmd.Flags().StringVar(&dest, "dest", "", "Absolute path on the nodes")
+	cmd.Flags().StringVar(&mode, "mode", "0644", "File mode (octal)")
+	cmd.Flags().StringVar(&owner, "owner", "root", "Owner as user or user:group")
+	cmd.Flags().StringVar(&adminToken, "admin-token", "", "Admin token (default: $SDS_ADMIN_TOKEN)")
+
+	return cmd
+}
+
+func nodeLogs() *cobra.Command {
+	var unit, resource, since string
+	var lines int
+	var follow bool
+
+	cmd := &cobra.Command{
+		Use:   "logs <node>",
+		Short: "Show the journal of drbd-reactor, DRBD or a gateway on a node",
+		Long: `Show the journal of a node service through the controller, without an SSH
+session to the node. -u selects the source:
+
+  drbd-reactor  the drbd-reactor service (default)
+  drbd          DRBD kernel messages
+  gateway       the units drbd-reactor runs for the gateway of --resource
+
+With -f new lines are streamed until Ctrl+C.
+
+Example:
+  sds node logs node1 -u drbd-reactor -f
+  sds node logs node1 -u drbd --since "1 hour ago"
+  sds node logs node2 -u gateway --resource nfs-data -n 200`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if unit == "gateway" && resource == "" {
+				return fmt.Errorf("--resource is required for -u gateway")
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			err = sdsClient.StreamNodeLogs(ctx, &v1.StreamNodeLogsRequest{
+				Node:     args[0],
+				Unit:     unit,
+				Resource: resource,
+				Lines:    int32(lines),
+				Follow:   follow,
+				Since:    since,
+			}, func(line *v1.NodeLogLine) {
+				fmt.Println(line.Line)
+			})
+			if err != nil {
+				return fmt.Errorf("node logs: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&unit, "unit", "u", "drbd-reactor", "Log source: drbd-reactor, drbd or gateway")
+	cmd.Flags().StringVar(&resource, "resource", "", "Resource of the gateway, for -u gateway")
+	cmd.Flags().IntVarP(&lines, "lines", "n", 100, "Recent lines to show")
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "Stream new lines until interrupted")
+	cmd.Flags().StringVar(&since, "since", "", "Only lines since this time, e.g. \"1 hour ago\" or \"2024-01-02 10:00\"")
+
+	return cmd
+}
+
+// shellJoin turns command arguments into one shell command line. A single
+// argument is used as is, so pipes and redirections can be passed quoted.
+func shellJoin(args []string) string {
+	if len(args) == 1 {
+		return args[0]
+	}
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if arg != "" && strings.IndexFunc(arg, func(r rune) bool {
+			return !(r == '-' || r == '_' || r == '.' || r == '/' || r == '=' || r == ':' || r == ',' || r == '@' ||
+				r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+		}) < 0 {
+			quoted[i] = arg
+		} else {
+			quoted[i] = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+		}
+	}
+	return strings.Join(quoted, " ")
+}
+
+func nodeCheck() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Run on-demand checks of the nodes",
+	}
+
+	cmd.AddCommand(nodeCheckTime())
+
+	return cmd
+}
+
+func nodeCheckTime() *cobra.Command {
+	var nodes []string
+
+	cmd := &cobra.Command{
+		Use:   "time",
+		Short: "Compare the clocks of the nodes with the controller's",
+		Long: `Compare the clocks of the nodes with the controller's and check that chrony
+or systemd-timesyncd keeps them in sync. The skew shown is the least the
+clocks differ by: a node reads its clock at some point during the remote
+command, differences within the round trip are not counted.
+
+Nodes skewed by more than timesync.max_skew or without a synchronized NTP
+client are reported and the command fails. The controller also checks the
+clocks every timesync.interval and exports the skew for the alerting rules.
+
+Example:
+  sds node check time
+  sds node check time --nodes node1,node2`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.CheckTime(ctx, nodes)
+			if err != nil {
+				return fmt.Errorf("failed to check time: %w", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tSKEW\tROUND TRIP\tSOURCE\tSERVER\tSYNCHRONIZED\tSTATUS")
+			failed := 0
+			for _, t := range resp.Nodes {
+				status := "ok"
+				switch {
+				case t.Error != "":
+					status = "error"
+				case len(t.Warnings) > 0:
+					status = "warning"
+				}
+				if status != "ok" {
+					failed++
+				}
+				synchronized := "no"
+				if t.Synchronized {
+					synchronized = "yes"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%.0f ms\t%s\t%s\t%s\t%s\n",
+					t.Node, formatSkew(t.SkewMs), t.RoundTripMs,
+					valueOr(t.Source, "-"), valueOr(t.Server, "-"), synchronized, status)
+			}
+			w.Flush()
+
+			for _, t := range resp.Nodes {
+				for _, warning := range t.Warnings {
+					fmt.Printf("[WARN] %s: %s\n", t.Node, warning)
+				}
+				if t.Error != "" {
+					fmt.Printf("[ERROR] %s: %s\n", t.Node, t.Error)
+				}
+			}
+			fmt.Printf("\nSpread between the clocks: %.0f ms (max skew %.0f ms)\n", resp.SpreadMs, resp.MaxSkewMs)
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d node(s) have clock problems", failed, len(resp.Nodes))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&nodes, "nodes", nil, "Nodes to check (default: all)")
+
+	return cmd
+}
+
+// formatSkew formats a clock skew in milliseconds with its direction
+func formatSkew(ms float64) string {
+	switch {
+	case ms > 0:
+		return fmt.Sprintf("+%.0f ms", ms)
+	case ms < 0:
+		return fmt.Sprintf("%.0f ms", ms)
+	}
+	return "0 ms"
+}
+
+func nodeMaintenance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "maintenance",
+		Short: "Manage node maintenance windows",
+		Long: `A maintenance window announces planned work on a node. While it lasts the
+node is ranked last in the preferred nodes of HA resources, the rebalancer
+and 'sds ha failover' do not move Primaries to it, auto-heal leaves its DRBD
+connections alone, and the cluster report and the generated alerting rules
+leave out its offline state, drift and volume alerts.
+
+Windows expire by themselves at their end; clear them to end them early.`,
+	}
+
+	cmd.AddCommand(nodeMaintenanceSet())
+	cmd.AddCommand(nodeMaintenanceClear())
+	cmd.AddCommand(nodeMaintenanceList())
+
+	return cmd
+}
+
+func nodeMaintenanceSet() *cobra.Command {
+	var fromFlag, toFlag, reason string
+
+	cmd := &cobra.Command{
+		Use:   "set <node> --to <time>",
+		Short: "Set the maintenance window of a node",
+		Long: `Set the maintenance window of a node, replacing the one set before.
+
+Times are given as RFC 3339 ("2026-03-01T22:00:00+01:00"), as local time
+("2026-03-01 22:00"), or relative with a leading + ("+2h"): --from relative
+to now, --to relative to --from. --from defaults to now.`,
+		Example: `  sds node maintenance set node1 --to +2h --reason "kernel update"
+  sds node maintenance set node2 --from "2026-03-01 22:00" --to +4h`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			now := time.Now()
+			from, err := parseMaintenanceTime(fromFlag, now)
+			if err != nil {
+				return fmt.Errorf("invalid --from: %w", err)
+			}
+			base := from
+			if base.IsZero() {
+				base = now
+			}
+			to, err := parseMaintenanceTime(toFlag, base)
+			if err != nil {
+				return fmt.Errorf("invalid --to: %w", err)
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			window, err := sdsClient.SetNodeMaintenance(ctx, args[0], from, to, reason)
+			if err != nil {
+				return fmt.Errorf("failed to set maintenance window: %w", err)
+			}
+
+			fmt.Printf("✓ Node %s under maintenance from %s to %s\n", window.Node,
+				time.Unix(window.From, 0).Format(time.RFC3339), time.Unix(window.To, 0).Format(time.RFC3339))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&fromFlag, "from", "", "Start of the window (default: now)")
+	cmd.Flags().StringVar(&toFlag, "to", "", "End of the window, e.g. +2h")
+	cmd.Flags().StringVar(&reason, "reason", "", "Why the node is under maintenance")
+	cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
+func nodeMaintenanceClear() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear <node>",
+		Short: "End the maintenance window of a node",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			if err := sdsClient.ClearNodeMaintenance(ctx, args[0]); err != nil {
+				return fmt.Errorf("failed to clear maintenance window: %w", err)
+			}
+
+			fmt.Printf("✓ Maintenance window of %s cleared\n", args[0])
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func nodeMaintenanceList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the maintenance windows",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			windows, err := sdsClient.ListMaintenanceWindows(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list maintenance windows: %w", err)
+			}
+
+			if len(windows) == 0 {
+				fmt.Println("No maintenance windows")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tFROM\tTO\tSTATE\tREASON")
+			for _, window := range windows {
+				state := "scheduled"
+				if window.Active {
+					state = "active"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+					window.Node,
+					time.Unix(window.From, 0).Format("2006-01-02 15:04"),
+					time.Unix(window.To, 0).Format("2006-01-02 15:04"),
+					state,
+					window.Reason)
+			}
+			w.Flush()
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// parseMaintenanceTime parses the time of a maintenance window: RFC 3339,
+// local time, or a duration with a leading + relative to base. An empty
+// value is the zero time.
+func parseMaintenanceTime(value string, base time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if offset, ok := strings.CutPrefix(value, "+"); ok {
+		d, err := time.ParseDuration(offset)
+		if err != nil || d <= 0 {
+			return time.Time{}, fmt.Errorf("invalid duration %q", value)
+		}
+		return base.Add(d), nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04", "2006-01-02T15:04", "2006-01-02 15:04:05"} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is neither RFC 3339, local time (2006-01-02 15:04) nor +duration", value)
+}
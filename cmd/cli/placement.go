@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func placementCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "placement",
+		Short: "Manage co-location and anti-affinity rules between resources",
+	}
+
+	cmd.AddCommand(placementAdd())
+	cmd.AddCommand(placementDelete())
+	cmd.AddCommand(placementList())
+
+	return cmd
+}
+
+func placementAdd() *cobra.Command {
+	var ruleType string
+
+	cmd := &cobra.Command{
+		Use:   "add <resource-a> <resource-b>",
+		Short: "Add a placement rule between two resources",
+		Long: `Add a placement rule between two resources.
+
+  colocate       both resources must be Primary on the same node
+  anti-affinity  the resources must never be Primary on the same node
+
+Rules are enforced when promoting, in the preferred-nodes of HA promoter
+configs and when evicting HA resources.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			if err := sdsClient.AddPlacementRule(ctx, args[0], args[1], ruleType); err != nil {
+				return fmt.Errorf("failed to add placement rule: %w", err)
+			}
+
+			fmt.Printf("Placement rule added: %s %s %s\n", args[0], ruleType, args[1])
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&ruleType, "type", "colocate", "Rule type (colocate, anti-affinity)")
+
+	return cmd
+}
+
+func placementDelete() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <resource-a> <resource-b>",
+		Short: "Delete the placement rule between two resources",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			if err := sdsClient.DeletePlacementRule(ctx, args[0], args[1]); err != nil {
+				return fmt.Errorf("failed to delete placement rule: %w", err)
+			}
+
+			fmt.Printf("Placement rule between %s and %s deleted\n", args[0], args[1])
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func placementList() *cobra.Command {
+	var resource string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List placement rules",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			rules, err := sdsClient.ListPlacementRules(ctx, resource)
+			if err != nil {
+				return fmt.Errorf("failed to list placement rules: %w", err)
+			}
+
+			if len(rules) == 0 {
+				fmt.Println("No placement rules defined")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "RESOURCE A\tRESOURCE B\tTYPE\tCREATED")
+			for _, rule := range rules {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+					rule.ResourceA,
+					rule.ResourceB,
+					rule.Type,
+					time.Unix(rule.CreatedAt, 0).Format("2006-01-02 15:04:05"))
+			}
+			w.Flush()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&resource, "resource", "", "Only show rules involving this resource")
+
+	return cmd
+}
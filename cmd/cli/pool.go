@@ -1,10 +1,11 @@
 package main
 
 import (
-	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -23,6 +24,7 @@ func poolCommand() *cobra.Command {
 	cmd.AddCommand(poolGet())
 	cmd.AddCommand(poolList())
 	cmd.AddCommand(poolAddDisk())
+	cmd.AddCommand(poolHistory())
 
 	return cmd
 }
@@ -70,7 +72,7 @@ func poolCreate() *cobra.Command {
 				nodeList[i] = strings.TrimSpace(nodeList[i])
 			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -155,7 +157,7 @@ func poolDelete() *cobra.Command {
 				return fmt.Errorf("node is required")
 			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -198,7 +200,7 @@ func poolGet() *cobra.Command {
 				return fmt.Errorf("node is required")
 			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -233,7 +235,7 @@ func poolList() *cobra.Command {
 		Use:   "list",
 		Short: "List all pools",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -291,7 +293,7 @@ func poolAddDisk() *cobra.Command {
 				nodeList[i] = strings.TrimSpace(nodeList[i])
 			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -335,3 +337,84 @@ func poolAddDisk() *cobra.Command {
 
 	return cmd
 }
+
+func poolHistory() *cobra.Command {
+	var node string
+	var since string
+
+	cmd := &cobra.Command{
+		Use:   "history <pool>",
+		Short: "Show the capacity history of a pool",
+		Long: `Show the total, free and allocated capacity of a pool over time, as sampled
+by the controller every pool_history.interval. Allocated is the provisioned
+size of the volumes in the pool; on thin pools it may exceed the total.`,
+		Example: `  sds pool history sds_data --since 7d
+  sds pool history sds_data --node node1 --since 12h`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			window, err := parseSince(since)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			samples, err := sdsClient.GetPoolHistory(ctx, args[0], node, time.Now().Add(-window))
+			if err != nil {
+				return fmt.Errorf("failed to get pool history: %w", err)
+			}
+
+			if len(samples) == 0 {
+				fmt.Printf("No samples of pool %s in the last %s\n", args[0], since)
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "TIME\tNODE\tTOTAL\tFREE\tALLOCATED\tUSED")
+			for _, s := range samples {
+				used := "-"
+				if s.TotalBytes > 0 {
+					used = fmt.Sprintf("%.1f%%", float64(s.TotalBytes-s.FreeBytes)/float64(s.TotalBytes)*100)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+					time.Unix(s.At, 0).Format("2006-01-02 15:04"),
+					s.Node,
+					util.FormatBytes(s.TotalBytes),
+					util.FormatBytes(s.FreeBytes),
+					util.FormatBytes(s.AllocatedBytes),
+					used)
+			}
+			w.Flush()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&node, "node", "", "Only show the pool on this node")
+	cmd.Flags().StringVar(&since, "since", "7d", "Time window, e.g. 7d, 12h or 30m")
+
+	return cmd
+}
+
+// parseSince parses a time window given in days ("7d") or as a Go duration
+func parseSince(since string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(since, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid --since %q", since)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(since)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid --since %q, use e.g. 7d or 12h", since)
+	}
+	return d, nil
+}
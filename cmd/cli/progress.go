@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/client"
+)
+
+// spinnerFrames are drawn in turn next to the running step
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// watchProgress shows the progress of the job started by a call made with
+// the returned context on stderr. Call stop once the call returned, before
+// printing its result.
+func watchProgress(ctx context.Context, sdsClient *client.SDSClient) (context.Context, func()) {
+	p := newProgressPrinter(os.Stderr)
+	ctx, stopWatch := sdsClient.WatchProgress(ctx, p.event)
+	return ctx, func() {
+		stopWatch()
+		p.close()
+	}
+}
+
+// progressPrinter shows the steps of a job with the remote commands they ran
+// on each node: on a terminal with a spinner on the running step, otherwise
+// as plain lines
+type progressPrinter struct {
+	mu      sync.Mutex
+	out     *os.File
+	tty     bool
+	step    string // Label of the running step
+	started time.Time
+	frame   int
+	stop    chan struct{}
+	stopped chan struct{}
+}
+
+func newProgressPrinter(out *os.File) *progressPrinter {
+	p := &progressPrinter{out: out, stop: make(chan struct{}), stopped: make(chan struct{})}
+	if info, err := out.Stat(); err == nil && info.Mode()&os.ModeCharDevice != 0 {
+		p.tty = true
+	}
+	if !p.tty {
+		close(p.stopped)
+		return p
+	}
+
+	go func() {
+		defer close(p.stopped)
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.stop:
+				return
+			case <-ticker.C:
+			}
+			p.mu.Lock()
+			p.frame++
+			p.drawSpinner()
+			p.mu.Unlock()
+		}
+	}()
+	return p
+}
+
+// event shows an event of the job
+func (p *progressPrinter) event(event *v1.JobProgress) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	switch event.Kind {
+	case "step":
+		p.finishStep("✓")
+		p.step = event.Label
+		p.started = time.Now()
+		if !p.tty {
+			fmt.Fprintf(p.out, "==> %s\n", p.step)
+		}
+		p.drawSpinner()
+	case "command":
+		mark := "✓"
+		if !event.Success {
+			mark = "✗"
+		}
+		p.clearLine()
+		line := fmt.Sprintf("    %s %-12s %s (%s)", mark, event.Node, event.Step, formatMillis(event.DurationMs))
+		if !event.Success && event.Message != "" {
+			first, _, _ := strings.Cut(strings.TrimSpace(event.Message), "\n")
+			line += ": " + first
+		}
+		fmt.Fprintln(p.out, line)
+		p.drawSpinner()
+	case "done":
+		mark := "✓"
+		if event.State != "succeeded" {
+			mark = "✗"
+		}
+		p.finishStep(mark)
+	}
+}
+
+// close stops the spinner and leaves the step that was running, if the
+// job did not report its end
+func (p *progressPrinter) close() {
+	close(p.stop)
+	<-p.stopped
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.finishStep("·")
+}
+
+// finishStep replaces the spinner of the running step with a mark
+func (p *progressPrinter) finishStep(mark string) {
+	if p.step == "" {
+		return
+	}
+	if p.tty {
+		p.clearLine()
+		fmt.Fprintf(p.out, "%s %s (%s)\n", mark, p.step, formatMillis(time.Since(p.started).Milliseconds()))
+	}
+	p.step = ""
+}
+
+// drawSpinner redraws the line of the running step on a terminal
+func (p *progressPrinter) drawSpinner() {
+	if !p.tty || p.step == "" {
+		return
+	}
+	fmt.Fprintf(p.out, "\r\033[K%s %s (%s)", spinnerFrames[p.frame%len(spinnerFrames)], p.step, formatMillis(time.Since(p.started).Milliseconds()))
+}
+
+// clearLine removes the spinner line on a terminal
+func (p *progressPrinter) clearLine() {
+	if p.tty && p.step != "" {
+		fmt.Fprint(p.out, "\r\033[K")
+	}
+}
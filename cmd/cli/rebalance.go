@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/liliang-cn/sds/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func rebalanceCommand() *cobra.Command {
+	var dryRun bool
+	var maxMoves int
+
+	cmd := &cobra.Command{
+		Use:   "rebalance",
+		Short: "Even out the Primaries across nodes",
+		Long: `Move Primary roles from the busiest to the idlest nodes until the number of
+Primaries per node differs by less than the configured threshold.
+
+A resource is only moved to an online node that replicates it and is
+UpToDate, when its placement rules allow it. HA resources with start
+dependencies are left where they are. Each move is a planned switchover
+that also moves HA services, VIPs and gateways.
+
+Example:
+  sds rebalance --dry-run
+  sds rebalance --max-moves 3`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.Rebalance(ctx, dryRun, maxMoves)
+			if resp != nil {
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+				fmt.Fprintln(w, "NODE\tPRIMARIES")
+				for _, n := range resp.Nodes {
+					fmt.Fprintf(w, "%s\t%d\n", n.Node, n.Primaries)
+				}
+				w.Flush()
+				fmt.Println()
+
+				if len(resp.Moves) == 0 {
+					fmt.Println("Primaries are balanced, nothing to move")
+				} else {
+					w = tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+					fmt.Fprintln(w, "RESOURCE\tFROM\tTO\tSTATUS")
+					for _, m := range resp.Moves {
+						status := "planned"
+						switch {
+						case m.Done:
+							status = "moved"
+						case m.Error != "":
+							status = "failed: " + m.Error
+						}
+						fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", m.Resource, m.FromNode, m.ToNode, status)
+					}
+					w.Flush()
+				}
+
+				if len(resp.Skipped) > 0 {
+					fmt.Println("\nSkipped:")
+					for _, s := range resp.Skipped {
+						fmt.Printf("  - %s\n", s)
+					}
+				}
+			}
+			if err != nil {
+				return fmt.Errorf("failed to rebalance: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show the planned moves")
+	cmd.Flags().IntVar(&maxMoves, "max-moves", 0, "Maximum number of moves (default: controller setting)")
+
+	return cmd
+}
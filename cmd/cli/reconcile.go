@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func driftCommand() *cobra.Command {
+	var refresh bool
+
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Show discrepancies between the database and the nodes",
+		Long: `Show discrepancies between the controller database and the state found on
+the nodes: missing DRBD configs, resources that are not up, missing HA
+promoter plugins, mount units and gateway plugins.
+
+Degraded objects are configured but not running, drifted objects miss
+configuration on a node. Converge them with 'sds repair'.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			report, err := sdsClient.GetDriftReport(ctx, refresh)
+			if err != nil {
+				return fmt.Errorf("failed to get drift report: %w", err)
+			}
+
+			checkedAt := time.Unix(report.CheckedAt, 0)
+			fmt.Printf("Checked: %s (%s ago)\n\n", checkedAt.Format("2006-01-02 15:04:05"), time.Since(checkedAt).Round(time.Second))
+
+			if len(report.Drifts) == 0 {
+				fmt.Println("No drift, the nodes match the database")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "KIND\tNAME\tNODE\tSTATE\tPROBLEM\tSINCE")
+			for _, d := range report.Drifts {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+					d.Kind, d.Name, d.Node, d.State, d.Problem,
+					time.Unix(d.DetectedAt, 0).Format("2006-01-02 15:04:05"))
+			}
+			w.Flush()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&refresh, "refresh", false, "Check the nodes now instead of showing the last background pass")
+
+	return cmd
+}
+
+func repairCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "repair <resource|ha|gateway|drbd-global> <name>",
+		Short: "Converge an object back to its database record",
+		Long: `Converge an object back to its database record.
+
+  resource  restore missing DRBD configs from a node that still has them and
+            run drbdadm adjust on all nodes of the resource
+  ha        rewrite the promoter plugin and mount unit from the HA config
+  gateway   restore a missing gateway plugin from a node that still has it
+  drbd-global
+            rewrite the latest global_common.conf on all nodes (name is
+            global_common)
+
+Example:
+  sds repair resource r0`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			actions, err := sdsClient.Repair(ctx, args[0], args[1])
+			for _, action := range actions {
+				fmt.Printf("  - %s\n", action)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to repair %s %s: %w", args[0], args[1], err)
+			}
+
+			if len(actions) == 0 {
+				fmt.Printf("%s %s is already in sync\n", args[0], args[1])
+				return nil
+			}
+			fmt.Printf("Repaired %s %s\n", args[0], args[1])
+			return nil
+		},
+	}
+}
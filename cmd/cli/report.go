@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func reportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Generate cluster reports",
+		Long: `A cluster report summarizes a period: capacity changes of the pools, failovers,
+resyncs, snapshot success rates and the alerts still outstanding.
+
+The controller also generates reports on a schedule, see the [report] section
+of the controller config. alert-rules generates Prometheus alerting rules on
+the controller metrics for the resources and pools of the cluster.`,
+	}
+
+	cmd.AddCommand(reportWeekly())
+	cmd.AddCommand(reportAlertRules())
+
+	return cmd
+}
+
+func reportWeekly() *cobra.Command {
+	var format, output string
+	var days uint32
+
+	cmd := &cobra.Command{
+		Use:   "weekly",
+		Short: "Generate the report of the past week",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != "html" && format != "md" {
+				return fmt.Errorf("unknown format %q (html, md)", format)
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			content, err := sdsClient.GetClusterReport(ctx, days, format)
+			if err != nil {
+				return fmt.Errorf("failed to generate report: %w", err)
+			}
+
+			if output == "" {
+				fmt.Print(content)
+				return nil
+			}
+			if err := os.WriteFile(output, []byte(content), 0o644); err != nil {
+				return fmt.Errorf("failed to write report: %w", err)
+			}
+			fmt.Printf("Report written to %s\n", output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "html", "Report format (html, md)")
+	cmd.Flags().Uint32Var(&days, "days", 7, "Number of days the report covers")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Write the report to this file instead of stdout")
+
+	return cmd
+}
+
+func reportAlertRules() *cobra.Command {
+	var output string
+	var poolFull float64
+	var pending time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "alert-rules",
+		Short: "Generate Prometheus alerting rules for the resources and pools",
+		Long: `Generate a Prometheus rule file with alerts on the controller metrics for each
+resource and pool of the cluster: volumes out of sync or diskless, pools
+nearly full, HA resources whose drbd-reactor promoter is active on no node,
+and snapshot replication that went stale. Regenerate the file after adding
+resources, pools or replication policies.`,
+		Example: `  sds report alert-rules -o /etc/prometheus/rules/sds.yml`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			rules, err := sdsClient.GetAlertRules(ctx, poolFull, uint32(pending/time.Second))
+			if err != nil {
+				return fmt.Errorf("failed to generate alerting rules: %w", err)
+			}
+
+			if output == "" {
+				fmt.Print(rules)
+				return nil
+			}
+			if err := os.WriteFile(output, []byte(rules), 0o644); err != nil {
+				return fmt.Errorf("failed to write alerting rules: %w", err)
+			}
+			fmt.Printf("Alerting rules written to %s\n", output)
+			return nil
+		},
+	}
+
+	cmd.Flags().Float64Var(&poolFull, "pool-full", 90, "Pool usage in percent that alerts")
+	cmd.Flags().DurationVar(&pending, "for", 10*time.Minute, "How long a condition holds before it alerts")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Write the rules to this file instead of stdout")
+
+	return cmd
+}
@@ -1,11 +1,14 @@
 package main
 
 import (
-	"context"
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
+	v1 "github.com/liliang-cn/sds/api/proto/v1"
 	"github.com/liliang-cn/sds/pkg/client"
 	"github.com/liliang-cn/sds/pkg/util"
 	"github.com/spf13/cobra"
@@ -22,6 +25,19 @@ func formatSize(sizeGB uint64) string {
 	return fmt.Sprintf("%d GB", sizeGB)
 }
 
+// formatVolumeSize formats the size of a volume found on a node, with the
+// allocated space of thin volumes, falling back to the recorded size
+func formatVolumeSize(v *v1.VolumeInfo) string {
+	if v.SizeBytes == 0 {
+		return formatSize(v.SizeGb)
+	}
+	size := fmt.Sprintf("%.1f GB", float64(v.SizeBytes)/(1<<30))
+	if v.AllocatedBytes < v.SizeBytes {
+		size += fmt.Sprintf(" (%.1f GB allocated)", float64(v.AllocatedBytes)/(1<<30))
+	}
+	return size
+}
+
 func resourceCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "resource",
@@ -31,6 +47,11 @@ func resourceCommand() *cobra.Command {
 	cmd.AddCommand(resourceCreate())
 	cmd.AddCommand(resourceGet())
 	cmd.AddCommand(resourceDelete())
+	cmd.AddCommand(resourceStop())
+	cmd.AddCommand(resourceStart())
+	cmd.AddCommand(resourcePauseSync())
+	cmd.AddCommand(resourceResumeSync())
+	cmd.AddCommand(resourceSyncRate())
 	cmd.AddCommand(resourceList())
 	cmd.AddCommand(resourceAddVolume())
 	cmd.AddCommand(resourceRemoveVolume())
@@ -39,11 +60,21 @@ func resourceCommand() *cobra.Command {
 	cmd.AddCommand(resourceSecondary())
 	cmd.AddCommand(resourceFs())
 	cmd.AddCommand(resourceStatus())
+	cmd.AddCommand(resourceExport())
+	cmd.AddCommand(resourceDiff())
+	cmd.AddCommand(resourceNodeConfig())
 	cmd.AddCommand(resourceMount())
 	cmd.AddCommand(resourceUnmount())
 	cmd.AddCommand(resourcePromote())
 	cmd.AddCommand(resourceDemote())
 	cmd.AddCommand(resourceSnapshot())
+	cmd.AddCommand(resourceSetMaxPeers())
+	cmd.AddCommand(resourceMigratePool())
+	cmd.AddCommand(resourceConvertStorage())
+	cmd.AddCommand(resourceReplaceDisk())
+	cmd.AddCommand(resourceExecFenceTest())
+	cmd.AddCommand(resourceActivate())
+	cmd.AddCommand(resourceDeactivate())
 
 	return cmd
 }
@@ -57,10 +88,38 @@ func resourceCreate() *cobra.Command {
 	var protocol string
 	var size string
 	var drbdOptions map[string]string
+	var devices map[string]string
+	var peerProtocols map[string]string
+	var maxPeers uint32
 
 	cmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create a new DRBD resource",
+		Long: `Create a new DRBD resource.
+
+With --storage-type raw, DRBD runs directly on existing block devices given
+per node with --device (e.g. --device node1=/dev/sdb,node2=/dev/sdc). No LV or
+zvol is created, the devices must be unused and of equal size, and --size and
+--pool are ignored.
+
+With --storage-type file, sparse files on loop devices are created on each
+node. This is meant for labs and CI and must be enabled on the controller with
+storage.allow_file_backend.
+
+With --peer-protocol, the connections to a node use their own protocol, e.g.
+synchronous replication (C) between local nodes and asynchronous (A) to a
+remote site in the same resource:
+
+  sds resource create --name r0 --port 7000 --size 10G \
+    --nodes node1,node2,dr1 --protocol C --peer-protocol dr1=A
+
+A connection between two nodes with different peer protocols uses the less
+synchronous one.
+
+The DRBD metadata holds one bitmap per peer. By default it is sized for the
+given nodes; --max-peers reserves slots for nodes to be added later, e.g.
+--max-peers 4 for a 2-node resource that may grow to 5 nodes. Raising it later
+requires 'sds resource set-max-peers', which resyncs every node.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
@@ -70,7 +129,7 @@ func resourceCreate() *cobra.Command {
 			if port == 0 {
 				return fmt.Errorf("DRBD port is required (use --port)")
 			}
-			if size == "" {
+			if size == "" && storageType != "raw" {
 				return fmt.Errorf("size is required (use --size)")
 			}
 
@@ -93,6 +152,48 @@ func resourceCreate() *cobra.Command {
 				protocol = "C"
 			}
 
+			if storageType == "raw" {
+				if len(devices) == 0 {
+					return fmt.Errorf("raw storage requires a device per node (use --device node=/dev/...)")
+				}
+
+				sdsClient, err := client.NewSDSClient(controllerAddr)
+				if err != nil {
+					return fmt.Errorf("failed to connect to controller: %w", err)
+				}
+				defer sdsClient.Close()
+
+				progressCtx, stopProgress := watchProgress(ctx, sdsClient)
+				err = sdsClient.CreateResourceFromRequest(progressCtx, &v1.CreateResourceRequest{
+					Name:          name,
+					Port:          port,
+					Nodes:         nodeList,
+					Protocol:      protocol,
+					StorageType:   "raw",
+					DrbdOptions:   drbdOptions,
+					Devices:       devices,
+					PeerProtocols: peerProtocols,
+					MaxPeers:      maxPeers,
+				})
+				stopProgress()
+				if err != nil {
+					return fmt.Errorf("failed to create resource: %w", err)
+				}
+
+				fmt.Printf("Resource created successfully\n")
+				fmt.Printf("  Name:        %s\n", name)
+				fmt.Printf("  Port:        %d\n", port)
+				fmt.Printf("  Storage:     raw\n")
+				for _, node := range nodeList {
+					fmt.Printf("  Device:      %s on %s\n", devices[node], node)
+				}
+				fmt.Printf("  Protocol:    %s%s\n", protocol, formatPeerProtocols(peerProtocols))
+				if maxPeers > 0 {
+					fmt.Printf("  Max peers:   %d\n", maxPeers)
+				}
+				return nil
+			}
+
 			sizeBytes, err := util.ParseSize(size)
 			if err != nil {
 				return fmt.Errorf("invalid size format: %s: %w", size, err)
@@ -109,7 +210,20 @@ func resourceCreate() *cobra.Command {
 			defer sdsClient.Close()
 
 			// Use unified method for all storage types
-			err = sdsClient.CreateResourceWithPoolAndType(ctx, name, port, nodeList, protocol, uint32(sizeGiB), pool, storageType, drbdOptions)
+			progressCtx, stopProgress := watchProgress(ctx, sdsClient)
+			err = sdsClient.CreateResourceFromRequest(progressCtx, &v1.CreateResourceRequest{
+				Name:          name,
+				Port:          port,
+				Nodes:         nodeList,
+				Protocol:      protocol,
+				SizeGb:        uint32(sizeGiB),
+				Pool:          pool,
+				StorageType:   storageType,
+				DrbdOptions:   drbdOptions,
+				PeerProtocols: peerProtocols,
+				MaxPeers:      maxPeers,
+			})
+			stopProgress()
 			if err != nil {
 				return fmt.Errorf("failed to create resource: %w", err)
 			}
@@ -120,8 +234,11 @@ func resourceCreate() *cobra.Command {
 			fmt.Printf("  Storage:     %s\n", storageType)
 			fmt.Printf("  Pool:        %s\n", pool)
 			fmt.Printf("  Nodes:       %v\n", nodeList)
-			fmt.Printf("  Protocol:    %s\n", protocol)
+			fmt.Printf("  Protocol:    %s%s\n", protocol, formatPeerProtocols(peerProtocols))
 			fmt.Printf("  Size:        %d GiB (%s)\n", sizeGiB, util.FormatBytes(sizeBytes))
+			if maxPeers > 0 {
+				fmt.Printf("  Max peers:   %d\n", maxPeers)
+			}
 			if len(drbdOptions) > 0 {
 				fmt.Printf("  Options:     %v\n", drbdOptions)
 			}
@@ -137,10 +254,13 @@ func resourceCreate() *cobra.Command {
 	cmd.Flags().Uint32Var(&port, "port", 0, "DRBD port (required)")
 	cmd.Flags().StringVar(&nodes, "nodes", "", "Node names (comma-separated, required)")
 	cmd.Flags().StringVar(&pool, "pool", "", "Storage pool name (default: data-pool)")
-	cmd.Flags().StringVar(&storageType, "storage-type", "lvm", "Storage type: lvm or zfs")
+	cmd.Flags().StringVar(&storageType, "storage-type", "lvm", "Storage type: lvm, lvm-thin, zfs, zfs-thin, raw or file (labs only)")
 	cmd.Flags().StringVar(&protocol, "protocol", "C", "DRBD protocol (A, B, or C)")
 	cmd.Flags().StringVar(&size, "size", "", "Volume size (e.g., 1G, 10GB, 1TB, 1GiB, required)")
-	cmd.Flags().StringToStringVar(&drbdOptions, "drbd-options", nil, "DRBD options as key=value pairs (e.g., on-no-quorum=suspend-io)")
+	cmd.Flags().StringToStringVar(&drbdOptions, "drbd-options", nil, "DRBD options as key=value pairs (e.g., on-no-quorum=suspend-io, handlers/split-brain=/usr/local/bin/notify), an empty value drops a controller default (e.g., auto-promote=)")
+	cmd.Flags().StringToStringVar(&devices, "device", nil, "Raw block device per node for --storage-type raw (e.g., node1=/dev/sdb)")
+	cmd.Flags().StringToStringVar(&peerProtocols, "peer-protocol", nil, "Protocol of the connections to a node (e.g., dr1=A)")
+	cmd.Flags().Uint32Var(&maxPeers, "max-peers", 0, "Peer slots in the DRBD metadata, for nodes added later (default: nodes - 1)")
 
 	cmd.MarkFlagRequired("name")
 	cmd.MarkFlagRequired("port")
@@ -158,7 +278,7 @@ func resourceGet() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			name := args[0]
 
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -173,8 +293,20 @@ func resourceGet() *cobra.Command {
 			}
 
 			fmt.Printf("Resource: %s\n", resource.Name)
+			if resource.DesiredState == "stopped" {
+				fmt.Printf("  State:    stopped (start it with sds resource start %s)\n", resource.Name)
+			}
+			if resource.Health != "" {
+				fmt.Printf("  Health:   %s\n", resource.Health)
+				for _, reason := range resource.HealthReasons {
+					fmt.Printf("            %s\n", reason)
+				}
+			}
 			fmt.Printf("  Port:     %d\n", resource.Port)
-			fmt.Printf("  Protocol: %s\n", resource.Protocol)
+			fmt.Printf("  Protocol: %s%s\n", resource.Protocol, formatPeerProtocols(resource.PeerProtocols))
+			if resource.Owner != "" {
+				fmt.Printf("  Owner:    %s\n", resource.Owner)
+			}
 			fmt.Printf("  Nodes:\n")
 			for _, node := range resource.Nodes {
 				state := "Unknown"
@@ -187,10 +319,19 @@ func resourceGet() *cobra.Command {
 				}
 				fmt.Printf("    %s: %s%s\n", node, state, diskState)
 			}
+			if resource.MaxPeers > 0 {
+				fmt.Printf("  Max peers: %d\n", resource.MaxPeers)
+			}
+			if len(resource.MaxPeersPending) > 0 {
+				fmt.Printf("  Max peers pending on: %s\n", strings.Join(resource.MaxPeersPending, ", "))
+			}
 			if len(resource.Volumes) > 0 {
 				fmt.Printf("  Volumes:\n")
 				for _, vol := range resource.Volumes {
-					fmt.Printf("    Volume %d: %s (%s)\n", vol.VolumeId, vol.Device, formatSize(vol.SizeGb))
+					fmt.Printf("    Volume %d: %s (%s)\n", vol.VolumeId, vol.Device, formatVolumeSize(vol))
+					for _, b := range vol.Backing {
+						fmt.Printf("      %s: %s\n", b.Node, b.Device)
+					}
 				}
 			}
 
@@ -202,6 +343,8 @@ func resourceGet() *cobra.Command {
 }
 
 func resourceDelete() *cobra.Command {
+	var noSafetySnapshot bool
+
 	cmd := &cobra.Command{
 		Use:   "delete <name>",
 		Short: "Delete a resource",
@@ -209,7 +352,7 @@ func resourceDelete() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			name := args[0]
 
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -218,7 +361,7 @@ func resourceDelete() *cobra.Command {
 			}
 			defer sdsClient.Close()
 
-			err = sdsClient.DeleteResource(ctx, name)
+			err = sdsClient.DeleteResource(ctx, name, noSafetySnapshot)
 			if err != nil {
 				return fmt.Errorf("failed to delete resource: %w", err)
 			}
@@ -228,6 +371,217 @@ func resourceDelete() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().BoolVar(&noSafetySnapshot, "no-safety-snapshot", false, "Skip the automatic safety snapshot taken before the operation")
+
+	return cmd
+}
+
+func resourceStop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stop <name>",
+		Short: "Take a resource down everywhere and keep it down",
+		Long: `Take a resource down on all of its nodes for an intentional offline period.
+The drbd-reactor promoters of its HA config and gateways are disabled first,
+which stops their services and demotes the Primary.
+
+A stopped resource is not treated as failed: the reconciler takes it down
+again where it comes up, and it gets no health metrics, alerting rules or
+snapshot replication until it is started.
+
+Example:
+  sds resource stop archive
+  sds resource start archive`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			if err := sdsClient.StopResource(ctx, name); err != nil {
+				return fmt.Errorf("failed to stop resource: %w", err)
+			}
+
+			fmt.Printf("✓ Resource '%s' stopped\n", name)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func resourceStart() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start <name>",
+		Short: "Bring a stopped resource up again",
+		Long: `Bring a stopped resource up on all of its nodes and enable the drbd-reactor
+promoters of its HA config and gateways, which start its services on one of
+them.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			if err := sdsClient.StartResource(ctx, name); err != nil {
+				return fmt.Errorf("failed to start resource: %w", err)
+			}
+
+			fmt.Printf("✓ Resource '%s' started\n", name)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func resourcePauseSync() *cobra.Command {
+	var peer string
+
+	cmd := &cobra.Command{
+		Use:   "pause-sync <name>",
+		Short: "Pause the resync of a resource",
+		Long: `Pause the resync of a resource on all of its nodes with drbdadm pause-sync,
+or with --peer only of its connections to that node, to shed replication
+load during an incident. New writes are still replicated. The resync stays
+paused until resume-sync.
+
+Example:
+  sds resource pause-sync data --peer node3
+  sds resource resume-sync data --peer node3`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			if err := sdsClient.PauseSync(ctx, name, peer); err != nil {
+				return fmt.Errorf("failed to pause resync: %w", err)
+			}
+
+			fmt.Printf("✓ Resync of '%s' paused\n", name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&peer, "peer", "", "Only pause the resync with this node")
+
+	return cmd
+}
+
+func resourceResumeSync() *cobra.Command {
+	var peer string
+
+	cmd := &cobra.Command{
+		Use:   "resume-sync <name>",
+		Short: "Resume a paused resync of a resource",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			if err := sdsClient.ResumeSync(ctx, name, peer); err != nil {
+				return fmt.Errorf("failed to resume resync: %w", err)
+			}
+
+			fmt.Printf("✓ Resync of '%s' resumed\n", name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&peer, "peer", "", "Only resume the resync with this node")
+
+	return cmd
+}
+
+func resourceSyncRate() *cobra.Command {
+	var peer string
+	var rate string
+	var duration time.Duration
+	var revert bool
+
+	cmd := &cobra.Command{
+		Use:   "sync-rate <name>",
+		Short: "Override the resync rate of a resource for a while",
+		Long: `Set a fixed resync rate on a resource at runtime, or with --peer only on its
+connections to that node, without changing its DRBD config. The dynamic
+resync controller is off meanwhile. The controller reverts the override
+to the configured rate after --for, or right away with --clear.
+
+Rates are in KiB/s unless suffixed with k, M or G.
+
+Example:
+  sds resource sync-rate data --rate 10M --for 2h
+  sds resource sync-rate data --clear`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			if !revert && rate == "" {
+				return fmt.Errorf("--rate or --clear is required")
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			expiresAt, err := sdsClient.SetSyncRate(ctx, &v1.SetSyncRateRequest{
+				Name:            name,
+				Peer:            peer,
+				Rate:            rate,
+				DurationSeconds: int64(duration.Seconds()),
+				Clear:           revert,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to set resync rate: %w", err)
+			}
+
+			if revert {
+				fmt.Printf("✓ Resync rate of '%s' reverted to its config\n", name)
+				return nil
+			}
+			fmt.Printf("✓ Resync rate of '%s' set to %s until %s\n", name, rate, expiresAt.Format("2006-01-02 15:04:05"))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&peer, "peer", "", "Only override the rate of the resync with this node")
+	cmd.Flags().StringVar(&rate, "rate", "", "Resync rate, e.g. 20M")
+	cmd.Flags().DurationVar(&duration, "for", time.Hour, "How long the override lasts")
+	cmd.Flags().BoolVar(&revert, "clear", false, "Revert to the configured rate")
+
 	return cmd
 }
 
@@ -236,7 +590,7 @@ func resourceList() *cobra.Command {
 		Use:   "list",
 		Short: "List all resources",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -256,7 +610,19 @@ func resourceList() *cobra.Command {
 			}
 
 			for _, r := range resources {
-				fmt.Printf("%s (port=%d, protocol=%s, nodes=%v)\n", r.Name, r.Port, r.Protocol, r.Nodes)
+				extra := ""
+				if r.Owner != "" {
+					extra += ", owner=" + r.Owner
+				}
+				if r.DesiredState == "stopped" {
+					extra += ", stopped"
+				} else if r.Health != "" {
+					extra += ", health=" + r.Health
+				}
+				fmt.Printf("%s (port=%d, protocol=%s, nodes=%v%s)\n", r.Name, r.Port, r.Protocol, r.Nodes, extra)
+				for _, reason := range r.HealthReasons {
+					fmt.Printf("    %s\n", reason)
+				}
 			}
 
 			return nil
@@ -289,7 +655,7 @@ func resourceAddVolume() *cobra.Command {
 				return fmt.Errorf("pool is required (--pool)")
 			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sizeBytes, err := util.ParseSize(size)
@@ -307,19 +673,22 @@ func resourceAddVolume() *cobra.Command {
 			}
 			defer sdsClient.Close()
 
-			err = sdsClient.AddVolume(ctx, resource, volume, pool, uint32(sizeGiB))
+			vol, err := sdsClient.AddVolume(ctx, resource, volume, pool, uint32(sizeGiB))
 			if err != nil {
 				return fmt.Errorf("failed to add volume: %w", err)
 			}
 
 			fmt.Printf("Volume '%s' added to '%s' (size: %s)\n", volume, resource, util.FormatBytes(sizeBytes))
+			if vol != nil {
+				fmt.Printf("  Volume ID: %d\n  Device:    %s\n", vol.VolumeId, vol.Device)
+			}
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVar(&name, "name", "", "Volume name (required)")
 	cmd.Flags().StringVar(&volume, "volume", "", "Volume name (required)")
-	cmd.Flags().StringVar(&pool, "pool", "", "Storage pool (required)")
+	cmd.Flags().StringVar(&pool, "pool", "", "Storage pool, of the storage type of the resource's other volumes (required)")
 	cmd.Flags().StringVar(&size, "size", "", "Volume size (e.g., 1G, 10GB, 1TB, required)")
 
 	// For compatibility, map --name to --volume
@@ -348,7 +717,7 @@ func resourceRemoveVolume() *cobra.Command {
 				return fmt.Errorf("invalid volume ID: %s", args[1])
 			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -389,7 +758,7 @@ func resourceResizeVolume() *cobra.Command {
 			}
 			size = args[2]
 
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sizeBytes, err := util.ParseSize(size)
@@ -433,7 +802,7 @@ func resourcePrimary() *cobra.Command {
 			resource := args[0]
 			node := args[1]
 
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -466,7 +835,7 @@ func resourceSecondary() *cobra.Command {
 			resource := args[0]
 			node := args[1]
 
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -504,7 +873,7 @@ func resourceFs() *cobra.Command {
 			}
 			fstype := args[2]
 
-			ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -536,7 +905,7 @@ func resourceStatus() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			resource := args[0]
 
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -563,6 +932,19 @@ func resourceStatus() *cobra.Command {
 				}
 			}
 
+			if ioStats := status.GetIoStats(); len(ioStats) > 0 {
+				fmt.Printf("\n  I/O (last poll interval):\n")
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				fmt.Fprintln(w, "    VOLUME\tNODE\tREAD/S\tWRITE/S\tREAD IOPS\tWRITE IOPS\tREAD LAT\tWRITE LAT")
+				for _, io := range ioStats {
+					fmt.Fprintf(w, "    %d\t%s\t%s\t%s\t%.1f\t%.1f\t%.2f ms\t%.2f ms\n",
+						io.GetVolumeId(), io.GetNode(),
+						formatBytes(uint64(io.GetReadBytesPerSec())), formatBytes(uint64(io.GetWriteBytesPerSec())),
+						io.GetReadIops(), io.GetWriteIops(), io.GetReadLatencyMs(), io.GetWriteLatencyMs())
+				}
+				w.Flush()
+			}
+
 			return nil
 		},
 	}
@@ -587,7 +969,7 @@ func resourceMount() *cobra.Command {
 			}
 			mountPath := args[2]
 
-			ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -628,7 +1010,7 @@ func resourceUnmount() *cobra.Command {
 				return fmt.Errorf("invalid volume ID: %s", args[1])
 			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -664,7 +1046,7 @@ func resourcePromote() *cobra.Command {
 			resource := args[0]
 			node := args[1]
 
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -697,7 +1079,7 @@ func resourceDemote() *cobra.Command {
 			resource := args[0]
 			node := args[1]
 
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -730,35 +1112,48 @@ func resourceSnapshot() *cobra.Command {
 	cmd.AddCommand(resourceSnapshotList())
 	cmd.AddCommand(resourceSnapshotRestore())
 	cmd.AddCommand(resourceSnapshotDelete())
+	cmd.AddCommand(resourceSnapshotHook())
+	cmd.AddCommand(resourceSnapshotReplication())
 
 	return cmd
 }
 
-func resourceSnapshotDelete() *cobra.Command {
-	var resource string
-	var snapshotName string
-	var node string
-	var storageType string
-	var pool string
+func resourceSnapshotHook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hook",
+		Short: "Quiesce the application of a resource around its snapshots",
+		Long: `Snapshot hooks make snapshots application-consistent. Before a snapshot of
+the resource, the pre command of each hook runs on the Primary, e.g.
+fsfreeze -f, a database checkpoint or qemu-guest-agent fsfreeze; after the
+snapshot, the post commands release the application again in reverse order.
+
+A hook whose pre command fails or times out aborts the snapshot, or with
+--on-failure continue lets it be taken without the application quiesced.
+The post command of a failed hook runs as well, so it must be safe to run
+when the pre command did not complete.
+
+The commands run as root, so setting and deleting hooks needs the admin
+token (--admin-token or $SDS_ADMIN_TOKEN). They run through "sudo sh -c",
+which the policy of sds node harden does not allow: with ssh.sudo =
+"restricted" hooks cannot be set and existing ones fail.`,
+	}
+
+	cmd.AddCommand(resourceSnapshotHookSet())
+	cmd.AddCommand(resourceSnapshotHookDelete())
+	cmd.AddCommand(resourceSnapshotHookList())
+
+	return cmd
+}
+
+func resourceSnapshotHookSet() *cobra.Command {
+	var resource, name, pre, post, onFailure, adminToken string
+	var timeout time.Duration
 
 	cmd := &cobra.Command{
-		Use:   "delete",
-		Short: "Delete a snapshot",
+		Use:   "set",
+		Short: "Add a snapshot hook or replace the hook of the same name",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if resource == "" {
-				return fmt.Errorf("resource name is required")
-			}
-			if snapshotName == "" {
-				return fmt.Errorf("snapshot name is required")
-			}
-			if node == "" {
-				return fmt.Errorf("node is required")
-			}
-			if pool == "" {
-				pool = "data-pool"
-			}
-
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -767,72 +1162,47 @@ func resourceSnapshotDelete() *cobra.Command {
 			}
 			defer sdsClient.Close()
 
-			if storageType == "zfs" {
-				// ZFS snapshot
-				// ZFS snapshot names are unique per dataset, usually passed as snapshot name only to destroy?
-				// But DeleteZFSSnapshot in backend takes 'snapshot' arg.
-				// Is it "snap1" or "pool/dataset@snap1"?
-				// pkg/deployment/deployment.go ZFSDestroySnapshot: "sudo zfs destroy %s"
-				// So it needs FULL path.
-				snapshotPath := fmt.Sprintf("%s/%s_data@%s", pool, resource, snapshotName)
-				err = sdsClient.DeleteZFSSnapshot(ctx, snapshotPath, node)
-				if err != nil {
-					return fmt.Errorf("failed to delete ZFS snapshot: %w", err)
-				}
-				fmt.Printf("ZFS snapshot '%s' deleted on node '%s'\n", snapshotName, node)
-			} else {
-				// LVM snapshot
-				// Pass pool as VG name
-				err = sdsClient.DeleteLvmSnapshot(ctx, pool, snapshotName, node)
-				if err != nil {
-					return fmt.Errorf("failed to delete LVM snapshot: %w", err)
-				}
-				fmt.Printf("LVM snapshot '%s' deleted on node '%s'\n", snapshotName, node)
+			hook := &v1.SnapshotHook{
+				Name:           name,
+				Pre:            pre,
+				Post:           post,
+				TimeoutSeconds: uint32(timeout / time.Second),
+				OnFailure:      onFailure,
 			}
-
+			if adminToken == "" {
+				adminToken = os.Getenv("SDS_ADMIN_TOKEN")
+			}
+			if err := sdsClient.SetSnapshotHook(client.WithAdminToken(ctx, adminToken), resource, hook); err != nil {
+				return fmt.Errorf("failed to set snapshot hook: %w", err)
+			}
+			fmt.Printf("Snapshot hook '%s' set for resource '%s'\n", name, resource)
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVar(&resource, "resource", "", "DRBD resource name")
-	cmd.Flags().StringVar(&snapshotName, "name", "", "Snapshot name")
-	cmd.Flags().StringVar(&node, "node", "", "Node where resource exists")
-	cmd.Flags().StringVar(&storageType, "storage-type", "lvm", "Storage type: lvm or zfs")
-	cmd.Flags().StringVar(&pool, "pool", "data-pool", "Storage pool name")
+	cmd.Flags().StringVar(&name, "name", "", "Hook name")
+	cmd.Flags().StringVar(&pre, "pre", "", "Command run on the Primary before the snapshot")
+	cmd.Flags().StringVar(&post, "post", "", "Command run on the Primary after the snapshot")
+	cmd.Flags().DurationVar(&timeout, "timeout", time.Minute, "Timeout of each command")
+	cmd.Flags().StringVar(&onFailure, "on-failure", "abort", "When the pre command fails: abort the snapshot or continue without quiescing")
+	cmd.Flags().StringVar(&adminToken, "admin-token", "", "Admin token (default: $SDS_ADMIN_TOKEN)")
 
 	cmd.MarkFlagRequired("resource")
 	cmd.MarkFlagRequired("name")
-	cmd.MarkFlagRequired("node")
+	cmd.MarkFlagRequired("pre")
 
 	return cmd
 }
 
-func resourceSnapshotCreate() *cobra.Command {
-	var resource string
-	var snapshotName string
-	var node string
-	var size string
-	var storageType string
-	var pool string
+func resourceSnapshotHookDelete() *cobra.Command {
+	var resource, name, adminToken string
 
 	cmd := &cobra.Command{
-		Use:   "create",
-		Short: "Create a snapshot of DRBD resource",
+		Use:   "delete",
+		Short: "Remove a snapshot hook",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if resource == "" {
-				return fmt.Errorf("resource name is required")
-			}
-			if snapshotName == "" {
-				return fmt.Errorf("snapshot name is required")
-			}
-			if node == "" {
-				return fmt.Errorf("node is required")
-			}
-			if pool == "" {
-				pool = "data-pool"
-			}
-
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -841,67 +1211,35 @@ func resourceSnapshotCreate() *cobra.Command {
 			}
 			defer sdsClient.Close()
 
-			if storageType == "zfs" {
-				// ZFS snapshot: pool/resource_data@snapshot
-				dataset := fmt.Sprintf("%s/%s_data", pool, resource)
-				err = sdsClient.CreateZFSSnapshot(ctx, dataset, snapshotName, node)
-				if err != nil {
-					return fmt.Errorf("failed to create ZFS snapshot: %w", err)
-				}
-				fmt.Printf("ZFS snapshot '%s' created for resource '%s' on node '%s'\n", snapshotName, resource, node)
-			} else {
-				// LVM snapshot (default)
-				if size == "" {
-					size = "1G"
-				}
-				lvName := fmt.Sprintf("%s_data", resource)
-				// Pass pool as the VG name (first argument)
-				err = sdsClient.CreateLvmSnapshot(ctx, pool, lvName, snapshotName, node, size)
-				if err != nil {
-					return fmt.Errorf("failed to create LVM snapshot: %w", err)
-				}
-				fmt.Printf("LVM snapshot '%s' created for resource '%s' on node '%s'\n", snapshotName, resource, node)
+			if adminToken == "" {
+				adminToken = os.Getenv("SDS_ADMIN_TOKEN")
 			}
-
+			if err := sdsClient.DeleteSnapshotHook(client.WithAdminToken(ctx, adminToken), resource, name); err != nil {
+				return fmt.Errorf("failed to delete snapshot hook: %w", err)
+			}
+			fmt.Printf("Snapshot hook '%s' deleted from resource '%s'\n", name, resource)
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVar(&resource, "resource", "", "DRBD resource name")
-	cmd.Flags().StringVar(&snapshotName, "name", "", "Snapshot name")
-	cmd.Flags().StringVar(&node, "node", "", "Node where resource exists")
-	cmd.Flags().StringVar(&size, "size", "1G", "Snapshot size for LVM (e.g., 1G)")
-	cmd.Flags().StringVar(&storageType, "storage-type", "lvm", "Storage type: lvm or zfs")
-	cmd.Flags().StringVar(&pool, "pool", "data-pool", "Storage pool name")
+	cmd.Flags().StringVar(&name, "name", "", "Hook name")
+	cmd.Flags().StringVar(&adminToken, "admin-token", "", "Admin token (default: $SDS_ADMIN_TOKEN)")
 
 	cmd.MarkFlagRequired("resource")
 	cmd.MarkFlagRequired("name")
-	cmd.MarkFlagRequired("node")
 
 	return cmd
 }
 
-func resourceSnapshotList() *cobra.Command {
+func resourceSnapshotHookList() *cobra.Command {
 	var resource string
-	var node string
-	var storageType string
-	var pool string
 
 	cmd := &cobra.Command{
 		Use:   "list",
-		Short: "List snapshots for DRBD resource",
+		Short: "List the snapshot hooks of a resource in the order they run",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if resource == "" {
-				return fmt.Errorf("resource name is required")
-			}
-			if node == "" {
-				return fmt.Errorf("node is required")
-			}
-			if pool == "" {
-				pool = "data-pool"
-			}
-
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -910,55 +1248,394 @@ func resourceSnapshotList() *cobra.Command {
 			}
 			defer sdsClient.Close()
 
-			if storageType == "zfs" {
-				// ZFS snapshots
-				dataset := fmt.Sprintf("%s/%s_data", pool, resource)
-				snapshots, err := sdsClient.ListZFSSnapshots(ctx, dataset, node)
-				if err != nil {
-					return fmt.Errorf("failed to list ZFS snapshots: %w", err)
+			hooks, err := sdsClient.ListSnapshotHooks(ctx, resource)
+			if err != nil {
+				return fmt.Errorf("failed to list snapshot hooks: %w", err)
+			}
+			if len(hooks) == 0 {
+				fmt.Printf("Resource '%s' has no snapshot hooks\n", resource)
+				return nil
+			}
+			for _, h := range hooks {
+				timeout := time.Duration(h.TimeoutSeconds) * time.Second
+				if timeout == 0 {
+					timeout = time.Minute
 				}
-
-				if len(snapshots) == 0 {
-					fmt.Printf("No ZFS snapshots found for resource '%s'\n", resource)
-					return nil
+				fmt.Printf("%s (timeout %s, on failure %s)\n", h.Name, timeout, h.OnFailure)
+				fmt.Printf("  pre:  %s\n", h.Pre)
+				if h.Post != "" {
+					fmt.Printf("  post: %s\n", h.Post)
 				}
+			}
+			return nil
+		},
+	}
 
-				fmt.Printf("ZFS snapshots for resource '%s':\n", resource)
-				for _, snap := range snapshots {
-					fmt.Printf("  - %s (created: %s)\n", snap.Name, snap.CreatedAt)
-				}
-			} else {
-				// LVM snapshots
-				// Pass pool as VG name
-				snapshots, err := sdsClient.ListLvmSnapshots(ctx, pool, node)
-				if err != nil {
-					return fmt.Errorf("failed to list LVM snapshots: %w", err)
-				}
+	cmd.Flags().StringVar(&resource, "resource", "", "DRBD resource name")
+	cmd.MarkFlagRequired("resource")
+
+	return cmd
+}
+
+func resourceSnapshotReplication() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replication",
+		Short: "Send the snapshots of a resource to a backup node on a schedule",
+		Long: `A replication policy takes a snapshot of a resource every --interval, with its
+snapshot hooks, on one of its UpToDate nodes and sends the snapshots of its
+ZFS volumes with zfs send to the dataset of the same name in --target-pool on
+--target-node. The first run sends a full copy, later runs only the changes
+since the previous snapshot. The target keeps the last --keep snapshots.
+
+The target node may be outside the DRBD replica set, so the copy survives the
+loss of the resource's nodes. A pool that backs a replica of the resource is
+refused as the target, one disk failure would lose both.
+
+A policy without a successful run for --stale-after is stale: the
+SdsReplicationStale alert fires (sds report alert-rules) and a failed run is
+recorded as a replication.failed event.`,
+	}
+
+	cmd.AddCommand(resourceSnapshotReplicationSet())
+	cmd.AddCommand(resourceSnapshotReplicationDelete())
+	cmd.AddCommand(resourceSnapshotReplicationList())
+	cmd.AddCommand(resourceSnapshotReplicationRun())
+
+	return cmd
+}
+
+func resourceSnapshotReplicationSet() *cobra.Command {
+	var resource, targetNode, targetPool string
+	var interval, staleAfter time.Duration
+	var keep uint32
+
+	cmd := &cobra.Command{
+		Use:     "set",
+		Short:   "Add or replace the replication policy of a resource",
+		Example: `  sds resource snapshot replication set --resource db --target-node backup1 --target-pool backup --interval 1h`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			policy := &v1.ReplicationPolicy{
+				TargetNode:        targetNode,
+				TargetPool:        targetPool,
+				IntervalSeconds:   int64(interval / time.Second),
+				Keep:              keep,
+				StaleAfterSeconds: int64(staleAfter / time.Second),
+			}
+			warnings, err := sdsClient.SetReplicationPolicy(ctx, resource, policy)
+			if err != nil {
+				return fmt.Errorf("failed to set replication policy: %w", err)
+			}
+			fmt.Printf("Replication of resource '%s' to %s:%s set\n", resource, targetNode, targetPool)
+			for _, warning := range warnings {
+				fmt.Printf("[WARN] %s\n", warning)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&resource, "resource", "", "DRBD resource name")
+	cmd.Flags().StringVar(&targetNode, "target-node", "", "Node receiving the snapshots")
+	cmd.Flags().StringVar(&targetPool, "target-pool", "", "ZFS pool or dataset on the target node")
+	cmd.Flags().DurationVar(&interval, "interval", time.Hour, "Time between replications")
+	cmd.Flags().Uint32Var(&keep, "keep", 7, "Replicated snapshots kept on the target")
+	cmd.Flags().DurationVar(&staleAfter, "stale-after", 0, "Alert when no replication succeeded for this long (default: 3 intervals)")
+
+	cmd.MarkFlagRequired("resource")
+	cmd.MarkFlagRequired("target-node")
+	cmd.MarkFlagRequired("target-pool")
+
+	return cmd
+}
+
+func resourceSnapshotReplicationDelete() *cobra.Command {
+	var resource string
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Stop replicating a resource, the replicated snapshots are kept",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			if err := sdsClient.DeleteReplicationPolicy(ctx, resource); err != nil {
+				return fmt.Errorf("failed to delete replication policy: %w", err)
+			}
+			fmt.Printf("Replication policy of resource '%s' deleted\n", resource)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&resource, "resource", "", "DRBD resource name")
+	cmd.MarkFlagRequired("resource")
+
+	return cmd
+}
+
+func resourceSnapshotReplicationList() *cobra.Command {
+	var resource string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the replication policies with their last runs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			policies, err := sdsClient.ListReplicationPolicies(ctx, resource)
+			if err != nil {
+				return fmt.Errorf("failed to list replication policies: %w", err)
+			}
+			if len(policies) == 0 {
+				fmt.Println("No replication policies")
+				return nil
+			}
 
-				if len(snapshots) == 0 {
-					fmt.Printf("No LVM snapshots found for resource '%s'\n", resource)
-					return nil
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "RESOURCE\tTARGET\tINTERVAL\tKEEP\tLAST SNAPSHOT\tLAST SUCCESS\tSTATE")
+			for _, p := range policies {
+				lastSnapshot := p.LastSnapshot
+				if lastSnapshot == "" {
+					lastSnapshot = "-"
+				}
+				lastSuccess := "never"
+				if p.LastSuccess > 0 {
+					lastSuccess = time.Unix(p.LastSuccess, 0).Format("2006-01-02 15:04")
 				}
+				state := "ok"
+				switch {
+				case p.Stale:
+					state = "stale"
+				case p.LastError != "":
+					state = "failing"
+				case p.LastAttempt == 0:
+					state = "pending"
+				}
+				fmt.Fprintf(w, "%s\t%s:%s\t%s\t%d\t%s\t%s\t%s\n",
+					p.Resource, p.TargetNode, p.TargetPool,
+					time.Duration(p.IntervalSeconds)*time.Second,
+					p.Keep, lastSnapshot, lastSuccess, state)
+			}
+			w.Flush()
 
-				fmt.Printf("LVM snapshots for resource '%s':\n", resource)
-				fmt.Println("  Name                    Size")
-				fmt.Println("  ----------------------- ----")
-				for _, snap := range snapshots {
-					fmt.Printf("  %-23s %d GB\n", snap.Name, snap.SizeGb)
+			for _, p := range policies {
+				if p.LastError != "" {
+					fmt.Printf("[ERROR] %s: %s\n", p.Resource, p.LastError)
 				}
 			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&resource, "resource", "", "Only the policy of this resource")
+
+	return cmd
+}
+
+func resourceSnapshotReplicationRun() *cobra.Command {
+	var resource string
+	var full bool
 
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Replicate a resource now",
+		Long: `Replicate a resource now instead of waiting for its interval.
+
+--full replaces the copy on the target with a full one. This is needed when
+the node that sent the last snapshot is gone or no longer UpToDate, only it
+has the base of the next increment. The older snapshots on the target are
+lost.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.RunReplication(ctx, resource, full)
+			if err != nil {
+				return fmt.Errorf("failed to replicate: %w", err)
+			}
+			fmt.Println(resp.Message)
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVar(&resource, "resource", "", "DRBD resource name")
-	cmd.Flags().StringVar(&node, "node", "", "Node where resource exists")
-	cmd.Flags().StringVar(&storageType, "storage-type", "lvm", "Storage type: lvm or zfs")
-	cmd.Flags().StringVar(&pool, "pool", "data-pool", "Storage pool name")
+	cmd.Flags().BoolVar(&full, "full", false, "Send a full copy, replacing the one on the target")
+	cmd.MarkFlagRequired("resource")
+
+	return cmd
+}
+
+func resourceSnapshotDelete() *cobra.Command {
+	var resource string
+	var snapshotName string
+	var node string
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a snapshot",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if resource == "" {
+				return fmt.Errorf("resource name is required")
+			}
+			if snapshotName == "" {
+				return fmt.Errorf("snapshot name is required")
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			// The controller detects the storage backend (LVM/ZFS) of the resource
+			err = sdsClient.DeleteSnapshot(ctx, resource, snapshotName, node)
+			if err != nil {
+				return fmt.Errorf("failed to delete snapshot: %w", err)
+			}
+			fmt.Printf("Snapshot '%s' deleted for resource '%s' on %s\n", snapshotName, resource, snapshotNodes(node))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&resource, "resource", "", "DRBD resource name")
+	cmd.Flags().StringVar(&snapshotName, "name", "", "Snapshot name")
+	cmd.Flags().StringVar(&node, "node", "", "Node to delete the snapshot on (default: all nodes of the resource)")
+	addDeprecatedSnapshotFlags(cmd)
+
+	cmd.MarkFlagRequired("resource")
+	cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func resourceSnapshotCreate() *cobra.Command {
+	var resource string
+	var snapshotName string
+	var node string
+	var size string
+	var noHooks bool
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a snapshot of DRBD resource",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if resource == "" {
+				return fmt.Errorf("resource name is required")
+			}
+			if snapshotName == "" {
+				return fmt.Errorf("snapshot name is required")
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			// The controller detects the storage backend (LVM/ZFS) of the resource
+			err = sdsClient.CreateSnapshot(ctx, resource, snapshotName, node, size, noHooks)
+			if err != nil {
+				return fmt.Errorf("failed to create snapshot: %w", err)
+			}
+			fmt.Printf("Snapshot '%s' created for resource '%s' on %s\n", snapshotName, resource, snapshotNodes(node))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&resource, "resource", "", "DRBD resource name")
+	cmd.Flags().StringVar(&snapshotName, "name", "", "Snapshot name")
+	cmd.Flags().StringVar(&node, "node", "", "Node to snapshot on (default: all nodes of the resource)")
+	cmd.Flags().StringVar(&size, "size", "", "Snapshot size for thick LVM volumes (e.g., 1G; default: sized from the volume and its change rate)")
+	cmd.Flags().BoolVar(&noHooks, "no-hooks", false, "Do not run the snapshot hooks of the resource")
+	addDeprecatedSnapshotFlags(cmd)
+
+	cmd.MarkFlagRequired("resource")
+	cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func resourceSnapshotList() *cobra.Command {
+	var resource string
+	var node string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List snapshots for DRBD resource",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if resource == "" {
+				return fmt.Errorf("resource name is required")
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			snapshots, err := sdsClient.ListSnapshots(ctx, resource, node)
+			if err != nil {
+				return fmt.Errorf("failed to list snapshots: %w", err)
+			}
+
+			if len(snapshots) == 0 {
+				fmt.Printf("No snapshots found for resource '%s'\n", resource)
+				return nil
+			}
+
+			fmt.Printf("Snapshots for resource '%s':\n", resource)
+			fmt.Println("  Name                    Node            Size    Created")
+			fmt.Println("  ----------------------- --------------- ------- -------")
+			for _, snap := range snapshots {
+				fmt.Printf("  %-23s %-15s %-7s %s\n", snap.Name, snap.Node, fmt.Sprintf("%d GB", snap.SizeGb), snap.CreatedAt)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&resource, "resource", "", "DRBD resource name")
+	cmd.Flags().StringVar(&node, "node", "", "Only list snapshots on this node (default: all nodes of the resource)")
+	addDeprecatedSnapshotFlags(cmd)
 
 	cmd.MarkFlagRequired("resource")
-	cmd.MarkFlagRequired("node")
 
 	return cmd
 }
@@ -967,12 +1644,21 @@ func resourceSnapshotRestore() *cobra.Command {
 	var resource string
 	var snapshotName string
 	var node string
-	var storageType string
-	var pool string
+	var noSafetySnapshot bool
 
 	cmd := &cobra.Command{
 		Use:   "restore",
 		Short: "Restore DRBD resource from snapshot",
+		Long: `Restore DRBD resource from snapshot.
+
+LVM snapshots are merged back into the original volume, ZFS volumes are
+rolled back. The storage backend is detected from the resource.
+
+Unless --no-safety-snapshot is given, a safety snapshot of the current data
+is taken first so the restore can be undone. A ZFS rollback destroys the
+snapshots newer than the restored one, so on ZFS the safety snapshot is also
+copied to the zvol <volume>-sds-safety-<time>-restore, and the restore is
+refused if the copy fails.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if resource == "" {
 				return fmt.Errorf("resource name is required")
@@ -983,11 +1669,8 @@ func resourceSnapshotRestore() *cobra.Command {
 			if node == "" {
 				return fmt.Errorf("node is required")
 			}
-			if pool == "" {
-				pool = "data-pool"
-			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -996,24 +1679,13 @@ func resourceSnapshotRestore() *cobra.Command {
 			}
 			defer sdsClient.Close()
 
-			if storageType == "zfs" {
-				// ZFS rollback
-				dataset := fmt.Sprintf("%s/%s_data", pool, resource)
-				err = sdsClient.RestoreZFSSnapshot(ctx, dataset, snapshotName, node)
-				if err != nil {
-					return fmt.Errorf("failed to restore ZFS snapshot: %w", err)
-				}
-				fmt.Printf("ZFS snapshot '%s' restored for resource '%s' on node '%s'\n", snapshotName, resource, node)
-			} else {
-				// LVM snapshot restore (merge)
-				// Pass pool as VG name
-				err = sdsClient.RestoreLvmSnapshot(ctx, pool, snapshotName, node)
-				if err != nil {
-					return fmt.Errorf("failed to restore LVM snapshot: %w", err)
-				}
-				fmt.Printf("LVM snapshot '%s' restored for resource '%s' on node '%s'\n", snapshotName, resource, node)
-				fmt.Println("Note: The snapshot has been merged back into the original volume.")
+			progressCtx, stopProgress := watchProgress(ctx, sdsClient)
+			err = sdsClient.RestoreSnapshot(progressCtx, resource, snapshotName, node, noSafetySnapshot)
+			stopProgress()
+			if err != nil {
+				return fmt.Errorf("failed to restore snapshot: %w", err)
 			}
+			fmt.Printf("Snapshot '%s' restored for resource '%s' on node '%s'\n", snapshotName, resource, node)
 
 			return nil
 		},
@@ -1022,8 +1694,8 @@ func resourceSnapshotRestore() *cobra.Command {
 	cmd.Flags().StringVar(&resource, "resource", "", "DRBD resource name")
 	cmd.Flags().StringVar(&snapshotName, "name", "", "Snapshot name")
 	cmd.Flags().StringVar(&node, "node", "", "Node where resource exists")
-	cmd.Flags().StringVar(&storageType, "storage-type", "lvm", "Storage type: lvm or zfs")
-	cmd.Flags().StringVar(&pool, "pool", "data-pool", "Storage pool name")
+	cmd.Flags().BoolVar(&noSafetySnapshot, "no-safety-snapshot", false, "Skip the automatic safety snapshot taken before the operation")
+	addDeprecatedSnapshotFlags(cmd)
 
 	cmd.MarkFlagRequired("resource")
 	cmd.MarkFlagRequired("name")
@@ -1031,3 +1703,550 @@ func resourceSnapshotRestore() *cobra.Command {
 
 	return cmd
 }
+
+// addDeprecatedSnapshotFlags keeps the old backend selection flags accepted;
+// the controller now detects the storage backend of the resource itself
+func addDeprecatedSnapshotFlags(cmd *cobra.Command) {
+	cmd.Flags().String("storage-type", "", "Storage type: lvm or zfs")
+	cmd.Flags().String("pool", "", "Storage pool name")
+	cmd.Flags().MarkDeprecated("storage-type", "the storage type is detected from the resource")
+	cmd.Flags().MarkDeprecated("pool", "the pool is detected from the resource")
+}
+
+// snapshotNodes describes the node selection of a snapshot command
+func snapshotNodes(node string) string {
+	if node == "" {
+		return "all nodes"
+	}
+	return fmt.Sprintf("node '%s'", node)
+}
+
+func resourceExport() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export <resource>",
+		Short: "Print the canonical DRBD config and promoter plugin of a resource",
+		Long: `Print the DRBD config and, for HA resources, the drbd-reactor promoter plugin
+as generated from the controller database, for review or to keep in version
+control. Compare them with the deployed files with 'sds resource diff'.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			export, err := sdsClient.ExportResource(ctx, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to export resource: %w", err)
+			}
+
+			fmt.Printf("# %s\n%s", export.DrbdConfigPath, export.DrbdConfig)
+			if export.PromoterConfigPath != "" {
+				fmt.Printf("\n# %s\n%s", export.PromoterConfigPath, export.PromoterConfig)
+			}
+			return nil
+		},
+	}
+}
+
+func resourceDiff() *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff <resource>",
+		Short: "Compare the canonical configs of a resource with the deployed ones",
+		Long: `Compare the canonical DRBD config and promoter plugin of a resource with the
+files deployed on each of its nodes. Lines starting with "-" are only in the
+canonical config, lines starting with "+" only on the node. Exits non-zero if
+any node differs.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			diffs, err := sdsClient.DiffResource(ctx, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to diff resource: %w", err)
+			}
+
+			differing := 0
+			for _, d := range diffs {
+				fmt.Printf("%s: %s: %s\n", d.Node, d.Path, d.State)
+				if d.State != "identical" {
+					differing++
+				}
+				if d.Diff != "" {
+					for _, line := range strings.Split(strings.TrimRight(d.Diff, "\n"), "\n") {
+						fmt.Printf("    %s\n", line)
+					}
+				}
+			}
+
+			if differing > 0 {
+				return fmt.Errorf("%d deployed config(s) differ from the canonical config", differing)
+			}
+			return nil
+		},
+	}
+}
+
+func resourceNodeConfig() *cobra.Command {
+	var configured bool
+
+	cmd := &cobra.Command{
+		Use:   "node-config <resource> <node>",
+		Short: "Show the DRBD config a node is running for a resource",
+		Long: `Show the DRBD config of a resource as loaded into the kernel of a node
+(drbdsetup show). With --configured, show the config file as drbdadm parses it
+on the node (drbdadm dump) instead. Compare with sds resource export to see
+what the node runs versus the intended config.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			cfg, err := sdsClient.GetNodeResourceConfig(ctx, args[0], args[1])
+			if err != nil {
+				return fmt.Errorf("failed to get node config: %w", err)
+			}
+
+			if configured {
+				if cfg.DumpError != "" {
+					return fmt.Errorf("drbdadm cannot parse the config on %s: %s", cfg.Node, cfg.DumpError)
+				}
+				fmt.Print(cfg.Dump)
+				return nil
+			}
+			if !cfg.Running {
+				return fmt.Errorf("resource %s is not up on %s, see its config file with --configured", args[0], cfg.Node)
+			}
+			fmt.Print(cfg.Show)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&configured, "configured", false, "Show the config file (drbdadm dump) instead of the running config")
+	return cmd
+}
+
+// formatPeerProtocols describes per-peer protocols, e.g. " (dr1: A)"
+func formatPeerProtocols(peerProtocols map[string]string) string {
+	if len(peerProtocols) == 0 {
+		return ""
+	}
+	var parts []string
+	for _, node := range sortedKeys(peerProtocols) {
+		parts = append(parts, fmt.Sprintf("%s: %s", node, peerProtocols[node]))
+	}
+	return " (" + strings.Join(parts, ", ") + ")"
+}
+
+func resourceSetMaxPeers() *cobra.Command {
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "set-max-peers <name> <max-peers>",
+		Short: "Change the peer slots of a resource's DRBD metadata",
+		Long: `Change the peer slots of a resource's DRBD metadata, e.g. before adding
+nodes beyond the ones the resource was created for.
+
+The metadata is recreated one Secondary at a time, each only while all other
+nodes are UpToDate. LVM and ZFS backing devices are grown for the larger
+bitmaps. Every converted node resyncs completely before the next one starts.
+
+The Primary is left pending. Fail it over (sds ha failover, or sds resource
+primary on another node) and rerun the command to convert it.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			maxPeers, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid max peers: %s", args[1])
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.SetMaxPeers(ctx, name, uint32(maxPeers), timeout)
+			if err != nil {
+				return fmt.Errorf("failed to set max peers: %w", err)
+			}
+
+			fmt.Println(resp.Message)
+			if len(resp.Converted) > 0 {
+				fmt.Printf("  Converted: %s\n", strings.Join(resp.Converted, ", "))
+			}
+			if len(resp.Pending) > 0 {
+				fmt.Printf("  Pending:   %s\n", strings.Join(resp.Pending, ", "))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "How long to wait for each node to resync")
+
+	return cmd
+}
+
+func resourceMigratePool() *cobra.Command {
+	var pool string
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "migrate-pool <name> --to <pool>",
+		Short: "Move the backing volumes of a resource to another pool",
+		Long: `Move the backing volumes of a resource to another LVM volume group or ZFS
+pool of the same storage type, e.g. from HDD to SSD.
+
+The nodes are moved one Secondary at a time, each only while all other nodes
+are UpToDate, so the resource stays online. ZFS volumes are copied with zfs
+send from a snapshot first and only the changes since are sent while the node
+is down. LVM volumes are copied with dd while the node is down; the target
+volume group must have the same extent size. The node then resyncs what
+changed in the meantime and its old volumes are removed. Volumes with
+snapshots are refused, the snapshots would be lost.
+
+The Primary is left pending. Fail it over (sds ha failover, or sds resource
+primary on another node) and rerun the command to move it.`,
+		Example: `  sds resource migrate-pool web --to sds_ssd`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if pool == "" {
+				return fmt.Errorf("--to is required")
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.MigratePool(ctx, args[0], pool, timeout)
+			if err != nil {
+				return fmt.Errorf("failed to migrate pool: %w", err)
+			}
+
+			fmt.Println(resp.Message)
+			if len(resp.Migrated) > 0 {
+				fmt.Printf("  Migrated: %s\n", strings.Join(resp.Migrated, ", "))
+			}
+			if len(resp.Pending) > 0 {
+				fmt.Printf("  Pending:  %s\n", strings.Join(resp.Pending, ", "))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&pool, "to", "", "Target volume group or ZFS pool")
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "How long to wait for each node to resync")
+
+	return cmd
+}
+
+func resourceConvertStorage() *cobra.Command {
+	var storageType string
+	var pool string
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "convert-storage <name> --to <type> --pool <pool>",
+		Short: "Convert the backing volumes of a resource between LVM and ZFS",
+		Long: `Convert the backing volumes of a resource from LVM to ZFS zvols or vice versa
+(lvm, lvm-thin, zfs, zfs-thin), creating the new volumes in --pool.
+
+The nodes are converted one Secondary at a time, each only while all other
+nodes are UpToDate, so the resource stays online. A converted node gets fresh
+DRBD metadata and is rebuilt from its peers with a full resync, which must
+finish within --timeout before its old volumes are removed and the next node
+starts. Volumes with snapshots are refused, the snapshots would be lost.
+
+The Primary is left pending. Fail it over (sds ha failover, or sds resource
+primary on another node) and rerun the command to convert it.`,
+		Example: `  sds resource convert-storage web --to zfs-thin --pool tank`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if storageType == "" {
+				return fmt.Errorf("--to is required")
+			}
+			if pool == "" {
+				return fmt.Errorf("--pool is required")
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.ConvertStorage(ctx, args[0], storageType, pool, timeout)
+			if err != nil {
+				return fmt.Errorf("failed to convert storage: %w", err)
+			}
+
+			fmt.Println(resp.Message)
+			if len(resp.Converted) > 0 {
+				fmt.Printf("  Converted: %s\n", strings.Join(resp.Converted, ", "))
+			}
+			if len(resp.Pending) > 0 {
+				fmt.Printf("  Pending:   %s\n", strings.Join(resp.Pending, ", "))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&storageType, "to", "", "Storage type: lvm, lvm-thin, zfs or zfs-thin")
+	cmd.Flags().StringVar(&pool, "pool", "", "Volume group or ZFS pool of the new volumes")
+	cmd.Flags().DurationVar(&timeout, "timeout", 2*time.Hour, "How long to wait for each node to resync")
+
+	return cmd
+}
+
+func resourceReplaceDisk() *cobra.Command {
+	var node string
+	var devices []string
+	var pool string
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "replace-disk <name> --node <node> [--new-device <device>]...",
+		Short: "Replace the backing disk of a resource on a node",
+		Long: `Replace the backing devices of a resource on one node, e.g. after the disk
+under them failed and DRBD detached it (the volume is Diskless, see the
+resource.disk_lost events and the SdsVolumeDiskless alert).
+
+Each volume is detached on the node, gets its new backing device with fresh
+DRBD metadata and is attached again. DRBD rebuilds it from its peers with a
+full resync, which must finish within --timeout. All other nodes must be
+UpToDate.
+
+--new-device gives the new devices, one per volume in volume order. Without
+it a new LVM or ZFS volume is created in --pool, by default the pool the
+volume was in; raw and file volumes need --new-device. The node may be the
+Primary, it uses its peers' disks while detached.`,
+		Example: `  sds resource replace-disk web --node node2
+  sds resource replace-disk web --node node2 --pool vg_new
+  sds resource replace-disk db --node node2 --new-device /dev/sdc`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if node == "" {
+				return fmt.Errorf("--node is required")
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.ReplaceDisk(ctx, args[0], node, devices, pool, timeout)
+			if err != nil {
+				return fmt.Errorf("failed to replace disk: %w", err)
+			}
+
+			fmt.Println(resp.Message)
+			for _, disk := range resp.Disks {
+				fmt.Printf("  Volume %d: %s -> %s\n", disk.VolumeId, disk.OldDevice, disk.NewDevice)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&node, "node", "", "Node whose disk is replaced")
+	cmd.Flags().StringArrayVar(&devices, "new-device", nil, "New backing device, once per volume in volume order")
+	cmd.Flags().StringVar(&pool, "pool", "", "Volume group or ZFS pool of created volumes (default: the pool of each volume)")
+	cmd.Flags().DurationVar(&timeout, "timeout", 2*time.Hour, "How long to wait for the node to resync")
+
+	return cmd
+}
+
+func resourceExecFenceTest() *cobra.Command {
+	var node, peer string
+	var ioTimeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "exec-fence-test <resource>",
+		Short: "Test the quorum and fencing policy of a resource",
+		Long: `Test the quorum and fencing policy of a resource without risking data.
+
+A Secondary node (--node) is forcibly disconnected from one peer (--peer) as if
+the peer was lost. A read-only direct IO probe on the node must then behave as
+its policy says: IO keeps working while the node has quorum; without quorum it
+fails with on-no-quorum io-error and is suspended with suspend-io. The peer is
+reconnected afterwards, also when the test fails or is interrupted, and the
+node must be connected, UpToDate and have quorum again.
+
+The test is refused unless all nodes are connected and UpToDate, on a Primary,
+and if the disconnected peer is Primary and would lose quorum. Exits non-zero
+if any check fails.`,
+		Example: `  sds resource exec-fence-test web
+  sds resource exec-fence-test web --node node2 --peer node3`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.ExecFenceTest(ctx, args[0], node, peer, ioTimeout)
+			if err != nil {
+				return fmt.Errorf("fence test failed to run: %w", err)
+			}
+
+			expect := "lose"
+			if resp.ExpectQuorum {
+				expect = "keep"
+			}
+			fmt.Printf("Fence test of %s on %s, disconnected from %s\n", args[0], resp.Node, resp.Peer)
+			fmt.Printf("  Policy: quorum %s, on-no-quorum %s (expected to %s quorum)\n", resp.Quorum, resp.OnNoQuorum, expect)
+
+			for _, check := range resp.Checks {
+				outcome := "PASS"
+				if !check.Passed {
+					outcome = "FAIL"
+				}
+				fmt.Printf("  %s  %-10s  %s\n", outcome, check.Name, check.Detail)
+			}
+
+			if !resp.Passed {
+				return fmt.Errorf("fence test of %s failed", args[0])
+			}
+			fmt.Println("Fence test passed")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&node, "node", "", "Secondary whose IO is probed (default: the first Secondary)")
+	cmd.Flags().StringVar(&peer, "peer", "", "Peer to disconnect (default: another Secondary, then the Primary)")
+	cmd.Flags().DurationVar(&ioTimeout, "io-timeout", 10*time.Second, "How long a read may block before IO counts as suspended")
+
+	return cmd
+}
+
+func resourceActivate() *cobra.Command {
+	var node, mountPoint, fsType string
+	var services []string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "activate <resource>",
+		Short: "Activate a cold standby resource on a node",
+		Long: `Activate a resource drbd-reactor does not manage on a node, as one audited
+operation: verify the node has UpToDate data, quorum and the services,
+promote the resource, mount its first volume (--mount-point) and start the
+services (--service) in order. A failed step rolls back the steps before it.
+
+--force activates a node that fails the verification; it never promotes over
+a fence constraint or Inconsistent data. Reverse it with sds resource
+deactivate.`,
+		Example: `  sds resource activate files --node node2 --mount-point /srv/files --service nfs-server`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.ActivateResource(ctx, args[0], node, mountPoint, fsType, services, force)
+			if resp != nil {
+				printActivationSteps(fmt.Sprintf("Activation of %s on %s", args[0], resp.Node), resp.Steps)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to activate resource: %w", err)
+			}
+			fmt.Println(resp.Message)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&node, "node", "", "Node to activate the resource on (required)")
+	cmd.Flags().StringVar(&mountPoint, "mount-point", "", "Mount point of volume 0, nothing is mounted if empty")
+	cmd.Flags().StringVar(&fsType, "fstype", "ext4", "Filesystem type (ext4, xfs, etc.)")
+	cmd.Flags().StringSliceVar(&services, "service", nil, "systemd unit to start after mounting, in order (repeatable)")
+	cmd.Flags().BoolVar(&force, "force", false, "Activate even if the node fails the verification")
+	cmd.MarkFlagRequired("node")
+
+	return cmd
+}
+
+func resourceDeactivate() *cobra.Command {
+	return &cobra.Command{
+		Use:   "deactivate <resource>",
+		Short: "Reverse the activation of a resource",
+		Long: `Reverse sds resource activate: stop the services in reverse order, unmount
+the volume and demote the resource. Stops at the first failed step, the
+resource is never demoted while it is in use.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.DeactivateResource(ctx, args[0])
+			if resp != nil {
+				printActivationSteps(fmt.Sprintf("Deactivation of %s on %s", args[0], resp.Node), resp.Steps)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to deactivate resource: %w", err)
+			}
+			fmt.Println(resp.Message)
+			return nil
+		},
+	}
+}
+
+// printActivationSteps prints the checklist of an activation or deactivation
+func printActivationSteps(title string, steps []*v1.ActivationStep) {
+	if len(steps) == 0 {
+		return
+	}
+	fmt.Println(title)
+	for _, step := range steps {
+		outcome := "PASS"
+		if !step.Passed {
+			outcome = "FAIL"
+		}
+		fmt.Printf("  %s  %-20s  %s\n", outcome, step.Name, step.Detail)
+	}
+}
@@ -1,10 +1,13 @@
 package main
 
 import (
-	"context"
 	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
 	"time"
 
+	v1 "github.com/liliang-cn/sds/api/proto/v1"
 	"github.com/liliang-cn/sds/pkg/client"
 	"github.com/spf13/cobra"
 )
@@ -19,6 +22,8 @@ func snapshotCommand() *cobra.Command {
 	cmd.AddCommand(snapshotDelete())
 	cmd.AddCommand(snapshotRestore())
 	cmd.AddCommand(snapshotList())
+	cmd.AddCommand(snapshotUsage())
+	cmd.AddCommand(snapshotGroups())
 
 	return cmd
 }
@@ -27,11 +32,53 @@ func snapshotCreate() *cobra.Command {
 	var volume string
 	var name string
 	var node string
+	var resources []string
 
 	cmd := &cobra.Command{
 		Use:   "create",
-		Short: "Create a snapshot of a volume",
+		Short: "Create a snapshot of a volume, or of several resources under a label",
+		Long: `Create a snapshot of a volume on a node, or with --resources snapshot several
+resources in parallel under a shared label. The snapshot of each resource is
+named <resource>_<label>; the group is deleted or restored as a whole with
+--label.
+
+Example:
+  sds snapshot create --volume ubuntu-vg/lv0 --name lv0_snap --node orange1
+  sds snapshot create --resources r1,r2,r3 --name nightly`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(resources) > 0 {
+				if volume != "" {
+					return fmt.Errorf("--volume and --resources are exclusive")
+				}
+				if name == "" {
+					return fmt.Errorf("snapshot name is required")
+				}
+
+				ctx, cancel := commandContext()
+				defer cancel()
+
+				sdsClient, err := client.NewSDSClient(controllerAddr)
+				if err != nil {
+					return fmt.Errorf("failed to connect to controller: %w", err)
+				}
+				defer sdsClient.Close()
+
+				progressCtx, stopProgress := watchProgress(ctx, sdsClient)
+				results, err := sdsClient.CreateSnapshotGroup(progressCtx, &v1.CreateSnapshotGroupRequest{
+					Name:      name,
+					Resources: resources,
+					Node:      node,
+				})
+				stopProgress()
+				printSnapshotGroupResults(results)
+				if err != nil {
+					return fmt.Errorf("failed to create snapshot group: %w", err)
+				}
+
+				fmt.Printf("Snapshot group '%s' created for %d resources\n", name, len(results))
+				return nil
+			}
+
 			if volume == "" {
 				return fmt.Errorf("volume name is required")
 			}
@@ -42,7 +89,7 @@ func snapshotCreate() *cobra.Command {
 				return fmt.Errorf("node name is required")
 			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -51,7 +98,7 @@ func snapshotCreate() *cobra.Command {
 			}
 			defer sdsClient.Close()
 
-			err = sdsClient.CreateSnapshot(ctx, volume, name, node)
+			err = sdsClient.CreateSnapshot(ctx, volume, name, node, "", false)
 			if err != nil {
 				return fmt.Errorf("failed to create snapshot: %w", err)
 			}
@@ -62,12 +109,11 @@ func snapshotCreate() *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&volume, "volume", "", "Volume name (vg/lv format)")
-	cmd.Flags().StringVar(&name, "name", "", "Snapshot name")
-	cmd.Flags().StringVar(&node, "node", "", "Node name")
+	cmd.Flags().StringVar(&name, "name", "", "Snapshot name, the label with --resources")
+	cmd.Flags().StringVar(&node, "node", "", "Node name, all nodes of each resource with --resources if empty")
+	cmd.Flags().StringSliceVar(&resources, "resources", nil, "Snapshot these resources in parallel under the label of --name (comma-separated)")
 
-	cmd.MarkFlagRequired("volume")
 	cmd.MarkFlagRequired("name")
-	cmd.MarkFlagRequired("node")
 
 	return cmd
 }
@@ -76,11 +122,34 @@ func snapshotDelete() *cobra.Command {
 	var volume string
 	var name string
 	var node string
+	var label string
 
 	cmd := &cobra.Command{
 		Use:   "delete",
-		Short: "Delete a snapshot",
+		Short: "Delete a snapshot, or the snapshots of a label",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if label != "" {
+				ctx, cancel := commandContext()
+				defer cancel()
+
+				sdsClient, err := client.NewSDSClient(controllerAddr)
+				if err != nil {
+					return fmt.Errorf("failed to connect to controller: %w", err)
+				}
+				defer sdsClient.Close()
+
+				progressCtx, stopProgress := watchProgress(ctx, sdsClient)
+				results, err := sdsClient.DeleteSnapshotGroup(progressCtx, label)
+				stopProgress()
+				printSnapshotGroupResults(results)
+				if err != nil {
+					return fmt.Errorf("failed to delete snapshot group: %w", err)
+				}
+
+				fmt.Printf("Snapshot group '%s' deleted\n", label)
+				return nil
+			}
+
 			if volume == "" {
 				return fmt.Errorf("volume name is required")
 			}
@@ -91,7 +160,7 @@ func snapshotDelete() *cobra.Command {
 				return fmt.Errorf("node name is required")
 			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -113,10 +182,7 @@ func snapshotDelete() *cobra.Command {
 	cmd.Flags().StringVar(&volume, "volume", "", "Volume name (vg/lv format)")
 	cmd.Flags().StringVar(&name, "name", "", "Snapshot name")
 	cmd.Flags().StringVar(&node, "node", "", "Node name")
-
-	cmd.MarkFlagRequired("volume")
-	cmd.MarkFlagRequired("name")
-	cmd.MarkFlagRequired("node")
+	cmd.Flags().StringVar(&label, "label", "", "Delete the snapshots of all resources of this label")
 
 	return cmd
 }
@@ -125,16 +191,42 @@ func snapshotRestore() *cobra.Command {
 	var volume string
 	var name string
 	var node string
+	var noSafetySnapshot bool
+	var label string
 
 	cmd := &cobra.Command{
 		Use:   "restore",
 		Short: "Restore a snapshot to its source volume (merges snapshot back)",
 		Long: `Restore a snapshot by merging it back into the source volume.
-This operation requires the volume to be unmounted first.
+This operation requires the volume to be unmounted first. With --label the
+snapshots of all resources of the label are restored in parallel.
 
 Example:
-  sds-cli snapshot restore --volume ubuntu-vg/lv0 --name lv0_snap --node orange1`,
+  sds-cli snapshot restore --volume ubuntu-vg/lv0 --name lv0_snap --node orange1
+  sds-cli snapshot restore --label nightly`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if label != "" {
+				ctx, cancel := commandContext()
+				defer cancel()
+
+				sdsClient, err := client.NewSDSClient(controllerAddr)
+				if err != nil {
+					return fmt.Errorf("failed to connect to controller: %w", err)
+				}
+				defer sdsClient.Close()
+
+				progressCtx, stopProgress := watchProgress(ctx, sdsClient)
+				results, err := sdsClient.RestoreSnapshotGroup(progressCtx, label, noSafetySnapshot)
+				stopProgress()
+				printSnapshotGroupResults(results)
+				if err != nil {
+					return fmt.Errorf("failed to restore snapshot group: %w", err)
+				}
+
+				fmt.Printf("Snapshot group '%s' restored\n", label)
+				return nil
+			}
+
 			if volume == "" {
 				return fmt.Errorf("volume name is required")
 			}
@@ -145,7 +237,7 @@ Example:
 				return fmt.Errorf("node name is required")
 			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -154,7 +246,9 @@ Example:
 			}
 			defer sdsClient.Close()
 
-			err = sdsClient.RestoreSnapshot(ctx, volume, name, node)
+			progressCtx, stopProgress := watchProgress(ctx, sdsClient)
+			err = sdsClient.RestoreSnapshot(progressCtx, volume, name, node, noSafetySnapshot)
+			stopProgress()
 			if err != nil {
 				return fmt.Errorf("failed to restore snapshot: %w", err)
 			}
@@ -168,10 +262,8 @@ Example:
 	cmd.Flags().StringVar(&volume, "volume", "", "Volume name (vg/lv format)")
 	cmd.Flags().StringVar(&name, "name", "", "Snapshot name")
 	cmd.Flags().StringVar(&node, "node", "", "Node name")
-
-	cmd.MarkFlagRequired("volume")
-	cmd.MarkFlagRequired("name")
-	cmd.MarkFlagRequired("node")
+	cmd.Flags().BoolVar(&noSafetySnapshot, "no-safety-snapshot", false, "Skip the automatic safety snapshot taken before the operation")
+	cmd.Flags().StringVar(&label, "label", "", "Restore the snapshots of all resources of this label")
 
 	return cmd
 }
@@ -191,7 +283,7 @@ func snapshotList() *cobra.Command {
 				return fmt.Errorf("node name is required")
 			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := commandContext()
 			defer cancel()
 
 			sdsClient, err := client.NewSDSClient(controllerAddr)
@@ -229,3 +321,168 @@ func snapshotList() *cobra.Command {
 
 	return cmd
 }
+
+func snapshotUsage() *cobra.Command {
+	var node string
+
+	cmd := &cobra.Command{
+		Use:   "usage <resource>",
+		Short: "Show per-snapshot space usage of a resource across its nodes",
+		Long: `Show how much space each snapshot of a resource uses on each node.
+
+  USED   space unique to the snapshot (ZFS used, allocated COW for LVM)
+  REFER  data the snapshot refers to (ZFS refer, origin size for LVM)
+  COW%   allocated part of the COW volume of thick LVM snapshots
+
+Thick LVM snapshots are invalidated once their COW volume is 100% allocated;
+snapshots above 80% are flagged and recorded in the events log.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resource := args[0]
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			usage, err := sdsClient.GetSnapshotUsage(ctx, resource, node)
+			if err != nil {
+				return fmt.Errorf("failed to get snapshot usage: %w", err)
+			}
+
+			if len(usage) == 0 {
+				fmt.Printf("No snapshots found for '%s'\n", resource)
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "SNAPSHOT\tVOLUME\tNODE\tBACKEND\tUSED\tREFER\tCOW%\tSTATE")
+
+			totals := make(map[string]uint64)
+			var names []string
+			var total uint64
+			var alerts []string
+			for _, u := range usage {
+				cow := "-"
+				if u.SizeBytes > 0 {
+					cow = fmt.Sprintf("%.1f%%", u.CowUsedPercent)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+					u.Name, u.Volume, u.Node, u.Backend,
+					formatBytes(u.UsedBytes), formatBytes(u.ReferencedBytes), cow, u.State)
+
+				if _, ok := totals[u.Name]; !ok {
+					names = append(names, u.Name)
+				}
+				totals[u.Name] += u.UsedBytes
+				total += u.UsedBytes
+
+				switch u.State {
+				case "invalid":
+					alerts = append(alerts, fmt.Sprintf("snapshot %s on %s is INVALID (COW volume overflowed), delete and recreate it", u.Name, u.Node))
+				case "warning", "critical":
+					alerts = append(alerts, fmt.Sprintf("snapshot %s on %s COW volume is %.1f%% full, extend it with lvextend or delete it", u.Name, u.Node, u.CowUsedPercent))
+				}
+			}
+			w.Flush()
+
+			fmt.Println()
+			fmt.Println("Unique space per snapshot (all nodes):")
+			for _, name := range names {
+				fmt.Printf("  %-20s %s\n", name, formatBytes(totals[name]))
+			}
+			fmt.Printf("  %-20s %s\n", "total", formatBytes(total))
+
+			if len(alerts) > 0 {
+				fmt.Println()
+				for _, alert := range alerts {
+					fmt.Printf("WARNING: %s\n", alert)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&node, "node", "", "Only show snapshots on this node")
+
+	return cmd
+}
+
+func snapshotGroups() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "groups",
+		Short: "List the labels of snapshots taken with --resources",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			groups, err := sdsClient.ListSnapshotGroups(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list snapshot groups: %w", err)
+			}
+
+			if len(groups) == 0 {
+				fmt.Println("No snapshot groups found")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "LABEL\tRESOURCES\tNODE\tJOB\tCREATED")
+			for _, group := range groups {
+				node := group.Node
+				if node == "" {
+					node = "all"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n", group.Name, strings.Join(group.Resources, ","), node,
+					group.JobId, time.Unix(group.CreatedAt, 0).Format("2006-01-02 15:04:05"))
+			}
+			w.Flush()
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// printSnapshotGroupResults prints the outcome of a snapshot group operation
+// on each resource
+func printSnapshotGroupResults(results []*v1.SnapshotGroupResult) {
+	if len(results) == 0 {
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "RESOURCE\tSNAPSHOT\tRESULT")
+	for _, result := range results {
+		outcome := "ok"
+		if result.Error != "" {
+			outcome = "FAILED: " + result.Error
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", result.Resource, result.Snapshot, outcome)
+	}
+	w.Flush()
+}
+
+// formatBytes formats a byte count as a human-readable string
+func formatBytes(b uint64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+	div, exp := uint64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/client"
+	"github.com/liliang-cn/sds/pkg/version"
+	"github.com/spf13/cobra"
+)
+
+func versionCommand() *cobra.Command {
+	var cluster bool
+
+	cmd := &cobra.Command{
+		Use:   "version",
+		Short: "Show the version of the CLI, and of the controller and nodes",
+		Long: `Show the version of the CLI.
+
+With --cluster, also show the build of the controller and the versions of the
+storage stack of every node: DRBD kernel module, drbd-utils, drbd-reactor, LVM
+and ZFS. The controller collects them from the nodes on every call; nodes it
+cannot reach are marked stale and show the versions last collected. Include
+the output in support requests and bug reports.
+
+--cluster here takes no value; select the cluster of a controller serving
+several with $SDS_CLUSTER.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			build := version.Get()
+			fmt.Printf("CLI:        %s (commit %s, built %s, %s %s)\n",
+				build.Version, valueOr(build.Commit, "unknown"), valueOr(build.BuildDate, "unknown"), build.GoVersion, build.Platform)
+			if !cluster {
+				return nil
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			info, err := sdsClient.GetClusterInfo(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get cluster info: %w", err)
+			}
+
+			if c := info.Controller; c != nil {
+				fmt.Printf("Controller: %s (commit %s, built %s, %s %s)\n",
+					c.Version, valueOr(c.Commit, "unknown"), valueOr(c.BuildDate, "unknown"), c.GoVersion, c.Platform)
+			}
+			if c := info.Cluster; c != nil {
+				fmt.Printf("Cluster:    %s (%s), %d nodes, %d resources\n", c.Name, c.Uuid, c.Nodes, c.Resources)
+			}
+			if len(info.Nodes) == 0 {
+				return nil
+			}
+
+			fmt.Println()
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tADDRESS\tSTATE\tDRBD\tDRBD-UTILS\tDRBD-REACTOR\tLVM\tZFS\tCHECKED")
+			var warnings []string
+			for _, n := range info.Nodes {
+				checked := "never"
+				if n.CheckedAt > 0 {
+					checked = time.Unix(n.CheckedAt, 0).Format(time.RFC3339)
+				}
+				if n.Stale {
+					checked += " (stale)"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+					n.Name, n.Address, n.State,
+					valueOr(n.DrbdKernelVersion, "-"), valueOr(n.DrbdUtilsVersion, "-"),
+					valueOr(n.DrbdReactorVersion, "-"), valueOr(n.LvmVersion, "-"),
+					valueOr(n.ZfsVersion, "-"), checked)
+				for _, warning := range n.Warnings {
+					warnings = append(warnings, fmt.Sprintf("%s: %s", n.Name, warning))
+				}
+			}
+			w.Flush()
+
+			if len(warnings) > 0 {
+				fmt.Printf("\nWarnings:\n  %s\n", strings.Join(warnings, "\n  "))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cluster, "cluster", false, "Also show the controller build and the component versions of the nodes")
+
+	return cmd
+}
+
+// valueOr returns value, or fallback if it is empty
+func valueOr(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func vipCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vip",
+		Short: "Show the service IPs of HA configs and gateways",
+	}
+
+	cmd.AddCommand(vipList())
+
+	return cmd
+}
+
+func vipList() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List VIPs in use and the IPAM pool usage",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			resp, err := sdsClient.ListVIPs(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list VIPs: %w", err)
+			}
+
+			if len(resp.Vips) == 0 {
+				fmt.Println("No VIPs in use")
+			} else {
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+				fmt.Fprintln(w, "VIP\tOWNER\tPOOL\tCREATED")
+				for _, vip := range resp.Vips {
+					pool := vip.Pool
+					if pool == "" {
+						pool = "-"
+					}
+					fmt.Fprintf(w, "%s/%d\t%s\t%s\t%s\n", vip.Address, vip.Prefix, vip.Owner, pool,
+						time.Unix(vip.CreatedAt, 0).Format("2006-01-02 15:04:05"))
+				}
+				w.Flush()
+			}
+
+			if len(resp.Pools) > 0 {
+				fmt.Println()
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+				fmt.Fprintln(w, "POOL\tRANGE\tUSED\tTOTAL")
+				for _, pool := range resp.Pools {
+					fmt.Fprintf(w, "%s\t%s\t%d\t%d\n", pool.Name, pool.Range, pool.Used, pool.Total)
+				}
+				w.Flush()
+			}
+
+			return nil
+		},
+	}
+}
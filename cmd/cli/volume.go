@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func volumeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "volume",
+		Short: "Inspect DRBD volumes and their backing devices",
+	}
+
+	cmd.AddCommand(volumeList())
+	cmd.AddCommand(volumeGet())
+
+	return cmd
+}
+
+func volumeList() *cobra.Command {
+	var resource string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List volumes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			volumes, err := sdsClient.ListVolumes(ctx, resource)
+			if err != nil {
+				return fmt.Errorf("failed to list volumes: %w", err)
+			}
+
+			if len(volumes) == 0 {
+				fmt.Println("No volumes found")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "RESOURCE\tVOLUME\tNAME\tDEVICE\tSIZE\tPOOL\tTYPE")
+			for _, v := range volumes {
+				fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%s\n",
+					v.Resource, v.VolumeId, v.Name, v.Device, formatVolumeSize(v), v.Pool, v.StorageType)
+			}
+			w.Flush()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&resource, "resource", "", "Only show volumes of this resource")
+
+	return cmd
+}
+
+func volumeGet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <resource> <volume-id>",
+		Short: "Show a volume and its backing device on each node",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			volumeID, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid volume ID: %s", args[1])
+			}
+
+			ctx, cancel := commandContext()
+			defer cancel()
+
+			sdsClient, err := client.NewSDSClient(controllerAddr)
+			if err != nil {
+				return fmt.Errorf("failed to connect to controller: %w", err)
+			}
+			defer sdsClient.Close()
+
+			v, err := sdsClient.GetVolume(ctx, args[0], uint32(volumeID))
+			if err != nil {
+				return fmt.Errorf("failed to get volume: %w", err)
+			}
+
+			fmt.Printf("Volume: %s/%d\n", v.Resource, v.VolumeId)
+			fmt.Printf("  ID:           %d\n", v.Id)
+			fmt.Printf("  Name:         %s\n", v.Name)
+			fmt.Printf("  Device:       %s\n", v.Device)
+			fmt.Printf("  Size:         %s\n", formatVolumeSize(v))
+			fmt.Printf("  Pool:         %s\n", v.Pool)
+			fmt.Printf("  Storage Type: %s\n", v.StorageType)
+			fmt.Printf("  Created:      %s\n", time.Unix(v.CreatedAt, 0).Format("2006-01-02 15:04:05"))
+			if len(v.Backing) > 0 {
+				fmt.Printf("  Backing devices:\n")
+				for _, b := range v.Backing {
+					fmt.Printf("    %s: %s\n", b.Node, b.Device)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/liliang-cn/sds/pkg/config"
+)
+
+// controllerUnitName is the systemd service of the controller
+const controllerUnitName = "sds-controller.service"
+
+// runInstall installs the controller on this node: directories, binary,
+// default config and a hardened systemd unit, then registers the service
+func runInstall(args []string) error {
+	fs := flag.NewFlagSet("install", flag.ExitOnError)
+	prefix := fs.String("prefix", "/opt/sds", "Installation directory (binary in <prefix>/bin)")
+	configPath := fs.String("config", "/etc/sds/controller.toml", "Configuration file, created with defaults if missing")
+	unitDir := fs.String("unit-dir", "/etc/systemd/system", "systemd unit directory")
+	noStart := fs.Bool("no-start", false, "Enable the service without starting it")
+	dryRun := fs.Bool("dry-run", false, "Print the systemd unit and exit")
+	fs.Parse(args)
+
+	binary := filepath.Join(*prefix, "bin", "sds-controller")
+	unit := generateControllerUnit(*prefix, binary, *configPath)
+	if *dryRun {
+		fmt.Print(unit)
+		return nil
+	}
+	if os.Geteuid() != 0 {
+		return fmt.Errorf("install must run as root")
+	}
+
+	// The database and the secrets master key must not be readable by others
+	dirs := []struct {
+		path string
+		mode os.FileMode
+	}{
+		{filepath.Join(*prefix, "bin"), 0755},
+		{filepath.Dir(*configPath), 0750},
+		{"/var/lib/sds", 0700},
+	}
+	for _, d := range dirs {
+		if err := os.MkdirAll(d.path, d.mode); err != nil {
+			return fmt.Errorf("failed to create %s: %w", d.path, err)
+		}
+		if err := os.Chmod(d.path, d.mode); err != nil {
+			return fmt.Errorf("failed to set permissions of %s: %w", d.path, err)
+		}
+		fmt.Printf("Directory  %s\n", d.path)
+	}
+
+	self, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to locate the running binary: %w", err)
+	}
+	if self, err = filepath.EvalSymlinks(self); err != nil {
+		return fmt.Errorf("failed to locate the running binary: %w", err)
+	}
+	if self != binary {
+		if err := copyFile(self, binary, 0755); err != nil {
+			return fmt.Errorf("failed to install binary: %w", err)
+		}
+	}
+	fmt.Printf("Binary     %s\n", binary)
+
+	if _, err := os.Stat(*configPath); os.IsNotExist(err) {
+		if err := os.WriteFile(*configPath, []byte(config.DefaultTOML), 0640); err != nil {
+			return fmt.Errorf("failed to write %s: %w", *configPath, err)
+		}
+		fmt.Printf("Config     %s (defaults)\n", *configPath)
+	} else {
+		fmt.Printf("Config     %s (kept)\n", *configPath)
+	}
+
+	unitPath := filepath.Join(*unitDir, controllerUnitName)
+	if err := os.WriteFile(unitPath, []byte(unit), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", unitPath, err)
+	}
+	fmt.Printf("Unit       %s\n", unitPath)
+
+	if err := systemctl("daemon-reload"); err != nil {
+		return err
+	}
+	if err := systemctl("enable", controllerUnitName); err != nil {
+		return err
+	}
+	if *noStart {
+		fmt.Printf("Service    %s enabled\n", controllerUnitName)
+		return nil
+	}
+	// Restart so a reinstall picks up the new binary
+	if err := systemctl("restart", controllerUnitName); err != nil {
+		return err
+	}
+	fmt.Printf("Service    %s enabled and started\n", controllerUnitName)
+	return nil
+}
+
+// generateControllerUnit generates the hardened systemd unit of the controller.
+// The controller runs storage commands (LVM, ZFS, DRBD) on its own node like
+// on any other, so devices, /etc and the kernel interfaces stay accessible.
+func generateControllerUnit(prefix, binary, configPath string) string {
+	return fmt.Sprintf(`[Unit]
+Description=SDS Controller
+Documentation=https://github.com/liliang-cn/sds
+After=network-online.target
+Wants=network-online.target
+
+[Service]
+Type=simple
+User=root
+Environment="HOME=/root"
+WorkingDirectory=%s
+ExecStartPre=%s --validate-config --config %s
+ExecStart=%s --config %s
+Restart=on-failure
+RestartSec=5s
+LimitNOFILE=65536
+
+# Hardening
+UMask=0077
+PrivateTmp=yes
+ProtectHome=read-only
+ProtectClock=yes
+ProtectHostname=yes
+ProtectKernelLogs=yes
+ProtectControlGroups=yes
+RestrictRealtime=yes
+LockPersonality=yes
+
+# Logging
+StandardOutput=journal
+StandardError=journal
+SyslogIdentifier=sds-controller
+
+[Install]
+WantedBy=multi-user.target
+`, prefix, binary, configPath, binary, configPath)
+}
+
+// systemctl runs a systemctl command
+func systemctl(args ...string) error {
+	out, err := exec.Command("systemctl", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("systemctl %v failed: %s", args, out)
+	}
+	return nil
+}
+
+// copyFile copies a file through a temporary file, so a running binary can be replaced
+func copyFile(src, dst string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	tmp := dst + ".tmp"
+	out, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, dst)
+}
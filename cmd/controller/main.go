@@ -12,16 +12,36 @@ import (
 
 	"github.com/liliang-cn/sds/pkg/config"
 	"github.com/liliang-cn/sds/pkg/controller"
-)
-
-var (
-	version = "dev"
+	"github.com/liliang-cn/sds/pkg/version"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "install" {
+		if err := runInstall(os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "Install failed: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	configPath := flag.String("config", "", "Path to configuration file")
+	validateConfig := flag.Bool("validate-config", false, "Validate the configuration and exit")
+	printDefaultConfig := flag.Bool("print-default-config", false, "Print the documented default configuration and exit")
+	printVersion := flag.Bool("version", false, "Print the version and exit")
 	flag.Parse()
 
+	build := version.Get()
+	if *printVersion {
+		fmt.Printf("sds-controller %s (commit %s, built %s, %s %s)\n",
+			build.Version, build.Commit, build.BuildDate, build.GoVersion, build.Platform)
+		return
+	}
+
+	if *printDefaultConfig {
+		fmt.Print(config.DefaultTOML)
+		return
+	}
+
 	// Load configuration
 	cfg, err := config.Load(*configPath)
 	if err != nil {
@@ -29,6 +49,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	if *validateConfig {
+		fmt.Println("Configuration is valid")
+		return
+	}
+
 	// Initialize logger
 	logger, err := initLogger(cfg)
 	if err != nil {
@@ -38,7 +63,8 @@ func main() {
 	defer logger.Sync()
 
 	logger.Info("Starting SDS controller",
-		zap.String("version", version),
+		zap.String("version", build.Version),
+		zap.String("commit", build.Commit),
 		zap.String("config", *configPath),
 		zap.Bool("metrics_enabled", cfg.Metrics.Enabled),
 		zap.String("metrics_address", fmt.Sprintf("%s:%d", cfg.Metrics.ListenAddress, cfg.Metrics.Port)))
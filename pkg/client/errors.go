@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// failureTrailer is the trailer in which the controller returns the remote
+// commands that failed during a failed call
+const failureTrailer = "x-sds-failure-bin"
+
+// troubleshootingURL is the guide the hints of typed errors link to
+const troubleshootingURL = "https://github.com/liliang-cn/sds/blob/main/docs/troubleshooting.md"
+
+// ErrorKind classifies a common failure of a controller call
+type ErrorKind string
+
+// Error kinds
+const (
+	ErrControllerUnreachable ErrorKind = "controller-unreachable"
+	ErrNotFound              ErrorKind = "not-found"
+	ErrNodeOffline           ErrorKind = "node-offline"
+	ErrQuorumLost            ErrorKind = "quorum-lost"
+	ErrHostKeyUntrusted      ErrorKind = "host-key-untrusted"
+)
+
+// errorHints tells how to fix each kind of failure
+var errorHints = map[ErrorKind]string{
+	ErrControllerUnreachable: "Check that sds-controller is running (systemctl status sds-controller) " +
+		"and that --controller points at its gRPC address.",
+	ErrNotFound: "Check the name; the list commands, e.g. 'sds resource list' or 'sds node list', " +
+		"show what exists.",
+	ErrNodeOffline: "Check that the node is up and that the controller reaches it over SSH; " +
+		"'sds node list' shows its state. Retry once the node is back.",
+	ErrQuorumLost: "The resource lost quorum and refuses writes. Bring enough of its nodes back online; " +
+		"'sds resource status <resource>' shows the disconnected peers.",
+	ErrHostKeyUntrusted: "The controller does not trust the SSH host key of the node. Pin it with " +
+		"'sds node trust <node>'; if the key changed, check why before passing --replace.",
+}
+
+// Error is a failed controller call with a hint how to fix it
+type Error struct {
+	Kind     ErrorKind // Empty if the failure is of no known kind
+	Err      error
+	Failures []string // Remote commands that failed, "node: step `command` exited N: output"
+}
+
+// Error returns the message of the underlying error
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Hint tells how to fix the failure, empty if its kind is unknown
+func (e *Error) Hint() string {
+	return errorHints[e.Kind]
+}
+
+// DocURL links to the documentation of the failure, empty if its kind is
+// unknown
+func (e *Error) DocURL() string {
+	if e.Kind == "" {
+		return ""
+	}
+	return troubleshootingURL + "#" + string(e.Kind)
+}
+
+// Explain classifies an error returned by the client and attaches the remote
+// commands that failed during the last call
+func Explain(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	return &Error{Kind: classifyError(err), Err: err, Failures: LastFailures()}
+}
+
+// classifyError returns the kind of an error, empty if it is unknown
+func classifyError(err error) ErrorKind {
+	switch status.Code(err) {
+	case codes.Unavailable:
+		return ErrControllerUnreachable
+	case codes.NotFound:
+		return ErrNotFound
+	}
+
+	message := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(message, "host key unknown"),
+		strings.Contains(message, "host key changed"):
+		return ErrHostKeyUntrusted
+	case strings.Contains(message, "quorum"):
+		return ErrQuorumLost
+	case strings.Contains(message, "offline"),
+		strings.Contains(message, "no route to host"),
+		strings.Contains(message, "host is down"),
+		strings.Contains(message, "i/o timeout"),
+		strings.Contains(message, "ssh: handshake failed"),
+		strings.Contains(message, "connection refused"):
+		return ErrNodeOffline
+	case strings.Contains(message, "not found"),
+		strings.Contains(message, "not registered"),
+		strings.Contains(message, "does not exist"):
+		return ErrNotFound
+	}
+	return ""
+}
+
+// lastFailures holds the failed remote commands of the last call
+var lastFailures struct {
+	sync.Mutex
+	failures []string
+}
+
+// LastFailures returns the remote commands that failed during the last call
+// of any client, as reported by the controller
+func LastFailures() []string {
+	lastFailures.Lock()
+	defer lastFailures.Unlock()
+	return lastFailures.failures
+}
+
+// failureInterceptor keeps the failed remote commands the controller returns
+// with a call
+func failureInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var trailer metadata.MD
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Trailer(&trailer))...)
+
+	lastFailures.Lock()
+	lastFailures.failures = trailer.Get(failureTrailer)
+	lastFailures.Unlock()
+	return err
+}
@@ -3,14 +3,91 @@ package client
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"time"
 
 	sdspb "github.com/liliang-cn/sds/api/proto/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
+// adminTokenHeader is the metadata key carrying the admin token
+const adminTokenHeader = "x-sds-admin-token"
+
+// WithAdminToken returns a context whose calls present the admin token
+// required by admin operations
+func WithAdminToken(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, adminTokenHeader, token)
+}
+
+// clusterHeader is the metadata key selecting the cluster of a call on a
+// controller serving several clusters
+const clusterHeader = "x-sds-cluster"
+
+// defaultCluster is the cluster clients created by NewSDSClient address
+var defaultCluster string
+
+// SetCluster makes clients created afterwards address the named cluster of a
+// controller serving several clusters, "" its default cluster
+func SetCluster(name string) {
+	defaultCluster = name
+}
+
+// WithCluster returns a context whose calls address the named cluster
+func WithCluster(ctx context.Context, name string) context.Context {
+	if name == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, clusterHeader, name)
+}
+
+// apiKeyHeader is the metadata key carrying the API key of the caller
+const apiKeyHeader = "x-sds-api-key"
+
+// defaultAPIKey is the API key clients created by NewSDSClient present
+var defaultAPIKey string
+
+// SetAPIKey makes clients created afterwards present the API key of a
+// principal, required once the controller has auth.keys
+func SetAPIKey(key string) {
+	defaultAPIKey = key
+}
+
+// headerInterceptor adds a metadata value to calls that did not set one
+func headerInterceptor(header, value string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if md, ok := metadata.FromOutgoingContext(ctx); !ok || len(md.Get(header)) == 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, header, value)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// headerStreamInterceptor adds a metadata value to streams that did not set
+// one
+func headerStreamInterceptor(header, value string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if md, ok := metadata.FromOutgoingContext(ctx); !ok || len(md.Get(header)) == 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, header, value)
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+// progressHeader is the metadata key carrying the progress id of a call
+const progressHeader = "x-sds-progress-id"
+
+// progressGrace is how long a progress watch waits for the last events of
+// a job after its call returned
+const progressGrace = time.Second
+
 // SDSClient wraps SDS controller gRPC client
 type SDSClient struct {
 	conn   *grpc.ClientConn
@@ -23,12 +100,26 @@ func NewSDSClient(addr string) (*SDSClient, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	conn, err := grpc.DialContext(ctx, addr,
+	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithBlock(),
-	)
+		grpc.WithChainUnaryInterceptor(failureInterceptor),
+	}
+	if defaultCluster != "" {
+		opts = append(opts, grpc.WithChainUnaryInterceptor(headerInterceptor(clusterHeader, defaultCluster)),
+			grpc.WithChainStreamInterceptor(headerStreamInterceptor(clusterHeader, defaultCluster)))
+	}
+	if defaultAPIKey != "" {
+		opts = append(opts, grpc.WithChainUnaryInterceptor(headerInterceptor(apiKeyHeader, defaultAPIKey)),
+			grpc.WithChainStreamInterceptor(headerStreamInterceptor(apiKeyHeader, defaultAPIKey)))
+	}
+
+	conn, err := grpc.DialContext(ctx, addr, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to SDS controller at %s: %w", addr, err)
+		return nil, &Error{
+			Kind: ErrControllerUnreachable,
+			Err:  fmt.Errorf("failed to connect to SDS controller at %s: %w", addr, err),
+		}
 	}
 
 	return &SDSClient{
@@ -48,6 +139,35 @@ func (c *SDSClient) Address() string {
 	return c.addr
 }
 
+// ListClusters lists the clusters served by the controller
+func (c *SDSClient) ListClusters(ctx context.Context) ([]*sdspb.ClusterInfo, error) {
+	resp, err := c.client.ListClusters(ctx, &sdspb.ListClustersRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Clusters, nil
+}
+
+// GetClusterInfo returns the build of the controller and the component
+// versions of the nodes of the cluster
+func (c *SDSClient) GetClusterInfo(ctx context.Context) (*sdspb.GetClusterInfoResponse, error) {
+	resp, err := c.client.GetClusterInfo(ctx, &sdspb.GetClusterInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
 // ==================== POOL OPERATIONS ====================
 
 // CreatePool creates a storage pool
@@ -66,7 +186,7 @@ func (c *SDSClient) CreatePool(ctx context.Context, name, poolType, node string,
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -85,7 +205,7 @@ func (c *SDSClient) GetPool(ctx context.Context, name, node string) (*sdspb.Pool
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf(resp.Message)
+		return nil, fmt.Errorf("%s", resp.Message)
 	}
 
 	return resp.Pool, nil
@@ -101,7 +221,7 @@ func (c *SDSClient) ListPools(ctx context.Context) ([]*sdspb.PoolInfo, error) {
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf(resp.Message)
+		return nil, fmt.Errorf("%s", resp.Message)
 	}
 
 	return resp.Pools, nil
@@ -121,12 +241,36 @@ func (c *SDSClient) AddDiskToPool(ctx context.Context, pool, disk, node string)
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
 }
 
+// GetPoolHistory returns the capacity samples of a pool taken since the given
+// time, oldest first. An empty node returns the samples of all nodes, a zero
+// time all samples kept.
+func (c *SDSClient) GetPoolHistory(ctx context.Context, pool, node string, since time.Time) ([]*sdspb.PoolUsageSample, error) {
+	req := &sdspb.GetPoolHistoryRequest{
+		Pool: pool,
+		Node: node,
+	}
+	if !since.IsZero() {
+		req.Since = since.Unix()
+	}
+
+	resp, err := c.client.GetPoolHistory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Samples, nil
+}
+
 // DeletePool deletes a storage pool
 func (c *SDSClient) DeletePool(ctx context.Context, pool, node string) error {
 	req := &sdspb.DeletePoolRequest{
@@ -140,7 +284,7 @@ func (c *SDSClient) DeletePool(ctx context.Context, pool, node string) error {
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -161,12 +305,122 @@ func (c *SDSClient) RegisterNode(ctx context.Context, name, address string) (*sd
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf(resp.Message)
+		return nil, fmt.Errorf("%s", resp.Message)
 	}
 
 	return resp.Node, nil
 }
 
+// SetNodeAddress moves a registered node to a new IP address and rewrites
+// the configs of its resources. The response is also returned when some
+// resources or connections did not recover.
+func (c *SDSClient) SetNodeAddress(ctx context.Context, name, address string, timeout time.Duration) (*sdspb.SetNodeAddressResponse, error) {
+	resp, err := c.client.SetNodeAddress(ctx, &sdspb.SetNodeAddressRequest{
+		Name:           name,
+		Address:        address,
+		TimeoutSeconds: int64(timeout / time.Second),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return resp, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// TrustNode fetches the SSH host key of a node, or pins it once its
+// fingerprint was confirmed
+func (c *SDSClient) TrustNode(ctx context.Context, node, fingerprint string, replace bool) (*sdspb.TrustNodeResponse, error) {
+	resp, err := c.client.TrustNode(ctx, &sdspb.TrustNodeRequest{
+		Node:        node,
+		Fingerprint: fingerprint,
+		Replace:     replace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// HardenNode generates the sudoers policy limiting the SSH user of a node to
+// the controller's commands, and installs it with apply
+func (c *SDSClient) HardenNode(ctx context.Context, node, user string, apply bool) (*sdspb.HardenNodeResponse, error) {
+	resp, err := c.client.HardenNode(ctx, &sdspb.HardenNodeRequest{
+		Node:  node,
+		User:  user,
+		Apply: apply,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// SetNodeMaintenance puts a node under maintenance from from (zero for now)
+// until to
+func (c *SDSClient) SetNodeMaintenance(ctx context.Context, node string, from, to time.Time, reason string) (*sdspb.MaintenanceWindow, error) {
+	req := &sdspb.SetNodeMaintenanceRequest{
+		Node:   node,
+		To:     to.Unix(),
+		Reason: reason,
+	}
+	if !from.IsZero() {
+		req.From = from.Unix()
+	}
+
+	resp, err := c.client.SetNodeMaintenance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Window, nil
+}
+
+// ClearNodeMaintenance ends the maintenance window of a node
+func (c *SDSClient) ClearNodeMaintenance(ctx context.Context, node string) error {
+	resp, err := c.client.ClearNodeMaintenance(ctx, &sdspb.ClearNodeMaintenanceRequest{Node: node})
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// ListMaintenanceWindows lists the maintenance windows that have not expired
+func (c *SDSClient) ListMaintenanceWindows(ctx context.Context) ([]*sdspb.MaintenanceWindow, error) {
+	resp, err := c.client.ListMaintenanceWindows(ctx, &sdspb.ListMaintenanceWindowsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Windows, nil
+}
+
 // ListNodes lists all nodes
 func (c *SDSClient) ListNodes(ctx context.Context) ([]*sdspb.NodeInfo, error) {
 	req := &sdspb.ListNodesRequest{}
@@ -177,7 +431,7 @@ func (c *SDSClient) ListNodes(ctx context.Context) ([]*sdspb.NodeInfo, error) {
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf(resp.Message)
+		return nil, fmt.Errorf("%s", resp.Message)
 	}
 
 	return resp.Nodes, nil
@@ -195,7 +449,7 @@ func (c *SDSClient) UnregisterNode(ctx context.Context, address string) error {
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -213,7 +467,7 @@ func (c *SDSClient) HealthCheck(ctx context.Context, node string) (*NodeHealthIn
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf(resp.Message)
+		return nil, fmt.Errorf("%s", resp.Message)
 	}
 
 	return &NodeHealthInfo{
@@ -224,18 +478,95 @@ func (c *SDSClient) HealthCheck(ctx context.Context, node string) (*NodeHealthIn
 		DrbdReactorRunning:      resp.Health.DrbdReactorRunning,
 		ResourceAgentsInstalled: resp.Health.ResourceAgentsInstalled,
 		AvailableAgents:         resp.Health.AvailableAgents,
+		DrbdKernelVersion:       resp.Health.DrbdKernelVersion,
+		DrbdUtilsVersion:        resp.Health.DrbdUtilsVersion,
+		DrbdVersionWarnings:     resp.Health.DrbdVersionWarnings,
+		LvmVersion:              resp.Health.LvmVersion,
+		ZfsVersion:              resp.Health.ZfsVersion,
+		Time:                    resp.Health.Time,
 	}, nil
 }
 
+// NodeExec runs an ad-hoc command on nodes. It needs a context with the
+// admin token, see WithAdminToken. The results are also returned when the
+// command failed on some nodes.
+func (c *SDSClient) NodeExec(ctx context.Context, nodes []string, command string, timeout time.Duration) (*sdspb.NodeExecResponse, error) {
+	resp, err := c.client.NodeExec(ctx, &sdspb.NodeExecRequest{
+		Nodes:          nodes,
+		Command:        command,
+		TimeoutSeconds: int32(timeout / time.Second),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return resp, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// StreamNodeLogs tails the journal of a node service and calls onLine for
+// every line until the stream ends: at once without follow, otherwise when
+// ctx is cancelled.
+func (c *SDSClient) StreamNodeLogs(ctx context.Context, req *sdspb.StreamNodeLogsRequest, onLine func(*sdspb.NodeLogLine)) error {
+	stream, err := c.client.StreamNodeLogs(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		line, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		onLine(line)
+	}
+}
+
+// PushFile writes a file to nodes and verifies its checksum. It needs a
+// context with the admin token, see WithAdminToken. The results are also
+// returned when the push failed on some nodes.
+func (c *SDSClient) PushFile(ctx context.Context, nodes []string, content []byte, dest string, mode uint32, owner string) (*sdspb.PushFileResponse, error) {
+	resp, err := c.client.PushFile(ctx, &sdspb.PushFileRequest{
+		Nodes:   nodes,
+		Dest:    dest,
+		Content: content,
+		Mode:    mode,
+		Owner:   owner,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return resp, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
 // NodeHealthInfo represents the health status of a node
 type NodeHealthInfo struct {
-	DrbdInstalled           bool     `json:"drbd_installed"`
-	DrbdVersion             string   `json:"drbd_version"`
-	DrbdReactorInstalled    bool     `json:"drbd_reactor_installed"`
-	DrbdReactorVersion      string   `json:"drbd_reactor_version"`
-	DrbdReactorRunning      bool     `json:"drbd_reactor_running"`
-	ResourceAgentsInstalled bool     `json:"resource_agents_installed"`
-	AvailableAgents         []string `json:"available_agents"`
+	DrbdInstalled           bool            `json:"drbd_installed"`
+	DrbdVersion             string          `json:"drbd_version"`
+	DrbdReactorInstalled    bool            `json:"drbd_reactor_installed"`
+	DrbdReactorVersion      string          `json:"drbd_reactor_version"`
+	DrbdReactorRunning      bool            `json:"drbd_reactor_running"`
+	ResourceAgentsInstalled bool            `json:"resource_agents_installed"`
+	AvailableAgents         []string        `json:"available_agents"`
+	DrbdKernelVersion       string          `json:"drbd_kernel_version"`
+	DrbdUtilsVersion        string          `json:"drbd_utils_version"`
+	DrbdVersionWarnings     []string        `json:"drbd_version_warnings,omitempty"`
+	LvmVersion              string          `json:"lvm_version"`
+	ZfsVersion              string          `json:"zfs_version"`
+	Time                    *sdspb.NodeTime `json:"time,omitempty"` // nil if the clock could not be read
 }
 
 // ==================== RESOURCE OPERATIONS ====================
@@ -252,380 +583,1671 @@ func (c *SDSClient) CreateResourceWithPool(ctx context.Context, name string, por
 
 // CreateResourceWithPoolAndType creates a DRBD resource with specified pool and storage type
 func (c *SDSClient) CreateResourceWithPoolAndType(ctx context.Context, name string, port uint32, nodes []string, protocol string, sizeGB uint32, pool string, storageType string, drbdOptions map[string]string) error {
-	req := &sdspb.CreateResourceRequest{
-		Name:         name,
-		Port:         port,
-		Nodes:        nodes,
-		Protocol:     protocol,
-		SizeGb:       sizeGB,
-		Pool:         pool,
-		StorageType:  storageType,
-		DrbdOptions:  drbdOptions,
-	}
+	return c.CreateResourceWithPeerProtocols(ctx, name, port, nodes, protocol, nil, sizeGB, pool, storageType, drbdOptions)
+}
+
+// CreateResourceWithPeerProtocols creates a DRBD resource whose connections
+// to some nodes use their own protocol, e.g. protocol C between local nodes
+// and A to a remote peer given as peerProtocols {"remote": "A"}
+func (c *SDSClient) CreateResourceWithPeerProtocols(ctx context.Context, name string, port uint32, nodes []string, protocol string, peerProtocols map[string]string, sizeGB uint32, pool string, storageType string, drbdOptions map[string]string) error {
+	return c.CreateResourceFromRequest(ctx, &sdspb.CreateResourceRequest{
+		Name:          name,
+		Port:          port,
+		Nodes:         nodes,
+		Protocol:      protocol,
+		SizeGb:        sizeGB,
+		Pool:          pool,
+		StorageType:   storageType,
+		DrbdOptions:   drbdOptions,
+		PeerProtocols: peerProtocols,
+	})
+}
 
+// CreateResourceFromRequest creates a DRBD resource from a complete request,
+// e.g. to reserve metadata peer slots with max_peers
+func (c *SDSClient) CreateResourceFromRequest(ctx context.Context, req *sdspb.CreateResourceRequest) error {
 	resp, err := c.client.CreateResource(ctx, req)
 	if err != nil {
 		return err
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
 }
 
+// CreateRawResource creates a DRBD resource directly on raw block devices,
+// given per node name. The size is taken from the devices.
+func (c *SDSClient) CreateRawResource(ctx context.Context, name string, port uint32, nodes []string, protocol string, devices, drbdOptions map[string]string) error {
+	return c.CreateRawResourceWithPeerProtocols(ctx, name, port, nodes, protocol, nil, devices, drbdOptions)
+}
+
+// CreateRawResourceWithPeerProtocols creates a DRBD resource on raw block
+// devices whose connections to some nodes use their own protocol
+func (c *SDSClient) CreateRawResourceWithPeerProtocols(ctx context.Context, name string, port uint32, nodes []string, protocol string, peerProtocols, devices, drbdOptions map[string]string) error {
+	return c.CreateResourceFromRequest(ctx, &sdspb.CreateResourceRequest{
+		Name:          name,
+		Port:          port,
+		Nodes:         nodes,
+		Protocol:      protocol,
+		StorageType:   "raw",
+		DrbdOptions:   drbdOptions,
+		Devices:       devices,
+		PeerProtocols: peerProtocols,
+	})
+}
+
 // CreateZFSResource creates a DRBD resource with ZFS backend
 func (c *SDSClient) CreateZFSResource(ctx context.Context, name string, port uint32, nodes []string, protocol string, sizeGB uint32, pool string, drbdOptions map[string]string) error {
 	return c.CreateResourceWithPoolAndType(ctx, name, port, nodes, protocol, sizeGB, pool, "zfs", drbdOptions)
 }
 
-// GetResource gets resource information
-func (c *SDSClient) GetResource(ctx context.Context, name string) (*sdspb.ResourceInfo, error) {
-	req := &sdspb.GetResourceRequest{
-		Name: name,
+// SetMaxPeers changes the metadata peer slots of a resource one Secondary at
+// a time. It returns the nodes that were converted and the ones left
+// pending. A zero timeout uses the controller's default resync wait.
+func (c *SDSClient) SetMaxPeers(ctx context.Context, name string, maxPeers uint32, timeout time.Duration) (*sdspb.SetMaxPeersResponse, error) {
+	req := &sdspb.SetMaxPeersRequest{
+		Name:           name,
+		MaxPeers:       maxPeers,
+		TimeoutSeconds: int64(timeout / time.Second),
 	}
 
-	resp, err := c.client.GetResource(ctx, req)
+	resp, err := c.client.SetMaxPeers(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf(resp.Message)
+		return nil, fmt.Errorf("%s", resp.Message)
 	}
 
-	return resp.Resource, nil
+	return resp, nil
 }
 
-// ListResources lists all resources
-func (c *SDSClient) ListResources(ctx context.Context) ([]*sdspb.ResourceInfo, error) {
-	req := &sdspb.ListResourcesRequest{}
+// MigratePool moves the backing volumes of a resource to another pool, node
+// by node; timeout bounds the resync of each node
+func (c *SDSClient) MigratePool(ctx context.Context, name, pool string, timeout time.Duration) (*sdspb.MigratePoolResponse, error) {
+	req := &sdspb.MigratePoolRequest{
+		Name:           name,
+		Pool:           pool,
+		TimeoutSeconds: int64(timeout / time.Second),
+	}
 
-	resp, err := c.client.ListResources(ctx, req)
+	resp, err := c.client.MigratePool(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf(resp.Message)
+		return nil, fmt.Errorf("%s", resp.Message)
 	}
 
-	return resp.Resources, nil
+	return resp, nil
 }
 
-// SetPrimary sets a node as Primary for a resource
-func (c *SDSClient) SetPrimary(ctx context.Context, resource, node string, force bool) error {
-	req := &sdspb.SetPrimaryRequest{
-		Resource: resource,
-		Node:     node,
-		Force:    force,
+// ConvertStorage converts the backing volumes of a resource to another
+// storage type in pool, node by node; timeout bounds the resync of each node
+func (c *SDSClient) ConvertStorage(ctx context.Context, name, storageType, pool string, timeout time.Duration) (*sdspb.ConvertStorageResponse, error) {
+	req := &sdspb.ConvertStorageRequest{
+		Name:           name,
+		StorageType:    storageType,
+		Pool:           pool,
+		TimeoutSeconds: int64(timeout / time.Second),
 	}
 
-	resp, err := c.client.SetPrimary(ctx, req)
+	resp, err := c.client.ConvertStorage(ctx, req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return nil, fmt.Errorf("%s", resp.Message)
 	}
 
-	return nil
+	return resp, nil
 }
 
-// DeleteResource deletes a DRBD resource
-func (c *SDSClient) DeleteResource(ctx context.Context, name string) error {
-	req := &sdspb.DeleteResourceRequest{
-		Name: name,
+// ReplaceDisk replaces the backing devices of a resource on a node with
+// devices, or with volumes created in pool when none are given; timeout
+// bounds the resync
+func (c *SDSClient) ReplaceDisk(ctx context.Context, name, node string, devices []string, pool string, timeout time.Duration) (*sdspb.ReplaceDiskResponse, error) {
+	req := &sdspb.ReplaceDiskRequest{
+		Name:           name,
+		Node:           node,
+		Devices:        devices,
+		Pool:           pool,
+		TimeoutSeconds: int64(timeout / time.Second),
 	}
 
-	resp, err := c.client.DeleteResource(ctx, req)
+	resp, err := c.client.ReplaceDisk(ctx, req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return nil, fmt.Errorf("%s", resp.Message)
 	}
 
-	return nil
+	return resp, nil
 }
 
-// AddVolume adds a volume to a resource
-func (c *SDSClient) AddVolume(ctx context.Context, resource, volume, pool string, sizeGB uint32) error {
-	req := &sdspb.AddVolumeRequest{
-		Resource: resource,
-		Volume:   volume,
-		Pool:     pool,
-		SizeGb:   sizeGB,
-	}
-
-	resp, err := c.client.AddVolume(ctx, req)
+// StopResource takes a resource down on all of its nodes and keeps it down
+func (c *SDSClient) StopResource(ctx context.Context, name string) error {
+	resp, err := c.client.StopResource(ctx, &sdspb.StopResourceRequest{Name: name})
 	if err != nil {
 		return err
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
 }
 
-// RemoveVolume removes a volume from a resource
-func (c *SDSClient) RemoveVolume(ctx context.Context, resource string, volumeID uint32, node string) error {
-	req := &sdspb.RemoveVolumeRequest{
-		Resource: resource,
-		VolumeId: volumeID,
-	}
-
-	resp, err := c.client.RemoveVolume(ctx, req)
+// PauseSync pauses the resync of a resource, or of its connections to peer
+func (c *SDSClient) PauseSync(ctx context.Context, name, peer string) error {
+	resp, err := c.client.PauseSync(ctx, &sdspb.PauseSyncRequest{Name: name, Peer: peer})
 	if err != nil {
 		return err
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
 }
 
-// ResizeVolume resizes a volume
-func (c *SDSClient) ResizeVolume(ctx context.Context, resource string, volumeID uint32, node string, sizeGB uint32) error {
-	req := &sdspb.ResizeVolumeRequest{
-		Resource: resource,
-		VolumeId: volumeID,
-		SizeGb:   sizeGB,
-	}
-
-	resp, err := c.client.ResizeVolume(ctx, req)
+// ResumeSync resumes a paused resync
+func (c *SDSClient) ResumeSync(ctx context.Context, name, peer string) error {
+	resp, err := c.client.ResumeSync(ctx, &sdspb.ResumeSyncRequest{Name: name, Peer: peer})
 	if err != nil {
 		return err
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
 }
 
-// ResourceStatus gets resource detailed status
-func (c *SDSClient) ResourceStatus(ctx context.Context, name string) (*sdspb.ResourceStatus, error) {
-	req := &sdspb.ResourceStatusRequest{
-		Name: name,
-	}
-
-	resp, err := c.client.ResourceStatus(ctx, req)
+// SetSyncRate overrides the resync rate of a resource, or with clear reverts
+// it, and returns when the override expires
+func (c *SDSClient) SetSyncRate(ctx context.Context, req *sdspb.SetSyncRateRequest) (time.Time, error) {
+	resp, err := c.client.SetSyncRate(ctx, req)
 	if err != nil {
-		return nil, err
+		return time.Time{}, err
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf(resp.Message)
+		return time.Time{}, fmt.Errorf("%s", resp.Message)
 	}
 
-	return resp.Status, nil
-}
-
-// SetSecondary sets a node as Secondary for a resource
-func (c *SDSClient) SetSecondary(ctx context.Context, resource, node string) error {
-	req := &sdspb.SetSecondaryRequest{
-		Resource: resource,
-		Node:     node,
+	if resp.ExpiresAt == 0 {
+		return time.Time{}, nil
 	}
+	return time.Unix(resp.ExpiresAt, 0), nil
+}
 
-	resp, err := c.client.SetSecondary(ctx, req)
+// StartResource brings a stopped resource up again
+func (c *SDSClient) StartResource(ctx context.Context, name string) error {
+	resp, err := c.client.StartResource(ctx, &sdspb.StartResourceRequest{Name: name})
 	if err != nil {
 		return err
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
 }
 
-// CreateFilesystem creates a filesystem on a DRBD device
-func (c *SDSClient) CreateFilesystem(ctx context.Context, resource string, volumeID uint32, node, fstype string) error {
-	req := &sdspb.CreateFilesystemRequest{
-		Resource: resource,
-		VolumeId: volumeID,
-		Fstype:   fstype,
-		Node:     node,
+// GetResource gets resource information
+func (c *SDSClient) GetResource(ctx context.Context, name string) (*sdspb.ResourceInfo, error) {
+	req := &sdspb.GetResourceRequest{
+		Name: name,
 	}
 
-	resp, err := c.client.CreateFilesystem(ctx, req)
+	resp, err := c.client.GetResource(ctx, req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return nil, fmt.Errorf("%s", resp.Message)
 	}
 
-	return nil
+	return resp.Resource, nil
 }
 
-// MountResource mounts a DRBD device
-func (c *SDSClient) MountResource(ctx context.Context, resource string, volumeID uint32, path, node, fstype string) error {
-	req := &sdspb.MountResourceRequest{
-		Resource: resource,
-		VolumeId: volumeID,
-		Path:     path,
+// ListResources lists all resources
+func (c *SDSClient) ListResources(ctx context.Context) ([]*sdspb.ResourceInfo, error) {
+	req := &sdspb.ListResourcesRequest{}
+
+	resp, err := c.client.ListResources(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Resources, nil
+}
+
+// SetPrimary sets a node as Primary for a resource
+func (c *SDSClient) SetPrimary(ctx context.Context, resource, node string, force bool) error {
+	req := &sdspb.SetPrimaryRequest{
+		Resource: resource,
 		Node:     node,
-		Fstype:   fstype,
+		Force:    force,
+	}
+
+	resp, err := c.client.SetPrimary(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// DeleteResource deletes a DRBD resource
+func (c *SDSClient) DeleteResource(ctx context.Context, name string, noSafetySnapshot bool) error {
+	req := &sdspb.DeleteResourceRequest{
+		Name:             name,
+		NoSafetySnapshot: noSafetySnapshot,
+	}
+
+	resp, err := c.client.DeleteResource(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// AddVolume adds a volume to a resource and returns the created volume
+func (c *SDSClient) AddVolume(ctx context.Context, resource, volume, pool string, sizeGB uint32) (*sdspb.VolumeInfo, error) {
+	req := &sdspb.AddVolumeRequest{
+		Resource: resource,
+		Volume:   volume,
+		Pool:     pool,
+		SizeGb:   sizeGB,
+	}
+
+	resp, err := c.client.AddVolume(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Volume, nil
+}
+
+// RemoveVolume removes a volume from a resource
+func (c *SDSClient) RemoveVolume(ctx context.Context, resource string, volumeID uint32, node string) error {
+	req := &sdspb.RemoveVolumeRequest{
+		Resource: resource,
+		VolumeId: volumeID,
+	}
+
+	resp, err := c.client.RemoveVolume(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// ResizeVolume resizes a volume
+func (c *SDSClient) ResizeVolume(ctx context.Context, resource string, volumeID uint32, node string, sizeGB uint32) error {
+	req := &sdspb.ResizeVolumeRequest{
+		Resource: resource,
+		VolumeId: volumeID,
+		SizeGb:   sizeGB,
+	}
+
+	resp, err := c.client.ResizeVolume(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// GetVolume gets a volume of a resource by its volume number
+func (c *SDSClient) GetVolume(ctx context.Context, resource string, volumeID uint32) (*sdspb.VolumeInfo, error) {
+	req := &sdspb.GetVolumeRequest{
+		Resource: resource,
+		VolumeId: volumeID,
+	}
+
+	resp, err := c.client.GetVolume(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Volume, nil
+}
+
+// ListVolumes lists volumes, optionally only those of one resource
+func (c *SDSClient) ListVolumes(ctx context.Context, resource string) ([]*sdspb.VolumeInfo, error) {
+	req := &sdspb.ListVolumesRequest{
+		Resource: resource,
+	}
+
+	resp, err := c.client.ListVolumes(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Volumes, nil
+}
+
+// ResourceStatus gets resource detailed status
+func (c *SDSClient) ResourceStatus(ctx context.Context, name string) (*sdspb.ResourceStatus, error) {
+	req := &sdspb.ResourceStatusRequest{
+		Name: name,
+	}
+
+	resp, err := c.client.ResourceStatus(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Status, nil
+}
+
+// ExportResource gets the canonical DRBD config and promoter plugin of a resource
+func (c *SDSClient) ExportResource(ctx context.Context, name string) (*sdspb.ExportResourceResponse, error) {
+	resp, err := c.client.ExportResource(ctx, &sdspb.ExportResourceRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// DiffResource compares the canonical configs of a resource with the ones
+// deployed on its nodes
+func (c *SDSClient) DiffResource(ctx context.Context, name string) ([]*sdspb.ConfigDiff, error) {
+	resp, err := c.client.DiffResource(ctx, &sdspb.DiffResourceRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Diffs, nil
+}
+
+// ExecFenceTest disconnects a node of a resource from one peer, checks that
+// its IO behaves as the quorum policy says and reconnects it
+func (c *SDSClient) ExecFenceTest(ctx context.Context, resource, node, peer string, ioTimeout time.Duration) (*sdspb.ExecFenceTestResponse, error) {
+	req := &sdspb.ExecFenceTestRequest{
+		Name:             resource,
+		Node:             node,
+		Peer:             peer,
+		IoTimeoutSeconds: int64(ioTimeout / time.Second),
+	}
+
+	resp, err := c.client.ExecFenceTest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// ActivateResource activates a resource on a node. The response carries the
+// checklist also when the activation failed.
+func (c *SDSClient) ActivateResource(ctx context.Context, resource, node, mountPoint, fsType string, services []string, force bool) (*sdspb.ActivateResourceResponse, error) {
+	req := &sdspb.ActivateResourceRequest{
+		Name:       resource,
+		Node:       node,
+		MountPoint: mountPoint,
+		FsType:     fsType,
+		Services:   services,
+		Force:      force,
+	}
+
+	resp, err := c.client.ActivateResource(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return resp, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// DeactivateResource reverses the activation of a resource. The response
+// carries the checklist also when the deactivation failed.
+func (c *SDSClient) DeactivateResource(ctx context.Context, resource string) (*sdspb.DeactivateResourceResponse, error) {
+	resp, err := c.client.DeactivateResource(ctx, &sdspb.DeactivateResourceRequest{Name: resource})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return resp, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// InstallFenceHandlers installs the fence-peer handler on all nodes
+func (c *SDSClient) InstallFenceHandlers(ctx context.Context) (*sdspb.InstallFenceHandlersResponse, error) {
+	resp, err := c.client.InstallFenceHandlers(ctx, &sdspb.InstallFenceHandlersRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// ListFenceConstraints lists the nodes fenced by the fence-peer handlers
+func (c *SDSClient) ListFenceConstraints(ctx context.Context) ([]*sdspb.FenceConstraint, error) {
+	resp, err := c.client.ListFenceConstraints(ctx, &sdspb.ListFenceConstraintsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Constraints, nil
+}
+
+// UnfencePeer lifts the fence constraint of one node of a resource, or with
+// all those of all nodes
+func (c *SDSClient) UnfencePeer(ctx context.Context, resource, node string, all bool) (string, error) {
+	req := &sdspb.UnfencePeerRequest{
+		Name: resource,
+		Peer: node,
+		All:  all,
+	}
+
+	resp, err := c.client.UnfencePeer(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	if !resp.Success {
+		return "", fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Message, nil
+}
+
+// GetNodeResourceConfig gets the configured (drbdadm dump) and the effective
+// (drbdsetup show) DRBD config of a resource from one of its nodes
+func (c *SDSClient) GetNodeResourceConfig(ctx context.Context, resource, node string) (*sdspb.GetNodeResourceConfigResponse, error) {
+	resp, err := c.client.GetNodeResourceConfig(ctx, &sdspb.GetNodeResourceConfigRequest{Resource: resource, Node: node})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// RenderConfig returns the files the controller generates for the given
+// inputs, without touching any node
+func (c *SDSClient) RenderConfig(ctx context.Context, req *sdspb.RenderConfigRequest) ([]*sdspb.PlannedFile, error) {
+	resp, err := c.client.RenderConfig(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Files, nil
+}
+
+// SetSecondary sets a node as Secondary for a resource
+func (c *SDSClient) SetSecondary(ctx context.Context, resource, node string) error {
+	req := &sdspb.SetSecondaryRequest{
+		Resource: resource,
+		Node:     node,
+	}
+
+	resp, err := c.client.SetSecondary(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// CreateFilesystem creates a filesystem on a DRBD device
+func (c *SDSClient) CreateFilesystem(ctx context.Context, resource string, volumeID uint32, node, fstype string) error {
+	req := &sdspb.CreateFilesystemRequest{
+		Resource: resource,
+		VolumeId: volumeID,
+		Fstype:   fstype,
+		Node:     node,
+	}
+
+	resp, err := c.client.CreateFilesystem(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// MountResource mounts a DRBD device
+func (c *SDSClient) MountResource(ctx context.Context, resource string, volumeID uint32, path, node, fstype string) error {
+	req := &sdspb.MountResourceRequest{
+		Resource: resource,
+		VolumeId: volumeID,
+		Path:     path,
+		Node:     node,
+		Fstype:   fstype,
+	}
+
+	resp, err := c.client.MountResource(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// UnmountResource unmounts a DRBD device
+func (c *SDSClient) UnmountResource(ctx context.Context, resource string, volumeID uint32, node string) error {
+	req := &sdspb.UnmountResourceRequest{
+		Resource: resource,
+		VolumeId: volumeID,
+		Node:     node,
+	}
+
+	resp, err := c.client.UnmountResource(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// MakeHa creates a drbd-reactor promoter config for HA failover. A vip of
+// "auto" is allocated from vipPool. A nil policy uses the controller's
+// defaults.
+func (c *SDSClient) MakeHa(ctx context.Context, resource string, services []string, mountPoint, fsType, vip, vipPool string, dependsOn []string, policy *sdspb.HaPolicy, dryRun bool) (*sdspb.MakeHaResponse, error) {
+	req := &sdspb.MakeHaRequest{
+		Resource:   resource,
+		Services:   services,
+		MountPoint: mountPoint,
+		Fstype:     fsType,
+		Vip:        vip,
+		VipPool:    vipPool,
+		DependsOn:  dependsOn,
+		Policy:     policy,
+		DryRun:     dryRun,
+	}
+
+	resp, err := c.client.MakeHa(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// UpdateHa changes the services or the VIP of an HA configuration in place.
+// vip "none" removes the VIP, an empty vip keeps it.
+func (c *SDSClient) UpdateHa(ctx context.Context, resource string, addServices, removeServices []string, vip, vipPool string, dryRun bool) (*sdspb.UpdateHaResponse, error) {
+	req := &sdspb.UpdateHaRequest{
+		Resource:       resource,
+		AddServices:    addServices,
+		RemoveServices: removeServices,
+		Vip:            vip,
+		VipPool:        vipPool,
+		DryRun:         dryRun,
+	}
+
+	resp, err := c.client.UpdateHa(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// ListVIPs lists the VIPs of HA configs and gateways and the IPAM pool usage
+func (c *SDSClient) ListVIPs(ctx context.Context) (*sdspb.ListVIPsResponse, error) {
+	resp, err := c.client.ListVIPs(ctx, &sdspb.ListVIPsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// EvictHa evicts an HA resource from the active node. force skips the check
+// that another node can take over.
+func (c *SDSClient) EvictHa(ctx context.Context, resource string, force bool) error {
+	req := &sdspb.EvictHaRequest{
+		Resource: resource,
+		Force:    force,
+	}
+
+	resp, err := c.client.EvictHa(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// FailoverHa moves an HA resource gracefully to the given node. A zero
+// timeout uses the controller's default, force skips the check that the node
+// can take over.
+func (c *SDSClient) FailoverHa(ctx context.Context, resource, target string, timeout time.Duration, force bool) (*sdspb.FailoverHaResponse, error) {
+	req := &sdspb.FailoverHaRequest{
+		Resource:       resource,
+		Target:         target,
+		TimeoutSeconds: int64(timeout / time.Second),
+		Force:          force,
+	}
+
+	resp, err := c.client.FailoverHa(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// DeleteHa deletes an HA configuration
+func (c *SDSClient) DeleteHa(ctx context.Context, resource string) error {
+	req := &sdspb.DeleteHaRequest{
+		Resource: resource,
+	}
+
+	resp, err := c.client.DeleteHa(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// GetHa gets an HA configuration
+func (c *SDSClient) GetHa(ctx context.Context, resource string) (*sdspb.HaConfigInfo, error) {
+	req := &sdspb.GetHaRequest{
+		Resource: resource,
+	}
+
+	resp, err := c.client.GetHa(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Config, nil
+}
+
+// ListHa lists all HA configurations
+func (c *SDSClient) ListHa(ctx context.Context) ([]*sdspb.HaConfigInfo, error) {
+	req := &sdspb.ListHaRequest{}
+
+	resp, err := c.client.ListHa(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Configs, nil
+}
+
+// GetHaStatus returns the HA config of a resource with its active node and
+// the state of each of its nodes
+func (c *SDSClient) GetHaStatus(ctx context.Context, resource string) (*sdspb.GetHaStatusResponse, error) {
+	resp, err := c.client.GetHaStatus(ctx, &sdspb.GetHaStatusRequest{Resource: resource})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// ImportPacemakerHa maps the Pacemaker HA setups of DRBD resources onto sds
+// HA configs and, with apply, installs them. The setups are returned on
+// failure too, with what blocks them.
+func (c *SDSClient) ImportPacemakerHa(ctx context.Context, node, cib string, resources []string, apply bool) ([]*sdspb.PacemakerHaImport, error) {
+	req := &sdspb.ImportPacemakerHaRequest{
+		Node:      node,
+		Cib:       cib,
+		Resources: resources,
+		Apply:     apply,
+	}
+
+	resp, err := c.client.ImportPacemakerHa(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return resp.Imports, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Imports, nil
+}
+
+// ==================== DR OPERATIONS ====================
+
+// DrSwitchover performs a planned switchover of a resource to the DR node
+func (c *SDSClient) DrSwitchover(ctx context.Context, resource, targetNode string, syncTimeoutSeconds uint32) (*sdspb.DrSwitchoverResponse, error) {
+	req := &sdspb.DrSwitchoverRequest{
+		Resource:           resource,
+		TargetNode:         targetNode,
+		SyncTimeoutSeconds: syncTimeoutSeconds,
+	}
+
+	resp, err := c.client.DrSwitchover(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return resp, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// DrFailback returns a resource to the node it was switched over from
+func (c *SDSClient) DrFailback(ctx context.Context, resource string, syncTimeoutSeconds uint32) (*sdspb.DrFailbackResponse, error) {
+	req := &sdspb.DrFailbackRequest{
+		Resource:           resource,
+		SyncTimeoutSeconds: syncTimeoutSeconds,
+	}
+
+	resp, err := c.client.DrFailback(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return resp, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// ==================== PLACEMENT OPERATIONS ====================
+
+// AddPlacementRule adds a co-location or anti-affinity rule between two resources
+func (c *SDSClient) AddPlacementRule(ctx context.Context, resourceA, resourceB, ruleType string) error {
+	req := &sdspb.AddPlacementRuleRequest{
+		ResourceA: resourceA,
+		ResourceB: resourceB,
+		Type:      ruleType,
+	}
+
+	resp, err := c.client.AddPlacementRule(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// DeletePlacementRule deletes the placement rule between two resources
+func (c *SDSClient) DeletePlacementRule(ctx context.Context, resourceA, resourceB string) error {
+	req := &sdspb.DeletePlacementRuleRequest{
+		ResourceA: resourceA,
+		ResourceB: resourceB,
+	}
+
+	resp, err := c.client.DeletePlacementRule(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// ListPlacementRules lists placement rules, optionally filtered by resource
+func (c *SDSClient) ListPlacementRules(ctx context.Context, resource string) ([]*sdspb.PlacementRuleInfo, error) {
+	req := &sdspb.ListPlacementRulesRequest{
+		Resource: resource,
+	}
+
+	resp, err := c.client.ListPlacementRules(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Rules, nil
+}
+
+// ==================== EVENT OPERATIONS ====================
+
+// ListEvents lists events from the events log, newest first
+func (c *SDSClient) ListEvents(ctx context.Context, resource string, limit uint32) ([]*sdspb.EventInfo, error) {
+	req := &sdspb.ListEventsRequest{
+		Resource: resource,
+		Limit:    limit,
+	}
+
+	resp, err := c.client.ListEvents(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Events, nil
+}
+
+// SetEventHook adds an event hook or replaces the one of the same name. It
+// needs a context with the admin token, see WithAdminToken.
+func (c *SDSClient) SetEventHook(ctx context.Context, hook *sdspb.EventHook) error {
+	resp, err := c.client.SetEventHook(ctx, &sdspb.SetEventHookRequest{
+		Name: hook.Name,
+		Hook: hook,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// DeleteEventHook removes an event hook. It needs a context with the admin
+// token, see WithAdminToken.
+func (c *SDSClient) DeleteEventHook(ctx context.Context, name string) error {
+	resp, err := c.client.DeleteEventHook(ctx, &sdspb.DeleteEventHookRequest{Name: name})
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// ListEventHooks lists the event hooks, with header values redacted
+func (c *SDSClient) ListEventHooks(ctx context.Context) ([]*sdspb.EventHook, error) {
+	resp, err := c.client.ListEventHooks(ctx, &sdspb.ListEventHooksRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Hooks, nil
+}
+
+// TestEventHook sends a test event to a hook. It needs a context with the
+// admin token, see WithAdminToken.
+func (c *SDSClient) TestEventHook(ctx context.Context, name string) error {
+	resp, err := c.client.TestEventHook(ctx, &sdspb.TestEventHookRequest{Name: name})
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// GetClusterReport returns the cluster report over the given number of days
+// up to now, rendered as html or md
+func (c *SDSClient) GetClusterReport(ctx context.Context, days uint32, format string) (string, error) {
+	req := &sdspb.GetClusterReportRequest{
+		Days:   days,
+		Format: format,
+	}
+
+	resp, err := c.client.GetClusterReport(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	if !resp.Success {
+		return "", fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Content, nil
+}
+
+// GetAlertRules returns Prometheus alerting rules for the resources and pools
+// of the cluster
+func (c *SDSClient) GetAlertRules(ctx context.Context, poolFullPercent float64, forSeconds uint32) (string, error) {
+	req := &sdspb.GetAlertRulesRequest{
+		PoolFullPercent: poolFullPercent,
+		ForSeconds:      forSeconds,
+	}
+
+	resp, err := c.client.GetAlertRules(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	if !resp.Success {
+		return "", fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Rules, nil
+}
+
+// ==================== ADMIN OPERATIONS ====================
+
+// Freeze puts the controller in read-only mode
+func (c *SDSClient) Freeze(ctx context.Context, reason string) (*sdspb.FreezeStatus, error) {
+	resp, err := c.client.Freeze(ctx, &sdspb.FreezeRequest{Reason: reason})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Status, nil
+}
+
+// Unfreeze leaves read-only mode
+func (c *SDSClient) Unfreeze(ctx context.Context) error {
+	resp, err := c.client.Unfreeze(ctx, &sdspb.UnfreezeRequest{})
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// GetFreezeStatus gets the read-only state of the controller
+func (c *SDSClient) GetFreezeStatus(ctx context.Context) (*sdspb.FreezeStatus, error) {
+	resp, err := c.client.GetFreezeStatus(ctx, &sdspb.GetFreezeStatusRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Status, nil
+}
+
+// CollectGarbage lists the orphaned artifacts on the nodes and, unless
+// dryRun is set, removes those not in use
+func (c *SDSClient) CollectGarbage(ctx context.Context, dryRun bool) ([]*sdspb.Orphan, error) {
+	resp, err := c.client.CollectGarbage(ctx, &sdspb.CollectGarbageRequest{DryRun: dryRun})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Orphans, nil
+}
+
+// Rediscover lists the database records missing for the artifacts on the
+// nodes and, unless dryRun is set, rebuilds them
+func (c *SDSClient) Rediscover(ctx context.Context, dryRun bool) ([]*sdspb.Rediscovered, error) {
+	resp, err := c.client.Rediscover(ctx, &sdspb.RediscoverRequest{DryRun: dryRun})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Records, nil
+}
+
+// SupportBundle writes the support bundle tarball the controller collects to
+// w. It needs a context with the admin token, see WithAdminToken.
+func (c *SDSClient) SupportBundle(ctx context.Context, req *sdspb.SupportBundleRequest, w io.Writer) (int64, error) {
+	stream, err := c.client.SupportBundle(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	var written int64
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return written, nil
+		}
+		if err != nil {
+			return written, err
+		}
+		n, err := w.Write(chunk.Data)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+}
+
+// ==================== RECONCILE OPERATIONS ====================
+
+// GetDriftReport gets the discrepancies between the database and the nodes,
+// refresh runs a reconcile pass instead of returning the last one
+func (c *SDSClient) GetDriftReport(ctx context.Context, refresh bool) (*sdspb.GetDriftReportResponse, error) {
+	resp, err := c.client.GetDriftReport(ctx, &sdspb.GetDriftReportRequest{Refresh: refresh})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// Repair converges a resource, HA config or gateway back to its record and
+// returns the actions taken, also those taken before a failure
+func (c *SDSClient) Repair(ctx context.Context, kind, name string) ([]string, error) {
+	resp, err := c.client.Repair(ctx, &sdspb.RepairRequest{Kind: kind, Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return resp.Actions, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Actions, nil
+}
+
+// ==================== REBALANCE OPERATIONS ====================
+
+// Rebalance plans Primary moves that even out the Primaries per node and,
+// unless dryRun is set, performs them. maxMoves 0 uses the controller's limit.
+func (c *SDSClient) Rebalance(ctx context.Context, dryRun bool, maxMoves int) (*sdspb.RebalanceResponse, error) {
+	resp, err := c.client.Rebalance(ctx, &sdspb.RebalanceRequest{DryRun: dryRun, MaxMoves: int32(maxMoves)})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return resp, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// ==================== JOB OPERATIONS ====================
+
+// ListJobs lists recent jobs newest first, optionally of one resource or
+// object and in one state
+func (c *SDSClient) ListJobs(ctx context.Context, target, state string, limit int) ([]*sdspb.JobInfo, error) {
+	resp, err := c.client.ListJobs(ctx, &sdspb.ListJobsRequest{Target: target, State: state, Limit: int32(limit)})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Jobs, nil
+}
+
+// WatchProgress watches the job started by a call made with the returned
+// context and calls onEvent for its steps and remote commands, from another
+// goroutine. Call stop once the call returned: it waits briefly for the
+// last events, and onEvent is not called afterwards. Controllers without
+// progress support send no events.
+func (c *SDSClient) WatchProgress(ctx context.Context, onEvent func(*sdspb.JobProgress)) (context.Context, func()) {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return ctx, func() {}
+	}
+	progressID := hex.EncodeToString(id)
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		stream, err := c.client.WatchJobProgress(watchCtx, &sdspb.WatchJobProgressRequest{ProgressId: progressID})
+		if err != nil {
+			return
+		}
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			onEvent(event)
+		}
+	}()
+
+	stop := func() {
+		select {
+		case <-done:
+		case <-time.After(progressGrace):
+		}
+		cancel()
+		<-done
+	}
+	return metadata.AppendToOutgoingContext(ctx, progressHeader, progressID), stop
+}
+
+// GetJob returns a job with the timing of its steps
+func (c *SDSClient) GetJob(ctx context.Context, id int64) (*sdspb.JobInfo, error) {
+	resp, err := c.client.GetJob(ctx, &sdspb.GetJobRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Job, nil
+}
+
+// ResumeJob runs the request of an interrupted job again and returns the ID
+// of the new job
+func (c *SDSClient) ResumeJob(ctx context.Context, id int64) (int64, error) {
+	resp, err := c.client.ResumeJob(ctx, &sdspb.ResumeJobRequest{Id: id})
+	if err != nil {
+		return 0, err
+	}
+
+	if !resp.Success {
+		return resp.JobId, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.JobId, nil
+}
+
+// RollbackJob undoes an interrupted job and returns the ID of the job that
+// ran the rollback
+func (c *SDSClient) RollbackJob(ctx context.Context, id int64) (int64, error) {
+	resp, err := c.client.RollbackJob(ctx, &sdspb.RollbackJobRequest{Id: id})
+	if err != nil {
+		return 0, err
+	}
+
+	if !resp.Success {
+		return resp.JobId, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.JobId, nil
+}
+
+// ==================== NET PROBE OPERATIONS ====================
+
+// ProbeNetwork measures latency and throughput between nodes, all nodes if
+// none are given
+func (c *SDSClient) ProbeNetwork(ctx context.Context, nodes []string) ([]*sdspb.NetProbe, error) {
+	resp, err := c.client.ProbeNetwork(ctx, &sdspb.ProbeNetworkRequest{Nodes: nodes})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Probes, nil
+}
+
+// CheckTime compares the clocks of the given nodes, all nodes if none are
+// given, with the controller's
+func (c *SDSClient) CheckTime(ctx context.Context, nodes []string) (*sdspb.CheckTimeResponse, error) {
+	resp, err := c.client.CheckTime(ctx, &sdspb.CheckTimeRequest{Nodes: nodes})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// ListNetProbes returns the latest measurement of every probed link
+func (c *SDSClient) ListNetProbes(ctx context.Context) ([]*sdspb.NetProbe, error) {
+	resp, err := c.client.ListNetProbes(ctx, &sdspb.ListNetProbesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Probes, nil
+}
+
+// ==================== SETUP OPERATIONS ====================
+
+// GetSetupStatus returns how far the cluster got through the first boot setup
+func (c *SDSClient) GetSetupStatus(ctx context.Context) (*sdspb.GetSetupStatusResponse, error) {
+	resp, err := c.client.GetSetupStatus(ctx, &sdspb.GetSetupStatusRequest{})
+	if err != nil {
+		return nil, err
 	}
 
-	resp, err := c.client.MountResource(ctx, req)
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// TestNodeConnection checks that a host can be registered as a node without
+// changing anything on it
+func (c *SDSClient) TestNodeConnection(ctx context.Context, name, address string) (*sdspb.TestNodeConnectionResponse, error) {
+	resp, err := c.client.TestNodeConnection(ctx, &sdspb.TestNodeConnectionRequest{
+		Name:    name,
+		Address: address,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// CompleteSetup records that the first boot setup was finished or skipped
+func (c *SDSClient) CompleteSetup(ctx context.Context) error {
+	resp, err := c.client.CompleteSetup(ctx, &sdspb.CompleteSetupRequest{})
 	if err != nil {
 		return err
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
 }
 
-// UnmountResource unmounts a DRBD device
-func (c *SDSClient) UnmountResource(ctx context.Context, resource string, volumeID uint32, node string) error {
-	req := &sdspb.UnmountResourceRequest{
-		Resource: resource,
-		VolumeId: volumeID,
-		Node:     node,
+// DiscoverDisks lists the disks of a node and whether a pool can be created
+// on them
+func (c *SDSClient) DiscoverDisks(ctx context.Context, node string) ([]*sdspb.DiscoveredDisk, error) {
+	resp, err := c.client.DiscoverDisks(ctx, &sdspb.DiscoverDisksRequest{Node: node})
+	if err != nil {
+		return nil, err
 	}
 
-	resp, err := c.client.UnmountResource(ctx, req)
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Disks, nil
+}
+
+// ==================== DRBD GLOBAL CONFIG OPERATIONS ====================
+
+// GetDrbdGlobalConfig returns a version of the DRBD global config and its
+// rendered global_common.conf, the latest one if version is 0
+func (c *SDSClient) GetDrbdGlobalConfig(ctx context.Context, version int) (*sdspb.DrbdGlobalConfig, string, error) {
+	resp, err := c.client.GetDrbdGlobalConfig(ctx, &sdspb.GetDrbdGlobalConfigRequest{Version: int32(version)})
 	if err != nil {
-		return err
+		return nil, "", err
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return nil, "", fmt.Errorf("%s", resp.Message)
 	}
 
-	return nil
+	return resp.Config, resp.Content, nil
 }
 
-// MakeHa creates a drbd-reactor promoter config for HA failover
-func (c *SDSClient) MakeHa(ctx context.Context, resource string, services []string, mountPoint, fsType, vip string) (string, error) {
-	req := &sdspb.MakeHaRequest{
-		Resource:   resource,
-		Services:   services,
-		MountPoint: mountPoint,
-		Fstype:     fsType,
-		Vip:        vip,
+// SetDrbdGlobalConfig saves a new version of the DRBD global config and
+// writes it to all nodes
+func (c *SDSClient) SetDrbdGlobalConfig(ctx context.Context, cfg *sdspb.DrbdGlobalConfig, apply bool) (*sdspb.SetDrbdGlobalConfigResponse, error) {
+	resp, err := c.client.SetDrbdGlobalConfig(ctx, &sdspb.SetDrbdGlobalConfigRequest{Config: cfg, Apply: apply})
+	if err != nil {
+		return nil, err
 	}
 
-	resp, err := c.client.MakeHa(ctx, req)
+	if !resp.Success {
+		return resp, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// ListDrbdGlobalConfigs lists all versions of the DRBD global config, newest first
+func (c *SDSClient) ListDrbdGlobalConfigs(ctx context.Context) ([]*sdspb.DrbdGlobalConfig, error) {
+	resp, err := c.client.ListDrbdGlobalConfigs(ctx, &sdspb.ListDrbdGlobalConfigsRequest{})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if !resp.Success {
-		return "", fmt.Errorf(resp.Message)
+		return nil, fmt.Errorf("%s", resp.Message)
 	}
 
-	return resp.ConfigPath, nil
+	return resp.Configs, nil
 }
 
-// EvictHa evicts an HA resource from the active node
-func (c *SDSClient) EvictHa(ctx context.Context, resource string) error {
-	req := &sdspb.EvictHaRequest{
+// RollbackDrbdGlobalConfig re-applies an earlier version of the DRBD global
+// config as a new version
+func (c *SDSClient) RollbackDrbdGlobalConfig(ctx context.Context, version int, apply bool) (*sdspb.RollbackDrbdGlobalConfigResponse, error) {
+	resp, err := c.client.RollbackDrbdGlobalConfig(ctx, &sdspb.RollbackDrbdGlobalConfigRequest{Version: int32(version), Apply: apply})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return resp, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// ==================== SNAPSHOT OPERATIONS ====================
+
+// CreateSnapshot creates a snapshot of a resource (or LVM vg/lv volume).
+// An empty node snapshots the resource on all of its nodes. skipHooks
+// takes the snapshot without running the snapshot hooks of the resource.
+func (c *SDSClient) CreateSnapshot(ctx context.Context, volume, snapshotName, node, size string, skipHooks bool) error {
+	req := &sdspb.CreateSnapshotRequest{
+		Volume:       volume,
+		SnapshotName: snapshotName,
+		Node:         node,
+		Size:         size,
+		SkipHooks:    skipHooks,
+	}
+
+	resp, err := c.client.CreateSnapshot(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// SetSnapshotHook adds a snapshot hook to a resource or replaces its hook of
+// the same name
+func (c *SDSClient) SetSnapshotHook(ctx context.Context, resource string, hook *sdspb.SnapshotHook) error {
+	req := &sdspb.SetSnapshotHookRequest{
 		Resource: resource,
+		Name:     hook.Name,
+		Hook:     hook,
 	}
 
-	resp, err := c.client.EvictHa(ctx, req)
+	resp, err := c.client.SetSnapshotHook(ctx, req)
 	if err != nil {
 		return err
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
 }
 
-// DeleteHa deletes an HA configuration
-func (c *SDSClient) DeleteHa(ctx context.Context, resource string) error {
-	req := &sdspb.DeleteHaRequest{
+// DeleteSnapshotHook removes a snapshot hook from a resource
+func (c *SDSClient) DeleteSnapshotHook(ctx context.Context, resource, name string) error {
+	req := &sdspb.DeleteSnapshotHookRequest{
 		Resource: resource,
+		Name:     name,
 	}
 
-	resp, err := c.client.DeleteHa(ctx, req)
+	resp, err := c.client.DeleteSnapshotHook(ctx, req)
 	if err != nil {
 		return err
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
 }
 
-// GetHa gets an HA configuration
-func (c *SDSClient) GetHa(ctx context.Context, resource string) (*sdspb.HaConfigInfo, error) {
-	req := &sdspb.GetHaRequest{
+// ListSnapshotHooks returns the snapshot hooks of a resource in the order
+// they run
+func (c *SDSClient) ListSnapshotHooks(ctx context.Context, resource string) ([]*sdspb.SnapshotHook, error) {
+	req := &sdspb.ListSnapshotHooksRequest{
 		Resource: resource,
 	}
 
-	resp, err := c.client.GetHa(ctx, req)
+	resp, err := c.client.ListSnapshotHooks(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf(resp.Message)
+		return nil, fmt.Errorf("%s", resp.Message)
 	}
 
-	return resp.Config, nil
+	return resp.Hooks, nil
 }
 
-// ListHa lists all HA configurations
-func (c *SDSClient) ListHa(ctx context.Context) ([]*sdspb.HaConfigInfo, error) {
-	req := &sdspb.ListHaRequest{}
+// SetReplicationPolicy adds or replaces the snapshot replication policy of
+// a resource and returns the warnings about its target
+func (c *SDSClient) SetReplicationPolicy(ctx context.Context, resource string, policy *sdspb.ReplicationPolicy) ([]string, error) {
+	req := &sdspb.SetReplicationPolicyRequest{
+		Resource: resource,
+		Policy:   policy,
+	}
 
-	resp, err := c.client.ListHa(ctx, req)
+	resp, err := c.client.SetReplicationPolicy(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf(resp.Message)
+		return nil, fmt.Errorf("%s", resp.Message)
 	}
 
-	return resp.Configs, nil
+	return resp.Warnings, nil
 }
 
-// ==================== SNAPSHOT OPERATIONS ====================
-
-// CreateSnapshot creates a snapshot
-func (c *SDSClient) CreateSnapshot(ctx context.Context, volume, snapshotName, node string) error {
-	req := &sdspb.CreateSnapshotRequest{
-		Volume:       volume,
-		SnapshotName: snapshotName,
-		Node:         node,
+// DeleteReplicationPolicy stops the snapshot replication of a resource
+func (c *SDSClient) DeleteReplicationPolicy(ctx context.Context, resource string) error {
+	req := &sdspb.DeleteReplicationPolicyRequest{
+		Resource: resource,
 	}
 
-	resp, err := c.client.CreateSnapshot(ctx, req)
+	resp, err := c.client.DeleteReplicationPolicy(ctx, req)
 	if err != nil {
 		return err
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
 }
 
+// ListReplicationPolicies returns the snapshot replication policies, only the
+// one of resource if set
+func (c *SDSClient) ListReplicationPolicies(ctx context.Context, resource string) ([]*sdspb.ReplicationPolicy, error) {
+	req := &sdspb.ListReplicationPoliciesRequest{
+		Resource: resource,
+	}
+
+	resp, err := c.client.ListReplicationPolicies(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Policies, nil
+}
+
+// RunReplication runs the snapshot replication of a resource now, full
+// replaces the copy on the target
+func (c *SDSClient) RunReplication(ctx context.Context, resource string, full bool) (*sdspb.RunReplicationResponse, error) {
+	req := &sdspb.RunReplicationRequest{
+		Resource: resource,
+		Full:     full,
+	}
+
+	resp, err := c.client.RunReplication(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
 // DeleteSnapshot deletes a snapshot
 func (c *SDSClient) DeleteSnapshot(ctx context.Context, volume, snapshotName, node string) error {
 	req := &sdspb.DeleteSnapshotRequest{
@@ -640,7 +2262,7 @@ func (c *SDSClient) DeleteSnapshot(ctx context.Context, volume, snapshotName, no
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -659,18 +2281,38 @@ func (c *SDSClient) ListSnapshots(ctx context.Context, volume, node string) ([]*
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf(resp.Message)
+		return nil, fmt.Errorf("%s", resp.Message)
 	}
 
 	return resp.Snapshots, nil
 }
 
+// GetSnapshotUsage returns per-snapshot space usage of a volume across its nodes
+func (c *SDSClient) GetSnapshotUsage(ctx context.Context, volume, node string) ([]*sdspb.SnapshotUsageInfo, error) {
+	req := &sdspb.GetSnapshotUsageRequest{
+		Volume: volume,
+		Node:   node,
+	}
+
+	resp, err := c.client.GetSnapshotUsage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Usage, nil
+}
+
 // RestoreSnapshot restores a snapshot to its source volume
-func (c *SDSClient) RestoreSnapshot(ctx context.Context, volume, snapshotName, node string) error {
+func (c *SDSClient) RestoreSnapshot(ctx context.Context, volume, snapshotName, node string, noSafetySnapshot bool) error {
 	req := &sdspb.RestoreSnapshotRequest{
-		Volume:       volume,
-		SnapshotName: snapshotName,
-		Node:         node,
+		Volume:           volume,
+		SnapshotName:     snapshotName,
+		Node:             node,
+		NoSafetySnapshot: noSafetySnapshot,
 	}
 
 	resp, err := c.client.RestoreSnapshot(ctx, req)
@@ -679,12 +2321,74 @@ func (c *SDSClient) RestoreSnapshot(ctx context.Context, volume, snapshotName, n
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
 }
 
+// CreateSnapshotGroup snapshots several resources in parallel under a
+// shared label. The results are also returned when some resources failed.
+func (c *SDSClient) CreateSnapshotGroup(ctx context.Context, req *sdspb.CreateSnapshotGroupRequest) ([]*sdspb.SnapshotGroupResult, error) {
+	resp, err := c.client.CreateSnapshotGroup(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return resp.Results, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Results, nil
+}
+
+// DeleteSnapshotGroup deletes the snapshots of a label. The results are also
+// returned when some resources failed.
+func (c *SDSClient) DeleteSnapshotGroup(ctx context.Context, name string) ([]*sdspb.SnapshotGroupResult, error) {
+	resp, err := c.client.DeleteSnapshotGroup(ctx, &sdspb.DeleteSnapshotGroupRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return resp.Results, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Results, nil
+}
+
+// RestoreSnapshotGroup restores the snapshots of a label. The results are
+// also returned when some resources failed.
+func (c *SDSClient) RestoreSnapshotGroup(ctx context.Context, name string, noSafetySnapshot bool) ([]*sdspb.SnapshotGroupResult, error) {
+	resp, err := c.client.RestoreSnapshotGroup(ctx, &sdspb.RestoreSnapshotGroupRequest{
+		Name:             name,
+		NoSafetySnapshot: noSafetySnapshot,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return resp.Results, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Results, nil
+}
+
+// ListSnapshotGroups lists the snapshot groups by label
+func (c *SDSClient) ListSnapshotGroups(ctx context.Context) ([]*sdspb.SnapshotGroup, error) {
+	resp, err := c.client.ListSnapshotGroups(ctx, &sdspb.ListSnapshotGroupsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Groups, nil
+}
+
 // ==================== GATEWAY OPERATIONS ====================
 
 // CreateNFSGateway creates an NFS gateway
@@ -695,7 +2399,7 @@ func (c *SDSClient) CreateNFSGateway(ctx context.Context, req *sdspb.CreateNFSGa
 	}
 
 	if !resp.Success {
-		return resp, fmt.Errorf(resp.Message)
+		return resp, fmt.Errorf("%s", resp.Message)
 	}
 
 	return resp, nil
@@ -709,7 +2413,7 @@ func (c *SDSClient) CreateISCSIGateway(ctx context.Context, req *sdspb.CreateISC
 	}
 
 	if !resp.Success {
-		return resp, fmt.Errorf(resp.Message)
+		return resp, fmt.Errorf("%s", resp.Message)
 	}
 
 	return resp, nil
@@ -723,12 +2427,27 @@ func (c *SDSClient) CreateNVMeGateway(ctx context.Context, req *sdspb.CreateNVMe
 	}
 
 	if !resp.Success {
-		return resp, fmt.Errorf(resp.Message)
+		return resp, fmt.Errorf("%s", resp.Message)
 	}
 
 	return resp, nil
 }
 
+// ListGatewayClients lists the clients connected to a gateway, or to the
+// gateways of a resource, "" lists all gateways
+func (c *SDSClient) ListGatewayClients(ctx context.Context, gateway string) ([]*sdspb.GatewayClientList, error) {
+	resp, err := c.client.ListGatewayClients(ctx, &sdspb.ListGatewayClientsRequest{Gateway: gateway})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Gateways, nil
+}
+
 // ListGateways lists all gateways
 func (c *SDSClient) ListGateways(ctx context.Context) ([]*sdspb.GatewayInfo, error) {
 	req := &sdspb.ListGatewaysRequest{}
@@ -739,7 +2458,7 @@ func (c *SDSClient) ListGateways(ctx context.Context) ([]*sdspb.GatewayInfo, err
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf(resp.Message)
+		return nil, fmt.Errorf("%s", resp.Message)
 	}
 
 	return resp.Gateways, nil
@@ -757,7 +2476,7 @@ func (c *SDSClient) StartGateway(ctx context.Context, id string) error {
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -775,7 +2494,7 @@ func (c *SDSClient) StopGateway(ctx context.Context, id string) error {
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -793,12 +2512,108 @@ func (c *SDSClient) DeleteGateway(ctx context.Context, id string) error {
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
 }
 
+// ==================== CLIENT OPERATIONS ====================
+
+// NVMeConnect connects a client node to an NVMe-oF gateway
+func (c *SDSClient) NVMeConnect(ctx context.Context, gateway, node, hostNQN string) (*sdspb.InitiatorInfo, error) {
+	req := &sdspb.NVMeConnectRequest{
+		Gateway: gateway,
+		Node:    node,
+		HostNqn: hostNQN,
+	}
+
+	resp, err := c.client.NVMeConnect(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return resp.Initiator, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Initiator, nil
+}
+
+// NVMeDisconnect disconnects a client node from an NVMe-oF gateway
+func (c *SDSClient) NVMeDisconnect(ctx context.Context, gateway, node string) (*sdspb.InitiatorInfo, error) {
+	req := &sdspb.NVMeDisconnectRequest{
+		Gateway: gateway,
+		Node:    node,
+	}
+
+	resp, err := c.client.NVMeDisconnect(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return resp.Initiator, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Initiator, nil
+}
+
+// GetISCSIClientConfig gets the multipath.conf snippet and iscsiadm login script of an iSCSI gateway
+func (c *SDSClient) GetISCSIClientConfig(ctx context.Context, gateway string) (*sdspb.GetISCSIClientConfigResponse, error) {
+	resp, err := c.client.GetISCSIClientConfig(ctx, &sdspb.GetISCSIClientConfigRequest{Gateway: gateway})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// ValidateISCSIInitiator checks the connectivity of a client node to an iSCSI gateway
+func (c *SDSClient) ValidateISCSIInitiator(ctx context.Context, gateway, node string) (*sdspb.InitiatorInfo, error) {
+	req := &sdspb.ValidateISCSIInitiatorRequest{
+		Gateway: gateway,
+		Node:    node,
+	}
+
+	resp, err := c.client.ValidateISCSIInitiator(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return resp.Initiator, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Initiator, nil
+}
+
+// NFSMount mounts the export of an NFS gateway on a client node
+func (c *SDSClient) NFSMount(ctx context.Context, gateway, node, path string, soft bool, options string) (*sdspb.InitiatorInfo, error) {
+	req := &sdspb.NFSMountRequest{
+		Gateway: gateway,
+		Node:    node,
+		Path:    path,
+		Soft:    soft,
+		Options: options,
+	}
+
+	resp, err := c.client.NFSMount(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return resp.Initiator, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Initiator, nil
+}
+
 // ==================== ZFS POOL OPERATIONS ====================
 
 // CreateZFSPool creates a ZFS pool
@@ -816,7 +2631,7 @@ func (c *SDSClient) CreateZFSPool(ctx context.Context, name, node string, vdevs
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -835,7 +2650,7 @@ func (c *SDSClient) DeleteZFSPool(ctx context.Context, name, node string) error
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -851,7 +2666,7 @@ func (c *SDSClient) ListZFSpools(ctx context.Context) ([]*sdspb.PoolInfo, error)
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf(resp.Message)
+		return nil, fmt.Errorf("%s", resp.Message)
 	}
 
 	return resp.Pools, nil
@@ -870,7 +2685,7 @@ func (c *SDSClient) CreateZFSDataset(ctx context.Context, datasetPath, node stri
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -889,7 +2704,7 @@ func (c *SDSClient) DeleteZFSDataset(ctx context.Context, datasetPath, node stri
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -910,7 +2725,7 @@ func (c *SDSClient) CreateZFSVolume(ctx context.Context, poolName, volumeName, s
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -930,7 +2745,7 @@ func (c *SDSClient) ResizeZFSVolume(ctx context.Context, volumePath, newSize, no
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -952,7 +2767,7 @@ func (c *SDSClient) CreateZFSSnapshot(ctx context.Context, dataset, snapshotName
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -971,7 +2786,7 @@ func (c *SDSClient) DeleteZFSSnapshot(ctx context.Context, snapshot, node string
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -990,7 +2805,7 @@ func (c *SDSClient) ListZFSSnapshots(ctx context.Context, dataset, node string)
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf(resp.Message)
+		return nil, fmt.Errorf("%s", resp.Message)
 	}
 
 	return resp.Snapshots, nil
@@ -1010,7 +2825,7 @@ func (c *SDSClient) RestoreZFSSnapshot(ctx context.Context, dataset, snapshotNam
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -1030,7 +2845,7 @@ func (c *SDSClient) CloneZFSSnapshot(ctx context.Context, snapshot, clonePath, n
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -1054,7 +2869,7 @@ func (c *SDSClient) CreateLvmSnapshot(ctx context.Context, pool, lvName, snapsho
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -1074,7 +2889,7 @@ func (c *SDSClient) DeleteLvmSnapshot(ctx context.Context, pool, snapshotName, n
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -1093,7 +2908,7 @@ func (c *SDSClient) ListLvmSnapshots(ctx context.Context, pool, node string) ([]
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf(resp.Message)
+		return nil, fmt.Errorf("%s", resp.Message)
 	}
 
 	return resp.Snapshots, nil
@@ -1113,7 +2928,7 @@ func (c *SDSClient) RestoreLvmSnapshot(ctx context.Context, pool, snapshotName,
 	}
 
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return fmt.Errorf("%s", resp.Message)
 	}
 
 	return nil
@@ -2,25 +2,69 @@
 package config
 
 import (
+	"time"
 	"fmt"
+	"path/filepath"
 
 	"github.com/spf13/viper"
 )
 
 // Config represents the application configuration
 type Config struct {
-	Server    ServerConfig    `mapstructure:"server"`
-	Database  DatabaseConfig  `mapstructure:"database"`
-	TLS       TLSConfig       `mapstructure:"tls"`
-	Log       LogConfig       `mapstructure:"log"`
-	Storage   StorageConfig   `mapstructure:"storage"`
-	Metrics   MetricsConfig   `mapstructure:"metrics"`
+	Server       ServerConfig       `mapstructure:"server"`
+	Database     DatabaseConfig     `mapstructure:"database"`
+	TLS          TLSConfig          `mapstructure:"tls"`
+	Log          LogConfig          `mapstructure:"log"`
+	Storage      StorageConfig      `mapstructure:"storage"`
+	Metrics      MetricsConfig      `mapstructure:"metrics"`
+	Secrets      SecretsConfig      `mapstructure:"secrets"`
+	Gateway      GatewayConfig      `mapstructure:"gateway"`
+	Timeouts     TimeoutsConfig     `mapstructure:"timeouts"`
+	Reconcile    ReconcileConfig    `mapstructure:"reconcile"`
+	Rebalance    RebalanceConfig    `mapstructure:"rebalance"`
+	IPAM         IPAMConfig         `mapstructure:"ipam"`
+	Reactor      ReactorConfig      `mapstructure:"reactor"`
+	Jobs         JobsConfig         `mapstructure:"jobs"`
+	Events       EventsConfig       `mapstructure:"events"`
+	Admin        AdminConfig        `mapstructure:"admin"`
+	Auth         AuthConfig         `mapstructure:"auth"`
+	NetProbe     NetProbeConfig     `mapstructure:"netprobe"`
+	TimeSync     TimeSyncConfig     `mapstructure:"timesync"`
+	AutoHeal     AutoHealConfig     `mapstructure:"autoheal"`
+	PoolHistory  PoolHistoryConfig  `mapstructure:"pool_history"`
+	Report       ReportConfig       `mapstructure:"report"`
+	DrbdDefaults DrbdDefaultsConfig `mapstructure:"drbd_defaults"`
+	Cluster      ClusterConfig      `mapstructure:"cluster"`
+	SSH          SSHConfig          `mapstructure:"ssh"`
+	Fencing      FencingConfig      `mapstructure:"fencing"`
+	Naming       NamingConfig       `mapstructure:"naming"`
+	Replication  ReplicationConfig  `mapstructure:"replication"`
 }
 
 // ServerConfig represents server configuration
 type ServerConfig struct {
 	ListenAddress string `mapstructure:"listen_address"`
-	Port          int    `mapstructure:"port"`
+	Port          int    `mapstructure:"port"`      // gRPC API
+	RESTPort      int    `mapstructure:"rest_port"` // REST API, also served by the web UI under /v1
+	UIPort        int    `mapstructure:"ui_port"`
+
+	// Limits of the gRPC server, so one misbehaving client cannot
+	// destabilize the controller
+	MaxRecvMsgSize               int           `mapstructure:"max_recv_msg_size"` // Bytes
+	MaxSendMsgSize               int           `mapstructure:"max_send_msg_size"` // Bytes
+	ReadTimeout                  time.Duration `mapstructure:"read_timeout"`      // Read-only RPCs, 0 means no deadline
+	WriteTimeout                 time.Duration `mapstructure:"write_timeout"`     // Changing RPCs, 0 means no deadline
+	MaxConnections               int           `mapstructure:"max_connections"`   // 0 means unlimited
+	MaxConcurrentStreams         uint32        `mapstructure:"max_concurrent_streams"`
+	KeepaliveMinTime             time.Duration `mapstructure:"keepalive_min_time"` // Clients pinging more often are disconnected
+	KeepalivePermitWithoutStream bool          `mapstructure:"keepalive_permit_without_stream"`
+	KeepaliveTime                time.Duration `mapstructure:"keepalive_time"`
+	KeepaliveTimeout             time.Duration `mapstructure:"keepalive_timeout"`
+	MaxConnectionIdle            time.Duration `mapstructure:"max_connection_idle"` // 0 means unlimited
+
+	// Serve gRPC server reflection for grpcurl and similar tools; reflection
+	// calls must present the admin token
+	Reflection bool `mapstructure:"reflection"`
 }
 
 // DatabaseConfig represents database configuration
@@ -40,19 +84,252 @@ type TLSConfig struct {
 type LogConfig struct {
 	Level  string `mapstructure:"level"`
 	Format string `mapstructure:"format"` // json or text
+	// ExecOutput logs the output of remote commands at debug level (sensitive
+	// values are redacted); disable it in production
+	ExecOutput bool `mapstructure:"exec_output"`
 }
 
 // StorageConfig represents storage configuration
 type StorageConfig struct {
 	DefaultPoolType     string `mapstructure:"default_pool_type"`
 	DefaultSnapshotSuffix string `mapstructure:"default_snapshot_suffix"`
+	SafetySnapshots         bool   `mapstructure:"safety_snapshots"`          // Snapshot before resize, restore, remove-volume and delete
+	SafetySnapshotRetention int    `mapstructure:"safety_snapshot_retention"` // Safety snapshots kept per volume and node
+	AllowFileBackend        bool   `mapstructure:"allow_file_backend"`        // Allow the lab-only "file" storage type (loop devices)
+	FileBackendDir          string `mapstructure:"file_backend_dir"`          // Directory of the backing files on each node
+
+	// COW reserve of thick LVM snapshots created without a size: at least
+	// this percentage of the origin, or what the change rate seen by earlier
+	// snapshots of the origin writes within the horizon
+	SnapshotReservePercent int           `mapstructure:"snapshot_reserve_percent"`
+	SnapshotReserveHorizon time.Duration `mapstructure:"snapshot_reserve_horizon"`
+	// dmeventd extends thick snapshots by the percentage once their COW
+	// volume is allocated beyond the threshold, 100 disables it
+	SnapshotAutoextendThreshold int `mapstructure:"snapshot_autoextend_threshold"`
+	SnapshotAutoextendPercent   int `mapstructure:"snapshot_autoextend_percent"`
 }
 
 // MetricsConfig represents metrics configuration
 type MetricsConfig struct {
 	Enabled       bool   `mapstructure:"enabled"`
 	ListenAddress string `mapstructure:"listen_address"`
-	Port          int    `mapstructure:"port"`
+	Port          int    `mapstructure:"port"`      // gRPC API
+	RESTPort      int    `mapstructure:"rest_port"` // REST API, also served by the web UI under /v1
+	UIPort        int    `mapstructure:"ui_port"`
+}
+
+// SecretsConfig represents the secrets store of gateway credentials and
+// event hook headers
+type SecretsConfig struct {
+	Backend       string `mapstructure:"backend"`         // "local" (encrypted in the database) or "vault"
+	MasterKeyFile string `mapstructure:"master_key_file"` // AES-256 key of the local backend, generated if missing
+	VaultAddress  string `mapstructure:"vault_address"`
+	VaultToken    string `mapstructure:"vault_token"` // Falls back to $VAULT_TOKEN
+	VaultMount    string `mapstructure:"vault_mount"` // KV version 2 mount
+	VaultPrefix   string `mapstructure:"vault_prefix"`
+}
+
+// GatewayConfig represents the default mount directories of storage
+// gateways; each gateway mounts its volumes in a subdirectory named after
+// its resource, and may override the directories when it is created
+type GatewayConfig struct {
+	ExportBasePath     string `mapstructure:"export_base_path"`     // NFS exports
+	ClusterPrivatePath string `mapstructure:"cluster_private_path"` // Cluster-private volume 0
+}
+
+// TimeoutsConfig represents the timeout budgets of remote commands per
+// orchestration step, e.g. "30m"
+type TimeoutsConfig struct {
+	Default      time.Duration `mapstructure:"default"` // Commands without a step budget
+	PoolCreate   time.Duration `mapstructure:"pool_create"`
+	VolumeCreate time.Duration `mapstructure:"volume_create"`
+	Metadata     time.Duration `mapstructure:"metadata"` // DRBD metadata creation
+	DrbdUp       time.Duration `mapstructure:"drbd_up"`
+	Promote      time.Duration `mapstructure:"promote"`
+	Mkfs         time.Duration `mapstructure:"mkfs"`
+	Snapshot     time.Duration `mapstructure:"snapshot"`
+	Copy         time.Duration `mapstructure:"copy"` // Copying a backing volume to another pool
+}
+
+// ReconcileConfig represents the background comparison of database records
+// with the state found on the nodes
+type ReconcileConfig struct {
+	Interval time.Duration `mapstructure:"interval"` // 0 disables the background pass
+}
+
+// RebalanceConfig represents the periodic evaluation of where Primaries run
+type RebalanceConfig struct {
+	Interval  time.Duration `mapstructure:"interval"`  // 0 disables the background pass
+	Auto      bool          `mapstructure:"auto"`      // Perform the suggested moves instead of only reporting them
+	MaxMoves  int           `mapstructure:"max_moves"` // Moves per pass
+	Threshold int           `mapstructure:"threshold"` // Minimum Primary count difference between nodes to act on
+}
+
+// IPAMConfig represents the pools VIPs are allocated from with "auto"
+type IPAMConfig struct {
+	// Pool name to "first-last/prefix" range or CIDR, e.g.
+	// mgmt = "192.168.1.200-192.168.1.220/24"
+	Pools map[string]string `mapstructure:"pools"`
+}
+
+// ReactorConfig represents the handling of drbd-reactor plugin snippets
+type ReactorConfig struct {
+	// Also have drbd-reactorctl parse snippets on one node before they are
+	// distributed; they are always linted by the controller
+	Check bool `mapstructure:"check"`
+}
+
+// JobsConfig represents the records kept of mutating operations
+type JobsConfig struct {
+	SlowCommand time.Duration `mapstructure:"slow_command"` // Remote commands taking longer are flagged, 0 disables
+	Retention   int           `mapstructure:"retention"`    // Number of jobs kept
+}
+
+// EventsConfig represents the events log
+type EventsConfig struct {
+	Retention int `mapstructure:"retention"` // Number of events kept
+}
+
+// AdminConfig represents the guarded operator operations
+type AdminConfig struct {
+	// Token admin RPCs must present, e.g. for sds node exec
+	Token string `mapstructure:"token"`
+	// Allow running arbitrary commands on the nodes (sds node exec)
+	Exec bool `mapstructure:"exec"`
+}
+
+// AuthConfig represents the API keys of the principals sharing the controller
+type AuthConfig struct {
+	// API key of each principal, e.g. a team. With keys set every call must
+	// present one or the admin token, and principals only see the resources
+	// and gateways they own.
+	Keys map[string]string `mapstructure:"keys"`
+}
+
+// NetProbeConfig represents the replication network probe
+type NetProbeConfig struct {
+	Port     int           `mapstructure:"port"`     // TCP port of the throughput probe on the target node
+	Duration time.Duration `mapstructure:"duration"` // Length of a throughput measurement per link
+	// Links of resources with protocol C should not have a higher round trip
+	// time, every write waits for the peer
+	MaxSyncLatency time.Duration `mapstructure:"max_sync_latency"`
+}
+
+// TimeSyncConfig represents the clock skew check of the nodes
+type TimeSyncConfig struct {
+	Interval time.Duration `mapstructure:"interval"` // How often the clocks are checked, 0 disables the periodic check
+	MaxSkew  time.Duration `mapstructure:"max_skew"` // Highest tolerated difference to the controller's clock
+}
+
+// AutoHealConfig represents the automatic reconnection of DRBD connections
+// stuck in StandAlone or Connecting
+type AutoHealConfig struct {
+	Interval    time.Duration `mapstructure:"interval"`     // How often connections are checked, 0 disables auto-heal
+	After       time.Duration `mapstructure:"after"`        // How long a connection must be down before and between attempts
+	MaxAttempts int           `mapstructure:"max_attempts"` // Attempts per outage before it is reported as failed
+}
+
+// ReplicationConfig represents the scheduler of the snapshot replication
+// policies of resources
+type ReplicationConfig struct {
+	Interval time.Duration `mapstructure:"interval"` // How often policies are checked for a due run, 0 disables scheduled runs
+	Port     int           `mapstructure:"port"`     // TCP port the backup node receives a snapshot stream on
+}
+
+// DefaultDrbdOptions are the DRBD options of every resource that neither the
+// config nor the resource overrides
+var DefaultDrbdOptions = map[string]string{
+	"options/auto-promote":                  "no",
+	"options/quorum":                        "majority",
+	"options/on-no-quorum":                  "io-error",
+	"options/on-no-data-accessible":         "io-error",
+	"options/on-suspended-primary-outdated": "force-secondary",
+	"net/rr-conflict":                       "retry-connect",
+}
+
+// DrbdDefaultsConfig represents the DRBD options every resource gets unless
+// it sets them itself
+type DrbdDefaultsConfig struct {
+	// "section/key" to value, keys without a section are in the options
+	// section. Overrides DefaultDrbdOptions; an empty value drops the option
+	// so DRBD's own default applies.
+	Options map[string]string `mapstructure:"options"`
+	// Pool name to options that override Options for resources in the pool
+	Pools map[string]map[string]string `mapstructure:"pools"`
+}
+
+// ClusterConfig represents the identity of the cluster a controller manages
+// and further clusters it serves from the same process
+type ClusterConfig struct {
+	Name string `mapstructure:"name"` // Stamped into the database and generated configs
+	// Further clusters by name, each with its own nodes and database, e.g. for
+	// labs with several environments. Clients select one per call.
+	Extra map[string]ExtraClusterConfig `mapstructure:"extra"`
+}
+
+// ExtraClusterConfig represents a further cluster of a controller
+type ExtraClusterConfig struct {
+	DatabasePath string `mapstructure:"database_path"` // Default: <name>.db next to database.path
+}
+
+// PoolHistoryConfig represents the pool capacity samples kept for the
+// capacity graphs
+type PoolHistoryConfig struct {
+	Interval  time.Duration `mapstructure:"interval"`  // How often pool capacity is sampled, 0 disables sampling
+	Retention time.Duration `mapstructure:"retention"` // How long samples are kept
+}
+
+// ReportConfig represents the scheduled cluster report
+type ReportConfig struct {
+	Interval  time.Duration `mapstructure:"interval"`  // How often a report over the past interval is generated, 0 disables scheduled reports
+	Format    string        `mapstructure:"format"`    // "html" or "md"
+	Directory string        `mapstructure:"directory"` // Where scheduled reports are written
+	Command   string        `mapstructure:"command"`   // Shell command each scheduled report is piped into, e.g. a mail command
+}
+
+// SSHConfig represents how the controller verifies the host keys of the nodes
+// it connects to
+type SSHConfig struct {
+	KnownHosts string `mapstructure:"known_hosts"` // Host keys pinned with sds node trust
+	// Refuse nodes whose host key is not pinned; otherwise an unknown key is
+	// pinned on first use
+	StrictHostKey bool `mapstructure:"strict_host_key"`
+	// "full": passwordless sudo for any command, "restricted": only the
+	// commands of the policy sds node harden installs
+	Sudo string `mapstructure:"sudo"`
+}
+
+// FencingConfig represents the DRBD fence-peer, unfence-peer and event
+// handlers installed with sds fencing install
+type FencingConfig struct {
+	// REST API URLs of the controller the handlers call, tried in order,
+	// e.g. http://10.0.0.1:3375
+	ControllerURLs []string      `mapstructure:"controller_urls"`
+	Timeout        time.Duration `mapstructure:"timeout"` // How long a handler waits for one controller
+}
+
+// NamingConfig represents the names of the artifacts sds creates for a
+// resource, as templates with the placeholders {resource} and {cluster}.
+// Names are recorded when an artifact is created, so changing a template only
+// affects new ones.
+type NamingConfig struct {
+	Volume   string `mapstructure:"volume"`    // LV or zvol backing a new resource
+	HaConfig string `mapstructure:"ha_config"` // drbd-reactor promoter config of sds ha create, without .toml
+}
+
+// ForCluster returns the configuration of an extra cluster: its own name and
+// database, the Vault prefix extended by the name, and no metrics server, the
+// one of the controller covers all clusters
+func (c *Config) ForCluster(name string) *Config {
+	cfg := *c
+	cfg.Cluster = ClusterConfig{Name: name}
+	cfg.Database.Path = c.Cluster.Extra[name].DatabasePath
+	if cfg.Database.Path == "" {
+		cfg.Database.Path = filepath.Join(filepath.Dir(c.Database.Path), name+".db")
+	}
+	cfg.Secrets.VaultPrefix = c.Secrets.VaultPrefix + "/" + name
+	cfg.Metrics.Enabled = false
+	return &cfg
 }
 
 // Load loads configuration from file
@@ -78,8 +355,9 @@ func Load(configPath string) (*Config, error) {
 		return nil, fmt.Errorf("failed to read config: %w", err)
 	}
 
+	// Unknown keys are most likely typos, fail instead of ignoring them
 	var config Config
-	if err := viper.Unmarshal(&config); err != nil {
+	if err := viper.UnmarshalExact(&config); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
@@ -87,6 +365,9 @@ func Load(configPath string) (*Config, error) {
 	if err := config.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid config: %w", err)
 	}
+	if err := config.Check(); err != nil {
+		return nil, fmt.Errorf("invalid config:\n%w", err)
+	}
 
 	return &config, nil
 }
@@ -99,24 +380,106 @@ func (c *Config) Validate() error {
 	if c.Server.Port == 0 {
 		c.Server.Port = 3374
 	}
+	if c.Server.RESTPort == 0 {
+		c.Server.RESTPort = 3375
+	}
+	if c.Server.UIPort == 0 {
+		c.Server.UIPort = 3376
+	}
 	if c.Log.Level == "" {
 		c.Log.Level = "info"
 	}
+	if c.Server.Reflection && c.Admin.Token == "" {
+		return fmt.Errorf("server.reflection needs admin.token, reflection calls must present it")
+	}
 	return nil
 }
 
 func setDefaults() {
 	viper.SetDefault("server.listen_address", "0.0.0.0")
 	viper.SetDefault("server.port", 3374)
+	viper.SetDefault("server.rest_port", 3375)
+	viper.SetDefault("server.ui_port", 3376)
+	viper.SetDefault("server.max_recv_msg_size", 16*1024*1024)
+	viper.SetDefault("server.max_send_msg_size", 16*1024*1024)
+	viper.SetDefault("server.read_timeout", "2m")
+	viper.SetDefault("server.write_timeout", "0s")
+	viper.SetDefault("server.max_connections", 1024)
+	viper.SetDefault("server.max_concurrent_streams", 256)
+	viper.SetDefault("server.keepalive_min_time", "5s")
+	viper.SetDefault("server.keepalive_permit_without_stream", true)
+	viper.SetDefault("server.keepalive_time", "1m")
+	viper.SetDefault("server.keepalive_timeout", "20s")
+	viper.SetDefault("server.max_connection_idle", "0s")
+	viper.SetDefault("server.reflection", false)
 	viper.SetDefault("database.path", "/var/lib/sds/sds.db")
 	viper.SetDefault("tls.enabled", false)
 	viper.SetDefault("log.level", "info")
 	viper.SetDefault("log.format", "json")
+	viper.SetDefault("log.exec_output", true)
 	viper.SetDefault("storage.default_pool_type", "vg")
 	viper.SetDefault("storage.default_snapshot_suffix", "_snap")
+	viper.SetDefault("storage.safety_snapshots", true)
+	viper.SetDefault("storage.safety_snapshot_retention", 3)
+	viper.SetDefault("storage.allow_file_backend", false)
+	viper.SetDefault("storage.file_backend_dir", "/var/lib/sds/loop")
+	viper.SetDefault("storage.snapshot_reserve_percent", 10)
+	viper.SetDefault("storage.snapshot_reserve_horizon", "24h")
+	viper.SetDefault("storage.snapshot_autoextend_threshold", 70)
+	viper.SetDefault("storage.snapshot_autoextend_percent", 20)
 	viper.SetDefault("metrics.enabled", true)
 	viper.SetDefault("metrics.listen_address", "0.0.0.0")
 	viper.SetDefault("metrics.port", 9433)
+	viper.SetDefault("secrets.backend", "local")
+	viper.SetDefault("secrets.master_key_file", "/etc/sds/master.key")
+	viper.SetDefault("secrets.vault_mount", "secret")
+	viper.SetDefault("secrets.vault_prefix", "sds")
+	viper.SetDefault("gateway.export_base_path", "/srv/gateway-exports")
+	viper.SetDefault("gateway.cluster_private_path", "/var/lib/sds")
+	viper.SetDefault("timeouts.default", "30s")
+	viper.SetDefault("timeouts.pool_create", "10m")
+	viper.SetDefault("timeouts.volume_create", "5m")
+	viper.SetDefault("timeouts.metadata", "5m")
+	viper.SetDefault("timeouts.drbd_up", "2m")
+	viper.SetDefault("timeouts.promote", "2m")
+	viper.SetDefault("timeouts.mkfs", "30m")
+	viper.SetDefault("timeouts.snapshot", "5m")
+	viper.SetDefault("timeouts.copy", "6h")
+	viper.SetDefault("reconcile.interval", "5m")
+	viper.SetDefault("rebalance.interval", "0s")
+	viper.SetDefault("rebalance.auto", false)
+	viper.SetDefault("rebalance.max_moves", 1)
+	viper.SetDefault("rebalance.threshold", 2)
+	viper.SetDefault("reactor.check", false)
+	viper.SetDefault("jobs.slow_command", "10s")
+	viper.SetDefault("jobs.retention", 1000)
+	viper.SetDefault("events.retention", 10000)
+	viper.SetDefault("admin.token", "")
+	viper.SetDefault("admin.exec", false)
+	viper.SetDefault("netprobe.port", 5201)
+	viper.SetDefault("netprobe.duration", "5s")
+	viper.SetDefault("netprobe.max_sync_latency", "5ms")
+	viper.SetDefault("timesync.interval", "5m")
+	viper.SetDefault("timesync.max_skew", "500ms")
+	viper.SetDefault("report.interval", "0s")
+	viper.SetDefault("report.format", "html")
+	viper.SetDefault("report.directory", "/var/lib/sds/reports")
+	viper.SetDefault("report.command", "")
+	viper.SetDefault("autoheal.interval", "30s")
+	viper.SetDefault("autoheal.after", "2m")
+	viper.SetDefault("autoheal.max_attempts", 3)
+	viper.SetDefault("pool_history.interval", "5m")
+	viper.SetDefault("pool_history.retention", "2160h")
+	viper.SetDefault("cluster.name", "default")
+	viper.SetDefault("ssh.known_hosts", "/var/lib/sds/known_hosts")
+	viper.SetDefault("ssh.strict_host_key", true)
+	viper.SetDefault("ssh.sudo", "full")
+	viper.SetDefault("fencing.controller_urls", []string{})
+	viper.SetDefault("fencing.timeout", "30s")
+	viper.SetDefault("naming.volume", DefaultVolumeName)
+	viper.SetDefault("naming.ha_config", DefaultHaConfigName)
+	viper.SetDefault("replication.interval", "1m")
+	viper.SetDefault("replication.port", 5202)
 }
 
 // Save saves configuration to file
@@ -128,6 +491,28 @@ func (c *Config) Save(path string) error {
 	config.Set("log", c.Log)
 	config.Set("storage", c.Storage)
 	config.Set("metrics", c.Metrics)
+	config.Set("secrets", c.Secrets)
+	config.Set("gateway", c.Gateway)
+	config.Set("timeouts", c.Timeouts)
+	config.Set("reconcile", c.Reconcile)
+	config.Set("rebalance", c.Rebalance)
+	config.Set("ipam", c.IPAM)
+	config.Set("reactor", c.Reactor)
+	config.Set("jobs", c.Jobs)
+	config.Set("events", c.Events)
+	config.Set("admin", c.Admin)
+	config.Set("auth", c.Auth)
+	config.Set("netprobe", c.NetProbe)
+	config.Set("timesync", c.TimeSync)
+	config.Set("autoheal", c.AutoHeal)
+	config.Set("pool_history", c.PoolHistory)
+	config.Set("report", c.Report)
+	config.Set("drbd_defaults", c.DrbdDefaults)
+	config.Set("cluster", c.Cluster)
+	config.Set("ssh", c.SSH)
+	config.Set("fencing", c.Fencing)
+	config.Set("naming", c.Naming)
+	config.Set("replication", c.Replication)
 
 	return config.WriteConfigAs(path)
 }
@@ -0,0 +1,311 @@
+package config
+
+// DefaultTOML is the default controller configuration with every setting
+// documented, as printed by sds-controller --print-default-config
+const DefaultTOML = `# SDS Controller Configuration
+#
+# Default location: /etc/sds/controller.toml. Every setting can be overridden
+# by an SDS_<SECTION>_<KEY> environment variable.
+
+[server]
+# Address of the gRPC API (port), the REST API (rest_port) and the web UI
+# (ui_port). The HTTP listeners speak HTTP/1.1 and cleartext HTTP/2; the web
+# UI also serves the REST API under /v1.
+listen_address = "0.0.0.0"
+port = 3374
+rest_port = 3375
+ui_port = 3376
+# Limits of the gRPC API, so one misbehaving client cannot destabilize the
+# controller. Message sizes are in bytes; the REST API and web UI are clients
+# of the gRPC API and share its limits.
+max_recv_msg_size = 16777216
+max_send_msg_size = 16777216
+# Deadlines the controller sets on read-only (Get, List, dry runs) and on
+# changing RPCs, shortened by earlier client deadlines. Remote commands of
+# changing RPCs are bounded by [timeouts]. 0 means no deadline.
+read_timeout = "2m"
+write_timeout = "0s"
+# Open connections and concurrent RPCs per connection; further connections
+# wait until one is closed. 0 means unlimited connections.
+max_connections = 1024
+max_concurrent_streams = 256
+# Clients sending keepalive pings more often than keepalive_min_time (or
+# without an RPC in flight unless permitted) are disconnected
+keepalive_min_time = "5s"
+keepalive_permit_without_stream = true
+# Ping idle clients after keepalive_time and drop them when the ping is not
+# answered within keepalive_timeout; close connections idle for
+# max_connection_idle (0 keeps them open)
+keepalive_time = "1m"
+keepalive_timeout = "20s"
+max_connection_idle = "0s"
+# Serve gRPC server reflection, so grpcurl can list and call the API without
+# the proto files, e.g. grpcurl -H "x-sds-admin-token: $SDS_ADMIN_TOKEN"
+# -plaintext controller:3374 list. Reflection calls must present
+# admin.token. For development and integration work only.
+reflection = false
+
+[database]
+# Database file, its directory must exist
+path = "/var/lib/sds/sds.db"
+
+[cluster]
+# Name of the cluster, stored in the database with a generated UUID and
+# stamped into generated DRBD and drbd-reactor configs. A database created for
+# another cluster name is refused.
+name = "default"
+# Further clusters served by this controller, each with its own nodes and
+# database (default: <name>.db next to database.path). Clients select one with
+# sds --cluster <name> or the x-sds-cluster header; without it calls go to the
+# cluster above.
+[cluster.extra]
+# [cluster.extra.lab2]
+# database_path = "/var/lib/sds/lab2.db"
+
+[ssh]
+# Host keys of the nodes, pinned with sds node trust <node>
+known_hosts = "/var/lib/sds/known_hosts"
+# Refuse to connect to nodes whose host key is not pinned. Disabling it pins
+# the key a node presents on first contact (trust on first use); a changed key
+# is refused either way.
+strict_host_key = true
+# sudo rights of the SSH user on the nodes: "full" (passwordless sudo for any
+# command) or "restricted" (only the commands of the policy that
+# sds node harden <node> installs). With "restricted" the controller runs
+# every privileged command through sudo -n.
+sudo = "full"
+
+[fencing]
+# sds fencing install puts a fence-peer and unfence-peer handler on the nodes
+# that asks the controller to outdate a lost peer, or to keep it from being
+# promoted while it is unreachable, before DRBD resumes I/O. The handlers try
+# these REST API URLs in order, waiting timeout for each. With URLs set, new
+# resources also get split-brain, out-of-sync and pri-lost-after-sb handlers
+# that report to the events log; override them in [drbd_defaults.options],
+# e.g. "handlers/pri-lost-after-sb" = "" to drop one.
+controller_urls = []   # e.g. ["http://10.0.0.1:3375"]
+timeout = "30s"
+
+[naming]
+# Names of what sds creates for a resource, with the placeholders {resource}
+# and {cluster} (the cluster name). The names are recorded with the resource
+# and its HA config, so a changed template only applies to new ones. Besides
+# {resource} a template needs fixed text, sds garbage collection relies on it.
+volume = "{resource}_data"       # LV or zvol backing the resource
+ha_config = "sds-ha-{resource}"  # drbd-reactor config of sds ha create, .toml is added
+
+[tls]
+enabled = false
+# Certificate files, required when TLS is enabled
+# ca_cert = "/etc/sds/certs/ca.crt"
+# client_cert = "/etc/sds/certs/client.crt"
+# client_key = "/etc/sds/certs/client.key"
+
+[log]
+level = "info"   # debug, info, warn or error
+format = "json"  # json or text
+# Log the output of remote commands at debug level. Known secrets (passwords,
+# LUKS keys, DRBD shared secrets) are redacted, but outputs may still contain
+# sensitive data; disable in production.
+exec_output = true
+
+[storage]
+default_pool_type = "vg"  # vg, lvm-thin, zfs or zfs-thin
+default_snapshot_suffix = "_snap"
+# Take a safety snapshot before resize, restore, remove-volume and delete
+# (skip per operation with --no-safety-snapshot) and keep the newest N per volume
+safety_snapshots = true
+safety_snapshot_retention = 3
+# The "file" storage type backs resources by sparse files on loop devices.
+# It is meant for labs, demos and CI only and never for production data.
+allow_file_backend = false
+file_backend_dir = "/var/lib/sds/loop"
+# Thick LVM snapshots created without a size get a COW volume of at least
+# snapshot_reserve_percent of their origin, more if earlier snapshots of the
+# origin saw it change faster than that within snapshot_reserve_horizon.
+# Creation fails if the volume group lacks the space. dmeventd extends them
+# by snapshot_autoextend_percent once they are snapshot_autoextend_threshold
+# percent allocated (set in lvm.conf on the nodes, 100 disables it), so they
+# are not silently invalidated.
+snapshot_reserve_percent = 10
+snapshot_reserve_horizon = "24h"
+snapshot_autoextend_threshold = 70
+snapshot_autoextend_percent = 20
+
+[secrets]
+# Gateway credentials (CHAP passwords) and the headers of event hooks (webhook
+# tokens) are kept in a secrets store, gateway configs and hooks only
+# reference them. "local" encrypts them in the database with the master key,
+# which is generated on first start; back it up with the database.
+backend = "local"  # local or vault
+master_key_file = "/etc/sds/master.key"
+# HashiCorp Vault KV version 2 engine, used with backend = "vault"
+# vault_address = "https://vault.example.com:8200"
+# vault_token = ""  # or set VAULT_TOKEN
+vault_mount = "secret"
+vault_prefix = "sds"
+
+[gateway]
+# Storage gateways mount their volumes under these directories on the nodes,
+# in a subdirectory named after the resource. A gateway may override them when
+# it is created; the directories of a gateway are recorded with it.
+export_base_path = "/srv/gateway-exports"    # NFS exports
+cluster_private_path = "/var/lib/sds"        # cluster-private volume 0
+
+[timeouts]
+# Timeout budgets of remote commands per orchestration step. Clients wait for
+# the controller, which enforces these.
+default = "30s"        # commands without a step budget
+pool_create = "10m"
+volume_create = "5m"
+metadata = "5m"        # DRBD metadata creation
+drbd_up = "2m"
+promote = "2m"
+mkfs = "30m"
+snapshot = "5m"
+copy = "6h"            # copying a backing volume to another pool
+
+[reconcile]
+# Compare resources, HA configs and gateways with the configs and DRBD state
+# found on the nodes and report drift (sds drift, repair with sds repair).
+# 0 disables the background pass.
+interval = "5m"
+
+[rebalance]
+# Evaluate where Primaries run and suggest moves that even out the number of
+# Primaries per node, respecting placement rules (sds rebalance). With auto,
+# the moves are performed unless the controller is frozen. 0 disables the
+# background pass.
+interval = "0s"
+auto = false
+max_moves = 1   # moves per pass
+threshold = 2   # minimum Primary count difference between two nodes
+
+[reactor]
+# Promoter and gateway snippets are linted before they are distributed. With
+# check, drbd-reactorctl also parses them on the first node of the resource.
+check = false
+
+[jobs]
+# Every mutating operation is recorded as a job with the timing of its remote
+# commands (sds job describe). Commands slower than slow_command are flagged
+# and logged; 0 disables the flagging.
+slow_command = "10s"
+retention = 1000   # jobs kept, oldest are dropped first
+
+[events]
+# The events log keeps the newest events, oldest are dropped first. DR
+# failback needs the event of the last switchover of a resource.
+retention = 10000
+
+[admin]
+# Admin operations must present this token (--admin-token or SDS_ADMIN_TOKEN).
+# With exec, admins can run arbitrary commands on the nodes through the
+# controller (sds node exec); every run is recorded in the events log.
+token = ""
+exec = false
+
+[auth]
+# API keys of the principals sharing the controller (--api-key or
+# SDS_API_KEY). Once keys are set every call needs a key or the admin token,
+# REST clients and the web UI send it as the X-Sds-Api-Key header. Resources
+# and gateways are owned by the principal that created them and only shown
+# to it; the admin sees everything, what it creates is shared. Cluster
+# administration (pools, nodes, freeze, global DRBD config, ...) and sds
+# fencing clear need the admin token. The DRBD handlers authenticate with
+# per-node keys, rerun sds fencing install once keys are set; each key only
+# speaks for its own node.
+[auth.keys]
+# team-a = "change-me"
+
+[netprobe]
+# sds net probe measures latency and throughput between the nodes of the
+# replication network with iperf3, or a plain TCP transfer where iperf3 is
+# not installed. Links slower than the sync rates of the resources they carry,
+# or protocol C links with a round trip above max_sync_latency, are reported.
+port = 5201
+duration = "5s"
+max_sync_latency = "5ms"
+
+[timesync]
+# The clocks of the nodes are compared with the controller's every interval
+# (0 disables, sds node check time checks on demand). Skew above max_skew
+# breaks the correlation of logs and events across nodes and the timing of
+# failovers; it is shown in the node health and exported as
+# sds_node_clock_skew_seconds for the generated alerting rules. Nodes whose
+# clock chrony or systemd-timesyncd does not keep in sync are reported too.
+interval = "5m"
+max_skew = "500ms"
+
+[autoheal]
+# DRBD connections that stay StandAlone or Connecting for longer than after
+# are reconnected (drbdadm connect, or adjust on the peer) up to max_attempts
+# times, waiting after between attempts. Every attempt is recorded in the
+# events log, an outage that could not be healed raises autoheal.failed.
+# Split brains are never resolved automatically. 0 disables auto-heal.
+interval = "30s"
+after = "2m"
+max_attempts = 3
+
+[replication]
+# Resources with a replication policy (sds resource snapshot replication set)
+# are snapshotted on one of their nodes every policy interval, and the
+# snapshots of their ZFS volumes are sent incrementally to a backup node,
+# which receives them on port. The stream is not encrypted, like the DRBD
+# replication itself. The scheduler checks for due policies each interval,
+# 0 disables scheduled runs.
+interval = "1m"
+port = 5202
+
+[pool_history]
+# The node poller stores the total, free and allocated capacity of every pool
+# each interval for sds pool history and the capacity graphs of the UI.
+# Samples older than retention are dropped. 0 disables sampling.
+interval = "5m"
+retention = "2160h"   # 90 days
+
+[report]
+# Cluster report over the past interval (sds report weekly): capacity
+# changes, failovers, resync events, snapshot success rates and outstanding
+# alerts. Each report is written to directory as sds-report-<cluster>-<date>
+# and, if command is set, piped into it by sh -c with SDS_REPORT_FILE and
+# SDS_REPORT_SUBJECT set, e.g. to mail it. 0 disables scheduled reports.
+interval = "0s"       # e.g. "168h" for a weekly report
+format = "html"       # html or md
+directory = "/var/lib/sds/reports"
+command = ""          # e.g. "mail -s \"$SDS_REPORT_SUBJECT\" ops@example.com"
+
+[drbd_defaults]
+# DRBD options every resource gets unless it sets them itself with
+# --drbd-options. The built-in defaults are listed below, entries here
+# override them one by one. Keys are "section/key", keys without a section
+# are in the options section. An empty value drops the option so DRBD's own
+# default applies, e.g. "auto-promote" = "" for libvirt, which promotes the
+# resource when it opens the device. Pools can override options in
+# [drbd_defaults.pools.<pool>]; a resource can drop one with an empty value,
+# e.g. --drbd-options auto-promote=
+[drbd_defaults.options]
+"auto-promote" = "no"
+"quorum" = "majority"
+"on-no-quorum" = "io-error"
+"on-no-data-accessible" = "io-error"
+"on-suspended-primary-outdated" = "force-secondary"
+"net/rr-conflict" = "retry-connect"
+[drbd_defaults.pools]
+# [drbd_defaults.pools.vms]
+# "auto-promote" = "yes"
+
+[ipam]
+# Pools for --vip auto / --service-ip auto, as "first-last/prefix" or CIDR.
+# Allocated VIPs are reserved in the database and ARP-probed before use.
+[ipam.pools]
+# mgmt = "192.168.1.200-192.168.1.220/24"
+
+[metrics]
+enabled = true
+listen_address = "0.0.0.0"
+port = 9433
+# Prometheus metrics are served at /metrics and Grafana dashboards at
+# /metrics/dashboards (use ?uid=sds-resources|sds-pools|sds-controller to
+# fetch a single importable dashboard)
+`
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// VIPPool is a range of service IPs that VIPs are allocated from
+type VIPPool struct {
+	First  netip.Addr
+	Last   netip.Addr
+	Prefix int // Prefix length the VIPs are configured with
+}
+
+// ParseVIPPool parses a pool given as "first-last/prefix", e.g.
+// "192.168.1.200-192.168.1.220/24", or as a CIDR, whose host addresses
+// make up the pool
+func ParseVIPPool(spec string) (*VIPPool, error) {
+	rangeSpec, bitsSpec, ok := strings.Cut(spec, "/")
+	if !ok {
+		return nil, fmt.Errorf("%q has no prefix length", spec)
+	}
+
+	if firstSpec, lastSpec, isRange := strings.Cut(rangeSpec, "-"); isRange {
+		first, err := netip.ParseAddr(strings.TrimSpace(firstSpec))
+		if err != nil {
+			return nil, fmt.Errorf("invalid first address: %w", err)
+		}
+		last, err := netip.ParseAddr(strings.TrimSpace(lastSpec))
+		if err != nil {
+			return nil, fmt.Errorf("invalid last address: %w", err)
+		}
+		prefix, err := netip.ParsePrefix(first.String() + "/" + bitsSpec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid prefix length: %w", err)
+		}
+		if first.BitLen() != last.BitLen() || last.Less(first) {
+			return nil, fmt.Errorf("%s is not after %s", last, first)
+		}
+		if !prefix.Contains(last) {
+			return nil, fmt.Errorf("%s and %s are not in the same /%d network", first, last, prefix.Bits())
+		}
+		return &VIPPool{First: first, Last: last, Prefix: prefix.Bits()}, nil
+	}
+
+	prefix, err := netip.ParsePrefix(spec)
+	if err != nil {
+		return nil, err
+	}
+	prefix = prefix.Masked()
+
+	first := prefix.Addr()
+	last := lastAddr(prefix)
+	// IPv4 networks lose their network and broadcast addresses
+	if first.Is4() && prefix.Bits() < 31 {
+		first, last = first.Next(), last.Prev()
+	}
+	if last.Less(first) {
+		return nil, fmt.Errorf("%s has no host addresses", spec)
+	}
+	return &VIPPool{First: first, Last: last, Prefix: prefix.Bits()}, nil
+}
+
+// lastAddr returns the last address of a masked prefix
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	bytes := prefix.Addr().AsSlice()
+	for i := range bytes {
+		// Bits of this byte that belong to the host part
+		hostBits := (i+1)*8 - prefix.Bits()
+		switch {
+		case hostBits >= 8:
+			bytes[i] = 0xff
+		case hostBits > 0:
+			bytes[i] |= byte(1<<hostBits) - 1
+		}
+	}
+	addr, _ := netip.AddrFromSlice(bytes)
+	return addr
+}
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Placeholders of the naming templates
+const (
+	NameResource = "{resource}"
+	NameCluster  = "{cluster}"
+)
+
+// Default naming templates, the names sds used before they were configurable
+const (
+	DefaultVolumeName   = NameResource + "_data"
+	DefaultHaConfigName = "sds-ha-" + NameResource
+)
+
+// namePattern matches names valid as LVM LV, ZFS zvol and file names
+var namePattern = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]*$`)
+
+// reservedVolumeParts are name parts LVM reserves for its internal volumes
+var reservedVolumeParts = []string{"_cdata", "_cmeta", "_corig", "_mimage", "_mlog", "_pmspare", "_rimage", "_rmeta", "_tdata", "_tmeta", "_vdata", "_vorigin"}
+
+// gatewayConfigPrefixes are the drbd-reactor config names of gateways
+var gatewayConfigPrefixes = []string{"sds-nfs-", "sds-iscsi-", "sds-nvmeof-"}
+
+// ExpandName fills in the resource and cluster name of a naming template
+func ExpandName(template, resource, cluster string) string {
+	return strings.NewReplacer(NameResource, resource, NameCluster, cluster).Replace(template)
+}
+
+// MatchName returns the resource a name was expanded from with the template,
+// and whether it was
+func MatchName(template, cluster, name string) (string, bool) {
+	prefix, suffix, _ := strings.Cut(ExpandName(template, NameResource, cluster), NameResource)
+	if len(name) <= len(prefix)+len(suffix) || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, suffix) {
+		return "", false
+	}
+	return name[len(prefix) : len(name)-len(suffix)], true
+}
+
+// checkNameTemplate checks a naming template: it contains {resource} once,
+// so every resource gets its own name, and fixed text besides it, so sds can
+// tell its names from others, and it expands to a valid name
+func checkNameTemplate(template string) error {
+	if strings.Count(template, NameResource) != 1 {
+		return fmt.Errorf("%q must contain %s exactly once", template, NameResource)
+	}
+	if strings.TrimSpace(strings.ReplaceAll(strings.ReplaceAll(template, NameResource, ""), NameCluster, "")) == "" {
+		return fmt.Errorf("%q needs fixed text besides the placeholders", template)
+	}
+	name := ExpandName(template, "r0", "default")
+	if strings.ContainsAny(name, "{}") {
+		return fmt.Errorf("%q has an unknown placeholder, only %s and %s are known", template, NameResource, NameCluster)
+	}
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("%q must expand to letters, digits, _, . and -, starting with a letter, digit or _", template)
+	}
+	return nil
+}
+
+// CheckVolumeName checks the template of backing volume names
+func CheckVolumeName(template string) error {
+	if err := checkNameTemplate(template); err != nil {
+		return err
+	}
+	name := ExpandName(template, "r0", "default")
+	if strings.HasPrefix(name, "snapshot") || strings.HasPrefix(name, "pvmove") {
+		return fmt.Errorf("%q expands to a name LVM reserves", template)
+	}
+	for _, part := range reservedVolumeParts {
+		if strings.Contains(name, part) {
+			return fmt.Errorf("%q contains %s, which LVM reserves", template, part)
+		}
+	}
+	return nil
+}
+
+// CheckHaConfigName checks the template of HA drbd-reactor config names
+func CheckHaConfigName(template string) error {
+	if err := checkNameTemplate(template); err != nil {
+		return err
+	}
+	name := ExpandName(template, "r0", "default")
+	for _, prefix := range gatewayConfigPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return fmt.Errorf("%q collides with the gateway configs %s<resource>", template, prefix)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,388 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Check strictly validates the configuration and returns all problems found,
+// joined into one error
+func (c *Config) Check() error {
+	var errs []error
+	add := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	switch c.Log.Level {
+	case "debug", "info", "warn", "error":
+	default:
+		add("log.level: unknown level %q (debug, info, warn, error)", c.Log.Level)
+	}
+	switch c.Log.Format {
+	case "", "json", "text":
+	default:
+		add("log.format: unknown format %q (json, text)", c.Log.Format)
+	}
+
+	if net.ParseIP(c.Server.ListenAddress) == nil {
+		add("server.listen_address: %q is not an IP address", c.Server.ListenAddress)
+	}
+	if c.Metrics.Enabled && net.ParseIP(c.Metrics.ListenAddress) == nil {
+		add("metrics.listen_address: %q is not an IP address", c.Metrics.ListenAddress)
+	}
+	errs = append(errs, c.checkPorts()...)
+	if c.Server.MaxRecvMsgSize < 64*1024 || c.Server.MaxSendMsgSize < 64*1024 {
+		add("server.max_recv_msg_size, server.max_send_msg_size: must be at least 65536 bytes")
+	}
+	if c.Server.ReadTimeout < 0 || c.Server.WriteTimeout < 0 || c.Server.KeepaliveMinTime < 0 ||
+		c.Server.KeepaliveTime < 0 || c.Server.KeepaliveTimeout < 0 || c.Server.MaxConnectionIdle < 0 {
+		add("server: durations must not be negative")
+	}
+	if c.Server.ReadTimeout > 0 && c.Server.ReadTimeout < time.Second {
+		add("server.read_timeout: %s is too short, use at least 1s", c.Server.ReadTimeout)
+	}
+	if c.Server.WriteTimeout > 0 && c.Server.WriteTimeout < time.Minute {
+		add("server.write_timeout: %s is too short for orchestration steps, use at least 1m", c.Server.WriteTimeout)
+	}
+	if c.Server.MaxConnections < 0 {
+		add("server.max_connections: must not be negative")
+	} else if c.Server.MaxConnections > 0 && c.Server.MaxConnections < 16 {
+		add("server.max_connections: %d leaves no room for the REST API and CLI clients, use at least 16", c.Server.MaxConnections)
+	}
+
+	if c.Database.Path == "" {
+		add("database.path: must be set")
+	} else if err := checkDir(filepath.Dir(c.Database.Path)); err != nil {
+		add("database.path: %v", err)
+	}
+
+	if c.TLS.Enabled {
+		for key, path := range map[string]string{
+			"tls.ca_cert":     c.TLS.CACert,
+			"tls.client_cert": c.TLS.ClientCert,
+			"tls.client_key":  c.TLS.ClientKey,
+		} {
+			if path == "" {
+				add("%s: must be set when TLS is enabled", key)
+			} else if _, err := os.Stat(path); err != nil {
+				add("%s: %v", key, err)
+			}
+		}
+	}
+
+	switch c.Storage.DefaultPoolType {
+	case "", "vg", "lvm", "lvm-thin", "thin", "zfs", "zfs-thin":
+	default:
+		add("storage.default_pool_type: unknown pool type %q", c.Storage.DefaultPoolType)
+	}
+	if c.Storage.SafetySnapshotRetention < 0 {
+		add("storage.safety_snapshot_retention: must not be negative")
+	}
+	if c.Storage.AllowFileBackend && !filepath.IsAbs(c.Storage.FileBackendDir) {
+		add("storage.file_backend_dir: %q must be an absolute path", c.Storage.FileBackendDir)
+	}
+	if c.Storage.SnapshotReservePercent < 1 || c.Storage.SnapshotReservePercent > 100 {
+		add("storage.snapshot_reserve_percent: must be between 1 and 100")
+	}
+	if c.Storage.SnapshotReserveHorizon < 0 {
+		add("storage.snapshot_reserve_horizon: must not be negative")
+	}
+	if c.Storage.SnapshotAutoextendThreshold < 50 || c.Storage.SnapshotAutoextendThreshold > 100 {
+		add("storage.snapshot_autoextend_threshold: must be between 50 and 100 (100 disables it)")
+	}
+	if c.Storage.SnapshotAutoextendPercent < 1 {
+		add("storage.snapshot_autoextend_percent: must be positive")
+	}
+
+	if c.Timeouts.Default < 0 || c.Timeouts.PoolCreate < 0 || c.Timeouts.VolumeCreate < 0 ||
+		c.Timeouts.Metadata < 0 || c.Timeouts.DrbdUp < 0 || c.Timeouts.Promote < 0 ||
+		c.Timeouts.Mkfs < 0 || c.Timeouts.Snapshot < 0 || c.Timeouts.Copy < 0 {
+		add("timeouts: durations must not be negative")
+	}
+	if c.Reconcile.Interval < 0 {
+		add("reconcile.interval: must not be negative")
+	} else if c.Reconcile.Interval > 0 && c.Reconcile.Interval < 30*time.Second {
+		add("reconcile.interval: %s is too short, use at least 30s", c.Reconcile.Interval)
+	}
+	if c.Rebalance.Interval < 0 {
+		add("rebalance.interval: must not be negative")
+	} else if c.Rebalance.Interval > 0 && c.Rebalance.Interval < time.Minute {
+		add("rebalance.interval: %s is too short, use at least 1m", c.Rebalance.Interval)
+	}
+	for name, spec := range c.IPAM.Pools {
+		if _, err := ParseVIPPool(spec); err != nil {
+			add("ipam.pools.%s: %v", name, err)
+		}
+	}
+	checkDrbdOptions := func(prefix string, options map[string]string) {
+		for key, value := range options {
+			if key == "" || strings.HasSuffix(key, "/") {
+				add("%s: empty option name", prefix)
+			} else if strings.ContainsAny(key+value, ";{}\n") {
+				add("%s.%s: option names and values must not contain ; { } or newlines", prefix, key)
+			}
+		}
+	}
+	checkDrbdOptions("drbd_defaults.options", c.DrbdDefaults.Options)
+	for pool, options := range c.DrbdDefaults.Pools {
+		checkDrbdOptions("drbd_defaults.pools."+pool, options)
+	}
+	if c.Rebalance.MaxMoves < 1 {
+		add("rebalance.max_moves: must be at least 1")
+	}
+	if c.Rebalance.Threshold < 2 {
+		add("rebalance.threshold: must be at least 2, a difference of 1 cannot be evened out")
+	}
+	if c.Jobs.SlowCommand < 0 {
+		add("jobs.slow_command: must not be negative")
+	}
+	if c.Jobs.Retention < 1 {
+		add("jobs.retention: must be at least 1")
+	}
+	if c.Events.Retention < 1 {
+		add("events.retention: must be at least 1")
+	}
+	if c.Admin.Exec && c.Admin.Token == "" {
+		add("admin.exec: requires admin.token, arbitrary commands must not be open to every client")
+	}
+	principals := make([]string, 0, len(c.Auth.Keys))
+	for principal := range c.Auth.Keys {
+		principals = append(principals, principal)
+	}
+	sort.Strings(principals)
+	keys := make(map[string]string)
+	for _, principal := range principals {
+		key := c.Auth.Keys[principal]
+		switch {
+		case principal == "admin":
+			add("auth.keys.admin: admin is the principal of the admin token")
+		case key == "":
+			add("auth.keys.%s: key must not be empty", principal)
+		case key == c.Admin.Token:
+			add("auth.keys.%s: key must differ from admin.token", principal)
+		case keys[key] != "":
+			add("auth.keys.%s: key is also the key of %s", principal, keys[key])
+		default:
+			keys[key] = principal
+		}
+	}
+	if c.NetProbe.Port < 1 || c.NetProbe.Port > 65535 {
+		add("netprobe.port: %d is not a valid port", c.NetProbe.Port)
+	}
+	if c.NetProbe.Duration < time.Second || c.NetProbe.Duration > time.Minute {
+		add("netprobe.duration: must be between 1s and 1m")
+	}
+	if c.NetProbe.MaxSyncLatency <= 0 {
+		add("netprobe.max_sync_latency: must be positive")
+	}
+	if c.TimeSync.Interval < 0 {
+		add("timesync.interval: must not be negative")
+	}
+	if c.TimeSync.MaxSkew <= 0 {
+		add("timesync.max_skew: must be positive")
+	}
+	if c.AutoHeal.Interval < 0 {
+		add("autoheal.interval: must not be negative")
+	} else if c.AutoHeal.Interval > 0 {
+		if c.AutoHeal.Interval < 10*time.Second {
+			add("autoheal.interval: %s is too short, use at least 10s", c.AutoHeal.Interval)
+		}
+		if c.AutoHeal.After < c.AutoHeal.Interval {
+			add("autoheal.after: must be at least autoheal.interval")
+		}
+		if c.AutoHeal.MaxAttempts < 1 {
+			add("autoheal.max_attempts: must be at least 1")
+		}
+	}
+	if c.Replication.Interval < 0 {
+		add("replication.interval: must not be negative")
+	} else if c.Replication.Interval > 0 && c.Replication.Interval < 10*time.Second {
+		add("replication.interval: %s is too short, use at least 10s", c.Replication.Interval)
+	}
+	if c.Replication.Port < 1 || c.Replication.Port > 65535 {
+		add("replication.port: %d is not a valid port", c.Replication.Port)
+	} else if c.Replication.Port == c.NetProbe.Port {
+		add("replication.port: %d is also netprobe.port", c.Replication.Port)
+	}
+	if c.PoolHistory.Interval < 0 {
+		add("pool_history.interval: must not be negative")
+	} else if c.PoolHistory.Interval > 0 {
+		if c.PoolHistory.Interval < time.Minute {
+			add("pool_history.interval: %s is too short, use at least 1m", c.PoolHistory.Interval)
+		}
+		if c.PoolHistory.Retention < c.PoolHistory.Interval {
+			add("pool_history.retention: must be at least pool_history.interval")
+		}
+	}
+	switch c.Report.Format {
+	case "html", "md":
+	default:
+		add("report.format: unknown format %q (html, md)", c.Report.Format)
+	}
+	if c.Report.Interval < 0 {
+		add("report.interval: must not be negative")
+	} else if c.Report.Interval > 0 {
+		if c.Report.Interval < time.Hour {
+			add("report.interval: %s is too short, use at least 1h", c.Report.Interval)
+		}
+		if !filepath.IsAbs(c.Report.Directory) {
+			add("report.directory: %q must be an absolute path", c.Report.Directory)
+		}
+	}
+
+	if !ValidClusterName(c.Cluster.Name) {
+		add("cluster.name: %q must be 1-63 lowercase letters, digits or dashes", c.Cluster.Name)
+	}
+	databases := map[string]string{filepath.Clean(c.Database.Path): c.Cluster.Name}
+	for name := range c.Cluster.Extra {
+		if !ValidClusterName(name) {
+			add("cluster.extra.%s: cluster names must be 1-63 lowercase letters, digits or dashes", name)
+			continue
+		}
+		if name == c.Cluster.Name {
+			add("cluster.extra.%s: is the name of the controller's own cluster", name)
+			continue
+		}
+		path := filepath.Clean(c.ForCluster(name).Database.Path)
+		if other, ok := databases[path]; ok {
+			add("cluster.extra.%s: database %s is already used by cluster %s", name, path, other)
+		} else if err := checkDir(filepath.Dir(path)); err != nil {
+			add("cluster.extra.%s.database_path: %v", name, err)
+		}
+		databases[path] = name
+	}
+
+	if c.SSH.KnownHosts == "" {
+		add("ssh.known_hosts: must be set")
+	} else if err := checkDir(filepath.Dir(c.SSH.KnownHosts)); err != nil {
+		add("ssh.known_hosts: %v", err)
+	}
+	switch c.SSH.Sudo {
+	case "", "full", "restricted":
+	default:
+		add("ssh.sudo: unknown mode %q (full, restricted)", c.SSH.Sudo)
+	}
+	for _, raw := range c.Fencing.ControllerURLs {
+		u, err := url.Parse(raw)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			add("fencing.controller_urls: %q is not an http or https URL", raw)
+		} else if strings.ContainsAny(raw, " '\"\\$`") {
+			add("fencing.controller_urls: %q must not contain spaces, quotes, $ or backticks", raw)
+		}
+	}
+	if c.Fencing.Timeout < time.Second {
+		add("fencing.timeout: must be at least 1s")
+	}
+	if err := CheckVolumeName(c.Naming.Volume); err != nil {
+		add("naming.volume: %v", err)
+	}
+	if err := CheckHaConfigName(c.Naming.HaConfig); err != nil {
+		add("naming.ha_config: %v", err)
+	}
+
+	for _, p := range []struct{ key, path string }{
+		{"gateway.export_base_path", c.Gateway.ExportBasePath},
+		{"gateway.cluster_private_path", c.Gateway.ClusterPrivatePath},
+	} {
+		if p.path != "" && (!filepath.IsAbs(p.path) || filepath.Clean(p.path) == "/") {
+			add("%s: %q must be an absolute path below /", p.key, p.path)
+		}
+	}
+	if c.Gateway.ExportBasePath != "" && filepath.Clean(c.Gateway.ExportBasePath) == filepath.Clean(c.Gateway.ClusterPrivatePath) {
+		add("gateway.export_base_path: must differ from gateway.cluster_private_path")
+	}
+
+	switch c.Secrets.Backend {
+	case "", "local":
+		if c.Secrets.MasterKeyFile == "" {
+			add("secrets.master_key_file: must be set for the local backend")
+		} else if err := checkDir(filepath.Dir(c.Secrets.MasterKeyFile)); err != nil {
+			add("secrets.master_key_file: %v", err)
+		}
+	case "vault":
+		if c.Secrets.VaultAddress == "" {
+			add("secrets.vault_address: must be set for the vault backend")
+		}
+		if c.Secrets.VaultToken == "" && os.Getenv("VAULT_TOKEN") == "" {
+			add("secrets.vault_token: must be set (or $VAULT_TOKEN) for the vault backend")
+		}
+	default:
+		add("secrets.backend: unknown backend %q (local, vault)", c.Secrets.Backend)
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidClusterName reports whether name can name a cluster: it becomes part
+// of file names, config comments and request headers
+func ValidClusterName(name string) bool {
+	if name == "" || len(name) > 63 || strings.HasPrefix(name, "-") {
+		return false
+	}
+	for _, r := range name {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// checkPorts reports invalid ports and listeners that would bind the same port
+func (c *Config) checkPorts() []error {
+	type listener struct {
+		name    string
+		address string
+		port    int
+	}
+	listeners := []listener{
+		{"server.port (gRPC)", c.Server.ListenAddress, c.Server.Port},
+		{"server.rest_port", c.Server.ListenAddress, c.Server.RESTPort},
+		{"server.ui_port", c.Server.ListenAddress, c.Server.UIPort},
+	}
+	if c.Metrics.Enabled {
+		listeners = append(listeners, listener{"metrics.port", c.Metrics.ListenAddress, c.Metrics.Port})
+	}
+
+	var errs []error
+	for i, l := range listeners {
+		if l.port <= 0 || l.port > 65535 {
+			errs = append(errs, fmt.Errorf("%s: invalid port %d", l.name, l.port))
+			continue
+		}
+		for _, other := range listeners[:i] {
+			if other.port == l.port && addressesOverlap(other.address, l.address) {
+				errs = append(errs, fmt.Errorf("%s: port %d conflicts with %s", l.name, l.port, other.name))
+			}
+		}
+	}
+	return errs
+}
+
+// addressesOverlap reports whether two listen addresses can bind the same
+// interface, wildcard addresses overlap with every address
+func addressesOverlap(a, b string) bool {
+	ipA, ipB := net.ParseIP(a), net.ParseIP(b)
+	if ipA == nil || ipB == nil {
+		return a == b
+	}
+	return ipA.IsUnspecified() || ipB.IsUnspecified() || ipA.Equal(ipB)
+}
+
+// checkDir checks that a directory exists
+func checkDir(dir string) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("directory %s does not exist", dir)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", dir)
+	}
+	return nil
+}
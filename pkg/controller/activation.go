@@ -0,0 +1,227 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+var (
+	// activationMountPattern restricts mount points interpolated into commands
+	activationMountPattern = regexp.MustCompile(`^/[A-Za-z0-9._/-]+$`)
+	// activationServicePattern matches systemd unit names
+	activationServicePattern = regexp.MustCompile(`^[A-Za-z0-9@._:-]+$`)
+	// activationFsPattern matches filesystem types
+	activationFsPattern = regexp.MustCompile(`^[a-z0-9]+$`)
+)
+
+// ActivationStep is a step of the checklist of an activation or deactivation
+type ActivationStep struct {
+	Name   string // e.g. verify, promote, mount, start nfs-server
+	Passed bool
+	Detail string
+}
+
+// ActivationResult is the checklist an activation or deactivation ran
+type ActivationResult struct {
+	Resource string
+	Node     string
+	Steps    []*ActivationStep
+}
+
+// recordActivationStep adds a step to the checklist and journals it with the job
+func (rm *ResourceManager) recordActivationStep(ctx context.Context, result *ActivationResult, name string, err error, detail string) error {
+	rm.controller.journalStep(ctx, "activation: "+name)
+	step := &ActivationStep{Name: name, Passed: err == nil, Detail: detail}
+	if err != nil {
+		step.Detail = err.Error()
+	}
+	result.Steps = append(result.Steps, step)
+	return err
+}
+
+// Activate brings a cold standby resource up on a node by hand, for
+// resources drbd-reactor does not manage: it verifies the node has UpToDate
+// data, quorum and the services, promotes the resource, mounts its first
+// volume and starts the services in order. A failed step rolls back the
+// steps before it. Unless forced, a node that fails the verification is
+// refused; force does not promote over a fence constraint or Inconsistent
+// data. The activation is kept so Deactivate can reverse it.
+func (rm *ResourceManager) Activate(ctx context.Context, resource, node, mountPoint, fsType string, services []string, force bool) (*ActivationResult, error) {
+	if rm.deployment == nil {
+		return nil, fmt.Errorf("deployment client not set")
+	}
+	if rm.controller.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	if fsType == "" {
+		fsType = "ext4"
+	}
+	if mountPoint != "" && (!activationMountPattern.MatchString(mountPoint) || strings.Contains(mountPoint, "..")) {
+		return nil, fmt.Errorf("invalid mount point %q", mountPoint)
+	}
+	if !activationFsPattern.MatchString(fsType) {
+		return nil, fmt.Errorf("invalid filesystem type %q", fsType)
+	}
+	for _, svc := range services {
+		if !activationServicePattern.MatchString(svc) {
+			return nil, fmt.Errorf("invalid service name %q", svc)
+		}
+	}
+
+	if _, err := rm.controller.db.GetHaConfig(ctx, resource); err == nil {
+		return nil, fmt.Errorf("resource %s is managed by drbd-reactor, use sds ha failover", resource)
+	}
+	name := rm.resourceNodeName(ctx, resource, node)
+	if name == "" {
+		return nil, fmt.Errorf("node %s does not replicate resource %s", node, resource)
+	}
+	if active, err := rm.controller.db.GetActivation(ctx, resource); err == nil {
+		return nil, fmt.Errorf("resource %s is already activated on %s, deactivate it first", resource, active.Node)
+	}
+	primary := rm.primaryNode(ctx, resource)
+	if primary != "" && primary != name {
+		return nil, fmt.Errorf("resource %s is Primary on %s", resource, primary)
+	}
+
+	rm.controller.logger.Info("Activating resource",
+		zap.String("resource", resource),
+		zap.String("node", name),
+		zap.String("mount_point", mountPoint),
+		zap.Strings("services", services),
+		zap.Bool("force", force))
+
+	result := &ActivationResult{Resource: resource, Node: name}
+	nodeNames, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	if problems := rm.failoverProblems(ctx, resource, name, len(nodeNames), services, ""); len(problems) > 0 {
+		detail := strings.Join(problems, "; ")
+		if !force {
+			return result, rm.recordActivationStep(ctx, result, "verify", fmt.Errorf("%s", detail), "")
+		}
+		rm.recordActivationStep(ctx, result, "verify", nil, "ignored: "+detail)
+	} else {
+		rm.recordActivationStep(ctx, result, "verify", nil, "UpToDate, quorum and services present")
+	}
+
+	address := rm.nodeAddress(name)
+	var undo []func()
+	rollback := func(err error) (*ActivationResult, error) {
+		for i := len(undo) - 1; i >= 0; i-- {
+			undo[i]()
+		}
+		return result, err
+	}
+
+	if primary == name {
+		rm.recordActivationStep(ctx, result, "promote", nil, "already Primary")
+	} else {
+		err := rm.SetPrimary(ctx, resource, name, false)
+		if rm.recordActivationStep(ctx, result, "promote", err, "Primary on "+name) != nil {
+			return rollback(err)
+		}
+		undo = append(undo, func() {
+			rm.recordActivationStep(ctx, result, "rollback: demote", rm.SetSecondary(ctx, resource, address), "Secondary")
+		})
+	}
+
+	if mountPoint != "" {
+		device := fmt.Sprintf("/dev/drbd/by-res/%s/0", resource)
+		_, err := rm.controller.execOutput(ctx, address, fmt.Sprintf(
+			"sudo mkdir -p %s && { mountpoint -q %s || sudo mount -t %s %s %s; }", mountPoint, mountPoint, fsType, device, mountPoint))
+		if rm.recordActivationStep(ctx, result, "mount", err, fmt.Sprintf("%s on %s", device, mountPoint)) != nil {
+			return rollback(err)
+		}
+		undo = append(undo, func() {
+			_, err := rm.controller.execOutput(ctx, address, "sudo umount "+mountPoint)
+			rm.recordActivationStep(ctx, result, "rollback: unmount", err, mountPoint)
+		})
+	}
+
+	for _, svc := range services {
+		_, err := rm.controller.execOutput(ctx, address, "sudo systemctl start "+svc)
+		if rm.recordActivationStep(ctx, result, "start "+svc, err, "active") != nil {
+			return rollback(err)
+		}
+		undo = append(undo, func() {
+			_, err := rm.controller.execOutput(ctx, address, "sudo systemctl stop "+svc)
+			rm.recordActivationStep(ctx, result, "rollback: stop "+svc, err, "inactive")
+		})
+	}
+
+	activation := &database.Activation{
+		Resource:   resource,
+		Node:       name,
+		MountPoint: mountPoint,
+		FsType:     fsType,
+		Services:   services,
+	}
+	if err := rm.controller.db.SaveActivation(ctx, activation); err != nil {
+		return rollback(fmt.Errorf("failed to save activation: %w", err))
+	}
+
+	rm.controller.RecordEvent(ctx, EventActivated, resource,
+		fmt.Sprintf("Resource %s activated on %s", resource, name),
+		map[string]string{"node": name, "mount_point": mountPoint, "services": strings.Join(services, ",")})
+	return result, nil
+}
+
+// Deactivate reverses the activation of a resource: it stops the services in
+// reverse order, unmounts the volume and demotes the resource. It stops at
+// the first failed step, so the resource is never demoted while in use; the
+// activation is kept until all steps passed.
+func (rm *ResourceManager) Deactivate(ctx context.Context, resource string) (*ActivationResult, error) {
+	if rm.deployment == nil {
+		return nil, fmt.Errorf("deployment client not set")
+	}
+	if rm.controller.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	activation, err := rm.controller.db.GetActivation(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("resource %s was not activated with sds resource activate", resource)
+	}
+
+	rm.controller.logger.Info("Deactivating resource",
+		zap.String("resource", resource),
+		zap.String("node", activation.Node))
+
+	result := &ActivationResult{Resource: resource, Node: activation.Node}
+	address := rm.nodeAddress(activation.Node)
+	for i := len(activation.Services) - 1; i >= 0; i-- {
+		svc := activation.Services[i]
+		_, err := rm.controller.execOutput(ctx, address, "sudo systemctl stop "+svc)
+		if rm.recordActivationStep(ctx, result, "stop "+svc, err, "inactive") != nil {
+			return result, err
+		}
+	}
+	if activation.MountPoint != "" {
+		_, err := rm.controller.execOutput(ctx, address, fmt.Sprintf(
+			"! mountpoint -q %s || sudo umount %s", activation.MountPoint, activation.MountPoint))
+		if rm.recordActivationStep(ctx, result, "unmount", err, activation.MountPoint) != nil {
+			return result, err
+		}
+	}
+	if rm.primaryNode(ctx, resource) == activation.Node {
+		err := rm.SetSecondary(ctx, resource, address)
+		if rm.recordActivationStep(ctx, result, "demote", err, "Secondary on "+activation.Node) != nil {
+			return result, err
+		}
+	} else {
+		rm.recordActivationStep(ctx, result, "demote", nil, "not Primary")
+	}
+
+	if err := rm.controller.db.DeleteActivation(ctx, resource); err != nil {
+		return result, fmt.Errorf("failed to delete activation: %w", err)
+	}
+	rm.controller.RecordEvent(ctx, EventDeactivated, resource,
+		fmt.Sprintf("Resource %s deactivated on %s", resource, activation.Node),
+		map[string]string{"node": activation.Node})
+	return result, nil
+}
@@ -0,0 +1,263 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Defaults of the generated alerting rules
+const (
+	defaultPoolFullPercent = 90
+	defaultAlertFor        = 10 * time.Minute
+)
+
+// alertRuleFile is a Prometheus rule file
+type alertRuleFile struct {
+	Groups []*alertRuleGroup `yaml:"groups"`
+}
+
+type alertRuleGroup struct {
+	Name  string       `yaml:"name"`
+	Rules []*alertRule `yaml:"rules"`
+}
+
+type alertRule struct {
+	Alert       string            `yaml:"alert"`
+	Expr        string            `yaml:"expr"`
+	For         string            `yaml:"for,omitempty"`
+	Labels      map[string]string `yaml:"labels"`
+	Annotations map[string]string `yaml:"annotations"`
+}
+
+// AlertRules generates Prometheus alerting rules on the metrics of the
+// controller for the resources and pools of the cluster: volumes out of sync
+// or diskless, resources degraded or without an UpToDate replica, pools
+// nearly full, HA resources whose drbd-reactor promoter is active nowhere,
+// stale snapshot replication, and node clocks skewed by more than
+// timesync.max_skew or not kept in sync. Volume and clock alerts
+// of nodes in a maintenance window are suppressed, stopped resources get no
+// rules.
+// poolFullPercent and forDuration fall back to 90% and 10m when not
+// positive.
+func (c *Controller) AlertRules(ctx context.Context, poolFullPercent float64, forDuration time.Duration) (string, error) {
+	if c.db == nil {
+		return "", fmt.Errorf("database not available")
+	}
+	if poolFullPercent <= 0 {
+		poolFullPercent = defaultPoolFullPercent
+	}
+	if poolFullPercent >= 100 {
+		return "", fmt.Errorf("pool full threshold must be below 100%%")
+	}
+	if forDuration <= 0 {
+		forDuration = defaultAlertFor
+	}
+	pending := promDuration(forDuration)
+
+	resources, err := c.db.ListResources(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list resources: %w", err)
+	}
+	sort.Slice(resources, func(i, j int) bool { return resources[i].Name < resources[j].Name })
+
+	// Resources drbd-reactor keeps running somewhere
+	promoted := make(map[string]bool)
+	haConfigs, err := c.db.ListHaConfigs(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list HA configs: %w", err)
+	}
+	for _, cfg := range haConfigs {
+		promoted[cfg.Resource] = true
+	}
+	gateways, err := c.db.ListGateways(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list gateways: %w", err)
+	}
+	for _, gw := range gateways {
+		promoted[gw.Resource] = true
+	}
+
+	// Resources with a snapshot replication policy
+	replicated := make(map[string]bool)
+	policies, err := c.db.ListReplicationPolicies(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list replication policies: %w", err)
+	}
+	for _, policy := range policies {
+		replicated[policy.Resource] = true
+	}
+
+	file := &alertRuleFile{}
+	for _, res := range resources {
+		// Stopped resources are down on purpose
+		if resourceStopped(res) {
+			continue
+		}
+		sel := fmt.Sprintf("resource=%s", strconv.Quote(res.Name))
+		group := &alertRuleGroup{
+			Name: "sds-resource-" + res.Name,
+			Rules: []*alertRule{
+				{
+					Alert: "SdsVolumeOutOfSync",
+					Expr: fmt.Sprintf("sds_drbd_volume_up_to_date{%s} == 0 unless on(resource, node, volume) sds_drbd_volume_diskless{%s} == 1",
+						sel, sel) + notInMaintenance,
+					For:    pending,
+					Labels: map[string]string{"severity": "warning"},
+					Annotations: map[string]string{
+						"summary":     fmt.Sprintf("Volume {{ $labels.volume }} of %s is out of sync on {{ $labels.node }}", res.Name),
+						"description": "The local disk is not UpToDate and waits for a resync. Check sds resource status and the connection to the peers.",
+					},
+				},
+				{
+					Alert:  "SdsVolumeDiskless",
+					Expr:   fmt.Sprintf("sds_drbd_volume_diskless{%s} == 1", sel) + notInMaintenance,
+					For:    pending,
+					Labels: map[string]string{"severity": "critical"},
+					Annotations: map[string]string{
+						"summary":     fmt.Sprintf("Volume {{ $labels.volume }} of %s lost its disk on {{ $labels.node }}", res.Name),
+						"description": "DRBD detached the backing device, usually after I/O errors. The node serves the volume over the network from its peers. Replace the disk with sds resource replace-disk.",
+					},
+				},
+			},
+		}
+		// Replicas are classified by the node poller, a resource is
+		// Degraded (1) or Critical (2) as a whole
+		group.Rules = append(group.Rules,
+			&alertRule{
+				Alert:  "SdsResourceDegraded",
+				Expr:   fmt.Sprintf("sds_drbd_resource_health{%s} == 1", sel),
+				For:    pending,
+				Labels: map[string]string{"severity": "warning"},
+				Annotations: map[string]string{
+					"summary":     fmt.Sprintf("Resource %s is degraded", res.Name),
+					"description": "A replica of the resource is down, lost its disk, is Outdated or stays Inconsistent. The unhealthy replicas are listed by sds resource get.",
+				},
+			},
+			&alertRule{
+				Alert:  "SdsResourceCritical",
+				Expr:   fmt.Sprintf("sds_drbd_resource_health{%s} == 2", sel),
+				For:    pending,
+				Labels: map[string]string{"severity": "critical"},
+				Annotations: map[string]string{
+					"summary":     fmt.Sprintf("Resource %s has no UpToDate replica", res.Name),
+					"description": "No node of the resource has UpToDate data, it cannot be served. Check sds resource get and the DRBD state on the nodes.",
+				},
+			})
+		if replicated[res.Name] {
+			group.Rules = append(group.Rules, &alertRule{
+				Alert:  "SdsReplicationStale",
+				Expr:   fmt.Sprintf("sds_replication_stale{%s} == 1", sel),
+				Labels: map[string]string{"severity": "warning"},
+				Annotations: map[string]string{
+					"summary":     fmt.Sprintf("The copy of %s on {{ $labels.target_node }} is stale", res.Name),
+					"description": "The snapshot replication of the resource has not succeeded within its stale-after time. Check the last error with sds resource snapshot replication list.",
+				},
+			})
+		}
+		if promoted[res.Name] {
+			group.Rules = append(group.Rules, &alertRule{
+				Alert: "SdsPromoterInactive",
+				Expr: fmt.Sprintf("max(sds_reactor_plugin_active{%s}) < 1 or absent(sds_reactor_plugin_active{%s})",
+					sel, sel),
+				For:    pending,
+				Labels: map[string]string{"severity": "critical", "resource": res.Name},
+				Annotations: map[string]string{
+					"summary":     fmt.Sprintf("drbd-reactor runs %s on no node", res.Name),
+					"description": "The promoter of the resource is active nowhere, its services are down. Check drbd-reactorctl status on the nodes.",
+				},
+			})
+		}
+		file.Groups = append(file.Groups, group)
+	}
+
+	pools, err := c.db.ListPools(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list pools: %w", err)
+	}
+	poolNames := make(map[string]bool)
+	for _, pool := range pools {
+		poolNames[pool.Name] = true
+	}
+	names := make([]string, 0, len(poolNames))
+	for name := range poolNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		sel := fmt.Sprintf("pool=%s", strconv.Quote(name))
+		file.Groups = append(file.Groups, &alertRuleGroup{
+			Name: "sds-pool-" + name,
+			Rules: []*alertRule{{
+				Alert: "SdsPoolNearlyFull",
+				Expr: fmt.Sprintf("sds_pool_capacity_bytes{%s,state=\"used\"} / ignoring(state) sds_pool_capacity_bytes{%s,state=\"total\"} > %g",
+					sel, sel, poolFullPercent/100),
+				For:    pending,
+				Labels: map[string]string{"severity": "warning"},
+				Annotations: map[string]string{
+					"summary":     fmt.Sprintf("Pool %s on {{ $labels.node }} is {{ $value | humanizePercentage }} full", name),
+					"description": fmt.Sprintf("More than %g%% of the pool is used. Extend the pool or move volumes with sds resource migrate-pool.", poolFullPercent),
+				},
+			}},
+		})
+	}
+
+	maxSkew := c.config.TimeSync.MaxSkew
+	file.Groups = append(file.Groups, &alertRuleGroup{
+		Name: "sds-nodes",
+		Rules: []*alertRule{
+			{
+				Alert:  "SdsNodeClockSkew",
+				Expr:   fmt.Sprintf("abs(sds_node_clock_skew_seconds) > %g", maxSkew.Seconds()) + notInMaintenance,
+				For:    pending,
+				Labels: map[string]string{"severity": "warning"},
+				Annotations: map[string]string{
+					"summary":     "The clock of {{ $labels.node }} is {{ $value | humanizeDuration }} off the controller's",
+					"description": fmt.Sprintf("The skew is above %s, logs and events of the nodes no longer line up and failover timing may be off. Check the NTP client with sds node check time.", maxSkew),
+				},
+			},
+			{
+				Alert:  "SdsNodeTimeNotSynchronized",
+				Expr:   "sds_node_time_synchronized == 0" + notInMaintenance,
+				For:    pending,
+				Labels: map[string]string{"severity": "warning"},
+				Annotations: map[string]string{
+					"summary":     "The clock of {{ $labels.node }} is not kept in sync",
+					"description": "Neither chrony nor systemd-timesyncd has synchronized the clock, it drifts away from the other nodes. Check the NTP client with sds node check time.",
+				},
+			},
+		},
+	})
+
+	data, err := yaml.Marshal(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to render alerting rules: %w", err)
+	}
+	header := fmt.Sprintf("# Prometheus alerting rules for SDS cluster %s\n# Generated by sds-controller, regenerate after adding resources or pools\n", c.ClusterName())
+	return header + string(data), nil
+}
+
+// notInMaintenance drops series of nodes in a maintenance window
+const notInMaintenance = " unless on(node) sds_node_maintenance == 1"
+
+// promDuration formats a duration as a Prometheus duration, e.g. 1h30m
+func promDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	var s string
+	if h := d / time.Hour; h > 0 {
+		s += fmt.Sprintf("%dh", h)
+		d -= h * time.Hour
+	}
+	if m := d / time.Minute; m > 0 {
+		s += fmt.Sprintf("%dm", m)
+		d -= m * time.Minute
+	}
+	if d > 0 || s == "" {
+		s += fmt.Sprintf("%ds", d/time.Second)
+	}
+	return s
+}
@@ -0,0 +1,548 @@
+package controller
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	sdspb "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/database"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// apiKeyHeader is the metadata key carrying the API key of a principal
+const apiKeyHeader = "x-sds-api-key"
+
+// adminPrincipal is the principal of calls with the admin token
+const adminPrincipal = "admin"
+
+// handlerRPCs are called by the DRBD handlers on the nodes, which hold no
+// API key but the handler key of their node
+var handlerRPCs = map[string]bool{
+	"FencePeer":       true,
+	"UnfencePeer":     true,
+	"ReportDrbdEvent": true,
+}
+
+// The RPCs principals may call are listed below by how the resource they act
+// on is found, every other RPC of the SDS service needs the admin token. New
+// RPCs are thereby closed to principals until they are scoped here.
+
+// resourceRPCs act on the resource of their resource field
+var resourceRPCs = map[string]bool{
+	"StreamNodeLogs":          true,
+	"AddVolume":               true,
+	"RemoveVolume":            true,
+	"ResizeVolume":            true,
+	"GetVolume":               true,
+	"ListVolumes":             true,
+	"GetNodeResourceConfig":   true,
+	"RenderConfig":            true,
+	"SetPrimary":              true,
+	"SetSecondary":            true,
+	"CreateFilesystem":        true,
+	"MountResource":           true,
+	"UnmountResource":         true,
+	"MakeHa":                  true,
+	"EvictHa":                 true,
+	"UpdateHa":                true,
+	"FailoverHa":              true,
+	"DeleteHa":                true,
+	"GetHa":                   true,
+	"GetHaStatus":             true,
+	"DrSwitchover":            true,
+	"DrFailback":              true,
+	"ListPlacementRules":      true,
+	"ListEvents":              true,
+	"ListSnapshotHooks":       true,
+	"SetReplicationPolicy":    true,
+	"DeleteReplicationPolicy": true,
+	"ListReplicationPolicies": true,
+	"RunReplication":          true,
+	"CreateNFSGateway":        true,
+	"CreateISCSIGateway":      true,
+	"CreateNVMeGateway":       true,
+}
+
+// resourceNameRPCs act on the resource of their name field
+var resourceNameRPCs = map[string]bool{
+	"DeleteResource":     true,
+	"SetMaxPeers":        true,
+	"MigratePool":        true,
+	"ConvertStorage":     true,
+	"ReplaceDisk":        true,
+	"StopResource":       true,
+	"StartResource":      true,
+	"ExecFenceTest":      true,
+	"ActivateResource":   true,
+	"DeactivateResource": true,
+	"GetResource":        true,
+	"ResourceStatus":     true,
+	"ExportResource":     true,
+	"DiffResource":       true,
+	"PauseSync":          true,
+	"ResumeSync":         true,
+	"SetSyncRate":        true,
+}
+
+// resourcePairRPCs act on the resources of their resource_a and resource_b
+// fields
+var resourcePairRPCs = map[string]bool{
+	"AddPlacementRule":    true,
+	"DeletePlacementRule": true,
+}
+
+// volumeRPCs act on the resource or backing volume of their volume field
+var volumeRPCs = map[string]bool{
+	"CreateSnapshot":   true,
+	"DeleteSnapshot":   true,
+	"RestoreSnapshot":  true,
+	"ListSnapshots":    true,
+	"GetSnapshotUsage": true,
+}
+
+// gatewayIDRPCs act on the gateway of their id field
+var gatewayIDRPCs = map[string]bool{
+	"DeleteGateway": true,
+	"GetGateway":    true,
+	"StartGateway":  true,
+	"StopGateway":   true,
+}
+
+// gatewayRPCs act on the gateway of their gateway field
+var gatewayRPCs = map[string]bool{
+	"NVMeConnect":            true,
+	"NVMeDisconnect":         true,
+	"GetISCSIClientConfig":   true,
+	"ValidateISCSIInitiator": true,
+	"NFSMount":               true,
+}
+
+// jobRPCs act on the job of their id field
+var jobRPCs = map[string]bool{
+	"GetJob":      true,
+	"ResumeJob":   true,
+	"RollbackJob": true,
+}
+
+// sharedRPCs name no object of a principal: they create one, leave out what
+// the caller may not see, scope themselves like the snapshot groups, or read
+// the cluster state all principals share
+var sharedRPCs = map[string]bool{
+	"CreateResource":         true,
+	"ListResources":          true,
+	"ListHa":                 true,
+	"ListVIPs":               true,
+	"ListGateways":           true,
+	"ListGatewayClients":     true,
+	"ListFenceConstraints":   true,
+	"ListJobs":               true,
+	"WatchJobProgress":       true,
+	"GetDriftReport":         true,
+	"CreateSnapshotGroup":    true,
+	"DeleteSnapshotGroup":    true,
+	"RestoreSnapshotGroup":   true,
+	"ListSnapshotGroups":     true,
+	"GetPool":                true,
+	"ListPools":              true,
+	"GetPoolHistory":         true,
+	"GetNode":                true,
+	"ListNodes":              true,
+	"ListMaintenanceWindows": true,
+	"HealthCheck":            true,
+	"GetAlertRules":          true,
+	"ListClusters":           true,
+	"GetClusterInfo":         true,
+	"GetOverview":            true,
+	"GetFreezeStatus":        true,
+	"GetDrbdGlobalConfig":    true,
+	"ListDrbdGlobalConfigs":  true,
+	"ListNetProbes":          true,
+	"GetSetupStatus":         true,
+}
+
+// principalRPC reports whether principals may call an RPC at all
+func principalRPC(method string) bool {
+	return sharedRPCs[method] || resourceRPCs[method] || resourceNameRPCs[method] ||
+		resourcePairRPCs[method] || volumeRPCs[method] || gatewayIDRPCs[method] ||
+		gatewayRPCs[method] || jobRPCs[method] || method == "Repair"
+}
+
+// Principal is the caller of an RPC as authenticated by its API key
+type Principal struct {
+	Name  string
+	Admin bool // Authenticated with the admin token, sees everything
+}
+
+type principalKey struct{}
+
+// principalFromContext returns the caller of an RPC, nil when no API keys
+// are configured
+func principalFromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
+
+// ownerFor returns the owner recorded for objects created by the caller.
+// What the admin creates is shared, it has no owner.
+func ownerFor(ctx context.Context) string {
+	if p := principalFromContext(ctx); p != nil && !p.Admin {
+		return p.Name
+	}
+	return ""
+}
+
+// mayAccess reports whether the caller may see an object of an owner.
+// Objects without an owner are shared.
+func mayAccess(ctx context.Context, owner string) bool {
+	p := principalFromContext(ctx)
+	return p == nil || p.Admin || owner == "" || owner == p.Name
+}
+
+// authInterceptor authenticates calls with the API keys of auth.keys or the
+// admin token, and hides the resources and gateways of other principals
+// from the calls naming them. Without keys every call is let through.
+func (c *Controller) authInterceptor() grpc.UnaryServerInterceptor {
+	service := "/" + sdspb.SDSController_ServiceDesc.ServiceName + "/"
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method := path.Base(info.FullMethod)
+		if len(c.config.Auth.Keys) == 0 || !strings.HasPrefix(info.FullMethod, service) {
+			return handler(ctx, req)
+		}
+		if handlerRPCs[method] && !manualUnfence(ctx, method) {
+			if err := c.authorizeHandler(ctx, method, req); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+
+		p, err := c.authenticate(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		ctx = context.WithValue(ctx, principalKey{}, p)
+
+		if err := c.authorize(ctx, method, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// manualUnfence reports whether an UnfencePeer call comes from sds fencing
+// clear, which presents the admin token instead of a handler key. The token
+// is checked with the other calls of the admin.
+func manualUnfence(ctx context.Context, method string) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return method == "UnfencePeer" && len(md.Get(adminTokenHeader)) > 0
+}
+
+// authorizeHandler authenticates a call of a DRBD handler by the handler key
+// of its node. The handler may only speak for that node, about a resource
+// the node replicates, and an unfence-peer handler only for one peer.
+func (c *Controller) authorizeHandler(ctx context.Context, method string, req interface{}) error {
+	r, ok := req.(interface {
+		GetName() string
+		GetNode() string
+		GetPeer() string
+	})
+	if !ok || r.GetNode() == "" {
+		if method == "UnfencePeer" {
+			return status.Error(codes.Unauthenticated, "admin token required (--admin-token or SDS_ADMIN_TOKEN)")
+		}
+		return status.Errorf(codes.InvalidArgument, "%s must name the node of the handler", method)
+	}
+	node, err := c.authenticateHandler(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	// The node is sent as the hostname, which may be an address of the node
+	if c.resources.resourceNodeName(ctx, r.GetName(), r.GetNode()) != node {
+		return status.Errorf(codes.PermissionDenied, "the handler key of %s does not speak for node %q of resource %s",
+			node, r.GetNode(), r.GetName())
+	}
+	if method == "UnfencePeer" && r.GetPeer() == "" {
+		return status.Error(codes.InvalidArgument, "the unfence-peer handler must name the peer, lift all constraints with sds fencing clear")
+	}
+	return nil
+}
+
+// reflectionServicePrefix prefixes the methods of the gRPC reflection
+// services, grpc.reflection.v1 and v1alpha
+const reflectionServicePrefix = "/grpc.reflection."
+
+// authStream carries the principal of a streaming call and hides the
+// resources of other principals from the request it receives
+type authStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	c      *Controller
+	method string
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.c.authorize(s.ctx, s.method, m)
+}
+
+// authStreamInterceptor authenticates streaming calls like authInterceptor
+func (c *Controller) authStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		// Reflection exposes the whole API, it is for admins only
+		if strings.HasPrefix(info.FullMethod, reflectionServicePrefix) {
+			if err := c.checkAdminToken(ss.Context()); err != nil {
+				return status.Error(codes.PermissionDenied, err.Error())
+			}
+			return handler(srv, ss)
+		}
+		if len(c.config.Auth.Keys) == 0 {
+			return handler(srv, ss)
+		}
+		p, err := c.authenticate(ss.Context())
+		if err != nil {
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+		ctx := context.WithValue(ss.Context(), principalKey{}, p)
+		return handler(srv, &authStream{ServerStream: ss, ctx: ctx, c: c, method: path.Base(info.FullMethod)})
+	}
+}
+
+// authenticate returns the principal of the API key or admin token sent
+// with a request
+func (c *Controller) authenticate(ctx context.Context) (*Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md.Get(adminTokenHeader)) > 0 {
+		if err := c.checkAdminToken(ctx); err != nil {
+			return nil, err
+		}
+		return &Principal{Name: adminPrincipal, Admin: true}, nil
+	}
+
+	keys := md.Get(apiKeyHeader)
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("API key required (--api-key or SDS_API_KEY)")
+	}
+	// Every key is compared so the time taken does not tell which matched
+	var match string
+	for _, name := range c.principalNames() {
+		if subtle.ConstantTimeCompare([]byte(keys[0]), []byte(c.config.Auth.Keys[name])) == 1 {
+			match = name
+		}
+	}
+	if match == "" {
+		return nil, fmt.Errorf("invalid API key")
+	}
+	return &Principal{Name: match}, nil
+}
+
+// principalNames returns the principals of auth.keys in order
+func (c *Controller) principalNames() []string {
+	names := make([]string, 0, len(c.config.Auth.Keys))
+	for name := range c.config.Auth.Keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// authorize hides the resources, volumes, gateways and jobs of other
+// principals from a call of a principal naming them, and refuses the RPCs
+// principals may not call. Admin RPCs are left to adminInterceptor.
+func (c *Controller) authorize(ctx context.Context, method string, req interface{}) error {
+	if !restrictedCaller(ctx) || adminRPCs[method] {
+		return nil
+	}
+	if !principalRPC(method) {
+		return status.Errorf(codes.PermissionDenied, "%s needs the admin token (--admin-token or SDS_ADMIN_TOKEN)", method)
+	}
+
+	if name := requestResource(method, req); name != "" && !c.resourceVisible(ctx, name) {
+		return status.Errorf(codes.NotFound, "resource not found: %s", name)
+	}
+	switch r := req.(type) {
+	case interface{ GetResourceA() string }:
+		if b, ok := req.(interface{ GetResourceB() string }); ok && resourcePairRPCs[method] {
+			for _, name := range []string{r.GetResourceA(), b.GetResourceB()} {
+				if !c.resourceVisible(ctx, name) {
+					return status.Errorf(codes.NotFound, "resource not found: %s", name)
+				}
+			}
+		}
+	case interface{ GetVolume() string }:
+		if volumeRPCs[method] && !c.volumeVisible(ctx, r.GetVolume()) {
+			return status.Errorf(codes.NotFound, "volume not found: %s", r.GetVolume())
+		}
+	case interface{ GetGateway() string }:
+		if gatewayRPCs[method] && !c.gatewayVisible(ctx, r.GetGateway()) {
+			return status.Errorf(codes.NotFound, "gateway not found: %s", r.GetGateway())
+		}
+	case interface{ GetId() string }:
+		if gatewayIDRPCs[method] && !c.gatewayVisible(ctx, r.GetId()) {
+			return status.Errorf(codes.NotFound, "gateway not found: %s", r.GetId())
+		}
+	case interface{ GetId() int64 }:
+		if jobRPCs[method] && c.db != nil {
+			if job, err := c.db.GetJob(ctx, r.GetId()); err == nil && !c.jobVisible(ctx, job) {
+				return status.Errorf(codes.NotFound, "job not found: %d", r.GetId())
+			}
+		}
+	case *sdspb.RepairRequest:
+		return c.authorizeRepair(ctx, r.Kind, r.Name)
+	}
+	return nil
+}
+
+// authorizeRepair lets principals repair the drifts of their resources,
+// HA configs and gateways; nodes and the global DRBD config are the admin's
+func (c *Controller) authorizeRepair(ctx context.Context, kind, name string) error {
+	switch kind {
+	case DriftKindResource, DriftKindHa:
+		if !c.resourceVisible(ctx, name) {
+			return status.Errorf(codes.NotFound, "resource not found: %s", name)
+		}
+	case DriftKindGateway:
+		if !c.gatewayVisible(ctx, name) {
+			return status.Errorf(codes.NotFound, "gateway not found: %s", name)
+		}
+	default:
+		return status.Errorf(codes.PermissionDenied, "repairing %s drift needs the admin token (--admin-token or SDS_ADMIN_TOKEN)", kind)
+	}
+	return nil
+}
+
+// requestResource returns the resource a request of a principal acts on, if
+// any
+func requestResource(method string, req interface{}) string {
+	if r, ok := req.(interface{ GetResource() string }); ok && resourceRPCs[method] {
+		return r.GetResource()
+	}
+	if r, ok := req.(interface{ GetName() string }); ok && resourceNameRPCs[method] {
+		return r.GetName()
+	}
+	return ""
+}
+
+// resourceVisible reports whether the caller may see a resource. Unknown
+// resources are left to the handler to report.
+func (c *Controller) resourceVisible(ctx context.Context, name string) bool {
+	if p := principalFromContext(ctx); p == nil || p.Admin || c.db == nil {
+		return true
+	}
+	res, err := c.db.GetResource(ctx, name)
+	if err != nil {
+		return true
+	}
+	return mayAccess(ctx, res.Owner)
+}
+
+// resourceFilter returns resourceVisible for filtering long lists, it asks
+// once per resource. Objects of no resource are shared.
+func (c *Controller) resourceFilter(ctx context.Context) func(name string) bool {
+	seen := make(map[string]bool)
+	return func(name string) bool {
+		if name == "" {
+			return true
+		}
+		visible, ok := seen[name]
+		if !ok {
+			visible = c.resourceVisible(ctx, name)
+			seen[name] = visible
+		}
+		return visible
+	}
+}
+
+// restrictedCaller reports whether the caller only sees its own and shared
+// objects
+func restrictedCaller(ctx context.Context) bool {
+	p := principalFromContext(ctx)
+	return p != nil && !p.Admin
+}
+
+// volumeVisible reports whether the caller may see a resource or a backing
+// volume given as vg/lv or pool/zvol, which is as visible as the resource it
+// belongs to. Volumes sds does not manage are only shown to the admin.
+func (c *Controller) volumeVisible(ctx context.Context, volume string) bool {
+	if !restrictedCaller(ctx) || c.db == nil {
+		return true
+	}
+	if _, err := c.db.GetResource(ctx, volume); err == nil {
+		return c.resourceVisible(ctx, volume)
+	}
+	volumes, err := c.db.ListVolumes(ctx, "")
+	if err != nil {
+		return false
+	}
+	pool, name := parseVolumePath(volume)
+	for _, v := range volumes {
+		if v.Pool == pool && v.VolumeName == name {
+			return c.resourceVisible(ctx, v.ResourceName)
+		}
+	}
+	return false
+}
+
+// jobVisible reports whether the caller may see a job: it must be of an RPC
+// principals may call, on a resource the caller may see
+func (c *Controller) jobVisible(ctx context.Context, job *database.Job) bool {
+	if !restrictedCaller(ctx) {
+		return true
+	}
+	return principalRPC(job.Operation) && c.resourceVisible(ctx, job.Target)
+}
+
+// vipVisible reports whether the caller may see a VIP: the HA config or
+// gateway owning it must be visible
+func (c *Controller) vipVisible(ctx context.Context, vip *database.VIP) bool {
+	if !restrictedCaller(ctx) {
+		return true
+	}
+	if resource, ok := strings.CutPrefix(vip.Owner, haVIPOwner("")); ok {
+		return c.resourceVisible(ctx, resource)
+	}
+	if gateway, ok := strings.CutPrefix(vip.Owner, gatewayVIPOwner("")); ok {
+		return c.gatewayVisible(ctx, gateway)
+	}
+	return false
+}
+
+// driftVisible reports whether the caller may see a drift: drifts of nodes
+// and the global DRBD config are the admin's
+func (c *Controller) driftVisible(ctx context.Context, d *Drift) bool {
+	if !restrictedCaller(ctx) {
+		return true
+	}
+	switch d.Kind {
+	case DriftKindResource, DriftKindHa:
+		return c.resourceVisible(ctx, d.Name)
+	case DriftKindGateway:
+		return c.gatewayVisible(ctx, d.Name)
+	}
+	return false
+}
+
+// gatewayVisible reports whether the caller may see a gateway: it must own
+// or share both the gateway and its resource. Gateways without a record
+// are only shown to the admin.
+func (c *Controller) gatewayVisible(ctx context.Context, id string) bool {
+	if p := principalFromContext(ctx); p == nil || p.Admin {
+		return true
+	}
+	gw, err := c.gatewayRecord(ctx, id)
+	if err != nil {
+		return false
+	}
+	return mayAccess(ctx, gw.Owner) && c.resourceVisible(ctx, gw.Resource)
+}
@@ -0,0 +1,158 @@
+package controller
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	sdspb "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/config"
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// newAuthController returns a controller with API keys, an admin token,
+// the shared resource web on alpha and beta, whose handlers hold the keys
+// alpha-key and beta-key, and resource db of team-b with its volume vg0/db_0
+// and gateway db-nfs
+func newAuthController(t *testing.T) *Controller {
+	t.Helper()
+	db, err := database.Open(&database.Config{Path: filepath.Join(t.TempDir(), "sds.db")}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	ctx := context.Background()
+	if err := db.SaveResource(ctx, &database.Resource{Name: "web", Nodes: "alpha,beta"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveResource(ctx, &database.Resource{Name: "db", Nodes: "alpha,beta", Owner: "team-b"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveVolume(ctx, &database.Volume{ResourceName: "db", VolumeName: "db_0", Pool: "vg0"}); err != nil {
+		t.Fatal(err)
+	}
+	gateway := &database.Gateway{Name: "db-nfs", Resource: "db", Type: database.GatewayTypeNFS, Owner: "team-b"}
+	if err := db.SaveGateway(ctx, gateway); err != nil {
+		t.Fatal(err)
+	}
+	keys := &database.HandlerKeys{Nodes: map[string]string{
+		"alpha": handlerKeyHash("alpha-key"),
+		"beta":  handlerKeyHash("beta-key"),
+	}}
+	if err := db.SaveHandlerKeys(ctx, keys); err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Controller{
+		config: &config.Config{
+			Admin: config.AdminConfig{Token: "admin-token"},
+			Auth:  config.AuthConfig{Keys: map[string]string{"team-a": "team-a-key", "team-b": "team-b-key"}},
+		},
+		logger:   zap.NewNop(),
+		db:       db,
+		hostsMap: make(map[string]string),
+	}
+	c.nodes = NewNodeManager(c)
+	c.resources = NewResourceManager(c)
+	return c
+}
+
+func TestAuthInterceptorHandlers(t *testing.T) {
+	c := newAuthController(t)
+	intercept := c.authInterceptor()
+
+	tests := []struct {
+		name   string
+		method string
+		req    interface{}
+		header string
+		value  string
+		want   codes.Code
+	}{
+		{"fence own node", "FencePeer", &sdspb.FencePeerRequest{Name: "web", Node: "alpha", Peer: "beta"},
+			handlerKeyHeader, "alpha-key", codes.OK},
+		{"fence as another node", "FencePeer", &sdspb.FencePeerRequest{Name: "web", Node: "beta", Peer: "alpha"},
+			handlerKeyHeader, "alpha-key", codes.PermissionDenied},
+		{"fence without node", "FencePeer", &sdspb.FencePeerRequest{Name: "web", Peer: "beta"},
+			handlerKeyHeader, "alpha-key", codes.InvalidArgument},
+		{"event as another node", "ReportDrbdEvent", &sdspb.ReportDrbdEventRequest{Name: "web", Node: "beta", Handler: "split-brain"},
+			handlerKeyHeader, "alpha-key", codes.PermissionDenied},
+		{"unfence one peer", "UnfencePeer", &sdspb.UnfencePeerRequest{Name: "web", Node: "alpha", Peer: "beta"},
+			handlerKeyHeader, "alpha-key", codes.OK},
+		{"unfence without peer", "UnfencePeer", &sdspb.UnfencePeerRequest{Name: "web", Node: "alpha"},
+			handlerKeyHeader, "alpha-key", codes.InvalidArgument},
+		{"unfence all with handler key", "UnfencePeer", &sdspb.UnfencePeerRequest{Name: "web", All: true},
+			handlerKeyHeader, "alpha-key", codes.Unauthenticated},
+		{"unfence all with admin token", "UnfencePeer", &sdspb.UnfencePeerRequest{Name: "web", All: true},
+			adminTokenHeader, "admin-token", codes.OK},
+		{"unfence with wrong admin token", "UnfencePeer", &sdspb.UnfencePeerRequest{Name: "web", All: true},
+			adminTokenHeader, "wrong", codes.Unauthenticated},
+		{"unfence with API key", "UnfencePeer", &sdspb.UnfencePeerRequest{Name: "web", All: true},
+			apiKeyHeader, "team-a-key", codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tt.header, tt.value))
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: "/v1.SDSController/" + tt.method}
+			_, err := intercept(ctx, tt.req, info, handler)
+			if status.Code(err) != tt.want {
+				t.Errorf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthInterceptorPrincipals(t *testing.T) {
+	c := newAuthController(t)
+	intercept := c.authInterceptor()
+
+	tests := []struct {
+		name   string
+		method string
+		req    interface{}
+		key    string
+		want   codes.Code
+	}{
+		{"shared resource", "PauseSync", &sdspb.PauseSyncRequest{Name: "web"}, "team-a-key", codes.OK},
+		{"own resource", "PauseSync", &sdspb.PauseSyncRequest{Name: "db"}, "team-b-key", codes.OK},
+		{"other resource by name", "PauseSync", &sdspb.PauseSyncRequest{Name: "db"}, "team-a-key", codes.NotFound},
+		{"other resource", "MakeHa", &sdspb.MakeHaRequest{Resource: "db"}, "team-a-key", codes.NotFound},
+		{"other resource snapshot", "CreateSnapshot", &sdspb.CreateSnapshotRequest{Volume: "db"}, "team-a-key", codes.NotFound},
+		{"other backing volume", "ListSnapshots", &sdspb.ListSnapshotsRequest{Volume: "vg0/db_0"}, "team-a-key", codes.NotFound},
+		{"own backing volume", "ListSnapshots", &sdspb.ListSnapshotsRequest{Volume: "vg0/db_0"}, "team-b-key", codes.OK},
+		{"unmanaged volume", "DeleteSnapshot", &sdspb.DeleteSnapshotRequest{Volume: "vg0/home"}, "team-b-key", codes.NotFound},
+		{"other gateway", "NFSMount", &sdspb.NFSMountRequest{Gateway: "db"}, "team-a-key", codes.NotFound},
+		{"own gateway", "NFSMount", &sdspb.NFSMountRequest{Gateway: "db"}, "team-b-key", codes.OK},
+		{"placement with other resource", "AddPlacementRule",
+			&sdspb.AddPlacementRuleRequest{ResourceA: "web", ResourceB: "db"}, "team-a-key", codes.NotFound},
+		{"repair other resource", "Repair", &sdspb.RepairRequest{Kind: DriftKindHa, Name: "db"}, "team-a-key", codes.NotFound},
+		{"repair node", "Repair", &sdspb.RepairRequest{Kind: DriftKindNode, Name: "alpha"}, "team-b-key", codes.PermissionDenied},
+		{"cluster administration", "CreatePool", &sdspb.CreatePoolRequest{Name: "vg1"}, "team-a-key", codes.PermissionDenied},
+		{"legacy shim", "DeleteLvmSnapshot", &sdspb.DeleteLvmSnapshotRequest{}, "team-a-key", codes.PermissionDenied},
+		{"shared list", "ListFenceConstraints", &sdspb.ListFenceConstraintsRequest{}, "team-a-key", codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(apiKeyHeader, tt.key))
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: "/v1.SDSController/" + tt.method}
+			_, err := intercept(ctx, tt.req, info, handler)
+			if status.Code(err) != tt.want {
+				t.Errorf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// healState tracks a DRBD connection that is down
+type healState struct {
+	state       string // StandAlone or Connecting
+	since       time.Time
+	attempts    int
+	lastAttempt time.Time
+	failed      bool // Attempts are exhausted and the failure was reported
+}
+
+// drbdConnection identifies the connection of a resource from a node to a peer
+type drbdConnection struct {
+	resource string
+	node     string
+	peer     string
+}
+
+func (k drbdConnection) String() string {
+	return fmt.Sprintf("%s: %s -> %s", k.resource, k.node, k.peer)
+}
+
+// runAutoHeal periodically reconnects DRBD connections that stay down until
+// the controller is stopped. The state of the outages is kept in memory, a
+// restart starts counting again.
+func (c *Controller) runAutoHeal(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	outages := make(map[drbdConnection]*healState)
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// No automatic changes during maintenance
+		if c.FreezeState().Frozen {
+			c.logger.Debug("Controller frozen, skipping auto-heal pass")
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(c.ctx, interval)
+		c.autoHealPass(ctx, outages)
+		cancel()
+	}
+}
+
+// autoHealPass reads the connection states of all resources and acts on the
+// connections that have been down for longer than autoheal.after
+func (c *Controller) autoHealPass(ctx context.Context, outages map[drbdConnection]*healState) {
+	down, checked, err := c.downConnections(ctx)
+	if err != nil {
+		c.logger.Warn("Auto-heal pass failed", zap.Error(err))
+		return
+	}
+
+	now := time.Now()
+	for conn, outage := range outages {
+		if _, still := down[conn]; still {
+			continue
+		}
+		// Connections of nodes that could not be read are left alone
+		if !checked[conn.node] {
+			continue
+		}
+		if outage.attempts > 0 {
+			c.RecordEvent(ctx, EventAutoHealRecovered, conn.resource,
+				fmt.Sprintf("Connection %s recovered after %d attempt(s)", conn, outage.attempts),
+				map[string]string{"node": conn.node, "peer": conn.peer, "attempts": fmt.Sprintf("%d", outage.attempts)})
+		}
+		delete(outages, conn)
+	}
+
+	conns := make([]drbdConnection, 0, len(down))
+	for conn := range down {
+		conns = append(conns, conn)
+	}
+	sort.Slice(conns, func(i, j int) bool { return conns[i].String() < conns[j].String() })
+
+	cfg := c.config.AutoHeal
+	maintenance := c.maintenanceNodes(ctx)
+	for _, conn := range conns {
+		outage, ok := outages[conn]
+		if !ok {
+			outage = &healState{since: now}
+			outages[conn] = outage
+		}
+		// StandAlone <-> Connecting is still the same outage
+		outage.state = down[conn]
+
+		// Connections are expected to go down during maintenance, the
+		// outage counts from the end of the window
+		if maintenance[conn.node] != nil || maintenance[conn.peer] != nil {
+			outage.since = now
+			continue
+		}
+
+		if outage.failed || now.Sub(outage.since) < cfg.After || now.Sub(outage.lastAttempt) < cfg.After {
+			continue
+		}
+
+		if outage.attempts >= cfg.MaxAttempts {
+			outage.failed = true
+			c.logger.Error("Auto-heal could not restore DRBD connection",
+				zap.String("resource", conn.resource),
+				zap.String("node", conn.node),
+				zap.String("peer", conn.peer),
+				zap.String("state", outage.state),
+				zap.Duration("down_for", now.Sub(outage.since)))
+			c.RecordEvent(ctx, EventAutoHealFailed, conn.resource,
+				fmt.Sprintf("Connection %s still %s after %d attempt(s), manual intervention required", conn, outage.state, outage.attempts),
+				map[string]string{
+					"node":     conn.node,
+					"peer":     conn.peer,
+					"state":    outage.state,
+					"attempts": fmt.Sprintf("%d", outage.attempts),
+					"since":    outage.since.Format(time.RFC3339),
+				})
+			continue
+		}
+
+		outage.attempts++
+		outage.lastAttempt = now
+		action, err := c.healConnection(ctx, conn, outage.state)
+		result := "ok"
+		if err != nil {
+			result = err.Error()
+		}
+		c.logger.Info("Auto-heal attempt",
+			zap.String("resource", conn.resource),
+			zap.String("node", conn.node),
+			zap.String("peer", conn.peer),
+			zap.String("state", outage.state),
+			zap.Int("attempt", outage.attempts),
+			zap.String("action", action),
+			zap.String("result", result))
+		c.RecordEvent(ctx, EventAutoHealAttempt, conn.resource,
+			fmt.Sprintf("Connection %s %s for %s, attempt %d/%d: %s", conn, outage.state,
+				now.Sub(outage.since).Round(time.Second), outage.attempts, cfg.MaxAttempts, action),
+			map[string]string{
+				"node":    conn.node,
+				"peer":    conn.peer,
+				"state":   outage.state,
+				"attempt": fmt.Sprintf("%d", outage.attempts),
+				"action":  action,
+				"result":  result,
+			})
+	}
+}
+
+// healConnection tries to bring a connection back. A StandAlone connection
+// is reconnected on the node; a Connecting one waits for the peer, whose
+// configuration is applied again. Split brains stay StandAlone, they are
+// never resolved automatically.
+func (c *Controller) healConnection(ctx context.Context, conn drbdConnection, state string) (string, error) {
+	if state == "StandAlone" {
+		action := fmt.Sprintf("drbdadm connect %s:%s on %s", conn.resource, conn.peer, conn.node)
+		if _, err := c.execOutput(ctx, c.resources.nodeAddress(conn.node),
+			fmt.Sprintf("sudo drbdadm connect %s:%s", conn.resource, conn.peer)); err != nil {
+			return action, err
+		}
+		return action, nil
+	}
+
+	action := fmt.Sprintf("drbdadm adjust %s on %s", conn.resource, conn.peer)
+	if _, err := c.execOutput(ctx, c.resources.nodeAddress(conn.peer),
+		fmt.Sprintf("sudo drbdadm adjust %s", conn.resource)); err != nil {
+		return action, err
+	}
+	return action, nil
+}
+
+// downConnections returns the connections in StandAlone or Connecting state
+// of every resource on its nodes, and the nodes whose state could be read
+func (c *Controller) downConnections(ctx context.Context) (map[drbdConnection]string, map[string]bool, error) {
+	if c.db == nil {
+		return nil, nil, fmt.Errorf("database not available")
+	}
+	resources, err := c.db.ListResources(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list resources: %w", err)
+	}
+
+	managed := make(map[string]bool)
+	var nodes []string
+	seen := make(map[string]bool)
+	for _, res := range resources {
+		managed[res.Name] = true
+		if res.Nodes == "" {
+			continue
+		}
+		for _, node := range strings.Split(res.Nodes, ",") {
+			if !seen[node] {
+				seen[node] = true
+				nodes = append(nodes, node)
+			}
+		}
+	}
+	sort.Strings(nodes)
+
+	down := make(map[drbdConnection]string)
+	checked := make(map[string]bool)
+	for _, node := range nodes {
+		output, err := c.execOutput(ctx, c.resources.nodeAddress(node), "sudo drbdsetup status 2>/dev/null")
+		if err != nil {
+			c.logger.Debug("Failed to read DRBD connection states", zap.String("node", node), zap.Error(err))
+			continue
+		}
+		checked[node] = true
+		for resource, peers := range parseDrbdConnections(output) {
+			if !managed[resource] {
+				continue
+			}
+			for peer, state := range peers {
+				if state == "StandAlone" || state == "Connecting" {
+					down[drbdConnection{resource: resource, node: node, peer: peer}] = state
+				}
+			}
+		}
+	}
+	return down, checked, nil
+}
+
+// parseDrbdConnections parses the connection state of every peer of every
+// resource from drbdsetup status output. Connected peers omit the state:
+//
+//	r0 role:Primary
+//	  disk:UpToDate
+//	  node2 connection:Connecting
+//	  node3 role:Secondary
+//	    peer-disk:UpToDate
+func parseDrbdConnections(output string) map[string]map[string]string {
+	connections := make(map[string]map[string]string)
+
+	var current map[string]string
+	for _, line := range strings.Split(output, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+
+		// Resource lines are not indented
+		if !strings.HasPrefix(line, " ") {
+			current = make(map[string]string)
+			connections[fields[0]] = current
+			continue
+		}
+		if current == nil || len(fields) < 2 {
+			continue
+		}
+
+		// Peer lines: "<peer> connection:<state>" or "<peer> role:<role>"
+		if state, ok := strings.CutPrefix(fields[1], "connection:"); ok {
+			current[fields[0]] = state
+		} else if strings.HasPrefix(fields[1], "role:") {
+			current[fields[0]] = "Connected"
+		}
+	}
+
+	return connections
+}
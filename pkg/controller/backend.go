@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// StorageBackend provisions the backing volumes of DRBD resources in the
+// pools of one storage technology. The ResourceManager creates, grows and
+// snapshots volumes only through the backend of their storage type, so a
+// new technology (e.g. Ceph RBD images used as external devices, or md raid
+// arrays) only needs a backend registered with RegisterStorageBackend.
+//
+// Addresses are node addresses, sizes are in bytes.
+type StorageBackend interface {
+	// Name is the family of the backend, shared by its storage types
+	// (lvm for lvm and lvm-thin); it is also the snapshot backend of its
+	// volumes
+	Name() string
+	// DevicePath returns the block device of a volume on the nodes. It must
+	// not use the storage manager, devices are also named without one.
+	DevicePath(pool, volume string) string
+	// CreateVolume creates a volume and returns once its device exists
+	CreateVolume(ctx context.Context, address, pool, volume string, sizeBytes uint64) error
+	// DeleteVolume removes a volume with its snapshots
+	DeleteVolume(ctx context.Context, address, pool, volume string) error
+	// Snapshot takes a snapshot of a volume. size is the space reserved for
+	// the changes where the backend needs one, empty for its default.
+	Snapshot(ctx context.Context, address, pool, volume, snapshot, size string) error
+	// Resize grows a volume to at least sizeBytes
+	Resize(ctx context.Context, address, pool, volume string, sizeBytes uint64) error
+	// ListCapacity lists the SDS pools of the backend on the given nodes
+	ListCapacity(ctx context.Context, addresses []string) ([]*PoolInfo, error)
+}
+
+// StorageBackendFactory creates the backend of a storage type for a storage
+// manager. A nil manager is passed when only DevicePath is needed.
+type StorageBackendFactory func(sm *StorageManager) StorageBackend
+
+var (
+	storageBackendsMu sync.RWMutex
+	storageBackends   = make(map[string]StorageBackendFactory)
+)
+
+// RegisterStorageBackend makes a backend available for a storage type. It is
+// meant to be called from init functions and panics on duplicate types.
+func RegisterStorageBackend(storageType string, factory StorageBackendFactory) {
+	storageBackendsMu.Lock()
+	defer storageBackendsMu.Unlock()
+
+	if factory == nil {
+		panic("controller: nil storage backend factory for " + storageType)
+	}
+	if _, ok := storageBackends[storageType]; ok {
+		panic("controller: storage backend registered twice for " + storageType)
+	}
+	storageBackends[storageType] = factory
+}
+
+// StorageBackendTypes returns the storage types with a registered backend
+func StorageBackendTypes() []string {
+	storageBackendsMu.RLock()
+	defer storageBackendsMu.RUnlock()
+
+	types := make([]string, 0, len(storageBackends))
+	for storageType := range storageBackends {
+		types = append(types, storageType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// storageBackendFactory returns the factory registered for a storage type
+func storageBackendFactory(storageType string) StorageBackendFactory {
+	storageBackendsMu.RLock()
+	defer storageBackendsMu.RUnlock()
+	return storageBackends[storageType]
+}
+
+// Backend returns the backend of a storage type. Raw and file devices have
+// none, they are not provisioned from pools.
+func (sm *StorageManager) Backend(storageType string) (StorageBackend, error) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if backend, ok := sm.backends[storageType]; ok {
+		return backend, nil
+	}
+	factory := storageBackendFactory(storageType)
+	if factory == nil {
+		return nil, fmt.Errorf("no storage backend for storage type %q (%v)", storageType, StorageBackendTypes())
+	}
+	backend := factory(sm)
+	sm.backends[storageType] = backend
+	return backend, nil
+}
+
+// backendFamilies returns one backend of every registered family, for the
+// operations that are per pool rather than per storage type
+func (sm *StorageManager) backendFamilies() []StorageBackend {
+	var backends []StorageBackend
+	seen := make(map[string]bool)
+	for _, storageType := range StorageBackendTypes() {
+		backend, err := sm.Backend(storageType)
+		if err != nil || seen[backend.Name()] {
+			continue
+		}
+		seen[backend.Name()] = true
+		backends = append(backends, backend)
+	}
+	return backends
+}
+
+// sizeArg formats a size in bytes for lvcreate and zfs, in whole GiB when
+// it is one and else rounded up to MiB
+func sizeArg(sizeBytes uint64) string {
+	const mib, gib = 1 << 20, 1 << 30
+	if sizeBytes%gib == 0 {
+		return fmt.Sprintf("%dG", sizeBytes/gib)
+	}
+	return fmt.Sprintf("%dM", (sizeBytes+mib-1)/mib)
+}
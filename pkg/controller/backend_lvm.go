@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	RegisterStorageBackend("lvm", func(sm *StorageManager) StorageBackend {
+		return &lvmBackend{sm: sm}
+	})
+	RegisterStorageBackend("lvm-thin", func(sm *StorageManager) StorageBackend {
+		return &lvmBackend{sm: sm, thin: true}
+	})
+}
+
+// lvmBackend provisions logical volumes in SDS volume groups. Thin volumes
+// are created in the thin pool <vg>_thin of the volume group.
+type lvmBackend struct {
+	sm   *StorageManager
+	thin bool
+}
+
+func (b *lvmBackend) Name() string {
+	return SnapshotBackendLVM
+}
+
+func (b *lvmBackend) DevicePath(pool, volume string) string {
+	return fmt.Sprintf("/dev/%s/%s", pool, volume)
+}
+
+func (b *lvmBackend) CreateVolume(ctx context.Context, address, pool, volume string, sizeBytes uint64) error {
+	deploy := b.sm.controller.deployment
+	if b.thin {
+		return execSucceeded(deploy.LVCreateThinVolume(ctx, []string{address}, pool, pool+"_thin", volume, sizeArg(sizeBytes)))
+	}
+	return execSucceeded(deploy.LVCreate(ctx, []string{address}, pool, volume, sizeArg(sizeBytes)))
+}
+
+func (b *lvmBackend) DeleteVolume(ctx context.Context, address, pool, volume string) error {
+	return execSucceeded(b.sm.controller.deployment.LVRemove(ctx, []string{address}, b.DevicePath(pool, volume)))
+}
+
+// Snapshot takes a thin snapshot of thin volumes and a thick one with a COW
+// volume of size of the others
+func (b *lvmBackend) Snapshot(ctx context.Context, address, pool, volume, snapshot, size string) error {
+	return b.sm.CreateLvmSnapshot(ctx, pool, volume, snapshot, address, size)
+}
+
+// Resize extends the volume, LVM rounds the size up to whole extents
+func (b *lvmBackend) Resize(ctx context.Context, address, pool, volume string, sizeBytes uint64) error {
+	_, err := b.sm.controller.execOutput(ctx, address, fmt.Sprintf("sudo lvextend -L %db %s", sizeBytes, b.DevicePath(pool, volume)))
+	return err
+}
+
+func (b *lvmBackend) ListCapacity(ctx context.Context, addresses []string) ([]*PoolInfo, error) {
+	result, err := b.sm.controller.deployment.Exec(ctx, addresses, "sudo vgs --noheadings --units b --separator '|' -o vg_name,vg_size,vg_free")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list LVM pools: %w", err)
+	}
+
+	var pools []*PoolInfo
+	seen := make(map[string]bool)
+	for host, r := range result.Hosts {
+		if !r.Success {
+			continue
+		}
+		normalizedHost := b.sm.controller.NormalizeHost(host)
+		if normalizedHost == "" {
+			normalizedHost = host
+		}
+
+		for _, line := range strings.Split(strings.TrimSpace(r.Output), "\n") {
+			fields := strings.Split(strings.TrimSpace(line), "|")
+			if len(fields) < 3 {
+				continue
+			}
+			vgName := strings.TrimSpace(fields[0])
+			// Only show SDS-managed pools (with sds_ prefix)
+			if !strings.HasPrefix(vgName, "sds_") {
+				continue
+			}
+			key := normalizedHost + "/" + vgName
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			totalSize, _ := strconv.ParseUint(strings.TrimSpace(strings.TrimSuffix(fields[1], "B")), 10, 64)
+			freeSize, _ := strconv.ParseUint(strings.TrimSpace(strings.TrimSuffix(fields[2], "B")), 10, 64)
+			pools = append(pools, &PoolInfo{
+				Name:    vgName,
+				Type:    "vg",
+				Node:    normalizedHost,
+				TotalGB: totalSize / 1024 / 1024 / 1024,
+				FreeGB:  freeSize / 1024 / 1024 / 1024,
+			})
+		}
+	}
+
+	return pools, nil
+}
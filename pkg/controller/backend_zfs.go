@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	// zvols are created sparse for both types, zfs only differs in how
+	// their allocation is accounted
+	RegisterStorageBackend("zfs", func(sm *StorageManager) StorageBackend {
+		return &zfsBackend{sm: sm}
+	})
+	RegisterStorageBackend("zfs-thin", func(sm *StorageManager) StorageBackend {
+		return &zfsBackend{sm: sm}
+	})
+}
+
+// zfsBackend provisions zvols in SDS zpools
+type zfsBackend struct {
+	sm *StorageManager
+}
+
+func (b *zfsBackend) Name() string {
+	return SnapshotBackendZFS
+}
+
+func (b *zfsBackend) DevicePath(pool, volume string) string {
+	return fmt.Sprintf("/dev/zvol/%s/%s", pool, volume)
+}
+
+// CreateVolume creates the zvol and waits for udev to create its device
+func (b *zfsBackend) CreateVolume(ctx context.Context, address, pool, volume string, sizeBytes uint64) error {
+	if err := execSucceeded(b.sm.controller.deployment.ZFSCreateThinDataset(ctx, []string{address}, pool, volume, sizeArg(sizeBytes))); err != nil {
+		return err
+	}
+	device := b.DevicePath(pool, volume)
+	wait := fmt.Sprintf("for i in $(seq 50); do [ -e %s ] && break; sleep 0.2; done; [ -e %s ]", device, device)
+	if _, err := b.sm.controller.execOutput(ctx, address, wait); err != nil {
+		return fmt.Errorf("%s did not appear: %w", device, err)
+	}
+	return nil
+}
+
+func (b *zfsBackend) DeleteVolume(ctx context.Context, address, pool, volume string) error {
+	_, err := b.sm.controller.execOutput(ctx, address, fmt.Sprintf("sudo zfs destroy -r %s/%s", pool, volume))
+	return err
+}
+
+// Snapshot takes a ZFS snapshot, which needs no reserved space
+func (b *zfsBackend) Snapshot(ctx context.Context, address, pool, volume, snapshot, size string) error {
+	return b.sm.ZFSSnapshot(ctx, fmt.Sprintf("%s/%s", pool, volume), snapshot, address)
+}
+
+// Resize sets the volsize, rounded up to MiB to stay a multiple of the
+// volblocksize
+func (b *zfsBackend) Resize(ctx context.Context, address, pool, volume string, sizeBytes uint64) error {
+	const mib = 1 << 20
+	sizeBytes = (sizeBytes + mib - 1) / mib * mib
+	_, err := b.sm.controller.execOutput(ctx, address, fmt.Sprintf("sudo zfs set volsize=%d %s/%s", sizeBytes, pool, volume))
+	return err
+}
+
+func (b *zfsBackend) ListCapacity(ctx context.Context, addresses []string) ([]*PoolInfo, error) {
+	result, err := b.sm.controller.deployment.ZFSListPools(ctx, addresses)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ZFS pools: %w", err)
+	}
+
+	var pools []*PoolInfo
+	seen := make(map[string]bool)
+	for host, r := range result.Hosts {
+		if !r.Success {
+			continue
+		}
+		normalizedHost := b.sm.controller.NormalizeHost(host)
+		if normalizedHost == "" {
+			normalizedHost = host
+		}
+
+		for _, line := range strings.Split(strings.TrimSpace(r.Output), "\n") {
+			fields := strings.Fields(line)
+			if len(fields) < 4 {
+				continue
+			}
+			poolName := fields[0]
+			// Only show SDS-managed pools (with sds_ prefix)
+			if !strings.HasPrefix(poolName, "sds_") {
+				continue
+			}
+			key := normalizedHost + "/" + poolName
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			totalSize, _ := strconv.ParseUint(fields[1], 10, 64)
+			freeSize, _ := strconv.ParseUint(fields[2], 10, 64)
+			pools = append(pools, &PoolInfo{
+				Name:    poolName,
+				Type:    "zfs",
+				Node:    normalizedHost,
+				TotalGB: totalSize / 1024 / 1024 / 1024,
+				FreeGB:  freeSize / 1024 / 1024 / 1024,
+			})
+		}
+	}
+
+	return pools, nil
+}
@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/liliang-cn/sds/pkg/util"
+)
+
+// backingSizeTolerance is how much the backing devices of a volume may differ
+// in size across nodes: LVM rounds LVs up to its extent size and ZFS zvols to
+// their block size, both depend on the pool
+const backingSizeTolerance = 16 << 20
+
+// backingInspectCmd prints whether a device exists, its size, the devices
+// holding it (e.g. a DRBD or device-mapper device) and where it or its
+// partitions are mounted
+const backingInspectCmd = `d='%s'; if [ ! -b "$d" ]; then echo missing; exit 0; fi; ` +
+	`echo "size $(sudo blockdev --getsize64 "$d")"; ` +
+	`echo "holders $(ls /sys/class/block/$(basename "$(readlink -f "$d")")/holders 2>/dev/null | tr '\n' ' ')"; ` +
+	`lsblk -nro MOUNTPOINT "$d" 2>/dev/null | sed -n 's/^\(.\+\)$/mount \1/p'`
+
+// verifyBackingDevices checks before DRBD attaches a volume that its backing
+// device exists on every node, is neither held by another device nor
+// mounted, and that the sizes match across nodes within
+// backingSizeTolerance. All problems are returned at once, per node.
+// nodes and addresses are parallel slices, backing maps node names to devices.
+func (rm *ResourceManager) verifyBackingDevices(ctx context.Context, nodes, addresses []string, backing map[string]string) error {
+	var problems []string
+	sizes := make(map[string]uint64)
+	for i, node := range nodes {
+		device := backing[node]
+		if device == "" {
+			problems = append(problems, fmt.Sprintf("%s: no backing device", node))
+			continue
+		}
+		output, err := rm.controller.execOutput(ctx, addresses[i], fmt.Sprintf(backingInspectCmd, device))
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: failed to inspect %s: %v", node, device, err))
+			continue
+		}
+
+		var mounts []string
+		for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+			key, value, _ := strings.Cut(strings.TrimSpace(line), " ")
+			value = strings.TrimSpace(value)
+			switch key {
+			case "missing":
+				problems = append(problems, fmt.Sprintf("%s: %s does not exist", node, device))
+			case "size":
+				size, err := strconv.ParseUint(value, 10, 64)
+				if err != nil {
+					problems = append(problems, fmt.Sprintf("%s: failed to read size of %s: %q", node, device, value))
+					continue
+				}
+				sizes[node] = size
+			case "holders":
+				if value != "" {
+					problems = append(problems, fmt.Sprintf("%s: %s is in use by %s", node, device, value))
+				}
+			case "mount":
+				mounts = append(mounts, value)
+			}
+		}
+		if len(mounts) > 0 {
+			problems = append(problems, fmt.Sprintf("%s: %s is mounted on %s", node, device, strings.Join(mounts, ", ")))
+		}
+	}
+
+	var smallest, largest uint64
+	for _, size := range sizes {
+		if smallest == 0 || size < smallest {
+			smallest = size
+		}
+		largest = max(largest, size)
+	}
+	if largest-smallest > backingSizeTolerance {
+		var parts []string
+		for node, size := range sizes {
+			parts = append(parts, fmt.Sprintf("%s %s = %s", node, backing[node], util.FormatBytes(size)))
+		}
+		sort.Strings(parts)
+		problems = append(problems, fmt.Sprintf("backing device sizes differ by more than %s: %s",
+			util.FormatBytes(backingSizeTolerance), strings.Join(parts, ", ")))
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("backing devices are not ready for DRBD: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
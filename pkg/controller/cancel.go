@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"path"
+
+	"google.golang.org/grpc"
+)
+
+// cancelInterceptor records operations whose caller went away (e.g. Ctrl+C
+// in the CLI) in the events log. Remote commands of the operation are killed
+// by the deployment layer once the request context is cancelled.
+func (c *Controller) cancelInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+
+		if errors.Is(ctx.Err(), context.Canceled) {
+			resource := requestTarget(req)
+			operation := path.Base(info.FullMethod)
+			// The request context is done, record with a fresh one
+			c.RecordEvent(context.Background(), EventOperationCancelled, resource,
+				"Operation "+operation+" cancelled by the caller",
+				map[string]string{"operation": operation})
+		}
+
+		return resp, err
+	}
+}
+
+// requestTarget returns the resource or object name a request is about
+func requestTarget(req interface{}) string {
+	if r, ok := req.(interface{ GetResource() string }); ok {
+		return r.GetResource()
+	}
+	if r, ok := req.(interface{ GetName() string }); ok {
+		return r.GetName()
+	}
+	return ""
+}
@@ -0,0 +1,239 @@
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdspb "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// clusterHeader is the metadata key that selects the cluster of a call on a
+// controller serving several clusters
+const clusterHeader = "x-sds-cluster"
+
+// ClusterInfo describes a cluster served by the controller
+type ClusterInfo struct {
+	Name         string
+	UUID         string
+	DatabasePath string
+	Nodes        int
+	Resources    int
+	Default      bool // Calls without a cluster go to this one
+}
+
+// loadClusterIdentity reads the identity of the cluster from the database,
+// or stamps the database with the configured name and a new UUID. A database
+// that belongs to another cluster is refused.
+func (c *Controller) loadClusterIdentity(ctx context.Context) error {
+	name := c.config.Cluster.Name
+	c.identity = &database.ClusterIdentity{Name: name}
+	if c.db == nil {
+		return nil
+	}
+
+	identity, err := c.db.GetClusterIdentity(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load cluster identity: %w", err)
+	}
+	if identity != nil {
+		if identity.Name != name {
+			return fmt.Errorf("database %s belongs to cluster %q, not %q; fix cluster.name or database.path",
+				c.config.Database.Path, identity.Name, name)
+		}
+		c.identity = identity
+		return nil
+	}
+
+	id, err := newClusterUUID()
+	if err != nil {
+		return err
+	}
+	identity = &database.ClusterIdentity{Name: name, UUID: id}
+	if err := c.db.SaveClusterIdentity(ctx, identity); err != nil {
+		return fmt.Errorf("failed to save cluster identity: %w", err)
+	}
+	c.identity = identity
+	c.logger.Info("Cluster identity created",
+		zap.String("cluster", name),
+		zap.String("uuid", id))
+	return nil
+}
+
+// newClusterUUID returns a random (version 4) UUID
+func newClusterUUID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate cluster UUID: %w", err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
+
+// ClusterName returns the name of the controller's cluster
+func (c *Controller) ClusterName() string {
+	return c.identity.Name
+}
+
+// ClusterID returns the UUID of the controller's cluster, empty without a
+// database
+func (c *Controller) ClusterID() string {
+	return c.identity.UUID
+}
+
+// clusterStamp names the cluster in the header of generated configs
+func (c *Controller) clusterStamp() string {
+	if c.identity.UUID == "" {
+		return "cluster " + c.identity.Name
+	}
+	return fmt.Sprintf("cluster %s (%s)", c.identity.Name, c.identity.UUID)
+}
+
+// newExtraClusters creates a controller for every extra cluster of the
+// config. They share the process and the API of this controller but have
+// their own nodes, database and background loops.
+func (c *Controller) newExtraClusters() error {
+	names := make([]string, 0, len(c.config.Cluster.Extra))
+	for name := range c.config.Cluster.Extra {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	c.clusters = make(map[string]*Controller)
+	for _, name := range names {
+		extra, err := New(c.config.ForCluster(name), c.logger.With(zap.String("cluster", name)))
+		if err != nil {
+			return fmt.Errorf("cluster %s: %w", name, err)
+		}
+		extra.sdsServer = NewServer(extra)
+		c.clusters[name] = extra
+	}
+	return nil
+}
+
+// clusterFromContext returns the cluster a call selected, empty for the
+// controller's own cluster
+func clusterFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(clusterHeader); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+// clusterInterceptor hands calls for an extra cluster to its controller,
+// which runs them through its own interceptors. ListClusters is always
+// answered by this controller.
+func (c *Controller) clusterInterceptor() grpc.UnaryServerInterceptor {
+	listClusters := "/" + sdspb.SDSController_ServiceDesc.ServiceName + "/ListClusters"
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		name := clusterFromContext(ctx)
+		if name == "" || name == c.ClusterName() || info.FullMethod == listClusters {
+			return handler(ctx, req)
+		}
+		extra := c.clusters[name]
+		if extra == nil {
+			return nil, status.Errorf(codes.NotFound, "unknown cluster %q, this controller serves %s",
+				name, strings.Join(c.clusterNames(), ", "))
+		}
+		return extra.serveUnary(ctx, req, info)
+	}
+}
+
+// clusterFor returns the controller of the cluster a call selected. Streams
+// do not run through clusterInterceptor and pick their controller with it.
+func (c *Controller) clusterFor(ctx context.Context) (*Controller, error) {
+	name := clusterFromContext(ctx)
+	if name == "" || name == c.ClusterName() {
+		return c, nil
+	}
+	extra := c.clusters[name]
+	if extra == nil {
+		return nil, status.Errorf(codes.NotFound, "unknown cluster %q, this controller serves %s",
+			name, strings.Join(c.clusterNames(), ", "))
+	}
+	return extra, nil
+}
+
+// serveUnary runs a call on an extra cluster's server
+func (c *Controller) serveUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo) (interface{}, error) {
+	prefix := "/" + sdspb.SDSController_ServiceDesc.ServiceName + "/"
+	method := strings.TrimPrefix(info.FullMethod, prefix)
+	for _, desc := range sdspb.SDSController_ServiceDesc.Methods {
+		if desc.MethodName != method {
+			continue
+		}
+		dec := func(v interface{}) error {
+			proto.Merge(v.(proto.Message), req.(proto.Message))
+			return nil
+		}
+		return desc.Handler(c.sdsServer, ctx, dec, chainUnaryInterceptors(c.unaryInterceptors()))
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method %s is not served for cluster %s", info.FullMethod, c.ClusterName())
+}
+
+// chainUnaryInterceptors combines interceptors into one, the first is the
+// outermost
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, inner)
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+// clusterNames returns the names of all clusters served, this one first
+func (c *Controller) clusterNames() []string {
+	names := []string{c.ClusterName()}
+	var extra []string
+	for name := range c.clusters {
+		extra = append(extra, name)
+	}
+	sort.Strings(extra)
+	return append(names, extra...)
+}
+
+// ListClusters describes the clusters served by the controller
+func (c *Controller) ListClusters(ctx context.Context) []*ClusterInfo {
+	clusters := []*ClusterInfo{c.clusterInfo(ctx)}
+	clusters[0].Default = true
+	for _, name := range c.clusterNames()[1:] {
+		clusters = append(clusters, c.clusters[name].clusterInfo(ctx))
+	}
+	return clusters
+}
+
+// clusterInfo describes this controller's cluster
+func (c *Controller) clusterInfo(ctx context.Context) *ClusterInfo {
+	info := &ClusterInfo{
+		Name:         c.ClusterName(),
+		UUID:         c.ClusterID(),
+		DatabasePath: c.config.Database.Path,
+	}
+	nodes, _ := c.nodes.ListNodes(ctx)
+	info.Nodes = len(nodes)
+	if c.db != nil {
+		if resources, err := c.db.ListResources(ctx); err == nil {
+			info.Resources = len(resources)
+		}
+	}
+	return info
+}
@@ -0,0 +1,261 @@
+package controller
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// metricsCollectInterval is how often DRBD and pool metrics are refreshed
+const metricsCollectInterval = 30 * time.Second
+
+// runMetricsCollector periodically collects per-resource and per-pool metrics
+// until the controller is stopped
+func (c *Controller) runMetricsCollector() {
+	ticker := time.NewTicker(metricsCollectInterval)
+	defer ticker.Stop()
+
+	for {
+		c.collectMetrics()
+
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// collectMetrics refreshes the DRBD and pool gauges.
+// All series carry stable resource/node/pool/volume labels so that the
+// dashboards served at /metrics/dashboards can filter on them.
+func (c *Controller) collectMetrics() {
+	if c.metrics == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.ctx, metricsCollectInterval)
+	defer cancel()
+
+	c.metrics.BeginCollection()
+	defer c.metrics.EndCollection()
+
+	c.collectPoolMetrics(ctx)
+	c.collectResourceMetrics(ctx)
+	c.collectHealthMetrics()
+	c.collectSnapshotMetrics(ctx)
+	c.collectReactorMetrics(ctx)
+	c.collectMaintenanceMetrics(ctx)
+	c.collectTimeMetrics()
+	c.collectReplicationMetrics(ctx)
+	c.collectIOMetrics()
+}
+
+// collectIOMetrics records the I/O rates of the volumes computed by the node
+// poller
+func (c *Controller) collectIOMetrics() {
+	for _, stats := range c.ResourceIOStats("") {
+		c.metrics.RecordVolumeIO(stats.Resource, stats.Node, strconv.Itoa(stats.Volume),
+			stats.ReadBytesPerSec, stats.WriteBytesPerSec, stats.ReadIOPS, stats.WriteIOPS,
+			stats.ReadLatencyMs, stats.WriteLatencyMs)
+	}
+}
+
+// collectHealthMetrics records the health of the resources classified by
+// the node poller
+func (c *Controller) collectHealthMetrics() {
+	c.healthMu.RLock()
+	defer c.healthMu.RUnlock()
+	for resource, health := range c.resourceHealths {
+		c.metrics.RecordResourceHealth(resource, healthLevel(health.State))
+	}
+}
+
+// collectMaintenanceMetrics records which registered nodes are in a
+// maintenance window, so alerting rules can leave them out
+func (c *Controller) collectMaintenanceMetrics(ctx context.Context) {
+	nodes, err := c.nodes.ListNodes(ctx)
+	if err != nil {
+		return
+	}
+	maintenance := c.maintenanceNodes(ctx)
+	for _, node := range nodes {
+		c.metrics.RecordNodeMaintenance(node.Name, maintenance[node.Name] != nil)
+	}
+}
+
+// collectTimeMetrics records the clock skew of the nodes as found by their
+// last time check
+func (c *Controller) collectTimeMetrics() {
+	c.nodes.mu.RLock()
+	defer c.nodes.mu.RUnlock()
+	for _, node := range c.nodes.nodes {
+		if t := node.Time; t != nil {
+			c.metrics.RecordNodeTime(t.Node, t.Skew.Seconds(), t.Synchronized)
+		}
+	}
+}
+
+// collectReplicationMetrics records the last successful run of every
+// snapshot replication policy and whether its copy is stale
+func (c *Controller) collectReplicationMetrics(ctx context.Context) {
+	if c.db == nil {
+		return
+	}
+	policies, err := c.db.ListReplicationPolicies(ctx)
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	for _, policy := range policies {
+		var lastSuccess float64
+		if !policy.LastSuccess.IsZero() {
+			lastSuccess = float64(policy.LastSuccess.Unix())
+		}
+		c.metrics.RecordReplication(policy.Resource, policy.TargetNode, lastSuccess, replicationStale(policy, now))
+	}
+}
+
+// collectPoolMetrics records capacity for every SDS-managed pool on every node
+func (c *Controller) collectPoolMetrics(ctx context.Context) {
+	pools, err := c.storage.ListPools(ctx)
+	if err != nil {
+		c.logger.Debug("Failed to collect pool metrics", zap.Error(err))
+		return
+	}
+
+	for _, pool := range pools {
+		total := float64(pool.TotalGB) * 1024 * 1024 * 1024
+		free := float64(pool.FreeGB) * 1024 * 1024 * 1024
+		c.metrics.RecordPoolCapacity(pool.Name, pool.Node, total, free)
+	}
+}
+
+// collectResourceMetrics records role and volume state of every resource on each of its nodes
+func (c *Controller) collectResourceMetrics(ctx context.Context) {
+	if c.db == nil {
+		return
+	}
+
+	resources, err := c.db.ListResources(ctx)
+	if err != nil {
+		c.logger.Debug("Failed to collect resource metrics", zap.Error(err))
+		return
+	}
+
+	for _, res := range resources {
+		// Volume metadata (pool, size) keyed by DRBD volume number
+		pools := make(map[int]string)
+		sizes := make(map[int]float64)
+		if volumes, err := c.db.ListVolumes(ctx, res.Name); err == nil {
+			for _, v := range volumes {
+				if v.ResourceName != res.Name {
+					continue
+				}
+				pools[v.VolumeID] = v.Pool
+				sizes[v.VolumeID] = float64(v.SizeGB) * 1024 * 1024 * 1024
+			}
+		}
+
+		// Stopped resources are down on purpose
+		if res.Nodes == "" || resourceStopped(res) {
+			continue
+		}
+
+		for _, node := range strings.Split(res.Nodes, ",") {
+			address := c.nodes.GetNodeAddressByName(node)
+			if address == "" {
+				address = node
+			}
+
+			result, err := c.deployment.DRBDStatus(ctx, []string{address}, res.Name)
+			if err != nil {
+				continue
+			}
+
+			for _, r := range result.Hosts {
+				if !r.Success {
+					continue
+				}
+
+				c.metrics.RecordResourceRole(res.Name, node, parseRoleFromStatus(r.Output) == "Primary")
+
+				for volID, diskState := range parseLocalDiskStatesFromStatus(r.Output) {
+					c.metrics.RecordVolumeState(res.Name, node, pools[volID], strconv.Itoa(volID),
+						diskState == "UpToDate", lostDiskStates[diskState], sizes[volID])
+					c.noteDiskState(ctx, res.Name, node, volID, diskState)
+				}
+			}
+		}
+	}
+}
+
+// collectSnapshotMetrics records the COW allocation of thick LVM snapshots of
+// every resource. Collecting usage also raises the events for snapshots whose
+// COW volume is filling up.
+func (c *Controller) collectSnapshotMetrics(ctx context.Context) {
+	if c.db == nil {
+		return
+	}
+
+	resources, err := c.db.ListResources(ctx)
+	if err != nil {
+		c.logger.Debug("Failed to collect snapshot metrics", zap.Error(err))
+		return
+	}
+
+	for _, res := range resources {
+		usage, err := c.snapshots.SnapshotUsage(ctx, res.Name, "")
+		if err != nil {
+			continue
+		}
+		for _, u := range usage {
+			if u.SizeBytes == 0 {
+				continue
+			}
+			c.metrics.RecordSnapshotCowUsage(res.Name, u.Node, u.Volume, u.Name, u.CowUsedPercent)
+		}
+	}
+}
+
+// parseLocalDiskStatesFromStatus parses the local disk state of each volume from DRBD status output
+// Format:
+//
+//	res role:Primary
+//	  volume:0 disk:UpToDate
+//	  volume:1 disk:UpToDate
+//	  peer role:Secondary
+//	    volume:0 peer-disk:UpToDate
+//
+// Single-volume resources omit the volume:N prefix, which is reported as volume 0.
+func parseLocalDiskStatesFromStatus(output string) map[int]string {
+	states := make(map[int]string)
+	lines := strings.Split(output, "\n")
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		parts := strings.Fields(trimmed)
+
+		// Stop at the first peer section
+		if i > 0 && len(parts) >= 2 && strings.HasPrefix(parts[1], "role:") {
+			break
+		}
+
+		volID := 0
+		for _, p := range parts {
+			if strings.HasPrefix(p, "volume:") {
+				if n, err := strconv.Atoi(strings.TrimPrefix(p, "volume:")); err == nil {
+					volID = n
+				}
+			}
+			if strings.HasPrefix(p, "disk:") {
+				states[volID] = strings.TrimSuffix(strings.TrimPrefix(p, "disk:"), ",")
+			}
+		}
+	}
+
+	return states
+}
@@ -0,0 +1,186 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/deployment"
+	"github.com/liliang-cn/sds/pkg/version"
+	"go.uber.org/zap"
+)
+
+// componentsTimeout bounds the inventory of all nodes
+const componentsTimeout = 30 * time.Second
+
+// componentVersionsCmd prints the versions of the storage stack of a node:
+// the DRBD versions of drbdVersionCmd, drbd-reactor, LVM and ZFS. The ZFS
+// module reports its version only while it is loaded, the zfs tool also
+// when it is not.
+const componentVersionsCmd = drbdVersionCmd + `; echo '#components'; ` +
+	`echo "REACTOR_VERSION=$(drbd-reactor --version 2>/dev/null | head -n1)"; ` +
+	`echo "LVM_VERSION=$(sudo lvm version 2>/dev/null | sed -n 's/^ *LVM version: *//p')"; ` +
+	`echo "ZFS_VERSION=$(cat /sys/module/zfs/version 2>/dev/null || zfs version 2>/dev/null | head -n1)"`
+
+// componentVersions are the versions in the output of componentVersionsCmd,
+// empty for components that are not installed
+type componentVersions struct {
+	DrbdKernel  string
+	DrbdUtils   string
+	DrbdReactor string
+	Lvm         string
+	Zfs         string
+}
+
+// parseComponentVersions parses the output of componentVersionsCmd
+func parseComponentVersions(output string) componentVersions {
+	var v componentVersions
+	v.DrbdKernel, v.DrbdUtils = parseDrbdVersions(output)
+	for _, line := range strings.Split(output, "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), "=")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(value)
+		if len(fields) == 0 {
+			continue
+		}
+		switch key {
+		case "REACTOR_VERSION":
+			// drbd-reactor 1.4.0
+			v.DrbdReactor = strings.TrimPrefix(fields[len(fields)-1], "v")
+		case "LVM_VERSION":
+			// 2.03.16(2) (2022-05-18)
+			v.Lvm = fields[0]
+		case "ZFS_VERSION":
+			// 2.1.5-1ubuntu6 from the module, zfs-2.1.5-1ubuntu6 from the tool
+			v.Zfs = strings.TrimPrefix(fields[0], "zfs-")
+		}
+	}
+	return v
+}
+
+// NodeComponents is the component inventory of a node
+type NodeComponents struct {
+	Name               string
+	Address            string
+	State              NodeState
+	DrbdKernelVersion  string
+	DrbdUtilsVersion   string
+	DrbdReactorVersion string
+	LvmVersion         string
+	ZfsVersion         string
+	CheckedAt          time.Time // Zero if the node was never inventoried
+	// Stale is set when the node could not be reached, the versions are
+	// the ones last collected
+	Stale    bool
+	Warnings []string
+}
+
+// ClusterInventory is the build of the controller and the components of the
+// nodes of its cluster, what a bug report needs to be reproduced
+type ClusterInventory struct {
+	Cluster    *ClusterInfo
+	Controller version.Info
+	Nodes      []*NodeComponents
+}
+
+// GetClusterInfo returns the build of the controller and collects the
+// component versions of every node. Nodes that cannot be reached are listed
+// with the versions their last health check or inventory found.
+func (c *Controller) GetClusterInfo(ctx context.Context) (*ClusterInventory, error) {
+	nodes, err := c.nodes.ListNodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	reached := make(map[string]bool)
+	if len(nodes) > 0 {
+		addresses := make([]string, len(nodes))
+		for i, node := range nodes {
+			addresses[i] = node.Address
+		}
+		result, err := c.deployment.Exec(ctx, addresses, componentVersionsCmd, deployment.WithExecTimeout(componentsTimeout))
+		if err != nil {
+			c.logger.Warn("Failed to collect node components", zap.Error(err))
+		} else {
+			for address, r := range result.Hosts {
+				if r.Success {
+					reached[address] = true
+					c.recordComponents(ctx, address, parseComponentVersions(r.Output))
+				}
+			}
+		}
+	}
+
+	inventory := &ClusterInventory{
+		Cluster:    c.clusterInfo(ctx),
+		Controller: version.Get(),
+	}
+	c.nodes.mu.RLock()
+	for _, n := range c.nodes.nodes {
+		inventory.Nodes = append(inventory.Nodes, &NodeComponents{
+			Name:               n.Name,
+			Address:            n.Address,
+			State:              n.State,
+			DrbdKernelVersion:  n.DrbdKernelVersion,
+			DrbdUtilsVersion:   n.DrbdUtilsVersion,
+			DrbdReactorVersion: n.DrbdReactorVersion,
+			LvmVersion:         n.LvmVersion,
+			ZfsVersion:         n.ZfsVersion,
+			CheckedAt:          n.ComponentsCheckedAt,
+			Stale:              !reached[n.Address],
+		})
+	}
+	c.nodes.mu.RUnlock()
+
+	sort.Slice(inventory.Nodes, func(i, j int) bool { return inventory.Nodes[i].Name < inventory.Nodes[j].Name })
+	for _, n := range inventory.Nodes {
+		n.Warnings = drbdVersionWarnings(n.DrbdKernelVersion, n.DrbdUtilsVersion)
+	}
+	return inventory, nil
+}
+
+// recordComponents keeps the component versions found on a node with its
+// record. The DRBD versions go through recordDrbdVersions, which warns
+// about them.
+func (c *Controller) recordComponents(ctx context.Context, address string, v componentVersions) {
+	c.recordDrbdVersions(ctx, address, v.DrbdKernel, v.DrbdUtils)
+
+	now := time.Now()
+	c.nodes.mu.Lock()
+	n := c.nodes.nodes[address]
+	if n == nil {
+		c.nodes.mu.Unlock()
+		return
+	}
+	changed := n.DrbdReactorVersion != v.DrbdReactor || n.LvmVersion != v.Lvm || n.ZfsVersion != v.Zfs
+	n.DrbdReactorVersion = v.DrbdReactor
+	n.LvmVersion = v.Lvm
+	n.ZfsVersion = v.Zfs
+	n.ComponentsCheckedAt = now
+	name := n.Name
+	c.nodes.mu.Unlock()
+
+	if c.db != nil {
+		if dbNode, err := c.db.GetNode(ctx, address); err == nil {
+			dbNode.DrbdReactorVersion = v.DrbdReactor
+			dbNode.LvmVersion = v.Lvm
+			dbNode.ZfsVersion = v.Zfs
+			dbNode.ComponentsCheckedAt = now
+			if err := c.db.SaveNode(ctx, dbNode); err != nil {
+				c.logger.Warn("Failed to save node to database", zap.Error(err))
+			}
+		}
+	}
+
+	if changed {
+		c.logger.Info("Component versions of node",
+			zap.String("node", name),
+			zap.String("drbd_reactor", v.DrbdReactor),
+			zap.String("lvm", v.Lvm),
+			zap.String("zfs", v.Zfs))
+	}
+}
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdspb "github.com/liliang-cn/sds/api/proto/v1"
+)
+
+// PlannedFile is a file a dry run would write to a node, compared with the
+// one deployed there
+type PlannedFile struct {
+	Node    string
+	Path    string
+	Content string // The file as it would be written
+	State   string // ConfigMissing for a new file
+	Diff    string // Changed lines, "-" deployed and "+" planned
+}
+
+// planFiles compares the files a change would write, by path, with the ones
+// deployed on each node, ordered by node and path
+func (rm *ResourceManager) planFiles(ctx context.Context, nodes []string, files map[string]string) []*PlannedFile {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var planned []*PlannedFile
+	for _, node := range nodes {
+		address := rm.nodeAddress(node)
+		for _, path := range paths {
+			file := &PlannedFile{Node: node, Path: path, Content: files[path]}
+			planned = append(planned, file)
+
+			output, err := rm.controller.execOutput(ctx, address, fmt.Sprintf("sudo cat %s 2>/dev/null || echo __missing__", path))
+			switch {
+			case err != nil:
+				file.State = ConfigUnreachable
+			case strings.TrimSpace(output) == "__missing__":
+				file.State = ConfigMissing
+			default:
+				file.Diff = lineDiff(output, file.Content)
+				file.State = ConfigIdentical
+				if file.Diff != "" {
+					file.State = ConfigDiffers
+				}
+			}
+		}
+	}
+	return planned
+}
+
+// planResourceFiles plans files for the nodes of a resource
+func (rm *ResourceManager) planResourceFiles(ctx context.Context, resource string, files map[string]string) ([]*PlannedFile, error) {
+	nodes, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	return rm.planFiles(ctx, nodes, files), nil
+}
+
+// planGatewayFiles diffs the files a gateway dry run would write against the
+// ones deployed on the nodes of its resource
+func (rm *ResourceManager) planGatewayFiles(ctx context.Context, resource string, files []*sdspb.PlannedFile) ([]*sdspb.PlannedFile, error) {
+	contents := make(map[string]string, len(files))
+	for _, file := range files {
+		contents[file.Path] = file.Content
+	}
+	planned, err := rm.planResourceFiles(ctx, resource, contents)
+	if err != nil {
+		return nil, err
+	}
+	return plannedFilesToProto(planned), nil
+}
+
+// plannedFilesToProto converts planned files for a response
+func plannedFilesToProto(files []*PlannedFile) []*sdspb.PlannedFile {
+	var result []*sdspb.PlannedFile
+	for _, file := range files {
+		result = append(result, &sdspb.PlannedFile{
+			Node:    file.Node,
+			Path:    file.Path,
+			Content: file.Content,
+			State:   file.State,
+			Diff:    file.Diff,
+		})
+	}
+	return result
+}
@@ -3,7 +3,6 @@ package controller
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
@@ -16,6 +15,7 @@ import (
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
 	"go.uber.org/zap"
 
 	sdspb "github.com/liliang-cn/sds/api/proto/v1"
@@ -24,6 +24,7 @@ import (
 	"github.com/liliang-cn/sds/pkg/deployment"
 	"github.com/liliang-cn/sds/pkg/gateway"
 	"github.com/liliang-cn/sds/pkg/metrics"
+	"github.com/liliang-cn/sds/pkg/secrets"
 )
 
 // Controller represents the SDS controller
@@ -41,6 +42,8 @@ type Controller struct {
 	// Metrics
 	metrics       *metrics.Metrics
 	metricsServer *http.Server
+	// REST API gateway, also served by the UI server
+	restServer *http.Server
 	// UI
 	uiServer *UIServer
 	// Managers
@@ -49,6 +52,51 @@ type Controller struct {
 	snapshots *SnapshotManager
 	nodes     *NodeManager
 	gateway   *gateway.Manager
+	// Gateway credentials
+	secrets *secrets.Store
+	// Read-only switch
+	freeze   *database.FreezeState
+	freezeMu sync.RWMutex
+	// Last reconcile pass
+	drift   *ReconcileReport
+	driftMu sync.RWMutex
+	// Last pool usage sample, only touched by the node poller
+	poolSampledAt time.Time
+	// DRBD I/O counters of the last node poll and the rates computed from them
+	ioSamples map[string]*ioSample
+	ioStats   map[string]*VolumeIOStats
+	ioMu      sync.RWMutex
+	// DRBD state of the resources on each node as of the last node poll,
+	// by node name and resource
+	liveStates map[string]map[string]*ResourceNodeState
+	liveMu     sync.RWMutex
+	// Health of the resources from the last node poll, and since when a
+	// replica is Inconsistent by resource/node
+	resourceHealths   map[string]*ResourceHealth
+	inconsistentSince map[string]time.Time
+	healthMu          sync.RWMutex
+	// Last completed node poll and the nodes it reached, for readiness
+	polledAt     time.Time
+	polledNodes  int
+	reachedNodes int
+	pollMu       sync.RWMutex
+	// Volumes whose local disk was lost, by resource/node/volume, only
+	// touched by the metrics collector
+	lostDisks map[string]string
+	// Snapshot replication runs one at a time, they share the port of the
+	// backup nodes
+	replicationMu sync.Mutex
+	// Progress of the jobs of calls with a progress id, by id
+	progressFeeds map[string]*progressFeed
+	progressMu    sync.Mutex
+	// gRPC health service, with the status of each subsystem
+	health *health.Server
+	// Cluster name and UUID
+	identity *database.ClusterIdentity
+	// Extra clusters served by this controller, and the server of an extra
+	// cluster that runs their calls
+	clusters  map[string]*Controller
+	sdsServer *Server
 }
 
 // New creates a new controller
@@ -72,6 +120,12 @@ func New(cfg *config.Config, logger *zap.Logger) (*Controller, error) {
 		return nil, fmt.Errorf("failed to create deployment client: %w", err)
 	}
 
+	deploymentClient.SetLogOutput(cfg.Log.ExecOutput)
+	deploymentClient.SetDefaultTimeout(cfg.Timeouts.Default)
+	deploymentClient.SetReactorCheck(cfg.Reactor.Check)
+	deploymentClient.SetKnownHosts(deployment.NewKnownHosts(cfg.SSH.KnownHosts), cfg.SSH.StrictHostKey)
+	deploymentClient.SetRestrictedSudo(cfg.SSH.Sudo == "restricted")
+
 	ctrl := &Controller{
 		config:     cfg,
 		logger:     logger,
@@ -107,43 +161,37 @@ func New(cfg *config.Config, logger *zap.Logger) (*Controller, error) {
 		ctrl.metrics = metricsInstance
 	}
 
-	// Initialize hosts mapping
-	ctrl.initHostsMapping()
+	// Restore the read-only switch before serving requests
+	ctrl.loadFreezeState(ctx)
+
+	if err := ctrl.loadClusterIdentity(ctx); err != nil {
+		cancel()
+		if db != nil {
+			db.Close()
+		}
+		return nil, err
+	}
+
+	// Initialize secrets store
+	if err := ctrl.initSecrets(); err != nil {
+		logger.Warn("Failed to initialize secrets store, gateway credentials will not be persisted and event hook headers cannot be set", zap.Error(err))
+	}
 
 	// Load data from database
 	if db != nil {
 		if err := ctrl.loadFromDatabase(ctx); err != nil {
 			logger.Warn("Failed to load data from database", zap.Error(err))
 		}
+		ctrl.migrateGatewaySecrets(ctx)
+		ctrl.migrateEventHookSecrets(ctx)
 	}
 
-	return ctrl, nil
-}
-
-// initHostsMapping initializes hostname to address mapping
-func (c *Controller) initHostsMapping() {
-	// Get hostnames from all hosts
-	if len(c.hosts) == 0 {
-		return
+	if err := ctrl.newExtraClusters(); err != nil {
+		ctrl.Stop()
+		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
-	for _, host := range c.hosts {
-		result, err := c.deployment.Exec(ctx, []string{host}, "hostname")
-		if err == nil && result.AllSuccess() {
-			for h, r := range result.Hosts {
-				if r.Success && r.Output != "" {
-					hostname := r.Output
-					c.hostsMap[hostname] = h
-					c.logger.Debug("Host mapping",
-						zap.String("hostname", hostname),
-						zap.String("address", h))
-				}
-			}
-		}
-	}
+	return ctrl, nil
 }
 
 // loadHostsFromDatabase loads hosts from registered nodes in database
@@ -169,24 +217,20 @@ func (c *Controller) loadHostsFromDatabase(ctx context.Context) error {
 
 // Start starts the controller
 func (c *Controller) Start() error {
-	c.logger.Info("Starting SDS controller")
-
-	// Load hosts from registered nodes in database
-	if c.db != nil {
-		if err := c.loadHostsFromDatabase(context.Background()); err != nil {
-			c.logger.Warn("Failed to load hosts from database", zap.Error(err))
-		}
-	}
+	c.logger.Info("Starting SDS controller", zap.String("cluster", c.ClusterName()))
 
-	// Initialize deployment client with hosts
-	c.resources.SetDeployment(c.deployment)
-	c.resources.SetHosts(c.hosts)
+	c.startCluster()
 
 	// Start metrics server if enabled
 	if c.config.Metrics.Enabled && c.metrics != nil {
 		if err := c.startMetricsServer(); err != nil {
 			return fmt.Errorf("failed to start metrics server: %w", err)
 		}
+		go c.runMetricsCollector()
+	}
+
+	for _, name := range c.clusterNames()[1:] {
+		c.clusters[name].startCluster()
 	}
 
 	// Start gRPC server
@@ -195,7 +239,7 @@ func (c *Controller) Start() error {
 	}
 
 	// Start UI server
-	uiServer, err := NewUIServer(c.logger, c.config.Server.ListenAddress, 3376)
+	uiServer, err := NewUIServer(c.logger, c.config.Server.ListenAddress, c.config.Server.UIPort, c.restServer.Handler)
 	if err != nil {
 		return fmt.Errorf("failed to create UI server: %w", err)
 	}
@@ -207,17 +251,73 @@ func (c *Controller) Start() error {
 	c.logger.Info("SDS controller started",
 		zap.String("address", c.config.Server.ListenAddress),
 		zap.Int("port", c.config.Server.Port),
+		zap.Strings("clusters", c.clusterNames()),
 		zap.Strings("hosts", c.hosts))
 
 	return nil
 }
 
+// startCluster loads the nodes of the cluster and starts its background
+// loops
+func (c *Controller) startCluster() {
+	// Load hosts from registered nodes in database
+	if c.db != nil {
+		if err := c.loadHostsFromDatabase(context.Background()); err != nil {
+			c.logger.Warn("Failed to load hosts from database", zap.Error(err))
+		}
+		c.warnUntrustedNodes(context.Background())
+		c.markInterruptedJobs(context.Background())
+	}
+
+	// Initialize deployment client with hosts
+	c.resources.SetDeployment(c.deployment)
+	c.resources.SetHosts(c.hosts)
+
+	// Start node capacity polling
+	go c.runNodePoller()
+
+	// Start checking the clocks of the nodes
+	if c.config.TimeSync.Interval > 0 {
+		go c.runTimeChecker(c.config.TimeSync.Interval)
+	}
+
+	// Start drift detection
+	if c.db != nil && c.config.Reconcile.Interval > 0 {
+		go c.runReconciler(c.config.Reconcile.Interval)
+	}
+
+	// Start Primary rebalancing
+	if c.db != nil && c.config.Rebalance.Interval > 0 {
+		rb := c.config.Rebalance
+		go c.runRebalancer(rb.Interval, rb.Auto, rb.MaxMoves, rb.Threshold)
+	}
+
+	// Start reconnecting DRBD connections that stay down
+	if c.db != nil && c.config.AutoHeal.Interval > 0 {
+		go c.runAutoHeal(c.config.AutoHeal.Interval)
+	}
+
+	// Start scheduled snapshot replication
+	if c.db != nil && c.config.Replication.Interval > 0 {
+		go c.runReplicator(c.config.Replication.Interval)
+	}
+
+	// Start scheduled cluster reports
+	if c.db != nil && c.config.Report.Interval > 0 {
+		go c.runReporter(c.config.Report.Interval)
+	}
+}
+
 // Stop stops the controller
 func (c *Controller) Stop() {
 	c.logger.Info("Stopping SDS controller")
 
 	c.cancel()
 
+	for _, extra := range c.clusters {
+		extra.Stop()
+	}
+
 	// Stop metrics server
 	if c.metricsServer != nil {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -232,6 +332,11 @@ func (c *Controller) Stop() {
 		c.server.GracefulStop()
 	}
 
+	// Stop REST API gateway
+	if c.restServer != nil {
+		c.restServer.Close()
+	}
+
 	// Stop UI server
 	if c.uiServer != nil {
 		c.uiServer.Shutdown()
@@ -248,27 +353,32 @@ func (c *Controller) startGRPCServer() error {
 	if err != nil {
 		return fmt.Errorf("failed to listen for gRPC: %w", err)
 	}
+	grpcLis = c.limitListener(grpcLis)
 
 	// Create gRPC server
-	var opts []grpc.ServerOption
-	if c.metrics != nil {
-		opts = append(opts, grpc.ChainUnaryInterceptor(
-			c.metrics.UnaryServerInterceptor(),
-		))
-	}
+	opts := c.serverOptions()
+	interceptors := append([]grpc.UnaryServerInterceptor{c.clusterInterceptor()}, c.unaryInterceptors()...)
+	opts = append(opts, grpc.ChainUnaryInterceptor(interceptors...), grpc.ChainStreamInterceptor(c.authStreamInterceptor()))
 	c.server = grpc.NewServer(opts...)
 
 	// Register health service
-	healthServer := health.NewServer()
-	grpc_health_v1.RegisterHealthServer(c.server, healthServer)
-	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+	c.health = health.NewServer()
+	grpc_health_v1.RegisterHealthServer(c.server, c.health)
+	c.health.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+	c.updateHealth(c.checkReadiness(context.Background()))
+	go c.runHealthChecker()
 
 	// Register SDS controller service
-	sdsServer := NewServer(c)
-	sdspb.RegisterSDSControllerServer(c.server, sdsServer)
+	c.sdsServer = NewServer(c)
+	sdspb.RegisterSDSControllerServer(c.server, c.sdsServer)
 
 	c.logger.Info("Registered SDS controller service")
 
+	if c.config.Server.Reflection {
+		reflection.Register(c.server)
+		c.logger.Warn("gRPC reflection enabled, calls must present the admin token")
+	}
+
 	// Start gRPC server
 	go func() {
 		c.logger.Info("gRPC server listening", zap.String("address", grpcAddr))
@@ -277,15 +387,12 @@ func (c *Controller) startGRPCServer() error {
 		}
 	}()
 
-	// Start HTTP REST API gateway on port 3375
-	restPort := 3375
-	restAddr := fmt.Sprintf("%s:%d", c.config.Server.ListenAddress, restPort)
+	// Start HTTP REST API gateway
+	restAddr := fmt.Sprintf("%s:%d", c.config.Server.ListenAddress, c.config.Server.RESTPort)
 	restLis, err := net.Listen("tcp", restAddr)
 	if err != nil {
 		return fmt.Errorf("failed to listen for REST: %w", err)
 	}
-	// Wrap listener to reject HTTP/2 connections
-	restLis = &http1OnlyListener{Listener: restLis}
 
 	// Create and register gRPC-Gateway
 	gatewayMux := runtime.NewServeMux(
@@ -296,11 +403,14 @@ func (c *Controller) startGRPCServer() error {
 
 	dialOpts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(4*1024*1024)),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(c.config.Server.MaxSendMsgSize),
+			grpc.MaxCallSendMsgSize(c.config.Server.MaxRecvMsgSize),
+		),
 		grpc.WithKeepaliveParams(keepalive.ClientParameters{
-			Time:                10 * time.Second,
+			Time:                c.gatewayKeepalive(),
 			Timeout:             time.Second,
-			PermitWithoutStream: true,
+			PermitWithoutStream: c.config.Server.KeepalivePermitWithoutStream,
 		}),
 	}
 
@@ -310,19 +420,23 @@ func (c *Controller) startGRPCServer() error {
 	}
 
 	// Wrap with CORS handler
-	corsHandler := corsMiddleware(gatewayMux)
+	corsHandler := corsMiddleware(etagMiddleware(gatewayMux))
+
+	// Liveness and readiness probes next to the API
+	mux := http.NewServeMux()
+	c.registerHealthHandlers(mux)
+	mux.Handle("/", corsHandler)
 
-	// Create HTTP server for gateway (disable HTTP/2 for REST API)
-	gatewayServer := &http.Server{
-		Handler:           corsHandler,
+	// Create HTTP server for gateway
+	c.restServer = &http.Server{
+		Handler:           mux,
 		ReadHeaderTimeout: 5 * time.Second,
-		// Disable HTTP/2 to avoid protocol mismatch with browsers
-		TLSNextProto: make(map[string]func(*http.Server, *tls.Conn, http.Handler)),
+		Protocols:         httpProtocols(),
 	}
 
 	go func() {
 		c.logger.Info("HTTP REST API gateway listening", zap.String("address", restAddr))
-		if err := gatewayServer.Serve(restLis); err != nil && err != http.ErrServerClosed {
+		if err := c.restServer.Serve(restLis); err != nil && err != http.ErrServerClosed {
 			c.logger.Error("HTTP gateway server error", zap.Error(err))
 		}
 	}()
@@ -334,23 +448,33 @@ func (c *Controller) startGRPCServer() error {
 	return nil
 }
 
-// corsMiddleware adds CORS headers and forces HTTP/1.1
+// unaryInterceptors returns the interceptors every call of the cluster runs
+// through
+func (c *Controller) unaryInterceptors() []grpc.UnaryServerInterceptor {
+	interceptors := []grpc.UnaryServerInterceptor{c.authInterceptor(), c.adminInterceptor(), c.freezeInterceptor(), c.deadlineInterceptor(), c.cancelInterceptor(), c.failureInterceptor(), c.jobInterceptor()}
+	if c.metrics != nil {
+		interceptors = append(interceptors, c.metrics.UnaryServerInterceptor())
+	}
+	return interceptors
+}
+
+// httpProtocols returns the protocols of the HTTP listeners: HTTP/1.1 for
+// browsers and cleartext HTTP/2 for clients that start with the HTTP/2
+// preface. Upgrade: h2c requests are answered with HTTP/1.1.
+func httpProtocols() *http.Protocols {
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetUnencryptedHTTP2(true)
+	return protocols
+}
+
+// corsMiddleware adds CORS headers
 func corsMiddleware(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Force HTTP/1.1 response
-		w.Header().Set("Connection", "close")
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, PATCH, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With")
-		w.Header().Set("Access-Control-Expose-Headers", "Content-Length, Content-Type")
-
-		// Reject HTTP/2 upgrade attempts
-		if r.Header.Get("Upgrade") == "h2c" || r.ProtoMajor == 2 {
-			w.Header().Set("Connection", "close")
-			w.WriteHeader(http.StatusHTTPVersionNotSupported)
-			w.Write([]byte("HTTP/2 not supported, use HTTP/1.1"))
-			return
-		}
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With, X-Sds-Cluster, X-Sds-Api-Key, X-Sds-Progress-Id, If-None-Match")
+		w.Header().Set("Access-Control-Expose-Headers", "Content-Length, Content-Type, ETag")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusNoContent)
@@ -361,46 +485,17 @@ func corsMiddleware(h http.Handler) http.Handler {
 	})
 }
 
-// http1OnlyListener wraps a listener to reject HTTP/2 client preface
-type http1OnlyListener struct {
-	net.Listener
-}
-
-func (l *http1OnlyListener) Accept() (net.Conn, error) {
-	conn, err := l.Listener.Accept()
-	if err != nil {
-		return conn, err
-	}
-	return &http1OnlyConn{Conn: conn}, nil
-}
-
-type http1OnlyConn struct {
-	net.Conn
-	firstByte bool
-}
-
-func (c *http1OnlyConn) Read(b []byte) (int, error) {
-	n, err := c.Conn.Read(b)
-	if n > 0 && !c.firstByte {
-		c.firstByte = true
-		// HTTP/2 client preface starts with "PRI * HTTP/2.0\r\n\r\nSM\r\n\r\n"
-		// The magic bytes are 0x505249202a20485454502f322e300d0a0d0a534d0d0a0d0a
-		// First byte is 'P' (0x50) for PRI, or we can check for the connection preface
-		if len(b) > 0 && b[0] == 0x50 { // 'P' from "PRI"
-			c.Conn.Close()
-			return 0, net.ErrClosed
-		}
-	}
-	return n, err
-}
-
-
 // startMetricsServer starts the Prometheus metrics HTTP server
 func (c *Controller) startMetricsServer() error {
 	addr := fmt.Sprintf("%s:%d", c.config.Metrics.ListenAddress, c.config.Metrics.Port)
+	mux := http.NewServeMux()
+	mux.Handle("/metrics/dashboards", metrics.DashboardsHandler())
+	c.registerHealthHandlers(mux)
+	mux.Handle("/", c.metrics.Handler())
+
 	c.metricsServer = &http.Server{
 		Addr:    addr,
-		Handler: c.metrics.Handler(),
+		Handler: mux,
 	}
 
 	go func() {
@@ -490,8 +585,19 @@ func (a *GatewayResourceManager) GetResource(ctx context.Context, name string) (
 	if err != nil {
 		return nil, err
 	}
+	return gatewayResourceInfo(info), nil
+}
+
+func (a *GatewayResourceManager) GetResourceFromDB(ctx context.Context, name string) (*gateway.ResourceInfo, error) {
+	info, err := a.rm.GetResourceFromDB(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return gatewayResourceInfo(info), nil
+}
 
-	// Convert controller.ResourceInfo to gateway.ResourceInfo
+// gatewayResourceInfo converts controller.ResourceInfo to gateway.ResourceInfo
+func gatewayResourceInfo(info *ResourceInfo) *gateway.ResourceInfo {
 	gwVolumes := make([]*gateway.ResourceVolumeInfo, len(info.Volumes))
 	for i, v := range info.Volumes {
 		gwVolumes[i] = &gateway.ResourceVolumeInfo{
@@ -518,13 +624,17 @@ func (a *GatewayResourceManager) GetResource(ctx context.Context, name string) (
 		Role:       info.Role,
 		Volumes:    gwVolumes,
 		NodeStates: gwNodeStates,
-	}, nil
+	}
 }
 
 func (a *GatewayResourceManager) SetPrimary(ctx context.Context, resource, node string, force bool) error {
 	return a.rm.SetPrimary(ctx, resource, node, force)
 }
 
+func (a *GatewayResourceManager) GetResourceHosts(ctx context.Context, name string) ([]string, error) {
+	return a.rm.ResourceHosts(ctx, name)
+}
+
 // GatewayDeploymentClient adapts deployment.Client to gateway.DeploymentClient interface
 type GatewayDeploymentClient struct {
 	dc *deployment.Client
@@ -564,7 +674,14 @@ func (c *Controller) loadFromDatabase(ctx context.Context) error {
 			State:    NodeState(dbNode.State),
 			LastSeen: dbNode.LastSeen,
 			Version:  dbNode.Version,
-			Capacity: make(map[string]interface{}),
+
+			DrbdKernelVersion: dbNode.DrbdKernelVersion,
+			DrbdUtilsVersion:  dbNode.DrbdUtilsVersion,
+
+			DrbdReactorVersion:  dbNode.DrbdReactorVersion,
+			LvmVersion:          dbNode.LvmVersion,
+			ZfsVersion:          dbNode.ZfsVersion,
+			ComponentsCheckedAt: dbNode.ComponentsCheckedAt,
 		}
 		c.nodes.mu.Unlock()
 
@@ -0,0 +1,176 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// Desired states of a resource
+const (
+	ResourceStarted = "started"
+	ResourceStopped = "stopped"
+)
+
+// driftUpWhileStopped is the drift of a stopped resource found up on a node
+const driftUpWhileStopped = "resource up while stopped"
+
+// resourceStopped tells whether a resource was stopped on purpose
+func resourceStopped(res *database.Resource) bool {
+	return res.DesiredState == ResourceStopped
+}
+
+// desiredState returns the desired state of a resource
+func desiredState(res *database.Resource) string {
+	if resourceStopped(res) {
+		return ResourceStopped
+	}
+	return ResourceStarted
+}
+
+// StopResource takes a resource down on all of its nodes and records it as
+// stopped. The promoters of its HA config and gateways are disabled first,
+// which stops their services and demotes the Primary. Until StartResource
+// the reconciler keeps the resource down, and it is left out of the health
+// metrics, alerting rules and snapshot replication.
+func (rm *ResourceManager) StopResource(ctx context.Context, name string) error {
+	if rm.deployment == nil {
+		return fmt.Errorf("deployment client not set")
+	}
+	if rm.controller.db == nil {
+		return fmt.Errorf("database not available")
+	}
+	res, err := rm.controller.db.GetResource(ctx, name)
+	if err != nil {
+		return fmt.Errorf("resource not found: %s", name)
+	}
+	nodes, err := rm.resourceNodeNames(ctx, name)
+	if err != nil {
+		return err
+	}
+	addresses := make([]string, len(nodes))
+	for i, node := range nodes {
+		addresses[i] = rm.nodeAddress(node)
+	}
+
+	// Recorded first, so the resource going down is not taken for a failure
+	if err := rm.setDesiredState(ctx, res, ResourceStopped); err != nil {
+		return err
+	}
+
+	rm.controller.logger.Info("Stopping resource",
+		zap.String("resource", name),
+		zap.Strings("nodes", nodes))
+
+	if configs := rm.reactorConfigsForResource(ctx, name); len(configs) > 0 {
+		cmd := fmt.Sprintf("sudo drbd-reactorctl disable --now %s", strings.Join(configs, " "))
+		if err := rm.execAll(ctx, addresses, cmd); err != nil {
+			return fmt.Errorf("failed to stop drbd-reactor promoters: %w", err)
+		}
+	}
+
+	result, err := rm.deployment.DRBDDown(ctx, addresses, name)
+	if err != nil {
+		return fmt.Errorf("failed to bring down resource: %w", err)
+	}
+	if !result.AllSuccess() {
+		return fmt.Errorf("resource down failed on hosts: %v, the reconciler retries", result.FailedHosts())
+	}
+
+	rm.controller.RecordEvent(ctx, EventResourceStopped, name,
+		fmt.Sprintf("Resource %s stopped on %s", name, strings.Join(nodes, ", ")),
+		map[string]string{"nodes": strings.Join(nodes, ",")})
+	return nil
+}
+
+// StartResource brings a stopped resource up again on all of its nodes and
+// enables the promoters of its HA config and gateways, which start its
+// services on one of them
+func (rm *ResourceManager) StartResource(ctx context.Context, name string) error {
+	if rm.deployment == nil {
+		return fmt.Errorf("deployment client not set")
+	}
+	if rm.controller.db == nil {
+		return fmt.Errorf("database not available")
+	}
+	res, err := rm.controller.db.GetResource(ctx, name)
+	if err != nil {
+		return fmt.Errorf("resource not found: %s", name)
+	}
+	nodes, err := rm.resourceNodeNames(ctx, name)
+	if err != nil {
+		return err
+	}
+	addresses := make([]string, len(nodes))
+	for i, node := range nodes {
+		addresses[i] = rm.nodeAddress(node)
+	}
+
+	rm.controller.logger.Info("Starting resource",
+		zap.String("resource", name),
+		zap.Strings("nodes", nodes))
+
+	// adjust brings the resource up where it is down
+	result, err := rm.deployment.DRBDAdjust(rm.controller.stepContext(ctx, StepDrbdUp), addresses, name)
+	if err != nil {
+		return fmt.Errorf("failed to bring up resource: %w", err)
+	}
+	if !result.AllSuccess() {
+		return fmt.Errorf("resource up failed on hosts: %v", result.FailedHosts())
+	}
+
+	if configs := rm.reactorConfigsForResource(ctx, name); len(configs) > 0 {
+		cmd := fmt.Sprintf("sudo drbd-reactorctl enable %s", strings.Join(configs, " "))
+		if err := rm.execAll(ctx, addresses, cmd); err != nil {
+			return fmt.Errorf("failed to enable drbd-reactor promoters: %w", err)
+		}
+	}
+
+	if err := rm.setDesiredState(ctx, res, ResourceStarted); err != nil {
+		return err
+	}
+
+	rm.controller.RecordEvent(ctx, EventResourceStarted, name,
+		fmt.Sprintf("Resource %s started on %s", name, strings.Join(nodes, ", ")),
+		map[string]string{"nodes": strings.Join(nodes, ",")})
+	return nil
+}
+
+// setDesiredState records the desired state of a resource
+func (rm *ResourceManager) setDesiredState(ctx context.Context, res *database.Resource, state string) error {
+	res.DesiredState = state
+	if state == ResourceStarted {
+		res.DesiredState = ""
+	}
+	if err := rm.controller.db.SaveResource(ctx, res); err != nil {
+		return fmt.Errorf("failed to save resource: %w", err)
+	}
+	return nil
+}
+
+// enforceStopped takes stopped resources down again on the nodes where a
+// reconcile pass found them up
+func (c *Controller) enforceStopped(ctx context.Context, report *ReconcileReport) {
+	for _, d := range report.Drifts {
+		if d.Kind != DriftKindResource || d.Problem != driftUpWhileStopped {
+			continue
+		}
+		result, err := c.deployment.DRBDDown(ctx, []string{c.resources.nodeAddress(d.Node)}, d.Name)
+		if err == nil && !result.AllSuccess() {
+			err = fmt.Errorf("drbdadm down failed")
+		}
+		if err != nil {
+			c.logger.Warn("Failed to take stopped resource down",
+				zap.String("resource", d.Name),
+				zap.String("node", d.Node),
+				zap.Error(err))
+			continue
+		}
+		c.RecordEvent(ctx, EventResourceStopped, d.Name,
+			fmt.Sprintf("Stopped resource %s was up on %s, took it down", d.Name, d.Node),
+			map[string]string{"nodes": d.Node})
+	}
+}
@@ -0,0 +1,393 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultDrSyncTimeout bounds how long a switchover waits for the target to become UpToDate
+	defaultDrSyncTimeout = 10 * time.Minute
+	// drRollbackTimeout bounds re-enabling the promoters after a failed switchover
+	drRollbackTimeout = 2 * time.Minute
+
+	// drPollInterval is how often DRBD state is polled during a switchover
+	drPollInterval = 2 * time.Second
+)
+
+// DrResult describes the outcome of a DR switchover or failback
+type DrResult struct {
+	Resource string
+	FromNode string
+	ToNode   string
+	Steps    []string
+}
+
+// DrSwitchover performs a planned migration of a resource to the DR node:
+// demote at the primary site, wait for the target to be in sync, promote at
+// the DR site (which re-points HA services, VIPs and gateways managed by
+// drbd-reactor) and verify the new roles.
+func (rm *ResourceManager) DrSwitchover(ctx context.Context, resource, target string, syncTimeout time.Duration) (*DrResult, error) {
+	result, err := rm.switchover(ctx, resource, target, syncTimeout)
+	if err != nil {
+		rm.controller.RecordEvent(ctx, EventDrSwitchoverFailed, resource,
+			fmt.Sprintf("DR switchover failed: %v", err), drEventDetails(result))
+		return result, err
+	}
+
+	rm.controller.RecordEvent(ctx, EventDrSwitchover, resource,
+		fmt.Sprintf("DR switchover from %s to %s completed", result.FromNode, result.ToNode), drEventDetails(result))
+	return result, nil
+}
+
+// DrFailback returns a resource to the node it was switched over from by the
+// most recent successful DR switchover
+func (rm *ResourceManager) DrFailback(ctx context.Context, resource string, syncTimeout time.Duration) (*DrResult, error) {
+	events, err := rm.controller.ListEvents(ctx, resource, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read events log: %w", err)
+	}
+
+	home := ""
+	for _, event := range events {
+		if event.Type == EventDrSwitchover {
+			home = event.Details["from"]
+			break
+		}
+	}
+	if home == "" {
+		return nil, fmt.Errorf("no previous DR switchover recorded for resource %s", resource)
+	}
+
+	result, err := rm.switchover(ctx, resource, home, syncTimeout)
+	if err != nil {
+		rm.controller.RecordEvent(ctx, EventDrFailbackFailed, resource,
+			fmt.Sprintf("DR failback failed: %v", err), drEventDetails(result))
+		return result, err
+	}
+
+	rm.controller.RecordEvent(ctx, EventDrFailback, resource,
+		fmt.Sprintf("DR failback from %s to %s completed", result.FromNode, result.ToNode), drEventDetails(result))
+	return result, nil
+}
+
+// switchover moves the Primary role of a resource to the target node. When
+// a step fails after the promoters were disabled and before the target was
+// promoted, they are enabled again, on the original Primary first, so the
+// resource does not stay down without HA.
+func (rm *ResourceManager) switchover(ctx context.Context, resource, target string, syncTimeout time.Duration) (result *DrResult, err error) {
+	result = &DrResult{Resource: resource, ToNode: target}
+
+	if rm.deployment == nil {
+		return result, fmt.Errorf("deployment client not set")
+	}
+	if syncTimeout <= 0 {
+		syncTimeout = defaultDrSyncTimeout
+	}
+
+	nodes, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return result, err
+	}
+
+	// 1. Locate the current Primary
+	primary := ""
+	for _, node := range nodes {
+		if role, _ := rm.nodeRole(ctx, resource, node); role == "Primary" {
+			primary = node
+			break
+		}
+	}
+	if primary == "" {
+		return result, fmt.Errorf("resource %s has no Primary, planned switchover is not possible", resource)
+	}
+	result.FromNode = primary
+
+	// 2. Pick and validate the DR node
+	if target == "" {
+		var candidates []string
+		for _, node := range nodes {
+			if node != primary {
+				candidates = append(candidates, node)
+			}
+		}
+		if len(candidates) != 1 {
+			return result, fmt.Errorf("resource %s has %d secondary nodes, specify the DR node", resource, len(candidates))
+		}
+		target = candidates[0]
+		result.ToNode = target
+	}
+	if !containsString(nodes, target) {
+		return result, fmt.Errorf("node %s does not replicate resource %s (nodes: %s)", target, resource, strings.Join(nodes, ", "))
+	}
+	if target == primary {
+		return result, fmt.Errorf("resource %s is already Primary on %s", resource, target)
+	}
+
+	rm.controller.logger.Info("Starting DR switchover",
+		zap.String("resource", resource),
+		zap.String("from", primary),
+		zap.String("to", target))
+
+	configs := rm.reactorConfigsForResource(ctx, resource)
+	targetAddr := rm.nodeAddress(target)
+
+	var others []string
+	for _, node := range nodes {
+		if node != target {
+			others = append(others, rm.nodeAddress(node))
+		}
+	}
+
+	// Set once the promoters are disabled until the target is Primary
+	var disabled []string
+	defer func() {
+		if err == nil || len(disabled) == 0 {
+			return
+		}
+		rollbackCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), drRollbackTimeout)
+		defer cancel()
+		cmd := fmt.Sprintf("sudo drbd-reactorctl enable %s", strings.Join(configs, " "))
+		rbErr := rm.execAll(rollbackCtx, disabled[:1], cmd)
+		if rbErr == nil && len(disabled) > 1 {
+			rbErr = rm.execAll(rollbackCtx, disabled[1:], cmd)
+		}
+		if rbErr != nil {
+			result.Steps = append(result.Steps, fmt.Sprintf("rollback failed, re-enable the promoters %s by hand: %v", strings.Join(configs, ", "), rbErr))
+			rm.controller.logger.Error("Failed to re-enable drbd-reactor promoters after a failed switchover",
+				zap.String("resource", resource),
+				zap.Error(rbErr))
+			return
+		}
+		result.Steps = append(result.Steps, fmt.Sprintf("rolled back: re-enabled promoters on %s and the other nodes", primary))
+	}()
+
+	// 3. Demote at the primary site
+	if len(configs) > 0 {
+		// Disable the promoters everywhere so no node grabs the resource while
+		// the target catches up; the primary stops its services and demotes.
+		allAddrs := append([]string{targetAddr}, others...)
+		// The original Primary comes first when they are enabled again
+		primaryAddr := rm.nodeAddress(primary)
+		disabled = []string{primaryAddr}
+		for _, addr := range allAddrs {
+			if addr != primaryAddr {
+				disabled = append(disabled, addr)
+			}
+		}
+		cmd := fmt.Sprintf("sudo drbd-reactorctl disable --now %s", strings.Join(configs, " "))
+		if err := rm.execAll(ctx, allAddrs, cmd); err != nil {
+			return result, fmt.Errorf("failed to stop drbd-reactor promoters: %w", err)
+		}
+		result.Steps = append(result.Steps, fmt.Sprintf("disabled promoters %s on all nodes", strings.Join(configs, ", ")))
+
+		if err := rm.waitForRole(ctx, resource, primary, "Secondary", syncTimeout); err != nil {
+			return result, fmt.Errorf("primary did not demote: %w", err)
+		}
+	} else {
+		hr, err := rm.deployment.DRBDSecondary(ctx, rm.nodeAddress(primary), resource)
+		if err != nil {
+			return result, fmt.Errorf("failed to demote %s: %w", primary, err)
+		}
+		if !hr.Success {
+			return result, fmt.Errorf("failed to demote %s: %s", primary, hr.Output)
+		}
+	}
+	result.Steps = append(result.Steps, fmt.Sprintf("demoted %s on %s", resource, primary))
+
+	// 4. Wait for the DR node to be in sync
+	if err := rm.waitForUpToDate(ctx, resource, target, syncTimeout); err != nil {
+		return result, fmt.Errorf("DR node %s did not reach UpToDate: %w", target, err)
+	}
+	result.Steps = append(result.Steps, fmt.Sprintf("%s is UpToDate", target))
+
+	// 5. Promote at the DR site
+	if len(configs) > 0 {
+		// drbd-reactor promotes the resource and starts services, VIPs and gateways
+		cmd := fmt.Sprintf("sudo drbd-reactorctl enable %s", strings.Join(configs, " "))
+		if err := rm.execAll(ctx, []string{targetAddr}, cmd); err != nil {
+			return result, fmt.Errorf("failed to enable drbd-reactor promoters on %s: %w", target, err)
+		}
+		if err := rm.waitForRole(ctx, resource, target, "Primary", syncTimeout); err != nil {
+			return result, fmt.Errorf("drbd-reactor did not promote %s: %w", target, err)
+		}
+		disabled = nil
+		result.Steps = append(result.Steps, fmt.Sprintf("drbd-reactor promoted %s and started services on %s", resource, target))
+
+		// Standby nodes take part in future failovers again
+		if len(others) > 0 {
+			if err := rm.execAll(ctx, others, cmd); err != nil {
+				return result, fmt.Errorf("failed to re-enable drbd-reactor promoters on standby nodes: %w", err)
+			}
+			result.Steps = append(result.Steps, "re-enabled promoters on standby nodes")
+		}
+	} else {
+		if err := rm.SetPrimary(ctx, resource, target, false); err != nil {
+			return result, err
+		}
+		result.Steps = append(result.Steps, fmt.Sprintf("promoted %s on %s", resource, target))
+	}
+
+	// 6. Verify
+	if role, err := rm.nodeRole(ctx, resource, target); err != nil || role != "Primary" {
+		return result, fmt.Errorf("verification failed: %s is %s on %s", resource, role, target)
+	}
+	if role, _ := rm.nodeRole(ctx, resource, primary); role == "Primary" {
+		return result, fmt.Errorf("verification failed: %s is still Primary on %s", resource, primary)
+	}
+	result.Steps = append(result.Steps, "verified roles")
+
+	rm.controller.logger.Info("DR switchover completed",
+		zap.String("resource", resource),
+		zap.String("from", primary),
+		zap.String("to", target))
+
+	return result, nil
+}
+
+// nodeStatus returns the DRBD status output of a resource as seen from a node
+func (rm *ResourceManager) nodeStatus(ctx context.Context, resource, node string) (string, error) {
+	result, err := rm.deployment.DRBDStatus(ctx, []string{rm.nodeAddress(node)}, resource)
+	if err != nil {
+		return "", err
+	}
+	for _, r := range result.Hosts {
+		if r.Success {
+			return r.Output, nil
+		}
+		return "", fmt.Errorf("drbdadm status failed on %s: %s", node, r.Output)
+	}
+	return "", fmt.Errorf("no status returned from %s", node)
+}
+
+// nodeRole returns the local role of a resource on a node
+func (rm *ResourceManager) nodeRole(ctx context.Context, resource, node string) (string, error) {
+	output, err := rm.nodeStatus(ctx, resource, node)
+	if err != nil {
+		return "Unknown", err
+	}
+	return parseRoleFromStatus(output), nil
+}
+
+// waitForRole polls until a resource has the given role on a node
+func (rm *ResourceManager) waitForRole(ctx context.Context, resource, node, role string, timeout time.Duration) error {
+	return pollUntil(ctx, timeout, func() (bool, error) {
+		current, _ := rm.nodeRole(ctx, resource, node)
+		return current == role, nil
+	})
+}
+
+// waitForUpToDate polls until all volumes of a resource are UpToDate on a node
+func (rm *ResourceManager) waitForUpToDate(ctx context.Context, resource, node string, timeout time.Duration) error {
+	return pollUntil(ctx, timeout, func() (bool, error) {
+		output, err := rm.nodeStatus(ctx, resource, node)
+		if err != nil {
+			return false, nil
+		}
+		states := parseLocalDiskStatesFromStatus(output)
+		if len(states) == 0 {
+			return false, nil
+		}
+		for _, state := range states {
+			if state != "UpToDate" {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+// reactorConfigsForResource returns the drbd-reactor promoter configs (HA and
+// gateways) that manage a resource
+func (rm *ResourceManager) reactorConfigsForResource(ctx context.Context, resource string) []string {
+	var configs []string
+	if rm.controller.db == nil {
+		return configs
+	}
+
+	if haCfg, err := rm.controller.db.GetHaConfig(ctx, resource); err == nil {
+		configs = append(configs, haConfigName(haCfg))
+	}
+
+	if gateways, err := rm.controller.db.ListGateways(ctx); err == nil {
+		for _, gw := range gateways {
+			if gw.Resource == resource {
+				configs = append(configs, fmt.Sprintf("sds-%s-%s", gw.Type, resource))
+			}
+		}
+	}
+
+	return configs
+}
+
+// execAll runs a command on hosts and fails if any host fails
+func (rm *ResourceManager) execAll(ctx context.Context, hosts []string, cmd string) error {
+	result, err := rm.deployment.Exec(ctx, hosts, cmd)
+	if err != nil {
+		return err
+	}
+	if !result.AllSuccess() {
+		return fmt.Errorf("command failed on hosts: %v", result.FailedHosts())
+	}
+	return nil
+}
+
+// pollUntil calls check every drPollInterval until it returns true, fails or times out
+func pollUntil(ctx context.Context, timeout time.Duration, check func() (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %s", timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(drPollInterval):
+		}
+	}
+}
+
+// drEventDetails converts a DR result into event log details
+func drEventDetails(result *DrResult) map[string]string {
+	if result == nil {
+		return nil
+	}
+	return map[string]string{
+		"from":  result.FromNode,
+		"to":    result.ToNode,
+		"steps": strings.Join(result.Steps, "; "),
+	}
+}
+
+// containsString reports whether list contains s
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// removeString returns list without s
+func removeString(list []string, s string) []string {
+	var out []string
+	for _, item := range list {
+		if item != s {
+			out = append(out, item)
+		}
+	}
+	return out
+}
@@ -0,0 +1,259 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// drbdGlobalPath is the DRBD global and common config included by drbd.conf
+const drbdGlobalPath = "/etc/drbd.d/global_common.conf"
+
+// drbdGlobalName names the global config in drift reports
+const drbdGlobalName = "global_common"
+
+// drbdHandlers are the handlers DRBD 9 accepts in the handlers section
+var drbdHandlers = []string{
+	"after-resync-target", "before-resync-source", "before-resync-target",
+	"disconnected", "fence-peer", "initial-split-brain", "local-io-error",
+	"out-of-sync", "pri-lost", "pri-lost-after-sb", "pri-on-incon-degr",
+	"quorum-lost", "split-brain", "unfence-peer",
+}
+
+// drbdOptionName matches DRBD option names such as "ping-timeout"
+var drbdOptionName = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// GetDrbdGlobalConfig returns a version of the global config, the latest
+// one if version is 0, together with its rendered global_common.conf
+func (c *Controller) GetDrbdGlobalConfig(ctx context.Context, version int) (*database.DrbdGlobalConfig, string, error) {
+	if c.db == nil {
+		return nil, "", fmt.Errorf("database not available")
+	}
+	cfg, err := c.db.GetDrbdGlobalConfig(ctx, version)
+	if err != nil {
+		if version == 0 {
+			return nil, "", fmt.Errorf("no DRBD global config defined, global_common.conf is not managed")
+		}
+		return nil, "", fmt.Errorf("DRBD global config version %d not found", version)
+	}
+	return cfg, renderDrbdGlobalConfig(cfg), nil
+}
+
+// ListDrbdGlobalConfigs lists all versions of the global config, newest first
+func (c *Controller) ListDrbdGlobalConfigs(ctx context.Context) ([]*database.DrbdGlobalConfig, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	return c.db.ListDrbdGlobalConfigs(ctx)
+}
+
+// SetDrbdGlobalConfig saves cfg as the next version of the global config and
+// writes it to all registered nodes. With apply, drbdadm adjust all makes the
+// running resources pick up the common options. cfg.Version is set to the
+// saved version; the nodes the config could not be written to or applied on
+// are returned.
+func (c *Controller) SetDrbdGlobalConfig(ctx context.Context, cfg *database.DrbdGlobalConfig, apply bool) ([]string, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	if cfg.UsageCount == "" {
+		cfg.UsageCount = "no"
+	}
+	if err := validateDrbdGlobalConfig(cfg); err != nil {
+		return nil, err
+	}
+
+	cfg.Version = 0
+	if err := c.db.SaveDrbdGlobalConfig(ctx, cfg); err != nil {
+		return nil, fmt.Errorf("failed to save DRBD global config: %w", err)
+	}
+
+	c.logger.Info("Saved DRBD global config",
+		zap.Int("version", cfg.Version),
+		zap.Bool("apply", apply))
+
+	failed, err := c.distributeDrbdGlobalConfig(ctx, cfg, nil, apply)
+	if err != nil {
+		return failed, err
+	}
+
+	message := fmt.Sprintf("DRBD global config version %d written to the nodes", cfg.Version)
+	if len(failed) > 0 {
+		message += fmt.Sprintf(", failed on %s", strings.Join(failed, ", "))
+	}
+	c.RecordEvent(ctx, EventDrbdGlobalUpdated, "", message,
+		map[string]string{"version": fmt.Sprintf("%d", cfg.Version), "comment": cfg.Comment})
+
+	return failed, nil
+}
+
+// RollbackDrbdGlobalConfig saves an earlier version as the next version and
+// writes it to all registered nodes
+func (c *Controller) RollbackDrbdGlobalConfig(ctx context.Context, version int, apply bool) (*database.DrbdGlobalConfig, []string, error) {
+	old, _, err := c.GetDrbdGlobalConfig(ctx, version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cfg := &database.DrbdGlobalConfig{
+		UsageCount: old.UsageCount,
+		Disk:       old.Disk,
+		Net:        old.Net,
+		Handlers:   old.Handlers,
+		Comment:    fmt.Sprintf("rollback to version %d", version),
+	}
+	failed, err := c.SetDrbdGlobalConfig(ctx, cfg, apply)
+	if err != nil {
+		return nil, failed, err
+	}
+	return cfg, failed, nil
+}
+
+// distributeDrbdGlobalConfig writes a global config to the given node
+// addresses, or all registered nodes if none are given, and optionally runs
+// drbdadm adjust all. It returns the nodes that failed.
+func (c *Controller) distributeDrbdGlobalConfig(ctx context.Context, cfg *database.DrbdGlobalConfig, addresses []string, apply bool) ([]string, error) {
+	if len(addresses) == 0 {
+		nodes, err := c.nodes.ListNodes(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list nodes: %w", err)
+		}
+		for _, node := range nodes {
+			addresses = append(addresses, node.Address)
+		}
+	}
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+	sort.Strings(addresses)
+
+	result, err := c.deployment.DistributeConfig(ctx, addresses, renderDrbdGlobalConfig(cfg), drbdGlobalPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to distribute %s: %w", drbdGlobalPath, err)
+	}
+
+	var written, failed []string
+	for _, addr := range addresses {
+		if r, ok := result.Hosts[addr]; ok && r.Success {
+			written = append(written, addr)
+		} else {
+			failed = append(failed, addr)
+		}
+	}
+
+	if apply && len(written) > 0 {
+		adjust, err := c.deployment.Exec(ctx, written, "sudo drbdadm adjust all")
+		if err != nil {
+			return failed, fmt.Errorf("failed to adjust resources: %w", err)
+		}
+		failed = append(failed, adjust.FailedHosts()...)
+	}
+
+	if len(failed) > 0 {
+		c.logger.Warn("DRBD global config not applied on all nodes",
+			zap.Int("version", cfg.Version),
+			zap.Strings("failed", failed))
+	}
+	return failed, nil
+}
+
+// pushDrbdGlobalConfig writes the latest global config, if one is defined,
+// to a newly registered node
+func (c *Controller) pushDrbdGlobalConfig(ctx context.Context, address string) {
+	if c.db == nil {
+		return
+	}
+	cfg, err := c.db.GetDrbdGlobalConfig(ctx, 0)
+	if err != nil {
+		// Not managed
+		return
+	}
+	if _, err := c.distributeDrbdGlobalConfig(ctx, cfg, []string{address}, false); err != nil {
+		c.logger.Warn("Failed to write DRBD global config to node",
+			zap.String("address", address),
+			zap.Error(err))
+	}
+}
+
+// drbdGlobalChecksum returns the sha256 of the rendered global config
+func drbdGlobalChecksum(cfg *database.DrbdGlobalConfig) string {
+	sum := sha256.Sum256([]byte(renderDrbdGlobalConfig(cfg)))
+	return hex.EncodeToString(sum[:])
+}
+
+// validateDrbdGlobalConfig checks that a global config renders into a
+// config drbdadm accepts
+func validateDrbdGlobalConfig(cfg *database.DrbdGlobalConfig) error {
+	switch cfg.UsageCount {
+	case "yes", "no", "ask":
+	default:
+		return fmt.Errorf("invalid usage-count %q (must be yes, no or ask)", cfg.UsageCount)
+	}
+
+	for section, options := range map[string]map[string]string{"disk": cfg.Disk, "net": cfg.Net} {
+		for name, value := range options {
+			if !drbdOptionName.MatchString(name) {
+				return fmt.Errorf("invalid %s option name %q", section, name)
+			}
+			if strings.TrimSpace(value) == "" || strings.ContainsAny(value, ";{}\"#\n") {
+				return fmt.Errorf("invalid value %q for %s option %s", value, section, name)
+			}
+		}
+	}
+
+	for name, command := range cfg.Handlers {
+		if !containsString(drbdHandlers, name) {
+			return fmt.Errorf("unknown handler %q (must be one of %s)", name, strings.Join(drbdHandlers, ", "))
+		}
+		if strings.TrimSpace(command) == "" || strings.ContainsAny(command, "\"\\\n") {
+			return fmt.Errorf("invalid command for handler %s", name)
+		}
+	}
+	return nil
+}
+
+// renderDrbdGlobalConfig renders a global config as global_common.conf.
+// Options are sorted so a version always renders the same.
+func renderDrbdGlobalConfig(cfg *database.DrbdGlobalConfig) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Managed by the SDS controller, version %d. Local changes are overwritten.\n\n", cfg.Version)
+
+	b.WriteString("global {\n")
+	fmt.Fprintf(&b, "\tusage-count %s;\n", cfg.UsageCount)
+	b.WriteString("}\n\n")
+
+	b.WriteString("common {\n")
+	section := func(name string, options map[string]string, quote bool) {
+		if len(options) == 0 {
+			return
+		}
+		var keys []string
+		for key := range options {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		fmt.Fprintf(&b, "\t%s {\n", name)
+		for _, key := range keys {
+			if quote {
+				fmt.Fprintf(&b, "\t\t%s \"%s\";\n", key, options[key])
+			} else {
+				fmt.Fprintf(&b, "\t\t%s %s;\n", key, options[key])
+			}
+		}
+		b.WriteString("\t}\n")
+	}
+	section("handlers", cfg.Handlers, true)
+	section("disk", cfg.Disk, false)
+	section("net", cfg.Net, false)
+	b.WriteString("}\n")
+
+	return b.String()
+}
@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// drbdEventHandlerPath is the handler that reports DRBD handler calls to the
+// events log, installed next to the fence-peer handler
+const drbdEventHandlerPath = "/usr/lib/drbd/sds-drbd-event.sh"
+
+// reportedHandlers are the handlers resources report to the events log by
+// default. None of them changes the state of the resource; the usual
+// pri-lost-after-sb handler reboots the node, the default only reports it.
+var reportedHandlers = []string{"split-brain", "out-of-sync", "pri-lost-after-sb"}
+
+// defaultHandlerOptions returns the handler options every resource gets once
+// fencing.controller_urls is set, so the handlers can reach the controller.
+// drbd_defaults and --drbd-options override them, an empty value drops one.
+func (c *Controller) defaultHandlerOptions() map[string]string {
+	options := make(map[string]string)
+	if c.config == nil || len(c.config.Fencing.ControllerURLs) == 0 {
+		return options
+	}
+	for _, handler := range reportedHandlers {
+		options["handlers/"+handler] = drbdEventHandlerPath + " " + handler
+	}
+	return options
+}
+
+// drbdHandlerCommand returns the command of a handler option without the
+// quotes it may be given with, the config quotes it when it is rendered
+func drbdHandlerCommand(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+		value = value[1 : len(value)-1]
+	}
+	return value
+}
+
+// validateDrbdHandlerOptions checks the handlers/<name> options of a
+// resource: the handler must be one DRBD knows and the command must be
+// renderable as a quoted string. An empty command drops the handler.
+func validateDrbdHandlerOptions(options map[string]string) error {
+	for key, value := range options {
+		section, name, ok := strings.Cut(key, "/")
+		if !ok || !strings.EqualFold(section, "handlers") {
+			continue
+		}
+		if !containsString(drbdHandlers, name) {
+			return fmt.Errorf("unknown handler %q (must be one of %s)", name, strings.Join(drbdHandlers, ", "))
+		}
+		if strings.ContainsAny(drbdHandlerCommand(value), "\"\\;{}\n") {
+			return fmt.Errorf("invalid command for handler %s", name)
+		}
+	}
+	return nil
+}
+
+// drbdEventHandlerScript generates the handler that reports a handler call.
+// It never fails, DRBD carries on whatever the controller answers.
+func (c *Controller) drbdEventHandlerScript() string {
+	return fmt.Sprintf(`#!/bin/sh
+# DRBD event handler of SDS cluster %s, reports handler calls to the events log
+# Generated by sds-controller, reinstall with sds fencing install
+controllers="%s"
+cluster=%s
+key=$(cat `+handlerKeyPath+` 2>/dev/null)
+
+handler="$1"
+[ -n "$handler" ] && [ -n "$DRBD_RESOURCE" ] || { echo "usage: $0 <handler>, run by DRBD" >&2; exit 0; }
+
+peer=""
+case "$DRBD_PEER_NODE_ID" in
+''|*[!0-9]*) ;;
+*) eval "peer=\${DRBD_NODE_ID_$DRBD_PEER_NODE_ID}" ;;
+esac
+body=$(printf '{"node":"%%s","handler":"%%s","peer":"%%s","peer_address":"%%s","volume":"%%s"}' \
+	"$(hostname)" "$handler" "$peer" "$DRBD_PEER_ADDRESS" "$DRBD_VOLUME")
+
+logger -t sds-drbd-event "$DRBD_RESOURCE: $handler ${peer:+peer $peer}"
+for url in $controllers; do
+	curl -fsS --max-time %d -H 'Content-Type: application/json' -H "X-Sds-Cluster: $cluster" \
+		-H "X-Sds-Handler-Key: $key" -d "$body" "$url/v1/resources/$DRBD_RESOURCE/drbd-events" >/dev/null 2>&1 && exit 0
+done
+logger -t sds-drbd-event "$DRBD_RESOURCE: no controller reachable"
+exit 0
+`, c.ClusterName(), strings.Join(c.config.Fencing.ControllerURLs, " "), c.handlerClusterArg(), c.handlerTimeout())
+}
+
+// ReportDrbdEvent records a handler call of DRBD on node in the events log
+func (c *Controller) ReportDrbdEvent(ctx context.Context, resource, handler, node, peer, peerAddress, volume string) (string, error) {
+	if !containsString(drbdHandlers, handler) {
+		return "", fmt.Errorf("unknown handler %q", handler)
+	}
+	if name := c.resources.resourceNodeName(ctx, resource, node); name != "" {
+		node = name
+	}
+	if name := c.resources.resourceNodeName(ctx, resource, peer); name != "" {
+		peer = name
+	} else if name := c.resources.resourceNodeName(ctx, resource, peerAddress); name != "" {
+		peer = name
+	}
+
+	var message string
+	switch handler {
+	case "split-brain":
+		message = fmt.Sprintf("Split brain of %s detected on %s", resource, node)
+	case "out-of-sync":
+		message = fmt.Sprintf("Online verify found out-of-sync blocks of %s on %s", resource, node)
+	case "pri-lost-after-sb":
+		message = fmt.Sprintf("%s lost the Primary role of %s after a split brain", node, resource)
+	default:
+		message = fmt.Sprintf("DRBD ran the %s handler of %s on %s", handler, resource, node)
+	}
+	if peer != "" {
+		message += fmt.Sprintf(", peer %s", peer)
+	}
+
+	c.logger.Warn("DRBD handler called",
+		zap.String("resource", resource),
+		zap.String("handler", handler),
+		zap.String("node", node),
+		zap.String("peer", peer))
+	details := map[string]string{"handler": handler, "node": node}
+	if peer != "" {
+		details["peer"] = peer
+	}
+	if volume != "" {
+		details["volume"] = volume
+	}
+	c.RecordEvent(ctx, EventDrbdHandler, resource, message, details)
+	return message, nil
+}
@@ -0,0 +1,253 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// drbdVersionCmd prints the DRBD kernel module and drbd-utils versions of a
+// node. drbdadm reports the module only while it is loaded, modinfo also
+// when it is not.
+const drbdVersionCmd = `echo '#drbdversion'; drbdadm --version 2>/dev/null | grep -E '^(DRBD_KERNEL_VERSION|DRBDADM_VERSION)='; ` +
+	`echo "MODINFO_VERSION=$(modinfo -F version drbd 2>/dev/null)"`
+
+// drbdVersion is a DRBD version as major, minor and patch level
+type drbdVersion [3]int
+
+// Minimum DRBD kernel module versions of what the controller generates
+var (
+	// node-id, connection-mesh, connection sections, more than two nodes and
+	// peer slots in the metadata
+	drbdVersion9 = drbdVersion{9, 0, 0}
+	// The quorum options
+	drbdVersionQuorum = drbdVersion{9, 0, 7}
+	// drbd-utils a DRBD 9 module needs
+	drbdUtilsVersion9 = drbdVersion{9, 0, 0}
+)
+
+// quorumOptions are dropped from configs for modules without quorum
+var quorumOptions = []string{"quorum", "on-no-quorum", "quorum-minimum-redundancy"}
+
+// parseDrbdVersion parses a version such as 9.2.5 or 8.4.11-1, ok is false
+// for anything else
+func parseDrbdVersion(s string) (drbdVersion, bool) {
+	var v drbdVersion
+	if i := strings.IndexAny(s, "-+ "); i >= 0 {
+		s = s[:i]
+	}
+	parts := strings.Split(strings.TrimPrefix(s, "v"), ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return v, false
+	}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return v, false
+		}
+		v[i] = n
+	}
+	return v, v != drbdVersion{}
+}
+
+// less tells whether v is older than other
+func (v drbdVersion) less(other drbdVersion) bool {
+	for i := range v {
+		if v[i] != other[i] {
+			return v[i] < other[i]
+		}
+	}
+	return false
+}
+
+func (v drbdVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v[0], v[1], v[2])
+}
+
+// parseDrbdVersions returns the kernel module and drbd-utils versions in the
+// output of drbdVersionCmd, empty if not found
+func parseDrbdVersions(output string) (kernel, utils string) {
+	var modinfo string
+	for _, line := range strings.Split(output, "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), "=")
+		if !ok {
+			continue
+		}
+		if _, valid := parseDrbdVersion(value); !valid {
+			continue
+		}
+		switch key {
+		case "DRBD_KERNEL_VERSION":
+			kernel = value
+		case "DRBDADM_VERSION":
+			utils = value
+		case "MODINFO_VERSION":
+			modinfo = value
+		}
+	}
+	if kernel == "" {
+		kernel = modinfo
+	}
+	return kernel, utils
+}
+
+// drbdVersionWarnings returns why a node's DRBD kernel module and drbd-utils
+// do not work together or with the controller
+func drbdVersionWarnings(kernel, utils string) []string {
+	var warnings []string
+	k, kernelKnown := parseDrbdVersion(kernel)
+	u, utilsKnown := parseDrbdVersion(utils)
+	if kernelKnown && k.less(drbdVersion9) {
+		warnings = append(warnings, fmt.Sprintf("DRBD %s kernel module: resources are limited to two nodes without quorum, peer protocols or peer slots", kernel))
+	} else if kernelKnown && k.less(drbdVersionQuorum) {
+		warnings = append(warnings, fmt.Sprintf("DRBD %s kernel module has no quorum, %s or later is needed for it", kernel, drbdVersionQuorum))
+	}
+	if kernelKnown && utilsKnown && !k.less(drbdVersion9) && u.less(drbdUtilsVersion9) {
+		warnings = append(warnings, fmt.Sprintf("drbd-utils %s cannot drive the DRBD %s kernel module, %s or later is needed", utils, kernel, drbdUtilsVersion9))
+	}
+	return warnings
+}
+
+// drbdFeatures are the DRBD features all nodes of a resource support. Nodes
+// whose versions are not known yet are assumed to run a current DRBD 9.
+type drbdFeatures struct {
+	Kernel string // Oldest known kernel module, empty if none is known
+	Drbd9  bool
+	Quorum bool
+}
+
+// drbdFeatures returns the features the kernel modules of the named nodes
+// have in common
+func (c *Controller) drbdFeatures(nodes []string) drbdFeatures {
+	features := drbdFeatures{Drbd9: true, Quorum: true}
+	var oldest drbdVersion
+	c.nodes.mu.RLock()
+	defer c.nodes.mu.RUnlock()
+	for _, n := range c.nodes.nodes {
+		if !containsString(nodes, n.Name) && !containsString(nodes, n.Address) {
+			continue
+		}
+		v, ok := parseDrbdVersion(n.DrbdKernelVersion)
+		if !ok {
+			continue
+		}
+		if features.Kernel == "" || v.less(oldest) {
+			oldest = v
+			features.Kernel = n.DrbdKernelVersion
+		}
+	}
+	if features.Kernel != "" {
+		features.Drbd9 = !oldest.less(drbdVersion9)
+		features.Quorum = !oldest.less(drbdVersionQuorum)
+	}
+	return features
+}
+
+// checkDrbdFeatures refuses a resource layout the kernel modules of its
+// nodes cannot run
+func (c *Controller) checkDrbdFeatures(nodes []string, peerProtocols map[string]string, maxPeers int) error {
+	features := c.drbdFeatures(nodes)
+	if features.Drbd9 {
+		return nil
+	}
+	if len(nodes) > 2 {
+		return fmt.Errorf("%d nodes need DRBD %s, a node runs DRBD %s which supports two", len(nodes), drbdVersion9, features.Kernel)
+	}
+	if len(peerProtocols) > 0 {
+		return fmt.Errorf("peer protocols need DRBD %s, a node runs DRBD %s", drbdVersion9, features.Kernel)
+	}
+	if maxPeers > 1 {
+		return fmt.Errorf("peer slots need DRBD %s, a node runs DRBD %s", drbdVersion9, features.Kernel)
+	}
+	return nil
+}
+
+// recordDrbdVersions keeps the DRBD versions found on a node with its record.
+// A change is logged, together with the warnings about the node's versions
+// and about DRBD 8 and 9 modules mixed in the cluster, which are recorded
+// as events.
+func (c *Controller) recordDrbdVersions(ctx context.Context, address, kernel, utils string) {
+	if kernel == "" && utils == "" {
+		return
+	}
+	c.nodes.mu.Lock()
+	n := c.nodes.nodes[address]
+	if n == nil || (n.DrbdKernelVersion == kernel && n.DrbdUtilsVersion == utils) {
+		c.nodes.mu.Unlock()
+		return
+	}
+	previous := n.DrbdKernelVersion
+	n.DrbdKernelVersion = kernel
+	n.DrbdUtilsVersion = utils
+	name := n.Name
+	c.nodes.mu.Unlock()
+
+	if c.db != nil {
+		if dbNode, err := c.db.GetNode(ctx, address); err == nil {
+			dbNode.DrbdKernelVersion = kernel
+			dbNode.DrbdUtilsVersion = utils
+			if err := c.db.SaveNode(ctx, dbNode); err != nil {
+				c.logger.Warn("Failed to save node to database", zap.Error(err))
+			}
+		}
+	}
+
+	c.logger.Info("DRBD versions of node",
+		zap.String("node", name),
+		zap.String("kernel", kernel),
+		zap.String("utils", utils),
+		zap.String("previous_kernel", previous))
+
+	warnings := drbdVersionWarnings(kernel, utils)
+	if mix := c.drbdVersionMix(); mix != "" {
+		warnings = append(warnings, mix)
+	}
+	for _, warning := range warnings {
+		c.logger.Warn("Unsupported DRBD versions", zap.String("node", name), zap.String("warning", warning))
+		c.RecordEvent(ctx, EventDrbdVersionWarning, "", fmt.Sprintf("%s: %s", name, warning),
+			map[string]string{"node": name, "kernel": kernel, "utils": utils})
+	}
+}
+
+// drbdVersionMix describes DRBD 8 and 9 kernel modules running side by side
+// in the cluster, empty if they do not
+func (c *Controller) drbdVersionMix() string {
+	byMajor := make(map[int][]string)
+	c.nodes.mu.RLock()
+	for _, n := range c.nodes.nodes {
+		if v, ok := parseDrbdVersion(n.DrbdKernelVersion); ok {
+			byMajor[v[0]] = append(byMajor[v[0]], n.Name)
+		}
+	}
+	c.nodes.mu.RUnlock()
+	if len(byMajor) < 2 {
+		return ""
+	}
+
+	var majors []int
+	for major := range byMajor {
+		majors = append(majors, major)
+	}
+	sort.Ints(majors)
+	var parts []string
+	for _, major := range majors {
+		names := byMajor[major]
+		sort.Strings(names)
+		parts = append(parts, fmt.Sprintf("DRBD %d on %s", major, strings.Join(names, ", ")))
+	}
+	return "mixed DRBD kernel modules, resources cannot span them: " + strings.Join(parts, "; ")
+}
+
+// DrbdVersionWarnings returns the warnings about the DRBD versions of a
+// node, including a DRBD 8 and 9 mix in the cluster
+func (c *Controller) DrbdVersionWarnings(kernel, utils string) []string {
+	warnings := drbdVersionWarnings(kernel, utils)
+	if mix := c.drbdVersionMix(); mix != "" {
+		warnings = append(warnings, mix)
+	}
+	return warnings
+}
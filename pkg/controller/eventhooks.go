@@ -0,0 +1,352 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"github.com/liliang-cn/sds/pkg/deployment"
+	"github.com/liliang-cn/sds/pkg/secrets"
+	"go.uber.org/zap"
+)
+
+// defaultEventHookTimeout bounds a webhook request or hook command that has
+// no timeout of its own
+const defaultEventHookTimeout = 10 * time.Second
+
+// EventHookTest is the type of the event TestEventHook sends
+const EventHookTest = "hook.test"
+
+// eventHookFuncs are the functions of payload templates besides the
+// builtins, e.g. {"text": {{json .Message}}}
+var eventHookFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// SetEventHook adds an event hook or replaces the one of the same name
+func (c *Controller) SetEventHook(ctx context.Context, hook *database.EventHook) error {
+	if c.db == nil {
+		return fmt.Errorf("database not available")
+	}
+	if hook.Name == "" {
+		return fmt.Errorf("hook name is required")
+	}
+	if len(hook.Events) == 0 {
+		return fmt.Errorf("hook %s needs at least one event type", hook.Name)
+	}
+	for _, pattern := range hook.Events {
+		if pattern == "" || strings.Contains(strings.TrimSuffix(pattern, "*"), "*") {
+			return fmt.Errorf("invalid event type %q, use e.g. ha.failover, ha.* or *", pattern)
+		}
+	}
+	if (hook.URL == "") == (strings.TrimSpace(hook.Command) == "") {
+		return fmt.Errorf("hook %s needs either a URL or a command", hook.Name)
+	}
+	if hook.URL != "" {
+		u, err := url.Parse(hook.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid webhook URL %q", hook.URL)
+		}
+	}
+	if hook.Timeout < 0 {
+		return fmt.Errorf("hook timeout must not be negative")
+	}
+	if hook.Template != "" {
+		if _, err := template.New(hook.Name).Funcs(eventHookFuncs).Parse(hook.Template); err != nil {
+			return fmt.Errorf("invalid payload template: %w", err)
+		}
+	}
+
+	existing, err := c.db.GetEventHook(ctx, hook.Name)
+	if err == nil {
+		hook.CreatedAt = existing.CreatedAt
+		// Header values are not returned by ListEventHooks, keep the ones
+		// not given again
+		for key, value := range hook.Headers {
+			if value == secrets.Redacted {
+				hook.Headers[key] = existing.Headers[key]
+			}
+		}
+	}
+	if err := c.storeEventHookSecrets(ctx, hook); err != nil {
+		return err
+	}
+	if err := c.db.SaveEventHook(ctx, hook); err != nil {
+		return fmt.Errorf("failed to save event hook: %w", err)
+	}
+	if existing != nil {
+		for key, ref := range existing.Headers {
+			if hook.Headers[key] != ref {
+				c.deleteEventHookSecret(ctx, existing, key)
+			}
+		}
+	}
+
+	c.logger.Info("Event hook set",
+		zap.String("hook", hook.Name),
+		zap.Strings("events", hook.Events))
+	return nil
+}
+
+// DeleteEventHook removes an event hook
+func (c *Controller) DeleteEventHook(ctx context.Context, name string) error {
+	if c.db == nil {
+		return fmt.Errorf("database not available")
+	}
+	hook, err := c.db.GetEventHook(ctx, name)
+	if err != nil {
+		return fmt.Errorf("event hook %s not found", name)
+	}
+	if err := c.db.DeleteEventHook(ctx, name); err != nil {
+		return err
+	}
+	for key := range hook.Headers {
+		c.deleteEventHookSecret(ctx, hook, key)
+	}
+	return nil
+}
+
+// ListEventHooks lists the event hooks by name, with header values redacted
+func (c *Controller) ListEventHooks(ctx context.Context) ([]*database.EventHook, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	hooks, err := c.db.ListEventHooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, hook := range hooks {
+		for key := range hook.Headers {
+			hook.Headers[key] = secrets.Redacted
+		}
+	}
+	return hooks, nil
+}
+
+// TestEventHook sends a test event to a hook and waits for the result
+func (c *Controller) TestEventHook(ctx context.Context, name string) error {
+	if c.db == nil {
+		return fmt.Errorf("database not available")
+	}
+	hook, err := c.db.GetEventHook(ctx, name)
+	if err != nil {
+		return fmt.Errorf("event hook %s not found", name)
+	}
+	event := &database.Event{
+		ID:        time.Now().UnixNano(),
+		Type:      EventHookTest,
+		Message:   fmt.Sprintf("Test of event hook %s", name),
+		Details:   map[string]string{"hook": name},
+		CreatedAt: time.Now(),
+	}
+	return c.runEventHook(ctx, hook, event)
+}
+
+// dispatchEventHooks runs the hooks subscribed to an event in the
+// background. Failures are recorded as events, which are never dispatched
+// themselves so a failing hook cannot trigger itself.
+func (c *Controller) dispatchEventHooks(event *database.Event) {
+	if c.db == nil || event.Type == EventHookFailed {
+		return
+	}
+	go func() {
+		ctx := context.Background()
+		hooks, err := c.db.ListEventHooks(ctx)
+		if err != nil {
+			c.logger.Warn("Failed to list event hooks", zap.Error(err))
+			return
+		}
+		for _, hook := range hooks {
+			if !eventHookMatches(hook, event.Type) {
+				continue
+			}
+			if err := c.runEventHook(ctx, hook, event); err != nil {
+				c.logger.Warn("Event hook failed",
+					zap.String("hook", hook.Name),
+					zap.String("event", event.Type),
+					zap.Error(err))
+				c.RecordEvent(ctx, EventHookFailed, event.Resource,
+					fmt.Sprintf("Event hook %s failed on %s: %v", hook.Name, event.Type, err),
+					map[string]string{"hook": hook.Name, "event": event.Type})
+			}
+		}
+	}()
+}
+
+// eventHookMatches reports whether a hook is subscribed to an event type
+func eventHookMatches(hook *database.EventHook, eventType string) bool {
+	for _, pattern := range hook.Events {
+		if pattern == "*" || pattern == eventType {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok && strings.HasPrefix(eventType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// runEventHook posts the payload of an event to the webhook of a hook or
+// runs its command with the payload on stdin
+func (c *Controller) runEventHook(ctx context.Context, hook *database.EventHook, event *database.Event) error {
+	payload, err := eventHookPayload(hook, event)
+	if err != nil {
+		return err
+	}
+
+	timeout := hook.Timeout
+	if timeout <= 0 {
+		timeout = defaultEventHookTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if hook.URL != "" {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Sds-Event", event.Type)
+		for key, value := range hook.Headers {
+			if secrets.IsRef(value) {
+				if c.secrets == nil {
+					return fmt.Errorf("secrets store not available for header %s", key)
+				}
+				if value, err = c.secrets.Resolve(ctx, value); err != nil {
+					return err
+				}
+			}
+			req.Header.Set(key, value)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return fmt.Errorf("webhook returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+		}
+		return nil
+	}
+
+	// Background children of the command must not keep it running past its
+	// timeout by holding the output pipe open
+	cmd := deployment.LocalCommand(ctx, hook.Command)
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Env = append(os.Environ(),
+		"SDS_EVENT_ID="+fmt.Sprint(event.ID),
+		"SDS_EVENT_TYPE="+event.Type,
+		"SDS_EVENT_RESOURCE="+event.Resource,
+		"SDS_EVENT_MESSAGE="+event.Message)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("hook command failed: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// storeEventHookSecrets moves the header values of a hook, which carry the
+// tokens of webhooks, into the secrets store and replaces them by references
+func (c *Controller) storeEventHookSecrets(ctx context.Context, hook *database.EventHook) error {
+	for key, value := range hook.Headers {
+		if value == "" || secrets.IsRef(value) {
+			continue
+		}
+		if c.secrets == nil {
+			return fmt.Errorf("secrets store not available, header %s of hook %s cannot be stored", key, hook.Name)
+		}
+		ref, err := c.secrets.Put(ctx, fmt.Sprintf("event-hook/%s/%s", hook.Name, key), value)
+		if err != nil {
+			return err
+		}
+		hook.Headers[key] = ref
+	}
+	return nil
+}
+
+// deleteEventHookSecret deletes the stored value of a header of a hook.
+// Failures are logged, they never fail the hook change.
+func (c *Controller) deleteEventHookSecret(ctx context.Context, hook *database.EventHook, key string) {
+	if c.secrets == nil {
+		return
+	}
+	if err := c.secrets.Delete(ctx, hook.Headers[key]); err != nil {
+		c.logger.Warn("Failed to delete event hook secret",
+			zap.String("hook", hook.Name),
+			zap.String("header", key),
+			zap.Error(err))
+	}
+}
+
+// migrateEventHookSecrets moves header values stored in plain text by
+// earlier versions into the secrets store
+func (c *Controller) migrateEventHookSecrets(ctx context.Context) {
+	if c.db == nil || c.secrets == nil {
+		return
+	}
+
+	hooks, err := c.db.ListEventHooks(ctx)
+	if err != nil {
+		return
+	}
+	for _, hook := range hooks {
+		plain := false
+		for _, value := range hook.Headers {
+			if value != "" && !secrets.IsRef(value) {
+				plain = true
+			}
+		}
+		if !plain {
+			continue
+		}
+
+		if err := c.storeEventHookSecrets(ctx, hook); err != nil {
+			c.logger.Warn("Failed to migrate event hook headers", zap.String("hook", hook.Name), zap.Error(err))
+			continue
+		}
+		if err := c.db.SaveEventHook(ctx, hook); err != nil {
+			c.logger.Warn("Failed to save migrated event hook", zap.String("hook", hook.Name), zap.Error(err))
+			continue
+		}
+		c.logger.Info("Moved event hook headers to the secrets store", zap.String("hook", hook.Name))
+	}
+}
+
+// eventHookPayload renders the payload of an event for a hook, the event as
+// JSON unless the hook has a template
+func eventHookPayload(hook *database.EventHook, event *database.Event) ([]byte, error) {
+	if hook.Template == "" {
+		return json.Marshal(map[string]interface{}{
+			"id":         event.ID,
+			"type":       event.Type,
+			"resource":   event.Resource,
+			"message":    event.Message,
+			"details":    event.Details,
+			"created_at": event.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	tmpl, err := template.New(hook.Name).Funcs(eventHookFuncs).Parse(hook.Template)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payload template: %w", err)
+	}
+	var payload bytes.Buffer
+	if err := tmpl.Execute(&payload, event); err != nil {
+		return nil, fmt.Errorf("failed to render payload: %w", err)
+	}
+	return payload.Bytes(), nil
+}
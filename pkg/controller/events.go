@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// Event types recorded in the events log
+const (
+	EventDrSwitchover       = "dr.switchover"
+	EventDrSwitchoverFailed = "dr.switchover.failed"
+	EventDrFailback         = "dr.failback"
+	EventDrFailbackFailed   = "dr.failback.failed"
+	EventSnapshotCowFilling = "snapshot.cow.filling"
+	EventSnapshotInvalid    = "snapshot.invalid"
+	EventSafetySnapshot     = "snapshot.safety"
+	EventSnapshotCompleted  = "snapshot.completed"
+	EventSnapshotRestored   = "snapshot.restored"
+	EventSnapshotHookFailed = "snapshot.hook_failed"
+	EventResourceCreated    = "resource.created"
+	EventResourceDeleted    = "resource.deleted"
+	EventResourceStopped    = "resource.stopped"
+	EventResourceStarted    = "resource.started"
+	EventOperationCancelled = "operation.cancelled"
+	EventControllerFrozen   = "controller.frozen"
+	EventControllerUnfrozen = "controller.unfrozen"
+	EventStateDrift         = "reconcile.drift"
+	EventStateRepaired      = "reconcile.repaired"
+	EventGarbageCollected   = "gc.cleaned"
+	EventRebalanceSuggested = "rebalance.suggested"
+	EventRebalanceMoved     = "rebalance.moved"
+	EventRebalanceFailed    = "rebalance.failed"
+	EventDrbdGlobalUpdated  = "drbd.global.updated"
+	EventNodeExec           = "node.exec"
+	EventAdminDenied        = "admin.denied"
+	EventFilePushed         = "node.file.pushed"
+	EventAutoHealAttempt    = "autoheal.attempt"
+	EventAutoHealRecovered  = "autoheal.recovered"
+	EventAutoHealFailed     = "autoheal.failed"
+	EventHaUpdated          = "ha.updated"
+	EventHaFailover         = "ha.failover"
+	EventMaxPeersChanged    = "resource.max_peers"
+	EventNodeAddressChanged = "node.address_changed"
+	EventNodeTrusted        = "node.trusted"
+	EventNodeHardened       = "node.hardened"
+	EventPoolMigrated       = "resource.pool_migrated"
+	EventStorageConverted   = "resource.storage_converted"
+	EventJobInterrupted     = "job.interrupted"
+	EventJobResolved        = "job.resolved"
+	EventFenceTest          = "resource.fence_test"
+	EventReportGenerated    = "report.generated"
+	EventHaImported         = "ha.imported"
+	EventMaintenanceStart   = "node.maintenance.start"
+	EventMaintenanceEnd     = "node.maintenance.end"
+	EventFencePeer          = "resource.fence_peer"
+	EventUnfencePeer        = "resource.unfence_peer"
+	EventDrbdHandler        = "resource.drbd_handler"
+	EventActivated          = "resource.activated"
+	EventDeactivated        = "resource.deactivated"
+	EventDiskLost           = "resource.disk_lost"
+	EventDiskReplaced       = "resource.disk_replaced"
+	EventReplicationFailed  = "replication.failed"
+	EventReplicationResumed = "replication.resumed"
+	EventDrbdVersionWarning = "node.drbd_version"
+	EventClockSkew          = "node.clock_skew"
+	EventSyncPaused         = "resource.sync_paused"
+	EventSyncResumed        = "resource.sync_resumed"
+	EventSyncRate           = "resource.sync_rate"
+	EventResourceHealth     = "resource.health"
+	EventSupportBundle      = "admin.support_bundle"
+	EventHookFailed         = "hook.failed"
+	EventRediscovered       = "admin.rediscover"
+)
+
+// defaultEventRetention applies when no retention is configured
+const defaultEventRetention = 10000
+
+// RecordEvent appends an entry to the events log.
+// Failures are logged but never returned, recording must not break operations.
+func (c *Controller) RecordEvent(ctx context.Context, eventType, resource, message string, details map[string]string) {
+	c.logger.Info("Event",
+		zap.String("type", eventType),
+		zap.String("resource", resource),
+		zap.String("message", message),
+		zap.Any("details", details))
+
+	if c.db == nil {
+		return
+	}
+
+	event := &database.Event{
+		Type:     eventType,
+		Resource: resource,
+		Message:  message,
+		Details:  details,
+	}
+	if err := c.db.SaveEvent(ctx, event); err != nil {
+		c.logger.Warn("Failed to save event to database", zap.Error(err))
+	}
+
+	retention := c.config.Events.Retention
+	if retention < 1 {
+		retention = defaultEventRetention
+	}
+	if err := c.db.PruneEvents(ctx, retention); err != nil {
+		c.logger.Warn("Failed to prune events", zap.Error(err))
+	}
+	c.dispatchEventHooks(event)
+}
+
+// ListEvents lists events newest first, optionally filtered by resource
+func (c *Controller) ListEvents(ctx context.Context, resource string, limit int) ([]*database.Event, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	if !restrictedCaller(ctx) {
+		return c.db.ListEvents(ctx, resource, limit)
+	}
+
+	// The events of other principals' resources are left out before the limit
+	events, err := c.db.ListEvents(ctx, resource, 0)
+	if err != nil {
+		return nil, err
+	}
+	visible := c.resourceFilter(ctx)
+	var result []*database.Event
+	for _, event := range events {
+		if !visible(event.Resource) {
+			continue
+		}
+		result = append(result, event)
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+	}
+	return result, nil
+}
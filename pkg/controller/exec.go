@@ -0,0 +1,193 @@
+package controller
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/deployment"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// adminTokenHeader is the metadata key carrying the admin token
+const adminTokenHeader = "x-sds-admin-token"
+
+// adminRPCs require the admin token
+var adminRPCs = map[string]bool{
+	"NodeExec": true,
+	"PushFile": true,
+	// Hooks run commands on the controller host and post to any URL
+	"SetEventHook":    true,
+	"DeleteEventHook": true,
+	"TestEventHook":   true,
+	// Snapshot hooks run commands as root on the Primary of the resource
+	"SetSnapshotHook":    true,
+	"DeleteSnapshotHook": true,
+}
+
+// maxNodeExecTimeout bounds how long an ad-hoc command may run
+const maxNodeExecTimeout = time.Hour
+
+// maxNodeExecOutput bounds the output returned per node
+const maxNodeExecOutput = 64 * 1024
+
+// NodeExecResult is the outcome of an ad-hoc command on one node
+type NodeExecResult struct {
+	Node      string
+	ExitCode  int
+	Success   bool
+	Output    string
+	Truncated bool
+	Duration  time.Duration
+}
+
+// adminInterceptor rejects admin RPCs without the configured admin token.
+// Refused calls are recorded in the events log.
+func (c *Controller) adminInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method := path.Base(info.FullMethod)
+		if !adminRPCs[method] {
+			return handler(ctx, req)
+		}
+
+		if err := c.checkAdminToken(ctx); err != nil {
+			caller := peerAddress(ctx)
+			c.RecordEvent(ctx, EventAdminDenied, "", fmt.Sprintf("Denied %s from %s: %v", method, caller, err),
+				map[string]string{"operation": method, "peer": caller})
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return handler(ctx, req)
+	}
+}
+
+// checkAdminToken verifies the admin token sent with a request
+func (c *Controller) checkAdminToken(ctx context.Context) error {
+	expected := c.config.Admin.Token
+	if expected == "" {
+		return fmt.Errorf("no admin token configured on the controller (admin.token)")
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	tokens := md.Get(adminTokenHeader)
+	if len(tokens) == 0 {
+		return fmt.Errorf("admin token required (--admin-token or SDS_ADMIN_TOKEN)")
+	}
+	if subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(expected)) != 1 {
+		return fmt.Errorf("invalid admin token")
+	}
+	return nil
+}
+
+// peerAddress returns the address of the caller of an RPC
+func peerAddress(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return "unknown"
+}
+
+// NodeExec runs an ad-hoc command on registered nodes through the deployment
+// layer and records the run in the events log. nodes are node names or
+// addresses, "all" selects every registered node. A zero timeout uses the
+// default command timeout.
+func (c *Controller) NodeExec(ctx context.Context, nodes []string, command string, timeout time.Duration) ([]*NodeExecResult, error) {
+	if !c.config.Admin.Exec {
+		return nil, fmt.Errorf("node exec is disabled, set admin.exec = true in the controller config")
+	}
+	if strings.TrimSpace(command) == "" {
+		return nil, fmt.Errorf("command is required")
+	}
+	if timeout < 0 || timeout > maxNodeExecTimeout {
+		return nil, fmt.Errorf("timeout must be between 0 and %s", maxNodeExecTimeout)
+	}
+
+	names, addresses, err := c.execTargets(ctx, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []deployment.ExecOption
+	if timeout > 0 {
+		opts = append(opts, deployment.WithExecTimeout(timeout))
+	}
+	result, err := c.deployment.Exec(ctx, addresses, command, opts...)
+	if err != nil {
+		c.RecordEvent(ctx, EventNodeExec, "", fmt.Sprintf("Ad-hoc command on %s failed: %v", strings.Join(names, ", "), err),
+			map[string]string{"nodes": strings.Join(names, ","), "command": deployment.Redact(command), "peer": peerAddress(ctx)})
+		return nil, fmt.Errorf("failed to run command: %w", err)
+	}
+
+	var results []*NodeExecResult
+	var exitCodes []string
+	for i, addr := range addresses {
+		r := &NodeExecResult{Node: names[i], ExitCode: -1}
+		if h, ok := result.Hosts[addr]; ok {
+			r.ExitCode = h.ExitCode
+			r.Success = h.Success
+			r.Output = h.Output
+			r.Duration = h.Duration
+		} else {
+			r.Output = "no result from node"
+		}
+		if len(r.Output) > maxNodeExecOutput {
+			r.Output = r.Output[len(r.Output)-maxNodeExecOutput:]
+			r.Truncated = true
+		}
+		results = append(results, r)
+		exitCodes = append(exitCodes, fmt.Sprintf("%s=%d", r.Node, r.ExitCode))
+	}
+
+	c.RecordEvent(ctx, EventNodeExec, "", fmt.Sprintf("Ad-hoc command on %s", strings.Join(names, ", ")),
+		map[string]string{
+			"nodes":      strings.Join(names, ","),
+			"command":    deployment.Redact(command),
+			"exit_codes": strings.Join(exitCodes, ","),
+			"peer":       peerAddress(ctx),
+		})
+
+	return results, nil
+}
+
+// execTargets resolves node names or addresses to registered nodes, sorted
+// by name
+func (c *Controller) execTargets(ctx context.Context, nodes []string) ([]string, []string, error) {
+	if len(nodes) == 0 {
+		return nil, nil, fmt.Errorf("at least one node is required")
+	}
+
+	registered, err := c.nodes.ListNodes(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	sort.Slice(registered, func(i, j int) bool { return registered[i].Name < registered[j].Name })
+
+	all := len(nodes) == 1 && nodes[0] == "all"
+	selected := make(map[string]bool)
+	var names, addresses []string
+	for _, node := range registered {
+		if all || containsString(nodes, node.Name) || containsString(nodes, node.Address) {
+			selected[node.Name] = true
+			selected[node.Address] = true
+			names = append(names, node.Name)
+			addresses = append(addresses, node.Address)
+		}
+	}
+
+	for _, node := range nodes {
+		if !all && !selected[node] {
+			return nil, nil, fmt.Errorf("unknown node %q, only registered nodes can be targeted", node)
+		}
+	}
+	if len(addresses) == 0 {
+		return nil, nil, fmt.Errorf("no registered nodes")
+	}
+	return names, addresses, nil
+}
@@ -0,0 +1,264 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/liliang-cn/sds/pkg/database"
+)
+
+// Config diff states
+const (
+	ConfigIdentical   = "identical"
+	ConfigDiffers     = "differs"
+	ConfigMissing     = "missing"
+	ConfigUnreachable = "unreachable"
+)
+
+// ResourceExport is the canonical configuration of a resource as generated
+// from its database records
+type ResourceExport struct {
+	Resource       string
+	DrbdConfigPath string
+	DrbdConfig     string
+	// Promoter plugin, empty unless the resource is HA-managed
+	PromoterConfigPath string
+	PromoterConfig     string
+}
+
+// ConfigDiff compares a canonical config file with the one deployed on a node
+type ConfigDiff struct {
+	Node  string
+	Path  string
+	State string
+	Diff  string // Changed lines, "-" canonical and "+" deployed
+}
+
+// ExportResource generates the canonical DRBD config and promoter plugin of a
+// resource from its database records. Options given at creation are only
+// known for resources created by this version.
+func (rm *ResourceManager) ExportResource(ctx context.Context, resource string) (*ResourceExport, error) {
+	if rm.controller.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+
+	dbRes, err := rm.controller.db.GetResource(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("resource not found: %s", resource)
+	}
+	if dbRes.Nodes == "" {
+		return nil, fmt.Errorf("resource %s has no nodes", resource)
+	}
+	nodes := strings.Split(dbRes.Nodes, ",")
+
+	volumes, err := rm.controller.db.ListVolumes(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volumes: %w", err)
+	}
+	sort.Slice(volumes, func(i, j int) bool { return volumes[i].VolumeID < volumes[j].VolumeID })
+
+	// Volume 0 is part of the generated config, later volumes are appended
+	// the way AddVolume does
+	pool, storageType, volumeName := "data-pool", "lvm", rm.controller.newVolumeName(resource)
+	var rawDevices map[string]string
+	var extra []string
+	nodeDisks := make(map[int]map[string]string) // Later volumes with per-node backing
+	for _, vol := range volumes {
+		if vol.VolumeID != 0 {
+			minor := strings.TrimPrefix(vol.Device, "/dev/drbd")
+			if _, err := strconv.Atoi(minor); err != nil {
+				return nil, fmt.Errorf("volume %s has no DRBD minor recorded", vol.VolumeName)
+			}
+			disk := backingDevicePath(vol.StorageType, vol.Pool, vol.VolumeName)
+			if backing := perNodeBacking(vol, nodes); backing != nil {
+				nodeDisks[vol.VolumeID] = backing
+				disk = ""
+			}
+			extra = append(extra, volumeSection(vol.VolumeID, minor, disk))
+			continue
+		}
+		pool, storageType, volumeName = vol.Pool, vol.StorageType, vol.VolumeName
+		if storageType == StorageTypeRaw || storageType == StorageTypeFile {
+			rawDevices = vol.Backing
+		} else if backing := perNodeBacking(vol, nodes); backing != nil {
+			rawDevices = backing
+		}
+	}
+
+	drbdConfig := rm.generateDrbdConfig(resource, uint32(dbRes.Port), nodes, dbRes.Protocol, dbRes.PeerProtocols, pool, volumeName, storageType, dbRes.Options, rawDevices, nil)
+	drbdConfig = appendVolumeSections(drbdConfig, extra)
+	for _, vol := range volumes {
+		for _, node := range nodes {
+			if disk, ok := nodeDisks[vol.VolumeID][node]; ok {
+				drbdConfig = addNodeVolumeDisk(drbdConfig, node, vol.VolumeID, disk)
+			}
+		}
+	}
+
+	export := &ResourceExport{
+		Resource:       resource,
+		DrbdConfigPath: fmt.Sprintf("/etc/drbd.d/%s.res", resource),
+		DrbdConfig:     drbdConfig,
+	}
+
+	if haCfg, err := rm.controller.db.GetHaConfig(ctx, resource); err == nil {
+		addresses := make([]string, len(nodes))
+		for i, node := range nodes {
+			addresses[i] = rm.nodeAddress(node)
+		}
+		export.PromoterConfigPath = haConfigPath(haConfigName(haCfg))
+		export.PromoterConfig = rm.generatePromoterConfig(resource, addresses, haCfg.Services, haCfg.MountPoint, haCfg.FsType, haCfg.VIP,
+			haCfg.DependsOn, rm.rankNodes(ctx, resource, nodes), haPolicyOf(haCfg))
+	}
+
+	return export, nil
+}
+
+// volumeSection returns the section of a volume after volume 0 the way
+// AddVolume writes it. Without disk the backing devices are given per node.
+func volumeSection(volumeID int, minor, disk string) string {
+	if disk != "" {
+		disk = fmt.Sprintf("        disk      %s;\n", disk)
+	}
+	return fmt.Sprintf("    volume %d {\n        device    minor %s;\n%s        meta-disk internal;\n    }",
+		volumeID, minor, disk)
+}
+
+// appendVolumeSections adds volume sections to the end of a generated DRBD
+// config
+func appendVolumeSections(config string, sections []string) string {
+	if len(sections) == 0 {
+		return config
+	}
+	end := strings.LastIndex(config, "}")
+	return config[:end] + strings.Join(sections, "\n") + "\n" + config[end:]
+}
+
+// perNodeBacking returns the backing devices of an LVM or ZFS volume if they
+// differ between the nodes, e.g. while the volume moves to another pool
+func perNodeBacking(vol *database.Volume, nodes []string) map[string]string {
+	if !rawDisksDiffer(vol.Backing) {
+		return nil
+	}
+	for _, node := range nodes {
+		if vol.Backing[node] == "" {
+			return nil
+		}
+	}
+	return vol.Backing
+}
+
+// addNodeVolumeDisk adds the backing device of a volume to the on section of
+// a node in a generated DRBD config
+func addNodeVolumeDisk(config, node string, volumeID int, disk string) string {
+	start := strings.Index(config, fmt.Sprintf("\n    on %s {\n", node))
+	if start < 0 {
+		return config
+	}
+	end := strings.Index(config[start:], "\n    }\n")
+	if end < 0 {
+		return config
+	}
+	pos := start + end + 1
+	block := fmt.Sprintf("        volume %d {\n            disk  %s;\n        }\n", volumeID, disk)
+	return config[:pos] + block + config[pos:]
+}
+
+// DiffResource compares the canonical configs of a resource with the ones
+// deployed on each of its nodes
+func (rm *ResourceManager) DiffResource(ctx context.Context, resource string) ([]*ConfigDiff, error) {
+	export, err := rm.ExportResource(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]string{export.DrbdConfigPath: export.DrbdConfig}
+	paths := []string{export.DrbdConfigPath}
+	if export.PromoterConfigPath != "" {
+		files[export.PromoterConfigPath] = export.PromoterConfig
+		paths = append(paths, export.PromoterConfigPath)
+	}
+
+	var diffs []*ConfigDiff
+	for _, node := range nodes {
+		address := rm.nodeAddress(node)
+		for _, path := range paths {
+			diff := &ConfigDiff{Node: node, Path: path}
+			diffs = append(diffs, diff)
+
+			output, err := rm.controller.execOutput(ctx, address, fmt.Sprintf("sudo cat %s 2>/dev/null || echo __missing__", path))
+			switch {
+			case err != nil:
+				diff.State = ConfigUnreachable
+			case strings.TrimSpace(output) == "__missing__":
+				diff.State = ConfigMissing
+			default:
+				diff.Diff = lineDiff(files[path], output)
+				diff.State = ConfigIdentical
+				if diff.Diff != "" {
+					diff.State = ConfigDiffers
+				}
+			}
+		}
+	}
+
+	return diffs, nil
+}
+
+// lineDiff returns the lines that differ between two texts, ignoring trailing
+// whitespace: "-" lines are only in want, "+" lines only in got. Lines are
+// matched by their longest common subsequence, which is fine for config sized
+// inputs.
+func lineDiff(want, got string) string {
+	a := splitConfigLines(want)
+	b := splitConfigLines(got)
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var diff strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			fmt.Fprintf(&diff, "-%s\n", a[i])
+			i++
+		default:
+			fmt.Fprintf(&diff, "+%s\n", b[j])
+			j++
+		}
+	}
+	return diff.String()
+}
+
+// splitConfigLines splits a config into lines without trailing whitespace
+// and trailing empty lines
+func splitConfigLines(s string) []string {
+	lines := strings.Split(strings.TrimRight(s, " \t\r\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	return lines
+}
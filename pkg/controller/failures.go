@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/liliang-cn/sds/pkg/deployment"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// failureTrailer is the trailer carrying the remote commands that failed
+// during a failed call. It is binary so that command output passes as is.
+const failureTrailer = "x-sds-failure-bin"
+
+// maxReportedFailures bounds the failed commands returned with a call
+const maxReportedFailures = 20
+
+// failureRecorder collects the remote commands of a call that failed
+type failureRecorder struct {
+	mu       sync.Mutex
+	failures []*deployment.CommandTrace
+}
+
+// TraceCommand keeps a command if it failed
+func (r *failureRecorder) TraceCommand(t *deployment.CommandTrace) {
+	if t.Success {
+		return
+	}
+	r.mu.Lock()
+	r.failures = append(r.failures, t)
+	r.mu.Unlock()
+}
+
+// failureInterceptor returns the remote commands that failed during a failed
+// call in a trailer, so that clients can show what went wrong on which host
+func (c *Controller) failureInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		recorder := &failureRecorder{}
+		resp, err := handler(deployment.WithTracer(ctx, recorder), req)
+
+		failed := err != nil
+		if r, ok := resp.(resultResponse); ok && !r.GetSuccess() {
+			failed = true
+		}
+		recorder.mu.Lock()
+		failures := recorder.failures
+		recorder.mu.Unlock()
+		if !failed || len(failures) == 0 {
+			return resp, err
+		}
+
+		if len(failures) > maxReportedFailures {
+			failures = failures[len(failures)-maxReportedFailures:]
+		}
+		names := c.nodeNamesByAddress(ctx)
+		md := metadata.MD{}
+		for _, f := range failures {
+			md.Append(failureTrailer, describeFailure(f, names))
+		}
+		if err := grpc.SetTrailer(ctx, md); err != nil {
+			c.logger.Debug("Failed to return failed commands", zap.Error(err))
+		}
+		return resp, err
+	}
+}
+
+// nodeNamesByAddress maps the addresses of the registered nodes to their names
+func (c *Controller) nodeNamesByAddress(ctx context.Context) map[string]string {
+	names := make(map[string]string)
+	nodes, err := c.nodes.ListNodes(ctx)
+	if err != nil {
+		return names
+	}
+	for _, node := range nodes {
+		names[node.Address] = node.Name
+	}
+	return names
+}
+
+// describeFailure formats a failed command as
+// "node: step `command` exited 1: output"
+func describeFailure(t *deployment.CommandTrace, names map[string]string) string {
+	host := t.Host
+	if name, ok := names[host]; ok {
+		host = name
+	}
+	line := fmt.Sprintf("%s: %s `%s` exited %d", host, t.Step, t.Command, t.ExitCode)
+	if t.Output != "" {
+		line += ": " + t.Output
+	}
+	return line
+}
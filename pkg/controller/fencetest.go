@@ -0,0 +1,442 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// defaultFenceTestIOTimeout is how long the IO probe of a fence test may
+// take before the IO counts as suspended
+const defaultFenceTestIOTimeout = 10 * time.Second
+
+// fenceTestReconnectTimeout bounds waiting for the disconnected peer to be
+// connected and UpToDate again
+const fenceTestReconnectTimeout = 2 * time.Minute
+
+// Fence test checks
+const (
+	FenceCheckDisconnect = "disconnect"
+	FenceCheckQuorum     = "quorum"
+	FenceCheckIO         = "io"
+	FenceCheckReconnect  = "reconnect"
+	FenceCheckIOResumed  = "io-resumed"
+)
+
+// FenceTestCheck is one expectation verified by a fence test
+type FenceTestCheck struct {
+	Name   string
+	Passed bool
+	Detail string
+}
+
+// FenceTestResult is the report of a fence test
+type FenceTestResult struct {
+	Node         string // Node whose IO was probed
+	Peer         string // Peer it was disconnected from
+	Quorum       string // Effective quorum policy on the node
+	OnNoQuorum   string
+	ExpectQuorum bool // The node keeps quorum without the peer
+	Checks       []*FenceTestCheck
+
+	probeBlocked bool // The IO probe was suspended, it must finish after the reconnect
+}
+
+// Passed reports whether every check of the test passed
+func (r *FenceTestResult) Passed() bool {
+	for _, check := range r.Checks {
+		if !check.Passed {
+			return false
+		}
+	}
+	return len(r.Checks) > 0
+}
+
+// check adds the outcome of a check to the report
+func (r *FenceTestResult) check(name string, passed bool, format string, args ...interface{}) {
+	r.Checks = append(r.Checks, &FenceTestCheck{Name: name, Passed: passed, Detail: fmt.Sprintf(format, args...)})
+}
+
+// fenceStatus is the state of a resource on one node, from drbdsetup status
+// --verbose
+type fenceStatus struct {
+	role      string
+	suspended string            // "no", or why IO is suspended, e.g. "quorum"
+	quorum    bool              // All local volumes have quorum
+	disks     map[string]string // Local volume to disk state
+	peers     map[string]string // Peer to connection state
+}
+
+// FenceTest tests the quorum and fencing policy of a resource without
+// risking data: a Secondary node is forcibly disconnected from one peer as
+// if the peer was lost, a read-only IO probe on the node must behave as the
+// node's policy says (IO keeps working with quorum; without quorum it fails
+// with on-no-quorum io-error and is suspended with suspend-io), and the peer
+// is reconnected and resynced. The test is refused unless all nodes are
+// connected and UpToDate, and if a Primary would lose quorum.
+//
+// node defaults to a Secondary and peer to another Secondary, then the
+// Primary.
+func (rm *ResourceManager) FenceTest(ctx context.Context, resource, node, peer string, ioTimeout time.Duration) (*FenceTestResult, error) {
+	if ioTimeout <= 0 {
+		ioTimeout = defaultFenceTestIOTimeout
+	}
+	nodes, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) < 2 {
+		return nil, fmt.Errorf("resource %s has a single node, there is no peer to disconnect", resource)
+	}
+	sort.Strings(nodes)
+
+	statuses := make(map[string]*fenceStatus)
+	var problems []string
+	for _, n := range nodes {
+		status, err := rm.fenceStatus(ctx, resource, n)
+		if err != nil {
+			return nil, fmt.Errorf("cannot check %s: %w", n, err)
+		}
+		statuses[n] = status
+		problems = append(problems, status.problems(n, nodes)...)
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("resource %s is not healthy, refusing to test: %s", resource, strings.Join(problems, "; "))
+	}
+
+	if node, err = pickFenceTestNode(nodes, statuses, node, ""); err != nil {
+		return nil, err
+	}
+	if statuses[node].role == "Primary" {
+		return nil, fmt.Errorf("refusing to test on %s, it is Primary and its applications would see the IO failing or freezing", node)
+	}
+	if peer, err = pickFenceTestNode(nodes, statuses, peer, node); err != nil {
+		return nil, err
+	}
+
+	cfg, err := rm.NodeResourceConfig(ctx, resource, node)
+	if err != nil {
+		return nil, err
+	}
+	result := &FenceTestResult{Node: node, Peer: peer, Quorum: "off", OnNoQuorum: "suspend-io"}
+	if options := drbdSectionOptions(cfg.Effective, "options"); options != nil {
+		if q := options["quorum"]; q != "" {
+			result.Quorum = q
+		}
+		if p := options["on-no-quorum"]; p != "" {
+			result.OnNoQuorum = p
+		}
+	}
+
+	// The node and the peer each lose one connection of the full mesh
+	result.ExpectQuorum = quorumKept(result.Quorum, len(nodes)-1, len(nodes))
+	if statuses[peer].role == "Primary" && !result.ExpectQuorum {
+		return nil, fmt.Errorf("refusing to test: %s is Primary and would lose quorum without %s (%d nodes, quorum %s)",
+			peer, node, len(nodes), result.Quorum)
+	}
+
+	device, err := rm.fenceTestDevice(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	rm.controller.logger.Info("Starting fence test",
+		zap.String("resource", resource),
+		zap.String("node", node),
+		zap.String("peer", peer),
+		zap.String("quorum", result.Quorum),
+		zap.String("on_no_quorum", result.OnNoQuorum))
+
+	address := rm.nodeAddress(node)
+	probeFile := fmt.Sprintf("/tmp/sds-fence-test-%s", resource)
+	if _, err := rm.controller.execOutput(ctx, address, fmt.Sprintf("sudo drbdadm disconnect --force %s:%s", resource, peer)); err != nil {
+		result.check(FenceCheckDisconnect, false, "failed to disconnect %s from %s: %v", node, peer, err)
+		rm.recordFenceTest(ctx, resource, result)
+		return result, nil
+	}
+	result.check(FenceCheckDisconnect, true, "%s disconnected from %s", node, peer)
+
+	// The peer is reconnected whatever happens, also when the caller went away
+	defer func() {
+		reconnectCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), fenceTestReconnectTimeout+time.Minute)
+		defer cancel()
+		rm.fenceTestReconnect(reconnectCtx, result, resource, address, probeFile)
+		rm.recordFenceTest(reconnectCtx, resource, result)
+	}()
+
+	var observed *fenceStatus
+	err = pollUntil(ctx, 10*time.Second, func() (bool, error) {
+		status, err := rm.fenceStatus(ctx, resource, node)
+		if err != nil {
+			return false, nil
+		}
+		observed = status
+		return status.peers[peer] != "Connected", nil
+	})
+	if err != nil || observed == nil {
+		result.check(FenceCheckQuorum, false, "%s still reports %s connected", node, peer)
+		return result, nil
+	}
+
+	keptQuorum := observed.quorum
+	kept := map[bool]string{true: "kept", false: "lost"}
+	result.check(FenceCheckQuorum, keptQuorum == result.ExpectQuorum, "%s %s quorum with %d of %d nodes, expected %s (quorum %s)",
+		node, kept[keptQuorum], len(nodes)-1, len(nodes), kept[result.ExpectQuorum], result.Quorum)
+
+	// Read one block with direct IO in the background, a suspended read
+	// would block the command
+	start := fmt.Sprintf("rm -f %[1]s; (sudo dd if=%[2]s of=/dev/null bs=4096 count=1 iflag=direct >/dev/null 2>&1; echo $? > %[1]s) </dev/null >/dev/null 2>&1 &",
+		probeFile, device)
+	if _, err := rm.controller.execOutput(ctx, address, start); err != nil {
+		result.check(FenceCheckIO, false, "failed to start the IO probe: %v", err)
+		return result, nil
+	}
+	probe := rm.fenceTestProbe(ctx, address, probeFile, ioTimeout)
+	result.probeBlocked = probe == "blocked"
+
+	switch {
+	case keptQuorum:
+		result.check(FenceCheckIO, probe == "succeeded", "IO with quorum must keep working, the read %s", probe)
+	case result.OnNoQuorum == "io-error":
+		result.check(FenceCheckIO, probe == "failed", "IO without quorum must fail (on-no-quorum io-error), the read %s", probe)
+	default:
+		suspended := "unknown"
+		if status, err := rm.fenceStatus(ctx, resource, node); err == nil {
+			suspended = status.suspended
+		}
+		result.check(FenceCheckIO, probe == "blocked" && strings.Contains(suspended, "quorum"),
+			"IO without quorum must be suspended (on-no-quorum %s), the read %s, suspended:%s", result.OnNoQuorum, probe, suspended)
+	}
+	return result, nil
+}
+
+// fenceTestReconnect reconnects the peer disconnected by a fence test, waits
+// until the node is connected, UpToDate and has quorum again, and checks that
+// a suspended IO probe finished
+func (rm *ResourceManager) fenceTestReconnect(ctx context.Context, result *FenceTestResult, resource, address, probeFile string) {
+	defer func() {
+		_, _ = rm.controller.execOutput(ctx, address, "rm -f "+probeFile)
+	}()
+
+	if _, err := rm.controller.execOutput(ctx, address, fmt.Sprintf("sudo drbdadm connect %s:%s", resource, result.Peer)); err != nil {
+		result.check(FenceCheckReconnect, false, "failed to reconnect %s to %s: %v, run drbdadm connect %s:%s on %s",
+			result.Node, result.Peer, err, resource, result.Peer, result.Node)
+		return
+	}
+	var last *fenceStatus
+	err := pollUntil(ctx, fenceTestReconnectTimeout, func() (bool, error) {
+		status, err := rm.fenceStatus(ctx, resource, result.Node)
+		if err != nil {
+			return false, nil
+		}
+		last = status
+		return status.quorum && status.peers[result.Peer] == "Connected" && len(status.problems(result.Node, nil)) == 0, nil
+	})
+	if err != nil {
+		detail := "no status"
+		if last != nil {
+			detail = fmt.Sprintf("connection %s, quorum %v", last.peers[result.Peer], last.quorum)
+		}
+		result.check(FenceCheckReconnect, false, "%s did not recover with %s within %s (%s)", result.Node, result.Peer, fenceTestReconnectTimeout, detail)
+		return
+	}
+	result.check(FenceCheckReconnect, true, "%s connected to %s and UpToDate with quorum", result.Node, result.Peer)
+
+	// A read suspended without quorum finishes once quorum is back
+	if result.probeBlocked {
+		probe := rm.fenceTestProbe(ctx, address, probeFile, defaultFenceTestIOTimeout)
+		result.check(FenceCheckIOResumed, probe == "succeeded", "the suspended read %s once quorum returned", probe)
+	}
+}
+
+// fenceTestProbe waits for the IO probe of a fence test and returns how the
+// read went: succeeded, failed, or blocked if it did not finish within timeout
+func (rm *ResourceManager) fenceTestProbe(ctx context.Context, address, probeFile string, timeout time.Duration) string {
+	seconds := int((timeout + time.Second - 1) / time.Second)
+	cmd := fmt.Sprintf("for i in $(seq %d); do [ -s %[2]s ] && break; sleep 1; done; cat %[2]s 2>/dev/null || echo blocked", seconds, probeFile)
+	output, err := rm.controller.execOutput(ctx, address, cmd)
+	if err != nil {
+		return "could not be checked"
+	}
+	switch strings.TrimSpace(output) {
+	case "blocked":
+		return "blocked"
+	case "0":
+		return "succeeded"
+	default:
+		return "failed"
+	}
+}
+
+// fenceTestDevice returns the DRBD device of the first volume of a resource
+func (rm *ResourceManager) fenceTestDevice(ctx context.Context, resource string) (string, error) {
+	volumes, err := rm.controller.db.ListVolumes(ctx, resource)
+	if err != nil {
+		return "", fmt.Errorf("failed to list volumes: %w", err)
+	}
+	for _, vol := range volumes {
+		if vol.VolumeID == 0 && vol.Device != "" {
+			return vol.Device, nil
+		}
+	}
+	return "", fmt.Errorf("resource %s has no device recorded for volume 0", resource)
+}
+
+// recordFenceTest logs the report of a fence test in the events log
+func (rm *ResourceManager) recordFenceTest(ctx context.Context, resource string, result *FenceTestResult) {
+	outcome := "passed"
+	details := map[string]string{
+		"node":         result.Node,
+		"peer":         result.Peer,
+		"quorum":       result.Quorum,
+		"on_no_quorum": result.OnNoQuorum,
+	}
+	for _, check := range result.Checks {
+		details[check.Name] = check.Detail
+		if !check.Passed {
+			outcome = "failed"
+		}
+	}
+	rm.controller.RecordEvent(ctx, EventFenceTest, resource,
+		fmt.Sprintf("Fence test of %s on %s without %s %s", resource, result.Node, result.Peer, outcome), details)
+}
+
+// pickFenceTestNode returns the requested node of a fence test, which must
+// replicate the resource and differ from other, or the first Secondary, then
+// the first other node
+func pickFenceTestNode(nodes []string, statuses map[string]*fenceStatus, requested, other string) (string, error) {
+	if requested != "" {
+		if requested == other {
+			return "", fmt.Errorf("the node and the peer must differ")
+		}
+		if statuses[requested] == nil {
+			return "", fmt.Errorf("resource is not on node %s (nodes: %s)", requested, strings.Join(nodes, ", "))
+		}
+		return requested, nil
+	}
+	for _, n := range nodes {
+		if n != other && statuses[n].role == "Secondary" {
+			return n, nil
+		}
+	}
+	for _, n := range nodes {
+		if n != other {
+			return n, nil
+		}
+	}
+	return "", fmt.Errorf("no node to test")
+}
+
+// quorumKept reports whether a node connected to connected nodes, itself
+// included, out of total has quorum under a DRBD quorum policy
+func quorumKept(policy string, connected, total int) bool {
+	switch policy {
+	case "off", "":
+		return true
+	case "majority":
+		return connected*2 > total
+	case "all":
+		return connected == total
+	}
+	if n, err := strconv.Atoi(policy); err == nil {
+		return connected >= n
+	}
+	return connected*2 > total
+}
+
+// drbdSectionOptions returns the options of a direct subsection of a parsed
+// DRBD config section
+func drbdSectionOptions(section *DrbdConfigSection, name string) map[string]string {
+	if section == nil {
+		return nil
+	}
+	for _, sub := range section.Sections {
+		if sub.Name == name {
+			return sub.Options
+		}
+	}
+	return nil
+}
+
+// fenceStatus reads the state of a resource on a node
+func (rm *ResourceManager) fenceStatus(ctx context.Context, resource, node string) (*fenceStatus, error) {
+	output, err := rm.controller.execOutput(ctx, rm.nodeAddress(node), fmt.Sprintf("sudo drbdsetup status --verbose %s", resource))
+	if err != nil {
+		return nil, err
+	}
+	return parseFenceStatus(output), nil
+}
+
+// parseFenceStatus parses drbdsetup status --verbose of one resource:
+//
+//	r0 node-id:1 role:Secondary suspended:no
+//	  volume:0 minor:1 disk:UpToDate quorum:yes blocked:no
+//	  node2 node-id:0 connection:Connected role:Primary congested:no
+//	    volume:0 replication:Established peer-disk:UpToDate
+func parseFenceStatus(output string) *fenceStatus {
+	status := &fenceStatus{quorum: true, disks: make(map[string]string), peers: make(map[string]string)}
+	volumes := 0
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		values := make(map[string]string)
+		for _, field := range fields {
+			if key, value, ok := strings.Cut(field, ":"); ok {
+				values[key] = value
+			}
+		}
+
+		switch {
+		case indent == 0:
+			status.role = values["role"]
+			status.suspended = values["suspended"]
+		case indent == 2 && values["disk"] != "":
+			volumes++
+			status.disks[values["volume"]] = values["disk"]
+			if values["quorum"] != "yes" {
+				status.quorum = false
+			}
+		case indent == 2 && values["connection"] != "":
+			status.peers[fields[0]] = values["connection"]
+		}
+	}
+	if volumes == 0 {
+		status.quorum = false
+	}
+	return status
+}
+
+// problems returns why a node's state is not healthy for a fence test: a
+// disk that is not UpToDate or, if nodes is set, a peer that is not connected
+func (s *fenceStatus) problems(node string, nodes []string) []string {
+	var problems []string
+	if len(s.disks) == 0 {
+		problems = append(problems, fmt.Sprintf("%s has no local disk", node))
+	}
+	for volume, state := range s.disks {
+		if state != "UpToDate" {
+			problems = append(problems, fmt.Sprintf("volume %s is %s on %s", volume, state, node))
+		}
+	}
+	for _, peer := range nodes {
+		if peer != node && s.peers[peer] != "Connected" {
+			state := s.peers[peer]
+			if state == "" {
+				state = "unknown"
+			}
+			problems = append(problems, fmt.Sprintf("%s to %s is %s", node, peer, state))
+		}
+	}
+	if s.suspended != "" && s.suspended != "no" {
+		problems = append(problems, fmt.Sprintf("IO is suspended on %s (%s)", node, s.suspended))
+	}
+	return problems
+}
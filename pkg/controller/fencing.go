@@ -0,0 +1,420 @@
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"github.com/liliang-cn/sds/pkg/deployment"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+// fenceHandlerPath is where sds fencing install puts the handler on the nodes
+const fenceHandlerPath = "/usr/lib/drbd/sds-fence-peer.sh"
+
+// handlerKeyPath is where sds fencing install puts the key the handlers of a
+// node authenticate with, readable by root only
+const handlerKeyPath = "/var/lib/sds/handler.key"
+
+// handlerKeyHeader is the header carrying the key of the handlers
+const handlerKeyHeader = "x-sds-handler-key"
+
+// Exit codes of the fence-peer handler, as DRBD interprets them
+const (
+	FenceExitFailed      = 1 // The handler failed, DRBD keeps I/O frozen
+	FenceExitPeerDown    = 3 // The peer's disk is already Outdated, Inconsistent or gone
+	FenceExitOutdated    = 4 // The peer's disk was outdated
+	FenceExitUnreachable = 5 // The peer is unreachable and kept from promotion
+	FenceExitPeerPrimary = 6 // The peer is Primary, the local node must not continue
+)
+
+// FenceHandlerOptions returns the DRBD options that hook the handlers into a
+// resource, for --drbd-options or drbd_defaults
+func FenceHandlerOptions() map[string]string {
+	return map[string]string{
+		"net/fencing":           "resource-only",
+		"handlers/fence-peer":   fenceHandlerPath + " fence",
+		"handlers/unfence-peer": fenceHandlerPath + " unfence",
+	}
+}
+
+// InstallFenceHandlers writes the fence-peer and unfence-peer handler, the
+// event handler and a new handler key to all registered nodes and returns the
+// nodes they could not be installed on. Resources use the fence handlers once
+// they have the options of FenceHandlerOptions, the event handler by default.
+func (c *Controller) InstallFenceHandlers(ctx context.Context) ([]string, error) {
+	if len(c.config.Fencing.ControllerURLs) == 0 {
+		return nil, fmt.Errorf("fencing.controller_urls is not set, the handlers would not reach the controller")
+	}
+	nodes, err := c.nodes.ListNodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Address < nodes[j].Address })
+	var names, addresses []string
+	for _, node := range nodes {
+		names = append(names, node.Name)
+		addresses = append(addresses, node.Address)
+	}
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("no nodes registered")
+	}
+
+	failed := make(map[string]bool)
+	keyFailed, err := c.installHandlerKeys(ctx, names, addresses)
+	if err != nil {
+		return nil, err
+	}
+	for _, host := range keyFailed {
+		failed[host] = true
+	}
+	for path, script := range map[string]string{
+		fenceHandlerPath:     c.fenceHandlerScript(),
+		drbdEventHandlerPath: c.drbdEventHandlerScript(),
+	} {
+		hosts, err := c.installHandlerScript(ctx, addresses, path, script)
+		if err != nil {
+			return nil, err
+		}
+		for _, host := range hosts {
+			failed[host] = true
+		}
+	}
+	var written, failedHosts []string
+	for _, addr := range addresses {
+		if failed[addr] {
+			failedHosts = append(failedHosts, addr)
+		} else {
+			written = append(written, addr)
+		}
+	}
+
+	c.logger.Info("Installed DRBD handlers",
+		zap.Strings("nodes", written),
+		zap.Strings("failed", failedHosts))
+	return failedHosts, nil
+}
+
+// installHandlerScript writes an executable handler to the hosts and returns
+// the hosts it could not be written to
+func (c *Controller) installHandlerScript(ctx context.Context, hosts []string, path, script string) ([]string, error) {
+	result, err := c.deployment.DistributeConfig(ctx, hosts, script, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to distribute %s: %w", path, err)
+	}
+	var written, failed []string
+	for _, addr := range hosts {
+		if r, ok := result.Hosts[addr]; ok && r.Success {
+			written = append(written, addr)
+		} else {
+			failed = append(failed, addr)
+		}
+	}
+	if len(written) > 0 {
+		chmod, err := c.deployment.Exec(ctx, written, "sudo chmod 0755 "+path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to make %s executable: %w", path, err)
+		}
+		failed = append(failed, chmod.FailedHosts()...)
+	}
+	return failed, nil
+}
+
+// installHandlerKeys writes a new key for the handlers to every node and
+// keeps its SHA-256 hash, and returns the hosts it could not be written to.
+// Those keep their previous key. names and addresses are parallel slices.
+func (c *Controller) installHandlerKeys(ctx context.Context, names, addresses []string) ([]string, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	keys, err := c.db.GetHandlerKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get handler keys: %w", err)
+	}
+
+	var failed []string
+	for i, addr := range addresses {
+		raw := make([]byte, 32)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, fmt.Errorf("failed to generate handler key: %w", err)
+		}
+		key := hex.EncodeToString(raw)
+		content := key + "\n"
+		deployment.RegisterSensitive(key, base64.StdEncoding.EncodeToString([]byte(content)))
+
+		result, err := c.deployment.PushFile(ctx, []string{addr}, []byte(content), handlerKeyPath, 0600, "root:root")
+		if err != nil || !result.Success {
+			failed = append(failed, addr)
+			continue
+		}
+		keys.Nodes[names[i]] = handlerKeyHash(key)
+	}
+
+	if err := c.db.SaveHandlerKeys(ctx, keys); err != nil {
+		return nil, fmt.Errorf("failed to save handler keys: %w", err)
+	}
+	return failed, nil
+}
+
+// handlerKeyHash returns the hash of a handler key the controller keeps
+func handlerKeyHash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// authenticateHandler returns the node whose handler key was sent with a
+// request
+func (c *Controller) authenticateHandler(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	sent := md.Get(handlerKeyHeader)
+	if len(sent) == 0 || sent[0] == "" {
+		return "", fmt.Errorf("handler key required, reinstall the handlers with sds fencing install")
+	}
+	if c.db == nil {
+		return "", fmt.Errorf("database not available")
+	}
+	keys, err := c.db.GetHandlerKeys(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get handler keys: %w", err)
+	}
+
+	// Every key is compared so the time taken does not tell which matched
+	hash := handlerKeyHash(sent[0])
+	var match string
+	for node, known := range keys.Nodes {
+		if subtle.ConstantTimeCompare([]byte(hash), []byte(known)) == 1 {
+			match = node
+		}
+	}
+	if match == "" {
+		return "", fmt.Errorf("invalid handler key")
+	}
+	return match, nil
+}
+
+// handlerTimeout returns how long a handler waits for one controller, in
+// seconds
+func (c *Controller) handlerTimeout() int {
+	if timeout := int(c.config.Fencing.Timeout.Seconds()); timeout >= 1 {
+		return timeout
+	}
+	return 30
+}
+
+// handlerClusterArg returns the cluster name quoted for the handlers
+func (c *Controller) handlerClusterArg() string {
+	return "'" + strings.ReplaceAll(c.ClusterName(), "'", `'\''`) + "'"
+}
+
+// fenceHandlerScript generates the handler. DRBD runs it with the resource
+// and peer in its environment; it asks the controllers in turn and exits with
+// the verdict of the first one that answers.
+func (c *Controller) fenceHandlerScript() string {
+	return fmt.Sprintf(`#!/bin/sh
+# DRBD fence-peer and unfence-peer handler of SDS cluster %s
+# Generated by sds-controller, reinstall with sds fencing install
+controllers="%s"
+cluster=%s
+key=$(cat `+handlerKeyPath+` 2>/dev/null)
+
+case "$1" in
+fence) action=fence-peer ;;
+unfence) action=unfence-peer ;;
+*) echo "usage: $0 fence|unfence" >&2; exit 1 ;;
+esac
+[ -n "$DRBD_RESOURCE" ] || { echo "DRBD_RESOURCE is not set" >&2; exit 1; }
+
+peer=""
+case "$DRBD_PEER_NODE_ID" in
+''|*[!0-9]*) ;;
+*) eval "peer=\${DRBD_NODE_ID_$DRBD_PEER_NODE_ID}" ;;
+esac
+body=$(printf '{"node":"%%s","peer":"%%s","peer_address":"%%s"}' "$(hostname)" "$peer" "$DRBD_PEER_ADDRESS")
+
+for url in $controllers; do
+	out=$(curl -fsS --max-time %d -H 'Content-Type: application/json' -H "X-Sds-Cluster: $cluster" \
+		-H "X-Sds-Handler-Key: $key" -d "$body" "$url/v1/resources/$DRBD_RESOURCE/$action" 2>&1) || {
+		logger -t sds-fence-peer "$DRBD_RESOURCE: $url: $out"
+		continue
+	}
+	code=$(echo "$out" | sed -n 's/.*"exitCode": *\([0-9]*\).*/\1/p')
+	logger -t sds-fence-peer "$DRBD_RESOURCE: $action $peer: $out"
+	exit "${code:-1}"
+done
+logger -t sds-fence-peer "$DRBD_RESOURCE: no controller reachable"
+exit 1
+`, c.ClusterName(), strings.Join(c.config.Fencing.ControllerURLs, " "), c.handlerClusterArg(), c.handlerTimeout())
+}
+
+// FencePeer is called by the fence-peer handler of node when it lost the
+// connection to peer. A reachable Secondary peer is outdated with drbdadm
+// outdate; an unreachable peer is kept from promotion by a fence constraint,
+// which sds resource primary and HA failovers respect, until the unfence-peer
+// handler lifts it. A Primary peer is never fenced. The returned exit code is
+// the one the handler exits with.
+func (c *Controller) FencePeer(ctx context.Context, resource, node, peer, peerAddress string) (int, string, error) {
+	if c.db == nil {
+		return FenceExitFailed, "", fmt.Errorf("database not available")
+	}
+	peerName := c.resources.resourceNodeName(ctx, resource, peer)
+	if peerName == "" {
+		peerName = c.resources.resourceNodeName(ctx, resource, peerAddress)
+	}
+	if peerName == "" {
+		return FenceExitFailed, "", fmt.Errorf("peer %q (%s) is not a node of resource %s", peer, peerAddress, resource)
+	}
+	if name := c.resources.resourceNodeName(ctx, resource, node); name != "" {
+		node = name
+	}
+
+	status, err := c.resources.fenceStatus(ctx, resource, peerName)
+	if err != nil {
+		constraint := &database.FenceConstraint{Resource: resource, Node: peerName, FencedBy: node}
+		if err := c.db.SaveFenceConstraint(ctx, constraint); err != nil {
+			return FenceExitFailed, "", fmt.Errorf("failed to save fence constraint: %w", err)
+		}
+		message := fmt.Sprintf("Peer %s of %s is unreachable, kept from promotion until unfenced", peerName, resource)
+		c.RecordEvent(ctx, EventFencePeer, resource, message,
+			map[string]string{"node": node, "peer": peerName, "result": "unreachable", "error": err.Error()})
+		return FenceExitUnreachable, message, nil
+	}
+
+	if status.role == "Primary" {
+		message := fmt.Sprintf("Peer %s of %s is Primary, refusing to fence it", peerName, resource)
+		c.RecordEvent(ctx, EventFencePeer, resource, message,
+			map[string]string{"node": node, "peer": peerName, "result": "peer-primary"})
+		return FenceExitPeerPrimary, message, nil
+	}
+
+	down := len(status.disks) > 0
+	for _, disk := range status.disks {
+		if disk != "Outdated" && disk != "Inconsistent" && disk != "Diskless" {
+			down = false
+		}
+	}
+	if down {
+		return FenceExitPeerDown, fmt.Sprintf("Peer %s of %s is already outdated", peerName, resource), nil
+	}
+
+	if _, err := c.execOutput(ctx, c.resources.nodeAddress(peerName), fmt.Sprintf("sudo drbdadm outdate %s", resource)); err != nil {
+		return FenceExitFailed, "", fmt.Errorf("failed to outdate %s on %s: %w", resource, peerName, err)
+	}
+	constraint := &database.FenceConstraint{Resource: resource, Node: peerName, FencedBy: node, Outdated: true}
+	if err := c.db.SaveFenceConstraint(ctx, constraint); err != nil {
+		return FenceExitFailed, "", fmt.Errorf("failed to save fence constraint: %w", err)
+	}
+	message := fmt.Sprintf("Outdated peer %s of %s", peerName, resource)
+	c.RecordEvent(ctx, EventFencePeer, resource, message,
+		map[string]string{"node": node, "peer": peerName, "result": "outdated"})
+	return FenceExitOutdated, message, nil
+}
+
+// UnfencePeer lifts the fence constraint of one peer of a resource, or with
+// all and no peer those of all peers. The unfence-peer handler of node calls
+// it for the peer it is in sync with again, a peer that is still cut off
+// stays fenced; only sds fencing clear --all lifts all of them.
+func (c *Controller) UnfencePeer(ctx context.Context, resource, node, peer string, all bool) (string, error) {
+	if c.db == nil {
+		return "", fmt.Errorf("database not available")
+	}
+	if node != "" && peer == "" {
+		return "", fmt.Errorf("the unfence-peer handler of %s named no peer, reinstall the handlers with sds fencing install or lift the constraints with sds fencing clear", node)
+	}
+	if peer == "" && !all {
+		return "", fmt.Errorf("name the peer to unfence, or lift the constraints of all peers explicitly")
+	}
+	if peer != "" && all {
+		return "", fmt.Errorf("a peer and all peers cannot be unfenced at once")
+	}
+	if name := c.resources.resourceNodeName(ctx, resource, peer); name != "" {
+		peer = name
+	}
+	lifted := make([]string, 0)
+	for _, constraint := range c.fenceConstraints(ctx, resource) {
+		if peer != "" && constraint.Node != peer {
+			continue
+		}
+		if err := c.db.DeleteFenceConstraint(ctx, resource, constraint.Node); err != nil {
+			return "", fmt.Errorf("failed to delete fence constraint: %w", err)
+		}
+		lifted = append(lifted, constraint.Node)
+	}
+	if len(lifted) == 0 {
+		return fmt.Sprintf("No fence constraints on %s", resource), nil
+	}
+
+	message := fmt.Sprintf("Lifted fence constraints of %s on %s", resource, strings.Join(lifted, ", "))
+	c.RecordEvent(ctx, EventUnfencePeer, resource, message,
+		map[string]string{"node": node, "peers": strings.Join(lifted, ",")})
+	return message, nil
+}
+
+// ListFenceConstraints lists the fence constraints, ordered by resource and
+// node. Those of other principals' resources are left out.
+func (c *Controller) ListFenceConstraints(ctx context.Context) ([]*database.FenceConstraint, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	all, err := c.db.ListFenceConstraints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fence constraints: %w", err)
+	}
+	visible := c.resourceFilter(ctx)
+	var constraints []*database.FenceConstraint
+	for _, constraint := range all {
+		if visible(constraint.Resource) {
+			constraints = append(constraints, constraint)
+		}
+	}
+	sort.Slice(constraints, func(i, j int) bool {
+		if constraints[i].Resource != constraints[j].Resource {
+			return constraints[i].Resource < constraints[j].Resource
+		}
+		return constraints[i].Node < constraints[j].Node
+	})
+	return constraints, nil
+}
+
+// fenceConstraints returns the fence constraints of a resource
+func (c *Controller) fenceConstraints(ctx context.Context, resource string) []*database.FenceConstraint {
+	if c.db == nil {
+		return nil
+	}
+	constraints, err := c.db.ListFenceConstraints(ctx)
+	if err != nil {
+		c.logger.Debug("Failed to list fence constraints", zap.Error(err))
+		return nil
+	}
+	var result []*database.FenceConstraint
+	for _, constraint := range constraints {
+		if constraint.Resource == resource {
+			result = append(result, constraint)
+		}
+	}
+	return result
+}
+
+// fenced returns the fence constraint that keeps a node from being promoted
+// for a resource, or nil
+func (c *Controller) fenced(ctx context.Context, resource, node string) *database.FenceConstraint {
+	for _, constraint := range c.fenceConstraints(ctx, resource) {
+		if constraint.Node == node {
+			return constraint
+		}
+	}
+	return nil
+}
+
+// fenceProblem describes a fence constraint for refusals
+func fenceProblem(constraint *database.FenceConstraint) string {
+	return fmt.Sprintf("fenced by %s since %s", constraint.FencedBy, constraint.CreatedAt.Format(time.RFC3339))
+}
@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// StorageTypeFile backs a resource by sparse files attached as loop devices.
+// It is meant for labs, demos and CI and must be enabled with
+// storage.allow_file_backend.
+const StorageTypeFile = "file"
+
+// defaultFileBackendDir holds the backing files when none is configured
+const defaultFileBackendDir = "/var/lib/sds/loop"
+
+// createFileDevices creates a sparse backing file on every node, attaches it
+// to a loop device and installs a systemd unit that re-attaches it to the same
+// loop device at boot. It returns the loop device per node name.
+// nodes and addresses are parallel slices.
+func (rm *ResourceManager) createFileDevices(ctx context.Context, resource string, nodes, addresses []string, sizeGB uint32) (map[string]string, error) {
+	cfg := rm.controller.config
+	if cfg == nil || !cfg.Storage.AllowFileBackend {
+		return nil, fmt.Errorf("the file storage type is for labs only and disabled, set storage.allow_file_backend to enable it")
+	}
+
+	dir := cfg.Storage.FileBackendDir
+	if dir == "" {
+		dir = defaultFileBackendDir
+	}
+	file := path.Join(dir, fmt.Sprintf("%s_data.img", resource))
+	unit := fmt.Sprintf("sds-loop-%s.service", resource)
+
+	devices := make(map[string]string)
+	for i, node := range nodes {
+		cmd := fmt.Sprintf("sudo mkdir -p %s && sudo truncate -s %dG %s && sudo losetup --find --show %s", dir, sizeGB, file, file)
+		result, err := rm.deployment.Exec(ctx, []string{addresses[i]}, cmd)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create loop device on %s: %w", node, err)
+		}
+		if !result.AllSuccess() {
+			return nil, fmt.Errorf("loop device creation failed on %s: %v", node, result.FailedHosts())
+		}
+
+		var device string
+		for _, r := range result.Hosts {
+			device = strings.TrimSpace(r.Output)
+		}
+		if !strings.HasPrefix(device, "/dev/loop") {
+			return nil, fmt.Errorf("unexpected losetup output on %s: %q", node, device)
+		}
+		devices[node] = device
+
+		if _, err := rm.deployment.DistributeConfig(ctx, []string{addresses[i]}, generateLoopUnit(resource, device, file), "/etc/systemd/system/"+unit); err != nil {
+			return nil, fmt.Errorf("failed to install %s on %s: %w", unit, node, err)
+		}
+		if err := rm.execAll(ctx, []string{addresses[i]}, fmt.Sprintf("sudo systemctl daemon-reload && sudo systemctl enable %s", unit)); err != nil {
+			return nil, fmt.Errorf("failed to enable %s on %s: %w", unit, node, err)
+		}
+
+		rm.controller.logger.Info("Created file-backed loop device",
+			zap.String("file", file),
+			zap.String("device", device),
+			zap.String("node", node))
+	}
+
+	return devices, nil
+}
+
+// generateLoopUnit generates the systemd unit attaching a backing file to its loop device at boot
+func generateLoopUnit(resource, device, file string) string {
+	return fmt.Sprintf(`[Unit]
+Description=SDS loop device for %s (lab storage)
+DefaultDependencies=no
+After=local-fs.target
+Before=drbd.service drbd@%s.service
+
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=/bin/sh -c 'losetup %s 2>/dev/null || losetup %s %s'
+ExecStop=/sbin/losetup -d %s
+
+[Install]
+WantedBy=multi-user.target
+`, resource, resource, device, device, file, device)
+}
@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// readOnlyRPCs are served while the controller is frozen, besides Get* and List*
+var readOnlyRPCs = map[string]bool{
+	"HealthCheck":            true,
+	"CheckTime":              true,
+	"ResourceStatus":         true,
+	"ExportResource":         true,
+	"DiffResource":           true,
+	"ValidateISCSIInitiator": true,
+	"TestNodeConnection":     true,
+	"DiscoverDisks":          true,
+	"TestEventHook":          true,
+	"Freeze":                 true,
+	"Unfreeze":               true,
+}
+
+// Freeze puts the controller in read-only mode: mutating RPCs are rejected
+// until Unfreeze, status is still served. The state survives restarts.
+func (c *Controller) Freeze(ctx context.Context, reason string) (*database.FreezeState, error) {
+	c.freezeMu.Lock()
+	defer c.freezeMu.Unlock()
+
+	if c.freeze.Frozen {
+		return c.freeze, nil
+	}
+
+	state := &database.FreezeState{Frozen: true, Reason: reason, Since: time.Now()}
+	if c.db != nil {
+		if err := c.db.SaveFreezeState(ctx, state); err != nil {
+			return nil, fmt.Errorf("failed to save freeze state: %w", err)
+		}
+	}
+	c.freeze = state
+
+	c.RecordEvent(ctx, EventControllerFrozen, "", "Controller frozen: "+reason,
+		map[string]string{"reason": reason})
+	return state, nil
+}
+
+// Unfreeze leaves read-only mode
+func (c *Controller) Unfreeze(ctx context.Context) error {
+	c.freezeMu.Lock()
+	defer c.freezeMu.Unlock()
+
+	if !c.freeze.Frozen {
+		return nil
+	}
+
+	state := &database.FreezeState{}
+	if c.db != nil {
+		if err := c.db.SaveFreezeState(ctx, state); err != nil {
+			return fmt.Errorf("failed to save freeze state: %w", err)
+		}
+	}
+	frozenFor := time.Since(c.freeze.Since).Round(time.Second)
+	c.freeze = state
+
+	c.RecordEvent(ctx, EventControllerUnfrozen, "", "Controller unfrozen after "+frozenFor.String(), nil)
+	return nil
+}
+
+// FreezeState returns the current freeze state
+func (c *Controller) FreezeState() database.FreezeState {
+	c.freezeMu.RLock()
+	defer c.freezeMu.RUnlock()
+	return *c.freeze
+}
+
+// loadFreezeState restores the freeze state saved before a restart
+func (c *Controller) loadFreezeState(ctx context.Context) {
+	c.freeze = &database.FreezeState{}
+	if c.db == nil {
+		return
+	}
+
+	state, err := c.db.GetFreezeState(ctx)
+	if err != nil {
+		c.logger.Warn("Failed to load freeze state", zap.Error(err))
+		return
+	}
+	c.freeze = state
+	if state.Frozen {
+		c.logger.Warn("Controller is frozen, mutating operations are rejected",
+			zap.String("reason", state.Reason),
+			zap.Time("since", state.Since))
+	}
+}
+
+// dryRunRequest is implemented by requests with a dry_run field
+type dryRunRequest interface {
+	GetDryRun() bool
+}
+
+// readOnlyRequest reports whether a request changes nothing: Get*, List*,
+// the read-only RPCs and dry runs
+func readOnlyRequest(method string, req interface{}) bool {
+	if strings.HasPrefix(method, "Get") || strings.HasPrefix(method, "List") || readOnlyRPCs[method] {
+		return true
+	}
+	r, ok := req.(dryRunRequest)
+	return ok && r.GetDryRun()
+}
+
+// handlerRequest reports whether a request comes from a DRBD handler on a
+// node, which names the node it runs on
+func handlerRequest(method string, req interface{}) bool {
+	r, ok := req.(interface{ GetNode() string })
+	return handlerRPCs[method] && ok && r.GetNode() != ""
+}
+
+// freezeInterceptor rejects mutating RPCs while the controller is frozen.
+// Dry runs change nothing and are always served, and so are the DRBD
+// handlers: a fence-peer handler that fails keeps I/O frozen on the Primary
+// for as long as the controller is.
+func (c *Controller) freezeInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method := path.Base(info.FullMethod)
+		if readOnlyRequest(method, req) || handlerRequest(method, req) {
+			return handler(ctx, req)
+		}
+
+		state := c.FreezeState()
+		if state.Frozen {
+			msg := fmt.Sprintf("controller is frozen (read-only) since %s", state.Since.Format(time.RFC3339))
+			if state.Reason != "" {
+				msg += ": " + state.Reason
+			}
+			return nil, status.Error(codes.FailedPrecondition, msg+"; run 'sds admin unfreeze' to allow changes")
+		}
+		return handler(ctx, req)
+	}
+}
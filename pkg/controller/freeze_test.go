@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdspb "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/database"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFreezeInterceptor(t *testing.T) {
+	c := &Controller{freeze: &database.FreezeState{Frozen: true, Reason: "maintenance", Since: time.Now()}}
+	intercept := c.freezeInterceptor()
+
+	tests := []struct {
+		name   string
+		method string
+		req    interface{}
+		served bool
+	}{
+		{"fence-peer handler", "FencePeer", &sdspb.FencePeerRequest{Name: "web", Node: "alpha", Peer: "beta"}, true},
+		{"unfence-peer handler", "UnfencePeer", &sdspb.UnfencePeerRequest{Name: "web", Node: "alpha", Peer: "beta"}, true},
+		{"event handler", "ReportDrbdEvent", &sdspb.ReportDrbdEventRequest{Name: "web", Node: "alpha", Handler: "split-brain"}, true},
+		{"manual fencing clear", "UnfencePeer", &sdspb.UnfencePeerRequest{Name: "web", Peer: "beta"}, false},
+		{"status", "ResourceStatus", &sdspb.ResourceStatusRequest{Name: "web"}, true},
+		{"list", "ListResources", &sdspb.ListResourcesRequest{}, true},
+		{"dry run", "Rebalance", &sdspb.RebalanceRequest{DryRun: true}, true},
+		{"mutation", "DeleteResource", &sdspb.DeleteResourceRequest{Name: "web"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			served := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				served = true
+				return nil, nil
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: "/v1.SDSController/" + tt.method}
+			_, err := intercept(context.Background(), tt.req, info, handler)
+			if served != tt.served {
+				t.Fatalf("served = %v, want %v (err %v)", served, tt.served, err)
+			}
+			if !tt.served && status.Code(err) != codes.FailedPrecondition {
+				t.Errorf("error = %v, want FailedPrecondition", err)
+			}
+		})
+	}
+}
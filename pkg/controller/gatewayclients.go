@@ -0,0 +1,256 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/liliang-cn/sds/pkg/database"
+)
+
+// GatewayClient is a client connected to a gateway
+type GatewayClient struct {
+	Address string // Address the client connects from
+	Name    string // NFSv4 client name, initiator IQN or host NQN, "" if unknown
+	State   string
+}
+
+// GatewayClients lists the clients connected to a gateway
+type GatewayClients struct {
+	Gateway  string
+	Type     database.GatewayType
+	Resource string
+	Node     string // Node the gateway is active on, "" if none
+	Clients  []*GatewayClient
+	Error    string // Why the clients could not be listed
+}
+
+// Service ports of the gateways, for clients without a session entry
+const (
+	nfsPort   = 2049
+	iscsiPort = 3260
+	nvmePort  = 4420
+)
+
+// targetNamePattern restricts IQNs and NQNs interpolated into commands
+var targetNamePattern = regexp.MustCompile(`^[A-Za-z0-9.:_-]+$`)
+
+// ListGatewayClients lists the clients connected to gateways on the node they
+// are active on: NFSv4 clients from /proc/fs/nfsd/clients, iSCSI sessions
+// from the LIO ACLs targetcli shows, and NVMe-oF controllers from the nvmet
+// debugfs. Established TCP connections to the service IP fill in clients
+// without a session entry, like NFSv3 clients. name is a gateway or resource
+// name, "" lists all gateways.
+func (c *Controller) ListGatewayClients(ctx context.Context, name string) ([]*GatewayClients, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	gateways, err := c.db.ListGateways(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list gateways: %w", err)
+	}
+	sort.Slice(gateways, func(i, j int) bool { return gateways[i].Name < gateways[j].Name })
+
+	var result []*GatewayClients
+	for _, gw := range gateways {
+		if name != "" && gw.Name != name && gw.Resource != name {
+			continue
+		}
+		if !mayAccess(ctx, gw.Owner) || !c.resourceVisible(ctx, gw.Resource) {
+			continue
+		}
+		info := &GatewayClients{Gateway: gw.Name, Type: gw.Type, Resource: gw.Resource}
+		info.Node = c.resources.primaryNode(ctx, gw.Resource)
+		if info.Node == "" {
+			info.Error = "gateway is not active on any node"
+		} else if info.Clients, err = c.gatewayClients(ctx, gw, c.resources.nodeAddress(info.Node)); err != nil {
+			info.Error = err.Error()
+		}
+		result = append(result, info)
+	}
+	if name != "" && len(result) == 0 {
+		return nil, fmt.Errorf("gateway not found: %s", name)
+	}
+	return result, nil
+}
+
+// gatewayClients collects the clients of a gateway on the host it is active on
+func (c *Controller) gatewayClients(ctx context.Context, gw *database.Gateway, host string) ([]*GatewayClient, error) {
+	var (
+		pattern string
+		port    int
+		parse   func(map[string]string) []*GatewayClient
+	)
+	switch gw.Type {
+	case database.GatewayTypeNFS:
+		pattern, port, parse = "/proc/fs/nfsd/clients/*/info", nfsPort, parseNFSClients
+	case database.GatewayTypeISCSI:
+		iqn := gatewayConfigString(gw, "iqn")
+		if !targetNamePattern.MatchString(iqn) {
+			return nil, fmt.Errorf("invalid IQN %q", iqn)
+		}
+		pattern, port, parse = fmt.Sprintf("/sys/kernel/config/target/iscsi/%s/tpgt_*/acls/*/info", iqn), iscsiPort, parseISCSISessions
+	case database.GatewayTypeNVMEOF:
+		nqn := gatewayConfigString(gw, "nqn")
+		if !targetNamePattern.MatchString(nqn) {
+			return nil, fmt.Errorf("invalid NQN %q", nqn)
+		}
+		pattern, port, parse = fmt.Sprintf("/sys/kernel/debug/nvmet/%s/ctrl*/*", nqn), nvmePort, parseNVMeControllers
+	default:
+		return nil, fmt.Errorf("unsupported gateway type %s", gw.Type)
+	}
+
+	// The session files are readable by root only, so each is read with sudo
+	// cat, which a restricted sudo policy allows for them. The glob expands
+	// unprivileged: debugfs is not searchable by other users, so the NVMe-oF
+	// controllers are only found through their connections below.
+	output, err := c.execOutput(ctx, host, fmt.Sprintf(
+		`for f in %s; do [ -f "$f" ] && { echo "==> $f"; sudo cat "$f"; }; done; true`, pattern))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sessions: %w", err)
+	}
+	clients := parse(splitSessionFiles(output))
+
+	conns, err := c.execOutput(ctx, host, fmt.Sprintf(`ss -Htn state established "( sport = :%d )"`, port))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list connections: %w", err)
+	}
+	known := make(map[string]bool)
+	for _, client := range clients {
+		known[clientHost(client.Address)] = true
+	}
+	for _, peer := range serviceConnections(conns, serviceAddress(gatewayConfigString(gw, "service_ip"))) {
+		if !known[clientHost(peer)] {
+			known[clientHost(peer)] = true
+			clients = append(clients, &GatewayClient{Address: peer, State: "connected"})
+		}
+	}
+	sort.Slice(clients, func(i, j int) bool { return clients[i].Address < clients[j].Address })
+	return clients, nil
+}
+
+// splitSessionFiles splits the output of the session file dump into the
+// contents of each file, by path
+func splitSessionFiles(output string) map[string]string {
+	files := make(map[string]string)
+	var current string
+	for _, line := range strings.Split(output, "\n") {
+		if name, ok := strings.CutPrefix(line, "==> "); ok {
+			current = name
+			files[current] = ""
+			continue
+		}
+		if current != "" {
+			files[current] += line + "\n"
+		}
+	}
+	return files
+}
+
+// parseNFSClients parses the info files of the NFSv4 clients of nfsd:
+// address: "10.0.0.7:874", name: "Linux NFSv4.2 client1", minor version: 2
+func parseNFSClients(files map[string]string) []*GatewayClient {
+	var clients []*GatewayClient
+	for _, content := range files {
+		client := &GatewayClient{}
+		for _, line := range strings.Split(content, "\n") {
+			key, value, ok := strings.Cut(line, ":")
+			if !ok {
+				continue
+			}
+			value = strings.Trim(strings.TrimSpace(value), `"`)
+			switch key {
+			case "address":
+				client.Address = value
+			case "name":
+				client.Name = value
+			case "minor version":
+				client.State = "NFSv4." + value
+			}
+		}
+		if client.Address != "" {
+			clients = append(clients, client)
+		}
+	}
+	return clients
+}
+
+// parseISCSISessions parses the info files of the LIO ACLs of a target, one
+// client per connection of a logged in session
+func parseISCSISessions(files map[string]string) []*GatewayClient {
+	var clients []*GatewayClient
+	for _, content := range files {
+		var initiator, state string
+		for _, line := range strings.Split(content, "\n") {
+			fields := strings.Fields(line)
+			switch {
+			case strings.HasPrefix(line, "InitiatorName:") && len(fields) > 1:
+				initiator = fields[1]
+			case strings.HasPrefix(line, "Session State:") && len(fields) > 2:
+				state = strings.TrimPrefix(fields[2], "TARG_SESS_STATE_")
+			case strings.HasPrefix(strings.TrimSpace(line), "Address ") && len(fields) > 1:
+				clients = append(clients, &GatewayClient{Address: fields[1], Name: initiator, State: state})
+			}
+		}
+	}
+	return clients
+}
+
+// parseNVMeControllers parses the nvmet debugfs entries of a subsystem's
+// controllers, one directory per controller with hostnqn, host_traddr and
+// state files
+func parseNVMeControllers(files map[string]string) []*GatewayClient {
+	controllers := make(map[string]*GatewayClient)
+	for file, content := range files {
+		dir := path.Dir(file)
+		client := controllers[dir]
+		if client == nil {
+			client = &GatewayClient{}
+			controllers[dir] = client
+		}
+		value := strings.TrimSpace(content)
+		switch path.Base(file) {
+		case "hostnqn":
+			client.Name = value
+		case "host_traddr":
+			client.Address = value
+		case "state":
+			client.State = value
+		}
+	}
+	var clients []*GatewayClient
+	for _, client := range controllers {
+		if client.Name != "" || client.Address != "" {
+			clients = append(clients, client)
+		}
+	}
+	return clients
+}
+
+// serviceConnections returns the peers of the connections in ss output whose
+// local address is the service address
+func serviceConnections(output, service string) []string {
+	var peers []string
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 4 {
+			continue
+		}
+		if clientHost(fields[2]) == service {
+			peers = append(peers, fields[3])
+		}
+	}
+	return peers
+}
+
+// clientHost strips the port and IPv4-mapped prefix from an address
+func clientHost(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return strings.TrimPrefix(addr, "::ffff:")
+}
@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"github.com/liliang-cn/sds/pkg/gateway"
+	"go.uber.org/zap"
+)
+
+// resolveGatewayPaths returns the mount directories of a new gateway, the
+// requested ones or the configured defaults. The mounts must not overlap
+// with those of another gateway, the volumes would be mounted over each other.
+func (c *Controller) resolveGatewayPaths(ctx context.Context, name, resource string, gwType database.GatewayType, exportBase, clusterPrivate string) (string, string, error) {
+	if exportBase == "" {
+		exportBase = c.config.Gateway.ExportBasePath
+	}
+	if clusterPrivate == "" {
+		clusterPrivate = c.config.Gateway.ClusterPrivatePath
+	}
+	for _, p := range []string{exportBase, clusterPrivate} {
+		if p != "" && (!filepath.IsAbs(p) || filepath.Clean(p) == "/") {
+			return "", "", fmt.Errorf("gateway path %q must be an absolute path below /", p)
+		}
+	}
+	if exportBase != "" {
+		exportBase = filepath.Clean(exportBase)
+	}
+	if clusterPrivate != "" {
+		clusterPrivate = filepath.Clean(clusterPrivate)
+	}
+
+	gw := &database.Gateway{
+		Name:     name,
+		Resource: resource,
+		Type:     gwType,
+		Config: map[string]interface{}{
+			"export_base_path":     exportBase,
+			"cluster_private_path": clusterPrivate,
+		},
+	}
+	mounts := gatewayMounts(gw)
+	if len(mounts) == 2 && pathsOverlap(mounts[0], mounts[1]) {
+		return "", "", fmt.Errorf("export directory %s and cluster-private directory %s overlap", mounts[0], mounts[1])
+	}
+
+	if c.db == nil {
+		return exportBase, clusterPrivate, nil
+	}
+	gateways, err := c.db.ListGateways(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to list gateways: %w", err)
+	}
+	for _, other := range gateways {
+		// Recreating a gateway reuses its directories
+		if other.Name == name {
+			continue
+		}
+		for _, mount := range mounts {
+			for _, used := range gatewayMounts(other) {
+				if pathsOverlap(mount, used) {
+					return "", "", fmt.Errorf("%s collides with %s of gateway %s", mount, used, other.Name)
+				}
+			}
+		}
+	}
+	return exportBase, clusterPrivate, nil
+}
+
+// gatewayMounts returns the directories a gateway mounts its volumes on, the
+// cluster-private mount first. Gateways recorded without directories use the
+// built-in defaults they were created with.
+func gatewayMounts(gw *database.Gateway) []string {
+	mounts := []string{gateway.ClusterPrivateMount(gatewayConfigString(gw, "cluster_private_path"), gw.Resource)}
+	if gw.Type == database.GatewayTypeNFS {
+		mounts = append(mounts, gateway.ExportRoot(gatewayConfigString(gw, "export_base_path"), gw.Resource))
+	}
+	return mounts
+}
+
+// pathsOverlap reports whether two directories are the same or one contains
+// the other
+func pathsOverlap(a, b string) bool {
+	return a == b || strings.HasPrefix(a, b+"/") || strings.HasPrefix(b, a+"/")
+}
+
+// removeGatewayMounts removes the emptied mount directories of a deleted
+// gateway from the nodes of its resource. rmdir leaves directories that are
+// still mounted or hold data.
+func (c *Controller) removeGatewayMounts(ctx context.Context, gw *database.Gateway) {
+	mounts := gatewayMounts(gw)
+	dirs := []string{mounts[0]}
+	if len(mounts) == 2 {
+		if export := gatewayConfigString(gw, "export_path"); export != "" {
+			dirs = append(dirs, filepath.Join(mounts[1], export))
+		}
+		dirs = append(dirs, mounts[1])
+	}
+
+	hosts, err := c.resources.ResourceHosts(ctx, gw.Resource)
+	if err != nil {
+		c.logger.Warn("Failed to look up gateway nodes for cleanup",
+			zap.String("gateway", gw.Name),
+			zap.Error(err))
+		return
+	}
+	quoted := make([]string, len(dirs))
+	for i, dir := range dirs {
+		quoted[i] = "'" + strings.ReplaceAll(dir, "'", `'\''`) + "'"
+	}
+	cmd := fmt.Sprintf("sudo rmdir %s 2>/dev/null; true", strings.Join(quoted, " "))
+	for _, host := range hosts {
+		if _, err := c.execOutput(ctx, host, cmd); err != nil {
+			c.logger.Warn("Failed to remove gateway mount directories",
+				zap.String("gateway", gw.Name),
+				zap.String("node", host),
+				zap.Error(err))
+		}
+	}
+}
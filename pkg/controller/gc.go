@@ -0,0 +1,284 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/liliang-cn/sds/pkg/config"
+	"go.uber.org/zap"
+)
+
+// Kinds of orphaned artifacts
+const (
+	OrphanLV            = "lv"
+	OrphanZvol          = "zvol"
+	OrphanDrbdConfig    = "drbd-config"
+	OrphanHaBackup      = "ha-backup"
+	OrphanReactorConfig = "reactor-config"
+)
+
+// Orphan is an artifact on a node that no database record refers to
+type Orphan struct {
+	Kind    string
+	Node    string
+	Path    string
+	InUse   bool // In use by DRBD or open, reported but never removed
+	Removed bool
+	Error   string
+}
+
+// gcInventoryCmd lists the artifacts of a node, one section per kind
+const gcInventoryCmd = `echo '#lv'; sudo lvs --noheadings --separator ' ' -o vg_name,lv_name,lv_attr 2>/dev/null; ` +
+	`echo '#zvol'; sudo zfs list -H -t volume -o name 2>/dev/null; ` +
+	`echo '#res'; ls -1 /etc/drbd.d/*.res 2>/dev/null; ` +
+	`echo '#backup'; ls -1d /tmp/ha_backup_* 2>/dev/null; ` +
+	`echo '#reactor'; ls -1 /etc/drbd-reactor.d/*.toml 2>/dev/null; ` +
+	`echo '#up'; sudo drbdsetup status 2>/dev/null | awk '/^[^ ]/ {print $1}'`
+
+// gcKnown holds the names the database refers to, and how sds names the
+// volumes and HA configs it creates
+type gcKnown struct {
+	resources      map[string]bool
+	volumes        map[string]bool
+	haBackups      map[string]bool
+	reactorConfigs map[string]bool
+	// volumeResource returns the resource of a volume name sds would create
+	volumeResource func(name string) (string, bool)
+	// haConfigResource returns the resource of an HA config name sds would
+	// create
+	haConfigResource func(name string) (string, bool)
+}
+
+// CollectGarbage finds artifacts on all registered nodes that no database
+// record refers to: LVs and zvols named by the naming template, DRBD configs,
+// HA backup directories and sds reactor plugins. Unless dryRun is set, the
+// orphans that are not in use are removed.
+func (c *Controller) CollectGarbage(ctx context.Context, dryRun bool) ([]*Orphan, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+
+	known, err := c.gcKnownNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := c.nodes.ListNodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+
+	var orphans []*Orphan
+	for _, node := range nodes {
+		output, err := c.execOutput(ctx, node.Address, gcInventoryCmd)
+		if err != nil {
+			// An unreachable node keeps its artifacts until the next run
+			c.logger.Warn("Failed to list artifacts, skipping node",
+				zap.String("node", node.Name),
+				zap.Error(err))
+			continue
+		}
+		nodeOrphans := findOrphans(node.Name, output, known)
+		orphans = append(orphans, nodeOrphans...)
+
+		if dryRun {
+			continue
+		}
+		c.removeOrphans(ctx, node.Address, nodeOrphans)
+	}
+
+	if !dryRun {
+		removed := 0
+		for _, o := range orphans {
+			if o.Removed {
+				removed++
+			}
+		}
+		if removed > 0 {
+			c.RecordEvent(ctx, EventGarbageCollected, "", fmt.Sprintf("Removed %d orphaned artifact(s)", removed), nil)
+		}
+	}
+
+	return orphans, nil
+}
+
+// gcKnownNames collects the resources, volumes, HA backups and reactor
+// plugins referred to by the database
+func (c *Controller) gcKnownNames(ctx context.Context) (*gcKnown, error) {
+	known := &gcKnown{
+		resources:        make(map[string]bool),
+		volumes:          make(map[string]bool),
+		haBackups:        make(map[string]bool),
+		reactorConfigs:   make(map[string]bool),
+		volumeResource:   c.matchVolumeName,
+		haConfigResource: c.matchHaConfigName,
+	}
+
+	resources, err := c.db.ListResources(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resources: %w", err)
+	}
+	for _, res := range resources {
+		known.resources[res.Name] = true
+		// Resources created before volumes were recorded use the default name
+		known.volumes[config.ExpandName(config.DefaultVolumeName, res.Name, "")] = true
+		known.volumes[c.newVolumeName(res.Name)] = true
+
+		volumes, err := c.db.ListVolumes(ctx, res.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list volumes of %s: %w", res.Name, err)
+		}
+		for _, vol := range volumes {
+			known.volumes[vol.VolumeName] = true
+		}
+	}
+
+	haConfigs, err := c.db.ListHaConfigs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list HA configs: %w", err)
+	}
+	for _, ha := range haConfigs {
+		known.reactorConfigs[haConfigName(ha)+".toml"] = true
+		if ha.MountPoint != "" {
+			known.haBackups["/tmp/ha_backup_"+strings.ReplaceAll(ha.MountPoint, "/", "_")] = true
+		}
+	}
+
+	gateways, err := c.db.ListGateways(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list gateways: %w", err)
+	}
+	for _, gw := range gateways {
+		known.reactorConfigs[path.Base(gatewayPluginPath(gw))] = true
+	}
+
+	return known, nil
+}
+
+// findOrphans parses the inventory of a node and returns the artifacts the
+// database does not refer to
+func findOrphans(node, inventory string, known *gcKnown) []*Orphan {
+	sections := make(map[string][]string)
+	section := ""
+	for _, line := range strings.Split(inventory, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			section = strings.TrimPrefix(line, "#")
+			continue
+		}
+		sections[section] = append(sections[section], line)
+	}
+
+	up := make(map[string]bool)
+	for _, name := range sections["up"] {
+		up[name] = true
+	}
+
+	var orphans []*Orphan
+	add := func(kind, p string, inUse bool) {
+		orphans = append(orphans, &Orphan{Kind: kind, Node: node, Path: p, InUse: inUse})
+	}
+
+	for _, line := range sections["lv"] {
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			continue
+		}
+		vg, lv, attr := fields[0], fields[1], fields[2]
+		resource, ok := known.volumeResource(lv)
+		if !ok || known.volumes[lv] {
+			continue
+		}
+		// The sixth attribute character is "o" for open volumes
+		open := len(attr) >= 6 && attr[5] == 'o'
+		add(OrphanLV, vg+"/"+lv, open || up[resource])
+	}
+
+	for _, zvol := range sections["zvol"] {
+		name := path.Base(zvol)
+		resource, ok := known.volumeResource(name)
+		// Safety copies of restores are pruned with the safety snapshots
+		if !ok || known.volumes[name] || strings.Contains(name, "-"+safetySnapshotPrefix) {
+			continue
+		}
+		add(OrphanZvol, zvol, up[resource])
+	}
+
+	for _, file := range sections["res"] {
+		resource := strings.TrimSuffix(path.Base(file), ".res")
+		if known.resources[resource] {
+			continue
+		}
+		add(OrphanDrbdConfig, file, up[resource])
+	}
+
+	for _, dir := range sections["backup"] {
+		if !known.haBackups[dir] {
+			add(OrphanHaBackup, dir, false)
+		}
+	}
+
+	// Only configs sds names are its own, the others belong to the site
+	for _, file := range sections["reactor"] {
+		name := strings.TrimSuffix(path.Base(file), ".toml")
+		if _, ok := known.haConfigResource(name); !ok && !strings.HasPrefix(name, "sds-") {
+			continue
+		}
+		if !known.reactorConfigs[path.Base(file)] {
+			add(OrphanReactorConfig, file, false)
+		}
+	}
+
+	return orphans
+}
+
+// removeOrphans removes the orphans of a node that are not in use
+func (c *Controller) removeOrphans(ctx context.Context, address string, orphans []*Orphan) {
+	reactorChanged := false
+	for _, o := range orphans {
+		if o.InUse {
+			continue
+		}
+
+		var cmd string
+		switch o.Kind {
+		case OrphanLV:
+			cmd = "sudo lvremove -f " + o.Path
+		case OrphanZvol:
+			// Without -r, zvols that still have snapshots are kept
+			cmd = "sudo zfs destroy " + o.Path
+		case OrphanDrbdConfig, OrphanReactorConfig:
+			cmd = "sudo rm -f " + o.Path
+		case OrphanHaBackup:
+			cmd = "sudo rm -rf " + o.Path
+		default:
+			continue
+		}
+
+		if _, err := c.execOutput(ctx, address, cmd); err != nil {
+			o.Error = err.Error()
+			c.logger.Warn("Failed to remove orphaned artifact",
+				zap.String("node", o.Node),
+				zap.String("path", o.Path),
+				zap.Error(err))
+			continue
+		}
+		o.Removed = true
+		if o.Kind == OrphanReactorConfig {
+			reactorChanged = true
+		}
+	}
+
+	if reactorChanged {
+		if _, err := c.deployment.ReactorReload(ctx, []string{address}); err != nil {
+			c.logger.Warn("Failed to reload drbd-reactor", zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// defaultHaFailoverTimeout bounds how long a targeted failover waits for the
+// destination to become Primary
+const defaultHaFailoverTimeout = 2 * time.Minute
+
+// FailoverHa moves an HA resource gracefully from its Primary to the given
+// node. Unlike an eviction, which lets drbd-reactor pick any other node, the
+// destination is put first in the preferred nodes of the promoter config
+// before the Primary is evicted. Unless forced, the destination must pass the
+// failover guard for the resource and its co-located partners. Afterwards the
+// regular placement order is restored with the new Primary first among equal
+// nodes, so drbd-reactor does not fail back. HA resources co-located with it
+// follow to the same node.
+func (rm *ResourceManager) FailoverHa(ctx context.Context, resource, target string, timeout time.Duration, force bool) (string, error) {
+	if rm.deployment == nil {
+		return "", fmt.Errorf("deployment client not set")
+	}
+	if timeout <= 0 {
+		timeout = defaultHaFailoverTimeout
+	}
+	if _, err := rm.GetHaConfig(ctx, resource); err != nil {
+		return "", fmt.Errorf("resource %s is not HA-managed: %w", resource, err)
+	}
+
+	destination := rm.resourceNodeName(ctx, resource, target)
+	if destination == "" {
+		return "", fmt.Errorf("node %s does not replicate resource %s", target, resource)
+	}
+	source := rm.primaryNode(ctx, resource)
+	if source == "" {
+		return "", fmt.Errorf("resource %s has no Primary to fail over from", resource)
+	}
+	if source == destination {
+		return "", fmt.Errorf("resource %s is already Primary on %s", resource, destination)
+	}
+
+	// Co-located HA partners active on the same node move along
+	resources := []string{resource}
+	colocated, separated := rm.placementPartners(ctx, resource)
+	for _, partner := range colocated {
+		if _, err := rm.controller.db.GetHaConfig(ctx, partner); err != nil {
+			continue
+		}
+		if rm.primaryNode(ctx, partner) != source {
+			continue
+		}
+		if rm.resourceNodeName(ctx, partner, destination) == "" {
+			return "", fmt.Errorf("co-located resource %s is not replicated on %s", partner, destination)
+		}
+		resources = append(resources, partner)
+	}
+	for _, partner := range separated {
+		if rm.primaryNode(ctx, partner) == destination {
+			return "", fmt.Errorf("resource %s must not share a node with %s, which is Primary on %s", resource, partner, destination)
+		}
+	}
+
+	if !force {
+		for _, res := range resources {
+			if err := rm.failoverGuard(ctx, res, source, []string{destination}); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	rm.controller.logger.Info("Failing over HA resource",
+		zap.String("resource", resource),
+		zap.String("from", source),
+		zap.String("to", destination),
+		zap.Strings("resources", resources))
+
+	for _, res := range resources {
+		if err := rm.writePromoterConfig(ctx, res, []string{destination}); err != nil {
+			rm.restorePromoterConfigs(ctx, resources, source)
+			return "", fmt.Errorf("failed to prefer %s for %s: %w", destination, res, err)
+		}
+	}
+
+	sourceAddress := rm.nodeAddress(source)
+	for _, res := range resources {
+		if err := rm.evictOnNode(ctx, res, sourceAddress); err != nil {
+			rm.restorePromoterConfigs(ctx, resources, source)
+			return "", fmt.Errorf("failed to evict %s from %s: %w", res, source, err)
+		}
+	}
+
+	var failed error
+	for _, res := range resources {
+		if err := rm.waitForRole(ctx, res, destination, "Primary", timeout); err != nil {
+			failed = fmt.Errorf("%s did not become Primary on %s: %w", res, destination, err)
+			break
+		}
+	}
+
+	// Whoever is Primary now keeps the resource
+	current := destination
+	if failed != nil {
+		current = rm.primaryNode(ctx, resource)
+	}
+	rm.restorePromoterConfigs(ctx, resources, current)
+
+	if failed != nil {
+		return "", failed
+	}
+
+	rm.controller.RecordEvent(ctx, EventHaFailover, resource,
+		fmt.Sprintf("HA resource %s failed over from %s to %s", resource, source, destination),
+		map[string]string{"from": source, "to": destination})
+
+	return source, nil
+}
+
+// writePromoterConfig rewrites the promoter config of an HA resource with the
+// given nodes preferred before the placement order, and reloads drbd-reactor
+func (rm *ResourceManager) writePromoterConfig(ctx context.Context, resource string, preferred []string) error {
+	haCfg, err := rm.GetHaConfig(ctx, resource)
+	if err != nil {
+		return err
+	}
+	nodeNames, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return err
+	}
+	nodeAddresses, err := rm.ResourceHosts(ctx, resource)
+	if err != nil {
+		return err
+	}
+
+	ordered := append([]string(nil), preferred...)
+	for _, node := range rm.rankNodes(ctx, resource, nodeNames) {
+		if !containsString(ordered, node) {
+			ordered = append(ordered, node)
+		}
+	}
+
+	configPath := haConfigPath(haConfigName(haCfg))
+	configContent := rm.generatePromoterConfig(resource, nodeAddresses, haCfg.Services, haCfg.MountPoint, haCfg.FsType, haCfg.VIP,
+		haCfg.DependsOn, ordered, haPolicyOf(haCfg))
+
+	if _, err := rm.deployment.DistributeConfig(ctx, nodeAddresses, configContent, configPath); err != nil {
+		return fmt.Errorf("failed to distribute promoter config: %w", err)
+	}
+	if _, err := rm.deployment.ReactorReload(ctx, nodeAddresses); err != nil {
+		rm.controller.logger.Warn("Failed to reload drbd-reactor", zap.Error(err))
+	}
+	return nil
+}
+
+// restorePromoterConfigs puts the placement order back into the promoter
+// configs, keeping primary first among the nodes placement ranks equally
+func (rm *ResourceManager) restorePromoterConfigs(ctx context.Context, resources []string, primary string) {
+	for _, res := range resources {
+		nodeNames, err := rm.resourceNodeNames(ctx, res)
+		if err != nil {
+			continue
+		}
+		var ordered []string
+		if containsString(nodeNames, primary) {
+			ordered = append(ordered, primary)
+		}
+		for _, node := range nodeNames {
+			if node != primary {
+				ordered = append(ordered, node)
+			}
+		}
+		if err := rm.writePromoterConfig(ctx, res, rm.rankNodes(ctx, res, ordered)); err != nil {
+			rm.controller.logger.Warn("Failed to restore promoter config",
+				zap.String("resource", res),
+				zap.Error(err))
+		}
+	}
+}
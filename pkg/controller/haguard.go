@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// failoverGuard refuses to move an HA resource away from source unless at
+// least one of the candidate nodes can take it over: the node is UpToDate,
+// keeps quorum with its connected peers, has the services and the mount unit
+// of the HA config installed, and is not under maintenance. The error lists why each candidate was
+// rejected.
+func (rm *ResourceManager) failoverGuard(ctx context.Context, resource, source string, candidates []string) error {
+	haCfg, err := rm.GetHaConfig(ctx, resource)
+	if err != nil {
+		return fmt.Errorf("resource %s is not HA-managed: %w", resource, err)
+	}
+	nodeNames, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return err
+	}
+
+	var reasons []string
+	for _, node := range candidates {
+		if node == source {
+			continue
+		}
+		problems := rm.failoverProblems(ctx, resource, node, len(nodeNames), haCfg.Services, haCfg.MountPoint)
+		if len(problems) == 0 {
+			return nil
+		}
+		reasons = append(reasons, fmt.Sprintf("%s: %s", node, strings.Join(problems, ", ")))
+	}
+
+	if len(reasons) == 0 {
+		return fmt.Errorf("refusing to fail over %s: no other node to take over, use --force to override", resource)
+	}
+	return fmt.Errorf("refusing to fail over %s, no node can take over safely (%s); use --force to override",
+		resource, strings.Join(reasons, "; "))
+}
+
+// failoverProblems returns why node cannot take over an HA resource
+// replicated on total nodes, or nothing if it can
+func (rm *ResourceManager) failoverProblems(ctx context.Context, resource, node string, total int, services []string, mountPoint string) []string {
+	var problems []string
+
+	output, err := rm.nodeStatus(ctx, resource, node)
+	if err != nil {
+		return []string{fmt.Sprintf("DRBD status unavailable (%v)", err)}
+	}
+
+	states := parseLocalDiskStatesFromStatus(output)
+	if len(states) == 0 {
+		problems = append(problems, "no local disk")
+	}
+	volumes := make([]int, 0, len(states))
+	for volume := range states {
+		volumes = append(volumes, volume)
+	}
+	sort.Ints(volumes)
+	for _, volume := range volumes {
+		if states[volume] != "UpToDate" {
+			problems = append(problems, fmt.Sprintf("volume %d is %s", volume, states[volume]))
+		}
+	}
+
+	// With quorum majority the node needs more than half of the nodes,
+	// itself included, to promote
+	connected := 1
+	for _, peers := range parseDrbdConnections(output) {
+		for _, state := range peers {
+			if state == "Connected" {
+				connected++
+			}
+		}
+	}
+	if connected*2 <= total {
+		problems = append(problems, fmt.Sprintf("no quorum, connected to %d of %d nodes", connected, total))
+	}
+
+	address := rm.nodeAddress(node)
+	for _, svc := range services {
+		out, err := rm.controller.execOutput(ctx, address, fmt.Sprintf("systemctl show %s -p LoadState 2>/dev/null", svc))
+		if err != nil || !strings.Contains(out, "LoadState=loaded") {
+			problems = append(problems, fmt.Sprintf("service %s not installed", svc))
+		}
+	}
+	if mountPoint != "" {
+		unit := haMountUnitPath(mountPoint)
+		if _, err := rm.controller.execOutput(ctx, address, "test -f "+unit); err != nil {
+			problems = append(problems, fmt.Sprintf("mount unit %s missing", unit))
+		}
+	}
+	if window := rm.controller.maintenanceNodes(ctx)[node]; window != nil {
+		problems = append(problems, fmt.Sprintf("under maintenance until %s", window.To.Format(time.RFC3339)))
+	}
+	if constraint := rm.controller.fenced(ctx, resource, node); constraint != nil {
+		problems = append(problems, fenceProblem(constraint))
+	}
+
+	return problems
+}
@@ -0,0 +1,215 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"github.com/liliang-cn/sds/pkg/pacemaker"
+	"go.uber.org/zap"
+)
+
+// HaImport is the sds HA setup of a DRBD resource taken over from Pacemaker
+type HaImport struct {
+	*pacemaker.HaResource
+	Config   string   // drbd-reactor promoter config
+	Blockers []string // Why the setup cannot be applied
+	Applied  bool
+}
+
+// ImportPacemakerHa maps the Pacemaker HA setup of DRBD resources onto
+// drbd-reactor promoter configs. The CIB is read from node unless given.
+// Only the given resources are imported, all if none are given.
+//
+// With apply, the mount unit and the promoter config of each resource are
+// installed and its HA config recorded, once Pacemaker no longer manages the
+// resource. drbd-reactor takes the resource over when it is removed from
+// Pacemaker, so clusters move one resource at a time.
+func (rm *ResourceManager) ImportPacemakerHa(ctx context.Context, node, cib string, resources []string, apply bool) ([]*HaImport, error) {
+	if cib == "" {
+		if node == "" {
+			return nil, fmt.Errorf("a CIB or a node to read it from is required")
+		}
+		output, err := rm.controller.execOutput(ctx, rm.nodeAddress(node), "sudo cibadmin --query")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the CIB from %s: %w", node, err)
+		}
+		cib = output
+	}
+	parsed, err := pacemaker.Parse([]byte(cib))
+	if err != nil {
+		return nil, err
+	}
+
+	var imports []*HaImport
+	found := make(map[string]bool)
+	for _, res := range parsed.HaResources() {
+		if len(resources) > 0 && !containsString(resources, res.DrbdResource) {
+			continue
+		}
+		found[res.DrbdResource] = true
+		imp, err := rm.planHaImport(ctx, res)
+		if err != nil {
+			return nil, err
+		}
+		imports = append(imports, imp)
+	}
+	for _, res := range resources {
+		if !found[res] {
+			return nil, fmt.Errorf("no promotable DRBD clone for resource %s in the CIB", res)
+		}
+	}
+
+	if !apply {
+		return imports, nil
+	}
+	for _, imp := range imports {
+		if len(imp.Blockers) > 0 {
+			return imports, fmt.Errorf("cannot import %s: %s", imp.DrbdResource, strings.Join(imp.Blockers, "; "))
+		}
+	}
+	for _, imp := range imports {
+		if err := rm.applyHaImport(ctx, imp); err != nil {
+			return imports, fmt.Errorf("failed to import %s: %w", imp.DrbdResource, err)
+		}
+		imp.Applied = true
+	}
+	return imports, nil
+}
+
+// planHaImport generates the promoter config of a Pacemaker HA setup and
+// lists what keeps it from being applied
+func (rm *ResourceManager) planHaImport(ctx context.Context, res *pacemaker.HaResource) (*HaImport, error) {
+	imp := &HaImport{HaResource: res}
+	imp.Blockers = append(imp.Blockers, res.Unsupported...)
+	if !res.Unmanaged {
+		imp.Blockers = append(imp.Blockers, fmt.Sprintf("Pacemaker still manages %s, unmanage it first (pcs resource unmanage)", res.Clone))
+	}
+	if res.MountPoint != "" && res.FsType == "" {
+		imp.Blockers = append(imp.Blockers, "the Filesystem resource has no fstype")
+	}
+
+	preferred := res.PreferredNodes
+	nodeNames, err := rm.resourceNodeNames(ctx, res.DrbdResource)
+	if err != nil {
+		imp.Blockers = append(imp.Blockers, fmt.Sprintf("not an sds resource: %v", err))
+	} else {
+		var known []string
+		for _, n := range preferred {
+			if containsString(nodeNames, n) {
+				known = append(known, n)
+			}
+		}
+		preferred = known
+		if len(preferred) == 0 {
+			preferred = rm.rankNodes(ctx, res.DrbdResource, nodeNames)
+		}
+	}
+	if rm.controller.db != nil {
+		if _, err := rm.controller.db.GetHaConfig(ctx, res.DrbdResource); err == nil {
+			imp.Blockers = append(imp.Blockers, "already HA-managed by sds")
+		}
+	}
+
+	imp.Config = rm.generatePromoterConfig(res.DrbdResource, nil, res.Services, res.MountPoint, res.FsType, res.VIP, nil, preferred, DefaultHaPolicy)
+	return imp, nil
+}
+
+// applyHaImport installs the mount unit and promoter config of an imported
+// HA setup and records it. The services are disabled at boot but left
+// running; Pacemaker stops them when the resources are removed from it.
+func (rm *ResourceManager) applyHaImport(ctx context.Context, imp *HaImport) error {
+	if rm.deployment == nil {
+		return fmt.Errorf("deployment client not set")
+	}
+	resource := imp.DrbdResource
+	nodeAddresses, err := rm.ResourceHosts(ctx, resource)
+	if err != nil {
+		return err
+	}
+	if err := rm.checkServicesLoaded(ctx, nodeAddresses, imp.Services); err != nil {
+		return err
+	}
+
+	owner := haVIPOwner(resource)
+	vip, err := rm.controller.reserveImportedVIP(ctx, imp.VIP, owner)
+	if err != nil {
+		return err
+	}
+
+	if imp.MountPoint != "" {
+		mountContent := rm.generateSystemdMountUnit(resource, imp.MountPoint, imp.FsType)
+		if _, err := rm.deployment.DistributeConfig(ctx, nodeAddresses, mountContent, haMountUnitPath(imp.MountPoint)); err != nil {
+			rm.controller.ReleaseVIPs(ctx, owner)
+			return fmt.Errorf("failed to distribute mount unit: %w", err)
+		}
+	}
+	for _, svc := range imp.Services {
+		if _, err := rm.deployment.Exec(ctx, nodeAddresses, fmt.Sprintf("sudo systemctl disable %s", svc)); err != nil {
+			rm.controller.logger.Warn("Failed to disable service", zap.String("service", svc), zap.Error(err))
+		}
+	}
+	if _, err := rm.deployment.Exec(ctx, nodeAddresses, "sudo systemctl daemon-reload"); err != nil {
+		rm.controller.logger.Warn("Failed to reload systemd", zap.Error(err))
+	}
+
+	configName := rm.controller.resourceHaConfigName(ctx, resource)
+	configPath := haConfigPath(configName)
+	if _, err := rm.deployment.DistributeConfig(ctx, nodeAddresses, imp.Config, configPath); err != nil {
+		rm.controller.ReleaseVIPs(ctx, owner)
+		return fmt.Errorf("failed to distribute promoter config: %w", err)
+	}
+	if _, err := rm.deployment.ReactorReload(ctx, nodeAddresses); err != nil {
+		rm.controller.logger.Warn("Failed to reload drbd-reactor", zap.Error(err))
+	}
+
+	if rm.controller.db != nil {
+		haCfg := &database.HaConfig{
+			Resource:        resource,
+			VIP:             vip,
+			MountPoint:      imp.MountPoint,
+			FsType:          imp.FsType,
+			Services:        imp.Services,
+			OnDemoteFailure: DefaultHaPolicy.OnDemoteFailure,
+			ConfigName:      configName,
+		}
+		if err := rm.controller.db.SaveHaConfig(ctx, haCfg); err != nil {
+			return fmt.Errorf("failed to save HA config: %w", err)
+		}
+	}
+
+	rm.controller.RecordEvent(ctx, EventHaImported, resource,
+		fmt.Sprintf("HA configuration of %s imported from Pacemaker clone %s", resource, imp.Clone),
+		map[string]string{
+			"clone":    imp.Clone,
+			"members":  strings.Join(imp.Members, ","),
+			"services": strings.Join(imp.Services, ","),
+			"vip":      vip,
+		})
+	return nil
+}
+
+// reserveImportedVIP reserves the VIP of an imported HA setup. Pacemaker
+// still holds the address, so unlike ReserveVIP it is not probed.
+func (c *Controller) reserveImportedVIP(ctx context.Context, vip, owner string) (string, error) {
+	if vip == "" || c.db == nil {
+		return vip, nil
+	}
+	prefix, err := parseVIP(vip)
+	if err != nil {
+		return "", err
+	}
+	used, err := c.vipsInUse(ctx)
+	if err != nil {
+		return "", err
+	}
+	addr := prefix.Addr().String()
+	if current, ok := used[addr]; ok && current != owner {
+		return "", fmt.Errorf("VIP %s is already used by %s", addr, current)
+	}
+	if err := c.db.ReserveVIP(ctx, &database.VIP{Address: addr, Prefix: prefix.Bits(), Owner: owner}); err != nil {
+		return "", err
+	}
+	return prefix.String(), nil
+}
@@ -0,0 +1,166 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/liliang-cn/sds/pkg/deployment"
+	"go.uber.org/zap"
+)
+
+// unrestrictedSudo matches a sudo -l rule that allows any command
+var unrestrictedSudo = regexp.MustCompile(`\)\s*(NOPASSWD:\s*)?ALL\s*$`)
+
+// HardenResult is the sudoers policy generated for a node
+type HardenResult struct {
+	User      string
+	Policy    string
+	Missing   []string // Privileged commands not installed on the node
+	Installed bool
+	// The user still has unrestricted sudo through another rule
+	Unrestricted bool
+}
+
+// HardenNode generates a sudoers policy that limits the SSH user of a node to
+// the commands the controller runs as root, resolved to their paths on the
+// node. Tools that can write any file run through a helper limited to the
+// paths of the controller. With apply the helper is installed, the policy is
+// checked with visudo and installed as /etc/sudoers.d/sds; broader rules
+// granted elsewhere are reported, removing them is left to the operator.
+func (c *Controller) HardenNode(ctx context.Context, node, user string, apply bool) (*HardenResult, error) {
+	if c.deployment == nil {
+		return nil, fmt.Errorf("deployment client not set")
+	}
+	address := c.nodes.GetNodeAddressByName(node)
+	if address == "" {
+		return nil, fmt.Errorf("node %s is not registered", node)
+	}
+	sshUser := c.deployment.SSHUser(address)
+	if user == "" {
+		user = sshUser
+	}
+	if user == "" || user == "root" {
+		return nil, fmt.Errorf("the controller connects to %s as root, create an unprivileged SSH user and give it as the user", node)
+	}
+
+	paths, visudo, missing, err := c.privilegedCommandPaths(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	if visudo == "" {
+		return nil, fmt.Errorf("visudo is not installed on %s", node)
+	}
+
+	header := fmt.Sprintf("Generated by sds-controller for %s\nsudo rights of %s limited to the commands of the controller",
+		c.clusterStamp(), user)
+	result := &HardenResult{
+		User:    user,
+		Policy:  deployment.SudoersPolicy(user, header, paths, visudo),
+		Missing: missing,
+	}
+	if !apply {
+		return result, nil
+	}
+
+	helper := deployment.FileHelper(fmt.Sprintf("Generated by sds-controller for %s", c.clusterStamp()))
+	written, err := c.deployment.PushFile(ctx, []string{address}, []byte(helper), deployment.FileHelperPath, 0755, "root:root")
+	if err != nil {
+		return nil, fmt.Errorf("failed to install %s: %w", deployment.FileHelperPath, err)
+	}
+	if !written.Success {
+		return nil, fmt.Errorf("failed to install %s on %s", deployment.FileHelperPath, node)
+	}
+
+	if err := c.installSudoersPolicy(ctx, address, result.Policy); err != nil {
+		return nil, err
+	}
+	result.Installed = true
+
+	// sudo -l shows the rules of the user the controller connects as
+	if user == sshUser {
+		output, err := c.execOutput(ctx, address, "sudo -n -l")
+		if err == nil {
+			for _, line := range strings.Split(output, "\n") {
+				if unrestrictedSudo.MatchString(strings.TrimSpace(line)) {
+					result.Unrestricted = true
+				}
+			}
+		}
+	}
+
+	c.logger.Info("Installed sudoers policy",
+		zap.String("node", node),
+		zap.String("user", user),
+		zap.Strings("missing", missing),
+		zap.Bool("unrestricted", result.Unrestricted))
+	c.RecordEvent(ctx, EventNodeHardened, "", fmt.Sprintf("sudo rights of %s on %s limited to the controller's commands", user, node),
+		map[string]string{
+			"node":         node,
+			"user":         user,
+			"unrestricted": fmt.Sprintf("%t", result.Unrestricted),
+		})
+
+	return result, nil
+}
+
+// privilegedCommandPaths resolves the privileged commands and visudo to their
+// paths on a node. Commands that are not installed, including those the helper
+// runs, are returned as missing.
+func (c *Controller) privilegedCommandPaths(ctx context.Context, address string) (paths []string, visudo string, missing []string, err error) {
+	tools := deployment.PrivilegedCommands()
+	checked := deployment.CheckedCommands()
+	cmd := fmt.Sprintf("PATH=$PATH:/usr/local/sbin:/usr/sbin:/sbin; for t in %s %s realpath visudo; do p=$(command -v $t) && echo \"$t $p\"; done; true",
+		strings.Join(tools, " "), strings.Join(checked, " "))
+	output, err := c.execOutput(ctx, address, cmd)
+	if err != nil {
+		return nil, "", nil, fmt.Errorf("failed to resolve command paths: %w", err)
+	}
+
+	resolved := make(map[string]string)
+	for _, line := range strings.Split(output, "\n") {
+		tool, p, ok := strings.Cut(strings.TrimSpace(line), " ")
+		if ok && strings.HasPrefix(p, "/") {
+			resolved[tool] = p
+		}
+	}
+	for _, tool := range tools {
+		if p, ok := resolved[tool]; ok {
+			paths = append(paths, p)
+		} else {
+			missing = append(missing, tool)
+		}
+	}
+	for _, tool := range append(checked, "realpath") {
+		if _, ok := resolved[tool]; !ok {
+			missing = append(missing, tool)
+		}
+	}
+	return paths, resolved["visudo"], missing, nil
+}
+
+// installSudoersPolicy stages a policy, has visudo check it and moves it into
+// place. A broken policy is removed again, sudo never reads it.
+func (c *Controller) installSudoersPolicy(ctx context.Context, address, policy string) error {
+	staged := deployment.SudoersCheckPath
+	result, err := c.deployment.DistributeConfig(ctx, []string{address}, policy, staged)
+	if err != nil {
+		return fmt.Errorf("failed to write sudoers policy: %w", err)
+	}
+	if !result.Success {
+		return fmt.Errorf("failed to write sudoers policy to %s", address)
+	}
+
+	if _, err := c.execOutput(ctx, address, fmt.Sprintf("sudo visudo -cf %s", staged)); err != nil {
+		_, _ = c.execOutput(ctx, address, fmt.Sprintf("sudo rm -f %s", staged))
+		return fmt.Errorf("visudo rejected the sudoers policy: %w", err)
+	}
+
+	install := fmt.Sprintf("sudo chmod 0440 %s && sudo chown root:root %s && sudo mv -f %s %s",
+		staged, staged, staged, deployment.SudoersPath)
+	if _, err := c.execOutput(ctx, address, install); err != nil {
+		return fmt.Errorf("failed to install sudoers policy: %w", err)
+	}
+	return nil
+}
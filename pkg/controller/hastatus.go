@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// haMemberCmd collects the DRBD state of a resource and the state of
+// drbd-reactor on a node, one section per source
+const haMemberCmd = `echo '#status'; sudo drbdsetup status %s 2>/dev/null; ` +
+	`echo '#reactor'; systemctl is-active drbd-reactor 2>/dev/null; true`
+
+// HaMember is the state of an HA resource on one of its nodes
+type HaMember struct {
+	Node      string
+	Address   string
+	Role      string // Unknown if the node cannot be reached
+	DiskState string
+	Reactor   string // State of the drbd-reactor service, e.g. active
+}
+
+// HaStatus is an HA config with the node the resource is active on and
+// the state of each of its nodes
+type HaStatus struct {
+	Config     *database.HaConfig
+	ConfigPath string
+	ActiveNode string // Empty if the resource is Primary nowhere
+	Members    []*HaMember
+}
+
+// GetHaStatus returns the HA config of a resource with its active node and
+// the DRBD and drbd-reactor state on each of its nodes, queried from the
+// nodes so the caller need not run on one of them
+func (rm *ResourceManager) GetHaStatus(ctx context.Context, resource string) (*HaStatus, error) {
+	haCfg, err := rm.GetHaConfig(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("resource %s is not HA-managed: %w", resource, err)
+	}
+	nodes, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &HaStatus{Config: haCfg, ConfigPath: haConfigPath(haConfigName(haCfg))}
+	for _, node := range nodes {
+		member := &HaMember{Node: node, Address: rm.nodeAddress(node), Role: "Unknown", DiskState: "Unknown", Reactor: "unknown"}
+		status.Members = append(status.Members, member)
+
+		output, err := rm.controller.execOutput(ctx, member.Address, fmt.Sprintf(haMemberCmd, resource))
+		if err != nil {
+			rm.controller.logger.Debug("Failed to query HA member",
+				zap.String("resource", resource),
+				zap.String("node", node),
+				zap.Error(err))
+		}
+		if state := parseDrbdStates(output)[resource]; state != nil {
+			member.Role = state.Role
+			member.DiskState = state.DiskState
+		}
+		if reactor := parseReactorState(output); reactor != "" {
+			member.Reactor = reactor
+		}
+		if member.Role == "Primary" && status.ActiveNode == "" {
+			status.ActiveNode = node
+		}
+	}
+	return status, nil
+}
+
+// cachedActiveNode returns the node an HA resource was Primary on in the
+// last node poll, empty if none or unknown
+func (c *Controller) cachedActiveNode(ctx context.Context, resource string) string {
+	nodes, err := c.resources.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return ""
+	}
+	for _, node := range nodes {
+		if state := c.liveState(node, resource); state != nil && state.Role == "Primary" {
+			return node
+		}
+	}
+	return ""
+}
+
+// parseReactorState returns the reactor section of the output of
+// haMemberCmd
+func parseReactorState(output string) string {
+	section := ""
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "#") {
+			section = strings.TrimPrefix(line, "#")
+			continue
+		}
+		if section == "reactor" && line != "" {
+			return line
+		}
+	}
+	return ""
+}
@@ -0,0 +1,275 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// vipNone removes the VIP of an HA config
+const vipNone = "none"
+
+// HaUpdate is a change to the HA configuration of a resource
+type HaUpdate struct {
+	AddServices    []string
+	RemoveServices []string
+	VIP            string // New VIP, "" keeps the current one
+	ClearVIP       bool   // Remove the VIP
+	DryRun         bool   // Only report the config diff and the planned actions
+}
+
+// HaUpdateResult is the outcome of an HA config update
+type HaUpdateResult struct {
+	Changed     bool
+	Diff        string         // Unified-style line diff of the promoter config
+	Actions     []string       // What was done, or would be done on a dry run
+	Files       []*PlannedFile // Files a dry run would write, per node
+	VIP         string         // VIP after the update
+	PreviousVIP string
+}
+
+// UpdateHa changes the services and the VIP of an HA-managed resource in
+// place. The promoter config is regenerated and diffed against the one on the
+// nodes; drbd-reactor is reloaded on the Secondaries first and on the Primary
+// last, where only the units that were removed are stopped and the ones that
+// were added are started. Units that stay are never restarted.
+func (rm *ResourceManager) UpdateHa(ctx context.Context, resource string, update HaUpdate) (*HaUpdateResult, error) {
+	if rm.deployment == nil {
+		return nil, fmt.Errorf("deployment client not set")
+	}
+	haCfg, err := rm.GetHaConfig(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("resource %s is not HA-managed: %w", resource, err)
+	}
+
+	services, err := updatedServices(haCfg.Services, update.AddServices, update.RemoveServices)
+	if err != nil {
+		return nil, err
+	}
+	vip := haCfg.VIP
+	if update.ClearVIP {
+		vip = ""
+	} else if update.VIP != "" {
+		vip = update.VIP
+	}
+
+	nodeNames, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	nodeAddresses, err := rm.ResourceHosts(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	configPath := haConfigPath(haConfigName(haCfg))
+	preferredNodes := rm.rankNodes(ctx, resource, nodeNames)
+	policy := haPolicyOf(haCfg)
+	newConfig := rm.generatePromoterConfig(resource, nodeAddresses, services, haCfg.MountPoint, haCfg.FsType, vip,
+		haCfg.DependsOn, preferredNodes, policy)
+
+	// Diff against what the nodes run, which may have drifted from the
+	// stored config
+	current, err := rm.controller.execOutput(ctx, nodeAddresses[0], "sudo cat "+configPath)
+	if err != nil {
+		rm.controller.logger.Warn("Failed to read promoter config, diffing against the stored HA config",
+			zap.String("resource", resource),
+			zap.Error(err))
+		current = rm.generatePromoterConfig(resource, nodeAddresses, haCfg.Services, haCfg.MountPoint, haCfg.FsType, haCfg.VIP,
+			haCfg.DependsOn, preferredNodes, policy)
+	}
+
+	result := &HaUpdateResult{
+		Diff:        lineDiff(current, newConfig),
+		VIP:         vip,
+		PreviousVIP: haCfg.VIP,
+	}
+
+	// Units to stop and start on the Primary
+	var stopUnits, startUnits []string
+	for _, svc := range haCfg.Services {
+		if !containsString(services, svc) {
+			stopUnits = append(stopUnits, svc)
+		}
+	}
+	if haCfg.VIP != vip {
+		if haCfg.VIP != "" {
+			stopUnits = append(stopUnits, serviceIPUnit(haCfg.VIP))
+		}
+		if vip != "" {
+			startUnits = append(startUnits, serviceIPUnit(vip))
+		}
+	}
+	var added []string
+	for _, svc := range services {
+		if !containsString(haCfg.Services, svc) {
+			added = append(added, svc)
+			startUnits = append(startUnits, svc)
+		}
+	}
+
+	if result.Diff == "" && len(stopUnits) == 0 && len(startUnits) == 0 {
+		return result, nil
+	}
+	result.Changed = true
+
+	primary := rm.primaryNode(ctx, resource)
+	var passive []string
+	for _, node := range nodeNames {
+		if node != primary {
+			passive = append(passive, rm.nodeAddress(node))
+		}
+	}
+
+	if len(added) > 0 {
+		result.Actions = append(result.Actions, fmt.Sprintf("stop and disable %s on all nodes", strings.Join(added, ", ")))
+	}
+	if result.Diff != "" {
+		result.Actions = append(result.Actions, "write "+configPath+" on all nodes")
+		if len(passive) > 0 {
+			result.Actions = append(result.Actions, "reload drbd-reactor on "+strings.Join(passive, ", "))
+		}
+		if primary != "" {
+			result.Actions = append(result.Actions, fmt.Sprintf("reload drbd-reactor on %s (Primary)", primary))
+		}
+	}
+	if primary != "" {
+		for _, unit := range stopUnits {
+			result.Actions = append(result.Actions, fmt.Sprintf("stop %s on %s", unit, primary))
+		}
+		for _, unit := range startUnits {
+			result.Actions = append(result.Actions, fmt.Sprintf("start %s on %s", unit, primary))
+		}
+	}
+
+	if update.DryRun {
+		result.Files = rm.planFiles(ctx, nodeNames, map[string]string{configPath: newConfig})
+		return result, nil
+	}
+
+	rm.controller.logger.Info("Updating HA configuration",
+		zap.String("resource", resource),
+		zap.Strings("services", services),
+		zap.String("vip", vip),
+		zap.String("primary", primary))
+
+	// Added services must be startable everywhere and only drbd-reactor may
+	// start them
+	if err := rm.checkServicesLoaded(ctx, nodeAddresses, added); err != nil {
+		return nil, err
+	}
+	for _, svc := range added {
+		if _, err := rm.deployment.Exec(ctx, nodeAddresses, fmt.Sprintf("systemctl disable %s", svc)); err != nil {
+			rm.controller.logger.Warn("Failed to disable service", zap.String("service", svc), zap.Error(err))
+		}
+		if _, err := rm.deployment.Exec(ctx, passive, fmt.Sprintf("systemctl stop %s", svc)); err != nil {
+			rm.controller.logger.Warn("Failed to stop service", zap.String("service", svc), zap.Error(err))
+		}
+	}
+
+	if result.Diff != "" {
+		if _, err := rm.deployment.DistributeConfig(ctx, nodeAddresses, newConfig, configPath); err != nil {
+			return nil, fmt.Errorf("failed to distribute promoter config: %w", err)
+		}
+		// The Secondaries pick up the config first, a failover during the
+		// update then already runs the new units
+		if len(passive) > 0 {
+			if _, err := rm.deployment.ReactorReload(ctx, passive); err != nil {
+				rm.controller.logger.Warn("Failed to reload drbd-reactor", zap.Error(err))
+			}
+		}
+		if primary != "" {
+			if _, err := rm.deployment.ReactorReload(ctx, []string{rm.nodeAddress(primary)}); err != nil {
+				rm.controller.logger.Warn("Failed to reload drbd-reactor on Primary",
+					zap.String("node", primary),
+					zap.Error(err))
+			}
+		}
+	}
+
+	if primary != "" {
+		address := rm.nodeAddress(primary)
+		for _, unit := range stopUnits {
+			if _, err := rm.controller.execOutput(ctx, address, fmt.Sprintf("sudo systemctl stop %s", unit)); err != nil {
+				rm.controller.logger.Warn("Failed to stop unit on Primary",
+					zap.String("unit", unit),
+					zap.String("node", primary),
+					zap.Error(err))
+			}
+		}
+		for _, unit := range startUnits {
+			if _, err := rm.controller.execOutput(ctx, address, fmt.Sprintf("sudo systemctl start %s", unit)); err != nil {
+				return nil, fmt.Errorf("config updated but %s failed to start on %s: %w", unit, primary, err)
+			}
+		}
+	}
+
+	haCfg.Services = services
+	haCfg.VIP = vip
+	if err := rm.controller.db.SaveHaConfig(ctx, haCfg); err != nil {
+		return nil, fmt.Errorf("failed to save HA config: %w", err)
+	}
+
+	rm.controller.RecordEvent(ctx, EventHaUpdated, resource,
+		fmt.Sprintf("HA configuration of %s updated", resource),
+		map[string]string{
+			"services":     strings.Join(services, ","),
+			"vip":          vip,
+			"previous_vip": result.PreviousVIP,
+			"primary":      primary,
+		})
+
+	return result, nil
+}
+
+// updatedServices applies additions and removals to the service list of an
+// HA config, keeping the start order of the existing services
+func updatedServices(current, add, remove []string) ([]string, error) {
+	for _, svc := range remove {
+		if !containsString(current, svc) {
+			return nil, fmt.Errorf("service %s is not part of the HA configuration", svc)
+		}
+	}
+
+	var services []string
+	for _, svc := range current {
+		if !containsString(remove, svc) {
+			services = append(services, svc)
+		}
+	}
+	for _, svc := range add {
+		if strings.TrimSpace(svc) == "" {
+			return nil, fmt.Errorf("service name must not be empty")
+		}
+		if containsString(services, svc) {
+			return nil, fmt.Errorf("service %s is already part of the HA configuration", svc)
+		}
+		services = append(services, svc)
+	}
+	return services, nil
+}
+
+// checkServicesLoaded verifies that systemd knows the services on all nodes,
+// so that a failover cannot fail on a missing unit
+func (rm *ResourceManager) checkServicesLoaded(ctx context.Context, nodeAddresses, services []string) error {
+	for _, svc := range services {
+		result, err := rm.deployment.Exec(ctx, nodeAddresses,
+			fmt.Sprintf("systemctl show %s -p LoadState 2>/dev/null || echo 'not-found'", svc))
+		if err != nil {
+			return fmt.Errorf("failed to check service %s on nodes: %w", svc, err)
+		}
+
+		var missingNodes []string
+		for node, hr := range result.Hosts {
+			if !strings.Contains(hr.Output, "LoadState=loaded") {
+				missingNodes = append(missingNodes, node)
+			}
+		}
+		if len(missingNodes) > 0 {
+			return fmt.Errorf("service %s not found on nodes: %v. Please install the service on all nodes first", svc, missingNodes)
+		}
+	}
+	return nil
+}
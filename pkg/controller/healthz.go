@@ -0,0 +1,150 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// healthCheckInterval is how often the gRPC health statuses are refreshed
+	healthCheckInterval = 10 * time.Second
+	// healthCheckTimeout bounds a readiness check
+	healthCheckTimeout = 5 * time.Second
+	// pollStaleAfter is how old the last node poll may be for the controller
+	// to be ready
+	pollStaleAfter = 3 * nodePollInterval
+)
+
+// Subsystems checked for readiness, also the service names of their statuses
+// in the gRPC health service. healthReady is ready when all of them are.
+const (
+	healthDatabase   = "sds.db"
+	healthDeployment = "sds.deployment"
+	healthPoller     = "sds.poller"
+	healthReady      = "sds.ready"
+)
+
+// SubsystemHealth is the status of a subsystem the controller needs to
+// orchestrate
+type SubsystemHealth struct {
+	Name   string `json:"name"`
+	Ready  bool   `json:"ready"`
+	Detail string `json:"detail"`
+}
+
+// Readiness is whether the controller is able to orchestrate, and why not
+type Readiness struct {
+	Ready      bool               `json:"ready"`
+	Subsystems []*SubsystemHealth `json:"subsystems"`
+}
+
+// checkReadiness checks the database, that the nodes are reachable over SSH
+// and that the node poller keeps running
+func (c *Controller) checkReadiness(ctx context.Context) *Readiness {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	readiness := &Readiness{Ready: true}
+	add := func(name string, err error, detail string) {
+		sub := &SubsystemHealth{Name: name, Ready: err == nil, Detail: detail}
+		if err != nil {
+			sub.Detail = err.Error()
+			readiness.Ready = false
+		}
+		readiness.Subsystems = append(readiness.Subsystems, sub)
+	}
+
+	switch {
+	case c.db == nil:
+		add(healthDatabase, fmt.Errorf("database not available"), "")
+	default:
+		add(healthDatabase, c.db.Ping(ctx), "readable")
+	}
+
+	c.pollMu.RLock()
+	polledAt, polled, reached := c.polledAt, c.polledNodes, c.reachedNodes
+	c.pollMu.RUnlock()
+
+	switch {
+	case c.deployment == nil:
+		add(healthDeployment, fmt.Errorf("deployment client not set"), "")
+	case polledAt.IsZero():
+		add(healthDeployment, fmt.Errorf("nodes not polled yet"), "")
+	case polled > 0 && reached == 0:
+		add(healthDeployment, fmt.Errorf("none of %d nodes reachable", polled), "")
+	default:
+		add(healthDeployment, nil, fmt.Sprintf("%d of %d nodes reachable", reached, polled))
+	}
+
+	switch age := time.Since(polledAt); {
+	case polledAt.IsZero():
+		add(healthPoller, fmt.Errorf("nodes not polled yet"), "")
+	case age > pollStaleAfter:
+		add(healthPoller, fmt.Errorf("last node poll %s ago", age.Round(time.Second)), "")
+	default:
+		add(healthPoller, nil, fmt.Sprintf("last node poll %s ago", age.Round(time.Second)))
+	}
+
+	return readiness
+}
+
+// updateHealth sets the statuses of the gRPC health service. The overall ""
+// status stays SERVING while the process is up; healthReady and the
+// subsystems report whether the controller can orchestrate.
+func (c *Controller) updateHealth(readiness *Readiness) {
+	if c.health == nil {
+		return
+	}
+	status := func(ready bool) grpc_health_v1.HealthCheckResponse_ServingStatus {
+		if ready {
+			return grpc_health_v1.HealthCheckResponse_SERVING
+		}
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	for _, sub := range readiness.Subsystems {
+		c.health.SetServingStatus(sub.Name, status(sub.Ready))
+	}
+	c.health.SetServingStatus(healthReady, status(readiness.Ready))
+}
+
+// runHealthChecker refreshes the gRPC health statuses until the controller
+// is stopped
+func (c *Controller) runHealthChecker() {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			c.health.Shutdown()
+			return
+		case <-ticker.C:
+			c.updateHealth(c.checkReadiness(c.ctx))
+		}
+	}
+}
+
+// registerHealthHandlers adds the probes for load balancers and systemd:
+// /healthz answers 200 while the process serves requests, /readyz answers
+// 200 only while the controller can orchestrate and 503 with the failed
+// subsystems otherwise
+func (c *Controller) registerHealthHandlers(mux *http.ServeMux) {
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write([]byte("ok\n"))
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		readiness := c.checkReadiness(r.Context())
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		if !readiness.Ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(readiness)
+	})
+}
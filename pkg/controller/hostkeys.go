@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/liliang-cn/sds/pkg/deployment"
+	"go.uber.org/zap"
+)
+
+// HostKeyInfo is the SSH host key a node presents
+type HostKeyInfo struct {
+	Node                string // Empty for an address that is no registered node
+	Address             string
+	KeyType             string
+	Fingerprint         string
+	PreviousFingerprint string // Key pinned before, if it differs
+	Pinned              bool
+}
+
+// TrustNode fetches the SSH host key of a node, given by its name or address.
+// Without a fingerprint the key is only returned for the operator to confirm.
+// With the fingerprint the operator confirmed the key is pinned, provided the
+// node still presents it. A different key pinned before is only replaced with
+// replace, e.g. after the node was reinstalled.
+func (c *Controller) TrustNode(ctx context.Context, node, fingerprint string, replace bool) (*HostKeyInfo, error) {
+	if c.deployment == nil {
+		return nil, fmt.Errorf("deployment client not set")
+	}
+	store := c.deployment.KnownHosts()
+	if store == nil {
+		return nil, fmt.Errorf("no known hosts store configured")
+	}
+
+	info := &HostKeyInfo{Address: c.nodes.GetNodeAddressByName(node)}
+	if info.Address != "" {
+		info.Node = node
+	} else if net.ParseIP(node) != nil {
+		info.Address = node
+		if registered, err := c.nodes.GetNode(ctx, node); err == nil {
+			info.Node = registered.Name
+		}
+	} else {
+		return nil, fmt.Errorf("node %s is not registered, give its address to trust it before registering", node)
+	}
+
+	key, err := c.deployment.FetchHostKey(ctx, info.Address)
+	if err != nil {
+		return nil, err
+	}
+	info.KeyType = key.Type()
+	info.Fingerprint = deployment.Fingerprint(key)
+
+	pinned, err := store.Lookup(info.Address)
+	if err != nil {
+		return nil, err
+	}
+	if pinned != nil && bytes.Equal(pinned.Marshal(), key.Marshal()) {
+		info.Pinned = true
+		return info, nil
+	}
+	if pinned != nil {
+		info.PreviousFingerprint = deployment.Fingerprint(pinned)
+	}
+
+	if fingerprint == "" {
+		return info, nil
+	}
+	if fingerprint != info.Fingerprint {
+		return nil, fmt.Errorf("%s presents host key %s, not the confirmed %s", info.Address, info.Fingerprint, fingerprint)
+	}
+	if pinned != nil && !replace {
+		return nil, fmt.Errorf("%s presents host key %s but %s is pinned; if the node was reinstalled, replace the pinned key",
+			info.Address, info.Fingerprint, info.PreviousFingerprint)
+	}
+
+	if err := store.Pin(info.Address, key); err != nil {
+		return nil, err
+	}
+	info.Pinned = true
+
+	c.logger.Info("Pinned host key",
+		zap.String("node", info.Node),
+		zap.String("address", info.Address),
+		zap.String("fingerprint", info.Fingerprint))
+	details := map[string]string{
+		"address":     info.Address,
+		"fingerprint": info.Fingerprint,
+	}
+	if info.PreviousFingerprint != "" {
+		details["previous"] = info.PreviousFingerprint
+	}
+	name := info.Node
+	if name == "" {
+		name = info.Address
+	}
+	c.RecordEvent(ctx, EventNodeTrusted, "", fmt.Sprintf("Host key of %s pinned: %s", name, info.Fingerprint), details)
+
+	return info, nil
+}
+
+// warnUntrustedNodes logs the registered nodes whose host key is not pinned,
+// strict host key checking refuses to connect to them
+func (c *Controller) warnUntrustedNodes(ctx context.Context) {
+	store := c.deployment.KnownHosts()
+	if store == nil || !c.config.SSH.StrictHostKey {
+		return
+	}
+	nodes, err := c.nodes.ListNodes(ctx)
+	if err != nil {
+		return
+	}
+	for _, node := range nodes {
+		if deployment.IsLocal(node.Address) {
+			continue
+		}
+		if key, err := store.Lookup(node.Address); err == nil && key == nil {
+			c.logger.Warn("Host key of node is not pinned, connections to it are refused; run sds node trust",
+				zap.String("node", node.Name),
+				zap.String("address", node.Address))
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// hostnameMismatchError explains that DRBD will not find a node in its "on"
+// sections and how to fix it
+func hostnameMismatchError(name, address, uname string) error {
+	return fmt.Errorf("node %s (%s) reports uname -n %q, DRBD matches its \"on\" sections against that name; "+
+		"register the node as %q, or run 'hostnamectl set-hostname %s' on it", name, address, uname, uname, name)
+}
+
+// nodeUname returns uname -n of the host at address
+func (c *Controller) nodeUname(ctx context.Context, address string) (string, error) {
+	output, err := c.execOutput(ctx, address, "uname -n")
+	if err != nil {
+		return "", fmt.Errorf("failed to read uname -n on %s: %w", address, err)
+	}
+	uname := strings.TrimSpace(output)
+	if uname == "" {
+		return "", fmt.Errorf("empty uname -n on %s", address)
+	}
+	return uname, nil
+}
+
+// checkNodeHostname verifies that the node name DRBD configs use for the
+// host at address equals its uname -n
+func (c *Controller) checkNodeHostname(ctx context.Context, name, address string) error {
+	uname, err := c.nodeUname(ctx, address)
+	if err != nil {
+		return err
+	}
+	if uname != name {
+		return hostnameMismatchError(name, address, uname)
+	}
+	return nil
+}
+
+// checkNodeHostnames verifies the hostname of every node of a resource
+// before anything is created on them, reporting all mismatches at once
+func (c *Controller) checkNodeHostnames(ctx context.Context, nodes, addresses []string) error {
+	var problems []string
+	for i, node := range nodes {
+		if err := c.checkNodeHostname(ctx, node, addresses[i]); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("hostname check failed: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// parseNodeHostname returns the hostname section of the output of
+// nodeCapacityCmd, empty if missing
+func parseNodeHostname(output string) string {
+	section := ""
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "#") {
+			section = strings.TrimPrefix(line, "#")
+			continue
+		}
+		if section == "hostname" && line != "" {
+			return line
+		}
+	}
+	return ""
+}
+
+// recordHostname keeps the hostname a node reported in the node poll. A
+// changed hostname replaces the previous one in the host mappings, the
+// database and the /etc/hosts entries of the other nodes.
+func (c *Controller) recordHostname(ctx context.Context, address, hostname string) {
+	if hostname == "" {
+		return
+	}
+	c.nodes.mu.Lock()
+	n := c.nodes.nodes[address]
+	if n == nil || n.Hostname == hostname {
+		c.nodes.mu.Unlock()
+		return
+	}
+	previous := n.Hostname
+	n.Hostname = hostname
+	name := n.Name
+	c.nodes.mu.Unlock()
+
+	c.hostsLock.Lock()
+	if previous != "" && previous != name && c.hostsMap[previous] == address {
+		delete(c.hostsMap, previous)
+	}
+	c.hostsMap[hostname] = address
+	c.hostsLock.Unlock()
+
+	if c.db != nil {
+		if dbNode, err := c.db.GetNode(ctx, address); err == nil {
+			dbNode.Hostname = hostname
+			if err := c.db.SaveNode(ctx, dbNode); err != nil {
+				c.logger.Warn("Failed to save node to database", zap.Error(err))
+			}
+		}
+	}
+
+	c.logger.Info("Hostname of node",
+		zap.String("node", name),
+		zap.String("hostname", hostname),
+		zap.String("previous", previous))
+
+	if previous != "" {
+		c.nodes.removeHostsEntry(ctx, address, previous)
+	}
+	c.nodes.addHostsEntry(ctx, address, hostname)
+}
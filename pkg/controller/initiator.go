@@ -0,0 +1,499 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"github.com/liliang-cn/sds/pkg/gateway"
+	"go.uber.org/zap"
+)
+
+// InitiatorResult describes what an initiator helper did on a client node
+type InitiatorResult struct {
+	Gateway  string
+	Node     string
+	Target   string   // NQN or IQN
+	Portals  []string // address:port
+	Devices  []string // block devices seen by the client
+	Unit     string   // systemd unit installed on the client
+	Warnings []string
+}
+
+// NVMeConnect discovers an sds-managed NVMe-oF gateway from a client node and
+// connects to its subsystem. Connecting is idempotent. hostNQN is optional,
+// the client's /etc/nvme/hostnqn is used otherwise.
+func (c *Controller) NVMeConnect(ctx context.Context, gatewayName, node, hostNQN string) (*InitiatorResult, error) {
+	gw, err := c.initiatorGateway(ctx, gatewayName, database.GatewayTypeNVMEOF, "-nvme")
+	if err != nil {
+		return nil, err
+	}
+
+	nqn := gatewayConfigString(gw, "nqn")
+	transport := gatewayConfigString(gw, "transport_type")
+	if transport == "" {
+		transport = "tcp"
+	}
+	addr := serviceAddress(gatewayConfigString(gw, "service_ip"))
+	if nqn == "" || addr == "" {
+		return nil, fmt.Errorf("gateway %s has no NQN or service IP recorded", gw.Name)
+	}
+
+	result := &InitiatorResult{
+		Gateway: gw.Name,
+		Node:    node,
+		Target:  nqn,
+		Portals: []string{fmt.Sprintf("%s:%d", addr, gateway.DefaultNVMePort)},
+	}
+	host := c.initiatorAddress(node)
+
+	c.logger.Info("Connecting NVMe-oF initiator",
+		zap.String("gateway", gw.Name),
+		zap.String("node", node),
+		zap.String("nqn", nqn),
+		zap.String("address", addr))
+
+	// Native NVMe multipath lets the client follow the gateway across failovers
+	if out, err := c.execOutput(ctx, host, "cat /sys/module/nvme_core/parameters/multipath 2>/dev/null || echo N"); err == nil && strings.TrimSpace(out) != "Y" {
+		result.Warnings = append(result.Warnings,
+			"native NVMe multipath is disabled on the client, set nvme_core.multipath=Y on the kernel command line")
+	}
+
+	if _, err := c.execOutput(ctx, host, fmt.Sprintf("sudo modprobe nvme-%s", transport)); err != nil {
+		return result, fmt.Errorf("failed to load nvme-%s on %s: %w", transport, node, err)
+	}
+
+	connected, err := c.nvmeConnected(ctx, host, nqn)
+	if err != nil {
+		return result, err
+	}
+	if connected {
+		result.Warnings = append(result.Warnings, "already connected")
+	} else {
+		out, err := c.execOutput(ctx, host, fmt.Sprintf("sudo nvme discover -t %s -a %s -s %d", transport, addr, gateway.DefaultNVMePort))
+		if err != nil {
+			return result, fmt.Errorf("NVMe discovery of %s from %s failed: %w", addr, node, err)
+		}
+		if !strings.Contains(out, nqn) {
+			return result, fmt.Errorf("subsystem %s is not offered by %s, is the gateway running?", nqn, addr)
+		}
+
+		cmd := fmt.Sprintf("sudo nvme connect -t %s -a %s -s %d -n %s", transport, addr, gateway.DefaultNVMePort, nqn)
+		if hostNQN != "" {
+			cmd += " --hostnqn " + hostNQN
+		}
+		if _, err := c.execOutput(ctx, host, cmd); err != nil {
+			return result, fmt.Errorf("failed to connect %s to %s: %w", node, nqn, err)
+		}
+	}
+
+	result.Devices = c.nvmeDevices(ctx, host, nqn)
+	return result, nil
+}
+
+// NVMeDisconnect disconnects a client node from an sds-managed NVMe-oF gateway
+func (c *Controller) NVMeDisconnect(ctx context.Context, gatewayName, node string) (*InitiatorResult, error) {
+	gw, err := c.initiatorGateway(ctx, gatewayName, database.GatewayTypeNVMEOF, "-nvme")
+	if err != nil {
+		return nil, err
+	}
+
+	nqn := gatewayConfigString(gw, "nqn")
+	if nqn == "" {
+		return nil, fmt.Errorf("gateway %s has no NQN recorded", gw.Name)
+	}
+
+	result := &InitiatorResult{Gateway: gw.Name, Node: node, Target: nqn}
+	host := c.initiatorAddress(node)
+
+	connected, err := c.nvmeConnected(ctx, host, nqn)
+	if err != nil {
+		return result, err
+	}
+	if !connected {
+		result.Warnings = append(result.Warnings, "not connected")
+		return result, nil
+	}
+
+	if _, err := c.execOutput(ctx, host, fmt.Sprintf("sudo nvme disconnect -n %s", nqn)); err != nil {
+		return result, fmt.Errorf("failed to disconnect %s from %s: %w", node, nqn, err)
+	}
+	return result, nil
+}
+
+// nvmeConnected reports whether a client node has a controller for the subsystem
+func (c *Controller) nvmeConnected(ctx context.Context, host, nqn string) (bool, error) {
+	out, err := c.execOutput(ctx, host, "cat /sys/class/nvme-subsystem/*/subsysnqn 2>/dev/null || true")
+	if err != nil {
+		return false, fmt.Errorf("failed to list NVMe subsystems: %w", err)
+	}
+	for _, line := range strings.Split(out, "\n") {
+		if strings.TrimSpace(line) == nqn {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// nvmeDevices lists the namespace block devices of a subsystem on a client node
+func (c *Controller) nvmeDevices(ctx context.Context, host, nqn string) []string {
+	cmd := fmt.Sprintf(`for s in /sys/class/nvme-subsystem/*; do grep -qx '%s' $s/subsysnqn 2>/dev/null && ls $s | grep -E '^nvme[0-9]+n[0-9]+$'; done; true`, nqn)
+	out, err := c.execOutput(ctx, host, cmd)
+	if err != nil {
+		return nil
+	}
+
+	var devices []string
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			devices = append(devices, "/dev/"+line)
+		}
+	}
+	return devices
+}
+
+// initiatorGateway looks up a gateway of the given type by name, or by the
+// name of its resource (which gateways are named after, with suffix)
+func (c *Controller) initiatorGateway(ctx context.Context, name string, gwType database.GatewayType, suffix string) (*database.Gateway, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+
+	gw, err := c.db.GetGateway(ctx, name)
+	if err != nil {
+		gw, err = c.db.GetGateway(ctx, name+suffix)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("gateway not found: %s", name)
+	}
+	if gw.Type != gwType {
+		return nil, fmt.Errorf("gateway %s is a %s gateway, not %s", gw.Name, gw.Type, gwType)
+	}
+	return gw, nil
+}
+
+// initiatorAddress resolves a client node name to its address
+func (c *Controller) initiatorAddress(node string) string {
+	if addr := c.nodes.GetNodeAddressByName(node); addr != "" {
+		return addr
+	}
+	return c.ResolveHost(node)
+}
+
+// execOutput runs a command on a single host and returns its output
+func (c *Controller) execOutput(ctx context.Context, host, cmd string) (string, error) {
+	result, err := c.deployment.Exec(ctx, []string{host}, cmd)
+	if err != nil {
+		return "", err
+	}
+
+	var output strings.Builder
+	for _, r := range result.Hosts {
+		if !r.Success {
+			return r.Output, fmt.Errorf("%s", strings.TrimSpace(r.Output))
+		}
+		output.WriteString(r.Output)
+	}
+	return output.String(), nil
+}
+
+// gatewayConfigString reads a string setting from a gateway's stored config
+func gatewayConfigString(gw *database.Gateway, key string) string {
+	if v, ok := gw.Config[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+// serviceAddress strips the prefix length from a service IP ("10.0.0.5/24")
+func serviceAddress(serviceIP string) string {
+	addr, _, _ := strings.Cut(serviceIP, "/")
+	return addr
+}
+
+// nfsMountPathPattern restricts client mount points to plain absolute paths
+var nfsMountPathPattern = regexp.MustCompile(`^/[A-Za-z0-9/_.-]+$`)
+
+// NFSMount mounts the export of an sds-managed NFS gateway on a client node
+// through a systemd mount unit, so the mount survives reboots. Hard mounts
+// (the default) block I/O while the gateway fails over, soft mounts return
+// errors once the retries are exhausted. options are appended to the defaults.
+func (c *Controller) NFSMount(ctx context.Context, gatewayName, node, mountPath string, soft bool, options string) (*InitiatorResult, error) {
+	gw, err := c.initiatorGateway(ctx, gatewayName, database.GatewayTypeNFS, "-nfs")
+	if err != nil {
+		return nil, err
+	}
+
+	mountPath = path.Clean(mountPath)
+	if !nfsMountPathPattern.MatchString(mountPath) || mountPath == "/" {
+		return nil, fmt.Errorf("invalid mount path %q", mountPath)
+	}
+	addr := serviceAddress(gatewayConfigString(gw, "service_ip"))
+	if addr == "" {
+		return nil, fmt.Errorf("gateway %s has no service IP recorded", gw.Name)
+	}
+	export := path.Join(gateway.ExportRoot(gatewayConfigString(gw, "export_base_path"), gw.Resource), gatewayConfigString(gw, "export_path"))
+
+	result := &InitiatorResult{
+		Gateway: gw.Name,
+		Node:    node,
+		Target:  fmt.Sprintf("%s:%s", addr, export),
+		Portals: []string{fmt.Sprintf("%s:%d", addr, gateway.DefaultNFSPort)},
+	}
+	host := c.initiatorAddress(node)
+
+	if _, err := c.execOutput(ctx, host, "command -v mount.nfs"); err != nil {
+		return result, fmt.Errorf("mount.nfs is not installed on %s (nfs-common or nfs-utils)", node)
+	}
+
+	out, err := c.execOutput(ctx, host, fmt.Sprintf("systemd-escape -p --suffix=mount %s", mountPath))
+	if err != nil {
+		return result, fmt.Errorf("failed to derive mount unit name on %s: %w", node, err)
+	}
+	unit := strings.TrimSpace(out)
+	result.Unit = unit
+
+	c.logger.Info("Installing NFS mount",
+		zap.String("gateway", gw.Name),
+		zap.String("node", node),
+		zap.String("export", result.Target),
+		zap.String("path", mountPath))
+
+	content := generateNFSMountUnit(gw.Name, result.Target, mountPath, nfsMountOptions(soft, options))
+	if _, err := c.execOutput(ctx, host, fmt.Sprintf("sudo mkdir -p %s", mountPath)); err != nil {
+		return result, fmt.Errorf("failed to create %s on %s: %w", mountPath, node, err)
+	}
+	if _, err := c.deployment.DistributeConfig(ctx, []string{host}, content, "/etc/systemd/system/"+unit); err != nil {
+		return result, fmt.Errorf("failed to install %s on %s: %w", unit, node, err)
+	}
+	// The unit name contains backslash escapes, quote it for the shell
+	if _, err := c.execOutput(ctx, host, fmt.Sprintf("sudo systemctl daemon-reload && sudo systemctl enable --now '%s'", unit)); err != nil {
+		return result, fmt.Errorf("failed to mount %s on %s: %w", result.Target, node, err)
+	}
+
+	if soft {
+		result.Warnings = append(result.Warnings,
+			"soft mount: applications may see I/O errors during a gateway failover")
+	}
+	return result, nil
+}
+
+// nfsMountOptions returns the mount options for a gateway export. timeo is
+// in tenths of a second; with hard mounts the client retries until the
+// service IP is back on another node and the NFSv4 grace period is over.
+func nfsMountOptions(soft bool, extra string) string {
+	options := []string{"vers=4.2", "proto=tcp", "_netdev"}
+	if soft {
+		options = append(options, "soft", "timeo=150", "retrans=6")
+	} else {
+		options = append(options, "hard", "timeo=100", "retrans=3")
+	}
+	if extra = strings.TrimSpace(extra); extra != "" {
+		options = append(options, extra)
+	}
+	return strings.Join(options, ",")
+}
+
+// generateNFSMountUnit generates the systemd mount unit of a gateway export
+func generateNFSMountUnit(gatewayName, what, where, options string) string {
+	return fmt.Sprintf(`[Unit]
+Description=SDS NFS mount of gateway %s
+After=network-online.target
+Wants=network-online.target
+
+[Mount]
+What=%s
+Where=%s
+Type=nfs
+Options=%s
+TimeoutSec=120
+
+[Install]
+WantedBy=remote-fs.target
+`, gatewayName, what, where, options)
+}
+
+// ISCSIClientConfig holds the initiator-side configuration of an iSCSI gateway
+type ISCSIClientConfig struct {
+	Gateway       string
+	IQN           string
+	Portals       []string // address:port
+	MultipathConf string   // /etc/multipath.conf snippet
+	LoginScript   string   // iscsiadm discovery and login script
+}
+
+// GetISCSIClientConfig generates the multipath.conf snippet and iscsiadm login
+// script for an sds-managed iSCSI gateway. Extra portals can be configured
+// with the gateway option "portals" (comma-separated addresses).
+func (c *Controller) GetISCSIClientConfig(ctx context.Context, gatewayName string) (*ISCSIClientConfig, error) {
+	gw, err := c.initiatorGateway(ctx, gatewayName, database.GatewayTypeISCSI, "-iscsi")
+	if err != nil {
+		return nil, err
+	}
+
+	iqn := gatewayConfigString(gw, "iqn")
+	portals := iscsiPortals(gw)
+	if iqn == "" || len(portals) == 0 {
+		return nil, fmt.Errorf("gateway %s has no IQN or service IP recorded", gw.Name)
+	}
+
+	// Same defaults as the iSCSITarget agent in the gateway's promoter config
+	username := gatewayConfigString(gw, "username")
+	if username == "" {
+		username = "username"
+	}
+	password, err := c.gatewaySecret(ctx, gw, "password")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the CHAP password of %s: %w", gw.Name, err)
+	}
+	if password == "" {
+		password = "password"
+	}
+	vendor := "LIO-ORG"
+	if impl := gatewayConfigString(gw, "implementation"); impl == "tgt" || impl == "iet" {
+		vendor = "IET"
+	}
+
+	return &ISCSIClientConfig{
+		Gateway:       gw.Name,
+		IQN:           iqn,
+		Portals:       portals,
+		MultipathConf: generateMultipathConf(gw.Name, iqn, vendor),
+		LoginScript:   generateISCSILoginScript(gw.Name, iqn, portals, username, password),
+	}, nil
+}
+
+// ValidateISCSIInitiator checks from a registered client node that the portals
+// of an iSCSI gateway are reachable and offer its target, and reports the
+// session and multipath state of the client
+func (c *Controller) ValidateISCSIInitiator(ctx context.Context, gatewayName, node string) (*InitiatorResult, error) {
+	cfg, err := c.GetISCSIClientConfig(ctx, gatewayName)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &InitiatorResult{
+		Gateway: cfg.Gateway,
+		Node:    node,
+		Target:  cfg.IQN,
+		Portals: cfg.Portals,
+	}
+	host := c.initiatorAddress(node)
+
+	if _, err := c.execOutput(ctx, host, "command -v iscsiadm"); err != nil {
+		return result, fmt.Errorf("iscsiadm is not installed on %s (open-iscsi)", node)
+	}
+
+	for _, portal := range cfg.Portals {
+		addr, port, _ := strings.Cut(portal, ":")
+		if _, err := c.execOutput(ctx, host, fmt.Sprintf("timeout 5 bash -c '</dev/tcp/%s/%s'", addr, port)); err != nil {
+			return result, fmt.Errorf("portal %s is not reachable from %s", portal, node)
+		}
+
+		out, err := c.execOutput(ctx, host, fmt.Sprintf("sudo iscsiadm -m discovery -t sendtargets -p %s", portal))
+		if err != nil {
+			return result, fmt.Errorf("discovery on portal %s from %s failed: %w", portal, node, err)
+		}
+		if !strings.Contains(out, cfg.IQN) {
+			return result, fmt.Errorf("target %s is not offered on portal %s, is the gateway running?", cfg.IQN, portal)
+		}
+	}
+
+	if out, _ := c.execOutput(ctx, host, "sudo iscsiadm -m session 2>/dev/null || true"); !strings.Contains(out, cfg.IQN) {
+		result.Warnings = append(result.Warnings, "not logged in, run the login script from 'sds client iscsi config'")
+	} else {
+		out, _ := c.execOutput(ctx, host, fmt.Sprintf("ls /dev/disk/by-path/ 2>/dev/null | grep -F '%s' || true", cfg.IQN))
+		for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				result.Devices = append(result.Devices, "/dev/disk/by-path/"+line)
+			}
+		}
+	}
+
+	if out, _ := c.execOutput(ctx, host, "systemctl is-active multipathd 2>/dev/null || true"); strings.TrimSpace(out) != "active" {
+		result.Warnings = append(result.Warnings,
+			"multipathd is not running, I/O fails instead of queueing during gateway failover")
+	}
+
+	return result, nil
+}
+
+// iscsiPortals returns the portals of an iSCSI gateway: its service IP and
+// any extra addresses of the "portals" option
+func iscsiPortals(gw *database.Gateway) []string {
+	var portals []string
+	add := func(addr string) {
+		addr = serviceAddress(strings.TrimSpace(addr))
+		if addr == "" {
+			return
+		}
+		if !strings.Contains(addr, ":") {
+			addr = fmt.Sprintf("%s:%d", addr, gateway.DefaultISCSIPort)
+		}
+		if !containsString(portals, addr) {
+			portals = append(portals, addr)
+		}
+	}
+
+	add(gatewayConfigString(gw, "service_ip"))
+	if options, ok := gw.Config["options"].(map[string]interface{}); ok {
+		if extra, ok := options["portals"].(string); ok {
+			for _, addr := range strings.Split(extra, ",") {
+				add(addr)
+			}
+		}
+	}
+	return portals
+}
+
+// generateMultipathConf generates a multipath.conf snippet that queues I/O
+// while the gateway fails over to another node instead of failing it
+func generateMultipathConf(gatewayName, iqn, vendor string) string {
+	return fmt.Sprintf(`# Generated by SDS for iSCSI gateway %s
+# Target: %s
+# Merge into /etc/multipath.conf and run: systemctl reload multipathd
+devices {
+    device {
+        vendor               "%s"
+        product              ".*"
+        path_grouping_policy "failover"
+        path_checker         "tur"
+        failback             "immediate"
+        no_path_retry        "queue"
+        fast_io_fail_tmo     5
+        dev_loss_tmo         "infinity"
+    }
+}
+`, gatewayName, iqn, vendor)
+}
+
+// generateISCSILoginScript generates an iscsiadm script that discovers and
+// logs in to a target on every portal and makes the login persistent
+func generateISCSILoginScript(gatewayName, iqn string, portals []string, username, password string) string {
+	var b strings.Builder
+
+	b.WriteString("#!/bin/sh\n")
+	fmt.Fprintf(&b, "# Generated by SDS for iSCSI gateway %s\n", gatewayName)
+	b.WriteString("set -e\n\n")
+	fmt.Fprintf(&b, "IQN=%q\n", iqn)
+	fmt.Fprintf(&b, "CHAP_USER=%q\n", username)
+	fmt.Fprintf(&b, "CHAP_PASS=%q\n", password)
+
+	for _, portal := range portals {
+		fmt.Fprintf(&b, "\n# Portal %s\n", portal)
+		fmt.Fprintf(&b, "iscsiadm -m discovery -t sendtargets -p %s\n", portal)
+		fmt.Fprintf(&b, "iscsiadm -m node -T \"$IQN\" -p %s -o update -n node.session.auth.authmethod -v CHAP\n", portal)
+		fmt.Fprintf(&b, "iscsiadm -m node -T \"$IQN\" -p %s -o update -n node.session.auth.username -v \"$CHAP_USER\"\n", portal)
+		fmt.Fprintf(&b, "iscsiadm -m node -T \"$IQN\" -p %s -o update -n node.session.auth.password -v \"$CHAP_PASS\"\n", portal)
+		fmt.Fprintf(&b, "iscsiadm -m node -T \"$IQN\" -p %s -o update -n node.session.timeo.replacement_timeout -v 15\n", portal)
+		fmt.Fprintf(&b, "iscsiadm -m node -T \"$IQN\" -p %s -o update -n node.startup -v automatic\n", portal)
+		// Exit code 15 means the session already exists
+		fmt.Fprintf(&b, "iscsiadm -m node -T \"$IQN\" -p %s --login || [ $? -eq 15 ]\n", portal)
+	}
+
+	b.WriteString("\niscsiadm -m session\n")
+	return b.String()
+}
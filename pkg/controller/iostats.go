@@ -0,0 +1,225 @@
+package controller
+
+import (
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// diskstatsSectorSize is the unit of the sector counts in /proc/diskstats
+const diskstatsSectorSize = 512
+
+// VolumeIOStats is the I/O rate of a DRBD volume on a node it is Primary on,
+// averaged over the last node poll interval
+type VolumeIOStats struct {
+	Node             string
+	Resource         string
+	Volume           int
+	ReadBytesPerSec  float64
+	WriteBytesPerSec float64
+	ReadIOPS         float64
+	WriteIOPS        float64
+	ReadLatencyMs    float64 // Average time per completed read
+	WriteLatencyMs   float64 // Average time per completed write
+	CollectedAt      time.Time
+}
+
+// ioSample is a sample of the /proc/diskstats counters of a DRBD device
+type ioSample struct {
+	reads, readSectors, readMs    uint64
+	writes, writeSectors, writeMs uint64
+	at                            time.Time
+}
+
+// ioKey identifies a DRBD volume on a node
+func ioKey(node, resource string, volume int) string {
+	return node + "/" + resource + "/" + strconv.Itoa(volume)
+}
+
+// updateIOStats computes the I/O rates of the DRBD volumes of a node from the
+// output of nodeCapacityCmd and the sample of the previous poll. Only volumes
+// of resources that are Primary on the node get rates, the upper layer I/O of
+// a Secondary is always zero.
+func (c *Controller) updateIOStats(node, output string) {
+	now := time.Now()
+	samples, primaries := parseIOSamples(output, now)
+
+	c.ioMu.Lock()
+	defer c.ioMu.Unlock()
+	if c.ioSamples == nil {
+		c.ioSamples = make(map[string]*ioSample)
+		c.ioStats = make(map[string]*VolumeIOStats)
+	}
+	last := make(map[string]*ioSample)
+	for key, sample := range c.ioSamples {
+		if strings.HasPrefix(key, node+"/") {
+			last[key] = sample
+		}
+	}
+	c.dropIOStatsLocked(node)
+
+	for vol, sample := range samples {
+		key := ioKey(node, vol.resource, vol.volume)
+		c.ioSamples[key] = sample
+		if last[key] == nil || !primaries[vol.resource] {
+			continue
+		}
+		if stats := ioRates(last[key], sample); stats != nil {
+			stats.Node, stats.Resource, stats.Volume = node, vol.resource, vol.volume
+			c.ioStats[key] = stats
+		}
+	}
+}
+
+// dropIOStats forgets the I/O samples and rates of a node, e.g. when it
+// cannot be polled
+func (c *Controller) dropIOStats(node string) {
+	c.ioMu.Lock()
+	defer c.ioMu.Unlock()
+	c.dropIOStatsLocked(node)
+}
+
+func (c *Controller) dropIOStatsLocked(node string) {
+	for key, stats := range c.ioStats {
+		if stats.Node == node {
+			delete(c.ioStats, key)
+		}
+	}
+	for key := range c.ioSamples {
+		if strings.HasPrefix(key, node+"/") {
+			delete(c.ioSamples, key)
+		}
+	}
+}
+
+// ResourceIOStats returns the last I/O rates of the volumes of a resource,
+// or of all resources for "", ordered by resource, volume and node
+func (c *Controller) ResourceIOStats(resource string) []*VolumeIOStats {
+	c.ioMu.RLock()
+	defer c.ioMu.RUnlock()
+
+	var result []*VolumeIOStats
+	for _, stats := range c.ioStats {
+		if resource == "" || stats.Resource == resource {
+			copied := *stats
+			result = append(result, &copied)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Resource != result[j].Resource {
+			return result[i].Resource < result[j].Resource
+		}
+		if result[i].Volume != result[j].Volume {
+			return result[i].Volume < result[j].Volume
+		}
+		return result[i].Node < result[j].Node
+	})
+	return result
+}
+
+// ioRates computes the rates between two samples of a device, nil if the
+// counters went back because the device was recreated
+func ioRates(previous, current *ioSample) *VolumeIOStats {
+	if current.reads < previous.reads || current.writes < previous.writes ||
+		current.readSectors < previous.readSectors || current.writeSectors < previous.writeSectors ||
+		current.readMs < previous.readMs || current.writeMs < previous.writeMs {
+		return nil
+	}
+	seconds := current.at.Sub(previous.at).Seconds()
+	if seconds <= 0 {
+		return nil
+	}
+
+	reads := current.reads - previous.reads
+	writes := current.writes - previous.writes
+	stats := &VolumeIOStats{
+		ReadBytesPerSec:  float64((current.readSectors-previous.readSectors)*diskstatsSectorSize) / seconds,
+		WriteBytesPerSec: float64((current.writeSectors-previous.writeSectors)*diskstatsSectorSize) / seconds,
+		ReadIOPS:         float64(reads) / seconds,
+		WriteIOPS:        float64(writes) / seconds,
+		CollectedAt:      current.at,
+	}
+	if reads > 0 {
+		stats.ReadLatencyMs = float64(current.readMs-previous.readMs) / float64(reads)
+	}
+	if writes > 0 {
+		stats.WriteLatencyMs = float64(current.writeMs-previous.writeMs) / float64(writes)
+	}
+	return stats
+}
+
+// ioVolume identifies a DRBD volume on a node
+type ioVolume struct {
+	resource string
+	volume   int
+}
+
+// parseIOSamples parses the drbdvol, diskstats and primaryres sections of
+// the output of nodeCapacityCmd into the counters of each volume and the
+// resources that are Primary on the node
+func parseIOSamples(output string, at time.Time) (map[ioVolume]*ioSample, map[string]bool) {
+	devices := make(map[string]ioVolume) // drbd1000 -> volume
+	counters := make(map[string]*ioSample)
+	primaries := make(map[string]bool)
+
+	section := ""
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			section = strings.TrimPrefix(line, "#")
+			continue
+		}
+		fields := strings.Fields(line)
+
+		switch section {
+		case "drbdvol":
+			// "/dev/drbd/by-res/r0/0 /dev/drbd1000"
+			if len(fields) < 2 {
+				continue
+			}
+			volume, err := strconv.Atoi(path.Base(fields[0]))
+			if err != nil {
+				continue
+			}
+			devices[path.Base(fields[1])] = ioVolume{resource: path.Base(path.Dir(fields[0])), volume: volume}
+		case "diskstats":
+			// major minor name reads merged sectors ms writes merged sectors ms ...
+			if len(fields) < 11 {
+				continue
+			}
+			var values [8]uint64
+			valid := true
+			for i := range values {
+				v, err := strconv.ParseUint(fields[3+i], 10, 64)
+				if err != nil {
+					valid = false
+					break
+				}
+				values[i] = v
+			}
+			if !valid {
+				continue
+			}
+			counters[fields[2]] = &ioSample{
+				reads: values[0], readSectors: values[2], readMs: values[3],
+				writes: values[4], writeSectors: values[6], writeMs: values[7],
+				at: at,
+			}
+		case "primaryres":
+			primaries[line] = true
+		}
+	}
+
+	samples := make(map[ioVolume]*ioSample)
+	for device, vol := range devices {
+		if sample := counters[device]; sample != nil {
+			samples[vol] = sample
+		}
+	}
+	return samples, primaries
+}
@@ -0,0 +1,225 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"github.com/liliang-cn/sds/pkg/deployment"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Job states
+const (
+	JobRunning   = "running"
+	JobSucceeded = "succeeded"
+	JobFailed    = "failed"
+	JobCancelled = "cancelled"
+	// The controller stopped while the job was running
+	JobInterrupted = "interrupted"
+)
+
+// defaultJobRetention applies when no retention is configured
+const defaultJobRetention = 1000
+
+// resultResponse is implemented by responses with success and message fields
+type resultResponse interface {
+	GetSuccess() bool
+	GetMessage() string
+}
+
+// jobRecorder collects the remote commands of a running job
+type jobRecorder struct {
+	mu    sync.Mutex
+	steps []*database.JobStep
+	slow  time.Duration
+	job   *database.Job
+	log   *zap.Logger
+	// Watchers of the job's progress, if any, and the node names of hosts
+	progress *progressFeed
+	names    map[string]string
+}
+
+// TraceCommand records a remote command as a step of the job
+func (r *jobRecorder) TraceCommand(t *deployment.CommandTrace) {
+	step := &database.JobStep{
+		Name:      t.Step,
+		Node:      t.Host,
+		Command:   t.Command,
+		StartedAt: t.StartedAt,
+		Duration:  t.Duration,
+		Success:   t.Success,
+		ExitCode:  t.ExitCode,
+		Output:    t.Output,
+	}
+	if r.slow > 0 && t.Duration >= r.slow {
+		step.Slow = true
+		r.log.Warn("Slow remote command",
+			zap.Int64("job", r.job.ID),
+			zap.String("operation", r.job.Operation),
+			zap.String("step", t.Step),
+			zap.String("host", t.Host),
+			zap.Duration("duration", t.Duration))
+	}
+
+	r.mu.Lock()
+	r.steps = append(r.steps, step)
+	r.mu.Unlock()
+
+	if r.progress != nil {
+		node := t.Host
+		if name, ok := r.names[t.Host]; ok {
+			node = name
+		}
+		event := &JobProgress{Kind: ProgressCommand, JobID: r.job.ID, Step: t.Step, Node: node, Success: t.Success, Duration: t.Duration}
+		if !t.Success {
+			event.Message = t.Output
+		}
+		r.progress.publish(event)
+	}
+}
+
+// jobInterceptor records every mutating RPC as a job together with the
+// timing of the remote commands it runs
+func (c *Controller) jobInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method := path.Base(info.FullMethod)
+		if c.db == nil || readOnlyRequest(method, req) {
+			return handler(ctx, req)
+		}
+
+		// The request is journaled with the job, to resume it after a crash
+		job := &database.Job{
+			Operation: method,
+			Target:    requestTarget(req),
+			State:     JobRunning,
+			StartedAt: time.Now(),
+			Request:   journalRequest(req),
+		}
+		if err := c.db.SaveJob(ctx, job); err != nil {
+			c.logger.Warn("Failed to record job", zap.String("operation", method), zap.Error(err))
+			return handler(ctx, req)
+		}
+		if res, ok := ctx.Value(resolutionKey{}).(*jobResolution); ok && res.job == nil {
+			res.job = job
+		}
+
+		recorder := &jobRecorder{slow: c.config.Jobs.SlowCommand, job: job, log: c.logger}
+		feed, progressID := c.startProgress(ctx)
+		if feed != nil {
+			recorder.progress = feed
+			recorder.names = c.nodeNamesByAddress(ctx)
+		}
+		jobCtx := context.WithValue(deployment.WithTracer(ctx, recorder), journalKey{}, recorder)
+		resp, err := handler(jobCtx, req)
+
+		job.FinishedAt = time.Now()
+		switch {
+		case errors.Is(ctx.Err(), context.Canceled):
+			job.State = JobCancelled
+			job.Message = "cancelled by the caller"
+		case err != nil:
+			job.State = JobFailed
+			job.Message = status.Convert(err).Message()
+		default:
+			job.State = JobSucceeded
+			if r, ok := resp.(resultResponse); ok {
+				if !r.GetSuccess() {
+					job.State = JobFailed
+				}
+				job.Message = r.GetMessage()
+			}
+		}
+		c.finishJob(job, recorder)
+		c.finishProgress(feed, progressID, job)
+
+		return resp, err
+	}
+}
+
+// finishJob saves a finished job with its steps in start order and the
+// node names of their hosts, and drops the oldest jobs beyond the retention
+func (c *Controller) finishJob(job *database.Job, recorder *jobRecorder) {
+	// The request context may be done already
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	recorder.mu.Lock()
+	job.Steps = recorder.steps
+	// A finished job is not resumed
+	job.Request = ""
+	recorder.mu.Unlock()
+	sort.SliceStable(job.Steps, func(i, j int) bool {
+		return job.Steps[i].StartedAt.Before(job.Steps[j].StartedAt)
+	})
+
+	names := c.nodeNamesByAddress(ctx)
+	for _, step := range job.Steps {
+		if name, ok := names[step.Node]; ok {
+			step.Node = name
+		}
+	}
+
+	if err := c.db.SaveJob(ctx, job); err != nil {
+		c.logger.Warn("Failed to save job", zap.Int64("job", job.ID), zap.Error(err))
+		return
+	}
+
+	retention := c.config.Jobs.Retention
+	if retention < 1 {
+		retention = defaultJobRetention
+	}
+	if err := c.db.PruneJobs(ctx, retention); err != nil {
+		c.logger.Warn("Failed to prune jobs", zap.Error(err))
+	}
+}
+
+// GetJob returns a job with its steps
+func (c *Controller) GetJob(ctx context.Context, id int64) (*database.Job, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	job, err := c.db.GetJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !c.jobVisible(ctx, job) {
+		return nil, fmt.Errorf("job not found: %d", id)
+	}
+	return job, nil
+}
+
+// ListJobs lists jobs newest first, optionally filtered by target and state.
+// The jobs of other principals' resources and of the admin's RPCs are left
+// out.
+func (c *Controller) ListJobs(ctx context.Context, target, state string, limit int) ([]*database.Job, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	if state == "" && !restrictedCaller(ctx) {
+		return c.db.ListJobs(ctx, target, limit)
+	}
+
+	jobs, err := c.db.ListJobs(ctx, target, 0)
+	if err != nil {
+		return nil, err
+	}
+	var matching []*database.Job
+	for _, job := range jobs {
+		if state != "" && job.State != state || !c.jobVisible(ctx, job) {
+			continue
+		}
+		matching = append(matching, job)
+		if limit > 0 && len(matching) >= limit {
+			break
+		}
+	}
+	return matching, nil
+}
@@ -0,0 +1,250 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	sdspb "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Resolutions of interrupted jobs
+const (
+	JobResumed    = "resumed"
+	JobRolledBack = "rolled back"
+)
+
+// journalKey carries the recorder of the running job in a context
+type journalKey struct{}
+
+// resolutionKey carries a jobResolution in a context
+type resolutionKey struct{}
+
+// jobResolution captures the job that resumes or rolls back an interrupted job
+type jobResolution struct {
+	job *database.Job // Set by the job interceptor
+}
+
+// journalRequest encodes a request for the job journal. Requests that carry
+// credentials are not journaled, their jobs cannot be resumed.
+func journalRequest(req interface{}) string {
+	msg, ok := req.(proto.Message)
+	if !ok || carriesCredentials(msg) {
+		return ""
+	}
+	wrapped, err := anypb.New(msg)
+	if err != nil {
+		return ""
+	}
+	data, err := protojson.Marshal(wrapped)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// carriesCredentials reports whether a request sets a password, secret or
+// token field
+func carriesCredentials(msg proto.Message) bool {
+	found := false
+	msg.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		name := string(fd.Name())
+		found = strings.Contains(name, "password") || strings.Contains(name, "secret") || strings.Contains(name, "token")
+		return !found
+	})
+	return found
+}
+
+// decodeJournalRequest decodes a journaled request
+func decodeJournalRequest(data string) (proto.Message, error) {
+	var wrapped anypb.Any
+	if err := protojson.Unmarshal([]byte(data), &wrapped); err != nil {
+		return nil, fmt.Errorf("failed to decode journaled request: %w", err)
+	}
+	return wrapped.UnmarshalNew()
+}
+
+// journalStep records in the job of ctx that it enters an orchestration
+// step, together with the commands it ran so far, before the step runs
+func (c *Controller) journalStep(ctx context.Context, step string) {
+	recorder, ok := ctx.Value(journalKey{}).(*jobRecorder)
+	if !ok || c.db == nil {
+		return
+	}
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	if recorder.job.Step == step && len(recorder.job.Steps) == len(recorder.steps) {
+		return
+	}
+	if recorder.job.Step != step {
+		recorder.progress.publish(&JobProgress{Kind: ProgressStep, JobID: recorder.job.ID, Step: step, Label: stepLabel(step)})
+	}
+	recorder.job.Step = step
+	recorder.job.Steps = append([]*database.JobStep(nil), recorder.steps...)
+	if err := c.db.SaveJob(ctx, recorder.job); err != nil {
+		c.logger.Warn("Failed to journal job step",
+			zap.Int64("job", recorder.job.ID),
+			zap.String("step", step),
+			zap.Error(err))
+	}
+}
+
+// markInterruptedJobs marks the jobs a previous controller process left
+// running as interrupted. The cluster may be anywhere between the step the
+// job journaled last and the next one, until the job is resumed or rolled
+// back.
+func (c *Controller) markInterruptedJobs(ctx context.Context) {
+	jobs, err := c.db.ListJobs(ctx, "", 0)
+	if err != nil {
+		c.logger.Warn("Failed to check for interrupted jobs", zap.Error(err))
+		return
+	}
+
+	for _, job := range jobs {
+		if job.State != JobRunning {
+			continue
+		}
+		job.State = JobInterrupted
+		job.FinishedAt = time.Now()
+		job.Message = "controller stopped before the operation finished"
+		if job.Step != "" {
+			job.Message = fmt.Sprintf("controller stopped during step %s", job.Step)
+		}
+		if err := c.db.SaveJob(ctx, job); err != nil {
+			c.logger.Warn("Failed to mark job interrupted", zap.Int64("job", job.ID), zap.Error(err))
+			continue
+		}
+
+		c.logger.Warn("Operation was interrupted, resume or roll it back",
+			zap.Int64("job", job.ID),
+			zap.String("operation", job.Operation),
+			zap.String("target", job.Target),
+			zap.String("step", job.Step))
+		c.RecordEvent(ctx, EventJobInterrupted, job.Target,
+			fmt.Sprintf("Job %d (%s %s) was interrupted: %s", job.ID, job.Operation, job.Target, job.Message),
+			map[string]string{
+				"job":       fmt.Sprintf("%d", job.ID),
+				"operation": job.Operation,
+				"step":      job.Step,
+			})
+	}
+}
+
+// interruptedJob returns an interrupted job that was not resolved yet
+func (c *Controller) interruptedJob(ctx context.Context, id int64) (*database.Job, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	job, err := c.db.GetJob(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("job %d not found", id)
+	}
+	if job.State != JobInterrupted {
+		return nil, fmt.Errorf("job %d is %s, only interrupted jobs can be resumed or rolled back", id, job.State)
+	}
+	if job.Resolution != "" {
+		return nil, fmt.Errorf("job %d was already %s by job %d", id, job.Resolution, job.ResolvedBy)
+	}
+	return job, nil
+}
+
+// ResumeJob runs the journaled request of an interrupted job again, as a new
+// job. Operations check the state they find; one that fails on what the
+// interrupted run left behind is rolled back instead.
+func (c *Controller) ResumeJob(ctx context.Context, id int64) (*database.Job, error) {
+	job, err := c.interruptedJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.Request == "" {
+		return nil, fmt.Errorf("job %d has no journaled request (it carried credentials), run %s again", id, job.Operation)
+	}
+	req, err := decodeJournalRequest(job.Request)
+	if err != nil {
+		return nil, err
+	}
+	return c.resolveJob(ctx, job, JobResumed, job.Operation, req)
+}
+
+// RollbackJob undoes what an interrupted job left behind by running the
+// operation that removes it, as a new job
+func (c *Controller) RollbackJob(ctx context.Context, id int64) (*database.Job, error) {
+	job, err := c.interruptedJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	method, req := jobRollback(job)
+	if req == nil {
+		return nil, fmt.Errorf("%s cannot be rolled back, resume job %d or check the cluster with sds drift", job.Operation, id)
+	}
+	return c.resolveJob(ctx, job, JobRolledBack, method, req)
+}
+
+// jobRollback returns the operation that undoes an interrupted job, none if
+// there is no such operation. Creations are undone by deleting the object.
+func jobRollback(job *database.Job) (string, proto.Message) {
+	var req proto.Message
+	if job.Request != "" {
+		req, _ = decodeJournalRequest(job.Request)
+	}
+
+	switch job.Operation {
+	case "CreateResource":
+		return "DeleteResource", &sdspb.DeleteResourceRequest{Name: job.Target, NoSafetySnapshot: true}
+	case "CreateNFSGateway", "CreateISCSIGateway", "CreateNVMeGateway":
+		return "DeleteGateway", &sdspb.DeleteGatewayRequest{Id: job.Target}
+	case "CreatePool":
+		if r, ok := req.(*sdspb.CreatePoolRequest); ok {
+			return "DeletePool", &sdspb.DeletePoolRequest{Name: r.Name, Node: r.Node}
+		}
+	case "CreateSnapshot":
+		if r, ok := req.(*sdspb.CreateSnapshotRequest); ok {
+			return "DeleteSnapshot", &sdspb.DeleteSnapshotRequest{Volume: r.Volume, SnapshotName: r.SnapshotName, Node: r.Node}
+		}
+	}
+	return "", nil
+}
+
+// resolveJob runs an operation for an interrupted job through the
+// interceptors, so it is recorded as a job of its own, and marks the
+// interrupted job resolved once the operation succeeded
+func (c *Controller) resolveJob(ctx context.Context, job *database.Job, resolution, method string, req proto.Message) (*database.Job, error) {
+	res := &jobResolution{}
+	info := &grpc.UnaryServerInfo{FullMethod: "/" + sdspb.SDSController_ServiceDesc.ServiceName + "/" + method}
+	resp, err := c.serveUnary(context.WithValue(ctx, resolutionKey{}, res), req, info)
+	if err == nil {
+		if r, ok := resp.(resultResponse); ok && !r.GetSuccess() {
+			err = fmt.Errorf("%s", r.GetMessage())
+		}
+	}
+	if err != nil {
+		return res.job, fmt.Errorf("%s failed: %w", method, err)
+	}
+
+	if res.job != nil {
+		job.ResolvedBy = res.job.ID
+	}
+	job.Resolution = resolution
+	if err := c.db.SaveJob(ctx, job); err != nil {
+		return res.job, fmt.Errorf("failed to save job: %w", err)
+	}
+
+	c.RecordEvent(ctx, EventJobResolved, job.Target,
+		fmt.Sprintf("Interrupted job %d (%s %s) %s by job %d", job.ID, job.Operation, job.Target, resolution, job.ResolvedBy),
+		map[string]string{
+			"job":         fmt.Sprintf("%d", job.ID),
+			"operation":   job.Operation,
+			"resolution":  resolution,
+			"resolved_by": fmt.Sprintf("%d", job.ResolvedBy),
+		})
+	return res.job, nil
+}
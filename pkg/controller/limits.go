@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"context"
+	"net"
+	"path"
+	"time"
+
+	"golang.org/x/net/netutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+// serverOptions returns the limits of the gRPC server from the server config:
+// message sizes, concurrent RPCs per connection and the keepalive policy.
+// Clients that ping too often are disconnected by the enforcement policy.
+func (c *Controller) serverOptions() []grpc.ServerOption {
+	cfg := c.config.Server
+	opts := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(cfg.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(cfg.MaxSendMsgSize),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             cfg.KeepaliveMinTime,
+			PermitWithoutStream: cfg.KeepalivePermitWithoutStream,
+		}),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle: cfg.MaxConnectionIdle,
+			Time:              cfg.KeepaliveTime,
+			Timeout:           cfg.KeepaliveTimeout,
+		}),
+	}
+	if cfg.MaxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(cfg.MaxConcurrentStreams))
+	}
+	return opts
+}
+
+// limitListener caps the open connections of the gRPC listener, further
+// connections wait in the accept queue until one is closed
+func (c *Controller) limitListener(lis net.Listener) net.Listener {
+	if c.config.Server.MaxConnections <= 0 {
+		return lis
+	}
+	return netutil.LimitListener(lis, c.config.Server.MaxConnections)
+}
+
+// gatewayKeepalive returns the keepalive ping interval of the REST gateway's
+// connection to the gRPC server, within the server's enforcement policy
+func (c *Controller) gatewayKeepalive() time.Duration {
+	if minTime := c.config.Server.KeepaliveMinTime; minTime > 10*time.Second {
+		return minTime
+	}
+	return 10 * time.Second
+}
+
+// deadlineInterceptor enforces the configured server-side deadline of an
+// RPC: read_timeout for read-only requests, write_timeout for the others.
+// An earlier deadline of the client is kept.
+func (c *Controller) deadlineInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		timeout := c.config.Server.WriteTimeout
+		if readOnlyRequest(path.Base(info.FullMethod), req) {
+			timeout = c.config.Server.ReadTimeout
+		}
+		if timeout <= 0 {
+			return handler(ctx, req)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
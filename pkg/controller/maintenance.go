@@ -0,0 +1,191 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// SetMaintenanceWindow puts a node under maintenance from from to to,
+// replacing a window set before. A zero from starts the window now. During
+// the window the node is ranked last for the Primaries of HA resources, the
+// rebalancer and targeted failovers do not move Primaries to it, and auto-heal
+// and the report leave its problems alone. The window expires by itself.
+func (c *Controller) SetMaintenanceWindow(ctx context.Context, node string, from, to time.Time, reason string) (*database.MaintenanceWindow, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	if c.nodes.GetNodeAddressByName(node) == "" {
+		return nil, fmt.Errorf("node %s is not registered", node)
+	}
+	now := time.Now()
+	if from.IsZero() {
+		from = now
+	}
+	if !to.After(from) {
+		return nil, fmt.Errorf("maintenance window must end after it starts")
+	}
+	if !to.After(now) {
+		return nil, fmt.Errorf("maintenance window ends in the past")
+	}
+
+	window := &database.MaintenanceWindow{Node: node, From: from, To: to, Reason: reason}
+	previous, err := c.db.GetMaintenanceWindow(ctx, node)
+	if err == nil {
+		// A window that already started stays started unless it is moved
+		// into the future
+		window.Started = previous.Started && !from.After(now)
+		window.CreatedAt = previous.CreatedAt
+	}
+	if err := c.db.SaveMaintenanceWindow(ctx, window); err != nil {
+		return nil, fmt.Errorf("failed to save maintenance window: %w", err)
+	}
+	if previous != nil && previous.Started && !window.Started {
+		c.endMaintenance(ctx, previous, "rescheduled")
+	}
+
+	c.logger.Info("Maintenance window set",
+		zap.String("node", node),
+		zap.Time("from", from),
+		zap.Time("to", to),
+		zap.String("reason", reason))
+
+	c.updateMaintenance(ctx)
+	return window, nil
+}
+
+// ClearMaintenanceWindow ends the maintenance window of a node early or
+// cancels one that has not started
+func (c *Controller) ClearMaintenanceWindow(ctx context.Context, node string) error {
+	if c.db == nil {
+		return fmt.Errorf("database not available")
+	}
+	window, err := c.db.GetMaintenanceWindow(ctx, node)
+	if err != nil {
+		return fmt.Errorf("node %s has no maintenance window", node)
+	}
+	if err := c.db.DeleteMaintenanceWindow(ctx, node); err != nil {
+		return fmt.Errorf("failed to delete maintenance window: %w", err)
+	}
+	if window.Started {
+		c.endMaintenance(ctx, window, "cleared")
+	}
+	return nil
+}
+
+// ListMaintenanceWindows returns the maintenance windows that have not
+// expired, ordered by node
+func (c *Controller) ListMaintenanceWindows(ctx context.Context) ([]*database.MaintenanceWindow, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	windows, err := c.db.ListMaintenanceWindows(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance windows: %w", err)
+	}
+	now := time.Now()
+	var current []*database.MaintenanceWindow
+	for _, window := range windows {
+		if window.To.After(now) {
+			current = append(current, window)
+		}
+	}
+	sort.Slice(current, func(i, j int) bool { return current[i].Node < current[j].Node })
+	return current, nil
+}
+
+// maintenanceNodes returns the windows of the nodes under maintenance now,
+// by node name
+func (c *Controller) maintenanceNodes(ctx context.Context) map[string]*database.MaintenanceWindow {
+	active := make(map[string]*database.MaintenanceWindow)
+	if c.db == nil {
+		return active
+	}
+	windows, err := c.db.ListMaintenanceWindows(ctx)
+	if err != nil {
+		c.logger.Debug("Failed to list maintenance windows", zap.Error(err))
+		return active
+	}
+	now := time.Now()
+	for _, window := range windows {
+		if !now.Before(window.From) && now.Before(window.To) {
+			active[window.Node] = window
+		}
+	}
+	return active
+}
+
+// updateMaintenance acts on maintenance windows that started or expired
+// since the last call: expired windows are deleted, and the promoter configs
+// of the HA resources on the node are rewritten either way so its rank
+// follows the window
+func (c *Controller) updateMaintenance(ctx context.Context) {
+	if c.db == nil {
+		return
+	}
+	windows, err := c.db.ListMaintenanceWindows(ctx)
+	if err != nil {
+		c.logger.Debug("Failed to list maintenance windows", zap.Error(err))
+		return
+	}
+
+	now := time.Now()
+	for _, window := range windows {
+		switch {
+		case !now.Before(window.To):
+			if err := c.db.DeleteMaintenanceWindow(ctx, window.Node); err != nil {
+				c.logger.Warn("Failed to delete expired maintenance window", zap.String("node", window.Node), zap.Error(err))
+				continue
+			}
+			if window.Started {
+				c.endMaintenance(ctx, window, "expired")
+			}
+
+		case !window.Started && !now.Before(window.From):
+			window.Started = true
+			if err := c.db.SaveMaintenanceWindow(ctx, window); err != nil {
+				c.logger.Warn("Failed to save maintenance window", zap.String("node", window.Node), zap.Error(err))
+				continue
+			}
+			message := fmt.Sprintf("Node %s under maintenance until %s", window.Node, window.To.Format(time.RFC3339))
+			if window.Reason != "" {
+				message += ": " + window.Reason
+			}
+			c.RecordEvent(ctx, EventMaintenanceStart, "", message, map[string]string{
+				"node":   window.Node,
+				"until":  window.To.Format(time.RFC3339),
+				"reason": window.Reason,
+			})
+			c.resources.refreshPromoterConfigs(ctx, c.nodeResources(ctx, window.Node)...)
+		}
+	}
+}
+
+// endMaintenance records the end of a maintenance window and puts the node
+// back into the placement order
+func (c *Controller) endMaintenance(ctx context.Context, window *database.MaintenanceWindow, how string) {
+	c.RecordEvent(ctx, EventMaintenanceEnd, "", fmt.Sprintf("Maintenance window of node %s %s", window.Node, how),
+		map[string]string{"node": window.Node})
+	c.resources.refreshPromoterConfigs(ctx, c.nodeResources(ctx, window.Node)...)
+}
+
+// nodeResources returns the resources replicated on a node
+func (c *Controller) nodeResources(ctx context.Context, node string) []string {
+	resources, err := c.db.ListResources(ctx)
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, res := range resources {
+		if res.Nodes != "" && containsString(strings.Split(res.Nodes, ","), node) {
+			names = append(names, res.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
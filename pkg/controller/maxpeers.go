@@ -0,0 +1,276 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// maxDrbdPeers is the highest number of peers DRBD 9 metadata can hold
+const maxDrbdPeers = 31
+
+// defaultMaxPeersSyncTimeout bounds the resync of a node after its metadata
+// was recreated
+const defaultMaxPeersSyncTimeout = 30 * time.Minute
+
+// MaxPeersResult is the outcome of changing the peer slots of a resource
+type MaxPeersResult struct {
+	Converted []string // Nodes whose metadata was recreated
+	Pending   []string // Nodes still left with the previous peer slots
+}
+
+// validateMaxPeers checks the peer slots requested for a resource replicated
+// on nodes, 0 sizes them for the nodes
+func validateMaxPeers(maxPeers, nodes int) (int, error) {
+	needed := max(nodes-1, 1)
+	if maxPeers == 0 {
+		return needed, nil
+	}
+	if maxPeers < needed {
+		return 0, fmt.Errorf("max peers %d is too small for %d nodes, at least %d are needed", maxPeers, nodes, needed)
+	}
+	if maxPeers > maxDrbdPeers {
+		return 0, fmt.Errorf("max peers %d exceeds the DRBD limit of %d", maxPeers, maxDrbdPeers)
+	}
+	return maxPeers, nil
+}
+
+// resourceMaxPeers returns the peer slots of a resource's metadata. Resources
+// created before the slots were recorded have one per peer.
+func resourceMaxPeers(res *database.Resource) int {
+	if res.MaxPeers > 0 {
+		return res.MaxPeers
+	}
+	nodes := res.Replicas
+	if res.Nodes != "" {
+		nodes = len(strings.Split(res.Nodes, ","))
+	}
+	return max(nodes-1, 1)
+}
+
+// drbdMetadataBytes returns the size of internal DRBD metadata for a device
+// of size bytes with maxPeers bitmaps: one bit per 4KiB per peer plus the
+// superblock and the activity log, 4KiB aligned
+func drbdMetadataBytes(size uint64, maxPeers int) uint64 {
+	const align = 4096
+	bitmap := (size/align + 7) / 8
+	bitmap = (bitmap + align - 1) / align * align
+	return uint64(maxPeers)*bitmap + 36*1024
+}
+
+// SetMaxPeers changes the peer slots of a resource's metadata so that nodes
+// can be added later. The metadata is recreated one Secondary at a time while
+// all other nodes are UpToDate: the backing device is grown for the larger
+// bitmaps where needed, the node is taken down, its metadata recreated and
+// brought up again, and it resyncs completely from its peers before the next
+// node is converted. The Primary is left pending; after a failover the
+// command continues with the pending nodes.
+func (rm *ResourceManager) SetMaxPeers(ctx context.Context, resource string, maxPeers int, timeout time.Duration) (*MaxPeersResult, error) {
+	if rm.deployment == nil {
+		return nil, fmt.Errorf("deployment client not set")
+	}
+	if rm.controller.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	if timeout <= 0 {
+		timeout = defaultMaxPeersSyncTimeout
+	}
+
+	res, err := rm.controller.db.GetResource(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("resource not found: %s", resource)
+	}
+	nodes, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	if maxPeers < 1 {
+		return nil, fmt.Errorf("max peers must be at least 1")
+	}
+	if _, err := validateMaxPeers(maxPeers, len(nodes)); err != nil {
+		return nil, err
+	}
+
+	// A new target starts over, the same one continues with the pending nodes
+	pending := res.MaxPeersPending
+	if res.MaxPeers != maxPeers || len(pending) == 0 {
+		if resourceMaxPeers(res) == maxPeers && len(pending) == 0 {
+			return nil, fmt.Errorf("resource %s already has %d peer slots", resource, maxPeers)
+		}
+		pending = append([]string(nil), nodes...)
+		sort.Strings(pending)
+	}
+	res.MaxPeers = maxPeers
+	res.MaxPeersPending = pending
+	if err := rm.controller.db.SaveResource(ctx, res); err != nil {
+		return nil, fmt.Errorf("failed to save resource: %w", err)
+	}
+
+	volumes, err := rm.controller.db.ListVolumes(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volumes: %w", err)
+	}
+
+	result := &MaxPeersResult{}
+	primary := rm.primaryNode(ctx, resource)
+	for _, node := range pending {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if node == primary {
+			rm.controller.logger.Info("Leaving Primary pending, fail over to convert it",
+				zap.String("resource", resource),
+				zap.String("node", node))
+			result.Pending = append(result.Pending, node)
+			continue
+		}
+
+		if err := rm.convertMaxPeers(ctx, res, node, nodes, volumes, timeout); err != nil {
+			return nil, fmt.Errorf("failed to convert %s: %w", node, err)
+		}
+		result.Converted = append(result.Converted, node)
+
+		res.MaxPeersPending = removeString(res.MaxPeersPending, node)
+		if err := rm.controller.db.SaveResource(ctx, res); err != nil {
+			return nil, fmt.Errorf("failed to save resource: %w", err)
+		}
+	}
+
+	message := fmt.Sprintf("Peer slots of %s set to %d on %s", resource, maxPeers, strings.Join(result.Converted, ", "))
+	if len(result.Pending) > 0 {
+		message += fmt.Sprintf(", pending on %s", strings.Join(result.Pending, ", "))
+	}
+	rm.controller.RecordEvent(ctx, EventMaxPeersChanged, resource, message,
+		map[string]string{
+			"max_peers": strconv.Itoa(maxPeers),
+			"converted": strings.Join(result.Converted, ","),
+			"pending":   strings.Join(result.Pending, ","),
+		})
+
+	return result, nil
+}
+
+// convertMaxPeers recreates the metadata of a resource on one Secondary node
+// with res.MaxPeers peer slots and waits for the node to resync
+func (rm *ResourceManager) convertMaxPeers(ctx context.Context, res *database.Resource, node string, nodes []string, volumes []*database.Volume, timeout time.Duration) error {
+	// The node resyncs from its peers, the others must be complete
+	if err := rm.checkPeersUpToDate(ctx, res.Name, node, nodes); err != nil {
+		return err
+	}
+
+	address := rm.nodeAddress(node)
+	for _, vol := range volumes {
+		if err := rm.growForMetadata(ctx, res, node, address, vol); err != nil {
+			return err
+		}
+	}
+
+	rm.controller.logger.Info("Recreating DRBD metadata",
+		zap.String("resource", res.Name),
+		zap.String("node", node),
+		zap.Int("max_peers", res.MaxPeers))
+
+	if _, err := rm.controller.execOutput(ctx, address, fmt.Sprintf("sudo drbdadm down %s", res.Name)); err != nil {
+		return fmt.Errorf("failed to take down resource: %w", err)
+	}
+	mdResult, mdErr := rm.deployment.DRBDCreateMD(rm.controller.stepContext(ctx, StepMetadata), []string{address}, res.Name, res.MaxPeers)
+	if mdErr == nil && !mdResult.AllSuccess() {
+		mdErr = fmt.Errorf("drbdadm create-md failed: %v", mdResult.FailedHosts())
+	}
+	// Bring the node back in any case, a failed create-md leaves the old
+	// metadata in place
+	if _, err := rm.controller.execOutput(rm.controller.stepContext(ctx, StepDrbdUp), address, fmt.Sprintf("sudo drbdadm up %s", res.Name)); err != nil {
+		return fmt.Errorf("failed to bring up resource: %w", err)
+	}
+	if mdErr != nil {
+		return mdErr
+	}
+
+	if err := rm.waitForUpToDate(ctx, res.Name, node, timeout); err != nil {
+		return fmt.Errorf("resync did not finish: %w", err)
+	}
+	return nil
+}
+
+// checkPeersUpToDate checks that every volume of a resource is UpToDate on
+// all of its nodes but node, which is about to be taken down
+func (rm *ResourceManager) checkPeersUpToDate(ctx context.Context, resource, node string, nodes []string) error {
+	for _, other := range nodes {
+		if other == node {
+			continue
+		}
+		output, err := rm.nodeStatus(ctx, resource, other)
+		if err != nil {
+			return fmt.Errorf("cannot check %s: %w", other, err)
+		}
+		states := parseLocalDiskStatesFromStatus(output)
+		if len(states) == 0 {
+			return fmt.Errorf("%s has no local disk", other)
+		}
+		for volume, state := range states {
+			if state != "UpToDate" {
+				return fmt.Errorf("volume %d is %s on %s, all other nodes must be UpToDate", volume, state, other)
+			}
+		}
+	}
+	return nil
+}
+
+// growForMetadata grows the backing device of a volume on a node so that it
+// holds the current DRBD device and the metadata with res.MaxPeers slots.
+// Volumes of a storage backend are grown by it, raw and file devices must be
+// big enough.
+func (rm *ResourceManager) growForMetadata(ctx context.Context, res *database.Resource, node, address string, vol *database.Volume) error {
+	backing := vol.Backing[node]
+	if backing == "" {
+		backing = backingDevicePath(vol.StorageType, vol.Pool, vol.VolumeName)
+	}
+
+	output, err := rm.controller.execOutput(ctx, address, fmt.Sprintf(
+		"sudo blockdev --getsize64 /dev/drbd/by-res/%s/%d %s", res.Name, vol.VolumeID, backing))
+	if err != nil {
+		return fmt.Errorf("failed to read sizes of volume %d: %w", vol.VolumeID, err)
+	}
+	fields := strings.Fields(output)
+	if len(fields) != 2 {
+		return fmt.Errorf("unexpected sizes of volume %d: %q", vol.VolumeID, strings.TrimSpace(output))
+	}
+	drbdSize, err1 := strconv.ParseUint(fields[0], 10, 64)
+	backingSize, err2 := strconv.ParseUint(fields[1], 10, 64)
+	if err1 != nil || err2 != nil {
+		return fmt.Errorf("unexpected sizes of volume %d: %q", vol.VolumeID, strings.TrimSpace(output))
+	}
+
+	required := drbdSize + drbdMetadataBytes(drbdSize, res.MaxPeers)
+	if backingSize >= required {
+		return nil
+	}
+	const mib = 1024 * 1024
+	growMiB := (required-backingSize+mib-1)/mib + 1
+
+	storageType := vol.StorageType
+	if storageType == "" {
+		storageType = "lvm"
+	}
+	backend, err := rm.controller.storage.Backend(storageType)
+	if err != nil {
+		return fmt.Errorf("backing device %s of volume %d on %s is %d bytes short of the metadata for %d peers, grow it first",
+			backing, vol.VolumeID, node, required-backingSize, res.MaxPeers)
+	}
+
+	rm.controller.logger.Info("Growing backing device for larger metadata",
+		zap.String("resource", res.Name),
+		zap.String("node", node),
+		zap.String("device", backing),
+		zap.Uint64("grow_mib", growMiB))
+	if err := backend.Resize(ctx, address, vol.Pool, vol.VolumeName, (backingSize/mib+growMiB)*mib); err != nil {
+		return fmt.Errorf("failed to grow %s on %s: %w", backing, node, err)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/liliang-cn/sds/pkg/config"
+	"github.com/liliang-cn/sds/pkg/database"
+	"github.com/liliang-cn/sds/pkg/gateway"
+)
+
+// namingTemplate returns a naming template of the controller config, the
+// default if it is not set
+func namingTemplate(template, fallback string) string {
+	if template == "" {
+		return fallback
+	}
+	return template
+}
+
+// newVolumeName returns the name of the LV or zvol backing a new resource
+func (c *Controller) newVolumeName(resource string) string {
+	return config.ExpandName(namingTemplate(c.config.Naming.Volume, config.DefaultVolumeName), resource, c.ClusterName())
+}
+
+// matchVolumeName returns the resource a backing volume name belongs to under
+// the naming template, and whether it does
+func (c *Controller) matchVolumeName(name string) (string, bool) {
+	return config.MatchName(namingTemplate(c.config.Naming.Volume, config.DefaultVolumeName), c.ClusterName(), name)
+}
+
+// newHaConfigName returns the drbd-reactor config name of a new HA config
+func (c *Controller) newHaConfigName(resource string) string {
+	return config.ExpandName(namingTemplate(c.config.Naming.HaConfig, config.DefaultHaConfigName), resource, c.ClusterName())
+}
+
+// matchHaConfigName returns the resource an HA config name belongs to under
+// the naming template, and whether it does
+func (c *Controller) matchHaConfigName(name string) (string, bool) {
+	return config.MatchName(namingTemplate(c.config.Naming.HaConfig, config.DefaultHaConfigName), c.ClusterName(), name)
+}
+
+// haConfigName returns the drbd-reactor config name recorded with an HA
+// config. Configs recorded before names were configurable have the default.
+func haConfigName(haCfg *database.HaConfig) string {
+	if haCfg.ConfigName != "" {
+		return haCfg.ConfigName
+	}
+	return config.ExpandName(config.DefaultHaConfigName, haCfg.Resource, "")
+}
+
+// haConfigPath returns the path of a drbd-reactor config by name
+func haConfigPath(name string) string {
+	return filepath.Join(gateway.DrbdReactorConfigDir, name+".toml")
+}
+
+// resourceHaConfigName returns the drbd-reactor config name of the HA config
+// of a resource, as recorded, or the one a new HA config would get
+func (c *Controller) resourceHaConfigName(ctx context.Context, resource string) string {
+	if c.db != nil {
+		if haCfg, err := c.db.GetHaConfig(ctx, resource); err == nil {
+			return haConfigName(haCfg)
+		}
+	}
+	return c.newHaConfigName(resource)
+}
@@ -0,0 +1,318 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// Net probe methods
+const (
+	NetProbeIperf3 = "iperf3"
+	NetProbeTCP    = "tcp"
+)
+
+// pingAvgPattern matches the summary line of ping, e.g.
+// "rtt min/avg/max/mdev = 0.120/0.180/0.250/0.040 ms"
+var pingAvgPattern = regexp.MustCompile(`= [0-9.]+/([0-9.]+)/`)
+
+// ddRatePattern matches the statistics of dd, e.g.
+// "1175453696 bytes (1.2 GB, 1.1 GiB) copied, 5.00106 s, 235 MB/s"
+var ddRatePattern = regexp.MustCompile(`(\d+) bytes .*copied, ([0-9.]+) s`)
+
+// ProbeNetwork measures the round trip time and the throughput of every link
+// between the given nodes over the addresses DRBD replicates on, one link at
+// a time so that the measurements do not disturb each other. iperf3 is used
+// where both nodes have it, a plain TCP transfer otherwise. Each link is
+// checked against the protocol and sync rates of the resources it carries
+// and the results are stored, replacing the previous ones.
+func (c *Controller) ProbeNetwork(ctx context.Context, nodes []string) ([]*database.NetProbe, error) {
+	if len(nodes) == 0 {
+		nodes = []string{"all"}
+	}
+	names, addresses, err := c.execTargets(ctx, nodes)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) < 2 {
+		return nil, fmt.Errorf("at least two nodes are needed to probe a link")
+	}
+
+	hasIperf := make(map[string]bool, len(addresses))
+	for _, addr := range addresses {
+		if _, err := c.execOutput(ctx, addr, "command -v iperf3"); err == nil {
+			hasIperf[addr] = true
+		}
+	}
+
+	requirements := c.linkRequirements(ctx)
+
+	var probes []*database.NetProbe
+	for i := range names {
+		for j := i + 1; j < len(names); j++ {
+			probe := &database.NetProbe{
+				Source:   names[i],
+				Target:   names[j],
+				Method:   NetProbeTCP,
+				ProbedAt: time.Now(),
+			}
+			if hasIperf[addresses[i]] && hasIperf[addresses[j]] {
+				probe.Method = NetProbeIperf3
+			}
+			if req, ok := requirements[linkKey(names[i], names[j])]; ok {
+				probe.RequiredMbps = req.mbps
+				probe.Protocol = req.protocol
+			}
+
+			c.probeLink(ctx, probe, addresses[i], addresses[j])
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			c.checkLink(probe)
+
+			if c.db != nil {
+				if err := c.db.SaveNetProbe(ctx, probe); err != nil {
+					c.logger.Warn("Failed to save net probe", zap.String("source", probe.Source),
+						zap.String("target", probe.Target), zap.Error(err))
+				}
+			}
+			for _, warning := range probe.Warnings {
+				c.logger.Warn("Replication link below requirements",
+					zap.String("source", probe.Source),
+					zap.String("target", probe.Target),
+					zap.String("warning", warning))
+			}
+			probes = append(probes, probe)
+		}
+	}
+
+	return probes, nil
+}
+
+// ListNetProbes returns the latest measurement of every probed link
+func (c *Controller) ListNetProbes(ctx context.Context) ([]*database.NetProbe, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	probes, err := c.db.ListNetProbes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(probes, func(i, j int) bool {
+		return linkKey(probes[i].Source, probes[i].Target) < linkKey(probes[j].Source, probes[j].Target)
+	})
+	return probes, nil
+}
+
+// probeLink measures the round trip time and the throughput from source to
+// target. Failures are recorded in the probe.
+func (c *Controller) probeLink(ctx context.Context, probe *database.NetProbe, source, target string) {
+	var errs []string
+
+	output, err := c.execOutput(ctx, source, fmt.Sprintf("ping -c 5 -i 0.2 -q -W 2 %s", target))
+	if m := pingAvgPattern.FindStringSubmatch(output); m != nil {
+		probe.LatencyMs, _ = strconv.ParseFloat(m[1], 64)
+	} else {
+		errs = append(errs, fmt.Sprintf("ping failed: %v", err))
+	}
+
+	var mbps float64
+	if probe.Method == NetProbeIperf3 {
+		mbps, err = c.probeIperf3(ctx, source, target)
+	} else {
+		mbps, err = c.probeTCP(ctx, source, target)
+	}
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("%s throughput test failed: %v", probe.Method, err))
+	}
+	probe.ThroughputMbps = mbps
+
+	probe.Error = strings.Join(errs, "; ")
+}
+
+// probeIperf3 runs a one-off iperf3 server on target and measures the
+// throughput from source to it in Mbit/s
+func (c *Controller) probeIperf3(ctx context.Context, source, target string) (float64, error) {
+	port := c.config.NetProbe.Port
+	seconds := int(c.config.NetProbe.Duration / time.Second)
+
+	if _, err := c.execOutput(ctx, target, fmt.Sprintf("iperf3 -s -1 -D -p %d", port)); err != nil {
+		return 0, fmt.Errorf("failed to start iperf3 server: %w", err)
+	}
+	// Give the daemon time to listen
+	time.Sleep(500 * time.Millisecond)
+
+	output, err := c.execOutput(ctx, source, fmt.Sprintf("iperf3 -c %s -p %d -t %d -J", target, port, seconds))
+	if err != nil {
+		return 0, err
+	}
+
+	var report struct {
+		End struct {
+			SumReceived struct {
+				BitsPerSecond float64 `json:"bits_per_second"`
+			} `json:"sum_received"`
+		} `json:"end"`
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(output), &report); err != nil {
+		return 0, fmt.Errorf("failed to parse iperf3 output: %w", err)
+	}
+	if report.Error != "" {
+		return 0, fmt.Errorf("%s", report.Error)
+	}
+	return report.End.SumReceived.BitsPerSecond / 1e6, nil
+}
+
+// probeTCP measures the throughput from source to target in Mbit/s by
+// sending zeros over a plain TCP connection for the probe duration
+func (c *Controller) probeTCP(ctx context.Context, source, target string) (float64, error) {
+	port := c.config.NetProbe.Port
+	seconds := int(c.config.NetProbe.Duration / time.Second)
+
+	// netcat variants differ in how the listen port is given
+	listen := fmt.Sprintf("nohup timeout %d sh -c 'nc -l -p %d || nc -l %d' > /dev/null 2>&1 &", seconds+15, port, port)
+	if _, err := c.execOutput(ctx, target, listen); err != nil {
+		return 0, fmt.Errorf("failed to start listener: %w", err)
+	}
+	time.Sleep(500 * time.Millisecond)
+
+	// dd prints its statistics when interrupted
+	send := fmt.Sprintf("bash -c 'timeout -s INT %d dd if=/dev/zero bs=1M 2>&1 > /dev/tcp/%s/%d'; true", seconds, target, port)
+	output, err := c.execOutput(ctx, source, send)
+	if err != nil {
+		return 0, err
+	}
+
+	m := ddRatePattern.FindStringSubmatch(output)
+	if m == nil {
+		return 0, fmt.Errorf("no transfer statistics: %s", strings.TrimSpace(output))
+	}
+	bytes, _ := strconv.ParseFloat(m[1], 64)
+	elapsed, _ := strconv.ParseFloat(m[2], 64)
+	if elapsed <= 0 {
+		return 0, fmt.Errorf("transfer too short to measure")
+	}
+	return bytes * 8 / elapsed / 1e6, nil
+}
+
+// checkLink adds warnings for a link that is too slow for the resources it
+// carries
+func (c *Controller) checkLink(probe *database.NetProbe) {
+	if probe.Protocol == "C" && probe.LatencyMs > 0 {
+		limit := float64(c.config.NetProbe.MaxSyncLatency) / float64(time.Millisecond)
+		if probe.LatencyMs > limit {
+			probe.Warnings = append(probe.Warnings, fmt.Sprintf(
+				"round trip time %.2f ms exceeds %.2f ms for protocol C, every write waits for the peer", probe.LatencyMs, limit))
+		}
+	}
+	if probe.RequiredMbps > 0 && probe.ThroughputMbps > 0 && probe.ThroughputMbps < probe.RequiredMbps {
+		probe.Warnings = append(probe.Warnings, fmt.Sprintf(
+			"throughput %.0f Mbit/s is below the configured sync rate of %.0f Mbit/s", probe.ThroughputMbps, probe.RequiredMbps))
+	}
+}
+
+// linkRequirement is what the resources on a link expect of it
+type linkRequirement struct {
+	protocol string  // C if any resource uses protocol C
+	mbps     float64 // Highest resync-rate or c-max-rate
+}
+
+// linkRequirements collects the protocol and the highest sync rate of the
+// resources between every pair of nodes, keyed by linkKey. Rates set in the
+// DRBD global config apply to resources without their own.
+func (c *Controller) linkRequirements(ctx context.Context) map[string]*linkRequirement {
+	requirements := make(map[string]*linkRequirement)
+	if c.db == nil {
+		return requirements
+	}
+
+	var globalMbps float64
+	if global, err := c.db.GetDrbdGlobalConfig(ctx, 0); err == nil {
+		for _, key := range []string{"resync-rate", "c-max-rate"} {
+			if mbps, ok := drbdRateMbps(global.Disk[key]); ok && mbps > globalMbps {
+				globalMbps = mbps
+			}
+		}
+	}
+
+	resources, err := c.db.ListResources(ctx)
+	if err != nil {
+		c.logger.Warn("Failed to list resources for net probe requirements", zap.Error(err))
+		return requirements
+	}
+	for _, res := range resources {
+		mbps := globalMbps
+		for key, value := range res.Options {
+			if strings.HasSuffix(key, "/resync-rate") || strings.HasSuffix(key, "/c-max-rate") {
+				if rate, ok := drbdRateMbps(value); ok {
+					mbps = rate
+				}
+			}
+		}
+
+		nodes := strings.Split(res.Nodes, ",")
+		for i := range nodes {
+			for j := i + 1; j < len(nodes); j++ {
+				key := linkKey(nodes[i], nodes[j])
+				req, ok := requirements[key]
+				if !ok {
+					req = &linkRequirement{}
+					requirements[key] = req
+				}
+				protocol := res.Protocol
+				if protocol == "" {
+					protocol = "C"
+				}
+				if strings.EqualFold(connectionProtocol(protocol, res.PeerProtocols, nodes[i], nodes[j]), "C") {
+					req.protocol = "C"
+				}
+				if mbps > req.mbps {
+					req.mbps = mbps
+				}
+			}
+		}
+	}
+	return requirements
+}
+
+// linkKey identifies the link between two nodes regardless of direction
+func linkKey(a, b string) string {
+	if a > b {
+		a, b = b, a
+	}
+	return a + "|" + b
+}
+
+// drbdRateMbps converts a DRBD rate option to Mbit/s. DRBD rates are in
+// KiB/s unless they carry a k, M or G suffix.
+func drbdRateMbps(value string) (float64, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	multiplier := 1024.0
+	switch value[len(value)-1] {
+	case 'k', 'K':
+		value = value[:len(value)-1]
+	case 'm', 'M':
+		multiplier = 1024 * 1024
+		value = value[:len(value)-1]
+	case 'g', 'G':
+		multiplier = 1024 * 1024 * 1024
+		value = value[:len(value)-1]
+	}
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+	return n * multiplier * 8 / 1e6, true
+}
@@ -0,0 +1,174 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// NodeResourceConfig is the DRBD config of a resource as a node sees it: the
+// config file as drbdadm parses it and the config loaded into the kernel
+type NodeResourceConfig struct {
+	Node      string
+	Resource  string
+	Running   bool   // The resource is up on the node
+	Dump      string // drbdadm dump
+	DumpError string // Why drbdadm could not parse the config file
+	Show      string // drbdsetup show, empty unless running
+	// Parsed forms of Dump and Show, nil if there is nothing to parse
+	Configured *DrbdConfigSection
+	Effective  *DrbdConfigSection
+}
+
+// DrbdConfigSection is a section of a DRBD config, e.g. resource r0 { ... },
+// with its "keyword value;" options and nested sections
+type DrbdConfigSection struct {
+	Name     string
+	Args     []string
+	Options  map[string]string // Repeated keywords are joined with "; "
+	Sections []*DrbdConfigSection
+}
+
+// NodeResourceConfig fetches the configured and the effective DRBD config of
+// a resource from one of its nodes
+func (rm *ResourceManager) NodeResourceConfig(ctx context.Context, resource, node string) (*NodeResourceConfig, error) {
+	nodes, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, n := range nodes {
+		if n == node {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("resource %s is not on node %s (nodes: %s)", resource, node, strings.Join(nodes, ", "))
+	}
+	address := rm.nodeAddress(node)
+
+	cfg := &NodeResourceConfig{Node: node, Resource: resource}
+
+	output, err := rm.controller.execOutput(ctx, address, fmt.Sprintf("sudo drbdadm dump %s 2>&1 || echo __failed__", resource))
+	if err != nil {
+		return nil, fmt.Errorf("failed to run drbdadm dump on %s: %w", node, err)
+	}
+	if dump, failed := strings.CutSuffix(strings.TrimRight(output, "\n"), "__failed__"); failed {
+		cfg.DumpError = strings.TrimSpace(dump)
+	} else {
+		cfg.Dump = output
+		if cfg.Configured, err = parseDrbdResource(output); err != nil {
+			return nil, fmt.Errorf("failed to parse drbdadm dump of %s: %w", node, err)
+		}
+	}
+
+	output, err = rm.controller.execOutput(ctx, address, fmt.Sprintf("sudo drbdsetup show %s 2>/dev/null || echo __down__", resource))
+	if err != nil {
+		return nil, fmt.Errorf("failed to run drbdsetup show on %s: %w", node, err)
+	}
+	if strings.TrimSpace(output) != "__down__" {
+		cfg.Running = true
+		cfg.Show = output
+		if cfg.Effective, err = parseDrbdResource(output); err != nil {
+			return nil, fmt.Errorf("failed to parse drbdsetup show of %s: %w", node, err)
+		}
+	}
+
+	return cfg, nil
+}
+
+// parseDrbdResource parses the output of drbdadm dump or drbdsetup show and
+// returns its resource section
+func parseDrbdResource(text string) (*DrbdConfigSection, error) {
+	root, err := parseDrbdConfig(text)
+	if err != nil {
+		return nil, err
+	}
+	for _, section := range root.Sections {
+		if section.Name == "resource" {
+			return section, nil
+		}
+	}
+	return nil, fmt.Errorf("no resource section")
+}
+
+// parseDrbdConfig parses DRBD config syntax: "keyword args { ... }" sections
+// and "keyword value;" options, with # comments and quoted strings. The
+// returned section is the unnamed top level.
+func parseDrbdConfig(text string) (*DrbdConfigSection, error) {
+	root := &DrbdConfigSection{}
+	stack := []*DrbdConfigSection{root}
+	var words []string
+
+	for _, tok := range tokenizeDrbdConfig(text) {
+		current := stack[len(stack)-1]
+		switch tok {
+		case "{":
+			if len(words) == 0 {
+				return nil, fmt.Errorf("section without a name")
+			}
+			section := &DrbdConfigSection{Name: words[0], Args: words[1:]}
+			current.Sections = append(current.Sections, section)
+			stack = append(stack, section)
+			words = nil
+		case "}":
+			if len(words) > 0 || len(stack) == 1 {
+				return nil, fmt.Errorf("unexpected } after %q", strings.Join(words, " "))
+			}
+			stack = stack[:len(stack)-1]
+		case ";":
+			if len(words) == 0 {
+				continue
+			}
+			if current.Options == nil {
+				current.Options = make(map[string]string)
+			}
+			value := strings.Join(words[1:], " ")
+			if prev, ok := current.Options[words[0]]; ok {
+				value = prev + "; " + value
+			}
+			current.Options[words[0]] = value
+			words = nil
+		default:
+			words = append(words, strings.Trim(tok, `"`))
+		}
+	}
+	if len(stack) != 1 || len(words) > 0 {
+		return nil, fmt.Errorf("unterminated config")
+	}
+	return root, nil
+}
+
+// tokenizeDrbdConfig splits DRBD config syntax into words, quoted strings
+// (with their quotes) and the punctuation { } ;
+func tokenizeDrbdConfig(text string) []string {
+	var tokens []string
+	for i := 0; i < len(text); {
+		switch ch := text[i]; {
+		case ch == '#':
+			for i < len(text) && text[i] != '\n' {
+				i++
+			}
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r':
+			i++
+		case ch == '{' || ch == '}' || ch == ';':
+			tokens = append(tokens, string(ch))
+			i++
+		case ch == '"':
+			end := len(text)
+			if n := strings.IndexByte(text[i+1:], '"'); n >= 0 {
+				end = i + n + 2
+			}
+			tokens = append(tokens, text[i:end])
+			i = end
+		default:
+			start := i
+			for i < len(text) && !strings.ContainsRune(" \t\r\n{};#\"", rune(text[i])) {
+				i++
+			}
+			tokens = append(tokens, text[start:i])
+		}
+	}
+	return tokens
+}
@@ -0,0 +1,154 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// Log sources of StreamNodeLogs
+const (
+	LogUnitReactor = "drbd-reactor" // The drbd-reactor service
+	LogUnitDRBD    = "drbd"         // DRBD kernel messages
+	LogUnitGateway = "gateway"      // The units drbd-reactor runs for a resource
+)
+
+// defaultNodeLogLines is how many recent lines a log stream starts with
+const defaultNodeLogLines = 100
+
+// maxNodeLogLines bounds the recent lines a log stream starts with
+const maxNodeLogLines = 10000
+
+// maxNodeLogLine bounds a single line, longer ones are split
+const maxNodeLogLine = 64 * 1024
+
+// logSincePattern is what journalctl --since values may contain, e.g.
+// "2024-01-02 10:00:00", "-1h" or "1 hour ago"
+var logSincePattern = regexp.MustCompile(`^[0-9A-Za-z :.+-]+$`)
+
+// NodeLogOptions selects the journal lines of a log stream
+type NodeLogOptions struct {
+	Unit     string // LogUnitReactor if empty
+	Resource string // Resource of the gateway for LogUnitGateway
+	Lines    int    // Recent lines to start with, 0 for the default
+	Follow   bool   // Keep sending new lines until cancelled
+	Since    string // journalctl --since
+}
+
+// StreamNodeLogs tails the journal of a node and hands it to send line by
+// line: drbd-reactor, the DRBD kernel messages or the units drbd-reactor
+// starts for the gateway of a resource. With Follow it keeps sending new
+// lines until ctx is cancelled or send fails.
+func (c *Controller) StreamNodeLogs(ctx context.Context, node string, opts NodeLogOptions, send func(line string) error) error {
+	if c.deployment == nil {
+		return fmt.Errorf("deployment client not set")
+	}
+	if node == "" || node == "all" {
+		return fmt.Errorf("a single node is required")
+	}
+	cmd, err := c.journalCommand(ctx, opts)
+	if err != nil {
+		return err
+	}
+	_, addresses, err := c.execTargets(ctx, []string{node})
+	if err != nil {
+		return err
+	}
+
+	c.logger.Debug("Streaming node logs",
+		zap.String("node", node),
+		zap.String("unit", opts.Unit),
+		zap.Bool("follow", opts.Follow))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var pending []byte
+	var sendErr error
+	flush := func(line []byte) {
+		if sendErr == nil {
+			if sendErr = send(string(line)); sendErr != nil {
+				cancel()
+			}
+		}
+	}
+	err = c.deployment.ExecStream(ctx, addresses[0], cmd, func(data []byte) {
+		pending = append(pending, data...)
+		for {
+			i := bytes.IndexByte(pending, '\n')
+			if i < 0 {
+				break
+			}
+			flush(pending[:i])
+			pending = pending[i+1:]
+		}
+		if len(pending) > maxNodeLogLine {
+			flush(pending)
+			pending = nil
+		}
+	})
+	if sendErr != nil {
+		return sendErr
+	}
+	if len(pending) > 0 {
+		flush(pending)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read the journal of %s: %w", node, err)
+	}
+	return sendErr
+}
+
+// journalCommand returns the journalctl command line for a log stream
+func (c *Controller) journalCommand(ctx context.Context, opts NodeLogOptions) (string, error) {
+	lines := opts.Lines
+	if lines == 0 {
+		lines = defaultNodeLogLines
+	}
+	if lines < 0 || lines > maxNodeLogLines {
+		return "", fmt.Errorf("lines must be between 0 and %d", maxNodeLogLines)
+	}
+	args := []string{"sudo", "journalctl", "--no-pager", "-o", "short-iso", "-n", strconv.Itoa(lines)}
+
+	switch opts.Unit {
+	case "", LogUnitReactor:
+		args = append(args, "-u", "drbd-reactor.service")
+	case LogUnitDRBD:
+		args = append(args, "-k", "--grep", "drbd")
+	case LogUnitGateway:
+		if opts.Resource == "" {
+			return "", fmt.Errorf("the resource of the gateway is required")
+		}
+		if c.db == nil {
+			return "", fmt.Errorf("database not available")
+		}
+		if _, err := c.db.GetResource(ctx, opts.Resource); err != nil || strings.ContainsAny(opts.Resource, `'\`) {
+			return "", fmt.Errorf("resource not found: %s", opts.Resource)
+		}
+		// drbd-reactor escapes "-" in its unit instances; in the glob matching
+		// the resource agent units the backslash must be escaped too
+		instance := strings.ReplaceAll(opts.Resource, "-", `\x2d`)
+		args = append(args,
+			"-u", fmt.Sprintf("'drbd-services@%s.target'", instance),
+			"-u", fmt.Sprintf("'drbd-promote@%s.service'", instance),
+			"-u", fmt.Sprintf("'ocf.*@*_%s.service'", systemdEscapeInstance(opts.Resource)))
+	default:
+		return "", fmt.Errorf("unknown log unit %q, use %s, %s or %s", opts.Unit, LogUnitReactor, LogUnitDRBD, LogUnitGateway)
+	}
+
+	if opts.Since != "" {
+		if !logSincePattern.MatchString(opts.Since) {
+			return "", fmt.Errorf("invalid since %q", opts.Since)
+		}
+		args = append(args, "--since", fmt.Sprintf("'%s'", opts.Since))
+	}
+	if opts.Follow {
+		args = append(args, "-f")
+	}
+	return strings.Join(args, " ") + " 2>&1", nil
+}
@@ -27,8 +27,19 @@ type NodeInfo struct {
 	Hostname   string                 `json:"hostname"`
 	State      NodeState              `json:"state"`
 	LastSeen   time.Time              `json:"last_seen"`
-	Capacity   map[string]interface{} `json:"capacity"`
+	Capacity   *NodeCapacity          `json:"capacity"` // nil until the node was polled
 	Version    string                 `json:"version"`
+	// DRBD versions found by the last health check or poll, empty if unknown
+	DrbdKernelVersion string `json:"drbd_kernel_version,omitempty"`
+	DrbdUtilsVersion  string `json:"drbd_utils_version,omitempty"`
+	// Versions of the other components found by the last health check or
+	// inventory, empty if not installed or unknown
+	DrbdReactorVersion  string    `json:"drbd_reactor_version,omitempty"`
+	LvmVersion          string    `json:"lvm_version,omitempty"`
+	ZfsVersion          string    `json:"zfs_version,omitempty"`
+	ComponentsCheckedAt time.Time `json:"components_checked_at,omitempty"`
+	// Clock of the node at the last time check, nil until it was checked
+	Time *NodeTime `json:"time,omitempty"`
 }
 
 // NodeManager manages cluster nodes
@@ -63,6 +74,11 @@ func (nm *NodeManager) RegisterNode(ctx context.Context, name, address string) (
 		return nil, fmt.Errorf("health check failed for node: %s", address)
 	}
 
+	// DRBD configs name the node, which must match uname -n on it
+	if err := nm.controller.checkNodeHostname(ctx, name, address); err != nil {
+		return nil, err
+	}
+
 	// Get hostname
 	hostname := name // fallback to provided name
 	for _, r := range result.Hosts {
@@ -80,7 +96,6 @@ func (nm *NodeManager) RegisterNode(ctx context.Context, name, address string) (
 		State:    NodeStateOnline,
 		LastSeen: time.Now(),
 		Version:  "1.0.0", // TODO: detect version from node environment
-		Capacity: make(map[string]interface{}),
 	}
 
 	// Save to in-memory cache
@@ -108,6 +123,9 @@ func (nm *NodeManager) RegisterNode(ctx context.Context, name, address string) (
 	// Add hosts entry to all existing nodes for new node
 	nm.addHostsEntry(ctx, address, hostname)
 
+	// New nodes start with the managed DRBD global config
+	nm.controller.pushDrbdGlobalConfig(ctx, address)
+
 	// Save to database
 	if nm.controller.db != nil {
 		dbNode := &database.Node{
@@ -296,13 +314,19 @@ func (nm *NodeManager) CheckNodeHealth(ctx context.Context, address string) erro
 
 // NodeHealthInfo represents the health status of a node
 type NodeHealthInfo struct {
-	DrbdInstalled           bool     `json:"drbd_installed"`
-	DrbdVersion             string   `json:"drbd_version"`
-	DrbdReactorInstalled    bool     `json:"drbd_reactor_installed"`
-	DrbdReactorVersion      string   `json:"drbd_reactor_version"`
-	DrbdReactorRunning      bool     `json:"drbd_reactor_running"`
-	ResourceAgentsInstalled bool     `json:"resource_agents_installed"`
-	AvailableAgents         []string `json:"available_agents"`
+	DrbdInstalled           bool      `json:"drbd_installed"`
+	DrbdVersion             string    `json:"drbd_version"`
+	DrbdReactorInstalled    bool      `json:"drbd_reactor_installed"`
+	DrbdReactorVersion      string    `json:"drbd_reactor_version"`
+	DrbdReactorRunning      bool      `json:"drbd_reactor_running"`
+	ResourceAgentsInstalled bool      `json:"resource_agents_installed"`
+	AvailableAgents         []string  `json:"available_agents"`
+	DrbdKernelVersion       string    `json:"drbd_kernel_version"`
+	DrbdUtilsVersion        string    `json:"drbd_utils_version"`
+	DrbdVersionWarnings     []string  `json:"drbd_version_warnings,omitempty"`
+	LvmVersion              string    `json:"lvm_version"`
+	ZfsVersion              string    `json:"zfs_version"`
+	Time                    *NodeTime `json:"time,omitempty"` // nil if the clock could not be read
 }
 
 // HealthCheck performs a comprehensive health check on a node
@@ -312,11 +336,14 @@ func (nm *NodeManager) HealthCheck(ctx context.Context, nodeName string) (*NodeH
 	}
 
 	// Get node info to find hostname for SSH
-	var sshTarget string
+	var sshTarget, address string
+	name := nodeName
 	nm.mu.RLock()
 	for _, node := range nm.nodes {
-		if node.Name == nodeName {
+		if node.Name == nodeName || node.Address == nodeName {
 			sshTarget = node.Hostname // Use hostname for SSH
+			address = node.Address
+			name = node.Name
 			break
 		}
 	}
@@ -342,6 +369,36 @@ func (nm *NodeManager) HealthCheck(ctx context.Context, nodeName string) (*NodeH
 		}
 	}
 
+	// Record the component versions: the DRBD kernel module and drbd-utils
+	// decide what the resource configs of the node may use, all of them go
+	// into the cluster inventory
+	versionResult, err := nm.controller.deployment.Exec(ctx, []string{sshTarget}, componentVersionsCmd)
+	if err == nil && versionResult.AllSuccess() {
+		for _, r := range versionResult.Hosts {
+			if r.Success {
+				versions := parseComponentVersions(r.Output)
+				info.DrbdKernelVersion, info.DrbdUtilsVersion = versions.DrbdKernel, versions.DrbdUtils
+				info.LvmVersion, info.ZfsVersion = versions.Lvm, versions.Zfs
+				if address != "" {
+					nm.controller.recordComponents(ctx, address, versions)
+				}
+				break
+			}
+		}
+	}
+	if info.DrbdInstalled {
+		info.DrbdVersionWarnings = nm.controller.DrbdVersionWarnings(info.DrbdKernelVersion, info.DrbdUtilsVersion)
+	}
+
+	// Compare the clock of the node with the controller's
+	if address != "" {
+		t := nm.controller.checkNodeTime(ctx, name, address)
+		nm.controller.recordNodeTime(ctx, address, t)
+		if t.Error == "" {
+			info.Time = t
+		}
+	}
+
 	// Check drbd-reactor installation
 	reactorResult, err := nm.controller.deployment.Exec(ctx, []string{sshTarget}, "drbd-reactor --version 2>/dev/null || echo 'not found'")
 	if err == nil && reactorResult.AllSuccess() {
@@ -476,3 +533,101 @@ func (nm *NodeManager) addHostsEntry(ctx context.Context, ip, hostname string) {
 		}
 	}
 }
+
+// changeAddress moves a registered node to a new address in the node list,
+// the host mappings and the database, and points the /etc/hosts entries of
+// the other nodes to it
+func (nm *NodeManager) changeAddress(ctx context.Context, name, previous, address string) error {
+	nm.mu.Lock()
+	node := nm.nodes[previous]
+	if node == nil || node.Name != name {
+		nm.mu.Unlock()
+		return fmt.Errorf("node %s is not registered at %s", name, previous)
+	}
+	delete(nm.nodes, previous)
+	node.Address = address
+	nm.nodes[address] = node
+	nm.mu.Unlock()
+
+	nm.controller.hostsLock.Lock()
+	for i, h := range nm.controller.hosts {
+		if h == previous {
+			nm.controller.hosts[i] = address
+		}
+	}
+	for host, addr := range nm.controller.hostsMap {
+		if addr == previous {
+			nm.controller.hostsMap[host] = address
+		}
+	}
+	nm.controller.hostsMap[name] = address
+	nm.controller.hostsLock.Unlock()
+
+	if nm.controller.db != nil {
+		dbNode, err := nm.controller.db.GetNode(ctx, previous)
+		if err != nil {
+			dbNode = &database.Node{
+				Name:     node.Name,
+				Hostname: node.Hostname,
+				State:    string(node.State),
+				LastSeen: node.LastSeen,
+				Version:  node.Version,
+
+				DrbdKernelVersion: node.DrbdKernelVersion,
+				DrbdUtilsVersion:  node.DrbdUtilsVersion,
+
+				DrbdReactorVersion:  node.DrbdReactorVersion,
+				LvmVersion:          node.LvmVersion,
+				ZfsVersion:          node.ZfsVersion,
+				ComponentsCheckedAt: node.ComponentsCheckedAt,
+			}
+		}
+		dbNode.Address = address
+		if err := nm.controller.db.SaveNode(ctx, dbNode); err != nil {
+			return fmt.Errorf("failed to save node: %w", err)
+		}
+		if err := nm.controller.db.DeleteNode(ctx, previous); err != nil {
+			nm.controller.logger.Warn("Failed to delete previous node address", zap.String("address", previous), zap.Error(err))
+		}
+	}
+
+	nm.removeHostsEntry(ctx, previous, node.Hostname)
+	nm.addHostsEntry(ctx, address, node.Hostname)
+	return nil
+}
+
+// removeHostsEntry removes the /etc/hosts entry of a node address from all
+// other nodes
+func (nm *NodeManager) removeHostsEntry(ctx context.Context, ip, hostname string) {
+	if hostname == "" || hostname == ip {
+		return
+	}
+
+	nm.mu.RLock()
+	var existingNodes []string
+	for _, node := range nm.nodes {
+		if node.Hostname != hostname && node.Hostname != "" {
+			existingNodes = append(existingNodes, node.Hostname)
+		}
+	}
+	nm.mu.RUnlock()
+
+	if len(existingNodes) == 0 {
+		return
+	}
+
+	hostsCmd := fmt.Sprintf("sudo sed -i '/^%s[[:space:]]\\+%s$/d' /etc/hosts",
+		strings.ReplaceAll(ip, ".", "\\."), hostname)
+	result, err := nm.controller.deployment.Exec(ctx, existingNodes, hostsCmd)
+	if err != nil {
+		nm.controller.logger.Warn("Failed to remove hosts entry", zap.Error(err))
+		return
+	}
+	for nodeHost, r := range result.Hosts {
+		if !r.Success {
+			nm.controller.logger.Warn("Failed to remove hosts entry",
+				zap.String("node", nodeHost),
+				zap.String("output", r.Output))
+		}
+	}
+}
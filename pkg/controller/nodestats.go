@@ -0,0 +1,203 @@
+package controller
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// nodePollInterval is how often node capacity and load are refreshed
+const nodePollInterval = 30 * time.Second
+
+// NodeCapacity is the storage, DRBD and load data of a node as last
+// collected by the node poller
+type NodeCapacity struct {
+	Pools             []*NodePoolCapacity `json:"pools"`
+	DrbdDevices       int                 `json:"drbd_devices"`
+	Primaries         int                 `json:"primaries"` // Resources in Primary role on the node
+	Load1             float64             `json:"load1"`
+	Load5             float64             `json:"load5"`
+	Load15            float64             `json:"load15"`
+	CPUs              int                 `json:"cpus"`
+	MemTotalBytes     uint64              `json:"mem_total_bytes"`
+	MemAvailableBytes uint64              `json:"mem_available_bytes"`
+	CollectedAt       time.Time           `json:"collected_at"`
+}
+
+// NodePoolCapacity is the capacity of an LVM volume group or ZFS pool on a node
+type NodePoolCapacity struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"` // lvm or zfs
+	TotalBytes uint64 `json:"total_bytes"`
+	FreeBytes  uint64 `json:"free_bytes"`
+}
+
+// nodeCapacityCmd collects the capacity and DRBD I/O data of a node, one
+// section per source
+const nodeCapacityCmd = `echo '#vg'; sudo vgs --noheadings --units b --nosuffix --separator ' ' -o vg_name,vg_size,vg_free 2>/dev/null; ` +
+	`echo '#zpool'; sudo zpool list -Hp -o name,size,free 2>/dev/null; ` +
+	`echo '#drbd'; ls -1 /dev/drbd[0-9]* 2>/dev/null | wc -l; ` +
+	`echo '#primary'; sudo drbdsetup status 2>/dev/null | grep -c '^[^ ].* role:Primary'; ` +
+	`echo '#load'; cat /proc/loadavg; nproc; ` +
+	`echo '#mem'; grep -E '^(MemTotal|MemAvailable):' /proc/meminfo; ` +
+	`echo '#primaryres'; sudo drbdsetup status 2>/dev/null | sed -n 's/^\([^ ]*\) role:Primary.*/\1/p'; ` +
+	`echo '#drbdvol'; for l in /dev/drbd/by-res/*/*; do [ -e "$l" ] && echo "$l $(readlink -f "$l")"; done; ` +
+	`echo '#status'; sudo drbdsetup status 2>/dev/null; ` +
+	`echo '#diskstats'; grep ' drbd[0-9]' /proc/diskstats || true; ` +
+	`echo '#hostname'; hostname; ` + drbdVersionCmd
+
+// runNodePoller periodically refreshes the state and capacity of all nodes
+// until the controller is stopped
+func (c *Controller) runNodePoller() {
+	ticker := time.NewTicker(nodePollInterval)
+	defer ticker.Stop()
+
+	for {
+		c.pollNodes()
+
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollNodes refreshes every registered node. Unreachable nodes are marked
+// offline and keep their last collected capacity. The pool capacity is
+// sampled for the pool history, the DRBD counters for the I/O rates.
+func (c *Controller) pollNodes() {
+	ctx, cancel := context.WithTimeout(c.ctx, nodePollInterval)
+	defer cancel()
+
+	nodes, err := c.nodes.ListNodes(ctx)
+	if err != nil {
+		return
+	}
+
+	reached := 0
+	for _, node := range nodes {
+		output, err := c.execOutput(ctx, node.Address, nodeCapacityCmd)
+		if err == nil {
+			reached++
+		}
+
+		c.nodes.mu.Lock()
+		n := c.nodes.nodes[node.Address]
+		if n != nil {
+			if err != nil {
+				n.State = NodeStateOffline
+			} else {
+				n.State = NodeStateOnline
+				n.LastSeen = time.Now()
+				n.Capacity = parseNodeCapacity(output)
+			}
+		}
+		c.nodes.mu.Unlock()
+
+		if err != nil {
+			c.dropIOStats(node.Name)
+			c.dropLiveStates(node.Name)
+		} else {
+			c.updateIOStats(node.Name, output)
+			c.updateLiveStates(node.Name, output)
+			kernel, utils := parseDrbdVersions(output)
+			c.recordDrbdVersions(ctx, node.Address, kernel, utils)
+			c.recordHostname(ctx, node.Address, parseNodeHostname(output))
+		}
+
+		if err != nil {
+			c.logger.Debug("Failed to poll node",
+				zap.String("node", node.Name),
+				zap.Error(err))
+		}
+	}
+
+	c.pollMu.Lock()
+	c.polledAt = time.Now()
+	c.polledNodes = len(nodes)
+	c.reachedNodes = reached
+	c.pollMu.Unlock()
+
+	c.updateResourceHealth(ctx)
+	c.recordPoolUsage(ctx)
+	c.updateMaintenance(ctx)
+	c.expireSyncRates(ctx)
+}
+
+// parseNodeCapacity parses the output of nodeCapacityCmd
+func parseNodeCapacity(output string) *NodeCapacity {
+	capacity := &NodeCapacity{CollectedAt: time.Now()}
+
+	section := ""
+	var loadLines []string
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			section = strings.TrimPrefix(line, "#")
+			continue
+		}
+		fields := strings.Fields(line)
+
+		switch section {
+		case "vg", "zpool":
+			if len(fields) < 3 {
+				continue
+			}
+			total, err1 := strconv.ParseUint(fields[1], 10, 64)
+			free, err2 := strconv.ParseUint(fields[2], 10, 64)
+			if err1 != nil || err2 != nil {
+				continue
+			}
+			poolType := "lvm"
+			if section == "zpool" {
+				poolType = "zfs"
+			}
+			capacity.Pools = append(capacity.Pools, &NodePoolCapacity{
+				Name: fields[0], Type: poolType, TotalBytes: total, FreeBytes: free,
+			})
+		case "drbd":
+			capacity.DrbdDevices, _ = strconv.Atoi(line)
+		case "primary":
+			capacity.Primaries, _ = strconv.Atoi(line)
+		case "load":
+			loadLines = append(loadLines, line)
+		case "mem":
+			// "MemTotal:  16318412 kB"
+			if len(fields) < 2 {
+				continue
+			}
+			kb, err := strconv.ParseUint(fields[1], 10, 64)
+			if err != nil {
+				continue
+			}
+			if fields[0] == "MemTotal:" {
+				capacity.MemTotalBytes = kb * 1024
+			} else {
+				capacity.MemAvailableBytes = kb * 1024
+			}
+		}
+	}
+
+	// /proc/loadavg followed by nproc
+	if len(loadLines) > 0 {
+		if fields := strings.Fields(loadLines[0]); len(fields) >= 3 {
+			capacity.Load1, _ = strconv.ParseFloat(fields[0], 64)
+			capacity.Load5, _ = strconv.ParseFloat(fields[1], 64)
+			capacity.Load15, _ = strconv.ParseFloat(fields[2], 64)
+		}
+	}
+	if len(loadLines) > 1 {
+		capacity.CPUs, _ = strconv.Atoi(loadLines[1])
+	}
+
+	sort.Slice(capacity.Pools, func(i, j int) bool { return capacity.Pools[i].Name < capacity.Pools[j].Name })
+	return capacity
+}
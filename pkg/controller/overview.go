@@ -0,0 +1,309 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"github.com/liliang-cn/sds/pkg/gateway"
+)
+
+// overviewPath is the REST path of GetOverview
+const overviewPath = "/v1/overview"
+
+// defaultOverviewEvents is the number of recent events in an overview when
+// the request asks for none
+const defaultOverviewEvents = 20
+
+// Overview is the state of the cluster in one piece for dashboards. It is
+// built from the database and what the node poller collected last, without
+// running commands on the nodes.
+type Overview struct {
+	Nodes     []*NodeInfo
+	Pools     []*PoolInfo
+	Resources []*ResourceInfo
+	Ha        []*database.HaConfig
+	Gateways  []*OverviewGateway
+	Events    []*database.Event
+}
+
+// OverviewGateway is a gateway with the node its resource is Primary on
+type OverviewGateway struct {
+	*gateway.GatewayInfo
+	Node string
+}
+
+// GetOverview returns the nodes, pools, resources with their last polled
+// DRBD state, HA configs, gateways and the latest events, as far as the
+// caller may see them
+func (c *Controller) GetOverview(ctx context.Context, events int) (*Overview, error) {
+	if events <= 0 {
+		events = defaultOverviewEvents
+	}
+	overview := &Overview{}
+
+	nodes, err := c.nodes.ListNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+	overview.Nodes = nodes
+	for _, node := range nodes {
+		if node.Capacity == nil {
+			continue
+		}
+		for _, pool := range node.Capacity.Pools {
+			// Typed like the pools of the storage backends
+			poolType := pool.Type
+			if poolType == "lvm" {
+				poolType = "vg"
+			}
+			overview.Pools = append(overview.Pools, &PoolInfo{
+				Name:    pool.Name,
+				Type:    poolType,
+				Node:    node.Name,
+				TotalGB: pool.TotalBytes / (1 << 30),
+				FreeGB:  pool.FreeBytes / (1 << 30),
+			})
+		}
+	}
+
+	resources, err := c.resources.ListResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := c.nodeNamesByAddress(ctx)
+	primaries := make(map[string]string)
+	for _, res := range resources {
+		if !mayAccess(ctx, res.Owner) {
+			continue
+		}
+		res.Role = "Unknown"
+		for _, node := range res.Nodes {
+			name := node
+			if n, ok := names[node]; ok {
+				name = n
+			}
+			state := c.liveState(name, res.Name)
+			if state == nil {
+				continue
+			}
+			res.NodeStates[node] = state
+			if state.Role == "Primary" {
+				res.Role = state.Role
+				primaries[res.Name] = name
+			} else if res.Role == "Unknown" {
+				res.Role = state.Role
+			}
+		}
+		overview.Resources = append(overview.Resources, res)
+	}
+	sort.Slice(overview.Resources, func(i, j int) bool { return overview.Resources[i].Name < overview.Resources[j].Name })
+
+	if c.db != nil {
+		configs, err := c.db.ListHaConfigs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, cfg := range configs {
+			if c.resourceVisible(ctx, cfg.Resource) {
+				overview.Ha = append(overview.Ha, cfg)
+			}
+		}
+		sort.Slice(overview.Ha, func(i, j int) bool { return overview.Ha[i].Resource < overview.Ha[j].Resource })
+	}
+
+	// Gateways without a config directory on the controller are left out
+	if gateways, err := c.gateway.ListGateways(ctx); err == nil {
+		for _, gw := range gateways {
+			if c.gatewayVisible(ctx, gw.ID) {
+				overview.Gateways = append(overview.Gateways, &OverviewGateway{GatewayInfo: gw, Node: primaries[gw.Resource]})
+			}
+		}
+		sort.Slice(overview.Gateways, func(i, j int) bool { return overview.Gateways[i].ID < overview.Gateways[j].ID })
+	}
+
+	// Events of resources the caller may not see are left out, the limit
+	// applies to what remains
+	limit := events
+	if p := principalFromContext(ctx); p != nil && !p.Admin {
+		limit = 0
+	}
+	recent, err := c.ListEvents(ctx, "", limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range recent {
+		if len(overview.Events) >= events {
+			break
+		}
+		if event.Resource == "" || c.resourceVisible(ctx, event.Resource) {
+			overview.Events = append(overview.Events, event)
+		}
+	}
+
+	return overview, nil
+}
+
+// liveState returns the DRBD state of a resource on a node as of the last
+// node poll, nil if unknown
+func (c *Controller) liveState(node, resource string) *ResourceNodeState {
+	c.liveMu.RLock()
+	defer c.liveMu.RUnlock()
+	if state := c.liveStates[node][resource]; state != nil {
+		copied := *state
+		return &copied
+	}
+	return nil
+}
+
+// updateLiveStates keeps the DRBD state of the resources of a node from
+// the output of nodeCapacityCmd
+func (c *Controller) updateLiveStates(node, output string) {
+	states := parseDrbdStates(output)
+	c.liveMu.Lock()
+	defer c.liveMu.Unlock()
+	if c.liveStates == nil {
+		c.liveStates = make(map[string]map[string]*ResourceNodeState)
+	}
+	c.liveStates[node] = states
+}
+
+// dropLiveStates forgets the DRBD state of the resources of a node, e.g.
+// when it cannot be polled
+func (c *Controller) dropLiveStates(node string) {
+	c.liveMu.Lock()
+	defer c.liveMu.Unlock()
+	delete(c.liveStates, node)
+}
+
+// parseDrbdStates parses the local role, disk and replication state of
+// each resource from the drbdsetup status section of nodeCapacityCmd.
+// Resources start at the beginning of a line, their volumes and peers are
+// indented:
+//
+//	res role:Primary
+//	  disk:UpToDate
+//	  peer role:Secondary
+//	    replication:SyncSource peer-disk:Inconsistent done:42.10
+func parseDrbdStates(output string) map[string]*ResourceNodeState {
+	blocks := make(map[string][]string)
+	var order []string
+	section, current := "", ""
+	for _, line := range strings.Split(output, "\n") {
+		if strings.HasPrefix(line, "#") {
+			section = strings.TrimPrefix(strings.TrimSpace(line), "#")
+			continue
+		}
+		if section != "status" || strings.TrimSpace(line) == "" {
+			continue
+		}
+		if !strings.HasPrefix(line, " ") {
+			current = strings.Fields(line)[0]
+			order = append(order, current)
+		}
+		if current != "" {
+			blocks[current] = append(blocks[current], line)
+		}
+	}
+
+	states := make(map[string]*ResourceNodeState, len(order))
+	for _, name := range order {
+		block := blocks[name]
+		state := &ResourceNodeState{Role: "Unknown", DiskState: "Unknown"}
+		for _, field := range strings.Fields(block[0]) {
+			if role, ok := strings.CutPrefix(field, "role:"); ok {
+				state.Role = role
+			}
+		}
+
+		// The first volume that is not UpToDate, UpToDate if all are
+		disks := parseLocalDiskStatesFromStatus(strings.Join(block, "\n"))
+		volumes := make([]int, 0, len(disks))
+		for volume := range disks {
+			volumes = append(volumes, volume)
+		}
+		sort.Ints(volumes)
+		for _, volume := range volumes {
+			if state.DiskState == "Unknown" || state.DiskState == "UpToDate" {
+				state.DiskState = disks[volume]
+			}
+		}
+
+		// The first peer that is not Established, e.g. one in resync
+		for _, line := range block[1:] {
+			for _, field := range strings.Fields(line) {
+				replication, ok := strings.CutPrefix(field, "replication:")
+				if ok && (state.Replication == "" || state.Replication == "Established") {
+					state.Replication = replication
+				}
+			}
+		}
+		states[name] = state
+	}
+	return states
+}
+
+// etagMiddleware answers GET requests of the overview with an ETag of the
+// response, and with 304 Not Modified when it matches If-None-Match, so
+// dashboards polling the overview only transfer what changed
+func etagMiddleware(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != overviewPath {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		buffered := &bufferedResponse{header: make(http.Header), status: http.StatusOK}
+		h.ServeHTTP(buffered, r)
+		for key, values := range buffered.header {
+			w.Header()[key] = values
+		}
+		if buffered.status != http.StatusOK {
+			w.WriteHeader(buffered.status)
+			w.Write(buffered.body.Bytes())
+			return
+		}
+
+		sum := sha256.Sum256(buffered.body.Bytes())
+		etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", "no-cache")
+		if etagMatches(r.Header.Get("If-None-Match"), etag) {
+			w.Header().Del("Content-Length")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(buffered.body.Bytes())
+	})
+}
+
+// etagMatches reports whether an If-None-Match header lists an ETag
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferedResponse keeps a response to look at it before it is sent
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header { return b.header }
+
+func (b *bufferedResponse) WriteHeader(status int) { b.status = status }
+
+func (b *bufferedResponse) Write(data []byte) (int, error) { return b.body.Write(data) }
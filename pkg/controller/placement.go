@@ -0,0 +1,265 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// Placement rule types
+const (
+	// PlacementColocate keeps two resources Primary on the same node
+	PlacementColocate = "colocate"
+
+	// PlacementAntiAffinity keeps two resources Primary on different nodes
+	PlacementAntiAffinity = "anti-affinity"
+)
+
+// AddPlacementRule adds or replaces the placement rule between two resources
+// and regenerates the promoter configs of the HA-managed ones so their
+// preferred-nodes reflect the rule.
+func (rm *ResourceManager) AddPlacementRule(ctx context.Context, a, b, ruleType string) error {
+	rm.controller.logger.Info("Adding placement rule",
+		zap.String("resource_a", a),
+		zap.String("resource_b", b),
+		zap.String("type", ruleType))
+
+	if rm.controller.db == nil {
+		return fmt.Errorf("database not available")
+	}
+	if ruleType != PlacementColocate && ruleType != PlacementAntiAffinity {
+		return fmt.Errorf("invalid placement rule type %q (must be %s or %s)", ruleType, PlacementColocate, PlacementAntiAffinity)
+	}
+	if a == b {
+		return fmt.Errorf("a placement rule needs two different resources")
+	}
+
+	nodesA, err := rm.resourceNodeNames(ctx, a)
+	if err != nil {
+		return err
+	}
+	nodesB, err := rm.resourceNodeNames(ctx, b)
+	if err != nil {
+		return err
+	}
+
+	shared := 0
+	for _, node := range nodesA {
+		if containsString(nodesB, node) {
+			shared++
+		}
+	}
+	switch ruleType {
+	case PlacementColocate:
+		if shared == 0 {
+			return fmt.Errorf("resources %s and %s share no nodes and cannot be co-located", a, b)
+		}
+	case PlacementAntiAffinity:
+		if shared == len(nodesA) && shared == len(nodesB) && shared < 2 {
+			return fmt.Errorf("resources %s and %s only share node %s and cannot be kept apart", a, b, nodesA[0])
+		}
+	}
+
+	rule := &database.PlacementRule{ResourceA: a, ResourceB: b, Type: ruleType}
+	if err := rm.controller.db.SavePlacementRule(ctx, rule); err != nil {
+		return fmt.Errorf("failed to save placement rule: %w", err)
+	}
+
+	rm.refreshPromoterConfigs(ctx, a, b)
+	return nil
+}
+
+// DeletePlacementRule removes the placement rule between two resources
+func (rm *ResourceManager) DeletePlacementRule(ctx context.Context, a, b string) error {
+	rm.controller.logger.Info("Deleting placement rule",
+		zap.String("resource_a", a),
+		zap.String("resource_b", b))
+
+	if rm.controller.db == nil {
+		return fmt.Errorf("database not available")
+	}
+	if err := rm.controller.db.DeletePlacementRule(ctx, a, b); err != nil {
+		return err
+	}
+
+	rm.refreshPromoterConfigs(ctx, a, b)
+	return nil
+}
+
+// ListPlacementRules lists placement rules, optionally only those involving resource
+func (rm *ResourceManager) ListPlacementRules(ctx context.Context, resource string) ([]*database.PlacementRule, error) {
+	if rm.controller.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+
+	rules, err := rm.controller.db.ListPlacementRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resource == "" {
+		return rules, nil
+	}
+
+	var filtered []*database.PlacementRule
+	for _, rule := range rules {
+		if rule.ResourceA == resource || rule.ResourceB == resource {
+			filtered = append(filtered, rule)
+		}
+	}
+	return filtered, nil
+}
+
+// placementPartners returns the resources that must share (colocated) or
+// must not share (separated) the Primary node with resource
+func (rm *ResourceManager) placementPartners(ctx context.Context, resource string) (colocated, separated []string) {
+	rules, err := rm.ListPlacementRules(ctx, resource)
+	if err != nil {
+		return nil, nil
+	}
+
+	for _, rule := range rules {
+		partner := rule.ResourceB
+		if partner == resource {
+			partner = rule.ResourceA
+		}
+		switch rule.Type {
+		case PlacementColocate:
+			colocated = append(colocated, partner)
+		case PlacementAntiAffinity:
+			separated = append(separated, partner)
+		}
+	}
+	return colocated, separated
+}
+
+// primaryNode returns the name of the node a resource is Primary on, or "" if none
+func (rm *ResourceManager) primaryNode(ctx context.Context, resource string) string {
+	nodes, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return ""
+	}
+	for _, node := range nodes {
+		if role, _ := rm.nodeRole(ctx, resource, node); role == "Primary" {
+			return node
+		}
+	}
+	return ""
+}
+
+// resourceNodeName maps a node name or address to the name of one of the
+// resource's nodes, or returns "" if it is not one of them
+func (rm *ResourceManager) resourceNodeName(ctx context.Context, resource, node string) string {
+	nodes, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return ""
+	}
+	for _, name := range nodes {
+		if name == node || rm.nodeAddress(name) == node {
+			return name
+		}
+	}
+	return ""
+}
+
+// rankNodes orders candidate nodes for a resource's Primary by its placement
+// rules: nodes where a co-located partner is Primary come first, nodes where
+// an anti-affine partner is Primary come last, the rest keep their order.
+// Nodes under maintenance come after all others.
+func (rm *ResourceManager) rankNodes(ctx context.Context, resource string, nodes []string) []string {
+	ranked := append([]string(nil), nodes...)
+
+	maintenance := rm.controller.maintenanceNodes(ctx)
+	colocated, separated := rm.placementPartners(ctx, resource)
+	if len(colocated) == 0 && len(separated) == 0 && len(maintenance) == 0 {
+		return ranked
+	}
+
+	score := make(map[string]int)
+	for _, partner := range colocated {
+		if node := rm.primaryNode(ctx, partner); node != "" {
+			score[node]--
+		}
+	}
+	for _, partner := range separated {
+		if node := rm.primaryNode(ctx, partner); node != "" {
+			score[node]++
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if (maintenance[ranked[i]] == nil) != (maintenance[ranked[j]] == nil) {
+			return maintenance[ranked[i]] == nil
+		}
+		return score[ranked[i]] < score[ranked[j]]
+	})
+	return ranked
+}
+
+// checkPlacement returns an error if making resource Primary on node would
+// violate one of its placement rules
+func (rm *ResourceManager) checkPlacement(ctx context.Context, resource, node string) error {
+	colocated, separated := rm.placementPartners(ctx, resource)
+
+	for _, partner := range colocated {
+		if primary := rm.primaryNode(ctx, partner); primary != "" && primary != node {
+			return fmt.Errorf("resource %s must be co-located with %s, which is Primary on %s", resource, partner, primary)
+		}
+	}
+	for _, partner := range separated {
+		if primary := rm.primaryNode(ctx, partner); primary == node {
+			return fmt.Errorf("resource %s must not share a node with %s, which is Primary on %s", resource, partner, primary)
+		}
+	}
+	return nil
+}
+
+// refreshPromoterConfigs regenerates and redistributes the promoter configs of
+// the given resources that are HA-managed. Failures are logged, the HA configs
+// are picked up again on the next change.
+func (rm *ResourceManager) refreshPromoterConfigs(ctx context.Context, resources ...string) {
+	for _, resource := range resources {
+		if err := rm.refreshPromoterConfig(ctx, resource); err != nil {
+			rm.controller.logger.Warn("Failed to refresh promoter config",
+				zap.String("resource", resource),
+				zap.Error(err))
+		}
+	}
+}
+
+// refreshPromoterConfig rewrites the promoter config of an HA-managed resource
+// from its stored HA configuration
+func (rm *ResourceManager) refreshPromoterConfig(ctx context.Context, resource string) error {
+	if rm.deployment == nil {
+		return fmt.Errorf("deployment client not set")
+	}
+
+	haCfg, err := rm.controller.db.GetHaConfig(ctx, resource)
+	if err != nil {
+		// Not HA-managed, nothing to refresh
+		return nil
+	}
+
+	nodeNames, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return err
+	}
+	nodeAddresses, err := rm.ResourceHosts(ctx, resource)
+	if err != nil {
+		return err
+	}
+
+	configPath := haConfigPath(haConfigName(haCfg))
+	configContent := rm.generatePromoterConfig(resource, nodeAddresses, haCfg.Services, haCfg.MountPoint, haCfg.FsType, haCfg.VIP,
+		haCfg.DependsOn, rm.rankNodes(ctx, resource, nodeNames), haPolicyOf(haCfg))
+
+	if _, err := rm.deployment.DistributeConfig(ctx, nodeAddresses, configContent, configPath); err != nil {
+		return fmt.Errorf("failed to distribute promoter config: %w", err)
+	}
+	if _, err := rm.deployment.ReactorReload(ctx, nodeAddresses); err != nil {
+		rm.controller.logger.Warn("Failed to reload drbd-reactor", zap.Error(err))
+	}
+	return nil
+}
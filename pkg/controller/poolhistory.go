@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// recordPoolUsage stores the pool capacity the node poller collected, at most
+// once per configured interval, and drops the samples beyond the retention
+func (c *Controller) recordPoolUsage(ctx context.Context) {
+	interval := c.config.PoolHistory.Interval
+	if c.db == nil || interval <= 0 || time.Since(c.poolSampledAt) < interval {
+		return
+	}
+	now := time.Now()
+	c.poolSampledAt = now
+
+	allocated := c.allocatedPoolBytes(ctx)
+
+	var samples []*database.PoolUsageSample
+	c.nodes.mu.RLock()
+	for _, n := range c.nodes.nodes {
+		// Offline nodes keep their last capacity, it is not sampled again
+		if n.State != NodeStateOnline || n.Capacity == nil {
+			continue
+		}
+		for _, pool := range n.Capacity.Pools {
+			samples = append(samples, &database.PoolUsageSample{
+				Pool:           pool.Name,
+				Node:           n.Name,
+				TotalBytes:     pool.TotalBytes,
+				FreeBytes:      pool.FreeBytes,
+				AllocatedBytes: allocated[n.Name+"/"+pool.Name],
+				At:             now,
+			})
+		}
+	}
+	c.nodes.mu.RUnlock()
+
+	if len(samples) > 0 {
+		if err := c.db.SavePoolUsage(ctx, samples); err != nil {
+			c.logger.Warn("Failed to save pool usage", zap.Error(err))
+		}
+	}
+
+	if deleted, err := c.db.PrunePoolUsage(ctx, now.Add(-c.config.PoolHistory.Retention)); err != nil {
+		c.logger.Warn("Failed to prune pool usage", zap.Error(err))
+	} else if deleted > 0 {
+		c.logger.Debug("Pruned pool usage samples", zap.Int("deleted", deleted))
+	}
+}
+
+// allocatedPoolBytes sums the provisioned size of the volumes in each pool,
+// keyed by "node/pool"
+func (c *Controller) allocatedPoolBytes(ctx context.Context) map[string]uint64 {
+	allocated := make(map[string]uint64)
+
+	resources, err := c.db.ListResources(ctx)
+	if err != nil {
+		return allocated
+	}
+	volumes, err := c.db.ListVolumes(ctx, "")
+	if err != nil {
+		return allocated
+	}
+	nodes := make(map[string][]string)
+	for _, res := range resources {
+		if res.Nodes != "" {
+			nodes[res.Name] = strings.Split(res.Nodes, ",")
+		}
+	}
+
+	for _, v := range volumes {
+		if v.Pool == "" {
+			continue
+		}
+		for _, node := range nodes[v.ResourceName] {
+			allocated[node+"/"+v.Pool] += uint64(v.SizeGB) << 30
+		}
+	}
+	return allocated
+}
+
+// GetPoolHistory returns the capacity samples of a pool taken since the given
+// time, oldest first. An empty node returns the samples of all nodes.
+func (c *Controller) GetPoolHistory(ctx context.Context, pool, node string, since time.Time) ([]*database.PoolUsageSample, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	if pool == "" {
+		return nil, fmt.Errorf("pool is required")
+	}
+	if node != "" && c.nodes.GetNodeAddressByName(node) == "" {
+		return nil, fmt.Errorf("node %s is not registered", node)
+	}
+	return c.db.ListPoolUsage(ctx, pool, node, since)
+}
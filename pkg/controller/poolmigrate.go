@@ -0,0 +1,452 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// defaultPoolMigrationSyncTimeout bounds the resync of a node after its
+// volumes were moved to the new pool
+const defaultPoolMigrationSyncTimeout = 30 * time.Minute
+
+// Snapshots the ZFS copy of a volume is sent from
+const (
+	migrateSnapshot      = "sds-migrate"
+	migrateFinalSnapshot = "sds-migrate-final"
+)
+
+// PoolMigrationResult is the outcome of moving a resource to another pool
+type PoolMigrationResult struct {
+	Migrated []string // Nodes whose volumes were moved
+	Pending  []string // Nodes left, the Primary until it fails over
+}
+
+// poolCopy is the move of one volume of a resource on one node
+type poolCopy struct {
+	vol    *database.Volume
+	source string // Backing device in the current pool
+	dest   string // Backing device in the target pool
+}
+
+// MigratePool moves the backing volumes of a resource to another LVM volume
+// group or ZFS pool of the same storage type, e.g. from HDD to SSD. The nodes
+// are moved one Secondary at a time while all other nodes are UpToDate, so
+// the resource stays online: new volumes are created in the target pool and
+// the data is copied locally, the node is taken down, the DRBD config is
+// switched to the new volumes and the node brought up again. It resyncs what
+// changed while it was down before the old volumes are removed and the next
+// node starts.
+//
+// ZFS volumes are copied with zfs send from a snapshot while the node is up,
+// only the changes since are sent while it is down. LVM volumes are copied
+// with dd while the node is down; its peers keep serving the resource.
+//
+// The Primary is left pending; after a failover the command continues with
+// it.
+func (rm *ResourceManager) MigratePool(ctx context.Context, resource, target string, timeout time.Duration) (*PoolMigrationResult, error) {
+	if rm.deployment == nil {
+		return nil, fmt.Errorf("deployment client not set")
+	}
+	if rm.controller.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	if target == "" {
+		return nil, fmt.Errorf("target pool is required")
+	}
+	if timeout <= 0 {
+		timeout = defaultPoolMigrationSyncTimeout
+	}
+
+	res, err := rm.controller.db.GetResource(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("resource not found: %s", resource)
+	}
+	nodes, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	volumes, err := rm.controller.db.ListVolumes(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volumes: %w", err)
+	}
+	if len(volumes) == 0 {
+		return nil, fmt.Errorf("resource %s has no volumes", resource)
+	}
+	for _, vol := range volumes {
+		switch vol.StorageType {
+		case StorageTypeRaw, StorageTypeFile:
+			return nil, fmt.Errorf("volume %d of %s is on %s devices, only LVM and ZFS volumes can move between pools",
+				vol.VolumeID, resource, vol.StorageType)
+		}
+	}
+
+	// Nodes are pending until all of their volumes are in the target pool
+	var pending []string
+	for _, node := range nodes {
+		if len(poolCopies(volumes, node, target)) > 0 {
+			pending = append(pending, node)
+		}
+	}
+	sort.Strings(pending)
+	if len(pending) == 0 {
+		moved := false
+		for _, vol := range volumes {
+			moved = moved || vol.Pool != target
+		}
+		if !moved {
+			return nil, fmt.Errorf("resource %s is already in pool %s", resource, target)
+		}
+	}
+
+	result := &PoolMigrationResult{}
+	primary := rm.primaryNode(ctx, resource)
+	for _, node := range pending {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if node == primary {
+			rm.controller.logger.Info("Leaving Primary pending, fail over to move it",
+				zap.String("resource", resource),
+				zap.String("node", node))
+			result.Pending = append(result.Pending, node)
+			continue
+		}
+
+		if err := rm.migrateNodePool(ctx, res, node, nodes, volumes, target, timeout); err != nil {
+			return nil, fmt.Errorf("failed to move %s: %w", node, err)
+		}
+		result.Migrated = append(result.Migrated, node)
+	}
+
+	// Once every node is moved the volumes belong to the target pool
+	if len(result.Pending) == 0 {
+		for _, vol := range volumes {
+			vol.Pool = target
+			vol.Backing = volumeBacking(nodes, backingDevicePath(vol.StorageType, target, vol.VolumeName))
+			if err := rm.controller.db.SaveVolume(ctx, vol); err != nil {
+				return nil, fmt.Errorf("failed to save volume: %w", err)
+			}
+		}
+		if err := rm.distributeResourceConfig(ctx, resource, nodes); err != nil {
+			return nil, err
+		}
+	}
+
+	message := fmt.Sprintf("Volumes of %s moved to pool %s on %s", resource, target, strings.Join(result.Migrated, ", "))
+	if len(result.Pending) > 0 {
+		message += fmt.Sprintf(", pending on %s", strings.Join(result.Pending, ", "))
+	}
+	rm.controller.RecordEvent(ctx, EventPoolMigrated, resource, message,
+		map[string]string{
+			"pool":     target,
+			"migrated": strings.Join(result.Migrated, ","),
+			"pending":  strings.Join(result.Pending, ","),
+		})
+
+	return result, nil
+}
+
+// poolCopies returns the volumes of a node that are not in the target pool yet
+func poolCopies(volumes []*database.Volume, node, target string) []*poolCopy {
+	var copies []*poolCopy
+	for _, vol := range volumes {
+		source := vol.Backing[node]
+		if source == "" {
+			source = backingDevicePath(vol.StorageType, vol.Pool, vol.VolumeName)
+		}
+		dest := backingDevicePath(vol.StorageType, target, vol.VolumeName)
+		if source != dest {
+			copies = append(copies, &poolCopy{vol: vol, source: source, dest: dest})
+		}
+	}
+	return copies
+}
+
+// migrateNodePool moves the volumes of a resource on one Secondary node to
+// the target pool and waits for the node to resync
+func (rm *ResourceManager) migrateNodePool(ctx context.Context, res *database.Resource, node string, nodes []string, volumes []*database.Volume, target string, timeout time.Duration) error {
+	// The node catches up from its peers, the others must be complete
+	if err := rm.checkPeersUpToDate(ctx, res.Name, node, nodes); err != nil {
+		return err
+	}
+
+	address := rm.nodeAddress(node)
+	copies := poolCopies(volumes, node, target)
+	for _, cp := range copies {
+		if err := rm.prepareCopy(ctx, address, cp, target); err != nil {
+			return err
+		}
+	}
+
+	rm.controller.logger.Info("Moving volumes to pool",
+		zap.String("resource", res.Name),
+		zap.String("node", node),
+		zap.String("pool", target))
+
+	if _, err := rm.controller.execOutput(ctx, address, fmt.Sprintf("sudo drbdadm down %s", res.Name)); err != nil {
+		return fmt.Errorf("failed to take down resource: %w", err)
+	}
+	// Until the config is switched the node comes back on its old volumes
+	up := func() error {
+		if _, err := rm.controller.execOutput(rm.controller.stepContext(ctx, StepDrbdUp), address, fmt.Sprintf("sudo drbdadm up %s", res.Name)); err != nil {
+			return fmt.Errorf("failed to bring up resource: %w", err)
+		}
+		return nil
+	}
+
+	for _, cp := range copies {
+		if err := rm.finishCopy(ctx, address, cp); err != nil {
+			return withUp(err, up())
+		}
+	}
+
+	if err := rm.switchBacking(ctx, res.Name, node, nodes, copies); err != nil {
+		return withUp(err, up())
+	}
+	if err := up(); err != nil {
+		return err
+	}
+
+	if err := rm.waitForUpToDate(ctx, res.Name, node, timeout); err != nil {
+		return fmt.Errorf("resync did not finish: %w", err)
+	}
+
+	for _, cp := range copies {
+		if err := rm.removeOldVolume(ctx, address, cp); err != nil {
+			rm.controller.logger.Warn("Failed to remove old volume",
+				zap.String("resource", res.Name),
+				zap.String("node", node),
+				zap.String("device", cp.source),
+				zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// switchBacking records the new volumes of a node and writes the DRBD config
+// with them to all nodes. On failure the old volumes are restored.
+func (rm *ResourceManager) switchBacking(ctx context.Context, resource, node string, nodes []string, copies []*poolCopy) error {
+	var err error
+	for _, cp := range copies {
+		cp.vol.Backing = withBacking(cp.vol.Backing, nodes, cp.vol, node, cp.dest)
+		if err = rm.controller.db.SaveVolume(ctx, cp.vol); err != nil {
+			err = fmt.Errorf("failed to save volume: %w", err)
+			break
+		}
+	}
+	if err == nil {
+		err = rm.distributeResourceConfig(ctx, resource, nodes)
+	}
+	if err != nil {
+		// The node may already have the new config
+		rm.restoreBacking(ctx, resource, node, nodes, copies)
+	}
+	return err
+}
+
+// restoreBacking switches a node back to its old volumes, failures are
+// logged
+func (rm *ResourceManager) restoreBacking(ctx context.Context, resource, node string, nodes []string, copies []*poolCopy) {
+	for _, cp := range copies {
+		cp.vol.Backing = withBacking(cp.vol.Backing, nodes, cp.vol, node, cp.source)
+		if err := rm.controller.db.SaveVolume(ctx, cp.vol); err != nil {
+			rm.controller.logger.Warn("Failed to restore volume", zap.Error(err))
+		}
+	}
+	if err := rm.distributeResourceConfig(ctx, resource, nodes); err != nil {
+		rm.controller.logger.Warn("Failed to restore DRBD config", zap.Error(err))
+	}
+}
+
+// prepareCopy creates the volume in the target pool while the node is still
+// up. ZFS volumes are received from a snapshot of the old one. A leftover of
+// an earlier attempt is replaced, the volume names belong to the resource.
+func (rm *ResourceManager) prepareCopy(ctx context.Context, address string, cp *poolCopy, target string) error {
+	if err := rm.checkNoSnapshots(ctx, address, cp); err != nil {
+		return err
+	}
+
+	vol := cp.vol
+	switch vol.StorageType {
+	case "zfs", "zfs-thin":
+		source := strings.TrimPrefix(cp.source, "/dev/zvol/")
+		dest := strings.TrimPrefix(cp.dest, "/dev/zvol/")
+		if _, err := rm.controller.execOutput(ctx, address, fmt.Sprintf("sudo zfs list -H -o name %s", target)); err != nil {
+			return fmt.Errorf("pool %s not found: %w", target, err)
+		}
+		cmd := fmt.Sprintf("sudo zfs destroy -r %s 2>/dev/null; sudo zfs destroy %s@%s 2>/dev/null; "+
+			"sudo zfs snapshot %s@%s && sudo zfs send %s@%s | sudo zfs recv -F %s",
+			dest, source, migrateSnapshot,
+			source, migrateSnapshot, source, migrateSnapshot, dest)
+		if _, err := rm.controller.execOutput(rm.controller.stepContext(ctx, StepCopy), address, cmd); err != nil {
+			return fmt.Errorf("failed to copy %s to %s: %w", source, dest, err)
+		}
+		return nil
+	}
+
+	if _, err := rm.controller.execOutput(ctx, address, fmt.Sprintf("sudo vgs %s", target)); err != nil {
+		return fmt.Errorf("pool %s not found: %w", target, err)
+	}
+	size, err := rm.blockDeviceSize(ctx, address, cp.source)
+	if err != nil {
+		return err
+	}
+	create := fmt.Sprintf("sudo lvremove -f %s 2>/dev/null; sudo lvcreate -y -L %db -n %s %s",
+		cp.dest, size, vol.VolumeName, target)
+	if vol.StorageType == "lvm-thin" {
+		create = fmt.Sprintf("sudo lvremove -f %s 2>/dev/null; sudo lvcreate -y -V %db -T %s/%s_thin -n %s",
+			cp.dest, size, target, target, vol.VolumeName)
+	}
+	if _, err := rm.controller.execOutput(rm.controller.stepContext(ctx, StepVolumeCreate), address, create); err != nil {
+		return fmt.Errorf("failed to create %s: %w", cp.dest, err)
+	}
+
+	// DRBD keeps its metadata at the end of the device, the copy must
+	// have the same size to carry it over
+	created, err := rm.blockDeviceSize(ctx, address, cp.dest)
+	if err != nil {
+		return err
+	}
+	if created != size {
+		_, _ = rm.controller.execOutput(ctx, address, fmt.Sprintf("sudo lvremove -f %s", cp.dest))
+		return fmt.Errorf("%s has %d bytes instead of the %d of %s, the extent sizes of the volume groups differ",
+			cp.dest, created, size, cp.source)
+	}
+	return nil
+}
+
+// checkNoSnapshots refuses to move a volume that has snapshots, they would be
+// removed with the old volume
+func (rm *ResourceManager) checkNoSnapshots(ctx context.Context, address string, cp *poolCopy) error {
+	cmd := fmt.Sprintf("sudo lvs --noheadings -o lv_name -S origin=%s %s", cp.vol.VolumeName, strings.TrimPrefix(path.Dir(cp.source), "/dev/"))
+	zfs := cp.vol.StorageType == "zfs" || cp.vol.StorageType == "zfs-thin"
+	if zfs {
+		cmd = fmt.Sprintf("sudo zfs list -H -t snapshot -o name %s", strings.TrimPrefix(cp.source, "/dev/zvol/"))
+	}
+	output, err := rm.controller.execOutput(ctx, address, cmd)
+	if err != nil {
+		return fmt.Errorf("failed to list snapshots of %s: %w", cp.source, err)
+	}
+
+	var snapshots []string
+	for _, line := range strings.Split(output, "\n") {
+		name := strings.TrimSpace(line)
+		if _, snap, ok := strings.Cut(name, "@"); ok && (snap == migrateSnapshot || snap == migrateFinalSnapshot) {
+			continue
+		}
+		if name != "" {
+			snapshots = append(snapshots, name)
+		}
+	}
+	if len(snapshots) > 0 {
+		return fmt.Errorf("%s has snapshots that do not move with it, delete them first: %s",
+			cp.source, strings.Join(snapshots, ", "))
+	}
+	return nil
+}
+
+// finishCopy completes the copy of a volume while the node is down: the
+// changes since the snapshot for ZFS, the whole device for LVM
+func (rm *ResourceManager) finishCopy(ctx context.Context, address string, cp *poolCopy) error {
+	var cmd string
+	switch cp.vol.StorageType {
+	case "zfs", "zfs-thin":
+		source := strings.TrimPrefix(cp.source, "/dev/zvol/")
+		dest := strings.TrimPrefix(cp.dest, "/dev/zvol/")
+		cmd = fmt.Sprintf("sudo zfs snapshot %s@%s && sudo zfs send -i @%s %s@%s | sudo zfs recv -F %s && "+
+			"sudo zfs destroy %s@%s && sudo zfs destroy %s@%s && "+
+			"for i in $(seq 50); do [ -e %s ] && break; sleep 0.2; done; [ -e %s ]",
+			source, migrateFinalSnapshot, migrateSnapshot, source, migrateFinalSnapshot, dest,
+			dest, migrateFinalSnapshot, dest, migrateSnapshot,
+			cp.dest, cp.dest)
+	default:
+		// A new thin volume reads as zeros, zero blocks need not be written
+		conv := "fsync"
+		if cp.vol.StorageType == "lvm-thin" {
+			conv = "sparse,fsync"
+		}
+		cmd = fmt.Sprintf("sudo dd if=%s of=%s bs=4M iflag=direct oflag=direct conv=%s status=none",
+			cp.source, cp.dest, conv)
+	}
+
+	if _, err := rm.controller.execOutput(rm.controller.stepContext(ctx, StepCopy), address, cmd); err != nil {
+		return fmt.Errorf("failed to copy %s to %s: %w", cp.source, cp.dest, err)
+	}
+	return nil
+}
+
+// removeOldVolume removes the volume a node moved away from, with the
+// snapshots of the copy
+func (rm *ResourceManager) removeOldVolume(ctx context.Context, address string, cp *poolCopy) error {
+	cmd := fmt.Sprintf("sudo lvremove -f %s", cp.source)
+	if cp.vol.StorageType == "zfs" || cp.vol.StorageType == "zfs-thin" {
+		cmd = fmt.Sprintf("sudo zfs destroy -r %s", strings.TrimPrefix(cp.source, "/dev/zvol/"))
+	}
+	_, err := rm.controller.execOutput(ctx, address, cmd)
+	return err
+}
+
+// blockDeviceSize returns the size of a block device on a node in bytes
+func (rm *ResourceManager) blockDeviceSize(ctx context.Context, address, device string) (uint64, error) {
+	output, err := rm.controller.execOutput(ctx, address, fmt.Sprintf("sudo blockdev --getsize64 %s", device))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read size of %s: %w", device, err)
+	}
+	size, err := strconv.ParseUint(strings.TrimSpace(output), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected size of %s: %q", device, strings.TrimSpace(output))
+	}
+	return size, nil
+}
+
+// distributeResourceConfig writes the DRBD config generated from the
+// database records to all nodes of a resource
+func (rm *ResourceManager) distributeResourceConfig(ctx context.Context, resource string, nodes []string) error {
+	export, err := rm.ExportResource(ctx, resource)
+	if err != nil {
+		return err
+	}
+	addresses := make([]string, len(nodes))
+	for i, node := range nodes {
+		addresses[i] = rm.nodeAddress(node)
+	}
+	result, err := rm.deployment.DistributeConfig(ctx, addresses, export.DrbdConfig, export.DrbdConfigPath)
+	if err != nil {
+		return fmt.Errorf("failed to distribute DRBD config: %w", err)
+	}
+	if !result.Success {
+		return fmt.Errorf("DRBD config distribution failed on some hosts")
+	}
+	return nil
+}
+
+// withBacking returns the backing devices of a volume with the one of node
+// replaced. Volumes recorded without them get the device of their pool on
+// the other nodes.
+func withBacking(backing map[string]string, nodes []string, vol *database.Volume, node, device string) map[string]string {
+	updated := make(map[string]string, len(nodes))
+	for _, n := range nodes {
+		updated[n] = backing[n]
+		if updated[n] == "" {
+			updated[n] = backingDevicePath(vol.StorageType, vol.Pool, vol.VolumeName)
+		}
+	}
+	updated[node] = device
+	return updated
+}
+
+// withUp returns err, noting that the node could not be brought back up
+func withUp(err, upErr error) error {
+	if upErr != nil {
+		return fmt.Errorf("%w; %v", err, upErr)
+	}
+	return err
+}
@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"google.golang.org/grpc/metadata"
+)
+
+// progressHeader is the metadata key carrying the id a client picks for a
+// call, to watch the progress of the job the call starts
+const progressHeader = "x-sds-progress-id"
+
+// progressRetention is how long the events of a finished job stay around
+// for watchers that connect late
+const progressRetention = time.Minute
+
+// Progress event kinds
+const (
+	ProgressStep    = "step"    // The job entered an orchestration step
+	ProgressCommand = "command" // A remote command finished on a node
+	ProgressDone    = "done"    // The job finished
+)
+
+// stepLabels describe the orchestration steps to the user
+var stepLabels = map[string]string{
+	StepPoolCreate:   "Creating storage pools",
+	StepVolumeCreate: "Creating backing volumes",
+	StepMetadata:     "Creating DRBD metadata",
+	StepDrbdUp:       "Bringing up DRBD",
+	StepPromote:      "Promoting to Primary",
+	StepMkfs:         "Creating the filesystem",
+	StepSnapshot:     "Taking snapshots",
+	StepCopy:         "Copying data",
+}
+
+// stepLabel describes an orchestration step, steps without a label by
+// their name
+func stepLabel(step string) string {
+	if label, ok := stepLabels[step]; ok {
+		return label
+	}
+	return step
+}
+
+// JobProgress is an event of a running job
+type JobProgress struct {
+	Kind     string
+	JobID    int64
+	Step     string // Orchestration step, or what a command did, e.g. "drbdadm up"
+	Label    string // Description of an orchestration step
+	Node     string
+	Success  bool
+	Duration time.Duration
+	State    string // Of a finished job
+	Message  string // Output of a failed command, the message of a finished job
+}
+
+// progressFeed keeps the events of a job for its watchers, who replay them
+// from the start
+type progressFeed struct {
+	mu       sync.Mutex
+	events   []*JobProgress
+	changed  chan struct{} // Closed and replaced on every event
+	done     bool
+	job      bool // A job publishes to the feed
+	watchers int
+}
+
+// publish adds an event and wakes up the watchers
+func (f *progressFeed) publish(event *JobProgress) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.done {
+		return
+	}
+	f.events = append(f.events, event)
+	f.done = event.Kind == ProgressDone
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+// progressFeed returns the feed of a progress id, created on first use by
+// the job or a watcher, whichever comes first
+func (c *Controller) progressFeed(id string) *progressFeed {
+	c.progressMu.Lock()
+	defer c.progressMu.Unlock()
+	if c.progressFeeds == nil {
+		c.progressFeeds = make(map[string]*progressFeed)
+	}
+	feed := c.progressFeeds[id]
+	if feed == nil {
+		feed = &progressFeed{changed: make(chan struct{})}
+		c.progressFeeds[id] = feed
+	}
+	return feed
+}
+
+// dropProgressFeed forgets the feed of a progress id
+func (c *Controller) dropProgressFeed(id string, feed *progressFeed) {
+	c.progressMu.Lock()
+	defer c.progressMu.Unlock()
+	if c.progressFeeds[id] == feed {
+		delete(c.progressFeeds, id)
+	}
+}
+
+// startProgress returns the feed a job of a call publishes to and its id,
+// nil if the caller does not watch its progress
+func (c *Controller) startProgress(ctx context.Context) (*progressFeed, string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ids := md.Get(progressHeader)
+	if len(ids) == 0 || ids[0] == "" {
+		return nil, ""
+	}
+	feed := c.progressFeed(ids[0])
+	feed.mu.Lock()
+	feed.job = true
+	feed.mu.Unlock()
+	return feed, ids[0]
+}
+
+// finishProgress publishes the end of a job and keeps its events for
+// watchers that connect late
+func (c *Controller) finishProgress(feed *progressFeed, id string, job *database.Job) {
+	if feed == nil {
+		return
+	}
+	feed.publish(&JobProgress{Kind: ProgressDone, JobID: job.ID, State: job.State, Message: job.Message})
+	time.AfterFunc(progressRetention, func() { c.dropProgressFeed(id, feed) })
+}
+
+// WatchJobProgress hands the events of the job started by the call with a
+// progress id to send, from the first one, until the job is done or ctx is
+// cancelled. The watch may start before the call.
+func (c *Controller) WatchJobProgress(ctx context.Context, id string, send func(*JobProgress) error) error {
+	feed := c.progressFeed(id)
+	feed.mu.Lock()
+	feed.watchers++
+	feed.mu.Unlock()
+	defer func() {
+		feed.mu.Lock()
+		feed.watchers--
+		// A feed no job publishes to is the watcher's alone
+		unused := feed.watchers == 0 && !feed.job
+		feed.mu.Unlock()
+		if unused {
+			c.dropProgressFeed(id, feed)
+		}
+	}()
+
+	sent := 0
+	for {
+		feed.mu.Lock()
+		events, finished, changed := feed.events[sent:], feed.done, feed.changed
+		feed.mu.Unlock()
+
+		for _, event := range events {
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+		sent += len(events)
+		if finished {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+)
+
+// protocolOrder ranks the DRBD protocols from asynchronous to synchronous
+var protocolOrder = map[string]int{"A": 0, "B": 1, "C": 2}
+
+// normalizePeerProtocols validates per-peer protocols against the nodes of a
+// resource and drops the ones equal to the resource protocol. The result is
+// nil if no peer differs.
+func normalizePeerProtocols(nodes []string, protocol string, peerProtocols map[string]string) (map[string]string, error) {
+	if len(peerProtocols) == 0 {
+		return nil, nil
+	}
+	if _, ok := protocolOrder[protocol]; !ok {
+		return nil, fmt.Errorf("invalid protocol %q (must be A, B or C)", protocol)
+	}
+
+	var normalized map[string]string
+	for node, p := range peerProtocols {
+		if !containsString(nodes, node) {
+			return nil, fmt.Errorf("peer protocol for %s, which is not a node of the resource", node)
+		}
+		p = strings.ToUpper(strings.TrimSpace(p))
+		if _, ok := protocolOrder[p]; !ok {
+			return nil, fmt.Errorf("invalid protocol %q for %s (must be A, B or C)", p, node)
+		}
+		if p == protocol {
+			continue
+		}
+		if normalized == nil {
+			normalized = make(map[string]string)
+		}
+		normalized[node] = p
+	}
+
+	if len(normalized) == len(nodes) {
+		return nil, fmt.Errorf("every node has a peer protocol, set the resource protocol instead")
+	}
+	return normalized, nil
+}
+
+// connectionProtocol returns the protocol of the connection between two
+// nodes: the less synchronous of their peer protocols, the resource protocol
+// if neither has one
+func connectionProtocol(protocol string, peerProtocols map[string]string, a, b string) string {
+	result := ""
+	for _, node := range []string{a, b} {
+		p, ok := peerProtocols[node]
+		if !ok {
+			continue
+		}
+		if result == "" || protocolOrder[p] < protocolOrder[result] {
+			result = p
+		}
+	}
+	if result == "" {
+		return protocol
+	}
+	return result
+}
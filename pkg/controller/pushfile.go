@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// maxPushFileSize bounds the size of a pushed file, it has to fit into a
+// single gRPC message
+const maxPushFileSize = 2 * 1024 * 1024
+
+// defaultPushFileMode applies when no mode is given
+const defaultPushFileMode = 0644
+
+// fileOwnerPattern matches user or user:group names and IDs
+var fileOwnerPattern = regexp.MustCompile(`^[a-z_][a-z0-9_.-]*[$]?(:[a-z_][a-z0-9_.-]*[$]?)?$|^[0-9]+(:[0-9]+)?$`)
+
+// PushFileResult is the outcome of pushing a file to one node
+type PushFileResult struct {
+	Node     string
+	Success  bool
+	Checksum string // SHA-256 of the file as written on the node
+	Error    string
+}
+
+// PushFile writes a file to registered nodes, sets its mode and owner and
+// verifies its SHA-256 checksum on every node, e.g. to distribute unit files
+// or application configs used by HA services. nodes are node names or
+// addresses, "all" selects every registered node. A zero mode uses 0644, an
+// empty owner leaves the owner as written (root).
+func (c *Controller) PushFile(ctx context.Context, nodes []string, content []byte, dest string, mode uint32, owner string) ([]*PushFileResult, string, error) {
+	if err := validatePushFile(content, dest, mode, owner); err != nil {
+		return nil, "", err
+	}
+	if mode == 0 {
+		mode = defaultPushFileMode
+	}
+
+	names, addresses, err := c.execTargets(ctx, nodes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	result, err := c.deployment.PushFile(ctx, addresses, content, dest, mode, owner)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to push %s: %w", dest, err)
+	}
+
+	var results []*PushFileResult
+	var failed []string
+	for i, addr := range addresses {
+		r := &PushFileResult{Node: names[i]}
+		if h, ok := result.Hosts[addr]; ok {
+			r.Success = h.Success
+			r.Checksum = h.Output
+			if !h.Success && h.Error != nil {
+				r.Error = h.Error.Error()
+			}
+		} else {
+			r.Error = "no result from node"
+		}
+		if !r.Success {
+			failed = append(failed, r.Node)
+		}
+		results = append(results, r)
+	}
+
+	details := map[string]string{
+		"nodes":    strings.Join(names, ","),
+		"path":     dest,
+		"mode":     fmt.Sprintf("%04o", mode),
+		"owner":    owner,
+		"checksum": checksum,
+		"size":     fmt.Sprintf("%d", len(content)),
+		"peer":     peerAddress(ctx),
+	}
+	message := fmt.Sprintf("Pushed %s to %s", dest, strings.Join(names, ", "))
+	if len(failed) > 0 {
+		details["failed"] = strings.Join(failed, ",")
+		message = fmt.Sprintf("Pushing %s failed on %s", dest, strings.Join(failed, ", "))
+	}
+	c.RecordEvent(ctx, EventFilePushed, "", message, details)
+
+	return results, checksum, nil
+}
+
+// validatePushFile checks the parameters of a file push
+func validatePushFile(content []byte, dest string, mode uint32, owner string) error {
+	if len(content) > maxPushFileSize {
+		return fmt.Errorf("file is %d bytes, at most %d bytes can be pushed", len(content), maxPushFileSize)
+	}
+	if !path.IsAbs(dest) || path.Clean(dest) != dest || dest == "/" {
+		return fmt.Errorf("destination %q must be an absolute, clean file path", dest)
+	}
+	if strings.ContainsAny(dest, " \t\n'\"`$;&|<>()\\*?[]{}") {
+		return fmt.Errorf("destination %q contains characters that are not allowed", dest)
+	}
+	if mode > 07777 {
+		return fmt.Errorf("invalid mode %o", mode)
+	}
+	if owner != "" && !fileOwnerPattern.MatchString(owner) {
+		return fmt.Errorf("invalid owner %q (must be user or user:group)", owner)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// StorageTypeRaw backs a resource directly by user-provided block devices,
+// without creating LVs or zvols
+const StorageTypeRaw = "raw"
+
+// rawDevicePattern restricts raw devices to plain /dev paths so they can be
+// passed to remote shells safely
+var rawDevicePattern = regexp.MustCompile(`^/dev/[A-Za-z0-9/_.:+-]+$`)
+
+// validateRawDevices checks that every node has a raw block device that is not
+// mounted and that all devices have the same size, which is returned in bytes.
+// nodes and addresses are parallel slices, devices maps node names to devices.
+func (rm *ResourceManager) validateRawDevices(ctx context.Context, nodes, addresses []string, devices map[string]string) (uint64, error) {
+	for node := range devices {
+		if !containsString(nodes, node) {
+			return 0, fmt.Errorf("raw device given for node %s, which is not a node of the resource", node)
+		}
+	}
+
+	sizes := make(map[string]uint64)
+	for i, node := range nodes {
+		device := devices[node]
+		if device == "" {
+			return 0, fmt.Errorf("no raw device given for node %s (use --device %s=/dev/...)", node, node)
+		}
+		if !rawDevicePattern.MatchString(device) {
+			return 0, fmt.Errorf("invalid raw device %q for node %s", device, node)
+		}
+
+		cmd := fmt.Sprintf("test -b %s && sudo blockdev --getsize64 %s && lsblk -nro MOUNTPOINT %s", device, device, device)
+		result, err := rm.deployment.Exec(ctx, []string{addresses[i]}, cmd)
+		if err != nil {
+			return 0, fmt.Errorf("failed to inspect %s on %s: %w", device, node, err)
+		}
+		if !result.AllSuccess() {
+			return 0, fmt.Errorf("%s is not a block device on %s", device, node)
+		}
+
+		var output string
+		for _, r := range result.Hosts {
+			output = r.Output
+		}
+		lines := strings.Split(strings.TrimSpace(output), "\n")
+
+		size, err := strconv.ParseUint(strings.TrimSpace(lines[0]), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read size of %s on %s: %q", device, node, lines[0])
+		}
+		for _, mountpoint := range lines[1:] {
+			if mountpoint = strings.TrimSpace(mountpoint); mountpoint != "" {
+				return 0, fmt.Errorf("%s on %s is in use (mounted on %s)", device, node, mountpoint)
+			}
+		}
+
+		sizes[node] = size
+	}
+
+	var size uint64
+	mismatch := false
+	for _, node := range nodes {
+		if size == 0 {
+			size = sizes[node]
+		} else if sizes[node] != size {
+			mismatch = true
+		}
+	}
+	if mismatch {
+		var parts []string
+		for node, s := range sizes {
+			parts = append(parts, fmt.Sprintf("%s %s = %d bytes", node, devices[node], s))
+		}
+		sort.Strings(parts)
+		return 0, fmt.Errorf("raw device sizes differ: %s", strings.Join(parts, ", "))
+	}
+
+	return size, nil
+}
+
+// rawDisksDiffer reports whether the nodes use different raw device paths
+func rawDisksDiffer(devices map[string]string) bool {
+	first := ""
+	for _, device := range devices {
+		if first == "" {
+			first = device
+		} else if device != first {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// reactorStatusSeparator splits the drbd-reactorctl status and the systemd
+// unit list in the output of reactorStatusCmd
+const reactorStatusSeparator = "__sds_units__"
+
+// reactorStatusCmd prints the drbd-reactor plugin states and the
+// drbd-services targets started by the promoters of a node
+const reactorStatusCmd = "sudo drbd-reactorctl status 2>/dev/null; echo " + reactorStatusSeparator +
+	"; systemctl list-units --all --no-legend --plain 'drbd-services@*.target' 2>/dev/null"
+
+// collectReactorMetrics records on which node the promoter of every
+// SDS-managed reactor config is active, and whether the services of every
+// gateway run on some node
+func (c *Controller) collectReactorMetrics(ctx context.Context) {
+	nodes, err := c.nodes.ListNodes(ctx)
+	if err != nil {
+		c.logger.Debug("Failed to collect reactor metrics", zap.Error(err))
+		return
+	}
+
+	// HA configs are named by the naming template of their time
+	haConfigs := make(map[string]string)
+	if c.db != nil {
+		if configs, err := c.db.ListHaConfigs(ctx); err == nil {
+			for _, ha := range configs {
+				haConfigs[haConfigName(ha)] = ha.Resource
+			}
+		}
+	}
+
+	// Resources whose drbd-services target is active on some node
+	running := make(map[string]bool)
+	for _, node := range nodes {
+		output, err := c.execOutput(ctx, node.Address, reactorStatusCmd)
+		if err != nil {
+			continue
+		}
+		status, units, _ := strings.Cut(output, reactorStatusSeparator)
+
+		for resource, active := range parseReactorPromoters(status, haConfigs) {
+			c.metrics.RecordReactorPlugin(resource, node.Name, active)
+		}
+		for resource, active := range parseServiceTargets(units) {
+			if active {
+				running[resource] = true
+			}
+		}
+	}
+
+	if c.db == nil {
+		return
+	}
+	gateways, err := c.db.ListGateways(ctx)
+	if err != nil {
+		c.logger.Debug("Failed to collect gateway metrics", zap.Error(err))
+		return
+	}
+	for _, gw := range gateways {
+		c.metrics.RecordGatewayService(string(gw.Type), gw.Resource, running[gw.Resource])
+	}
+}
+
+// parseReactorPromoters parses drbd-reactorctl status output into the
+// resources of SDS-managed promoter configs and whether they are active on
+// the node. haConfigs maps the names of HA configs to their resources. Every
+// config is headed by its path:
+//
+//	/etc/drbd-reactor.d/sds-ha-r0.toml:
+//	Promoter: Currently active on this node
+//	● drbd-services@r0.target
+func parseReactorPromoters(output string, haConfigs map[string]string) map[string]bool {
+	promoters := make(map[string]bool)
+
+	resource := ""
+	for _, line := range strings.Split(output, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasSuffix(trimmed, ".toml:") {
+			resource = reactorConfigResource(strings.TrimSuffix(filepath.Base(trimmed), ".toml:"), haConfigs)
+			continue
+		}
+		if resource == "" || !strings.HasPrefix(trimmed, "Promoter:") {
+			continue
+		}
+		state := strings.ToLower(trimmed)
+		promoters[resource] = strings.Contains(state, "active on this node") && !strings.Contains(state, "not active")
+	}
+
+	return promoters
+}
+
+// reactorConfigResource returns the resource of an SDS-managed reactor
+// config name, an HA config of haConfigs, sds-ha-<resource> or
+// sds-<gateway type>-<resource>, or ""
+func reactorConfigResource(name string, haConfigs map[string]string) string {
+	if resource, ok := haConfigs[name]; ok {
+		return resource
+	}
+	if resource, ok := strings.CutPrefix(name, "sds-ha-"); ok {
+		return resource
+	}
+	for _, gwType := range []string{"nfs", "iscsi", "nvmeof"} {
+		if resource, ok := strings.CutPrefix(name, "sds-"+gwType+"-"); ok {
+			return resource
+		}
+	}
+	return ""
+}
+
+// parseServiceTargets parses systemctl list-units output of drbd-services
+// targets into their resources and whether they are active
+//
+//	drbd-services@r0.target loaded active active drbd-services@r0.target
+func parseServiceTargets(output string) map[string]bool {
+	targets := make(map[string]bool)
+
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			continue
+		}
+		unit, ok := strings.CutPrefix(fields[0], "drbd-services@")
+		if !ok || !strings.HasSuffix(unit, ".target") {
+			continue
+		}
+		resource := strings.ReplaceAll(strings.TrimSuffix(unit, ".target"), `\x2d`, "-")
+		targets[resource] = fields[2] == "active"
+	}
+
+	return targets
+}
@@ -0,0 +1,185 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// defaultReaddressTimeout bounds how long a node address change waits for
+// the DRBD connections of the node to come back
+const defaultReaddressTimeout = 2 * time.Minute
+
+// NodeAddressResult is the outcome of moving a node to a new address
+type NodeAddressResult struct {
+	PreviousAddress string
+	Resources       []string // Resources whose configs were rewritten
+	Actions         []string
+	Unrecovered     []string // "resource: peer state" of connections still down at the timeout
+}
+
+// SetNodeAddress moves a registered node to a new IP address, e.g. after its
+// NIC was renumbered. The node must already answer on the new address under
+// its name. The node records and host mappings are updated, the DRBD config of
+// every resource on the node is regenerated and written to all of its nodes,
+// HA promoter configs are rewritten, and drbdadm adjust switches the
+// connections to the new address. Finally the connections of the node are
+// waited for; the ones that did not recover are returned.
+func (c *Controller) SetNodeAddress(ctx context.Context, name, address string, timeout time.Duration) (*NodeAddressResult, error) {
+	if c.deployment == nil {
+		return nil, fmt.Errorf("deployment client not set")
+	}
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	if net.ParseIP(address) == nil {
+		return nil, fmt.Errorf("invalid IP address: %s", address)
+	}
+	if timeout <= 0 {
+		timeout = defaultReaddressTimeout
+	}
+
+	previous := c.nodes.GetNodeAddressByName(name)
+	if previous == "" {
+		return nil, fmt.Errorf("node %s is not registered", name)
+	}
+	if previous == address {
+		return nil, fmt.Errorf("node %s already has address %s", name, address)
+	}
+	if other, err := c.nodes.GetNode(ctx, address); err == nil {
+		return nil, fmt.Errorf("address %s is already used by node %s", address, other.Name)
+	}
+	if err := c.checkNodeHostname(ctx, name, address); err != nil {
+		return nil, fmt.Errorf("node %s must be reachable on %s first: %w", name, address, err)
+	}
+
+	dbResources, err := c.db.ListResources(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resources: %w", err)
+	}
+	var resources []string
+	for _, res := range dbResources {
+		if containsString(strings.Split(res.Nodes, ","), name) {
+			resources = append(resources, res.Name)
+		}
+	}
+	sort.Strings(resources)
+
+	c.logger.Info("Changing node address",
+		zap.String("node", name),
+		zap.String("from", previous),
+		zap.String("to", address),
+		zap.Strings("resources", resources))
+
+	if err := c.nodes.changeAddress(ctx, name, previous, address); err != nil {
+		return nil, err
+	}
+	result := &NodeAddressResult{
+		PreviousAddress: previous,
+		Actions:         []string{fmt.Sprintf("moved node %s from %s to %s", name, previous, address)},
+	}
+
+	var failed []string
+	for _, resource := range resources {
+		if err := c.readdressResource(ctx, resource); err != nil {
+			c.logger.Warn("Failed to move resource to the new node address",
+				zap.String("resource", resource),
+				zap.Error(err))
+			failed = append(failed, fmt.Sprintf("%s: %v", resource, err))
+			continue
+		}
+		result.Resources = append(result.Resources, resource)
+		result.Actions = append(result.Actions, fmt.Sprintf("rewrote and adjusted %s", resource))
+	}
+
+	// The peers reconnect to the new address on their own
+	var down []string
+	_ = pollUntil(ctx, timeout, func() (bool, error) {
+		down = c.nodeConnectionsDown(ctx, name, result.Resources)
+		return len(down) == 0, nil
+	})
+	result.Unrecovered = down
+
+	message := fmt.Sprintf("Node %s moved from %s to %s", name, previous, address)
+	details := map[string]string{
+		"node":      name,
+		"from":      previous,
+		"to":        address,
+		"resources": strings.Join(result.Resources, ","),
+	}
+	if len(down) > 0 {
+		details["unrecovered"] = strings.Join(down, "; ")
+	}
+	c.RecordEvent(ctx, EventNodeAddressChanged, "", message, details)
+
+	if len(failed) > 0 {
+		return result, fmt.Errorf("node address changed but resources failed: %s", strings.Join(failed, "; "))
+	}
+	return result, nil
+}
+
+// readdressResource writes the regenerated DRBD and promoter configs of a
+// resource to its nodes and adjusts it
+func (c *Controller) readdressResource(ctx context.Context, resource string) error {
+	rm := c.resources
+	export, err := rm.ExportResource(ctx, resource)
+	if err != nil {
+		return err
+	}
+	nodes, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return err
+	}
+	addresses := make([]string, len(nodes))
+	for i, node := range nodes {
+		addresses[i] = rm.nodeAddress(node)
+	}
+
+	if _, err := c.deployment.DistributeConfig(ctx, addresses, export.DrbdConfig, export.DrbdConfigPath); err != nil {
+		return fmt.Errorf("failed to distribute DRBD config: %w", err)
+	}
+	adjust, err := c.deployment.DRBDAdjust(c.stepContext(ctx, StepDrbdUp), addresses, resource)
+	if err != nil {
+		return fmt.Errorf("failed to adjust resource: %w", err)
+	}
+	if !adjust.AllSuccess() {
+		return fmt.Errorf("drbdadm adjust failed on hosts: %v", adjust.FailedHosts())
+	}
+
+	if err := rm.refreshPromoterConfig(ctx, resource); err != nil {
+		c.logger.Warn("Failed to refresh promoter config",
+			zap.String("resource", resource),
+			zap.Error(err))
+	}
+	return nil
+}
+
+// nodeConnectionsDown returns the connections of a node's resources that are
+// not Connected, as "resource: peer state"
+func (c *Controller) nodeConnectionsDown(ctx context.Context, node string, resources []string) []string {
+	var down []string
+	for _, resource := range resources {
+		output, err := c.resources.nodeStatus(ctx, resource, node)
+		if err != nil {
+			down = append(down, fmt.Sprintf("%s: status unavailable", resource))
+			continue
+		}
+		peers := parseDrbdConnections(output)[resource]
+		names := make([]string, 0, len(peers))
+		for peer := range peers {
+			names = append(names, peer)
+		}
+		sort.Strings(names)
+		for _, peer := range names {
+			if peers[peer] != "Connected" {
+				down = append(down, fmt.Sprintf("%s: %s %s", resource, peer, peers[peer]))
+			}
+		}
+	}
+	return down
+}
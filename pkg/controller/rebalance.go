@@ -0,0 +1,342 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RebalanceMove is a planned move of a resource's Primary role
+type RebalanceMove struct {
+	Resource string
+	From     string
+	To       string
+	Done     bool
+	Error    string
+}
+
+// RebalancePlan is the outcome of a rebalance evaluation
+type RebalancePlan struct {
+	Primaries map[string]int // Primaries per reachable node before the moves
+	Moves     []*RebalanceMove
+	Skipped   []string // Resources left out and why
+}
+
+// drbdLocalState is the local role and disk state of a resource on a node
+type drbdLocalState struct {
+	Role     string
+	UpToDate bool
+}
+
+// runRebalancer periodically evaluates the Primary placement until the
+// controller is stopped. Suggested moves are performed when auto is set,
+// otherwise they are logged and recorded as events.
+func (c *Controller) runRebalancer(interval time.Duration, auto bool, maxMoves, threshold int) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastSuggestion := ""
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// No automatic moves during maintenance
+		if c.FreezeState().Frozen {
+			c.logger.Debug("Controller frozen, skipping rebalance pass")
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(c.ctx, interval)
+		if auto {
+			if _, err := c.resources.Rebalance(ctx, maxMoves, threshold, false); err != nil {
+				c.logger.Warn("Rebalance pass failed", zap.Error(err))
+			}
+			cancel()
+			continue
+		}
+
+		plan, err := c.resources.PlanRebalance(ctx, maxMoves, threshold)
+		cancel()
+		if err != nil {
+			c.logger.Warn("Rebalance evaluation failed", zap.Error(err))
+			continue
+		}
+
+		var moves []string
+		for _, move := range plan.Moves {
+			moves = append(moves, fmt.Sprintf("%s: %s -> %s", move.Resource, move.From, move.To))
+		}
+		suggestion := strings.Join(moves, ", ")
+		if suggestion != "" && suggestion != lastSuggestion {
+			c.logger.Info("Rebalance suggested", zap.Strings("moves", moves))
+			c.RecordEvent(c.ctx, EventRebalanceSuggested, "", "Suggested Primary moves: "+suggestion, nil)
+		}
+		lastSuggestion = suggestion
+	}
+}
+
+// Rebalance plans Primary moves and, unless dryRun is set, performs them one
+// after the other with a switchover. It stops at the first failed move.
+func (rm *ResourceManager) Rebalance(ctx context.Context, maxMoves, threshold int, dryRun bool) (*RebalancePlan, error) {
+	plan, err := rm.PlanRebalance(ctx, maxMoves, threshold)
+	if err != nil || dryRun {
+		return plan, err
+	}
+
+	for _, move := range plan.Moves {
+		result, err := rm.switchover(ctx, move.Resource, move.To, defaultDrSyncTimeout)
+		details := drEventDetails(result)
+		if err != nil {
+			move.Error = err.Error()
+			rm.controller.RecordEvent(ctx, EventRebalanceFailed, move.Resource,
+				fmt.Sprintf("Rebalance move from %s to %s failed: %v", move.From, move.To, err), details)
+			return plan, fmt.Errorf("failed to move %s to %s: %w", move.Resource, move.To, err)
+		}
+		move.Done = true
+		rm.controller.RecordEvent(ctx, EventRebalanceMoved, move.Resource,
+			fmt.Sprintf("Rebalanced Primary from %s to %s", move.From, move.To), details)
+	}
+	return plan, nil
+}
+
+// PlanRebalance evaluates where the Primaries of all resources run and plans
+// up to maxMoves moves from the busiest to the idlest nodes while their
+// Primary counts differ by at least threshold. A move is only planned to an
+// online node that is UpToDate, allowed by the placement rules and not under
+// maintenance; HA resources with start dependencies are left where they are.
+func (rm *ResourceManager) PlanRebalance(ctx context.Context, maxMoves, threshold int) (*RebalancePlan, error) {
+	if rm.controller.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	if maxMoves < 1 {
+		maxMoves = 1
+	}
+	if threshold < 2 {
+		threshold = 2
+	}
+
+	resources, err := rm.controller.db.ListResources(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resources: %w", err)
+	}
+	haConfigs, err := rm.controller.db.ListHaConfigs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list HA configs: %w", err)
+	}
+
+	// Resources started together with others on the same node move as a group
+	dependent := make(map[string]bool)
+	for _, ha := range haConfigs {
+		for _, dep := range ha.DependsOn {
+			dependent[ha.Resource] = true
+			dependent[dep] = true
+		}
+	}
+
+	// Nodes the registry knows as offline or degraded are not touched
+	available := make(map[string]bool)
+	if nodes, err := rm.controller.nodes.ListNodes(ctx); err == nil {
+		for _, node := range nodes {
+			available[node.Name] = node.State == NodeStateOnline
+		}
+	}
+
+	// Nodes under maintenance keep their Primaries but get no new ones
+	maintenance := make(map[string]bool)
+	for node := range rm.controller.maintenanceNodes(ctx) {
+		maintenance[node] = true
+	}
+
+	resourceNodes := make(map[string][]string)
+	var nodeNames []string
+	seen := make(map[string]bool)
+	for _, res := range resources {
+		if res.Nodes == "" {
+			continue
+		}
+		resourceNodes[res.Name] = strings.Split(res.Nodes, ",")
+		for _, node := range resourceNodes[res.Name] {
+			if !seen[node] {
+				seen[node] = true
+				nodeNames = append(nodeNames, node)
+			}
+		}
+	}
+	sort.Strings(nodeNames)
+
+	// One status round trip per node
+	states := make(map[string]map[string]*drbdLocalState)
+	for _, node := range nodeNames {
+		if online, known := available[node]; known && !online {
+			continue
+		}
+		output, err := rm.controller.execOutput(ctx, rm.nodeAddress(node), "sudo drbdsetup status 2>/dev/null")
+		if err != nil {
+			rm.controller.logger.Debug("Failed to read DRBD status, leaving node out of rebalance",
+				zap.String("node", node),
+				zap.Error(err))
+			continue
+		}
+		states[node] = parseDrbdLocalStates(output)
+	}
+
+	plan := &RebalancePlan{Primaries: make(map[string]int)}
+	for node := range states {
+		plan.Primaries[node] = 0
+	}
+
+	primaries := make(map[string]string)
+	var names []string
+	for name := range resourceNodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, node := range resourceNodes[name] {
+			if st := states[node][name]; st != nil && st.Role == "Primary" {
+				primaries[name] = node
+				plan.Primaries[node]++
+				break
+			}
+		}
+	}
+
+	counts := make(map[string]int)
+	for node, n := range plan.Primaries {
+		counts[node] = n
+	}
+	skipped := make(map[string]bool)
+	skip := func(resource, reason string) {
+		if !skipped[resource] {
+			skipped[resource] = true
+			plan.Skipped = append(plan.Skipped, fmt.Sprintf("%s: %s", resource, reason))
+		}
+	}
+
+	moved := make(map[string]bool)
+	for len(plan.Moves) < maxMoves {
+		nodes := make([]string, 0, len(counts))
+		for node := range counts {
+			nodes = append(nodes, node)
+		}
+		sort.Slice(nodes, func(i, j int) bool {
+			if counts[nodes[i]] != counts[nodes[j]] {
+				return counts[nodes[i]] > counts[nodes[j]]
+			}
+			return nodes[i] < nodes[j]
+		})
+
+		move := rm.nextRebalanceMove(ctx, nodes, counts, threshold, names, resourceNodes, primaries, states, dependent, moved, maintenance, skip)
+		if move == nil {
+			break
+		}
+		plan.Moves = append(plan.Moves, move)
+		moved[move.Resource] = true
+		primaries[move.Resource] = move.To
+		counts[move.From]--
+		counts[move.To]++
+	}
+
+	return plan, nil
+}
+
+// nextRebalanceMove returns the first allowed move from a busy to an idle
+// node, trying the busiest source and idlest target first. nodes is sorted
+// by Primary count, busiest first.
+func (rm *ResourceManager) nextRebalanceMove(ctx context.Context, nodes []string, counts map[string]int, threshold int,
+	resources []string, resourceNodes map[string][]string, primaries map[string]string,
+	states map[string]map[string]*drbdLocalState, dependent, moved, maintenance map[string]bool, skip func(resource, reason string)) *RebalanceMove {
+	for _, from := range nodes {
+		for i := len(nodes) - 1; i >= 0; i-- {
+			to := nodes[i]
+			if counts[from]-counts[to] < threshold {
+				break
+			}
+			if maintenance[to] {
+				continue
+			}
+			for _, resource := range resources {
+				if primaries[resource] != from || moved[resource] || !containsString(resourceNodes[resource], to) {
+					continue
+				}
+				if dependent[resource] {
+					skip(resource, "HA start dependencies, moves with its group only")
+					continue
+				}
+				if st := states[to][resource]; st == nil || !st.UpToDate {
+					continue
+				}
+				if err := rm.checkPlacement(ctx, resource, to); err != nil {
+					continue
+				}
+				return &RebalanceMove{Resource: resource, From: from, To: to}
+			}
+		}
+	}
+	return nil
+}
+
+// parseDrbdLocalStates parses the output of drbdsetup status for all
+// resources of a node into their local role and whether all local volumes
+// are UpToDate
+func parseDrbdLocalStates(output string) map[string]*drbdLocalState {
+	states := make(map[string]*drbdLocalState)
+
+	disks := make(map[*drbdLocalState][]string)
+	var current *drbdLocalState
+	inPeer := false
+	for _, line := range strings.Split(output, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+
+		// Resource lines are not indented: "r0 role:Primary"
+		if !strings.HasPrefix(line, " ") {
+			current = &drbdLocalState{Role: "Unknown"}
+			states[fields[0]] = current
+			inPeer = false
+			for _, f := range fields[1:] {
+				if role, ok := strings.CutPrefix(f, "role:"); ok {
+					current.Role = role
+				}
+			}
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		// Peer sections start with "<peer> role:..." or "<peer> connection:..."
+		if len(fields) >= 2 && (strings.HasPrefix(fields[1], "role:") || strings.HasPrefix(fields[1], "connection:")) {
+			inPeer = true
+		}
+		if inPeer {
+			continue
+		}
+		for _, f := range fields {
+			if disk, ok := strings.CutPrefix(f, "disk:"); ok {
+				disks[current] = append(disks[current], disk)
+			}
+		}
+	}
+
+	// Diskless resources have no local disk to be UpToDate
+	for state, list := range disks {
+		state.UpToDate = true
+		for _, disk := range list {
+			if disk != "UpToDate" {
+				state.UpToDate = false
+			}
+		}
+	}
+
+	return states
+}
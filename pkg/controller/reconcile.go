@@ -0,0 +1,515 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// Drift states
+const (
+	// DriftStateDegraded means the object is configured but not running as recorded
+	DriftStateDegraded = "Degraded"
+	// DriftStateDrifted means on-node state is missing or differs from the record
+	DriftStateDrifted = "Drifted"
+)
+
+// Kinds of objects compared by the reconciler
+const (
+	DriftKindResource   = "resource"
+	DriftKindHa         = "ha"
+	DriftKindGateway    = "gateway"
+	DriftKindNode       = "node"
+	DriftKindDrbdGlobal = "drbd-global"
+)
+
+// Drift is a discrepancy between a database record and a node
+type Drift struct {
+	Kind       string
+	Name       string
+	Node       string
+	State      string
+	Problem    string
+	DetectedAt time.Time
+}
+
+// key identifies a drift across reconcile passes
+func (d *Drift) key() string {
+	return strings.Join([]string{d.Kind, d.Name, d.Node, d.Problem}, "/")
+}
+
+// ReconcileReport is the result of a reconcile pass
+type ReconcileReport struct {
+	CheckedAt time.Time
+	Drifts    []*Drift
+}
+
+// driftCheck is an expectation about one node: test is a shell condition
+// that holds when the node matches the record
+type driftCheck struct {
+	drift *Drift
+	test  string
+}
+
+// runReconciler periodically compares the database with the nodes until the
+// controller is stopped. Stopped resources found up are taken down again.
+func (c *Controller) runReconciler(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		ctx, cancel := context.WithTimeout(c.ctx, interval)
+		report, err := c.Reconcile(ctx)
+		if err != nil {
+			c.logger.Warn("Reconcile pass failed", zap.Error(err))
+		} else if !c.FreezeState().Frozen {
+			c.enforceStopped(ctx, report)
+		}
+		cancel()
+	}
+}
+
+// Reconcile compares resources, HA configs and gateways in the database with
+// the configs and DRBD state found on their nodes. Drifts not seen in the
+// previous pass are recorded as events. Nothing is changed on the nodes.
+func (c *Controller) Reconcile(ctx context.Context) (*ReconcileReport, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+
+	checks, err := c.driftChecks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// One round trip per node
+	byNode := make(map[string][]*driftCheck)
+	var nodes []string
+	for _, check := range checks {
+		if _, ok := byNode[check.drift.Node]; !ok {
+			nodes = append(nodes, check.drift.Node)
+		}
+		byNode[check.drift.Node] = append(byNode[check.drift.Node], check)
+	}
+
+	var drifts []*Drift
+	for _, node := range nodes {
+		drifts = append(drifts, c.runDriftChecks(ctx, node, byNode[node])...)
+	}
+
+	sort.Slice(drifts, func(i, j int) bool {
+		return drifts[i].key() < drifts[j].key()
+	})
+
+	report := &ReconcileReport{CheckedAt: time.Now(), Drifts: drifts}
+
+	c.driftMu.Lock()
+	previous := make(map[string]*Drift)
+	if c.drift != nil {
+		for _, d := range c.drift.Drifts {
+			previous[d.key()] = d
+		}
+	}
+	var detected []*Drift
+	for _, d := range drifts {
+		if p, ok := previous[d.key()]; ok {
+			d.DetectedAt = p.DetectedAt
+		} else {
+			d.DetectedAt = report.CheckedAt
+			detected = append(detected, d)
+		}
+	}
+	c.drift = report
+	c.driftMu.Unlock()
+
+	for _, d := range detected {
+		c.RecordEvent(ctx, EventStateDrift, driftResource(ctx, c.db, d),
+			fmt.Sprintf("%s %s on %s: %s", d.Kind, d.Name, d.Node, d.Problem),
+			map[string]string{"kind": d.Kind, "name": d.Name, "node": d.Node, "state": d.State})
+	}
+
+	return report, nil
+}
+
+// DriftReport returns the result of the last reconcile pass, nil before the first
+func (c *Controller) DriftReport() *ReconcileReport {
+	c.driftMu.RLock()
+	defer c.driftMu.RUnlock()
+	return c.drift
+}
+
+// driftChecks builds the expectations of every record on every node
+func (c *Controller) driftChecks(ctx context.Context) ([]*driftCheck, error) {
+	resources, err := c.db.ListResources(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resources: %w", err)
+	}
+	haConfigs, err := c.db.ListHaConfigs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list HA configs: %w", err)
+	}
+	gateways, err := c.db.ListGateways(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list gateways: %w", err)
+	}
+
+	resourceNodes := make(map[string][]string)
+	stopped := make(map[string]bool)
+	var checks []*driftCheck
+	add := func(kind, name, node, state, problem, test string) {
+		checks = append(checks, &driftCheck{
+			drift: &Drift{Kind: kind, Name: name, Node: node, State: state, Problem: problem},
+			test:  test,
+		})
+	}
+
+	for _, res := range resources {
+		if res.Nodes == "" {
+			continue
+		}
+		nodes := strings.Split(res.Nodes, ",")
+		resourceNodes[res.Name] = nodes
+		stopped[res.Name] = resourceStopped(res)
+		for _, node := range nodes {
+			add(DriftKindResource, res.Name, node, DriftStateDrifted, "DRBD config missing",
+				fmt.Sprintf("test -f /etc/drbd.d/%s.res", res.Name))
+			if stopped[res.Name] {
+				add(DriftKindResource, res.Name, node, DriftStateDrifted, driftUpWhileStopped,
+					fmt.Sprintf("! sudo drbdsetup status %s >/dev/null 2>&1", res.Name))
+				continue
+			}
+			add(DriftKindResource, res.Name, node, DriftStateDegraded, "resource not up",
+				fmt.Sprintf("sudo drbdsetup status %s >/dev/null 2>&1", res.Name))
+		}
+	}
+
+	// The promoters of stopped resources are disabled
+	for _, ha := range haConfigs {
+		if stopped[ha.Resource] {
+			continue
+		}
+		for _, node := range resourceNodes[ha.Resource] {
+			add(DriftKindHa, ha.Resource, node, DriftStateDrifted, "promoter plugin missing",
+				"test -f "+haConfigPath(haConfigName(ha)))
+			if ha.MountPoint != "" {
+				add(DriftKindHa, ha.Resource, node, DriftStateDrifted, "mount unit missing",
+					fmt.Sprintf("test -f %s", haMountUnitPath(ha.MountPoint)))
+			}
+		}
+	}
+
+	for _, gw := range gateways {
+		nodes, ok := resourceNodes[gw.Resource]
+		if !ok || stopped[gw.Resource] {
+			continue
+		}
+		for _, node := range nodes {
+			add(DriftKindGateway, gw.Name, node, DriftStateDrifted, "gateway plugin missing",
+				fmt.Sprintf("test -f %s", gatewayPluginPath(gw)))
+			// The plugin mounts the directories recorded with the gateway
+			add(DriftKindGateway, gw.Name, node, DriftStateDrifted, "gateway plugin mounts other directories",
+				fmt.Sprintf("! test -f %[1]s || grep -qF 'directory=%[2]s ' %[1]s", gatewayPluginPath(gw), gatewayMounts(gw)[0]))
+		}
+	}
+
+	// Every node carries the managed global config, once one is defined
+	if global, err := c.db.GetDrbdGlobalConfig(ctx, 0); err == nil {
+		nodes, err := c.nodes.ListNodes(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list nodes: %w", err)
+		}
+		for _, node := range nodes {
+			add(DriftKindDrbdGlobal, drbdGlobalName, node.Name, DriftStateDrifted,
+				fmt.Sprintf("%s differs from version %d", drbdGlobalPath, global.Version),
+				fmt.Sprintf("echo '%s  %s' | sha256sum -c --status 2>/dev/null", drbdGlobalChecksum(global), drbdGlobalPath))
+		}
+	}
+
+	return checks, nil
+}
+
+// runDriftChecks evaluates the checks of one node in a single command and
+// returns the expectations that do not hold
+func (c *Controller) runDriftChecks(ctx context.Context, node string, checks []*driftCheck) []*Drift {
+	var script strings.Builder
+	for i, check := range checks {
+		fmt.Fprintf(&script, "%s || echo %d; ", check.test, i)
+	}
+
+	output, err := c.execOutput(ctx, c.resources.nodeAddress(node), script.String())
+	if err != nil {
+		return []*Drift{{
+			Kind:    DriftKindNode,
+			Name:    node,
+			Node:    node,
+			State:   DriftStateDegraded,
+			Problem: "node unreachable, its objects were not checked",
+		}}
+	}
+
+	var drifts []*Drift
+	for _, line := range strings.Fields(output) {
+		i, err := strconv.Atoi(line)
+		if err != nil || i < 0 || i >= len(checks) {
+			continue
+		}
+		drifts = append(drifts, checks[i].drift)
+	}
+	return drifts
+}
+
+// Repair converges an object back to its database record and returns the
+// actions taken. kind is resource, ha, gateway or drbd-global.
+func (c *Controller) Repair(ctx context.Context, kind, name string) ([]string, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+
+	// Rewriting a promoter would enable it again
+	if kind == DriftKindHa {
+		if res, err := c.db.GetResource(ctx, name); err == nil && resourceStopped(res) {
+			return nil, fmt.Errorf("resource %s is stopped, start it with sds resource start", name)
+		}
+	}
+	if kind == DriftKindGateway {
+		if gw, err := c.gatewayRecord(ctx, name); err == nil {
+			if res, err := c.db.GetResource(ctx, gw.Resource); err == nil && resourceStopped(res) {
+				return nil, fmt.Errorf("resource %s of gateway %s is stopped, start it with sds resource start", gw.Resource, name)
+			}
+		}
+	}
+
+	var actions []string
+	var err error
+	switch kind {
+	case DriftKindResource:
+		actions, err = c.repairResource(ctx, name)
+	case DriftKindHa:
+		actions, err = c.repairHa(ctx, name)
+	case DriftKindGateway:
+		actions, err = c.repairGateway(ctx, name)
+	case DriftKindDrbdGlobal:
+		actions, err = c.repairDrbdGlobal(ctx)
+	default:
+		return nil, fmt.Errorf("unknown kind %q (resource, ha, gateway, drbd-global)", kind)
+	}
+	if err != nil {
+		return actions, err
+	}
+
+	// Drop the repaired drifts, the next pass confirms the result
+	c.driftMu.Lock()
+	if c.drift != nil {
+		var remaining []*Drift
+		for _, d := range c.drift.Drifts {
+			if d.Kind != kind || d.Name != name {
+				remaining = append(remaining, d)
+			}
+		}
+		c.drift = &ReconcileReport{CheckedAt: c.drift.CheckedAt, Drifts: remaining}
+	}
+	c.driftMu.Unlock()
+
+	resource := name
+	if kind == DriftKindDrbdGlobal {
+		resource = ""
+	}
+	if kind == DriftKindGateway {
+		if gw, err := c.gatewayRecord(ctx, name); err == nil {
+			resource = gw.Resource
+		}
+	}
+	c.RecordEvent(ctx, EventStateRepaired, resource, fmt.Sprintf("Repaired %s %s", kind, name),
+		map[string]string{"kind": kind, "name": name, "actions": strings.Join(actions, "; ")})
+
+	return actions, nil
+}
+
+// repairResource restores missing DRBD configs from a node that still has
+// the config and adjusts the resource on all of its nodes, or takes it down
+// if it is stopped
+func (c *Controller) repairResource(ctx context.Context, name string) ([]string, error) {
+	rm := c.resources
+	nodes, err := rm.resourceNodeNames(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]string, len(nodes))
+	for i, node := range nodes {
+		addresses[i] = rm.nodeAddress(node)
+	}
+
+	actions, err := c.restoreConfig(ctx, fmt.Sprintf("/etc/drbd.d/%s.res", name), nodes, addresses)
+	if err != nil {
+		return actions, err
+	}
+
+	if res, err := c.db.GetResource(ctx, name); err == nil && resourceStopped(res) {
+		result, err := c.deployment.DRBDDown(ctx, addresses, name)
+		if err != nil {
+			return actions, fmt.Errorf("failed to bring down resource: %w", err)
+		}
+		if !result.AllSuccess() {
+			return actions, fmt.Errorf("drbdadm down failed on hosts: %v", result.FailedHosts())
+		}
+		return append(actions, fmt.Sprintf("took stopped %s down on %s", name, strings.Join(nodes, ", "))), nil
+	}
+
+	result, err := c.deployment.DRBDAdjust(c.stepContext(ctx, StepDrbdUp), addresses, name)
+	if err != nil {
+		return actions, fmt.Errorf("failed to adjust resource: %w", err)
+	}
+	if !result.AllSuccess() {
+		return actions, fmt.Errorf("drbdadm adjust failed on hosts: %v", result.FailedHosts())
+	}
+	actions = append(actions, fmt.Sprintf("adjusted %s on %s", name, strings.Join(nodes, ", ")))
+
+	return actions, nil
+}
+
+// repairHa rewrites the promoter plugin and mount unit of an HA resource
+// from its stored HA configuration
+func (c *Controller) repairHa(ctx context.Context, resource string) ([]string, error) {
+	rm := c.resources
+	haCfg, err := c.db.GetHaConfig(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("resource %s is not HA-managed", resource)
+	}
+
+	var actions []string
+	if haCfg.MountPoint != "" {
+		addresses, err := rm.ResourceHosts(ctx, resource)
+		if err != nil {
+			return nil, err
+		}
+		mountPath := haMountUnitPath(haCfg.MountPoint)
+		content := rm.generateSystemdMountUnit(resource, haCfg.MountPoint, haCfg.FsType)
+		if _, err := c.deployment.DistributeConfig(ctx, addresses, content, mountPath); err != nil {
+			return nil, fmt.Errorf("failed to distribute mount unit: %w", err)
+		}
+		if _, err := c.deployment.Exec(ctx, addresses, "systemctl daemon-reload"); err != nil {
+			c.logger.Warn("Failed to reload systemd", zap.Error(err))
+		}
+		actions = append(actions, "rewrote "+mountPath)
+	}
+
+	if err := rm.refreshPromoterConfig(ctx, resource); err != nil {
+		return actions, err
+	}
+	actions = append(actions, fmt.Sprintf("rewrote promoter plugin %s and reloaded drbd-reactor", haConfigName(haCfg)))
+
+	return actions, nil
+}
+
+// repairGateway restores a missing gateway plugin from a node that still has it
+func (c *Controller) repairGateway(ctx context.Context, name string) ([]string, error) {
+	gw, err := c.gatewayRecord(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := c.resources.resourceNodeNames(ctx, gw.Resource)
+	if err != nil {
+		return nil, err
+	}
+	addresses := make([]string, len(nodes))
+	for i, node := range nodes {
+		addresses[i] = c.resources.nodeAddress(node)
+	}
+
+	actions, err := c.restoreConfig(ctx, gatewayPluginPath(gw), nodes, addresses)
+	if err != nil {
+		return actions, err
+	}
+
+	if _, err := c.deployment.ReactorReload(ctx, addresses); err != nil {
+		c.logger.Warn("Failed to reload drbd-reactor", zap.Error(err))
+	}
+	actions = append(actions, "reloaded drbd-reactor")
+
+	return actions, nil
+}
+
+// repairDrbdGlobal rewrites the latest global config on all nodes. The
+// running resources are not adjusted, they run with the recorded options.
+func (c *Controller) repairDrbdGlobal(ctx context.Context) ([]string, error) {
+	cfg, err := c.db.GetDrbdGlobalConfig(ctx, 0)
+	if err != nil {
+		return nil, fmt.Errorf("no DRBD global config defined")
+	}
+
+	failed, err := c.distributeDrbdGlobalConfig(ctx, cfg, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(failed) > 0 {
+		return nil, fmt.Errorf("failed to rewrite %s on %s", drbdGlobalPath, strings.Join(failed, ", "))
+	}
+	return []string{fmt.Sprintf("rewrote %s version %d on all nodes", drbdGlobalPath, cfg.Version)}, nil
+}
+
+// restoreConfig copies a config file from a node that has it to the nodes
+// that lack it. It fails if no node has the file anymore.
+func (c *Controller) restoreConfig(ctx context.Context, path string, nodes, addresses []string) ([]string, error) {
+	var content string
+	var missing, missingNodes []string
+	for i, addr := range addresses {
+		output, err := c.execOutput(ctx, addr, "sudo cat "+path)
+		if err != nil {
+			missing = append(missing, addr)
+			missingNodes = append(missingNodes, nodes[i])
+			continue
+		}
+		if content == "" {
+			content = output
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil, nil
+	}
+	if content == "" {
+		return nil, fmt.Errorf("%s is missing on all nodes, recreate the object", path)
+	}
+
+	result, err := c.deployment.DistributeConfig(ctx, missing, content, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore %s: %w", path, err)
+	}
+	if !result.Success {
+		return nil, fmt.Errorf("failed to restore %s on some hosts", path)
+	}
+
+	return []string{fmt.Sprintf("restored %s on %s", path, strings.Join(missingNodes, ", "))}, nil
+}
+
+// gatewayPluginPath returns the drbd-reactor plugin file of a gateway
+func gatewayPluginPath(gw *database.Gateway) string {
+	return fmt.Sprintf("/etc/drbd-reactor.d/sds-%s-%s.toml", gw.Type, gw.Resource)
+}
+
+// driftResource returns the resource a drift is recorded against in the events log
+func driftResource(ctx context.Context, db *database.DB, d *Drift) string {
+	if d.Kind == DriftKindGateway {
+		if gw, err := db.GetGateway(ctx, d.Name); err == nil {
+			return gw.Resource
+		}
+	}
+	if d.Kind == DriftKindNode || d.Kind == DriftKindDrbdGlobal {
+		return ""
+	}
+	return d.Name
+}
@@ -0,0 +1,617 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/liliang-cn/sds/pkg/config"
+	"github.com/liliang-cn/sds/pkg/database"
+	"github.com/pelletier/go-toml/v2"
+	"go.uber.org/zap"
+)
+
+// Kinds of records Rediscover rebuilds
+const (
+	RediscoverResource = "resource"
+	RediscoverVolume   = "volume"
+	RediscoverHaConfig = "ha-config"
+)
+
+// Rediscovered is a database record missing for an artifact sds left on the
+// nodes
+type Rediscovered struct {
+	Kind     string
+	Name     string   // Resource, resource/volume or HA config name
+	Nodes    []string // Nodes the artifact was found on
+	Source   string   // Path of the artifact
+	Detail   string
+	Skipped  string // Why the record cannot be rebuilt
+	Restored bool
+	Error    string
+}
+
+// rediscoverCmd prints the DRBD configs, drbd-reactor plugins and DRBD
+// mount units of a node like bundleNodeCmd, then its LVs and zvols
+const rediscoverCmd = `m='` + bundleFileMarker + `'
+for f in /etc/drbd.d/*.res /etc/drbd-reactor.d/*.toml $(grep -ls '^What=/dev/drbd' /etc/systemd/system/*.mount); do
+  [ -f "$f" ] && { echo "${m}$f"; sudo cat "$f" 2>/dev/null; }
+done
+echo "${m}lvs"; sudo lvs --noheadings --units b --nosuffix --separator ' ' -o vg_name,lv_name,lv_attr,lv_size 2>/dev/null
+echo "${m}zvols"; sudo zfs list -Hp -t volume -o name,volsize,refreservation 2>/dev/null
+true`
+
+// generatedStampPattern matches the header sds writes into its configs and
+// captures the cluster, see clusterStamp
+var generatedStampPattern = regexp.MustCompile(`(?m)^# Generated by sds-controller for cluster (\S+)`)
+
+// rediscoverNode is what rediscoverCmd found on a node
+type rediscoverNode struct {
+	name    string
+	files   map[string]string         // Path -> content
+	volumes map[string]*backingVolume // Device path -> LV or zvol
+}
+
+// backingVolume is an LV or zvol found on a node
+type backingVolume struct {
+	storageType string
+	pool        string
+	name        string
+	sizeBytes   uint64
+}
+
+// resConfig is the part of a DRBD config sds records
+type resConfig struct {
+	Name     string
+	Port     int
+	Protocol string
+	Hosts    []string
+	Volumes  []*resConfigVolume
+}
+
+// resConfigVolume is a volume of a DRBD config
+type resConfigVolume struct {
+	ID        int
+	Minor     int
+	Disk      string
+	NodeDisks map[string]string // Disks given in the on sections
+}
+
+// promoterSnippet is the part of a drbd-reactor promoter config sds records
+type promoterSnippet struct {
+	Promoter []struct {
+		Resources map[string]struct {
+			Start              []string `toml:"start"`
+			OnDemoteFailure    string   `toml:"on-drbd-demote-failure"`
+			StopServicesOnExit bool     `toml:"stop-services-on-exit"`
+			SecondaryForce     *bool    `toml:"secondary-force"`
+		} `toml:"resources"`
+	} `toml:"promoter"`
+}
+
+// Rediscover rebuilds missing database records from the artifacts sds left
+// on the registered nodes, e.g. after the controller database was lost or
+// restored from an old backup: resources and volumes from the DRBD configs
+// sds generated for this cluster, with the LVs and zvols backing them, and
+// HA configs from the promoter configs named by naming.ha_config. Existing
+// records are never changed. DRBD options and peer protocols stay in the
+// DRBD configs only. Unless dryRun is set, the missing records are saved.
+func (c *Controller) Rediscover(ctx context.Context, dryRun bool) ([]*Rediscovered, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+
+	nodes, err := c.nodes.ListNodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("no nodes registered, add the nodes first")
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+
+	var inventories []*rediscoverNode
+	for _, node := range nodes {
+		output, err := c.execOutput(ctx, node.Address, rediscoverCmd)
+		if err != nil && output == "" {
+			c.logger.Warn("Failed to list artifacts, skipping node",
+				zap.String("node", node.Name),
+				zap.Error(err))
+			continue
+		}
+		inventories = append(inventories, parseRediscoverNode(node.Name, output))
+	}
+	if len(inventories) == 0 {
+		return nil, fmt.Errorf("no node could be reached")
+	}
+
+	resources, err := c.db.ListResources(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resources: %w", err)
+	}
+	knownResources := make(map[string]bool)
+	for _, res := range resources {
+		knownResources[res.Name] = true
+	}
+
+	found, referenced := c.rediscoverResources(ctx, inventories, knownResources, dryRun)
+	found = append(found, c.rediscoverHaConfigs(ctx, inventories, knownResources, dryRun)...)
+	found = append(found, c.unreferencedVolumes(ctx, inventories, referenced)...)
+
+	if !dryRun {
+		restored := 0
+		for _, r := range found {
+			if r.Restored {
+				restored++
+			}
+		}
+		if restored > 0 {
+			c.RecordEvent(ctx, EventRediscovered, "", fmt.Sprintf("Rebuilt %d database record(s) from the nodes", restored), nil)
+		}
+	}
+
+	return found, nil
+}
+
+// parseRediscoverNode parses the output of rediscoverCmd
+func parseRediscoverNode(node, output string) *rediscoverNode {
+	inv := &rediscoverNode{
+		name:    node,
+		files:   make(map[string]string),
+		volumes: make(map[string]*backingVolume),
+	}
+	for _, f := range parseBundleFiles(output) {
+		switch f.name {
+		case "lvs":
+			for _, line := range strings.Split(string(f.data), "\n") {
+				fields := strings.Fields(line)
+				if len(fields) < 4 {
+					continue
+				}
+				size, _ := strconv.ParseUint(fields[3], 10, 64)
+				storageType := "lvm"
+				// The first attribute character is "V" for thin volumes
+				if strings.HasPrefix(fields[2], "V") {
+					storageType = "lvm-thin"
+				}
+				inv.volumes[fmt.Sprintf("/dev/%s/%s", fields[0], fields[1])] = &backingVolume{
+					storageType: storageType, pool: fields[0], name: fields[1], sizeBytes: size,
+				}
+			}
+		case "zvols":
+			for _, line := range strings.Split(string(f.data), "\n") {
+				fields := strings.Fields(line)
+				if len(fields) < 3 {
+					continue
+				}
+				size, _ := strconv.ParseUint(fields[1], 10, 64)
+				storageType := "zfs"
+				// Sparse zvols reserve nothing
+				if fields[2] == "0" || fields[2] == "-" {
+					storageType = "zfs-thin"
+				}
+				inv.volumes["/dev/zvol/"+fields[0]] = &backingVolume{
+					storageType: storageType, pool: path.Dir(fields[0]), name: path.Base(fields[0]), sizeBytes: size,
+				}
+			}
+		default:
+			inv.files["/"+f.name] = string(f.data)
+		}
+	}
+	return inv
+}
+
+// ownConfig reports whether a config was generated by sds for this cluster
+func (c *Controller) ownConfig(content string) bool {
+	match := generatedStampPattern.FindStringSubmatch(content)
+	return match != nil && match[1] == c.ClusterName()
+}
+
+// rediscoverResources finds the resources and volumes of the DRBD configs on
+// the nodes that the database has no record of. It also returns the backing
+// devices the configs refer to, per node.
+func (c *Controller) rediscoverResources(ctx context.Context, inventories []*rediscoverNode, knownResources map[string]bool, dryRun bool) ([]*Rediscovered, map[string]map[string]bool) {
+	type resFile struct {
+		source string
+		config *resConfig
+		nodes  []string
+	}
+	files := make(map[string]*resFile)
+	byNode := make(map[string]*rediscoverNode)
+	for _, inv := range inventories {
+		byNode[inv.name] = inv
+		for p, content := range inv.files {
+			if !strings.HasPrefix(p, "/etc/drbd.d/") || !strings.HasSuffix(p, ".res") || !c.ownConfig(content) {
+				continue
+			}
+			name := strings.TrimSuffix(path.Base(p), ".res")
+			if f, ok := files[name]; ok {
+				f.nodes = append(f.nodes, inv.name)
+				continue
+			}
+			cfg, err := parseResConfig(content)
+			if err != nil {
+				c.logger.Warn("Failed to parse DRBD config",
+					zap.String("node", inv.name),
+					zap.String("path", p),
+					zap.Error(err))
+				continue
+			}
+			files[name] = &resFile{source: p, config: cfg, nodes: []string{inv.name}}
+		}
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var found []*Rediscovered
+	referenced := make(map[string]map[string]bool)
+	for _, name := range names {
+		f := files[name]
+		cfg := f.config
+
+		if !knownResources[name] {
+			r := &Rediscovered{
+				Kind:   RediscoverResource,
+				Name:   name,
+				Nodes:  f.nodes,
+				Source: f.source,
+				Detail: fmt.Sprintf("port %d, protocol %s, nodes %s", cfg.Port, cfg.Protocol, strings.Join(cfg.Hosts, ",")),
+			}
+			found = append(found, r)
+			if !dryRun {
+				res := &database.Resource{
+					Name:     name,
+					Port:     cfg.Port,
+					Nodes:    strings.Join(cfg.Hosts, ","),
+					Protocol: cfg.Protocol,
+					Replicas: len(cfg.Hosts),
+				}
+				if err := c.db.SaveResource(ctx, res); err != nil {
+					r.Error = err.Error()
+				} else {
+					r.Restored = true
+					knownResources[name] = true
+				}
+			}
+		}
+
+		recorded := make(map[int]bool)
+		if volumes, err := c.db.ListVolumes(ctx, name); err == nil {
+			for _, vol := range volumes {
+				recorded[vol.VolumeID] = true
+			}
+		}
+
+		for _, v := range cfg.Volumes {
+			backing := make(map[string]string)
+			var volume *backingVolume
+			for _, host := range cfg.Hosts {
+				disk := v.Disk
+				if d, ok := v.NodeDisks[host]; ok {
+					disk = d
+				}
+				if disk == "" {
+					continue
+				}
+				backing[host] = disk
+				if referenced[host] == nil {
+					referenced[host] = make(map[string]bool)
+				}
+				referenced[host][disk] = true
+				if inv := byNode[host]; inv != nil && volume == nil {
+					volume = inv.volumes[disk]
+				}
+			}
+			if recorded[v.ID] {
+				continue
+			}
+
+			vol := &database.Volume{
+				ResourceName: name,
+				VolumeID:     v.ID,
+				Device:       fmt.Sprintf("/dev/drbd%d", v.Minor),
+				Backing:      backing,
+			}
+			switch {
+			case volume != nil:
+				vol.VolumeName = volume.name
+				vol.Pool = volume.pool
+				vol.StorageType = volume.storageType
+				vol.SizeGB = int(volume.sizeBytes >> 30)
+			default:
+				vol.StorageType = StorageTypeRaw
+				for _, disk := range backing {
+					vol.VolumeName = path.Base(disk)
+					if strings.HasPrefix(disk, "/dev/loop") {
+						vol.StorageType = StorageTypeFile
+					}
+					break
+				}
+			}
+
+			r := &Rediscovered{
+				Kind:   RediscoverVolume,
+				Name:   fmt.Sprintf("%s/%d", name, v.ID),
+				Nodes:  f.nodes,
+				Source: f.source,
+				Detail: fmt.Sprintf("%s %s, %d GiB", vol.StorageType, path.Join(vol.Pool, vol.VolumeName), vol.SizeGB),
+			}
+			found = append(found, r)
+			if dryRun {
+				continue
+			}
+			if !knownResources[name] {
+				r.Skipped = "the resource record could not be rebuilt"
+				continue
+			}
+			if err := c.db.SaveVolume(ctx, vol); err != nil {
+				r.Error = err.Error()
+			} else {
+				r.Restored = true
+			}
+		}
+	}
+	return found, referenced
+}
+
+// rediscoverHaConfigs finds the HA configs of the promoter configs on the
+// nodes that the database has no record of
+func (c *Controller) rediscoverHaConfigs(ctx context.Context, inventories []*rediscoverNode, knownResources map[string]bool, dryRun bool) []*Rediscovered {
+	type haFile struct {
+		source string
+		config *database.HaConfig
+		nodes  []string
+	}
+	files := make(map[string]*haFile)
+	for _, inv := range inventories {
+		for p, content := range inv.files {
+			if !strings.HasPrefix(p, "/etc/drbd-reactor.d/") || !strings.HasSuffix(p, ".toml") || !c.ownConfig(content) {
+				continue
+			}
+			configName := strings.TrimSuffix(path.Base(p), ".toml")
+			resource, ok := c.matchHaConfigName(configName)
+			if !ok {
+				// Configs recorded before names were configurable
+				resource, ok = config.MatchName(config.DefaultHaConfigName, "", configName)
+			}
+			if !ok {
+				continue
+			}
+			if f, ok := files[configName]; ok {
+				f.nodes = append(f.nodes, inv.name)
+				continue
+			}
+			haCfg, err := parsePromoterConfig(content, resource, inv.files)
+			if err != nil {
+				c.logger.Warn("Failed to parse promoter config",
+					zap.String("node", inv.name),
+					zap.String("path", p),
+					zap.Error(err))
+				continue
+			}
+			haCfg.ConfigName = configName
+			files[configName] = &haFile{source: p, config: haCfg, nodes: []string{inv.name}}
+		}
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var found []*Rediscovered
+	for _, name := range names {
+		f := files[name]
+		haCfg := f.config
+		if _, err := c.db.GetHaConfig(ctx, haCfg.Resource); err == nil {
+			continue
+		}
+
+		var detail []string
+		if haCfg.MountPoint != "" {
+			detail = append(detail, fmt.Sprintf("mount %s (%s)", haCfg.MountPoint, haCfg.FsType))
+		}
+		if haCfg.VIP != "" {
+			detail = append(detail, "VIP "+haCfg.VIP)
+		}
+		if len(haCfg.Services) > 0 {
+			detail = append(detail, "services "+strings.Join(haCfg.Services, ","))
+		}
+		r := &Rediscovered{
+			Kind:   RediscoverHaConfig,
+			Name:   haCfg.Resource,
+			Nodes:  f.nodes,
+			Source: f.source,
+			Detail: strings.Join(detail, ", "),
+		}
+		found = append(found, r)
+		if dryRun {
+			continue
+		}
+		if !knownResources[haCfg.Resource] {
+			r.Skipped = fmt.Sprintf("resource %s has no record and no DRBD config", haCfg.Resource)
+			continue
+		}
+		vip, err := c.reserveImportedVIP(ctx, haCfg.VIP, haVIPOwner(haCfg.Resource))
+		if err != nil {
+			r.Error = err.Error()
+			continue
+		}
+		haCfg.VIP = vip
+		if err := c.db.SaveHaConfig(ctx, haCfg); err != nil {
+			r.Error = err.Error()
+		} else {
+			r.Restored = true
+		}
+	}
+	return found
+}
+
+// unreferencedVolumes lists the LVs and zvols named by the naming template
+// that neither a DRBD config nor a volume record refers to; without a DRBD
+// config there is no resource to rebuild
+func (c *Controller) unreferencedVolumes(ctx context.Context, inventories []*rediscoverNode, referenced map[string]map[string]bool) []*Rediscovered {
+	known, err := c.gcKnownNames(ctx)
+	if err != nil {
+		c.logger.Warn("Failed to list known volumes", zap.Error(err))
+		return nil
+	}
+
+	var found []*Rediscovered
+	for _, inv := range inventories {
+		devices := make([]string, 0, len(inv.volumes))
+		for device := range inv.volumes {
+			devices = append(devices, device)
+		}
+		sort.Strings(devices)
+		for _, device := range devices {
+			volume := inv.volumes[device]
+			if _, ok := c.matchVolumeName(volume.name); !ok || known.volumes[volume.name] || referenced[inv.name][device] ||
+				strings.Contains(volume.name, "-"+safetySnapshotPrefix) {
+				continue
+			}
+			found = append(found, &Rediscovered{
+				Kind:    RediscoverVolume,
+				Name:    volume.name,
+				Nodes:   []string{inv.name},
+				Source:  device,
+				Detail:  fmt.Sprintf("%s, %d GiB", volume.storageType, volume.sizeBytes>>30),
+				Skipped: "no DRBD config refers to it, see sds admin gc",
+			})
+		}
+	}
+	return found
+}
+
+// parseResConfig parses a DRBD config generated by sds
+func parseResConfig(text string) (*resConfig, error) {
+	section, err := parseDrbdResource(text)
+	if err != nil {
+		return nil, err
+	}
+	if len(section.Args) == 0 {
+		return nil, fmt.Errorf("resource section without a name")
+	}
+
+	cfg := &resConfig{Name: section.Args[0], Protocol: "C"}
+	volumes := make(map[int]*resConfigVolume)
+	volume := func(args []string) *resConfigVolume {
+		id := 0
+		if len(args) > 0 {
+			id, _ = strconv.Atoi(args[0])
+		}
+		if volumes[id] == nil {
+			volumes[id] = &resConfigVolume{ID: id, NodeDisks: make(map[string]string)}
+		}
+		return volumes[id]
+	}
+
+	for _, sub := range section.Sections {
+		switch sub.Name {
+		case "net":
+			if p := sub.Options["protocol"]; p != "" {
+				cfg.Protocol = p
+			}
+		case "volume":
+			v := volume(sub.Args)
+			v.Disk = sub.Options["disk"]
+			if minor, ok := strings.CutPrefix(sub.Options["device"], "minor "); ok {
+				v.Minor, _ = strconv.Atoi(minor)
+			}
+		case "on":
+			if len(sub.Args) == 0 {
+				continue
+			}
+			host := sub.Args[0]
+			cfg.Hosts = append(cfg.Hosts, host)
+			if address := sub.Options["address"]; address != "" && cfg.Port == 0 {
+				if i := strings.LastIndex(address, ":"); i >= 0 {
+					cfg.Port, _ = strconv.Atoi(address[i+1:])
+				}
+			}
+			for _, nested := range sub.Sections {
+				if nested.Name == "volume" && nested.Options["disk"] != "" {
+					volume(nested.Args).NodeDisks[host] = nested.Options["disk"]
+				}
+			}
+		}
+	}
+	if len(cfg.Hosts) == 0 {
+		return nil, fmt.Errorf("resource %s has no on sections", cfg.Name)
+	}
+	if cfg.Port == 0 {
+		return nil, fmt.Errorf("resource %s has no port", cfg.Name)
+	}
+
+	for _, v := range volumes {
+		cfg.Volumes = append(cfg.Volumes, v)
+	}
+	sort.Slice(cfg.Volumes, func(i, j int) bool { return cfg.Volumes[i].ID < cfg.Volumes[j].ID })
+	return cfg, nil
+}
+
+// parsePromoterConfig parses a promoter config generated by sds for
+// resource into its HA config, see generatePromoterConfig. The mount point
+// and filesystem are read from the mount unit among files if it was found.
+func parsePromoterConfig(text, resource string, files map[string]string) (*database.HaConfig, error) {
+	var snippet promoterSnippet
+	if err := toml.Unmarshal([]byte(text), &snippet); err != nil {
+		return nil, err
+	}
+
+	for _, promoter := range snippet.Promoter {
+		res, ok := promoter.Resources[resource]
+		if !ok {
+			continue
+		}
+
+		haCfg := &database.HaConfig{
+			Resource:           resource,
+			StopServicesOnExit: res.StopServicesOnExit,
+			NoSecondaryForce:   res.SecondaryForce != nil && !*res.SecondaryForce,
+		}
+		if res.OnDemoteFailure != DefaultHaPolicy.OnDemoteFailure {
+			haCfg.OnDemoteFailure = res.OnDemoteFailure
+		}
+
+		for _, unit := range res.Start {
+			unit = strings.TrimSpace(unit)
+			switch {
+			case strings.HasPrefix(unit, "drbd-services@") && strings.HasSuffix(unit, ".target"):
+				dep := strings.TrimSuffix(strings.TrimPrefix(unit, "drbd-services@"), ".target")
+				haCfg.DependsOn = append(haCfg.DependsOn, strings.ReplaceAll(dep, `\x2d`, "-"))
+			case strings.HasPrefix(unit, "service-ip@") && strings.HasSuffix(unit, ".service"):
+				vip := strings.TrimSuffix(strings.TrimPrefix(unit, "service-ip@"), ".service")
+				if i := strings.LastIndex(vip, "-"); i >= 0 {
+					vip = vip[:i] + "/" + vip[i+1:]
+				}
+				haCfg.VIP = vip
+			case strings.HasSuffix(unit, ".mount") && haCfg.MountPoint == "":
+				haCfg.MountPoint = "/" + strings.ReplaceAll(strings.TrimSuffix(unit, ".mount"), "-", "/")
+				if mount, ok := files["/etc/systemd/system/"+unit]; ok {
+					for _, line := range strings.Split(mount, "\n") {
+						if where, ok := strings.CutPrefix(line, "Where="); ok {
+							haCfg.MountPoint = strings.TrimSpace(where)
+						}
+						if fsType, ok := strings.CutPrefix(line, "Type="); ok {
+							haCfg.FsType = strings.TrimSpace(fsType)
+						}
+					}
+				}
+			default:
+				haCfg.Services = append(haCfg.Services, unit)
+			}
+		}
+		return haCfg, nil
+	}
+	return nil, fmt.Errorf("no promoter for resource %s", resource)
+}
@@ -0,0 +1,175 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdspb "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/gateway"
+)
+
+// Artifacts RenderConfig generates
+const (
+	RenderDrbd     = "drbd"     // DRBD resource config
+	RenderPromoter = "promoter" // drbd-reactor promoter plugin of an HA config
+	RenderNFS      = "nfs"      // NFS gateway
+	RenderISCSI    = "iscsi"    // iSCSI gateway
+	RenderNVMe     = "nvmeof"   // NVMe-oF gateway
+)
+
+// renderBasePort is the DRBD port of a rendered resource without one
+const renderBasePort = 7000
+
+// RenderConfig returns the files the controller would generate for arbitrary
+// inputs, for inspecting the config generators: nothing is looked up in the
+// database, reserved or written. Nodes are names, or name=address to give
+// their address; otherwise the registered address or the name is used.
+func (rm *ResourceManager) RenderConfig(ctx context.Context, req *sdspb.RenderConfigRequest) ([]*sdspb.PlannedFile, error) {
+	if req.Resource == "" {
+		return nil, fmt.Errorf("resource name is required")
+	}
+	port := req.Port
+	if port == 0 {
+		port = renderBasePort
+	}
+	if port < renderBasePort {
+		return nil, fmt.Errorf("port %d is below %d, the minor is the port minus %d", port, renderBasePort, renderBasePort)
+	}
+	nodes, addresses, err := parseRenderNodes(req.Nodes)
+	if err != nil {
+		return nil, err
+	}
+	drbdDevice := fmt.Sprintf("/dev/drbd%d", port-renderBasePort)
+	// Gateways need volume 0 for their state and one to serve
+	gatewayVolumes := int(req.Volumes)
+	if gatewayVolumes == 0 {
+		gatewayVolumes = 2
+	}
+
+	switch req.Kind {
+	case "", RenderDrbd:
+		config, err := rm.renderDrbdConfig(req, port, nodes, addresses)
+		if err != nil {
+			return nil, err
+		}
+		return []*sdspb.PlannedFile{{Path: fmt.Sprintf("/etc/drbd.d/%s.res", req.Resource), Content: config}}, nil
+
+	case RenderPromoter:
+		if len(nodes) == 0 {
+			return nil, fmt.Errorf("at least one node is required")
+		}
+		nodeAddresses := make([]string, len(nodes))
+		for i, node := range nodes {
+			nodeAddresses[i] = addresses[node]
+			if nodeAddresses[i] == "" {
+				nodeAddresses[i] = rm.nodeAddress(node)
+			}
+		}
+		config := rm.generatePromoterConfig(req.Resource, nodeAddresses, req.Services, req.MountPoint, req.FsType, req.Vip,
+			req.DependsOn, nodes, DefaultHaPolicy)
+		return []*sdspb.PlannedFile{{Path: haConfigPath(rm.controller.newHaConfigName(req.Resource)), Content: config}}, nil
+
+	case RenderNFS:
+		gw := req.Nfs
+		if gw == nil {
+			gw = &sdspb.CreateNFSGatewayRequest{}
+		}
+		gw.Resource = req.Resource
+		gw.ExportBasePath, gw.ClusterPrivatePath = rm.renderGatewayPaths(gw.ExportBasePath, gw.ClusterPrivatePath)
+		return gateway.RenderNFSGateway(gw, drbdDevice)
+
+	case RenderISCSI:
+		gw := req.Iscsi
+		if gw == nil {
+			gw = &sdspb.CreateISCSIGatewayRequest{}
+		}
+		gw.Resource = req.Resource
+		gw.ClusterPrivatePath, _ = rm.renderGatewayPaths("", gw.ClusterPrivatePath)
+		return gateway.RenderISCSIGateway(gw, drbdDevice, gatewayVolumes)
+
+	case RenderNVMe:
+		gw := req.Nvmeof
+		if gw == nil {
+			gw = &sdspb.CreateNVMeGatewayRequest{}
+		}
+		gw.Resource = req.Resource
+		gw.ClusterPrivatePath, _ = rm.renderGatewayPaths("", gw.ClusterPrivatePath)
+		return gateway.RenderNVMeGateway(gw, drbdDevice, gatewayVolumes)
+	}
+
+	return nil, fmt.Errorf("unknown kind %q, use %s, %s, %s, %s or %s", req.Kind, RenderDrbd, RenderPromoter, RenderNFS, RenderISCSI, RenderNVMe)
+}
+
+// renderDrbdConfig generates the DRBD config of a rendered resource the way
+// CreateResource and AddVolume write it. Volumes after volume 0 get the
+// minors following it and the volume name with their number appended.
+func (rm *ResourceManager) renderDrbdConfig(req *sdspb.RenderConfigRequest, port uint32, nodes []string, addresses map[string]string) (string, error) {
+	if len(nodes) == 0 {
+		return "", fmt.Errorf("at least one node is required")
+	}
+	protocol := req.Protocol
+	if protocol == "" {
+		protocol = "C"
+	}
+	pool := req.Pool
+	if pool == "" {
+		pool = "data-pool"
+	}
+	storageType := req.StorageType
+	if storageType == "" {
+		storageType = "lvm"
+	}
+	if storageType != "lvm" && storageType != "zfs" {
+		return "", fmt.Errorf("storage type %q cannot be rendered, use lvm or zfs", storageType)
+	}
+	peerProtocols, err := normalizePeerProtocols(nodes, protocol, req.PeerProtocols)
+	if err != nil {
+		return "", err
+	}
+	if err := validateDrbdHandlerOptions(req.DrbdOptions); err != nil {
+		return "", err
+	}
+
+	volumeName := rm.controller.newVolumeName(req.Resource)
+	config := rm.generateDrbdConfig(req.Resource, port, nodes, protocol, peerProtocols, pool, volumeName, storageType, req.DrbdOptions, nil, addresses)
+
+	var extra []string
+	for id := 1; id < int(req.Volumes); id++ {
+		minor := strconv.Itoa(int(port-renderBasePort) + id)
+		extra = append(extra, volumeSection(id, minor, backingDevicePath(storageType, pool, fmt.Sprintf("%s_%d", volumeName, id))))
+	}
+	return appendVolumeSections(config, extra), nil
+}
+
+// renderGatewayPaths applies the controller's default gateway directories
+func (rm *ResourceManager) renderGatewayPaths(exportBase, clusterPrivate string) (string, string) {
+	if exportBase == "" {
+		exportBase = rm.controller.config.Gateway.ExportBasePath
+	}
+	if clusterPrivate == "" {
+		clusterPrivate = rm.controller.config.Gateway.ClusterPrivatePath
+	}
+	return exportBase, clusterPrivate
+}
+
+// parseRenderNodes splits name=address nodes into their names and addresses
+func parseRenderNodes(specs []string) ([]string, map[string]string, error) {
+	var nodes []string
+	addresses := make(map[string]string)
+	for _, spec := range specs {
+		name, address, _ := strings.Cut(strings.TrimSpace(spec), "=")
+		if name == "" {
+			return nil, nil, fmt.Errorf("invalid node %q, use name or name=address", spec)
+		}
+		if containsString(nodes, name) {
+			return nil, nil, fmt.Errorf("node %s is given twice", name)
+		}
+		nodes = append(nodes, name)
+		if address != "" {
+			addresses[name] = address
+		}
+	}
+	return nodes, addresses, nil
+}
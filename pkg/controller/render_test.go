@@ -0,0 +1,176 @@
+package controller
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	sdspb "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/config"
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// update rewrites the golden files with the current output:
+// go test ./pkg/controller -run TestRenderConfig -update
+var update = flag.Bool("update", false, "rewrite the golden files in testdata/render")
+
+// randomIDPattern matches the IDs generated randomly on each render: the FSID
+// of NFS exports and the UUID and NGUID of NVMe namespaces
+var randomIDPattern = regexp.MustCompile(`\b(fsid|uuid|nguid)=[0-9a-f-]+`)
+
+// newRenderManager returns a resource manager without database, nodes or
+// SSH, as RenderConfig needs it
+func newRenderManager() *ResourceManager {
+	c := &Controller{
+		config: &config.Config{
+			Gateway: config.GatewayConfig{
+				ExportBasePath:     "/srv/gateway-exports",
+				ClusterPrivatePath: "/var/lib/sds",
+			},
+		},
+		logger:   zap.NewNop(),
+		hostsMap: make(map[string]string),
+		identity: &database.ClusterIdentity{Name: "test"},
+	}
+	c.nodes = NewNodeManager(c)
+	c.resources = NewResourceManager(c)
+	return c.resources
+}
+
+func TestRenderConfig(t *testing.T) {
+	twoNodes := []string{"alpha=10.0.0.1", "beta=10.0.0.2"}
+	threeNodes := []string{"alpha=10.0.0.1", "beta=10.0.0.2", "gamma=10.0.0.3"}
+
+	tests := []struct {
+		name string
+		req  *sdspb.RenderConfigRequest
+	}{
+		{
+			name: "drbd-lvm-2nodes",
+			req:  &sdspb.RenderConfigRequest{Resource: "web", Nodes: twoNodes},
+		},
+		{
+			name: "drbd-zfs-3nodes",
+			req: &sdspb.RenderConfigRequest{Resource: "db", Nodes: threeNodes, Port: 7005,
+				Pool: "tank", StorageType: "zfs"},
+		},
+		{
+			name: "drbd-ipv6",
+			req: &sdspb.RenderConfigRequest{Resource: "web6",
+				Nodes: []string{"alpha=fd00::1", "beta=fd00::2", "gamma=fd00::3"}},
+		},
+		{
+			name: "drbd-multivolume",
+			req: &sdspb.RenderConfigRequest{Resource: "data", Nodes: twoNodes, Port: 7010,
+				StorageType: "zfs", Pool: "tank", Volumes: 3},
+		},
+		{
+			name: "drbd-options",
+			req: &sdspb.RenderConfigRequest{Resource: "tuned", Nodes: threeNodes, Protocol: "A",
+				PeerProtocols: map[string]string{"gamma": "C"},
+				DrbdOptions: map[string]string{
+					"net/max-buffers":      "8000",
+					"disk/on-io-error":     "detach",
+					"auto-promote":         "no",
+					"handlers/split-brain": "/usr/lib/drbd/notify-split-brain.sh root",
+				}},
+		},
+		{
+			name: "promoter-mount-vip",
+			req: &sdspb.RenderConfigRequest{Kind: RenderPromoter, Resource: "web", Nodes: twoNodes,
+				Services: []string{"nginx.service"}, MountPoint: "/srv/web", FsType: "ext4", Vip: "192.168.1.50/24"},
+		},
+		{
+			name: "promoter-depends",
+			req: &sdspb.RenderConfigRequest{Kind: RenderPromoter, Resource: "app", Nodes: threeNodes,
+				Services: []string{"app.service", "worker.service"}, DependsOn: []string{"db"}},
+		},
+		{
+			name: "gateway-nfs",
+			req: &sdspb.RenderConfigRequest{Kind: RenderNFS, Resource: "share", Port: 7002,
+				Nfs: &sdspb.CreateNFSGatewayRequest{ServiceIp: "192.168.1.60/24", ExportPath: "/data",
+					AllowedIps: []string{"192.168.1.0/24"}}},
+		},
+		{
+			name: "gateway-nfs-vlan",
+			req: &sdspb.RenderConfigRequest{Kind: RenderNFS, Resource: "tenant", Port: 7003,
+				Nfs: &sdspb.CreateNFSGatewayRequest{ServiceIp: "10.20.0.10/24", ExportPath: "/data",
+					Interface: "eth1", Vlan: 20, Router: "10.20.0.1"}},
+		},
+		{
+			name: "gateway-iscsi",
+			req: &sdspb.RenderConfigRequest{Kind: RenderISCSI, Resource: "lun", Port: 7004, Volumes: 3,
+				Iscsi: &sdspb.CreateISCSIGatewayRequest{ServiceIp: "192.168.1.70/24",
+					Iqn: "iqn.2026-01.com.example:lun", AllowedInitiators: []string{"iqn.2026-01.com.example:client"}}},
+		},
+		{
+			name: "gateway-nvmeof",
+			req: &sdspb.RenderConfigRequest{Kind: RenderNVMe, Resource: "ns", Port: 7006,
+				Nvmeof: &sdspb.CreateNVMeGatewayRequest{ServiceIp: "192.168.1.80/24",
+					Nqn: "nqn.2026-01.com.example:ns"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files, err := newRenderManager().RenderConfig(context.Background(), tt.req)
+			if err != nil {
+				t.Fatalf("RenderConfig: %v", err)
+			}
+
+			var got strings.Builder
+			for _, f := range files {
+				got.WriteString("==> " + f.Path + "\n")
+				got.WriteString(randomIDPattern.ReplaceAllString(f.Content, "$1=RANDOM"))
+			}
+
+			golden := filepath.Join("testdata", "render", tt.name+".golden")
+			if *update {
+				if err := os.MkdirAll(filepath.Dir(golden), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(golden, []byte(got.String()), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("missing golden file, run with -update: %v", err)
+			}
+			if got.String() != string(want) {
+				t.Errorf("output differs from %s:\n%s", golden, got.String())
+			}
+		})
+	}
+}
+
+func TestRenderConfigErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *sdspb.RenderConfigRequest
+		want string
+	}{
+		{"no resource", &sdspb.RenderConfigRequest{Nodes: []string{"alpha"}}, "resource name is required"},
+		{"no nodes", &sdspb.RenderConfigRequest{Resource: "web"}, "at least one node"},
+		{"port below base", &sdspb.RenderConfigRequest{Resource: "web", Nodes: []string{"alpha"}, Port: 80}, "below"},
+		{"duplicate node", &sdspb.RenderConfigRequest{Resource: "web", Nodes: []string{"alpha", "alpha=10.0.0.1"}}, "given twice"},
+		{"raw storage", &sdspb.RenderConfigRequest{Resource: "web", Nodes: []string{"alpha"}, StorageType: "raw"}, "cannot be rendered"},
+		{"unknown kind", &sdspb.RenderConfigRequest{Kind: "smb", Resource: "web", Nodes: []string{"alpha"}}, "unknown kind"},
+		{"iscsi one volume", &sdspb.RenderConfigRequest{Kind: RenderISCSI, Resource: "lun", Volumes: 1,
+			Iscsi: &sdspb.CreateISCSIGatewayRequest{ServiceIp: "192.168.1.70/24", Iqn: "iqn.2026-01.com.example:lun"}}, "at least 2 volumes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newRenderManager().RenderConfig(context.Background(), tt.req)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("RenderConfig error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// defaultDiskReplaceSyncTimeout bounds the full resync of a node onto its
+// replaced disks
+const defaultDiskReplaceSyncTimeout = 2 * time.Hour
+
+// lostDiskStates are the local disk states of a volume whose backing device
+// was detached, usually by DRBD after I/O errors
+var lostDiskStates = map[string]bool{"Diskless": true, "Failed": true, "Detaching": true}
+
+// ReplacedDisk is the new backing device of one volume on a node
+type ReplacedDisk struct {
+	VolumeID  int
+	OldDevice string
+	NewDevice string
+}
+
+// ReplaceDisk replaces the backing devices of the volumes of a resource on a
+// node, e.g. after the disk under them failed. Each volume is detached, gets
+// a new backing device with fresh DRBD metadata and is attached again; DRBD
+// then rebuilds it from its peers with a full resync, which must finish
+// within timeout. The new devices are the given ones, in volume order, or
+// volumes of the storage type of each volume created in pool, by default the
+// pool the volume was in. Raw and file volumes need devices. All other
+// nodes must be UpToDate, they are the source of the resync.
+//
+// The node may be the Primary: while its volumes are detached it reads and
+// writes over the network to its peers.
+func (rm *ResourceManager) ReplaceDisk(ctx context.Context, resource, node string, devices []string, pool string, timeout time.Duration) ([]*ReplacedDisk, error) {
+	if rm.deployment == nil {
+		return nil, fmt.Errorf("deployment client not set")
+	}
+	if rm.controller.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	if timeout <= 0 {
+		timeout = defaultDiskReplaceSyncTimeout
+	}
+
+	res, err := rm.controller.db.GetResource(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("resource not found: %s", resource)
+	}
+	nodes, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	if !containsString(nodes, node) {
+		return nil, fmt.Errorf("%s is not a node of resource %s", node, resource)
+	}
+	if len(nodes) < 2 {
+		return nil, fmt.Errorf("resource %s has a single node, there is no peer to resync the new disk from", resource)
+	}
+	volumes, err := rm.controller.db.ListVolumes(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volumes: %w", err)
+	}
+	if len(volumes) == 0 {
+		return nil, fmt.Errorf("resource %s has no volumes", resource)
+	}
+	sort.Slice(volumes, func(i, j int) bool { return volumes[i].VolumeID < volumes[j].VolumeID })
+	if len(devices) > 0 && len(devices) != len(volumes) {
+		return nil, fmt.Errorf("resource %s has %d volume(s), %d device(s) given", resource, len(volumes), len(devices))
+	}
+
+	copies := make([]*poolCopy, len(volumes))
+	for i, vol := range volumes {
+		cp := &poolCopy{vol: vol, source: vol.Backing[node]}
+		if cp.source == "" {
+			cp.source = backingDevicePath(vol.StorageType, vol.Pool, vol.VolumeName)
+		}
+		switch {
+		case len(devices) > 0:
+			cp.dest = devices[i]
+		case vol.StorageType == StorageTypeRaw || vol.StorageType == StorageTypeFile:
+			return nil, fmt.Errorf("volume %d of %s is on %s devices, give the new device", vol.VolumeID, resource, vol.StorageType)
+		case pool != "":
+			cp.dest = backingDevicePath(vol.StorageType, pool, vol.VolumeName)
+		default:
+			cp.dest = backingDevicePath(vol.StorageType, vol.Pool, vol.VolumeName)
+		}
+		copies[i] = cp
+	}
+
+	// The node is rebuilt from its peers, they must be complete
+	if err := rm.checkPeersUpToDate(ctx, resource, node, nodes); err != nil {
+		return nil, err
+	}
+
+	address := rm.nodeAddress(node)
+	var peer string
+	for _, other := range nodes {
+		if other != node {
+			peer = other
+			break
+		}
+	}
+
+	var replaced []*ReplacedDisk
+	for _, cp := range copies {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := rm.replaceVolumeDisk(ctx, res, node, address, peer, nodes, cp, len(devices) > 0, pool); err != nil {
+			return nil, fmt.Errorf("failed to replace the disk of volume %d: %w", cp.vol.VolumeID, err)
+		}
+		replaced = append(replaced, &ReplacedDisk{VolumeID: cp.vol.VolumeID, OldDevice: cp.source, NewDevice: cp.dest})
+
+		// The old volume was on the failed disk, removing it is best effort
+		if len(devices) == 0 && cp.source != cp.dest && !(cp.vol.StorageType == StorageTypeRaw || cp.vol.StorageType == StorageTypeFile) {
+			if err := rm.removeOldVolume(ctx, address, cp); err != nil {
+				rm.controller.logger.Warn("Failed to remove old volume",
+					zap.String("resource", resource),
+					zap.String("node", node),
+					zap.String("device", cp.source),
+					zap.Error(err))
+			}
+		}
+	}
+
+	if err := rm.waitForUpToDate(ctx, resource, node, timeout); err != nil {
+		return nil, fmt.Errorf("resync did not finish: %w", err)
+	}
+
+	var changes []string
+	for _, r := range replaced {
+		changes = append(changes, fmt.Sprintf("volume %d %s -> %s", r.VolumeID, r.OldDevice, r.NewDevice))
+	}
+	rm.controller.RecordEvent(ctx, EventDiskReplaced, resource,
+		fmt.Sprintf("Disk of %s replaced on %s: %s", resource, node, strings.Join(changes, ", ")),
+		map[string]string{
+			"node":    node,
+			"volumes": strconv.Itoa(len(replaced)),
+		})
+	return replaced, nil
+}
+
+// replaceVolumeDisk detaches one volume on a node, switches it to its new
+// backing device with fresh metadata and attaches it again. given tells
+// whether the device exists or has to be created in pool.
+func (rm *ResourceManager) replaceVolumeDisk(ctx context.Context, res *database.Resource, node, address, peer string, nodes []string, cp *poolCopy, given bool, pool string) error {
+	volume := fmt.Sprintf("%s/%d", res.Name, cp.vol.VolumeID)
+
+	// The new device must hold what the peers hold
+	peerBacking := cp.vol.Backing[peer]
+	if peerBacking == "" {
+		peerBacking = backingDevicePath(cp.vol.StorageType, cp.vol.Pool, cp.vol.VolumeName)
+	}
+	size, err := rm.blockDeviceSize(ctx, rm.nodeAddress(peer), peerBacking)
+	if err != nil {
+		return err
+	}
+
+	rm.controller.logger.Info("Replacing disk",
+		zap.String("resource", res.Name),
+		zap.String("node", node),
+		zap.Int("volume", cp.vol.VolumeID),
+		zap.String("old_device", cp.source),
+		zap.String("new_device", cp.dest))
+
+	output, err := rm.nodeStatus(ctx, res.Name, node)
+	if err != nil {
+		return fmt.Errorf("failed to read the disk state: %w", err)
+	}
+	if state := parseLocalDiskStatesFromStatus(output)[cp.vol.VolumeID]; state != "Diskless" {
+		if _, err := rm.controller.execOutput(ctx, address, fmt.Sprintf("sudo drbdadm detach %s", volume)); err != nil {
+			return fmt.Errorf("failed to detach %s (%s): %w", volume, state, err)
+		}
+	}
+
+	if !given {
+		if pool == "" {
+			pool = cp.vol.Pool
+		}
+		const mib = 1024 * 1024
+		if err := rm.createConvertedVolume(ctx, address, cp, cp.vol.StorageType, pool, (size+mib-1)/mib); err != nil {
+			return fmt.Errorf("%w; %s stays detached", err, volume)
+		}
+	}
+	if err := rm.verifyBackingDevices(ctx, []string{node}, []string{address}, map[string]string{node: cp.dest}); err != nil {
+		return fmt.Errorf("%w; %s stays detached", err, volume)
+	}
+	created, err := rm.blockDeviceSize(ctx, address, cp.dest)
+	if err != nil {
+		return err
+	}
+	if created < size {
+		return fmt.Errorf("%s has %d bytes, less than the %d of %s on %s; %s stays detached",
+			cp.dest, created, size, peerBacking, peer, volume)
+	}
+
+	if err := rm.switchBacking(ctx, res.Name, node, nodes, []*poolCopy{cp}); err != nil {
+		return fmt.Errorf("%w; %s stays detached", err, volume)
+	}
+	md, err := rm.deployment.DRBDCreateMD(rm.controller.stepContext(ctx, StepMetadata), []string{address}, volume, resourceMaxPeers(res))
+	if err == nil && !md.AllSuccess() {
+		err = fmt.Errorf("drbdadm create-md failed: %v", md.FailedHosts())
+	}
+	if err != nil {
+		return fmt.Errorf("%w; %s stays detached", err, volume)
+	}
+	if _, err := rm.controller.execOutput(rm.controller.stepContext(ctx, StepDrbdUp), address, fmt.Sprintf("sudo drbdadm attach %s", volume)); err != nil {
+		return fmt.Errorf("failed to attach %s: %w", volume, err)
+	}
+	return nil
+}
+
+// noteDiskState records an event when a volume of a resource loses its local
+// disk on a node, once until the disk is back. Lost disks are exported as
+// sds_drbd_volume_diskless and raise the SdsVolumeDiskless alert.
+func (c *Controller) noteDiskState(ctx context.Context, resource, node string, volume int, diskState string) {
+	key := fmt.Sprintf("%s/%s/%d", resource, node, volume)
+	if !lostDiskStates[diskState] {
+		delete(c.lostDisks, key)
+		return
+	}
+	if _, noted := c.lostDisks[key]; noted {
+		return
+	}
+	if c.lostDisks == nil {
+		c.lostDisks = make(map[string]string)
+	}
+	c.lostDisks[key] = diskState
+
+	c.logger.Error("Volume lost its disk",
+		zap.String("resource", resource),
+		zap.String("node", node),
+		zap.Int("volume", volume),
+		zap.String("disk_state", diskState))
+	c.RecordEvent(ctx, EventDiskLost, resource,
+		fmt.Sprintf("Volume %d of %s is %s on %s, replace the disk with sds resource replace-disk %s --node %s",
+			volume, resource, diskState, node, resource, node),
+		map[string]string{
+			"node":       node,
+			"volume":     strconv.Itoa(volume),
+			"disk_state": diskState,
+		})
+}
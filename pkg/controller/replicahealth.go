@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Resource health levels, from the state of the replicas
+const (
+	ResourceHealthy  = "Healthy"  // Every replica is up and UpToDate
+	ResourceDegraded = "Degraded" // A replica is down, lost its disk, is Outdated or stays Inconsistent
+	ResourceCritical = "Critical" // No replica is UpToDate
+)
+
+// inconsistentThreshold is how long a replica may stay Inconsistent, e.g.
+// while it resyncs, before it degrades its resource
+const inconsistentThreshold = 15 * time.Minute
+
+// ResourceHealth is the health of a resource as of the last node poll
+type ResourceHealth struct {
+	State   string
+	Reasons []string // One per unhealthy replica, e.g. "node2: Outdated"
+	Since   time.Time
+}
+
+// healthLevel orders the health levels, as recorded in the metrics
+func healthLevel(state string) int {
+	switch state {
+	case ResourceDegraded:
+		return 1
+	case ResourceCritical:
+		return 2
+	}
+	return 0
+}
+
+// resourceHealth returns the health of a resource and why it is not
+// healthy, empty until the resource was polled
+func (c *Controller) resourceHealth(resource string) (string, []string) {
+	c.healthMu.RLock()
+	defer c.healthMu.RUnlock()
+	if health := c.resourceHealths[resource]; health != nil {
+		return health.State, health.Reasons
+	}
+	return "", nil
+}
+
+// updateResourceHealth classifies every started resource from the DRBD
+// state the node poll collected of its replicas and records an event when
+// the health of a resource changes
+func (c *Controller) updateResourceHealth(ctx context.Context) {
+	if c.db == nil {
+		return
+	}
+	resources, err := c.db.ListResources(ctx)
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	health := make(map[string]*ResourceHealth)
+	inconsistent := make(map[string]time.Time)
+
+	type change struct {
+		resource, message string
+		details           map[string]string
+	}
+	var changes []change
+
+	c.healthMu.Lock()
+	for _, res := range resources {
+		// Stopped resources are down on purpose
+		if res.Nodes == "" || resourceStopped(res) {
+			continue
+		}
+
+		upToDate := 0
+		var reasons []string
+		for _, node := range strings.Split(res.Nodes, ",") {
+			state := c.liveState(node, res.Name)
+			switch {
+			case state == nil:
+				reasons = append(reasons, node+": not up or unreachable")
+			case lostDiskStates[state.DiskState], state.DiskState == "Outdated":
+				reasons = append(reasons, node+": "+state.DiskState)
+			case state.DiskState == "Inconsistent":
+				key := res.Name + "/" + node
+				since, ok := c.inconsistentSince[key]
+				if !ok {
+					since = now
+				}
+				inconsistent[key] = since
+				if now.Sub(since) >= inconsistentThreshold {
+					reasons = append(reasons, fmt.Sprintf("%s: Inconsistent for %s", node, now.Sub(since).Round(time.Minute)))
+				}
+			case state.DiskState == "UpToDate":
+				upToDate++
+			}
+		}
+		sort.Strings(reasons)
+
+		current := &ResourceHealth{State: ResourceHealthy, Reasons: reasons, Since: now}
+		if upToDate == 0 {
+			current.State = ResourceCritical
+		} else if len(reasons) > 0 {
+			current.State = ResourceDegraded
+		}
+		previous := c.resourceHealths[res.Name]
+		if previous != nil && previous.State == current.State {
+			current.Since = previous.Since
+		}
+		health[res.Name] = current
+
+		if previous != nil && previous.State != current.State {
+			c.logger.Info("Resource health changed",
+				zap.String("resource", res.Name),
+				zap.String("from", previous.State),
+				zap.String("to", current.State),
+				zap.Strings("reasons", reasons))
+			message := fmt.Sprintf("Resource %s is %s", res.Name, current.State)
+			if len(reasons) > 0 {
+				message += ": " + strings.Join(reasons, ", ")
+			}
+			changes = append(changes, change{res.Name, message, map[string]string{"from": previous.State, "to": current.State}})
+		}
+	}
+	c.resourceHealths = health
+	c.inconsistentSince = inconsistent
+	c.healthMu.Unlock()
+
+	for _, ch := range changes {
+		c.RecordEvent(ctx, EventResourceHealth, ch.resource, ch.message, ch.details)
+	}
+}
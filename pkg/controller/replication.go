@@ -0,0 +1,444 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// Snapshots taken for replication are named replSnapshotPrefix and the UTC
+// time they were taken, so they sort by age
+const replSnapshotPrefix = "sds-repl-"
+
+// Replication policy defaults
+const (
+	defaultReplicationKeep = 7
+	minReplicationInterval = time.Minute
+	// A policy without a successful run for this many intervals is stale
+	replicationStaleIntervals = 3
+)
+
+// replicationStreamTimeout bounds how long the backup node waits for and
+// receives a snapshot stream, a full copy of a large volume takes hours
+const replicationStreamTimeout = 24 * time.Hour
+
+// SetReplicationPolicy adds or replaces the replication policy of a resource:
+// every interval a snapshot of the resource is taken on one of its UpToDate
+// nodes, with its snapshot hooks, and the snapshots of its volumes are sent
+// to the dataset of the same name in the pool of the target node. The first
+// run sends a full copy, later runs the changes since the previous snapshot.
+// The target must not share a pool with a replica of the resource, a failed
+// disk would take both; a target node in the replica set only returns a
+// warning. Only ZFS volumes can be replicated.
+func (c *Controller) SetReplicationPolicy(ctx context.Context, policy *database.ReplicationPolicy) ([]string, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	if policy.TargetNode == "" || policy.TargetPool == "" {
+		return nil, fmt.Errorf("target node and pool are required")
+	}
+	if policy.Interval < minReplicationInterval {
+		return nil, fmt.Errorf("interval must be at least %s", minReplicationInterval)
+	}
+	if policy.Keep < 0 {
+		return nil, fmt.Errorf("keep must not be negative")
+	}
+	if policy.Keep == 0 {
+		policy.Keep = defaultReplicationKeep
+	}
+	if policy.StaleAfter == 0 {
+		policy.StaleAfter = replicationStaleIntervals * policy.Interval
+	}
+	if policy.StaleAfter < policy.Interval {
+		return nil, fmt.Errorf("stale-after must be at least the interval")
+	}
+
+	nodes, err := c.resources.resourceNodeNames(ctx, policy.Resource)
+	if err != nil {
+		return nil, err
+	}
+	volumes, err := c.db.ListVolumes(ctx, policy.Resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volumes: %w", err)
+	}
+	if len(volumes) == 0 {
+		return nil, fmt.Errorf("resource %s has no volumes", policy.Resource)
+	}
+	target := c.nodes.GetNodeAddressByName(policy.TargetNode)
+	if target == "" {
+		return nil, fmt.Errorf("node %s is not registered", policy.TargetNode)
+	}
+
+	var warnings []string
+	replica := containsString(nodes, policy.TargetNode)
+	for _, vol := range volumes {
+		if !isZFSStorage(vol.StorageType) {
+			return nil, fmt.Errorf("volume %d of %s is %s, only ZFS volumes can be replicated", vol.VolumeID, policy.Resource, vol.StorageType)
+		}
+		if replica && zfsPoolName(policy.TargetPool) == zfsPoolName(vol.Pool) {
+			return nil, fmt.Errorf("%s on %s is the pool of the replica of %s there, a failed disk would lose both",
+				policy.TargetPool, policy.TargetNode, policy.Resource)
+		}
+	}
+	if replica {
+		warnings = append(warnings, fmt.Sprintf("%s is a replica of %s, the copy does not survive the loss of the node",
+			policy.TargetNode, policy.Resource))
+	}
+	if _, err := c.execOutput(ctx, target, fmt.Sprintf("sudo zfs list -H -o name %s", policy.TargetPool)); err != nil {
+		return nil, fmt.Errorf("pool %s not found on %s: %w", policy.TargetPool, policy.TargetNode, err)
+	}
+
+	// Runs continue from the last snapshot the same target received
+	if previous, err := c.db.GetReplicationPolicy(ctx, policy.Resource); err == nil {
+		policy.CreatedAt = previous.CreatedAt
+		if previous.TargetNode == policy.TargetNode && previous.TargetPool == policy.TargetPool {
+			policy.SourceNode = previous.SourceNode
+			policy.LastSnapshot = previous.LastSnapshot
+			policy.LastAttempt = previous.LastAttempt
+			policy.LastSuccess = previous.LastSuccess
+			policy.LastError = previous.LastError
+		}
+	}
+	if err := c.db.SaveReplicationPolicy(ctx, policy); err != nil {
+		return nil, fmt.Errorf("failed to save replication policy: %w", err)
+	}
+
+	c.logger.Info("Replication policy set",
+		zap.String("resource", policy.Resource),
+		zap.String("target_node", policy.TargetNode),
+		zap.String("target_pool", policy.TargetPool),
+		zap.Duration("interval", policy.Interval))
+	return warnings, nil
+}
+
+// DeleteReplicationPolicy stops the replication of a resource. The
+// replicated snapshots stay on the target.
+func (c *Controller) DeleteReplicationPolicy(ctx context.Context, resource string) error {
+	if c.db == nil {
+		return fmt.Errorf("database not available")
+	}
+	if _, err := c.db.GetReplicationPolicy(ctx, resource); err != nil {
+		return fmt.Errorf("resource %s has no replication policy", resource)
+	}
+	return c.db.DeleteReplicationPolicy(ctx, resource)
+}
+
+// ListReplicationPolicies returns the replication policies ordered by
+// resource, only the one of resource if set. Those of other principals'
+// resources are left out.
+func (c *Controller) ListReplicationPolicies(ctx context.Context, resource string) ([]*database.ReplicationPolicy, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	policies, err := c.db.ListReplicationPolicies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list replication policies: %w", err)
+	}
+	visible := c.resourceFilter(ctx)
+	var selected []*database.ReplicationPolicy
+	for _, policy := range policies {
+		if (resource == "" || policy.Resource == resource) && visible(policy.Resource) {
+			selected = append(selected, policy)
+		}
+	}
+	sort.Slice(selected, func(i, j int) bool { return selected[i].Resource < selected[j].Resource })
+	return selected, nil
+}
+
+// replicationStale reports whether a policy has gone longer than StaleAfter
+// without a successful run, counted from its creation before the first one
+func replicationStale(policy *database.ReplicationPolicy, now time.Time) bool {
+	since := policy.LastSuccess
+	if since.IsZero() {
+		since = policy.CreatedAt
+	}
+	return now.Sub(since) > policy.StaleAfter
+}
+
+// RunReplication runs the replication policy of a resource now. full
+// replaces the copy on the target with a full one, e.g. after the node that
+// held the base of the next increment was lost.
+func (c *Controller) RunReplication(ctx context.Context, resource string, full bool) (*database.ReplicationPolicy, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	policy, err := c.db.GetReplicationPolicy(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("resource %s has no replication policy", resource)
+	}
+	if err := c.runReplicationPolicy(ctx, policy, full); err != nil {
+		return policy, err
+	}
+	return policy, nil
+}
+
+// runReplicator runs the replication policies that are due until the
+// controller is stopped
+func (c *Controller) runReplicator(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// No automatic changes during maintenance
+		if c.FreezeState().Frozen {
+			c.logger.Debug("Controller frozen, skipping replication")
+			continue
+		}
+
+		policies, err := c.db.ListReplicationPolicies(c.ctx)
+		if err != nil {
+			c.logger.Warn("Failed to list replication policies", zap.Error(err))
+			continue
+		}
+		now := time.Now()
+		for _, policy := range policies {
+			if now.Sub(policy.LastAttempt) < policy.Interval {
+				continue
+			}
+			if res, err := c.db.GetResource(c.ctx, policy.Resource); err == nil && resourceStopped(res) {
+				continue
+			}
+			if err := c.runReplicationPolicy(c.ctx, policy, false); err != nil {
+				c.logger.Warn("Replication failed",
+					zap.String("resource", policy.Resource),
+					zap.String("target_node", policy.TargetNode),
+					zap.Error(err))
+			}
+		}
+	}
+}
+
+// runReplicationPolicy runs a policy and records the outcome with it. A
+// failure is recorded as an event when the previous run succeeded, the first
+// success after failures as well.
+func (c *Controller) runReplicationPolicy(ctx context.Context, policy *database.ReplicationPolicy, full bool) error {
+	// Runs share the port of the backup nodes
+	c.replicationMu.Lock()
+	defer c.replicationMu.Unlock()
+
+	previousError := policy.LastError
+	policy.LastAttempt = time.Now()
+	err := c.replicate(ctx, policy, full)
+	if err != nil {
+		policy.LastError = err.Error()
+	} else {
+		policy.LastError = ""
+		policy.LastSuccess = time.Now()
+	}
+	if saveErr := c.db.SaveReplicationPolicy(ctx, policy); saveErr != nil {
+		c.logger.Warn("Failed to save replication policy", zap.String("resource", policy.Resource), zap.Error(saveErr))
+	}
+
+	details := map[string]string{
+		"target_node": policy.TargetNode,
+		"target_pool": policy.TargetPool,
+		"snapshot":    policy.LastSnapshot,
+	}
+	switch {
+	case err != nil && previousError == "":
+		details["error"] = err.Error()
+		c.RecordEvent(ctx, EventReplicationFailed, policy.Resource,
+			fmt.Sprintf("Replication of %s to %s failed: %v", policy.Resource, policy.TargetNode, err), details)
+	case err == nil && previousError != "":
+		c.RecordEvent(ctx, EventReplicationResumed, policy.Resource,
+			fmt.Sprintf("Replication of %s to %s succeeded again with %s", policy.Resource, policy.TargetNode, policy.LastSnapshot), details)
+	}
+	return err
+}
+
+// replicate takes a snapshot of a resource on its source node and sends the
+// snapshots of its volumes to the target, incrementally from the last
+// snapshot the target received unless full is set or there is none yet
+func (c *Controller) replicate(ctx context.Context, policy *database.ReplicationPolicy, full bool) error {
+	rm := c.resources
+	nodes, err := rm.resourceNodeNames(ctx, policy.Resource)
+	if err != nil {
+		return err
+	}
+	volumes, err := c.db.ListVolumes(ctx, policy.Resource)
+	if err != nil {
+		return fmt.Errorf("failed to list volumes: %w", err)
+	}
+	target := c.nodes.GetNodeAddressByName(policy.TargetNode)
+	if target == "" {
+		return fmt.Errorf("node %s is not registered", policy.TargetNode)
+	}
+
+	incremental := !full && policy.LastSnapshot != ""
+	source := policy.SourceNode
+	if incremental {
+		// Only the node that sent the last snapshot has it as the base
+		if !containsString(nodes, source) {
+			return fmt.Errorf("%s, which holds the base snapshot %s, is no longer a node of %s; run with --full",
+				source, policy.LastSnapshot, policy.Resource)
+		}
+		if !rm.nodeUpToDate(ctx, policy.Resource, source) {
+			return fmt.Errorf("%s, which holds the base snapshot %s, is not UpToDate", source, policy.LastSnapshot)
+		}
+	} else {
+		source = rm.replicationSource(ctx, policy.Resource, nodes)
+		if source == "" {
+			return fmt.Errorf("no node of %s is UpToDate", policy.Resource)
+		}
+	}
+	address := rm.nodeAddress(source)
+
+	// A first copy must not overwrite data the target already has
+	if !incremental && !full {
+		for _, vol := range volumes {
+			dest := fmt.Sprintf("%s/%s", policy.TargetPool, vol.VolumeName)
+			if _, err := c.execOutput(ctx, target, fmt.Sprintf("sudo zfs list -H -o name %s", dest)); err == nil {
+				return fmt.Errorf("%s already exists on %s; run with --full to replace it", dest, policy.TargetNode)
+			}
+		}
+	}
+
+	snapshot := replSnapshotPrefix + time.Now().UTC().Format("20060102-150405")
+	if err := c.snapshots.CreateSnapshot(ctx, policy.Resource, snapshot, source, "", false); err != nil {
+		return fmt.Errorf("failed to snapshot %s on %s: %w", policy.Resource, source, err)
+	}
+
+	for _, vol := range volumes {
+		origin := fmt.Sprintf("%s/%s", vol.Pool, vol.VolumeName)
+		dest := fmt.Sprintf("%s/%s", policy.TargetPool, vol.VolumeName)
+		send := fmt.Sprintf("sudo zfs send %s@%s", origin, snapshot)
+		if incremental {
+			send = fmt.Sprintf("sudo zfs send -i @%s %s@%s", policy.LastSnapshot, origin, snapshot)
+		} else if full {
+			_, _ = c.execOutput(ctx, target, fmt.Sprintf("sudo zfs destroy -r %s 2>/dev/null", dest))
+		}
+		if err := c.sendStream(ctx, address, target, send, dest, snapshot); err != nil {
+			// The next run starts from the last snapshot the target has
+			for _, v := range volumes {
+				_, _ = c.execOutput(ctx, address, fmt.Sprintf("sudo zfs destroy %s/%s@%s 2>/dev/null", v.Pool, v.VolumeName, snapshot))
+			}
+			return fmt.Errorf("failed to send %s@%s to %s: %w", origin, snapshot, policy.TargetNode, err)
+		}
+	}
+
+	policy.SourceNode = source
+	policy.LastSnapshot = snapshot
+
+	// The source keeps the new base, the target the last Keep snapshots
+	for _, vol := range volumes {
+		origin := fmt.Sprintf("%s/%s", vol.Pool, vol.VolumeName)
+		c.pruneReplSnapshots(ctx, address, origin, 1)
+		c.pruneReplSnapshots(ctx, target, fmt.Sprintf("%s/%s", policy.TargetPool, vol.VolumeName), policy.Keep)
+	}
+
+	c.logger.Info("Resource replicated",
+		zap.String("resource", policy.Resource),
+		zap.String("source", source),
+		zap.String("target_node", policy.TargetNode),
+		zap.String("snapshot", snapshot),
+		zap.Bool("incremental", incremental))
+	return nil
+}
+
+// sendStream pipes a zfs send command on the source into zfs recv of dataset
+// on the target, over a TCP connection on the replication port, and checks
+// that the target received the snapshot
+func (c *Controller) sendStream(ctx context.Context, source, target, send, dataset, snapshot string) error {
+	port := c.config.Replication.Port
+	log := fmt.Sprintf("/tmp/sds-recv-%d.log", port)
+
+	// netcat variants differ in how the listen port is given
+	listen := fmt.Sprintf("nohup timeout %d sh -c '(nc -l -p %d 2>/dev/null || nc -l %d) | sudo zfs recv -F -u %s' > %s 2>&1 &",
+		int(replicationStreamTimeout/time.Second), port, port, dataset, log)
+	if _, err := c.execOutput(ctx, target, listen); err != nil {
+		return fmt.Errorf("failed to start receiver: %w", err)
+	}
+	time.Sleep(500 * time.Millisecond)
+
+	if _, err := c.execOutput(c.stepContext(ctx, StepCopy), source, fmt.Sprintf("bash -c '%s > /dev/tcp/%s/%d'", send, target, port)); err != nil {
+		return err
+	}
+
+	// zfs recv finishes after the stream ended
+	received := fmt.Sprintf("sudo zfs list -H -o name %s@%s", dataset, snapshot)
+	err := pollUntil(ctx, time.Minute, func() (bool, error) {
+		_, err := c.execOutput(ctx, target, received)
+		return err == nil, nil
+	})
+	if err != nil {
+		output, _ := c.execOutput(ctx, target, fmt.Sprintf("cat %s", log))
+		return fmt.Errorf("%s did not receive %s: %s", dataset, snapshot, strings.TrimSpace(output))
+	}
+	return nil
+}
+
+// pruneReplSnapshots deletes all but the newest keep replication snapshots
+// of a dataset on a node, failures are logged
+func (c *Controller) pruneReplSnapshots(ctx context.Context, address, dataset string, keep int) {
+	output, err := c.execOutput(ctx, address, fmt.Sprintf("sudo zfs list -H -t snapshot -o name -s creation %s", dataset))
+	if err != nil {
+		c.logger.Warn("Failed to list replication snapshots", zap.String("dataset", dataset), zap.Error(err))
+		return
+	}
+	var snapshots []string
+	for _, line := range strings.Split(output, "\n") {
+		name := strings.TrimSpace(line)
+		if _, snap, ok := strings.Cut(name, "@"); ok && strings.HasPrefix(snap, replSnapshotPrefix) {
+			snapshots = append(snapshots, name)
+		}
+	}
+	for len(snapshots) > keep {
+		if _, err := c.execOutput(ctx, address, fmt.Sprintf("sudo zfs destroy %s", snapshots[0])); err != nil {
+			c.logger.Warn("Failed to delete replication snapshot", zap.String("snapshot", snapshots[0]), zap.Error(err))
+		}
+		snapshots = snapshots[1:]
+	}
+}
+
+// replicationSource picks the node a full copy is sent from: an UpToDate
+// Secondary, so the Primary's I/O is not slowed by the send, or the Primary
+func (rm *ResourceManager) replicationSource(ctx context.Context, resource string, nodes []string) string {
+	primary := rm.primaryNode(ctx, resource)
+	sorted := append([]string(nil), nodes...)
+	sort.Strings(sorted)
+	for _, node := range sorted {
+		if node != primary && rm.nodeUpToDate(ctx, resource, node) {
+			return node
+		}
+	}
+	if primary != "" && rm.nodeUpToDate(ctx, resource, primary) {
+		return primary
+	}
+	return ""
+}
+
+// nodeUpToDate reports whether all volumes of a resource are UpToDate on a
+// node
+func (rm *ResourceManager) nodeUpToDate(ctx context.Context, resource, node string) bool {
+	output, err := rm.nodeStatus(ctx, resource, node)
+	if err != nil {
+		return false
+	}
+	states := parseLocalDiskStatesFromStatus(output)
+	if len(states) == 0 {
+		return false
+	}
+	for _, state := range states {
+		if state != "UpToDate" {
+			return false
+		}
+	}
+	return true
+}
+
+// zfsPoolName returns the pool of a ZFS dataset path
+func zfsPoolName(dataset string) string {
+	pool, _, _ := strings.Cut(dataset, "/")
+	return pool
+}
@@ -0,0 +1,434 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"github.com/liliang-cn/sds/pkg/util"
+	"go.uber.org/zap"
+)
+
+// defaultReportPeriod is the period of a report unless another is requested
+const defaultReportPeriod = 7 * 24 * time.Hour
+
+// Report formats
+const (
+	ReportFormatHTML     = "html"
+	ReportFormatMarkdown = "md"
+)
+
+// reportFailoverEvents are the events a report lists as failovers
+var reportFailoverEvents = map[string]bool{
+	EventHaFailover:         true,
+	EventDrSwitchover:       true,
+	EventDrSwitchoverFailed: true,
+	EventDrFailback:         true,
+	EventDrFailbackFailed:   true,
+	EventRebalanceMoved:     true,
+}
+
+// reportResyncEvents are the events a report lists as resyncs: reconnected
+// connections resync the changes they missed, moved and converted backing
+// volumes are resynced in full
+var reportResyncEvents = map[string]bool{
+	EventAutoHealAttempt:   true,
+	EventAutoHealRecovered: true,
+	EventAutoHealFailed:    true,
+	EventPoolMigrated:      true,
+	EventStorageConverted:  true,
+}
+
+// ClusterReport summarizes what happened in a cluster over a period
+type ClusterReport struct {
+	Cluster    string
+	From       time.Time
+	To         time.Time
+	Capacity   []*CapacityChange
+	Failovers  []*database.Event
+	Resyncs    []*database.Event
+	Snapshots  []*OperationStats // Snapshot operations run as jobs
+	Alerts     []string          // Problems outstanding at the end of the period
+	NoCapacity bool              // Pool sampling is disabled
+}
+
+// CapacityChange is the change of a pool on a node over a report period,
+// from its first to its last sample
+type CapacityChange struct {
+	Pool       string
+	Node       string
+	TotalBytes uint64
+	UsedStart  uint64
+	UsedEnd    uint64
+}
+
+// OperationStats counts the outcomes of one operation over a report period
+type OperationStats struct {
+	Operation string
+	Succeeded int
+	Failed    int // Failed, cancelled or interrupted
+}
+
+// SuccessRate returns the share of the finished runs that succeeded, in percent
+func (s *OperationStats) SuccessRate() float64 {
+	if s.Succeeded+s.Failed == 0 {
+		return 0
+	}
+	return 100 * float64(s.Succeeded) / float64(s.Succeeded+s.Failed)
+}
+
+// ClusterReport collects the report of the cluster over the period up to now
+func (c *Controller) ClusterReport(ctx context.Context, period time.Duration) (*ClusterReport, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	if period <= 0 {
+		period = defaultReportPeriod
+	}
+	now := time.Now()
+	report := &ClusterReport{
+		Cluster:    c.ClusterName(),
+		From:       now.Add(-period),
+		To:         now,
+		NoCapacity: c.config.PoolHistory.Interval <= 0,
+	}
+
+	samples, err := c.db.ListPoolUsage(ctx, "", "", report.From)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pool usage: %w", err)
+	}
+	report.Capacity = capacityChanges(samples)
+
+	events, err := c.db.ListEvents(ctx, "", 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+	// Events are listed newest first, the report lists them in order
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+		if event.CreatedAt.Before(report.From) {
+			continue
+		}
+		switch {
+		case reportFailoverEvents[event.Type]:
+			report.Failovers = append(report.Failovers, event)
+		case reportResyncEvents[event.Type]:
+			report.Resyncs = append(report.Resyncs, event)
+		}
+	}
+
+	jobs, err := c.db.ListJobs(ctx, "", 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+	report.Snapshots = snapshotStats(jobs, report.From)
+	report.Alerts = c.outstandingAlerts(ctx, jobs)
+
+	return report, nil
+}
+
+// capacityChanges reduces pool usage samples to the first and the last
+// sample of each pool on each node
+func capacityChanges(samples []*database.PoolUsageSample) []*CapacityChange {
+	changes := make(map[string]*CapacityChange)
+	for _, sample := range samples {
+		used := sample.TotalBytes - sample.FreeBytes
+		key := sample.Pool + "|" + sample.Node
+		change, ok := changes[key]
+		if !ok {
+			change = &CapacityChange{Pool: sample.Pool, Node: sample.Node, UsedStart: used}
+			changes[key] = change
+		}
+		// Samples are ordered oldest first
+		change.TotalBytes = sample.TotalBytes
+		change.UsedEnd = used
+	}
+
+	list := make([]*CapacityChange, 0, len(changes))
+	for _, change := range changes {
+		list = append(list, change)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Pool != list[j].Pool {
+			return list[i].Pool < list[j].Pool
+		}
+		return list[i].Node < list[j].Node
+	})
+	return list
+}
+
+// snapshotStats counts the outcomes of the snapshot jobs that started since
+// from, by operation
+func snapshotStats(jobs []*database.Job, from time.Time) []*OperationStats {
+	stats := make(map[string]*OperationStats)
+	for _, job := range jobs {
+		if job.StartedAt.Before(from) || !strings.Contains(job.Operation, "Snapshot") || job.State == JobRunning {
+			continue
+		}
+		s, ok := stats[job.Operation]
+		if !ok {
+			s = &OperationStats{Operation: job.Operation}
+			stats[job.Operation] = s
+		}
+		if job.State == JobSucceeded {
+			s.Succeeded++
+		} else {
+			s.Failed++
+		}
+	}
+
+	list := make([]*OperationStats, 0, len(stats))
+	for _, s := range stats {
+		list = append(list, s)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Operation < list[j].Operation })
+	return list
+}
+
+// outstandingAlerts lists the problems that need attention now: a frozen
+// controller, offline nodes, drift, interrupted jobs and snapshots whose COW
+// volume fills up. Offline nodes and drift of nodes under maintenance are
+// expected and left out.
+func (c *Controller) outstandingAlerts(ctx context.Context, jobs []*database.Job) []string {
+	var alerts []string
+	maintenance := c.maintenanceNodes(ctx)
+
+	if state := c.FreezeState(); state.Frozen {
+		alert := fmt.Sprintf("Controller frozen since %s", state.Since.Format(time.RFC3339))
+		if state.Reason != "" {
+			alert += ": " + state.Reason
+		}
+		alerts = append(alerts, alert)
+	}
+
+	if nodes, err := c.nodes.ListNodes(ctx); err == nil {
+		sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+		for _, node := range nodes {
+			if node.State != NodeStateOnline && maintenance[node.Name] == nil {
+				alerts = append(alerts, fmt.Sprintf("Node %s is %s, last seen %s", node.Name, node.State, node.LastSeen.Format(time.RFC3339)))
+			}
+		}
+	}
+
+	if drift := c.DriftReport(); drift != nil {
+		for _, d := range drift.Drifts {
+			if d.Node != "" && maintenance[d.Node] != nil {
+				continue
+			}
+			alert := fmt.Sprintf("Drift: %s %s: %s", d.Kind, d.Name, d.Problem)
+			if d.Node != "" {
+				alert = fmt.Sprintf("Drift: %s %s on %s: %s", d.Kind, d.Name, d.Node, d.Problem)
+			}
+			alerts = append(alerts, alert)
+		}
+	}
+
+	for _, job := range jobs {
+		if job.State == JobInterrupted && job.Resolution == "" {
+			alerts = append(alerts, fmt.Sprintf("Job %d (%s %s) was interrupted, resume or roll it back", job.ID, job.Operation, job.Target))
+		}
+	}
+
+	c.snapshots.mu.RLock()
+	var snapshots []string
+	for key, state := range c.snapshots.usageAlerts {
+		parts := strings.SplitN(key, "@", 3)
+		if len(parts) == 3 {
+			snapshots = append(snapshots, fmt.Sprintf("LVM snapshot %s of %s on %s is %s", parts[1], parts[0], parts[2], state))
+		}
+	}
+	c.snapshots.mu.RUnlock()
+	sort.Strings(snapshots)
+	alerts = append(alerts, snapshots...)
+
+	return alerts
+}
+
+// reportTable is a section of a rendered report
+type reportTable struct {
+	Title   string
+	Empty   string // Shown instead of the table without rows
+	Headers []string
+	Rows    [][]string
+}
+
+// tables lays the report out as the sections it is rendered with
+func (r *ClusterReport) tables() []*reportTable {
+	capacity := &reportTable{
+		Title:   "Capacity",
+		Empty:   "No pool usage samples in this period.",
+		Headers: []string{"Pool", "Node", "Total", "Used", "Change"},
+	}
+	if r.NoCapacity {
+		capacity.Empty = "Pool usage sampling is disabled (pool_history.interval)."
+	}
+	for _, c := range r.Capacity {
+		change := "+" + util.FormatBytes(c.UsedEnd-c.UsedStart)
+		if c.UsedEnd < c.UsedStart {
+			change = "-" + util.FormatBytes(c.UsedStart-c.UsedEnd)
+		}
+		used := fmt.Sprintf("%s (%.0f%%)", util.FormatBytes(c.UsedEnd), 100*float64(c.UsedEnd)/float64(max(c.TotalBytes, 1)))
+		capacity.Rows = append(capacity.Rows, []string{c.Pool, c.Node, util.FormatBytes(c.TotalBytes), used, change})
+	}
+
+	eventTable := func(title, empty string, events []*database.Event) *reportTable {
+		table := &reportTable{Title: title, Empty: empty, Headers: []string{"Time", "Type", "Resource", "Message"}}
+		for _, e := range events {
+			table.Rows = append(table.Rows, []string{e.CreatedAt.Format("2006-01-02 15:04"), e.Type, e.Resource, e.Message})
+		}
+		return table
+	}
+
+	snapshots := &reportTable{
+		Title:   "Snapshots",
+		Empty:   "No snapshot operations in this period.",
+		Headers: []string{"Operation", "Succeeded", "Failed", "Success rate"},
+	}
+	for _, s := range r.Snapshots {
+		snapshots.Rows = append(snapshots.Rows, []string{s.Operation, fmt.Sprint(s.Succeeded), fmt.Sprint(s.Failed), fmt.Sprintf("%.1f%%", s.SuccessRate())})
+	}
+
+	alerts := &reportTable{Title: "Outstanding alerts", Empty: "None.", Headers: []string{"Alert"}}
+	for _, alert := range r.Alerts {
+		alerts.Rows = append(alerts.Rows, []string{alert})
+	}
+
+	return []*reportTable{
+		capacity,
+		eventTable("Failovers", "No failovers in this period.", r.Failovers),
+		eventTable("Resyncs and reconnections", "No resyncs or reconnections in this period.", r.Resyncs),
+		snapshots,
+		alerts,
+	}
+}
+
+// Title returns the title of the report
+func (r *ClusterReport) Title() string {
+	return fmt.Sprintf("SDS cluster report: %s, %s to %s", r.Cluster, r.From.Format("2006-01-02"), r.To.Format("2006-01-02"))
+}
+
+// Render renders the report as HTML, e.g. for mail, or as Markdown
+func (r *ClusterReport) Render(format string) (string, error) {
+	switch format {
+	case ReportFormatHTML:
+		var buf bytes.Buffer
+		if err := reportHTML.Execute(&buf, map[string]interface{}{"Title": r.Title(), "Tables": r.tables()}); err != nil {
+			return "", fmt.Errorf("failed to render report: %w", err)
+		}
+		return buf.String(), nil
+	case ReportFormatMarkdown:
+		var b strings.Builder
+		fmt.Fprintf(&b, "# %s\n", r.Title())
+		for _, table := range r.tables() {
+			fmt.Fprintf(&b, "\n## %s\n\n", table.Title)
+			if len(table.Rows) == 0 {
+				fmt.Fprintf(&b, "%s\n", table.Empty)
+				continue
+			}
+			fmt.Fprintf(&b, "| %s |\n", strings.Join(table.Headers, " | "))
+			fmt.Fprintf(&b, "|%s\n", strings.Repeat(" --- |", len(table.Headers)))
+			for _, row := range table.Rows {
+				cells := make([]string, len(row))
+				for i, cell := range row {
+					cells[i] = strings.ReplaceAll(cell, "|", `\|`)
+				}
+				fmt.Fprintf(&b, "| %s |\n", strings.Join(cells, " | "))
+			}
+		}
+		return b.String(), nil
+	}
+	return "", fmt.Errorf("unknown report format %q (html, md)", format)
+}
+
+// reportHTML renders a report as a self-contained page that mail clients show
+var reportHTML = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+</head>
+<body style="font-family: sans-serif; font-size: 14px; color: #222;">
+<h1 style="font-size: 20px;">{{.Title}}</h1>
+{{- range .Tables}}
+<h2 style="font-size: 16px; margin-top: 24px;">{{.Title}}</h2>
+{{- if .Rows}}
+<table style="border-collapse: collapse;">
+<tr>{{range .Headers}}<th style="text-align: left; border-bottom: 1px solid #999; padding: 4px 8px;">{{.}}</th>{{end}}</tr>
+{{- range .Rows}}
+<tr>{{range .}}<td style="border-bottom: 1px solid #ddd; padding: 4px 8px;">{{.}}</td>{{end}}</tr>
+{{- end}}
+</table>
+{{- else}}
+<p>{{.Empty}}</p>
+{{- end}}
+{{- end}}
+</body>
+</html>
+`))
+
+// runReporter generates a report over the past interval each interval until
+// the controller is stopped, writes it to the report directory and pipes it
+// into the report command
+func (c *Controller) runReporter(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		ctx, cancel := context.WithTimeout(c.ctx, 5*time.Minute)
+		if err := c.deliverReport(ctx, interval); err != nil {
+			c.logger.Warn("Failed to deliver cluster report", zap.Error(err))
+		}
+		cancel()
+	}
+}
+
+// deliverReport generates a scheduled report and delivers it
+func (c *Controller) deliverReport(ctx context.Context, period time.Duration) error {
+	report, err := c.ClusterReport(ctx, period)
+	if err != nil {
+		return err
+	}
+	cfg := c.config.Report
+	content, err := report.Render(cfg.Format)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(cfg.Directory, 0o750); err != nil {
+		return fmt.Errorf("failed to create report directory: %w", err)
+	}
+	path := filepath.Join(cfg.Directory, fmt.Sprintf("sds-report-%s-%s.%s", report.Cluster, report.To.Format("2006-01-02"), cfg.Format))
+	if err := os.WriteFile(path, []byte(content), 0o640); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	if cfg.Command != "" {
+		cmd := exec.CommandContext(ctx, "sh", "-c", cfg.Command)
+		cmd.Stdin = strings.NewReader(content)
+		cmd.Env = append(os.Environ(), "SDS_REPORT_FILE="+path, "SDS_REPORT_SUBJECT="+report.Title())
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("report command failed: %w: %s", err, strings.TrimSpace(string(output)))
+		}
+	}
+
+	c.RecordEvent(ctx, EventReportGenerated, "", fmt.Sprintf("Cluster report written to %s", path), map[string]string{
+		"file":      path,
+		"failovers": fmt.Sprint(len(report.Failovers)),
+		"alerts":    fmt.Sprint(len(report.Alerts)),
+	})
+	return nil
+}
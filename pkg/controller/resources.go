@@ -11,8 +11,10 @@ import (
 	"sync"
 
 	"go.uber.org/zap"
+	"github.com/liliang-cn/sds/pkg/config"
 	"github.com/liliang-cn/sds/pkg/database"
 	"github.com/liliang-cn/sds/pkg/deployment"
+	"github.com/liliang-cn/sds/pkg/reactor"
 )
 
 // ResourceInfo represents DRBD resource information
@@ -24,6 +26,20 @@ type ResourceInfo struct {
 	Role       string
 	Volumes    []*ResourceVolumeInfo
 	NodeStates map[string]*ResourceNodeState
+	// Protocol of the connections to a node, if it differs from Protocol
+	PeerProtocols map[string]string
+	// Peer slots of the metadata and the nodes still left with the previous
+	// slots while they are changed
+	MaxPeers        int
+	MaxPeersPending []string
+	// started, or stopped while the resource is down on purpose
+	DesiredState string
+	// Principal the resource belongs to, empty when shared
+	Owner string
+	// Health from the state of the replicas as of the last node poll, empty
+	// until polled, and why it is not Healthy
+	Health        string
+	HealthReasons []string
 }
 
 // ResourceNodeState represents detailed state of a node for a resource
@@ -38,6 +54,8 @@ type ResourceVolumeInfo struct {
 	VolumeID uint32
 	Device   string
 	SizeGB   uint64
+	Size     *VolumeSize      // size found on a node, nil if unknown
+	Record   *database.Volume // stored volume record, nil if unknown
 }
 
 // ResourceManager manages DRBD resources using dispatch
@@ -100,13 +118,18 @@ func (rm *ResourceManager) GetHosts() []string {
 	return rm.hosts
 }
 
-// CreateResource creates a DRBD resource across multiple nodes
-func (rm *ResourceManager) CreateResource(ctx context.Context, name string, port uint32, nodes []string, protocol string, sizeGB uint32, pool string, storageType string, drbdOptions map[string]string) error {
+// CreateResource creates a DRBD resource across multiple nodes.
+// For the raw storage type, devices maps each node name to its block device
+// and the size is taken from the devices. maxPeers reserves metadata peer
+// slots for nodes added later, 0 sizes the metadata for the given nodes.
+func (rm *ResourceManager) CreateResource(ctx context.Context, name string, port uint32, nodes []string, protocol string, peerProtocols map[string]string, maxPeers int, sizeGB uint32, pool string, storageType string, drbdOptions map[string]string, devices map[string]string) error {
 	rm.controller.logger.Info("Creating DRBD resource",
 		zap.String("name", name),
 		zap.Uint32("port", port),
 		zap.Strings("nodes", nodes),
 		zap.String("protocol", protocol),
+		zap.Any("peer_protocols", peerProtocols),
+		zap.Int("max_peers", maxPeers),
 		zap.Uint32("size_gb", sizeGB),
 		zap.String("pool", pool),
 		zap.String("storage_type", storageType),
@@ -127,9 +150,23 @@ func (rm *ResourceManager) CreateResource(ctx context.Context, name string, port
 	if protocol == "" {
 		protocol = "C"
 	}
+	peerProtocols, err := normalizePeerProtocols(nodes, protocol, peerProtocols)
+	if err != nil {
+		return err
+	}
+	maxPeers, err = validateMaxPeers(maxPeers, len(nodes))
+	if err != nil {
+		return err
+	}
+	if err := rm.controller.checkDrbdFeatures(nodes, peerProtocols, maxPeers); err != nil {
+		return err
+	}
+	if err := validateDrbdHandlerOptions(drbdOptions); err != nil {
+		return err
+	}
 
-	// For both LVM and ZFS, we use a consistent volume name
-	volumeName := fmt.Sprintf("%s_data", name)
+	// For both LVM and ZFS, the volume is named by the naming template
+	volumeName := rm.controller.newVolumeName(name)
 
 	// Convert node names to IP addresses for deployment
 	nodeIPs := make([]string, len(nodes))
@@ -141,63 +178,62 @@ func (rm *ResourceManager) CreateResource(ctx context.Context, name string, port
 		nodeIPs[i] = ip
 	}
 
-	// 1. Create storage volumes on all nodes (LVM or ZFS)
-	if storageType == "zfs" || storageType == "zfs-thin" {
-		// Create ZFS zvol on all nodes
-		// For zfs-thin, ZFSCreateThinDataset handles sparse creation (which is default for ZVOLs created with -s)
-		for i, nodeIP := range nodeIPs {
-			zvolPath := fmt.Sprintf("%s/%s", pool, volumeName)
-			result, err := rm.deployment.ZFSCreateThinDataset(ctx, []string{nodeIP}, pool, volumeName, fmt.Sprintf("%dG", sizeGB))
-			if err != nil {
-				return fmt.Errorf("failed to create ZFS zvol on %s: %w", nodes[i], err)
-			}
-			if !result.AllSuccess() {
-				for host, hres := range result.Hosts {
-					if !hres.Success {
-						return fmt.Errorf("ZFS zvol creation failed on %s: %s", host, hres.Output)
-					}
-				}
-			}
-			rm.controller.logger.Info("Created ZFS zvol",
-				zap.String("zvol", zvolPath),
-				zap.String("node", nodes[i]))
+	// DRBD finds its "on" section by uname -n, a mismatch only shows up
+	// when the resource is brought up
+	if err := rm.controller.checkNodeHostnames(ctx, nodes, nodeIPs); err != nil {
+		return err
+	}
+
+	// Raw devices are used as they are, they only need to match in size
+	if storageType == StorageTypeRaw {
+		pool = ""
+		sizeBytes, err := rm.validateRawDevices(ctx, nodes, nodeIPs, devices)
+		if err != nil {
+			return err
 		}
-	} else if storageType == "lvm-thin" {
-		// Create LVM Thin LV
-		// Convention: Thin Pool name is pool + "_thin"
-		thinPoolName := pool + "_thin"
-		for i, nodeIP := range nodeIPs {
-			result, err := rm.deployment.LVCreateThinVolume(ctx, []string{nodeIP}, pool, thinPoolName, volumeName, fmt.Sprintf("%dG", sizeGB))
-			if err != nil {
-				return fmt.Errorf("failed to create Thin LV on %s: %w", nodes[i], err)
-			}
-			if !result.AllSuccess() {
-				for host, hres := range result.Hosts {
-					if !hres.Success {
-						return fmt.Errorf("Thin LV creation failed on %s: %s", host, hres.Output)
-					}
-				}
-			}
+		sizeGB = uint32(sizeBytes >> 30)
+	}
+
+	// 1. Create storage volumes on all nodes (raw, file or from a pool)
+	if storageType == StorageTypeRaw {
+		rm.controller.logger.Info("Using raw devices",
+			zap.Any("devices", devices))
+	} else if storageType == StorageTypeFile {
+		// Lab storage: sparse files on loop devices, used like raw devices
+		pool = ""
+		fileDevices, err := rm.createFileDevices(ctx, name, nodes, nodeIPs, sizeGB)
+		if err != nil {
+			return err
 		}
+		devices = fileDevices
 	} else {
-		// Create LVM LV on all nodes (default)
+		// Pools are provisioned by the backend of the storage type
+		backend, err := rm.controller.storage.Backend(storageType)
+		if err != nil {
+			return err
+		}
 		for i, nodeIP := range nodeIPs {
-			result, err := rm.deployment.LVCreate(ctx, []string{nodeIP}, pool, volumeName, fmt.Sprintf("%dG", sizeGB))
-			if err != nil {
-				return fmt.Errorf("failed to create LV on %s: %w", nodes[i], err)
-			}
-			if !result.AllSuccess() {
-				for host, hres := range result.Hosts {
-					if !hres.Success {
-						return fmt.Errorf("LV creation failed on %s: %s", host, hres.Output)
-					}
-				}
+			if err := backend.CreateVolume(rm.controller.stepContext(ctx, StepVolumeCreate), nodeIP, pool, volumeName, uint64(sizeGB)<<30); err != nil {
+				return fmt.Errorf("failed to create %s volume %s/%s on %s: %w", storageType, pool, volumeName, nodes[i], err)
 			}
+			rm.controller.logger.Info("Created backing volume",
+				zap.String("storage_type", storageType),
+				zap.String("device", backend.DevicePath(pool, volumeName)),
+				zap.String("node", nodes[i]))
 		}
 	}
 
+	// Catch missing, busy or mismatched devices before drbdadm does
+	backing := volumeBacking(nodes, backingDevicePath(storageType, pool, volumeName))
+	if storageType == StorageTypeRaw || storageType == StorageTypeFile {
+		backing = devices
+	}
+	if err := rm.verifyBackingDevices(ctx, nodes, nodeIPs, backing); err != nil {
+		return err
+	}
+
 	// 2. Generate DRBD config
-	drbdConfig := rm.generateDrbdConfig(name, port, nodes, protocol, pool, volumeName, storageType, drbdOptions)
+	drbdConfig := rm.generateDrbdConfig(name, port, nodes, protocol, peerProtocols, pool, volumeName, storageType, drbdOptions, devices, nil)
 
 	// 3. Distribute config to all nodes
 	configResult, err := rm.deployment.DistributeConfig(ctx, nodeIPs, drbdConfig, fmt.Sprintf("/etc/drbd.d/%s.res", name))
@@ -208,8 +244,12 @@ func (rm *ResourceManager) CreateResource(ctx context.Context, name string, port
 		return fmt.Errorf("config distribution failed on some hosts")
 	}
 
-	// 4. Create metadata on all nodes
-	mdResult, err := rm.deployment.DRBDCreateMD(ctx, nodeIPs, name)
+	// 4. Create metadata on all nodes, DRBD 8.4 metadata has no peer slots
+	mdPeers := maxPeers
+	if !rm.controller.drbdFeatures(nodes).Drbd9 {
+		mdPeers = 0
+	}
+	mdResult, err := rm.deployment.DRBDCreateMD(rm.controller.stepContext(ctx, StepMetadata), nodeIPs, name, mdPeers)
 	if err != nil {
 		return fmt.Errorf("failed to create metadata: %w", err)
 	}
@@ -218,7 +258,7 @@ func (rm *ResourceManager) CreateResource(ctx context.Context, name string, port
 	}
 
 	// 5. Bring up resource on all nodes
-	upResult, err := rm.deployment.DRBDUp(ctx, nodeIPs, name)
+	upResult, err := rm.deployment.DRBDUp(rm.controller.stepContext(ctx, StepDrbdUp), nodeIPs, name)
 	if err != nil {
 		return fmt.Errorf("failed to bring up resource: %w", err)
 	}
@@ -234,10 +274,29 @@ func (rm *ResourceManager) CreateResource(ctx context.Context, name string, port
 			Nodes:    strings.Join(nodes, ","),
 			Protocol: protocol,
 			Replicas: len(nodes),
+			Options:  drbdOptions,
+
+			PeerProtocols: peerProtocols,
+			MaxPeers:      maxPeers,
+			Owner:         ownerFor(ctx),
 		}
 		if err := rm.controller.db.SaveResource(ctx, dbRes); err != nil {
 			rm.controller.logger.Warn("Failed to save resource to database", zap.Error(err))
 		}
+
+		dbVol := &database.Volume{
+			ResourceName: name,
+			VolumeName:   volumeName,
+			VolumeID:     0,
+			Pool:         pool,
+			StorageType:  storageType,
+			SizeGB:       int(sizeGB),
+			Device:       fmt.Sprintf("/dev/drbd%d", port-7000),
+			Backing:      backing,
+		}
+		if err := rm.controller.db.SaveVolume(ctx, dbVol); err != nil {
+			rm.controller.logger.Warn("Failed to save volume to database", zap.Error(err))
+		}
 	}
 
 	// 7. Update hosts for this resource
@@ -248,12 +307,61 @@ func (rm *ResourceManager) CreateResource(ctx context.Context, name string, port
 	rm.controller.logger.Info("DRBD resource created successfully",
 		zap.String("name", name))
 
+	rm.controller.RecordEvent(ctx, EventResourceCreated, name,
+		fmt.Sprintf("Resource %s created on %s", name, strings.Join(nodes, ", ")),
+		map[string]string{"nodes": strings.Join(nodes, ","), "size_gb": fmt.Sprint(sizeGB), "pool": pool, "protocol": protocol})
+
 	return nil
 }
 
-// generateDrbdConfig generates a DRBD resource configuration file
-func (rm *ResourceManager) generateDrbdConfig(name string, port uint32, nodes []string, protocol, pool, volumeName, storageType string, options map[string]string) string {
+// drbdDefaultOptions returns the built-in DRBD options overridden by the
+// controller config and then by the overrides of pool
+func (rm *ResourceManager) drbdDefaultOptions(pool string) map[string]string {
+	options := make(map[string]string)
+	for k, v := range config.DefaultDrbdOptions {
+		options[k] = v
+	}
+	for k, v := range rm.controller.defaultHandlerOptions() {
+		options[k] = v
+	}
+	if rm.controller.config == nil {
+		return options
+	}
+	defaults := rm.controller.config.DrbdDefaults
+	for k, v := range defaults.Options {
+		options[normalizeDrbdOptionKey(k)] = v
+	}
+	// Pool names are case-insensitive in the config file
+	for name, overrides := range defaults.Pools {
+		if !strings.EqualFold(name, pool) {
+			continue
+		}
+		for k, v := range overrides {
+			options[normalizeDrbdOptionKey(k)] = v
+		}
+	}
+	return options
+}
+
+// normalizeDrbdOptionKey returns an option key as section/key
+func normalizeDrbdOptionKey(key string) string {
+	if strings.Contains(key, "/") {
+		return key
+	}
+	return "options/" + key
+}
+
+// generateDrbdConfig generates a DRBD resource configuration file.
+// rawDevices, if set, maps nodes to raw or loop backing devices; differing
+// devices are written to per-node volume sections. With peerProtocols the
+// connections are written one by one instead of as a mesh, each with its
+// protocol. What the oldest DRBD kernel module among the nodes lacks is left
+// out: quorum before 9.0.7, and node-id and connections before DRBD 9, where
+// the two nodes connect implicitly. addresses, if set, overrides the
+// registered addresses of nodes.
+func (rm *ResourceManager) generateDrbdConfig(name string, port uint32, nodes []string, protocol string, peerProtocols map[string]string, pool, volumeName, storageType string, options map[string]string, rawDevices map[string]string, addresses map[string]string) string {
 	var config strings.Builder
+	features := rm.controller.drbdFeatures(nodes)
 
 	// Organize options by section -> key -> value
 	sections := make(map[string]map[string]string)
@@ -266,29 +374,53 @@ func (rm *ResourceManager) generateDrbdConfig(name string, port uint32, nodes []
 		sections[section][key] = value
 	}
 
-	// Add defaults
-	setOption("options", "auto-promote", "no")
-	setOption("options", "quorum", "majority")
-	setOption("options", "on-no-quorum", "io-error")
-	setOption("options", "on-no-data-accessible", "io-error")
-	setOption("options", "on-suspended-primary-outdated", "force-secondary")
-	
-	setOption("net", "rr-conflict", "retry-connect")
-
-	// Process user options
-	for k, v := range options {
+	// Apply an option given as section/key, or key in the options section
+	applyOption := func(k, v string) {
 		parts := strings.SplitN(k, "/", 2)
 		if len(parts) == 2 {
 			// section/key format (e.g. disk/on-io-error)
 			section := strings.ToLower(parts[0])
-			key := parts[1]
-			setOption(section, key, v)
+			if section == "handlers" && v != "" {
+				// Handler commands are strings, given with or without quotes
+				v = `"` + drbdHandlerCommand(v) + `"`
+			}
+			setOption(section, parts[1], v)
 		} else {
 			// default to options section
 			setOption("options", k, v)
 		}
 	}
 
+	// Cluster defaults, overridden per pool and then by the resource's own
+	// options
+	for k, v := range rm.drbdDefaultOptions(pool) {
+		applyOption(k, v)
+	}
+	for k, v := range options {
+		applyOption(k, v)
+	}
+
+	// An empty value drops the option, DRBD's own default applies
+	for section, opts := range sections {
+		for k, v := range opts {
+			if v == "" {
+				delete(opts, k)
+			}
+		}
+		if len(opts) == 0 {
+			delete(sections, section)
+		}
+	}
+	if opts := sections["options"]; !features.Quorum && opts != nil {
+		for _, k := range quorumOptions {
+			delete(opts, k)
+		}
+		if len(opts) == 0 {
+			delete(sections, "options")
+		}
+	}
+
+	config.WriteString(fmt.Sprintf("# Generated by sds-controller for %s\n", rm.controller.clusterStamp()))
 	config.WriteString(fmt.Sprintf("resource %s {\n", name))
 
 	// Write configuration sections
@@ -364,13 +496,14 @@ func (rm *ResourceManager) generateDrbdConfig(name string, port uint32, nodes []
 	config.WriteString(fmt.Sprintf("        device    minor %d;\n", port-7000))
 
 	// Use ZFS device path or LVM device path based on storage type
-	var diskPath string
-	if storageType == "zfs" || storageType == "zfs-thin" {
-		diskPath = fmt.Sprintf("/dev/zvol/%s/%s", pool, volumeName)
-	} else {
-		diskPath = fmt.Sprintf("/dev/%s/%s", pool, volumeName)
+	perNodeDisks := len(rawDevices) > 0 && rawDisksDiffer(rawDevices)
+	if !perNodeDisks {
+		diskPath := backingDevicePath(storageType, pool, volumeName)
+		if len(rawDevices) > 0 {
+			diskPath = rawDevices[nodes[0]]
+		}
+		config.WriteString(fmt.Sprintf("        disk      %s;\n", diskPath))
 	}
-	config.WriteString(fmt.Sprintf("        disk      %s;\n", diskPath))
 	config.WriteString("        meta-disk internal;\n")
 	
 	// Inject disk options here
@@ -393,7 +526,10 @@ func (rm *ResourceManager) generateDrbdConfig(name string, port uint32, nodes []
 	var hostnames []string
 	for i, node := range nodes {
 		// Get IP address from NodeManager by node name
-		ip := rm.controller.nodes.GetNodeAddressByName(node)
+		ip := addresses[node]
+		if ip == "" {
+			ip = rm.controller.nodes.GetNodeAddressByName(node)
+		}
 
 		// Fallback: try direct lookup in hostMap
 		if ip == "" {
@@ -409,13 +545,36 @@ func (rm *ResourceManager) generateDrbdConfig(name string, port uint32, nodes []
 
 		hostnames = append(hostnames, node)
 		config.WriteString(fmt.Sprintf("\n    on %s {\n", node))
-		config.WriteString(fmt.Sprintf("        address   %s:%d;\n", ip, port))
-		config.WriteString(fmt.Sprintf("        node-id   %d;\n", i))
+		config.WriteString(fmt.Sprintf("        address   %s;\n", drbdAddress(ip, port)))
+		if features.Drbd9 {
+			config.WriteString(fmt.Sprintf("        node-id   %d;\n", i))
+		}
+		if perNodeDisks {
+			config.WriteString("        volume 0 {\n")
+			config.WriteString(fmt.Sprintf("            disk  %s;\n", rawDevices[node]))
+			config.WriteString("        }\n")
+		}
 		config.WriteString("    }\n")
 	}
 
-	// Use connection-mesh for DRBD 9
-	if len(hostnames) > 0 {
+	// Per-peer protocols need explicit connections, a mesh has one net
+	// section. DRBD 8.4 connects its two on sections without either.
+	if features.Drbd9 && len(peerProtocols) > 0 {
+		for i := range hostnames {
+			for j := i + 1; j < len(hostnames); j++ {
+				config.WriteString("\n    connection {\n")
+				config.WriteString(fmt.Sprintf("        host %s;\n", hostnames[i]))
+				config.WriteString(fmt.Sprintf("        host %s;\n", hostnames[j]))
+				if p := connectionProtocol(protocol, peerProtocols, hostnames[i], hostnames[j]); p != protocol {
+					config.WriteString("        net {\n")
+					config.WriteString(fmt.Sprintf("            protocol %s;\n", p))
+					config.WriteString("        }\n")
+				}
+				config.WriteString("    }\n")
+			}
+		}
+	} else if features.Drbd9 && len(hostnames) > 0 {
+		// Use connection-mesh for DRBD 9
 		config.WriteString("\n    connection-mesh {\n")
 		config.WriteString("        hosts")
 		for _, hostname := range hostnames {
@@ -430,6 +589,15 @@ func (rm *ResourceManager) generateDrbdConfig(name string, port uint32, nodes []
 	return config.String()
 }
 
+// drbdAddress returns the address of a node in an on section, IPv6 with
+// its address family and in brackets
+func drbdAddress(ip string, port uint32) string {
+	if strings.Contains(ip, ":") {
+		return fmt.Sprintf("ipv6 [%s]:%d", strings.Trim(ip, "[]"), port)
+	}
+	return fmt.Sprintf("%s:%d", ip, port)
+}
+
 // GetResource gets resource information from database with live status
 func (rm *ResourceManager) GetResource(ctx context.Context, name string) (*ResourceInfo, error) {
 	if rm.controller.db == nil {
@@ -483,7 +651,6 @@ func (rm *ResourceManager) GetResource(ctx context.Context, name string) (*Resou
 					volumes = append(volumes, &ResourceVolumeInfo{
 						VolumeID: uint32(v.id),
 						Device:   v.device,
-						SizeGB:   v.sizeGB,
 					})
 				}
 
@@ -498,15 +665,94 @@ func (rm *ResourceManager) GetResource(ctx context.Context, name string) (*Resou
 		}
 	}
 
+	volumes = rm.attachVolumeRecords(ctx, name, volumes)
+
+	// Live status has no sizes, query the backing volumes
+	var records []*database.Volume
+	for _, v := range volumes {
+		if v.Record != nil {
+			records = append(records, v.Record)
+		}
+	}
+	sizes := rm.VolumeSizes(ctx, records)
+	for _, v := range volumes {
+		if size, ok := sizes[v.Record]; ok {
+			v.Size = size
+			v.SizeGB = size.SizeBytes >> 30
+		}
+	}
+
 	info := &ResourceInfo{
 		Name:       dbRes.Name,
 		Port:       uint32(dbRes.Port),
 		Protocol:   dbRes.Protocol,
 		Nodes:      nodeAddresses,
 		Role:       localRole, // Local node's role
+
+		PeerProtocols:   dbRes.PeerProtocols,
+		MaxPeers:        resourceMaxPeers(dbRes),
+		MaxPeersPending: dbRes.MaxPeersPending,
+		DesiredState:    desiredState(dbRes),
+		Owner:           dbRes.Owner,
 		Volumes:    volumes,
 		NodeStates: nodeStates,
 	}
+	info.Health, info.HealthReasons = rm.controller.resourceHealth(dbRes.Name)
+
+	return info, nil
+}
+
+// GetResourceFromDB returns a resource from its database records and the
+// DRBD states of the last node poll, without the remote calls of
+// GetResource, for callers that need its metadata, e.g. gateways. Volumes
+// have no backing sizes; Role is Primary if a node was at the last poll.
+func (rm *ResourceManager) GetResourceFromDB(ctx context.Context, name string) (*ResourceInfo, error) {
+	if rm.controller.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	dbRes, err := rm.controller.db.GetResource(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("resource not found: %s", name)
+	}
+
+	var nodes []string
+	if dbRes.Nodes != "" {
+		nodes = strings.Split(dbRes.Nodes, ",")
+	}
+
+	role := "Unknown"
+	nodeStates := make(map[string]*ResourceNodeState)
+	for _, node := range nodes {
+		state := rm.controller.liveState(node, name)
+		if state == nil {
+			continue
+		}
+		nodeStates[node] = state
+		if state.Role == "Primary" {
+			role = "Primary"
+		} else if role == "Unknown" {
+			role = state.Role
+		}
+	}
+
+	volumes := rm.attachVolumeRecords(ctx, name, nil)
+	sort.Slice(volumes, func(i, j int) bool { return volumes[i].VolumeID < volumes[j].VolumeID })
+
+	info := &ResourceInfo{
+		Name:            dbRes.Name,
+		Port:            uint32(dbRes.Port),
+		Protocol:        dbRes.Protocol,
+		Nodes:           nodes,
+		Role:            role,
+		PeerProtocols:   dbRes.PeerProtocols,
+		MaxPeers:        resourceMaxPeers(dbRes),
+		MaxPeersPending: dbRes.MaxPeersPending,
+		DesiredState:    desiredState(dbRes),
+		Owner:           dbRes.Owner,
+		Volumes:         volumes,
+		NodeStates:      nodeStates,
+	}
+	info.Health, info.HealthReasons = rm.controller.resourceHealth(dbRes.Name)
 
 	return info, nil
 }
@@ -537,16 +783,24 @@ func (rm *ResourceManager) ListResources(ctx context.Context) ([]*ResourceInfo,
 			Protocol: dbRes.Protocol,
 			Nodes:    nodeAddresses,
 			Role:     "Unknown", // Will be updated by GetResource if needed
+
+			PeerProtocols:   dbRes.PeerProtocols,
+			MaxPeers:        resourceMaxPeers(dbRes),
+			MaxPeersPending: dbRes.MaxPeersPending,
+			DesiredState:    desiredState(dbRes),
+			Owner:           dbRes.Owner,
 			Volumes:  []*ResourceVolumeInfo{},
 			NodeStates: make(map[string]*ResourceNodeState),
 		})
+		res := resources[len(resources)-1]
+		res.Health, res.HealthReasons = rm.controller.resourceHealth(dbRes.Name)
 	}
 
 	return resources, nil
 }
 
-// AddVolume adds a volume to an existing DRBD resource
-func (rm *ResourceManager) AddVolume(ctx context.Context, resource, volume, pool string, sizeGB uint32) error {
+// AddVolume adds a volume to an existing DRBD resource and returns its record
+func (rm *ResourceManager) AddVolume(ctx context.Context, resource, volume, pool string, sizeGB uint32) (*database.Volume, error) {
 	rm.controller.logger.Info("Adding volume to resource",
 		zap.String("resource", resource),
 		zap.String("volume", volume),
@@ -554,9 +808,21 @@ func (rm *ResourceManager) AddVolume(ctx context.Context, resource, volume, pool
 		zap.Uint32("size_gb", sizeGB))
 
 	if rm.deployment == nil {
-		return fmt.Errorf("deployment client not set")
+		return nil, fmt.Errorf("deployment client not set")
 	}
 
+	// The new volume is backed like the existing ones of the resource
+	storageType := "lvm"
+	if rm.controller.db != nil {
+		if volumes, err := rm.controller.db.ListVolumes(ctx, resource); err == nil && len(volumes) > 0 {
+			if volumes[0].StorageType != "" {
+				storageType = volumes[0].StorageType
+			}
+			if pool == "" {
+				pool = volumes[0].Pool
+			}
+		}
+	}
 	if pool == "" {
 		pool = "data-pool"
 	}
@@ -568,7 +834,7 @@ func (rm *ResourceManager) AddVolume(ctx context.Context, resource, volume, pool
 	// Get current config to find next volume number and minor
 	result, err := rm.deployment.Exec(ctx, []string{hosts[0]}, fmt.Sprintf("cat /etc/drbd.d/%s.res", resource))
 	if err != nil {
-		return fmt.Errorf("failed to read config: %w", err)
+		return nil, fmt.Errorf("failed to read config: %w", err)
 	}
 
 	var hostResult *deployment.HostResult
@@ -578,7 +844,7 @@ func (rm *ResourceManager) AddVolume(ctx context.Context, resource, volume, pool
 	}
 
 	if hostResult == nil || !hostResult.Success {
-		return fmt.Errorf("failed to get config")
+		return nil, fmt.Errorf("failed to get config")
 	}
 
 	maxVolNum := -1
@@ -616,24 +882,30 @@ func (rm *ResourceManager) AddVolume(ctx context.Context, resource, volume, pool
 	newMinor := maxMinor + 1
 
 	// Generate volume block for new volume
-	// Note: AddVolume currently only supports LVM
-	volumeBlock := fmt.Sprintf("    volume %d {\n        device    minor %d;\n        disk      /dev/%s/%s;\n        meta-disk internal;\n    }",
-		newVolNum, newMinor, pool, volume)
+	device := backingDevicePath(storageType, pool, volume)
+	volumeBlock := fmt.Sprintf("    volume %d {\n        device    minor %d;\n        disk      %s;\n        meta-disk internal;\n    }",
+		newVolNum, newMinor, device)
 
-	// Create LVs on all nodes
+	// Create the backing volumes on all nodes
+	backend, err := rm.controller.storage.Backend(storageType)
+	if err != nil {
+		return nil, err
+	}
 	for _, host := range hosts {
-		_, err := rm.deployment.LVCreate(ctx, []string{host}, pool, volume, fmt.Sprintf("%dG", sizeGB))
-		if err != nil {
-			return fmt.Errorf("failed to create LV on %s: %w", host, err)
+		if err := backend.CreateVolume(rm.controller.stepContext(ctx, StepVolumeCreate), host, pool, volume, uint64(sizeGB)<<30); err != nil {
+			return nil, fmt.Errorf("failed to create volume on %s: %w", host, err)
 		}
 	}
+	if err := rm.verifyBackingDevices(ctx, hosts, hosts, volumeBacking(hosts, device)); err != nil {
+		return nil, err
+	}
 
 	// Add volume block to config on all nodeAddresses
 	for _, host := range hosts {
 		updateCmd := fmt.Sprintf("sed -i '/^}/i %s' /etc/drbd.d/%s.res", volumeBlock, resource)
 		_, err := rm.deployment.Exec(ctx, []string{host}, updateCmd)
 		if err != nil {
-			return fmt.Errorf("failed to update config on %s: %w", host, err)
+			return nil, fmt.Errorf("failed to update config on %s: %w", host, err)
 		}
 	}
 
@@ -642,34 +914,66 @@ func (rm *ResourceManager) AddVolume(ctx context.Context, resource, volume, pool
 		_, _ = rm.deployment.DRBDDown(ctx, []string{host}, resource)
 	}
 
-	// Create metadata for new volume only
+	// Create metadata for new volume only, with the peer slots of the
+	// resource's other volumes
+	maxPeers := max(len(hosts)-1, 1)
+	if rm.controller.db != nil {
+		if res, err := rm.controller.db.GetResource(ctx, resource); err == nil {
+			maxPeers = resourceMaxPeers(res)
+		}
+	}
+	// DRBD 8.4 has v08 metadata without peer slots
+	drbd9 := rm.controller.drbdFeatures(hosts).Drbd9
 	for _, host := range hosts {
-		createMetaCmd := fmt.Sprintf("sudo drbdmeta --force %d v09 /dev/%s/%s internal create-md %d",
-			newMinor, pool, volume, len(hosts)*3)
-		_, err := rm.deployment.Exec(ctx, []string{host}, createMetaCmd)
+		createMetaCmd := fmt.Sprintf("sudo drbdmeta --force %d v09 %s internal create-md %d",
+			newMinor, device, maxPeers)
+		if !drbd9 {
+			createMetaCmd = fmt.Sprintf("sudo drbdmeta --force %d v08 %s internal create-md",
+				newMinor, device)
+		}
+		_, err := rm.deployment.Exec(rm.controller.stepContext(ctx, StepMetadata), []string{host}, createMetaCmd)
 		if err != nil {
-			return fmt.Errorf("failed to create metadata on %s: %w", host, err)
+			return nil, fmt.Errorf("failed to create metadata on %s: %w", host, err)
 		}
 	}
 
 	// Up resource
-	upResult, err := rm.deployment.DRBDUp(ctx, hosts, resource)
+	upResult, err := rm.deployment.DRBDUp(rm.controller.stepContext(ctx, StepDrbdUp), hosts, resource)
 	if err != nil {
-		return fmt.Errorf("failed to bring up resource: %w", err)
+		return nil, fmt.Errorf("failed to bring up resource: %w", err)
 	}
 	if !upResult.AllSuccess() {
-		return fmt.Errorf("resource up failed on hosts: %v", upResult.FailedHosts())
+		return nil, fmt.Errorf("resource up failed on hosts: %v", upResult.FailedHosts())
 	}
 
 	rm.controller.logger.Info("Volume added successfully",
 		zap.String("resource", resource),
 		zap.String("volume", volume))
 
-	return nil
+	dbVol := &database.Volume{
+		ResourceName: resource,
+		VolumeName:   volume,
+		VolumeID:     newVolNum,
+		Pool:         pool,
+		StorageType:  storageType,
+		SizeGB:       int(sizeGB),
+		Device:       fmt.Sprintf("/dev/drbd%d", newMinor),
+	}
+	if nodeNames, err := rm.resourceNodeNames(ctx, resource); err == nil {
+		dbVol.Backing = volumeBacking(nodeNames, device)
+	}
+	if rm.controller.db != nil {
+		if err := rm.controller.db.SaveVolume(ctx, dbVol); err != nil {
+			rm.controller.logger.Warn("Failed to save volume to database", zap.Error(err))
+		}
+	}
+
+	return dbVol, nil
 }
 
-// DeleteResource deletes a DRBD resource from all nodeAddresses
-func (rm *ResourceManager) DeleteResource(ctx context.Context, name string, force bool) error {
+// DeleteResource deletes a DRBD resource from all nodeAddresses.
+// Unless skipSafety is set, a safety snapshot of its backing volumes is taken first.
+func (rm *ResourceManager) DeleteResource(ctx context.Context, name string, force, skipSafety bool) error {
 	rm.controller.logger.Info("Deleting DRBD resource",
 		zap.String("name", name),
 		zap.Bool("force", force))
@@ -678,6 +982,11 @@ func (rm *ResourceManager) DeleteResource(ctx context.Context, name string, forc
 		return fmt.Errorf("deployment client not set")
 	}
 
+	safety, err := rm.controller.snapshots.SafetySnapshot(ctx, name, "", SafetyOpDelete, "", skipSafety)
+	if err != nil {
+		return err
+	}
+
 	rm.mu.RLock()
 	hosts := rm.hosts
 	rm.mu.RUnlock()
@@ -701,9 +1010,42 @@ func (rm *ResourceManager) DeleteResource(ctx context.Context, name string, forc
 	// 3. Delete LVs (optional, depends on use case)
 	// This is left for the caller to decide
 
+	// 4. Forget the resource and its volume records
+	if rm.controller.db != nil {
+		if volumes, err := rm.controller.db.ListVolumes(ctx, name); err == nil {
+			for _, v := range volumes {
+				if err := rm.controller.db.DeleteVolume(ctx, name, v.VolumeName); err != nil {
+					rm.controller.logger.Warn("Failed to delete volume from database", zap.Error(err))
+				}
+			}
+		}
+		if err := rm.controller.db.DeleteSnapshotHooks(ctx, name); err != nil {
+			rm.controller.logger.Warn("Failed to delete snapshot hooks from database", zap.Error(err))
+		}
+		if err := rm.controller.db.DeleteSyncRateOverride(ctx, name); err != nil {
+			rm.controller.logger.Warn("Failed to delete sync rate override from database", zap.Error(err))
+		}
+		// A resource created again under the name starts unfenced
+		for _, constraint := range rm.controller.fenceConstraints(ctx, name) {
+			if err := rm.controller.db.DeleteFenceConstraint(ctx, name, constraint.Node); err != nil {
+				rm.controller.logger.Warn("Failed to delete fence constraint from database", zap.Error(err))
+			}
+		}
+		// The replicated snapshots stay on the backup node
+		if err := rm.controller.db.DeleteReplicationPolicy(ctx, name); err != nil {
+			rm.controller.logger.Warn("Failed to delete replication policy from database", zap.Error(err))
+		}
+		if err := rm.controller.db.DeleteResource(ctx, name); err != nil {
+			rm.controller.logger.Warn("Failed to delete resource from database", zap.Error(err))
+		}
+	}
+
 	rm.controller.logger.Info("Resource deleted successfully",
 		zap.String("name", name))
 
+	rm.controller.RecordEvent(ctx, EventResourceDeleted, name,
+		fmt.Sprintf("Resource %s deleted", name), safetyEventDetails(safety, nil))
+
 	return nil
 }
 
@@ -722,7 +1064,17 @@ func (rm *ResourceManager) SetPrimary(ctx context.Context, resource, node string
 		return fmt.Errorf("deployment client not set")
 	}
 
-	result, err := rm.deployment.DRBDPrimary(ctx, address, resource, force)
+	if name := rm.resourceNodeName(ctx, resource, node); name != "" {
+		if err := rm.checkPlacement(ctx, resource, name); err != nil {
+			return err
+		}
+		// A fenced node may have missed writes, promoting it needs force
+		if constraint := rm.controller.fenced(ctx, resource, name); constraint != nil && !force {
+			return fmt.Errorf("node %s is %s, lift it with sds fencing clear or use --force", name, fenceProblem(constraint))
+		}
+	}
+
+	result, err := rm.deployment.DRBDPrimary(rm.controller.stepContext(ctx, StepPromote), address, resource, force)
 	if err != nil {
 		return fmt.Errorf("failed to set primary: %w", err)
 	}
@@ -902,6 +1254,12 @@ func (rm *ResourceManager) Unmount(ctx context.Context, resource string, volumeI
 	return nil
 }
 
+// haMountUnitPath returns the path of the systemd mount unit of an HA mount point
+func haMountUnitPath(mountPoint string) string {
+	unitName := strings.ReplaceAll(strings.TrimPrefix(mountPoint, "/"), "/", "-")
+	return fmt.Sprintf("/etc/systemd/system/%s.mount", unitName)
+}
+
 // generateSystemdMountUnit generates a systemd mount unit content
 func (rm *ResourceManager) generateSystemdMountUnit(resource, mountPoint, fsType string) string {
 	device := fmt.Sprintf("/dev/drbd/by-res/%s/0", resource)
@@ -917,50 +1275,38 @@ WantedBy=multi-user.target
 }
 
 // MakeHa creates a drbd-reactor promoter config for HA failover
-func (rm *ResourceManager) MakeHa(ctx context.Context, resource string, services []string, mountPoint, fsType, vip string) (string, error) {
+// dependsOn lists HA resources that must be active on the same node before
+// this resource's mount, VIP and services are started.
+func (rm *ResourceManager) MakeHa(ctx context.Context, resource string, services []string, mountPoint, fsType, vip string, dependsOn []string, policy HaPolicy) (string, error) {
 	rm.controller.logger.Info("Making resource HA",
 		zap.String("resource", resource),
 		zap.Strings("services", services),
 		zap.String("mount_point", mountPoint),
 		zap.String("fstype", fsType),
-		zap.String("vip", vip))
+		zap.String("vip", vip),
+		zap.Strings("depends_on", dependsOn),
+		zap.String("on_demote_failure", policy.OnDemoteFailure))
 
 	if rm.deployment == nil {
 		return "", fmt.Errorf("deployment client not set")
 	}
-
-	// Get hosts for deployment
-	rm.mu.RLock()
-	hosts := rm.hosts
-	rm.mu.RUnlock()
-
-	if len(hosts) == 0 {
-		return "", fmt.Errorf("no hosts configured")
+	if err := policy.Validate(); err != nil {
+		return "", err
 	}
 
-	// Get resource info to find nodeAddresses
-	dbResource, err := rm.controller.db.GetResource(ctx, resource)
+	// Get the verified node set of the resource; all configs are scoped to it
+	nodeNames, err := rm.resourceNodeNames(ctx, resource)
 	if err != nil {
-		return "", fmt.Errorf("failed to get resource from database: %w", err)
+		return "", err
 	}
 
-	if dbResource == nil {
-		return "", fmt.Errorf("resource not found: %s", resource)
+	nodeAddresses, err := rm.ResourceHosts(ctx, resource)
+	if err != nil {
+		return "", err
 	}
 
-	nodeNames := strings.Split(dbResource.Nodes, ",")
-	if len(nodeNames) == 0 {
-		return "", fmt.Errorf("no nodes found for resource")
-	}
-
-	// Convert node names to addresses for deployment
-	nodeAddresses := make([]string, len(nodeNames))
-	for i, nodeName := range nodeNames {
-		addr := rm.controller.nodes.GetNodeAddressByName(nodeName)
-		if addr == "" {
-			return "", fmt.Errorf("failed to resolve address for node: %s", nodeName)
-		}
-		nodeAddresses[i] = addr
+	if err := rm.validateHaDependencies(ctx, resource, nodeNames, dependsOn); err != nil {
+		return "", err
 	}
 
 	// Step 1: Check DRBD status and ensure resource is up
@@ -970,7 +1316,7 @@ func (rm *ResourceManager) MakeHa(ctx context.Context, resource string, services
 
 	// First, ensure resource is up on all nodes
 	rm.controller.logger.Info("Bringing up DRBD resource on all nodes")
-	_, err = rm.deployment.Exec(ctx, nodeAddresses, "sudo drbdadm up "+resource)
+	_, err = rm.deployment.Exec(rm.controller.stepContext(ctx, StepDrbdUp), nodeAddresses, "sudo drbdadm up "+resource)
 	if err != nil {
 		rm.controller.logger.Warn("Failed to bring up resource (continuing anyway)", zap.Error(err))
 	}
@@ -992,15 +1338,17 @@ func (rm *ResourceManager) MakeHa(ctx context.Context, resource string, services
 		}
 	}
 
+	// Placement rules decide which node is preferred as Primary
+	preferredNodes := rm.rankNodes(ctx, resource, nodeNames)
+
 	if !hasPrimary {
-		rm.controller.logger.Info("No Primary node found, setting first node as Primary",
-			zap.String("node", nodeNames[0]),
-			zap.String("address", nodeAddresses[0]))
-		if err := rm.SetPrimary(ctx, resource, nodeAddresses[0], true); err != nil {
+		rm.controller.logger.Info("No Primary node found, setting preferred node as Primary",
+			zap.String("node", preferredNodes[0]))
+		if err := rm.SetPrimary(ctx, resource, rm.nodeAddress(preferredNodes[0]), true); err != nil {
 			return "", fmt.Errorf("failed to set Primary: %w", err)
 		}
 		rm.controller.logger.Info("Primary set successfully",
-			zap.String("node", nodeNames[0]))
+			zap.String("node", preferredNodes[0]))
 	}
 
 	// Step 2: Create filesystem if mount point and fs type are specified
@@ -1109,40 +1457,37 @@ func (rm *ResourceManager) MakeHa(ctx context.Context, resource string, services
 
 	// Handle mount unit creation
 	if mountPoint != "" {
-		mountUnitName := strings.TrimPrefix(mountPoint, "/")
-		mountUnitName = strings.ReplaceAll(mountUnitName, "/", "-")
-		mountUnitName = fmt.Sprintf("%s.mount", mountUnitName)
-
 		mountContent := rm.generateSystemdMountUnit(resource, mountPoint, fsType)
-		mountPath := fmt.Sprintf("/etc/systemd/system/%s", mountUnitName)
+		mountPath := haMountUnitPath(mountPoint)
 
 		rm.controller.logger.Info("Distributing mount unit", zap.String("path", mountPath))
 
-		if _, err := rm.deployment.DistributeConfig(ctx, hosts, mountContent, mountPath); err != nil {
+		if _, err := rm.deployment.DistributeConfig(ctx, nodeAddresses, mountContent, mountPath); err != nil {
 			return "", fmt.Errorf("failed to distribute mount unit: %w", err)
 		}
 
 		// Reload systemd to pick up new unit
-		if _, err := rm.deployment.Exec(ctx, hosts, "systemctl daemon-reload"); err != nil {
+		if _, err := rm.deployment.Exec(ctx, nodeAddresses, "systemctl daemon-reload"); err != nil {
 			rm.controller.logger.Warn("Failed to reload systemd", zap.Error(err))
 		}
 	}
 
 	// Generate drbd-reactor promoter config
-	configPath := fmt.Sprintf("/etc/drbd-reactor.d/sds-ha-%s.toml", resource)
-	configContent := rm.generatePromoterConfig(resource, nodeAddresses, services, mountPoint, fsType, vip)
+	configName := rm.controller.resourceHaConfigName(ctx, resource)
+	configPath := haConfigPath(configName)
+	configContent := rm.generatePromoterConfig(resource, nodeAddresses, services, mountPoint, fsType, vip, dependsOn, preferredNodes, policy)
 
 	rm.controller.logger.Debug("Generated promoter config",
 		zap.String("config", configContent))
 
-	// Distribute config to all hosts using DistributeConfig
-	_, err = rm.deployment.DistributeConfig(ctx, hosts, configContent, configPath)
+	// Distribute config to the resource's nodes only
+	_, err = rm.deployment.DistributeConfig(ctx, nodeAddresses, configContent, configPath)
 	if err != nil {
 		return "", fmt.Errorf("failed to distribute promoter config: %w", err)
 	}
 
-	// Reload drbd-reactor on all hosts
-	_, err = rm.deployment.ReactorReload(ctx, hosts)
+	// Reload drbd-reactor on the resource's nodes
+	_, err = rm.deployment.ReactorReload(ctx, nodeAddresses)
 	if err != nil {
 		rm.controller.logger.Warn("Failed to reload drbd-reactor", zap.Error(err))
 	}
@@ -1155,7 +1500,7 @@ func (rm *ResourceManager) MakeHa(ctx context.Context, resource string, services
 		backupDir := fmt.Sprintf("/tmp/ha_backup_%s", strings.ReplaceAll(mountPoint, "/", "_"))
 
 		// Find the active (primary) node for restoration
-		activeNode, err := rm.findActiveNode(ctx, resource, hosts)
+		activeNode, err := rm.findActiveNode(ctx, resource, nodeAddresses)
 		if err != nil {
 			rm.controller.logger.Warn("Failed to find active node for data restore",
 				zap.Error(err))
@@ -1199,6 +1544,12 @@ func (rm *ResourceManager) MakeHa(ctx context.Context, resource string, services
 			MountPoint: mountPoint,
 			FsType:     fsType,
 			Services:   services,
+			DependsOn:  dependsOn,
+
+			OnDemoteFailure:    policy.OnDemoteFailure,
+			StopServicesOnExit: policy.StopServicesOnExit,
+			NoSecondaryForce:   !policy.SecondaryForce,
+			ConfigName:         configName,
 		}
 		if err := rm.controller.db.SaveHaConfig(ctx, haCfg); err != nil {
 			rm.controller.logger.Warn("Failed to save HA config to database", zap.Error(err))
@@ -1208,6 +1559,113 @@ func (rm *ResourceManager) MakeHa(ctx context.Context, resource string, services
 	return configPath, nil
 }
 
+// PlanHa is the dry run of MakeHa: it checks the HA config and returns the
+// mount unit and promoter config MakeHa would write to each node of the
+// resource, compared with the deployed ones. Nothing is changed.
+func (rm *ResourceManager) PlanHa(ctx context.Context, resource string, services []string, mountPoint, fsType, vip string, dependsOn []string, policy HaPolicy) ([]*PlannedFile, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	nodeNames, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	nodeAddresses, err := rm.ResourceHosts(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	if err := rm.validateHaDependencies(ctx, resource, nodeNames, dependsOn); err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]string)
+	if mountPoint != "" {
+		files[haMountUnitPath(mountPoint)] = rm.generateSystemdMountUnit(resource, mountPoint, fsType)
+	}
+	configPath := haConfigPath(rm.controller.resourceHaConfigName(ctx, resource))
+	files[configPath] = rm.generatePromoterConfig(resource, nodeAddresses, services, mountPoint, fsType, vip, dependsOn,
+		rm.rankNodes(ctx, resource, nodeNames), policy)
+	return rm.planFiles(ctx, nodeNames, files), nil
+}
+
+// validateHaDependencies checks that every resource an HA resource depends on
+// is itself HA-managed, replicated on all of the dependent's nodes (so both
+// can be co-located wherever the dependent is promoted) and that the
+// dependency graph stays acyclic.
+func (rm *ResourceManager) validateHaDependencies(ctx context.Context, resource string, nodeNames, dependsOn []string) error {
+	if len(dependsOn) == 0 {
+		return nil
+	}
+	if rm.controller.db == nil {
+		return fmt.Errorf("database not available")
+	}
+
+	seen := make(map[string]bool)
+	for _, parent := range dependsOn {
+		if parent == resource {
+			return fmt.Errorf("resource %s cannot depend on itself", resource)
+		}
+		if seen[parent] {
+			return fmt.Errorf("duplicate dependency %s", parent)
+		}
+		seen[parent] = true
+
+		if _, err := rm.controller.db.GetHaConfig(ctx, parent); err != nil {
+			return fmt.Errorf("dependency %s is not HA-managed, run 'sds ha create %s' first", parent, parent)
+		}
+
+		parentNodes, err := rm.resourceNodeNames(ctx, parent)
+		if err != nil {
+			return fmt.Errorf("failed to get nodes of dependency %s: %w", parent, err)
+		}
+		for _, node := range nodeNames {
+			if !containsString(parentNodes, node) {
+				return fmt.Errorf("dependency %s is not replicated on node %s, resources must share nodes to be co-located", parent, node)
+			}
+		}
+
+		if rm.haDependsOn(ctx, parent, resource, make(map[string]bool)) {
+			return fmt.Errorf("dependency %s already depends on %s, cycles are not allowed", parent, resource)
+		}
+	}
+
+	return nil
+}
+
+// haDependsOn reports whether HA resource from (transitively) depends on target
+func (rm *ResourceManager) haDependsOn(ctx context.Context, from, target string, visited map[string]bool) bool {
+	if visited[from] {
+		return false
+	}
+	visited[from] = true
+
+	haCfg, err := rm.controller.db.GetHaConfig(ctx, from)
+	if err != nil {
+		return false
+	}
+	for _, dep := range haCfg.DependsOn {
+		if dep == target || rm.haDependsOn(ctx, dep, target, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// haDependents returns the HA resources that directly depend on resource
+func (rm *ResourceManager) haDependents(ctx context.Context, resource string) []string {
+	var dependents []string
+	haConfigs, err := rm.controller.db.ListHaConfigs(ctx)
+	if err != nil {
+		return dependents
+	}
+	for _, cfg := range haConfigs {
+		if containsString(cfg.DependsOn, resource) {
+			dependents = append(dependents, cfg.Resource)
+		}
+	}
+	return dependents
+}
+
 // ListHaConfigs lists all HA configurations from database
 func (rm *ResourceManager) ListHaConfigs(ctx context.Context) ([]*database.HaConfig, error) {
 	if rm.controller.db == nil {
@@ -1230,17 +1688,15 @@ func (rm *ResourceManager) GetHaConfig(ctx context.Context, resource string) (*d
 // 2. Stop all services (mount, VIP, etc.)
 // 3. Demote DRBD to Secondary
 // 4. Wait for another node to promote to Primary
-func (rm *ResourceManager) EvictHa(ctx context.Context, resource string) error {
+// Unless forced, another node must pass the failover guard first.
+func (rm *ResourceManager) EvictHa(ctx context.Context, resource string, force bool) error {
 	rm.controller.logger.Info("Evicting HA resource",
 		zap.String("resource", resource))
 
-	// Get hosts for deployment
-	rm.mu.RLock()
-	hosts := rm.hosts
-	rm.mu.RUnlock()
-
-	if len(hosts) == 0 {
-		return fmt.Errorf("no hosts configured")
+	// Only the resource's nodes can be active
+	hosts, err := rm.ResourceHosts(ctx, resource)
+	if err != nil {
+		return err
 	}
 
 	rm.controller.logger.Info("Hosts configured",
@@ -1256,8 +1712,73 @@ func (rm *ResourceManager) EvictHa(ctx context.Context, resource string) error {
 		zap.String("resource", resource),
 		zap.String("active_node", activeNode))
 
+	activeName := rm.resourceNodeName(ctx, resource, activeNode)
+	colocated, separated := rm.placementPartners(ctx, resource)
+
+	// Anti-affinity: there must be a node left that no separated partner is Primary on
+	if len(separated) > 0 && activeName != "" {
+		nodes, err := rm.resourceNodeNames(ctx, resource)
+		if err != nil {
+			return err
+		}
+		taken := make(map[string]bool)
+		for _, partner := range separated {
+			if node := rm.primaryNode(ctx, partner); node != "" {
+				taken[node] = true
+			}
+		}
+		eligible := 0
+		for _, node := range nodes {
+			if node != activeName && !taken[node] {
+				eligible++
+			}
+		}
+		if eligible == 0 {
+			return fmt.Errorf("cannot evict %s from %s: all other nodes run anti-affine resources", resource, activeName)
+		}
+	}
+
+	// Refuse unless another node can take over safely
+	if !force && activeName != "" {
+		nodes, err := rm.resourceNodeNames(ctx, resource)
+		if err != nil {
+			return err
+		}
+		if err := rm.failoverGuard(ctx, resource, activeName, nodes); err != nil {
+			return err
+		}
+	}
+
+	if err := rm.evictOnNode(ctx, resource, activeNode); err != nil {
+		return err
+	}
+
+	// Co-located HA partners on the same node follow the resource
+	for _, partner := range colocated {
+		if _, err := rm.controller.db.GetHaConfig(ctx, partner); err != nil {
+			continue
+		}
+		if activeName == "" || rm.primaryNode(ctx, partner) != activeName {
+			continue
+		}
+		rm.controller.logger.Info("Evicting co-located HA resource",
+			zap.String("resource", partner),
+			zap.String("colocated_with", resource))
+		if err := rm.evictOnNode(ctx, partner, activeNode); err != nil {
+			return fmt.Errorf("failed to evict co-located resource %s: %w", partner, err)
+		}
+	}
+
+	rm.controller.logger.Info("HA resource evicted successfully",
+		zap.String("resource", resource))
+
+	return nil
+}
+
+// evictOnNode runs drbd-reactorctl evict for a resource's HA config on its active node
+func (rm *ResourceManager) evictOnNode(ctx context.Context, resource, activeNode string) error {
 	// The config name for drbd-reactorctl (without .toml extension)
-	configName := fmt.Sprintf("sds-ha-%s", resource)
+	configName := rm.controller.resourceHaConfigName(ctx, resource)
 
 	// Get local hostname to check if active node is local
 	hostnameBytes, _ := exec.Command("hostname").Output()
@@ -1306,9 +1827,6 @@ func (rm *ResourceManager) EvictHa(ctx context.Context, resource string) error {
 		}
 	}
 
-	rm.controller.logger.Info("HA resource evicted successfully",
-		zap.String("resource", resource))
-
 	return nil
 }
 
@@ -1441,6 +1959,66 @@ func (rm *ResourceManager) findActiveNode(ctx context.Context, resource string,
 	return "", fmt.Errorf("no active (Primary) node found for resource %s", resource)
 }
 
+// resourceNodeNames returns the node names a resource is replicated on
+func (rm *ResourceManager) resourceNodeNames(ctx context.Context, resource string) ([]string, error) {
+	if rm.controller.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+
+	dbRes, err := rm.controller.db.GetResource(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("resource not found: %s", resource)
+	}
+	if dbRes.Nodes == "" {
+		return nil, fmt.Errorf("resource %s has no nodes", resource)
+	}
+
+	return strings.Split(dbRes.Nodes, ","), nil
+}
+
+// nodeAddress resolves a node name to the address used for remote execution
+func (rm *ResourceManager) nodeAddress(node string) string {
+	if addr := rm.controller.nodes.GetNodeAddressByName(node); addr != "" {
+		return addr
+	}
+	return rm.controller.ResolveHost(node)
+}
+
+// ResourceHosts returns the addresses of the nodes that replicate a resource.
+// The node set is verified before it is returned: every node must resolve to
+// a registered address and have the resource configured, so that HA and
+// gateway configs are never distributed to nodes the resource is not attached to.
+func (rm *ResourceManager) ResourceHosts(ctx context.Context, resource string) ([]string, error) {
+	nodes, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]string, len(nodes))
+	for i, node := range nodes {
+		addr := rm.controller.nodes.GetNodeAddressByName(node)
+		if addr == "" {
+			return nil, fmt.Errorf("failed to resolve address for node: %s", node)
+		}
+		addresses[i] = addr
+	}
+
+	if rm.deployment == nil {
+		return nil, fmt.Errorf("deployment client not set")
+	}
+
+	checkCmd := fmt.Sprintf("sudo drbdadm dump %s >/dev/null", resource)
+	result, err := rm.deployment.Exec(ctx, addresses, checkCmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify node set of resource %s: %w", resource, err)
+	}
+	if !result.AllSuccess() {
+		return nil, fmt.Errorf("resource %s is not configured on nodes: %v", resource, result.FailedHosts())
+	}
+
+	return addresses, nil
+}
+
 // getNodeHost gets the host address for a node name
 // hosts format is "nodename:ip" or just "nodename"
 func (rm *ResourceManager) getNodeHost(nodeName string) string {
@@ -1469,9 +2047,17 @@ func (rm *ResourceManager) RemoveHa(ctx context.Context, resource string) error
 		return fmt.Errorf("deployment client not set")
 	}
 
-	rm.mu.RLock()
-	hosts := rm.hosts
-	rm.mu.RUnlock()
+	// Clean up on the resource's nodes; fall back to all hosts if the
+	// resource is gone or its node set can no longer be verified
+	hosts, err := rm.ResourceHosts(ctx, resource)
+	if err != nil {
+		rm.controller.logger.Warn("Failed to get resource node set, cleaning up on all hosts",
+			zap.String("resource", resource),
+			zap.Error(err))
+		rm.mu.RLock()
+		hosts = rm.hosts
+		rm.mu.RUnlock()
+	}
 
 	// Get HA config to know what to clean up
 	haCfg, err := rm.controller.db.GetHaConfig(ctx, resource)
@@ -1479,18 +2065,20 @@ func (rm *ResourceManager) RemoveHa(ctx context.Context, resource string) error
 		return fmt.Errorf("failed to get HA config: %w", err)
 	}
 
+	// Dependents would lose their start ordering target
+	if dependents := rm.haDependents(ctx, resource); len(dependents) > 0 {
+		return fmt.Errorf("HA resources %s depend on %s, remove them first", strings.Join(dependents, ", "), resource)
+	}
+
 	// 1. Delete promoter config
-	configPath := fmt.Sprintf("/etc/drbd-reactor.d/sds-ha-%s.toml", resource)
+	configPath := haConfigPath(haConfigName(haCfg))
 	if err := rm.deployment.DeleteConfig(ctx, hosts, configPath); err != nil {
 		rm.controller.logger.Warn("Failed to delete promoter config", zap.Error(err))
 	}
 
 	// 2. Delete mount unit if it exists
 	if haCfg.MountPoint != "" {
-		mountUnitName := strings.TrimPrefix(haCfg.MountPoint, "/")
-		mountUnitName = strings.ReplaceAll(mountUnitName, "/", "-")
-		mountUnitName = fmt.Sprintf("%s.mount", mountUnitName)
-		mountPath := fmt.Sprintf("/etc/systemd/system/%s", mountUnitName)
+		mountPath := haMountUnitPath(haCfg.MountPoint)
 
 		if err := rm.deployment.DeleteConfig(ctx, hosts, mountPath); err != nil {
 			rm.controller.logger.Warn("Failed to delete mount unit", zap.Error(err))
@@ -1510,10 +2098,52 @@ func (rm *ResourceManager) RemoveHa(ctx context.Context, resource string) error
 	return nil
 }
 
-// generatePromoterConfig generates drbd-reactor promoter TOML config
-func (rm *ResourceManager) generatePromoterConfig(resource string, nodeAddresses, services []string, mountPoint, fsType, vip string) string {
+// HaPolicy is the drbd-reactor failure handling of an HA resource
+type HaPolicy struct {
+	OnDemoteFailure    string // systemd action when demoting fails
+	StopServicesOnExit bool   // Stop the services when drbd-reactor exits
+	SecondaryForce     bool   // Demote with --force after stopping the services
+}
+
+// DefaultHaPolicy reboots a node that cannot demote, so the resource can be
+// promoted elsewhere; this is the drbd-reactor recommendation
+var DefaultHaPolicy = HaPolicy{OnDemoteFailure: "reboot", SecondaryForce: true}
+
+// Validate checks the policy against what drbd-reactor accepts
+func (p HaPolicy) Validate() error {
+	if !containsString(reactor.DemoteFailureActions, p.OnDemoteFailure) {
+		return fmt.Errorf("invalid on-drbd-demote-failure action %q, use one of: %s",
+			p.OnDemoteFailure, strings.Join(reactor.DemoteFailureActions, ", "))
+	}
+	return nil
+}
+
+// haPolicyOf returns the policy stored with an HA config
+func haPolicyOf(cfg *database.HaConfig) HaPolicy {
+	policy := HaPolicy{
+		OnDemoteFailure:    cfg.OnDemoteFailure,
+		StopServicesOnExit: cfg.StopServicesOnExit,
+		SecondaryForce:     !cfg.NoSecondaryForce,
+	}
+	if policy.OnDemoteFailure == "" {
+		policy.OnDemoteFailure = DefaultHaPolicy.OnDemoteFailure
+	}
+	return policy
+}
+
+// generatePromoterConfig generates drbd-reactor promoter TOML config.
+// Dependencies are started first through their drbd-services target, so
+// their mounts and services come up in order on the same node; a node that
+// cannot start a dependency cannot keep this resource either.
+// preferredNodes is the placement order of node names, most preferred first.
+// Policy settings that match the drbd-reactor defaults are left out.
+func (rm *ResourceManager) generatePromoterConfig(resource string, nodeAddresses, services []string, mountPoint, fsType, vip string, dependsOn, preferredNodes []string, policy HaPolicy) string {
 	var startActions []string
 
+	for _, dep := range dependsOn {
+		startActions = append(startActions, fmt.Sprintf("\"drbd-services@%s.target\"", systemdEscapeInstance(dep)))
+	}
+
 	// Add mount unit if mount point specified
 	if mountPoint != "" {
 		// Generate systemd mount unit name from path
@@ -1527,15 +2157,7 @@ func (rm *ResourceManager) generatePromoterConfig(resource string, nodeAddresses
 
 	// Add VIP if specified
 	if vip != "" {
-		// Use service-ip systemd unit
-		// Format: service-ip@<IP>-<MASK>.service (replace / with -)
-		vipParam := strings.ReplaceAll(vip, "/", "-")
-		if !strings.Contains(vipParam, "-") {
-			vipParam = vipParam + "-32"
-		}
-		
-		serviceIPUnit := fmt.Sprintf("\"service-ip@%s.service\"", vipParam)
-		startActions = append(startActions, serviceIPUnit)
+		startActions = append(startActions, fmt.Sprintf("\"%s\"", serviceIPUnit(vip)))
 	}
 
 	// Add systemd services
@@ -1545,7 +2167,7 @@ func (rm *ResourceManager) generatePromoterConfig(resource string, nodeAddresses
 
 	// Generate TOML config
 	toml := fmt.Sprintf(`# drbd-reactor promoter configuration for HA resource: %s
-# Generated by sds-controller
+# Generated by sds-controller for %s
 
 [[promoter]]
 [promoter.resources.%s]
@@ -1553,11 +2175,47 @@ runner = "systemd"
 start = [
 %s
 ]
-on-drbd-demote-failure = "reboot"
+on-drbd-demote-failure = %q
+`, resource, rm.controller.clusterStamp(), resource, strings.Join(startActions, ",\n"), policy.OnDemoteFailure)
+
+	if policy.StopServicesOnExit {
+		toml += "stop-services-on-exit = true\n"
+	}
+	if !policy.SecondaryForce {
+		toml += "secondary-force = false\n"
+	}
+
+	// Stop this resource if a dependency goes away underneath it
+	if len(dependsOn) > 0 {
+		toml += "dependencies-as = \"Requires\"\ntarget-as = \"Requires\"\n"
+	}
+
+	if len(preferredNodes) > 0 {
+		quoted := make([]string, len(preferredNodes))
+		for i, node := range preferredNodes {
+			quoted[i] = fmt.Sprintf("%q", node)
+		}
+		toml += fmt.Sprintf("preferred-nodes = [%s]\n", strings.Join(quoted, ", "))
+	}
+
+	return toml + "\n"
+}
 
-`, resource, resource, strings.Join(startActions, ",\n"))
+// serviceIPUnit returns the service-ip systemd unit that brings up a VIP,
+// service-ip@<IP>-<MASK>.service (/ replaced with -)
+func serviceIPUnit(vip string) string {
+	vipParam := strings.ReplaceAll(vip, "/", "-")
+	if !strings.Contains(vipParam, "-") {
+		vipParam = vipParam + "-32"
+	}
+	return fmt.Sprintf("service-ip@%s.service", vipParam)
+}
 
-	return toml
+// systemdEscapeInstance escapes a resource name for use as a systemd
+// template instance inside a TOML string, as drbd-reactor does for its
+// drbd-services@ targets ("-" becomes \x2d, with the backslash escaped)
+func systemdEscapeInstance(name string) string {
+	return strings.ReplaceAll(name, "-", `\\x2d`)
 }
 
 // CreateFilesystemOnly creates a filesystem on a DRBD device
@@ -1586,7 +2244,7 @@ func (rm *ResourceManager) CreateFilesystemOnly(ctx context.Context, resource st
 		forceFlag = "-f"
 	}
 	mkfsCmd := fmt.Sprintf("sudo mkfs.%s %s %s", fsType, forceFlag, drbdDevice)
-	result, err := rm.deployment.Exec(ctx, []string{address}, mkfsCmd)
+	result, err := rm.deployment.Exec(rm.controller.stepContext(ctx, StepMkfs), []string{address}, mkfsCmd)
 	if err != nil {
 		return fmt.Errorf("failed to create filesystem: %w", err)
 	}
@@ -1607,10 +2265,10 @@ func (rm *ResourceManager) CreateFilesystemOnly(ctx context.Context, resource st
 
 // Helper functions for parsing DRBD status output
 
+// volumeInfo is a volume found in drbdadm status, which has no sizes
 type volumeInfo struct {
-	id      int
-	device  string
-	sizeGB  uint64
+	id     int
+	device string
 }
 
 func parseRoleFromStatus(output string) string {
@@ -1744,7 +2402,6 @@ func parseVolumesFromStatus(output string) []volumeInfo {
 				volumes = append(volumes, volumeInfo{
 					id:     currentVol,
 					device: device,
-					sizeGB: 0, // Would need to query LVM for actual size
 				})
 			}
 			currentVol = -1
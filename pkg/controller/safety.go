@@ -0,0 +1,197 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// safetySnapshotPrefix marks snapshots taken automatically before destructive operations
+const safetySnapshotPrefix = "sds-safety-"
+
+// defaultSafetySnapshotRetention is used when the configured retention is not positive
+const defaultSafetySnapshotRetention = 3
+
+// Destructive operations guarded by safety snapshots
+const (
+	SafetyOpDelete  = "delete"
+	SafetyOpRestore = "restore"
+)
+
+// SafetySnapshot takes an automatic snapshot of a resource (or vg/lv volume)
+// before a destructive operation, records it in the events log and prunes
+// older safety snapshots beyond the configured retention. keep is a snapshot
+// that must survive pruning, e.g. the one about to be restored.
+// It returns the snapshot name, or "" when safety snapshots are disabled,
+// skipped or the volume cannot be resolved to backing volumes.
+func (sm *SnapshotManager) SafetySnapshot(ctx context.Context, volume, node, operation, keep string, skip bool) (string, error) {
+	cfg := sm.controller.config
+	if skip || cfg == nil || !cfg.Storage.SafetySnapshots {
+		return "", nil
+	}
+
+	targets, err := sm.ResolveTargets(ctx, volume, node)
+	if err != nil {
+		sm.controller.logger.Warn("Skipping safety snapshot, volume cannot be resolved",
+			zap.String("volume", volume),
+			zap.String("operation", operation),
+			zap.Error(err))
+		return "", nil
+	}
+
+	name := fmt.Sprintf("%s%s-%s", safetySnapshotPrefix, time.Now().UTC().Format("20060102-150405"), operation)
+
+	sm.controller.logger.Info("Taking safety snapshot",
+		zap.String("volume", volume),
+		zap.String("snapshot", name),
+		zap.String("operation", operation))
+
+	var nodes []string
+	for _, t := range targets {
+		if err := sm.CreateSnapshotOn(ctx, t, name, ""); err != nil {
+			return "", fmt.Errorf("failed to take safety snapshot before %s (use --no-safety-snapshot to skip): %w", operation, err)
+		}
+		if !containsString(nodes, t.Node) {
+			nodes = append(nodes, t.Node)
+		}
+	}
+
+	sm.controller.RecordEvent(ctx, EventSafetySnapshot, volume,
+		fmt.Sprintf("Safety snapshot %s taken before %s", name, operation),
+		map[string]string{
+			"snapshot":  name,
+			"operation": operation,
+			"nodes":     strings.Join(nodes, ","),
+		})
+
+	for _, t := range targets {
+		sm.pruneSafetySnapshots(ctx, t, keep)
+	}
+
+	return name, nil
+}
+
+// pruneSafetySnapshots deletes the oldest safety snapshots of a target beyond
+// the configured retention. Failures are logged, pruning never fails an operation.
+func (sm *SnapshotManager) pruneSafetySnapshots(ctx context.Context, t *SnapshotTarget, keep string) {
+	retention := sm.controller.config.Storage.SafetySnapshotRetention
+	if retention <= 0 {
+		retention = defaultSafetySnapshotRetention
+	}
+
+	snapshots, err := sm.ListSnapshotsOn(ctx, t)
+	if err != nil {
+		sm.controller.logger.Warn("Failed to list safety snapshots for pruning",
+			zap.String("volume", t.Path()),
+			zap.String("node", t.Node),
+			zap.Error(err))
+		return
+	}
+
+	// Names embed a UTC timestamp, so they sort oldest first
+	var safety []string
+	for _, snap := range snapshots {
+		if strings.HasPrefix(snap.Name, safetySnapshotPrefix) && snap.Name != keep {
+			safety = append(safety, snap.Name)
+		}
+	}
+	sort.Strings(safety)
+
+	for len(safety) > retention {
+		name := safety[0]
+		safety = safety[1:]
+		if err := sm.DeleteSnapshotOn(ctx, t, name); err != nil {
+			sm.controller.logger.Warn("Failed to prune safety snapshot",
+				zap.String("snapshot", name),
+				zap.String("volume", t.Path()),
+				zap.String("node", t.Node),
+				zap.Error(err))
+		}
+	}
+}
+
+// keepZfsSafetyCopies copies the safety snapshot of each ZFS target into a
+// zvol of its own, <volume>-<snapshot>, and returns the copies. A rollback
+// destroys all snapshots newer than its target, the safety snapshot
+// included, so the copy is what keeps a ZFS restore undoable. Older copies
+// beyond the safety snapshot retention are destroyed.
+func (sm *SnapshotManager) keepZfsSafetyCopies(ctx context.Context, targets []*SnapshotTarget, snapshot string) ([]string, error) {
+	if snapshot == "" {
+		return nil, nil
+	}
+
+	var copies []string
+	for _, t := range targets {
+		if t.Backend != SnapshotBackendZFS {
+			continue
+		}
+		dest := safetyCopyPath(t, snapshot)
+		cmd := fmt.Sprintf("sudo zfs send %s@%s | sudo zfs recv -u %s", t.Path(), snapshot, dest)
+		if _, err := sm.controller.execOutput(ctx, sm.address(t.Node), cmd); err != nil {
+			return copies, fmt.Errorf("failed to copy safety snapshot %s to %s on %s, the rollback would destroy it (use --no-safety-snapshot to skip): %w",
+				snapshot, dest, t.Node, err)
+		}
+		copies = append(copies, t.Node+":"+dest)
+		sm.pruneZfsSafetyCopies(ctx, t)
+	}
+	return copies, nil
+}
+
+// safetyCopyPath returns the zvol a ZFS safety snapshot is copied to
+func safetyCopyPath(t *SnapshotTarget, snapshot string) string {
+	return t.Path() + "-" + snapshot
+}
+
+// pruneZfsSafetyCopies destroys the oldest safety copies of a ZFS target
+// beyond the configured retention
+func (sm *SnapshotManager) pruneZfsSafetyCopies(ctx context.Context, t *SnapshotTarget) {
+	retention := sm.controller.config.Storage.SafetySnapshotRetention
+	if retention <= 0 {
+		retention = defaultSafetySnapshotRetention
+	}
+
+	output, err := sm.controller.execOutput(ctx, sm.address(t.Node), fmt.Sprintf("sudo zfs list -H -o name -t volume -d 1 %s", t.Pool))
+	if err != nil {
+		sm.controller.logger.Warn("Failed to list safety copies for pruning",
+			zap.String("volume", t.Path()),
+			zap.String("node", t.Node),
+			zap.Error(err))
+		return
+	}
+
+	// Names embed a UTC timestamp, so they sort oldest first
+	prefix := safetyCopyPath(t, safetySnapshotPrefix)
+	var copies []string
+	for _, name := range strings.Fields(output) {
+		if strings.HasPrefix(name, prefix) {
+			copies = append(copies, name)
+		}
+	}
+	sort.Strings(copies)
+
+	for len(copies) > retention {
+		name := copies[0]
+		copies = copies[1:]
+		if _, err := sm.controller.execOutput(ctx, sm.address(t.Node), "sudo zfs destroy "+name); err != nil {
+			sm.controller.logger.Warn("Failed to prune safety copy",
+				zap.String("copy", name),
+				zap.String("node", t.Node),
+				zap.Error(err))
+		}
+	}
+}
+
+// safetyEventDetails links an operation event to the safety snapshot taken before it
+func safetyEventDetails(snapshot string, details map[string]string) map[string]string {
+	if details == nil {
+		details = make(map[string]string)
+	}
+	if snapshot != "" {
+		details["safety_snapshot"] = snapshot
+	}
+	return details
+}
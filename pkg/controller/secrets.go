@@ -0,0 +1,196 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"github.com/liliang-cn/sds/pkg/deployment"
+	"github.com/liliang-cn/sds/pkg/secrets"
+	"go.uber.org/zap"
+)
+
+// gatewaySecretKeys are the gateway config keys holding credentials
+var gatewaySecretKeys = []string{"password"}
+
+// initSecrets sets up the secrets store of gateway credentials. Without a
+// store, credentials are not persisted at all.
+func (c *Controller) initSecrets() error {
+	cfg := c.config.Secrets
+
+	var backend secrets.Backend
+	switch cfg.Backend {
+	case "", "local":
+		if c.db == nil {
+			return fmt.Errorf("the local secrets backend needs the database")
+		}
+		local, err := secrets.NewLocal(c.db, cfg.MasterKeyFile)
+		if err != nil {
+			return err
+		}
+		backend = local
+	case "vault":
+		token := cfg.VaultToken
+		if token == "" {
+			token = os.Getenv("VAULT_TOKEN")
+		}
+		vault, err := secrets.NewVault(cfg.VaultAddress, token, cfg.VaultMount, cfg.VaultPrefix)
+		if err != nil {
+			return err
+		}
+		backend = vault
+	default:
+		return fmt.Errorf("unknown secrets backend %q", cfg.Backend)
+	}
+
+	c.secrets = secrets.New(backend)
+	return nil
+}
+
+// storeGatewaySecrets moves plain-text credentials of a gateway config into
+// the secrets store and replaces them by references
+func (c *Controller) storeGatewaySecrets(ctx context.Context, gw *database.Gateway) error {
+	for _, key := range gatewaySecretKeys {
+		value := gatewayConfigString(gw, key)
+		if value == "" || secrets.IsRef(value) {
+			continue
+		}
+		if c.secrets == nil {
+			delete(gw.Config, key)
+			c.logger.Warn("Secrets store not available, gateway credential is not persisted",
+				zap.String("gateway", gw.Name),
+				zap.String("key", key))
+			continue
+		}
+
+		deployment.RegisterSensitive(value)
+		ref, err := c.secrets.Put(ctx, fmt.Sprintf("gateway/%s/%s", gw.Name, key), value)
+		if err != nil {
+			return err
+		}
+		gw.Config[key] = ref
+	}
+	return nil
+}
+
+// gatewaySecret returns the plain value of a gateway credential
+func (c *Controller) gatewaySecret(ctx context.Context, gw *database.Gateway, key string) (string, error) {
+	value := gatewayConfigString(gw, key)
+	if !secrets.IsRef(value) {
+		return value, nil
+	}
+	if c.secrets == nil {
+		return "", fmt.Errorf("secrets store not available")
+	}
+	plain, err := c.secrets.Resolve(ctx, value)
+	if err != nil {
+		return "", err
+	}
+	deployment.RegisterSensitive(plain)
+	return plain, nil
+}
+
+// deleteGatewaySecrets deletes the stored credentials of a gateway. Failures
+// are logged, they never fail the gateway deletion.
+func (c *Controller) deleteGatewaySecrets(ctx context.Context, gw *database.Gateway) {
+	if c.secrets == nil {
+		return
+	}
+	for _, key := range gatewaySecretKeys {
+		if err := c.secrets.Delete(ctx, gatewayConfigString(gw, key)); err != nil {
+			c.logger.Warn("Failed to delete gateway secret",
+				zap.String("gateway", gw.Name),
+				zap.String("key", key),
+				zap.Error(err))
+		}
+	}
+}
+
+// migrateGatewaySecrets moves credentials stored in plain text by earlier
+// versions into the secrets store
+func (c *Controller) migrateGatewaySecrets(ctx context.Context) {
+	if c.db == nil || c.secrets == nil {
+		return
+	}
+
+	gateways, err := c.db.ListGateways(ctx)
+	if err != nil {
+		return
+	}
+	for _, gw := range gateways {
+		plain := false
+		for _, key := range gatewaySecretKeys {
+			if v := gatewayConfigString(gw, key); v != "" && !secrets.IsRef(v) {
+				plain = true
+			}
+		}
+		if !plain {
+			continue
+		}
+
+		if err := c.storeGatewaySecrets(ctx, gw); err != nil {
+			c.logger.Warn("Failed to migrate gateway credentials", zap.String("gateway", gw.Name), zap.Error(err))
+			continue
+		}
+		if err := c.db.SaveGateway(ctx, gw); err != nil {
+			c.logger.Warn("Failed to save migrated gateway", zap.String("gateway", gw.Name), zap.Error(err))
+			continue
+		}
+		c.logger.Info("Moved gateway credentials to the secrets store", zap.String("gateway", gw.Name))
+	}
+}
+
+// gatewayRecord looks up the stored record of a gateway by name, or by the
+// resource name used as gateway ID
+func (c *Controller) gatewayRecord(ctx context.Context, id string) (*database.Gateway, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	for _, name := range []string{id, id + "-nfs", id + "-iscsi", id + "-nvme"} {
+		if gw, err := c.db.GetGateway(ctx, name); err == nil {
+			return gw, nil
+		}
+	}
+	return nil, fmt.Errorf("gateway not found: %s", id)
+}
+
+// redactedGatewayConfig flattens a gateway config for API responses, with
+// credentials replaced by their secret reference or redacted
+func redactedGatewayConfig(gw *database.Gateway) map[string]string {
+	config := make(map[string]string)
+	for key, value := range gw.Config {
+		switch v := value.(type) {
+		case string:
+			if v != "" {
+				config[key] = v
+			}
+		case []interface{}:
+			items := make([]string, 0, len(v))
+			for _, item := range v {
+				items = append(items, fmt.Sprint(item))
+			}
+			if len(items) > 0 {
+				config[key] = strings.Join(items, ",")
+			}
+		case map[string]interface{}:
+			keys := make([]string, 0, len(v))
+			for k := range v {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				config[key+"."+k] = fmt.Sprint(v[k])
+			}
+		}
+	}
+
+	for _, key := range gatewaySecretKeys {
+		if v, ok := config[key]; ok && !secrets.IsRef(v) {
+			config[key] = secrets.Redacted
+		}
+	}
+	return config
+}
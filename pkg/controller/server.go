@@ -1,12 +1,20 @@
 package controller
 
 import (
+	"bufio"
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	sdspb "github.com/liliang-cn/sds/api/proto/v1"
 	"github.com/liliang-cn/sds/pkg/database"
 	"github.com/liliang-cn/sds/pkg/gateway"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Server implements the SDS controller gRPC service
@@ -128,6 +136,36 @@ func (s *Server) AddDiskToPool(ctx context.Context, req *sdspb.AddDiskToPoolRequ
 	}, nil
 }
 
+func (s *Server) GetPoolHistory(ctx context.Context, req *sdspb.GetPoolHistoryRequest) (*sdspb.GetPoolHistoryResponse, error) {
+	var since time.Time
+	if req.Since > 0 {
+		since = time.Unix(req.Since, 0)
+	}
+
+	samples, err := s.ctrl.GetPoolHistory(ctx, req.Pool, req.Node, since)
+	if err != nil {
+		return &sdspb.GetPoolHistoryResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &sdspb.GetPoolHistoryResponse{
+		Success: true,
+		Message: fmt.Sprintf("Found %d sample(s)", len(samples)),
+	}
+	for _, sample := range samples {
+		resp.Samples = append(resp.Samples, &sdspb.PoolUsageSample{
+			Node:           sample.Node,
+			TotalBytes:     sample.TotalBytes,
+			FreeBytes:      sample.FreeBytes,
+			AllocatedBytes: sample.AllocatedBytes,
+			At:             sample.At.Unix(),
+		})
+	}
+	return resp, nil
+}
+
 // ==================== NODE OPERATIONS ====================
 
 func (s *Server) RegisterNode(ctx context.Context, req *sdspb.RegisterNodeRequest) (*sdspb.RegisterNodeResponse, error) {
@@ -141,14 +179,7 @@ func (s *Server) RegisterNode(ctx context.Context, req *sdspb.RegisterNodeReques
 	return &sdspb.RegisterNodeResponse{
 		Success: true,
 		Message: "Node registered successfully",
-		Node: &sdspb.NodeInfo{
-			Name:     node.Name,
-			Address:  node.Address,
-			Hostname: node.Hostname,
-			State:    string(node.State),
-			LastSeen: node.LastSeen.Unix(),
-			Version:  node.Version,
-		},
+		Node: nodeToProto(node),
 	}, nil
 }
 
@@ -177,14 +208,7 @@ func (s *Server) GetNode(ctx context.Context, req *sdspb.GetNodeRequest) (*sdspb
 	return &sdspb.GetNodeResponse{
 		Success: true,
 		Message: "Node found",
-		Node: &sdspb.NodeInfo{
-			Name:     node.Name,
-			Address:  node.Address,
-			Hostname: node.Hostname,
-			State:    string(node.State),
-			LastSeen: node.LastSeen.Unix(),
-			Version:  node.Version,
-		},
+		Node: nodeToProto(node),
 	}, nil
 }
 
@@ -199,14 +223,7 @@ func (s *Server) ListNodes(ctx context.Context, req *sdspb.ListNodesRequest) (*s
 
 	var pbNodes []*sdspb.NodeInfo
 	for _, n := range nodes {
-		pbNodes = append(pbNodes, &sdspb.NodeInfo{
-			Name:     n.Name,
-			Address:  n.Address,
-			Hostname: n.Hostname,
-			State:    string(n.State),
-			LastSeen: n.LastSeen.Unix(),
-			Version:  n.Version,
-		})
+		pbNodes = append(pbNodes, nodeToProto(n))
 	}
 
 	return &sdspb.ListNodesResponse{
@@ -216,6 +233,182 @@ func (s *Server) ListNodes(ctx context.Context, req *sdspb.ListNodesRequest) (*s
 	}, nil
 }
 
+// nodeToProto converts node information, including its last polled capacity
+func nodeToProto(n *NodeInfo) *sdspb.NodeInfo {
+	info := &sdspb.NodeInfo{
+		Name:     n.Name,
+		Address:  n.Address,
+		Hostname: n.Hostname,
+		State:    string(n.State),
+		LastSeen: n.LastSeen.Unix(),
+		Version:  n.Version,
+
+		DrbdKernelVersion: n.DrbdKernelVersion,
+		DrbdUtilsVersion:  n.DrbdUtilsVersion,
+	}
+
+	if c := n.Capacity; c != nil {
+		info.Capacity = &sdspb.NodeCapacity{
+			DrbdDevices:       uint32(c.DrbdDevices),
+			Primaries:         uint32(c.Primaries),
+			Load1:             c.Load1,
+			Load5:             c.Load5,
+			Load15:            c.Load15,
+			Cpus:              uint32(c.CPUs),
+			MemTotalBytes:     c.MemTotalBytes,
+			MemAvailableBytes: c.MemAvailableBytes,
+			CollectedAt:       c.CollectedAt.Unix(),
+		}
+		for _, p := range c.Pools {
+			info.Capacity.Pools = append(info.Capacity.Pools, &sdspb.NodePoolCapacity{
+				Name:       p.Name,
+				Type:       p.Type,
+				TotalBytes: p.TotalBytes,
+				FreeBytes:  p.FreeBytes,
+			})
+		}
+	}
+	return info
+}
+
+func (s *Server) SetNodeAddress(ctx context.Context, req *sdspb.SetNodeAddressRequest) (*sdspb.SetNodeAddressResponse, error) {
+	timeout := time.Duration(req.TimeoutSeconds) * time.Second
+	result, err := s.ctrl.SetNodeAddress(ctx, req.Name, req.Address, timeout)
+	if result == nil {
+		return &sdspb.SetNodeAddressResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &sdspb.SetNodeAddressResponse{
+		Success:         true,
+		Message:         fmt.Sprintf("Node %s moved to %s", req.Name, req.Address),
+		PreviousAddress: result.PreviousAddress,
+		Resources:       result.Resources,
+		Actions:         result.Actions,
+		Unrecovered:     result.Unrecovered,
+	}
+	if err != nil {
+		resp.Success = false
+		resp.Message = err.Error()
+	} else if len(result.Unrecovered) > 0 {
+		resp.Success = false
+		resp.Message = fmt.Sprintf("Node %s moved to %s but connections did not recover: %s",
+			req.Name, req.Address, strings.Join(result.Unrecovered, "; "))
+	}
+	return resp, nil
+}
+
+func (s *Server) TrustNode(ctx context.Context, req *sdspb.TrustNodeRequest) (*sdspb.TrustNodeResponse, error) {
+	info, err := s.ctrl.TrustNode(ctx, req.Node, req.Fingerprint, req.Replace)
+	if err != nil {
+		return &sdspb.TrustNodeResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	message := fmt.Sprintf("Host key of %s is %s", info.Address, info.Fingerprint)
+	if info.Pinned {
+		message = fmt.Sprintf("Host key %s of %s is pinned", info.Fingerprint, info.Address)
+	}
+	return &sdspb.TrustNodeResponse{
+		Success:             true,
+		Message:             message,
+		Node:                info.Node,
+		Address:             info.Address,
+		KeyType:             info.KeyType,
+		Fingerprint:         info.Fingerprint,
+		PreviousFingerprint: info.PreviousFingerprint,
+		Pinned:              info.Pinned,
+	}, nil
+}
+
+func (s *Server) HardenNode(ctx context.Context, req *sdspb.HardenNodeRequest) (*sdspb.HardenNodeResponse, error) {
+	result, err := s.ctrl.HardenNode(ctx, req.Node, req.User, req.Apply)
+	if err != nil {
+		return &sdspb.HardenNodeResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	message := fmt.Sprintf("Sudoers policy for %s on %s generated", result.User, req.Node)
+	if result.Installed {
+		message = fmt.Sprintf("Sudoers policy for %s installed on %s", result.User, req.Node)
+	}
+	return &sdspb.HardenNodeResponse{
+		Success:      true,
+		Message:      message,
+		User:         result.User,
+		Policy:       result.Policy,
+		Missing:      result.Missing,
+		Installed:    result.Installed,
+		Unrestricted: result.Unrestricted,
+	}, nil
+}
+
+func (s *Server) SetNodeMaintenance(ctx context.Context, req *sdspb.SetNodeMaintenanceRequest) (*sdspb.SetNodeMaintenanceResponse, error) {
+	var from time.Time
+	if req.From > 0 {
+		from = time.Unix(req.From, 0)
+	}
+	window, err := s.ctrl.SetMaintenanceWindow(ctx, req.Node, from, time.Unix(req.To, 0), req.Reason)
+	if err != nil {
+		return &sdspb.SetNodeMaintenanceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.SetNodeMaintenanceResponse{
+		Success: true,
+		Message: fmt.Sprintf("Maintenance window of %s set", req.Node),
+		Window:  maintenanceWindowToProto(window),
+	}, nil
+}
+
+func (s *Server) ClearNodeMaintenance(ctx context.Context, req *sdspb.ClearNodeMaintenanceRequest) (*sdspb.ClearNodeMaintenanceResponse, error) {
+	if err := s.ctrl.ClearMaintenanceWindow(ctx, req.Node); err != nil {
+		return &sdspb.ClearNodeMaintenanceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.ClearNodeMaintenanceResponse{
+		Success: true,
+		Message: fmt.Sprintf("Maintenance window of %s cleared", req.Node),
+	}, nil
+}
+
+func (s *Server) ListMaintenanceWindows(ctx context.Context, req *sdspb.ListMaintenanceWindowsRequest) (*sdspb.ListMaintenanceWindowsResponse, error) {
+	windows, err := s.ctrl.ListMaintenanceWindows(ctx)
+	if err != nil {
+		return &sdspb.ListMaintenanceWindowsResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	resp := &sdspb.ListMaintenanceWindowsResponse{
+		Success: true,
+		Message: fmt.Sprintf("Found %d maintenance windows", len(windows)),
+	}
+	for _, window := range windows {
+		resp.Windows = append(resp.Windows, maintenanceWindowToProto(window))
+	}
+	return resp, nil
+}
+
+func maintenanceWindowToProto(window *database.MaintenanceWindow) *sdspb.MaintenanceWindow {
+	return &sdspb.MaintenanceWindow{
+		Node:   window.Node,
+		From:   window.From.Unix(),
+		To:     window.To.Unix(),
+		Reason: window.Reason,
+		Active: !time.Now().Before(window.From),
+	}
+}
+
 func (s *Server) HealthCheck(ctx context.Context, req *sdspb.HealthCheckRequest) (*sdspb.HealthCheckResponse, error) {
 	health, err := s.nodes.HealthCheck(ctx, req.Node)
 	if err != nil {
@@ -236,14 +429,210 @@ func (s *Server) HealthCheck(ctx context.Context, req *sdspb.HealthCheckRequest)
 			DrbdReactorRunning:       health.DrbdReactorRunning,
 			ResourceAgentsInstalled:  health.ResourceAgentsInstalled,
 			AvailableAgents:          health.AvailableAgents,
+			DrbdKernelVersion:        health.DrbdKernelVersion,
+			DrbdUtilsVersion:         health.DrbdUtilsVersion,
+			DrbdVersionWarnings:      health.DrbdVersionWarnings,
+			LvmVersion:               health.LvmVersion,
+			ZfsVersion:               health.ZfsVersion,
+			Time:                     nodeTimeToProto(health.Time),
 		},
 	}, nil
 }
 
+func (s *Server) CheckTime(ctx context.Context, req *sdspb.CheckTimeRequest) (*sdspb.CheckTimeResponse, error) {
+	times, err := s.ctrl.CheckTime(ctx, req.Nodes)
+	if err != nil {
+		return &sdspb.CheckTimeResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &sdspb.CheckTimeResponse{
+		Success:   true,
+		MaxSkewMs: durationMs(s.ctrl.config.TimeSync.MaxSkew),
+	}
+	var warned, checked int
+	var lowest, highest time.Duration
+	for _, t := range times {
+		resp.Nodes = append(resp.Nodes, nodeTimeToProto(t))
+		if len(t.Warnings) > 0 {
+			warned++
+		}
+		if t.Error != "" {
+			continue
+		}
+		if checked == 0 || t.Skew < lowest {
+			lowest = t.Skew
+		}
+		if checked == 0 || t.Skew > highest {
+			highest = t.Skew
+		}
+		checked++
+	}
+	resp.SpreadMs = durationMs(highest - lowest)
+	resp.Message = fmt.Sprintf("Checked %d node(s), %d with clock problems", len(times), warned)
+	return resp, nil
+}
+
+// nodeTimeToProto converts a time check, nil stays nil
+func nodeTimeToProto(t *NodeTime) *sdspb.NodeTime {
+	if t == nil {
+		return nil
+	}
+	return &sdspb.NodeTime{
+		Node:           t.Node,
+		SkewMs:         durationMs(t.Skew),
+		RoundTripMs:    durationMs(t.RoundTrip),
+		Synchronized:   t.Synchronized,
+		Source:         t.Source,
+		Server:         t.Server,
+		SourceOffsetMs: durationMs(t.SourceOffset),
+		Warnings:       t.Warnings,
+		Error:          t.Error,
+		CheckedAt:      t.CheckedAt.Unix(),
+	}
+}
+
+// durationMs returns a duration in milliseconds
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func (s *Server) NodeExec(ctx context.Context, req *sdspb.NodeExecRequest) (*sdspb.NodeExecResponse, error) {
+	timeout := time.Duration(req.TimeoutSeconds) * time.Second
+	results, err := s.ctrl.NodeExec(ctx, req.Nodes, req.Command, timeout)
+	if err != nil {
+		return &sdspb.NodeExecResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &sdspb.NodeExecResponse{Success: true}
+	var failed []string
+	for _, r := range results {
+		resp.Results = append(resp.Results, &sdspb.NodeExecResult{
+			Node:       r.Node,
+			ExitCode:   int32(r.ExitCode),
+			Success:    r.Success,
+			Output:     r.Output,
+			Truncated:  r.Truncated,
+			DurationMs: r.Duration.Milliseconds(),
+		})
+		if !r.Success {
+			failed = append(failed, r.Node)
+		}
+	}
+	if len(failed) > 0 {
+		resp.Success = false
+		resp.Message = "Command failed on: " + strings.Join(failed, ", ")
+	} else {
+		resp.Message = fmt.Sprintf("Command succeeded on %d node(s)", len(results))
+	}
+	return resp, nil
+}
+
+func (s *Server) PushFile(ctx context.Context, req *sdspb.PushFileRequest) (*sdspb.PushFileResponse, error) {
+	results, checksum, err := s.ctrl.PushFile(ctx, req.Nodes, req.Content, req.Dest, req.Mode, req.Owner)
+	if err != nil {
+		return &sdspb.PushFileResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &sdspb.PushFileResponse{Success: true, Checksum: checksum}
+	var failed []string
+	for _, r := range results {
+		resp.Results = append(resp.Results, &sdspb.PushFileResult{
+			Node:     r.Node,
+			Success:  r.Success,
+			Checksum: r.Checksum,
+			Error:    r.Error,
+		})
+		if !r.Success {
+			failed = append(failed, r.Node)
+		}
+	}
+	if len(failed) > 0 {
+		resp.Success = false
+		resp.Message = "Push failed on: " + strings.Join(failed, ", ")
+	} else {
+		resp.Message = fmt.Sprintf("%s written and verified on %d node(s)", req.Dest, len(results))
+	}
+	return resp, nil
+}
+
+func (s *Server) StreamNodeLogs(req *sdspb.StreamNodeLogsRequest, stream grpc.ServerStreamingServer[sdspb.NodeLogLine]) error {
+	ctx := stream.Context()
+	ctrl, err := s.ctrl.clusterFor(ctx)
+	if err != nil {
+		return err
+	}
+	opts := NodeLogOptions{
+		Unit:     req.Unit,
+		Resource: req.Resource,
+		Lines:    int(req.Lines),
+		Follow:   req.Follow,
+		Since:    req.Since,
+	}
+	err = ctrl.StreamNodeLogs(ctx, req.Node, opts, func(line string) error {
+		return stream.Send(&sdspb.NodeLogLine{Node: req.Node, Line: line})
+	})
+	if err != nil && ctx.Err() == nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return nil
+}
+
+// supportBundleChunkSize is the size of the chunks a support bundle is
+// streamed in
+const supportBundleChunkSize = 256 * 1024
+
+func (s *Server) SupportBundle(req *sdspb.SupportBundleRequest, stream grpc.ServerStreamingServer[sdspb.SupportBundleChunk]) error {
+	ctx := stream.Context()
+	// Streams bypass the admin interceptor
+	if err := s.ctrl.checkAdminToken(ctx); err != nil {
+		caller := peerAddress(ctx)
+		s.ctrl.RecordEvent(ctx, EventAdminDenied, "", fmt.Sprintf("Denied SupportBundle from %s: %v", caller, err),
+			map[string]string{"operation": "SupportBundle", "peer": caller})
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	ctrl, err := s.ctrl.clusterFor(ctx)
+	if err != nil {
+		return err
+	}
+
+	opts := SupportBundleOptions{
+		Nodes:    req.Nodes,
+		LogLines: int(req.LogLines),
+		Jobs:     int(req.Jobs),
+	}
+	w := bufio.NewWriterSize(chunkWriter(func(data []byte) error {
+		return stream.Send(&sdspb.SupportBundleChunk{Data: data})
+	}), supportBundleChunkSize)
+	if err := ctrl.WriteSupportBundle(ctx, opts, w); err != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return w.Flush()
+}
+
+// chunkWriter sends each write as a chunk of a stream
+type chunkWriter func(data []byte) error
+
+func (w chunkWriter) Write(p []byte) (int, error) {
+	// The stream may hold on to the chunk
+	if err := w(append([]byte(nil), p...)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
 // ==================== RESOURCE OPERATIONS ====================
 
 func (s *Server) CreateResource(ctx context.Context, req *sdspb.CreateResourceRequest) (*sdspb.CreateResourceResponse, error) {
-	err := s.resources.CreateResource(ctx, req.Name, req.Port, req.Nodes, req.Protocol, req.SizeGb, req.Pool, req.StorageType, req.DrbdOptions)
+	err := s.resources.CreateResource(ctx, req.Name, req.Port, req.Nodes, req.Protocol, req.PeerProtocols, int(req.MaxPeers), req.SizeGb, req.Pool, req.StorageType, req.DrbdOptions, req.Devices)
 	if err != nil {
 		return &sdspb.CreateResourceResponse{
 			Success: false,
@@ -257,7 +646,7 @@ func (s *Server) CreateResource(ctx context.Context, req *sdspb.CreateResourceRe
 }
 
 func (s *Server) DeleteResource(ctx context.Context, req *sdspb.DeleteResourceRequest) (*sdspb.DeleteResourceResponse, error) {
-	err := s.resources.DeleteResource(ctx, req.Name, true)
+	err := s.resources.DeleteResource(ctx, req.Name, true, req.NoSafetySnapshot)
 	if err != nil {
 		return &sdspb.DeleteResourceResponse{
 			Success: false,
@@ -270,6 +659,176 @@ func (s *Server) DeleteResource(ctx context.Context, req *sdspb.DeleteResourceRe
 	}, nil
 }
 
+func (s *Server) SetMaxPeers(ctx context.Context, req *sdspb.SetMaxPeersRequest) (*sdspb.SetMaxPeersResponse, error) {
+	result, err := s.resources.SetMaxPeers(ctx, req.Name, int(req.MaxPeers), time.Duration(req.TimeoutSeconds)*time.Second)
+	if err != nil {
+		return &sdspb.SetMaxPeersResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	message := fmt.Sprintf("Peer slots set to %d", req.MaxPeers)
+	if len(result.Pending) > 0 {
+		message = fmt.Sprintf("Peer slots set to %d except on the Primary %s; fail it over and run the command again",
+			req.MaxPeers, strings.Join(result.Pending, ", "))
+	}
+	return &sdspb.SetMaxPeersResponse{
+		Success:   true,
+		Message:   message,
+		Converted: result.Converted,
+		Pending:   result.Pending,
+	}, nil
+}
+
+func (s *Server) MigratePool(ctx context.Context, req *sdspb.MigratePoolRequest) (*sdspb.MigratePoolResponse, error) {
+	result, err := s.resources.MigratePool(ctx, req.Name, req.Pool, time.Duration(req.TimeoutSeconds)*time.Second)
+	if err != nil {
+		return &sdspb.MigratePoolResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	message := fmt.Sprintf("Resource moved to pool %s", req.Pool)
+	if len(result.Pending) > 0 {
+		message = fmt.Sprintf("Resource moved to pool %s except on the Primary %s; fail it over and run the command again",
+			req.Pool, strings.Join(result.Pending, ", "))
+	}
+	return &sdspb.MigratePoolResponse{
+		Success:  true,
+		Message:  message,
+		Migrated: result.Migrated,
+		Pending:  result.Pending,
+	}, nil
+}
+
+func (s *Server) ConvertStorage(ctx context.Context, req *sdspb.ConvertStorageRequest) (*sdspb.ConvertStorageResponse, error) {
+	result, err := s.resources.ConvertStorage(ctx, req.Name, req.StorageType, req.Pool, time.Duration(req.TimeoutSeconds)*time.Second)
+	if err != nil {
+		return &sdspb.ConvertStorageResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	message := fmt.Sprintf("Resource converted to %s", req.StorageType)
+	if len(result.Pending) > 0 {
+		message = fmt.Sprintf("Resource converted to %s except on the Primary %s; fail it over and run the command again",
+			req.StorageType, strings.Join(result.Pending, ", "))
+	}
+	return &sdspb.ConvertStorageResponse{
+		Success:   true,
+		Message:   message,
+		Converted: result.Converted,
+		Pending:   result.Pending,
+	}, nil
+}
+
+func (s *Server) ReplaceDisk(ctx context.Context, req *sdspb.ReplaceDiskRequest) (*sdspb.ReplaceDiskResponse, error) {
+	replaced, err := s.resources.ReplaceDisk(ctx, req.Name, req.Node, req.Devices, req.Pool, time.Duration(req.TimeoutSeconds)*time.Second)
+	if err != nil {
+		return &sdspb.ReplaceDiskResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	var disks []*sdspb.ReplacedDisk
+	for _, r := range replaced {
+		disks = append(disks, &sdspb.ReplacedDisk{
+			VolumeId:  uint32(r.VolumeID),
+			OldDevice: r.OldDevice,
+			NewDevice: r.NewDevice,
+		})
+	}
+	return &sdspb.ReplaceDiskResponse{
+		Success: true,
+		Message: fmt.Sprintf("Disk of %s replaced on %s and resynced", req.Name, req.Node),
+		Disks:   disks,
+	}, nil
+}
+
+func (s *Server) StopResource(ctx context.Context, req *sdspb.StopResourceRequest) (*sdspb.StopResourceResponse, error) {
+	if err := s.resources.StopResource(ctx, req.Name); err != nil {
+		return &sdspb.StopResourceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.StopResourceResponse{
+		Success: true,
+		Message: fmt.Sprintf("Resource %s stopped", req.Name),
+	}, nil
+}
+
+func (s *Server) PauseSync(ctx context.Context, req *sdspb.PauseSyncRequest) (*sdspb.PauseSyncResponse, error) {
+	if err := s.resources.PauseSync(ctx, req.Name, req.Peer); err != nil {
+		return &sdspb.PauseSyncResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.PauseSyncResponse{
+		Success: true,
+		Message: fmt.Sprintf("Resync of %s paused", req.Name),
+	}, nil
+}
+
+func (s *Server) ResumeSync(ctx context.Context, req *sdspb.ResumeSyncRequest) (*sdspb.ResumeSyncResponse, error) {
+	if err := s.resources.ResumeSync(ctx, req.Name, req.Peer); err != nil {
+		return &sdspb.ResumeSyncResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.ResumeSyncResponse{
+		Success: true,
+		Message: fmt.Sprintf("Resync of %s resumed", req.Name),
+	}, nil
+}
+
+func (s *Server) SetSyncRate(ctx context.Context, req *sdspb.SetSyncRateRequest) (*sdspb.SetSyncRateResponse, error) {
+	if req.Clear {
+		if err := s.resources.ClearSyncRate(ctx, req.Name); err != nil {
+			return &sdspb.SetSyncRateResponse{
+				Success: false,
+				Message: err.Error(),
+			}, nil
+		}
+		return &sdspb.SetSyncRateResponse{
+			Success: true,
+			Message: fmt.Sprintf("Resync rate of %s reverted", req.Name),
+		}, nil
+	}
+
+	override, err := s.resources.SetSyncRate(ctx, req.Name, req.Peer, req.Rate, time.Duration(req.DurationSeconds)*time.Second)
+	if err != nil {
+		return &sdspb.SetSyncRateResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.SetSyncRateResponse{
+		Success:   true,
+		Message:   fmt.Sprintf("Resync rate of %s set to %s", req.Name, override.Rate),
+		ExpiresAt: override.ExpiresAt.Unix(),
+	}, nil
+}
+
+func (s *Server) StartResource(ctx context.Context, req *sdspb.StartResourceRequest) (*sdspb.StartResourceResponse, error) {
+	if err := s.resources.StartResource(ctx, req.Name); err != nil {
+		return &sdspb.StartResourceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.StartResourceResponse{
+		Success: true,
+		Message: fmt.Sprintf("Resource %s started", req.Name),
+	}, nil
+}
+
 func (s *Server) GetResource(ctx context.Context, req *sdspb.GetResourceRequest) (*sdspb.GetResourceResponse, error) {
 	resource, err := s.resources.GetResource(ctx, req.Name)
 	if err != nil {
@@ -281,11 +840,7 @@ func (s *Server) GetResource(ctx context.Context, req *sdspb.GetResourceRequest)
 
 	var pbVolumes []*sdspb.VolumeInfo
 	for _, v := range resource.Volumes {
-		pbVolumes = append(pbVolumes, &sdspb.VolumeInfo{
-			VolumeId: v.VolumeID,
-			Device:   v.Device,
-			SizeGb:   v.SizeGB,
-		})
+		pbVolumes = append(pbVolumes, resourceVolumeToProto(v))
 	}
 
 	// Build node states map
@@ -308,6 +863,14 @@ func (s *Server) GetResource(ctx context.Context, req *sdspb.GetResourceRequest)
 			Role:        resource.Role,
 			Volumes:     pbVolumes,
 			NodeStates:  nodeStates,
+
+			PeerProtocols:   resource.PeerProtocols,
+			MaxPeers:        uint32(resource.MaxPeers),
+			MaxPeersPending: resource.MaxPeersPending,
+			DesiredState:    resource.DesiredState,
+			Owner:           resource.Owner,
+			Health:          resource.Health,
+			HealthReasons:   resource.HealthReasons,
 		},
 	}, nil
 }
@@ -323,6 +886,9 @@ func (s *Server) ListResources(ctx context.Context, req *sdspb.ListResourcesRequ
 
 	var pbResources []*sdspb.ResourceInfo
 	for _, r := range resources {
+		if !mayAccess(ctx, r.Owner) {
+			continue
+		}
 		var pbVolumes []*sdspb.VolumeInfo
 		for _, v := range r.Volumes {
 			pbVolumes = append(pbVolumes, &sdspb.VolumeInfo{
@@ -338,6 +904,14 @@ func (s *Server) ListResources(ctx context.Context, req *sdspb.ListResourcesRequ
 			Nodes:    r.Nodes,
 			Role:     r.Role,
 			Volumes:  pbVolumes,
+
+			PeerProtocols:   r.PeerProtocols,
+			MaxPeers:        uint32(r.MaxPeers),
+			MaxPeersPending: r.MaxPeersPending,
+			DesiredState:    r.DesiredState,
+			Owner:           r.Owner,
+			Health:          r.Health,
+			HealthReasons:   r.HealthReasons,
 		})
 	}
 
@@ -349,7 +923,7 @@ func (s *Server) ListResources(ctx context.Context, req *sdspb.ListResourcesRequ
 }
 
 func (s *Server) AddVolume(ctx context.Context, req *sdspb.AddVolumeRequest) (*sdspb.AddVolumeResponse, error) {
-	err := s.resources.AddVolume(ctx, req.Resource, req.Volume, req.Pool, req.SizeGb)
+	volume, err := s.resources.AddVolume(ctx, req.Resource, req.Volume, req.Pool, req.SizeGb)
 	if err != nil {
 		return &sdspb.AddVolumeResponse{
 			Success: false,
@@ -359,6 +933,7 @@ func (s *Server) AddVolume(ctx context.Context, req *sdspb.AddVolumeRequest) (*s
 	return &sdspb.AddVolumeResponse{
 		Success: true,
 		Message: "Volume added successfully",
+		Volume:  volumeToProto(volume),
 	}, nil
 }
 
@@ -390,22 +965,381 @@ func (s *Server) ResizeVolume(ctx context.Context, req *sdspb.ResizeVolumeReques
 	}, nil
 }
 
-func (s *Server) ResourceStatus(ctx context.Context, req *sdspb.ResourceStatusRequest) (*sdspb.ResourceStatusResponse, error) {
-	// Get resource detailed status
-	resource, err := s.resources.GetResource(ctx, req.Name)
+func (s *Server) GetVolume(ctx context.Context, req *sdspb.GetVolumeRequest) (*sdspb.GetVolumeResponse, error) {
+	volume, err := s.resources.GetVolume(ctx, req.Resource, req.VolumeId)
 	if err != nil {
-		return &sdspb.ResourceStatusResponse{
+		return &sdspb.GetVolumeResponse{
 			Success: false,
 			Message: err.Error(),
 		}, nil
 	}
+	info := volumeToProto(volume)
+	setVolumeSize(info, s.resources.VolumeSizes(ctx, []*database.Volume{volume})[volume])
+	return &sdspb.GetVolumeResponse{
+		Success: true,
+		Message: "Volume found",
+		Volume:  info,
+	}, nil
+}
 
-	// Convert to status format with detailed node states
-	status := &sdspb.ResourceStatus{
-		Name:     resource.Name,
-		Role:     resource.Role,
-		Nodes:    resource.Nodes,
-		NodeStates: make(map[string]*sdspb.NodeResourceState),
+func (s *Server) ListVolumes(ctx context.Context, req *sdspb.ListVolumesRequest) (*sdspb.ListVolumesResponse, error) {
+	volumes, err := s.resources.ListVolumes(ctx, req.Resource)
+	if err != nil {
+		return &sdspb.ListVolumesResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	visible := s.ctrl.resourceFilter(ctx)
+	var own []*database.Volume
+	for _, v := range volumes {
+		if visible(v.ResourceName) {
+			own = append(own, v)
+		}
+	}
+	volumes = own
+
+	sizes := s.resources.VolumeSizes(ctx, volumes)
+	var pbVolumes []*sdspb.VolumeInfo
+	for _, v := range volumes {
+		info := volumeToProto(v)
+		setVolumeSize(info, sizes[v])
+		pbVolumes = append(pbVolumes, info)
+	}
+
+	return &sdspb.ListVolumesResponse{
+		Success: true,
+		Message: "Volumes listed successfully",
+		Volumes: pbVolumes,
+	}, nil
+}
+
+// volumeToProto converts a stored volume record to its API representation
+func volumeToProto(v *database.Volume) *sdspb.VolumeInfo {
+	info := &sdspb.VolumeInfo{
+		VolumeId:    uint32(v.VolumeID),
+		Device:      v.Device,
+		SizeGb:      uint64(v.SizeGB),
+		Id:          v.ID,
+		Resource:    v.ResourceName,
+		Name:        v.VolumeName,
+		Pool:        v.Pool,
+		StorageType: v.StorageType,
+		CreatedAt:   v.CreatedAt.Unix(),
+	}
+
+	nodes := make([]string, 0, len(v.Backing))
+	for node := range v.Backing {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	for _, node := range nodes {
+		info.Backing = append(info.Backing, &sdspb.VolumeBacking{Node: node, Device: v.Backing[node]})
+	}
+
+	return info
+}
+
+// resourceVolumeToProto converts live volume info, completed by its stored record if any
+func resourceVolumeToProto(v *ResourceVolumeInfo) *sdspb.VolumeInfo {
+	info := &sdspb.VolumeInfo{}
+	if v.Record != nil {
+		info = volumeToProto(v.Record)
+	}
+	info.VolumeId = v.VolumeID
+	info.Device = v.Device
+	if v.SizeGB > 0 {
+		info.SizeGb = v.SizeGB
+	}
+	setVolumeSize(info, v.Size)
+	return info
+}
+
+// setVolumeSize fills in the size of a volume found on a node, if known
+func setVolumeSize(info *sdspb.VolumeInfo, size *VolumeSize) {
+	if size == nil {
+		return
+	}
+	info.SizeGb = size.SizeBytes >> 30
+	info.SizeBytes = size.SizeBytes
+	info.AllocatedBytes = size.AllocatedBytes
+}
+
+func (s *Server) ExportResource(ctx context.Context, req *sdspb.ExportResourceRequest) (*sdspb.ExportResourceResponse, error) {
+	export, err := s.resources.ExportResource(ctx, req.Name)
+	if err != nil {
+		return &sdspb.ExportResourceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.ExportResourceResponse{
+		Success:            true,
+		Message:            "OK",
+		DrbdConfigPath:     export.DrbdConfigPath,
+		DrbdConfig:         export.DrbdConfig,
+		PromoterConfigPath: export.PromoterConfigPath,
+		PromoterConfig:     export.PromoterConfig,
+	}, nil
+}
+
+func (s *Server) DiffResource(ctx context.Context, req *sdspb.DiffResourceRequest) (*sdspb.DiffResourceResponse, error) {
+	diffs, err := s.resources.DiffResource(ctx, req.Name)
+	if err != nil {
+		return &sdspb.DiffResourceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &sdspb.DiffResourceResponse{Success: true, Message: "OK"}
+	for _, d := range diffs {
+		resp.Diffs = append(resp.Diffs, &sdspb.ConfigDiff{
+			Node:  d.Node,
+			Path:  d.Path,
+			State: d.State,
+			Diff:  d.Diff,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) ExecFenceTest(ctx context.Context, req *sdspb.ExecFenceTestRequest) (*sdspb.ExecFenceTestResponse, error) {
+	result, err := s.resources.FenceTest(ctx, req.Name, req.Node, req.Peer, time.Duration(req.IoTimeoutSeconds)*time.Second)
+	if err != nil {
+		return &sdspb.ExecFenceTestResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &sdspb.ExecFenceTestResponse{
+		Success:      true,
+		Message:      "OK",
+		Node:         result.Node,
+		Peer:         result.Peer,
+		Quorum:       result.Quorum,
+		OnNoQuorum:   result.OnNoQuorum,
+		ExpectQuorum: result.ExpectQuorum,
+		Passed:       result.Passed(),
+	}
+	for _, check := range result.Checks {
+		resp.Checks = append(resp.Checks, &sdspb.FenceTestCheck{
+			Name:   check.Name,
+			Passed: check.Passed,
+			Detail: check.Detail,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) ActivateResource(ctx context.Context, req *sdspb.ActivateResourceRequest) (*sdspb.ActivateResourceResponse, error) {
+	result, err := s.resources.Activate(ctx, req.Name, req.Node, req.MountPoint, req.FsType, req.Services, req.Force)
+	resp := &sdspb.ActivateResourceResponse{
+		Success: err == nil,
+		Message: fmt.Sprintf("Resource %s activated", req.Name),
+	}
+	if err != nil {
+		resp.Message = err.Error()
+	}
+	if result != nil {
+		resp.Node = result.Node
+		resp.Steps = activationStepsToProto(result.Steps)
+	}
+	return resp, nil
+}
+
+func (s *Server) DeactivateResource(ctx context.Context, req *sdspb.DeactivateResourceRequest) (*sdspb.DeactivateResourceResponse, error) {
+	result, err := s.resources.Deactivate(ctx, req.Name)
+	resp := &sdspb.DeactivateResourceResponse{
+		Success: err == nil,
+		Message: fmt.Sprintf("Resource %s deactivated", req.Name),
+	}
+	if err != nil {
+		resp.Message = err.Error()
+	}
+	if result != nil {
+		resp.Node = result.Node
+		resp.Steps = activationStepsToProto(result.Steps)
+	}
+	return resp, nil
+}
+
+func activationStepsToProto(steps []*ActivationStep) []*sdspb.ActivationStep {
+	var result []*sdspb.ActivationStep
+	for _, step := range steps {
+		result = append(result, &sdspb.ActivationStep{
+			Name:   step.Name,
+			Passed: step.Passed,
+			Detail: step.Detail,
+		})
+	}
+	return result
+}
+
+func (s *Server) FencePeer(ctx context.Context, req *sdspb.FencePeerRequest) (*sdspb.FencePeerResponse, error) {
+	code, message, err := s.ctrl.FencePeer(ctx, req.Name, req.Node, req.Peer, req.PeerAddress)
+	if err != nil {
+		return &sdspb.FencePeerResponse{
+			Success:  false,
+			Message:  err.Error(),
+			ExitCode: uint32(code),
+		}, nil
+	}
+	return &sdspb.FencePeerResponse{
+		Success:  true,
+		Message:  message,
+		ExitCode: uint32(code),
+	}, nil
+}
+
+func (s *Server) UnfencePeer(ctx context.Context, req *sdspb.UnfencePeerRequest) (*sdspb.UnfencePeerResponse, error) {
+	message, err := s.ctrl.UnfencePeer(ctx, req.Name, req.Node, req.Peer, req.All)
+	if err != nil {
+		return &sdspb.UnfencePeerResponse{
+			Success:  false,
+			Message:  err.Error(),
+			ExitCode: FenceExitFailed,
+		}, nil
+	}
+	return &sdspb.UnfencePeerResponse{
+		Success: true,
+		Message: message,
+	}, nil
+}
+
+func (s *Server) ReportDrbdEvent(ctx context.Context, req *sdspb.ReportDrbdEventRequest) (*sdspb.ReportDrbdEventResponse, error) {
+	message, err := s.ctrl.ReportDrbdEvent(ctx, req.Name, req.Handler, req.Node, req.Peer, req.PeerAddress, req.Volume)
+	if err != nil {
+		return &sdspb.ReportDrbdEventResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.ReportDrbdEventResponse{
+		Success: true,
+		Message: message,
+	}, nil
+}
+
+func (s *Server) InstallFenceHandlers(ctx context.Context, req *sdspb.InstallFenceHandlersRequest) (*sdspb.InstallFenceHandlersResponse, error) {
+	failed, err := s.ctrl.InstallFenceHandlers(ctx)
+	if err != nil {
+		return &sdspb.InstallFenceHandlersResponse{
+			Success:     false,
+			Message:     err.Error(),
+			FailedNodes: failed,
+		}, nil
+	}
+	message := "DRBD handlers installed"
+	if len(failed) > 0 {
+		message = fmt.Sprintf("DRBD handlers installed, failed on %d nodes", len(failed))
+	}
+	return &sdspb.InstallFenceHandlersResponse{
+		Success:     true,
+		Message:     message,
+		Path:             fenceHandlerPath,
+		FailedNodes:      failed,
+		DrbdOptions:      FenceHandlerOptions(),
+		EventHandlerPath: drbdEventHandlerPath,
+	}, nil
+}
+
+func (s *Server) ListFenceConstraints(ctx context.Context, req *sdspb.ListFenceConstraintsRequest) (*sdspb.ListFenceConstraintsResponse, error) {
+	constraints, err := s.ctrl.ListFenceConstraints(ctx)
+	if err != nil {
+		return &sdspb.ListFenceConstraintsResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	resp := &sdspb.ListFenceConstraintsResponse{
+		Success: true,
+		Message: fmt.Sprintf("Found %d fence constraints", len(constraints)),
+	}
+	for _, constraint := range constraints {
+		resp.Constraints = append(resp.Constraints, &sdspb.FenceConstraint{
+			Resource:  constraint.Resource,
+			Node:      constraint.Node,
+			FencedBy:  constraint.FencedBy,
+			Outdated:  constraint.Outdated,
+			CreatedAt: constraint.CreatedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) GetNodeResourceConfig(ctx context.Context, req *sdspb.GetNodeResourceConfigRequest) (*sdspb.GetNodeResourceConfigResponse, error) {
+	cfg, err := s.resources.NodeResourceConfig(ctx, req.Resource, req.Node)
+	if err != nil {
+		return &sdspb.GetNodeResourceConfigResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &sdspb.GetNodeResourceConfigResponse{
+		Success:    true,
+		Message:    "OK",
+		Node:       cfg.Node,
+		Running:    cfg.Running,
+		Dump:       cfg.Dump,
+		DumpError:  cfg.DumpError,
+		Show:       cfg.Show,
+		Configured: drbdConfigSectionToProto(cfg.Configured),
+		Effective:  drbdConfigSectionToProto(cfg.Effective),
+	}, nil
+}
+
+func (s *Server) RenderConfig(ctx context.Context, req *sdspb.RenderConfigRequest) (*sdspb.RenderConfigResponse, error) {
+	files, err := s.resources.RenderConfig(ctx, req)
+	if err != nil {
+		return &sdspb.RenderConfigResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.RenderConfigResponse{
+		Success: true,
+		Message: "OK",
+		Files:   files,
+	}, nil
+}
+
+// drbdConfigSectionToProto converts a parsed DRBD config section and its
+// nested sections
+func drbdConfigSectionToProto(section *DrbdConfigSection) *sdspb.DrbdConfigSection {
+	if section == nil {
+		return nil
+	}
+	pb := &sdspb.DrbdConfigSection{
+		Name:    section.Name,
+		Args:    section.Args,
+		Options: section.Options,
+	}
+	for _, sub := range section.Sections {
+		pb.Sections = append(pb.Sections, drbdConfigSectionToProto(sub))
+	}
+	return pb
+}
+
+func (s *Server) ResourceStatus(ctx context.Context, req *sdspb.ResourceStatusRequest) (*sdspb.ResourceStatusResponse, error) {
+	// Get resource detailed status
+	resource, err := s.resources.GetResource(ctx, req.Name)
+	if err != nil {
+		return &sdspb.ResourceStatusResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	// Convert to status format with detailed node states
+	status := &sdspb.ResourceStatus{
+		Name:     resource.Name,
+		Role:     resource.Role,
+		Nodes:    resource.Nodes,
+		NodeStates: make(map[string]*sdspb.NodeResourceState),
 	}
 
 	// Convert node states from endpoint key to hostname key
@@ -424,6 +1358,19 @@ func (s *Server) ResourceStatus(ctx context.Context, req *sdspb.ResourceStatusRe
 		}
 	}
 
+	for _, stats := range s.ctrl.ResourceIOStats(resource.Name) {
+		status.IoStats = append(status.IoStats, &sdspb.VolumeIOStats{
+			Node:             stats.Node,
+			VolumeId:         uint32(stats.Volume),
+			ReadBytesPerSec:  stats.ReadBytesPerSec,
+			WriteBytesPerSec: stats.WriteBytesPerSec,
+			ReadIops:         stats.ReadIOPS,
+			WriteIops:        stats.WriteIOPS,
+			ReadLatencyMs:    stats.ReadLatencyMs,
+			WriteLatencyMs:   stats.WriteLatencyMs,
+		})
+	}
+
 	return &sdspb.ResourceStatusResponse{
 		Success: true,
 		Message: "Resource status retrieved",
@@ -504,8 +1451,56 @@ func (s *Server) UnmountResource(ctx context.Context, req *sdspb.UnmountResource
 }
 
 func (s *Server) MakeHa(ctx context.Context, req *sdspb.MakeHaRequest) (*sdspb.MakeHaResponse, error) {
-	configPath, err := s.resources.MakeHa(ctx, req.Resource, req.Services, req.MountPoint, req.Fstype, req.Vip)
+	policy := DefaultHaPolicy
+	if req.Policy != nil {
+		policy = HaPolicy{
+			OnDemoteFailure:    req.Policy.OnDemoteFailure,
+			StopServicesOnExit: req.Policy.StopServicesOnExit,
+			SecondaryForce:     req.Policy.SecondaryForce,
+		}
+		if policy.OnDemoteFailure == "" {
+			policy.OnDemoteFailure = DefaultHaPolicy.OnDemoteFailure
+		}
+	}
+
+	owner := haVIPOwner(req.Resource)
+
+	// A dry run only checks the VIP is free and shows the files per node
+	if req.DryRun {
+		vip, err := s.ctrl.PreviewVIP(ctx, req.Vip, req.VipPool, owner, req.Resource, "")
+		if err != nil {
+			return &sdspb.MakeHaResponse{
+				Success: false,
+				Message: err.Error(),
+			}, nil
+		}
+		files, err := s.resources.PlanHa(ctx, req.Resource, req.Services, req.MountPoint, req.Fstype, vip, req.DependsOn, policy)
+		if err != nil {
+			return &sdspb.MakeHaResponse{
+				Success: false,
+				Message: err.Error(),
+			}, nil
+		}
+		return &sdspb.MakeHaResponse{
+			Success:    true,
+			Message:    "Dry run, nothing was changed",
+			ConfigPath: haConfigPath(s.ctrl.resourceHaConfigName(ctx, req.Resource)),
+			Vip:        vip,
+			Files:      plannedFilesToProto(files),
+		}, nil
+	}
+
+	vip, err := s.ctrl.ReserveVIP(ctx, req.Vip, req.VipPool, owner, req.Resource, "")
+	if err != nil {
+		return &sdspb.MakeHaResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	configPath, err := s.resources.MakeHa(ctx, req.Resource, req.Services, req.MountPoint, req.Fstype, vip, req.DependsOn, policy)
 	if err != nil {
+		s.ctrl.ReleaseVIPs(ctx, owner)
 		return &sdspb.MakeHaResponse{
 			Success: false,
 			Message: err.Error(),
@@ -515,102 +1510,1453 @@ func (s *Server) MakeHa(ctx context.Context, req *sdspb.MakeHaRequest) (*sdspb.M
 		Success: true,
 		Message: "HA configuration created successfully",
 		ConfigPath: configPath,
+		Vip:        vip,
+	}, nil
+}
+
+func (s *Server) ImportPacemakerHa(ctx context.Context, req *sdspb.ImportPacemakerHaRequest) (*sdspb.ImportPacemakerHaResponse, error) {
+	imports, err := s.resources.ImportPacemakerHa(ctx, req.Node, req.Cib, req.Resources, req.Apply)
+	resp := &sdspb.ImportPacemakerHaResponse{Success: err == nil, Message: "Pacemaker HA setups imported"}
+	if err != nil {
+		resp.Message = err.Error()
+	} else if !req.Apply {
+		resp.Message = "Pacemaker HA setups mapped, nothing applied"
+	}
+	for _, imp := range imports {
+		resp.Imports = append(resp.Imports, &sdspb.PacemakerHaImport{
+			Resource:       imp.DrbdResource,
+			Clone:          imp.Clone,
+			Members:        imp.Members,
+			MountPoint:     imp.MountPoint,
+			Fstype:         imp.FsType,
+			Vip:            imp.VIP,
+			Services:       imp.Services,
+			PreferredNodes: imp.PreferredNodes,
+			Unmanaged:      imp.Unmanaged,
+			Warnings:       imp.Warnings,
+			Blockers:       imp.Blockers,
+			Config:         imp.Config,
+			Applied:        imp.Applied,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) UpdateHa(ctx context.Context, req *sdspb.UpdateHaRequest) (*sdspb.UpdateHaResponse, error) {
+	update := HaUpdate{
+		AddServices:    req.AddServices,
+		RemoveServices: req.RemoveServices,
+		ClearVIP:       req.Vip == vipNone,
+		DryRun:         req.DryRun,
+	}
+
+	// A dry run only shows the VIP as given, nothing is reserved
+	owner := haVIPOwner(req.Resource)
+	if req.Vip != "" && !update.ClearVIP {
+		if req.DryRun {
+			update.VIP = req.Vip
+		} else {
+			vip, err := s.ctrl.ReserveVIP(ctx, req.Vip, req.VipPool, owner, req.Resource, "")
+			if err != nil {
+				return &sdspb.UpdateHaResponse{
+					Success: false,
+					Message: err.Error(),
+				}, nil
+			}
+			update.VIP = vip
+		}
+	}
+
+	result, err := s.resources.UpdateHa(ctx, req.Resource, update)
+	if err != nil {
+		// Only drop a reservation made for this update
+		if update.VIP != "" && !req.DryRun {
+			if current, getErr := s.resources.GetHaConfig(ctx, req.Resource); getErr != nil || !sameVIP(current.VIP, update.VIP) {
+				s.ctrl.ReleaseVIP(ctx, update.VIP)
+			}
+		}
+		return &sdspb.UpdateHaResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	message := "HA configuration updated successfully"
+	switch {
+	case !result.Changed:
+		message = "HA configuration is up to date, nothing to change"
+	case req.DryRun:
+		message = "Dry run, nothing was changed"
+	case !sameVIP(result.PreviousVIP, result.VIP):
+		s.ctrl.ReleaseVIP(ctx, result.PreviousVIP)
+	}
+
+	return &sdspb.UpdateHaResponse{
+		Success: true,
+		Message: message,
+		Diff:    result.Diff,
+		Actions: result.Actions,
+		Vip:     result.VIP,
+		Files:   plannedFilesToProto(result.Files),
 	}, nil
 }
 
 func (s *Server) EvictHa(ctx context.Context, req *sdspb.EvictHaRequest) (*sdspb.EvictHaResponse, error) {
-	err := s.resources.EvictHa(ctx, req.Resource)
+	err := s.resources.EvictHa(ctx, req.Resource, req.Force)
 	if err != nil {
 		return &sdspb.EvictHaResponse{
 			Success: false,
 			Message: err.Error(),
 		}, nil
 	}
-	return &sdspb.EvictHaResponse{
+	return &sdspb.EvictHaResponse{
+		Success: true,
+		Message: "HA resource evicted successfully",
+	}, nil
+}
+
+func (s *Server) FailoverHa(ctx context.Context, req *sdspb.FailoverHaRequest) (*sdspb.FailoverHaResponse, error) {
+	from, err := s.resources.FailoverHa(ctx, req.Resource, req.Target, time.Duration(req.TimeoutSeconds)*time.Second, req.Force)
+	if err != nil {
+		return &sdspb.FailoverHaResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.FailoverHaResponse{
+		Success: true,
+		Message: fmt.Sprintf("HA resource %s failed over to %s", req.Resource, req.Target),
+		From:    from,
+		To:      req.Target,
+	}, nil
+}
+
+func (s *Server) DeleteHa(ctx context.Context, req *sdspb.DeleteHaRequest) (*sdspb.DeleteHaResponse, error) {
+	err := s.resources.RemoveHa(ctx, req.Resource)
+	if err != nil {
+		return &sdspb.DeleteHaResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	s.ctrl.ReleaseVIPs(ctx, haVIPOwner(req.Resource))
+	return &sdspb.DeleteHaResponse{
+		Success: true,
+		Message: "HA configuration deleted successfully",
+	}, nil
+}
+
+func (s *Server) GetHa(ctx context.Context, req *sdspb.GetHaRequest) (*sdspb.GetHaResponse, error) {
+	haCfg, err := s.resources.GetHaConfig(ctx, req.Resource)
+	if err != nil {
+		return &sdspb.GetHaResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &sdspb.GetHaResponse{
+		Success: true,
+		Message: "HA configuration found",
+		Config: &sdspb.HaConfigInfo{
+			Resource:   haCfg.Resource,
+			Vip:        haCfg.VIP,
+			MountPoint: haCfg.MountPoint,
+			FsType:     haCfg.FsType,
+			Services:   haCfg.Services,
+			DependsOn:  haCfg.DependsOn,
+			Policy:     haPolicyToProto(haPolicyOf(haCfg)),
+		},
+	}, nil
+}
+
+func (s *Server) ListHa(ctx context.Context, req *sdspb.ListHaRequest) (*sdspb.ListHaResponse, error) {
+	haConfigs, err := s.resources.ListHaConfigs(ctx)
+	if err != nil {
+		return &sdspb.ListHaResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	var pbConfigs []*sdspb.HaConfigInfo
+	for _, cfg := range haConfigs {
+		if !s.ctrl.resourceVisible(ctx, cfg.Resource) {
+			continue
+		}
+		pbConfigs = append(pbConfigs, &sdspb.HaConfigInfo{
+			Resource:   cfg.Resource,
+			Vip:        cfg.VIP,
+			MountPoint: cfg.MountPoint,
+			FsType:     cfg.FsType,
+			Services:   cfg.Services,
+			DependsOn:  cfg.DependsOn,
+			Policy:     haPolicyToProto(haPolicyOf(cfg)),
+			ActiveNode: s.ctrl.cachedActiveNode(ctx, cfg.Resource),
+		})
+	}
+
+	return &sdspb.ListHaResponse{
+		Success: true,
+		Message: "HA configurations listed successfully",
+		Configs: pbConfigs,
+	}, nil
+}
+
+func (s *Server) GetHaStatus(ctx context.Context, req *sdspb.GetHaStatusRequest) (*sdspb.GetHaStatusResponse, error) {
+	status, err := s.resources.GetHaStatus(ctx, req.Resource)
+	if err != nil {
+		return &sdspb.GetHaStatusResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	haCfg := status.Config
+	resp := &sdspb.GetHaStatusResponse{
+		Success: true,
+		Message: "HA status collected",
+		Config: &sdspb.HaConfigInfo{
+			Resource:   haCfg.Resource,
+			Vip:        haCfg.VIP,
+			MountPoint: haCfg.MountPoint,
+			FsType:     haCfg.FsType,
+			Services:   haCfg.Services,
+			DependsOn:  haCfg.DependsOn,
+			Policy:     haPolicyToProto(haPolicyOf(haCfg)),
+			ActiveNode: status.ActiveNode,
+		},
+		ConfigPath: status.ConfigPath,
+		ActiveNode: status.ActiveNode,
+	}
+	for _, member := range status.Members {
+		resp.Members = append(resp.Members, &sdspb.HaMember{
+			Node:      member.Node,
+			Address:   member.Address,
+			Role:      member.Role,
+			DiskState: member.DiskState,
+			Reactor:   member.Reactor,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) ListVIPs(ctx context.Context, req *sdspb.ListVIPsRequest) (*sdspb.ListVIPsResponse, error) {
+	vips, err := s.ctrl.ListVIPs(ctx)
+	if err != nil {
+		return &sdspb.ListVIPsResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	// The pool usage counts every VIP, the list only shows the caller's
+	resp := &sdspb.ListVIPsResponse{Success: true}
+	for _, vip := range vips {
+		if !s.ctrl.vipVisible(ctx, vip) {
+			continue
+		}
+		resp.Vips = append(resp.Vips, &sdspb.VIPInfo{
+			Address:   vip.Address,
+			Prefix:    int32(vip.Prefix),
+			Owner:     vip.Owner,
+			Pool:      vip.Pool,
+			CreatedAt: vip.CreatedAt.Unix(),
+		})
+	}
+	resp.Message = fmt.Sprintf("Found %d VIPs", len(resp.Vips))
+	for _, pool := range s.ctrl.VIPPoolUsage(vips) {
+		resp.Pools = append(resp.Pools, &sdspb.VIPPoolInfo{
+			Name:  pool.Name,
+			Range: pool.Range,
+			Total: int32(pool.Total),
+			Used:  int32(pool.Used),
+		})
+	}
+	return resp, nil
+}
+
+// haPolicyToProto converts an HA policy to its API representation
+func haPolicyToProto(p HaPolicy) *sdspb.HaPolicy {
+	return &sdspb.HaPolicy{
+		OnDemoteFailure:    p.OnDemoteFailure,
+		StopServicesOnExit: p.StopServicesOnExit,
+		SecondaryForce:     p.SecondaryForce,
+	}
+}
+
+// ==================== DR OPERATIONS ====================
+
+func (s *Server) DrSwitchover(ctx context.Context, req *sdspb.DrSwitchoverRequest) (*sdspb.DrSwitchoverResponse, error) {
+	timeout := time.Duration(req.SyncTimeoutSeconds) * time.Second
+	result, err := s.resources.DrSwitchover(ctx, req.Resource, req.TargetNode, timeout)
+
+	resp := &sdspb.DrSwitchoverResponse{}
+	if result != nil {
+		resp.FromNode = result.FromNode
+		resp.ToNode = result.ToNode
+		resp.Steps = result.Steps
+	}
+	if err != nil {
+		resp.Success = false
+		resp.Message = err.Error()
+		return resp, nil
+	}
+
+	resp.Success = true
+	resp.Message = "DR switchover completed successfully"
+	return resp, nil
+}
+
+func (s *Server) DrFailback(ctx context.Context, req *sdspb.DrFailbackRequest) (*sdspb.DrFailbackResponse, error) {
+	timeout := time.Duration(req.SyncTimeoutSeconds) * time.Second
+	result, err := s.resources.DrFailback(ctx, req.Resource, timeout)
+
+	resp := &sdspb.DrFailbackResponse{}
+	if result != nil {
+		resp.FromNode = result.FromNode
+		resp.ToNode = result.ToNode
+		resp.Steps = result.Steps
+	}
+	if err != nil {
+		resp.Success = false
+		resp.Message = err.Error()
+		return resp, nil
+	}
+
+	resp.Success = true
+	resp.Message = "DR failback completed successfully"
+	return resp, nil
+}
+
+// ==================== PLACEMENT OPERATIONS ====================
+
+func (s *Server) AddPlacementRule(ctx context.Context, req *sdspb.AddPlacementRuleRequest) (*sdspb.AddPlacementRuleResponse, error) {
+	err := s.resources.AddPlacementRule(ctx, req.ResourceA, req.ResourceB, req.Type)
+	if err != nil {
+		return &sdspb.AddPlacementRuleResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.AddPlacementRuleResponse{
+		Success: true,
+		Message: "Placement rule added successfully",
+	}, nil
+}
+
+func (s *Server) DeletePlacementRule(ctx context.Context, req *sdspb.DeletePlacementRuleRequest) (*sdspb.DeletePlacementRuleResponse, error) {
+	err := s.resources.DeletePlacementRule(ctx, req.ResourceA, req.ResourceB)
+	if err != nil {
+		return &sdspb.DeletePlacementRuleResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.DeletePlacementRuleResponse{
+		Success: true,
+		Message: "Placement rule deleted successfully",
+	}, nil
+}
+
+func (s *Server) ListPlacementRules(ctx context.Context, req *sdspb.ListPlacementRulesRequest) (*sdspb.ListPlacementRulesResponse, error) {
+	rules, err := s.resources.ListPlacementRules(ctx, req.Resource)
+	if err != nil {
+		return &sdspb.ListPlacementRulesResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	// Placement keeps applying the rules with other principals' resources,
+	// they are only left out here
+	visible := s.ctrl.resourceFilter(ctx)
+	var pbRules []*sdspb.PlacementRuleInfo
+	for _, rule := range rules {
+		if !visible(rule.ResourceA) || !visible(rule.ResourceB) {
+			continue
+		}
+		pbRules = append(pbRules, &sdspb.PlacementRuleInfo{
+			ResourceA: rule.ResourceA,
+			ResourceB: rule.ResourceB,
+			Type:      rule.Type,
+			CreatedAt: rule.CreatedAt.Unix(),
+		})
+	}
+
+	return &sdspb.ListPlacementRulesResponse{
+		Success: true,
+		Message: "Placement rules listed successfully",
+		Rules:   pbRules,
+	}, nil
+}
+
+// ==================== EVENT OPERATIONS ====================
+
+func (s *Server) ListEvents(ctx context.Context, req *sdspb.ListEventsRequest) (*sdspb.ListEventsResponse, error) {
+	events, err := s.ctrl.ListEvents(ctx, req.Resource, int(req.Limit))
+	if err != nil {
+		return &sdspb.ListEventsResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	var pbEvents []*sdspb.EventInfo
+	for _, event := range events {
+		pbEvents = append(pbEvents, &sdspb.EventInfo{
+			Id:        event.ID,
+			Timestamp: event.CreatedAt.Unix(),
+			Type:      event.Type,
+			Resource:  event.Resource,
+			Message:   event.Message,
+			Details:   event.Details,
+		})
+	}
+
+	return &sdspb.ListEventsResponse{
+		Success: true,
+		Message: "Events listed successfully",
+		Events:  pbEvents,
+	}, nil
+}
+
+func (s *Server) GetOverview(ctx context.Context, req *sdspb.GetOverviewRequest) (*sdspb.GetOverviewResponse, error) {
+	overview, err := s.ctrl.GetOverview(ctx, int(req.Events))
+	if err != nil {
+		return &sdspb.GetOverviewResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &sdspb.GetOverviewResponse{
+		Success: true,
+		Message: "Overview collected",
+	}
+	for _, n := range overview.Nodes {
+		resp.Nodes = append(resp.Nodes, nodeToProto(n))
+	}
+	for _, p := range overview.Pools {
+		resp.Pools = append(resp.Pools, &sdspb.PoolInfo{
+			Name:    p.Name,
+			Type:    p.Type,
+			Node:    p.Node,
+			TotalGb: p.TotalGB,
+			FreeGb:  p.FreeGB,
+		})
+	}
+	for _, r := range overview.Resources {
+		nodeStates := make(map[string]*sdspb.NodeResourceState)
+		for node, state := range r.NodeStates {
+			nodeStates[node] = &sdspb.NodeResourceState{
+				Role:             state.Role,
+				DiskState:        state.DiskState,
+				ReplicationState: state.Replication,
+			}
+		}
+		resp.Resources = append(resp.Resources, &sdspb.ResourceInfo{
+			Name:       r.Name,
+			Port:       r.Port,
+			Protocol:   r.Protocol,
+			Nodes:      r.Nodes,
+			Role:       r.Role,
+			NodeStates: nodeStates,
+
+			PeerProtocols:   r.PeerProtocols,
+			MaxPeers:        uint32(r.MaxPeers),
+			MaxPeersPending: r.MaxPeersPending,
+			DesiredState:    r.DesiredState,
+			Owner:           r.Owner,
+			Health:          r.Health,
+			HealthReasons:   r.HealthReasons,
+		})
+	}
+	for _, cfg := range overview.Ha {
+		resp.Ha = append(resp.Ha, &sdspb.HaConfigInfo{
+			Resource:   cfg.Resource,
+			Vip:        cfg.VIP,
+			MountPoint: cfg.MountPoint,
+			FsType:     cfg.FsType,
+			Services:   cfg.Services,
+			DependsOn:  cfg.DependsOn,
+			Policy:     haPolicyToProto(haPolicyOf(cfg)),
+		})
+	}
+	for _, gw := range overview.Gateways {
+		resp.Gateways = append(resp.Gateways, &sdspb.GatewayInfo{
+			Id:       gw.ID,
+			Name:     gw.Name,
+			Type:     gw.Type,
+			Node:     gw.Node,
+			Resource: gw.Resource,
+			Options:  s.gatewayOptions(ctx, gw.ID),
+			Owner:    s.gatewayOwner(ctx, gw.ID),
+		})
+	}
+	for _, event := range overview.Events {
+		resp.Events = append(resp.Events, &sdspb.EventInfo{
+			Id:        event.ID,
+			Timestamp: event.CreatedAt.Unix(),
+			Type:      event.Type,
+			Resource:  event.Resource,
+			Message:   event.Message,
+			Details:   event.Details,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) GetClusterReport(ctx context.Context, req *sdspb.GetClusterReportRequest) (*sdspb.GetClusterReportResponse, error) {
+	format := req.Format
+	if format == "" {
+		format = ReportFormatHTML
+	}
+	report, err := s.ctrl.ClusterReport(ctx, time.Duration(req.Days)*24*time.Hour)
+	if err == nil {
+		var content string
+		if content, err = report.Render(format); err == nil {
+			return &sdspb.GetClusterReportResponse{
+				Success: true,
+				Message: report.Title(),
+				Format:  format,
+				Content: content,
+			}, nil
+		}
+	}
+	return &sdspb.GetClusterReportResponse{
+		Success: false,
+		Message: err.Error(),
+	}, nil
+}
+
+func (s *Server) GetAlertRules(ctx context.Context, req *sdspb.GetAlertRulesRequest) (*sdspb.GetAlertRulesResponse, error) {
+	rules, err := s.ctrl.AlertRules(ctx, req.PoolFullPercent, time.Duration(req.ForSeconds)*time.Second)
+	if err != nil {
+		return &sdspb.GetAlertRulesResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.GetAlertRulesResponse{
+		Success: true,
+		Message: "Alerting rules generated",
+		Rules:   rules,
+	}, nil
+}
+
+// ==================== CLUSTER OPERATIONS ====================
+
+func (s *Server) ListClusters(ctx context.Context, req *sdspb.ListClustersRequest) (*sdspb.ListClustersResponse, error) {
+	resp := &sdspb.ListClustersResponse{Success: true, Message: "OK"}
+	for _, cluster := range s.ctrl.ListClusters(ctx) {
+		resp.Clusters = append(resp.Clusters, &sdspb.ClusterInfo{
+			Name:         cluster.Name,
+			Uuid:         cluster.UUID,
+			DatabasePath: cluster.DatabasePath,
+			Nodes:        uint32(cluster.Nodes),
+			Resources:    uint32(cluster.Resources),
+			IsDefault:    cluster.Default,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) GetClusterInfo(ctx context.Context, req *sdspb.GetClusterInfoRequest) (*sdspb.GetClusterInfoResponse, error) {
+	inventory, err := s.ctrl.GetClusterInfo(ctx)
+	if err != nil {
+		return &sdspb.GetClusterInfoResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	build := inventory.Controller
+	resp := &sdspb.GetClusterInfoResponse{
+		Success: true,
+		Message: "OK",
+		Cluster: &sdspb.ClusterInfo{
+			Name:         inventory.Cluster.Name,
+			Uuid:         inventory.Cluster.UUID,
+			DatabasePath: inventory.Cluster.DatabasePath,
+			Nodes:        uint32(inventory.Cluster.Nodes),
+			Resources:    uint32(inventory.Cluster.Resources),
+		},
+		Controller: &sdspb.BuildInfo{
+			Version:   build.Version,
+			Commit:    build.Commit,
+			BuildDate: build.BuildDate,
+			GoVersion: build.GoVersion,
+			Platform:  build.Platform,
+		},
+	}
+	for _, n := range inventory.Nodes {
+		var checkedAt int64
+		if !n.CheckedAt.IsZero() {
+			checkedAt = n.CheckedAt.Unix()
+		}
+		resp.Nodes = append(resp.Nodes, &sdspb.NodeComponents{
+			Name:               n.Name,
+			Address:            n.Address,
+			State:              string(n.State),
+			DrbdKernelVersion:  n.DrbdKernelVersion,
+			DrbdUtilsVersion:   n.DrbdUtilsVersion,
+			DrbdReactorVersion: n.DrbdReactorVersion,
+			LvmVersion:         n.LvmVersion,
+			ZfsVersion:         n.ZfsVersion,
+			CheckedAt:          checkedAt,
+			Stale:              n.Stale,
+			Warnings:           n.Warnings,
+		})
+	}
+	return resp, nil
+}
+
+// ==================== ADMIN OPERATIONS ====================
+
+func (s *Server) Freeze(ctx context.Context, req *sdspb.FreezeRequest) (*sdspb.FreezeResponse, error) {
+	state, err := s.ctrl.Freeze(ctx, req.Reason)
+	if err != nil {
+		return &sdspb.FreezeResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.FreezeResponse{
+		Success: true,
+		Message: "Controller frozen, mutating operations are rejected",
+		Status:  freezeStatusToProto(state),
+	}, nil
+}
+
+func (s *Server) Unfreeze(ctx context.Context, req *sdspb.UnfreezeRequest) (*sdspb.UnfreezeResponse, error) {
+	if err := s.ctrl.Unfreeze(ctx); err != nil {
+		return &sdspb.UnfreezeResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.UnfreezeResponse{
+		Success: true,
+		Message: "Controller unfrozen",
+	}, nil
+}
+
+func (s *Server) GetFreezeStatus(ctx context.Context, req *sdspb.GetFreezeStatusRequest) (*sdspb.GetFreezeStatusResponse, error) {
+	state := s.ctrl.FreezeState()
+	return &sdspb.GetFreezeStatusResponse{
+		Success: true,
+		Message: "OK",
+		Status:  freezeStatusToProto(&state),
+	}, nil
+}
+
+func (s *Server) CollectGarbage(ctx context.Context, req *sdspb.CollectGarbageRequest) (*sdspb.CollectGarbageResponse, error) {
+	orphans, err := s.ctrl.CollectGarbage(ctx, req.DryRun)
+	if err != nil {
+		return &sdspb.CollectGarbageResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &sdspb.CollectGarbageResponse{
+		Success: true,
+		Message: fmt.Sprintf("%d orphaned artifact(s) found", len(orphans)),
+	}
+	for _, o := range orphans {
+		resp.Orphans = append(resp.Orphans, &sdspb.Orphan{
+			Kind:    o.Kind,
+			Node:    o.Node,
+			Path:    o.Path,
+			InUse:   o.InUse,
+			Removed: o.Removed,
+			Error:   o.Error,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) Rediscover(ctx context.Context, req *sdspb.RediscoverRequest) (*sdspb.RediscoverResponse, error) {
+	records, err := s.ctrl.Rediscover(ctx, req.DryRun)
+	if err != nil {
+		return &sdspb.RediscoverResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &sdspb.RediscoverResponse{
+		Success: true,
+		Message: fmt.Sprintf("%d missing record(s) found", len(records)),
+	}
+	for _, r := range records {
+		resp.Records = append(resp.Records, &sdspb.Rediscovered{
+			Kind:     r.Kind,
+			Name:     r.Name,
+			Nodes:    r.Nodes,
+			Source:   r.Source,
+			Detail:   r.Detail,
+			Skipped:  r.Skipped,
+			Restored: r.Restored,
+			Error:    r.Error,
+		})
+	}
+	return resp, nil
+}
+
+// freezeStatusToProto converts the freeze state
+func freezeStatusToProto(state *database.FreezeState) *sdspb.FreezeStatus {
+	status := &sdspb.FreezeStatus{Frozen: state.Frozen, Reason: state.Reason}
+	if state.Frozen {
+		status.Since = state.Since.Unix()
+	}
+	return status
+}
+
+// ==================== RECONCILE OPERATIONS ====================
+
+func (s *Server) GetDriftReport(ctx context.Context, req *sdspb.GetDriftReportRequest) (*sdspb.GetDriftReportResponse, error) {
+	report := s.ctrl.DriftReport()
+	if req.Refresh || report == nil {
+		var err error
+		report, err = s.ctrl.Reconcile(ctx)
+		if err != nil {
+			return &sdspb.GetDriftReportResponse{
+				Success: false,
+				Message: err.Error(),
+			}, nil
+		}
+	}
+
+	resp := &sdspb.GetDriftReportResponse{
+		Success:   true,
+		CheckedAt: report.CheckedAt.Unix(),
+	}
+	for _, d := range report.Drifts {
+		if !s.ctrl.driftVisible(ctx, d) {
+			continue
+		}
+		resp.Drifts = append(resp.Drifts, &sdspb.Drift{
+			Kind:       d.Kind,
+			Name:       d.Name,
+			Node:       d.Node,
+			State:      d.State,
+			Problem:    d.Problem,
+			DetectedAt: d.DetectedAt.Unix(),
+		})
+	}
+	resp.Message = fmt.Sprintf("%d drift(s) found", len(resp.Drifts))
+	return resp, nil
+}
+
+func (s *Server) Repair(ctx context.Context, req *sdspb.RepairRequest) (*sdspb.RepairResponse, error) {
+	actions, err := s.ctrl.Repair(ctx, req.Kind, req.Name)
+	if err != nil {
+		return &sdspb.RepairResponse{
+			Success: false,
+			Message: err.Error(),
+			Actions: actions,
+		}, nil
+	}
+	return &sdspb.RepairResponse{
+		Success: true,
+		Message: fmt.Sprintf("Repaired %s %s", req.Kind, req.Name),
+		Actions: actions,
+	}, nil
+}
+
+// ==================== REBALANCE OPERATIONS ====================
+
+func (s *Server) Rebalance(ctx context.Context, req *sdspb.RebalanceRequest) (*sdspb.RebalanceResponse, error) {
+	maxMoves := int(req.MaxMoves)
+	if maxMoves <= 0 {
+		maxMoves = s.ctrl.config.Rebalance.MaxMoves
+	}
+
+	plan, err := s.resources.Rebalance(ctx, maxMoves, s.ctrl.config.Rebalance.Threshold, req.DryRun)
+	resp := &sdspb.RebalanceResponse{Success: err == nil}
+	if plan != nil {
+		var nodes []string
+		for node := range plan.Primaries {
+			nodes = append(nodes, node)
+		}
+		sort.Strings(nodes)
+		for _, node := range nodes {
+			resp.Nodes = append(resp.Nodes, &sdspb.NodePrimaries{Node: node, Primaries: int32(plan.Primaries[node])})
+		}
+		for _, move := range plan.Moves {
+			resp.Moves = append(resp.Moves, &sdspb.RebalanceMove{
+				Resource: move.Resource,
+				FromNode: move.From,
+				ToNode:   move.To,
+				Done:     move.Done,
+				Error:    move.Error,
+			})
+		}
+		resp.Skipped = plan.Skipped
+	}
+
+	switch {
+	case err != nil:
+		resp.Message = err.Error()
+	case req.DryRun:
+		resp.Message = fmt.Sprintf("Planned %d move(s)", len(resp.Moves))
+	default:
+		resp.Message = fmt.Sprintf("Performed %d move(s)", len(resp.Moves))
+	}
+	return resp, nil
+}
+
+// ==================== JOB OPERATIONS ====================
+
+// defaultJobListLimit is the number of jobs listed without a limit
+const defaultJobListLimit = 50
+
+func (s *Server) ListJobs(ctx context.Context, req *sdspb.ListJobsRequest) (*sdspb.ListJobsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultJobListLimit
+	}
+
+	jobs, err := s.ctrl.ListJobs(ctx, req.Target, req.State, limit)
+	if err != nil {
+		return &sdspb.ListJobsResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &sdspb.ListJobsResponse{
+		Success: true,
+		Message: fmt.Sprintf("Found %d job(s)", len(jobs)),
+	}
+	for _, job := range jobs {
+		resp.Jobs = append(resp.Jobs, jobToProto(job, false))
+	}
+	return resp, nil
+}
+
+func (s *Server) GetJob(ctx context.Context, req *sdspb.GetJobRequest) (*sdspb.GetJobResponse, error) {
+	job, err := s.ctrl.GetJob(ctx, req.Id)
+	if err != nil {
+		return &sdspb.GetJobResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &sdspb.GetJobResponse{
+		Success: true,
+		Message: fmt.Sprintf("Job %d", job.ID),
+		Job:     jobToProto(job, true),
+	}, nil
+}
+
+func (s *Server) ResumeJob(ctx context.Context, req *sdspb.ResumeJobRequest) (*sdspb.ResumeJobResponse, error) {
+	job, err := s.ctrl.ResumeJob(ctx, req.Id)
+	resp := &sdspb.ResumeJobResponse{}
+	if job != nil {
+		resp.JobId = job.ID
+	}
+	if err != nil {
+		resp.Message = err.Error()
+		return resp, nil
+	}
+	resp.Success = true
+	resp.Message = fmt.Sprintf("Job %d resumed as job %d", req.Id, resp.JobId)
+	return resp, nil
+}
+
+func (s *Server) RollbackJob(ctx context.Context, req *sdspb.RollbackJobRequest) (*sdspb.RollbackJobResponse, error) {
+	job, err := s.ctrl.RollbackJob(ctx, req.Id)
+	resp := &sdspb.RollbackJobResponse{}
+	if job != nil {
+		resp.JobId = job.ID
+	}
+	if err != nil {
+		resp.Message = err.Error()
+		return resp, nil
+	}
+	resp.Success = true
+	resp.Message = fmt.Sprintf("Job %d rolled back by job %d", req.Id, resp.JobId)
+	return resp, nil
+}
+
+func (s *Server) WatchJobProgress(req *sdspb.WatchJobProgressRequest, stream grpc.ServerStreamingServer[sdspb.JobProgress]) error {
+	if req.ProgressId == "" {
+		return status.Error(codes.InvalidArgument, "progress id required")
+	}
+	ctx := stream.Context()
+	ctrl, err := s.ctrl.clusterFor(ctx)
+	if err != nil {
+		return err
+	}
+	return ctrl.WatchJobProgress(ctx, req.ProgressId, func(event *JobProgress) error {
+		return stream.Send(&sdspb.JobProgress{
+			Kind:       event.Kind,
+			JobId:      event.JobID,
+			Step:       event.Step,
+			Label:      event.Label,
+			Node:       event.Node,
+			Success:    event.Success,
+			DurationMs: event.Duration.Milliseconds(),
+			State:      event.State,
+			Message:    event.Message,
+		})
+	})
+}
+
+// jobToProto converts a job, with its steps if requested
+func jobToProto(job *database.Job, withSteps bool) *sdspb.JobInfo {
+	info := &sdspb.JobInfo{
+		Id:         job.ID,
+		Operation:  job.Operation,
+		Target:     job.Target,
+		State:      job.State,
+		Message:    job.Message,
+		StartedAt:  job.StartedAt.UnixMilli(),
+		StepCount:  int32(len(job.Steps)),
+		Step:       job.Step,
+		ResolvedBy: job.ResolvedBy,
+		Resolution: job.Resolution,
+	}
+	if !job.FinishedAt.IsZero() {
+		info.FinishedAt = job.FinishedAt.UnixMilli()
+	}
+	if job.State == JobInterrupted && job.Resolution == "" {
+		info.Resumable = job.Request != ""
+		info.Rollback, _ = jobRollback(job)
+	}
+	for _, step := range job.Steps {
+		if step.Slow {
+			info.SlowSteps++
+		}
+		if withSteps {
+			info.Steps = append(info.Steps, &sdspb.JobStep{
+				Name:       step.Name,
+				Node:       step.Node,
+				Command:    step.Command,
+				StartedAt:  step.StartedAt.UnixMilli(),
+				DurationMs: step.Duration.Milliseconds(),
+				Success:    step.Success,
+				Slow:       step.Slow,
+				ExitCode:   int32(step.ExitCode),
+				Output:     step.Output,
+			})
+		}
+	}
+	return info
+}
+
+// ==================== DRBD GLOBAL CONFIG OPERATIONS ====================
+
+func (s *Server) GetDrbdGlobalConfig(ctx context.Context, req *sdspb.GetDrbdGlobalConfigRequest) (*sdspb.GetDrbdGlobalConfigResponse, error) {
+	cfg, content, err := s.ctrl.GetDrbdGlobalConfig(ctx, int(req.Version))
+	if err != nil {
+		return &sdspb.GetDrbdGlobalConfigResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &sdspb.GetDrbdGlobalConfigResponse{
+		Success: true,
+		Message: fmt.Sprintf("DRBD global config version %d", cfg.Version),
+		Config:  drbdGlobalConfigToProto(cfg),
+		Content: content,
+	}, nil
+}
+
+func (s *Server) SetDrbdGlobalConfig(ctx context.Context, req *sdspb.SetDrbdGlobalConfigRequest) (*sdspb.SetDrbdGlobalConfigResponse, error) {
+	if req.Config == nil {
+		return &sdspb.SetDrbdGlobalConfigResponse{
+			Success: false,
+			Message: "config is required",
+		}, nil
+	}
+
+	cfg := &database.DrbdGlobalConfig{
+		UsageCount: req.Config.UsageCount,
+		Disk:       req.Config.Disk,
+		Net:        req.Config.Net,
+		Handlers:   req.Config.Handlers,
+		Comment:    req.Config.Comment,
+	}
+	failed, err := s.ctrl.SetDrbdGlobalConfig(ctx, cfg, req.Apply)
+	if err != nil {
+		return &sdspb.SetDrbdGlobalConfigResponse{
+			Success:     false,
+			Message:     err.Error(),
+			Version:     int32(cfg.Version),
+			FailedNodes: failed,
+		}, nil
+	}
+
+	return &sdspb.SetDrbdGlobalConfigResponse{
+		Success:     len(failed) == 0,
+		Message:     drbdGlobalMessage(cfg.Version, failed),
+		Version:     int32(cfg.Version),
+		FailedNodes: failed,
+	}, nil
+}
+
+func (s *Server) ListDrbdGlobalConfigs(ctx context.Context, req *sdspb.ListDrbdGlobalConfigsRequest) (*sdspb.ListDrbdGlobalConfigsResponse, error) {
+	configs, err := s.ctrl.ListDrbdGlobalConfigs(ctx)
+	if err != nil {
+		return &sdspb.ListDrbdGlobalConfigsResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &sdspb.ListDrbdGlobalConfigsResponse{
+		Success: true,
+		Message: fmt.Sprintf("Found %d version(s)", len(configs)),
+	}
+	for _, cfg := range configs {
+		resp.Configs = append(resp.Configs, drbdGlobalConfigToProto(cfg))
+	}
+	return resp, nil
+}
+
+func (s *Server) RollbackDrbdGlobalConfig(ctx context.Context, req *sdspb.RollbackDrbdGlobalConfigRequest) (*sdspb.RollbackDrbdGlobalConfigResponse, error) {
+	cfg, failed, err := s.ctrl.RollbackDrbdGlobalConfig(ctx, int(req.Version), req.Apply)
+	if err != nil {
+		return &sdspb.RollbackDrbdGlobalConfigResponse{
+			Success:     false,
+			Message:     err.Error(),
+			FailedNodes: failed,
+		}, nil
+	}
+
+	return &sdspb.RollbackDrbdGlobalConfigResponse{
+		Success:     len(failed) == 0,
+		Message:     drbdGlobalMessage(cfg.Version, failed),
+		Version:     int32(cfg.Version),
+		FailedNodes: failed,
+	}, nil
+}
+
+// drbdGlobalConfigToProto converts a stored global config version
+func drbdGlobalConfigToProto(cfg *database.DrbdGlobalConfig) *sdspb.DrbdGlobalConfig {
+	return &sdspb.DrbdGlobalConfig{
+		Version:    int32(cfg.Version),
+		UsageCount: cfg.UsageCount,
+		Disk:       cfg.Disk,
+		Net:        cfg.Net,
+		Handlers:   cfg.Handlers,
+		Comment:    cfg.Comment,
+		CreatedAt:  cfg.CreatedAt.Unix(),
+	}
+}
+
+// drbdGlobalMessage describes the outcome of writing a global config version
+func drbdGlobalMessage(version int, failed []string) string {
+	if len(failed) > 0 {
+		return fmt.Sprintf("Saved DRBD global config version %d, but failed on: %s", version, strings.Join(failed, ", "))
+	}
+	return fmt.Sprintf("DRBD global config version %d written to all nodes", version)
+}
+
+// ==================== NET PROBE OPERATIONS ====================
+
+func (s *Server) ProbeNetwork(ctx context.Context, req *sdspb.ProbeNetworkRequest) (*sdspb.ProbeNetworkResponse, error) {
+	probes, err := s.ctrl.ProbeNetwork(ctx, req.Nodes)
+	if err != nil {
+		return &sdspb.ProbeNetworkResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &sdspb.ProbeNetworkResponse{Success: true}
+	var below int
+	for _, probe := range probes {
+		resp.Probes = append(resp.Probes, netProbeToProto(probe))
+		if len(probe.Warnings) > 0 {
+			below++
+		}
+	}
+	resp.Message = fmt.Sprintf("Probed %d link(s), %d below the requirements", len(probes), below)
+	return resp, nil
+}
+
+func (s *Server) ListNetProbes(ctx context.Context, req *sdspb.ListNetProbesRequest) (*sdspb.ListNetProbesResponse, error) {
+	probes, err := s.ctrl.ListNetProbes(ctx)
+	if err != nil {
+		return &sdspb.ListNetProbesResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &sdspb.ListNetProbesResponse{
+		Success: true,
+		Message: fmt.Sprintf("Found %d link(s)", len(probes)),
+	}
+	for _, probe := range probes {
+		resp.Probes = append(resp.Probes, netProbeToProto(probe))
+	}
+	return resp, nil
+}
+
+// netProbeToProto converts a link measurement
+func netProbeToProto(probe *database.NetProbe) *sdspb.NetProbe {
+	return &sdspb.NetProbe{
+		Source:         probe.Source,
+		Target:         probe.Target,
+		Method:         probe.Method,
+		LatencyMs:      probe.LatencyMs,
+		ThroughputMbps: probe.ThroughputMbps,
+		RequiredMbps:   probe.RequiredMbps,
+		Protocol:       probe.Protocol,
+		Warnings:       probe.Warnings,
+		Error:          probe.Error,
+		ProbedAt:       probe.ProbedAt.Unix(),
+	}
+}
+
+// ==================== SETUP OPERATIONS ====================
+
+func (s *Server) GetSetupStatus(ctx context.Context, req *sdspb.GetSetupStatusRequest) (*sdspb.GetSetupStatusResponse, error) {
+	status, err := s.ctrl.GetSetupStatus(ctx)
+	if err != nil {
+		return &sdspb.GetSetupStatusResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	message := "Setup is at step " + status.Next
+	if status.Completed {
+		message = "Setup is completed"
+	}
+	resp := &sdspb.GetSetupStatusResponse{
+		Success:   true,
+		Message:   message,
+		Next:      status.Next,
+		Completed: status.Completed,
+	}
+	if !status.CompletedAt.IsZero() {
+		resp.CompletedAt = status.CompletedAt.Unix()
+	}
+	for _, step := range status.Steps {
+		resp.Steps = append(resp.Steps, &sdspb.SetupStep{
+			Name:   step.Name,
+			Done:   step.Done,
+			Detail: step.Detail,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) TestNodeConnection(ctx context.Context, req *sdspb.TestNodeConnectionRequest) (*sdspb.TestNodeConnectionResponse, error) {
+	checks, err := s.ctrl.TestNodeConnection(ctx, req.Name, req.Address)
+	if err != nil {
+		return &sdspb.TestNodeConnectionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &sdspb.TestNodeConnectionResponse{Success: true, Ready: true}
+	failed := 0
+	for _, check := range checks {
+		resp.Checks = append(resp.Checks, &sdspb.SetupCheck{
+			Name:   check.Name,
+			Passed: check.Passed,
+			Detail: check.Detail,
+		})
+		if !check.Passed {
+			failed++
+		}
+	}
+	resp.Ready = failed == 0
+	resp.Message = fmt.Sprintf("%s is ready to be registered", req.Address)
+	if failed > 0 {
+		resp.Message = fmt.Sprintf("%d check(s) failed on %s", failed, req.Address)
+	}
+	return resp, nil
+}
+
+func (s *Server) CompleteSetup(ctx context.Context, req *sdspb.CompleteSetupRequest) (*sdspb.CompleteSetupResponse, error) {
+	state, err := s.ctrl.CompleteSetup(ctx)
+	if err != nil {
+		return &sdspb.CompleteSetupResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &sdspb.CompleteSetupResponse{
+		Success:     true,
+		Message:     "Setup completed",
+		CompletedAt: state.CompletedAt.Unix(),
+	}, nil
+}
+
+func (s *Server) DiscoverDisks(ctx context.Context, req *sdspb.DiscoverDisksRequest) (*sdspb.DiscoverDisksResponse, error) {
+	disks, err := s.ctrl.DiscoverDisks(ctx, req.Node)
+	if err != nil {
+		return &sdspb.DiscoverDisksResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	available := 0
+	resp := &sdspb.DiscoverDisksResponse{Success: true}
+	for _, disk := range disks {
+		resp.Disks = append(resp.Disks, &sdspb.DiscoveredDisk{
+			Path:       disk.Path,
+			SizeBytes:  disk.SizeBytes,
+			Model:      disk.Model,
+			Rotational: disk.Rotational,
+			Available:  disk.Available,
+			Reason:     disk.Reason,
+		})
+		if disk.Available {
+			available++
+		}
+	}
+	resp.Message = fmt.Sprintf("Found %d disk(s) on %s, %d available", len(disks), req.Node, available)
+	return resp, nil
+}
+
+// ==================== SNAPSHOT OPERATIONS ====================
+
+func (s *Server) CreateSnapshot(ctx context.Context, req *sdspb.CreateSnapshotRequest) (*sdspb.CreateSnapshotResponse, error) {
+	err := s.snapshots.CreateSnapshot(ctx, req.Volume, req.SnapshotName, req.Node, req.Size, req.SkipHooks)
+	if err != nil {
+		return &sdspb.CreateSnapshotResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.CreateSnapshotResponse{
+		Success: true,
+		Message: "Snapshot created successfully",
+	}, nil
+}
+
+func (s *Server) SetSnapshotHook(ctx context.Context, req *sdspb.SetSnapshotHookRequest) (*sdspb.SetSnapshotHookResponse, error) {
+	hook := &database.SnapshotHook{Name: req.Name}
+	if req.Hook != nil {
+		hook.Pre = req.Hook.Pre
+		hook.Post = req.Hook.Post
+		hook.Timeout = time.Duration(req.Hook.TimeoutSeconds) * time.Second
+		hook.OnFailure = req.Hook.OnFailure
+	}
+	if err := s.ctrl.SetSnapshotHook(ctx, req.Resource, hook); err != nil {
+		return &sdspb.SetSnapshotHookResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.SetSnapshotHookResponse{
+		Success: true,
+		Message: fmt.Sprintf("Snapshot hook %s set", req.Name),
+	}, nil
+}
+
+func (s *Server) DeleteSnapshotHook(ctx context.Context, req *sdspb.DeleteSnapshotHookRequest) (*sdspb.DeleteSnapshotHookResponse, error) {
+	if err := s.ctrl.DeleteSnapshotHook(ctx, req.Resource, req.Name); err != nil {
+		return &sdspb.DeleteSnapshotHookResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.DeleteSnapshotHookResponse{
+		Success: true,
+		Message: fmt.Sprintf("Snapshot hook %s deleted", req.Name),
+	}, nil
+}
+
+func (s *Server) ListSnapshotHooks(ctx context.Context, req *sdspb.ListSnapshotHooksRequest) (*sdspb.ListSnapshotHooksResponse, error) {
+	hooks, err := s.ctrl.ListSnapshotHooks(ctx, req.Resource)
+	if err != nil {
+		return &sdspb.ListSnapshotHooksResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	resp := &sdspb.ListSnapshotHooksResponse{Success: true, Message: "OK"}
+	for _, h := range hooks {
+		resp.Hooks = append(resp.Hooks, &sdspb.SnapshotHook{
+			Name:           h.Name,
+			Pre:            h.Pre,
+			Post:           h.Post,
+			TimeoutSeconds: uint32(h.Timeout / time.Second),
+			OnFailure:      h.OnFailure,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) SetEventHook(ctx context.Context, req *sdspb.SetEventHookRequest) (*sdspb.SetEventHookResponse, error) {
+	hook := &database.EventHook{Name: req.Name}
+	if req.Hook != nil {
+		hook.Events = req.Hook.Events
+		hook.URL = req.Hook.Url
+		hook.Command = req.Hook.Command
+		hook.Headers = req.Hook.Headers
+		hook.Template = req.Hook.Template
+		hook.Timeout = time.Duration(req.Hook.TimeoutSeconds) * time.Second
+	}
+	if err := s.ctrl.SetEventHook(ctx, hook); err != nil {
+		return &sdspb.SetEventHookResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.SetEventHookResponse{
+		Success: true,
+		Message: fmt.Sprintf("Event hook %s set", req.Name),
+	}, nil
+}
+
+func (s *Server) DeleteEventHook(ctx context.Context, req *sdspb.DeleteEventHookRequest) (*sdspb.DeleteEventHookResponse, error) {
+	if err := s.ctrl.DeleteEventHook(ctx, req.Name); err != nil {
+		return &sdspb.DeleteEventHookResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.DeleteEventHookResponse{
+		Success: true,
+		Message: fmt.Sprintf("Event hook %s deleted", req.Name),
+	}, nil
+}
+
+func (s *Server) ListEventHooks(ctx context.Context, req *sdspb.ListEventHooksRequest) (*sdspb.ListEventHooksResponse, error) {
+	hooks, err := s.ctrl.ListEventHooks(ctx)
+	if err != nil {
+		return &sdspb.ListEventHooksResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	resp := &sdspb.ListEventHooksResponse{Success: true, Message: fmt.Sprintf("Found %d event hook(s)", len(hooks))}
+	for _, h := range hooks {
+		resp.Hooks = append(resp.Hooks, &sdspb.EventHook{
+			Name:           h.Name,
+			Events:         h.Events,
+			Url:            h.URL,
+			Command:        h.Command,
+			Headers:        h.Headers,
+			Template:       h.Template,
+			TimeoutSeconds: uint32(h.Timeout / time.Second),
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) TestEventHook(ctx context.Context, req *sdspb.TestEventHookRequest) (*sdspb.TestEventHookResponse, error) {
+	if err := s.ctrl.TestEventHook(ctx, req.Name); err != nil {
+		return &sdspb.TestEventHookResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.TestEventHookResponse{
 		Success: true,
-		Message: "HA resource evicted successfully",
+		Message: fmt.Sprintf("Test event delivered to hook %s", req.Name),
 	}, nil
 }
 
-func (s *Server) DeleteHa(ctx context.Context, req *sdspb.DeleteHaRequest) (*sdspb.DeleteHaResponse, error) {
-	err := s.resources.RemoveHa(ctx, req.Resource)
+func (s *Server) SetReplicationPolicy(ctx context.Context, req *sdspb.SetReplicationPolicyRequest) (*sdspb.SetReplicationPolicyResponse, error) {
+	policy := &database.ReplicationPolicy{Resource: req.Resource}
+	if req.Policy != nil {
+		policy.TargetNode = req.Policy.TargetNode
+		policy.TargetPool = req.Policy.TargetPool
+		policy.Interval = time.Duration(req.Policy.IntervalSeconds) * time.Second
+		policy.Keep = int(req.Policy.Keep)
+		policy.StaleAfter = time.Duration(req.Policy.StaleAfterSeconds) * time.Second
+	}
+	warnings, err := s.ctrl.SetReplicationPolicy(ctx, policy)
 	if err != nil {
-		return &sdspb.DeleteHaResponse{
+		return &sdspb.SetReplicationPolicyResponse{
 			Success: false,
 			Message: err.Error(),
 		}, nil
 	}
-	return &sdspb.DeleteHaResponse{
-		Success: true,
-		Message: "HA configuration deleted successfully",
+	return &sdspb.SetReplicationPolicyResponse{
+		Success:  true,
+		Message:  fmt.Sprintf("Replication of %s to %s set", req.Resource, policy.TargetNode),
+		Warnings: warnings,
 	}, nil
 }
 
-func (s *Server) GetHa(ctx context.Context, req *sdspb.GetHaRequest) (*sdspb.GetHaResponse, error) {
-	haCfg, err := s.resources.GetHaConfig(ctx, req.Resource)
-	if err != nil {
-		return &sdspb.GetHaResponse{
+func (s *Server) DeleteReplicationPolicy(ctx context.Context, req *sdspb.DeleteReplicationPolicyRequest) (*sdspb.DeleteReplicationPolicyResponse, error) {
+	if err := s.ctrl.DeleteReplicationPolicy(ctx, req.Resource); err != nil {
+		return &sdspb.DeleteReplicationPolicyResponse{
 			Success: false,
 			Message: err.Error(),
 		}, nil
 	}
-
-	return &sdspb.GetHaResponse{
+	return &sdspb.DeleteReplicationPolicyResponse{
 		Success: true,
-		Message: "HA configuration found",
-		Config: &sdspb.HaConfigInfo{
-			Resource:   haCfg.Resource,
-			Vip:        haCfg.VIP,
-			MountPoint: haCfg.MountPoint,
-			FsType:     haCfg.FsType,
-			Services:   haCfg.Services,
-		},
+		Message: fmt.Sprintf("Replication of %s deleted, the replicated snapshots were kept", req.Resource),
 	}, nil
 }
 
-func (s *Server) ListHa(ctx context.Context, req *sdspb.ListHaRequest) (*sdspb.ListHaResponse, error) {
-	haConfigs, err := s.resources.ListHaConfigs(ctx)
+func (s *Server) ListReplicationPolicies(ctx context.Context, req *sdspb.ListReplicationPoliciesRequest) (*sdspb.ListReplicationPoliciesResponse, error) {
+	policies, err := s.ctrl.ListReplicationPolicies(ctx, req.Resource)
 	if err != nil {
-		return &sdspb.ListHaResponse{
+		return &sdspb.ListReplicationPoliciesResponse{
 			Success: false,
 			Message: err.Error(),
 		}, nil
 	}
-
-	var pbConfigs []*sdspb.HaConfigInfo
-	for _, cfg := range haConfigs {
-		pbConfigs = append(pbConfigs, &sdspb.HaConfigInfo{
-			Resource:   cfg.Resource,
-			Vip:        cfg.VIP,
-			MountPoint: cfg.MountPoint,
-			FsType:     cfg.FsType,
-			Services:   cfg.Services,
-		})
+	resp := &sdspb.ListReplicationPoliciesResponse{Success: true, Message: "OK"}
+	for _, policy := range policies {
+		resp.Policies = append(resp.Policies, replicationPolicyToProto(policy))
 	}
-
-	return &sdspb.ListHaResponse{
-		Success: true,
-		Message: "HA configurations listed successfully",
-		Configs: pbConfigs,
-	}, nil
+	return resp, nil
 }
 
-// ==================== SNAPSHOT OPERATIONS ====================
-
-func (s *Server) CreateSnapshot(ctx context.Context, req *sdspb.CreateSnapshotRequest) (*sdspb.CreateSnapshotResponse, error) {
-	err := s.snapshots.CreateSnapshot(ctx, req.Volume, req.SnapshotName, req.Node)
+func (s *Server) RunReplication(ctx context.Context, req *sdspb.RunReplicationRequest) (*sdspb.RunReplicationResponse, error) {
+	policy, err := s.ctrl.RunReplication(ctx, req.Resource, req.Full)
 	if err != nil {
-		return &sdspb.CreateSnapshotResponse{
+		resp := &sdspb.RunReplicationResponse{
 			Success: false,
 			Message: err.Error(),
-		}, nil
+		}
+		if policy != nil {
+			resp.Policy = replicationPolicyToProto(policy)
+		}
+		return resp, nil
 	}
-	return &sdspb.CreateSnapshotResponse{
+	return &sdspb.RunReplicationResponse{
 		Success: true,
-		Message: "Snapshot created successfully",
+		Message: fmt.Sprintf("%s replicated to %s with %s", req.Resource, policy.TargetNode, policy.LastSnapshot),
+		Policy:  replicationPolicyToProto(policy),
 	}, nil
 }
 
+func replicationPolicyToProto(policy *database.ReplicationPolicy) *sdspb.ReplicationPolicy {
+	pb := &sdspb.ReplicationPolicy{
+		Resource:          policy.Resource,
+		TargetNode:        policy.TargetNode,
+		TargetPool:        policy.TargetPool,
+		IntervalSeconds:   int64(policy.Interval / time.Second),
+		Keep:              uint32(policy.Keep),
+		StaleAfterSeconds: int64(policy.StaleAfter / time.Second),
+		SourceNode:        policy.SourceNode,
+		LastSnapshot:      policy.LastSnapshot,
+		LastError:         policy.LastError,
+		Stale:             replicationStale(policy, time.Now()),
+	}
+	if !policy.LastAttempt.IsZero() {
+		pb.LastAttempt = policy.LastAttempt.Unix()
+	}
+	if !policy.LastSuccess.IsZero() {
+		pb.LastSuccess = policy.LastSuccess.Unix()
+	}
+	return pb
+}
+
 func (s *Server) DeleteSnapshot(ctx context.Context, req *sdspb.DeleteSnapshotRequest) (*sdspb.DeleteSnapshotResponse, error) {
 	err := s.snapshots.DeleteSnapshot(ctx, req.Volume, req.SnapshotName, req.Node)
 	if err != nil {
@@ -626,7 +2972,7 @@ func (s *Server) DeleteSnapshot(ctx context.Context, req *sdspb.DeleteSnapshotRe
 }
 
 func (s *Server) RestoreSnapshot(ctx context.Context, req *sdspb.RestoreSnapshotRequest) (*sdspb.RestoreSnapshotResponse, error) {
-	err := s.snapshots.RestoreSnapshot(ctx, req.Volume, req.SnapshotName, req.Node)
+	err := s.snapshots.RestoreSnapshot(ctx, req.Volume, req.SnapshotName, req.Node, req.NoSafetySnapshot)
 	if err != nil {
 		return &sdspb.RestoreSnapshotResponse{
 			Success: false,
@@ -639,7 +2985,68 @@ func (s *Server) RestoreSnapshot(ctx context.Context, req *sdspb.RestoreSnapshot
 	}, nil
 }
 
+func (s *Server) CreateSnapshotGroup(ctx context.Context, req *sdspb.CreateSnapshotGroupRequest) (*sdspb.SnapshotGroupResponse, error) {
+	results, err := s.ctrl.CreateSnapshotGroup(ctx, req.Name, req.Resources, req.Node, req.Size, req.SkipHooks)
+	return snapshotGroupResponse(results, err, fmt.Sprintf("Snapshot group %s created", req.Name)), nil
+}
+
+func (s *Server) DeleteSnapshotGroup(ctx context.Context, req *sdspb.DeleteSnapshotGroupRequest) (*sdspb.SnapshotGroupResponse, error) {
+	results, err := s.ctrl.DeleteSnapshotGroup(ctx, req.Name)
+	return snapshotGroupResponse(results, err, fmt.Sprintf("Snapshot group %s deleted", req.Name)), nil
+}
+
+func (s *Server) RestoreSnapshotGroup(ctx context.Context, req *sdspb.RestoreSnapshotGroupRequest) (*sdspb.SnapshotGroupResponse, error) {
+	results, err := s.ctrl.RestoreSnapshotGroup(ctx, req.Name, req.NoSafetySnapshot)
+	return snapshotGroupResponse(results, err, fmt.Sprintf("Snapshot group %s restored", req.Name)), nil
+}
+
+// snapshotGroupResponse converts the outcome of a snapshot group operation,
+// with the results of each resource also when it failed on some
+func snapshotGroupResponse(results []*SnapshotGroupResult, err error, message string) *sdspb.SnapshotGroupResponse {
+	resp := &sdspb.SnapshotGroupResponse{Success: err == nil, Message: message}
+	if err != nil {
+		resp.Message = err.Error()
+	}
+	for _, result := range results {
+		resp.Results = append(resp.Results, &sdspb.SnapshotGroupResult{
+			Resource: result.Resource,
+			Snapshot: result.Snapshot,
+			Error:    result.Error,
+		})
+	}
+	return resp
+}
+
+func (s *Server) ListSnapshotGroups(ctx context.Context, req *sdspb.ListSnapshotGroupsRequest) (*sdspb.ListSnapshotGroupsResponse, error) {
+	groups, err := s.ctrl.ListSnapshotGroups(ctx)
+	if err != nil {
+		return &sdspb.ListSnapshotGroupsResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &sdspb.ListSnapshotGroupsResponse{Success: true, Message: "OK"}
+	for _, group := range groups {
+		resp.Groups = append(resp.Groups, &sdspb.SnapshotGroup{
+			Name:      group.Label,
+			Resources: group.Resources,
+			Node:      group.Node,
+			JobId:     group.Job,
+			CreatedAt: group.CreatedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
+
 func (s *Server) ListSnapshots(ctx context.Context, req *sdspb.ListSnapshotsRequest) (*sdspb.ListSnapshotsResponse, error) {
+	if !s.ctrl.volumeVisible(ctx, req.Volume) {
+		return &sdspb.ListSnapshotsResponse{
+			Success: false,
+			Message: fmt.Sprintf("resource not found: %s", req.Volume),
+		}, nil
+	}
+
 	snapshots, err := s.snapshots.ListSnapshots(ctx, req.Volume, req.Node)
 	if err != nil {
 		return &sdspb.ListSnapshotsResponse{
@@ -648,34 +3055,91 @@ func (s *Server) ListSnapshots(ctx context.Context, req *sdspb.ListSnapshotsRequ
 		}, nil
 	}
 
-	var pbSnapshots []*sdspb.SnapshotInfo
-	for _, snap := range snapshots {
-		pbSnapshots = append(pbSnapshots, &sdspb.SnapshotInfo{
-			Name:      snap.Name,
-			Volume:    snap.Volume,
-			SizeGb:    snap.SizeGB,
-			CreatedAt: snap.CreatedAt,
+	return &sdspb.ListSnapshotsResponse{
+		Success: true,
+		Message: "Snapshots listed successfully",
+		Snapshots: snapshotsToProto(snapshots),
+	}, nil
+}
+
+func (s *Server) GetSnapshotUsage(ctx context.Context, req *sdspb.GetSnapshotUsageRequest) (*sdspb.GetSnapshotUsageResponse, error) {
+	usage, err := s.snapshots.SnapshotUsage(ctx, req.Volume, req.Node)
+	if err != nil {
+		return &sdspb.GetSnapshotUsageResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	var pbUsage []*sdspb.SnapshotUsageInfo
+	for _, u := range usage {
+		pbUsage = append(pbUsage, &sdspb.SnapshotUsageInfo{
+			Name:            u.Name,
+			Volume:          u.Volume,
+			Node:            u.Node,
+			Backend:         u.Backend,
+			UsedBytes:       u.UsedBytes,
+			ReferencedBytes: u.ReferencedBytes,
+			SizeBytes:       u.SizeBytes,
+			CowUsedPercent:  u.CowUsedPercent,
+			State:           u.State,
 		})
 	}
 
-	return &sdspb.ListSnapshotsResponse{
+	return &sdspb.GetSnapshotUsageResponse{
 		Success: true,
-		Message: "Snapshots listed successfully",
-		Snapshots: pbSnapshots,
+		Message: "Snapshot usage retrieved successfully",
+		Usage:   pbUsage,
 	}, nil
 }
 
 // ==================== GATEWAY OPERATIONS ====================
 
 func (s *Server) CreateNFSGateway(ctx context.Context, req *sdspb.CreateNFSGatewayRequest) (*sdspb.CreateNFSGatewayResponse, error) {
+	// Generate gateway name from resource
+	gwName := req.Resource + "-nfs"
+
+	exportBase, clusterPrivate, err := s.ctrl.resolveGatewayPaths(ctx, gwName, req.Resource, database.GatewayTypeNFS, req.ExportBasePath, req.ClusterPrivatePath)
+	if err != nil {
+		return &sdspb.CreateNFSGatewayResponse{Success: false, Message: err.Error()}, nil
+	}
+	req.ExportBasePath, req.ClusterPrivatePath = exportBase, clusterPrivate
+
+	owner := gatewayVIPOwner(gwName)
+	dev, err := s.ctrl.checkTenantNetwork(ctx, gwName, req.Interface, req.Vlan, req.Router)
+	if err != nil {
+		return &sdspb.CreateNFSGatewayResponse{Success: false, Message: err.Error()}, nil
+	}
+	// A dry run only checks the VIP is free, it does not reserve it
+	reserveVIP := s.ctrl.ReserveVIP
+	if req.DryRun {
+		reserveVIP = s.ctrl.PreviewVIP
+	}
+	serviceIP, err := reserveVIP(ctx, req.ServiceIp, req.ServiceIpPool, owner, req.Resource, dev)
+	if err != nil {
+		return &sdspb.CreateNFSGatewayResponse{Success: false, Message: err.Error()}, nil
+	}
+	req.ServiceIp = serviceIP
+
 	nfsMgr := gateway.NewNFSManager(s.gateway)
 	resp, err := nfsMgr.CreateNFSGateway(ctx, req)
 	if err != nil {
+		if !req.DryRun {
+			s.ctrl.ReleaseVIPs(ctx, owner)
+		}
 		return resp, err
 	}
-
-	// Generate gateway name from resource
-	gwName := req.Resource + "-nfs"
+	if resp != nil {
+		resp.ServiceIp = serviceIP
+	}
+	if req.DryRun {
+		files, err := s.resources.planGatewayFiles(ctx, req.Resource, resp.Files)
+		if err != nil {
+			return &sdspb.CreateNFSGatewayResponse{Success: false, Message: err.Error()}, nil
+		}
+		resp.Files = files
+		return resp, nil
+	}
 
 	// Save to database
 	if s.ctrl.db != nil {
@@ -684,13 +3148,19 @@ func (s *Server) CreateNFSGateway(ctx context.Context, req *sdspb.CreateNFSGatew
 			Resource: req.Resource,
 			Type:     database.GatewayTypeNFS,
 			Config: map[string]interface{}{
-				"service_ip":    req.ServiceIp,
-				"export_path":   req.ExportPath,
-				"allowed_ips":   req.AllowedIps,
-				"fs_type":       req.FsType,
-				"options":       req.Options,
+				"service_ip":           req.ServiceIp,
+				"export_path":          req.ExportPath,
+				"allowed_ips":          req.AllowedIps,
+				"fs_type":              req.FsType,
+				"options":              req.Options,
+				"export_base_path":     req.ExportBasePath,
+				"cluster_private_path": req.ClusterPrivatePath,
+				"interface":            req.Interface,
+				"vlan":                 req.Vlan,
+				"router":               req.Router,
 			},
 			Status: "created",
+			Owner:  ownerFor(ctx),
 		}
 		if err := s.ctrl.db.SaveGateway(ctx, gw); err != nil {
 			s.ctrl.logger.Error("Failed to save gateway to database", zap.Error(err))
@@ -701,14 +3171,50 @@ func (s *Server) CreateNFSGateway(ctx context.Context, req *sdspb.CreateNFSGatew
 }
 
 func (s *Server) CreateISCSIGateway(ctx context.Context, req *sdspb.CreateISCSIGatewayRequest) (*sdspb.CreateISCSIGatewayResponse, error) {
+	// Generate gateway name from resource
+	gwName := req.Resource + "-iscsi"
+
+	_, clusterPrivate, err := s.ctrl.resolveGatewayPaths(ctx, gwName, req.Resource, database.GatewayTypeISCSI, "", req.ClusterPrivatePath)
+	if err != nil {
+		return &sdspb.CreateISCSIGatewayResponse{Success: false, Message: err.Error()}, nil
+	}
+	req.ClusterPrivatePath = clusterPrivate
+
+	owner := gatewayVIPOwner(gwName)
+	dev, err := s.ctrl.checkTenantNetwork(ctx, gwName, req.Interface, req.Vlan, req.Router)
+	if err != nil {
+		return &sdspb.CreateISCSIGatewayResponse{Success: false, Message: err.Error()}, nil
+	}
+	// A dry run only checks the VIP is free, it does not reserve it
+	reserveVIP := s.ctrl.ReserveVIP
+	if req.DryRun {
+		reserveVIP = s.ctrl.PreviewVIP
+	}
+	serviceIP, err := reserveVIP(ctx, req.ServiceIp, req.ServiceIpPool, owner, req.Resource, dev)
+	if err != nil {
+		return &sdspb.CreateISCSIGatewayResponse{Success: false, Message: err.Error()}, nil
+	}
+	req.ServiceIp = serviceIP
+
 	iscsiMgr := gateway.NewISCSIManager(s.gateway)
 	resp, err := iscsiMgr.CreateISCSIGateway(ctx, req)
 	if err != nil {
+		if !req.DryRun {
+			s.ctrl.ReleaseVIPs(ctx, owner)
+		}
 		return resp, err
 	}
-
-	// Generate gateway name from resource
-	gwName := req.Resource + "-iscsi"
+	if resp != nil {
+		resp.ServiceIp = serviceIP
+	}
+	if req.DryRun {
+		files, err := s.resources.planGatewayFiles(ctx, req.Resource, resp.Files)
+		if err != nil {
+			return &sdspb.CreateISCSIGatewayResponse{Success: false, Message: err.Error()}, nil
+		}
+		resp.Files = files
+		return resp, nil
+	}
 
 	// Save to database
 	if s.ctrl.db != nil {
@@ -717,15 +3223,25 @@ func (s *Server) CreateISCSIGateway(ctx context.Context, req *sdspb.CreateISCSIG
 			Resource: req.Resource,
 			Type:     database.GatewayTypeISCSI,
 			Config: map[string]interface{}{
-				"service_ip":         req.ServiceIp,
-				"iqn":                req.Iqn,
-				"allowed_initiators":  req.AllowedInitiators,
-				"username":           req.Username,
-				"password":           req.Password,
-				"implementation":     req.Implementation,
-				"options":            req.Options,
+				"service_ip":           req.ServiceIp,
+				"iqn":                  req.Iqn,
+				"allowed_initiators":   req.AllowedInitiators,
+				"username":             req.Username,
+				"password":             req.Password,
+				"implementation":       req.Implementation,
+				"options":              req.Options,
+				"cluster_private_path": req.ClusterPrivatePath,
+				"interface":            req.Interface,
+				"vlan":                 req.Vlan,
+				"router":               req.Router,
 			},
 			Status: "created",
+			Owner:  ownerFor(ctx),
+		}
+		// The CHAP password is kept in the secrets store, the record only references it
+		if err := s.ctrl.storeGatewaySecrets(ctx, gw); err != nil {
+			s.ctrl.logger.Error("Failed to store gateway credentials", zap.Error(err))
+			delete(gw.Config, "password")
 		}
 		if err := s.ctrl.db.SaveGateway(ctx, gw); err != nil {
 			s.ctrl.logger.Error("Failed to save gateway to database", zap.Error(err))
@@ -736,14 +3252,50 @@ func (s *Server) CreateISCSIGateway(ctx context.Context, req *sdspb.CreateISCSIG
 }
 
 func (s *Server) CreateNVMeGateway(ctx context.Context, req *sdspb.CreateNVMeGatewayRequest) (*sdspb.CreateNVMeGatewayResponse, error) {
+	// Generate gateway name from resource
+	gwName := req.Resource + "-nvme"
+
+	_, clusterPrivate, err := s.ctrl.resolveGatewayPaths(ctx, gwName, req.Resource, database.GatewayTypeNVMEOF, "", req.ClusterPrivatePath)
+	if err != nil {
+		return &sdspb.CreateNVMeGatewayResponse{Success: false, Message: err.Error()}, nil
+	}
+	req.ClusterPrivatePath = clusterPrivate
+
+	owner := gatewayVIPOwner(gwName)
+	dev, err := s.ctrl.checkTenantNetwork(ctx, gwName, req.Interface, req.Vlan, req.Router)
+	if err != nil {
+		return &sdspb.CreateNVMeGatewayResponse{Success: false, Message: err.Error()}, nil
+	}
+	// A dry run only checks the VIP is free, it does not reserve it
+	reserveVIP := s.ctrl.ReserveVIP
+	if req.DryRun {
+		reserveVIP = s.ctrl.PreviewVIP
+	}
+	serviceIP, err := reserveVIP(ctx, req.ServiceIp, req.ServiceIpPool, owner, req.Resource, dev)
+	if err != nil {
+		return &sdspb.CreateNVMeGatewayResponse{Success: false, Message: err.Error()}, nil
+	}
+	req.ServiceIp = serviceIP
+
 	nvmeMgr := gateway.NewNVMeManager(s.gateway)
 	resp, err := nvmeMgr.CreateNVMeGateway(ctx, req)
 	if err != nil {
+		if !req.DryRun {
+			s.ctrl.ReleaseVIPs(ctx, owner)
+		}
 		return resp, err
 	}
-
-	// Generate gateway name from resource
-	gwName := req.Resource + "-nvme"
+	if resp != nil {
+		resp.ServiceIp = serviceIP
+	}
+	if req.DryRun {
+		files, err := s.resources.planGatewayFiles(ctx, req.Resource, resp.Files)
+		if err != nil {
+			return &sdspb.CreateNVMeGatewayResponse{Success: false, Message: err.Error()}, nil
+		}
+		resp.Files = files
+		return resp, nil
+	}
 
 	// Save to database
 	if s.ctrl.db != nil {
@@ -752,12 +3304,17 @@ func (s *Server) CreateNVMeGateway(ctx context.Context, req *sdspb.CreateNVMeGat
 			Resource: req.Resource,
 			Type:     database.GatewayTypeNVMEOF,
 			Config: map[string]interface{}{
-				"service_ip":      req.ServiceIp,
-				"nqn":             req.Nqn,
-				"transport_type":  req.TransportType,
-				"options":         req.Options,
+				"service_ip":           req.ServiceIp,
+				"nqn":                  req.Nqn,
+				"transport_type":       req.TransportType,
+				"options":              req.Options,
+				"cluster_private_path": req.ClusterPrivatePath,
+				"interface":            req.Interface,
+				"vlan":                 req.Vlan,
+				"router":               req.Router,
 			},
 			Status: "created",
+			Owner:  ownerFor(ctx),
 		}
 		if err := s.ctrl.db.SaveGateway(ctx, gw); err != nil {
 			s.ctrl.logger.Error("Failed to save gateway to database", zap.Error(err))
@@ -776,9 +3333,12 @@ func (s *Server) DeleteGateway(ctx context.Context, req *sdspb.DeleteGatewayRequ
 		}, nil
 	}
 
-	// Delete from database
-	if s.ctrl.db != nil {
-		if err := s.ctrl.db.DeleteGateway(ctx, req.Id); err != nil {
+	// Delete from database, with the credentials the record references
+	if gw, err := s.ctrl.gatewayRecord(ctx, req.Id); err == nil {
+		s.ctrl.removeGatewayMounts(ctx, gw)
+		s.ctrl.deleteGatewaySecrets(ctx, gw)
+		s.ctrl.ReleaseVIPs(ctx, gatewayVIPOwner(gw.Name))
+		if err := s.ctrl.db.DeleteGateway(ctx, gw.Name); err != nil {
 			s.ctrl.logger.Error("Failed to delete gateway from database", zap.Error(err))
 		}
 	}
@@ -805,6 +3365,8 @@ func (s *Server) GetGateway(ctx context.Context, req *sdspb.GetGatewayRequest) (
 			Name:     gw.Name,
 			Type:     gw.Type,
 			Resource: gw.Resource,
+			Options:  s.gatewayOptions(ctx, gw.ID),
+			Owner:    s.gatewayOwner(ctx, gw.ID),
 		},
 	}, nil
 }
@@ -820,11 +3382,16 @@ func (s *Server) ListGateways(ctx context.Context, req *sdspb.ListGatewaysReques
 
 	var pbGateways []*sdspb.GatewayInfo
 	for _, gw := range gateways {
+		if !s.ctrl.gatewayVisible(ctx, gw.ID) {
+			continue
+		}
 		pbGateways = append(pbGateways, &sdspb.GatewayInfo{
 			Id:       gw.ID,
 			Name:     gw.Name,
 			Type:     gw.Type,
 			Resource: gw.Resource,
+			Options:  s.gatewayOptions(ctx, gw.ID),
+			Owner:    s.gatewayOwner(ctx, gw.ID),
 		})
 	}
 
@@ -835,6 +3402,23 @@ func (s *Server) ListGateways(ctx context.Context, req *sdspb.ListGatewaysReques
 	}, nil
 }
 
+// gatewayOptions returns the stored config of a gateway with credentials redacted
+func (s *Server) gatewayOptions(ctx context.Context, id string) map[string]string {
+	gw, err := s.ctrl.gatewayRecord(ctx, id)
+	if err != nil {
+		return nil
+	}
+	return redactedGatewayConfig(gw)
+}
+
+// gatewayOwner returns the principal a gateway belongs to
+func (s *Server) gatewayOwner(ctx context.Context, id string) string {
+	if gw, err := s.ctrl.gatewayRecord(ctx, id); err == nil {
+		return gw.Owner
+	}
+	return ""
+}
+
 func (s *Server) StartGateway(ctx context.Context, req *sdspb.StartGatewayRequest) (*sdspb.StartGatewayResponse, error) {
 	err := s.gateway.StartGateway(ctx, req.Id)
 	if err != nil {
@@ -863,6 +3447,141 @@ func (s *Server) StopGateway(ctx context.Context, req *sdspb.StopGatewayRequest)
 	}, nil
 }
 
+func (s *Server) ListGatewayClients(ctx context.Context, req *sdspb.ListGatewayClientsRequest) (*sdspb.ListGatewayClientsResponse, error) {
+	gateways, err := s.ctrl.ListGatewayClients(ctx, req.Gateway)
+	if err != nil {
+		return &sdspb.ListGatewayClientsResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	var pbGateways []*sdspb.GatewayClientList
+	for _, gw := range gateways {
+		pbGw := &sdspb.GatewayClientList{
+			Gateway:  gw.Gateway,
+			Type:     string(gw.Type),
+			Resource: gw.Resource,
+			Node:     gw.Node,
+			Error:    gw.Error,
+		}
+		for _, client := range gw.Clients {
+			pbGw.Clients = append(pbGw.Clients, &sdspb.GatewayClient{
+				Address: client.Address,
+				Name:    client.Name,
+				State:   client.State,
+			})
+		}
+		pbGateways = append(pbGateways, pbGw)
+	}
+	return &sdspb.ListGatewayClientsResponse{
+		Success:  true,
+		Message:  fmt.Sprintf("Found %d gateways", len(pbGateways)),
+		Gateways: pbGateways,
+	}, nil
+}
+
+// ==================== CLIENT OPERATIONS ====================
+
+func (s *Server) NVMeConnect(ctx context.Context, req *sdspb.NVMeConnectRequest) (*sdspb.NVMeConnectResponse, error) {
+	result, err := s.ctrl.NVMeConnect(ctx, req.Gateway, req.Node, req.HostNqn)
+	if err != nil {
+		return &sdspb.NVMeConnectResponse{
+			Success:   false,
+			Message:   err.Error(),
+			Initiator: initiatorToProto(result),
+		}, nil
+	}
+	return &sdspb.NVMeConnectResponse{
+		Success:   true,
+		Message:   fmt.Sprintf("Connected %s to %s", req.Node, result.Target),
+		Initiator: initiatorToProto(result),
+	}, nil
+}
+
+func (s *Server) NVMeDisconnect(ctx context.Context, req *sdspb.NVMeDisconnectRequest) (*sdspb.NVMeDisconnectResponse, error) {
+	result, err := s.ctrl.NVMeDisconnect(ctx, req.Gateway, req.Node)
+	if err != nil {
+		return &sdspb.NVMeDisconnectResponse{
+			Success:   false,
+			Message:   err.Error(),
+			Initiator: initiatorToProto(result),
+		}, nil
+	}
+	return &sdspb.NVMeDisconnectResponse{
+		Success:   true,
+		Message:   fmt.Sprintf("Disconnected %s from %s", req.Node, result.Target),
+		Initiator: initiatorToProto(result),
+	}, nil
+}
+
+func (s *Server) GetISCSIClientConfig(ctx context.Context, req *sdspb.GetISCSIClientConfigRequest) (*sdspb.GetISCSIClientConfigResponse, error) {
+	cfg, err := s.ctrl.GetISCSIClientConfig(ctx, req.Gateway)
+	if err != nil {
+		return &sdspb.GetISCSIClientConfigResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &sdspb.GetISCSIClientConfigResponse{
+		Success:       true,
+		Message:       "OK",
+		Gateway:       cfg.Gateway,
+		Iqn:           cfg.IQN,
+		Portals:       cfg.Portals,
+		MultipathConf: cfg.MultipathConf,
+		LoginScript:   cfg.LoginScript,
+	}, nil
+}
+
+func (s *Server) ValidateISCSIInitiator(ctx context.Context, req *sdspb.ValidateISCSIInitiatorRequest) (*sdspb.ValidateISCSIInitiatorResponse, error) {
+	result, err := s.ctrl.ValidateISCSIInitiator(ctx, req.Gateway, req.Node)
+	if err != nil {
+		return &sdspb.ValidateISCSIInitiatorResponse{
+			Success:   false,
+			Message:   err.Error(),
+			Initiator: initiatorToProto(result),
+		}, nil
+	}
+	return &sdspb.ValidateISCSIInitiatorResponse{
+		Success:   true,
+		Message:   fmt.Sprintf("%s can reach %s", req.Node, result.Target),
+		Initiator: initiatorToProto(result),
+	}, nil
+}
+
+func (s *Server) NFSMount(ctx context.Context, req *sdspb.NFSMountRequest) (*sdspb.NFSMountResponse, error) {
+	result, err := s.ctrl.NFSMount(ctx, req.Gateway, req.Node, req.Path, req.Soft, req.Options)
+	if err != nil {
+		return &sdspb.NFSMountResponse{
+			Success:   false,
+			Message:   err.Error(),
+			Initiator: initiatorToProto(result),
+		}, nil
+	}
+	return &sdspb.NFSMountResponse{
+		Success:   true,
+		Message:   fmt.Sprintf("Mounted %s on %s:%s", result.Target, req.Node, req.Path),
+		Initiator: initiatorToProto(result),
+	}, nil
+}
+
+// initiatorToProto converts an initiator helper result, which may be nil
+func initiatorToProto(result *InitiatorResult) *sdspb.InitiatorInfo {
+	if result == nil {
+		return nil
+	}
+	return &sdspb.InitiatorInfo{
+		Gateway:  result.Gateway,
+		Node:     result.Node,
+		Target:   result.Target,
+		Portals:  result.Portals,
+		Devices:  result.Devices,
+		Unit:     result.Unit,
+		Warnings: result.Warnings,
+	}
+}
+
 // ==================== ZFS POOL OPERATIONS ====================
 
 func (s *Server) CreateZFSPool(ctx context.Context, req *sdspb.CreateZFSPoolRequest) (*sdspb.CreateZFSPoolResponse, error) {
@@ -980,10 +3699,32 @@ func (s *Server) DeleteZFSDataset(ctx context.Context, req *sdspb.DeleteZFSDatas
 	}, nil
 }
 
+// snapshotsToProto converts snapshot infos to their protobuf form
+func snapshotsToProto(snapshots []*SnapshotInfo) []*sdspb.SnapshotInfo {
+	var pbSnapshots []*sdspb.SnapshotInfo
+	for _, snap := range snapshots {
+		pbSnapshots = append(pbSnapshots, &sdspb.SnapshotInfo{
+			Name:      snap.Name,
+			Volume:    snap.Volume,
+			SizeGb:    snap.SizeGB,
+			CreatedAt: snap.CreatedAt,
+			Node:      snap.Node,
+		})
+	}
+	return pbSnapshots
+}
+
 // ==================== ZFS SNAPSHOT OPERATIONS ====================
+// Deprecated backend-specific shims over SnapshotManager
+
+// zfsSnapshotTarget builds a snapshot target from a ZFS dataset path
+func zfsSnapshotTarget(dataset, node string) *SnapshotTarget {
+	pool, volume, _ := strings.Cut(dataset, "/")
+	return &SnapshotTarget{Backend: SnapshotBackendZFS, Pool: pool, Volume: volume, Node: node}
+}
 
 func (s *Server) CreateZFSSnapshot(ctx context.Context, req *sdspb.CreateZFSSnapshotRequest) (*sdspb.CreateZFSSnapshotResponse, error) {
-	err := s.storage.ZFSSnapshot(ctx, req.Dataset, req.SnapshotName, req.Node)
+	err := s.snapshots.CreateSnapshotOn(ctx, zfsSnapshotTarget(req.Dataset, req.Node), req.SnapshotName, "")
 	if err != nil {
 		return &sdspb.CreateZFSSnapshotResponse{
 			Success: false,
@@ -997,7 +3738,8 @@ func (s *Server) CreateZFSSnapshot(ctx context.Context, req *sdspb.CreateZFSSnap
 }
 
 func (s *Server) DeleteZFSSnapshot(ctx context.Context, req *sdspb.DeleteZFSSnapshotRequest) (*sdspb.DeleteZFSSnapshotResponse, error) {
-	err := s.storage.ZFSDeleteSnapshot(ctx, req.Snapshot, req.Node)
+	dataset, name, _ := strings.Cut(req.Snapshot, "@")
+	err := s.snapshots.DeleteSnapshotOn(ctx, zfsSnapshotTarget(dataset, req.Node), name)
 	if err != nil {
 		return &sdspb.DeleteZFSSnapshotResponse{
 			Success: false,
@@ -1011,7 +3753,7 @@ func (s *Server) DeleteZFSSnapshot(ctx context.Context, req *sdspb.DeleteZFSSnap
 }
 
 func (s *Server) ListZFSSnapshots(ctx context.Context, req *sdspb.ListZFSSnapshotsRequest) (*sdspb.ListZFSSnapshotsResponse, error) {
-	snapshots, err := s.storage.ZFSListSnapshots(ctx, req.Dataset, req.Node)
+	snapshots, err := s.snapshots.ListSnapshotsOn(ctx, zfsSnapshotTarget(req.Dataset, req.Node))
 	if err != nil {
 		return &sdspb.ListZFSSnapshotsResponse{
 			Success: false,
@@ -1019,25 +3761,15 @@ func (s *Server) ListZFSSnapshots(ctx context.Context, req *sdspb.ListZFSSnapsho
 		}, nil
 	}
 
-	var pbSnapshots []*sdspb.SnapshotInfo
-	for _, snap := range snapshots {
-		pbSnapshots = append(pbSnapshots, &sdspb.SnapshotInfo{
-			Name:      snap.Name,
-			Volume:    snap.Volume,
-			SizeGb:    snap.SizeGB,
-			CreatedAt: snap.CreatedAt,
-		})
-	}
-
 	return &sdspb.ListZFSSnapshotsResponse{
 		Success: true,
 		Message: "ZFS snapshots listed successfully",
-		Snapshots: pbSnapshots,
+		Snapshots: snapshotsToProto(snapshots),
 	}, nil
 }
 
 func (s *Server) RestoreZFSSnapshot(ctx context.Context, req *sdspb.RestoreZFSSnapshotRequest) (*sdspb.RestoreZFSSnapshotResponse, error) {
-	err := s.storage.ZFSRestoreSnapshot(ctx, req.Dataset, req.SnapshotName, req.Node)
+	err := s.snapshots.RestoreSnapshotOn(ctx, zfsSnapshotTarget(req.Dataset, req.Node), req.SnapshotName)
 	if err != nil {
 		return &sdspb.RestoreZFSSnapshotResponse{
 			Success: false,
@@ -1065,9 +3797,13 @@ func (s *Server) CloneZFSSnapshot(ctx context.Context, req *sdspb.CloneZFSSnapsh
 }
 
 // ==================== LVM SNAPSHOT OPERATIONS ====================
+// Deprecated backend-specific shims over SnapshotManager.
+// As before, the resource field of create and the lv_name field of the
+// other requests carry the volume group name.
 
 func (s *Server) CreateLvmSnapshot(ctx context.Context, req *sdspb.CreateLvmSnapshotRequest) (*sdspb.CreateLvmSnapshotResponse, error) {
-	err := s.storage.CreateLvmSnapshot(ctx, req.Resource, req.LvName, req.SnapshotName, req.Node, req.Size)
+	target := &SnapshotTarget{Backend: SnapshotBackendLVM, Pool: req.Resource, Volume: req.LvName, Node: req.Node}
+	err := s.snapshots.CreateSnapshotOn(ctx, target, req.SnapshotName, req.Size)
 	if err != nil {
 		return &sdspb.CreateLvmSnapshotResponse{
 			Success: false,
@@ -1081,7 +3817,8 @@ func (s *Server) CreateLvmSnapshot(ctx context.Context, req *sdspb.CreateLvmSnap
 }
 
 func (s *Server) DeleteLvmSnapshot(ctx context.Context, req *sdspb.DeleteLvmSnapshotRequest) (*sdspb.DeleteLvmSnapshotResponse, error) {
-	err := s.storage.DeleteLvmSnapshot(ctx, req.LvName, req.SnapshotName, req.Node)
+	target := &SnapshotTarget{Backend: SnapshotBackendLVM, Pool: req.LvName, Node: req.Node}
+	err := s.snapshots.DeleteSnapshotOn(ctx, target, req.SnapshotName)
 	if err != nil {
 		return &sdspb.DeleteLvmSnapshotResponse{
 			Success: false,
@@ -1095,7 +3832,8 @@ func (s *Server) DeleteLvmSnapshot(ctx context.Context, req *sdspb.DeleteLvmSnap
 }
 
 func (s *Server) ListLvmSnapshots(ctx context.Context, req *sdspb.ListLvmSnapshotsRequest) (*sdspb.ListLvmSnapshotsResponse, error) {
-	snapshots, err := s.storage.ListLvmSnapshots(ctx, req.LvName, req.Node)
+	target := &SnapshotTarget{Backend: SnapshotBackendLVM, Pool: req.LvName, Node: req.Node}
+	snapshots, err := s.snapshots.ListSnapshotsOn(ctx, target)
 	if err != nil {
 		return &sdspb.ListLvmSnapshotsResponse{
 			Success:  false,
@@ -1103,25 +3841,16 @@ func (s *Server) ListLvmSnapshots(ctx context.Context, req *sdspb.ListLvmSnapsho
 			Snapshots: nil,
 		}, nil
 	}
-	// Convert to proto SnapshotInfo
-	var protoSnapshots []*sdspb.SnapshotInfo
-	for _, snap := range snapshots {
-		protoSnapshots = append(protoSnapshots, &sdspb.SnapshotInfo{
-			Name:      snap.Name,
-			Volume:    snap.Volume,
-			SizeGb:    snap.SizeGB,
-			CreatedAt: snap.CreatedAt,
-		})
-	}
 	return &sdspb.ListLvmSnapshotsResponse{
 		Success:  true,
 		Message:  "LVM snapshots listed successfully",
-		Snapshots: protoSnapshots,
+		Snapshots: snapshotsToProto(snapshots),
 	}, nil
 }
 
 func (s *Server) RestoreLvmSnapshot(ctx context.Context, req *sdspb.RestoreLvmSnapshotRequest) (*sdspb.RestoreLvmSnapshotResponse, error) {
-	err := s.storage.RestoreLvmSnapshot(ctx, req.LvName, req.SnapshotName, req.Node)
+	target := &SnapshotTarget{Backend: SnapshotBackendLVM, Pool: req.LvName, Node: req.Node}
+	err := s.snapshots.RestoreSnapshotOn(ctx, target, req.SnapshotName)
 	if err != nil {
 		return &sdspb.RestoreLvmSnapshotResponse{
 			Success: false,
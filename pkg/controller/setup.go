@@ -0,0 +1,319 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+)
+
+// Steps of the first boot setup, in the order the web UI walks through them
+const (
+	SetupStepNodes        = "nodes"        // Two or more nodes are registered
+	SetupStepConnectivity = "connectivity" // A network probe reached every pair of nodes
+	SetupStepPools        = "pools"        // Every node has a storage pool
+	SetupStepResource     = "resource"     // A resource exists
+	SetupStepHa           = "ha"           // A resource is highly available
+)
+
+// setupMinNodes is how many nodes replicate the first resource
+const setupMinNodes = 2
+
+// SetupStep is a step of the first boot setup
+type SetupStep struct {
+	Name   string
+	Done   bool
+	Detail string
+}
+
+// SetupStatus is how far the cluster got through the first boot setup
+type SetupStatus struct {
+	Steps       []*SetupStep
+	Next        string // First step not done, empty when all are
+	Completed   bool   // All steps are done, or the setup was finished or skipped
+	CompletedAt time.Time
+}
+
+// GetSetupStatus derives the steps of the first boot setup from the state
+// of the cluster, so the setup picks up where it was left, also after work
+// done with the CLI
+func (c *Controller) GetSetupStatus(ctx context.Context) (*SetupStatus, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	nodes, err := c.nodes.ListNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+
+	status := &SetupStatus{}
+	add := func(name string, done bool, detail string) {
+		status.Steps = append(status.Steps, &SetupStep{Name: name, Done: done, Detail: detail})
+		if !done && status.Next == "" {
+			status.Next = name
+		}
+	}
+
+	add(SetupStepNodes, len(nodes) >= setupMinNodes, fmt.Sprintf("%d of %d nodes registered", len(nodes), setupMinNodes))
+
+	probes, err := c.db.ListNetProbes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reached := make(map[string]bool)
+	for _, probe := range probes {
+		if probe.Error == "" {
+			reached[linkKey(probe.Source, probe.Target)] = true
+		}
+	}
+	var unprobed []string
+	for i := range nodes {
+		for j := i + 1; j < len(nodes); j++ {
+			if !reached[linkKey(nodes[i].Name, nodes[j].Name)] {
+				unprobed = append(unprobed, nodes[i].Name+"-"+nodes[j].Name)
+			}
+		}
+	}
+	detail := "every pair of nodes reached"
+	if len(unprobed) > 0 {
+		detail = "not probed or unreachable: " + strings.Join(unprobed, ", ")
+	}
+	add(SetupStepConnectivity, len(nodes) >= setupMinNodes && len(unprobed) == 0, detail)
+
+	pools, err := c.db.ListPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pooled := make(map[string]bool)
+	for _, pool := range pools {
+		pooled[pool.Node] = true
+	}
+	var poolless []string
+	for _, node := range nodes {
+		if !pooled[node.Name] && !pooled[node.Address] {
+			poolless = append(poolless, node.Name)
+		}
+	}
+	detail = fmt.Sprintf("%d pools", len(pools))
+	if len(poolless) > 0 {
+		detail = "no pool on " + strings.Join(poolless, ", ")
+	}
+	add(SetupStepPools, len(nodes) > 0 && len(poolless) == 0, detail)
+
+	resources, err := c.db.ListResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	add(SetupStepResource, len(resources) > 0, fmt.Sprintf("%d resources", len(resources)))
+
+	haConfigs, err := c.db.ListHaConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	add(SetupStepHa, len(haConfigs) > 0, fmt.Sprintf("%d HA resources", len(haConfigs)))
+
+	state, err := c.db.GetSetupState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status.CompletedAt = state.CompletedAt
+	status.Completed = status.Next == "" || !state.CompletedAt.IsZero()
+	return status, nil
+}
+
+// CompleteSetup records that the first boot setup was finished or skipped,
+// so the web UI no longer offers it
+func (c *Controller) CompleteSetup(ctx context.Context) (*database.SetupState, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	state := &database.SetupState{CompletedAt: time.Now()}
+	if err := c.db.SaveSetupState(ctx, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// SetupCheck is a check of a host before it is registered as a node
+type SetupCheck struct {
+	Name   string // ssh, sudo, hostname, drbd, drbd-reactor or storage
+	Passed bool
+	Detail string
+}
+
+// TestNodeConnection checks that a host can be registered as a node: the
+// controller reaches it over SSH, may run sudo without a password, its
+// uname -n matches the node name, and DRBD, drbd-reactor and LVM or ZFS are
+// installed. Nothing is changed on the host.
+func (c *Controller) TestNodeConnection(ctx context.Context, name, address string) ([]*SetupCheck, error) {
+	if c.deployment == nil {
+		return nil, fmt.Errorf("deployment client not set")
+	}
+	if address == "" {
+		return nil, fmt.Errorf("address is required")
+	}
+
+	var checks []*SetupCheck
+	add := func(check string, err error, detail string) {
+		result := &SetupCheck{Name: check, Passed: err == nil, Detail: detail}
+		if err != nil {
+			result.Detail = err.Error()
+		}
+		checks = append(checks, result)
+	}
+
+	// Nothing else can be checked without SSH, e.g. when the host key
+	// must be confirmed with TrustNode first
+	if _, err := c.execOutput(ctx, address, "true"); err != nil {
+		add("ssh", err, "")
+		return checks, nil
+	}
+	add("ssh", nil, "reachable as "+address)
+
+	if _, err := c.execOutput(ctx, address, "sudo -n true"); err != nil {
+		add("sudo", fmt.Errorf("passwordless sudo is required: %v", err), "")
+	} else {
+		add("sudo", nil, "passwordless")
+	}
+
+	if name != "" {
+		uname, err := c.nodeUname(ctx, address)
+		if err == nil && uname != name {
+			err = hostnameMismatchError(name, address, uname)
+		}
+		add("hostname", err, uname)
+	}
+
+	output, err := c.execOutput(ctx, address, componentVersionsCmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read component versions: %w", err)
+	}
+	versions := parseComponentVersions(output)
+
+	if versions.DrbdKernel == "" || versions.DrbdUtils == "" {
+		add("drbd", fmt.Errorf("DRBD 9 kernel module and drbd-utils are required, found module %q and utils %q",
+			versions.DrbdKernel, versions.DrbdUtils), "")
+	} else if warnings := c.DrbdVersionWarnings(versions.DrbdKernel, versions.DrbdUtils); len(warnings) > 0 {
+		add("drbd", fmt.Errorf("%s", strings.Join(warnings, "; ")), "")
+	} else {
+		add("drbd", nil, fmt.Sprintf("kernel module %s, utils %s", versions.DrbdKernel, versions.DrbdUtils))
+	}
+
+	if versions.DrbdReactor == "" {
+		add("drbd-reactor", fmt.Errorf("drbd-reactor is required for HA resources and gateways"), "")
+	} else {
+		add("drbd-reactor", nil, versions.DrbdReactor)
+	}
+
+	switch {
+	case versions.Lvm != "" && versions.Zfs != "":
+		add("storage", nil, fmt.Sprintf("LVM %s, ZFS %s", versions.Lvm, versions.Zfs))
+	case versions.Lvm != "":
+		add("storage", nil, "LVM "+versions.Lvm)
+	case versions.Zfs != "":
+		add("storage", nil, "ZFS "+versions.Zfs)
+	default:
+		add("storage", fmt.Errorf("LVM or ZFS is required for storage pools"), "")
+	}
+
+	return checks, nil
+}
+
+// diskDiscoverCmd lists the block devices of a node with their holders and
+// signatures as KEY="value" pairs
+const diskDiscoverCmd = "sudo lsblk -bnpPo NAME,SIZE,TYPE,ROTA,RO,MODEL,FSTYPE,MOUNTPOINT,PKNAME"
+
+// lsblkPairPattern matches a KEY="value" pair of lsblk -P
+var lsblkPairPattern = regexp.MustCompile(`([A-Z:-]+)="([^"]*)"`)
+
+// DiscoveredDisk is a disk of a node and whether a pool can be created on it
+type DiscoveredDisk struct {
+	Path       string
+	SizeBytes  uint64
+	Model      string
+	Rotational bool
+	Available  bool
+	Reason     string // Why the disk is not available, e.g. "has partitions"
+}
+
+// DiscoverDisks lists the disks of a node for creating pools. Disks with
+// partitions, a filesystem, RAID, LVM or ZFS signature, a mount or a holder,
+// and read-only disks are listed as unavailable.
+func (c *Controller) DiscoverDisks(ctx context.Context, node string) ([]*DiscoveredDisk, error) {
+	address := c.nodes.GetNodeAddressByName(node)
+	if address == "" {
+		address = c.ResolveHost(node)
+	}
+	output, err := c.execOutput(ctx, address, diskDiscoverCmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list disks on %s: %w", node, err)
+	}
+	return parseDiscoveredDisks(output), nil
+}
+
+// parseDiscoveredDisks parses the output of diskDiscoverCmd
+func parseDiscoveredDisks(output string) []*DiscoveredDisk {
+	var disks []*DiscoveredDisk
+	byPath := make(map[string]*DiscoveredDisk)
+	children := make(map[string][]map[string]string)
+	for _, line := range strings.Split(output, "\n") {
+		fields := make(map[string]string)
+		for _, match := range lsblkPairPattern.FindAllStringSubmatch(line, -1) {
+			fields[match[1]] = match[2]
+		}
+		if fields["NAME"] == "" {
+			continue
+		}
+		if parent := fields["PKNAME"]; parent != "" {
+			children[parent] = append(children[parent], fields)
+		}
+		if fields["TYPE"] != "disk" {
+			continue
+		}
+
+		size, _ := strconv.ParseUint(fields["SIZE"], 10, 64)
+		disk := &DiscoveredDisk{
+			Path:       fields["NAME"],
+			SizeBytes:  size,
+			Model:      strings.TrimSpace(fields["MODEL"]),
+			Rotational: fields["ROTA"] == "1",
+			Available:  true,
+		}
+		switch {
+		case size == 0:
+			disk.Reason = "empty"
+		case fields["RO"] == "1":
+			disk.Reason = "read-only"
+		case fields["MOUNTPOINT"] != "":
+			disk.Reason = "mounted at " + fields["MOUNTPOINT"]
+		case fields["FSTYPE"] != "":
+			disk.Reason = "has a " + fields["FSTYPE"] + " signature"
+		}
+		disks = append(disks, disk)
+		byPath[disk.Path] = disk
+	}
+
+	for parent, held := range children {
+		disk := byPath[parent]
+		if disk == nil || disk.Reason != "" {
+			continue
+		}
+		switch held[0]["TYPE"] {
+		case "part":
+			disk.Reason = "has partitions"
+		default:
+			disk.Reason = "in use by " + held[0]["NAME"]
+		}
+	}
+	for _, disk := range disks {
+		disk.Available = disk.Reason == ""
+	}
+	sort.Slice(disks, func(i, j int) bool { return disks[i].Path < disks[j].Path })
+	return disks
+}
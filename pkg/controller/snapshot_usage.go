@@ -0,0 +1,272 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// Snapshot usage states
+const (
+	SnapshotUsageOK       = "ok"
+	SnapshotUsageWarning  = "warning"
+	SnapshotUsageCritical = "critical"
+	SnapshotUsageInvalid  = "invalid"
+)
+
+// COW allocation thresholds of thick LVM snapshots. LVM invalidates a
+// snapshot silently once its COW volume is 100% allocated.
+const (
+	snapshotCowWarnPercent     = 80.0
+	snapshotCowCriticalPercent = 95.0
+)
+
+// SnapshotUsage is the space accounting of one snapshot on one node
+type SnapshotUsage struct {
+	Name    string
+	Volume  string
+	Node    string
+	Backend string
+
+	// UsedBytes is the space unique to the snapshot: ZFS used, or the
+	// allocated part of the COW volume for LVM
+	UsedBytes uint64
+
+	// ReferencedBytes is the data the snapshot refers to: ZFS refer, or the
+	// origin size for LVM
+	ReferencedBytes uint64
+
+	// SizeBytes is the COW volume size of thick LVM snapshots, 0 otherwise
+	SizeBytes uint64
+
+	// CowUsedPercent is the allocated percentage of the COW volume of thick LVM snapshots
+	CowUsedPercent float64
+
+	State string
+}
+
+// SnapshotUsage reports per-snapshot space usage of a resource or backing
+// volume, aggregated across nodes. Thick LVM snapshots whose COW volume is
+// nearly full or already invalidated are recorded in the events log.
+func (sm *SnapshotManager) SnapshotUsage(ctx context.Context, volume, node string) ([]*SnapshotUsage, error) {
+	targets, err := sm.ResolveTargets(ctx, volume, node)
+	if err != nil {
+		return nil, err
+	}
+
+	var usage []*SnapshotUsage
+	for _, t := range targets {
+		u, err := sm.SnapshotUsageOn(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		usage = append(usage, u...)
+	}
+
+	for _, u := range usage {
+		sm.alertSnapshotUsage(ctx, volume, u)
+	}
+
+	return usage, nil
+}
+
+// SnapshotUsageOn reports the space usage of the snapshots of a single target
+func (sm *SnapshotManager) SnapshotUsageOn(ctx context.Context, t *SnapshotTarget) ([]*SnapshotUsage, error) {
+	var usage []*SnapshotUsage
+	var err error
+
+	switch t.Backend {
+	case SnapshotBackendZFS:
+		usage, err = sm.zfsSnapshotUsage(ctx, t)
+	default:
+		usage, err = sm.lvmSnapshotUsage(ctx, t)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, u := range usage {
+		u.Node = t.Node
+		u.Backend = t.Backend
+	}
+	return usage, nil
+}
+
+// zfsSnapshotUsage reads used and referenced space of the snapshots of a zvol
+func (sm *SnapshotManager) zfsSnapshotUsage(ctx context.Context, t *SnapshotTarget) ([]*SnapshotUsage, error) {
+	cmd := fmt.Sprintf("sudo zfs list -Hp -t snapshot -r -d 1 -o name,used,refer %s", t.Path())
+	output, err := sm.exec(ctx, t.Node, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot usage of %s: %w", t.Path(), err)
+	}
+
+	var usage []*SnapshotUsage
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.Split(line, "\t")
+		if len(fields) < 3 {
+			continue
+		}
+		dataset, name, ok := strings.Cut(fields[0], "@")
+		if !ok {
+			continue
+		}
+
+		used, _ := strconv.ParseUint(strings.TrimSpace(fields[1]), 10, 64)
+		refer, _ := strconv.ParseUint(strings.TrimSpace(fields[2]), 10, 64)
+		usage = append(usage, &SnapshotUsage{
+			Name:            name,
+			Volume:          dataset,
+			UsedBytes:       used,
+			ReferencedBytes: refer,
+			State:           SnapshotUsageOK,
+		})
+	}
+
+	return usage, nil
+}
+
+// lvmSnapshotUsage reads COW allocation of the LVM snapshots of a logical volume.
+// Thin snapshots have no COW volume; their mapped data is reported as used.
+func (sm *SnapshotManager) lvmSnapshotUsage(ctx context.Context, t *SnapshotTarget) ([]*SnapshotUsage, error) {
+	cmd := fmt.Sprintf("sudo lvs --noheadings --separator '|' --units b --nosuffix -S lv_role=snapshot -o lv_name,origin,lv_attr,lv_size,origin_size,data_percent %s", t.Pool)
+	output, err := sm.exec(ctx, t.Node, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot usage of %s: %w", t.Path(), err)
+	}
+
+	var usage []*SnapshotUsage
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.Split(line, "|")
+		if len(fields) < 6 {
+			continue
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+
+		origin := fields[1]
+		if t.Volume != "" && origin != t.Volume {
+			continue
+		}
+
+		size, _ := strconv.ParseUint(fields[3], 10, 64)
+		originSize, _ := strconv.ParseUint(fields[4], 10, 64)
+		percent, _ := strconv.ParseFloat(fields[5], 64)
+
+		u := &SnapshotUsage{
+			Name:            fields[0],
+			Volume:          fmt.Sprintf("%s/%s", t.Pool, origin),
+			ReferencedBytes: originSize,
+			State:           SnapshotUsageOK,
+		}
+
+		attr := fields[2]
+		switch {
+		case strings.HasPrefix(attr, "V"):
+			// Thin snapshot: data_percent is the mapped part of its virtual size
+			u.UsedBytes = uint64(float64(size) * percent / 100)
+		case strings.HasPrefix(attr, "S") || (len(attr) > 4 && attr[4] == 'I'):
+			u.SizeBytes = size
+			u.UsedBytes = size
+			u.CowUsedPercent = 100
+			u.State = SnapshotUsageInvalid
+		default:
+			u.SizeBytes = size
+			u.UsedBytes = uint64(float64(size) * percent / 100)
+			u.CowUsedPercent = percent
+			u.State = snapshotCowState(percent)
+		}
+
+		usage = append(usage, u)
+	}
+
+	return usage, nil
+}
+
+// alertSnapshotUsage records an event when a thick LVM snapshot crosses into
+// a worse state than last reported. Each state is reported once per snapshot.
+func (sm *SnapshotManager) alertSnapshotUsage(ctx context.Context, resource string, u *SnapshotUsage) {
+	key := fmt.Sprintf("%s@%s@%s", u.Volume, u.Name, u.Node)
+
+	sm.mu.Lock()
+	previous := sm.usageAlerts[key]
+	if u.State == SnapshotUsageOK {
+		delete(sm.usageAlerts, key)
+	} else if snapshotStateRank(u.State) > snapshotStateRank(previous) {
+		sm.usageAlerts[key] = u.State
+	}
+	sm.mu.Unlock()
+
+	if snapshotStateRank(u.State) <= snapshotStateRank(previous) || u.State == SnapshotUsageOK {
+		return
+	}
+
+	details := map[string]string{
+		"snapshot":         u.Name,
+		"volume":           u.Volume,
+		"node":             u.Node,
+		"cow_used_percent": strconv.FormatFloat(u.CowUsedPercent, 'f', 1, 64),
+	}
+
+	if u.State == SnapshotUsageInvalid {
+		sm.controller.RecordEvent(ctx, EventSnapshotInvalid, resource,
+			fmt.Sprintf("LVM snapshot %s of %s on %s is invalid, its COW volume overflowed", u.Name, u.Volume, u.Node), details)
+		return
+	}
+
+	sm.controller.logger.Warn("LVM snapshot COW volume filling up",
+		zap.String("snapshot", u.Name),
+		zap.String("volume", u.Volume),
+		zap.String("node", u.Node),
+		zap.Float64("cow_used_percent", u.CowUsedPercent))
+	sm.controller.RecordEvent(ctx, EventSnapshotCowFilling, resource,
+		fmt.Sprintf("LVM snapshot %s of %s on %s is %.1f%% full and will be invalidated at 100%%", u.Name, u.Volume, u.Node, u.CowUsedPercent), details)
+}
+
+// exec runs a command on a node and returns its output
+func (sm *SnapshotManager) exec(ctx context.Context, node, cmd string) (string, error) {
+	result, err := sm.controller.deployment.Exec(ctx, []string{sm.address(node)}, cmd)
+	if err != nil {
+		return "", err
+	}
+	if !result.AllSuccess() {
+		return "", fmt.Errorf("command failed on hosts: %v", result.FailedHosts())
+	}
+
+	var output strings.Builder
+	for _, r := range result.Hosts {
+		output.WriteString(r.Output)
+	}
+	return output.String(), nil
+}
+
+// snapshotCowState classifies the COW allocation of a thick LVM snapshot
+func snapshotCowState(percent float64) string {
+	switch {
+	case percent >= 100:
+		return SnapshotUsageInvalid
+	case percent >= snapshotCowCriticalPercent:
+		return SnapshotUsageCritical
+	case percent >= snapshotCowWarnPercent:
+		return SnapshotUsageWarning
+	default:
+		return SnapshotUsageOK
+	}
+}
+
+// snapshotStateRank orders snapshot usage states by severity
+func snapshotStateRank(state string) int {
+	switch state {
+	case SnapshotUsageWarning:
+		return 1
+	case SnapshotUsageCritical:
+		return 2
+	case SnapshotUsageInvalid:
+		return 3
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,245 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// snapshotGroupParallelism is how many resources of a snapshot group are
+// worked on at the same time
+const snapshotGroupParallelism = 8
+
+// snapshotLabelPattern matches labels that are valid in LVM and ZFS
+// snapshot names
+var snapshotLabelPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.+-]*$`)
+
+// SnapshotGroupResult is the outcome of a snapshot group operation on one
+// resource
+type SnapshotGroupResult struct {
+	Resource string
+	Snapshot string
+	Error    string
+}
+
+// groupSnapshotName names the snapshot of a resource in a snapshot group.
+// LVM snapshot names are unique per volume group, resources sharing a pool
+// cannot all use the label.
+func groupSnapshotName(resource, label string) string {
+	return resource + "_" + label
+}
+
+// CreateSnapshotGroup snapshots resources in parallel under a shared label,
+// on node or on all nodes of each resource. The resources that got their
+// snapshot are recorded under the label, also when others failed, so the
+// group can be deleted or restored as a whole.
+func (c *Controller) CreateSnapshotGroup(ctx context.Context, label string, resources []string, node, size string, skipHooks bool) ([]*SnapshotGroupResult, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	if !snapshotLabelPattern.MatchString(label) {
+		return nil, fmt.Errorf("invalid label %q: use letters, digits and _.+-", label)
+	}
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("at least one resource is required")
+	}
+	if _, err := c.db.GetSnapshotGroup(ctx, label); err == nil {
+		return nil, fmt.Errorf("snapshot group %s already exists, delete it first", label)
+	}
+
+	seen := make(map[string]bool)
+	for _, resource := range resources {
+		if seen[resource] {
+			return nil, fmt.Errorf("resource %s is listed twice", resource)
+		}
+		seen[resource] = true
+		if _, err := c.db.GetResource(ctx, resource); err != nil || !c.resourceVisible(ctx, resource) {
+			return nil, fmt.Errorf("resource not found: %s", resource)
+		}
+	}
+
+	c.logger.Info("Creating snapshot group",
+		zap.String("label", label),
+		zap.Strings("resources", resources),
+		zap.String("node", node))
+
+	results := runSnapshotGroup(resources, label, func(resource, snapshot string) error {
+		return c.snapshots.CreateSnapshot(ctx, resource, snapshot, node, size, skipHooks)
+	})
+
+	group := &database.SnapshotGroup{Label: label, Node: node}
+	if recorder, ok := ctx.Value(journalKey{}).(*jobRecorder); ok {
+		group.Job = recorder.job.ID
+	}
+	for _, result := range results {
+		if result.Error == "" {
+			group.Resources = append(group.Resources, result.Resource)
+		}
+	}
+	if err := c.db.SaveSnapshotGroup(ctx, group); err != nil {
+		return results, fmt.Errorf("snapshots taken, but failed to save snapshot group: %w", err)
+	}
+	return results, groupError("snapshot", results)
+}
+
+// DeleteSnapshotGroup deletes the snapshots of a snapshot group in parallel.
+// Resources whose snapshot could not be deleted stay in the group.
+func (c *Controller) DeleteSnapshotGroup(ctx context.Context, label string) ([]*SnapshotGroupResult, error) {
+	group, err := c.snapshotGroup(ctx, label)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Info("Deleting snapshot group",
+		zap.String("label", label),
+		zap.Strings("resources", group.Resources))
+
+	results := runSnapshotGroup(group.Resources, label, func(resource, snapshot string) error {
+		return c.snapshots.DeleteSnapshot(ctx, resource, snapshot, group.Node)
+	})
+
+	group.Resources = nil
+	for _, result := range results {
+		if result.Error != "" {
+			group.Resources = append(group.Resources, result.Resource)
+		}
+	}
+	if err := c.db.SaveSnapshotGroup(ctx, group); err != nil {
+		return results, fmt.Errorf("failed to save snapshot group: %w", err)
+	}
+	return results, groupError("delete", results)
+}
+
+// RestoreSnapshotGroup restores the snapshots of a snapshot group in
+// parallel. LVM snapshots are merged into their origin and leave the group,
+// ZFS snapshots stay.
+func (c *Controller) RestoreSnapshotGroup(ctx context.Context, label string, skipSafety bool) ([]*SnapshotGroupResult, error) {
+	group, err := c.snapshotGroup(ctx, label)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Info("Restoring snapshot group",
+		zap.String("label", label),
+		zap.Strings("resources", group.Resources))
+
+	var mu sync.Mutex
+	merged := make(map[string]bool)
+	results := runSnapshotGroup(group.Resources, label, func(resource, snapshot string) error {
+		targets, err := c.snapshots.ResolveTargets(ctx, resource, group.Node)
+		if err != nil {
+			return err
+		}
+		if err := c.snapshots.RestoreSnapshot(ctx, resource, snapshot, group.Node, skipSafety); err != nil {
+			return err
+		}
+		for _, t := range targets {
+			if t.Backend == SnapshotBackendLVM {
+				mu.Lock()
+				merged[resource] = true
+				mu.Unlock()
+			}
+		}
+		return nil
+	})
+
+	var kept []string
+	for _, resource := range group.Resources {
+		if !merged[resource] {
+			kept = append(kept, resource)
+		}
+	}
+	group.Resources = kept
+	if err := c.db.SaveSnapshotGroup(ctx, group); err != nil {
+		return results, fmt.Errorf("failed to save snapshot group: %w", err)
+	}
+	return results, groupError("restore", results)
+}
+
+// ListSnapshotGroups lists the snapshot groups by label, as far as the
+// caller may see all of their resources
+func (c *Controller) ListSnapshotGroups(ctx context.Context) ([]*database.SnapshotGroup, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	groups, err := c.db.ListSnapshotGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var visible []*database.SnapshotGroup
+	for _, group := range groups {
+		if c.snapshotGroupVisible(ctx, group) {
+			visible = append(visible, group)
+		}
+	}
+	sort.Slice(visible, func(i, j int) bool { return visible[i].Label < visible[j].Label })
+	return visible, nil
+}
+
+// snapshotGroup returns a snapshot group the caller may see
+func (c *Controller) snapshotGroup(ctx context.Context, label string) (*database.SnapshotGroup, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	group, err := c.db.GetSnapshotGroup(ctx, label)
+	if err != nil || !c.snapshotGroupVisible(ctx, group) {
+		return nil, fmt.Errorf("snapshot group not found: %s", label)
+	}
+	return group, nil
+}
+
+// snapshotGroupVisible reports whether the caller may see every resource of
+// a snapshot group
+func (c *Controller) snapshotGroupVisible(ctx context.Context, group *database.SnapshotGroup) bool {
+	for _, resource := range group.Resources {
+		if !c.resourceVisible(ctx, resource) {
+			return false
+		}
+	}
+	return true
+}
+
+// runSnapshotGroup runs op on the snapshot of each resource of a group in
+// parallel and returns the results in the order of the resources
+func runSnapshotGroup(resources []string, label string, op func(resource, snapshot string) error) []*SnapshotGroupResult {
+	results := make([]*SnapshotGroupResult, len(resources))
+	slots := make(chan struct{}, snapshotGroupParallelism)
+	var wg sync.WaitGroup
+	for i, resource := range resources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			result := &SnapshotGroupResult{Resource: resource, Snapshot: groupSnapshotName(resource, label)}
+			if err := op(resource, result.Snapshot); err != nil {
+				result.Error = err.Error()
+			}
+			results[i] = result
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// groupError summarizes the resources a snapshot group operation failed on
+func groupError(operation string, results []*SnapshotGroupResult) error {
+	var failed []string
+	for _, result := range results {
+		if result.Error != "" {
+			failed = append(failed, result.Resource)
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s failed on %d of %d resources: %s", operation, len(failed), len(results), strings.Join(failed, ", "))
+}
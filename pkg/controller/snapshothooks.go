@@ -0,0 +1,208 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// What a failing pre command of a snapshot hook does to the snapshot
+const (
+	HookAbort    = "abort"    // The snapshot is not taken
+	HookContinue = "continue" // The snapshot is taken without the application quiesced
+)
+
+// defaultHookTimeout bounds a hook command that has no timeout of its own
+const defaultHookTimeout = time.Minute
+
+// errHooksNeedFullSudo is returned for snapshot hooks with ssh.sudo =
+// "restricted": hooks run any command as root through sudo sh -c, which the
+// policy of sds node harden deliberately does not allow
+var errHooksNeedFullSudo = fmt.Errorf(`snapshot hooks run their commands as root through "sudo sh -c", which the restricted sudo policy does not allow; they need ssh.sudo = "full"`)
+
+// SetSnapshotHook adds a snapshot hook to a resource or replaces its hook of
+// the same name
+func (c *Controller) SetSnapshotHook(ctx context.Context, resource string, hook *database.SnapshotHook) error {
+	if c.db == nil {
+		return fmt.Errorf("database not available")
+	}
+	if c.config.SSH.Sudo == "restricted" {
+		return errHooksNeedFullSudo
+	}
+	if _, err := c.db.GetResource(ctx, resource); err != nil {
+		return fmt.Errorf("resource %s not found", resource)
+	}
+	if hook.Name == "" {
+		return fmt.Errorf("hook name is required")
+	}
+	if strings.TrimSpace(hook.Pre) == "" {
+		return fmt.Errorf("hook %s needs a pre command", hook.Name)
+	}
+	if hook.Timeout < 0 {
+		return fmt.Errorf("hook timeout must not be negative")
+	}
+	if hook.OnFailure == "" {
+		hook.OnFailure = HookAbort
+	}
+	if hook.OnFailure != HookAbort && hook.OnFailure != HookContinue {
+		return fmt.Errorf("invalid failure policy %q, use %s or %s", hook.OnFailure, HookAbort, HookContinue)
+	}
+
+	hooks, err := c.db.GetSnapshotHooks(ctx, resource)
+	if err != nil {
+		return fmt.Errorf("failed to get snapshot hooks: %w", err)
+	}
+	replaced := false
+	for i, h := range hooks.Hooks {
+		if h.Name == hook.Name {
+			hooks.Hooks[i] = hook
+			replaced = true
+		}
+	}
+	if !replaced {
+		hooks.Hooks = append(hooks.Hooks, hook)
+	}
+	if err := c.db.SaveSnapshotHooks(ctx, hooks); err != nil {
+		return fmt.Errorf("failed to save snapshot hooks: %w", err)
+	}
+
+	c.logger.Info("Snapshot hook set",
+		zap.String("resource", resource),
+		zap.String("hook", hook.Name),
+		zap.String("on_failure", hook.OnFailure))
+	return nil
+}
+
+// DeleteSnapshotHook removes a snapshot hook from a resource
+func (c *Controller) DeleteSnapshotHook(ctx context.Context, resource, name string) error {
+	if c.db == nil {
+		return fmt.Errorf("database not available")
+	}
+	hooks, err := c.db.GetSnapshotHooks(ctx, resource)
+	if err != nil {
+		return fmt.Errorf("failed to get snapshot hooks: %w", err)
+	}
+	kept := hooks.Hooks[:0]
+	for _, h := range hooks.Hooks {
+		if h.Name != name {
+			kept = append(kept, h)
+		}
+	}
+	if len(kept) == len(hooks.Hooks) {
+		return fmt.Errorf("resource %s has no snapshot hook %s", resource, name)
+	}
+	hooks.Hooks = kept
+	if err := c.db.SaveSnapshotHooks(ctx, hooks); err != nil {
+		return fmt.Errorf("failed to save snapshot hooks: %w", err)
+	}
+	return nil
+}
+
+// ListSnapshotHooks returns the snapshot hooks of a resource in the order
+// they run
+func (c *Controller) ListSnapshotHooks(ctx context.Context, resource string) ([]*database.SnapshotHook, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	hooks, err := c.db.GetSnapshotHooks(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot hooks: %w", err)
+	}
+	return hooks.Hooks, nil
+}
+
+// quiesce runs the pre commands of the snapshot hooks of a resource on its
+// Primary and returns the function that releases them again. The post
+// command of a hook runs even if its pre command failed, so a release
+// undoes a partial quiesce, e.g. after an interrupted run; post commands
+// must therefore be safe to run on an application that is not quiesced.
+func (sm *SnapshotManager) quiesce(ctx context.Context, resource string) (func(), error) {
+	noop := func() {}
+	c := sm.controller
+	if c.db == nil {
+		return noop, nil
+	}
+	hooks, err := c.db.GetSnapshotHooks(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot hooks: %w", err)
+	}
+	if len(hooks.Hooks) == 0 {
+		return noop, nil
+	}
+
+	primary := c.resources.primaryNode(ctx, resource)
+	if primary == "" {
+		// Nothing writes to a resource without Primary
+		c.logger.Info("Resource has no Primary, snapshot taken without hooks", zap.String("resource", resource))
+		return noop, nil
+	}
+	address := c.resources.nodeAddress(primary)
+
+	var ran []*database.SnapshotHook
+	release := func() {
+		// The snapshot is taken, its release must not be cancelled with it
+		ctx := context.WithoutCancel(ctx)
+		for i := len(ran) - 1; i >= 0; i-- {
+			hook := ran[i]
+			if hook.Post == "" {
+				continue
+			}
+			if err := sm.runHook(ctx, address, hook.Post, hook.Timeout); err != nil {
+				c.logger.Error("Snapshot hook failed to release the application",
+					zap.String("resource", resource),
+					zap.String("hook", hook.Name),
+					zap.String("node", primary),
+					zap.Error(err))
+				c.RecordEvent(ctx, EventSnapshotHookFailed, resource,
+					fmt.Sprintf("Post command of snapshot hook %s failed on %s, the application may still be quiesced: %v", hook.Name, primary, err),
+					map[string]string{"hook": hook.Name, "node": primary, "phase": "post"})
+			}
+		}
+	}
+
+	for _, hook := range hooks.Hooks {
+		ran = append(ran, hook)
+		err := sm.runHook(ctx, address, hook.Pre, hook.Timeout)
+		if err == nil {
+			continue
+		}
+		if hook.OnFailure == HookContinue {
+			c.logger.Warn("Snapshot hook failed, snapshot taken without the application quiesced",
+				zap.String("resource", resource),
+				zap.String("hook", hook.Name),
+				zap.String("node", primary),
+				zap.Error(err))
+			c.RecordEvent(ctx, EventSnapshotHookFailed, resource,
+				fmt.Sprintf("Pre command of snapshot hook %s failed on %s, snapshot taken anyway: %v", hook.Name, primary, err),
+				map[string]string{"hook": hook.Name, "node": primary, "phase": "pre"})
+			continue
+		}
+		release()
+		return nil, fmt.Errorf("snapshot hook %s failed on %s, no snapshot taken: %w", hook.Name, primary, err)
+	}
+	return release, nil
+}
+
+// runHook runs a hook command on a node, killed after its timeout
+func (sm *SnapshotManager) runHook(ctx context.Context, address, command string, timeout time.Duration) error {
+	// Hooks set before the nodes were hardened fail like any other hook
+	if sm.controller.config.SSH.Sudo == "restricted" {
+		return errHooksNeedFullSudo
+	}
+	if timeout <= 0 {
+		timeout = defaultHookTimeout
+	}
+	secs := int((timeout + time.Second - 1) / time.Second)
+	quoted := "'" + strings.ReplaceAll(command, "'", `'\''`) + "'"
+	cmd := fmt.Sprintf("sudo timeout --kill-after=10 %d sh -c %s 2>&1; rc=$?; [ $rc -eq 124 ] && echo 'timed out after %ds'; exit $rc", secs, quoted, secs)
+
+	// Leave the remote timeout room to report before the call is cancelled
+	ctx, cancel := context.WithTimeout(ctx, timeout+30*time.Second)
+	defer cancel()
+	_, err := sm.controller.execOutput(ctx, address, cmd)
+	return err
+}
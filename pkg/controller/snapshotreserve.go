@@ -0,0 +1,223 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/util"
+	"go.uber.org/zap"
+)
+
+// Bounds of the COW reserve of thick LVM snapshots
+const (
+	// minSnapshotReserve is the smallest reserve, the fixed size snapshots
+	// had before they were sized, unless the origin is smaller
+	minSnapshotReserve = 1 << 30
+	// snapshotReserveHeadroom is added to the change rate estimate
+	snapshotReserveHeadroom = 1.25
+	// minSnapshotRateAge is the age below which a snapshot says too little
+	// about the change rate of its origin
+	minSnapshotRateAge = 10 * time.Minute
+)
+
+// lvmAutoextendCmd sets the snapshot autoextend policy of dmeventd in
+// lvm.conf, replacing the settings or their commented out defaults; the
+// examples in the comments are left alone
+const lvmAutoextendCmd = `sudo sed -i -E ` +
+	`-e 's/^([[:space:]]*)snapshot_autoextend_threshold *= *[0-9]+ *$/\1snapshot_autoextend_threshold = %[1]d/' ` +
+	`-e 's/^([[:space:]]*)# *snapshot_autoextend_threshold *= *100 *$/\1snapshot_autoextend_threshold = %[1]d/' ` +
+	`-e 's/^([[:space:]]*)snapshot_autoextend_percent *= *[0-9]+ *$/\1snapshot_autoextend_percent = %[2]d/' ` +
+	`-e 's/^([[:space:]]*)# *snapshot_autoextend_percent *= *20 *$/\1snapshot_autoextend_percent = %[2]d/' /etc/lvm/lvm.conf`
+
+// lvmAutoextendQuery prints the effective snapshot autoextend policy
+const lvmAutoextendQuery = `sudo lvmconfig --typeconfig full activation/monitoring activation/snapshot_autoextend_threshold activation/snapshot_autoextend_percent`
+
+// lvmOrigin is a logical volume snapshots are taken of, with the thick
+// snapshots already taken of it
+type lvmOrigin struct {
+	SizeBytes   uint64
+	Thin        bool
+	VGFreeBytes uint64
+	// ChangeRate is the highest rate in bytes per second at which an earlier
+	// snapshot of the origin filled its COW volume, 0 if none tells
+	ChangeRate float64
+}
+
+// prepareLvmSnapshot sizes the COW volume of a thick LVM snapshot if no size
+// is given and checks the volume group has the space for it, so creating the
+// snapshot does not fail half-way or leave it too small to outlive its
+// purpose. It also makes sure dmeventd extends the snapshot before it fills
+// up. The size to create the snapshot with is returned, thin snapshots need
+// none.
+func (sm *SnapshotManager) prepareLvmSnapshot(ctx context.Context, t *SnapshotTarget, size string) (string, error) {
+	origin, err := sm.lvmOrigin(ctx, t)
+	if err != nil {
+		return "", err
+	}
+	if origin.Thin {
+		return size, nil
+	}
+
+	var reserve uint64
+	if size != "" {
+		if reserve, err = util.ParseSize(size); err != nil {
+			return "", fmt.Errorf("invalid snapshot size %q: %w", size, err)
+		}
+	} else {
+		reserve = sm.snapshotReserve(origin)
+		size = fmt.Sprintf("%dm", reserve>>20)
+		sm.controller.logger.Info("Sized LVM snapshot",
+			zap.String("volume", t.Path()),
+			zap.String("node", t.Node),
+			zap.Uint64("origin_bytes", origin.SizeBytes),
+			zap.Float64("change_bytes_per_sec", origin.ChangeRate),
+			zap.String("size", size))
+	}
+	if reserve > origin.VGFreeBytes {
+		return "", fmt.Errorf("volume group %s on %s has %s free, the snapshot of %s needs %s",
+			t.Pool, t.Node, util.FormatBytes(origin.VGFreeBytes), t.Volume, util.FormatBytes(reserve))
+	}
+
+	sm.ensureLvmAutoextend(ctx, t.Node)
+	return size, nil
+}
+
+// snapshotReserve returns the COW size of a snapshot of origin: the
+// configured share of the origin, or what its change rate writes within the
+// horizon if more, but no more than a copy of the whole origin needs.
+// The size is rounded up to whole MiB.
+func (sm *SnapshotManager) snapshotReserve(origin *lvmOrigin) uint64 {
+	cfg := sm.controller.config.Storage
+	reserve := origin.SizeBytes * uint64(cfg.SnapshotReservePercent) / 100
+	if origin.ChangeRate > 0 {
+		reserve = max(reserve, uint64(origin.ChangeRate*cfg.SnapshotReserveHorizon.Seconds()*snapshotReserveHeadroom))
+	}
+	reserve = max(reserve, min(minSnapshotReserve, origin.SizeBytes))
+	// The exception store needs a little more than the data
+	reserve = min(reserve, origin.SizeBytes+origin.SizeBytes/50)
+	return (reserve + 1<<20 - 1) &^ (1<<20 - 1)
+}
+
+// lvmOrigin reads the size and kind of the origin of a snapshot target, the
+// free space of its volume group and the change rate its thick snapshots saw
+func (sm *SnapshotManager) lvmOrigin(ctx context.Context, t *SnapshotTarget) (*lvmOrigin, error) {
+	cmd := fmt.Sprintf(`sudo lvs --noheadings --separator '|' --units b --nosuffix --config 'report/time_format="%%s"' `+
+		`-o lv_name,origin,lv_attr,lv_size,data_percent,lv_time,segtype,vg_free %s`, t.Pool)
+	output, err := sm.exec(ctx, t.Node, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect volume group %s on %s: %w", t.Pool, t.Node, err)
+	}
+
+	var origin *lvmOrigin
+	var rate float64
+	now := time.Now()
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.Split(line, "|")
+		if len(fields) < 8 {
+			continue
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		name, from, attr := fields[0], fields[1], fields[2]
+		size, _ := strconv.ParseUint(fields[3], 10, 64)
+
+		if name == t.Volume {
+			free, _ := strconv.ParseUint(fields[7], 10, 64)
+			origin = &lvmOrigin{
+				SizeBytes:   size,
+				Thin:        strings.HasPrefix(fields[6], "thin"),
+				VGFreeBytes: free,
+			}
+			continue
+		}
+
+		// Thick snapshots of the origin, "s" and invalidated "S"
+		if from != t.Volume || (!strings.HasPrefix(attr, "s") && !strings.HasPrefix(attr, "S")) {
+			continue
+		}
+		created, err := strconv.ParseInt(fields[5], 10, 64)
+		if err != nil {
+			continue
+		}
+		age := now.Sub(time.Unix(created, 0))
+		if age < minSnapshotRateAge {
+			continue
+		}
+		used := float64(size)
+		if percent, err := strconv.ParseFloat(fields[4], 64); err == nil && !strings.HasPrefix(attr, "S") {
+			used = float64(size) * percent / 100
+		}
+		rate = max(rate, used/age.Seconds())
+	}
+
+	if origin == nil {
+		return nil, fmt.Errorf("logical volume %s not found on %s", t.Path(), t.Node)
+	}
+	origin.ChangeRate = rate
+	return origin, nil
+}
+
+// ensureLvmAutoextend configures the snapshot autoextend policy of dmeventd
+// on a node once per controller run. Failures are logged, they do not keep
+// snapshots from being taken.
+func (sm *SnapshotManager) ensureLvmAutoextend(ctx context.Context, node string) {
+	cfg := sm.controller.config.Storage
+	want := fmt.Sprintf("%d/%d", cfg.SnapshotAutoextendThreshold, cfg.SnapshotAutoextendPercent)
+
+	sm.mu.Lock()
+	done := sm.autoextend[node] == want
+	sm.mu.Unlock()
+	if done {
+		return
+	}
+
+	policy, err := sm.lvmAutoextendPolicy(ctx, node)
+	if err == nil && policy != want {
+		if _, err = sm.exec(ctx, node, fmt.Sprintf(lvmAutoextendCmd, cfg.SnapshotAutoextendThreshold, cfg.SnapshotAutoextendPercent)); err == nil {
+			if policy, err = sm.lvmAutoextendPolicy(ctx, node); err == nil && policy != want {
+				err = fmt.Errorf("lvm.conf has the policy %s after setting it, edit activation/snapshot_autoextend_* by hand", policy)
+			}
+			if err == nil {
+				// dmeventd reads lvm.conf on start, a restart keeps the monitored devices
+				_, _ = sm.exec(ctx, node, "sudo dmeventd -R 2>/dev/null || true")
+				sm.controller.logger.Info("Set LVM snapshot autoextend policy",
+					zap.String("node", node),
+					zap.Int("threshold", cfg.SnapshotAutoextendThreshold),
+					zap.Int("percent", cfg.SnapshotAutoextendPercent))
+			}
+		}
+	}
+	if err != nil {
+		sm.controller.logger.Warn("Failed to set LVM snapshot autoextend policy, thick snapshots may be invalidated when full",
+			zap.String("node", node),
+			zap.Error(err))
+		return
+	}
+
+	sm.mu.Lock()
+	sm.autoextend[node] = want
+	sm.mu.Unlock()
+}
+
+// lvmAutoextendPolicy returns the effective autoextend threshold and percent
+// of a node as threshold/percent. Without dmeventd monitoring there is none.
+func (sm *SnapshotManager) lvmAutoextendPolicy(ctx context.Context, node string) (string, error) {
+	output, err := sm.exec(ctx, node, lvmAutoextendQuery)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the LVM config: %w", err)
+	}
+	values := make(map[string]string)
+	for _, line := range strings.Split(output, "\n") {
+		if key, value, ok := strings.Cut(strings.TrimSpace(line), "="); ok {
+			values[key] = value
+		}
+	}
+	if values["monitoring"] == "0" {
+		return "", fmt.Errorf("dmeventd monitoring is disabled (activation/monitoring = 0)")
+	}
+	return values["snapshot_autoextend_threshold"] + "/" + values["snapshot_autoextend_percent"], nil
+}
@@ -10,76 +10,120 @@ import (
 	"go.uber.org/zap"
 )
 
+// Snapshot backends
+const (
+	SnapshotBackendLVM = "lvm"
+	SnapshotBackendZFS = "zfs"
+)
+
 // SnapshotInfo represents snapshot information
 type SnapshotInfo struct {
 	Name      string
 	Volume    string
+	Node      string
 	SizeGB    uint64
 	CreatedAt string
 }
 
-// SnapshotManager manages volume snapshots
+// SnapshotTarget identifies a backing volume on one node that snapshots are taken of
+type SnapshotTarget struct {
+	Backend string // SnapshotBackendLVM or SnapshotBackendZFS
+	Pool    string // volume group or zpool
+	Volume  string // logical volume or zvol within the pool, empty for the whole pool
+	Node    string
+}
+
+// Path returns the pool-relative path of the target (vg/lv or pool/zvol)
+func (t *SnapshotTarget) Path() string {
+	if t.Volume == "" {
+		return t.Pool
+	}
+	return fmt.Sprintf("%s/%s", t.Pool, t.Volume)
+}
+
+// SnapshotManager manages volume snapshots.
+// Callers address snapshots by resource name; the backend (LVM or ZFS) and
+// backing volumes are looked up in the database and operations are routed
+// to the matching StorageManager implementation.
 type SnapshotManager struct {
 	controller *Controller
 	mu         sync.RWMutex
+
+	// usageAlerts holds the last reported usage state per snapshot
+	usageAlerts map[string]string
+
+	// autoextend holds the snapshot autoextend policy set per node
+	autoextend map[string]string
 }
 
 // NewSnapshotManager creates a new snapshot manager
 func NewSnapshotManager(ctrl *Controller) *SnapshotManager {
 	return &SnapshotManager{
-		controller: ctrl,
+		controller:  ctrl,
+		usageAlerts: make(map[string]string),
+		autoextend:  make(map[string]string),
 	}
 }
 
-// CreateSnapshot creates a snapshot
-func (sm *SnapshotManager) CreateSnapshot(ctx context.Context, volume, snapshotName, node string) error {
+// CreateSnapshot creates a snapshot of a resource or backing volume.
+// volume is a resource name or, for volumes unknown to SDS, an LVM vg/lv path.
+// An empty node snapshots the resource on all of its nodes.
+// size is the COW size of thick LVM snapshots and ignored otherwise.
+// The snapshot hooks of a resource quiesce its application on the Primary
+// around the snapshot, unless skipHooks is set.
+func (sm *SnapshotManager) CreateSnapshot(ctx context.Context, volume, snapshotName, node, size string, skipHooks bool) error {
 	sm.controller.logger.Info("Creating snapshot",
 		zap.String("volume", volume),
 		zap.String("snapshot", snapshotName),
-		zap.String("node", node))
+		zap.String("node", node),
+		zap.String("size", size))
 
-	// Parse volume path (e.g., "ubuntu-vg/lv0" -> vg="ubuntu-vg", lv="lv0")
-	vg, lv := parseVolumePath(volume)
-	originPath := fmt.Sprintf("/dev/%s/%s", vg, lv)
-
-	// Create snapshot using lvcreate
-	cmd := fmt.Sprintf("sudo lvcreate -s -n %s %s", snapshotName, originPath)
-	result, err := sm.controller.deployment.Exec(ctx, []string{node}, cmd)
+	targets, err := sm.ResolveTargets(ctx, volume, node)
 	if err != nil {
-		return fmt.Errorf("failed to create snapshot: %w", err)
+		return err
 	}
 
-	if !result.AllSuccess() {
-		return fmt.Errorf("failed to create snapshot: %v", result.FailedHosts())
+	if !skipHooks {
+		release, err := sm.quiesce(ctx, volume)
+		if err != nil {
+			return err
+		}
+		defer release()
+	}
+
+	for _, t := range targets {
+		if err := sm.CreateSnapshotOn(ctx, t, snapshotName, size); err != nil {
+			return err
+		}
 	}
 
 	sm.controller.logger.Info("Snapshot created successfully",
 		zap.String("volume", volume),
 		zap.String("snapshot", snapshotName))
 
+	sm.controller.RecordEvent(ctx, EventSnapshotCompleted, volume,
+		fmt.Sprintf("Snapshot %s taken", snapshotName),
+		map[string]string{"snapshot": snapshotName, "node": node})
+
 	return nil
 }
 
-// DeleteSnapshot deletes a snapshot
+// DeleteSnapshot deletes a snapshot of a resource or backing volume
 func (sm *SnapshotManager) DeleteSnapshot(ctx context.Context, volume, snapshotName, node string) error {
 	sm.controller.logger.Info("Deleting snapshot",
 		zap.String("volume", volume),
 		zap.String("snapshot", snapshotName),
 		zap.String("node", node))
 
-	// Parse volume path and build snapshot LV path
-	vg, _ := parseVolumePath(volume)
-	snapshotPath := fmt.Sprintf("/dev/%s/%s", vg, snapshotName)
-
-	// Remove snapshot
-	cmd := fmt.Sprintf("sudo lvremove -f %s", snapshotPath)
-	result, err := sm.controller.deployment.Exec(ctx, []string{node}, cmd)
+	targets, err := sm.ResolveTargets(ctx, volume, node)
 	if err != nil {
-		return fmt.Errorf("failed to delete snapshot: %w", err)
+		return err
 	}
 
-	if !result.AllSuccess() {
-		return fmt.Errorf("failed to delete snapshot: %v", result.FailedHosts())
+	for _, t := range targets {
+		if err := sm.DeleteSnapshotOn(ctx, t, snapshotName); err != nil {
+			return err
+		}
 	}
 
 	sm.controller.logger.Info("Snapshot deleted successfully",
@@ -88,83 +132,232 @@ func (sm *SnapshotManager) DeleteSnapshot(ctx context.Context, volume, snapshotN
 	return nil
 }
 
-// ListSnapshots lists snapshots for a volume
+// ListSnapshots lists snapshots of a resource or backing volume, aggregated across nodes
 func (sm *SnapshotManager) ListSnapshots(ctx context.Context, volume, node string) ([]*SnapshotInfo, error) {
-	// Parse volume path
-	vg, lv := parseVolumePath(volume)
-
-	// List snapshots using lvs
-	cmd := fmt.Sprintf("sudo lvs --noheadings --separator '|' -o lv_name,lv_size,origin %s", vg)
-	result, err := sm.controller.deployment.Exec(ctx, []string{node}, cmd)
+	targets, err := sm.ResolveTargets(ctx, volume, node)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list snapshots: %w", err)
-	}
-
-	if !result.AllSuccess() {
-		return nil, fmt.Errorf("failed to list snapshots: %v", result.FailedHosts())
+		return nil, err
 	}
 
 	var snapshots []*SnapshotInfo
-	for _, r := range result.Hosts {
-		if r.Success {
-			lines := strings.Split(strings.TrimSpace(r.Output), "\n")
-			for _, line := range lines {
-				fields := strings.Split(line, "|")
-				if len(fields) >= 3 {
-					lvName := strings.TrimSpace(fields[0])
-					origin := strings.TrimSpace(fields[2])
-					// Check if this is a snapshot of the requested LV
-					if origin == lv {
-						sizeStr := strings.TrimSpace(fields[1])
-						// Parse size (e.g., "4.00g" or "4.00G")
-						sizeStr = strings.TrimSuffix(sizeStr, "g")
-						sizeStr = strings.TrimSuffix(sizeStr, "G")
-						sizeFloat, _ := strconv.ParseFloat(sizeStr, 64)
-						snapshots = append(snapshots, &SnapshotInfo{
-							Name:      lvName,
-							Volume:    volume,
-							SizeGB:    uint64(sizeFloat),
-							CreatedAt: "",
-						})
-					}
-				}
-			}
+	for _, t := range targets {
+		snaps, err := sm.ListSnapshotsOn(ctx, t)
+		if err != nil {
+			return nil, err
 		}
+		snapshots = append(snapshots, snaps...)
 	}
 
 	return snapshots, nil
 }
 
-// RestoreSnapshot restores a snapshot
-func (sm *SnapshotManager) RestoreSnapshot(ctx context.Context, volume, snapshotName, node string) error {
+// RestoreSnapshot restores a snapshot of a resource or backing volume.
+// LVM snapshots are merged into the origin, ZFS volumes are rolled back.
+// Unless skipSafety is set, a safety snapshot of the current data is taken
+// first; on ZFS it is also copied to a zvol, as the rollback destroys it.
+func (sm *SnapshotManager) RestoreSnapshot(ctx context.Context, volume, snapshotName, node string, skipSafety bool) error {
 	sm.controller.logger.Info("Restoring snapshot",
 		zap.String("volume", volume),
 		zap.String("snapshot", snapshotName),
 		zap.String("node", node))
 
-	// Parse volume path and build paths
-	vg, _ := parseVolumePath(volume)
-	snapshotPath := fmt.Sprintf("/dev/%s/%s", vg, snapshotName)
+	targets, err := sm.ResolveTargets(ctx, volume, node)
+	if err != nil {
+		return err
+	}
 
-	// Merge snapshot back into origin
-	// First, unmount if mounted (caller should handle this)
-	// Then use lvconvert --merge
-	cmd := fmt.Sprintf("sudo lvconvert --merge %s", snapshotPath)
-	result, err := sm.controller.deployment.Exec(ctx, []string{node}, cmd)
+	safety, err := sm.SafetySnapshot(ctx, volume, node, SafetyOpRestore, snapshotName, skipSafety)
+	if err != nil {
+		return err
+	}
+	copies, err := sm.keepZfsSafetyCopies(ctx, targets, safety)
 	if err != nil {
-		return fmt.Errorf("failed to restore snapshot: %w", err)
+		return err
 	}
 
-	if !result.AllSuccess() {
-		return fmt.Errorf("failed to restore snapshot: %v", result.FailedHosts())
+	for _, t := range targets {
+		if err := sm.RestoreSnapshotOn(ctx, t, snapshotName); err != nil {
+			return err
+		}
 	}
 
 	sm.controller.logger.Info("Snapshot restored successfully",
 		zap.String("snapshot", snapshotName))
 
+	details := map[string]string{"snapshot": snapshotName, "node": node}
+	if len(copies) > 0 {
+		details["safety_copy"] = strings.Join(copies, ",")
+	}
+	sm.controller.RecordEvent(ctx, EventSnapshotRestored, volume,
+		fmt.Sprintf("Snapshot %s restored", snapshotName),
+		safetyEventDetails(safety, details))
+
 	return nil
 }
 
+// ResolveTargets resolves a resource name or vg/lv path to the backing
+// volumes to operate on. Resources use their volume records from the
+// database; an empty node selects all of the resource's nodes.
+func (sm *SnapshotManager) ResolveTargets(ctx context.Context, volume, node string) ([]*SnapshotTarget, error) {
+	if sm.controller.db != nil {
+		if res, err := sm.controller.db.GetResource(ctx, volume); err == nil {
+			volumes, err := sm.controller.db.ListVolumes(ctx, volume)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get volumes of resource %s: %w", volume, err)
+			}
+
+			nodes := []string{node}
+			if node == "" {
+				nodes = strings.Split(res.Nodes, ",")
+			}
+
+			var targets []*SnapshotTarget
+			for _, v := range volumes {
+				if v.ResourceName != volume {
+					continue
+				}
+				if v.StorageType == StorageTypeRaw || v.StorageType == StorageTypeFile {
+					return nil, fmt.Errorf("resource %s is backed by %s devices, which do not support snapshots", volume, v.StorageType)
+				}
+				for _, n := range nodes {
+					targets = append(targets, &SnapshotTarget{
+						Backend: snapshotBackend(v.StorageType),
+						Pool:    v.Pool,
+						Volume:  v.VolumeName,
+						Node:    n,
+					})
+				}
+			}
+			if len(targets) == 0 {
+				return nil, fmt.Errorf("no volume records for resource %s, pass the backing volume as vg/lv", volume)
+			}
+			return targets, nil
+		}
+	}
+
+	// Not a resource: an LVM vg/lv path on an explicit node
+	if node == "" {
+		return nil, fmt.Errorf("node is required for volume %s", volume)
+	}
+	pool, lv := parseVolumePath(volume)
+	return []*SnapshotTarget{{Backend: SnapshotBackendLVM, Pool: pool, Volume: lv, Node: node}}, nil
+}
+
+// CreateSnapshotOn creates a snapshot of a single target.
+// size is the COW size of thick LVM snapshots and ignored otherwise; without
+// it the COW volume is sized from the origin and its change rate.
+func (sm *SnapshotManager) CreateSnapshotOn(ctx context.Context, t *SnapshotTarget, snapshotName, size string) error {
+	ctx = sm.controller.stepContext(ctx, StepSnapshot)
+	backend, err := sm.controller.storage.Backend(t.Backend)
+	if err != nil {
+		return err
+	}
+	if t.Backend == SnapshotBackendLVM {
+		if size, err = sm.prepareLvmSnapshot(ctx, t, size); err != nil {
+			return err
+		}
+	}
+	return backend.Snapshot(ctx, sm.address(t.Node), t.Pool, t.Volume, snapshotName, size)
+}
+
+// DeleteSnapshotOn deletes a snapshot of a single target
+func (sm *SnapshotManager) DeleteSnapshotOn(ctx context.Context, t *SnapshotTarget, snapshotName string) error {
+	switch t.Backend {
+	case SnapshotBackendZFS:
+		return sm.controller.storage.ZFSDeleteSnapshot(ctx, fmt.Sprintf("%s@%s", t.Path(), snapshotName), sm.address(t.Node))
+	default:
+		return sm.controller.storage.DeleteLvmSnapshot(ctx, t.Pool, snapshotName, sm.address(t.Node))
+	}
+}
+
+// RestoreSnapshotOn restores a snapshot of a single target
+func (sm *SnapshotManager) RestoreSnapshotOn(ctx context.Context, t *SnapshotTarget, snapshotName string) error {
+	switch t.Backend {
+	case SnapshotBackendZFS:
+		return sm.controller.storage.ZFSRestoreSnapshot(ctx, t.Path(), snapshotName, sm.address(t.Node))
+	default:
+		return sm.controller.storage.RestoreLvmSnapshot(ctx, t.Pool, snapshotName, sm.address(t.Node))
+	}
+}
+
+// ListSnapshotsOn lists the snapshots of a single target.
+// An LVM target without a volume lists all snapshots in the volume group.
+func (sm *SnapshotManager) ListSnapshotsOn(ctx context.Context, t *SnapshotTarget) ([]*SnapshotInfo, error) {
+	var snapshots []*SnapshotInfo
+	var err error
+
+	switch t.Backend {
+	case SnapshotBackendZFS:
+		snapshots, err = sm.controller.storage.ZFSListSnapshots(ctx, t.Path(), sm.address(t.Node))
+	default:
+		snapshots, err = sm.listLvmSnapshots(ctx, t)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, snap := range snapshots {
+		snap.Node = t.Node
+	}
+	return snapshots, nil
+}
+
+// listLvmSnapshots lists LVM snapshots whose origin is the target volume
+func (sm *SnapshotManager) listLvmSnapshots(ctx context.Context, t *SnapshotTarget) ([]*SnapshotInfo, error) {
+	cmd := fmt.Sprintf("sudo lvs --noheadings --separator '|' --units g --nosuffix -S lv_role=snapshot -o lv_name,lv_size,origin,lv_time %s", t.Pool)
+	result, err := sm.controller.deployment.Exec(ctx, []string{sm.address(t.Node)}, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+
+	if !result.AllSuccess() {
+		return nil, fmt.Errorf("failed to list snapshots: %v", result.FailedHosts())
+	}
+
+	var snapshots []*SnapshotInfo
+	for _, r := range result.Hosts {
+		for _, line := range strings.Split(strings.TrimSpace(r.Output), "\n") {
+			fields := strings.Split(line, "|")
+			if len(fields) < 3 {
+				continue
+			}
+			origin := strings.TrimSpace(fields[2])
+			if t.Volume != "" && origin != t.Volume {
+				continue
+			}
+
+			sizeFloat, _ := strconv.ParseFloat(strings.TrimSpace(fields[1]), 64)
+			snap := &SnapshotInfo{
+				Name:   strings.TrimSpace(fields[0]),
+				Volume: fmt.Sprintf("%s/%s", t.Pool, origin),
+				SizeGB: uint64(sizeFloat),
+			}
+			if len(fields) >= 4 {
+				snap.CreatedAt = strings.TrimSpace(fields[3])
+			}
+			snapshots = append(snapshots, snap)
+		}
+	}
+
+	return snapshots, nil
+}
+
+// address resolves a node name to its address
+func (sm *SnapshotManager) address(node string) string {
+	if addr := sm.controller.nodes.GetNodeAddressByName(node); addr != "" {
+		return addr
+	}
+	return sm.controller.ResolveHost(node)
+}
+
+// snapshotBackend maps a volume storage type to its snapshot backend
+func snapshotBackend(storageType string) string {
+	if strings.HasPrefix(storageType, "zfs") {
+		return SnapshotBackendZFS
+	}
+	return SnapshotBackendLVM
+}
+
 func parseVolumePath(volume string) (vg, lv string) {
 	parts := strings.Split(volume, "/")
 	if len(parts) >= 2 {
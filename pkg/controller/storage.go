@@ -27,12 +27,16 @@ type PoolInfo struct {
 type StorageManager struct {
 	controller *Controller
 	mu         sync.RWMutex
+
+	// backends holds the backend created per storage type
+	backends map[string]StorageBackend
 }
 
 // NewStorageManager creates a new storage manager
 func NewStorageManager(ctrl *Controller) *StorageManager {
 	return &StorageManager{
 		controller: ctrl,
+		backends:   make(map[string]StorageBackend),
 	}
 }
 
@@ -59,7 +63,7 @@ func (sm *StorageManager) CreatePool(ctx context.Context, name, poolType, node s
 
 	// Create PVs first
 	for _, disk := range disks {
-		result, err := sm.controller.deployment.PVCreate(ctx, []string{address}, disk)
+		result, err := sm.controller.deployment.PVCreate(sm.controller.stepContext(ctx, StepPoolCreate), []string{address}, disk)
 		if err != nil {
 			return fmt.Errorf("failed to create PV on %s: %w", disk, err)
 		}
@@ -69,7 +73,7 @@ func (sm *StorageManager) CreatePool(ctx context.Context, name, poolType, node s
 	}
 
 	// Create VG
-	result, err := sm.controller.deployment.VGCreate(ctx, []string{address}, name, disks)
+	result, err := sm.controller.deployment.VGCreate(sm.controller.stepContext(ctx, StepPoolCreate), []string{address}, name, disks)
 	if err != nil {
 		return fmt.Errorf("failed to create pool: %w", err)
 	}
@@ -92,7 +96,7 @@ func (sm *StorageManager) CreatePool(ctx context.Context, name, poolType, node s
 			thinSize = fmt.Sprintf("%dG", sizeGB)
 		}
 
-		tpResult, err := sm.controller.deployment.LVCreateThinPool(ctx, []string{address}, name, thinPoolName, thinSize)
+		tpResult, err := sm.controller.deployment.LVCreateThinPool(sm.controller.stepContext(ctx, StepPoolCreate), []string{address}, name, thinPoolName, thinSize)
 		if err != nil {
 			return fmt.Errorf("failed to create thin pool: %w", err)
 		}
@@ -144,72 +148,25 @@ func (sm *StorageManager) GetPool(ctx context.Context, poolName, node string) (*
 	return nil, fmt.Errorf("pool not found: %s", poolName)
 }
 
-// ListPools lists all pools across all nodes (LVM and ZFS)
+// ListPools lists all pools across all nodes, of every storage backend
 func (sm *StorageManager) ListPools(ctx context.Context) ([]*PoolInfo, error) {
 	var pools []*PoolInfo
-	// Use map to deduplicate by normalized node name
-	seen := make(map[string]bool)
 
 	hosts := sm.controller.GetHosts()
 	if len(hosts) == 0 {
 		return pools, nil
 	}
 
-	// 1. Get LVM pools
-	result, err := sm.controller.deployment.Exec(ctx, hosts, "sudo vgs --noheadings --units b --separator '|' -o vg_name,vg_size,vg_free")
-	if err != nil {
-		// Log error but continue to try ZFS
-		sm.controller.logger.Warn("Failed to list LVM pools", zap.Error(err))
-	} else {
-		for host, r := range result.Hosts {
-			if r.Success {
-				normalizedHost := sm.controller.NormalizeHost(host)
-				if normalizedHost == "" {
-					normalizedHost = host
-				}
-
-				lines := strings.Split(strings.TrimSpace(r.Output), "\n")
-				for _, line := range lines {
-					line = strings.TrimSpace(line)
-					if line == "" {
-						continue
-					}
-					fields := strings.Split(line, "|")
-					if len(fields) >= 3 {
-						vgName := strings.TrimSpace(fields[0])
-						// Only show SDS-managed pools (with sds_ prefix)
-						if !strings.HasPrefix(vgName, "sds_") {
-							continue
-						}
-						key := normalizedHost + "/lvm/" + vgName
-						if seen[key] {
-							continue
-						}
-						seen[key] = true
-
-						totalSizeStr := strings.TrimSpace(strings.TrimSuffix(fields[1], "B"))
-						freeSizeStr := strings.TrimSpace(strings.TrimSuffix(fields[2], "B"))
-						totalSize, _ := strconv.ParseUint(totalSizeStr, 10, 64)
-						freeSize, _ := strconv.ParseUint(freeSizeStr, 10, 64)
-						pools = append(pools, &PoolInfo{
-							Name:    vgName,
-							Type:    "vg",
-							Node:    normalizedHost,
-							TotalGB: totalSize / 1024 / 1024 / 1024,
-							FreeGB:  freeSize / 1024 / 1024 / 1024,
-						})
-					}
-				}
-			}
+	// A backend that fails to list does not hide the pools of the others
+	for _, backend := range sm.backendFamilies() {
+		found, err := backend.ListCapacity(ctx, hosts)
+		if err != nil {
+			sm.controller.logger.Warn("Failed to list pools",
+				zap.String("backend", backend.Name()),
+				zap.Error(err))
+			continue
 		}
-	}
-
-	// 2. Get ZFS pools
-	zfsPools, err := sm.ListZFSpools(ctx)
-	if err != nil {
-		sm.controller.logger.Warn("Failed to list ZFS pools", zap.Error(err))
-	} else {
-		pools = append(pools, zfsPools...)
+		pools = append(pools, found...)
 	}
 
 	return pools, nil
@@ -218,7 +175,7 @@ func (sm *StorageManager) ListPools(ctx context.Context) ([]*PoolInfo, error) {
 // AddDiskToPool adds a disk to a pool
 func (sm *StorageManager) AddDiskToPool(ctx context.Context, pool, disk, node string) error {
 	// Create PV first
-	result, err := sm.controller.deployment.PVCreate(ctx, []string{node}, disk)
+	result, err := sm.controller.deployment.PVCreate(sm.controller.stepContext(ctx, StepPoolCreate), []string{node}, disk)
 	if err != nil {
 		return fmt.Errorf("failed to create PV: %w", err)
 	}
@@ -291,7 +248,7 @@ func (sm *StorageManager) CreateZFSPool(ctx context.Context, name, node string,
 	}
 
 	// Create ZFS pool
-	result, err := sm.controller.deployment.ZFSCreatePool(ctx, []string{address}, name, vdevs)
+	result, err := sm.controller.deployment.ZFSCreatePool(sm.controller.stepContext(ctx, StepPoolCreate), []string{address}, name, vdevs)
 	if err != nil {
 		return fmt.Errorf("failed to create ZFS pool: %w", err)
 	}
@@ -342,60 +299,16 @@ func (sm *StorageManager) GetZFSPool(ctx context.Context, poolName, node string)
 
 // ListZFSpools lists all ZFS pools across all nodes
 func (sm *StorageManager) ListZFSpools(ctx context.Context) ([]*PoolInfo, error) {
-	var pools []*PoolInfo
-	seen := make(map[string]bool)
-
 	hosts := sm.controller.GetHosts()
 	if len(hosts) == 0 {
-		return pools, nil
+		return nil, nil
 	}
 
-	result, err := sm.controller.deployment.ZFSListPools(ctx, hosts)
+	backend, err := sm.Backend("zfs")
 	if err != nil {
-		return nil, fmt.Errorf("failed to list ZFS pools: %w", err)
-	}
-
-	for host, r := range result.Hosts {
-		if r.Success {
-			normalizedHost := sm.controller.NormalizeHost(host)
-			if normalizedHost == "" {
-				normalizedHost = host
-			}
-
-			lines := strings.Split(strings.TrimSpace(r.Output), "\n")
-			for _, line := range lines {
-				line = strings.TrimSpace(line)
-				if line == "" {
-					continue
-				}
-				fields := strings.Fields(line)
-				if len(fields) >= 4 {
-					poolName := fields[0]
-					// Only show SDS-managed pools (with sds_ prefix)
-					if !strings.HasPrefix(poolName, "sds_") {
-						continue
-					}
-					key := normalizedHost + "/" + poolName
-					if seen[key] {
-						continue
-					}
-					seen[key] = true
-
-					totalSize, _ := strconv.ParseUint(fields[1], 10, 64)
-					freeSize, _ := strconv.ParseUint(fields[2], 10, 64)
-					pools = append(pools, &PoolInfo{
-						Name:    poolName,
-						Type:    "zfs",
-						Node:    normalizedHost,
-						TotalGB: totalSize / 1024 / 1024 / 1024,
-						FreeGB:  freeSize / 1024 / 1024 / 1024,
-					})
-				}
-			}
-		}
+		return nil, err
 	}
-
-	return pools, nil
+	return backend.ListCapacity(ctx, hosts)
 }
 
 // DeleteZFSPool deletes a ZFS storage pool
@@ -631,10 +544,10 @@ func (sm *StorageManager) CreateLvmSnapshot(ctx context.Context, vgName, lvName,
 	var result *deployment.ExecResult
 	if isThin {
 		sm.controller.logger.Info("Creating Thin Snapshot", zap.String("origin", lvName))
-		result, err = sm.controller.deployment.LVCreateThinSnapshot(ctx, []string{address}, vgName, lvName, snapshotName)
+		result, err = sm.controller.deployment.LVCreateThinSnapshot(sm.controller.stepContext(ctx, StepSnapshot), []string{address}, vgName, lvName, snapshotName)
 	} else {
 		sm.controller.logger.Info("Creating Standard Snapshot", zap.String("origin", lvName))
-		result, err = sm.controller.deployment.LVCreateSnapshot(ctx, []string{address}, vgName, lvName, snapshotName, size)
+		result, err = sm.controller.deployment.LVCreateSnapshot(sm.controller.stepContext(ctx, StepSnapshot), []string{address}, vgName, lvName, snapshotName, size)
 	}
 
 	if err != nil {
@@ -0,0 +1,271 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"github.com/liliang-cn/sds/pkg/deployment"
+	"go.uber.org/zap"
+)
+
+// defaultConversionSyncTimeout bounds the full resync of a node after its
+// volumes were recreated with the new storage type
+const defaultConversionSyncTimeout = 2 * time.Hour
+
+// StorageConversionResult is the outcome of converting the backing of a
+// resource to another storage type
+type StorageConversionResult struct {
+	Converted []string // Nodes whose volumes were recreated
+	Pending   []string // Nodes left, the Primary until it fails over
+}
+
+// isZFSStorage reports whether a storage type is backed by zvols
+func isZFSStorage(storageType string) bool {
+	return storageType == "zfs" || storageType == "zfs-thin"
+}
+
+// ConvertStorage converts the backing volumes of a resource from LVM to ZFS
+// zvols or vice versa, e.g. lvm to zfs-thin in pool tank. The nodes are
+// converted one Secondary at a time while all other nodes are UpToDate, so
+// the resource stays online: a volume of the new type is created, the node
+// is taken down and switched to it with fresh DRBD metadata, and brought up
+// again. DRBD rebuilds the replica from its peers with a full resync, which
+// must finish before the old volumes are removed and the next node starts.
+//
+// The Primary is left pending; after a failover the command continues with
+// it. A resource on a single node has no peer to resync from.
+func (rm *ResourceManager) ConvertStorage(ctx context.Context, resource, storageType, pool string, timeout time.Duration) (*StorageConversionResult, error) {
+	if rm.deployment == nil {
+		return nil, fmt.Errorf("deployment client not set")
+	}
+	if rm.controller.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	if _, err := rm.controller.storage.Backend(storageType); err != nil {
+		return nil, fmt.Errorf("unsupported storage type %q (%s)", storageType, strings.Join(StorageBackendTypes(), ", "))
+	}
+	if pool == "" {
+		return nil, fmt.Errorf("pool is required")
+	}
+	if timeout <= 0 {
+		timeout = defaultConversionSyncTimeout
+	}
+
+	res, err := rm.controller.db.GetResource(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("resource not found: %s", resource)
+	}
+	nodes, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) < 2 {
+		return nil, fmt.Errorf("resource %s has a single node, there is no peer to resync the converted volumes from", resource)
+	}
+	volumes, err := rm.controller.db.ListVolumes(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volumes: %w", err)
+	}
+
+	// Volumes of the requested type only need to move between pools
+	var converting []*database.Volume
+	for _, vol := range volumes {
+		switch {
+		case vol.StorageType == StorageTypeRaw || vol.StorageType == StorageTypeFile:
+			return nil, fmt.Errorf("volume %d of %s is on %s devices, only LVM and ZFS volumes can be converted",
+				vol.VolumeID, resource, vol.StorageType)
+		case vol.StorageType != storageType:
+			converting = append(converting, vol)
+		case vol.Pool != pool:
+			return nil, fmt.Errorf("volume %d of %s already is %s, move it with migrate-pool", vol.VolumeID, resource, storageType)
+		}
+	}
+	if len(converting) == 0 {
+		return nil, fmt.Errorf("resource %s already is %s in pool %s", resource, storageType, pool)
+	}
+
+	var pending []string
+	for _, node := range nodes {
+		if len(conversionCopies(converting, node, storageType, pool)) > 0 {
+			pending = append(pending, node)
+		}
+	}
+	sort.Strings(pending)
+
+	result := &StorageConversionResult{}
+	primary := rm.primaryNode(ctx, resource)
+	for _, node := range pending {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if node == primary {
+			rm.controller.logger.Info("Leaving Primary pending, fail over to convert it",
+				zap.String("resource", resource),
+				zap.String("node", node))
+			result.Pending = append(result.Pending, node)
+			continue
+		}
+
+		copies := conversionCopies(converting, node, storageType, pool)
+		if err := rm.convertNodeStorage(ctx, res, node, nodes, copies, storageType, pool, timeout); err != nil {
+			return nil, fmt.Errorf("failed to convert %s: %w", node, err)
+		}
+		result.Converted = append(result.Converted, node)
+	}
+
+	// Once every node is converted the volumes have the new type
+	if len(result.Pending) == 0 {
+		for _, vol := range converting {
+			vol.StorageType = storageType
+			vol.Pool = pool
+			vol.Backing = volumeBacking(nodes, backingDevicePath(storageType, pool, vol.VolumeName))
+			if err := rm.controller.db.SaveVolume(ctx, vol); err != nil {
+				return nil, fmt.Errorf("failed to save volume: %w", err)
+			}
+		}
+		if err := rm.distributeResourceConfig(ctx, resource, nodes); err != nil {
+			return nil, err
+		}
+	}
+
+	message := fmt.Sprintf("Volumes of %s converted to %s in pool %s on %s", resource, storageType, pool, strings.Join(result.Converted, ", "))
+	if len(result.Pending) > 0 {
+		message += fmt.Sprintf(", pending on %s", strings.Join(result.Pending, ", "))
+	}
+	rm.controller.RecordEvent(ctx, EventStorageConverted, resource, message,
+		map[string]string{
+			"storage_type": storageType,
+			"pool":         pool,
+			"converted":    strings.Join(result.Converted, ","),
+			"pending":      strings.Join(result.Pending, ","),
+		})
+
+	return result, nil
+}
+
+// conversionCopies returns the volumes of a node that do not have the new
+// storage type yet
+func conversionCopies(volumes []*database.Volume, node, storageType, pool string) []*poolCopy {
+	var copies []*poolCopy
+	for _, vol := range volumes {
+		source := vol.Backing[node]
+		if source == "" {
+			source = backingDevicePath(vol.StorageType, vol.Pool, vol.VolumeName)
+		}
+		dest := backingDevicePath(storageType, pool, vol.VolumeName)
+		if source != dest {
+			copies = append(copies, &poolCopy{vol: vol, source: source, dest: dest})
+		}
+	}
+	return copies
+}
+
+// convertNodeStorage recreates the volumes of a resource on one Secondary node
+// with the new storage type and waits for the node to resync
+func (rm *ResourceManager) convertNodeStorage(ctx context.Context, res *database.Resource, node string, nodes []string, copies []*poolCopy, storageType, pool string, timeout time.Duration) error {
+	// The node is rebuilt from its peers, they must be complete
+	if err := rm.checkPeersUpToDate(ctx, res.Name, node, nodes); err != nil {
+		return err
+	}
+
+	address := rm.nodeAddress(node)
+	for _, cp := range copies {
+		if err := rm.checkNoSnapshots(ctx, address, cp); err != nil {
+			return err
+		}
+		size, err := rm.blockDeviceSize(ctx, address, cp.source)
+		if err != nil {
+			return err
+		}
+		// Fresh metadata is created, the new volume may be a little larger
+		const mib = 1024 * 1024
+		if err := rm.createConvertedVolume(ctx, address, cp, storageType, pool, (size+mib-1)/mib); err != nil {
+			return err
+		}
+	}
+
+	rm.controller.logger.Info("Converting volumes",
+		zap.String("resource", res.Name),
+		zap.String("node", node),
+		zap.String("storage_type", storageType),
+		zap.String("pool", pool))
+
+	if _, err := rm.controller.execOutput(ctx, address, fmt.Sprintf("sudo drbdadm down %s", res.Name)); err != nil {
+		return fmt.Errorf("failed to take down resource: %w", err)
+	}
+	up := func() error {
+		if _, err := rm.controller.execOutput(rm.controller.stepContext(ctx, StepDrbdUp), address, fmt.Sprintf("sudo drbdadm up %s", res.Name)); err != nil {
+			return fmt.Errorf("failed to bring up resource: %w", err)
+		}
+		return nil
+	}
+
+	if err := rm.switchBacking(ctx, res.Name, node, nodes, copies); err != nil {
+		return withUp(err, up())
+	}
+	for _, cp := range copies {
+		volume := fmt.Sprintf("%s/%d", res.Name, cp.vol.VolumeID)
+		md, err := rm.deployment.DRBDCreateMD(rm.controller.stepContext(ctx, StepMetadata), []string{address}, volume, resourceMaxPeers(res))
+		if err == nil && !md.AllSuccess() {
+			err = fmt.Errorf("drbdadm create-md failed: %v", md.FailedHosts())
+		}
+		if err != nil {
+			// The old volumes still have their metadata
+			rm.restoreBacking(ctx, res.Name, node, nodes, copies)
+			return withUp(err, up())
+		}
+	}
+	if err := up(); err != nil {
+		return err
+	}
+
+	if err := rm.waitForUpToDate(ctx, res.Name, node, timeout); err != nil {
+		return fmt.Errorf("resync did not finish: %w", err)
+	}
+
+	for _, cp := range copies {
+		if err := rm.removeOldVolume(ctx, address, cp); err != nil {
+			rm.controller.logger.Warn("Failed to remove old volume",
+				zap.String("resource", res.Name),
+				zap.String("node", node),
+				zap.String("device", cp.source),
+				zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// createConvertedVolume creates the volume of the new storage type on a node.
+// A leftover of an earlier attempt is replaced, the volume names belong to
+// the resource.
+func (rm *ResourceManager) createConvertedVolume(ctx context.Context, address string, cp *poolCopy, storageType, pool string, sizeMiB uint64) error {
+	ctx = rm.controller.stepContext(ctx, StepVolumeCreate)
+	backend, err := rm.controller.storage.Backend(storageType)
+	if err != nil {
+		return err
+	}
+
+	_ = backend.DeleteVolume(ctx, address, pool, cp.vol.VolumeName)
+	if err := backend.CreateVolume(ctx, address, pool, cp.vol.VolumeName, sizeMiB<<20); err != nil {
+		return fmt.Errorf("failed to create %s: %w", cp.dest, err)
+	}
+	return nil
+}
+
+// execSucceeded returns the error of a remote command, with the output of
+// the host it failed on
+func execSucceeded(result *deployment.ExecResult, err error) error {
+	if err != nil {
+		return err
+	}
+	for host, r := range result.Hosts {
+		if !r.Success {
+			return fmt.Errorf("failed on %s: %s", host, strings.TrimSpace(r.Output))
+		}
+	}
+	return nil
+}
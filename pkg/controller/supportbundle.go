@@ -0,0 +1,315 @@
+package controller
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"github.com/liliang-cn/sds/pkg/deployment"
+	"github.com/liliang-cn/sds/pkg/secrets"
+	"github.com/liliang-cn/sds/pkg/version"
+	"go.uber.org/zap"
+)
+
+// Defaults of a support bundle
+const (
+	defaultBundleLogLines = 2000
+	defaultBundleJobs     = 50
+	maxBundleLogLines     = 100000
+)
+
+// controllerUnit is the systemd unit of the controller, see
+// configs/sds-controller.service
+const controllerUnit = "sds-controller"
+
+// bundleFileMarker starts each file in the output of bundleNodeCmd
+const bundleFileMarker = "==> sds-bundle: "
+
+// bundleNodeCmd collects the DRBD and drbd-reactor state, configs and logs
+// of a node, one file after each bundleFileMarker line
+const bundleNodeCmd = `m='` + bundleFileMarker + `'
+echo "${m}drbd-status.txt"; sudo drbdsetup status --verbose --statistics 2>&1
+echo "${m}drbd-events.txt"; sudo drbdsetup events2 --now --statistics 2>&1
+echo "${m}drbd-version.txt"; cat /proc/drbd 2>&1; drbdadm --version 2>&1
+echo "${m}reactor-status.txt"; sudo drbd-reactorctl status 2>&1; systemctl status drbd-reactor --no-pager 2>&1
+echo "${m}reactor.log"; sudo journalctl -u drbd-reactor --no-pager -o short-iso -n %[1]d 2>&1
+echo "${m}kernel.log"; sudo journalctl -k --no-pager -o short-iso -n %[1]d 2>&1 | grep -i drbd
+for f in /etc/drbd.conf /etc/drbd.d/* /etc/drbd-reactor.toml /etc/drbd-reactor.d/*; do
+  [ -f "$f" ] && { echo "${m}config$f"; sudo cat "$f" 2>&1; }
+done
+true`
+
+// sensitiveFieldPattern matches JSON fields whose values a support bundle
+// leaves out, like the redaction of logged commands
+var sensitiveFieldPattern = regexp.MustCompile(`(?i)password|passwd|passphrase|secret|token|authorization|headers`)
+
+// SupportBundleOptions selects what a support bundle collects
+type SupportBundleOptions struct {
+	Nodes    []string // Node names or addresses, all nodes if empty
+	LogLines int      // Recent log lines per log, 0 for the default
+	Jobs     int      // Recent jobs with their command transcripts, 0 for the default
+}
+
+// bundleFile is a file of a support bundle
+type bundleFile struct {
+	name string
+	data []byte
+}
+
+// WriteSupportBundle writes a gzipped tarball for bug reports to w: the
+// controller log, a database dump, the command transcripts of recent jobs
+// and, per node, the DRBD status and configs and the drbd-reactor status
+// and log. Credentials are redacted. Whatever could not be collected is
+// listed in errors.txt instead of failing the bundle.
+func (c *Controller) WriteSupportBundle(ctx context.Context, opts SupportBundleOptions, w io.Writer) error {
+	if c.db == nil {
+		return fmt.Errorf("database not available")
+	}
+	if opts.LogLines <= 0 {
+		opts.LogLines = defaultBundleLogLines
+	}
+	if opts.LogLines > maxBundleLogLines {
+		opts.LogLines = maxBundleLogLines
+	}
+	if opts.Jobs <= 0 {
+		opts.Jobs = defaultBundleJobs
+	}
+
+	nodes := opts.Nodes
+	if len(nodes) == 0 {
+		nodes = []string{"all"}
+	}
+	names, addresses, err := c.execTargets(ctx, nodes)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	prefix := "sds-support-" + now.UTC().Format("20060102-150405")
+	c.logger.Info("Collecting support bundle",
+		zap.Strings("nodes", names),
+		zap.Int("log_lines", opts.LogLines),
+		zap.Int("jobs", opts.Jobs))
+
+	var files []bundleFile
+	var problems []string
+	add := func(name string, data []byte) {
+		files = append(files, bundleFile{name: path.Join(prefix, name), data: data})
+	}
+
+	manifest, _ := json.MarshalIndent(map[string]interface{}{
+		"created_at": now.UTC().Format(time.RFC3339),
+		"controller": version.Get(),
+		"nodes":      names,
+		"log_lines":  opts.LogLines,
+		"jobs":       opts.Jobs,
+	}, "", "  ")
+	add("manifest.json", manifest)
+
+	if log, err := controllerLog(ctx, opts.LogLines); err != nil {
+		problems = append(problems, fmt.Sprintf("controller log: %v", err))
+	} else {
+		add("controller/controller.log", []byte(deployment.Redact(log)))
+	}
+
+	if dump, err := c.db.Dump(ctx); err != nil {
+		problems = append(problems, fmt.Sprintf("database: %v", err))
+	} else {
+		data, err := json.MarshalIndent(redactDump(dump), "", "  ")
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("database: %v", err))
+		} else {
+			add("controller/database.json", data)
+		}
+	}
+
+	jobs, err := c.db.ListJobs(ctx, "", opts.Jobs)
+	if err != nil {
+		problems = append(problems, fmt.Sprintf("jobs: %v", err))
+	}
+	for _, job := range jobs {
+		add(fmt.Sprintf("jobs/%06d-%s.txt", job.ID, job.Operation), []byte(jobTranscript(job)))
+	}
+
+	nodeFiles := make([][]bundleFile, len(addresses))
+	nodeErrors := make([]error, len(addresses))
+	var wg sync.WaitGroup
+	for i := range addresses {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			output, err := c.execOutput(ctx, addresses[i], fmt.Sprintf(bundleNodeCmd, opts.LogLines))
+			if err != nil && output == "" {
+				nodeErrors[i] = err
+				return
+			}
+			nodeFiles[i] = parseBundleFiles(deployment.Redact(output))
+		}()
+	}
+	wg.Wait()
+	for i, name := range names {
+		if nodeErrors[i] != nil {
+			problems = append(problems, fmt.Sprintf("node %s: %v", name, nodeErrors[i]))
+			continue
+		}
+		for _, f := range nodeFiles[i] {
+			add(path.Join("nodes", name, f.name), f.data)
+		}
+	}
+
+	if len(problems) > 0 {
+		add("errors.txt", []byte(strings.Join(problems, "\n")+"\n"))
+	}
+
+	if err := writeTarball(w, files, now); err != nil {
+		return fmt.Errorf("failed to write support bundle: %w", err)
+	}
+
+	c.RecordEvent(ctx, EventSupportBundle, "", fmt.Sprintf("Support bundle of %d file(s) collected for %s", len(files), peerAddress(ctx)),
+		map[string]string{"nodes": strings.Join(names, ","), "problems": fmt.Sprint(len(problems))})
+	return nil
+}
+
+// controllerLog returns the recent journal of the controller on its host
+func controllerLog(ctx context.Context, lines int) (string, error) {
+	cmd := exec.CommandContext(ctx, "journalctl", "-u", controllerUnit, "--no-pager", "-o", "short-iso", "-n", fmt.Sprint(lines))
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("journalctl -u %s: %w: %s", controllerUnit, err, strings.TrimSpace(string(output)))
+	}
+	return string(output), nil
+}
+
+// redactDump masks sensitive fields and values in a database dump
+func redactDump(dump map[string]map[string]json.RawMessage) map[string]map[string]interface{} {
+	redacted := make(map[string]map[string]interface{}, len(dump))
+	for bucket, records := range dump {
+		redacted[bucket] = make(map[string]interface{}, len(records))
+		for key, data := range records {
+			var value interface{}
+			if err := json.Unmarshal(data, &value); err != nil {
+				continue
+			}
+			redacted[bucket][key] = redactValue(value, false)
+		}
+	}
+	return redacted
+}
+
+// redactValue masks the strings of sensitive fields, and sensitive flags
+// and registered values in all other strings. Secret references are kept,
+// they do not reveal the secret.
+func redactValue(value interface{}, sensitive bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			v[key] = redactValue(field, sensitive || sensitiveFieldPattern.MatchString(key))
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item, sensitive)
+		}
+		return v
+	case string:
+		if sensitive && v != "" && !secrets.IsRef(v) {
+			return secrets.Redacted
+		}
+		return deployment.Redact(v)
+	}
+	return value
+}
+
+// jobTranscript renders a job and the remote commands it ran
+func jobTranscript(job *database.Job) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Job %d: %s %s\n", job.ID, job.Operation, job.Target)
+	fmt.Fprintf(&b, "State: %s\n", job.State)
+	fmt.Fprintf(&b, "Started: %s\n", job.StartedAt.UTC().Format(time.RFC3339))
+	if !job.FinishedAt.IsZero() {
+		fmt.Fprintf(&b, "Finished: %s (%s)\n", job.FinishedAt.UTC().Format(time.RFC3339), job.FinishedAt.Sub(job.StartedAt).Round(time.Millisecond))
+	}
+	if job.Message != "" {
+		fmt.Fprintf(&b, "Message: %s\n", deployment.Redact(job.Message))
+	}
+	if job.ResolvedBy != 0 {
+		fmt.Fprintf(&b, "Resolved by job %d: %s\n", job.ResolvedBy, job.Resolution)
+	}
+
+	for _, step := range job.Steps {
+		result := "ok"
+		if !step.Success {
+			result = fmt.Sprintf("exit %d", step.ExitCode)
+		}
+		if step.Slow {
+			result += ", slow"
+		}
+		fmt.Fprintf(&b, "\n[%s] %s on %s (%s, %s)\n$ %s\n",
+			step.StartedAt.UTC().Format("15:04:05.000"), step.Name, step.Node, step.Duration.Round(time.Millisecond), result, step.Command)
+		if step.Output != "" {
+			b.WriteString(strings.TrimRight(step.Output, "\n"))
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// parseBundleFiles splits the output of bundleNodeCmd into its files
+func parseBundleFiles(output string) []bundleFile {
+	var files []bundleFile
+	var current *bundleFile
+	var content strings.Builder
+	flush := func() {
+		if current != nil {
+			current.data = []byte(content.String())
+			files = append(files, *current)
+		}
+		content.Reset()
+	}
+	for _, line := range strings.SplitAfter(output, "\n") {
+		if name, ok := strings.CutPrefix(line, bundleFileMarker); ok {
+			flush()
+			current = &bundleFile{name: path.Clean("/" + strings.TrimSpace(name))[1:]}
+			continue
+		}
+		content.WriteString(line)
+	}
+	flush()
+	sort.SliceStable(files, func(i, j int) bool { return files[i].name < files[j].name })
+	return files
+}
+
+// writeTarball writes files as a gzipped tarball
+func writeTarball(w io.Writer, files []bundleFile, modTime time.Time) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	for _, f := range files {
+		header := &tar.Header{
+			Name:    f.name,
+			Mode:    0600,
+			Size:    int64(len(f.data)),
+			ModTime: modTime,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tw.Write(f.data); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
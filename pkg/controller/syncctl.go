@@ -0,0 +1,183 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// defaultSyncRateDuration is how long a resync rate override lasts when no
+// duration is given
+const defaultSyncRateDuration = time.Hour
+
+// syncRatePattern matches DRBD rates, KiB/s unless suffixed with k, M or G
+var syncRatePattern = regexp.MustCompile(`^[0-9]+[kKmMgG]?$`)
+
+// syncTargets returns the nodes of a resource to run a resync command on and
+// the drbdadm target: the resource on all of its nodes, or with a peer its
+// connections to the peer on the other nodes
+func (rm *ResourceManager) syncTargets(ctx context.Context, resource, peer string) ([]string, string, error) {
+	if rm.deployment == nil {
+		return nil, "", fmt.Errorf("deployment client not set")
+	}
+	nodes, err := rm.resourceNodeNames(ctx, resource)
+	if err != nil {
+		return nil, "", err
+	}
+	if peer == "" {
+		addresses := make([]string, len(nodes))
+		for i, node := range nodes {
+			addresses[i] = rm.nodeAddress(node)
+		}
+		return addresses, resource, nil
+	}
+
+	name := rm.resourceNodeName(ctx, resource, peer)
+	if name == "" {
+		return nil, "", fmt.Errorf("node %s does not replicate resource %s", peer, resource)
+	}
+	var addresses []string
+	for _, node := range nodes {
+		if node != name {
+			addresses = append(addresses, rm.nodeAddress(node))
+		}
+	}
+	return addresses, resource + ":" + name, nil
+}
+
+// PauseSync pauses the resync of a resource, or of its connections to peer,
+// until ResumeSync. Replication of new writes goes on.
+func (rm *ResourceManager) PauseSync(ctx context.Context, resource, peer string) error {
+	addresses, target, err := rm.syncTargets(ctx, resource, peer)
+	if err != nil {
+		return err
+	}
+	rm.controller.logger.Info("Pausing resync", zap.String("target", target))
+	if err := rm.execAll(ctx, addresses, "sudo drbdadm pause-sync "+target); err != nil {
+		return fmt.Errorf("failed to pause resync: %w", err)
+	}
+	rm.controller.RecordEvent(ctx, EventSyncPaused, resource, fmt.Sprintf("Resync of %s paused", target),
+		map[string]string{"peer": peer})
+	return nil
+}
+
+// ResumeSync resumes a resync paused by PauseSync
+func (rm *ResourceManager) ResumeSync(ctx context.Context, resource, peer string) error {
+	addresses, target, err := rm.syncTargets(ctx, resource, peer)
+	if err != nil {
+		return err
+	}
+	rm.controller.logger.Info("Resuming resync", zap.String("target", target))
+	if err := rm.execAll(ctx, addresses, "sudo drbdadm resume-sync "+target); err != nil {
+		return fmt.Errorf("failed to resume resync: %w", err)
+	}
+	rm.controller.RecordEvent(ctx, EventSyncResumed, resource, fmt.Sprintf("Resync of %s resumed", target),
+		map[string]string{"peer": peer})
+	return nil
+}
+
+// SetSyncRate sets a fixed resync rate on a resource, or on its connections
+// to peer, without changing its DRBD config. The dynamic resync controller
+// is turned off meanwhile. The override replaces an earlier one and is
+// reverted after duration, also across controller restarts.
+func (rm *ResourceManager) SetSyncRate(ctx context.Context, resource, peer, rate string, duration time.Duration) (*database.SyncRateOverride, error) {
+	if rm.controller.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+	if !syncRatePattern.MatchString(rate) {
+		return nil, fmt.Errorf("invalid resync rate %q, e.g. 20M", rate)
+	}
+	if duration <= 0 {
+		duration = defaultSyncRateDuration
+	}
+	addresses, target, err := rm.syncTargets(ctx, resource, peer)
+	if err != nil {
+		return nil, err
+	}
+
+	// A narrower override must not leave the previous one on other peers
+	if previous, err := rm.controller.db.GetSyncRateOverride(ctx, resource); err == nil && previous.Peer != peer {
+		if err := rm.revertSyncRate(ctx, resource); err != nil {
+			return nil, err
+		}
+	}
+
+	rm.controller.logger.Info("Overriding resync rate",
+		zap.String("target", target),
+		zap.String("rate", rate),
+		zap.Duration("duration", duration))
+	cmd := fmt.Sprintf("sudo drbdadm peer-device-options --c-plan-ahead=0 --resync-rate=%s %s", rate, target)
+	if err := rm.execAll(ctx, addresses, cmd); err != nil {
+		return nil, fmt.Errorf("failed to set resync rate: %w", err)
+	}
+
+	override := &database.SyncRateOverride{
+		Resource:  resource,
+		Peer:      peer,
+		Rate:      rate,
+		ExpiresAt: time.Now().Add(duration),
+	}
+	if err := rm.controller.db.SaveSyncRateOverride(ctx, override); err != nil {
+		return nil, fmt.Errorf("resync rate set, but failed to save it for reverting: %w", err)
+	}
+	rm.controller.RecordEvent(ctx, EventSyncRate, resource,
+		fmt.Sprintf("Resync rate of %s set to %s until %s", target, rate, override.ExpiresAt.Format(time.RFC3339)),
+		map[string]string{"peer": peer, "rate": rate})
+	return override, nil
+}
+
+// ClearSyncRate reverts the resync rate override of a resource to its DRBD
+// config
+func (rm *ResourceManager) ClearSyncRate(ctx context.Context, resource string) error {
+	if rm.controller.db == nil {
+		return fmt.Errorf("database not available")
+	}
+	if _, err := rm.controller.db.GetSyncRateOverride(ctx, resource); err != nil {
+		return fmt.Errorf("resource %s has no resync rate override", resource)
+	}
+	if err := rm.revertSyncRate(ctx, resource); err != nil {
+		return err
+	}
+	rm.controller.RecordEvent(ctx, EventSyncRate, resource,
+		fmt.Sprintf("Resync rate of %s reverted to its config", resource), nil)
+	return nil
+}
+
+// revertSyncRate applies the configured peer device options of a resource
+// again on all of its nodes and forgets its override
+func (rm *ResourceManager) revertSyncRate(ctx context.Context, resource string) error {
+	addresses, _, err := rm.syncTargets(ctx, resource, "")
+	if err != nil {
+		return err
+	}
+	if err := rm.execAll(ctx, addresses, "sudo drbdadm adjust "+resource); err != nil {
+		return fmt.Errorf("failed to revert resync rate: %w", err)
+	}
+	return rm.controller.db.DeleteSyncRateOverride(ctx, resource)
+}
+
+// expireSyncRates reverts the resync rate overrides that expired. Failed
+// reverts are retried on the next call.
+func (c *Controller) expireSyncRates(ctx context.Context) {
+	if c.db == nil {
+		return
+	}
+	overrides, err := c.db.ListSyncRateOverrides(ctx)
+	if err != nil {
+		return
+	}
+	for _, override := range overrides {
+		if time.Now().Before(override.ExpiresAt) {
+			continue
+		}
+		if err := c.resources.ClearSyncRate(ctx, override.Resource); err != nil {
+			c.logger.Warn("Failed to revert expired resync rate",
+				zap.String("resource", override.Resource),
+				zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liliang-cn/sds/pkg/gateway"
+)
+
+// checkTenantNetwork checks the tenant network of a new gateway and returns
+// the interface its VIP is probed and brought up on, "" without a tenant
+// network. Gateways on the same interface and VLAN share its routing table,
+// so they must use the same router.
+func (c *Controller) checkTenantNetwork(ctx context.Context, name, iface string, vlan uint32, router string) (string, error) {
+	if iface == "" {
+		return "", nil
+	}
+	dev, err := gateway.TenantDevice(iface, vlan)
+	if err != nil {
+		return "", fmt.Errorf("invalid tenant network: %w", err)
+	}
+	if c.db == nil {
+		return dev, nil
+	}
+
+	gateways, err := c.db.ListGateways(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list gateways: %w", err)
+	}
+	for _, other := range gateways {
+		if other.Name == name || gatewayConfigString(other, "interface") != iface {
+			continue
+		}
+		if fmt.Sprint(other.Config["vlan"]) != fmt.Sprint(vlan) {
+			continue
+		}
+		if used := gatewayConfigString(other, "router"); used != router {
+			if used == "" {
+				used = "none"
+			}
+			return "", fmt.Errorf("tenant network %s is used by gateway %s with router %s", dev, other.Name, used)
+		}
+	}
+	return dev, nil
+}
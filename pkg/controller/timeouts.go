@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/deployment"
+)
+
+// Orchestration steps with their own timeout budget
+const (
+	StepPoolCreate   = "pool_create"
+	StepVolumeCreate = "volume_create"
+	StepMetadata     = "metadata"
+	StepDrbdUp       = "drbd_up"
+	StepPromote      = "promote"
+	StepMkfs         = "mkfs"
+	StepSnapshot     = "snapshot"
+	StepCopy         = "copy"
+)
+
+// defaultStepTimeouts are used for steps without a configured budget
+var defaultStepTimeouts = map[string]time.Duration{
+	StepPoolCreate:   10 * time.Minute,
+	StepVolumeCreate: 5 * time.Minute,
+	StepMetadata:     5 * time.Minute,
+	StepDrbdUp:       2 * time.Minute,
+	StepPromote:      2 * time.Minute,
+	StepMkfs:         30 * time.Minute,
+	StepSnapshot:     5 * time.Minute,
+	StepCopy:         6 * time.Hour,
+}
+
+// stepTimeout returns the timeout budget of an orchestration step
+func (c *Controller) stepTimeout(step string) time.Duration {
+	if c.config != nil {
+		t := c.config.Timeouts
+		configured := map[string]time.Duration{
+			StepPoolCreate:   t.PoolCreate,
+			StepVolumeCreate: t.VolumeCreate,
+			StepMetadata:     t.Metadata,
+			StepDrbdUp:       t.DrbdUp,
+			StepPromote:      t.Promote,
+			StepMkfs:         t.Mkfs,
+			StepSnapshot:     t.Snapshot,
+			StepCopy:         t.Copy,
+		}
+		if d := configured[step]; d > 0 {
+			return d
+		}
+	}
+	return defaultStepTimeouts[step]
+}
+
+// stepContext returns a context under which the remote commands of a step
+// run with the step's timeout budget. The step is journaled with the job
+// first, so a crash leaves a record of where the operation stopped.
+func (c *Controller) stepContext(ctx context.Context, step string) context.Context {
+	c.journalStep(ctx, step)
+	return deployment.WithStepTimeout(ctx, c.stepTimeout(step))
+}
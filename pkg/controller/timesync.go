@@ -0,0 +1,237 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Time sources keeping the clock of a node in sync
+const (
+	TimeSourceChrony    = "chrony"
+	TimeSourceTimesyncd = "timesyncd"
+)
+
+// timeCheckCmd prints the clock of a node first, then the state of chrony and
+// of the systemd NTP client
+const timeCheckCmd = `date +%s.%N; ` +
+	`echo '#chrony'; chronyc -n tracking 2>/dev/null; ` +
+	`echo '#timedatectl'; timedatectl show -p NTP -p NTPSynchronized 2>/dev/null`
+
+// NodeTime is the clock of a node as found by the last time check
+type NodeTime struct {
+	Node string `json:"node"`
+	// Least difference of the node's clock to the controller's, positive
+	// when the node is ahead. The node reads its clock at some point during
+	// the remote command, a difference within the round trip is not skew.
+	Skew      time.Duration `json:"skew"`
+	RoundTrip time.Duration `json:"round_trip"`
+	// chrony or timesyncd keep the clock synchronized to their NTP source
+	Synchronized bool          `json:"synchronized"`
+	Source       string        `json:"source,omitempty"`        // chrony, timesyncd, or empty if neither runs
+	Server       string        `json:"server,omitempty"`        // NTP server chrony follows
+	SourceOffset time.Duration `json:"source_offset,omitempty"` // Offset to the NTP source as chrony reports it
+	Warnings     []string      `json:"warnings,omitempty"`
+	Error        string        `json:"error,omitempty"`
+	CheckedAt    time.Time     `json:"checked_at"`
+}
+
+// CheckTime compares the clocks of the given nodes, all nodes if none are
+// given, with the controller's and records the results in the node health
+// and the metrics. Nodes skewed by more than timesync.max_skew or without a
+// synchronized NTP client get warnings.
+func (c *Controller) CheckTime(ctx context.Context, nodes []string) ([]*NodeTime, error) {
+	if len(nodes) == 0 {
+		nodes = []string{"all"}
+	}
+	names, addresses, err := c.execTargets(ctx, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	times := make([]*NodeTime, 0, len(names))
+	for i, name := range names {
+		t := c.checkNodeTime(ctx, name, addresses[i])
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		c.recordNodeTime(ctx, addresses[i], t)
+		times = append(times, t)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Node < times[j].Node })
+	return times, nil
+}
+
+// runTimeChecker periodically checks the clocks of all nodes until the
+// controller is stopped
+func (c *Controller) runTimeChecker(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		ctx, cancel := context.WithTimeout(c.ctx, interval)
+		if _, err := c.CheckTime(ctx, nil); err != nil {
+			c.logger.Debug("Time check failed", zap.Error(err))
+		}
+		cancel()
+	}
+}
+
+// checkNodeTime reads the clock of a node and compares it with the
+// controller's before and after the command
+func (c *Controller) checkNodeTime(ctx context.Context, name, address string) *NodeTime {
+	start := time.Now()
+	output, err := c.execOutput(ctx, address, timeCheckCmd)
+	end := time.Now()
+
+	t := &NodeTime{Node: name, RoundTrip: end.Sub(start), CheckedAt: end}
+	if err != nil {
+		t.Error = err.Error()
+		return t
+	}
+	clock, err := parseNodeTime(output, t)
+	if err != nil {
+		t.Error = err.Error()
+		return t
+	}
+	switch {
+	case clock.Before(start):
+		t.Skew = clock.Sub(start)
+	case clock.After(end):
+		t.Skew = clock.Sub(end)
+	}
+
+	t.Warnings = c.timeWarnings(t)
+	return t
+}
+
+// parseNodeTime parses the output of timeCheckCmd into t and returns the
+// clock of the node
+func parseNodeTime(output string, t *NodeTime) (time.Time, error) {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	seconds, err := strconv.ParseFloat(strings.TrimSpace(lines[0]), 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unexpected output of date: %q", lines[0])
+	}
+	whole := int64(seconds)
+	clock := time.Unix(whole, int64((seconds-float64(whole))*1e9))
+
+	section := ""
+	for _, line := range lines[1:] {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "#") {
+			section = strings.TrimPrefix(line, "#")
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if section == "timedatectl" {
+			key, value, ok = strings.Cut(line, "=")
+		}
+		if !ok {
+			continue
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+
+		switch section {
+		case "chrony":
+			// chronyc tracking answers only while chronyd runs
+			t.Source = TimeSourceChrony
+			switch key {
+			case "Reference ID":
+				// "0A000001 (ntp.example.com)"
+				if open := strings.Index(value, "("); open >= 0 {
+					t.Server = strings.TrimSuffix(value[open+1:], ")")
+				}
+			case "System time":
+				// "0.000012345 seconds slow of NTP time"
+				fields := strings.Fields(value)
+				if len(fields) >= 3 {
+					if offset, err := strconv.ParseFloat(fields[0], 64); err == nil {
+						if fields[2] == "slow" {
+							offset = -offset
+						}
+						t.SourceOffset = time.Duration(offset * float64(time.Second))
+					}
+				}
+			case "Leap status":
+				t.Synchronized = value != "Not synchronised"
+			}
+		case "timedatectl":
+			if t.Source == TimeSourceChrony {
+				continue
+			}
+			switch key {
+			case "NTP":
+				if value == "yes" {
+					t.Source = TimeSourceTimesyncd
+				}
+			case "NTPSynchronized":
+				t.Synchronized = value == "yes"
+			}
+		}
+	}
+	return clock, nil
+}
+
+// timeWarnings returns what is wrong with the clock of a node
+func (c *Controller) timeWarnings(t *NodeTime) []string {
+	var warnings []string
+	maxSkew := c.config.TimeSync.MaxSkew
+	if skew := t.Skew.Abs(); maxSkew > 0 && skew > maxSkew {
+		direction := "ahead of"
+		if t.Skew < 0 {
+			direction = "behind"
+		}
+		warnings = append(warnings, fmt.Sprintf("clock is %s %s the controller, more than timesync.max_skew %s",
+			skew.Round(time.Millisecond), direction, maxSkew))
+	}
+	switch {
+	case t.Source == "":
+		warnings = append(warnings, "no NTP client keeps the clock in sync, install chrony or enable systemd-timesyncd")
+	case !t.Synchronized:
+		warnings = append(warnings, fmt.Sprintf("%s has not synchronized the clock", t.Source))
+	}
+	return warnings
+}
+
+// recordNodeTime keeps the time check of a node for its health and the
+// metrics collector. New warnings are logged and recorded in the events
+// log, once until the node is fine again.
+func (c *Controller) recordNodeTime(ctx context.Context, address string, t *NodeTime) {
+	if t.Error != "" {
+		return
+	}
+	c.nodes.mu.Lock()
+	n := c.nodes.nodes[address]
+	if n == nil {
+		c.nodes.mu.Unlock()
+		return
+	}
+	previous := n.Time
+	n.Time = t
+	c.nodes.mu.Unlock()
+
+	if len(t.Warnings) == 0 || (previous != nil && len(previous.Warnings) > 0) {
+		return
+	}
+	for _, warning := range t.Warnings {
+		c.logger.Warn("Node clock problem", zap.String("node", t.Node), zap.String("warning", warning))
+	}
+	c.RecordEvent(ctx, EventClockSkew, "", fmt.Sprintf("%s: %s", t.Node, strings.Join(t.Warnings, "; ")),
+		map[string]string{
+			"node":   t.Node,
+			"skew":   t.Skew.String(),
+			"source": t.Source,
+		})
+}
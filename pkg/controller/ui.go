@@ -11,15 +11,17 @@ import (
 	"go.uber.org/zap"
 )
 
-// UIServer serves the embedded web UI
+// UIServer serves the embedded web UI and, under /v1, the REST API, so the UI
+// reaches the API on its own origin whatever port the REST API listens on
 type UIServer struct {
 	logger *zap.Logger
 	server *http.Server
 	distFS fs.FS
+	api    http.Handler
 }
 
 // NewUIServer creates a new UI server
-func NewUIServer(logger *zap.Logger, listenAddress string, port int) (*UIServer, error) {
+func NewUIServer(logger *zap.Logger, listenAddress string, port int, api http.Handler) (*UIServer, error) {
 	// Get the subdirectory from the embed
 	distFS, err := fs.Sub(ui.FS, "dist")
 	if err != nil {
@@ -29,18 +31,25 @@ func NewUIServer(logger *zap.Logger, listenAddress string, port int) (*UIServer,
 	uiServer := &UIServer{
 		logger: logger,
 		distFS: distFS,
+		api:    api,
 	}
 
 	uiAddr := fmt.Sprintf("%s:%d", listenAddress, port)
 	uiServer.server = &http.Server{
-		Addr:    uiAddr,
-		Handler: uiServer,
+		Addr:      uiAddr,
+		Handler:   uiServer,
+		Protocols: httpProtocols(),
 	}
 
 	return uiServer, nil
 }
 
 func (s *UIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.api != nil && strings.HasPrefix(r.URL.Path, "/v1/") {
+		s.api.ServeHTTP(w, r)
+		return
+	}
+
 	// Add CORS headers
 	w.Header().Set("Access-Control-Allow-Origin", "*")
 	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
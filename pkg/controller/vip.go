@@ -0,0 +1,383 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"net/netip"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/liliang-cn/sds/pkg/config"
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// vipAuto requests a VIP allocated from an IPAM pool
+const vipAuto = "auto"
+
+// vipProbeAttempts bounds how many pool addresses are ARP-probed per allocation
+const vipProbeAttempts = 16
+
+// VIPPoolUsage is the utilization of an IPAM pool
+type VIPPoolUsage struct {
+	Name  string
+	Range string
+	Total int
+	Used  int
+}
+
+// haVIPOwner returns the VIP owner of an HA config
+func haVIPOwner(resource string) string {
+	return "ha/" + resource
+}
+
+// gatewayVIPOwner returns the VIP owner of a gateway
+func gatewayVIPOwner(name string) string {
+	return "gateway/" + name
+}
+
+// ReserveVIP checks and reserves the VIP of an HA config or gateway and
+// returns it as address/prefix. "auto" allocates the first free address of
+// pool. A VIP must not be used by another HA config or gateway and must not
+// answer ARP on the network of the resource's first node, probed on dev or
+// on the interface that routes to the VIP.
+func (c *Controller) ReserveVIP(ctx context.Context, vip, pool, owner, resource, dev string) (string, error) {
+	return c.checkVIP(ctx, vip, pool, owner, resource, dev, true)
+}
+
+// PreviewVIP returns the VIP ReserveVIP would reserve, after the same checks,
+// without reserving it. Dry runs use it.
+func (c *Controller) PreviewVIP(ctx context.Context, vip, pool, owner, resource, dev string) (string, error) {
+	return c.checkVIP(ctx, vip, pool, owner, resource, dev, false)
+}
+
+// checkVIP checks a VIP for ReserveVIP and PreviewVIP, reserving it if asked
+func (c *Controller) checkVIP(ctx context.Context, vip, pool, owner, resource, dev string, reserve bool) (string, error) {
+	if vip == "" {
+		return "", nil
+	}
+	if c.db == nil {
+		// Nothing to check against, use the VIP as given
+		if vip == vipAuto {
+			return "", fmt.Errorf("VIP allocation needs the database")
+		}
+		return vip, nil
+	}
+
+	used, err := c.vipsInUse(ctx)
+	if err != nil {
+		return "", err
+	}
+	probeNode := c.vipProbeNode(ctx, resource)
+
+	if vip == vipAuto {
+		return c.allocateVIP(ctx, pool, owner, used, probeNode, dev, reserve)
+	}
+
+	prefix, err := parseVIP(vip)
+	if err != nil {
+		return "", err
+	}
+	addr := prefix.Addr().String()
+	if current, ok := used[addr]; ok {
+		if current != owner {
+			return "", fmt.Errorf("VIP %s is already used by %s", addr, current)
+		}
+	} else if inUse, err := c.probeVIP(ctx, probeNode, addr, dev); err != nil {
+		return "", err
+	} else if inUse {
+		return "", fmt.Errorf("VIP %s answers ARP on the network of node %s, it is used outside of sds", addr, probeNode)
+	}
+
+	if !reserve {
+		return prefix.String(), nil
+	}
+	if err := c.db.ReserveVIP(ctx, &database.VIP{Address: addr, Prefix: prefix.Bits(), Owner: owner}); err != nil {
+		return "", err
+	}
+	return prefix.String(), nil
+}
+
+// ReleaseVIPs drops the VIP reservations of an owner
+func (c *Controller) ReleaseVIPs(ctx context.Context, owner string) {
+	if c.db == nil {
+		return
+	}
+	if err := c.db.ReleaseVIPs(ctx, owner); err != nil {
+		c.logger.Warn("Failed to release VIP",
+			zap.String("owner", owner),
+			zap.Error(err))
+	}
+}
+
+// ReleaseVIP drops the reservation of a VIP given as address/prefix or as a
+// bare address
+func (c *Controller) ReleaseVIP(ctx context.Context, vip string) {
+	if c.db == nil || vip == "" {
+		return
+	}
+	prefix, err := parseVIP(vip)
+	if err != nil {
+		return
+	}
+	if err := c.db.ReleaseVIP(ctx, prefix.Addr().String()); err != nil {
+		c.logger.Warn("Failed to release VIP",
+			zap.String("vip", vip),
+			zap.Error(err))
+	}
+}
+
+// ListVIPs returns the reserved VIPs together with the VIPs of HA configs and
+// gateways created before reservations were recorded, sorted by address
+func (c *Controller) ListVIPs(ctx context.Context) ([]*database.VIP, error) {
+	if c.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+
+	vips, err := c.db.ListVIPs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VIPs: %w", err)
+	}
+	known := make(map[string]bool)
+	for _, vip := range vips {
+		known[vip.Address] = true
+	}
+
+	untracked, err := c.configuredVIPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, vip := range untracked {
+		if !known[vip.Address] {
+			known[vip.Address] = true
+			vips = append(vips, vip)
+		}
+	}
+
+	sort.Slice(vips, func(i, j int) bool {
+		a, errA := netip.ParseAddr(vips[i].Address)
+		b, errB := netip.ParseAddr(vips[j].Address)
+		if errA != nil || errB != nil {
+			return vips[i].Address < vips[j].Address
+		}
+		return a.Less(b)
+	})
+	return vips, nil
+}
+
+// VIPPoolUsage returns the utilization of the configured IPAM pools
+func (c *Controller) VIPPoolUsage(vips []*database.VIP) []*VIPPoolUsage {
+	var names []string
+	for name := range c.config.IPAM.Pools {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var usage []*VIPPoolUsage
+	for _, name := range names {
+		pool, err := config.ParseVIPPool(c.config.IPAM.Pools[name])
+		if err != nil {
+			continue
+		}
+		u := &VIPPoolUsage{Name: name, Range: c.config.IPAM.Pools[name]}
+		size := new(big.Int).Sub(new(big.Int).SetBytes(pool.Last.AsSlice()), new(big.Int).SetBytes(pool.First.AsSlice()))
+		if size.IsInt64() && size.Int64() < math.MaxInt32 {
+			u.Total = int(size.Int64()) + 1
+		} else {
+			u.Total = math.MaxInt32
+		}
+		for _, vip := range vips {
+			if addr, err := netip.ParseAddr(vip.Address); err == nil && !addr.Less(pool.First) && !pool.Last.Less(addr) {
+				u.Used++
+			}
+		}
+		usage = append(usage, u)
+	}
+	return usage
+}
+
+// allocateVIP reserves the first address of a pool that is neither used by
+// sds nor answers ARP, or only finds it without reserve
+func (c *Controller) allocateVIP(ctx context.Context, poolName, owner string, used map[string]string, probeNode, dev string, reserve bool) (string, error) {
+	if poolName == "" {
+		return "", fmt.Errorf("a VIP pool is required to allocate a VIP")
+	}
+	spec, ok := c.config.IPAM.Pools[poolName]
+	if !ok {
+		return "", fmt.Errorf("unknown VIP pool %q", poolName)
+	}
+	pool, err := config.ParseVIPPool(spec)
+	if err != nil {
+		return "", fmt.Errorf("invalid VIP pool %s: %w", poolName, err)
+	}
+
+	// Re-creating an owner keeps its address
+	for addr, current := range used {
+		if current != owner {
+			continue
+		}
+		if a, err := netip.ParseAddr(addr); err == nil && !a.Less(pool.First) && !pool.Last.Less(a) {
+			return netip.PrefixFrom(a, pool.Prefix).String(), nil
+		}
+	}
+
+	probes := 0
+	for addr := pool.First; addr.IsValid() && !pool.Last.Less(addr); addr = addr.Next() {
+		if _, taken := used[addr.String()]; taken {
+			continue
+		}
+		if probes == vipProbeAttempts {
+			return "", fmt.Errorf("no free VIP found in pool %s after probing %d addresses", poolName, probes)
+		}
+		probes++
+
+		inUse, err := c.probeVIP(ctx, probeNode, addr.String(), dev)
+		if err != nil {
+			return "", err
+		}
+		if inUse {
+			c.logger.Warn("Pool address answers ARP, skipping",
+				zap.String("pool", poolName),
+				zap.String("address", addr.String()))
+			continue
+		}
+
+		if !reserve {
+			return netip.PrefixFrom(addr, pool.Prefix).String(), nil
+		}
+		vip := &database.VIP{Address: addr.String(), Prefix: pool.Prefix, Owner: owner, Pool: poolName}
+		if err := c.db.ReserveVIP(ctx, vip); err != nil {
+			// Taken concurrently, try the next one
+			continue
+		}
+		return netip.PrefixFrom(addr, pool.Prefix).String(), nil
+	}
+
+	return "", fmt.Errorf("VIP pool %s is exhausted", poolName)
+}
+
+// vipsInUse maps the addresses of all known VIPs to their owner
+func (c *Controller) vipsInUse(ctx context.Context) (map[string]string, error) {
+	vips, err := c.ListVIPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	used := make(map[string]string, len(vips))
+	for _, vip := range vips {
+		used[vip.Address] = vip.Owner
+	}
+	return used, nil
+}
+
+// configuredVIPs returns the VIPs of HA configs and gateway service IPs
+func (c *Controller) configuredVIPs(ctx context.Context) ([]*database.VIP, error) {
+	var vips []*database.VIP
+	add := func(vip, owner string, createdAt time.Time) {
+		if prefix, err := parseVIP(vip); err == nil {
+			vips = append(vips, &database.VIP{
+				Address: prefix.Addr().String(), Prefix: prefix.Bits(), Owner: owner, CreatedAt: createdAt,
+			})
+		}
+	}
+
+	haConfigs, err := c.db.ListHaConfigs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list HA configs: %w", err)
+	}
+	for _, ha := range haConfigs {
+		if ha.VIP != "" {
+			add(ha.VIP, haVIPOwner(ha.Resource), ha.CreatedAt)
+		}
+	}
+
+	gateways, err := c.db.ListGateways(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list gateways: %w", err)
+	}
+	for _, gw := range gateways {
+		if ip := gatewayConfigString(gw, "service_ip"); ip != "" {
+			add(ip, gatewayVIPOwner(gw.Name), gw.CreatedAt)
+		}
+	}
+
+	return vips, nil
+}
+
+// vipProbeNode returns the address of the node the VIP of a resource is
+// ARP-probed from, or "" if the resource has no known nodes
+func (c *Controller) vipProbeNode(ctx context.Context, resource string) string {
+	nodes, err := c.resources.resourceNodeNames(ctx, resource)
+	if err != nil || len(nodes) == 0 {
+		return ""
+	}
+	return c.resources.nodeAddress(nodes[0])
+}
+
+// vipProbeCmd checks whether an address is configured on the node or answers
+// duplicate address detection on the given interface or the one that routes
+// to it
+const vipProbeCmd = `ip=%s; dev=%s; ` +
+	`if ip -o addr show | grep -q "inet6\? $ip/"; then echo local; exit 0; fi; ` +
+	`[ -n "$dev" ] || dev=$(ip -o route get "$ip" 2>/dev/null | sed -n 's/.* dev \([^ ]*\).*/\1/p'); ` +
+	`[ -n "$dev" ] || { echo noroute; exit 0; }; ` +
+	`[ -e "/sys/class/net/$dev" ] || { echo "no interface $dev"; exit 0; }; ` +
+	`command -v arping >/dev/null 2>&1 || { echo noarping; exit 0; }; ` +
+	`if sudo arping -D -q -c 2 -w 3 -I "$dev" "$ip"; then echo free; else echo taken; fi`
+
+// probeVIP reports whether an address is in use on the network of a node,
+// probed on dev if given. Without a node, route, interface or arping the
+// probe is skipped with a warning.
+func (c *Controller) probeVIP(ctx context.Context, node, addr, dev string) (bool, error) {
+	if node == "" {
+		c.logger.Warn("No node to probe the VIP from, skipping ARP probe", zap.String("vip", addr))
+		return false, nil
+	}
+
+	output, err := c.execOutput(ctx, node, fmt.Sprintf(vipProbeCmd, addr, dev))
+	if err != nil {
+		return false, fmt.Errorf("failed to probe VIP %s from %s: %w", addr, node, err)
+	}
+
+	switch result := strings.TrimSpace(output); result {
+	case "free":
+		return false, nil
+	case "taken", "local":
+		return true, nil
+	default:
+		c.logger.Warn("Cannot ARP-probe VIP, skipping",
+			zap.String("vip", addr),
+			zap.String("node", node),
+			zap.String("reason", result))
+		return false, nil
+	}
+}
+
+// sameVIP reports whether two VIPs have the same address
+func sameVIP(a, b string) bool {
+	pa, errA := parseVIP(a)
+	pb, errB := parseVIP(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return pa.Addr() == pb.Addr()
+}
+
+// parseVIP parses a VIP given as address/prefix or as a bare address, which
+// is a host route as in the promoter config
+func parseVIP(vip string) (netip.Prefix, error) {
+	if !strings.Contains(vip, "/") {
+		addr, err := netip.ParseAddr(vip)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid VIP %q: %w", vip, err)
+		}
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(vip)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid VIP %q: %w", vip, err)
+	}
+	return prefix, nil
+}
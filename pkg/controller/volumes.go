@@ -0,0 +1,236 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/liliang-cn/sds/pkg/database"
+	"go.uber.org/zap"
+)
+
+// GetVolume returns the record of a volume of a resource by its DRBD volume number
+func (rm *ResourceManager) GetVolume(ctx context.Context, resource string, volumeID uint32) (*database.Volume, error) {
+	volumes, err := rm.ListVolumes(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range volumes {
+		if uint32(v.VolumeID) == volumeID {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("volume %d of resource %s not found", volumeID, resource)
+}
+
+// ListVolumes lists volume records ordered by resource and volume number,
+// optionally only those of one resource
+func (rm *ResourceManager) ListVolumes(ctx context.Context, resource string) ([]*database.Volume, error) {
+	if rm.controller.db == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+
+	if resource != "" {
+		if _, err := rm.controller.db.GetResource(ctx, resource); err != nil {
+			return nil, fmt.Errorf("resource not found: %s", resource)
+		}
+	}
+
+	volumes, err := rm.controller.db.ListVolumes(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volumes: %w", err)
+	}
+
+	sort.Slice(volumes, func(i, j int) bool {
+		if volumes[i].ResourceName != volumes[j].ResourceName {
+			return volumes[i].ResourceName < volumes[j].ResourceName
+		}
+		return volumes[i].VolumeID < volumes[j].VolumeID
+	})
+	return volumes, nil
+}
+
+// attachVolumeRecords completes live volume info with the stored volume
+// records of a resource. Recorded volumes missing from the live status
+// (e.g. the resource is down) are appended.
+func (rm *ResourceManager) attachVolumeRecords(ctx context.Context, resource string, volumes []*ResourceVolumeInfo) []*ResourceVolumeInfo {
+	records, err := rm.controller.db.ListVolumes(ctx, resource)
+	if err != nil {
+		return volumes
+	}
+
+	for _, rec := range records {
+		var info *ResourceVolumeInfo
+		for _, v := range volumes {
+			if v.VolumeID == uint32(rec.VolumeID) {
+				info = v
+				break
+			}
+		}
+		if info == nil {
+			info = &ResourceVolumeInfo{
+				VolumeID: uint32(rec.VolumeID),
+				Device:   rec.Device,
+				SizeGB:   uint64(rec.SizeGB),
+			}
+			volumes = append(volumes, info)
+		}
+		info.Record = rec
+	}
+
+	return volumes
+}
+
+// backingDevicePath returns the block device of a volume as the backend of
+// its storage type names it, an LV path for types without a backend
+func backingDevicePath(storageType, pool, volumeName string) string {
+	if factory := storageBackendFactory(storageType); factory != nil {
+		return factory(nil).DevicePath(pool, volumeName)
+	}
+	return fmt.Sprintf("/dev/%s/%s", pool, volumeName)
+}
+
+// volumeBacking maps every node to the same backing device
+func volumeBacking(nodes []string, device string) map[string]string {
+	backing := make(map[string]string, len(nodes))
+	for _, node := range nodes {
+		backing[node] = device
+	}
+	return backing
+}
+
+// VolumeSize is the size of a backing volume as found on a node
+type VolumeSize struct {
+	SizeBytes      uint64 // Provisioned size
+	AllocatedBytes uint64 // Space actually allocated, less than the size for thin volumes
+}
+
+// volumeSizesCmd lists LV, zvol and block device sizes in bytes; the block
+// devices are appended as arguments of the loop
+const volumeSizesCmd = `sudo lvs --noheadings --units b --nosuffix --separator ' ' -o vg_name,lv_name,lv_size,data_percent 2>/dev/null; ` +
+	`echo '#zfs'; sudo zfs list -Hp -t volume -o name,volsize,referenced 2>/dev/null; ` +
+	`echo '#dev'; for d in _ %s; do [ "$d" = _ ] || echo "$d $(sudo blockdev --getsize64 $d 2>/dev/null)"; done`
+
+// VolumeSizes queries the sizes of backing volumes on the first reachable
+// node of their resource, with one command per node. Volumes whose size
+// cannot be determined are missing from the result.
+func (rm *ResourceManager) VolumeSizes(ctx context.Context, volumes []*database.Volume) map[*database.Volume]*VolumeSize {
+	sizes := make(map[*database.Volume]*VolumeSize)
+	if rm.deployment == nil || rm.controller.db == nil {
+		return sizes
+	}
+
+	// Group the volumes by the node they are queried on
+	type nodeQuery struct {
+		node    string
+		volumes []*database.Volume
+		devices []string
+	}
+	queries := make(map[string]*nodeQuery)
+	var addresses []string
+	for _, vol := range volumes {
+		nodes, err := rm.resourceNodeNames(ctx, vol.ResourceName)
+		if err != nil || len(nodes) == 0 {
+			continue
+		}
+		address := rm.nodeAddress(nodes[0])
+		q, ok := queries[address]
+		if !ok {
+			q = &nodeQuery{node: nodes[0]}
+			queries[address] = q
+			addresses = append(addresses, address)
+		}
+		q.volumes = append(q.volumes, vol)
+		if dev := vol.Backing[nodes[0]]; dev != "" && (vol.StorageType == StorageTypeRaw || vol.StorageType == StorageTypeFile) {
+			q.devices = append(q.devices, dev)
+		}
+	}
+
+	for _, address := range addresses {
+		q := queries[address]
+		output, err := rm.controller.execOutput(ctx, address, fmt.Sprintf(volumeSizesCmd, strings.Join(q.devices, " ")))
+		if err != nil {
+			rm.controller.logger.Debug("Failed to query volume sizes",
+				zap.String("node", q.node),
+				zap.Error(err))
+			continue
+		}
+
+		found := parseVolumeSizes(output)
+		for _, vol := range q.volumes {
+			key := vol.Pool + "/" + vol.VolumeName
+			if vol.StorageType == StorageTypeRaw || vol.StorageType == StorageTypeFile {
+				key = vol.Backing[q.node]
+			}
+			size, ok := found[key]
+			if !ok {
+				continue
+			}
+			if vol.StorageType == "zfs" {
+				// Thick zvols reserve their full size
+				size.AllocatedBytes = size.SizeBytes
+			}
+			sizes[vol] = size
+		}
+	}
+
+	return sizes
+}
+
+// parseVolumeSizes parses the output of volumeSizesCmd into sizes keyed by
+// vg/lv, zvol name or device path
+func parseVolumeSizes(output string) map[string]*VolumeSize {
+	sizes := make(map[string]*VolumeSize)
+	section := "lvm"
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "#") {
+			section = strings.TrimPrefix(line, "#")
+			continue
+		}
+		fields := strings.Fields(line)
+
+		switch section {
+		case "lvm":
+			// vg lv size [data_percent], the percentage is only set for thin volumes
+			if len(fields) < 3 {
+				continue
+			}
+			size, err := strconv.ParseUint(fields[2], 10, 64)
+			if err != nil {
+				continue
+			}
+			allocated := size
+			if len(fields) >= 4 {
+				if percent, err := strconv.ParseFloat(fields[3], 64); err == nil {
+					allocated = uint64(float64(size) * percent / 100)
+				}
+			}
+			sizes[fields[0]+"/"+fields[1]] = &VolumeSize{SizeBytes: size, AllocatedBytes: allocated}
+		case "zfs":
+			// name volsize referenced
+			if len(fields) < 3 {
+				continue
+			}
+			size, err1 := strconv.ParseUint(fields[1], 10, 64)
+			referenced, err2 := strconv.ParseUint(fields[2], 10, 64)
+			if err1 != nil || err2 != nil {
+				continue
+			}
+			sizes[fields[0]] = &VolumeSize{SizeBytes: size, AllocatedBytes: min(referenced, size)}
+		case "dev":
+			if len(fields) < 2 {
+				continue
+			}
+			size, err := strconv.ParseUint(fields[1], 10, 64)
+			if err != nil {
+				continue
+			}
+			sizes[fields[0]] = &VolumeSize{SizeBytes: size, AllocatedBytes: size}
+		}
+	}
+	return sizes
+}
@@ -1,11 +1,13 @@
 package database
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 	"time"
 
@@ -21,6 +23,24 @@ const (
 	volumesBucket   = "volumes"
 	gatewaysBucket  = "gateways"
 	haConfigsBucket = "ha_configs"
+	eventsBucket    = "events"
+
+	placementRulesBucket = "placement_rules"
+	secretsBucket        = "secrets"
+	settingsBucket       = "settings"
+	vipsBucket           = "vips"
+	drbdGlobalBucket     = "drbd_global"
+	jobsBucket           = "jobs"
+	netProbesBucket      = "net_probes"
+	poolUsageBucket      = "pool_usage"
+	snapshotHooksBucket  = "snapshot_hooks"
+	maintenanceBucket    = "maintenance_windows"
+	fenceBucket          = "fence_constraints"
+	activationsBucket    = "activations"
+	replicationBucket    = "replication_policies"
+	snapshotGroupsBucket = "snapshot_groups"
+	syncRatesBucket      = "sync_rate_overrides"
+	eventHooksBucket     = "event_hooks"
 )
 
 // DB holds the database connection
@@ -59,7 +79,7 @@ func Open(cfg *Config, logger *zap.Logger) (*DB, error) {
 
 	// Initialize buckets
 	if err := db.Update(func(tx *bolt.Tx) error {
-		buckets := []string{nodesBucket, poolsBucket, resourcesBucket, volumesBucket, gatewaysBucket, haConfigsBucket}
+		buckets := []string{nodesBucket, poolsBucket, resourcesBucket, volumesBucket, gatewaysBucket, haConfigsBucket, eventsBucket, placementRulesBucket, secretsBucket, settingsBucket, vipsBucket, drbdGlobalBucket, jobsBucket, netProbesBucket, poolUsageBucket, snapshotHooksBucket, maintenanceBucket, fenceBucket, activationsBucket, replicationBucket, snapshotGroupsBucket, syncRatesBucket, eventHooksBucket}
 		for _, bucket := range buckets {
 			_, err := tx.CreateBucketIfNotExists([]byte(bucket))
 			if err != nil {
@@ -90,6 +110,61 @@ func (db *DB) Close() error {
 	return db.db.Close()
 }
 
+// Ping checks the database can be read
+func (db *DB) Ping(ctx context.Context) error {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return db.db.View(func(tx *bolt.Tx) error {
+		if tx.Bucket([]byte(nodesBucket)) == nil {
+			return fmt.Errorf("bucket %s not found", nodesBucket)
+		}
+		return nil
+	})
+}
+
+// Dump returns the records of every bucket by bucket and key, for support
+// bundles. The secrets bucket is left out, its values are never exported;
+// values that are not JSON are dumped as strings.
+func (db *DB) Dump(ctx context.Context) (map[string]map[string]json.RawMessage, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	dump := make(map[string]map[string]json.RawMessage)
+	err := db.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			if string(name) == secretsBucket {
+				return nil
+			}
+			records := make(map[string]json.RawMessage)
+			err := b.ForEach(func(k, v []byte) error {
+				if v == nil {
+					return nil
+				}
+				if json.Valid(v) {
+					records[string(k)] = append(json.RawMessage(nil), v...)
+					return nil
+				}
+				data, err := json.Marshal(string(v))
+				if err != nil {
+					return err
+				}
+				records[string(k)] = data
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("failed to dump bucket %s: %w", name, err)
+			}
+			dump[string(name)] = records
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dump, nil
+}
+
 // ==================== NODE ====================
 
 // Node represents a stored node
@@ -100,8 +175,16 @@ type Node struct {
 	State     string
 	LastSeen  time.Time
 	Version   string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	// DRBD kernel module and drbd-utils versions, empty until detected
+	DrbdKernelVersion string `json:",omitempty"`
+	DrbdUtilsVersion  string `json:",omitempty"`
+	// Other component versions and when they were last collected
+	DrbdReactorVersion  string    `json:",omitempty"`
+	LvmVersion          string    `json:",omitempty"`
+	ZfsVersion          string    `json:",omitempty"`
+	ComponentsCheckedAt time.Time `json:",omitempty"`
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
 }
 
 // SaveNode saves or updates a node
@@ -277,6 +360,20 @@ type Resource struct {
 	Nodes     string
 	Protocol  string
 	Replicas  int
+	Options   map[string]string // DRBD options given at creation ("section/key")
+	// Protocol of the connections to a node, if it differs from Protocol,
+	// e.g. asynchronous replication to a remote site
+	PeerProtocols map[string]string `json:",omitempty"`
+	// Peer slots of the DRBD metadata, 0 for resources created before they
+	// were recorded (drbdadm sized them for the nodes at the time)
+	MaxPeers int `json:",omitempty"`
+	// Nodes whose metadata still has the previous peer slots while the
+	// slots are being changed
+	MaxPeersPending []string `json:",omitempty"`
+	// "stopped" while the resource is down on purpose, empty when started
+	DesiredState string `json:",omitempty"`
+	// Principal the resource belongs to, empty for shared resources
+	Owner     string `json:",omitempty"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -365,6 +462,14 @@ type HaConfig struct {
 	MountPoint string
 	FsType     string
 	Services   []string
+	DependsOn  []string // HA resources started before this one on the same node
+	// drbd-reactor failure handling, the zero values are the defaults
+	OnDemoteFailure    string // on-drbd-demote-failure action, "" is reboot
+	StopServicesOnExit bool
+	NoSecondaryForce   bool // secondary-force is on unless disabled
+	// drbd-reactor config name without .toml, "" for configs recorded
+	// before names were configurable (sds-ha-<resource>)
+	ConfigName string `json:",omitempty"`
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 }
@@ -464,6 +569,7 @@ type Gateway struct {
 	Config    map[string]interface{}
 	Status    string
 	ActiveNode string
+	Owner     string `json:",omitempty"` // Principal the gateway belongs to, empty when shared
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -553,8 +659,10 @@ type Volume struct {
 	VolumeName   string
 	VolumeID     int
 	Pool        string
+	StorageType string // lvm, lvm-thin, zfs or zfs-thin
 	SizeGB      int
 	Device      string
+	Backing     map[string]string // node name -> backing device
 	CreatedAt   time.Time
 }
 
@@ -582,7 +690,7 @@ func (db *DB) SaveVolume(ctx context.Context, volume *Volume) error {
 	})
 }
 
-// ListVolumes lists all volumes for a resource
+// ListVolumes lists all volumes for a resource, or of all resources if resourceName is empty
 func (db *DB) ListVolumes(ctx context.Context, resourceName string) ([]*Volume, error) {
 	db.mu.RLock()
 	defer db.mu.RUnlock()
@@ -591,8 +699,11 @@ func (db *DB) ListVolumes(ctx context.Context, resourceName string) ([]*Volume,
 	err := db.db.View(func(tx *bolt.Tx) error {
 		b := tx.Bucket([]byte(volumesBucket))
 		c := b.Cursor()
-		prefix := []byte(resourceName)
-		for k, v := c.Seek(prefix); k != nil && len(k) >= len(prefix) && string(k[:len(prefix)]) == resourceName; k, v = c.Next() {
+		prefix := []byte(resourceName + ":")
+		if resourceName == "" {
+			prefix = []byte{}
+		}
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
 			var volume Volume
 			if err := json.Unmarshal(v, &volume); err != nil {
 				return err
@@ -616,3 +727,1516 @@ func (db *DB) DeleteVolume(ctx context.Context, resourceName, volumeName string)
 		return b.Delete([]byte(key))
 	})
 }
+
+// ==================== EVENT ====================
+
+// Event represents an entry in the cluster events log
+type Event struct {
+	ID        int64
+	Type      string
+	Resource  string
+	Message   string
+	Details   map[string]string
+	CreatedAt time.Time
+}
+
+// SaveEvent appends an event to the events log
+func (db *DB) SaveEvent(ctx context.Context, event *Event) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+	if event.ID == 0 {
+		event.ID = event.CreatedAt.UnixNano()
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	// Zero-padded key keeps events ordered by time
+	key := fmt.Sprintf("%020d", event.ID)
+	return db.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(eventsBucket))
+		return b.Put([]byte(key), data)
+	})
+}
+
+// PruneEvents deletes the oldest events beyond the newest keep
+func (db *DB) PruneEvents(ctx context.Context, keep int) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(eventsBucket))
+		c := b.Cursor()
+
+		n := 0
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			n++
+		}
+		// Keys are ordered oldest first
+		for ; n > keep; n-- {
+			k, _ := c.First()
+			if k == nil {
+				break
+			}
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ListEvents lists events newest first, optionally filtered by resource.
+// A limit of 0 returns all matching events.
+func (db *DB) ListEvents(ctx context.Context, resource string, limit int) ([]*Event, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var events []*Event
+	err := db.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(eventsBucket)).Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			var event Event
+			if err := json.Unmarshal(v, &event); err != nil {
+				return err
+			}
+			if resource != "" && event.Resource != resource {
+				continue
+			}
+			events = append(events, &event)
+			if limit > 0 && len(events) >= limit {
+				break
+			}
+		}
+		return nil
+	})
+
+	return events, err
+}
+
+// ==================== EVENT HOOKS ====================
+
+// EventHook notifies an external system of events: a webhook URL is posted
+// the payload, a command runs on the controller host with it on stdin
+type EventHook struct {
+	Name      string
+	Events    []string          // Event types, "ha.*" for all of a prefix, "*" for all
+	URL       string            // Webhook, or
+	Command   string            // Shell command
+	Headers   map[string]string // Of webhook requests, e.g. Authorization
+	Template  string            // Go template of the payload, the event as JSON if empty
+	Timeout   time.Duration     // 0 for the default
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SaveEventHook saves or replaces an event hook
+func (db *DB) SaveEventHook(ctx context.Context, hook *EventHook) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	now := time.Now()
+	if hook.CreatedAt.IsZero() {
+		hook.CreatedAt = now
+	}
+	hook.UpdatedAt = now
+
+	data, err := json.Marshal(hook)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event hook: %w", err)
+	}
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(eventHooksBucket)).Put([]byte(hook.Name), data)
+	})
+}
+
+// GetEventHook retrieves an event hook by name
+func (db *DB) GetEventHook(ctx context.Context, name string) (*EventHook, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var hook EventHook
+	err := db.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket([]byte(eventHooksBucket)).Get([]byte(name))
+		if data == nil {
+			return fmt.Errorf("event hook not found")
+		}
+		return json.Unmarshal(data, &hook)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &hook, nil
+}
+
+// ListEventHooks lists the event hooks by name
+func (db *DB) ListEventHooks(ctx context.Context) ([]*EventHook, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var hooks []*EventHook
+	err := db.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(eventHooksBucket)).ForEach(func(k, v []byte) error {
+			var hook EventHook
+			if err := json.Unmarshal(v, &hook); err != nil {
+				return err
+			}
+			hooks = append(hooks, &hook)
+			return nil
+		})
+	})
+	return hooks, err
+}
+
+// DeleteEventHook deletes an event hook
+func (db *DB) DeleteEventHook(ctx context.Context, name string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(eventHooksBucket)).Delete([]byte(name))
+	})
+}
+
+// ==================== PLACEMENT ====================
+
+// PlacementRule constrains which node two resources may be Primary on.
+// Rules are symmetric and stored once per resource pair.
+type PlacementRule struct {
+	ResourceA string
+	ResourceB string
+	Type      string // "colocate" or "anti-affinity"
+	CreatedAt time.Time
+}
+
+// placementRuleKey returns the order-independent key of a resource pair
+func placementRuleKey(a, b string) string {
+	if b < a {
+		a, b = b, a
+	}
+	return fmt.Sprintf("%s:%s", a, b)
+}
+
+// SavePlacementRule saves or replaces the rule between two resources
+func (db *DB) SavePlacementRule(ctx context.Context, rule *PlacementRule) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if rule.CreatedAt.IsZero() {
+		rule.CreatedAt = time.Now()
+	}
+
+	data, err := json.Marshal(rule)
+	if err != nil {
+		return fmt.Errorf("failed to marshal placement rule: %w", err)
+	}
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(placementRulesBucket))
+		return b.Put([]byte(placementRuleKey(rule.ResourceA, rule.ResourceB)), data)
+	})
+}
+
+// ListPlacementRules lists all placement rules
+func (db *DB) ListPlacementRules(ctx context.Context) ([]*PlacementRule, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var rules []*PlacementRule
+	err := db.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(placementRulesBucket))
+		return b.ForEach(func(k, v []byte) error {
+			var rule PlacementRule
+			if err := json.Unmarshal(v, &rule); err != nil {
+				return err
+			}
+			rules = append(rules, &rule)
+			return nil
+		})
+	})
+
+	return rules, err
+}
+
+// DeletePlacementRule deletes the rule between two resources
+func (db *DB) DeletePlacementRule(ctx context.Context, a, b string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(placementRulesBucket))
+		key := []byte(placementRuleKey(a, b))
+		if bucket.Get(key) == nil {
+			return fmt.Errorf("placement rule not found")
+		}
+		return bucket.Delete(key)
+	})
+}
+
+// ==================== VIP ====================
+
+// VIP is a service IP reserved by an HA config or gateway
+type VIP struct {
+	Address   string // IP address without prefix
+	Prefix    int
+	Owner     string // "ha/<resource>" or "gateway/<name>"
+	Pool      string // IPAM pool it was allocated from, empty if given explicitly
+	CreatedAt time.Time
+}
+
+// ReserveVIP saves a VIP reservation. It fails if the address is reserved
+// by another owner; reserving it again for the same owner is a no-op.
+func (db *DB) ReserveVIP(ctx context.Context, vip *VIP) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if vip.CreatedAt.IsZero() {
+		vip.CreatedAt = time.Now()
+	}
+
+	data, err := json.Marshal(vip)
+	if err != nil {
+		return fmt.Errorf("failed to marshal vip: %w", err)
+	}
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(vipsBucket))
+		if v := b.Get([]byte(vip.Address)); v != nil {
+			var existing VIP
+			if err := json.Unmarshal(v, &existing); err != nil {
+				return err
+			}
+			if existing.Owner != vip.Owner {
+				return fmt.Errorf("VIP %s is already used by %s", vip.Address, existing.Owner)
+			}
+			return nil
+		}
+		return b.Put([]byte(vip.Address), data)
+	})
+}
+
+// ListVIPs lists all VIP reservations
+func (db *DB) ListVIPs(ctx context.Context) ([]*VIP, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var vips []*VIP
+	err := db.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(vipsBucket))
+		return b.ForEach(func(k, v []byte) error {
+			var vip VIP
+			if err := json.Unmarshal(v, &vip); err != nil {
+				return err
+			}
+			vips = append(vips, &vip)
+			return nil
+		})
+	})
+
+	return vips, err
+}
+
+// ReleaseVIPs deletes the VIP reservations of an owner
+func (db *DB) ReleaseVIPs(ctx context.Context, owner string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(vipsBucket))
+		var keys [][]byte
+		if err := b.ForEach(func(k, v []byte) error {
+			var vip VIP
+			if err := json.Unmarshal(v, &vip); err != nil {
+				return err
+			}
+			if vip.Owner == owner {
+				keys = append(keys, append([]byte(nil), k...))
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ReleaseVIP deletes the reservation of a single address
+func (db *DB) ReleaseVIP(ctx context.Context, address string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(vipsBucket)).Delete([]byte(address))
+	})
+}
+
+// ==================== DRBD GLOBAL CONFIG ====================
+
+// DrbdGlobalConfig is a version of the controller-managed
+// /etc/drbd.d/global_common.conf. Versions are never changed once saved.
+type DrbdGlobalConfig struct {
+	Version    int
+	UsageCount string            // yes, no or ask
+	Disk       map[string]string // common disk options
+	Net        map[string]string // common net options, e.g. timeouts
+	Handlers   map[string]string // handler name -> command
+	Comment    string
+	CreatedAt  time.Time
+}
+
+// SaveDrbdGlobalConfig saves a config as the next version and sets its Version
+func (db *DB) SaveDrbdGlobalConfig(ctx context.Context, cfg *DrbdGlobalConfig) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if cfg.CreatedAt.IsZero() {
+		cfg.CreatedAt = time.Now()
+	}
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(drbdGlobalBucket))
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		cfg.Version = int(seq)
+
+		data, err := json.Marshal(cfg)
+		if err != nil {
+			return fmt.Errorf("failed to marshal DRBD global config: %w", err)
+		}
+		// Zero-padded key keeps versions ordered
+		return b.Put([]byte(fmt.Sprintf("%010d", seq)), data)
+	})
+}
+
+// GetDrbdGlobalConfig retrieves a version of the global config, the latest
+// one if version is 0
+func (db *DB) GetDrbdGlobalConfig(ctx context.Context, version int) (*DrbdGlobalConfig, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var cfg DrbdGlobalConfig
+	err := db.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(drbdGlobalBucket))
+		var data []byte
+		if version == 0 {
+			_, data = b.Cursor().Last()
+		} else {
+			data = b.Get([]byte(fmt.Sprintf("%010d", version)))
+		}
+		if data == nil {
+			return fmt.Errorf("DRBD global config not found")
+		}
+		return json.Unmarshal(data, &cfg)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// ListDrbdGlobalConfigs lists all versions of the global config, newest first
+func (db *DB) ListDrbdGlobalConfigs(ctx context.Context) ([]*DrbdGlobalConfig, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var configs []*DrbdGlobalConfig
+	err := db.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(drbdGlobalBucket)).Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			var cfg DrbdGlobalConfig
+			if err := json.Unmarshal(v, &cfg); err != nil {
+				return err
+			}
+			configs = append(configs, &cfg)
+		}
+		return nil
+	})
+
+	return configs, err
+}
+
+// ==================== JOBS ====================
+
+// Job is the record of a mutating operation
+type Job struct {
+	ID         int64
+	Operation  string // RPC name, e.g. "CreateResource"
+	Target     string // Resource or object name, if any
+	State      string // running, succeeded, failed, cancelled or interrupted
+	Message    string
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Steps      []*JobStep
+	// Journal of a running job: the orchestration step it entered last and
+	// its request, kept until the job finishes to resume it after a crash
+	Step    string
+	Request string
+	// Job that resumed or rolled back an interrupted job
+	ResolvedBy int64
+	Resolution string
+}
+
+// JobStep is a remote command run by a job on one node
+type JobStep struct {
+	Name      string // e.g. "lvcreate" or "drbdadm create-md"
+	Node      string
+	Command   string
+	StartedAt time.Time
+	Duration  time.Duration
+	Success   bool
+	ExitCode  int
+	Output    string // Truncated, the end is kept
+	Slow      bool   // Took longer than the slow command threshold
+}
+
+// jobKey returns the ordered key of a job
+func jobKey(id int64) []byte {
+	return []byte(fmt.Sprintf("%020d", id))
+}
+
+// SaveJob saves a job. A job without an ID gets the next one.
+func (db *DB) SaveJob(ctx context.Context, job *Job) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(jobsBucket))
+		if job.ID == 0 {
+			seq, err := b.NextSequence()
+			if err != nil {
+				return err
+			}
+			job.ID = int64(seq)
+		}
+
+		data, err := json.Marshal(job)
+		if err != nil {
+			return fmt.Errorf("failed to marshal job: %w", err)
+		}
+		return b.Put(jobKey(job.ID), data)
+	})
+}
+
+// GetJob retrieves a job by ID
+func (db *DB) GetJob(ctx context.Context, id int64) (*Job, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var job Job
+	err := db.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(jobsBucket))
+		data := b.Get(jobKey(id))
+		if data == nil {
+			return fmt.Errorf("job not found")
+		}
+		return json.Unmarshal(data, &job)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// ListJobs lists jobs newest first, optionally filtered by target.
+// A limit of 0 returns all matching jobs.
+func (db *DB) ListJobs(ctx context.Context, target string, limit int) ([]*Job, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var jobs []*Job
+	err := db.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(jobsBucket)).Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			var job Job
+			if err := json.Unmarshal(v, &job); err != nil {
+				return err
+			}
+			if target != "" && job.Target != target {
+				continue
+			}
+			jobs = append(jobs, &job)
+			if limit > 0 && len(jobs) >= limit {
+				break
+			}
+		}
+		return nil
+	})
+
+	return jobs, err
+}
+
+// PruneJobs deletes the oldest jobs beyond the newest keep
+func (db *DB) PruneJobs(ctx context.Context, keep int) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(jobsBucket))
+		c := b.Cursor()
+
+		// Keys are ordered oldest first, keep the last ones
+		var keys [][]byte
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			keys = append(keys, append([]byte(nil), k...))
+		}
+		if len(keys) <= keep {
+			return nil
+		}
+		keys = keys[:len(keys)-keep]
+		for _, k := range keys {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ==================== NET PROBES ====================
+
+// NetProbe is the latest measurement of the replication link between two nodes
+type NetProbe struct {
+	Source         string
+	Target         string
+	Method         string  // iperf3 or tcp
+	LatencyMs      float64 // Average round trip time, 0 if not measured
+	ThroughputMbps float64 // From Source to Target, 0 if not measured
+	RequiredMbps   float64 // Highest sync rate of the resources on the link
+	Protocol       string  // C if any resource on the link uses protocol C
+	Warnings       []string
+	Error          string
+	ProbedAt       time.Time
+}
+
+// netProbeKey returns the key of the link between two nodes
+func netProbeKey(source, target string) []byte {
+	return []byte(source + "|" + target)
+}
+
+// SaveNetProbe saves the measurement of a link, replacing the previous one
+func (db *DB) SaveNetProbe(ctx context.Context, probe *NetProbe) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	data, err := json.Marshal(probe)
+	if err != nil {
+		return fmt.Errorf("failed to marshal net probe: %w", err)
+	}
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(netProbesBucket))
+		return b.Put(netProbeKey(probe.Source, probe.Target), data)
+	})
+}
+
+// ListNetProbes lists the latest measurement of every probed link
+func (db *DB) ListNetProbes(ctx context.Context) ([]*NetProbe, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var probes []*NetProbe
+	err := db.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(netProbesBucket))
+		return b.ForEach(func(k, v []byte) error {
+			var probe NetProbe
+			if err := json.Unmarshal(v, &probe); err != nil {
+				return err
+			}
+			probes = append(probes, &probe)
+			return nil
+		})
+	})
+
+	return probes, err
+}
+
+// ==================== POOL USAGE ====================
+
+// PoolUsageSample is the capacity of a pool on a node at one point in time
+type PoolUsageSample struct {
+	Pool           string
+	Node           string
+	TotalBytes     uint64
+	FreeBytes      uint64
+	AllocatedBytes uint64 // Provisioned size of the volumes in the pool
+	At             time.Time
+}
+
+// poolUsageKey returns the key of a sample, ordered by pool, node and time
+func poolUsageKey(sample *PoolUsageSample) []byte {
+	return []byte(fmt.Sprintf("%s|%s|%020d", sample.Pool, sample.Node, sample.At.UnixNano()))
+}
+
+// SavePoolUsage saves a batch of pool usage samples
+func (db *DB) SavePoolUsage(ctx context.Context, samples []*PoolUsageSample) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(poolUsageBucket))
+		for _, sample := range samples {
+			data, err := json.Marshal(sample)
+			if err != nil {
+				return fmt.Errorf("failed to marshal pool usage sample: %w", err)
+			}
+			if err := b.Put(poolUsageKey(sample), data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ListPoolUsage lists the samples of a pool taken since the given time,
+// oldest first. An empty node returns the samples of the pool on all nodes,
+// an empty pool the samples of all pools.
+func (db *DB) ListPoolUsage(ctx context.Context, pool, node string, since time.Time) ([]*PoolUsageSample, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var prefix []byte
+	switch {
+	case pool != "" && node != "":
+		prefix = []byte(pool + "|" + node + "|")
+	case pool != "":
+		prefix = []byte(pool + "|")
+	}
+
+	var samples []*PoolUsageSample
+	err := db.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(poolUsageBucket)).Cursor()
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			var sample PoolUsageSample
+			if err := json.Unmarshal(v, &sample); err != nil {
+				return err
+			}
+			if sample.At.Before(since) {
+				continue
+			}
+			samples = append(samples, &sample)
+		}
+		return nil
+	})
+
+	sort.SliceStable(samples, func(i, j int) bool { return samples[i].At.Before(samples[j].At) })
+	return samples, err
+}
+
+// PrunePoolUsage deletes the samples taken before the given time and returns
+// how many were deleted
+func (db *DB) PrunePoolUsage(ctx context.Context, before time.Time) (int, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	deleted := 0
+	err := db.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(poolUsageBucket))
+		var keys [][]byte
+		err := b.ForEach(func(k, v []byte) error {
+			var sample PoolUsageSample
+			if err := json.Unmarshal(v, &sample); err != nil || sample.At.Before(before) {
+				keys = append(keys, append([]byte(nil), k...))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		deleted = len(keys)
+		return nil
+	})
+
+	return deleted, err
+}
+
+// ==================== SNAPSHOT HOOKS ====================
+
+// SnapshotHook is a pair of commands run on the Primary of a resource around
+// its snapshots: Pre quiesces the application, Post releases it again
+type SnapshotHook struct {
+	Name      string
+	Pre       string
+	Post      string
+	Timeout   time.Duration // Of each command, 0 for the default
+	OnFailure string        // What a failing Pre does to the snapshot, "" is abort
+}
+
+// SnapshotHooks are the snapshot hooks of a resource, run in order before the
+// snapshot and in reverse order after it
+type SnapshotHooks struct {
+	Resource  string
+	Hooks     []*SnapshotHook
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SaveSnapshotHooks saves the snapshot hooks of a resource, deleting them
+// when there are none
+func (db *DB) SaveSnapshotHooks(ctx context.Context, hooks *SnapshotHooks) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if len(hooks.Hooks) == 0 {
+		return db.db.Update(func(tx *bolt.Tx) error {
+			return tx.Bucket([]byte(snapshotHooksBucket)).Delete([]byte(hooks.Resource))
+		})
+	}
+
+	now := time.Now()
+	if hooks.CreatedAt.IsZero() {
+		hooks.CreatedAt = now
+	}
+	hooks.UpdatedAt = now
+
+	data, err := json.Marshal(hooks)
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot hooks: %w", err)
+	}
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(snapshotHooksBucket)).Put([]byte(hooks.Resource), data)
+	})
+}
+
+// GetSnapshotHooks returns the snapshot hooks of a resource, none if it has
+// no hooks
+func (db *DB) GetSnapshotHooks(ctx context.Context, resource string) (*SnapshotHooks, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	hooks := &SnapshotHooks{Resource: resource}
+	err := db.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket([]byte(snapshotHooksBucket)).Get([]byte(resource))
+		if data == nil {
+			return nil
+		}
+		return json.Unmarshal(data, hooks)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hooks, nil
+}
+
+// DeleteSnapshotHooks deletes the snapshot hooks of a resource
+func (db *DB) DeleteSnapshotHooks(ctx context.Context, resource string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(snapshotHooksBucket)).Delete([]byte(resource))
+	})
+}
+
+// SnapshotGroup is a label shared by snapshots of several resources taken
+// together, e.g. nightly. The snapshot of each resource is named after the
+// label.
+type SnapshotGroup struct {
+	Label     string
+	Resources []string // Resources that have the snapshot
+	Node      string   // Node the snapshots were taken on, "" for all nodes of each resource
+	Job       int64    // Job that took the snapshots
+	CreatedAt time.Time
+}
+
+// SaveSnapshotGroup saves a snapshot group, deleting it when no resource has
+// the snapshot anymore
+func (db *DB) SaveSnapshotGroup(ctx context.Context, group *SnapshotGroup) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if len(group.Resources) == 0 {
+		return db.db.Update(func(tx *bolt.Tx) error {
+			return tx.Bucket([]byte(snapshotGroupsBucket)).Delete([]byte(group.Label))
+		})
+	}
+
+	if group.CreatedAt.IsZero() {
+		group.CreatedAt = time.Now()
+	}
+
+	data, err := json.Marshal(group)
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot group: %w", err)
+	}
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(snapshotGroupsBucket)).Put([]byte(group.Label), data)
+	})
+}
+
+// GetSnapshotGroup retrieves a snapshot group by label
+func (db *DB) GetSnapshotGroup(ctx context.Context, label string) (*SnapshotGroup, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var group SnapshotGroup
+	err := db.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket([]byte(snapshotGroupsBucket)).Get([]byte(label))
+		if data == nil {
+			return fmt.Errorf("snapshot group not found")
+		}
+		return json.Unmarshal(data, &group)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// ListSnapshotGroups lists all snapshot groups by label
+func (db *DB) ListSnapshotGroups(ctx context.Context) ([]*SnapshotGroup, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var groups []*SnapshotGroup
+	err := db.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(snapshotGroupsBucket)).ForEach(func(k, v []byte) error {
+			var group SnapshotGroup
+			if err := json.Unmarshal(v, &group); err != nil {
+				return err
+			}
+			groups = append(groups, &group)
+			return nil
+		})
+	})
+	return groups, err
+}
+
+// SyncRateOverride is a resync rate set on a resource at runtime, on top of
+// its DRBD config, until it expires
+type SyncRateOverride struct {
+	Resource  string
+	Peer      string // Only the connections to this node, "" for all
+	Rate      string // DRBD resync-rate, e.g. 20M
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// SaveSyncRateOverride saves or replaces the resync rate override of a
+// resource
+func (db *DB) SaveSyncRateOverride(ctx context.Context, override *SyncRateOverride) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if override.CreatedAt.IsZero() {
+		override.CreatedAt = time.Now()
+	}
+
+	data, err := json.Marshal(override)
+	if err != nil {
+		return fmt.Errorf("failed to marshal sync rate override: %w", err)
+	}
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(syncRatesBucket)).Put([]byte(override.Resource), data)
+	})
+}
+
+// GetSyncRateOverride retrieves the resync rate override of a resource
+func (db *DB) GetSyncRateOverride(ctx context.Context, resource string) (*SyncRateOverride, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var override SyncRateOverride
+	err := db.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket([]byte(syncRatesBucket)).Get([]byte(resource))
+		if data == nil {
+			return fmt.Errorf("sync rate override not found")
+		}
+		return json.Unmarshal(data, &override)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &override, nil
+}
+
+// ListSyncRateOverrides lists the resync rate overrides of all resources
+func (db *DB) ListSyncRateOverrides(ctx context.Context) ([]*SyncRateOverride, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var overrides []*SyncRateOverride
+	err := db.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(syncRatesBucket)).ForEach(func(k, v []byte) error {
+			var override SyncRateOverride
+			if err := json.Unmarshal(v, &override); err != nil {
+				return err
+			}
+			overrides = append(overrides, &override)
+			return nil
+		})
+	})
+	return overrides, err
+}
+
+// DeleteSyncRateOverride deletes the resync rate override of a resource
+func (db *DB) DeleteSyncRateOverride(ctx context.Context, resource string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(syncRatesBucket)).Delete([]byte(resource))
+	})
+}
+
+// ==================== MAINTENANCE WINDOWS ====================
+
+// MaintenanceWindow is a period in which a node is under maintenance
+type MaintenanceWindow struct {
+	Node      string
+	From      time.Time
+	To        time.Time
+	Reason    string
+	Started   bool // The start of the window was acted on
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SaveMaintenanceWindow saves or replaces the maintenance window of a node
+func (db *DB) SaveMaintenanceWindow(ctx context.Context, window *MaintenanceWindow) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	now := time.Now()
+	if window.CreatedAt.IsZero() {
+		window.CreatedAt = now
+	}
+	window.UpdatedAt = now
+
+	data, err := json.Marshal(window)
+	if err != nil {
+		return fmt.Errorf("failed to marshal maintenance window: %w", err)
+	}
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(maintenanceBucket)).Put([]byte(window.Node), data)
+	})
+}
+
+// GetMaintenanceWindow retrieves the maintenance window of a node
+func (db *DB) GetMaintenanceWindow(ctx context.Context, node string) (*MaintenanceWindow, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var window MaintenanceWindow
+	err := db.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket([]byte(maintenanceBucket)).Get([]byte(node))
+		if data == nil {
+			return fmt.Errorf("maintenance window not found")
+		}
+		return json.Unmarshal(data, &window)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &window, nil
+}
+
+// ListMaintenanceWindows lists the maintenance windows of all nodes
+func (db *DB) ListMaintenanceWindows(ctx context.Context) ([]*MaintenanceWindow, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var windows []*MaintenanceWindow
+	err := db.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(maintenanceBucket)).ForEach(func(k, v []byte) error {
+			var window MaintenanceWindow
+			if err := json.Unmarshal(v, &window); err != nil {
+				return err
+			}
+			windows = append(windows, &window)
+			return nil
+		})
+	})
+	return windows, err
+}
+
+// DeleteMaintenanceWindow deletes the maintenance window of a node
+func (db *DB) DeleteMaintenanceWindow(ctx context.Context, node string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(maintenanceBucket)).Delete([]byte(node))
+	})
+}
+
+// ==================== FENCE CONSTRAINTS ====================
+
+// FenceConstraint keeps a node that was fenced by the fence-peer handler of
+// a resource from being promoted until the unfence-peer handler lifts it
+type FenceConstraint struct {
+	Resource  string
+	Node      string // The fenced peer
+	FencedBy  string // The node whose handler fenced it
+	Outdated  bool   // The peer's disk was outdated, otherwise it was unreachable
+	CreatedAt time.Time
+}
+
+// fenceKey is the key of the constraint of a node of a resource
+func fenceKey(resource, node string) []byte {
+	return []byte(resource + "/" + node)
+}
+
+// SaveFenceConstraint saves or replaces the fence constraint of a node of a
+// resource
+func (db *DB) SaveFenceConstraint(ctx context.Context, constraint *FenceConstraint) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if constraint.CreatedAt.IsZero() {
+		constraint.CreatedAt = time.Now()
+	}
+	data, err := json.Marshal(constraint)
+	if err != nil {
+		return fmt.Errorf("failed to marshal fence constraint: %w", err)
+	}
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(fenceBucket)).Put(fenceKey(constraint.Resource, constraint.Node), data)
+	})
+}
+
+// ListFenceConstraints lists the fence constraints of all resources
+func (db *DB) ListFenceConstraints(ctx context.Context) ([]*FenceConstraint, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var constraints []*FenceConstraint
+	err := db.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(fenceBucket)).ForEach(func(k, v []byte) error {
+			var constraint FenceConstraint
+			if err := json.Unmarshal(v, &constraint); err != nil {
+				return err
+			}
+			constraints = append(constraints, &constraint)
+			return nil
+		})
+	})
+	return constraints, err
+}
+
+// DeleteFenceConstraint deletes the fence constraint of a node of a resource
+func (db *DB) DeleteFenceConstraint(ctx context.Context, resource, node string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(fenceBucket)).Delete(fenceKey(resource, node))
+	})
+}
+
+// ==================== ACTIVATIONS ====================
+
+// Activation is a resource activated by hand on a node with sds resource
+// activate, kept so deactivate can reverse it
+type Activation struct {
+	Resource    string
+	Node        string
+	MountPoint  string // "" if nothing was mounted
+	FsType      string
+	Services    []string // Started in order, stopped in reverse
+	ActivatedAt time.Time
+}
+
+// SaveActivation saves or replaces the activation of a resource
+func (db *DB) SaveActivation(ctx context.Context, activation *Activation) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if activation.ActivatedAt.IsZero() {
+		activation.ActivatedAt = time.Now()
+	}
+	data, err := json.Marshal(activation)
+	if err != nil {
+		return fmt.Errorf("failed to marshal activation: %w", err)
+	}
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(activationsBucket)).Put([]byte(activation.Resource), data)
+	})
+}
+
+// GetActivation retrieves the activation of a resource
+func (db *DB) GetActivation(ctx context.Context, resource string) (*Activation, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var activation Activation
+	err := db.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket([]byte(activationsBucket)).Get([]byte(resource))
+		if data == nil {
+			return fmt.Errorf("activation not found")
+		}
+		return json.Unmarshal(data, &activation)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &activation, nil
+}
+
+// DeleteActivation deletes the activation of a resource
+func (db *DB) DeleteActivation(ctx context.Context, resource string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(activationsBucket)).Delete([]byte(resource))
+	})
+}
+
+// ==================== REPLICATION POLICIES ====================
+
+// ReplicationPolicy sends the snapshots of a resource incrementally to a
+// backup node on a schedule, with the outcome of the last runs
+type ReplicationPolicy struct {
+	Resource     string
+	TargetNode   string        // Node receiving the snapshots, may be outside the replica set
+	TargetPool   string        // ZFS pool or dataset the volumes are received into
+	Interval     time.Duration // Between runs
+	Keep         int           // Replicated snapshots kept on the target
+	StaleAfter   time.Duration // Without a successful run for this long the copy is stale
+	SourceNode   string        // Node the snapshots are sent from, holds the base of the next increment
+	LastSnapshot string        // Last snapshot the target received, "" before the first full copy
+	LastAttempt  time.Time
+	LastSuccess  time.Time
+	LastError    string // Of the last run, "" if it succeeded
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// SaveReplicationPolicy saves or replaces the replication policy of a resource
+func (db *DB) SaveReplicationPolicy(ctx context.Context, policy *ReplicationPolicy) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	now := time.Now()
+	if policy.CreatedAt.IsZero() {
+		policy.CreatedAt = now
+	}
+	policy.UpdatedAt = now
+
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return fmt.Errorf("failed to marshal replication policy: %w", err)
+	}
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(replicationBucket)).Put([]byte(policy.Resource), data)
+	})
+}
+
+// GetReplicationPolicy retrieves the replication policy of a resource
+func (db *DB) GetReplicationPolicy(ctx context.Context, resource string) (*ReplicationPolicy, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var policy ReplicationPolicy
+	err := db.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket([]byte(replicationBucket)).Get([]byte(resource))
+		if data == nil {
+			return fmt.Errorf("replication policy not found")
+		}
+		return json.Unmarshal(data, &policy)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// ListReplicationPolicies lists the replication policies of all resources
+func (db *DB) ListReplicationPolicies(ctx context.Context) ([]*ReplicationPolicy, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var policies []*ReplicationPolicy
+	err := db.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(replicationBucket)).ForEach(func(k, v []byte) error {
+			var policy ReplicationPolicy
+			if err := json.Unmarshal(v, &policy); err != nil {
+				return err
+			}
+			policies = append(policies, &policy)
+			return nil
+		})
+	})
+	return policies, err
+}
+
+// DeleteReplicationPolicy deletes the replication policy of a resource
+func (db *DB) DeleteReplicationPolicy(ctx context.Context, resource string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(replicationBucket)).Delete([]byte(resource))
+	})
+}
+
+// ==================== SECRETS ====================
+
+// SaveSecret saves an encrypted secret. The value is stored as is, encryption
+// is up to the caller.
+func (db *DB) SaveSecret(ctx context.Context, id string, data []byte) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(secretsBucket))
+		return b.Put([]byte(id), data)
+	})
+}
+
+// GetSecret retrieves an encrypted secret by ID
+func (db *DB) GetSecret(ctx context.Context, id string) ([]byte, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var data []byte
+	err := db.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(secretsBucket))
+		v := b.Get([]byte(id))
+		if v == nil {
+			return fmt.Errorf("secret not found")
+		}
+		// Values are only valid inside the transaction
+		data = append([]byte(nil), v...)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// DeleteSecret deletes a secret by ID
+func (db *DB) DeleteSecret(ctx context.Context, id string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(secretsBucket))
+		return b.Delete([]byte(id))
+	})
+}
+
+// ==================== SETTINGS ====================
+
+// freezeKey is the settings key of the freeze state
+const freezeKey = "freeze"
+
+// FreezeState is the cluster-wide read-only switch of the controller
+type FreezeState struct {
+	Frozen bool
+	Reason string
+	Since  time.Time
+}
+
+// SaveFreezeState saves the freeze state
+func (db *DB) SaveFreezeState(ctx context.Context, state *FreezeState) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal freeze state: %w", err)
+	}
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(settingsBucket))
+		return b.Put([]byte(freezeKey), data)
+	})
+}
+
+// GetFreezeState retrieves the freeze state, which is unfrozen when never saved
+func (db *DB) GetFreezeState(ctx context.Context) (*FreezeState, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var state FreezeState
+	err := db.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(settingsBucket))
+		data := b.Get([]byte(freezeKey))
+		if data == nil {
+			return nil
+		}
+		return json.Unmarshal(data, &state)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// setupKey is the settings key of the setup wizard state
+const setupKey = "setup"
+
+// SetupState records that the first boot setup was finished or skipped
+type SetupState struct {
+	CompletedAt time.Time
+}
+
+// SaveSetupState saves the setup wizard state
+func (db *DB) SaveSetupState(ctx context.Context, state *SetupState) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal setup state: %w", err)
+	}
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(settingsBucket))
+		return b.Put([]byte(setupKey), data)
+	})
+}
+
+// GetSetupState retrieves the setup wizard state, which is not completed
+// when never saved
+func (db *DB) GetSetupState(ctx context.Context) (*SetupState, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var state SetupState
+	err := db.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(settingsBucket))
+		data := b.Get([]byte(setupKey))
+		if data == nil {
+			return nil
+		}
+		return json.Unmarshal(data, &state)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// handlerKeysKey is the settings key of the DRBD handler keys
+const handlerKeysKey = "handler_keys"
+
+// HandlerKeys are the keys the DRBD handlers of the nodes authenticate with
+type HandlerKeys struct {
+	Nodes map[string]string // SHA-256 of the key by node name
+}
+
+// SaveHandlerKeys saves the handler keys
+func (db *DB) SaveHandlerKeys(ctx context.Context, keys *HandlerKeys) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	data, err := json.Marshal(keys)
+	if err != nil {
+		return fmt.Errorf("failed to marshal handler keys: %w", err)
+	}
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(settingsBucket))
+		return b.Put([]byte(handlerKeysKey), data)
+	})
+}
+
+// GetHandlerKeys retrieves the handler keys, which are empty when never saved
+func (db *DB) GetHandlerKeys(ctx context.Context) (*HandlerKeys, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	keys := HandlerKeys{Nodes: make(map[string]string)}
+	err := db.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(settingsBucket))
+		data := b.Get([]byte(handlerKeysKey))
+		if data == nil {
+			return nil
+		}
+		return json.Unmarshal(data, &keys)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	if keys.Nodes == nil {
+		keys.Nodes = make(map[string]string)
+	}
+	return &keys, nil
+}
+
+// clusterKey is the settings key of the cluster identity
+const clusterKey = "cluster"
+
+// ClusterIdentity names the cluster a database belongs to
+type ClusterIdentity struct {
+	Name      string
+	UUID      string
+	CreatedAt time.Time
+}
+
+// SaveClusterIdentity saves the cluster identity
+func (db *DB) SaveClusterIdentity(ctx context.Context, identity *ClusterIdentity) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if identity.CreatedAt.IsZero() {
+		identity.CreatedAt = time.Now()
+	}
+
+	data, err := json.Marshal(identity)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cluster identity: %w", err)
+	}
+
+	return db.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(settingsBucket))
+		return b.Put([]byte(clusterKey), data)
+	})
+}
+
+// GetClusterIdentity retrieves the cluster identity, nil when never saved
+func (db *DB) GetClusterIdentity(ctx context.Context) (*ClusterIdentity, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var identity *ClusterIdentity
+	err := db.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(settingsBucket))
+		data := b.Get([]byte(clusterKey))
+		if data == nil {
+			return nil
+		}
+		identity = &ClusterIdentity{}
+		return json.Unmarshal(data, identity)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return identity, nil
+}
@@ -0,0 +1,99 @@
+package deployment
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// cancelKillGrace is how long a cancelled remote command gets between
+// SIGTERM and SIGKILL
+const cancelKillGrace = 3 * time.Second
+
+// newExecID returns a random ID for a remote command
+func newExecID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
+
+// execPIDFile is where a remote command records its process group
+func execPIDFile(execID string) string {
+	return fmt.Sprintf("/tmp/sds-exec-%s.pid", execID)
+}
+
+// shellQuote quotes a string for sh
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// cancellableCommand wraps a command so it runs in its own process group,
+// whose ID is recorded for killRemote. The exit code is preserved.
+func cancellableCommand(cmd, execID string) string {
+	pidFile := execPIDFile(execID)
+	return fmt.Sprintf("setsid sh -c %s & pid=$!; echo $pid > %s; wait $pid; rc=$?; rm -f %s; exit $rc",
+		shellQuote(cmd), pidFile, pidFile)
+}
+
+// watchCancel kills the remote command on all hosts when ctx is cancelled
+// before the returned stop function is called
+func (c *Client) watchCancel(ctx context.Context, hosts []string, execID string) (stop func()) {
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-done:
+		case <-ctx.Done():
+			c.killRemote(hosts, execID)
+		}
+	}()
+	return func() { close(done) }
+}
+
+// killRemote terminates the process group of a remote command, over a new
+// session since the one running the command is still busy
+func (c *Client) killRemote(hosts []string, execID string) {
+	pidFile := execPIDFile(execID)
+	// The PID file may not be written yet when cancelling right after the start
+	cmd := fmt.Sprintf("for i in 1 2 3 4 5; do [ -f %s ] && break; sleep 0.2; done; "+
+		"pgid=$(cat %s 2>/dev/null) || exit 0; "+
+		"sudo kill -TERM -- -$pgid 2>/dev/null; sleep %d; sudo kill -KILL -- -$pgid 2>/dev/null; rm -f %s; true",
+		pidFile, pidFile, int(cancelKillGrace.Seconds()), pidFile)
+	if c.restrictedSudo {
+		cmd = restrictSudo(cmd)
+	}
+
+	c.logger.Info("Killing cancelled remote command",
+		zap.Strings("hosts", hosts),
+		zap.String("exec_id", execID))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if _, err := c.dispatch.Exec(ctx, hosts, cmd); err != nil {
+		c.logger.Warn("Failed to kill cancelled remote command",
+			zap.Strings("hosts", hosts),
+			zap.String("exec_id", execID),
+			zap.Error(err))
+	}
+}
+
+// stepTimeoutKey carries the timeout budget of an orchestration step
+type stepTimeoutKey struct{}
+
+// WithStepTimeout returns a context under which remote commands get the
+// given timeout instead of the default, e.g. for mkfs or metadata creation
+func WithStepTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, stepTimeoutKey{}, d)
+}
+
+// stepTimeout returns the step timeout carried by a context
+func stepTimeout(ctx context.Context) (time.Duration, bool) {
+	d, ok := ctx.Value(stepTimeoutKey{}).(time.Duration)
+	return d, ok && d > 0
+}
@@ -4,7 +4,9 @@ package deployment
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"net"
 	"os"
@@ -14,6 +16,7 @@ import (
 	"time"
 
 	"github.com/liliang-cn/dispatch/pkg/dispatch"
+	"github.com/liliang-cn/sds/pkg/reactor"
 	"go.uber.org/zap"
 )
 
@@ -61,6 +64,18 @@ type Client struct {
 	dispatch *dispatch.Dispatch
 	logger   *zap.Logger
 	parallel int
+	// logOutput enables debug logging of command outputs
+	logOutput bool
+	// defaultTimeout applies to commands without an explicit or step timeout
+	defaultTimeout time.Duration
+	// reactorCheck has drbd-reactorctl parse reactor snippets on one node
+	// before they are distributed
+	reactorCheck bool
+	// knownHosts pins the host keys of the nodes
+	knownHosts *KnownHosts
+	// restrictedSudo adapts remote commands to a sudo policy limited to
+	// PrivilegedCommands
+	restrictedSudo bool
 }
 
 // New creates a new deployment Client
@@ -76,22 +91,68 @@ func New(logger *zap.Logger) (*Client, error) {
 		dispatch: client,
 		logger:   logger,
 		parallel: 10, // Default parallelism
+		logOutput: true,
+		defaultTimeout: 30 * time.Second,
 	}, nil
 }
 
+// SetDefaultTimeout sets the timeout of commands without an explicit or step timeout
+func (c *Client) SetDefaultTimeout(d time.Duration) {
+	if d > 0 {
+		c.defaultTimeout = d
+	}
+}
+
+// SetLogOutput enables or disables debug logging of command outputs.
+// Command lines are always logged with sensitive values redacted.
+func (c *Client) SetLogOutput(enabled bool) {
+	c.logOutput = enabled
+}
+
+// SetReactorCheck enables checking drbd-reactor snippets with
+// drbd-reactorctl on the first target node before distributing them
+func (c *Client) SetReactorCheck(enabled bool) {
+	c.reactorCheck = enabled
+}
+
 // ============ Config Distribution ============
 
-// DistributeConfig distributes a configuration file to multiple nodes
+// DistributeConfig distributes a configuration file to multiple nodes.
+// drbd-reactor snippets are linted first and not written anywhere if they
+// are broken.
 func (c *Client) DistributeConfig(ctx context.Context, hosts []string, content, remotePath string, opts ...ConfigOption) (*ConfigResult, error) {
 	options := &configOptions{}
 	for _, opt := range opts {
 		opt(options)
 	}
 
+	if reactor.IsSnippetPath(remotePath) {
+		if err := reactor.LintConfig(content); err != nil {
+			return nil, fmt.Errorf("refusing to distribute %s: %w", remotePath, err)
+		}
+		if c.reactorCheck && len(hosts) > 0 {
+			if err := c.checkReactorConfig(ctx, hosts[0], content); err != nil {
+				return nil, fmt.Errorf("refusing to distribute %s: %w", remotePath, err)
+			}
+		}
+	}
+
 	c.logger.Info("Distributing config",
 		zap.Strings("hosts", hosts),
 		zap.String("path", remotePath))
 
+	// The distribution is reported as one step per host instead of its
+	// individual commands. Hosts are handled one after the other, a host's
+	// time ends when the next one starts.
+	tracer := tracerFrom(ctx)
+	ctx = withoutTracer(ctx)
+	var traced []string
+	startedAt := make(map[string]time.Time)
+	mark := func(host string) {
+		traced = append(traced, host)
+		startedAt[host] = time.Now()
+	}
+
 	localTempFile := "/tmp/" + filepath.Base(remotePath) + ".tmp"
 
 	configResult := &ConfigResult{
@@ -114,6 +175,7 @@ func (c *Client) DistributeConfig(ctx context.Context, hosts []string, content,
 
 	// Handle local hosts - write directly
 	for _, host := range localHosts {
+		mark(host)
 		// Write to temp path
 		if err := os.WriteFile(localTempFile, []byte(content), 0644); err != nil {
 			c.logger.Error("Failed to write local config", zap.String("host", host), zap.Error(err))
@@ -165,6 +227,7 @@ func (c *Client) DistributeConfig(ctx context.Context, hosts []string, content,
 		c.logger.Debug("Copying to remote hosts", zap.Strings("remote_hosts", remoteHosts))
 		// For remote hosts, use cat + ssh + sudo tee to handle privileged paths
 		for _, host := range remoteHosts {
+			mark(host)
 			// First create directory
 			mkdirCmd := fmt.Sprintf("sudo mkdir -p %s", filepath.Dir(remotePath))
 			mkdirResult, err := c.Exec(ctx, []string{host}, mkdirCmd)
@@ -216,9 +279,7 @@ func (c *Client) DistributeConfig(ctx context.Context, hosts []string, content,
 			}
 
 			// Use base64 encoding to safely transfer content
-			encodedContent := fmt.Sprintf("echo %s | base64 -d | sudo tee %s > /dev/null",
-				fmt.Sprintf("%q", base64.StdEncoding.EncodeToString(fileContent)), remotePath)
-			copyResult, err := c.Exec(ctx, []string{host}, encodedContent)
+			copyResult, err := c.copyEncoded(ctx, host, base64.StdEncoding.EncodeToString(fileContent), remotePath)
 			if err != nil {
 				c.logger.Error("Failed to copy config", zap.String("host", host), zap.Error(err))
 				configResult.Hosts[host] = &HostResult{
@@ -248,6 +309,30 @@ func (c *Client) DistributeConfig(ctx context.Context, hosts []string, content,
 		os.Remove(localTempFile)
 	}
 
+	if tracer != nil {
+		end := time.Now()
+		for i := len(traced) - 1; i >= 0; i-- {
+			host := traced[i]
+			r := configResult.Hosts[host]
+			trace := &CommandTrace{
+				Host:      host,
+				Step:      "DistributeConfig",
+				Command:   remotePath,
+				StartedAt: startedAt[host],
+				Duration:  end.Sub(startedAt[host]),
+				Success:   r != nil && r.Success,
+			}
+			if !trace.Success {
+				trace.ExitCode = 1
+				if r != nil && r.Error != nil {
+					trace.Output = tracedOutput(r.Error.Error())
+				}
+			}
+			tracer.TraceCommand(trace)
+			end = startedAt[host]
+		}
+	}
+
 	// Run post-command if specified
 	if options.postCommand != "" {
 		_, _ = c.Exec(ctx, hosts, options.postCommand)
@@ -256,6 +341,99 @@ func (c *Client) DistributeConfig(ctx context.Context, hosts []string, content,
 	return configResult, nil
 }
 
+// maxInlineContent bounds the base64 content sent in one command line, the
+// kernel limits a single argument of the remote shell to 128 KiB. It is a
+// multiple of 4 so that every chunk decodes on its own.
+const maxInlineContent = 64 * 1024
+
+// copyEncoded writes base64 encoded content to remotePath on a host. Larger
+// content is written in chunks to a temporary file that is moved into place.
+func (c *Client) copyEncoded(ctx context.Context, host, encoded, remotePath string) (*ExecResult, error) {
+	if len(encoded) <= maxInlineContent {
+		return c.Exec(ctx, []string{host}, fmt.Sprintf("echo %q | base64 -d | sudo tee %s > /dev/null", encoded, remotePath))
+	}
+
+	tempPath := remotePath + ".sds-tmp"
+	for offset := 0; offset < len(encoded); offset += maxInlineContent {
+		end := offset + maxInlineContent
+		if end > len(encoded) {
+			end = len(encoded)
+		}
+		teeFlags := ""
+		if offset > 0 {
+			teeFlags = "-a "
+		}
+		result, err := c.Exec(ctx, []string{host}, fmt.Sprintf("echo %q | base64 -d | sudo tee %s%s > /dev/null", encoded[offset:end], teeFlags, tempPath))
+		if err != nil || !result.AllSuccess() {
+			_, _ = c.Exec(ctx, []string{host}, fmt.Sprintf("sudo rm -f %s", tempPath))
+			return result, err
+		}
+	}
+	return c.Exec(ctx, []string{host}, fmt.Sprintf("sudo mv -f %s %s", tempPath, remotePath))
+}
+
+// PushFile distributes a file like DistributeConfig, then sets its mode
+// (permission bits, e.g. 0644) and,
+// if given, its owner and verifies its SHA-256 checksum on every host. The
+// checksum a host reports is returned as its output.
+func (c *Client) PushFile(ctx context.Context, hosts []string, content []byte, remotePath string, mode uint32, owner string) (*ConfigResult, error) {
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	result, err := c.DistributeConfig(ctx, hosts, string(content), remotePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var written []string
+	for _, host := range hosts {
+		if r, ok := result.Hosts[host]; ok && r.Success {
+			written = append(written, host)
+		}
+	}
+	if len(written) == 0 {
+		return result, nil
+	}
+
+	cmd := fmt.Sprintf("sudo chmod %04o %s", mode, remotePath)
+	if owner != "" {
+		cmd += fmt.Sprintf(" && sudo chown %s %s", owner, remotePath)
+	}
+	cmd += fmt.Sprintf(" && sudo sha256sum %s", remotePath)
+
+	verify, err := c.Exec(ctx, written, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify %s: %w", remotePath, err)
+	}
+
+	for _, host := range written {
+		r := result.Hosts[host]
+		v, ok := verify.Hosts[host]
+		switch {
+		case !ok:
+			r.Success = false
+			r.Error = fmt.Errorf("no result from host")
+		case !v.Success:
+			r.Success = false
+			r.Error = fmt.Errorf("failed to set mode or owner: %s", strings.TrimSpace(v.Output))
+		default:
+			fields := strings.Fields(v.Output)
+			if len(fields) > 0 {
+				r.Output = fields[0]
+			}
+			if r.Output != checksum {
+				r.Success = false
+				r.Error = fmt.Errorf("checksum mismatch: expected %s, got %q", checksum, r.Output)
+			}
+		}
+		if !r.Success {
+			result.Success = false
+		}
+	}
+
+	return result, nil
+}
+
 // isLocalHost checks if a host is the local machine
 func isLocalHost(host string) bool {
 	hostname, _ := os.Hostname()
@@ -318,14 +496,17 @@ func (c *Client) Exec(ctx context.Context, hosts []string, cmd string, opts ...E
 	if options.parallel > 0 {
 		parallel = options.parallel
 	}
-	timeout := 30 * time.Second
+	timeout := c.defaultTimeout
+	if d, ok := stepTimeout(ctx); ok {
+		timeout = d
+	}
 	if options.timeout > 0 {
 		timeout = options.timeout
 	}
 
 	c.logger.Debug("deployment.Exec called",
 		zap.Strings("hosts", hosts),
-		zap.String("cmd", cmd),
+		zap.String("cmd", Redact(cmd)),
 		zap.Duration("timeout", timeout))
 
 	// Separate local and remote hosts
@@ -352,7 +533,7 @@ func (c *Client) Exec(ctx context.Context, hosts []string, cmd string, opts ...E
 	// Execute on local hosts using os/exec
 	for _, host := range localHosts {
 		start := time.Now()
-		output, err := exec.CommandContext(ctx, "sh", "-c", cmd).CombinedOutput()
+		output, err := LocalCommand(ctx, cmd).CombinedOutput()
 		end := time.Now()
 		exitCode := 0
 		var errorMsg error = nil
@@ -377,11 +558,20 @@ func (c *Client) Exec(ctx context.Context, hosts []string, cmd string, opts ...E
 	}
 
 	// Execute on remote hosts using dispatch
+	// Remote commands are killed when ctx is cancelled, dispatch itself only
+	// stops starting new ones
 	if len(remoteHosts) > 0 {
-		dispatchResult, dispatchErr := c.dispatch.Exec(ctx, remoteHosts, cmd,
+		remoteCmd := cmd
+		if c.restrictedSudo {
+			remoteCmd = restrictSudo(cmd)
+		}
+		execID := newExecID()
+		stop := c.watchCancel(ctx, remoteHosts, execID)
+		dispatchResult, dispatchErr := c.dispatch.Exec(ctx, remoteHosts, cancellableCommand(remoteCmd, execID),
 			dispatch.WithParallel(parallel),
 			dispatch.WithTimeout(timeout),
 		)
+		stop()
 		if dispatchErr != nil {
 			c.logger.Warn("Remote dispatch.Exec failed", zap.Error(dispatchErr))
 			return nil, dispatchErr
@@ -391,6 +581,13 @@ func (c *Client) Exec(ctx context.Context, hosts []string, cmd string, opts ...E
 		}
 	}
 
+	if err := ctx.Err(); err != nil {
+		c.logger.Warn("deployment.Exec cancelled",
+			zap.Strings("hosts", hosts),
+			zap.String("cmd", Redact(cmd)))
+		return nil, fmt.Errorf("command cancelled: %w", err)
+	}
+
 	c.logger.Debug("deployment.Exec completed",
 		zap.Int("result_hosts_count", len(result.Hosts)),
 		zap.Strings("requested_hosts", hosts))
@@ -399,19 +596,40 @@ func (c *Client) Exec(ctx context.Context, hosts []string, cmd string, opts ...E
 		Hosts: make(map[string]*HostResult),
 	}
 
+	tracer := tracerFrom(ctx)
+	step := commandStep(cmd)
 	for host, r := range result.Hosts {
-		c.logger.Debug("deployment.Exec result",
+		if tracer != nil {
+			tracer.TraceCommand(&CommandTrace{
+				Host:      host,
+				Step:      step,
+				Command:   truncateCommand(Redact(cmd)),
+				StartedAt: r.StartTime,
+				Duration:  r.Duration,
+				ExitCode:  r.ExitCode,
+				Success:   r.Success,
+				Output:    commandOutput(r.Success, string(r.Output), r.ErrorMsg),
+			})
+		}
+
+		fields := []zap.Field{
 			zap.String("host", host),
 			zap.Bool("success", r.Success),
 			zap.Int("exit_code", r.ExitCode),
-			zap.String("error_msg", fmt.Sprintf("%v", r.ErrorMsg)),
+			zap.String("error_msg", Redact(fmt.Sprintf("%v", r.ErrorMsg))),
 			zap.Int("output_len", len(r.Output)),
-			zap.String("output", string(r.Output)))
+		}
+		if c.logOutput {
+			fields = append(fields, zap.String("output", Redact(string(r.Output))))
+		}
+		c.logger.Debug("deployment.Exec result", fields...)
 		execResult.Hosts[host] = &HostResult{
-			Host:    host,
-			Output:  string(r.Output),
-			Success: r.Success,
-			Error:   fmt.Errorf("%s", string(r.Error)),
+			Host:     host,
+			Output:   string(r.Output),
+			Success:  r.Success,
+			Error:    fmt.Errorf("%s", string(r.Error)),
+			ExitCode: r.ExitCode,
+			Duration: r.Duration,
 		}
 	}
 
@@ -652,8 +870,12 @@ func (c *Client) DRBDSecondary(ctx context.Context, host, resource string) (*Hos
 	return nil, fmt.Errorf("no result returned for host %s", host)
 }
 
-// DRBDCreateMD creates DRBD metadata
-func (c *Client) DRBDCreateMD(ctx context.Context, hosts []string, resource string) (*ExecResult, error) {
+// DRBDCreateMD creates DRBD metadata with room for maxPeers peers; 0 leaves
+// the number to drbdadm, which sizes it for the peers in the config
+func (c *Client) DRBDCreateMD(ctx context.Context, hosts []string, resource string, maxPeers int) (*ExecResult, error) {
+	if maxPeers > 0 {
+		return c.Exec(ctx, hosts, fmt.Sprintf("sudo drbdadm create-md --force --max-peers=%d %s", maxPeers, resource))
+	}
 	return c.Exec(ctx, hosts, fmt.Sprintf("sudo drbdadm create-md --force %s", resource))
 }
 
@@ -676,6 +898,28 @@ func (c *Client) ReactorWriteConfig(ctx context.Context, hosts []string, pluginI
 		WithPostCommand("sudo systemctl reload drbd-reactor || sudo systemctl restart drbd-reactor"))
 }
 
+// reactorCheckCmd has drbd-reactorctl parse a snippet from a scratch
+// directory, so the node's own configuration is not touched
+const reactorCheckCmd = `d=$(mktemp -d) && mkdir -p "$d/snippets" && ` +
+	`echo %s | base64 -d > "$d/snippets/check.toml" && ` +
+	`printf 'snippets = "%%s/snippets"\n' "$d" > "$d/drbd-reactor.toml" && ` +
+	`drbd-reactorctl --config "$d/drbd-reactor.toml" ls >/dev/null; rc=$?; rm -rf "$d"; exit $rc`
+
+// checkReactorConfig validates a snippet with drbd-reactorctl on a node
+func (c *Client) checkReactorConfig(ctx context.Context, host, content string) error {
+	cmd := fmt.Sprintf(reactorCheckCmd, base64.StdEncoding.EncodeToString([]byte(content)))
+	result, err := c.Exec(ctx, []string{host}, cmd)
+	if err != nil {
+		return fmt.Errorf("drbd-reactorctl check on %s failed: %w", host, err)
+	}
+	for _, r := range result.Hosts {
+		if !r.Success {
+			return fmt.Errorf("drbd-reactorctl rejected the config on %s: %s", host, strings.TrimSpace(r.Output))
+		}
+	}
+	return nil
+}
+
 // ReactorEnablePlugin enables a promoter plugin
 func (c *Client) ReactorEnablePlugin(ctx context.Context, hosts []string, pluginID string) (*ExecResult, error) {
 	return c.Exec(ctx, hosts, fmt.Sprintf("sudo drbd-reactorctl prom enable %s", pluginID))
@@ -729,10 +973,12 @@ type ExecResult struct {
 
 // HostResult represents result for a single host
 type HostResult struct {
-	Host    string
-	Output  string
-	Success bool
-	Error   error
+	Host     string
+	Output   string
+	Success  bool
+	Error    error
+	ExitCode int           // Set by Exec
+	Duration time.Duration // Set by Exec
 }
 
 // AllSuccess returns true if all operations succeeded
@@ -0,0 +1,236 @@
+package deployment
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// hostKeyTimeout bounds fetching the host key of a node
+const hostKeyTimeout = 10 * time.Second
+
+// errHostKeyFetched aborts the SSH handshake once the host key was read
+var errHostKeyFetched = errors.New("host key fetched")
+
+// KnownHosts is the store of pinned node host keys, a known_hosts file with
+// one "address key-type key" line per node
+type KnownHosts struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewKnownHosts returns the store kept in the file at path
+func NewKnownHosts(path string) *KnownHosts {
+	return &KnownHosts{path: path}
+}
+
+// Path returns the file of the store
+func (k *KnownHosts) Path() string {
+	return k.path
+}
+
+// Lookup returns the key pinned for host, nil if there is none
+func (k *KnownHosts) Lookup(host string) (ssh.PublicKey, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	lines, err := k.read()
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range lines {
+		if key := parseKnownHost(line, host); key != nil {
+			return key, nil
+		}
+	}
+	return nil, nil
+}
+
+// Pin pins key for host, replacing the keys pinned for it before
+func (k *KnownHosts) Pin(host string, key ssh.PublicKey) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	lines, err := k.read()
+	if err != nil {
+		return err
+	}
+	lines = withoutHost(lines, host)
+	lines = append(lines, host+" "+strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key))))
+	return k.write(lines)
+}
+
+// read returns the lines of the store, none if it does not exist yet
+func (k *KnownHosts) read() ([]string, error) {
+	data, err := os.ReadFile(k.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read known hosts: %w", err)
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
+
+// write replaces the store atomically
+func (k *KnownHosts) write(lines []string) error {
+	var buf bytes.Buffer
+	for _, line := range lines {
+		buf.WriteString(line + "\n")
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(k.path), ".known_hosts-*")
+	if err != nil {
+		return fmt.Errorf("failed to write known hosts: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write known hosts: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write known hosts: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return fmt.Errorf("failed to write known hosts: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), k.path); err != nil {
+		return fmt.Errorf("failed to write known hosts: %w", err)
+	}
+	return nil
+}
+
+// parseKnownHost returns the key of a store line if it is pinned for host
+func parseKnownHost(line, host string) ssh.PublicKey {
+	if strings.HasPrefix(line, "#") {
+		return nil
+	}
+	hosts, keyData, ok := strings.Cut(line, " ")
+	if !ok || !containsHost(hosts, host) {
+		return nil
+	}
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(keyData))
+	if err != nil {
+		return nil
+	}
+	return key
+}
+
+// withoutHost returns the store lines that are not pinned for host
+func withoutHost(lines []string, host string) []string {
+	var kept []string
+	for _, line := range lines {
+		hosts, _, _ := strings.Cut(line, " ")
+		if !strings.HasPrefix(line, "#") && containsHost(hosts, host) {
+			continue
+		}
+		kept = append(kept, line)
+	}
+	return kept
+}
+
+// containsHost reports whether the host field of a store line, possibly a
+// bracketed list, names host
+func containsHost(hosts, host string) bool {
+	hosts = strings.TrimSuffix(strings.TrimPrefix(hosts, "["), "]")
+	for _, h := range strings.Split(hosts, ",") {
+		if h == host {
+			return true
+		}
+	}
+	return false
+}
+
+// Fingerprint returns the SHA256 fingerprint of a key as printed by
+// ssh-keygen -l, e.g. "SHA256:nThbg6kXUpJWGl7E1IGOCspRomTxdCARLviKw6E5SY8"
+func Fingerprint(key ssh.PublicKey) string {
+	return ssh.FingerprintSHA256(key)
+}
+
+// SetKnownHosts makes SSH connections verify the host keys of the nodes
+// against the store. With strict, nodes without a pinned key are refused;
+// otherwise the key a node presents on first contact is pinned. A changed
+// key is refused either way.
+func (c *Client) SetKnownHosts(k *KnownHosts, strict bool) {
+	cfg := c.dispatch.GetInventory().GetConfig()
+	cfg.SSH.KnownHostsPath = k.Path()
+	cfg.SSH.StrictHostKey = strict
+	c.knownHosts = k
+}
+
+// IsLocal reports whether host is an address of this machine; commands run
+// there without SSH
+func IsLocal(host string) bool {
+	return isLocalIP(host, getLocalIPs())
+}
+
+// KnownHosts returns the store of pinned host keys, nil if none is set
+func (c *Client) KnownHosts() *KnownHosts {
+	return c.knownHosts
+}
+
+// SSHUser returns the user the controller connects to host as
+func (c *Client) SSHUser(host string) string {
+	if hosts, err := c.dispatch.GetHosts([]string{host}); err == nil && len(hosts) > 0 && hosts[0].User != "" {
+		return hosts[0].User
+	}
+	return c.dispatch.GetInventory().GetConfig().SSH.User
+}
+
+// FetchHostKey returns the host key the SSH server of host presents. The
+// handshake is aborted before authentication.
+func (c *Client) FetchHostKey(ctx context.Context, host string) (ssh.PublicKey, error) {
+	port := c.dispatch.GetInventory().GetConfig().SSH.Port
+	if hosts, err := c.dispatch.GetHosts([]string{host}); err == nil && len(hosts) > 0 && hosts[0].Port > 0 {
+		port = hosts[0].Port
+	}
+	if port == 0 {
+		port = 22
+	}
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+
+	ctx, cancel := context.WithTimeout(ctx, hostKeyTimeout)
+	defer cancel()
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	var key ssh.PublicKey
+	config := &ssh.ClientConfig{
+		User: "sds",
+		HostKeyCallback: func(_ string, _ net.Addr, k ssh.PublicKey) error {
+			key = k
+			return errHostKeyFetched
+		},
+	}
+	_, _, _, err = ssh.NewClientConn(conn, addr, config)
+	if key == nil {
+		return nil, fmt.Errorf("failed to read host key of %s: %w", addr, err)
+	}
+	return key, nil
+}
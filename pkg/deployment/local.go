@@ -0,0 +1,21 @@
+package deployment
+
+import (
+	"context"
+	"os/exec"
+	"syscall"
+)
+
+// LocalCommand runs a command in its own process group, so cancelling ctx
+// terminates the whole group (e.g. sudo and its child) and not only sh.
+// Output pipes held open by leftover children are closed after a grace
+// period instead of blocking Wait.
+func LocalCommand(ctx context.Context, cmd string) *exec.Cmd {
+	c := exec.CommandContext(ctx, "sh", "-c", cmd)
+	c.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	c.Cancel = func() error {
+		return syscall.Kill(-c.Process.Pid, syscall.SIGTERM)
+	}
+	c.WaitDelay = cancelKillGrace
+	return c
+}
@@ -0,0 +1,69 @@
+package deployment
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// redactedValue replaces sensitive values in logged commands and outputs
+const redactedValue = "******"
+
+// redactPatterns match known sensitive flags and fields. The first group is
+// kept, the rest of the match is replaced.
+var redactPatterns = []*regexp.Regexp{
+	// key=value, e.g. incoming_password=... of the iSCSITarget agent
+	regexp.MustCompile(`(?i)(\b[\w.-]*(?:password|passwd|_pass|passphrase|secret|token)[\w.-]*=)("[^"]*"|'[^']*'|\S+)`),
+	// --password value, --passphrase=value
+	regexp.MustCompile(`(?i)(--[\w-]*(?:password|passphrase|secret|token)[\w-]*[= ])("[^"]*"|'[^']*'|\S+)`),
+	// iscsiadm -n node.session.auth.password -v value
+	regexp.MustCompile(`(?i)(\.auth\.password(?:_in)?\s+-v\s+)("[^"]*"|'[^']*'|\S+)`),
+	// DRBD net { shared-secret "..."; }
+	regexp.MustCompile(`(?i)(shared-secret\s+)("[^"]*"|\S+;)`),
+	// LUKS keys piped to cryptsetup: echo -n 'key' | cryptsetup ...
+	regexp.MustCompile(`(?i)(echo\s+(?:-n\s+)?)("[^"]*"|'[^']*'|\S+)(\s*\|\s*(?:sudo\s+)?cryptsetup)`),
+}
+
+// sensitiveValues are literal secrets registered at runtime, e.g. stored
+// gateway credentials, that are scrubbed wherever they appear
+var (
+	sensitiveValues   = make(map[string]struct{})
+	sensitiveValuesMu sync.RWMutex
+)
+
+// minSensitiveLength avoids scrubbing short, common strings from all logs
+const minSensitiveLength = 4
+
+// RegisterSensitive registers literal values that must never be logged
+func RegisterSensitive(values ...string) {
+	sensitiveValuesMu.Lock()
+	defer sensitiveValuesMu.Unlock()
+
+	for _, v := range values {
+		if len(v) >= minSensitiveLength {
+			sensitiveValues[v] = struct{}{}
+		}
+	}
+}
+
+// Redact masks known sensitive flags, fields and registered values in a
+// command line or command output
+func Redact(s string) string {
+	for _, re := range redactPatterns {
+		s = re.ReplaceAllStringFunc(s, func(match string) string {
+			groups := re.FindStringSubmatch(match)
+			suffix := ""
+			if len(groups) > 3 {
+				suffix = groups[3]
+			}
+			return groups[1] + redactedValue + suffix
+		})
+	}
+
+	sensitiveValuesMu.RLock()
+	defer sensitiveValuesMu.RUnlock()
+	for v := range sensitiveValues {
+		s = strings.ReplaceAll(s, v, redactedValue)
+	}
+	return s
+}
@@ -0,0 +1,78 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/liliang-cn/dispatch/pkg/dispatch"
+	"go.uber.org/zap"
+)
+
+// streamWriter hands what a local command writes to a callback
+type streamWriter struct {
+	mu       sync.Mutex
+	onOutput func([]byte)
+}
+
+func (w *streamWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.onOutput(append([]byte(nil), p...))
+	return len(p), nil
+}
+
+// ExecStream runs a command on one host and hands its output to onOutput as
+// it arrives, one call at a time, until the command exits. It is not bound
+// by the default command timeout: long-running commands such as
+// journalctl -f run until ctx is cancelled, which kills them. The exit code
+// of remote commands is not known, only failures to run them are returned.
+func (c *Client) ExecStream(ctx context.Context, host, cmd string, onOutput func([]byte)) error {
+	c.logger.Debug("deployment.ExecStream called",
+		zap.String("host", host),
+		zap.String("cmd", Redact(cmd)))
+
+	w := &streamWriter{onOutput: onOutput}
+
+	if isLocalIP(host, getLocalIPs()) {
+		local := LocalCommand(ctx, cmd)
+		local.Stdout = w
+		local.Stderr = w
+		err := local.Run()
+		if ctx.Err() != nil {
+			return fmt.Errorf("command cancelled: %w", ctx.Err())
+		}
+		return err
+	}
+
+	remoteCmd := cmd
+	if c.restrictedSudo {
+		remoteCmd = restrictSudo(cmd)
+	}
+	execID := newExecID()
+	stop := c.watchCancel(ctx, []string{host}, execID)
+	defer stop()
+
+	var failure string
+	_, err := c.dispatch.Exec(ctx, []string{host}, cancellableCommand(remoteCmd, execID),
+		dispatch.WithStreamCallback(func(_, streamType string, data []byte) {
+			if streamType == "error" {
+				failure = strings.TrimSpace(string(data))
+				return
+			}
+			w.Write(data)
+		}),
+	)
+	if ctx.Err() != nil {
+		return fmt.Errorf("command cancelled: %w", ctx.Err())
+	}
+	if err != nil {
+		c.logger.Warn("Remote dispatch.Exec failed", zap.Error(err))
+		return err
+	}
+	if failure != "" {
+		return fmt.Errorf("failed to run command on %s: %s", host, failure)
+	}
+	return nil
+}
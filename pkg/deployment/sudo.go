@@ -0,0 +1,405 @@
+package deployment
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// SudoersCheckPath is where a generated sudoers policy is staged for
+// visudo before it is moved into place. sudo ignores files in sudoers.d
+// whose name contains a dot.
+const SudoersCheckPath = "/etc/sudoers.d/.sds-check"
+
+// SudoersPath is where the generated sudoers policy is installed
+const SudoersPath = "/etc/sudoers.d/sds"
+
+// escalatedTools need root for anything they do on a node. With a restricted
+// sudo policy they are run through sudo even where a command line omits it.
+var escalatedTools = map[string]bool{
+	"arping":          true,
+	"blockdev":        true,
+	"cibadmin":        true,
+	"dmeventd":        true,
+	"drbd-reactorctl": true,
+	"drbdadm":         true,
+	"drbdmeta":        true,
+	"drbdsetup":       true,
+	"iscsiadm":        true,
+	"journalctl":      true,
+	"losetup":         true,
+	"lsblk":           true,
+	"lvchange":        true,
+	"lvconvert":       true,
+	"lvcreate":        true,
+	"lvextend":        true,
+	"lvremove":        true,
+	"lvs":             true,
+	"mkfs.ext4":       true,
+	"mkfs.xfs":        true,
+	"modprobe":        true,
+	"mount":           true,
+	"nvme":            true,
+	"pvcreate":        true,
+	"pvs":             true,
+	"systemctl":       true,
+	"umount":          true,
+	"vgcreate":        true,
+	"vgextend":        true,
+	"vgremove":        true,
+	"vgs":             true,
+	"zfs":             true,
+	"zpool":           true,
+}
+
+// FileHelperPath is where sds node harden installs the helper that runs the
+// checked tools as root
+const FileHelperPath = "/usr/local/sbin/sds-file"
+
+// fileTools are the general utilities the controller runs through sudo that
+// cannot overwrite files or start programs, e.g. to create directories and
+// signal processes
+var fileTools = []string{"blkid", "kill", "mkdir", "rmdir"}
+
+// checkedTools can overwrite any file or start any unit as root. With a
+// restricted sudo policy they run through the helper at FileHelperPath, which
+// limits their options and operands to what the controller uses.
+var checkedTools = map[string]bool{
+	"cat":       true,
+	"chmod":     true,
+	"chown":     true,
+	"dd":        true,
+	"mv":        true,
+	"rm":        true,
+	"sed":       true,
+	"sha256sum": true,
+	"systemctl": true,
+	"tee":       true,
+	"truncate":  true,
+}
+
+// noexecTools may not start other programs, e.g. a pager, which sudo
+// enforces with NOEXEC
+var noexecTools = map[string]bool{
+	"journalctl": true,
+}
+
+// managedPaths are the files and directories the controller writes on the
+// nodes; a trailing * matches any suffix. The file backend of labs is only
+// covered in its default directory.
+var managedPaths = []string{
+	"/etc/drbd-reactor.d",
+	"/etc/drbd.d",
+	"/etc/hosts",
+	"/etc/lvm/lvm.conf",
+	"/etc/systemd/system",
+	"/tmp/ha_backup_*",
+	"/usr/lib/drbd/sds-*",
+	"/var/lib/sds",
+}
+
+// readPaths are the files the controller reads as root besides managedPaths
+var readPaths = []string{
+	"/etc/drbd-reactor.toml",
+	"/etc/drbd.conf",
+	"/proc/fs/nfsd/clients",
+	"/sys/kernel/config/target/iscsi",
+	"/sys/kernel/debug/nvmet",
+}
+
+// PrivilegedCommands returns the binaries the controller runs as root on the
+// nodes directly, sorted. A restricted sudo policy allows exactly these and
+// the helper at FileHelperPath.
+func PrivilegedCommands() []string {
+	commands := append([]string(nil), fileTools...)
+	for tool := range escalatedTools {
+		if !checkedTools[tool] {
+			commands = append(commands, tool)
+		}
+	}
+	sort.Strings(commands)
+	return commands
+}
+
+// CheckedCommands returns the binaries the helper at FileHelperPath runs for
+// the controller, sorted
+func CheckedCommands() []string {
+	var commands []string
+	for tool := range checkedTools {
+		commands = append(commands, tool)
+	}
+	sort.Strings(commands)
+	return commands
+}
+
+// SudoersPolicy returns a sudoers policy that lets user run the binaries at
+// paths and the helper at FileHelperPath as root without a password and
+// nothing else; the noexec tools among paths may not start other programs and
+// visudo may only check the staged policy. header is written as a comment at
+// the top.
+func SudoersPolicy(user, header string, paths []string, visudo string) string {
+	var b strings.Builder
+	for _, line := range strings.Split(header, "\n") {
+		fmt.Fprintf(&b, "# %s\n", line)
+	}
+	b.WriteString("\n")
+
+	var commands, noexec []string
+	for _, p := range paths {
+		if noexecTools[path.Base(p)] {
+			noexec = append(noexec, p)
+		} else {
+			commands = append(commands, p)
+		}
+	}
+	commands = append(commands, FileHelperPath)
+
+	writeAlias(&b, "SDS_COMMANDS", commands)
+	rule := "SDS_COMMANDS, SDS_CHECK"
+	if len(noexec) > 0 {
+		writeAlias(&b, "SDS_NOEXEC", noexec)
+		rule += ", NOEXEC: SDS_NOEXEC"
+	}
+	fmt.Fprintf(&b, "Cmnd_Alias SDS_CHECK = %s -cf %s\n\n", visudo, SudoersCheckPath)
+
+	fmt.Fprintf(&b, "Defaults:%s !requiretty\n", user)
+	fmt.Fprintf(&b, "%s ALL=(root) NOPASSWD: %s\n", user, rule)
+	return b.String()
+}
+
+// writeAlias writes a Cmnd_Alias with one command per line
+func writeAlias(b *strings.Builder, name string, commands []string) {
+	fmt.Fprintf(b, "Cmnd_Alias %s = \\\n", name)
+	for i, p := range commands {
+		sep := ", \\"
+		if i == len(commands)-1 {
+			sep = ""
+		}
+		fmt.Fprintf(b, "    %s%s\n", p, sep)
+	}
+}
+
+// FileHelper returns the helper script installed at FileHelperPath. It runs
+// a checked tool as root after checking that its options are ones the
+// controller uses and its operands are managed paths, readable paths for cat
+// and sha256sum, volumes for dd and units for systemctl. sed runs sandboxed.
+// header is written as a comment at the top.
+func FileHelper(header string) string {
+	var b strings.Builder
+	b.WriteString("#!/bin/sh\n")
+	for _, line := range strings.Split(header, "\n") {
+		fmt.Fprintf(&b, "# %s\n", line)
+	}
+	fmt.Fprintf(&b, fileHelperScript, casePattern(managedPaths), casePattern(readPaths))
+	return b.String()
+}
+
+// casePattern returns a shell case pattern that matches the paths and
+// everything below them
+func casePattern(paths []string) string {
+	var patterns []string
+	for _, p := range paths {
+		if prefix, ok := strings.CutSuffix(p, "*"); ok {
+			patterns = append(patterns, fmt.Sprintf("'%s'*", prefix))
+		} else {
+			patterns = append(patterns, fmt.Sprintf("'%s'|'%s'/*", p, p))
+		}
+	}
+	return strings.Join(patterns, "|")
+}
+
+// fileHelperScript is the body of FileHelper, formatted with the case
+// patterns of the managed and the readable paths
+const fileHelperScript = `# Runs the file tools of sds-controller as root, limited to its files and
+# units. Installed by sds node harden, do not edit.
+set -eu
+PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin
+SYSTEMD_PAGER=cat
+export PATH SYSTEMD_PAGER
+
+deny() {
+	echo "sds-file: $*" >&2
+	exit 1
+}
+
+managed() {
+	p=$(realpath -m -- "$1" 2>/dev/null) || return 1
+	case "$p" in
+	%[1]s) return 0 ;;
+	esac
+	return 1
+}
+
+readable() {
+	p=$(realpath -m -- "$1" 2>/dev/null) || return 1
+	case "$p" in
+	%[1]s|%[2]s) return 0 ;;
+	esac
+	return 1
+}
+
+volume() {
+	p=$(realpath -e -- "$1" 2>/dev/null) || return 1
+	case "$p" in
+	/dev/dm-*|/dev/zd*|/dev/drbd*|/dev/loop*|/dev/null) return 0 ;;
+	esac
+	return 1
+}
+
+# operands OPTIONS CHECK SKIP ARGS: every argument is one of OPTIONS or an
+# operand that CHECK accepts, the first SKIP operands are a mode, owner,
+# script or size
+operands() {
+	options=$1 check=$2 skip=$3
+	shift 3
+	for a in "$@"; do
+		case " $options " in *" $a "*) continue ;; esac
+		case "$a" in -*) deny "$tool $a is not used by the controller" ;; esac
+		if [ "$skip" -gt 0 ]; then
+			skip=$((skip - 1))
+			continue
+		fi
+		$check "$a" || deny "$a is not a path of the controller"
+	done
+}
+
+[ $# -gt 0 ] || deny "usage: sds-file TOOL ARGS"
+tool=$1
+shift
+
+case "$tool" in
+cat|sha256sum) operands "" readable 0 "$@" ;;
+tee) operands "-a" managed 0 "$@" ;;
+rm) operands "-f -r -rf" managed 0 "$@" ;;
+mv) operands "-f" managed 0 "$@" ;;
+chmod|chown) operands "" managed 1 "$@" ;;
+sed) operands "-i -E" managed 1 "$@" ;;
+truncate) operands "-s" managed 1 "$@" ;;
+dd)
+	for a in "$@"; do
+		case "$a" in
+		if=*|of=*) volume "${a#*=}" || deny "${a#*=} is not a volume" ;;
+		bs=*|count=*|iflag=*|oflag=*|conv=*|status=*) ;;
+		*) deny "dd $a is not used by the controller" ;;
+		esac
+	done ;;
+systemctl)
+	command=
+	for a in "$@"; do
+		case "$a" in */*) deny "systemctl $a is not used by the controller" ;; esac
+		case "$a" in -*) continue ;; esac
+		[ -n "$command" ] || command=$a
+	done
+	case "$command" in
+	start|stop|restart|reload|try-restart|reload-or-restart|enable|disable|daemon-reload|reset-failed) ;;
+	is-active|is-enabled|is-failed|show|status|list-units) ;;
+	*) deny "systemctl $command is not used by the controller" ;;
+	esac ;;
+*) deny "$tool is not run by the controller" ;;
+esac
+
+if [ "$tool" = sed ]; then
+	exec sed --sandbox "$@"
+fi
+exec "$tool" "$@"
+`
+
+// SetRestrictedSudo adapts remote commands to a sudo policy that only allows
+// PrivilegedCommands, as generated by sds node harden
+func (c *Client) SetRestrictedSudo(enabled bool) {
+	c.restrictedSudo = enabled
+}
+
+// shellKeywords may precede the first word of a simple command
+var shellKeywords = map[string]bool{
+	"!": true, "if": true, "then": true, "elif": true, "else": true,
+	"do": true, "while": true, "until": true, "{": true,
+}
+
+// restrictSudo adapts a command line to a restricted sudo policy: the
+// escalated tools get sudo where the command line omits it, the checked tools
+// run through the helper at FileHelperPath, and sudo never prompts (-n) so
+// that a command outside the policy fails at once instead of hanging. Quoted
+// strings are left alone.
+func restrictSudo(cmd string) string {
+	var b strings.Builder
+	start := true      // At the first word of a simple command
+	afterSudo := false // At the command sudo runs
+	var quote byte
+
+	for i := 0; i < len(cmd); {
+		ch := cmd[i]
+		if quote != 0 {
+			b.WriteByte(ch)
+			i++
+			if ch == '\\' && quote == '"' && i < len(cmd) {
+				b.WriteByte(cmd[i])
+				i++
+			} else if ch == quote {
+				quote = 0
+			}
+			continue
+		}
+
+		switch {
+		case ch == '\'' || ch == '"':
+			quote = ch
+			start = false
+			b.WriteByte(ch)
+			i++
+		case ch == '\\' && i+1 < len(cmd):
+			b.WriteString(cmd[i : i+2])
+			start = false
+			i += 2
+		case strings.IndexByte(";&|(\n", ch) >= 0:
+			b.WriteByte(ch)
+			start = true
+			afterSudo = false
+			i++
+		case ch == ' ' || ch == '\t' || ch == ')':
+			b.WriteByte(ch)
+			i++
+		default:
+			j := i
+			for j < len(cmd) && strings.IndexByte(" \t;&|()\n'\"\\", cmd[j]) < 0 {
+				j++
+			}
+			word := cmd[i:j]
+			i = j
+			if afterSudo && !strings.HasPrefix(word, "-") {
+				if checkedTools[path.Base(word)] {
+					b.WriteString(FileHelperPath + " ")
+				}
+				afterSudo = false
+			}
+			if !start {
+				b.WriteString(word)
+				continue
+			}
+
+			switch {
+			case word == "sudo":
+				b.WriteString(word)
+				if !strings.HasPrefix(cmd[j:], " -n ") {
+					b.WriteString(" -n")
+				}
+				start = false
+				afterSudo = true
+			case shellKeywords[word] || strings.Contains(word, "="):
+				// Keywords and variable assignments precede the command
+				b.WriteString(word)
+			case checkedTools[path.Base(word)] && escalatedTools[path.Base(word)]:
+				b.WriteString("sudo -n " + FileHelperPath + " " + word)
+				start = false
+			case escalatedTools[path.Base(word)]:
+				b.WriteString("sudo -n " + word)
+				start = false
+			default:
+				b.WriteString(word)
+				start = false
+			}
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,131 @@
+package deployment
+
+import (
+	"context"
+	"path"
+	"strings"
+	"time"
+)
+
+// maxTracedCommand bounds the length of a command line handed to a tracer
+const maxTracedCommand = 256
+
+// maxTracedOutput bounds the output of a command handed to a tracer
+const maxTracedOutput = 1024
+
+// CommandTrace is the timing of a remote command on one host
+type CommandTrace struct {
+	Host      string
+	Step      string // e.g. "lvcreate", "drbdadm create-md" or "DistributeConfig"
+	Command   string // Redacted and truncated command line
+	StartedAt time.Time
+	Duration  time.Duration
+	ExitCode  int
+	Success   bool
+	Output    string // Redacted and truncated output, the error of a command without output
+}
+
+// Tracer receives the remote commands run under a context
+type Tracer interface {
+	TraceCommand(t *CommandTrace)
+}
+
+// tracerKey carries the tracer of an operation
+type tracerKey struct{}
+
+// WithTracer returns a context under which every remote command is reported
+// to t, e.g. to time the steps of an operation. A tracer already carried by
+// ctx keeps receiving the commands as well.
+func WithTracer(ctx context.Context, t Tracer) context.Context {
+	if outer := tracerFrom(ctx); outer != nil {
+		t = tracers{outer, t}
+	}
+	return context.WithValue(ctx, tracerKey{}, t)
+}
+
+// tracers reports commands to several tracers
+type tracers []Tracer
+
+// TraceCommand reports a command to every tracer
+func (ts tracers) TraceCommand(t *CommandTrace) {
+	for _, tracer := range ts {
+		tracer.TraceCommand(t)
+	}
+}
+
+// tracerFrom returns the tracer carried by a context, nil if there is none
+func tracerFrom(ctx context.Context) Tracer {
+	t, _ := ctx.Value(tracerKey{}).(Tracer)
+	return t
+}
+
+// withoutTracer hides the tracer from the commands a composite operation
+// runs, which reports itself as one step
+func withoutTracer(ctx context.Context) context.Context {
+	if tracerFrom(ctx) == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, tracerKey{}, nil)
+}
+
+// subcommandTools are tools whose first argument names the action
+var subcommandTools = map[string]bool{
+	"drbdadm":         true,
+	"drbdsetup":       true,
+	"drbd-reactorctl": true,
+	"zfs":             true,
+	"zpool":           true,
+	"systemctl":       true,
+	"nvme":            true,
+	"iscsiadm":        true,
+}
+
+// commandStep names the step a command line performs, e.g.
+// "drbdadm create-md" for "sudo drbdadm create-md --force r0"
+func commandStep(cmd string) string {
+	fields := strings.Fields(cmd)
+	// Skip sudo and environment assignments
+	for len(fields) > 0 && (fields[0] == "sudo" || strings.Contains(fields[0], "=")) {
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		return "shell"
+	}
+
+	name := strings.TrimRight(path.Base(fields[0]), ";")
+	if subcommandTools[name] {
+		for _, f := range fields[1:] {
+			if !strings.HasPrefix(f, "-") {
+				return name + " " + strings.TrimRight(f, ";")
+			}
+		}
+	}
+	return name
+}
+
+// truncateCommand shortens a command line for a trace
+func truncateCommand(cmd string) string {
+	if len(cmd) <= maxTracedCommand {
+		return cmd
+	}
+	return cmd[:maxTracedCommand] + "..."
+}
+
+// tracedOutput returns the redacted output of a command for a trace, keeping
+// its end where the error usually is
+func tracedOutput(output string) string {
+	output = strings.TrimSpace(Redact(output))
+	if len(output) <= maxTracedOutput {
+		return output
+	}
+	return "..." + output[len(output)-maxTracedOutput:]
+}
+
+// commandOutput returns the traced output of a command. A failed command
+// that produced no output is described by its error.
+func commandOutput(success bool, output string, err error) string {
+	if !success && strings.TrimSpace(output) == "" && err != nil {
+		output = err.Error()
+	}
+	return tracedOutput(output)
+}
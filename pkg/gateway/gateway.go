@@ -65,8 +65,15 @@ type ResourceNodeState struct {
 
 // ResourceManager provides access to DRBD resource operations
 type ResourceManager interface {
+	// GetResource returns a resource with its live DRBD status, queried on
+	// the nodes
 	GetResource(ctx context.Context, name string) (*ResourceInfo, error)
+	// GetResourceFromDB returns a resource from the controller's records and
+	// the DRBD states of its last node poll, without remote calls
+	GetResourceFromDB(ctx context.Context, name string) (*ResourceInfo, error)
 	SetPrimary(ctx context.Context, resource, node string, force bool) error
+	// GetResourceHosts returns the verified addresses of the nodes replicating a resource
+	GetResourceHosts(ctx context.Context, name string) ([]string, error)
 }
 
 // DeploymentClient provides deployment operations
@@ -163,8 +170,8 @@ func (m *Manager) ListGateways(ctx context.Context) ([]*GatewayInfo, error) {
 func (m *Manager) DeleteGateway(ctx context.Context, id string) error {
 	m.logger.Info("Deleting gateway", zap.String("id", id))
 
-	// Stop drbd-reactor services and remove config on all nodes
-	for _, host := range m.hosts {
+	// Stop drbd-reactor services and remove config on the resource's nodes
+	for _, host := range m.cleanupHosts(ctx, id) {
 		m.logger.Info("Stopping gateway services on node",
 			zap.String("node", host),
 			zap.String("gateway", id))
@@ -174,17 +181,18 @@ func (m *Manager) DeleteGateway(ctx context.Context, id string) error {
 		stopCmd := fmt.Sprintf("systemctl stop drbd-services@%s.target 2>/dev/null || true", escapedID)
 		m.deployment.Exec(ctx, []string{host}, stopCmd)
 
-		// 2. Delete reactor config files (all types: nfs, iscsi, nvmeof)
-		configFiles := []string{
-			fmt.Sprintf("sds-nfs-%s.toml", id),
-			fmt.Sprintf("sds-iscsi-%s.toml", id),
-			fmt.Sprintf("sds-nvmeof-%s.toml", id),
+		// 2. Delete reactor config files and tenant networks (all types: nfs, iscsi, nvmeof)
+		pluginIDs := []string{
+			fmt.Sprintf("sds-nfs-%s", id),
+			fmt.Sprintf("sds-iscsi-%s", id),
+			fmt.Sprintf("sds-nvmeof-%s", id),
 		}
 
-		for _, configFile := range configFiles {
-			configPath := filepath.Join(DrbdReactorConfigDir, configFile)
+		for _, pluginID := range pluginIDs {
+			configPath := filepath.Join(DrbdReactorConfigDir, pluginID+".toml")
 			rmCmd := fmt.Sprintf("sudo rm -f %s", configPath)
 			m.deployment.Exec(ctx, []string{host}, rmCmd)
+			m.removeTenantNetwork(ctx, host, pluginID)
 		}
 
 		// 3. Reload drbd-reactor to pick up changes
@@ -228,6 +236,24 @@ func parseServiceIP(serviceIP string) (*ServiceIP, error) {
 	}, nil
 }
 
+// ClusterPrivateMount returns the directory the cluster-private volume of a
+// resource is mounted on, under base or the default directory
+func ClusterPrivateMount(base, resource string) string {
+	if base == "" {
+		base = DefaultClusterPrivateMountPath
+	}
+	return filepath.Join(base, resource)
+}
+
+// ExportRoot returns the directory the NFS exports of a resource are mounted
+// under, below base or the default directory
+func ExportRoot(base, resource string) string {
+	if base == "" {
+		base = DefaultExportBasePath
+	}
+	return filepath.Join(base, resource)
+}
+
 // extractNodeName extracts node name from endpoint (e.g., "orange1:50051" -> "orange1")
 func extractNodeName(endpoint string) string {
 	parts := strings.Split(endpoint, ":")
@@ -237,31 +263,80 @@ func extractNodeName(endpoint string) string {
 	return endpoint
 }
 
-// writeReactorConfig writes drbd-reactor configuration to all nodes
+// resourceHosts returns the verified node set of a resource.
+// Gateway configs are only ever distributed to these nodes.
+func (m *Manager) resourceHosts(ctx context.Context, resource string) ([]string, error) {
+	hosts, err := m.resources.GetResourceHosts(ctx, resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node set for resource %s: %w", resource, err)
+	}
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("resource %s has no nodes", resource)
+	}
+	return hosts, nil
+}
+
+// cleanupHosts returns the hosts to remove gateway configs from. It prefers the
+// resource's node set and falls back to all known hosts when the resource no
+// longer exists.
+func (m *Manager) cleanupHosts(ctx context.Context, resource string) []string {
+	hosts, err := m.resourceHosts(ctx, resource)
+	if err != nil {
+		m.logger.Warn("Falling back to all hosts for gateway cleanup",
+			zap.String("resource", resource),
+			zap.Error(err))
+		return m.hosts
+	}
+	return hosts
+}
+
+// writeReactorConfig writes drbd-reactor configuration to the nodes of the resource
 func (m *Manager) writeReactorConfig(ctx context.Context, resource, pluginID, config string) error {
 	remotePath := filepath.Join(DrbdReactorConfigDir, fmt.Sprintf("%s.toml", pluginID))
 
-	m.logger.Debug("Writing reactor config to all nodes",
-		zap.Strings("hosts", m.hosts),
+	hosts, err := m.resourceHosts(ctx, resource)
+	if err != nil {
+		return err
+	}
+
+	m.logger.Debug("Writing reactor config to resource nodes",
+		zap.Strings("hosts", hosts),
 		zap.String("path", remotePath))
 
-	if err := m.deployment.DistributeConfig(ctx, m.hosts, config, remotePath); err != nil {
+	if err := m.deployment.DistributeConfig(ctx, hosts, config, remotePath); err != nil {
 		return fmt.Errorf("failed to write config: %w", err)
 	}
 
-	// Reload drbd-reactor on all nodes
+	// Reload drbd-reactor on the resource's nodes
 	reloadCmd := "sudo systemctl reload drbd-reactor || sudo systemctl restart drbd-reactor"
-	if err := m.deployment.Exec(ctx, m.hosts, reloadCmd); err != nil {
+	if err := m.deployment.Exec(ctx, hosts, reloadCmd); err != nil {
 		m.logger.Warn("Failed to reload drbd-reactor", zap.Error(err))
 	}
 
 	return nil
 }
 
+// plannedFiles returns the files creating a gateway writes to the nodes of its
+// resource, for a dry run: the tenant network unit, if any, and the
+// drbd-reactor config
+func plannedFiles(pluginID, config string, tenant *TenantNetwork, serviceIP string) []*v1.PlannedFile {
+	var files []*v1.PlannedFile
+	if tenant != nil {
+		files = append(files, &v1.PlannedFile{
+			Path:    filepath.Join(SystemdUnitDir, TenantUnitName(pluginID)),
+			Content: tenant.tenantUnit(pluginID, serviceIP),
+		})
+	}
+	return append(files, &v1.PlannedFile{
+		Path:    filepath.Join(DrbdReactorConfigDir, fmt.Sprintf("%s.toml", pluginID)),
+		Content: config,
+	})
+}
+
 // getDRBDDevice gets the DRBD device path for a resource
 func (m *Manager) getDRBDDevice(ctx context.Context, resource string) (string, error) {
 	// Try to get device from resource info
-	resInfo, err := m.resources.GetResource(ctx, resource)
+	resInfo, err := m.resources.GetResourceFromDB(ctx, resource)
 	if err == nil && len(resInfo.Volumes) > 0 && resInfo.Volumes[0].Device != "" {
 		return resInfo.Volumes[0].Device, nil
 	}
@@ -415,7 +490,7 @@ func (m *Manager) CreateNVMeGateway(ctx context.Context, req *v1.CreateNVMeGatew
 
 // StartGateway starts a gateway
 func (m *Manager) StartGateway(ctx context.Context, id string) error {
-	return m.reloadDrbdReactor(ctx)
+	return m.reloadDrbdReactor(ctx, id)
 }
 
 // StopGateway stops a gateway
@@ -423,8 +498,8 @@ func (m *Manager) StopGateway(ctx context.Context, id string) error {
 	return fmt.Errorf("stopping individual gateways not yet implemented")
 }
 
-// reloadDrbdReactor reloads drbd-reactor configuration
-func (m *Manager) reloadDrbdReactor(ctx context.Context) error {
+// reloadDrbdReactor reloads drbd-reactor configuration on the nodes of a resource
+func (m *Manager) reloadDrbdReactor(ctx context.Context, resource string) error {
 	reloadCmd := "sudo systemctl reload drbd-reactor || sudo systemctl restart drbd-reactor"
-	return m.deployment.Exec(ctx, m.hosts, reloadCmd)
+	return m.deployment.Exec(ctx, m.cleanupHosts(ctx, resource), reloadCmd)
 }
@@ -11,6 +11,7 @@ import (
 
 	"go.uber.org/zap"
 	v1 "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/reactor"
 )
 
 // iSCSIManager handles iSCSI gateway operations
@@ -39,9 +40,18 @@ func (i *iSCSIManager) CreateISCSIGateway(ctx context.Context, req *v1.CreateISC
 		}, err
 	}
 
+	// Bind the VIP to the tenant network, if one is given
+	tenant, err := NewTenantNetwork(req.Interface, req.Vlan, req.Router, req.ServiceIp)
+	if err != nil {
+		return &v1.CreateISCSIGatewayResponse{
+			Success: false,
+			Message: fmt.Sprintf("invalid tenant network: %v", err),
+		}, err
+	}
+
 	// Get volume info from resource - iSCSI requires at least 2 volumes
 	// Volume 0: cluster-private, Volume 1+: LUNs exposed to initiators
-	resInfo, err := i.resources.GetResource(ctx, req.Resource)
+	resInfo, err := i.resources.GetResourceFromDB(ctx, req.Resource)
 	if err != nil {
 		return &v1.CreateISCSIGatewayResponse{
 			Success: false,
@@ -72,7 +82,7 @@ func (i *iSCSIManager) CreateISCSIGateway(ctx context.Context, req *v1.CreateISC
 		zap.Int("lun_count", len(resInfo.Volumes)-1))
 
 	// Generate drbd-reactor configuration
-	config, err := i.generateISCSIGatewayConfig(req, serviceIP, drbdDevice, len(resInfo.Volumes))
+	config, err := i.generateISCSIGatewayConfig(req, serviceIP, drbdDevice, len(resInfo.Volumes), tenantDevice(tenant))
 	if err != nil {
 		return &v1.CreateISCSIGatewayResponse{
 			Success: false,
@@ -80,8 +90,37 @@ func (i *iSCSIManager) CreateISCSIGateway(ctx context.Context, req *v1.CreateISC
 		}, err
 	}
 
+	// The services must only ever run on the Primary
+	if err := reactor.CheckColocation(config, req.Resource, DefaultISCSIPort); err != nil {
+		return &v1.CreateISCSIGatewayResponse{
+			Success: false,
+			Message: fmt.Sprintf("invalid gateway config: %v", err),
+		}, err
+	}
+
 	// Write configuration to all nodes
 	pluginID := fmt.Sprintf("sds-iscsi-%s", req.Resource)
+
+	// A dry run only shows the files, the controller diffs them per node
+	if req.DryRun {
+		return &v1.CreateISCSIGatewayResponse{
+			Success:    true,
+			Message:    "Dry run, nothing was changed",
+			ConfigPath: filepath.Join(DrbdReactorConfigDir, fmt.Sprintf("%s.toml", pluginID)),
+			Files:      plannedFiles(pluginID, config, tenant, req.ServiceIp),
+		}, nil
+	}
+
+	// The tenant network is up before drbd-reactor brings up the VIP on it
+	if tenant != nil {
+		if err := i.installTenantNetwork(ctx, req.Resource, pluginID, tenant, req.ServiceIp); err != nil {
+			return &v1.CreateISCSIGatewayResponse{
+				Success: false,
+				Message: fmt.Sprintf("failed to set up tenant network: %v", err),
+			}, err
+		}
+	}
+
 	if err := i.writeReactorConfig(ctx, req.Resource, pluginID, config); err != nil {
 		return &v1.CreateISCSIGatewayResponse{
 			Success: false,
@@ -91,9 +130,9 @@ func (i *iSCSIManager) CreateISCSIGateway(ctx context.Context, req *v1.CreateISC
 
 	configPath := filepath.Join(DrbdReactorConfigDir, fmt.Sprintf("%s.toml", pluginID))
 
-	// Set resource to Primary on the first node
-	if len(i.hosts) > 0 {
-		primaryNode := i.hosts[0]
+	// Set resource to Primary on the first node of the resource
+	if hosts, err := i.resourceHosts(ctx, req.Resource); err == nil {
+		primaryNode := hosts[0]
 		i.logger.Info("Setting resource to Primary for gateway",
 			zap.String("resource", req.Resource),
 			zap.String("node", primaryNode))
@@ -114,7 +153,7 @@ func (i *iSCSIManager) CreateISCSIGateway(ctx context.Context, req *v1.CreateISC
 }
 
 // generateISCSIGatewayConfig generates drbd-reactor TOML configuration for iSCSI gateway
-func (i *iSCSIManager) generateISCSIGatewayConfig(req *v1.CreateISCSIGatewayRequest, serviceIP *ServiceIP, drbdDevice string, volumeCount int) (string, error) {
+func (i *iSCSIManager) generateISCSIGatewayConfig(req *v1.CreateISCSIGatewayRequest, serviceIP *ServiceIP, drbdDevice string, volumeCount int, nic string) (string, error) {
 	// Template for iSCSI gateway - matches linstor-gateway pattern
 	tmpl := `# SDS iSCSI Gateway Configuration
 # Generated by SDS Controller
@@ -129,7 +168,7 @@ func (i *iSCSIManager) generateISCSIGatewayConfig(req *v1.CreateISCSIGatewayRequ
 
   [promoter.resources]
 
-    [promoter.resources.{{ .IQN }}]
+    [promoter.resources.{{ .Resource }}]
       on-drbd-demote-failure = "reboot-immediate"
       runner = "systemd"
       stop-services-on-exit = true
@@ -138,7 +177,7 @@ func (i *iSCSIManager) generateISCSIGatewayConfig(req *v1.CreateISCSIGatewayRequ
       start = [
         "ocf:heartbeat:Filesystem fs_cluster_private device={{ .DRBDDevice }} directory={{ .ClusterPrivatePath }} fstype={{ .FSType }} run_fsck=no",
         "ocf:heartbeat:portblock pblock0 ip={{ .IPAddress }} portno={{ .ISCSIPort }} action=block protocol=tcp",
-        "ocf:heartbeat:IPaddr2 service_ip0 ip={{ .IPAddress }} cidr_netmask={{ .Prefix }}",
+        "ocf:heartbeat:IPaddr2 service_ip0 ip={{ .IPAddress }} cidr_netmask={{ .Prefix }}{{ if .Interface }} nic={{ .Interface }}{{ end }}",
         "ocf:heartbeat:iSCSITarget target iqn={{ .IQN }} portals={{ .Portal }} incoming_username={{ .Username }} incoming_password={{ .Password }} allowed_initiators={{ .AllowedInitiators }} implementation={{ .Implementation }}",
 {{ range $idx, $lun := .LUNs }}
         "ocf:heartbeat:iSCSILogicalUnit lu{{ $lun.Number }} target_iqn={{ $.IQN }} lun={{ $lun.Number }} path={{ $lun.Device }} product_id={{ $lun.Serial }} scsi_sn={{ $lun.Serial }}",
@@ -197,7 +236,7 @@ func (i *iSCSIManager) generateISCSIGatewayConfig(req *v1.CreateISCSIGatewayRequ
 		allowedInitiators = "ALL"
 	}
 
-	clusterPrivatePath := filepath.Join(DefaultClusterPrivateMountPath, req.Resource)
+	clusterPrivatePath := ClusterPrivateMount(req.ClusterPrivatePath, req.Resource)
 
 	data := struct {
 		Resource           string
@@ -205,6 +244,7 @@ func (i *iSCSIManager) generateISCSIGatewayConfig(req *v1.CreateISCSIGatewayRequ
 		ServiceIP          string
 		IPAddress          string
 		Prefix             int
+		Interface          string
 		Portal             string
 		FSType             string
 		ClusterPrivatePath string
@@ -221,6 +261,7 @@ func (i *iSCSIManager) generateISCSIGatewayConfig(req *v1.CreateISCSIGatewayRequ
 		ServiceIP:          req.ServiceIp,
 		IPAddress:          ipAddr,
 		Prefix:             prefix,
+		Interface:          nic,
 		Portal:             portal,
 		FSType:             DefaultFSType,
 		DRBDDevice:         drbdDevice,
@@ -255,7 +296,7 @@ func (i *iSCSIManager) GetISCSIGatewayStatus(ctx context.Context, resource strin
 	status["config_path"] = configPath
 
 	// Check if resource is primary
-	if resInfo, err := i.resources.GetResource(ctx, resource); err == nil {
+	if resInfo, err := i.resources.GetResourceFromDB(ctx, resource); err == nil {
 		status["role"] = resInfo.Role
 		status["nodes"] = resInfo.Nodes
 		status["volumes"] = len(resInfo.Volumes)
@@ -271,8 +312,8 @@ func (i *iSCSIManager) DeleteISCSIGateway(ctx context.Context, resource string)
 	configFile := fmt.Sprintf("sds-iscsi-%s.toml", resource)
 	configPath := filepath.Join(DrbdReactorConfigDir, configFile)
 
-	// Remove config from all nodes
-	for _, host := range i.hosts {
+	// Remove config from the resource's nodes
+	for _, host := range i.cleanupHosts(ctx, resource) {
 		rmCmd := fmt.Sprintf("sudo rm -f %s", configPath)
 		if err := i.deployment.Exec(ctx, []string{host}, rmCmd); err != nil {
 			i.logger.Warn("Failed to delete config",
@@ -282,7 +323,7 @@ func (i *iSCSIManager) DeleteISCSIGateway(ctx context.Context, resource string)
 	}
 
 	// Reload drbd-reactor
-	if err := i.reloadDrbdReactor(ctx); err != nil {
+	if err := i.reloadDrbdReactor(ctx, resource); err != nil {
 		return err
 	}
 
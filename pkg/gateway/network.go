@@ -0,0 +1,187 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"net/netip"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// SystemdUnitDir is the directory the gateway network units are installed in
+const SystemdUnitDir = "/etc/systemd/system"
+
+// interfaceNamePattern matches Linux interface names
+var interfaceNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,15}$`)
+
+// TenantNetwork is the network a gateway serves its consumers on: the VIP is
+// bound to Interface, or to a VLAN on it, and traffic from the VIP is routed
+// through a table of its own, so it never leaves through another network
+type TenantNetwork struct {
+	Interface string // Parent interface of the VLAN, or the interface itself
+	VLAN      uint32 // 0 for no VLAN
+	Router    string // Router of the tenant network, "" for clients on its subnet only
+}
+
+// NewTenantNetwork validates the tenant network of a gateway. It returns nil
+// without an interface, the VIP is then brought up wherever it routes.
+func NewTenantNetwork(iface string, vlan uint32, router, serviceIP string) (*TenantNetwork, error) {
+	if iface == "" {
+		if vlan != 0 || router != "" {
+			return nil, fmt.Errorf("a VLAN or router needs the interface of the tenant network")
+		}
+		return nil, nil
+	}
+	if _, err := TenantDevice(iface, vlan); err != nil {
+		return nil, err
+	}
+	t := &TenantNetwork{Interface: iface, VLAN: vlan, Router: router}
+
+	vip, err := netip.ParsePrefix(serviceIP)
+	if err != nil {
+		return nil, fmt.Errorf("invalid service IP %q: %w", serviceIP, err)
+	}
+	if router != "" {
+		addr, err := netip.ParseAddr(router)
+		if err != nil {
+			return nil, fmt.Errorf("invalid router %q: %w", router, err)
+		}
+		if !vip.Masked().Contains(addr) {
+			return nil, fmt.Errorf("router %s is not on the network of service IP %s", router, serviceIP)
+		}
+	} else if vip.Bits() == vip.Addr().BitLen() {
+		return nil, fmt.Errorf("service IP %s is a host address, a tenant network needs its prefix or a router", serviceIP)
+	}
+	return t, nil
+}
+
+// TenantDevice validates the interface and VLAN of a tenant network and
+// returns the interface the VIP is bound to
+func TenantDevice(iface string, vlan uint32) (string, error) {
+	if !interfaceNamePattern.MatchString(iface) {
+		return "", fmt.Errorf("invalid interface name %q", iface)
+	}
+	if vlan > 4094 {
+		return "", fmt.Errorf("invalid VLAN %d, must be 1-4094", vlan)
+	}
+	t := &TenantNetwork{Interface: iface, VLAN: vlan}
+	if len(t.Device()) > 15 {
+		return "", fmt.Errorf("VLAN interface name %s is longer than 15 characters", t.Device())
+	}
+	return t.Device(), nil
+}
+
+// Device returns the interface the VIP is bound to, e.g. eth1.100
+func (t *TenantNetwork) Device() string {
+	if t.VLAN == 0 {
+		return t.Interface
+	}
+	return fmt.Sprintf("%s.%d", t.Interface, t.VLAN)
+}
+
+// Table returns the routing table of the tenant network: 1000 plus the VLAN,
+// or a table derived from the interface name without VLAN
+func (t *TenantNetwork) Table() int {
+	if t.VLAN != 0 {
+		return 1000 + int(t.VLAN)
+	}
+	return 5100 + int(crc32.ChecksumIEEE([]byte(t.Interface))%1000)
+}
+
+// TenantUnitName returns the systemd unit that sets up the tenant network of
+// a gateway
+func TenantUnitName(pluginID string) string {
+	return pluginID + "-net.service"
+}
+
+// tenantUnit generates the systemd unit that creates the VLAN interface,
+// fills the routing table of the tenant network and routes traffic from the
+// VIP through it. Without a router the table only reaches the subnet of the
+// VIP, replies to other networks are refused instead of taking the default
+// route of the node.
+func (t *TenantNetwork) tenantUnit(pluginID, serviceIP string) string {
+	vip := netip.MustParsePrefix(serviceIP)
+	dev := t.Device()
+	table := t.Table()
+
+	var start []string
+	if t.VLAN != 0 {
+		start = append(start, fmt.Sprintf("{ ip link show %s >/dev/null 2>&1 || ip link add link %s name %s type vlan id %d; }",
+			dev, t.Interface, dev, t.VLAN))
+	}
+	start = append(start, fmt.Sprintf("ip link set %s up", dev))
+	if vip.Bits() < vip.Addr().BitLen() {
+		start = append(start, fmt.Sprintf("ip route replace %s dev %s table %d", vip.Masked(), dev, table))
+	}
+	if t.Router != "" {
+		start = append(start, fmt.Sprintf("ip route replace default via %s dev %s table %d", t.Router, dev, table))
+	} else {
+		start = append(start, fmt.Sprintf("ip route replace unreachable default table %d", table))
+	}
+	rule := fmt.Sprintf("from %s lookup %d", vip.Addr(), table)
+	start = append(start, fmt.Sprintf("{ ip rule del %s 2>/dev/null; ip rule add %s pref %d; }", rule, rule, table))
+
+	return fmt.Sprintf(`# Tenant network of gateway %s
+# Generated by sds-controller
+
+[Unit]
+Description=SDS tenant network of %s (%s on %s)
+After=network-online.target
+Wants=network-online.target
+Before=drbd-reactor.service
+
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=/bin/sh -c '%s'
+ExecStop=/bin/sh -c 'ip rule del %s 2>/dev/null || true'
+
+[Install]
+WantedBy=multi-user.target
+`, pluginID, pluginID, serviceIP, dev, strings.Join(start, " && "), rule)
+}
+
+// installTenantNetwork installs and starts the tenant network unit of a
+// gateway on the nodes of its resource, before drbd-reactor brings up the VIP
+func (m *Manager) installTenantNetwork(ctx context.Context, resource, pluginID string, t *TenantNetwork, serviceIP string) error {
+	hosts, err := m.resourceHosts(ctx, resource)
+	if err != nil {
+		return err
+	}
+	unit := TenantUnitName(pluginID)
+
+	m.logger.Info("Installing tenant network",
+		zap.String("resource", resource),
+		zap.String("device", t.Device()),
+		zap.Int("table", t.Table()))
+
+	if err := m.deployment.DistributeConfig(ctx, hosts, t.tenantUnit(pluginID, serviceIP), filepath.Join(SystemdUnitDir, unit)); err != nil {
+		return fmt.Errorf("failed to write tenant network unit: %w", err)
+	}
+	if err := m.deployment.Exec(ctx, hosts, fmt.Sprintf("sudo systemctl daemon-reload && sudo systemctl enable %s && sudo systemctl restart %s", unit, unit)); err != nil {
+		return fmt.Errorf("failed to start tenant network unit: %w", err)
+	}
+	return nil
+}
+
+// removeTenantNetwork stops and removes the tenant network unit of a gateway.
+// The VLAN interface is left, other gateways may share it.
+func (m *Manager) removeTenantNetwork(ctx context.Context, host, pluginID string) {
+	unit := TenantUnitName(pluginID)
+	cmd := fmt.Sprintf("if [ -f %s ]; then sudo systemctl disable --now %s; sudo rm -f %s; sudo systemctl daemon-reload; fi",
+		filepath.Join(SystemdUnitDir, unit), unit, filepath.Join(SystemdUnitDir, unit))
+	m.deployment.Exec(ctx, []string{host}, cmd)
+}
+
+// tenantDevice returns the interface the VIP of a gateway is bound to, ""
+// without a tenant network
+func tenantDevice(t *TenantNetwork) string {
+	if t == nil {
+		return ""
+	}
+	return t.Device()
+}
@@ -11,6 +11,7 @@ import (
 
 	"go.uber.org/zap"
 	v1 "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/reactor"
 )
 
 // NFSManager handles NFS gateway operations
@@ -39,9 +40,18 @@ func (n *NFSManager) CreateNFSGateway(ctx context.Context, req *v1.CreateNFSGate
 		}, err
 	}
 
+	// Bind the VIP to the tenant network, if one is given
+	tenant, err := NewTenantNetwork(req.Interface, req.Vlan, req.Router, req.ServiceIp)
+	if err != nil {
+		return &v1.CreateNFSGatewayResponse{
+			Success: false,
+			Message: fmt.Sprintf("invalid tenant network: %v", err),
+		}, err
+	}
+
 	// Get volume info from resource - NFS requires at least 2 volumes
 	// Volume 0: cluster-private (NFS state), Volume 1+: exported data
-	resInfo, err := n.resources.GetResource(ctx, req.Resource)
+	resInfo, err := n.resources.GetResourceFromDB(ctx, req.Resource)
 	if err != nil {
 		return &v1.CreateNFSGatewayResponse{
 			Success: false,
@@ -71,7 +81,7 @@ func (n *NFSManager) CreateNFSGateway(ctx context.Context, req *v1.CreateNFSGate
 		zap.Int("volume_count", len(resInfo.Volumes)))
 
 	// Generate drbd-reactor configuration
-	config, err := n.generateNFSGatewayConfig(req, serviceIP, drbdDevice)
+	config, err := n.generateNFSGatewayConfig(req, serviceIP, drbdDevice, tenantDevice(tenant))
 	if err != nil {
 		return &v1.CreateNFSGatewayResponse{
 			Success: false,
@@ -79,8 +89,37 @@ func (n *NFSManager) CreateNFSGateway(ctx context.Context, req *v1.CreateNFSGate
 		}, err
 	}
 
+	// The services must only ever run on the Primary
+	if err := reactor.CheckColocation(config, req.Resource, DefaultNFSPort); err != nil {
+		return &v1.CreateNFSGatewayResponse{
+			Success: false,
+			Message: fmt.Sprintf("invalid gateway config: %v", err),
+		}, err
+	}
+
 	// Write configuration to all nodes
 	pluginID := fmt.Sprintf("sds-nfs-%s", req.Resource)
+
+	// A dry run only shows the files, the controller diffs them per node
+	if req.DryRun {
+		return &v1.CreateNFSGatewayResponse{
+			Success:    true,
+			Message:    "Dry run, nothing was changed",
+			ConfigPath: filepath.Join(DrbdReactorConfigDir, fmt.Sprintf("%s.toml", pluginID)),
+			Files:      plannedFiles(pluginID, config, tenant, req.ServiceIp),
+		}, nil
+	}
+
+	// The tenant network is up before drbd-reactor brings up the VIP on it
+	if tenant != nil {
+		if err := n.installTenantNetwork(ctx, req.Resource, pluginID, tenant, req.ServiceIp); err != nil {
+			return &v1.CreateNFSGatewayResponse{
+				Success: false,
+				Message: fmt.Sprintf("failed to set up tenant network: %v", err),
+			}, err
+		}
+	}
+
 	if err := n.writeReactorConfig(ctx, req.Resource, pluginID, config); err != nil {
 		return &v1.CreateNFSGatewayResponse{
 			Success: false,
@@ -90,9 +129,9 @@ func (n *NFSManager) CreateNFSGateway(ctx context.Context, req *v1.CreateNFSGate
 
 	configPath := filepath.Join(DrbdReactorConfigDir, fmt.Sprintf("%s.toml", pluginID))
 
-	// Set resource to Primary on the first node
-	if len(n.hosts) > 0 {
-		primaryNode := n.hosts[0]
+	// Set resource to Primary on the first node of the resource
+	if hosts, err := n.resourceHosts(ctx, req.Resource); err == nil {
+		primaryNode := hosts[0]
 		n.logger.Info("Setting resource to Primary for gateway",
 			zap.String("resource", req.Resource),
 			zap.String("node", primaryNode))
@@ -113,7 +152,7 @@ func (n *NFSManager) CreateNFSGateway(ctx context.Context, req *v1.CreateNFSGate
 }
 
 // generateNFSGatewayConfig generates drbd-reactor TOML configuration for NFS gateway
-func (n *NFSManager) generateNFSGatewayConfig(req *v1.CreateNFSGatewayRequest, serviceIP *ServiceIP, drbdDevice string) (string, error) {
+func (n *NFSManager) generateNFSGatewayConfig(req *v1.CreateNFSGatewayRequest, serviceIP *ServiceIP, drbdDevice string, nic string) (string, error) {
 	// Template for NFS gateway - matches linstor-gateway pattern
 	tmpl := `# SDS NFS Gateway Configuration
 # Generated by SDS Controller
@@ -138,7 +177,7 @@ func (n *NFSManager) generateNFSGatewayConfig(req *v1.CreateNFSGatewayRequest, s
         "ocf:heartbeat:portblock portblock ip={{ .IPAddress }} portno={{ .NFSPort }} action=block protocol=tcp",
         "ocf:heartbeat:Filesystem fs_cluster_private device={{ .DRBDDevice }} directory={{ .ClusterPrivatePath }} fstype={{ .FSType }} run_fsck=no",
         "ocf:heartbeat:Filesystem fs_export device={{ .ExportDevice }} directory={{ .ExportPath }} fstype={{ .FSType }} run_fsck=no",
-        "ocf:heartbeat:IPaddr2 service_ip ip={{ .IPAddress }} cidr_netmask={{ .Prefix }}",
+        "ocf:heartbeat:IPaddr2 service_ip ip={{ .IPAddress }} cidr_netmask={{ .Prefix }}{{ if .Interface }} nic={{ .Interface }}{{ end }}",
         "ocf:heartbeat:nfsserver nfsserver nfs_ip={{ .IPAddress }} nfs_shared_infodir={{ .NFSInfoDir }} nfs_server_scope={{ .IPAddress }}",
 {{ range $idx, $client := .AllowedClients }}
         "ocf:heartbeat:exportfs export_{{ $idx }} directory={{ $.ExportPath }} fsid={{ $.FSID }} clientspec={{ $client }} options={{ $.Options }}",
@@ -155,7 +194,7 @@ func (n *NFSManager) generateNFSGatewayConfig(req *v1.CreateNFSGatewayRequest, s
 	}
 
 	// Prepare export path
-	exportsPath := filepath.Join(DefaultExportBasePath, req.Resource, req.ExportPath)
+	exportsPath := filepath.Join(ExportRoot(req.ExportBasePath, req.Resource), req.ExportPath)
 
 	// Generate UUID-based FSID (matches linstor-gateway)
 	// FSID is derived from resource UUID + volume UUID for uniqueness
@@ -177,14 +216,15 @@ func (n *NFSManager) generateNFSGatewayConfig(req *v1.CreateNFSGatewayRequest, s
 	options := "rw,all_squash,anonuid=0,anongid=0"
 
 	// NFS info directory uses cluster private mount path
-	nfsInfoDir := filepath.Join(DefaultClusterPrivateMountPath, req.Resource, "nfs")
-	clusterPrivatePath := filepath.Join(DefaultClusterPrivateMountPath, req.Resource)
+	clusterPrivatePath := ClusterPrivateMount(req.ClusterPrivatePath, req.Resource)
+	nfsInfoDir := filepath.Join(clusterPrivatePath, "nfs")
 
 	data := struct {
 		Resource           string
 		ServiceIP          string
 		IPAddress          string
 		Prefix             int
+		Interface          string
 		FSType             string
 		ExportPath         string
 		ExportDevice       string
@@ -200,6 +240,7 @@ func (n *NFSManager) generateNFSGatewayConfig(req *v1.CreateNFSGatewayRequest, s
 		ServiceIP:          req.ServiceIp,
 		IPAddress:          ipAddr,
 		Prefix:             prefix,
+		Interface:          nic,
 		FSType:             fsType,
 		DRBDDevice:         drbdDevice,
 		ExportDevice:       getDRBDDeviceForVolume(drbdDevice, 1), // Volume 1 for export
@@ -257,7 +298,7 @@ func (n *NFSManager) GetNFSGatewayStatus(ctx context.Context, resource string) (
 	status["config_path"] = configPath
 
 	// Check if resource is primary
-	if resInfo, err := n.resources.GetResource(ctx, resource); err == nil {
+	if resInfo, err := n.resources.GetResourceFromDB(ctx, resource); err == nil {
 		status["role"] = resInfo.Role
 		status["nodes"] = resInfo.Nodes
 	}
@@ -272,8 +313,8 @@ func (n *NFSManager) DeleteNFSGateway(ctx context.Context, resource string) erro
 	configFile := fmt.Sprintf("sds-nfs-%s.toml", resource)
 	configPath := filepath.Join(DrbdReactorConfigDir, configFile)
 
-	// Remove config from all nodes
-	for _, host := range n.hosts {
+	// Remove config from the resource's nodes
+	for _, host := range n.cleanupHosts(ctx, resource) {
 		rmCmd := fmt.Sprintf("sudo rm -f %s", configPath)
 		if err := n.deployment.Exec(ctx, []string{host}, rmCmd); err != nil {
 			n.logger.Warn("Failed to delete config",
@@ -283,7 +324,7 @@ func (n *NFSManager) DeleteNFSGateway(ctx context.Context, resource string) erro
 	}
 
 	// Reload drbd-reactor
-	if err := n.reloadDrbdReactor(ctx); err != nil {
+	if err := n.reloadDrbdReactor(ctx, resource); err != nil {
 		return err
 	}
 
@@ -12,6 +12,7 @@ import (
 
 	"go.uber.org/zap"
 	v1 "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/reactor"
 )
 
 // NVMeManager handles NVMe-oF gateway operations
@@ -40,9 +41,18 @@ func (n *NVMeManager) CreateNVMeGateway(ctx context.Context, req *v1.CreateNVMeG
 		}, err
 	}
 
+	// Bind the VIP to the tenant network, if one is given
+	tenant, err := NewTenantNetwork(req.Interface, req.Vlan, req.Router, req.ServiceIp)
+	if err != nil {
+		return &v1.CreateNVMeGatewayResponse{
+			Success: false,
+			Message: fmt.Sprintf("invalid tenant network: %v", err),
+		}, err
+	}
+
 	// Get volume info from resource - NVMe-oF requires at least 2 volumes
 	// Volume 0: cluster-private, Volume 1+: namespaces exposed to initiators
-	resInfo, err := n.resources.GetResource(ctx, req.Resource)
+	resInfo, err := n.resources.GetResourceFromDB(ctx, req.Resource)
 	if err != nil {
 		return &v1.CreateNVMeGatewayResponse{
 			Success: false,
@@ -73,7 +83,7 @@ func (n *NVMeManager) CreateNVMeGateway(ctx context.Context, req *v1.CreateNVMeG
 		zap.Int("namespace_count", len(resInfo.Volumes)-1))
 
 	// Generate drbd-reactor configuration
-	config, err := n.generateNVMeGatewayConfig(req, serviceIP, drbdDevice, len(resInfo.Volumes))
+	config, err := n.generateNVMeGatewayConfig(req, serviceIP, drbdDevice, len(resInfo.Volumes), tenantDevice(tenant))
 	if err != nil {
 		return &v1.CreateNVMeGatewayResponse{
 			Success: false,
@@ -81,8 +91,37 @@ func (n *NVMeManager) CreateNVMeGateway(ctx context.Context, req *v1.CreateNVMeG
 		}, err
 	}
 
+	// The services must only ever run on the Primary
+	if err := reactor.CheckColocation(config, req.Resource, DefaultNVMePort); err != nil {
+		return &v1.CreateNVMeGatewayResponse{
+			Success: false,
+			Message: fmt.Sprintf("invalid gateway config: %v", err),
+		}, err
+	}
+
 	// Write configuration to all nodes
 	pluginID := fmt.Sprintf("sds-nvmeof-%s", req.Resource)
+
+	// A dry run only shows the files, the controller diffs them per node
+	if req.DryRun {
+		return &v1.CreateNVMeGatewayResponse{
+			Success:    true,
+			Message:    "Dry run, nothing was changed",
+			ConfigPath: filepath.Join(DrbdReactorConfigDir, fmt.Sprintf("%s.toml", pluginID)),
+			Files:      plannedFiles(pluginID, config, tenant, req.ServiceIp),
+		}, nil
+	}
+
+	// The tenant network is up before drbd-reactor brings up the VIP on it
+	if tenant != nil {
+		if err := n.installTenantNetwork(ctx, req.Resource, pluginID, tenant, req.ServiceIp); err != nil {
+			return &v1.CreateNVMeGatewayResponse{
+				Success: false,
+				Message: fmt.Sprintf("failed to set up tenant network: %v", err),
+			}, err
+		}
+	}
+
 	if err := n.writeReactorConfig(ctx, req.Resource, pluginID, config); err != nil {
 		return &v1.CreateNVMeGatewayResponse{
 			Success: false,
@@ -92,9 +131,9 @@ func (n *NVMeManager) CreateNVMeGateway(ctx context.Context, req *v1.CreateNVMeG
 
 	configPath := filepath.Join(DrbdReactorConfigDir, fmt.Sprintf("%s.toml", pluginID))
 
-	// Set resource to Primary on the first node
-	if len(n.hosts) > 0 {
-		primaryNode := n.hosts[0]
+	// Set resource to Primary on the first node of the resource
+	if hosts, err := n.resourceHosts(ctx, req.Resource); err == nil {
+		primaryNode := hosts[0]
 		n.logger.Info("Setting resource to Primary for gateway",
 			zap.String("resource", req.Resource),
 			zap.String("node", primaryNode))
@@ -115,7 +154,7 @@ func (n *NVMeManager) CreateNVMeGateway(ctx context.Context, req *v1.CreateNVMeG
 }
 
 // generateNVMeGatewayConfig generates drbd-reactor TOML configuration for NVMe-oF gateway
-func (n *NVMeManager) generateNVMeGatewayConfig(req *v1.CreateNVMeGatewayRequest, serviceIP *ServiceIP, drbdDevice string, volumeCount int) (string, error) {
+func (n *NVMeManager) generateNVMeGatewayConfig(req *v1.CreateNVMeGatewayRequest, serviceIP *ServiceIP, drbdDevice string, volumeCount int, nic string) (string, error) {
 	// Template for NVMe-oF gateway - matches linstor-gateway pattern
 	tmpl := `# SDS NVMe-oF Gateway Configuration
 # Generated by SDS Controller
@@ -130,7 +169,7 @@ func (n *NVMeManager) generateNVMeGatewayConfig(req *v1.CreateNVMeGatewayRequest
 
   [promoter.resources]
 
-    [promoter.resources.{{ .SubsystemID }}]
+    [promoter.resources.{{ .Resource }}]
       on-drbd-demote-failure = "reboot-immediate"
       runner = "systemd"
       stop-services-on-exit = true
@@ -139,7 +178,7 @@ func (n *NVMeManager) generateNVMeGatewayConfig(req *v1.CreateNVMeGatewayRequest
       start = [
         "ocf:heartbeat:portblock portblock ip={{ .IPAddress }} portno={{ .NVMePort }} action=block protocol=tcp",
         "ocf:heartbeat:Filesystem fs_cluster_private device={{ .DRBDDevice }} directory={{ .ClusterPrivatePath }} fstype={{ .FSType }} run_fsck=no",
-        "ocf:heartbeat:IPaddr2 service_ip ip={{ .IPAddress }} cidr_netmask={{ .Prefix }}",
+        "ocf:heartbeat:IPaddr2 service_ip ip={{ .IPAddress }} cidr_netmask={{ .Prefix }}{{ if .Interface }} nic={{ .Interface }}{{ end }}",
         "ocf:heartbeat:nvmet-subsystem subsys nqn={{ .NQN }} serial={{ .Serial }}",
 {{ range $idx, $ns := .Namespaces }}
         "ocf:heartbeat:nvmet-namespace ns_{{ $ns.Number }} nqn={{ $.NQN }} namespace_id={{ $ns.Number }} backing_path={{ $ns.Device }} uuid={{ $ns.UUID }} nguid={{ $ns.NGUID }}",
@@ -161,13 +200,6 @@ func (n *NVMeManager) generateNVMeGatewayConfig(req *v1.CreateNVMeGatewayRequest
 	digest := sha256.Sum256([]byte(req.Nqn))
 	serial := hex.EncodeToString(digest[:8])
 
-	// Prepare subsystem ID (extract subsystem name from NQN)
-	// Format: nqn.2024-01.com.example:subsystem.name -> subsystem.name
-	subsystemID := req.Nqn
-	if parts := strings.SplitN(req.Nqn, ":", 2); len(parts) == 2 {
-		subsystemID = parts[1]
-	}
-
 	// Prepare namespace data - Volume 0 is cluster-private, volumes 1+ are namespaces
 	// NVMe namespaces start at 1
 	type Namespace struct {
@@ -198,15 +230,15 @@ func (n *NVMeManager) generateNVMeGatewayConfig(req *v1.CreateNVMeGatewayRequest
 		}
 	}
 
-	clusterPrivatePath := filepath.Join(DefaultClusterPrivateMountPath, req.Resource)
+	clusterPrivatePath := ClusterPrivateMount(req.ClusterPrivatePath, req.Resource)
 
 	data := struct {
 		Resource           string
 		NQN                string
-		SubsystemID        string
 		ServiceIP          string
 		IPAddress          string
 		Prefix             int
+		Interface          string
 		FSType             string
 		ClusterPrivatePath string
 		NVMePort           int
@@ -217,10 +249,10 @@ func (n *NVMeManager) generateNVMeGatewayConfig(req *v1.CreateNVMeGatewayRequest
 	}{
 		Resource:           req.Resource,
 		NQN:                req.Nqn,
-		SubsystemID:        subsystemID,
 		ServiceIP:          req.ServiceIp,
 		IPAddress:          ipAddr,
 		Prefix:             prefix,
+		Interface:          nic,
 		FSType:             DefaultFSType,
 		DRBDDevice:         drbdDevice,
 		ClusterPrivatePath: clusterPrivatePath,
@@ -252,7 +284,7 @@ func (n *NVMeManager) GetNVMeGatewayStatus(ctx context.Context, resource string)
 	status["config_path"] = configPath
 
 	// Check if resource is primary
-	if resInfo, err := n.resources.GetResource(ctx, resource); err == nil {
+	if resInfo, err := n.resources.GetResourceFromDB(ctx, resource); err == nil {
 		status["role"] = resInfo.Role
 		status["nodes"] = resInfo.Nodes
 		status["volumes"] = len(resInfo.Volumes)
@@ -268,8 +300,8 @@ func (n *NVMeManager) DeleteNVMeGateway(ctx context.Context, resource string) er
 	configFile := fmt.Sprintf("sds-nvmeof-%s.toml", resource)
 	configPath := filepath.Join(DrbdReactorConfigDir, configFile)
 
-	// Remove config from all nodes
-	for _, host := range n.hosts {
+	// Remove config from the resource's nodes
+	for _, host := range n.cleanupHosts(ctx, resource) {
 		rmCmd := fmt.Sprintf("sudo rm -f %s", configPath)
 		if err := n.deployment.Exec(ctx, []string{host}, rmCmd); err != nil {
 			n.logger.Warn("Failed to delete config",
@@ -279,7 +311,7 @@ func (n *NVMeManager) DeleteNVMeGateway(ctx context.Context, resource string) er
 	}
 
 	// Reload drbd-reactor
-	if err := n.reloadDrbdReactor(ctx); err != nil {
+	if err := n.reloadDrbdReactor(ctx, resource); err != nil {
 		return err
 	}
 
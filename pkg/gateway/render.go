@@ -0,0 +1,90 @@
+package gateway
+
+import (
+	"fmt"
+
+	v1 "github.com/liliang-cn/sds/api/proto/v1"
+	"github.com/liliang-cn/sds/pkg/reactor"
+)
+
+// renderDevice is the DRBD device gateways are rendered for when none is
+// given, the one the controller falls back to
+const renderDevice = "/dev/drbd0"
+
+// RenderNFSGateway returns the files an NFS gateway would write, generated
+// from the request alone: the resource does not need to exist and nothing is
+// reserved or written. The FSID is random, as on creation.
+func RenderNFSGateway(req *v1.CreateNFSGatewayRequest, drbdDevice string) ([]*v1.PlannedFile, error) {
+	serviceIP, tenant, err := renderNetwork(req.ServiceIp, req.Interface, req.Vlan, req.Router)
+	if err != nil {
+		return nil, err
+	}
+	config, err := (&NFSManager{}).generateNFSGatewayConfig(req, serviceIP, renderDeviceOr(drbdDevice), tenantDevice(tenant))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate config: %w", err)
+	}
+	if err := reactor.CheckColocation(config, req.Resource, DefaultNFSPort); err != nil {
+		return nil, fmt.Errorf("invalid gateway config: %w", err)
+	}
+	return plannedFiles(fmt.Sprintf("sds-nfs-%s", req.Resource), config, tenant, req.ServiceIp), nil
+}
+
+// RenderISCSIGateway returns the files an iSCSI gateway would write for a
+// resource with the given volumes, one LUN per volume after the first
+func RenderISCSIGateway(req *v1.CreateISCSIGatewayRequest, drbdDevice string, volumes int) ([]*v1.PlannedFile, error) {
+	if volumes < 2 {
+		return nil, fmt.Errorf("iSCSI gateway requires at least 2 volumes (got %d)", volumes)
+	}
+	serviceIP, tenant, err := renderNetwork(req.ServiceIp, req.Interface, req.Vlan, req.Router)
+	if err != nil {
+		return nil, err
+	}
+	config, err := (&iSCSIManager{}).generateISCSIGatewayConfig(req, serviceIP, renderDeviceOr(drbdDevice), volumes, tenantDevice(tenant))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate config: %w", err)
+	}
+	if err := reactor.CheckColocation(config, req.Resource, DefaultISCSIPort); err != nil {
+		return nil, fmt.Errorf("invalid gateway config: %w", err)
+	}
+	return plannedFiles(fmt.Sprintf("sds-iscsi-%s", req.Resource), config, tenant, req.ServiceIp), nil
+}
+
+// RenderNVMeGateway returns the files an NVMe-oF gateway would write for a
+// resource with the given volumes, one namespace per volume after the first
+func RenderNVMeGateway(req *v1.CreateNVMeGatewayRequest, drbdDevice string, volumes int) ([]*v1.PlannedFile, error) {
+	if volumes < 2 {
+		return nil, fmt.Errorf("NVMe-oF gateway requires at least 2 volumes (got %d)", volumes)
+	}
+	serviceIP, tenant, err := renderNetwork(req.ServiceIp, req.Interface, req.Vlan, req.Router)
+	if err != nil {
+		return nil, err
+	}
+	config, err := (&NVMeManager{}).generateNVMeGatewayConfig(req, serviceIP, renderDeviceOr(drbdDevice), volumes, tenantDevice(tenant))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate config: %w", err)
+	}
+	if err := reactor.CheckColocation(config, req.Resource, DefaultNVMePort); err != nil {
+		return nil, fmt.Errorf("invalid gateway config: %w", err)
+	}
+	return plannedFiles(fmt.Sprintf("sds-nvmeof-%s", req.Resource), config, tenant, req.ServiceIp), nil
+}
+
+// renderNetwork parses the service IP and tenant network of a rendered gateway
+func renderNetwork(serviceIP, iface string, vlan uint32, router string) (*ServiceIP, *TenantNetwork, error) {
+	ip, err := parseServiceIP(serviceIP)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid service IP: %w", err)
+	}
+	tenant, err := NewTenantNetwork(iface, vlan, router, serviceIP)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid tenant network: %w", err)
+	}
+	return ip, tenant, nil
+}
+
+func renderDeviceOr(drbdDevice string) string {
+	if drbdDevice == "" {
+		return renderDevice
+	}
+	return drbdDevice
+}
@@ -0,0 +1,163 @@
+// Package metrics provides Prometheus metrics support for the SDS controller
+package metrics
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Dashboard UIDs served by DashboardsHandler
+const (
+	DashboardResources = "sds-resources"
+	DashboardPools     = "sds-pools"
+	DashboardOverview  = "sds-controller"
+)
+
+// Dashboards returns the generated Grafana dashboards keyed by UID.
+// The dashboards only reference labels attached by the collectors
+// (resource, node, pool, volume), so they can be imported as-is.
+func Dashboards() map[string]map[string]interface{} {
+	return map[string]map[string]interface{}{
+		DashboardResources: resourcesDashboard(),
+		DashboardPools:     poolsDashboard(),
+		DashboardOverview:  overviewDashboard(),
+	}
+}
+
+// DashboardsHandler serves the generated Grafana dashboards as JSON.
+// Without parameters all dashboards are returned; with ?uid=<uid> only the
+// selected dashboard is returned in a form that can be imported directly.
+func DashboardsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		dashboards := Dashboards()
+
+		var payload interface{} = dashboards
+		if uid := r.URL.Query().Get("uid"); uid != "" {
+			dashboard, ok := dashboards[uid]
+			if !ok {
+				http.Error(w, "dashboard not found: "+uid, http.StatusNotFound)
+				return
+			}
+			payload = dashboard
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		_ = enc.Encode(payload)
+	})
+}
+
+func resourcesDashboard() map[string]interface{} {
+	return dashboard(DashboardResources, "SDS / DRBD Resources",
+		[]map[string]interface{}{
+			templateVar("resource", `label_values(sds_drbd_resource_primary, resource)`),
+			templateVar("node", `label_values(sds_drbd_resource_primary{resource=~"$resource"}, node)`),
+		},
+		[]map[string]interface{}{
+			statPanel(1, "Primary node", `sds_drbd_resource_primary{resource=~"$resource", node=~"$node"} == 1`, "{{resource}} on {{node}}", 0, 0),
+			statPanel(2, "Volumes not UpToDate", `count(sds_drbd_volume_up_to_date{resource=~"$resource", node=~"$node"} == 0) or vector(0)`, "", 12, 0),
+			timeseriesPanel(3, "Volume disk state (1 = UpToDate)", `sds_drbd_volume_up_to_date{resource=~"$resource", node=~"$node"}`, "{{resource}}/{{volume}} @ {{node}}", "none", 0, 8),
+			timeseriesPanel(4, "Volume size", `sds_drbd_volume_size_bytes{resource=~"$resource", node=~"$node"}`, "{{resource}}/{{volume}} @ {{node}} ({{pool}})", "bytes", 12, 8),
+			timeseriesPanel(5, "LVM snapshot COW usage", `sds_snapshot_cow_used_ratio{resource=~"$resource", node=~"$node"}`, "{{snapshot}} of {{volume}} @ {{node}}", "percentunit", 0, 16),
+			timeseriesPanel(6, "Volume throughput", `sds_drbd_volume_io_bytes_per_second{resource=~"$resource", node=~"$node"}`, "{{resource}}/{{volume}} {{op}} @ {{node}}", "Bps", 12, 16),
+			timeseriesPanel(7, "Volume IOPS", `sds_drbd_volume_iops{resource=~"$resource", node=~"$node"}`, "{{resource}}/{{volume}} {{op}} @ {{node}}", "iops", 0, 24),
+			timeseriesPanel(8, "Volume I/O latency", `sds_drbd_volume_io_latency_seconds{resource=~"$resource", node=~"$node"}`, "{{resource}}/{{volume}} {{op}} @ {{node}}", "s", 12, 24),
+		})
+}
+
+func poolsDashboard() map[string]interface{} {
+	return dashboard(DashboardPools, "SDS / Storage Pools",
+		[]map[string]interface{}{
+			templateVar("pool", `label_values(sds_pool_capacity_bytes, pool)`),
+			templateVar("node", `label_values(sds_pool_capacity_bytes{pool=~"$pool"}, node)`),
+		},
+		[]map[string]interface{}{
+			timeseriesPanel(1, "Pool usage", `sds_pool_capacity_bytes{pool=~"$pool", node=~"$node", state="used"} / sds_pool_capacity_bytes{pool=~"$pool", node=~"$node", state="total"}`, "{{pool}} @ {{node}}", "percentunit", 0, 0),
+			timeseriesPanel(2, "Pool free space", `sds_pool_capacity_bytes{pool=~"$pool", node=~"$node", state="free"}`, "{{pool}} @ {{node}}", "bytes", 12, 0),
+			timeseriesPanel(3, "Allocated volume size per pool", `sum by (pool, node) (sds_drbd_volume_size_bytes{pool=~"$pool", node=~"$node"})`, "{{pool}} @ {{node}}", "bytes", 0, 8),
+		})
+}
+
+func overviewDashboard() map[string]interface{} {
+	return dashboard(DashboardOverview, "SDS / Controller",
+		[]map[string]interface{}{},
+		[]map[string]interface{}{
+			statPanel(1, "Controller up", `sds_controller_up`, "", 0, 0),
+			timeseriesPanel(2, "gRPC requests", `sum by (method, status) (rate(sds_controller_grpc_requests_total[5m]))`, "{{method}} {{status}}", "reqps", 12, 0),
+			timeseriesPanel(3, "gRPC latency (p95)", `histogram_quantile(0.95, sum by (method, le) (rate(sds_controller_grpc_request_duration_seconds_bucket[5m])))`, "{{method}}", "s", 0, 8),
+			timeseriesPanel(4, "Operations", `sum by (operation, result) (rate(sds_controller_operations_total[5m]))`, "{{operation}} {{result}}", "ops", 12, 8),
+		})
+}
+
+// dashboard builds a Grafana dashboard model with a Prometheus datasource variable
+func dashboard(uid, title string, vars, panels []map[string]interface{}) map[string]interface{} {
+	templating := []map[string]interface{}{
+		{
+			"name":  "datasource",
+			"label": "Data source",
+			"type":  "datasource",
+			"query": "prometheus",
+		},
+	}
+	templating = append(templating, vars...)
+
+	return map[string]interface{}{
+		"uid":           uid,
+		"title":         title,
+		"tags":          []string{"sds", "drbd"},
+		"timezone":      "browser",
+		"schemaVersion": 39,
+		"refresh":       "30s",
+		"time":          map[string]string{"from": "now-6h", "to": "now"},
+		"templating":    map[string]interface{}{"list": templating},
+		"panels":        panels,
+	}
+}
+
+func templateVar(name, query string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":       name,
+		"type":       "query",
+		"datasource": map[string]string{"type": "prometheus", "uid": "${datasource}"},
+		"query":      query,
+		"refresh":    2,
+		"includeAll": true,
+		"multi":      true,
+		"current":    map[string]interface{}{"text": "All", "value": "$__all"},
+	}
+}
+
+func statPanel(id int, title, expr, legend string, x, y int) map[string]interface{} {
+	return panel(id, "stat", title, expr, legend, "none", x, y)
+}
+
+func timeseriesPanel(id int, title, expr, legend, unit string, x, y int) map[string]interface{} {
+	return panel(id, "timeseries", title, expr, legend, unit, x, y)
+}
+
+func panel(id int, panelType, title, expr, legend, unit string, x, y int) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         id,
+		"type":       panelType,
+		"title":      title,
+		"datasource": map[string]string{"type": "prometheus", "uid": "${datasource}"},
+		"gridPos":    map[string]int{"x": x, "y": y, "w": 12, "h": 8},
+		"fieldConfig": map[string]interface{}{
+			"defaults":  map[string]string{"unit": unit},
+			"overrides": []interface{}{},
+		},
+		"targets": []map[string]interface{}{
+			{
+				"refId":        "A",
+				"expr":         expr,
+				"legendFormat": legend,
+			},
+		},
+	}
+}
@@ -3,6 +3,7 @@ package metrics
 
 import (
 	"net/http"
+	"strings"
 	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -14,6 +15,14 @@ import (
 const (
 	namespace = "sds"
 	subsystem = "controller"
+
+	drbdSubsystem     = "drbd"
+	poolSubsystem     = "pool"
+	snapshotSubsystem = "snapshot"
+	reactorSubsystem  = "reactor"
+	gatewaySubsystem  = "gateway"
+	nodeSubsystem     = "node"
+	replSubsystem     = "replication"
 )
 
 // Metrics holds all Prometheus metrics for the SDS controller
@@ -48,15 +57,67 @@ type Metrics struct {
 	// Up gauge indicates the instance is available (always 1)
 	up prometheus.Gauge
 
+	// DRBD resource role per node (1 = Primary, 0 = otherwise)
+	drbdResourcePrimary *prometheus.GaugeVec
+
+	// DRBD volume local disk state per node (1 = UpToDate, 0 = otherwise)
+	drbdVolumeUpToDate *prometheus.GaugeVec
+
+	// DRBD volume without its local disk per node (1 = Diskless or Failed, 0 = otherwise)
+	drbdVolumeDiskless *prometheus.GaugeVec
+
+	// DRBD volume size in bytes per node
+	drbdVolumeSize *prometheus.GaugeVec
+
+	// DRBD volume I/O rates on its Primary by operation (read, write)
+	drbdVolumeIOBytes   *prometheus.GaugeVec
+	drbdVolumeIOPS      *prometheus.GaugeVec
+	drbdVolumeIOLatency *prometheus.GaugeVec
+
+	// Pool capacity in bytes by pool, node and state
+	poolCapacity *prometheus.GaugeVec
+
+	// COW allocation ratio of thick LVM snapshots per node
+	snapshotCowUsed *prometheus.GaugeVec
+
+	// Health of a resource from the state of its replicas (0 = Healthy,
+	// 1 = Degraded, 2 = Critical)
+	drbdResourceHealth *prometheus.GaugeVec
+
+	// drbd-reactor promoter plugin of a resource per node (1 = active here)
+	reactorPluginActive *prometheus.GaugeVec
+
+	// Services of a gateway (1 = drbd-services target active on a node)
+	gatewayServiceUp *prometheus.GaugeVec
+
+	// Node under maintenance (1 = in a maintenance window)
+	nodeMaintenance *prometheus.GaugeVec
+
+	// Clock of a node against the controller's and whether NTP keeps it in sync
+	nodeClockSkew        *prometheus.GaugeVec
+	nodeTimeSynchronized *prometheus.GaugeVec
+
+	// Snapshot replication of a resource to its backup node
+	replicationLastSuccess *prometheus.GaugeVec
+	replicationStale       *prometheus.GaugeVec
+
 	// Go runtime metrics
 	goRuntimeMetrics *prometheus.CounterVec
 
 	// Process metrics
 	processMetrics *prometheus.GaugeVec
 
+	// Label sets of the collected gauges set by the last collection and by
+	// the running one, so that only the stale ones are deleted at its end
+	collected labelSets
+	seen      labelSets
+
 	mu sync.Mutex
 }
 
+// labelSets holds the label values set on each gauge, keyed by their join
+type labelSets map[*prometheus.GaugeVec]map[string][]string
+
 // New creates and registers all Prometheus metrics
 func New(logger *zap.Logger) (*Metrics, error) {
 	registry := prometheus.NewRegistry()
@@ -146,6 +207,159 @@ func New(logger *zap.Logger) (*Metrics, error) {
 				Help:      "Indicates the SDS controller instance is available (always 1)",
 			},
 		),
+		drbdResourcePrimary: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: drbdSubsystem,
+				Name:      "resource_primary",
+				Help:      "Whether the DRBD resource is Primary on the node (1) or not (0)",
+			},
+			[]string{"resource", "node"},
+		),
+		drbdResourceHealth: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: drbdSubsystem,
+				Name:      "resource_health",
+				Help:      "Health of the resource from the state of its replicas: Healthy (0), Degraded (1) or Critical (2)",
+			},
+			[]string{"resource"},
+		),
+		drbdVolumeUpToDate: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: drbdSubsystem,
+				Name:      "volume_up_to_date",
+				Help:      "Whether the local disk of the DRBD volume is UpToDate (1) or not (0)",
+			},
+			[]string{"resource", "node", "pool", "volume"},
+		),
+		drbdVolumeDiskless: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: drbdSubsystem,
+				Name:      "volume_diskless",
+				Help:      "Whether the DRBD volume lost its local disk on the node (1) or not (0)",
+			},
+			[]string{"resource", "node", "pool", "volume"},
+		),
+		drbdVolumeSize: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: drbdSubsystem,
+				Name:      "volume_size_bytes",
+				Help:      "Size of the DRBD volume in bytes",
+			},
+			[]string{"resource", "node", "pool", "volume"},
+		),
+		drbdVolumeIOBytes: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: drbdSubsystem,
+				Name:      "volume_io_bytes_per_second",
+				Help:      "Throughput of the DRBD volume on its Primary over the last poll interval",
+			},
+			[]string{"resource", "node", "volume", "op"},
+		),
+		drbdVolumeIOPS: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: drbdSubsystem,
+				Name:      "volume_iops",
+				Help:      "Completed I/Os per second of the DRBD volume on its Primary over the last poll interval",
+			},
+			[]string{"resource", "node", "volume", "op"},
+		),
+		drbdVolumeIOLatency: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: drbdSubsystem,
+				Name:      "volume_io_latency_seconds",
+				Help:      "Average time per completed I/O of the DRBD volume on its Primary over the last poll interval",
+			},
+			[]string{"resource", "node", "volume", "op"},
+		),
+		poolCapacity: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: poolSubsystem,
+				Name:      "capacity_bytes",
+				Help:      "Storage pool capacity in bytes by pool, node and state",
+			},
+			[]string{"pool", "node", "state"},
+		),
+		snapshotCowUsed: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: snapshotSubsystem,
+				Name:      "cow_used_ratio",
+				Help:      "Allocated ratio of the COW volume of thick LVM snapshots (invalidated at 1)",
+			},
+			[]string{"resource", "node", "volume", "snapshot"},
+		),
+		reactorPluginActive: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: reactorSubsystem,
+				Name:      "plugin_active",
+				Help:      "Whether the drbd-reactor promoter of the resource is active on the node (1) or not (0)",
+			},
+			[]string{"resource", "node"},
+		),
+		gatewayServiceUp: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: gatewaySubsystem,
+				Name:      "service_up",
+				Help:      "Whether the services of the gateway run on a node of its resource (1) or nowhere (0)",
+			},
+			[]string{"type", "resource"},
+		),
+		nodeMaintenance: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: nodeSubsystem,
+				Name:      "maintenance",
+				Help:      "Whether the node is in a maintenance window (1) or not (0)",
+			},
+			[]string{"node"},
+		),
+		nodeClockSkew: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: nodeSubsystem,
+				Name:      "clock_skew_seconds",
+				Help:      "Least difference of the node's clock to the controller's at the last time check, positive when ahead",
+			},
+			[]string{"node"},
+		),
+		nodeTimeSynchronized: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: nodeSubsystem,
+				Name:      "time_synchronized",
+				Help:      "Whether chrony or systemd-timesyncd keeps the node's clock synchronized (1) or not (0)",
+			},
+			[]string{"node"},
+		),
+		replicationLastSuccess: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: replSubsystem,
+				Name:      "last_success_timestamp_seconds",
+				Help:      "Unix time of the last snapshot the backup node received, 0 before the first",
+			},
+			[]string{"resource", "target_node"},
+		),
+		replicationStale: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: replSubsystem,
+				Name:      "stale",
+				Help:      "Whether the copy on the backup node is older than the policy allows (1) or not (0)",
+			},
+			[]string{"resource", "target_node"},
+		),
 	}
 
 	// Register all custom metrics with the custom registry
@@ -159,6 +373,23 @@ func New(logger *zap.Logger) (*Metrics, error) {
 		m.grpcRequestsTotal,
 		m.grpcRequestDuration,
 		m.up,
+		m.drbdResourcePrimary,
+		m.drbdResourceHealth,
+		m.drbdVolumeUpToDate,
+		m.drbdVolumeDiskless,
+		m.drbdVolumeSize,
+		m.drbdVolumeIOBytes,
+		m.drbdVolumeIOPS,
+		m.drbdVolumeIOLatency,
+		m.poolCapacity,
+		m.snapshotCowUsed,
+		m.reactorPluginActive,
+		m.gatewayServiceUp,
+		m.nodeMaintenance,
+		m.nodeClockSkew,
+		m.nodeTimeSynchronized,
+		m.replicationLastSuccess,
+		m.replicationStale,
 	)
 
 	// Set up to 1
@@ -272,6 +503,157 @@ func (m *Metrics) DecrementGatewayCount(gatewayType, state string) {
 	m.gateways.WithLabelValues(gatewayType, state).Dec()
 }
 
+// RecordResourceRole records whether a DRBD resource is Primary on a node
+func (m *Metrics) RecordResourceRole(resource, node string, primary bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.setCollected(m.drbdResourcePrimary, boolToFloat(primary), resource, node)
+}
+
+// RecordResourceHealth records the health level of a resource, 0 for
+// Healthy, 1 for Degraded and 2 for Critical
+func (m *Metrics) RecordResourceHealth(resource string, level int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.setCollected(m.drbdResourceHealth, float64(level), resource)
+}
+
+// RecordVolumeState records the local disk state and size of a DRBD volume on a node
+func (m *Metrics) RecordVolumeState(resource, node, pool, volume string, upToDate, diskless bool, sizeBytes float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.setCollected(m.drbdVolumeUpToDate, boolToFloat(upToDate), resource, node, pool, volume)
+	m.setCollected(m.drbdVolumeDiskless, boolToFloat(diskless), resource, node, pool, volume)
+	if sizeBytes > 0 {
+		m.setCollected(m.drbdVolumeSize, sizeBytes, resource, node, pool, volume)
+	}
+}
+
+// RecordVolumeIO records the I/O rates of a DRBD volume on its Primary,
+// latencies in milliseconds
+func (m *Metrics) RecordVolumeIO(resource, node, volume string, readBytes, writeBytes, readIOPS, writeIOPS, readLatencyMs, writeLatencyMs float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.setCollected(m.drbdVolumeIOBytes, readBytes, resource, node, volume, "read")
+	m.setCollected(m.drbdVolumeIOBytes, writeBytes, resource, node, volume, "write")
+	m.setCollected(m.drbdVolumeIOPS, readIOPS, resource, node, volume, "read")
+	m.setCollected(m.drbdVolumeIOPS, writeIOPS, resource, node, volume, "write")
+	m.setCollected(m.drbdVolumeIOLatency, readLatencyMs/1000, resource, node, volume, "read")
+	m.setCollected(m.drbdVolumeIOLatency, writeLatencyMs/1000, resource, node, volume, "write")
+}
+
+// RecordPoolCapacity records the capacity of a storage pool on a node
+func (m *Metrics) RecordPoolCapacity(pool, node string, total, free float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.setCollected(m.poolCapacity, total, pool, node, "total")
+	m.setCollected(m.poolCapacity, free, pool, node, "free")
+	m.setCollected(m.poolCapacity, total-free, pool, node, "used")
+}
+
+// RecordSnapshotCowUsage records the COW allocation of a thick LVM snapshot on a node
+func (m *Metrics) RecordSnapshotCowUsage(resource, node, volume, snapshot string, percent float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.setCollected(m.snapshotCowUsed, percent/100, resource, node, volume, snapshot)
+}
+
+// RecordReactorPlugin records whether the promoter of a resource is active on a node
+func (m *Metrics) RecordReactorPlugin(resource, node string, active bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.setCollected(m.reactorPluginActive, boolToFloat(active), resource, node)
+}
+
+// RecordGatewayService records whether the services of a gateway are up
+func (m *Metrics) RecordGatewayService(gatewayType, resource string, up bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.setCollected(m.gatewayServiceUp, boolToFloat(up), gatewayType, resource)
+}
+
+// RecordNodeMaintenance records whether a node is in a maintenance window
+func (m *Metrics) RecordNodeMaintenance(node string, active bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.setCollected(m.nodeMaintenance, boolToFloat(active), node)
+}
+
+// RecordNodeTime records the clock skew of a node in seconds and whether its
+// clock is synchronized
+func (m *Metrics) RecordNodeTime(node string, skew float64, synchronized bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.setCollected(m.nodeClockSkew, skew, node)
+	m.setCollected(m.nodeTimeSynchronized, boolToFloat(synchronized), node)
+}
+
+// RecordReplication records the last successful replication of a resource
+// to its backup node as Unix time and whether its copy is stale
+func (m *Metrics) RecordReplication(resource, targetNode string, lastSuccess float64, stale bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.setCollected(m.replicationLastSuccess, lastSuccess, resource, targetNode)
+	m.setCollected(m.replicationStale, boolToFloat(stale), resource, targetNode)
+}
+
+// BeginCollection starts a new collection of the DRBD, pool, snapshot,
+// reactor, gateway, node and replication gauges
+func (m *Metrics) BeginCollection() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.seen = make(labelSets)
+}
+
+// EndCollection deletes the label sets of the collected gauges that the
+// collection did not set again, so that deleted resources and pools do not
+// linger. The others keep their values throughout the collection, so
+// scrapes never see them missing.
+func (m *Metrics) EndCollection() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.seen == nil {
+		return
+	}
+	for vec, sets := range m.collected {
+		for key, labels := range sets {
+			if _, ok := m.seen[vec][key]; !ok {
+				vec.DeleteLabelValues(labels...)
+			}
+		}
+	}
+	m.collected = m.seen
+	m.seen = nil
+}
+
+// setCollected sets a collected gauge and records its label set in the
+// running collection. The caller holds m.mu.
+func (m *Metrics) setCollected(vec *prometheus.GaugeVec, value float64, labels ...string) {
+	vec.WithLabelValues(labels...).Set(value)
+	if m.seen == nil {
+		return
+	}
+	sets := m.seen[vec]
+	if sets == nil {
+		sets = make(map[string][]string)
+		m.seen[vec] = sets
+	}
+	sets[strings.Join(labels, "\xff")] = labels
+}
+
 // RecordGRPCRequest records a gRPC request with method, status, and duration
 func (m *Metrics) RecordGRPCRequest(method, status string, duration float64) {
 	m.mu.Lock()
@@ -302,9 +684,25 @@ func (m *Metrics) ResetMetrics() {
 	m.gateways.Reset()
 	m.grpcRequestsTotal.Reset()
 	m.grpcRequestDuration.Reset()
+	m.drbdResourcePrimary.Reset()
+	m.drbdVolumeUpToDate.Reset()
+	m.drbdVolumeSize.Reset()
+	m.poolCapacity.Reset()
+	m.snapshotCowUsed.Reset()
+	m.reactorPluginActive.Reset()
+	m.gatewayServiceUp.Reset()
+	m.collected = nil
 }
 
 // GetRegistry returns the Prometheus registry
 func (m *Metrics) GetRegistry() *prometheus.Registry {
 	return m.registry
 }
+
+// boolToFloat converts a boolean to a gauge value
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
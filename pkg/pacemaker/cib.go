@@ -0,0 +1,368 @@
+// Package pacemaker reads the HA setup of DRBD resources from a Pacemaker
+// CIB, so clusters can move their resources to drbd-reactor one at a time
+package pacemaker
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CIB is the configuration section of a Pacemaker CIB, as cibadmin --query
+// prints it
+type CIB struct {
+	ClusterProperties []NVPair     `xml:"configuration>crm_config>cluster_property_set>nvpair"`
+	Resources         Resources    `xml:"configuration>resources"`
+	Colocations       []Colocation `xml:"configuration>constraints>rsc_colocation"`
+	Locations         []Location   `xml:"configuration>constraints>rsc_location"`
+}
+
+// Resources are the resources of a CIB or of a group or clone
+type Resources struct {
+	Primitives []*Primitive `xml:"primitive"`
+	Groups     []*Group     `xml:"group"`
+	Clones     []*Clone     `xml:"clone"`
+	Masters    []*Clone     `xml:"master"` // Promotable clones before Pacemaker 2
+}
+
+// Primitive is a single resource run by a resource agent
+type Primitive struct {
+	ID       string   `xml:"id,attr"`
+	Class    string   `xml:"class,attr"`
+	Provider string   `xml:"provider,attr"`
+	Type     string   `xml:"type,attr"`
+	Params   []NVPair `xml:"instance_attributes>nvpair"`
+	Meta     []NVPair `xml:"meta_attributes>nvpair"`
+}
+
+// Group is a list of primitives started in order on the same node
+type Group struct {
+	ID         string       `xml:"id,attr"`
+	Meta       []NVPair     `xml:"meta_attributes>nvpair"`
+	Primitives []*Primitive `xml:"primitive"`
+}
+
+// Clone runs a primitive or group on several nodes, promotable clones
+// promote one instance
+type Clone struct {
+	ID        string     `xml:"id,attr"`
+	Meta      []NVPair   `xml:"meta_attributes>nvpair"`
+	Primitive *Primitive `xml:"primitive"`
+	Group     *Group     `xml:"group"`
+}
+
+// NVPair is a name/value attribute
+type NVPair struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// Colocation places rsc on the node of with-rsc
+type Colocation struct {
+	ID          string `xml:"id,attr"`
+	Rsc         string `xml:"rsc,attr"`
+	WithRsc     string `xml:"with-rsc,attr"`
+	WithRscRole string `xml:"with-rsc-role,attr"`
+	Score       string `xml:"score,attr"`
+}
+
+// Location prefers or avoids a node for a resource
+type Location struct {
+	ID    string `xml:"id,attr"`
+	Rsc   string `xml:"rsc,attr"`
+	Node  string `xml:"node,attr"`
+	Score string `xml:"score,attr"`
+}
+
+// HaResource is the HA setup of one DRBD resource: the primitives that run
+// on its Primary, mapped onto a mount, a VIP and systemd services
+type HaResource struct {
+	DrbdResource   string
+	Clone          string   // ID of the promotable DRBD clone
+	Members        []string // IDs of the primitives that run on the Primary
+	MountPoint     string
+	FsType         string
+	VIP            string // address/prefix
+	Services       []string
+	PreferredNodes []string // From location constraints, most preferred first
+	Unmanaged      bool     // Pacemaker leaves the clone and all members alone
+	Unsupported    []string // Members without an sds equivalent
+	Warnings       []string
+}
+
+// Parse parses a CIB
+func Parse(data []byte) (*CIB, error) {
+	var cib CIB
+	if err := xml.Unmarshal(data, &cib); err != nil {
+		return nil, fmt.Errorf("failed to parse CIB: %w", err)
+	}
+	return &cib, nil
+}
+
+// byRes matches the DRBD device of a resource volume
+var byRes = regexp.MustCompile(`^/dev/drbd/by-res/([^/]+)(?:/(\d+))?$`)
+
+// HaResources returns the HA setup of every DRBD resource managed by a
+// promotable clone, ordered by resource name
+func (c *CIB) HaResources() []*HaResource {
+	maintenance := isTrue(c.ClusterProperties, "maintenance-mode")
+
+	var result []*HaResource
+	for _, clone := range c.promotableClones() {
+		drbd := clone.Primitive
+		res := &HaResource{
+			DrbdResource: param(drbd.Params, "drbd_resource"),
+			Clone:        clone.ID,
+		}
+		if res.DrbdResource == "" {
+			continue
+		}
+
+		colocated, members, membersUnmanaged := c.colocatedWith(res, maintenance)
+		res.Unmanaged = maintenance || ((unmanaged(clone.Meta) || unmanaged(drbd.Meta)) && membersUnmanaged)
+		for _, p := range members {
+			res.Members = append(res.Members, p.ID)
+			res.addMember(p)
+		}
+		if res.MountPoint == "" && len(res.Services) > 0 {
+			res.Warnings = append(res.Warnings, "no Filesystem resource, the services start without a mount")
+		}
+		res.PreferredNodes, res.Warnings = c.preferredNodes(append(append([]string{clone.ID}, colocated...), res.Members...), res.Warnings)
+		result = append(result, res)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].DrbdResource < result[j].DrbdResource })
+	return result
+}
+
+// promotableClones returns the promotable clones of ocf:linbit:drbd
+func (c *CIB) promotableClones() []*Clone {
+	var clones []*Clone
+	for _, clone := range append(append([]*Clone(nil), c.Resources.Masters...), c.Resources.Clones...) {
+		p := clone.Primitive
+		if p == nil || p.Class != "ocf" || p.Provider != "linbit" || p.Type != "drbd" {
+			continue
+		}
+		if isTrue(clone.Meta, "promotable") || containsClone(c.Resources.Masters, clone) {
+			clones = append(clones, clone)
+		}
+	}
+	return clones
+}
+
+// colocatedWith returns the resources colocated with the Primary of a DRBD
+// clone, directly or through each other, and their primitives in group
+// order. It also reports whether Pacemaker manages none of them.
+func (c *CIB) colocatedWith(res *HaResource, maintenance bool) ([]string, []*Primitive, bool) {
+	clone := res.Clone
+	bound := map[string]bool{clone: true}
+	var order []string
+	for changed := true; changed; {
+		changed = false
+		for _, col := range c.Colocations {
+			if bound[col.Rsc] || !bound[col.WithRsc] || !mandatory(col.Score) {
+				continue
+			}
+			if col.WithRsc == clone && col.WithRscRole != "Master" && col.WithRscRole != "Promoted" {
+				continue
+			}
+			bound[col.Rsc] = true
+			order = append(order, col.Rsc)
+			changed = true
+		}
+	}
+
+	var members []*Primitive
+	allUnmanaged := true
+	for _, id := range order {
+		if p := c.primitive(id); p != nil {
+			members = append(members, p)
+			allUnmanaged = allUnmanaged && (maintenance || unmanaged(p.Meta))
+			continue
+		}
+		found := false
+		for _, g := range c.Resources.Groups {
+			if g.ID != id {
+				continue
+			}
+			found = true
+			members = append(members, g.Primitives...)
+			for _, p := range g.Primitives {
+				allUnmanaged = allUnmanaged && (maintenance || unmanaged(g.Meta) || unmanaged(p.Meta))
+			}
+		}
+		if !found {
+			res.Unsupported = append(res.Unsupported, fmt.Sprintf("%s: colocated clones and bundles have no sds equivalent", id))
+		}
+	}
+	return order, members, allUnmanaged
+}
+
+// primitive returns a top-level primitive by ID
+func (c *CIB) primitive(id string) *Primitive {
+	for _, p := range c.Resources.Primitives {
+		if p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// addMember maps a primitive onto the mount, VIP or services of the HA setup
+func (r *HaResource) addMember(p *Primitive) {
+	agent := p.Class + ":" + p.Type
+	if p.Provider != "" {
+		agent = p.Class + ":" + p.Provider + ":" + p.Type
+	}
+
+	switch {
+	case agent == "ocf:heartbeat:Filesystem":
+		if r.MountPoint != "" {
+			r.Unsupported = append(r.Unsupported, fmt.Sprintf("%s: second Filesystem, only one mount per resource", p.ID))
+			return
+		}
+		device := param(p.Params, "device")
+		m := byRes.FindStringSubmatch(device)
+		switch {
+		case m != nil && m[1] != r.DrbdResource:
+			r.Unsupported = append(r.Unsupported, fmt.Sprintf("%s: mounts %s of another resource", p.ID, device))
+			return
+		case m != nil && m[2] != "" && m[2] != "0":
+			r.Unsupported = append(r.Unsupported, fmt.Sprintf("%s: mounts volume %s, only volume 0 can be mounted", p.ID, m[2]))
+			return
+		case m == nil:
+			r.Warnings = append(r.Warnings, fmt.Sprintf("%s: device %s is mounted as /dev/drbd/by-res/%s/0", p.ID, device, r.DrbdResource))
+		}
+		r.MountPoint = param(p.Params, "directory")
+		r.FsType = param(p.Params, "fstype")
+		if options := param(p.Params, "options"); options != "" {
+			r.Warnings = append(r.Warnings, fmt.Sprintf("%s: mount options %q are not carried over", p.ID, options))
+		}
+
+	case agent == "ocf:heartbeat:IPaddr2" || agent == "ocf:heartbeat:IPaddr":
+		if r.VIP != "" {
+			r.Unsupported = append(r.Unsupported, fmt.Sprintf("%s: second IP address, only one VIP per resource", p.ID))
+			return
+		}
+		ip := param(p.Params, "ip")
+		prefix := param(p.Params, "cidr_netmask")
+		if prefix == "" {
+			prefix = "32"
+			r.Warnings = append(r.Warnings, fmt.Sprintf("%s: no cidr_netmask, using /32", p.ID))
+		}
+		r.VIP = ip + "/" + prefix
+
+	case p.Class == "systemd" || p.Class == "service" || p.Class == "lsb":
+		// systemd generates units for LSB init scripts as well
+		unit := p.Type
+		if !strings.Contains(unit, ".") {
+			unit += ".service"
+		}
+		r.Services = append(r.Services, unit)
+
+	default:
+		r.Unsupported = append(r.Unsupported, fmt.Sprintf("%s: %s has no sds equivalent, replace it with a systemd unit", p.ID, agent))
+	}
+}
+
+// preferredNodes orders the nodes that location constraints of the given
+// resources prefer, highest score first
+func (c *CIB) preferredNodes(ids []string, warnings []string) ([]string, []string) {
+	scores := make(map[string]float64)
+	for _, loc := range c.Locations {
+		if loc.Node == "" || !containsString(ids, loc.Rsc) {
+			continue
+		}
+		score := parseScore(loc.Score)
+		if score < 0 {
+			warnings = append(warnings, fmt.Sprintf("%s: %s avoids node %s, drbd-reactor has no equivalent", loc.ID, loc.Rsc, loc.Node))
+			continue
+		}
+		scores[loc.Node] += score
+	}
+
+	nodes := make([]string, 0, len(scores))
+	for node := range scores {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if scores[nodes[i]] != scores[nodes[j]] {
+			return scores[nodes[i]] > scores[nodes[j]]
+		}
+		return nodes[i] < nodes[j]
+	})
+	return nodes, warnings
+}
+
+// parseScore parses a Pacemaker score, INFINITY included
+func parseScore(score string) float64 {
+	switch strings.TrimPrefix(strings.ToUpper(score), "+") {
+	case "INFINITY":
+		return math.Inf(1)
+	case "-INFINITY":
+		return math.Inf(-1)
+	}
+	v, _ := strconv.ParseFloat(score, 64)
+	return v
+}
+
+// mandatory reports whether a colocation score forces the resources together
+func mandatory(score string) bool {
+	return score == "" || math.IsInf(parseScore(score), 1)
+}
+
+// unmanaged reports whether meta attributes take a resource out of
+// Pacemaker's hands
+func unmanaged(meta []NVPair) bool {
+	return isFalse(meta, "is-managed") || isTrue(meta, "maintenance")
+}
+
+// param returns the value of an attribute, empty if it is not set
+func param(pairs []NVPair, name string) string {
+	for _, p := range pairs {
+		if p.Name == name {
+			return p.Value
+		}
+	}
+	return ""
+}
+
+// isTrue reports whether an attribute is set to a Pacemaker true value
+func isTrue(pairs []NVPair, name string) bool {
+	switch strings.ToLower(param(pairs, name)) {
+	case "true", "yes", "on", "y", "1":
+		return true
+	}
+	return false
+}
+
+// isFalse reports whether an attribute is set to a Pacemaker false value
+func isFalse(pairs []NVPair, name string) bool {
+	switch strings.ToLower(param(pairs, name)) {
+	case "false", "no", "off", "n", "0":
+		return true
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsClone(list []*Clone, clone *Clone) bool {
+	for _, c := range list {
+		if c == clone {
+			return true
+		}
+	}
+	return false
+}
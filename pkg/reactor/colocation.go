@@ -0,0 +1,96 @@
+package reactor
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// ocfAction is a parsed "ocf:provider:agent instance key=value ..." start action
+type ocfAction struct {
+	agent  string
+	params map[string]string
+}
+
+// parseOCFAction splits an OCF start action into its agent and parameters.
+// ok is false for systemd units and other runners' actions.
+func parseOCFAction(action string) (ocfAction, bool) {
+	fields := strings.Fields(action)
+	if len(fields) == 0 || !strings.HasPrefix(fields[0], "ocf:") {
+		return ocfAction{}, false
+	}
+	parts := strings.Split(fields[0], ":")
+	a := ocfAction{agent: parts[len(parts)-1], params: make(map[string]string)}
+	for _, field := range fields[1:] {
+		if key, value, ok := strings.Cut(field, "="); ok {
+			a.params[key] = value
+		}
+	}
+	return a, true
+}
+
+// blocksPort reports whether an action is a portblock agent with the given
+// action on port
+func (a ocfAction) blocksPort(action string, port int) bool {
+	return a.agent == "portblock" && a.params["action"] == action && a.params["portno"] == strconv.Itoa(port)
+}
+
+// CheckColocation checks that a gateway snippet keeps the export service with
+// the DRBD Primary of resource: the promoter is keyed on the DRBD resource,
+// so drbd-reactor only starts the services on the node it promoted, and the
+// service port is blocked before the service IP comes up and unblocked as
+// the last start action, so clients retry instead of being reset while the
+// service moves between nodes
+func CheckColocation(content, resource string, port int) error {
+	var doc struct {
+		Promoter []struct {
+			Resources map[string]struct {
+				Start []string `toml:"start"`
+			} `toml:"resources"`
+		} `toml:"promoter"`
+	}
+	if err := toml.Unmarshal([]byte(content), &doc); err != nil {
+		return fmt.Errorf("failed to parse promoter config: %w", err)
+	}
+	if len(doc.Promoter) != 1 || len(doc.Promoter[0].Resources) != 1 {
+		return fmt.Errorf("gateway config must contain a single promoter for resource %s", resource)
+	}
+
+	promoted, ok := doc.Promoter[0].Resources[resource]
+	if !ok {
+		for name := range doc.Promoter[0].Resources {
+			return fmt.Errorf("promoter is keyed on %s, the services would not follow the Primary of resource %s", name, resource)
+		}
+	}
+
+	var actions []ocfAction
+	for _, action := range promoted.Start {
+		if a, ok := parseOCFAction(action); ok {
+			actions = append(actions, a)
+		}
+	}
+
+	blocked, serviceIP := -1, -1
+	for i, a := range actions {
+		if blocked < 0 && a.blocksPort("block", port) {
+			blocked = i
+		}
+		if serviceIP < 0 && a.agent == "IPaddr2" {
+			serviceIP = i
+		}
+	}
+	switch {
+	case blocked < 0:
+		return fmt.Errorf("resource %s: no portblock action blocks port %d", resource, port)
+	case serviceIP < 0:
+		return fmt.Errorf("resource %s: no IPaddr2 action starts the service IP", resource)
+	case serviceIP < blocked:
+		return fmt.Errorf("resource %s: port %d must be blocked before the service IP starts", resource, port)
+	}
+	if last := actions[len(actions)-1]; !last.blocksPort("unblock", port) {
+		return fmt.Errorf("resource %s: the last start action must unblock port %d", resource, port)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package reactor
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// ConfigDir is the directory drbd-reactor reads its plugin snippets from
+const ConfigDir = "/etc/drbd-reactor.d"
+
+// DemoteFailureActions are the systemd actions drbd-reactor accepts for
+// on-drbd-demote-failure
+var DemoteFailureActions = []string{
+	"none", "reboot", "reboot-force", "reboot-immediate",
+	"poweroff", "poweroff-force", "poweroff-immediate", "exit", "exit-force",
+}
+
+// snippetPlugins are the plugin tables a snippet may contain
+var snippetPlugins = map[string]bool{
+	"promoter":   true,
+	"debugger":   true,
+	"umh":        true,
+	"prometheus": true,
+	"agentx":     true,
+}
+
+// IsSnippetPath reports whether a path is a drbd-reactor plugin snippet
+func IsSnippetPath(p string) bool {
+	return path.Dir(p) == ConfigDir && strings.HasSuffix(p, ".toml")
+}
+
+// LintConfig parses a drbd-reactor snippet and checks the structure of its
+// promoter plugins, so a broken config is never written to the nodes, where
+// it would stop drbd-reactor from loading any plugin
+func LintConfig(content string) error {
+	var doc map[string]interface{}
+	if err := toml.Unmarshal([]byte(content), &doc); err != nil {
+		var decodeErr *toml.DecodeError
+		if errors.As(err, &decodeErr) {
+			row, col := decodeErr.Position()
+			return fmt.Errorf("TOML syntax error at line %d, column %d: %s", row, col, decodeErr.Error())
+		}
+		return fmt.Errorf("TOML syntax error: %w", err)
+	}
+
+	var keys []string
+	for key := range doc {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !snippetPlugins[key] {
+			return fmt.Errorf("unknown plugin %q", key)
+		}
+	}
+
+	promoters, ok := doc["promoter"]
+	if !ok {
+		return nil
+	}
+	list, ok := promoters.([]interface{})
+	if !ok {
+		return fmt.Errorf("promoter must be an array of tables ([[promoter]])")
+	}
+	for i, p := range list {
+		promoter, ok := p.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("promoter %d is not a table", i)
+		}
+		if err := lintPromoter(promoter); err != nil {
+			return fmt.Errorf("promoter %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// lintPromoter checks the resources of a promoter plugin
+func lintPromoter(promoter map[string]interface{}) error {
+	resources, ok := promoter["resources"].(map[string]interface{})
+	if !ok || len(resources) == 0 {
+		return fmt.Errorf("no resources table")
+	}
+
+	for name, r := range resources {
+		res, ok := r.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("resource %s is not a table", name)
+		}
+
+		start, ok := res["start"].([]interface{})
+		if !ok || len(start) == 0 {
+			return fmt.Errorf("resource %s: start must be a non-empty array", name)
+		}
+		for _, action := range start {
+			if s, ok := action.(string); !ok || strings.TrimSpace(s) == "" {
+				return fmt.Errorf("resource %s: start actions must be non-empty strings", name)
+			}
+		}
+
+		if runner, ok := res["runner"]; ok && runner != "systemd" && runner != "shell" {
+			return fmt.Errorf("resource %s: unknown runner %v", name, runner)
+		}
+		if action, ok := res["on-drbd-demote-failure"]; ok {
+			s, _ := action.(string)
+			if !containsString(DemoteFailureActions, s) {
+				return fmt.Errorf("resource %s: invalid on-drbd-demote-failure %v", name, action)
+			}
+		}
+		for _, key := range []string{"stop-services-on-exit", "secondary-force"} {
+			if v, ok := res[key]; ok {
+				if _, isBool := v.(bool); !isBool {
+					return fmt.Errorf("resource %s: %s must be a boolean", name, key)
+				}
+			}
+		}
+		if nodes, ok := res["preferred-nodes"]; ok {
+			list, isList := nodes.([]interface{})
+			if !isList {
+				return fmt.Errorf("resource %s: preferred-nodes must be an array", name)
+			}
+			for _, node := range list {
+				if _, isString := node.(string); !isString {
+					return fmt.Errorf("resource %s: preferred-nodes must be strings", name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// containsString reports whether list contains s
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
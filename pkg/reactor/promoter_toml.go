@@ -5,6 +5,12 @@ import (
 	"strings"
 )
 
+// Service ports the gateways block while they move between nodes
+const (
+	nfsPort   = 2049
+	iscsiPort = 3260
+)
+
 // NFSClient represents NFS client configuration
 type NFSClient struct {
 	Address string
@@ -32,6 +38,15 @@ func GenerateNFSPromoterConfig(resourceName, serviceIP, exportPath string, clien
 	// Build OCF resource commands
 	var resources []string
 
+	// Block the NFS port until the export is ready, clients retry instead
+	// of getting connection resets during a failover
+	blockOCF := fmt.Sprintf(`  ocf:heartbeat:portblock portblock_%s
+    ip = "%s"
+    portno = %d
+    action = "block"
+    protocol = "tcp"`, resourceName, serviceIP, nfsPort)
+	resources = append(resources, blockOCF)
+
 	// 1. Filesystem agent - mount DRBD device
 	fsOCF := fmt.Sprintf(`  ocf:heartbeat:Filesystem fs_%s
     device = "%s"
@@ -55,12 +70,21 @@ func GenerateNFSPromoterConfig(resourceName, serviceIP, exportPath string, clien
     options = "%s"`, resourceName, exportPath, clientsStr, options)
 	resources = append(resources, exportOCF)
 
-	// 4. IPaddr2 agent - manage virtual IP (must be last)
+	// 4. IPaddr2 agent - manage virtual IP
 	vipOCF := fmt.Sprintf(`  ocf:heartbeat:IPaddr2 service_ip_%s
     ip = "%s"
     cidr_netmask = 24`, resourceName, serviceIP)
 	resources = append(resources, vipOCF)
 
+	// 5. Unblock the NFS port once everything is up (must be last)
+	unblockOCF := fmt.Sprintf(`  ocf:heartbeat:portblock portunblock_%s
+    ip = "%s"
+    portno = %d
+    action = "unblock"
+    protocol = "tcp"
+    tickle_dir = "%s"`, resourceName, serviceIP, nfsPort, nfsInfodir)
+	resources = append(resources, unblockOCF)
+
 	// Generate TOML config
 	toml := fmt.Sprintf(`# drbd-reactor promoter configuration for NFS gateway: %s
 # Generated by sds-controller
@@ -83,6 +107,14 @@ func GenerateNFSPromoterConfig(resourceName, serviceIP, exportPath string, clien
 func GenerateiSCSIPromoterConfig(resourceName, serviceIP, iqn string, tpgt int, luns []ISCSILun, implementation string, nodes []string) string {
 	var resources []string
 
+	// Block the portal until the target and its LUNs are ready
+	blockOCF := fmt.Sprintf(`  ocf:heartbeat:portblock portblock_%s
+    ip = "%s"
+    portno = %d
+    action = "block"
+    protocol = "tcp"`, resourceName, serviceIP, iscsiPort)
+	resources = append(resources, blockOCF)
+
 	// 1. IPaddr2 agent - manage virtual IP
 	vipOCF := fmt.Sprintf(`  ocf:heartbeat:IPaddr2 p_%s_vip
     ip = "%s"
@@ -90,7 +122,7 @@ func GenerateiSCSIPromoterConfig(resourceName, serviceIP, iqn string, tpgt int,
 	resources = append(resources, vipOCF)
 
 	// 2. iSCSITarget agent - manage iSCSI target
-	portal := fmt.Sprintf("\"%s:3260\"", serviceIP)
+	portal := fmt.Sprintf("\"%s:%d\"", serviceIP, iscsiPort)
 	targetOCF := fmt.Sprintf(`  ocf:heartbeat:HA-SDS-iSCSITarget p_%s_target
     implementation = "%s"
     iqn = "%s"
@@ -111,6 +143,14 @@ func GenerateiSCSIPromoterConfig(resourceName, serviceIP, iqn string, tpgt int,
 		resources = append(resources, lunOCF)
 	}
 
+	// 4. Unblock the portal once the LUNs are exported (must be last)
+	unblockOCF := fmt.Sprintf(`  ocf:heartbeat:portblock portunblock_%s
+    ip = "%s"
+    portno = %d
+    action = "unblock"
+    protocol = "tcp"`, resourceName, serviceIP, iscsiPort)
+	resources = append(resources, unblockOCF)
+
 	// Generate TOML config
 	toml := fmt.Sprintf(`# drbd-reactor promoter configuration for iSCSI gateway: %s
 # Generated by sds-controller
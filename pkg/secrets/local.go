@@ -0,0 +1,114 @@
+package secrets
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// masterKeySize is the size of the AES-256 master key
+const masterKeySize = 32
+
+// Storage persists encrypted secrets, implemented by the controller database
+type Storage interface {
+	SaveSecret(ctx context.Context, id string, data []byte) error
+	GetSecret(ctx context.Context, id string) ([]byte, error)
+	DeleteSecret(ctx context.Context, id string) error
+}
+
+// LocalBackend encrypts secrets with AES-256-GCM under the controller
+// master key and keeps them in the controller database
+type LocalBackend struct {
+	storage Storage
+	aead    cipher.AEAD
+}
+
+// NewLocal creates a local backend, generating the master key file on first use
+func NewLocal(storage Storage, keyFile string) (*LocalBackend, error) {
+	key, err := loadOrCreateKey(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid master key: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	return &LocalBackend{storage: storage, aead: aead}, nil
+}
+
+// Put encrypts and stores a secret. The ID is authenticated along with the
+// value, so a ciphertext cannot be moved to another ID.
+func (b *LocalBackend) Put(ctx context.Context, id string, value []byte) error {
+	nonce := make([]byte, b.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return b.storage.SaveSecret(ctx, id, b.aead.Seal(nonce, nonce, value, []byte(id)))
+}
+
+// Get reads and decrypts a secret
+func (b *LocalBackend) Get(ctx context.Context, id string) ([]byte, error) {
+	data, err := b.storage.GetSecret(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	size := b.aead.NonceSize()
+	if len(data) < size {
+		return nil, fmt.Errorf("stored secret is corrupt")
+	}
+	value, err := b.aead.Open(nil, data[:size], data[size:], []byte(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt secret, wrong master key?")
+	}
+	return value, nil
+}
+
+// Delete deletes a secret
+func (b *LocalBackend) Delete(ctx context.Context, id string) error {
+	return b.storage.DeleteSecret(ctx, id)
+}
+
+// loadOrCreateKey reads the master key file, or creates it with a random key
+func loadOrCreateKey(path string) ([]byte, error) {
+	key, err := os.ReadFile(path)
+	if err == nil {
+		if len(key) != masterKeySize {
+			return nil, fmt.Errorf("master key %s must be %d bytes, got %d", path, masterKeySize, len(key))
+		}
+		return key, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read master key: %w", err)
+	}
+
+	key = make([]byte, masterKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, fmt.Errorf("failed to generate master key: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create master key directory: %w", err)
+	}
+	// O_EXCL so a concurrently created key is never overwritten
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create master key: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(key); err != nil {
+		return nil, fmt.Errorf("failed to write master key: %w", err)
+	}
+	return key, nil
+}
@@ -0,0 +1,83 @@
+// Package secrets stores credentials such as CHAP passwords outside of the
+// objects that use them. Objects keep a reference ("secret:<id>") and the
+// value is resolved from a backend only when it is needed.
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// RefPrefix marks a config value as a reference to a stored secret
+const RefPrefix = "secret:"
+
+// Redacted replaces secret values in logs and API responses
+const Redacted = "******"
+
+// Backend stores secret values by ID
+type Backend interface {
+	Put(ctx context.Context, id string, value []byte) error
+	Get(ctx context.Context, id string) ([]byte, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// Store stores secrets in a backend and resolves references to them
+type Store struct {
+	backend Backend
+}
+
+// New creates a secrets store on a backend
+func New(backend Backend) *Store {
+	return &Store{backend: backend}
+}
+
+// Ref returns the reference to a secret ID
+func Ref(id string) string {
+	return RefPrefix + id
+}
+
+// IsRef reports whether a value is a secret reference
+func IsRef(value string) bool {
+	return strings.HasPrefix(value, RefPrefix)
+}
+
+// Put stores a secret value and returns its reference
+func (s *Store) Put(ctx context.Context, id, value string) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("secret ID is empty")
+	}
+	if err := s.backend.Put(ctx, id, []byte(value)); err != nil {
+		return "", fmt.Errorf("failed to store secret %s: %w", id, err)
+	}
+	return Ref(id), nil
+}
+
+// Resolve returns the value of a secret reference. Values that are not
+// references are returned unchanged.
+func (s *Store) Resolve(ctx context.Context, value string) (string, error) {
+	if !IsRef(value) {
+		return value, nil
+	}
+
+	id := strings.TrimPrefix(value, RefPrefix)
+	data, err := s.backend.Get(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret %s: %w", id, err)
+	}
+	return string(data), nil
+}
+
+// Delete deletes the secret behind a reference. Values that are not
+// references are ignored.
+func (s *Store) Delete(ctx context.Context, value string) error {
+	if !IsRef(value) {
+		return nil
+	}
+
+	id := strings.TrimPrefix(value, RefPrefix)
+	if err := s.backend.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete secret %s: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// VaultBackend keeps secrets in a HashiCorp Vault KV version 2 engine
+type VaultBackend struct {
+	address string
+	token   string
+	mount   string
+	prefix  string
+	client  *http.Client
+}
+
+// NewVault creates a Vault backend. Secrets are stored at
+// <mount>/data/<prefix>/<id> under the "value" key.
+func NewVault(address, token, mount, prefix string) (*VaultBackend, error) {
+	if address == "" || token == "" {
+		return nil, fmt.Errorf("vault address and token are required")
+	}
+	if mount == "" {
+		mount = "secret"
+	}
+
+	return &VaultBackend{
+		address: strings.TrimSuffix(address, "/"),
+		token:   token,
+		mount:   strings.Trim(mount, "/"),
+		prefix:  strings.Trim(prefix, "/"),
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// Put writes a secret
+func (b *VaultBackend) Put(ctx context.Context, id string, value []byte) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"data": map[string]string{"value": string(value)},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = b.do(ctx, http.MethodPost, "data", id, body)
+	return err
+}
+
+// Get reads the latest version of a secret
+func (b *VaultBackend) Get(ctx context.Context, id string) ([]byte, error) {
+	data, err := b.do(ctx, http.MethodGet, "data", id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Data struct {
+			Data map[string]string `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("invalid vault response: %w", err)
+	}
+	value, ok := resp.Data.Data["value"]
+	if !ok {
+		return nil, fmt.Errorf("secret not found")
+	}
+	return []byte(value), nil
+}
+
+// Delete deletes a secret with all its versions
+func (b *VaultBackend) Delete(ctx context.Context, id string) error {
+	_, err := b.do(ctx, http.MethodDelete, "metadata", id, nil)
+	return err
+}
+
+// do sends a request to the KV engine and returns the response body
+func (b *VaultBackend) do(ctx context.Context, method, kind, id string, body []byte) ([]byte, error) {
+	path := id
+	if b.prefix != "" {
+		path = b.prefix + "/" + id
+	}
+	url := fmt.Sprintf("%s/v1/%s/%s/%s", b.address, b.mount, kind, path)
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", b.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("vault request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vault response: %w", err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("secret not found")
+	}
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("vault returned %s", resp.Status)
+	}
+	return data, nil
+}
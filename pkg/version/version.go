@@ -0,0 +1,61 @@
+// Package version holds the build information of the SDS binaries
+package version
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// Set at build time with
+//
+//	-ldflags "-X github.com/liliang-cn/sds/pkg/version.Version=v1.2.3
+//	          -X github.com/liliang-cn/sds/pkg/version.Commit=<sha>
+//	          -X github.com/liliang-cn/sds/pkg/version.BuildDate=<RFC 3339>"
+//
+// Commit and BuildDate fall back to the VCS information go build embeds.
+var (
+	Version   = "dev"
+	Commit    = ""
+	BuildDate = ""
+)
+
+// Info is the build information of a binary
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"build_date"`
+	GoVersion string `json:"go_version"`
+	Platform  string `json:"platform"`
+}
+
+// Get returns the build information of the running binary
+func Get() Info {
+	info := Info{
+		Version:   Version,
+		Commit:    Commit,
+		BuildDate: BuildDate,
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+	}
+	if build, ok := debug.ReadBuildInfo(); ok {
+		dirty := false
+		for _, s := range build.Settings {
+			switch s.Key {
+			case "vcs.revision":
+				if info.Commit == "" {
+					info.Commit = s.Value
+				}
+			case "vcs.time":
+				if info.BuildDate == "" {
+					info.BuildDate = s.Value
+				}
+			case "vcs.modified":
+				dirty = s.Value == "true"
+			}
+		}
+		if dirty && Commit == "" && info.Commit != "" {
+			info.Commit += "-dirty"
+		}
+	}
+	return info
+}
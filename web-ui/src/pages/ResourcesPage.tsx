@@ -1,9 +1,11 @@
+import { useState } from 'react';
 import { useQuery } from '@tanstack/react-query';
 import { api } from '../services/api';
 import { clsx } from 'clsx';
 import { MdViewInAr, MdAdd, MdVisibility, MdEdit, MdDelete } from 'react-icons/md';
 
 export function ResourcesPage() {
+  const [expanded, setExpanded] = useState<string | null>(null);
   const { data: resources, isLoading } = useQuery({
     queryKey: ['resources'],
     queryFn: () => api.getResources(),
@@ -38,7 +40,12 @@ export function ResourcesPage() {
           </thead>
           <tbody>
             {resources?.resources.map((resource) => (
-              <ResourceRow key={resource.name} resource={resource} />
+              <ResourceRow
+                key={resource.name}
+                resource={resource}
+                expanded={expanded === resource.name}
+                onToggle={() => setExpanded(expanded === resource.name ? null : resource.name)}
+              />
             ))}
           </tbody>
         </table>
@@ -62,65 +69,137 @@ interface ResourceRowProps {
     role: string;
     volumes: Array<{ volumeId: number; device: string; sizeGb: number }>;
   };
+  expanded: boolean;
+  onToggle: () => void;
 }
 
-function ResourceRow({ resource }: ResourceRowProps) {
+function ResourceRow({ resource, expanded, onToggle }: ResourceRowProps) {
   return (
-    <tr className="border-b border-gray-100 hover:bg-gray-50">
-      <td className="py-3 px-4">
-        <div className="flex items-center gap-2">
-          <div className="h-8 w-8 rounded bg-purple-100 flex items-center justify-center">
-            <MdViewInAr className="h-4 w-4 text-purple-600" />
+    <>
+      <tr className="border-b border-gray-100 hover:bg-gray-50">
+        <td className="py-3 px-4">
+          <div className="flex items-center gap-2">
+            <div className="h-8 w-8 rounded bg-purple-100 flex items-center justify-center">
+              <MdViewInAr className="h-4 w-4 text-purple-600" />
+            </div>
+            <span className="font-medium">{resource.name}</span>
           </div>
-          <span className="font-medium">{resource.name}</span>
-        </div>
-      </td>
-      <td className="py-3 px-4 text-sm text-gray-500">{resource.port}</td>
-      <td className="py-3 px-4">
-        <span className="inline-flex items-center px-2 py-1 rounded text-xs font-medium bg-blue-100 text-blue-700">
-          {resource.protocol}
-        </span>
-      </td>
-      <td className="py-3 px-4 text-sm text-gray-500">
-        <div className="flex gap-1">
-          {resource.nodes.map((node) => (
-            <span key={node} className="px-2 py-0.5 bg-gray-100 rounded text-xs">
-              {node}
-            </span>
-          ))}
-        </div>
-      </td>
-      <td className="py-3 px-4">
-        <span className={clsx(
-          'inline-flex items-center px-2 py-1 rounded-full text-xs font-medium',
-          resource.role === 'Primary' && 'bg-green-100 text-green-700',
-          resource.role === 'Secondary' && 'bg-gray-100 text-gray-700',
-          resource.role === 'Unknown' && 'bg-yellow-100 text-yellow-700',
-        )}>
-          {resource.role}
-        </span>
-      </td>
-      <td className="py-3 px-4 text-sm text-gray-500">{resource.volumes.length}</td>
-      <td className="py-3 px-4">
-        <div className="flex justify-end gap-2">
-          <button className="btn btn-secondary text-xs py-1 px-2 flex items-center gap-1">
-            <MdVisibility className="h-3 w-3" />
-            Status
-          </button>
-          <button className="btn btn-secondary text-xs py-1 px-2 flex items-center gap-1">
-            <MdEdit className="h-3 w-3" />
-            Edit
-          </button>
-          <button className="btn btn-danger text-xs py-1 px-2 flex items-center gap-1">
-            <MdDelete className="h-3 w-3" />
-            Delete
-          </button>
-        </div>
-      </td>
-    </tr>
+        </td>
+        <td className="py-3 px-4 text-sm text-gray-500">{resource.port}</td>
+        <td className="py-3 px-4">
+          <span className="inline-flex items-center px-2 py-1 rounded text-xs font-medium bg-blue-100 text-blue-700">
+            {resource.protocol}
+          </span>
+        </td>
+        <td className="py-3 px-4 text-sm text-gray-500">
+          <div className="flex gap-1">
+            {resource.nodes.map((node) => (
+              <span key={node} className="px-2 py-0.5 bg-gray-100 rounded text-xs">
+                {node}
+              </span>
+            ))}
+          </div>
+        </td>
+        <td className="py-3 px-4">
+          <span className={clsx(
+            'inline-flex items-center px-2 py-1 rounded-full text-xs font-medium',
+            resource.role === 'Primary' && 'bg-green-100 text-green-700',
+            resource.role === 'Secondary' && 'bg-gray-100 text-gray-700',
+            resource.role === 'Unknown' && 'bg-yellow-100 text-yellow-700',
+          )}>
+            {resource.role}
+          </span>
+        </td>
+        <td className="py-3 px-4 text-sm text-gray-500">{resource.volumes.length}</td>
+        <td className="py-3 px-4">
+          <div className="flex justify-end gap-2">
+            <button className="btn btn-secondary text-xs py-1 px-2 flex items-center gap-1" onClick={onToggle}>
+              <MdVisibility className="h-3 w-3" />
+              Status
+            </button>
+            <button className="btn btn-secondary text-xs py-1 px-2 flex items-center gap-1">
+              <MdEdit className="h-3 w-3" />
+              Edit
+            </button>
+            <button className="btn btn-danger text-xs py-1 px-2 flex items-center gap-1">
+              <MdDelete className="h-3 w-3" />
+              Delete
+            </button>
+          </div>
+        </td>
+      </tr>
+      {expanded && (
+        <tr className="border-b border-gray-100 bg-gray-50">
+          <td colSpan={7} className="py-3 px-4">
+            <ResourceIOPanel name={resource.name} />
+          </td>
+        </tr>
+      )}
+    </>
   );
 }
 
+// ResourceIOPanel shows the I/O rates of the volumes of a resource on its
+// Primary, refreshed with the node poller
+function ResourceIOPanel({ name }: { name: string }) {
+  const { data, isLoading } = useQuery({
+    queryKey: ['resource-status', name],
+    queryFn: () => api.getResourceStatus(name),
+    refetchInterval: 30000,
+  });
+
+  if (isLoading) {
+    return <div className="text-sm text-gray-500">Loading...</div>;
+  }
+
+  const ioStats = data?.status?.ioStats ?? [];
+  if (!ioStats.length) {
+    return <div className="text-sm text-gray-500">No I/O statistics yet, the resource is not Primary anywhere or was not polled twice.</div>;
+  }
+
+  return (
+    <table className="w-full text-sm">
+      <thead>
+        <tr className="text-gray-500">
+          <th className="text-left py-1 font-medium">Volume</th>
+          <th className="text-left py-1 font-medium">Node</th>
+          <th className="text-right py-1 font-medium">Read</th>
+          <th className="text-right py-1 font-medium">Write</th>
+          <th className="text-right py-1 font-medium">Read IOPS</th>
+          <th className="text-right py-1 font-medium">Write IOPS</th>
+          <th className="text-right py-1 font-medium">Read latency</th>
+          <th className="text-right py-1 font-medium">Write latency</th>
+        </tr>
+      </thead>
+      <tbody>
+        {ioStats.map((io) => (
+          <tr key={`${io.node}/${io.volumeId ?? 0}`}>
+            <td className="py-1">{io.volumeId ?? 0}</td>
+            <td className="py-1">{io.node}</td>
+            <td className="py-1 text-right">{formatRate(io.readBytesPerSec)}</td>
+            <td className="py-1 text-right">{formatRate(io.writeBytesPerSec)}</td>
+            <td className="py-1 text-right">{(io.readIops ?? 0).toFixed(1)}</td>
+            <td className="py-1 text-right">{(io.writeIops ?? 0).toFixed(1)}</td>
+            <td className="py-1 text-right">{(io.readLatencyMs ?? 0).toFixed(2)} ms</td>
+            <td className="py-1 text-right">{(io.writeLatencyMs ?? 0).toFixed(2)} ms</td>
+          </tr>
+        ))}
+      </tbody>
+    </table>
+  );
+}
+
+function formatRate(bytesPerSec = 0) {
+  const units = ['B/s', 'KiB/s', 'MiB/s', 'GiB/s'];
+  let value = bytesPerSec;
+  let unit = 0;
+  while (value >= 1024 && unit < units.length - 1) {
+    value /= 1024;
+    unit++;
+  }
+  return `${value.toFixed(1)} ${units[unit]}`;
+}
+
 function clsx(...classes: (string | boolean | undefined)[]) {
   return classes.filter(Boolean).join(' ');
 }
@@ -1,7 +1,8 @@
-// API base URL - use relative path for embedded UI
+// API base URL - use relative path for embedded UI, the controller serves the
+// REST API under /v1 next to the UI
 const API_BASE = window.location.hostname === 'localhost' || window.location.hostname === '127.0.0.1'
   ? 'http://orange1:3375/v1'
-  : `${window.location.protocol}//${window.location.hostname}:3375/v1`;
+  : '/v1';
 
 export interface ApiResponse<T = unknown> {
   success: boolean;
@@ -50,6 +51,25 @@ export interface NodeState {
   replication: string;
 }
 
+export interface VolumeIOStats {
+  node: string;
+  volumeId: number;
+  readBytesPerSec: number;
+  writeBytesPerSec: number;
+  readIops: number;
+  writeIops: number;
+  readLatencyMs: number;
+  writeLatencyMs: number;
+}
+
+export interface ResourceStatus {
+  name: string;
+  role: string;
+  nodes: string[];
+  volumes: Volume[];
+  ioStats?: VolumeIOStats[];
+}
+
 export interface Gateway {
   id: string;
   name: string;
@@ -170,6 +190,9 @@ class ApiClient {
       body: JSON.stringify(data),
     });
 
+  getResourceStatus = (name: string) =>
+    this.request<ApiResponse & { status: ResourceStatus }>(`/resources/${name}/status`);
+
   deleteResource = (name: string) =>
     this.request<ApiResponse>(`/resources/${name}`, { method: 'DELETE' });
 